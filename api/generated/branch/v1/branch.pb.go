@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/branch/v1/branch.proto
+
+package branchv1
+
+import (
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Address is the postal address of a branch
+type Address struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Street string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	City   string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	// Optional: the state, province or prefecture
+	Region string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	// Optional
+	PostalCode string `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// ISO 3166-1 alpha-2 code, e.g. "JP"
+	Country       string `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_api_proto_branch_v1_branch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+// Branch is a location of a tenant where cars are kept, picked up and returned
+type Branch struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Unique among the branches of the tenant
+	Name    string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Address *Address `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// WGS 84, in decimal degrees
+	Latitude  float64 `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// In the timezone of the tenant. Without any period, the business hours of
+	// the tenant apply.
+	OpeningHours  []*v1.OpeningHours     `protobuf:"bytes,7,rep,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Branch) Reset() {
+	*x = Branch{}
+	mi := &file_api_proto_branch_v1_branch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Branch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Branch) ProtoMessage() {}
+
+func (x *Branch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Branch.ProtoReflect.Descriptor instead.
+func (*Branch) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Branch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Branch) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Branch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Branch) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Branch) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Branch) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Branch) GetOpeningHours() []*v1.OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *Branch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Branch) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// NearestBranch is a branch with its distance to the searched position
+type NearestBranch struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Branch *Branch                `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	// Great-circle distance in kilometers
+	DistanceKm    float64 `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearestBranch) Reset() {
+	*x = NearestBranch{}
+	mi := &file_api_proto_branch_v1_branch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearestBranch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestBranch) ProtoMessage() {}
+
+func (x *NearestBranch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestBranch.ProtoReflect.Descriptor instead.
+func (*NearestBranch) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NearestBranch) GetBranch() *Branch {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+func (x *NearestBranch) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+var File_api_proto_branch_v1_branch_proto protoreflect.FileDescriptor
+
+const file_api_proto_branch_v1_branch_proto_rawDesc = "" +
+	"\n" +
+	" api/proto/branch/v1/branch.proto\x12\tbranch.v1\x1a1api/proto/tenantsettings/v1/tenant_settings.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x88\x01\n" +
+	"\aAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\"\xed\x02\n" +
+	"\x06Branch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12,\n" +
+	"\aaddress\x18\x04 \x01(\v2\x12.branch.v1.AddressR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\x12D\n" +
+	"\ropening_hours\x18\a \x03(\v2\x1f.tenantsettings.v1.OpeningHoursR\fopeningHours\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"[\n" +
+	"\rNearestBranch\x12)\n" +
+	"\x06branch\x18\x01 \x01(\v2\x11.branch.v1.BranchR\x06branch\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKmBGZEgithub.com/jp-ryuji/go-arch-patterns/api/generated/branch/v1;branchv1b\x06proto3"
+
+var (
+	file_api_proto_branch_v1_branch_proto_rawDescOnce sync.Once
+	file_api_proto_branch_v1_branch_proto_rawDescData []byte
+)
+
+func file_api_proto_branch_v1_branch_proto_rawDescGZIP() []byte {
+	file_api_proto_branch_v1_branch_proto_rawDescOnce.Do(func() {
+		file_api_proto_branch_v1_branch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_branch_v1_branch_proto_rawDesc), len(file_api_proto_branch_v1_branch_proto_rawDesc)))
+	})
+	return file_api_proto_branch_v1_branch_proto_rawDescData
+}
+
+var file_api_proto_branch_v1_branch_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_proto_branch_v1_branch_proto_goTypes = []any{
+	(*Address)(nil),               // 0: branch.v1.Address
+	(*Branch)(nil),                // 1: branch.v1.Branch
+	(*NearestBranch)(nil),         // 2: branch.v1.NearestBranch
+	(*v1.OpeningHours)(nil),       // 3: tenantsettings.v1.OpeningHours
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_api_proto_branch_v1_branch_proto_depIdxs = []int32{
+	0, // 0: branch.v1.Branch.address:type_name -> branch.v1.Address
+	3, // 1: branch.v1.Branch.opening_hours:type_name -> tenantsettings.v1.OpeningHours
+	4, // 2: branch.v1.Branch.created_at:type_name -> google.protobuf.Timestamp
+	4, // 3: branch.v1.Branch.updated_at:type_name -> google.protobuf.Timestamp
+	1, // 4: branch.v1.NearestBranch.branch:type_name -> branch.v1.Branch
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_branch_v1_branch_proto_init() }
+func file_api_proto_branch_v1_branch_proto_init() {
+	if File_api_proto_branch_v1_branch_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_branch_v1_branch_proto_rawDesc), len(file_api_proto_branch_v1_branch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_branch_v1_branch_proto_goTypes,
+		DependencyIndexes: file_api_proto_branch_v1_branch_proto_depIdxs,
+		MessageInfos:      file_api_proto_branch_v1_branch_proto_msgTypes,
+	}.Build()
+	File_api_proto_branch_v1_branch_proto = out.File
+	file_api_proto_branch_v1_branch_proto_goTypes = nil
+	file_api_proto_branch_v1_branch_proto_depIdxs = nil
+}
@@ -0,0 +1,618 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/branch/v1/branch_service.proto
+
+package branchv1
+
+import (
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateBranchRequest is the request for adding a branch
+type CreateBranchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// A name already used by another branch of the tenant is rejected with
+	// ALREADY_EXISTS
+	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address   *Address `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude  float64  `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64  `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Optional: without any period, the business hours of the tenant apply
+	OpeningHours  []*v1.OpeningHours `protobuf:"bytes,6,rep,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBranchRequest) Reset() {
+	*x = CreateBranchRequest{}
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBranchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBranchRequest) ProtoMessage() {}
+
+func (x *CreateBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBranchRequest.ProtoReflect.Descriptor instead.
+func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateBranchRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateBranchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBranchRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateBranchRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateBranchRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateBranchRequest) GetOpeningHours() []*v1.OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+// CreateBranchResponse is the response for adding a branch
+type CreateBranchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branch        *Branch                `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBranchResponse) Reset() {
+	*x = CreateBranchResponse{}
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBranchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBranchResponse) ProtoMessage() {}
+
+func (x *CreateBranchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBranchResponse.ProtoReflect.Descriptor instead.
+func (*CreateBranchResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateBranchResponse) GetBranch() *Branch {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+// UpdateBranchRequest is the request for updating a branch. Every attribute is
+// replaced.
+type UpdateBranchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	OpeningHours  []*v1.OpeningHours     `protobuf:"bytes,6,rep,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBranchRequest) Reset() {
+	*x = UpdateBranchRequest{}
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBranchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBranchRequest) ProtoMessage() {}
+
+func (x *UpdateBranchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBranchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBranchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateBranchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBranchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBranchRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateBranchRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateBranchRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateBranchRequest) GetOpeningHours() []*v1.OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+// UpdateBranchResponse is the response for updating a branch
+type UpdateBranchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branch        *Branch                `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBranchResponse) Reset() {
+	*x = UpdateBranchResponse{}
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBranchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBranchResponse) ProtoMessage() {}
+
+func (x *UpdateBranchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBranchResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBranchResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateBranchResponse) GetBranch() *Branch {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+// ListBranchesRequest is the request for listing branches
+type ListBranchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBranchesRequest) Reset() {
+	*x = ListBranchesRequest{}
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBranchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBranchesRequest) ProtoMessage() {}
+
+func (x *ListBranchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBranchesRequest.ProtoReflect.Descriptor instead.
+func (*ListBranchesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBranchesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ListBranchesResponse is the response for listing branches
+type ListBranchesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by name
+	Branches      []*Branch `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBranchesResponse) Reset() {
+	*x = ListBranchesResponse{}
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBranchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBranchesResponse) ProtoMessage() {}
+
+func (x *ListBranchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBranchesResponse.ProtoReflect.Descriptor instead.
+func (*ListBranchesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListBranchesResponse) GetBranches() []*Branch {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+// FindNearestBranchesRequest is the request for finding the closest branches
+type FindNearestBranchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// WGS 84, in decimal degrees
+	Latitude  float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Optional: defaults to 5
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearestBranchesRequest) Reset() {
+	*x = FindNearestBranchesRequest{}
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestBranchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestBranchesRequest) ProtoMessage() {}
+
+func (x *FindNearestBranchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearestBranchesRequest.ProtoReflect.Descriptor instead.
+func (*FindNearestBranchesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FindNearestBranchesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FindNearestBranchesRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FindNearestBranchesRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *FindNearestBranchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// FindNearestBranchesResponse is the response for finding the closest branches
+type FindNearestBranchesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The nearest first
+	Branches      []*NearestBranch `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearestBranchesResponse) Reset() {
+	*x = FindNearestBranchesResponse{}
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearestBranchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearestBranchesResponse) ProtoMessage() {}
+
+func (x *FindNearestBranchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_branch_v1_branch_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearestBranchesResponse.ProtoReflect.Descriptor instead.
+func (*FindNearestBranchesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_branch_v1_branch_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FindNearestBranchesResponse) GetBranches() []*NearestBranch {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+var File_api_proto_branch_v1_branch_service_proto protoreflect.FileDescriptor
+
+const file_api_proto_branch_v1_branch_service_proto_rawDesc = "" +
+	"\n" +
+	"(api/proto/branch/v1/branch_service.proto\x12\tbranch.v1\x1a api/proto/branch/v1/branch.proto\x1a1api/proto/tenantsettings/v1/tenant_settings.proto\x1a\x1cgoogle/api/annotations.proto\"\xf4\x01\n" +
+	"\x13CreateBranchRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\aaddress\x18\x03 \x01(\v2\x12.branch.v1.AddressR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12D\n" +
+	"\ropening_hours\x18\x06 \x03(\v2\x1f.tenantsettings.v1.OpeningHoursR\fopeningHours\"A\n" +
+	"\x14CreateBranchResponse\x12)\n" +
+	"\x06branch\x18\x01 \x01(\v2\x11.branch.v1.BranchR\x06branch\"\xe7\x01\n" +
+	"\x13UpdateBranchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\aaddress\x18\x03 \x01(\v2\x12.branch.v1.AddressR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12D\n" +
+	"\ropening_hours\x18\x06 \x03(\v2\x1f.tenantsettings.v1.OpeningHoursR\fopeningHours\"A\n" +
+	"\x14UpdateBranchResponse\x12)\n" +
+	"\x06branch\x18\x01 \x01(\v2\x11.branch.v1.BranchR\x06branch\"2\n" +
+	"\x13ListBranchesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"E\n" +
+	"\x14ListBranchesResponse\x12-\n" +
+	"\bbranches\x18\x01 \x03(\v2\x11.branch.v1.BranchR\bbranches\"\x89\x01\n" +
+	"\x1aFindNearestBranchesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"S\n" +
+	"\x1bFindNearestBranchesResponse\x124\n" +
+	"\bbranches\x18\x01 \x03(\v2\x18.branch.v1.NearestBranchR\bbranches2\xd9\x03\n" +
+	"\rBranchService\x12h\n" +
+	"\fCreateBranch\x12\x1e.branch.v1.CreateBranchRequest\x1a\x1f.branch.v1.CreateBranchResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/branches\x12m\n" +
+	"\fUpdateBranch\x12\x1e.branch.v1.UpdateBranchRequest\x1a\x1f.branch.v1.UpdateBranchResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/branches/{id}\x12h\n" +
+	"\fListBranches\x12\x1e.branch.v1.ListBranchesRequest\x1a\x1f.branch.v1.ListBranchesResponse\"\x17\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/branches\x90\x02\x01\x12\x84\x01\n" +
+	"\x13FindNearestBranches\x12%.branch.v1.FindNearestBranchesRequest\x1a&.branch.v1.FindNearestBranchesResponse\"\x1e\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/nearestBranches\x90\x02\x01BGZEgithub.com/jp-ryuji/go-arch-patterns/api/generated/branch/v1;branchv1b\x06proto3"
+
+var (
+	file_api_proto_branch_v1_branch_service_proto_rawDescOnce sync.Once
+	file_api_proto_branch_v1_branch_service_proto_rawDescData []byte
+)
+
+func file_api_proto_branch_v1_branch_service_proto_rawDescGZIP() []byte {
+	file_api_proto_branch_v1_branch_service_proto_rawDescOnce.Do(func() {
+		file_api_proto_branch_v1_branch_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_branch_v1_branch_service_proto_rawDesc), len(file_api_proto_branch_v1_branch_service_proto_rawDesc)))
+	})
+	return file_api_proto_branch_v1_branch_service_proto_rawDescData
+}
+
+var file_api_proto_branch_v1_branch_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_branch_v1_branch_service_proto_goTypes = []any{
+	(*CreateBranchRequest)(nil),         // 0: branch.v1.CreateBranchRequest
+	(*CreateBranchResponse)(nil),        // 1: branch.v1.CreateBranchResponse
+	(*UpdateBranchRequest)(nil),         // 2: branch.v1.UpdateBranchRequest
+	(*UpdateBranchResponse)(nil),        // 3: branch.v1.UpdateBranchResponse
+	(*ListBranchesRequest)(nil),         // 4: branch.v1.ListBranchesRequest
+	(*ListBranchesResponse)(nil),        // 5: branch.v1.ListBranchesResponse
+	(*FindNearestBranchesRequest)(nil),  // 6: branch.v1.FindNearestBranchesRequest
+	(*FindNearestBranchesResponse)(nil), // 7: branch.v1.FindNearestBranchesResponse
+	(*Address)(nil),                     // 8: branch.v1.Address
+	(*v1.OpeningHours)(nil),             // 9: tenantsettings.v1.OpeningHours
+	(*Branch)(nil),                      // 10: branch.v1.Branch
+	(*NearestBranch)(nil),               // 11: branch.v1.NearestBranch
+}
+var file_api_proto_branch_v1_branch_service_proto_depIdxs = []int32{
+	8,  // 0: branch.v1.CreateBranchRequest.address:type_name -> branch.v1.Address
+	9,  // 1: branch.v1.CreateBranchRequest.opening_hours:type_name -> tenantsettings.v1.OpeningHours
+	10, // 2: branch.v1.CreateBranchResponse.branch:type_name -> branch.v1.Branch
+	8,  // 3: branch.v1.UpdateBranchRequest.address:type_name -> branch.v1.Address
+	9,  // 4: branch.v1.UpdateBranchRequest.opening_hours:type_name -> tenantsettings.v1.OpeningHours
+	10, // 5: branch.v1.UpdateBranchResponse.branch:type_name -> branch.v1.Branch
+	10, // 6: branch.v1.ListBranchesResponse.branches:type_name -> branch.v1.Branch
+	11, // 7: branch.v1.FindNearestBranchesResponse.branches:type_name -> branch.v1.NearestBranch
+	0,  // 8: branch.v1.BranchService.CreateBranch:input_type -> branch.v1.CreateBranchRequest
+	2,  // 9: branch.v1.BranchService.UpdateBranch:input_type -> branch.v1.UpdateBranchRequest
+	4,  // 10: branch.v1.BranchService.ListBranches:input_type -> branch.v1.ListBranchesRequest
+	6,  // 11: branch.v1.BranchService.FindNearestBranches:input_type -> branch.v1.FindNearestBranchesRequest
+	1,  // 12: branch.v1.BranchService.CreateBranch:output_type -> branch.v1.CreateBranchResponse
+	3,  // 13: branch.v1.BranchService.UpdateBranch:output_type -> branch.v1.UpdateBranchResponse
+	5,  // 14: branch.v1.BranchService.ListBranches:output_type -> branch.v1.ListBranchesResponse
+	7,  // 15: branch.v1.BranchService.FindNearestBranches:output_type -> branch.v1.FindNearestBranchesResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_branch_v1_branch_service_proto_init() }
+func file_api_proto_branch_v1_branch_service_proto_init() {
+	if File_api_proto_branch_v1_branch_service_proto != nil {
+		return
+	}
+	file_api_proto_branch_v1_branch_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_branch_v1_branch_service_proto_rawDesc), len(file_api_proto_branch_v1_branch_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_branch_v1_branch_service_proto_goTypes,
+		DependencyIndexes: file_api_proto_branch_v1_branch_service_proto_depIdxs,
+		MessageInfos:      file_api_proto_branch_v1_branch_service_proto_msgTypes,
+	}.Build()
+	File_api_proto_branch_v1_branch_service_proto = out.File
+	file_api_proto_branch_v1_branch_service_proto_goTypes = nil
+	file_api_proto_branch_v1_branch_service_proto_depIdxs = nil
+}
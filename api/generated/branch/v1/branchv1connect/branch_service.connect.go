@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/proto/branch/v1/branch_service.proto
+
+package branchv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/branch/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BranchServiceName is the fully-qualified name of the BranchService service.
+	BranchServiceName = "branch.v1.BranchService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BranchServiceCreateBranchProcedure is the fully-qualified name of the BranchService's
+	// CreateBranch RPC.
+	BranchServiceCreateBranchProcedure = "/branch.v1.BranchService/CreateBranch"
+	// BranchServiceUpdateBranchProcedure is the fully-qualified name of the BranchService's
+	// UpdateBranch RPC.
+	BranchServiceUpdateBranchProcedure = "/branch.v1.BranchService/UpdateBranch"
+	// BranchServiceListBranchesProcedure is the fully-qualified name of the BranchService's
+	// ListBranches RPC.
+	BranchServiceListBranchesProcedure = "/branch.v1.BranchService/ListBranches"
+	// BranchServiceFindNearestBranchesProcedure is the fully-qualified name of the BranchService's
+	// FindNearestBranches RPC.
+	BranchServiceFindNearestBranchesProcedure = "/branch.v1.BranchService/FindNearestBranches"
+)
+
+// BranchServiceClient is a client for the branch.v1.BranchService service.
+type BranchServiceClient interface {
+	// CreateBranch adds a branch to the tenant
+	CreateBranch(context.Context, *connect.Request[v1.CreateBranchRequest]) (*connect.Response[v1.CreateBranchResponse], error)
+	// UpdateBranch replaces the attributes of a branch of the tenant
+	UpdateBranch(context.Context, *connect.Request[v1.UpdateBranchRequest]) (*connect.Response[v1.UpdateBranchResponse], error)
+	// ListBranches retrieves the branches of the tenant
+	ListBranches(context.Context, *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error)
+	// FindNearestBranches retrieves the branches of the tenant closest to a position
+	FindNearestBranches(context.Context, *connect.Request[v1.FindNearestBranchesRequest]) (*connect.Response[v1.FindNearestBranchesResponse], error)
+}
+
+// NewBranchServiceClient constructs a client for the branch.v1.BranchService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBranchServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BranchServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	branchServiceMethods := v1.File_api_proto_branch_v1_branch_service_proto.Services().ByName("BranchService").Methods()
+	return &branchServiceClient{
+		createBranch: connect.NewClient[v1.CreateBranchRequest, v1.CreateBranchResponse](
+			httpClient,
+			baseURL+BranchServiceCreateBranchProcedure,
+			connect.WithSchema(branchServiceMethods.ByName("CreateBranch")),
+			connect.WithClientOptions(opts...),
+		),
+		updateBranch: connect.NewClient[v1.UpdateBranchRequest, v1.UpdateBranchResponse](
+			httpClient,
+			baseURL+BranchServiceUpdateBranchProcedure,
+			connect.WithSchema(branchServiceMethods.ByName("UpdateBranch")),
+			connect.WithClientOptions(opts...),
+		),
+		listBranches: connect.NewClient[v1.ListBranchesRequest, v1.ListBranchesResponse](
+			httpClient,
+			baseURL+BranchServiceListBranchesProcedure,
+			connect.WithSchema(branchServiceMethods.ByName("ListBranches")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		findNearestBranches: connect.NewClient[v1.FindNearestBranchesRequest, v1.FindNearestBranchesResponse](
+			httpClient,
+			baseURL+BranchServiceFindNearestBranchesProcedure,
+			connect.WithSchema(branchServiceMethods.ByName("FindNearestBranches")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// branchServiceClient implements BranchServiceClient.
+type branchServiceClient struct {
+	createBranch        *connect.Client[v1.CreateBranchRequest, v1.CreateBranchResponse]
+	updateBranch        *connect.Client[v1.UpdateBranchRequest, v1.UpdateBranchResponse]
+	listBranches        *connect.Client[v1.ListBranchesRequest, v1.ListBranchesResponse]
+	findNearestBranches *connect.Client[v1.FindNearestBranchesRequest, v1.FindNearestBranchesResponse]
+}
+
+// CreateBranch calls branch.v1.BranchService.CreateBranch.
+func (c *branchServiceClient) CreateBranch(ctx context.Context, req *connect.Request[v1.CreateBranchRequest]) (*connect.Response[v1.CreateBranchResponse], error) {
+	return c.createBranch.CallUnary(ctx, req)
+}
+
+// UpdateBranch calls branch.v1.BranchService.UpdateBranch.
+func (c *branchServiceClient) UpdateBranch(ctx context.Context, req *connect.Request[v1.UpdateBranchRequest]) (*connect.Response[v1.UpdateBranchResponse], error) {
+	return c.updateBranch.CallUnary(ctx, req)
+}
+
+// ListBranches calls branch.v1.BranchService.ListBranches.
+func (c *branchServiceClient) ListBranches(ctx context.Context, req *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error) {
+	return c.listBranches.CallUnary(ctx, req)
+}
+
+// FindNearestBranches calls branch.v1.BranchService.FindNearestBranches.
+func (c *branchServiceClient) FindNearestBranches(ctx context.Context, req *connect.Request[v1.FindNearestBranchesRequest]) (*connect.Response[v1.FindNearestBranchesResponse], error) {
+	return c.findNearestBranches.CallUnary(ctx, req)
+}
+
+// BranchServiceHandler is an implementation of the branch.v1.BranchService service.
+type BranchServiceHandler interface {
+	// CreateBranch adds a branch to the tenant
+	CreateBranch(context.Context, *connect.Request[v1.CreateBranchRequest]) (*connect.Response[v1.CreateBranchResponse], error)
+	// UpdateBranch replaces the attributes of a branch of the tenant
+	UpdateBranch(context.Context, *connect.Request[v1.UpdateBranchRequest]) (*connect.Response[v1.UpdateBranchResponse], error)
+	// ListBranches retrieves the branches of the tenant
+	ListBranches(context.Context, *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error)
+	// FindNearestBranches retrieves the branches of the tenant closest to a position
+	FindNearestBranches(context.Context, *connect.Request[v1.FindNearestBranchesRequest]) (*connect.Response[v1.FindNearestBranchesResponse], error)
+}
+
+// NewBranchServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBranchServiceHandler(svc BranchServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	branchServiceMethods := v1.File_api_proto_branch_v1_branch_service_proto.Services().ByName("BranchService").Methods()
+	branchServiceCreateBranchHandler := connect.NewUnaryHandler(
+		BranchServiceCreateBranchProcedure,
+		svc.CreateBranch,
+		connect.WithSchema(branchServiceMethods.ByName("CreateBranch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	branchServiceUpdateBranchHandler := connect.NewUnaryHandler(
+		BranchServiceUpdateBranchProcedure,
+		svc.UpdateBranch,
+		connect.WithSchema(branchServiceMethods.ByName("UpdateBranch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	branchServiceListBranchesHandler := connect.NewUnaryHandler(
+		BranchServiceListBranchesProcedure,
+		svc.ListBranches,
+		connect.WithSchema(branchServiceMethods.ByName("ListBranches")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	branchServiceFindNearestBranchesHandler := connect.NewUnaryHandler(
+		BranchServiceFindNearestBranchesProcedure,
+		svc.FindNearestBranches,
+		connect.WithSchema(branchServiceMethods.ByName("FindNearestBranches")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/branch.v1.BranchService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BranchServiceCreateBranchProcedure:
+			branchServiceCreateBranchHandler.ServeHTTP(w, r)
+		case BranchServiceUpdateBranchProcedure:
+			branchServiceUpdateBranchHandler.ServeHTTP(w, r)
+		case BranchServiceListBranchesProcedure:
+			branchServiceListBranchesHandler.ServeHTTP(w, r)
+		case BranchServiceFindNearestBranchesProcedure:
+			branchServiceFindNearestBranchesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBranchServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBranchServiceHandler struct{}
+
+func (UnimplementedBranchServiceHandler) CreateBranch(context.Context, *connect.Request[v1.CreateBranchRequest]) (*connect.Response[v1.CreateBranchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("branch.v1.BranchService.CreateBranch is not implemented"))
+}
+
+func (UnimplementedBranchServiceHandler) UpdateBranch(context.Context, *connect.Request[v1.UpdateBranchRequest]) (*connect.Response[v1.UpdateBranchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("branch.v1.BranchService.UpdateBranch is not implemented"))
+}
+
+func (UnimplementedBranchServiceHandler) ListBranches(context.Context, *connect.Request[v1.ListBranchesRequest]) (*connect.Response[v1.ListBranchesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("branch.v1.BranchService.ListBranches is not implemented"))
+}
+
+func (UnimplementedBranchServiceHandler) FindNearestBranches(context.Context, *connect.Request[v1.FindNearestBranchesRequest]) (*connect.Response[v1.FindNearestBranchesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("branch.v1.BranchService.FindNearestBranches is not implemented"))
+}
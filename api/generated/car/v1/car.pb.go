@@ -22,21 +22,328 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Car represents a car entity
-type Car struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+// CarCategory is the class of cars a model belongs to
+type CarCategory int32
+
+const (
+	// Also the category of models moved into the catalog from the model names of cars
+	// created before it
+	CarCategory_CAR_CATEGORY_UNSPECIFIED CarCategory = 0
+	CarCategory_CAR_CATEGORY_ECONOMY     CarCategory = 1
+	CarCategory_CAR_CATEGORY_COMPACT     CarCategory = 2
+	CarCategory_CAR_CATEGORY_MIDSIZE     CarCategory = 3
+	CarCategory_CAR_CATEGORY_FULLSIZE    CarCategory = 4
+	CarCategory_CAR_CATEGORY_SUV         CarCategory = 5
+	CarCategory_CAR_CATEGORY_VAN         CarCategory = 6
+	CarCategory_CAR_CATEGORY_LUXURY      CarCategory = 7
+)
+
+// Enum value maps for CarCategory.
+var (
+	CarCategory_name = map[int32]string{
+		0: "CAR_CATEGORY_UNSPECIFIED",
+		1: "CAR_CATEGORY_ECONOMY",
+		2: "CAR_CATEGORY_COMPACT",
+		3: "CAR_CATEGORY_MIDSIZE",
+		4: "CAR_CATEGORY_FULLSIZE",
+		5: "CAR_CATEGORY_SUV",
+		6: "CAR_CATEGORY_VAN",
+		7: "CAR_CATEGORY_LUXURY",
+	}
+	CarCategory_value = map[string]int32{
+		"CAR_CATEGORY_UNSPECIFIED": 0,
+		"CAR_CATEGORY_ECONOMY":     1,
+		"CAR_CATEGORY_COMPACT":     2,
+		"CAR_CATEGORY_MIDSIZE":     3,
+		"CAR_CATEGORY_FULLSIZE":    4,
+		"CAR_CATEGORY_SUV":         5,
+		"CAR_CATEGORY_VAN":         6,
+		"CAR_CATEGORY_LUXURY":      7,
+	}
+)
+
+func (x CarCategory) Enum() *CarCategory {
+	p := new(CarCategory)
+	*p = x
+	return p
+}
+
+func (x CarCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CarCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_car_v1_car_proto_enumTypes[0].Descriptor()
+}
+
+func (CarCategory) Type() protoreflect.EnumType {
+	return &file_api_proto_car_v1_car_proto_enumTypes[0]
+}
+
+func (x CarCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CarCategory.Descriptor instead.
+func (CarCategory) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_proto_rawDescGZIP(), []int{0}
+}
+
+// Transmission is the gearbox of a car model
+type Transmission int32
+
+const (
+	Transmission_TRANSMISSION_UNSPECIFIED Transmission = 0
+	Transmission_TRANSMISSION_AUTOMATIC   Transmission = 1
+	Transmission_TRANSMISSION_MANUAL      Transmission = 2
+)
+
+// Enum value maps for Transmission.
+var (
+	Transmission_name = map[int32]string{
+		0: "TRANSMISSION_UNSPECIFIED",
+		1: "TRANSMISSION_AUTOMATIC",
+		2: "TRANSMISSION_MANUAL",
+	}
+	Transmission_value = map[string]int32{
+		"TRANSMISSION_UNSPECIFIED": 0,
+		"TRANSMISSION_AUTOMATIC":   1,
+		"TRANSMISSION_MANUAL":      2,
+	}
+)
+
+func (x Transmission) Enum() *Transmission {
+	p := new(Transmission)
+	*p = x
+	return p
+}
+
+func (x Transmission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Transmission) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_car_v1_car_proto_enumTypes[1].Descriptor()
+}
+
+func (Transmission) Type() protoreflect.EnumType {
+	return &file_api_proto_car_v1_car_proto_enumTypes[1]
+}
+
+func (x Transmission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Transmission.Descriptor instead.
+func (Transmission) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_proto_rawDescGZIP(), []int{1}
+}
+
+// FuelType is what a car model runs on
+type FuelType int32
+
+const (
+	FuelType_FUEL_TYPE_UNSPECIFIED FuelType = 0
+	FuelType_FUEL_TYPE_GASOLINE    FuelType = 1
+	FuelType_FUEL_TYPE_DIESEL      FuelType = 2
+	FuelType_FUEL_TYPE_HYBRID      FuelType = 3
+	FuelType_FUEL_TYPE_ELECTRIC    FuelType = 4
+)
+
+// Enum value maps for FuelType.
+var (
+	FuelType_name = map[int32]string{
+		0: "FUEL_TYPE_UNSPECIFIED",
+		1: "FUEL_TYPE_GASOLINE",
+		2: "FUEL_TYPE_DIESEL",
+		3: "FUEL_TYPE_HYBRID",
+		4: "FUEL_TYPE_ELECTRIC",
+	}
+	FuelType_value = map[string]int32{
+		"FUEL_TYPE_UNSPECIFIED": 0,
+		"FUEL_TYPE_GASOLINE":    1,
+		"FUEL_TYPE_DIESEL":      2,
+		"FUEL_TYPE_HYBRID":      3,
+		"FUEL_TYPE_ELECTRIC":    4,
+	}
+)
+
+func (x FuelType) Enum() *FuelType {
+	p := new(FuelType)
+	*p = x
+	return p
+}
+
+func (x FuelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FuelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_car_v1_car_proto_enumTypes[2].Descriptor()
+}
+
+func (FuelType) Type() protoreflect.EnumType {
+	return &file_api_proto_car_v1_car_proto_enumTypes[2]
+}
+
+func (x FuelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FuelType.Descriptor instead.
+func (FuelType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_proto_rawDescGZIP(), []int{2}
+}
+
+// CarModel is an entry of the tenant's catalog of car models
+type CarModel struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// e.g. "Toyota"
+	Make string `protobuf:"bytes,3,opt,name=make,proto3" json:"make,omitempty"`
+	// The name of the model within its make, e.g. "Camry"
+	Name     string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Category CarCategory `protobuf:"varint,5,opt,name=category,proto3,enum=car.v1.CarCategory" json:"category,omitempty"`
+	// Zero for models moved into the catalog from the model names of cars created before it
+	Seats         int32                  `protobuf:"varint,6,opt,name=seats,proto3" json:"seats,omitempty"`
+	Transmission  Transmission           `protobuf:"varint,7,opt,name=transmission,proto3,enum=car.v1.Transmission" json:"transmission,omitempty"`
+	FuelType      FuelType               `protobuf:"varint,8,opt,name=fuel_type,json=fuelType,proto3,enum=car.v1.FuelType" json:"fuel_type,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *CarModel) Reset() {
+	*x = CarModel{}
+	mi := &file_api_proto_car_v1_car_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarModel) ProtoMessage() {}
+
+func (x *CarModel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarModel.ProtoReflect.Descriptor instead.
+func (*CarModel) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CarModel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CarModel) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CarModel) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *CarModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CarModel) GetCategory() CarCategory {
+	if x != nil {
+		return x.Category
+	}
+	return CarCategory_CAR_CATEGORY_UNSPECIFIED
+}
+
+func (x *CarModel) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *CarModel) GetTransmission() Transmission {
+	if x != nil {
+		return x.Transmission
+	}
+	return Transmission_TRANSMISSION_UNSPECIFIED
+}
+
+func (x *CarModel) GetFuelType() FuelType {
+	if x != nil {
+		return x.FuelType
+	}
+	return FuelType_FUEL_TYPE_UNSPECIFIED
+}
+
+func (x *CarModel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CarModel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Car represents a car entity: a physical unit of a model of the catalog
+type Car struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId   string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CarModelId string                 `protobuf:"bytes,6,opt,name=car_model_id,json=carModelId,proto3" json:"car_model_id,omitempty"`
+	Model      *CarModel              `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	// Vehicle identification number; empty when not recorded. Unique among the
+	// cars of the tenant.
+	Vin string `protobuf:"bytes,8,opt,name=vin,proto3" json:"vin,omitempty"`
+	// Empty when not recorded. Unique among the cars of the tenant and country.
+	LicensePlate string `protobuf:"bytes,9,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	// ISO 3166-1 alpha-2 code of the country that issued the plate; empty when
+	// no plate is recorded
+	LicensePlateCountry string `protobuf:"bytes,10,opt,name=license_plate_country,json=licensePlateCountry,proto3" json:"license_plate_country,omitempty"`
+	// The branch the car belongs to; empty for cars without a branch
+	HomeBranchId string `protobuf:"bytes,11,opt,name=home_branch_id,json=homeBranchId,proto3" json:"home_branch_id,omitempty"`
+	// The branch the car is at, which differs from home_branch_id after a
+	// one-way rental
+	CurrentBranchId string `protobuf:"bytes,12,opt,name=current_branch_id,json=currentBranchId,proto3" json:"current_branch_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
 func (x *Car) Reset() {
 	*x = Car{}
-	mi := &file_api_proto_car_v1_car_proto_msgTypes[0]
+	mi := &file_api_proto_car_v1_car_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48,7 +355,7 @@ func (x *Car) String() string {
 func (*Car) ProtoMessage() {}
 
 func (x *Car) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_car_v1_car_proto_msgTypes[0]
+	mi := &file_api_proto_car_v1_car_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,7 +368,7 @@ func (x *Car) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Car.ProtoReflect.Descriptor instead.
 func (*Car) Descriptor() ([]byte, []int) {
-	return file_api_proto_car_v1_car_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_car_v1_car_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Car) GetId() string {
@@ -78,13 +385,6 @@ func (x *Car) GetTenantId() string {
 	return ""
 }
 
-func (x *Car) GetModel() string {
-	if x != nil {
-		return x.Model
-	}
-	return ""
-}
-
 func (x *Car) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -99,19 +399,109 @@ func (x *Car) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Car) GetCarModelId() string {
+	if x != nil {
+		return x.CarModelId
+	}
+	return ""
+}
+
+func (x *Car) GetModel() *CarModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *Car) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *Car) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+func (x *Car) GetLicensePlateCountry() string {
+	if x != nil {
+		return x.LicensePlateCountry
+	}
+	return ""
+}
+
+func (x *Car) GetHomeBranchId() string {
+	if x != nil {
+		return x.HomeBranchId
+	}
+	return ""
+}
+
+func (x *Car) GetCurrentBranchId() string {
+	if x != nil {
+		return x.CurrentBranchId
+	}
+	return ""
+}
+
 var File_api_proto_car_v1_car_proto protoreflect.FileDescriptor
 
 const file_api_proto_car_v1_car_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/proto/car/v1/car.proto\x12\x06car.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x01\n" +
+	"\x1aapi/proto/car/v1/car.proto\x12\x06car.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x85\x03\n" +
+	"\bCarModel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04make\x18\x03 \x01(\tR\x04make\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12/\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x13.car.v1.CarCategoryR\bcategory\x12\x14\n" +
+	"\x05seats\x18\x06 \x01(\x05R\x05seats\x128\n" +
+	"\ftransmission\x18\a \x01(\x0e2\x14.car.v1.TransmissionR\ftransmission\x12-\n" +
+	"\tfuel_type\x18\b \x01(\x0e2\x10.car.v1.FuelTypeR\bfuelType\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb5\x03\n" +
 	"\x03Car\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x14\n" +
-	"\x05model\x18\x03 \x01(\tR\x05model\x129\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x129\n" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtBAZ?github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1;carv1b\x06proto3"
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12 \n" +
+	"\fcar_model_id\x18\x06 \x01(\tR\n" +
+	"carModelId\x12&\n" +
+	"\x05model\x18\a \x01(\v2\x10.car.v1.CarModelR\x05model\x12\x10\n" +
+	"\x03vin\x18\b \x01(\tR\x03vin\x12#\n" +
+	"\rlicense_plate\x18\t \x01(\tR\flicensePlate\x122\n" +
+	"\x15license_plate_country\x18\n" +
+	" \x01(\tR\x13licensePlateCountry\x12$\n" +
+	"\x0ehome_branch_id\x18\v \x01(\tR\fhomeBranchId\x12*\n" +
+	"\x11current_branch_id\x18\f \x01(\tR\x0fcurrentBranchIdJ\x04\b\x03\x10\x04*\xd9\x01\n" +
+	"\vCarCategory\x12\x1c\n" +
+	"\x18CAR_CATEGORY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CAR_CATEGORY_ECONOMY\x10\x01\x12\x18\n" +
+	"\x14CAR_CATEGORY_COMPACT\x10\x02\x12\x18\n" +
+	"\x14CAR_CATEGORY_MIDSIZE\x10\x03\x12\x19\n" +
+	"\x15CAR_CATEGORY_FULLSIZE\x10\x04\x12\x14\n" +
+	"\x10CAR_CATEGORY_SUV\x10\x05\x12\x14\n" +
+	"\x10CAR_CATEGORY_VAN\x10\x06\x12\x17\n" +
+	"\x13CAR_CATEGORY_LUXURY\x10\a*a\n" +
+	"\fTransmission\x12\x1c\n" +
+	"\x18TRANSMISSION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TRANSMISSION_AUTOMATIC\x10\x01\x12\x17\n" +
+	"\x13TRANSMISSION_MANUAL\x10\x02*\x81\x01\n" +
+	"\bFuelType\x12\x19\n" +
+	"\x15FUEL_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12FUEL_TYPE_GASOLINE\x10\x01\x12\x14\n" +
+	"\x10FUEL_TYPE_DIESEL\x10\x02\x12\x14\n" +
+	"\x10FUEL_TYPE_HYBRID\x10\x03\x12\x16\n" +
+	"\x12FUEL_TYPE_ELECTRIC\x10\x04BAZ?github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1;carv1b\x06proto3"
 
 var (
 	file_api_proto_car_v1_car_proto_rawDescOnce sync.Once
@@ -125,19 +515,30 @@ func file_api_proto_car_v1_car_proto_rawDescGZIP() []byte {
 	return file_api_proto_car_v1_car_proto_rawDescData
 }
 
-var file_api_proto_car_v1_car_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_proto_car_v1_car_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_proto_car_v1_car_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_api_proto_car_v1_car_proto_goTypes = []any{
-	(*Car)(nil),                   // 0: car.v1.Car
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(CarCategory)(0),              // 0: car.v1.CarCategory
+	(Transmission)(0),             // 1: car.v1.Transmission
+	(FuelType)(0),                 // 2: car.v1.FuelType
+	(*CarModel)(nil),              // 3: car.v1.CarModel
+	(*Car)(nil),                   // 4: car.v1.Car
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_api_proto_car_v1_car_proto_depIdxs = []int32{
-	1, // 0: car.v1.Car.created_at:type_name -> google.protobuf.Timestamp
-	1, // 1: car.v1.Car.updated_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	0, // 0: car.v1.CarModel.category:type_name -> car.v1.CarCategory
+	1, // 1: car.v1.CarModel.transmission:type_name -> car.v1.Transmission
+	2, // 2: car.v1.CarModel.fuel_type:type_name -> car.v1.FuelType
+	5, // 3: car.v1.CarModel.created_at:type_name -> google.protobuf.Timestamp
+	5, // 4: car.v1.CarModel.updated_at:type_name -> google.protobuf.Timestamp
+	5, // 5: car.v1.Car.created_at:type_name -> google.protobuf.Timestamp
+	5, // 6: car.v1.Car.updated_at:type_name -> google.protobuf.Timestamp
+	3, // 7: car.v1.Car.model:type_name -> car.v1.CarModel
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_car_v1_car_proto_init() }
@@ -150,13 +551,14 @@ func file_api_proto_car_v1_car_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_car_v1_car_proto_rawDesc), len(file_api_proto_car_v1_car_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      3,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_proto_car_v1_car_proto_goTypes,
 		DependencyIndexes: file_api_proto_car_v1_car_proto_depIdxs,
+		EnumInfos:         file_api_proto_car_v1_car_proto_enumTypes,
 		MessageInfos:      file_api_proto_car_v1_car_proto_msgTypes,
 	}.Build()
 	File_api_proto_car_v1_car_proto = out.File
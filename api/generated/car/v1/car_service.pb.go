@@ -24,9 +24,24 @@ const (
 
 // CreateCarRequest is the request for creating a car
 type CreateCarRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// A model of the tenant's catalog
+	CarModelId string `protobuf:"bytes,3,opt,name=car_model_id,json=carModelId,proto3" json:"car_model_id,omitempty"`
+	// Optional: vehicle identification number, validated against its ISO 3779
+	// check digit. A VIN already used by another car of the tenant is rejected
+	// with ALREADY_EXISTS.
+	Vin string `protobuf:"bytes,4,opt,name=vin,proto3" json:"vin,omitempty"`
+	// Optional: validated against the format of license_plate_country. A plate
+	// already used by another car of the tenant is rejected with ALREADY_EXISTS.
+	LicensePlate string `protobuf:"bytes,5,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	// ISO 3166-1 alpha-2 code of the country that issued the plate, e.g. "US";
+	// required with license_plate
+	LicensePlateCountry string `protobuf:"bytes,6,opt,name=license_plate_country,json=licensePlateCountry,proto3" json:"license_plate_country,omitempty"`
+	// Optional: a branch of the tenant the car belongs to and starts at
+	HomeBranchId  string `protobuf:"bytes,7,opt,name=home_branch_id,json=homeBranchId,proto3" json:"home_branch_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -68,9 +83,37 @@ func (x *CreateCarRequest) GetTenantId() string {
 	return ""
 }
 
-func (x *CreateCarRequest) GetModel() string {
+func (x *CreateCarRequest) GetCarModelId() string {
 	if x != nil {
-		return x.Model
+		return x.CarModelId
+	}
+	return ""
+}
+
+func (x *CreateCarRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *CreateCarRequest) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+func (x *CreateCarRequest) GetLicensePlateCountry() string {
+	if x != nil {
+		return x.LicensePlateCountry
+	}
+	return ""
+}
+
+func (x *CreateCarRequest) GetHomeBranchId() string {
+	if x != nil {
+		return x.HomeBranchId
 	}
 	return ""
 }
@@ -212,10 +255,12 @@ func (x *GetCarResponse) GetCar() *Car {
 
 // ListCarsRequest is the request for listing cars
 type ListCarsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -324,14 +369,499 @@ func (x *ListCarsResponse) GetNextPageToken() string {
 	return ""
 }
 
+// AssignCarBranchesRequest is the request for setting the branches of a car
+type AssignCarBranchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// A branch of the tenant
+	HomeBranchId string `protobuf:"bytes,2,opt,name=home_branch_id,json=homeBranchId,proto3" json:"home_branch_id,omitempty"`
+	// Optional: defaults to home_branch_id
+	CurrentBranchId string `protobuf:"bytes,3,opt,name=current_branch_id,json=currentBranchId,proto3" json:"current_branch_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssignCarBranchesRequest) Reset() {
+	*x = AssignCarBranchesRequest{}
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignCarBranchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignCarBranchesRequest) ProtoMessage() {}
+
+func (x *AssignCarBranchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignCarBranchesRequest.ProtoReflect.Descriptor instead.
+func (*AssignCarBranchesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssignCarBranchesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssignCarBranchesRequest) GetHomeBranchId() string {
+	if x != nil {
+		return x.HomeBranchId
+	}
+	return ""
+}
+
+func (x *AssignCarBranchesRequest) GetCurrentBranchId() string {
+	if x != nil {
+		return x.CurrentBranchId
+	}
+	return ""
+}
+
+// AssignCarBranchesResponse is the response for setting the branches of a car
+type AssignCarBranchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Car           *Car                   `protobuf:"bytes,1,opt,name=car,proto3" json:"car,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignCarBranchesResponse) Reset() {
+	*x = AssignCarBranchesResponse{}
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignCarBranchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignCarBranchesResponse) ProtoMessage() {}
+
+func (x *AssignCarBranchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignCarBranchesResponse.ProtoReflect.Descriptor instead.
+func (*AssignCarBranchesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AssignCarBranchesResponse) GetCar() *Car {
+	if x != nil {
+		return x.Car
+	}
+	return nil
+}
+
+// CreateCarModelRequest is the request for adding a model to the catalog
+type CreateCarModelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId      string       `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Make          string       `protobuf:"bytes,2,opt,name=make,proto3" json:"make,omitempty"`
+	Name          string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Category      CarCategory  `protobuf:"varint,4,opt,name=category,proto3,enum=car.v1.CarCategory" json:"category,omitempty"`
+	Seats         int32        `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+	Transmission  Transmission `protobuf:"varint,6,opt,name=transmission,proto3,enum=car.v1.Transmission" json:"transmission,omitempty"`
+	FuelType      FuelType     `protobuf:"varint,7,opt,name=fuel_type,json=fuelType,proto3,enum=car.v1.FuelType" json:"fuel_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCarModelRequest) Reset() {
+	*x = CreateCarModelRequest{}
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCarModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCarModelRequest) ProtoMessage() {}
+
+func (x *CreateCarModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCarModelRequest.ProtoReflect.Descriptor instead.
+func (*CreateCarModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateCarModelRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateCarModelRequest) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *CreateCarModelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCarModelRequest) GetCategory() CarCategory {
+	if x != nil {
+		return x.Category
+	}
+	return CarCategory_CAR_CATEGORY_UNSPECIFIED
+}
+
+func (x *CreateCarModelRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *CreateCarModelRequest) GetTransmission() Transmission {
+	if x != nil {
+		return x.Transmission
+	}
+	return Transmission_TRANSMISSION_UNSPECIFIED
+}
+
+func (x *CreateCarModelRequest) GetFuelType() FuelType {
+	if x != nil {
+		return x.FuelType
+	}
+	return FuelType_FUEL_TYPE_UNSPECIFIED
+}
+
+// CreateCarModelResponse is the response for adding a model to the catalog
+type CreateCarModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *CarModel              `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCarModelResponse) Reset() {
+	*x = CreateCarModelResponse{}
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCarModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCarModelResponse) ProtoMessage() {}
+
+func (x *CreateCarModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCarModelResponse.ProtoReflect.Descriptor instead.
+func (*CreateCarModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateCarModelResponse) GetModel() *CarModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+// UpdateCarModelRequest is the request for updating a model of the catalog. Every
+// attribute is replaced.
+type UpdateCarModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Make          string                 `protobuf:"bytes,2,opt,name=make,proto3" json:"make,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Category      CarCategory            `protobuf:"varint,4,opt,name=category,proto3,enum=car.v1.CarCategory" json:"category,omitempty"`
+	Seats         int32                  `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+	Transmission  Transmission           `protobuf:"varint,6,opt,name=transmission,proto3,enum=car.v1.Transmission" json:"transmission,omitempty"`
+	FuelType      FuelType               `protobuf:"varint,7,opt,name=fuel_type,json=fuelType,proto3,enum=car.v1.FuelType" json:"fuel_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCarModelRequest) Reset() {
+	*x = UpdateCarModelRequest{}
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCarModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCarModelRequest) ProtoMessage() {}
+
+func (x *UpdateCarModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCarModelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCarModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateCarModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCarModelRequest) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *UpdateCarModelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCarModelRequest) GetCategory() CarCategory {
+	if x != nil {
+		return x.Category
+	}
+	return CarCategory_CAR_CATEGORY_UNSPECIFIED
+}
+
+func (x *UpdateCarModelRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *UpdateCarModelRequest) GetTransmission() Transmission {
+	if x != nil {
+		return x.Transmission
+	}
+	return Transmission_TRANSMISSION_UNSPECIFIED
+}
+
+func (x *UpdateCarModelRequest) GetFuelType() FuelType {
+	if x != nil {
+		return x.FuelType
+	}
+	return FuelType_FUEL_TYPE_UNSPECIFIED
+}
+
+// UpdateCarModelResponse is the response for updating a model of the catalog
+type UpdateCarModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *CarModel              `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCarModelResponse) Reset() {
+	*x = UpdateCarModelResponse{}
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCarModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCarModelResponse) ProtoMessage() {}
+
+func (x *UpdateCarModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCarModelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCarModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateCarModelResponse) GetModel() *CarModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+// ListCarModelsRequest is the request for listing the catalog
+type ListCarModelsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCarModelsRequest) Reset() {
+	*x = ListCarModelsRequest{}
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCarModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCarModelsRequest) ProtoMessage() {}
+
+func (x *ListCarModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCarModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListCarModelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListCarModelsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ListCarModelsResponse is the response for listing the catalog
+type ListCarModelsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by make and name
+	Models        []*CarModel `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCarModelsResponse) Reset() {
+	*x = ListCarModelsResponse{}
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCarModelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCarModelsResponse) ProtoMessage() {}
+
+func (x *ListCarModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_car_v1_car_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCarModelsResponse.ProtoReflect.Descriptor instead.
+func (*ListCarModelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_car_v1_car_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListCarModelsResponse) GetModels() []*CarModel {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
 var File_api_proto_car_v1_car_service_proto protoreflect.FileDescriptor
 
 const file_api_proto_car_v1_car_service_proto_rawDesc = "" +
 	"\n" +
-	"\"api/proto/car/v1/car_service.proto\x12\x06car.v1\x1a\x1aapi/proto/car/v1/car.proto\x1a\x1cgoogle/api/annotations.proto\"E\n" +
+	"\"api/proto/car/v1/car_service.proto\x12\x06car.v1\x1a\x1aapi/proto/car/v1/car.proto\x1a\x1cgoogle/api/annotations.proto\"\xe8\x01\n" +
 	"\x10CreateCarRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
-	"\x05model\x18\x02 \x01(\tR\x05model\"2\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12 \n" +
+	"\fcar_model_id\x18\x03 \x01(\tR\n" +
+	"carModelId\x12\x10\n" +
+	"\x03vin\x18\x04 \x01(\tR\x03vin\x12#\n" +
+	"\rlicense_plate\x18\x05 \x01(\tR\flicensePlate\x122\n" +
+	"\x15license_plate_country\x18\x06 \x01(\tR\x13licensePlateCountry\x12$\n" +
+	"\x0ehome_branch_id\x18\a \x01(\tR\fhomeBranchIdJ\x04\b\x02\x10\x03\"2\n" +
 	"\x11CreateCarResponse\x12\x1d\n" +
 	"\x03car\x18\x01 \x01(\v2\v.car.v1.CarR\x03car\"\x1f\n" +
 	"\rGetCarRequest\x12\x0e\n" +
@@ -345,13 +875,47 @@ const file_api_proto_car_v1_car_service_proto_rawDesc = "" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\"[\n" +
 	"\x10ListCarsResponse\x12\x1f\n" +
 	"\x04cars\x18\x01 \x03(\v2\v.car.v1.CarR\x04cars\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\x84\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"|\n" +
+	"\x18AssignCarBranchesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12$\n" +
+	"\x0ehome_branch_id\x18\x02 \x01(\tR\fhomeBranchId\x12*\n" +
+	"\x11current_branch_id\x18\x03 \x01(\tR\x0fcurrentBranchId\":\n" +
+	"\x19AssignCarBranchesResponse\x12\x1d\n" +
+	"\x03car\x18\x01 \x01(\v2\v.car.v1.CarR\x03car\"\x8c\x02\n" +
+	"\x15CreateCarModelRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04make\x18\x02 \x01(\tR\x04make\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12/\n" +
+	"\bcategory\x18\x04 \x01(\x0e2\x13.car.v1.CarCategoryR\bcategory\x12\x14\n" +
+	"\x05seats\x18\x05 \x01(\x05R\x05seats\x128\n" +
+	"\ftransmission\x18\x06 \x01(\x0e2\x14.car.v1.TransmissionR\ftransmission\x12-\n" +
+	"\tfuel_type\x18\a \x01(\x0e2\x10.car.v1.FuelTypeR\bfuelType\"@\n" +
+	"\x16CreateCarModelResponse\x12&\n" +
+	"\x05model\x18\x01 \x01(\v2\x10.car.v1.CarModelR\x05model\"\xff\x01\n" +
+	"\x15UpdateCarModelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04make\x18\x02 \x01(\tR\x04make\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12/\n" +
+	"\bcategory\x18\x04 \x01(\x0e2\x13.car.v1.CarCategoryR\bcategory\x12\x14\n" +
+	"\x05seats\x18\x05 \x01(\x05R\x05seats\x128\n" +
+	"\ftransmission\x18\x06 \x01(\x0e2\x14.car.v1.TransmissionR\ftransmission\x12-\n" +
+	"\tfuel_type\x18\a \x01(\x0e2\x10.car.v1.FuelTypeR\bfuelType\"@\n" +
+	"\x16UpdateCarModelResponse\x12&\n" +
+	"\x05model\x18\x01 \x01(\v2\x10.car.v1.CarModelR\x05model\"3\n" +
+	"\x14ListCarModelsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"A\n" +
+	"\x15ListCarModelsResponse\x12(\n" +
+	"\x06models\x18\x01 \x03(\v2\x10.car.v1.CarModelR\x06models2\xd1\x05\n" +
 	"\n" +
 	"CarService\x12U\n" +
-	"\tCreateCar\x12\x18.car.v1.CreateCarRequest\x1a\x19.car.v1.CreateCarResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/cars\x12N\n" +
-	"\x06GetCar\x12\x15.car.v1.GetCarRequest\x1a\x16.car.v1.GetCarResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/cars/{id}\x12O\n" +
-	"\bListCars\x12\x17.car.v1.ListCarsRequest\x1a\x18.car.v1.ListCarsResponse\"\x10\x82\xd3\xe4\x93\x02\n" +
-	"\x12\b/v1/carsBAZ?github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1;carv1b\x06proto3"
+	"\tCreateCar\x12\x18.car.v1.CreateCarRequest\x1a\x19.car.v1.CreateCarResponse\"\x13\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/cars\x12Q\n" +
+	"\x06GetCar\x12\x15.car.v1.GetCarRequest\x1a\x16.car.v1.GetCarResponse\"\x18\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/cars/{id}\x90\x02\x01\x12R\n" +
+	"\bListCars\x12\x17.car.v1.ListCarsRequest\x1a\x18.car.v1.ListCarsResponse\"\x13\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/cars\x90\x02\x01\x12i\n" +
+	"\x0eCreateCarModel\x12\x1d.car.v1.CreateCarModelRequest\x1a\x1e.car.v1.CreateCarModelResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/carModels\x12n\n" +
+	"\x0eUpdateCarModel\x12\x1d.car.v1.UpdateCarModelRequest\x1a\x1e.car.v1.UpdateCarModelResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/v1/carModels/{id}\x12\x81\x01\n" +
+	"\x11AssignCarBranches\x12 .car.v1.AssignCarBranchesRequest\x1a!.car.v1.AssignCarBranchesResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/cars/{id}:assignBranches\x12f\n" +
+	"\rListCarModels\x12\x1c.car.v1.ListCarModelsRequest\x1a\x1d.car.v1.ListCarModelsResponse\"\x18\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/carModels\x90\x02\x01BAZ?github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1;carv1b\x06proto3"
 
 var (
 	file_api_proto_car_v1_car_service_proto_rawDescOnce sync.Once
@@ -365,31 +929,61 @@ func file_api_proto_car_v1_car_service_proto_rawDescGZIP() []byte {
 	return file_api_proto_car_v1_car_service_proto_rawDescData
 }
 
-var file_api_proto_car_v1_car_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_proto_car_v1_car_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_proto_car_v1_car_service_proto_goTypes = []any{
-	(*CreateCarRequest)(nil),  // 0: car.v1.CreateCarRequest
-	(*CreateCarResponse)(nil), // 1: car.v1.CreateCarResponse
-	(*GetCarRequest)(nil),     // 2: car.v1.GetCarRequest
-	(*GetCarResponse)(nil),    // 3: car.v1.GetCarResponse
-	(*ListCarsRequest)(nil),   // 4: car.v1.ListCarsRequest
-	(*ListCarsResponse)(nil),  // 5: car.v1.ListCarsResponse
-	(*Car)(nil),               // 6: car.v1.Car
+	(*CreateCarRequest)(nil),          // 0: car.v1.CreateCarRequest
+	(*CreateCarResponse)(nil),         // 1: car.v1.CreateCarResponse
+	(*GetCarRequest)(nil),             // 2: car.v1.GetCarRequest
+	(*GetCarResponse)(nil),            // 3: car.v1.GetCarResponse
+	(*ListCarsRequest)(nil),           // 4: car.v1.ListCarsRequest
+	(*ListCarsResponse)(nil),          // 5: car.v1.ListCarsResponse
+	(*AssignCarBranchesRequest)(nil),  // 6: car.v1.AssignCarBranchesRequest
+	(*AssignCarBranchesResponse)(nil), // 7: car.v1.AssignCarBranchesResponse
+	(*CreateCarModelRequest)(nil),     // 8: car.v1.CreateCarModelRequest
+	(*CreateCarModelResponse)(nil),    // 9: car.v1.CreateCarModelResponse
+	(*UpdateCarModelRequest)(nil),     // 10: car.v1.UpdateCarModelRequest
+	(*UpdateCarModelResponse)(nil),    // 11: car.v1.UpdateCarModelResponse
+	(*ListCarModelsRequest)(nil),      // 12: car.v1.ListCarModelsRequest
+	(*ListCarModelsResponse)(nil),     // 13: car.v1.ListCarModelsResponse
+	(*Car)(nil),                       // 14: car.v1.Car
+	(CarCategory)(0),                  // 15: car.v1.CarCategory
+	(Transmission)(0),                 // 16: car.v1.Transmission
+	(FuelType)(0),                     // 17: car.v1.FuelType
+	(*CarModel)(nil),                  // 18: car.v1.CarModel
 }
 var file_api_proto_car_v1_car_service_proto_depIdxs = []int32{
-	6, // 0: car.v1.CreateCarResponse.car:type_name -> car.v1.Car
-	6, // 1: car.v1.GetCarResponse.car:type_name -> car.v1.Car
-	6, // 2: car.v1.ListCarsResponse.cars:type_name -> car.v1.Car
-	0, // 3: car.v1.CarService.CreateCar:input_type -> car.v1.CreateCarRequest
-	2, // 4: car.v1.CarService.GetCar:input_type -> car.v1.GetCarRequest
-	4, // 5: car.v1.CarService.ListCars:input_type -> car.v1.ListCarsRequest
-	1, // 6: car.v1.CarService.CreateCar:output_type -> car.v1.CreateCarResponse
-	3, // 7: car.v1.CarService.GetCar:output_type -> car.v1.GetCarResponse
-	5, // 8: car.v1.CarService.ListCars:output_type -> car.v1.ListCarsResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	14, // 0: car.v1.CreateCarResponse.car:type_name -> car.v1.Car
+	14, // 1: car.v1.GetCarResponse.car:type_name -> car.v1.Car
+	14, // 2: car.v1.ListCarsResponse.cars:type_name -> car.v1.Car
+	14, // 3: car.v1.AssignCarBranchesResponse.car:type_name -> car.v1.Car
+	15, // 4: car.v1.CreateCarModelRequest.category:type_name -> car.v1.CarCategory
+	16, // 5: car.v1.CreateCarModelRequest.transmission:type_name -> car.v1.Transmission
+	17, // 6: car.v1.CreateCarModelRequest.fuel_type:type_name -> car.v1.FuelType
+	18, // 7: car.v1.CreateCarModelResponse.model:type_name -> car.v1.CarModel
+	15, // 8: car.v1.UpdateCarModelRequest.category:type_name -> car.v1.CarCategory
+	16, // 9: car.v1.UpdateCarModelRequest.transmission:type_name -> car.v1.Transmission
+	17, // 10: car.v1.UpdateCarModelRequest.fuel_type:type_name -> car.v1.FuelType
+	18, // 11: car.v1.UpdateCarModelResponse.model:type_name -> car.v1.CarModel
+	18, // 12: car.v1.ListCarModelsResponse.models:type_name -> car.v1.CarModel
+	0,  // 13: car.v1.CarService.CreateCar:input_type -> car.v1.CreateCarRequest
+	2,  // 14: car.v1.CarService.GetCar:input_type -> car.v1.GetCarRequest
+	4,  // 15: car.v1.CarService.ListCars:input_type -> car.v1.ListCarsRequest
+	8,  // 16: car.v1.CarService.CreateCarModel:input_type -> car.v1.CreateCarModelRequest
+	10, // 17: car.v1.CarService.UpdateCarModel:input_type -> car.v1.UpdateCarModelRequest
+	6,  // 18: car.v1.CarService.AssignCarBranches:input_type -> car.v1.AssignCarBranchesRequest
+	12, // 19: car.v1.CarService.ListCarModels:input_type -> car.v1.ListCarModelsRequest
+	1,  // 20: car.v1.CarService.CreateCar:output_type -> car.v1.CreateCarResponse
+	3,  // 21: car.v1.CarService.GetCar:output_type -> car.v1.GetCarResponse
+	5,  // 22: car.v1.CarService.ListCars:output_type -> car.v1.ListCarsResponse
+	9,  // 23: car.v1.CarService.CreateCarModel:output_type -> car.v1.CreateCarModelResponse
+	11, // 24: car.v1.CarService.UpdateCarModel:output_type -> car.v1.UpdateCarModelResponse
+	7,  // 25: car.v1.CarService.AssignCarBranches:output_type -> car.v1.AssignCarBranchesResponse
+	13, // 26: car.v1.CarService.ListCarModels:output_type -> car.v1.ListCarModelsResponse
+	20, // [20:27] is the sub-list for method output_type
+	13, // [13:20] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_car_v1_car_service_proto_init() }
@@ -404,7 +998,7 @@ func file_api_proto_car_v1_car_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_car_v1_car_service_proto_rawDesc), len(file_api_proto_car_v1_car_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CarService_CreateCar_FullMethodName = "/car.v1.CarService/CreateCar"
-	CarService_GetCar_FullMethodName    = "/car.v1.CarService/GetCar"
-	CarService_ListCars_FullMethodName  = "/car.v1.CarService/ListCars"
+	CarService_CreateCar_FullMethodName         = "/car.v1.CarService/CreateCar"
+	CarService_GetCar_FullMethodName            = "/car.v1.CarService/GetCar"
+	CarService_ListCars_FullMethodName          = "/car.v1.CarService/ListCars"
+	CarService_CreateCarModel_FullMethodName    = "/car.v1.CarService/CreateCarModel"
+	CarService_UpdateCarModel_FullMethodName    = "/car.v1.CarService/UpdateCarModel"
+	CarService_AssignCarBranches_FullMethodName = "/car.v1.CarService/AssignCarBranches"
+	CarService_ListCarModels_FullMethodName     = "/car.v1.CarService/ListCarModels"
 )
 
 // CarServiceClient is the client API for CarService service.
@@ -36,6 +40,14 @@ type CarServiceClient interface {
 	GetCar(ctx context.Context, in *GetCarRequest, opts ...grpc.CallOption) (*GetCarResponse, error)
 	// ListCars retrieves a list of cars
 	ListCars(ctx context.Context, in *ListCarsRequest, opts ...grpc.CallOption) (*ListCarsResponse, error)
+	// CreateCarModel adds a model to the tenant's catalog
+	CreateCarModel(ctx context.Context, in *CreateCarModelRequest, opts ...grpc.CallOption) (*CreateCarModelResponse, error)
+	// UpdateCarModel replaces the attributes of a model of the tenant's catalog
+	UpdateCarModel(ctx context.Context, in *UpdateCarModelRequest, opts ...grpc.CallOption) (*UpdateCarModelResponse, error)
+	// AssignCarBranches sets the branch a car belongs to and the one it is at
+	AssignCarBranches(ctx context.Context, in *AssignCarBranchesRequest, opts ...grpc.CallOption) (*AssignCarBranchesResponse, error)
+	// ListCarModels retrieves the tenant's catalog
+	ListCarModels(ctx context.Context, in *ListCarModelsRequest, opts ...grpc.CallOption) (*ListCarModelsResponse, error)
 }
 
 type carServiceClient struct {
@@ -76,6 +88,46 @@ func (c *carServiceClient) ListCars(ctx context.Context, in *ListCarsRequest, op
 	return out, nil
 }
 
+func (c *carServiceClient) CreateCarModel(ctx context.Context, in *CreateCarModelRequest, opts ...grpc.CallOption) (*CreateCarModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCarModelResponse)
+	err := c.cc.Invoke(ctx, CarService_CreateCarModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) UpdateCarModel(ctx context.Context, in *UpdateCarModelRequest, opts ...grpc.CallOption) (*UpdateCarModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCarModelResponse)
+	err := c.cc.Invoke(ctx, CarService_UpdateCarModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) AssignCarBranches(ctx context.Context, in *AssignCarBranchesRequest, opts ...grpc.CallOption) (*AssignCarBranchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignCarBranchesResponse)
+	err := c.cc.Invoke(ctx, CarService_AssignCarBranches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *carServiceClient) ListCarModels(ctx context.Context, in *ListCarModelsRequest, opts ...grpc.CallOption) (*ListCarModelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCarModelsResponse)
+	err := c.cc.Invoke(ctx, CarService_ListCarModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CarServiceServer is the server API for CarService service.
 // All implementations should embed UnimplementedCarServiceServer
 // for forward compatibility.
@@ -88,6 +140,14 @@ type CarServiceServer interface {
 	GetCar(context.Context, *GetCarRequest) (*GetCarResponse, error)
 	// ListCars retrieves a list of cars
 	ListCars(context.Context, *ListCarsRequest) (*ListCarsResponse, error)
+	// CreateCarModel adds a model to the tenant's catalog
+	CreateCarModel(context.Context, *CreateCarModelRequest) (*CreateCarModelResponse, error)
+	// UpdateCarModel replaces the attributes of a model of the tenant's catalog
+	UpdateCarModel(context.Context, *UpdateCarModelRequest) (*UpdateCarModelResponse, error)
+	// AssignCarBranches sets the branch a car belongs to and the one it is at
+	AssignCarBranches(context.Context, *AssignCarBranchesRequest) (*AssignCarBranchesResponse, error)
+	// ListCarModels retrieves the tenant's catalog
+	ListCarModels(context.Context, *ListCarModelsRequest) (*ListCarModelsResponse, error)
 }
 
 // UnimplementedCarServiceServer should be embedded to have
@@ -106,6 +166,18 @@ func (UnimplementedCarServiceServer) GetCar(context.Context, *GetCarRequest) (*G
 func (UnimplementedCarServiceServer) ListCars(context.Context, *ListCarsRequest) (*ListCarsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCars not implemented")
 }
+func (UnimplementedCarServiceServer) CreateCarModel(context.Context, *CreateCarModelRequest) (*CreateCarModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCarModel not implemented")
+}
+func (UnimplementedCarServiceServer) UpdateCarModel(context.Context, *UpdateCarModelRequest) (*UpdateCarModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCarModel not implemented")
+}
+func (UnimplementedCarServiceServer) AssignCarBranches(context.Context, *AssignCarBranchesRequest) (*AssignCarBranchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignCarBranches not implemented")
+}
+func (UnimplementedCarServiceServer) ListCarModels(context.Context, *ListCarModelsRequest) (*ListCarModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCarModels not implemented")
+}
 func (UnimplementedCarServiceServer) testEmbeddedByValue() {}
 
 // UnsafeCarServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +252,78 @@ func _CarService_ListCars_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CarService_CreateCarModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCarModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).CreateCarModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarService_CreateCarModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).CreateCarModel(ctx, req.(*CreateCarModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_UpdateCarModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCarModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).UpdateCarModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarService_UpdateCarModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).UpdateCarModel(ctx, req.(*UpdateCarModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_AssignCarBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCarBranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).AssignCarBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarService_AssignCarBranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).AssignCarBranches(ctx, req.(*AssignCarBranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CarService_ListCarModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCarModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CarServiceServer).ListCarModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CarService_ListCarModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CarServiceServer).ListCarModels(ctx, req.(*ListCarModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CarService_ServiceDesc is the grpc.ServiceDesc for CarService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,6 +343,22 @@ var CarService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCars",
 			Handler:    _CarService_ListCars_Handler,
 		},
+		{
+			MethodName: "CreateCarModel",
+			Handler:    _CarService_CreateCarModel_Handler,
+		},
+		{
+			MethodName: "UpdateCarModel",
+			Handler:    _CarService_UpdateCarModel_Handler,
+		},
+		{
+			MethodName: "AssignCarBranches",
+			Handler:    _CarService_AssignCarBranches_Handler,
+		},
+		{
+			MethodName: "ListCarModels",
+			Handler:    _CarService_ListCarModels_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/car/v1/car_service.proto",
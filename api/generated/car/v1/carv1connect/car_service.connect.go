@@ -39,6 +39,18 @@ const (
 	CarServiceGetCarProcedure = "/car.v1.CarService/GetCar"
 	// CarServiceListCarsProcedure is the fully-qualified name of the CarService's ListCars RPC.
 	CarServiceListCarsProcedure = "/car.v1.CarService/ListCars"
+	// CarServiceCreateCarModelProcedure is the fully-qualified name of the CarService's CreateCarModel
+	// RPC.
+	CarServiceCreateCarModelProcedure = "/car.v1.CarService/CreateCarModel"
+	// CarServiceUpdateCarModelProcedure is the fully-qualified name of the CarService's UpdateCarModel
+	// RPC.
+	CarServiceUpdateCarModelProcedure = "/car.v1.CarService/UpdateCarModel"
+	// CarServiceAssignCarBranchesProcedure is the fully-qualified name of the CarService's
+	// AssignCarBranches RPC.
+	CarServiceAssignCarBranchesProcedure = "/car.v1.CarService/AssignCarBranches"
+	// CarServiceListCarModelsProcedure is the fully-qualified name of the CarService's ListCarModels
+	// RPC.
+	CarServiceListCarModelsProcedure = "/car.v1.CarService/ListCarModels"
 )
 
 // CarServiceClient is a client for the car.v1.CarService service.
@@ -49,6 +61,14 @@ type CarServiceClient interface {
 	GetCar(context.Context, *connect.Request[v1.GetCarRequest]) (*connect.Response[v1.GetCarResponse], error)
 	// ListCars retrieves a list of cars
 	ListCars(context.Context, *connect.Request[v1.ListCarsRequest]) (*connect.Response[v1.ListCarsResponse], error)
+	// CreateCarModel adds a model to the tenant's catalog
+	CreateCarModel(context.Context, *connect.Request[v1.CreateCarModelRequest]) (*connect.Response[v1.CreateCarModelResponse], error)
+	// UpdateCarModel replaces the attributes of a model of the tenant's catalog
+	UpdateCarModel(context.Context, *connect.Request[v1.UpdateCarModelRequest]) (*connect.Response[v1.UpdateCarModelResponse], error)
+	// AssignCarBranches sets the branch a car belongs to and the one it is at
+	AssignCarBranches(context.Context, *connect.Request[v1.AssignCarBranchesRequest]) (*connect.Response[v1.AssignCarBranchesResponse], error)
+	// ListCarModels retrieves the tenant's catalog
+	ListCarModels(context.Context, *connect.Request[v1.ListCarModelsRequest]) (*connect.Response[v1.ListCarModelsResponse], error)
 }
 
 // NewCarServiceClient constructs a client for the car.v1.CarService service. By default, it uses
@@ -72,12 +92,39 @@ func NewCarServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			httpClient,
 			baseURL+CarServiceGetCarProcedure,
 			connect.WithSchema(carServiceMethods.ByName("GetCar")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listCars: connect.NewClient[v1.ListCarsRequest, v1.ListCarsResponse](
 			httpClient,
 			baseURL+CarServiceListCarsProcedure,
 			connect.WithSchema(carServiceMethods.ByName("ListCars")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createCarModel: connect.NewClient[v1.CreateCarModelRequest, v1.CreateCarModelResponse](
+			httpClient,
+			baseURL+CarServiceCreateCarModelProcedure,
+			connect.WithSchema(carServiceMethods.ByName("CreateCarModel")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCarModel: connect.NewClient[v1.UpdateCarModelRequest, v1.UpdateCarModelResponse](
+			httpClient,
+			baseURL+CarServiceUpdateCarModelProcedure,
+			connect.WithSchema(carServiceMethods.ByName("UpdateCarModel")),
+			connect.WithClientOptions(opts...),
+		),
+		assignCarBranches: connect.NewClient[v1.AssignCarBranchesRequest, v1.AssignCarBranchesResponse](
+			httpClient,
+			baseURL+CarServiceAssignCarBranchesProcedure,
+			connect.WithSchema(carServiceMethods.ByName("AssignCarBranches")),
+			connect.WithClientOptions(opts...),
+		),
+		listCarModels: connect.NewClient[v1.ListCarModelsRequest, v1.ListCarModelsResponse](
+			httpClient,
+			baseURL+CarServiceListCarModelsProcedure,
+			connect.WithSchema(carServiceMethods.ByName("ListCarModels")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -85,9 +132,13 @@ func NewCarServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 
 // carServiceClient implements CarServiceClient.
 type carServiceClient struct {
-	createCar *connect.Client[v1.CreateCarRequest, v1.CreateCarResponse]
-	getCar    *connect.Client[v1.GetCarRequest, v1.GetCarResponse]
-	listCars  *connect.Client[v1.ListCarsRequest, v1.ListCarsResponse]
+	createCar         *connect.Client[v1.CreateCarRequest, v1.CreateCarResponse]
+	getCar            *connect.Client[v1.GetCarRequest, v1.GetCarResponse]
+	listCars          *connect.Client[v1.ListCarsRequest, v1.ListCarsResponse]
+	createCarModel    *connect.Client[v1.CreateCarModelRequest, v1.CreateCarModelResponse]
+	updateCarModel    *connect.Client[v1.UpdateCarModelRequest, v1.UpdateCarModelResponse]
+	assignCarBranches *connect.Client[v1.AssignCarBranchesRequest, v1.AssignCarBranchesResponse]
+	listCarModels     *connect.Client[v1.ListCarModelsRequest, v1.ListCarModelsResponse]
 }
 
 // CreateCar calls car.v1.CarService.CreateCar.
@@ -105,6 +156,26 @@ func (c *carServiceClient) ListCars(ctx context.Context, req *connect.Request[v1
 	return c.listCars.CallUnary(ctx, req)
 }
 
+// CreateCarModel calls car.v1.CarService.CreateCarModel.
+func (c *carServiceClient) CreateCarModel(ctx context.Context, req *connect.Request[v1.CreateCarModelRequest]) (*connect.Response[v1.CreateCarModelResponse], error) {
+	return c.createCarModel.CallUnary(ctx, req)
+}
+
+// UpdateCarModel calls car.v1.CarService.UpdateCarModel.
+func (c *carServiceClient) UpdateCarModel(ctx context.Context, req *connect.Request[v1.UpdateCarModelRequest]) (*connect.Response[v1.UpdateCarModelResponse], error) {
+	return c.updateCarModel.CallUnary(ctx, req)
+}
+
+// AssignCarBranches calls car.v1.CarService.AssignCarBranches.
+func (c *carServiceClient) AssignCarBranches(ctx context.Context, req *connect.Request[v1.AssignCarBranchesRequest]) (*connect.Response[v1.AssignCarBranchesResponse], error) {
+	return c.assignCarBranches.CallUnary(ctx, req)
+}
+
+// ListCarModels calls car.v1.CarService.ListCarModels.
+func (c *carServiceClient) ListCarModels(ctx context.Context, req *connect.Request[v1.ListCarModelsRequest]) (*connect.Response[v1.ListCarModelsResponse], error) {
+	return c.listCarModels.CallUnary(ctx, req)
+}
+
 // CarServiceHandler is an implementation of the car.v1.CarService service.
 type CarServiceHandler interface {
 	// CreateCar creates a new car
@@ -113,6 +184,14 @@ type CarServiceHandler interface {
 	GetCar(context.Context, *connect.Request[v1.GetCarRequest]) (*connect.Response[v1.GetCarResponse], error)
 	// ListCars retrieves a list of cars
 	ListCars(context.Context, *connect.Request[v1.ListCarsRequest]) (*connect.Response[v1.ListCarsResponse], error)
+	// CreateCarModel adds a model to the tenant's catalog
+	CreateCarModel(context.Context, *connect.Request[v1.CreateCarModelRequest]) (*connect.Response[v1.CreateCarModelResponse], error)
+	// UpdateCarModel replaces the attributes of a model of the tenant's catalog
+	UpdateCarModel(context.Context, *connect.Request[v1.UpdateCarModelRequest]) (*connect.Response[v1.UpdateCarModelResponse], error)
+	// AssignCarBranches sets the branch a car belongs to and the one it is at
+	AssignCarBranches(context.Context, *connect.Request[v1.AssignCarBranchesRequest]) (*connect.Response[v1.AssignCarBranchesResponse], error)
+	// ListCarModels retrieves the tenant's catalog
+	ListCarModels(context.Context, *connect.Request[v1.ListCarModelsRequest]) (*connect.Response[v1.ListCarModelsResponse], error)
 }
 
 // NewCarServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -132,12 +211,39 @@ func NewCarServiceHandler(svc CarServiceHandler, opts ...connect.HandlerOption)
 		CarServiceGetCarProcedure,
 		svc.GetCar,
 		connect.WithSchema(carServiceMethods.ByName("GetCar")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	carServiceListCarsHandler := connect.NewUnaryHandler(
 		CarServiceListCarsProcedure,
 		svc.ListCars,
 		connect.WithSchema(carServiceMethods.ByName("ListCars")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	carServiceCreateCarModelHandler := connect.NewUnaryHandler(
+		CarServiceCreateCarModelProcedure,
+		svc.CreateCarModel,
+		connect.WithSchema(carServiceMethods.ByName("CreateCarModel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	carServiceUpdateCarModelHandler := connect.NewUnaryHandler(
+		CarServiceUpdateCarModelProcedure,
+		svc.UpdateCarModel,
+		connect.WithSchema(carServiceMethods.ByName("UpdateCarModel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	carServiceAssignCarBranchesHandler := connect.NewUnaryHandler(
+		CarServiceAssignCarBranchesProcedure,
+		svc.AssignCarBranches,
+		connect.WithSchema(carServiceMethods.ByName("AssignCarBranches")),
+		connect.WithHandlerOptions(opts...),
+	)
+	carServiceListCarModelsHandler := connect.NewUnaryHandler(
+		CarServiceListCarModelsProcedure,
+		svc.ListCarModels,
+		connect.WithSchema(carServiceMethods.ByName("ListCarModels")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/car.v1.CarService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -148,6 +254,14 @@ func NewCarServiceHandler(svc CarServiceHandler, opts ...connect.HandlerOption)
 			carServiceGetCarHandler.ServeHTTP(w, r)
 		case CarServiceListCarsProcedure:
 			carServiceListCarsHandler.ServeHTTP(w, r)
+		case CarServiceCreateCarModelProcedure:
+			carServiceCreateCarModelHandler.ServeHTTP(w, r)
+		case CarServiceUpdateCarModelProcedure:
+			carServiceUpdateCarModelHandler.ServeHTTP(w, r)
+		case CarServiceAssignCarBranchesProcedure:
+			carServiceAssignCarBranchesHandler.ServeHTTP(w, r)
+		case CarServiceListCarModelsProcedure:
+			carServiceListCarModelsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -168,3 +282,19 @@ func (UnimplementedCarServiceHandler) GetCar(context.Context, *connect.Request[v
 func (UnimplementedCarServiceHandler) ListCars(context.Context, *connect.Request[v1.ListCarsRequest]) (*connect.Response[v1.ListCarsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("car.v1.CarService.ListCars is not implemented"))
 }
+
+func (UnimplementedCarServiceHandler) CreateCarModel(context.Context, *connect.Request[v1.CreateCarModelRequest]) (*connect.Response[v1.CreateCarModelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("car.v1.CarService.CreateCarModel is not implemented"))
+}
+
+func (UnimplementedCarServiceHandler) UpdateCarModel(context.Context, *connect.Request[v1.UpdateCarModelRequest]) (*connect.Response[v1.UpdateCarModelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("car.v1.CarService.UpdateCarModel is not implemented"))
+}
+
+func (UnimplementedCarServiceHandler) AssignCarBranches(context.Context, *connect.Request[v1.AssignCarBranchesRequest]) (*connect.Response[v1.AssignCarBranchesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("car.v1.CarService.AssignCarBranches is not implemented"))
+}
+
+func (UnimplementedCarServiceHandler) ListCarModels(context.Context, *connect.Request[v1.ListCarModelsRequest]) (*connect.Response[v1.ListCarModelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("car.v1.CarService.ListCarModels is not implemented"))
+}
@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/maintenance/v1/maintenance.proto
+
+package maintenancev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MaintenanceType is the kind of work a car is taken out of service for
+type MaintenanceType int32
+
+const (
+	MaintenanceType_MAINTENANCE_TYPE_UNSPECIFIED MaintenanceType = 0
+	MaintenanceType_MAINTENANCE_TYPE_INSPECTION  MaintenanceType = 1
+	MaintenanceType_MAINTENANCE_TYPE_SERVICE     MaintenanceType = 2
+	MaintenanceType_MAINTENANCE_TYPE_REPAIR      MaintenanceType = 3
+	MaintenanceType_MAINTENANCE_TYPE_CLEANING    MaintenanceType = 4
+)
+
+// Enum value maps for MaintenanceType.
+var (
+	MaintenanceType_name = map[int32]string{
+		0: "MAINTENANCE_TYPE_UNSPECIFIED",
+		1: "MAINTENANCE_TYPE_INSPECTION",
+		2: "MAINTENANCE_TYPE_SERVICE",
+		3: "MAINTENANCE_TYPE_REPAIR",
+		4: "MAINTENANCE_TYPE_CLEANING",
+	}
+	MaintenanceType_value = map[string]int32{
+		"MAINTENANCE_TYPE_UNSPECIFIED": 0,
+		"MAINTENANCE_TYPE_INSPECTION":  1,
+		"MAINTENANCE_TYPE_SERVICE":     2,
+		"MAINTENANCE_TYPE_REPAIR":      3,
+		"MAINTENANCE_TYPE_CLEANING":    4,
+	}
+)
+
+func (x MaintenanceType) Enum() *MaintenanceType {
+	p := new(MaintenanceType)
+	*p = x
+	return p
+}
+
+func (x MaintenanceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_maintenance_v1_maintenance_proto_enumTypes[0].Descriptor()
+}
+
+func (MaintenanceType) Type() protoreflect.EnumType {
+	return &file_api_proto_maintenance_v1_maintenance_proto_enumTypes[0]
+}
+
+func (x MaintenanceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceType.Descriptor instead.
+func (MaintenanceType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+// MaintenanceStatus is where a maintenance window is in its lifecycle
+type MaintenanceStatus int32
+
+const (
+	MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED MaintenanceStatus = 0
+	// The car cannot be booked over the window
+	MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED MaintenanceStatus = 1
+	// The work is done. A window completed early ends when it was completed.
+	MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED MaintenanceStatus = 2
+	// The window was called off and no longer blocks the car
+	MaintenanceStatus_MAINTENANCE_STATUS_CANCELED MaintenanceStatus = 3
+)
+
+// Enum value maps for MaintenanceStatus.
+var (
+	MaintenanceStatus_name = map[int32]string{
+		0: "MAINTENANCE_STATUS_UNSPECIFIED",
+		1: "MAINTENANCE_STATUS_SCHEDULED",
+		2: "MAINTENANCE_STATUS_COMPLETED",
+		3: "MAINTENANCE_STATUS_CANCELED",
+	}
+	MaintenanceStatus_value = map[string]int32{
+		"MAINTENANCE_STATUS_UNSPECIFIED": 0,
+		"MAINTENANCE_STATUS_SCHEDULED":   1,
+		"MAINTENANCE_STATUS_COMPLETED":   2,
+		"MAINTENANCE_STATUS_CANCELED":    3,
+	}
+)
+
+func (x MaintenanceStatus) Enum() *MaintenanceStatus {
+	p := new(MaintenanceStatus)
+	*p = x
+	return p
+}
+
+func (x MaintenanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_maintenance_v1_maintenance_proto_enumTypes[1].Descriptor()
+}
+
+func (MaintenanceStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_maintenance_v1_maintenance_proto_enumTypes[1]
+}
+
+func (x MaintenanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceStatus.Descriptor instead.
+func (MaintenanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{1}
+}
+
+// MaintenanceWindow is a period over which a car of a tenant is out of service
+type MaintenanceWindow struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CarId    string                 `protobuf:"bytes,3,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	Type     MaintenanceType        `protobuf:"varint,4,opt,name=type,proto3,enum=maintenance.v1.MaintenanceType" json:"type,omitempty"`
+	Reason   string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Status   MaintenanceStatus      `protobuf:"varint,8,opt,name=status,proto3,enum=maintenance.v1.MaintenanceStatus" json:"status,omitempty"`
+	// Set once the window is completed
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Set once the window is canceled
+	CanceledAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_api_proto_maintenance_v1_maintenance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetType() MaintenanceType {
+	if x != nil {
+		return x.Type
+	}
+	return MaintenanceType_MAINTENANCE_TYPE_UNSPECIFIED
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetStatus() MaintenanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED
+}
+
+func (x *MaintenanceWindow) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetCanceledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CanceledAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_api_proto_maintenance_v1_maintenance_proto protoreflect.FileDescriptor
+
+const file_api_proto_maintenance_v1_maintenance_proto_rawDesc = "" +
+	"\n" +
+	"*api/proto/maintenance/v1/maintenance.proto\x12\x0emaintenance.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbf\x04\n" +
+	"\x11MaintenanceWindow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06car_id\x18\x03 \x01(\tR\x05carId\x123\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.maintenance.v1.MaintenanceTypeR\x04type\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x127\n" +
+	"\tstarts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x129\n" +
+	"\x06status\x18\b \x01(\x0e2!.maintenance.v1.MaintenanceStatusR\x06status\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12;\n" +
+	"\vcanceled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"canceledAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\xae\x01\n" +
+	"\x0fMaintenanceType\x12 \n" +
+	"\x1cMAINTENANCE_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bMAINTENANCE_TYPE_INSPECTION\x10\x01\x12\x1c\n" +
+	"\x18MAINTENANCE_TYPE_SERVICE\x10\x02\x12\x1b\n" +
+	"\x17MAINTENANCE_TYPE_REPAIR\x10\x03\x12\x1d\n" +
+	"\x19MAINTENANCE_TYPE_CLEANING\x10\x04*\x9c\x01\n" +
+	"\x11MaintenanceStatus\x12\"\n" +
+	"\x1eMAINTENANCE_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cMAINTENANCE_STATUS_SCHEDULED\x10\x01\x12 \n" +
+	"\x1cMAINTENANCE_STATUS_COMPLETED\x10\x02\x12\x1f\n" +
+	"\x1bMAINTENANCE_STATUS_CANCELED\x10\x03BQZOgithub.com/jp-ryuji/go-arch-patterns/api/generated/maintenance/v1;maintenancev1b\x06proto3"
+
+var (
+	file_api_proto_maintenance_v1_maintenance_proto_rawDescOnce sync.Once
+	file_api_proto_maintenance_v1_maintenance_proto_rawDescData []byte
+)
+
+func file_api_proto_maintenance_v1_maintenance_proto_rawDescGZIP() []byte {
+	file_api_proto_maintenance_v1_maintenance_proto_rawDescOnce.Do(func() {
+		file_api_proto_maintenance_v1_maintenance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_maintenance_v1_maintenance_proto_rawDesc), len(file_api_proto_maintenance_v1_maintenance_proto_rawDesc)))
+	})
+	return file_api_proto_maintenance_v1_maintenance_proto_rawDescData
+}
+
+var file_api_proto_maintenance_v1_maintenance_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_proto_maintenance_v1_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_proto_maintenance_v1_maintenance_proto_goTypes = []any{
+	(MaintenanceType)(0),          // 0: maintenance.v1.MaintenanceType
+	(MaintenanceStatus)(0),        // 1: maintenance.v1.MaintenanceStatus
+	(*MaintenanceWindow)(nil),     // 2: maintenance.v1.MaintenanceWindow
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_api_proto_maintenance_v1_maintenance_proto_depIdxs = []int32{
+	0, // 0: maintenance.v1.MaintenanceWindow.type:type_name -> maintenance.v1.MaintenanceType
+	3, // 1: maintenance.v1.MaintenanceWindow.starts_at:type_name -> google.protobuf.Timestamp
+	3, // 2: maintenance.v1.MaintenanceWindow.ends_at:type_name -> google.protobuf.Timestamp
+	1, // 3: maintenance.v1.MaintenanceWindow.status:type_name -> maintenance.v1.MaintenanceStatus
+	3, // 4: maintenance.v1.MaintenanceWindow.completed_at:type_name -> google.protobuf.Timestamp
+	3, // 5: maintenance.v1.MaintenanceWindow.canceled_at:type_name -> google.protobuf.Timestamp
+	3, // 6: maintenance.v1.MaintenanceWindow.created_at:type_name -> google.protobuf.Timestamp
+	3, // 7: maintenance.v1.MaintenanceWindow.updated_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_maintenance_v1_maintenance_proto_init() }
+func file_api_proto_maintenance_v1_maintenance_proto_init() {
+	if File_api_proto_maintenance_v1_maintenance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_maintenance_v1_maintenance_proto_rawDesc), len(file_api_proto_maintenance_v1_maintenance_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_maintenance_v1_maintenance_proto_goTypes,
+		DependencyIndexes: file_api_proto_maintenance_v1_maintenance_proto_depIdxs,
+		EnumInfos:         file_api_proto_maintenance_v1_maintenance_proto_enumTypes,
+		MessageInfos:      file_api_proto_maintenance_v1_maintenance_proto_msgTypes,
+	}.Build()
+	File_api_proto_maintenance_v1_maintenance_proto = out.File
+	file_api_proto_maintenance_v1_maintenance_proto_goTypes = nil
+	file_api_proto_maintenance_v1_maintenance_proto_depIdxs = nil
+}
@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/maintenance/v1/maintenance_service.proto
+
+package maintenancev1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScheduleMaintenanceWindowRequest is the request for taking a car out of service
+type ScheduleMaintenanceWindowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	CarId string                 `protobuf:"bytes,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	Type  MaintenanceType        `protobuf:"varint,2,opt,name=type,proto3,enum=maintenance.v1.MaintenanceType" json:"type,omitempty"`
+	// Optional: at most 1000 characters
+	Reason   string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// Must be after starts_at
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMaintenanceWindowRequest) Reset() {
+	*x = ScheduleMaintenanceWindowRequest{}
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ScheduleMaintenanceWindowRequest) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceWindowRequest) GetType() MaintenanceType {
+	if x != nil {
+		return x.Type
+	}
+	return MaintenanceType_MAINTENANCE_TYPE_UNSPECIFIED
+}
+
+func (x *ScheduleMaintenanceWindowRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ScheduleMaintenanceWindowRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ScheduleMaintenanceWindowRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+// ScheduleMaintenanceWindowResponse is the response for taking a car out of service
+type ScheduleMaintenanceWindowResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceWindow *MaintenanceWindow     `protobuf:"bytes,1,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScheduleMaintenanceWindowResponse) Reset() {
+	*x = ScheduleMaintenanceWindowResponse{}
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *ScheduleMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScheduleMaintenanceWindowResponse) GetMaintenanceWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+// CompleteMaintenanceWindowRequest is the request for completing a maintenance window.
+// Only scheduled windows that have started are completed.
+type CompleteMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMaintenanceWindowRequest) Reset() {
+	*x = CompleteMaintenanceWindowRequest{}
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CompleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompleteMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CompleteMaintenanceWindowResponse is the response for completing a maintenance window
+type CompleteMaintenanceWindowResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceWindow *MaintenanceWindow     `protobuf:"bytes,1,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CompleteMaintenanceWindowResponse) Reset() {
+	*x = CompleteMaintenanceWindowResponse{}
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *CompleteMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*CompleteMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompleteMaintenanceWindowResponse) GetMaintenanceWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+// CancelMaintenanceWindowRequest is the request for canceling a maintenance window. Only
+// scheduled windows are canceled.
+type CancelMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMaintenanceWindowRequest) Reset() {
+	*x = CancelMaintenanceWindowRequest{}
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CancelMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CancelMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CancelMaintenanceWindowResponse is the response for canceling a maintenance window
+type CancelMaintenanceWindowResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceWindow *MaintenanceWindow     `protobuf:"bytes,1,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelMaintenanceWindowResponse) Reset() {
+	*x = CancelMaintenanceWindowResponse{}
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *CancelMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*CancelMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelMaintenanceWindowResponse) GetMaintenanceWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+// ListMaintenanceWindowsRequest is the request for listing the maintenance windows of a car
+type ListMaintenanceWindowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CarId         string                 `protobuf:"bytes,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMaintenanceWindowsRequest) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+// ListMaintenanceWindowsResponse is the response for listing the maintenance windows of a
+// car
+type ListMaintenanceWindowsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The earliest first, canceled windows included
+	MaintenanceWindows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListMaintenanceWindowsResponse) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+var File_api_proto_maintenance_v1_maintenance_service_proto protoreflect.FileDescriptor
+
+const file_api_proto_maintenance_v1_maintenance_service_proto_rawDesc = "" +
+	"\n" +
+	"2api/proto/maintenance/v1/maintenance_service.proto\x12\x0emaintenance.v1\x1a*api/proto/maintenance/v1/maintenance.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf4\x01\n" +
+	" ScheduleMaintenanceWindowRequest\x12\x15\n" +
+	"\x06car_id\x18\x01 \x01(\tR\x05carId\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.maintenance.v1.MaintenanceTypeR\x04type\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x127\n" +
+	"\tstarts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"u\n" +
+	"!ScheduleMaintenanceWindowResponse\x12P\n" +
+	"\x12maintenance_window\x18\x01 \x01(\v2!.maintenance.v1.MaintenanceWindowR\x11maintenanceWindow\"2\n" +
+	" CompleteMaintenanceWindowRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"u\n" +
+	"!CompleteMaintenanceWindowResponse\x12P\n" +
+	"\x12maintenance_window\x18\x01 \x01(\v2!.maintenance.v1.MaintenanceWindowR\x11maintenanceWindow\"0\n" +
+	"\x1eCancelMaintenanceWindowRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x1fCancelMaintenanceWindowResponse\x12P\n" +
+	"\x12maintenance_window\x18\x01 \x01(\v2!.maintenance.v1.MaintenanceWindowR\x11maintenanceWindow\"6\n" +
+	"\x1dListMaintenanceWindowsRequest\x12\x15\n" +
+	"\x06car_id\x18\x01 \x01(\tR\x05carId\"t\n" +
+	"\x1eListMaintenanceWindowsResponse\x12R\n" +
+	"\x13maintenance_windows\x18\x01 \x03(\v2!.maintenance.v1.MaintenanceWindowR\x12maintenanceWindows2\xd3\x05\n" +
+	"\x12MaintenanceService\x12\xb1\x01\n" +
+	"\x19ScheduleMaintenanceWindow\x120.maintenance.v1.ScheduleMaintenanceWindowRequest\x1a1.maintenance.v1.ScheduleMaintenanceWindowResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/cars/{car_id}/maintenanceWindows\x12\xb1\x01\n" +
+	"\x19CompleteMaintenanceWindow\x120.maintenance.v1.CompleteMaintenanceWindowRequest\x1a1.maintenance.v1.CompleteMaintenanceWindowResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/maintenanceWindows/{id}:complete\x12\xa9\x01\n" +
+	"\x17CancelMaintenanceWindow\x12..maintenance.v1.CancelMaintenanceWindowRequest\x1a/.maintenance.v1.CancelMaintenanceWindowResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/maintenanceWindows/{id}:cancel\x12\xa8\x01\n" +
+	"\x16ListMaintenanceWindows\x12-.maintenance.v1.ListMaintenanceWindowsRequest\x1a..maintenance.v1.ListMaintenanceWindowsResponse\"/\x82\xd3\xe4\x93\x02&\x12$/v1/cars/{car_id}/maintenanceWindows\x90\x02\x01BQZOgithub.com/jp-ryuji/go-arch-patterns/api/generated/maintenance/v1;maintenancev1b\x06proto3"
+
+var (
+	file_api_proto_maintenance_v1_maintenance_service_proto_rawDescOnce sync.Once
+	file_api_proto_maintenance_v1_maintenance_service_proto_rawDescData []byte
+)
+
+func file_api_proto_maintenance_v1_maintenance_service_proto_rawDescGZIP() []byte {
+	file_api_proto_maintenance_v1_maintenance_service_proto_rawDescOnce.Do(func() {
+		file_api_proto_maintenance_v1_maintenance_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_maintenance_v1_maintenance_service_proto_rawDesc), len(file_api_proto_maintenance_v1_maintenance_service_proto_rawDesc)))
+	})
+	return file_api_proto_maintenance_v1_maintenance_service_proto_rawDescData
+}
+
+var file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_proto_maintenance_v1_maintenance_service_proto_goTypes = []any{
+	(*ScheduleMaintenanceWindowRequest)(nil),  // 0: maintenance.v1.ScheduleMaintenanceWindowRequest
+	(*ScheduleMaintenanceWindowResponse)(nil), // 1: maintenance.v1.ScheduleMaintenanceWindowResponse
+	(*CompleteMaintenanceWindowRequest)(nil),  // 2: maintenance.v1.CompleteMaintenanceWindowRequest
+	(*CompleteMaintenanceWindowResponse)(nil), // 3: maintenance.v1.CompleteMaintenanceWindowResponse
+	(*CancelMaintenanceWindowRequest)(nil),    // 4: maintenance.v1.CancelMaintenanceWindowRequest
+	(*CancelMaintenanceWindowResponse)(nil),   // 5: maintenance.v1.CancelMaintenanceWindowResponse
+	(*ListMaintenanceWindowsRequest)(nil),     // 6: maintenance.v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),    // 7: maintenance.v1.ListMaintenanceWindowsResponse
+	(MaintenanceType)(0),                      // 8: maintenance.v1.MaintenanceType
+	(*timestamppb.Timestamp)(nil),             // 9: google.protobuf.Timestamp
+	(*MaintenanceWindow)(nil),                 // 10: maintenance.v1.MaintenanceWindow
+}
+var file_api_proto_maintenance_v1_maintenance_service_proto_depIdxs = []int32{
+	8,  // 0: maintenance.v1.ScheduleMaintenanceWindowRequest.type:type_name -> maintenance.v1.MaintenanceType
+	9,  // 1: maintenance.v1.ScheduleMaintenanceWindowRequest.starts_at:type_name -> google.protobuf.Timestamp
+	9,  // 2: maintenance.v1.ScheduleMaintenanceWindowRequest.ends_at:type_name -> google.protobuf.Timestamp
+	10, // 3: maintenance.v1.ScheduleMaintenanceWindowResponse.maintenance_window:type_name -> maintenance.v1.MaintenanceWindow
+	10, // 4: maintenance.v1.CompleteMaintenanceWindowResponse.maintenance_window:type_name -> maintenance.v1.MaintenanceWindow
+	10, // 5: maintenance.v1.CancelMaintenanceWindowResponse.maintenance_window:type_name -> maintenance.v1.MaintenanceWindow
+	10, // 6: maintenance.v1.ListMaintenanceWindowsResponse.maintenance_windows:type_name -> maintenance.v1.MaintenanceWindow
+	0,  // 7: maintenance.v1.MaintenanceService.ScheduleMaintenanceWindow:input_type -> maintenance.v1.ScheduleMaintenanceWindowRequest
+	2,  // 8: maintenance.v1.MaintenanceService.CompleteMaintenanceWindow:input_type -> maintenance.v1.CompleteMaintenanceWindowRequest
+	4,  // 9: maintenance.v1.MaintenanceService.CancelMaintenanceWindow:input_type -> maintenance.v1.CancelMaintenanceWindowRequest
+	6,  // 10: maintenance.v1.MaintenanceService.ListMaintenanceWindows:input_type -> maintenance.v1.ListMaintenanceWindowsRequest
+	1,  // 11: maintenance.v1.MaintenanceService.ScheduleMaintenanceWindow:output_type -> maintenance.v1.ScheduleMaintenanceWindowResponse
+	3,  // 12: maintenance.v1.MaintenanceService.CompleteMaintenanceWindow:output_type -> maintenance.v1.CompleteMaintenanceWindowResponse
+	5,  // 13: maintenance.v1.MaintenanceService.CancelMaintenanceWindow:output_type -> maintenance.v1.CancelMaintenanceWindowResponse
+	7,  // 14: maintenance.v1.MaintenanceService.ListMaintenanceWindows:output_type -> maintenance.v1.ListMaintenanceWindowsResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_maintenance_v1_maintenance_service_proto_init() }
+func file_api_proto_maintenance_v1_maintenance_service_proto_init() {
+	if File_api_proto_maintenance_v1_maintenance_service_proto != nil {
+		return
+	}
+	file_api_proto_maintenance_v1_maintenance_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_maintenance_v1_maintenance_service_proto_rawDesc), len(file_api_proto_maintenance_v1_maintenance_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_maintenance_v1_maintenance_service_proto_goTypes,
+		DependencyIndexes: file_api_proto_maintenance_v1_maintenance_service_proto_depIdxs,
+		MessageInfos:      file_api_proto_maintenance_v1_maintenance_service_proto_msgTypes,
+	}.Build()
+	File_api_proto_maintenance_v1_maintenance_service_proto = out.File
+	file_api_proto_maintenance_v1_maintenance_service_proto_goTypes = nil
+	file_api_proto_maintenance_v1_maintenance_service_proto_depIdxs = nil
+}
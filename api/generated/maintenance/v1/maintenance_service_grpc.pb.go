@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/proto/maintenance/v1/maintenance_service.proto
+
+package maintenancev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MaintenanceService_ScheduleMaintenanceWindow_FullMethodName = "/maintenance.v1.MaintenanceService/ScheduleMaintenanceWindow"
+	MaintenanceService_CompleteMaintenanceWindow_FullMethodName = "/maintenance.v1.MaintenanceService/CompleteMaintenanceWindow"
+	MaintenanceService_CancelMaintenanceWindow_FullMethodName   = "/maintenance.v1.MaintenanceService/CancelMaintenanceWindow"
+	MaintenanceService_ListMaintenanceWindows_FullMethodName    = "/maintenance.v1.MaintenanceService/ListMaintenanceWindows"
+)
+
+// MaintenanceServiceClient is the client API for MaintenanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MaintenanceService provides operations for taking the cars of a tenant out of service
+// for maintenance and inspections
+type MaintenanceServiceClient interface {
+	// ScheduleMaintenanceWindow takes a car of the tenant out of service over a
+	// period. A period overlapping rentals of the car is rejected with
+	// FAILED_PRECONDITION, and the IDs of the rentals are listed in a
+	// google.rpc.PreconditionFailure detail.
+	ScheduleMaintenanceWindow(ctx context.Context, in *ScheduleMaintenanceWindowRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceWindowResponse, error)
+	// CompleteMaintenanceWindow records that the work of a maintenance window is done
+	CompleteMaintenanceWindow(ctx context.Context, in *CompleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*CompleteMaintenanceWindowResponse, error)
+	// CancelMaintenanceWindow calls off a maintenance window
+	CancelMaintenanceWindow(ctx context.Context, in *CancelMaintenanceWindowRequest, opts ...grpc.CallOption) (*CancelMaintenanceWindowResponse, error)
+	// ListMaintenanceWindows retrieves the maintenance windows of a car of the tenant
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
+}
+
+type maintenanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMaintenanceServiceClient(cc grpc.ClientConnInterface) MaintenanceServiceClient {
+	return &maintenanceServiceClient{cc}
+}
+
+func (c *maintenanceServiceClient) ScheduleMaintenanceWindow(ctx context.Context, in *ScheduleMaintenanceWindowRequest, opts ...grpc.CallOption) (*ScheduleMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, MaintenanceService_ScheduleMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) CompleteMaintenanceWindow(ctx context.Context, in *CompleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*CompleteMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, MaintenanceService_CompleteMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) CancelMaintenanceWindow(ctx context.Context, in *CancelMaintenanceWindowRequest, opts ...grpc.CallOption) (*CancelMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, MaintenanceService_CancelMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceServiceClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, MaintenanceService_ListMaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MaintenanceServiceServer is the server API for MaintenanceService service.
+// All implementations should embed UnimplementedMaintenanceServiceServer
+// for forward compatibility.
+//
+// MaintenanceService provides operations for taking the cars of a tenant out of service
+// for maintenance and inspections
+type MaintenanceServiceServer interface {
+	// ScheduleMaintenanceWindow takes a car of the tenant out of service over a
+	// period. A period overlapping rentals of the car is rejected with
+	// FAILED_PRECONDITION, and the IDs of the rentals are listed in a
+	// google.rpc.PreconditionFailure detail.
+	ScheduleMaintenanceWindow(context.Context, *ScheduleMaintenanceWindowRequest) (*ScheduleMaintenanceWindowResponse, error)
+	// CompleteMaintenanceWindow records that the work of a maintenance window is done
+	CompleteMaintenanceWindow(context.Context, *CompleteMaintenanceWindowRequest) (*CompleteMaintenanceWindowResponse, error)
+	// CancelMaintenanceWindow calls off a maintenance window
+	CancelMaintenanceWindow(context.Context, *CancelMaintenanceWindowRequest) (*CancelMaintenanceWindowResponse, error)
+	// ListMaintenanceWindows retrieves the maintenance windows of a car of the tenant
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
+}
+
+// UnimplementedMaintenanceServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMaintenanceServiceServer struct{}
+
+func (UnimplementedMaintenanceServiceServer) ScheduleMaintenanceWindow(context.Context, *ScheduleMaintenanceWindowRequest) (*ScheduleMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaintenanceWindow not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) CompleteMaintenanceWindow(context.Context, *CompleteMaintenanceWindowRequest) (*CompleteMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMaintenanceWindow not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) CancelMaintenanceWindow(context.Context, *CancelMaintenanceWindowRequest) (*CancelMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMaintenanceWindow not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedMaintenanceServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMaintenanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MaintenanceServiceServer will
+// result in compilation errors.
+type UnsafeMaintenanceServiceServer interface {
+	mustEmbedUnimplementedMaintenanceServiceServer()
+}
+
+func RegisterMaintenanceServiceServer(s grpc.ServiceRegistrar, srv MaintenanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMaintenanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MaintenanceService_ServiceDesc, srv)
+}
+
+func _MaintenanceService_ScheduleMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).ScheduleMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_ScheduleMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).ScheduleMaintenanceWindow(ctx, req.(*ScheduleMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_CompleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).CompleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_CompleteMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).CompleteMaintenanceWindow(ctx, req.(*CompleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_CancelMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).CancelMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_CancelMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).CancelMaintenanceWindow(ctx, req.(*CancelMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceService_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServiceServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceService_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServiceServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MaintenanceService_ServiceDesc is the grpc.ServiceDesc for MaintenanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MaintenanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "maintenance.v1.MaintenanceService",
+	HandlerType: (*MaintenanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleMaintenanceWindow",
+			Handler:    _MaintenanceService_ScheduleMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "CompleteMaintenanceWindow",
+			Handler:    _MaintenanceService_CompleteMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "CancelMaintenanceWindow",
+			Handler:    _MaintenanceService_CancelMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _MaintenanceService_ListMaintenanceWindows_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/maintenance/v1/maintenance_service.proto",
+}
@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/proto/maintenance/v1/maintenance_service.proto
+
+package maintenancev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/maintenance/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MaintenanceServiceName is the fully-qualified name of the MaintenanceService service.
+	MaintenanceServiceName = "maintenance.v1.MaintenanceService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MaintenanceServiceScheduleMaintenanceWindowProcedure is the fully-qualified name of the
+	// MaintenanceService's ScheduleMaintenanceWindow RPC.
+	MaintenanceServiceScheduleMaintenanceWindowProcedure = "/maintenance.v1.MaintenanceService/ScheduleMaintenanceWindow"
+	// MaintenanceServiceCompleteMaintenanceWindowProcedure is the fully-qualified name of the
+	// MaintenanceService's CompleteMaintenanceWindow RPC.
+	MaintenanceServiceCompleteMaintenanceWindowProcedure = "/maintenance.v1.MaintenanceService/CompleteMaintenanceWindow"
+	// MaintenanceServiceCancelMaintenanceWindowProcedure is the fully-qualified name of the
+	// MaintenanceService's CancelMaintenanceWindow RPC.
+	MaintenanceServiceCancelMaintenanceWindowProcedure = "/maintenance.v1.MaintenanceService/CancelMaintenanceWindow"
+	// MaintenanceServiceListMaintenanceWindowsProcedure is the fully-qualified name of the
+	// MaintenanceService's ListMaintenanceWindows RPC.
+	MaintenanceServiceListMaintenanceWindowsProcedure = "/maintenance.v1.MaintenanceService/ListMaintenanceWindows"
+)
+
+// MaintenanceServiceClient is a client for the maintenance.v1.MaintenanceService service.
+type MaintenanceServiceClient interface {
+	// ScheduleMaintenanceWindow takes a car of the tenant out of service over a
+	// period. A period overlapping rentals of the car is rejected with
+	// FAILED_PRECONDITION, and the IDs of the rentals are listed in a
+	// google.rpc.PreconditionFailure detail.
+	ScheduleMaintenanceWindow(context.Context, *connect.Request[v1.ScheduleMaintenanceWindowRequest]) (*connect.Response[v1.ScheduleMaintenanceWindowResponse], error)
+	// CompleteMaintenanceWindow records that the work of a maintenance window is done
+	CompleteMaintenanceWindow(context.Context, *connect.Request[v1.CompleteMaintenanceWindowRequest]) (*connect.Response[v1.CompleteMaintenanceWindowResponse], error)
+	// CancelMaintenanceWindow calls off a maintenance window
+	CancelMaintenanceWindow(context.Context, *connect.Request[v1.CancelMaintenanceWindowRequest]) (*connect.Response[v1.CancelMaintenanceWindowResponse], error)
+	// ListMaintenanceWindows retrieves the maintenance windows of a car of the tenant
+	ListMaintenanceWindows(context.Context, *connect.Request[v1.ListMaintenanceWindowsRequest]) (*connect.Response[v1.ListMaintenanceWindowsResponse], error)
+}
+
+// NewMaintenanceServiceClient constructs a client for the maintenance.v1.MaintenanceService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMaintenanceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MaintenanceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	maintenanceServiceMethods := v1.File_api_proto_maintenance_v1_maintenance_service_proto.Services().ByName("MaintenanceService").Methods()
+	return &maintenanceServiceClient{
+		scheduleMaintenanceWindow: connect.NewClient[v1.ScheduleMaintenanceWindowRequest, v1.ScheduleMaintenanceWindowResponse](
+			httpClient,
+			baseURL+MaintenanceServiceScheduleMaintenanceWindowProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("ScheduleMaintenanceWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		completeMaintenanceWindow: connect.NewClient[v1.CompleteMaintenanceWindowRequest, v1.CompleteMaintenanceWindowResponse](
+			httpClient,
+			baseURL+MaintenanceServiceCompleteMaintenanceWindowProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("CompleteMaintenanceWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelMaintenanceWindow: connect.NewClient[v1.CancelMaintenanceWindowRequest, v1.CancelMaintenanceWindowResponse](
+			httpClient,
+			baseURL+MaintenanceServiceCancelMaintenanceWindowProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("CancelMaintenanceWindow")),
+			connect.WithClientOptions(opts...),
+		),
+		listMaintenanceWindows: connect.NewClient[v1.ListMaintenanceWindowsRequest, v1.ListMaintenanceWindowsResponse](
+			httpClient,
+			baseURL+MaintenanceServiceListMaintenanceWindowsProcedure,
+			connect.WithSchema(maintenanceServiceMethods.ByName("ListMaintenanceWindows")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// maintenanceServiceClient implements MaintenanceServiceClient.
+type maintenanceServiceClient struct {
+	scheduleMaintenanceWindow *connect.Client[v1.ScheduleMaintenanceWindowRequest, v1.ScheduleMaintenanceWindowResponse]
+	completeMaintenanceWindow *connect.Client[v1.CompleteMaintenanceWindowRequest, v1.CompleteMaintenanceWindowResponse]
+	cancelMaintenanceWindow   *connect.Client[v1.CancelMaintenanceWindowRequest, v1.CancelMaintenanceWindowResponse]
+	listMaintenanceWindows    *connect.Client[v1.ListMaintenanceWindowsRequest, v1.ListMaintenanceWindowsResponse]
+}
+
+// ScheduleMaintenanceWindow calls maintenance.v1.MaintenanceService.ScheduleMaintenanceWindow.
+func (c *maintenanceServiceClient) ScheduleMaintenanceWindow(ctx context.Context, req *connect.Request[v1.ScheduleMaintenanceWindowRequest]) (*connect.Response[v1.ScheduleMaintenanceWindowResponse], error) {
+	return c.scheduleMaintenanceWindow.CallUnary(ctx, req)
+}
+
+// CompleteMaintenanceWindow calls maintenance.v1.MaintenanceService.CompleteMaintenanceWindow.
+func (c *maintenanceServiceClient) CompleteMaintenanceWindow(ctx context.Context, req *connect.Request[v1.CompleteMaintenanceWindowRequest]) (*connect.Response[v1.CompleteMaintenanceWindowResponse], error) {
+	return c.completeMaintenanceWindow.CallUnary(ctx, req)
+}
+
+// CancelMaintenanceWindow calls maintenance.v1.MaintenanceService.CancelMaintenanceWindow.
+func (c *maintenanceServiceClient) CancelMaintenanceWindow(ctx context.Context, req *connect.Request[v1.CancelMaintenanceWindowRequest]) (*connect.Response[v1.CancelMaintenanceWindowResponse], error) {
+	return c.cancelMaintenanceWindow.CallUnary(ctx, req)
+}
+
+// ListMaintenanceWindows calls maintenance.v1.MaintenanceService.ListMaintenanceWindows.
+func (c *maintenanceServiceClient) ListMaintenanceWindows(ctx context.Context, req *connect.Request[v1.ListMaintenanceWindowsRequest]) (*connect.Response[v1.ListMaintenanceWindowsResponse], error) {
+	return c.listMaintenanceWindows.CallUnary(ctx, req)
+}
+
+// MaintenanceServiceHandler is an implementation of the maintenance.v1.MaintenanceService service.
+type MaintenanceServiceHandler interface {
+	// ScheduleMaintenanceWindow takes a car of the tenant out of service over a
+	// period. A period overlapping rentals of the car is rejected with
+	// FAILED_PRECONDITION, and the IDs of the rentals are listed in a
+	// google.rpc.PreconditionFailure detail.
+	ScheduleMaintenanceWindow(context.Context, *connect.Request[v1.ScheduleMaintenanceWindowRequest]) (*connect.Response[v1.ScheduleMaintenanceWindowResponse], error)
+	// CompleteMaintenanceWindow records that the work of a maintenance window is done
+	CompleteMaintenanceWindow(context.Context, *connect.Request[v1.CompleteMaintenanceWindowRequest]) (*connect.Response[v1.CompleteMaintenanceWindowResponse], error)
+	// CancelMaintenanceWindow calls off a maintenance window
+	CancelMaintenanceWindow(context.Context, *connect.Request[v1.CancelMaintenanceWindowRequest]) (*connect.Response[v1.CancelMaintenanceWindowResponse], error)
+	// ListMaintenanceWindows retrieves the maintenance windows of a car of the tenant
+	ListMaintenanceWindows(context.Context, *connect.Request[v1.ListMaintenanceWindowsRequest]) (*connect.Response[v1.ListMaintenanceWindowsResponse], error)
+}
+
+// NewMaintenanceServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMaintenanceServiceHandler(svc MaintenanceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	maintenanceServiceMethods := v1.File_api_proto_maintenance_v1_maintenance_service_proto.Services().ByName("MaintenanceService").Methods()
+	maintenanceServiceScheduleMaintenanceWindowHandler := connect.NewUnaryHandler(
+		MaintenanceServiceScheduleMaintenanceWindowProcedure,
+		svc.ScheduleMaintenanceWindow,
+		connect.WithSchema(maintenanceServiceMethods.ByName("ScheduleMaintenanceWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceCompleteMaintenanceWindowHandler := connect.NewUnaryHandler(
+		MaintenanceServiceCompleteMaintenanceWindowProcedure,
+		svc.CompleteMaintenanceWindow,
+		connect.WithSchema(maintenanceServiceMethods.ByName("CompleteMaintenanceWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceCancelMaintenanceWindowHandler := connect.NewUnaryHandler(
+		MaintenanceServiceCancelMaintenanceWindowProcedure,
+		svc.CancelMaintenanceWindow,
+		connect.WithSchema(maintenanceServiceMethods.ByName("CancelMaintenanceWindow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	maintenanceServiceListMaintenanceWindowsHandler := connect.NewUnaryHandler(
+		MaintenanceServiceListMaintenanceWindowsProcedure,
+		svc.ListMaintenanceWindows,
+		connect.WithSchema(maintenanceServiceMethods.ByName("ListMaintenanceWindows")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/maintenance.v1.MaintenanceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MaintenanceServiceScheduleMaintenanceWindowProcedure:
+			maintenanceServiceScheduleMaintenanceWindowHandler.ServeHTTP(w, r)
+		case MaintenanceServiceCompleteMaintenanceWindowProcedure:
+			maintenanceServiceCompleteMaintenanceWindowHandler.ServeHTTP(w, r)
+		case MaintenanceServiceCancelMaintenanceWindowProcedure:
+			maintenanceServiceCancelMaintenanceWindowHandler.ServeHTTP(w, r)
+		case MaintenanceServiceListMaintenanceWindowsProcedure:
+			maintenanceServiceListMaintenanceWindowsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMaintenanceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMaintenanceServiceHandler struct{}
+
+func (UnimplementedMaintenanceServiceHandler) ScheduleMaintenanceWindow(context.Context, *connect.Request[v1.ScheduleMaintenanceWindowRequest]) (*connect.Response[v1.ScheduleMaintenanceWindowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("maintenance.v1.MaintenanceService.ScheduleMaintenanceWindow is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) CompleteMaintenanceWindow(context.Context, *connect.Request[v1.CompleteMaintenanceWindowRequest]) (*connect.Response[v1.CompleteMaintenanceWindowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("maintenance.v1.MaintenanceService.CompleteMaintenanceWindow is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) CancelMaintenanceWindow(context.Context, *connect.Request[v1.CancelMaintenanceWindowRequest]) (*connect.Response[v1.CancelMaintenanceWindowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("maintenance.v1.MaintenanceService.CancelMaintenanceWindow is not implemented"))
+}
+
+func (UnimplementedMaintenanceServiceHandler) ListMaintenanceWindows(context.Context, *connect.Request[v1.ListMaintenanceWindowsRequest]) (*connect.Response[v1.ListMaintenanceWindowsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("maintenance.v1.MaintenanceService.ListMaintenanceWindows is not implemented"))
+}
@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/metering/v1/metering.proto
+
+package meteringv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UsagePeriod is the length of the periods usage is rolled up over
+type UsagePeriod int32
+
+const (
+	UsagePeriod_USAGE_PERIOD_UNSPECIFIED UsagePeriod = 0
+	// Calendar days in UTC
+	UsagePeriod_USAGE_PERIOD_DAY UsagePeriod = 1
+	// Calendar months in UTC
+	UsagePeriod_USAGE_PERIOD_MONTH UsagePeriod = 2
+)
+
+// Enum value maps for UsagePeriod.
+var (
+	UsagePeriod_name = map[int32]string{
+		0: "USAGE_PERIOD_UNSPECIFIED",
+		1: "USAGE_PERIOD_DAY",
+		2: "USAGE_PERIOD_MONTH",
+	}
+	UsagePeriod_value = map[string]int32{
+		"USAGE_PERIOD_UNSPECIFIED": 0,
+		"USAGE_PERIOD_DAY":         1,
+		"USAGE_PERIOD_MONTH":       2,
+	}
+)
+
+func (x UsagePeriod) Enum() *UsagePeriod {
+	p := new(UsagePeriod)
+	*p = x
+	return p
+}
+
+func (x UsagePeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UsagePeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_metering_v1_metering_proto_enumTypes[0].Descriptor()
+}
+
+func (UsagePeriod) Type() protoreflect.EnumType {
+	return &file_api_proto_metering_v1_metering_proto_enumTypes[0]
+}
+
+func (x UsagePeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UsagePeriod.Descriptor instead.
+func (UsagePeriod) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_metering_v1_metering_proto_rawDescGZIP(), []int{0}
+}
+
+// UsageRollup is the usage of a meter by a tenant over a day or a month
+type UsageRollup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "active_cars", "rentals_created" or "api_calls"
+	Meter       string                 `protobuf:"bytes,1,opt,name=meter,proto3" json:"meter,omitempty"`
+	Period      UsagePeriod            `protobuf:"varint,2,opt,name=period,proto3,enum=metering.v1.UsagePeriod" json:"period,omitempty"`
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// The peak of the period for active_cars, the sum of the period otherwise
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageRollup) Reset() {
+	*x = UsageRollup{}
+	mi := &file_api_proto_metering_v1_metering_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRollup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRollup) ProtoMessage() {}
+
+func (x *UsageRollup) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_metering_v1_metering_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRollup.ProtoReflect.Descriptor instead.
+func (*UsageRollup) Descriptor() ([]byte, []int) {
+	return file_api_proto_metering_v1_metering_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UsageRollup) GetMeter() string {
+	if x != nil {
+		return x.Meter
+	}
+	return ""
+}
+
+func (x *UsageRollup) GetPeriod() UsagePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return UsagePeriod_USAGE_PERIOD_UNSPECIFIED
+}
+
+func (x *UsageRollup) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *UsageRollup) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *UsageRollup) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_api_proto_metering_v1_metering_proto protoreflect.FileDescriptor
+
+const file_api_proto_metering_v1_metering_proto_rawDesc = "" +
+	"\n" +
+	"$api/proto/metering/v1/metering.proto\x12\vmetering.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xeb\x01\n" +
+	"\vUsageRollup\x12\x14\n" +
+	"\x05meter\x18\x01 \x01(\tR\x05meter\x120\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x18.metering.v1.UsagePeriodR\x06period\x12=\n" +
+	"\fperiod_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*Y\n" +
+	"\vUsagePeriod\x12\x1c\n" +
+	"\x18USAGE_PERIOD_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10USAGE_PERIOD_DAY\x10\x01\x12\x16\n" +
+	"\x12USAGE_PERIOD_MONTH\x10\x02BKZIgithub.com/jp-ryuji/go-arch-patterns/api/generated/metering/v1;meteringv1b\x06proto3"
+
+var (
+	file_api_proto_metering_v1_metering_proto_rawDescOnce sync.Once
+	file_api_proto_metering_v1_metering_proto_rawDescData []byte
+)
+
+func file_api_proto_metering_v1_metering_proto_rawDescGZIP() []byte {
+	file_api_proto_metering_v1_metering_proto_rawDescOnce.Do(func() {
+		file_api_proto_metering_v1_metering_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_metering_v1_metering_proto_rawDesc), len(file_api_proto_metering_v1_metering_proto_rawDesc)))
+	})
+	return file_api_proto_metering_v1_metering_proto_rawDescData
+}
+
+var file_api_proto_metering_v1_metering_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_metering_v1_metering_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_proto_metering_v1_metering_proto_goTypes = []any{
+	(UsagePeriod)(0),              // 0: metering.v1.UsagePeriod
+	(*UsageRollup)(nil),           // 1: metering.v1.UsageRollup
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_api_proto_metering_v1_metering_proto_depIdxs = []int32{
+	0, // 0: metering.v1.UsageRollup.period:type_name -> metering.v1.UsagePeriod
+	2, // 1: metering.v1.UsageRollup.period_start:type_name -> google.protobuf.Timestamp
+	2, // 2: metering.v1.UsageRollup.updated_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_metering_v1_metering_proto_init() }
+func file_api_proto_metering_v1_metering_proto_init() {
+	if File_api_proto_metering_v1_metering_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_metering_v1_metering_proto_rawDesc), len(file_api_proto_metering_v1_metering_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_metering_v1_metering_proto_goTypes,
+		DependencyIndexes: file_api_proto_metering_v1_metering_proto_depIdxs,
+		EnumInfos:         file_api_proto_metering_v1_metering_proto_enumTypes,
+		MessageInfos:      file_api_proto_metering_v1_metering_proto_msgTypes,
+	}.Build()
+	File_api_proto_metering_v1_metering_proto = out.File
+	file_api_proto_metering_v1_metering_proto_goTypes = nil
+	file_api_proto_metering_v1_metering_proto_depIdxs = nil
+}
@@ -0,0 +1,340 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/metering/v1/metering_service.proto
+
+package meteringv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetUsageRequest is the request for retrieving the metered usage of a tenant
+type GetUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Optional: defaults to days
+	Period UsagePeriod `protobuf:"varint,2,opt,name=period,proto3,enum=metering.v1.UsagePeriod" json:"period,omitempty"`
+	// Optional: the rollups of the periods starting in [from, to) are returned;
+	// both default to the bounds of the current UTC month
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_api_proto_metering_v1_metering_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_metering_v1_metering_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_metering_v1_metering_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetUsageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetPeriod() UsagePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return UsagePeriod_USAGE_PERIOD_UNSPECIFIED
+}
+
+func (x *GetUsageRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetUsageRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+// GetUsageResponse is the response for retrieving the metered usage of a tenant
+type GetUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by period start and meter; periods without usage are left out
+	Rollups       []*UsageRollup `protobuf:"bytes,1,rep,name=rollups,proto3" json:"rollups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_api_proto_metering_v1_metering_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_metering_v1_metering_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_metering_v1_metering_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetUsageResponse) GetRollups() []*UsageRollup {
+	if x != nil {
+		return x.Rollups
+	}
+	return nil
+}
+
+// ExportUsageStatementRequest is the request for exporting a monthly usage statement
+type ExportUsageStatementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Optional: the UTC month as "YYYY-MM"; defaults to the current month
+	Month         string `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsageStatementRequest) Reset() {
+	*x = ExportUsageStatementRequest{}
+	mi := &file_api_proto_metering_v1_metering_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsageStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsageStatementRequest) ProtoMessage() {}
+
+func (x *ExportUsageStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_metering_v1_metering_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsageStatementRequest.ProtoReflect.Descriptor instead.
+func (*ExportUsageStatementRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_metering_v1_metering_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportUsageStatementRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ExportUsageStatementRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+// ExportUsageStatementResponse is the response for exporting a monthly usage statement
+type ExportUsageStatementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. "usage-acme-2026-10.csv"
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// One row per day with a column per meter, then a total row
+	Csv           []byte `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUsageStatementResponse) Reset() {
+	*x = ExportUsageStatementResponse{}
+	mi := &file_api_proto_metering_v1_metering_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUsageStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsageStatementResponse) ProtoMessage() {}
+
+func (x *ExportUsageStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_metering_v1_metering_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsageStatementResponse.ProtoReflect.Descriptor instead.
+func (*ExportUsageStatementResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_metering_v1_metering_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportUsageStatementResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportUsageStatementResponse) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+var File_api_proto_metering_v1_metering_service_proto protoreflect.FileDescriptor
+
+const file_api_proto_metering_v1_metering_service_proto_rawDesc = "" +
+	"\n" +
+	",api/proto/metering/v1/metering_service.proto\x12\vmetering.v1\x1a$api/proto/metering/v1/metering.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\x01\n" +
+	"\x0fGetUsageRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x120\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x18.metering.v1.UsagePeriodR\x06period\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"F\n" +
+	"\x10GetUsageResponse\x122\n" +
+	"\arollups\x18\x01 \x03(\v2\x18.metering.v1.UsageRollupR\arollups\"P\n" +
+	"\x1bExportUsageStatementRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\"L\n" +
+	"\x1cExportUsageStatementResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv2\x93\x02\n" +
+	"\x0fMeteringService\x12f\n" +
+	"\bGetUsage\x12\x1c.metering.v1.GetUsageRequest\x1a\x1d.metering.v1.GetUsageResponse\"\x1d\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/metering/usage\x90\x02\x01\x12\x97\x01\n" +
+	"\x14ExportUsageStatement\x12(.metering.v1.ExportUsageStatementRequest\x1a).metering.v1.ExportUsageStatementResponse\"*\x82\xd3\xe4\x93\x02!\x12\x1f/v1/metering/statements/{month}\x90\x02\x01BKZIgithub.com/jp-ryuji/go-arch-patterns/api/generated/metering/v1;meteringv1b\x06proto3"
+
+var (
+	file_api_proto_metering_v1_metering_service_proto_rawDescOnce sync.Once
+	file_api_proto_metering_v1_metering_service_proto_rawDescData []byte
+)
+
+func file_api_proto_metering_v1_metering_service_proto_rawDescGZIP() []byte {
+	file_api_proto_metering_v1_metering_service_proto_rawDescOnce.Do(func() {
+		file_api_proto_metering_v1_metering_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_metering_v1_metering_service_proto_rawDesc), len(file_api_proto_metering_v1_metering_service_proto_rawDesc)))
+	})
+	return file_api_proto_metering_v1_metering_service_proto_rawDescData
+}
+
+var file_api_proto_metering_v1_metering_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_proto_metering_v1_metering_service_proto_goTypes = []any{
+	(*GetUsageRequest)(nil),              // 0: metering.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),             // 1: metering.v1.GetUsageResponse
+	(*ExportUsageStatementRequest)(nil),  // 2: metering.v1.ExportUsageStatementRequest
+	(*ExportUsageStatementResponse)(nil), // 3: metering.v1.ExportUsageStatementResponse
+	(UsagePeriod)(0),                     // 4: metering.v1.UsagePeriod
+	(*timestamppb.Timestamp)(nil),        // 5: google.protobuf.Timestamp
+	(*UsageRollup)(nil),                  // 6: metering.v1.UsageRollup
+}
+var file_api_proto_metering_v1_metering_service_proto_depIdxs = []int32{
+	4, // 0: metering.v1.GetUsageRequest.period:type_name -> metering.v1.UsagePeriod
+	5, // 1: metering.v1.GetUsageRequest.from:type_name -> google.protobuf.Timestamp
+	5, // 2: metering.v1.GetUsageRequest.to:type_name -> google.protobuf.Timestamp
+	6, // 3: metering.v1.GetUsageResponse.rollups:type_name -> metering.v1.UsageRollup
+	0, // 4: metering.v1.MeteringService.GetUsage:input_type -> metering.v1.GetUsageRequest
+	2, // 5: metering.v1.MeteringService.ExportUsageStatement:input_type -> metering.v1.ExportUsageStatementRequest
+	1, // 6: metering.v1.MeteringService.GetUsage:output_type -> metering.v1.GetUsageResponse
+	3, // 7: metering.v1.MeteringService.ExportUsageStatement:output_type -> metering.v1.ExportUsageStatementResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_metering_v1_metering_service_proto_init() }
+func file_api_proto_metering_v1_metering_service_proto_init() {
+	if File_api_proto_metering_v1_metering_service_proto != nil {
+		return
+	}
+	file_api_proto_metering_v1_metering_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_metering_v1_metering_service_proto_rawDesc), len(file_api_proto_metering_v1_metering_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_metering_v1_metering_service_proto_goTypes,
+		DependencyIndexes: file_api_proto_metering_v1_metering_service_proto_depIdxs,
+		MessageInfos:      file_api_proto_metering_v1_metering_service_proto_msgTypes,
+	}.Build()
+	File_api_proto_metering_v1_metering_service_proto = out.File
+	file_api_proto_metering_v1_metering_service_proto_goTypes = nil
+	file_api_proto_metering_v1_metering_service_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/proto/metering/v1/metering_service.proto
+
+package meteringv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MeteringService_GetUsage_FullMethodName             = "/metering.v1.MeteringService/GetUsage"
+	MeteringService_ExportUsageStatement_FullMethodName = "/metering.v1.MeteringService/ExportUsageStatement"
+)
+
+// MeteringServiceClient is the client API for MeteringService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MeteringService provides the metered usage a tenant is billed on
+type MeteringServiceClient interface {
+	// GetUsage retrieves the daily or monthly usage rollups of the tenant
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	// ExportUsageStatement exports the daily usage of the tenant over a month as CSV
+	ExportUsageStatement(ctx context.Context, in *ExportUsageStatementRequest, opts ...grpc.CallOption) (*ExportUsageStatementResponse, error)
+}
+
+type meteringServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMeteringServiceClient(cc grpc.ClientConnInterface) MeteringServiceClient {
+	return &meteringServiceClient{cc}
+}
+
+func (c *meteringServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, MeteringService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meteringServiceClient) ExportUsageStatement(ctx context.Context, in *ExportUsageStatementRequest, opts ...grpc.CallOption) (*ExportUsageStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUsageStatementResponse)
+	err := c.cc.Invoke(ctx, MeteringService_ExportUsageStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MeteringServiceServer is the server API for MeteringService service.
+// All implementations should embed UnimplementedMeteringServiceServer
+// for forward compatibility.
+//
+// MeteringService provides the metered usage a tenant is billed on
+type MeteringServiceServer interface {
+	// GetUsage retrieves the daily or monthly usage rollups of the tenant
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	// ExportUsageStatement exports the daily usage of the tenant over a month as CSV
+	ExportUsageStatement(context.Context, *ExportUsageStatementRequest) (*ExportUsageStatementResponse, error)
+}
+
+// UnimplementedMeteringServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMeteringServiceServer struct{}
+
+func (UnimplementedMeteringServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedMeteringServiceServer) ExportUsageStatement(context.Context, *ExportUsageStatementRequest) (*ExportUsageStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUsageStatement not implemented")
+}
+func (UnimplementedMeteringServiceServer) testEmbeddedByValue() {}
+
+// UnsafeMeteringServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MeteringServiceServer will
+// result in compilation errors.
+type UnsafeMeteringServiceServer interface {
+	mustEmbedUnimplementedMeteringServiceServer()
+}
+
+func RegisterMeteringServiceServer(s grpc.ServiceRegistrar, srv MeteringServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMeteringServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MeteringService_ServiceDesc, srv)
+}
+
+func _MeteringService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeteringServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeteringService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeteringServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MeteringService_ExportUsageStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUsageStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeteringServiceServer).ExportUsageStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MeteringService_ExportUsageStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeteringServiceServer).ExportUsageStatement(ctx, req.(*ExportUsageStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MeteringService_ServiceDesc is the grpc.ServiceDesc for MeteringService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MeteringService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "metering.v1.MeteringService",
+	HandlerType: (*MeteringServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUsage",
+			Handler:    _MeteringService_GetUsage_Handler,
+		},
+		{
+			MethodName: "ExportUsageStatement",
+			Handler:    _MeteringService_ExportUsageStatement_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/metering/v1/metering_service.proto",
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/proto/metering/v1/metering_service.proto
+
+package meteringv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/metering/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// MeteringServiceName is the fully-qualified name of the MeteringService service.
+	MeteringServiceName = "metering.v1.MeteringService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// MeteringServiceGetUsageProcedure is the fully-qualified name of the MeteringService's GetUsage
+	// RPC.
+	MeteringServiceGetUsageProcedure = "/metering.v1.MeteringService/GetUsage"
+	// MeteringServiceExportUsageStatementProcedure is the fully-qualified name of the MeteringService's
+	// ExportUsageStatement RPC.
+	MeteringServiceExportUsageStatementProcedure = "/metering.v1.MeteringService/ExportUsageStatement"
+)
+
+// MeteringServiceClient is a client for the metering.v1.MeteringService service.
+type MeteringServiceClient interface {
+	// GetUsage retrieves the daily or monthly usage rollups of the tenant
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+	// ExportUsageStatement exports the daily usage of the tenant over a month as CSV
+	ExportUsageStatement(context.Context, *connect.Request[v1.ExportUsageStatementRequest]) (*connect.Response[v1.ExportUsageStatementResponse], error)
+}
+
+// NewMeteringServiceClient constructs a client for the metering.v1.MeteringService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMeteringServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MeteringServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	meteringServiceMethods := v1.File_api_proto_metering_v1_metering_service_proto.Services().ByName("MeteringService").Methods()
+	return &meteringServiceClient{
+		getUsage: connect.NewClient[v1.GetUsageRequest, v1.GetUsageResponse](
+			httpClient,
+			baseURL+MeteringServiceGetUsageProcedure,
+			connect.WithSchema(meteringServiceMethods.ByName("GetUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		exportUsageStatement: connect.NewClient[v1.ExportUsageStatementRequest, v1.ExportUsageStatementResponse](
+			httpClient,
+			baseURL+MeteringServiceExportUsageStatementProcedure,
+			connect.WithSchema(meteringServiceMethods.ByName("ExportUsageStatement")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// meteringServiceClient implements MeteringServiceClient.
+type meteringServiceClient struct {
+	getUsage             *connect.Client[v1.GetUsageRequest, v1.GetUsageResponse]
+	exportUsageStatement *connect.Client[v1.ExportUsageStatementRequest, v1.ExportUsageStatementResponse]
+}
+
+// GetUsage calls metering.v1.MeteringService.GetUsage.
+func (c *meteringServiceClient) GetUsage(ctx context.Context, req *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
+// ExportUsageStatement calls metering.v1.MeteringService.ExportUsageStatement.
+func (c *meteringServiceClient) ExportUsageStatement(ctx context.Context, req *connect.Request[v1.ExportUsageStatementRequest]) (*connect.Response[v1.ExportUsageStatementResponse], error) {
+	return c.exportUsageStatement.CallUnary(ctx, req)
+}
+
+// MeteringServiceHandler is an implementation of the metering.v1.MeteringService service.
+type MeteringServiceHandler interface {
+	// GetUsage retrieves the daily or monthly usage rollups of the tenant
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+	// ExportUsageStatement exports the daily usage of the tenant over a month as CSV
+	ExportUsageStatement(context.Context, *connect.Request[v1.ExportUsageStatementRequest]) (*connect.Response[v1.ExportUsageStatementResponse], error)
+}
+
+// NewMeteringServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMeteringServiceHandler(svc MeteringServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	meteringServiceMethods := v1.File_api_proto_metering_v1_metering_service_proto.Services().ByName("MeteringService").Methods()
+	meteringServiceGetUsageHandler := connect.NewUnaryHandler(
+		MeteringServiceGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(meteringServiceMethods.ByName("GetUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	meteringServiceExportUsageStatementHandler := connect.NewUnaryHandler(
+		MeteringServiceExportUsageStatementProcedure,
+		svc.ExportUsageStatement,
+		connect.WithSchema(meteringServiceMethods.ByName("ExportUsageStatement")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/metering.v1.MeteringService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MeteringServiceGetUsageProcedure:
+			meteringServiceGetUsageHandler.ServeHTTP(w, r)
+		case MeteringServiceExportUsageStatementProcedure:
+			meteringServiceExportUsageStatementHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMeteringServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMeteringServiceHandler struct{}
+
+func (UnimplementedMeteringServiceHandler) GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("metering.v1.MeteringService.GetUsage is not implemented"))
+}
+
+func (UnimplementedMeteringServiceHandler) ExportUsageStatement(context.Context, *connect.Request[v1.ExportUsageStatementRequest]) (*connect.Response[v1.ExportUsageStatementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("metering.v1.MeteringService.ExportUsageStatement is not implemented"))
+}
@@ -0,0 +1,735 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/rental/v1/rental.proto
+
+package rentalv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HandoverKind is the moment of a rental a handover inspection is made at
+type HandoverKind int32
+
+const (
+	HandoverKind_HANDOVER_KIND_UNSPECIFIED HandoverKind = 0
+	HandoverKind_HANDOVER_KIND_PICKUP      HandoverKind = 1
+	HandoverKind_HANDOVER_KIND_RETURN      HandoverKind = 2
+)
+
+// Enum value maps for HandoverKind.
+var (
+	HandoverKind_name = map[int32]string{
+		0: "HANDOVER_KIND_UNSPECIFIED",
+		1: "HANDOVER_KIND_PICKUP",
+		2: "HANDOVER_KIND_RETURN",
+	}
+	HandoverKind_value = map[string]int32{
+		"HANDOVER_KIND_UNSPECIFIED": 0,
+		"HANDOVER_KIND_PICKUP":      1,
+		"HANDOVER_KIND_RETURN":      2,
+	}
+)
+
+func (x HandoverKind) Enum() *HandoverKind {
+	p := new(HandoverKind)
+	*p = x
+	return p
+}
+
+func (x HandoverKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HandoverKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_rental_v1_rental_proto_enumTypes[0].Descriptor()
+}
+
+func (HandoverKind) Type() protoreflect.EnumType {
+	return &file_api_proto_rental_v1_rental_proto_enumTypes[0]
+}
+
+func (x HandoverKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HandoverKind.Descriptor instead.
+func (HandoverKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_proto_rawDescGZIP(), []int{0}
+}
+
+// DamageArea is the part of a car a damage is found on
+type DamageArea int32
+
+const (
+	DamageArea_DAMAGE_AREA_UNSPECIFIED DamageArea = 0
+	DamageArea_DAMAGE_AREA_FRONT       DamageArea = 1
+	DamageArea_DAMAGE_AREA_REAR        DamageArea = 2
+	DamageArea_DAMAGE_AREA_LEFT_SIDE   DamageArea = 3
+	DamageArea_DAMAGE_AREA_RIGHT_SIDE  DamageArea = 4
+	DamageArea_DAMAGE_AREA_ROOF        DamageArea = 5
+	DamageArea_DAMAGE_AREA_GLASS       DamageArea = 6
+	DamageArea_DAMAGE_AREA_WHEELS      DamageArea = 7
+	DamageArea_DAMAGE_AREA_INTERIOR    DamageArea = 8
+)
+
+// Enum value maps for DamageArea.
+var (
+	DamageArea_name = map[int32]string{
+		0: "DAMAGE_AREA_UNSPECIFIED",
+		1: "DAMAGE_AREA_FRONT",
+		2: "DAMAGE_AREA_REAR",
+		3: "DAMAGE_AREA_LEFT_SIDE",
+		4: "DAMAGE_AREA_RIGHT_SIDE",
+		5: "DAMAGE_AREA_ROOF",
+		6: "DAMAGE_AREA_GLASS",
+		7: "DAMAGE_AREA_WHEELS",
+		8: "DAMAGE_AREA_INTERIOR",
+	}
+	DamageArea_value = map[string]int32{
+		"DAMAGE_AREA_UNSPECIFIED": 0,
+		"DAMAGE_AREA_FRONT":       1,
+		"DAMAGE_AREA_REAR":        2,
+		"DAMAGE_AREA_LEFT_SIDE":   3,
+		"DAMAGE_AREA_RIGHT_SIDE":  4,
+		"DAMAGE_AREA_ROOF":        5,
+		"DAMAGE_AREA_GLASS":       6,
+		"DAMAGE_AREA_WHEELS":      7,
+		"DAMAGE_AREA_INTERIOR":    8,
+	}
+)
+
+func (x DamageArea) Enum() *DamageArea {
+	p := new(DamageArea)
+	*p = x
+	return p
+}
+
+func (x DamageArea) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DamageArea) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_rental_v1_rental_proto_enumTypes[1].Descriptor()
+}
+
+func (DamageArea) Type() protoreflect.EnumType {
+	return &file_api_proto_rental_v1_rental_proto_enumTypes[1]
+}
+
+func (x DamageArea) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DamageArea.Descriptor instead.
+func (DamageArea) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_proto_rawDescGZIP(), []int{1}
+}
+
+// Rental is a booking of a car for a renter
+type Rental struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The tenant that booked the rental, whose renter it is
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// The tenant owning the car; differs from tenant_id for cars shared under a fleet
+	// sharing agreement
+	OwnerTenantId string                 `protobuf:"bytes,3,opt,name=owner_tenant_id,json=ownerTenantId,proto3" json:"owner_tenant_id,omitempty"`
+	CarId         string                 `protobuf:"bytes,4,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	RenterId      string                 `protobuf:"bytes,5,opt,name=renter_id,json=renterId,proto3" json:"renter_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The branches of the owner of the car where it is picked up and returned;
+	// empty for cars without a branch
+	PickupBranchId string `protobuf:"bytes,10,opt,name=pickup_branch_id,json=pickupBranchId,proto3" json:"pickup_branch_id,omitempty"`
+	ReturnBranchId string `protobuf:"bytes,11,opt,name=return_branch_id,json=returnBranchId,proto3" json:"return_branch_id,omitempty"`
+	// Unset until the car is returned
+	ReturnedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=returned_at,json=returnedAt,proto3" json:"returned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rental) Reset() {
+	*x = Rental{}
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rental) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rental) ProtoMessage() {}
+
+func (x *Rental) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rental.ProtoReflect.Descriptor instead.
+func (*Rental) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Rental) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rental) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Rental) GetOwnerTenantId() string {
+	if x != nil {
+		return x.OwnerTenantId
+	}
+	return ""
+}
+
+func (x *Rental) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+func (x *Rental) GetRenterId() string {
+	if x != nil {
+		return x.RenterId
+	}
+	return ""
+}
+
+func (x *Rental) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Rental) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Rental) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Rental) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Rental) GetPickupBranchId() string {
+	if x != nil {
+		return x.PickupBranchId
+	}
+	return ""
+}
+
+func (x *Rental) GetReturnBranchId() string {
+	if x != nil {
+		return x.ReturnBranchId
+	}
+	return ""
+}
+
+func (x *Rental) GetReturnedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReturnedAt
+	}
+	return nil
+}
+
+// AvailableCar is a car free over the searched period
+type AvailableCar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	CarId string                 `protobuf:"bytes,1,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	// The tenant owning the car
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// The make and name of the model of the car, e.g. "Toyota Camry"
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// The fleet sharing agreement the car is shared under; empty for the tenant's own cars
+	AgreementId  string `protobuf:"bytes,4,opt,name=agreement_id,json=agreementId,proto3" json:"agreement_id,omitempty"`
+	CarModelId   string `protobuf:"bytes,5,opt,name=car_model_id,json=carModelId,proto3" json:"car_model_id,omitempty"`
+	LicensePlate string `protobuf:"bytes,6,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	// The branch the car is at; empty for cars without a branch
+	CurrentBranchId string `protobuf:"bytes,7,opt,name=current_branch_id,json=currentBranchId,proto3" json:"current_branch_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AvailableCar) Reset() {
+	*x = AvailableCar{}
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailableCar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableCar) ProtoMessage() {}
+
+func (x *AvailableCar) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableCar.ProtoReflect.Descriptor instead.
+func (*AvailableCar) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AvailableCar) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+func (x *AvailableCar) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AvailableCar) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *AvailableCar) GetAgreementId() string {
+	if x != nil {
+		return x.AgreementId
+	}
+	return ""
+}
+
+func (x *AvailableCar) GetCarModelId() string {
+	if x != nil {
+		return x.CarModelId
+	}
+	return ""
+}
+
+func (x *AvailableCar) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+func (x *AvailableCar) GetCurrentBranchId() string {
+	if x != nil {
+		return x.CurrentBranchId
+	}
+	return ""
+}
+
+// Damage is an entry of the damage checklist of a handover inspection
+type Damage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Area  DamageArea             `protobuf:"varint,1,opt,name=area,proto3,enum=rental.v1.DamageArea" json:"area,omitempty"`
+	// Optional: at most 500 characters
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Damage) Reset() {
+	*x = Damage{}
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Damage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Damage) ProtoMessage() {}
+
+func (x *Damage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Damage.ProtoReflect.Descriptor instead.
+func (*Damage) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Damage) GetArea() DamageArea {
+	if x != nil {
+		return x.Area
+	}
+	return DamageArea_DAMAGE_AREA_UNSPECIFIED
+}
+
+func (x *Damage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// HandoverInspection is what is read off the car of a rental as it is handed
+// over
+type HandoverInspection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Must not be lower at return than at pickup
+	OdometerKm int32 `protobuf:"varint,1,opt,name=odometer_km,json=odometerKm,proto3" json:"odometer_km,omitempty"`
+	// The fuel or charge level, from 0 to 100 percent
+	FuelLevel     int32     `protobuf:"varint,2,opt,name=fuel_level,json=fuelLevel,proto3" json:"fuel_level,omitempty"`
+	Damages       []*Damage `protobuf:"bytes,3,rep,name=damages,proto3" json:"damages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandoverInspection) Reset() {
+	*x = HandoverInspection{}
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoverInspection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoverInspection) ProtoMessage() {}
+
+func (x *HandoverInspection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoverInspection.ProtoReflect.Descriptor instead.
+func (*HandoverInspection) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HandoverInspection) GetOdometerKm() int32 {
+	if x != nil {
+		return x.OdometerKm
+	}
+	return 0
+}
+
+func (x *HandoverInspection) GetFuelLevel() int32 {
+	if x != nil {
+		return x.FuelLevel
+	}
+	return 0
+}
+
+func (x *HandoverInspection) GetDamages() []*Damage {
+	if x != nil {
+		return x.Damages
+	}
+	return nil
+}
+
+// RentalHandover is the inspection of the car of a rental at pickup or return
+type RentalHandover struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The tenant that booked the rental
+	TenantId   string              `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RentalId   string              `protobuf:"bytes,3,opt,name=rental_id,json=rentalId,proto3" json:"rental_id,omitempty"`
+	Kind       HandoverKind        `protobuf:"varint,4,opt,name=kind,proto3,enum=rental.v1.HandoverKind" json:"kind,omitempty"`
+	Inspection *HandoverInspection `protobuf:"bytes,5,opt,name=inspection,proto3" json:"inspection,omitempty"`
+	// The distance driven since pickup; zero for pickups
+	DistanceKm int32 `protobuf:"varint,6,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	// The fuel or charge level missing since pickup, in percentage points; zero
+	// for pickups and for cars returned with as much or more
+	FuelShortfall int32                  `protobuf:"varint,7,opt,name=fuel_shortfall,json=fuelShortfall,proto3" json:"fuel_shortfall,omitempty"`
+	InspectedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=inspected_at,json=inspectedAt,proto3" json:"inspected_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RentalHandover) Reset() {
+	*x = RentalHandover{}
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RentalHandover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RentalHandover) ProtoMessage() {}
+
+func (x *RentalHandover) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RentalHandover.ProtoReflect.Descriptor instead.
+func (*RentalHandover) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RentalHandover) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RentalHandover) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *RentalHandover) GetRentalId() string {
+	if x != nil {
+		return x.RentalId
+	}
+	return ""
+}
+
+func (x *RentalHandover) GetKind() HandoverKind {
+	if x != nil {
+		return x.Kind
+	}
+	return HandoverKind_HANDOVER_KIND_UNSPECIFIED
+}
+
+func (x *RentalHandover) GetInspection() *HandoverInspection {
+	if x != nil {
+		return x.Inspection
+	}
+	return nil
+}
+
+func (x *RentalHandover) GetDistanceKm() int32 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *RentalHandover) GetFuelShortfall() int32 {
+	if x != nil {
+		return x.FuelShortfall
+	}
+	return 0
+}
+
+func (x *RentalHandover) GetInspectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InspectedAt
+	}
+	return nil
+}
+
+var File_api_proto_rental_v1_rental_proto protoreflect.FileDescriptor
+
+const file_api_proto_rental_v1_rental_proto_rawDesc = "" +
+	"\n" +
+	" api/proto/rental/v1/rental.proto\x12\trental.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x04\n" +
+	"\x06Rental\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12&\n" +
+	"\x0fowner_tenant_id\x18\x03 \x01(\tR\rownerTenantId\x12\x15\n" +
+	"\x06car_id\x18\x04 \x01(\tR\x05carId\x12\x1b\n" +
+	"\trenter_id\x18\x05 \x01(\tR\brenterId\x127\n" +
+	"\tstarts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
+	"\x10pickup_branch_id\x18\n" +
+	" \x01(\tR\x0epickupBranchId\x12(\n" +
+	"\x10return_branch_id\x18\v \x01(\tR\x0ereturnBranchId\x12;\n" +
+	"\vreturned_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"returnedAt\"\xee\x01\n" +
+	"\fAvailableCar\x12\x15\n" +
+	"\x06car_id\x18\x01 \x01(\tR\x05carId\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12!\n" +
+	"\fagreement_id\x18\x04 \x01(\tR\vagreementId\x12 \n" +
+	"\fcar_model_id\x18\x05 \x01(\tR\n" +
+	"carModelId\x12#\n" +
+	"\rlicense_plate\x18\x06 \x01(\tR\flicensePlate\x12*\n" +
+	"\x11current_branch_id\x18\a \x01(\tR\x0fcurrentBranchId\"U\n" +
+	"\x06Damage\x12)\n" +
+	"\x04area\x18\x01 \x01(\x0e2\x15.rental.v1.DamageAreaR\x04area\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x81\x01\n" +
+	"\x12HandoverInspection\x12\x1f\n" +
+	"\vodometer_km\x18\x01 \x01(\x05R\n" +
+	"odometerKm\x12\x1d\n" +
+	"\n" +
+	"fuel_level\x18\x02 \x01(\x05R\tfuelLevel\x12+\n" +
+	"\adamages\x18\x03 \x03(\v2\x11.rental.v1.DamageR\adamages\"\xcd\x02\n" +
+	"\x0eRentalHandover\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1b\n" +
+	"\trental_id\x18\x03 \x01(\tR\brentalId\x12+\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x17.rental.v1.HandoverKindR\x04kind\x12=\n" +
+	"\n" +
+	"inspection\x18\x05 \x01(\v2\x1d.rental.v1.HandoverInspectionR\n" +
+	"inspection\x12\x1f\n" +
+	"\vdistance_km\x18\x06 \x01(\x05R\n" +
+	"distanceKm\x12%\n" +
+	"\x0efuel_shortfall\x18\a \x01(\x05R\rfuelShortfall\x12=\n" +
+	"\finspected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vinspectedAt*a\n" +
+	"\fHandoverKind\x12\x1d\n" +
+	"\x19HANDOVER_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14HANDOVER_KIND_PICKUP\x10\x01\x12\x18\n" +
+	"\x14HANDOVER_KIND_RETURN\x10\x02*\xec\x01\n" +
+	"\n" +
+	"DamageArea\x12\x1b\n" +
+	"\x17DAMAGE_AREA_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11DAMAGE_AREA_FRONT\x10\x01\x12\x14\n" +
+	"\x10DAMAGE_AREA_REAR\x10\x02\x12\x19\n" +
+	"\x15DAMAGE_AREA_LEFT_SIDE\x10\x03\x12\x1a\n" +
+	"\x16DAMAGE_AREA_RIGHT_SIDE\x10\x04\x12\x14\n" +
+	"\x10DAMAGE_AREA_ROOF\x10\x05\x12\x15\n" +
+	"\x11DAMAGE_AREA_GLASS\x10\x06\x12\x16\n" +
+	"\x12DAMAGE_AREA_WHEELS\x10\a\x12\x18\n" +
+	"\x14DAMAGE_AREA_INTERIOR\x10\bBGZEgithub.com/jp-ryuji/go-arch-patterns/api/generated/rental/v1;rentalv1b\x06proto3"
+
+var (
+	file_api_proto_rental_v1_rental_proto_rawDescOnce sync.Once
+	file_api_proto_rental_v1_rental_proto_rawDescData []byte
+)
+
+func file_api_proto_rental_v1_rental_proto_rawDescGZIP() []byte {
+	file_api_proto_rental_v1_rental_proto_rawDescOnce.Do(func() {
+		file_api_proto_rental_v1_rental_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_rental_v1_rental_proto_rawDesc), len(file_api_proto_rental_v1_rental_proto_rawDesc)))
+	})
+	return file_api_proto_rental_v1_rental_proto_rawDescData
+}
+
+var file_api_proto_rental_v1_rental_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_proto_rental_v1_rental_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_proto_rental_v1_rental_proto_goTypes = []any{
+	(HandoverKind)(0),             // 0: rental.v1.HandoverKind
+	(DamageArea)(0),               // 1: rental.v1.DamageArea
+	(*Rental)(nil),                // 2: rental.v1.Rental
+	(*AvailableCar)(nil),          // 3: rental.v1.AvailableCar
+	(*Damage)(nil),                // 4: rental.v1.Damage
+	(*HandoverInspection)(nil),    // 5: rental.v1.HandoverInspection
+	(*RentalHandover)(nil),        // 6: rental.v1.RentalHandover
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_api_proto_rental_v1_rental_proto_depIdxs = []int32{
+	7,  // 0: rental.v1.Rental.starts_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: rental.v1.Rental.ends_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: rental.v1.Rental.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: rental.v1.Rental.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 4: rental.v1.Rental.returned_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: rental.v1.Damage.area:type_name -> rental.v1.DamageArea
+	4,  // 6: rental.v1.HandoverInspection.damages:type_name -> rental.v1.Damage
+	0,  // 7: rental.v1.RentalHandover.kind:type_name -> rental.v1.HandoverKind
+	5,  // 8: rental.v1.RentalHandover.inspection:type_name -> rental.v1.HandoverInspection
+	7,  // 9: rental.v1.RentalHandover.inspected_at:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_rental_v1_rental_proto_init() }
+func file_api_proto_rental_v1_rental_proto_init() {
+	if File_api_proto_rental_v1_rental_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_rental_v1_rental_proto_rawDesc), len(file_api_proto_rental_v1_rental_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_rental_v1_rental_proto_goTypes,
+		DependencyIndexes: file_api_proto_rental_v1_rental_proto_depIdxs,
+		EnumInfos:         file_api_proto_rental_v1_rental_proto_enumTypes,
+		MessageInfos:      file_api_proto_rental_v1_rental_proto_msgTypes,
+	}.Build()
+	File_api_proto_rental_v1_rental_proto = out.File
+	file_api_proto_rental_v1_rental_proto_goTypes = nil
+	file_api_proto_rental_v1_rental_proto_depIdxs = nil
+}
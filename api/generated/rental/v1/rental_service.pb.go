@@ -0,0 +1,882 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/rental/v1/rental_service.proto
+
+package rentalv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchAvailableCarsRequest is the request for searching the cars free over a period
+type SearchAvailableCarsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Optional: defaults to 20
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional: only the cars at this branch
+	BranchId      string `protobuf:"bytes,5,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAvailableCarsRequest) Reset() {
+	*x = SearchAvailableCarsRequest{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAvailableCarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAvailableCarsRequest) ProtoMessage() {}
+
+func (x *SearchAvailableCarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAvailableCarsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAvailableCarsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchAvailableCarsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SearchAvailableCarsRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *SearchAvailableCarsRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SearchAvailableCarsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchAvailableCarsRequest) GetBranchId() string {
+	if x != nil {
+		return x.BranchId
+	}
+	return ""
+}
+
+// SearchAvailableCarsResponse is the response for searching the cars free over a period
+type SearchAvailableCarsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by car ID
+	Cars          []*AvailableCar `protobuf:"bytes,1,rep,name=cars,proto3" json:"cars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAvailableCarsResponse) Reset() {
+	*x = SearchAvailableCarsResponse{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAvailableCarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAvailableCarsResponse) ProtoMessage() {}
+
+func (x *SearchAvailableCarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAvailableCarsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAvailableCarsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchAvailableCarsResponse) GetCars() []*AvailableCar {
+	if x != nil {
+		return x.Cars
+	}
+	return nil
+}
+
+// BookRentalRequest is the request for booking a car
+type BookRentalRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CarId    string `protobuf:"bytes,2,opt,name=car_id,json=carId,proto3" json:"car_id,omitempty"`
+	RenterId string `protobuf:"bytes,3,opt,name=renter_id,json=renterId,proto3" json:"renter_id,omitempty"`
+	// Must be within the opening hours of the pickup branch or, for branches
+	// without any and cars without a branch, the business hours of the tenant
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Optional: defaults to the branch the car is at, the only one it can be
+	// picked up at
+	PickupBranchId string `protobuf:"bytes,6,opt,name=pickup_branch_id,json=pickupBranchId,proto3" json:"pickup_branch_id,omitempty"`
+	// Optional: defaults to the pickup branch. Cars shared under a fleet sharing
+	// agreement go back to their pickup branch.
+	ReturnBranchId string `protobuf:"bytes,7,opt,name=return_branch_id,json=returnBranchId,proto3" json:"return_branch_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BookRentalRequest) Reset() {
+	*x = BookRentalRequest{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookRentalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookRentalRequest) ProtoMessage() {}
+
+func (x *BookRentalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookRentalRequest.ProtoReflect.Descriptor instead.
+func (*BookRentalRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookRentalRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *BookRentalRequest) GetCarId() string {
+	if x != nil {
+		return x.CarId
+	}
+	return ""
+}
+
+func (x *BookRentalRequest) GetRenterId() string {
+	if x != nil {
+		return x.RenterId
+	}
+	return ""
+}
+
+func (x *BookRentalRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *BookRentalRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *BookRentalRequest) GetPickupBranchId() string {
+	if x != nil {
+		return x.PickupBranchId
+	}
+	return ""
+}
+
+func (x *BookRentalRequest) GetReturnBranchId() string {
+	if x != nil {
+		return x.ReturnBranchId
+	}
+	return ""
+}
+
+// BookRentalResponse is the response for booking a car
+type BookRentalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rental        *Rental                `protobuf:"bytes,1,opt,name=rental,proto3" json:"rental,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookRentalResponse) Reset() {
+	*x = BookRentalResponse{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookRentalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookRentalResponse) ProtoMessage() {}
+
+func (x *BookRentalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookRentalResponse.ProtoReflect.Descriptor instead.
+func (*BookRentalResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BookRentalResponse) GetRental() *Rental {
+	if x != nil {
+		return x.Rental
+	}
+	return nil
+}
+
+// PickUpRentalRequest is the request for picking up the car of a rental
+type PickUpRentalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Inspection    *HandoverInspection    `protobuf:"bytes,2,opt,name=inspection,proto3" json:"inspection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickUpRentalRequest) Reset() {
+	*x = PickUpRentalRequest{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickUpRentalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickUpRentalRequest) ProtoMessage() {}
+
+func (x *PickUpRentalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickUpRentalRequest.ProtoReflect.Descriptor instead.
+func (*PickUpRentalRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PickUpRentalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PickUpRentalRequest) GetInspection() *HandoverInspection {
+	if x != nil {
+		return x.Inspection
+	}
+	return nil
+}
+
+// PickUpRentalResponse is the response for picking up the car of a rental
+type PickUpRentalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handover      *RentalHandover        `protobuf:"bytes,1,opt,name=handover,proto3" json:"handover,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickUpRentalResponse) Reset() {
+	*x = PickUpRentalResponse{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickUpRentalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickUpRentalResponse) ProtoMessage() {}
+
+func (x *PickUpRentalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickUpRentalResponse.ProtoReflect.Descriptor instead.
+func (*PickUpRentalResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PickUpRentalResponse) GetHandover() *RentalHandover {
+	if x != nil {
+		return x.Handover
+	}
+	return nil
+}
+
+// ReturnRentalRequest is the request for returning the car of a rental
+type ReturnRentalRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Required: compared with the inspection at pickup, which the rental must have
+	Inspection    *HandoverInspection `protobuf:"bytes,2,opt,name=inspection,proto3" json:"inspection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnRentalRequest) Reset() {
+	*x = ReturnRentalRequest{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnRentalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnRentalRequest) ProtoMessage() {}
+
+func (x *ReturnRentalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnRentalRequest.ProtoReflect.Descriptor instead.
+func (*ReturnRentalRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReturnRentalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReturnRentalRequest) GetInspection() *HandoverInspection {
+	if x != nil {
+		return x.Inspection
+	}
+	return nil
+}
+
+// ReturnRentalResponse is the response for returning the car of a rental
+type ReturnRentalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rental        *Rental                `protobuf:"bytes,1,opt,name=rental,proto3" json:"rental,omitempty"`
+	Handover      *RentalHandover        `protobuf:"bytes,2,opt,name=handover,proto3" json:"handover,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnRentalResponse) Reset() {
+	*x = ReturnRentalResponse{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnRentalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnRentalResponse) ProtoMessage() {}
+
+func (x *ReturnRentalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnRentalResponse.ProtoReflect.Descriptor instead.
+func (*ReturnRentalResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReturnRentalResponse) GetRental() *Rental {
+	if x != nil {
+		return x.Rental
+	}
+	return nil
+}
+
+func (x *ReturnRentalResponse) GetHandover() *RentalHandover {
+	if x != nil {
+		return x.Handover
+	}
+	return nil
+}
+
+// ListRentalsRequest is the request for listing rentals
+type ListRentalsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId  string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional: only the rentals of this renter. Renters can only list their own
+	// rentals, so they must set it to themselves.
+	RenterId      string `protobuf:"bytes,4,opt,name=renter_id,json=renterId,proto3" json:"renter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRentalsRequest) Reset() {
+	*x = ListRentalsRequest{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRentalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRentalsRequest) ProtoMessage() {}
+
+func (x *ListRentalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRentalsRequest.ProtoReflect.Descriptor instead.
+func (*ListRentalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListRentalsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListRentalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRentalsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRentalsRequest) GetRenterId() string {
+	if x != nil {
+		return x.RenterId
+	}
+	return ""
+}
+
+// ListRentalsResponse is the response for listing rentals
+type ListRentalsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The latest first
+	Rentals       []*Rental `protobuf:"bytes,1,rep,name=rentals,proto3" json:"rentals,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	TotalCount    int32     `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRentalsResponse) Reset() {
+	*x = ListRentalsResponse{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRentalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRentalsResponse) ProtoMessage() {}
+
+func (x *ListRentalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRentalsResponse.ProtoReflect.Descriptor instead.
+func (*ListRentalsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListRentalsResponse) GetRentals() []*Rental {
+	if x != nil {
+		return x.Rentals
+	}
+	return nil
+}
+
+func (x *ListRentalsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListRentalsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// ListRentalHandoversRequest is the request for listing the handover
+// inspections of a rental
+type ListRentalHandoversRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RentalId      string                 `protobuf:"bytes,1,opt,name=rental_id,json=rentalId,proto3" json:"rental_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRentalHandoversRequest) Reset() {
+	*x = ListRentalHandoversRequest{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRentalHandoversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRentalHandoversRequest) ProtoMessage() {}
+
+func (x *ListRentalHandoversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRentalHandoversRequest.ProtoReflect.Descriptor instead.
+func (*ListRentalHandoversRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListRentalHandoversRequest) GetRentalId() string {
+	if x != nil {
+		return x.RentalId
+	}
+	return ""
+}
+
+// ListRentalHandoversResponse is the response for listing the handover
+// inspections of a rental
+type ListRentalHandoversResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pickup first
+	Handovers     []*RentalHandover `protobuf:"bytes,1,rep,name=handovers,proto3" json:"handovers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRentalHandoversResponse) Reset() {
+	*x = ListRentalHandoversResponse{}
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRentalHandoversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRentalHandoversResponse) ProtoMessage() {}
+
+func (x *ListRentalHandoversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_rental_v1_rental_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRentalHandoversResponse.ProtoReflect.Descriptor instead.
+func (*ListRentalHandoversResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rental_v1_rental_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListRentalHandoversResponse) GetHandovers() []*RentalHandover {
+	if x != nil {
+		return x.Handovers
+	}
+	return nil
+}
+
+var File_api_proto_rental_v1_rental_service_proto protoreflect.FileDescriptor
+
+const file_api_proto_rental_v1_rental_service_proto_rawDesc = "" +
+	"\n" +
+	"(api/proto/rental/v1/rental_service.proto\x12\trental.v1\x1a api/proto/rental/v1/rental.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe1\x01\n" +
+	"\x1aSearchAvailableCarsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1b\n" +
+	"\tbranch_id\x18\x05 \x01(\tR\bbranchId\"J\n" +
+	"\x1bSearchAvailableCarsResponse\x12+\n" +
+	"\x04cars\x18\x01 \x03(\v2\x17.rental.v1.AvailableCarR\x04cars\"\xa6\x02\n" +
+	"\x11BookRentalRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06car_id\x18\x02 \x01(\tR\x05carId\x12\x1b\n" +
+	"\trenter_id\x18\x03 \x01(\tR\brenterId\x127\n" +
+	"\tstarts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12(\n" +
+	"\x10pickup_branch_id\x18\x06 \x01(\tR\x0epickupBranchId\x12(\n" +
+	"\x10return_branch_id\x18\a \x01(\tR\x0ereturnBranchId\"?\n" +
+	"\x12BookRentalResponse\x12)\n" +
+	"\x06rental\x18\x01 \x01(\v2\x11.rental.v1.RentalR\x06rental\"d\n" +
+	"\x13PickUpRentalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\n" +
+	"inspection\x18\x02 \x01(\v2\x1d.rental.v1.HandoverInspectionR\n" +
+	"inspection\"M\n" +
+	"\x14PickUpRentalResponse\x125\n" +
+	"\bhandover\x18\x01 \x01(\v2\x19.rental.v1.RentalHandoverR\bhandover\"d\n" +
+	"\x13ReturnRentalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12=\n" +
+	"\n" +
+	"inspection\x18\x02 \x01(\v2\x1d.rental.v1.HandoverInspectionR\n" +
+	"inspection\"x\n" +
+	"\x14ReturnRentalResponse\x12)\n" +
+	"\x06rental\x18\x01 \x01(\v2\x11.rental.v1.RentalR\x06rental\x125\n" +
+	"\bhandover\x18\x02 \x01(\v2\x19.rental.v1.RentalHandoverR\bhandover\"\x8a\x01\n" +
+	"\x12ListRentalsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\trenter_id\x18\x04 \x01(\tR\brenterId\"\x8b\x01\n" +
+	"\x13ListRentalsResponse\x12+\n" +
+	"\arentals\x18\x01 \x03(\v2\x11.rental.v1.RentalR\arentals\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"9\n" +
+	"\x1aListRentalHandoversRequest\x12\x1b\n" +
+	"\trental_id\x18\x01 \x01(\tR\brentalId\"V\n" +
+	"\x1bListRentalHandoversResponse\x127\n" +
+	"\thandovers\x18\x01 \x03(\v2\x19.rental.v1.RentalHandoverR\thandovers2\xdc\x05\n" +
+	"\rRentalService\x12\x82\x01\n" +
+	"\x13SearchAvailableCars\x12%.rental.v1.SearchAvailableCarsRequest\x1a&.rental.v1.SearchAvailableCarsResponse\"\x1c\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/availableCars\x90\x02\x01\x12a\n" +
+	"\n" +
+	"BookRental\x12\x1c.rental.v1.BookRentalRequest\x1a\x1d.rental.v1.BookRentalResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/rentals\x12s\n" +
+	"\fPickUpRental\x12\x1e.rental.v1.PickUpRentalRequest\x1a\x1f.rental.v1.PickUpRentalResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/rentals/{id}:pickUp\x12s\n" +
+	"\fReturnRental\x12\x1e.rental.v1.ReturnRentalRequest\x1a\x1f.rental.v1.ReturnRentalResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/rentals/{id}:return\x12d\n" +
+	"\vListRentals\x12\x1d.rental.v1.ListRentalsRequest\x1a\x1e.rental.v1.ListRentalsResponse\"\x16\x82\xd3\xe4\x93\x02\r\x12\v/v1/rentals\x90\x02\x01\x12\x92\x01\n" +
+	"\x13ListRentalHandovers\x12%.rental.v1.ListRentalHandoversRequest\x1a&.rental.v1.ListRentalHandoversResponse\",\x82\xd3\xe4\x93\x02#\x12!/v1/rentals/{rental_id}/handovers\x90\x02\x01BGZEgithub.com/jp-ryuji/go-arch-patterns/api/generated/rental/v1;rentalv1b\x06proto3"
+
+var (
+	file_api_proto_rental_v1_rental_service_proto_rawDescOnce sync.Once
+	file_api_proto_rental_v1_rental_service_proto_rawDescData []byte
+)
+
+func file_api_proto_rental_v1_rental_service_proto_rawDescGZIP() []byte {
+	file_api_proto_rental_v1_rental_service_proto_rawDescOnce.Do(func() {
+		file_api_proto_rental_v1_rental_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_rental_v1_rental_service_proto_rawDesc), len(file_api_proto_rental_v1_rental_service_proto_rawDesc)))
+	})
+	return file_api_proto_rental_v1_rental_service_proto_rawDescData
+}
+
+var file_api_proto_rental_v1_rental_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_proto_rental_v1_rental_service_proto_goTypes = []any{
+	(*SearchAvailableCarsRequest)(nil),  // 0: rental.v1.SearchAvailableCarsRequest
+	(*SearchAvailableCarsResponse)(nil), // 1: rental.v1.SearchAvailableCarsResponse
+	(*BookRentalRequest)(nil),           // 2: rental.v1.BookRentalRequest
+	(*BookRentalResponse)(nil),          // 3: rental.v1.BookRentalResponse
+	(*PickUpRentalRequest)(nil),         // 4: rental.v1.PickUpRentalRequest
+	(*PickUpRentalResponse)(nil),        // 5: rental.v1.PickUpRentalResponse
+	(*ReturnRentalRequest)(nil),         // 6: rental.v1.ReturnRentalRequest
+	(*ReturnRentalResponse)(nil),        // 7: rental.v1.ReturnRentalResponse
+	(*ListRentalsRequest)(nil),          // 8: rental.v1.ListRentalsRequest
+	(*ListRentalsResponse)(nil),         // 9: rental.v1.ListRentalsResponse
+	(*ListRentalHandoversRequest)(nil),  // 10: rental.v1.ListRentalHandoversRequest
+	(*ListRentalHandoversResponse)(nil), // 11: rental.v1.ListRentalHandoversResponse
+	(*timestamppb.Timestamp)(nil),       // 12: google.protobuf.Timestamp
+	(*AvailableCar)(nil),                // 13: rental.v1.AvailableCar
+	(*Rental)(nil),                      // 14: rental.v1.Rental
+	(*HandoverInspection)(nil),          // 15: rental.v1.HandoverInspection
+	(*RentalHandover)(nil),              // 16: rental.v1.RentalHandover
+}
+var file_api_proto_rental_v1_rental_service_proto_depIdxs = []int32{
+	12, // 0: rental.v1.SearchAvailableCarsRequest.starts_at:type_name -> google.protobuf.Timestamp
+	12, // 1: rental.v1.SearchAvailableCarsRequest.ends_at:type_name -> google.protobuf.Timestamp
+	13, // 2: rental.v1.SearchAvailableCarsResponse.cars:type_name -> rental.v1.AvailableCar
+	12, // 3: rental.v1.BookRentalRequest.starts_at:type_name -> google.protobuf.Timestamp
+	12, // 4: rental.v1.BookRentalRequest.ends_at:type_name -> google.protobuf.Timestamp
+	14, // 5: rental.v1.BookRentalResponse.rental:type_name -> rental.v1.Rental
+	15, // 6: rental.v1.PickUpRentalRequest.inspection:type_name -> rental.v1.HandoverInspection
+	16, // 7: rental.v1.PickUpRentalResponse.handover:type_name -> rental.v1.RentalHandover
+	15, // 8: rental.v1.ReturnRentalRequest.inspection:type_name -> rental.v1.HandoverInspection
+	14, // 9: rental.v1.ReturnRentalResponse.rental:type_name -> rental.v1.Rental
+	16, // 10: rental.v1.ReturnRentalResponse.handover:type_name -> rental.v1.RentalHandover
+	14, // 11: rental.v1.ListRentalsResponse.rentals:type_name -> rental.v1.Rental
+	16, // 12: rental.v1.ListRentalHandoversResponse.handovers:type_name -> rental.v1.RentalHandover
+	0,  // 13: rental.v1.RentalService.SearchAvailableCars:input_type -> rental.v1.SearchAvailableCarsRequest
+	2,  // 14: rental.v1.RentalService.BookRental:input_type -> rental.v1.BookRentalRequest
+	4,  // 15: rental.v1.RentalService.PickUpRental:input_type -> rental.v1.PickUpRentalRequest
+	6,  // 16: rental.v1.RentalService.ReturnRental:input_type -> rental.v1.ReturnRentalRequest
+	8,  // 17: rental.v1.RentalService.ListRentals:input_type -> rental.v1.ListRentalsRequest
+	10, // 18: rental.v1.RentalService.ListRentalHandovers:input_type -> rental.v1.ListRentalHandoversRequest
+	1,  // 19: rental.v1.RentalService.SearchAvailableCars:output_type -> rental.v1.SearchAvailableCarsResponse
+	3,  // 20: rental.v1.RentalService.BookRental:output_type -> rental.v1.BookRentalResponse
+	5,  // 21: rental.v1.RentalService.PickUpRental:output_type -> rental.v1.PickUpRentalResponse
+	7,  // 22: rental.v1.RentalService.ReturnRental:output_type -> rental.v1.ReturnRentalResponse
+	9,  // 23: rental.v1.RentalService.ListRentals:output_type -> rental.v1.ListRentalsResponse
+	11, // 24: rental.v1.RentalService.ListRentalHandovers:output_type -> rental.v1.ListRentalHandoversResponse
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_rental_v1_rental_service_proto_init() }
+func file_api_proto_rental_v1_rental_service_proto_init() {
+	if File_api_proto_rental_v1_rental_service_proto != nil {
+		return
+	}
+	file_api_proto_rental_v1_rental_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_rental_v1_rental_service_proto_rawDesc), len(file_api_proto_rental_v1_rental_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_rental_v1_rental_service_proto_goTypes,
+		DependencyIndexes: file_api_proto_rental_v1_rental_service_proto_depIdxs,
+		MessageInfos:      file_api_proto_rental_v1_rental_service_proto_msgTypes,
+	}.Build()
+	File_api_proto_rental_v1_rental_service_proto = out.File
+	file_api_proto_rental_v1_rental_service_proto_goTypes = nil
+	file_api_proto_rental_v1_rental_service_proto_depIdxs = nil
+}
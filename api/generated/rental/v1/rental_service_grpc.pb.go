@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/proto/rental/v1/rental_service.proto
+
+package rentalv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RentalService_SearchAvailableCars_FullMethodName = "/rental.v1.RentalService/SearchAvailableCars"
+	RentalService_BookRental_FullMethodName          = "/rental.v1.RentalService/BookRental"
+	RentalService_PickUpRental_FullMethodName        = "/rental.v1.RentalService/PickUpRental"
+	RentalService_ReturnRental_FullMethodName        = "/rental.v1.RentalService/ReturnRental"
+	RentalService_ListRentals_FullMethodName         = "/rental.v1.RentalService/ListRentals"
+	RentalService_ListRentalHandovers_FullMethodName = "/rental.v1.RentalService/ListRentalHandovers"
+)
+
+// RentalServiceClient is the client API for RentalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RentalService provides availability search and booking over the cars of the tenant and
+// the cars sibling tenants share with it
+type RentalServiceClient interface {
+	// SearchAvailableCars retrieves the cars free over a period
+	SearchAvailableCars(ctx context.Context, in *SearchAvailableCarsRequest, opts ...grpc.CallOption) (*SearchAvailableCarsResponse, error)
+	// BookRental books a car for a renter of the tenant
+	BookRental(ctx context.Context, in *BookRentalRequest, opts ...grpc.CallOption) (*BookRentalResponse, error)
+	// PickUpRental records that the car of a rental the tenant booked was picked
+	// up, with its inspection
+	PickUpRental(ctx context.Context, in *PickUpRentalRequest, opts ...grpc.CallOption) (*PickUpRentalResponse, error)
+	// ReturnRental records that the car of a rental the tenant booked was brought
+	// back
+	ReturnRental(ctx context.Context, in *ReturnRentalRequest, opts ...grpc.CallOption) (*ReturnRentalResponse, error)
+	// ListRentals retrieves the rentals the tenant booked or owns the car of
+	ListRentals(ctx context.Context, in *ListRentalsRequest, opts ...grpc.CallOption) (*ListRentalsResponse, error)
+	// ListRentalHandovers retrieves the handover inspections of a rental the
+	// tenant booked or owns the car of
+	ListRentalHandovers(ctx context.Context, in *ListRentalHandoversRequest, opts ...grpc.CallOption) (*ListRentalHandoversResponse, error)
+}
+
+type rentalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRentalServiceClient(cc grpc.ClientConnInterface) RentalServiceClient {
+	return &rentalServiceClient{cc}
+}
+
+func (c *rentalServiceClient) SearchAvailableCars(ctx context.Context, in *SearchAvailableCarsRequest, opts ...grpc.CallOption) (*SearchAvailableCarsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAvailableCarsResponse)
+	err := c.cc.Invoke(ctx, RentalService_SearchAvailableCars_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rentalServiceClient) BookRental(ctx context.Context, in *BookRentalRequest, opts ...grpc.CallOption) (*BookRentalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookRentalResponse)
+	err := c.cc.Invoke(ctx, RentalService_BookRental_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rentalServiceClient) PickUpRental(ctx context.Context, in *PickUpRentalRequest, opts ...grpc.CallOption) (*PickUpRentalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickUpRentalResponse)
+	err := c.cc.Invoke(ctx, RentalService_PickUpRental_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rentalServiceClient) ReturnRental(ctx context.Context, in *ReturnRentalRequest, opts ...grpc.CallOption) (*ReturnRentalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnRentalResponse)
+	err := c.cc.Invoke(ctx, RentalService_ReturnRental_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rentalServiceClient) ListRentals(ctx context.Context, in *ListRentalsRequest, opts ...grpc.CallOption) (*ListRentalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRentalsResponse)
+	err := c.cc.Invoke(ctx, RentalService_ListRentals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rentalServiceClient) ListRentalHandovers(ctx context.Context, in *ListRentalHandoversRequest, opts ...grpc.CallOption) (*ListRentalHandoversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRentalHandoversResponse)
+	err := c.cc.Invoke(ctx, RentalService_ListRentalHandovers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RentalServiceServer is the server API for RentalService service.
+// All implementations should embed UnimplementedRentalServiceServer
+// for forward compatibility.
+//
+// RentalService provides availability search and booking over the cars of the tenant and
+// the cars sibling tenants share with it
+type RentalServiceServer interface {
+	// SearchAvailableCars retrieves the cars free over a period
+	SearchAvailableCars(context.Context, *SearchAvailableCarsRequest) (*SearchAvailableCarsResponse, error)
+	// BookRental books a car for a renter of the tenant
+	BookRental(context.Context, *BookRentalRequest) (*BookRentalResponse, error)
+	// PickUpRental records that the car of a rental the tenant booked was picked
+	// up, with its inspection
+	PickUpRental(context.Context, *PickUpRentalRequest) (*PickUpRentalResponse, error)
+	// ReturnRental records that the car of a rental the tenant booked was brought
+	// back
+	ReturnRental(context.Context, *ReturnRentalRequest) (*ReturnRentalResponse, error)
+	// ListRentals retrieves the rentals the tenant booked or owns the car of
+	ListRentals(context.Context, *ListRentalsRequest) (*ListRentalsResponse, error)
+	// ListRentalHandovers retrieves the handover inspections of a rental the
+	// tenant booked or owns the car of
+	ListRentalHandovers(context.Context, *ListRentalHandoversRequest) (*ListRentalHandoversResponse, error)
+}
+
+// UnimplementedRentalServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRentalServiceServer struct{}
+
+func (UnimplementedRentalServiceServer) SearchAvailableCars(context.Context, *SearchAvailableCarsRequest) (*SearchAvailableCarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAvailableCars not implemented")
+}
+func (UnimplementedRentalServiceServer) BookRental(context.Context, *BookRentalRequest) (*BookRentalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookRental not implemented")
+}
+func (UnimplementedRentalServiceServer) PickUpRental(context.Context, *PickUpRentalRequest) (*PickUpRentalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PickUpRental not implemented")
+}
+func (UnimplementedRentalServiceServer) ReturnRental(context.Context, *ReturnRentalRequest) (*ReturnRentalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnRental not implemented")
+}
+func (UnimplementedRentalServiceServer) ListRentals(context.Context, *ListRentalsRequest) (*ListRentalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRentals not implemented")
+}
+func (UnimplementedRentalServiceServer) ListRentalHandovers(context.Context, *ListRentalHandoversRequest) (*ListRentalHandoversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRentalHandovers not implemented")
+}
+func (UnimplementedRentalServiceServer) testEmbeddedByValue() {}
+
+// UnsafeRentalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RentalServiceServer will
+// result in compilation errors.
+type UnsafeRentalServiceServer interface {
+	mustEmbedUnimplementedRentalServiceServer()
+}
+
+func RegisterRentalServiceServer(s grpc.ServiceRegistrar, srv RentalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRentalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RentalService_ServiceDesc, srv)
+}
+
+func _RentalService_SearchAvailableCars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAvailableCarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RentalServiceServer).SearchAvailableCars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RentalService_SearchAvailableCars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RentalServiceServer).SearchAvailableCars(ctx, req.(*SearchAvailableCarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RentalService_BookRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookRentalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RentalServiceServer).BookRental(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RentalService_BookRental_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RentalServiceServer).BookRental(ctx, req.(*BookRentalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RentalService_PickUpRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickUpRentalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RentalServiceServer).PickUpRental(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RentalService_PickUpRental_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RentalServiceServer).PickUpRental(ctx, req.(*PickUpRentalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RentalService_ReturnRental_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnRentalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RentalServiceServer).ReturnRental(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RentalService_ReturnRental_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RentalServiceServer).ReturnRental(ctx, req.(*ReturnRentalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RentalService_ListRentals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRentalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RentalServiceServer).ListRentals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RentalService_ListRentals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RentalServiceServer).ListRentals(ctx, req.(*ListRentalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RentalService_ListRentalHandovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRentalHandoversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RentalServiceServer).ListRentalHandovers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RentalService_ListRentalHandovers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RentalServiceServer).ListRentalHandovers(ctx, req.(*ListRentalHandoversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RentalService_ServiceDesc is the grpc.ServiceDesc for RentalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RentalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rental.v1.RentalService",
+	HandlerType: (*RentalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchAvailableCars",
+			Handler:    _RentalService_SearchAvailableCars_Handler,
+		},
+		{
+			MethodName: "BookRental",
+			Handler:    _RentalService_BookRental_Handler,
+		},
+		{
+			MethodName: "PickUpRental",
+			Handler:    _RentalService_PickUpRental_Handler,
+		},
+		{
+			MethodName: "ReturnRental",
+			Handler:    _RentalService_ReturnRental_Handler,
+		},
+		{
+			MethodName: "ListRentals",
+			Handler:    _RentalService_ListRentals_Handler,
+		},
+		{
+			MethodName: "ListRentalHandovers",
+			Handler:    _RentalService_ListRentalHandovers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/rental/v1/rental_service.proto",
+}
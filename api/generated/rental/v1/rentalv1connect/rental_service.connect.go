@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/proto/rental/v1/rental_service.proto
+
+package rentalv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/rental/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RentalServiceName is the fully-qualified name of the RentalService service.
+	RentalServiceName = "rental.v1.RentalService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RentalServiceSearchAvailableCarsProcedure is the fully-qualified name of the RentalService's
+	// SearchAvailableCars RPC.
+	RentalServiceSearchAvailableCarsProcedure = "/rental.v1.RentalService/SearchAvailableCars"
+	// RentalServiceBookRentalProcedure is the fully-qualified name of the RentalService's BookRental
+	// RPC.
+	RentalServiceBookRentalProcedure = "/rental.v1.RentalService/BookRental"
+	// RentalServicePickUpRentalProcedure is the fully-qualified name of the RentalService's
+	// PickUpRental RPC.
+	RentalServicePickUpRentalProcedure = "/rental.v1.RentalService/PickUpRental"
+	// RentalServiceReturnRentalProcedure is the fully-qualified name of the RentalService's
+	// ReturnRental RPC.
+	RentalServiceReturnRentalProcedure = "/rental.v1.RentalService/ReturnRental"
+	// RentalServiceListRentalsProcedure is the fully-qualified name of the RentalService's ListRentals
+	// RPC.
+	RentalServiceListRentalsProcedure = "/rental.v1.RentalService/ListRentals"
+	// RentalServiceListRentalHandoversProcedure is the fully-qualified name of the RentalService's
+	// ListRentalHandovers RPC.
+	RentalServiceListRentalHandoversProcedure = "/rental.v1.RentalService/ListRentalHandovers"
+)
+
+// RentalServiceClient is a client for the rental.v1.RentalService service.
+type RentalServiceClient interface {
+	// SearchAvailableCars retrieves the cars free over a period
+	SearchAvailableCars(context.Context, *connect.Request[v1.SearchAvailableCarsRequest]) (*connect.Response[v1.SearchAvailableCarsResponse], error)
+	// BookRental books a car for a renter of the tenant
+	BookRental(context.Context, *connect.Request[v1.BookRentalRequest]) (*connect.Response[v1.BookRentalResponse], error)
+	// PickUpRental records that the car of a rental the tenant booked was picked
+	// up, with its inspection
+	PickUpRental(context.Context, *connect.Request[v1.PickUpRentalRequest]) (*connect.Response[v1.PickUpRentalResponse], error)
+	// ReturnRental records that the car of a rental the tenant booked was brought
+	// back
+	ReturnRental(context.Context, *connect.Request[v1.ReturnRentalRequest]) (*connect.Response[v1.ReturnRentalResponse], error)
+	// ListRentals retrieves the rentals the tenant booked or owns the car of
+	ListRentals(context.Context, *connect.Request[v1.ListRentalsRequest]) (*connect.Response[v1.ListRentalsResponse], error)
+	// ListRentalHandovers retrieves the handover inspections of a rental the
+	// tenant booked or owns the car of
+	ListRentalHandovers(context.Context, *connect.Request[v1.ListRentalHandoversRequest]) (*connect.Response[v1.ListRentalHandoversResponse], error)
+}
+
+// NewRentalServiceClient constructs a client for the rental.v1.RentalService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRentalServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RentalServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	rentalServiceMethods := v1.File_api_proto_rental_v1_rental_service_proto.Services().ByName("RentalService").Methods()
+	return &rentalServiceClient{
+		searchAvailableCars: connect.NewClient[v1.SearchAvailableCarsRequest, v1.SearchAvailableCarsResponse](
+			httpClient,
+			baseURL+RentalServiceSearchAvailableCarsProcedure,
+			connect.WithSchema(rentalServiceMethods.ByName("SearchAvailableCars")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		bookRental: connect.NewClient[v1.BookRentalRequest, v1.BookRentalResponse](
+			httpClient,
+			baseURL+RentalServiceBookRentalProcedure,
+			connect.WithSchema(rentalServiceMethods.ByName("BookRental")),
+			connect.WithClientOptions(opts...),
+		),
+		pickUpRental: connect.NewClient[v1.PickUpRentalRequest, v1.PickUpRentalResponse](
+			httpClient,
+			baseURL+RentalServicePickUpRentalProcedure,
+			connect.WithSchema(rentalServiceMethods.ByName("PickUpRental")),
+			connect.WithClientOptions(opts...),
+		),
+		returnRental: connect.NewClient[v1.ReturnRentalRequest, v1.ReturnRentalResponse](
+			httpClient,
+			baseURL+RentalServiceReturnRentalProcedure,
+			connect.WithSchema(rentalServiceMethods.ByName("ReturnRental")),
+			connect.WithClientOptions(opts...),
+		),
+		listRentals: connect.NewClient[v1.ListRentalsRequest, v1.ListRentalsResponse](
+			httpClient,
+			baseURL+RentalServiceListRentalsProcedure,
+			connect.WithSchema(rentalServiceMethods.ByName("ListRentals")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listRentalHandovers: connect.NewClient[v1.ListRentalHandoversRequest, v1.ListRentalHandoversResponse](
+			httpClient,
+			baseURL+RentalServiceListRentalHandoversProcedure,
+			connect.WithSchema(rentalServiceMethods.ByName("ListRentalHandovers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// rentalServiceClient implements RentalServiceClient.
+type rentalServiceClient struct {
+	searchAvailableCars *connect.Client[v1.SearchAvailableCarsRequest, v1.SearchAvailableCarsResponse]
+	bookRental          *connect.Client[v1.BookRentalRequest, v1.BookRentalResponse]
+	pickUpRental        *connect.Client[v1.PickUpRentalRequest, v1.PickUpRentalResponse]
+	returnRental        *connect.Client[v1.ReturnRentalRequest, v1.ReturnRentalResponse]
+	listRentals         *connect.Client[v1.ListRentalsRequest, v1.ListRentalsResponse]
+	listRentalHandovers *connect.Client[v1.ListRentalHandoversRequest, v1.ListRentalHandoversResponse]
+}
+
+// SearchAvailableCars calls rental.v1.RentalService.SearchAvailableCars.
+func (c *rentalServiceClient) SearchAvailableCars(ctx context.Context, req *connect.Request[v1.SearchAvailableCarsRequest]) (*connect.Response[v1.SearchAvailableCarsResponse], error) {
+	return c.searchAvailableCars.CallUnary(ctx, req)
+}
+
+// BookRental calls rental.v1.RentalService.BookRental.
+func (c *rentalServiceClient) BookRental(ctx context.Context, req *connect.Request[v1.BookRentalRequest]) (*connect.Response[v1.BookRentalResponse], error) {
+	return c.bookRental.CallUnary(ctx, req)
+}
+
+// PickUpRental calls rental.v1.RentalService.PickUpRental.
+func (c *rentalServiceClient) PickUpRental(ctx context.Context, req *connect.Request[v1.PickUpRentalRequest]) (*connect.Response[v1.PickUpRentalResponse], error) {
+	return c.pickUpRental.CallUnary(ctx, req)
+}
+
+// ReturnRental calls rental.v1.RentalService.ReturnRental.
+func (c *rentalServiceClient) ReturnRental(ctx context.Context, req *connect.Request[v1.ReturnRentalRequest]) (*connect.Response[v1.ReturnRentalResponse], error) {
+	return c.returnRental.CallUnary(ctx, req)
+}
+
+// ListRentals calls rental.v1.RentalService.ListRentals.
+func (c *rentalServiceClient) ListRentals(ctx context.Context, req *connect.Request[v1.ListRentalsRequest]) (*connect.Response[v1.ListRentalsResponse], error) {
+	return c.listRentals.CallUnary(ctx, req)
+}
+
+// ListRentalHandovers calls rental.v1.RentalService.ListRentalHandovers.
+func (c *rentalServiceClient) ListRentalHandovers(ctx context.Context, req *connect.Request[v1.ListRentalHandoversRequest]) (*connect.Response[v1.ListRentalHandoversResponse], error) {
+	return c.listRentalHandovers.CallUnary(ctx, req)
+}
+
+// RentalServiceHandler is an implementation of the rental.v1.RentalService service.
+type RentalServiceHandler interface {
+	// SearchAvailableCars retrieves the cars free over a period
+	SearchAvailableCars(context.Context, *connect.Request[v1.SearchAvailableCarsRequest]) (*connect.Response[v1.SearchAvailableCarsResponse], error)
+	// BookRental books a car for a renter of the tenant
+	BookRental(context.Context, *connect.Request[v1.BookRentalRequest]) (*connect.Response[v1.BookRentalResponse], error)
+	// PickUpRental records that the car of a rental the tenant booked was picked
+	// up, with its inspection
+	PickUpRental(context.Context, *connect.Request[v1.PickUpRentalRequest]) (*connect.Response[v1.PickUpRentalResponse], error)
+	// ReturnRental records that the car of a rental the tenant booked was brought
+	// back
+	ReturnRental(context.Context, *connect.Request[v1.ReturnRentalRequest]) (*connect.Response[v1.ReturnRentalResponse], error)
+	// ListRentals retrieves the rentals the tenant booked or owns the car of
+	ListRentals(context.Context, *connect.Request[v1.ListRentalsRequest]) (*connect.Response[v1.ListRentalsResponse], error)
+	// ListRentalHandovers retrieves the handover inspections of a rental the
+	// tenant booked or owns the car of
+	ListRentalHandovers(context.Context, *connect.Request[v1.ListRentalHandoversRequest]) (*connect.Response[v1.ListRentalHandoversResponse], error)
+}
+
+// NewRentalServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRentalServiceHandler(svc RentalServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	rentalServiceMethods := v1.File_api_proto_rental_v1_rental_service_proto.Services().ByName("RentalService").Methods()
+	rentalServiceSearchAvailableCarsHandler := connect.NewUnaryHandler(
+		RentalServiceSearchAvailableCarsProcedure,
+		svc.SearchAvailableCars,
+		connect.WithSchema(rentalServiceMethods.ByName("SearchAvailableCars")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	rentalServiceBookRentalHandler := connect.NewUnaryHandler(
+		RentalServiceBookRentalProcedure,
+		svc.BookRental,
+		connect.WithSchema(rentalServiceMethods.ByName("BookRental")),
+		connect.WithHandlerOptions(opts...),
+	)
+	rentalServicePickUpRentalHandler := connect.NewUnaryHandler(
+		RentalServicePickUpRentalProcedure,
+		svc.PickUpRental,
+		connect.WithSchema(rentalServiceMethods.ByName("PickUpRental")),
+		connect.WithHandlerOptions(opts...),
+	)
+	rentalServiceReturnRentalHandler := connect.NewUnaryHandler(
+		RentalServiceReturnRentalProcedure,
+		svc.ReturnRental,
+		connect.WithSchema(rentalServiceMethods.ByName("ReturnRental")),
+		connect.WithHandlerOptions(opts...),
+	)
+	rentalServiceListRentalsHandler := connect.NewUnaryHandler(
+		RentalServiceListRentalsProcedure,
+		svc.ListRentals,
+		connect.WithSchema(rentalServiceMethods.ByName("ListRentals")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	rentalServiceListRentalHandoversHandler := connect.NewUnaryHandler(
+		RentalServiceListRentalHandoversProcedure,
+		svc.ListRentalHandovers,
+		connect.WithSchema(rentalServiceMethods.ByName("ListRentalHandovers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/rental.v1.RentalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RentalServiceSearchAvailableCarsProcedure:
+			rentalServiceSearchAvailableCarsHandler.ServeHTTP(w, r)
+		case RentalServiceBookRentalProcedure:
+			rentalServiceBookRentalHandler.ServeHTTP(w, r)
+		case RentalServicePickUpRentalProcedure:
+			rentalServicePickUpRentalHandler.ServeHTTP(w, r)
+		case RentalServiceReturnRentalProcedure:
+			rentalServiceReturnRentalHandler.ServeHTTP(w, r)
+		case RentalServiceListRentalsProcedure:
+			rentalServiceListRentalsHandler.ServeHTTP(w, r)
+		case RentalServiceListRentalHandoversProcedure:
+			rentalServiceListRentalHandoversHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRentalServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRentalServiceHandler struct{}
+
+func (UnimplementedRentalServiceHandler) SearchAvailableCars(context.Context, *connect.Request[v1.SearchAvailableCarsRequest]) (*connect.Response[v1.SearchAvailableCarsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rental.v1.RentalService.SearchAvailableCars is not implemented"))
+}
+
+func (UnimplementedRentalServiceHandler) BookRental(context.Context, *connect.Request[v1.BookRentalRequest]) (*connect.Response[v1.BookRentalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rental.v1.RentalService.BookRental is not implemented"))
+}
+
+func (UnimplementedRentalServiceHandler) PickUpRental(context.Context, *connect.Request[v1.PickUpRentalRequest]) (*connect.Response[v1.PickUpRentalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rental.v1.RentalService.PickUpRental is not implemented"))
+}
+
+func (UnimplementedRentalServiceHandler) ReturnRental(context.Context, *connect.Request[v1.ReturnRentalRequest]) (*connect.Response[v1.ReturnRentalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rental.v1.RentalService.ReturnRental is not implemented"))
+}
+
+func (UnimplementedRentalServiceHandler) ListRentals(context.Context, *connect.Request[v1.ListRentalsRequest]) (*connect.Response[v1.ListRentalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rental.v1.RentalService.ListRentals is not implemented"))
+}
+
+func (UnimplementedRentalServiceHandler) ListRentalHandovers(context.Context, *connect.Request[v1.ListRentalHandoversRequest]) (*connect.Response[v1.ListRentalHandoversResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("rental.v1.RentalService.ListRentalHandovers is not implemented"))
+}
@@ -0,0 +1,942 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/tenant/v1/tenant.proto
+
+package tenantv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TenantStatus is the lifecycle state of a tenant
+type TenantStatus int32
+
+const (
+	TenantStatus_TENANT_STATUS_UNSPECIFIED TenantStatus = 0
+	TenantStatus_TENANT_STATUS_ACTIVE      TenantStatus = 1
+	// Suspended tenants can read their data but not change it
+	TenantStatus_TENANT_STATUS_SUSPENDED TenantStatus = 2
+	// Tenants pending deletion can read their data but not change it, until their data is
+	// purged at purge_at
+	TenantStatus_TENANT_STATUS_PENDING_DELETION TenantStatus = 3
+)
+
+// Enum value maps for TenantStatus.
+var (
+	TenantStatus_name = map[int32]string{
+		0: "TENANT_STATUS_UNSPECIFIED",
+		1: "TENANT_STATUS_ACTIVE",
+		2: "TENANT_STATUS_SUSPENDED",
+		3: "TENANT_STATUS_PENDING_DELETION",
+	}
+	TenantStatus_value = map[string]int32{
+		"TENANT_STATUS_UNSPECIFIED":      0,
+		"TENANT_STATUS_ACTIVE":           1,
+		"TENANT_STATUS_SUSPENDED":        2,
+		"TENANT_STATUS_PENDING_DELETION": 3,
+	}
+)
+
+func (x TenantStatus) Enum() *TenantStatus {
+	p := new(TenantStatus)
+	*p = x
+	return p
+}
+
+func (x TenantStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TenantStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_tenant_v1_tenant_proto_enumTypes[0].Descriptor()
+}
+
+func (TenantStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_tenant_v1_tenant_proto_enumTypes[0]
+}
+
+func (x TenantStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TenantStatus.Descriptor instead.
+func (TenantStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{0}
+}
+
+// TenantIsolation is how the data of a tenant is separated from the data of other tenants
+type TenantIsolation int32
+
+const (
+	TenantIsolation_TENANT_ISOLATION_UNSPECIFIED TenantIsolation = 0
+	// Rows in tables shared by every tenant, guarded by row-level security
+	TenantIsolation_TENANT_ISOLATION_SHARED TenantIsolation = 1
+	// Tables in a schema of the tenant's own
+	TenantIsolation_TENANT_ISOLATION_SCHEMA TenantIsolation = 2
+	// Tables in a database of the tenant's own
+	TenantIsolation_TENANT_ISOLATION_DATABASE TenantIsolation = 3
+)
+
+// Enum value maps for TenantIsolation.
+var (
+	TenantIsolation_name = map[int32]string{
+		0: "TENANT_ISOLATION_UNSPECIFIED",
+		1: "TENANT_ISOLATION_SHARED",
+		2: "TENANT_ISOLATION_SCHEMA",
+		3: "TENANT_ISOLATION_DATABASE",
+	}
+	TenantIsolation_value = map[string]int32{
+		"TENANT_ISOLATION_UNSPECIFIED": 0,
+		"TENANT_ISOLATION_SHARED":      1,
+		"TENANT_ISOLATION_SCHEMA":      2,
+		"TENANT_ISOLATION_DATABASE":    3,
+	}
+)
+
+func (x TenantIsolation) Enum() *TenantIsolation {
+	p := new(TenantIsolation)
+	*p = x
+	return p
+}
+
+func (x TenantIsolation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TenantIsolation) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_tenant_v1_tenant_proto_enumTypes[1].Descriptor()
+}
+
+func (TenantIsolation) Type() protoreflect.EnumType {
+	return &file_api_proto_tenant_v1_tenant_proto_enumTypes[1]
+}
+
+func (x TenantIsolation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TenantIsolation.Descriptor instead.
+func (TenantIsolation) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{1}
+}
+
+// ArchiveJobKind is what an archive job does
+type ArchiveJobKind int32
+
+const (
+	ArchiveJobKind_ARCHIVE_JOB_KIND_UNSPECIFIED ArchiveJobKind = 0
+	ArchiveJobKind_ARCHIVE_JOB_KIND_EXPORT      ArchiveJobKind = 1
+	ArchiveJobKind_ARCHIVE_JOB_KIND_IMPORT      ArchiveJobKind = 2
+)
+
+// Enum value maps for ArchiveJobKind.
+var (
+	ArchiveJobKind_name = map[int32]string{
+		0: "ARCHIVE_JOB_KIND_UNSPECIFIED",
+		1: "ARCHIVE_JOB_KIND_EXPORT",
+		2: "ARCHIVE_JOB_KIND_IMPORT",
+	}
+	ArchiveJobKind_value = map[string]int32{
+		"ARCHIVE_JOB_KIND_UNSPECIFIED": 0,
+		"ARCHIVE_JOB_KIND_EXPORT":      1,
+		"ARCHIVE_JOB_KIND_IMPORT":      2,
+	}
+)
+
+func (x ArchiveJobKind) Enum() *ArchiveJobKind {
+	p := new(ArchiveJobKind)
+	*p = x
+	return p
+}
+
+func (x ArchiveJobKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveJobKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_tenant_v1_tenant_proto_enumTypes[2].Descriptor()
+}
+
+func (ArchiveJobKind) Type() protoreflect.EnumType {
+	return &file_api_proto_tenant_v1_tenant_proto_enumTypes[2]
+}
+
+func (x ArchiveJobKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveJobKind.Descriptor instead.
+func (ArchiveJobKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+// ArchiveJobStatus is the state of an archive job
+type ArchiveJobStatus int32
+
+const (
+	ArchiveJobStatus_ARCHIVE_JOB_STATUS_UNSPECIFIED ArchiveJobStatus = 0
+	ArchiveJobStatus_ARCHIVE_JOB_STATUS_RUNNING     ArchiveJobStatus = 1
+	ArchiveJobStatus_ARCHIVE_JOB_STATUS_SUCCEEDED   ArchiveJobStatus = 2
+	ArchiveJobStatus_ARCHIVE_JOB_STATUS_FAILED      ArchiveJobStatus = 3
+)
+
+// Enum value maps for ArchiveJobStatus.
+var (
+	ArchiveJobStatus_name = map[int32]string{
+		0: "ARCHIVE_JOB_STATUS_UNSPECIFIED",
+		1: "ARCHIVE_JOB_STATUS_RUNNING",
+		2: "ARCHIVE_JOB_STATUS_SUCCEEDED",
+		3: "ARCHIVE_JOB_STATUS_FAILED",
+	}
+	ArchiveJobStatus_value = map[string]int32{
+		"ARCHIVE_JOB_STATUS_UNSPECIFIED": 0,
+		"ARCHIVE_JOB_STATUS_RUNNING":     1,
+		"ARCHIVE_JOB_STATUS_SUCCEEDED":   2,
+		"ARCHIVE_JOB_STATUS_FAILED":      3,
+	}
+)
+
+func (x ArchiveJobStatus) Enum() *ArchiveJobStatus {
+	p := new(ArchiveJobStatus)
+	*p = x
+	return p
+}
+
+func (x ArchiveJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_tenant_v1_tenant_proto_enumTypes[3].Descriptor()
+}
+
+func (ArchiveJobStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_tenant_v1_tenant_proto_enumTypes[3]
+}
+
+func (x ArchiveJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveJobStatus.Descriptor instead.
+func (ArchiveJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+// Tenant represents a rental company using the platform
+type Tenant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Code names the tenant's subdomain, e.g. "acme" for acme.example.com
+	Code        string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Status      TenantStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=tenant.v1.TenantStatus" json:"status,omitempty"`
+	SuspendedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Empty for tenants without a plan, which are not limited
+	PlanId    string          `protobuf:"bytes,7,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Isolation TenantIsolation `protobuf:"varint,8,opt,name=isolation,proto3,enum=tenant.v1.TenantIsolation" json:"isolation,omitempty"`
+	// When the data of a tenant pending deletion is purged
+	PurgeAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	// Empty for tenants outside a franchise and for franchise parents
+	ParentId      string `protobuf:"bytes,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tenant) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Tenant) GetStatus() TenantStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TenantStatus_TENANT_STATUS_UNSPECIFIED
+}
+
+func (x *Tenant) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *Tenant) GetIsolation() TenantIsolation {
+	if x != nil {
+		return x.Isolation
+	}
+	return TenantIsolation_TENANT_ISOLATION_UNSPECIFIED
+}
+
+func (x *Tenant) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+func (x *Tenant) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+// Plan is a subscription plan with the limits of the tenants on it
+type Plan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Limits        *PlanLimits            `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Plan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Plan) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Plan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Plan) GetLimits() *PlanLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+// PlanLimits are the most of each resource a tenant may have; zero means unlimited
+type PlanLimits struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	MaxCars    int32                  `protobuf:"varint,1,opt,name=max_cars,json=maxCars,proto3" json:"max_cars,omitempty"`
+	MaxRenters int32                  `protobuf:"varint,2,opt,name=max_renters,json=maxRenters,proto3" json:"max_renters,omitempty"`
+	// Rentals created per calendar month, in the tenant's timezone
+	MaxMonthlyRentals int32 `protobuf:"varint,3,opt,name=max_monthly_rentals,json=maxMonthlyRentals,proto3" json:"max_monthly_rentals,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlanLimits) Reset() {
+	*x = PlanLimits{}
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanLimits) ProtoMessage() {}
+
+func (x *PlanLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanLimits.ProtoReflect.Descriptor instead.
+func (*PlanLimits) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlanLimits) GetMaxCars() int32 {
+	if x != nil {
+		return x.MaxCars
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxRenters() int32 {
+	if x != nil {
+		return x.MaxRenters
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxMonthlyRentals() int32 {
+	if x != nil {
+		return x.MaxMonthlyRentals
+	}
+	return 0
+}
+
+// TableRows is how many rows of a tenant a table holds
+type TableRows struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Rows          int32                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableRows) Reset() {
+	*x = TableRows{}
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableRows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableRows) ProtoMessage() {}
+
+func (x *TableRows) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableRows.ProtoReflect.Descriptor instead.
+func (*TableRows) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TableRows) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *TableRows) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+// ArchiveJob is an export of a tenant to an archive or an import of one
+type ArchiveJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind  ArchiveJobKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=tenant.v1.ArchiveJobKind" json:"kind,omitempty"`
+	// The exported tenant, or the imported one once the import succeeded
+	TenantId string `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Name of the archive in the archive directory of the server
+	Archive string           `protobuf:"bytes,4,opt,name=archive,proto3" json:"archive,omitempty"`
+	Status  ArchiveJobStatus `protobuf:"varint,5,opt,name=status,proto3,enum=tenant.v1.ArchiveJobStatus" json:"status,omitempty"`
+	// Records written or read per kind, e.g. {"car": 12}, once the job succeeded
+	Counts map[string]int32 `protobuf:"bytes,6,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Why the job failed
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveJob) Reset() {
+	*x = ArchiveJob{}
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveJob) ProtoMessage() {}
+
+func (x *ArchiveJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveJob.ProtoReflect.Descriptor instead.
+func (*ArchiveJob) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ArchiveJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ArchiveJob) GetKind() ArchiveJobKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ArchiveJobKind_ARCHIVE_JOB_KIND_UNSPECIFIED
+}
+
+func (x *ArchiveJob) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ArchiveJob) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+func (x *ArchiveJob) GetStatus() ArchiveJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ArchiveJobStatus_ARCHIVE_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ArchiveJob) GetCounts() map[string]int32 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *ArchiveJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ArchiveJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ArchiveJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// FleetSharingAgreement lets a borrower tenant book the cars of a lender tenant of the same
+// franchise. Agreements go one way.
+type FleetSharingAgreement struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LenderTenantId   string                 `protobuf:"bytes,2,opt,name=lender_tenant_id,json=lenderTenantId,proto3" json:"lender_tenant_id,omitempty"`
+	BorrowerTenantId string                 `protobuf:"bytes,3,opt,name=borrower_tenant_id,json=borrowerTenantId,proto3" json:"borrower_tenant_id,omitempty"`
+	// Rentals of shared cars start on or after valid_from
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// Optional: rentals of shared cars end by valid_until
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// Longest rental of a shared car; zero means no cap
+	MaxRentalDays int32 `protobuf:"varint,6,opt,name=max_rental_days,json=maxRentalDays,proto3" json:"max_rental_days,omitempty"`
+	// Part of the revenue of rentals of shared cars that goes to the lender, for reporting
+	OwnerSharePercent int32 `protobuf:"varint,7,opt,name=owner_share_percent,json=ownerSharePercent,proto3" json:"owner_share_percent,omitempty"`
+	// Set once the agreement is terminated
+	TerminatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FleetSharingAgreement) Reset() {
+	*x = FleetSharingAgreement{}
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FleetSharingAgreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetSharingAgreement) ProtoMessage() {}
+
+func (x *FleetSharingAgreement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetSharingAgreement.ProtoReflect.Descriptor instead.
+func (*FleetSharingAgreement) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FleetSharingAgreement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FleetSharingAgreement) GetLenderTenantId() string {
+	if x != nil {
+		return x.LenderTenantId
+	}
+	return ""
+}
+
+func (x *FleetSharingAgreement) GetBorrowerTenantId() string {
+	if x != nil {
+		return x.BorrowerTenantId
+	}
+	return ""
+}
+
+func (x *FleetSharingAgreement) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *FleetSharingAgreement) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *FleetSharingAgreement) GetMaxRentalDays() int32 {
+	if x != nil {
+		return x.MaxRentalDays
+	}
+	return 0
+}
+
+func (x *FleetSharingAgreement) GetOwnerSharePercent() int32 {
+	if x != nil {
+		return x.OwnerSharePercent
+	}
+	return 0
+}
+
+func (x *FleetSharingAgreement) GetTerminatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TerminatedAt
+	}
+	return nil
+}
+
+func (x *FleetSharingAgreement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FleetSharingAgreement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_api_proto_tenant_v1_tenant_proto protoreflect.FileDescriptor
+
+const file_api_proto_tenant_v1_tenant_proto_rawDesc = "" +
+	"\n" +
+	" api/proto/tenant/v1/tenant.proto\x12\ttenant.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x03\n" +
+	"\x06Tenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12/\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x17.tenant.v1.TenantStatusR\x06status\x12=\n" +
+	"\fsuspended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vsuspendedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
+	"\aplan_id\x18\a \x01(\tR\x06planId\x128\n" +
+	"\tisolation\x18\b \x01(\x0e2\x1a.tenant.v1.TenantIsolationR\tisolation\x125\n" +
+	"\bpurge_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\x12\x1b\n" +
+	"\tparent_id\x18\n" +
+	" \x01(\tR\bparentId\"m\n" +
+	"\x04Plan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12-\n" +
+	"\x06limits\x18\x04 \x01(\v2\x15.tenant.v1.PlanLimitsR\x06limits\"x\n" +
+	"\n" +
+	"PlanLimits\x12\x19\n" +
+	"\bmax_cars\x18\x01 \x01(\x05R\amaxCars\x12\x1f\n" +
+	"\vmax_renters\x18\x02 \x01(\x05R\n" +
+	"maxRenters\x12.\n" +
+	"\x13max_monthly_rentals\x18\x03 \x01(\x05R\x11maxMonthlyRentals\"5\n" +
+	"\tTableRows\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\x05R\x04rows\"\xbb\x03\n" +
+	"\n" +
+	"ArchiveJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x19.tenant.v1.ArchiveJobKindR\x04kind\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x18\n" +
+	"\aarchive\x18\x04 \x01(\tR\aarchive\x123\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1b.tenant.v1.ArchiveJobStatusR\x06status\x129\n" +
+	"\x06counts\x18\x06 \x03(\v2!.tenant.v1.ArchiveJob.CountsEntryR\x06counts\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x1a9\n" +
+	"\vCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\x86\x04\n" +
+	"\x15FleetSharingAgreement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x10lender_tenant_id\x18\x02 \x01(\tR\x0elenderTenantId\x12,\n" +
+	"\x12borrower_tenant_id\x18\x03 \x01(\tR\x10borrowerTenantId\x129\n" +
+	"\n" +
+	"valid_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12&\n" +
+	"\x0fmax_rental_days\x18\x06 \x01(\x05R\rmaxRentalDays\x12.\n" +
+	"\x13owner_share_percent\x18\a \x01(\x05R\x11ownerSharePercent\x12?\n" +
+	"\rterminated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fterminatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\x88\x01\n" +
+	"\fTenantStatus\x12\x1d\n" +
+	"\x19TENANT_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TENANT_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
+	"\x17TENANT_STATUS_SUSPENDED\x10\x02\x12\"\n" +
+	"\x1eTENANT_STATUS_PENDING_DELETION\x10\x03*\x8c\x01\n" +
+	"\x0fTenantIsolation\x12 \n" +
+	"\x1cTENANT_ISOLATION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TENANT_ISOLATION_SHARED\x10\x01\x12\x1b\n" +
+	"\x17TENANT_ISOLATION_SCHEMA\x10\x02\x12\x1d\n" +
+	"\x19TENANT_ISOLATION_DATABASE\x10\x03*l\n" +
+	"\x0eArchiveJobKind\x12 \n" +
+	"\x1cARCHIVE_JOB_KIND_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ARCHIVE_JOB_KIND_EXPORT\x10\x01\x12\x1b\n" +
+	"\x17ARCHIVE_JOB_KIND_IMPORT\x10\x02*\x97\x01\n" +
+	"\x10ArchiveJobStatus\x12\"\n" +
+	"\x1eARCHIVE_JOB_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aARCHIVE_JOB_STATUS_RUNNING\x10\x01\x12 \n" +
+	"\x1cARCHIVE_JOB_STATUS_SUCCEEDED\x10\x02\x12\x1d\n" +
+	"\x19ARCHIVE_JOB_STATUS_FAILED\x10\x03BGZEgithub.com/jp-ryuji/go-arch-patterns/api/generated/tenant/v1;tenantv1b\x06proto3"
+
+var (
+	file_api_proto_tenant_v1_tenant_proto_rawDescOnce sync.Once
+	file_api_proto_tenant_v1_tenant_proto_rawDescData []byte
+)
+
+func file_api_proto_tenant_v1_tenant_proto_rawDescGZIP() []byte {
+	file_api_proto_tenant_v1_tenant_proto_rawDescOnce.Do(func() {
+		file_api_proto_tenant_v1_tenant_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_tenant_v1_tenant_proto_rawDesc), len(file_api_proto_tenant_v1_tenant_proto_rawDesc)))
+	})
+	return file_api_proto_tenant_v1_tenant_proto_rawDescData
+}
+
+var file_api_proto_tenant_v1_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_proto_tenant_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_proto_tenant_v1_tenant_proto_goTypes = []any{
+	(TenantStatus)(0),             // 0: tenant.v1.TenantStatus
+	(TenantIsolation)(0),          // 1: tenant.v1.TenantIsolation
+	(ArchiveJobKind)(0),           // 2: tenant.v1.ArchiveJobKind
+	(ArchiveJobStatus)(0),         // 3: tenant.v1.ArchiveJobStatus
+	(*Tenant)(nil),                // 4: tenant.v1.Tenant
+	(*Plan)(nil),                  // 5: tenant.v1.Plan
+	(*PlanLimits)(nil),            // 6: tenant.v1.PlanLimits
+	(*TableRows)(nil),             // 7: tenant.v1.TableRows
+	(*ArchiveJob)(nil),            // 8: tenant.v1.ArchiveJob
+	(*FleetSharingAgreement)(nil), // 9: tenant.v1.FleetSharingAgreement
+	nil,                           // 10: tenant.v1.ArchiveJob.CountsEntry
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_api_proto_tenant_v1_tenant_proto_depIdxs = []int32{
+	0,  // 0: tenant.v1.Tenant.status:type_name -> tenant.v1.TenantStatus
+	11, // 1: tenant.v1.Tenant.suspended_at:type_name -> google.protobuf.Timestamp
+	11, // 2: tenant.v1.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	11, // 3: tenant.v1.Tenant.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: tenant.v1.Tenant.isolation:type_name -> tenant.v1.TenantIsolation
+	11, // 5: tenant.v1.Tenant.purge_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: tenant.v1.Plan.limits:type_name -> tenant.v1.PlanLimits
+	2,  // 7: tenant.v1.ArchiveJob.kind:type_name -> tenant.v1.ArchiveJobKind
+	3,  // 8: tenant.v1.ArchiveJob.status:type_name -> tenant.v1.ArchiveJobStatus
+	10, // 9: tenant.v1.ArchiveJob.counts:type_name -> tenant.v1.ArchiveJob.CountsEntry
+	11, // 10: tenant.v1.ArchiveJob.created_at:type_name -> google.protobuf.Timestamp
+	11, // 11: tenant.v1.ArchiveJob.finished_at:type_name -> google.protobuf.Timestamp
+	11, // 12: tenant.v1.FleetSharingAgreement.valid_from:type_name -> google.protobuf.Timestamp
+	11, // 13: tenant.v1.FleetSharingAgreement.valid_until:type_name -> google.protobuf.Timestamp
+	11, // 14: tenant.v1.FleetSharingAgreement.terminated_at:type_name -> google.protobuf.Timestamp
+	11, // 15: tenant.v1.FleetSharingAgreement.created_at:type_name -> google.protobuf.Timestamp
+	11, // 16: tenant.v1.FleetSharingAgreement.updated_at:type_name -> google.protobuf.Timestamp
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_tenant_v1_tenant_proto_init() }
+func file_api_proto_tenant_v1_tenant_proto_init() {
+	if File_api_proto_tenant_v1_tenant_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_tenant_v1_tenant_proto_rawDesc), len(file_api_proto_tenant_v1_tenant_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_tenant_v1_tenant_proto_goTypes,
+		DependencyIndexes: file_api_proto_tenant_v1_tenant_proto_depIdxs,
+		EnumInfos:         file_api_proto_tenant_v1_tenant_proto_enumTypes,
+		MessageInfos:      file_api_proto_tenant_v1_tenant_proto_msgTypes,
+	}.Build()
+	File_api_proto_tenant_v1_tenant_proto = out.File
+	file_api_proto_tenant_v1_tenant_proto_goTypes = nil
+	file_api_proto_tenant_v1_tenant_proto_depIdxs = nil
+}
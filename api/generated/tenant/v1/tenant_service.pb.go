@@ -0,0 +1,1716 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/tenant/v1/tenant_service.proto
+
+package tenantv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateTenantRequest is the request for creating a tenant
+type CreateTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Code must be 3 to 50 lowercase letters, digits and hyphens, starting with a letter
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Optional: code of the plan of the tenant
+	PlanCode string `protobuf:"bytes,2,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`
+	// Optional: defaults to shared tables and cannot change later. Schemas and databases
+	// are provisioned with `make migrate.tenant` before the tenant is used.
+	Isolation TenantIsolation `protobuf:"varint,3,opt,name=isolation,proto3,enum=tenant.v1.TenantIsolation" json:"isolation,omitempty"`
+	// Optional: ID of the franchise parent of the tenant, which cannot change later. Children
+	// of the same parent can share their fleets.
+	ParentId      string `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateTenantRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetIsolation() TenantIsolation {
+	if x != nil {
+		return x.Isolation
+	}
+	return TenantIsolation_TENANT_ISOLATION_UNSPECIFIED
+}
+
+func (x *CreateTenantRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+// CreateTenantResponse is the response for creating a tenant
+type CreateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantResponse) Reset() {
+	*x = CreateTenantResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResponse) ProtoMessage() {}
+
+func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// GetTenantRequest is the request for retrieving a tenant; set either id or code
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTenantRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// GetTenantResponse is the response for retrieving a tenant
+type GetTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantResponse) Reset() {
+	*x = GetTenantResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantResponse) ProtoMessage() {}
+
+func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// SuspendTenantRequest is the request for suspending a tenant
+type SuspendTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendTenantRequest) Reset() {
+	*x = SuspendTenantRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendTenantRequest) ProtoMessage() {}
+
+func (x *SuspendTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendTenantRequest.ProtoReflect.Descriptor instead.
+func (*SuspendTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SuspendTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// SuspendTenantResponse is the response for suspending a tenant
+type SuspendTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendTenantResponse) Reset() {
+	*x = SuspendTenantResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendTenantResponse) ProtoMessage() {}
+
+func (x *SuspendTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendTenantResponse.ProtoReflect.Descriptor instead.
+func (*SuspendTenantResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SuspendTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// ReactivateTenantRequest is the request for reactivating a tenant
+type ReactivateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateTenantRequest) Reset() {
+	*x = ReactivateTenantRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateTenantRequest) ProtoMessage() {}
+
+func (x *ReactivateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateTenantRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReactivateTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ReactivateTenantResponse is the response for reactivating a tenant
+type ReactivateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateTenantResponse) Reset() {
+	*x = ReactivateTenantResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateTenantResponse) ProtoMessage() {}
+
+func (x *ReactivateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateTenantResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReactivateTenantResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// ChangeTenantPlanRequest is the request for moving a tenant to another plan
+type ChangeTenantPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlanCode      string                 `protobuf:"bytes,2,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTenantPlanRequest) Reset() {
+	*x = ChangeTenantPlanRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTenantPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTenantPlanRequest) ProtoMessage() {}
+
+func (x *ChangeTenantPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTenantPlanRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTenantPlanRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChangeTenantPlanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeTenantPlanRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+// ChangeTenantPlanResponse is the response for moving a tenant to another plan
+type ChangeTenantPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTenantPlanResponse) Reset() {
+	*x = ChangeTenantPlanResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTenantPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTenantPlanResponse) ProtoMessage() {}
+
+func (x *ChangeTenantPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTenantPlanResponse.ProtoReflect.Descriptor instead.
+func (*ChangeTenantPlanResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChangeTenantPlanResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// ScheduleTenantDeletionRequest is the request for scheduling the deletion of a tenant
+type ScheduleTenantDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTenantDeletionRequest) Reset() {
+	*x = ScheduleTenantDeletionRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTenantDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTenantDeletionRequest) ProtoMessage() {}
+
+func (x *ScheduleTenantDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTenantDeletionRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleTenantDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScheduleTenantDeletionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ScheduleTenantDeletionResponse is the response for scheduling the deletion of a tenant
+type ScheduleTenantDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTenantDeletionResponse) Reset() {
+	*x = ScheduleTenantDeletionResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTenantDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTenantDeletionResponse) ProtoMessage() {}
+
+func (x *ScheduleTenantDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTenantDeletionResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleTenantDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScheduleTenantDeletionResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// CancelTenantDeletionRequest is the request for canceling the deletion of a tenant
+type CancelTenantDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTenantDeletionRequest) Reset() {
+	*x = CancelTenantDeletionRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTenantDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTenantDeletionRequest) ProtoMessage() {}
+
+func (x *CancelTenantDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTenantDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelTenantDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelTenantDeletionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CancelTenantDeletionResponse is the response for canceling the deletion of a tenant
+type CancelTenantDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTenantDeletionResponse) Reset() {
+	*x = CancelTenantDeletionResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTenantDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTenantDeletionResponse) ProtoMessage() {}
+
+func (x *CancelTenantDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTenantDeletionResponse.ProtoReflect.Descriptor instead.
+func (*CancelTenantDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelTenantDeletionResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// GetTenantPurgeReportRequest is the request for counting the rows of a tenant
+type GetTenantPurgeReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantPurgeReportRequest) Reset() {
+	*x = GetTenantPurgeReportRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantPurgeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantPurgeReportRequest) ProtoMessage() {}
+
+func (x *GetTenantPurgeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantPurgeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantPurgeReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTenantPurgeReportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetTenantPurgeReportResponse is the response for counting the rows of a tenant
+type GetTenantPurgeReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rows per table, in the order the tables are purged
+	Tables        []*TableRows `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	TotalRows     int32        `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantPurgeReportResponse) Reset() {
+	*x = GetTenantPurgeReportResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantPurgeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantPurgeReportResponse) ProtoMessage() {}
+
+func (x *GetTenantPurgeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantPurgeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantPurgeReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTenantPurgeReportResponse) GetTables() []*TableRows {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GetTenantPurgeReportResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+// ListPlansRequest is the request for listing the plan catalog
+type ListPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansRequest) Reset() {
+	*x = ListPlansRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansRequest) ProtoMessage() {}
+
+func (x *ListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{16}
+}
+
+// ListPlansResponse is the response for listing the plan catalog
+type ListPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*Plan                `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPlansResponse) Reset() {
+	*x = ListPlansResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlansResponse) ProtoMessage() {}
+
+func (x *ListPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListPlansResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListPlansResponse) GetPlans() []*Plan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// ExportTenantRequest is the request for exporting a tenant
+type ExportTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTenantRequest) Reset() {
+	*x = ExportTenantRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTenantRequest) ProtoMessage() {}
+
+func (x *ExportTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTenantRequest.ProtoReflect.Descriptor instead.
+func (*ExportTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ExportTenantResponse is the response for exporting a tenant
+type ExportTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ArchiveJob            `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTenantResponse) Reset() {
+	*x = ExportTenantResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTenantResponse) ProtoMessage() {}
+
+func (x *ExportTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTenantResponse.ProtoReflect.Descriptor instead.
+func (*ExportTenantResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportTenantResponse) GetJob() *ArchiveJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// ImportTenantRequest is the request for importing a tenant
+type ImportTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of an archive in the archive directory of the server
+	Archive string `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	// Give every imported row a new ID, e.g. to import a copy next to the original tenant
+	RemapIds bool `protobuf:"varint,2,opt,name=remap_ids,json=remapIds,proto3" json:"remap_ids,omitempty"`
+	// Optional: replaces the code of the archived tenant
+	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTenantRequest) Reset() {
+	*x = ImportTenantRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTenantRequest) ProtoMessage() {}
+
+func (x *ImportTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTenantRequest.ProtoReflect.Descriptor instead.
+func (*ImportTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ImportTenantRequest) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+func (x *ImportTenantRequest) GetRemapIds() bool {
+	if x != nil {
+		return x.RemapIds
+	}
+	return false
+}
+
+func (x *ImportTenantRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// ImportTenantResponse is the response for importing a tenant
+type ImportTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ArchiveJob            `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTenantResponse) Reset() {
+	*x = ImportTenantResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTenantResponse) ProtoMessage() {}
+
+func (x *ImportTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTenantResponse.ProtoReflect.Descriptor instead.
+func (*ImportTenantResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportTenantResponse) GetJob() *ArchiveJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// GetArchiveJobRequest is the request for retrieving an export or import job
+type GetArchiveJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchiveJobRequest) Reset() {
+	*x = GetArchiveJobRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchiveJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchiveJobRequest) ProtoMessage() {}
+
+func (x *GetArchiveJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchiveJobRequest.ProtoReflect.Descriptor instead.
+func (*GetArchiveJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetArchiveJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetArchiveJobResponse is the response for retrieving an export or import job
+type GetArchiveJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ArchiveJob            `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetArchiveJobResponse) Reset() {
+	*x = GetArchiveJobResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetArchiveJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchiveJobResponse) ProtoMessage() {}
+
+func (x *GetArchiveJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchiveJobResponse.ProtoReflect.Descriptor instead.
+func (*GetArchiveJobResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetArchiveJobResponse) GetJob() *ArchiveJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// CreateFleetSharingAgreementRequest is the request for creating a fleet sharing agreement
+type CreateFleetSharingAgreementRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LenderTenantId   string                 `protobuf:"bytes,1,opt,name=lender_tenant_id,json=lenderTenantId,proto3" json:"lender_tenant_id,omitempty"`
+	BorrowerTenantId string                 `protobuf:"bytes,2,opt,name=borrower_tenant_id,json=borrowerTenantId,proto3" json:"borrower_tenant_id,omitempty"`
+	// Optional: defaults to now
+	ValidFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	// Optional: defaults to no end
+	ValidUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// Optional: zero means no cap
+	MaxRentalDays     int32 `protobuf:"varint,5,opt,name=max_rental_days,json=maxRentalDays,proto3" json:"max_rental_days,omitempty"`
+	OwnerSharePercent int32 `protobuf:"varint,6,opt,name=owner_share_percent,json=ownerSharePercent,proto3" json:"owner_share_percent,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateFleetSharingAgreementRequest) Reset() {
+	*x = CreateFleetSharingAgreementRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFleetSharingAgreementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFleetSharingAgreementRequest) ProtoMessage() {}
+
+func (x *CreateFleetSharingAgreementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFleetSharingAgreementRequest.ProtoReflect.Descriptor instead.
+func (*CreateFleetSharingAgreementRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateFleetSharingAgreementRequest) GetLenderTenantId() string {
+	if x != nil {
+		return x.LenderTenantId
+	}
+	return ""
+}
+
+func (x *CreateFleetSharingAgreementRequest) GetBorrowerTenantId() string {
+	if x != nil {
+		return x.BorrowerTenantId
+	}
+	return ""
+}
+
+func (x *CreateFleetSharingAgreementRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreateFleetSharingAgreementRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *CreateFleetSharingAgreementRequest) GetMaxRentalDays() int32 {
+	if x != nil {
+		return x.MaxRentalDays
+	}
+	return 0
+}
+
+func (x *CreateFleetSharingAgreementRequest) GetOwnerSharePercent() int32 {
+	if x != nil {
+		return x.OwnerSharePercent
+	}
+	return 0
+}
+
+// CreateFleetSharingAgreementResponse is the response for creating a fleet sharing agreement
+type CreateFleetSharingAgreementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agreement     *FleetSharingAgreement `protobuf:"bytes,1,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFleetSharingAgreementResponse) Reset() {
+	*x = CreateFleetSharingAgreementResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFleetSharingAgreementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFleetSharingAgreementResponse) ProtoMessage() {}
+
+func (x *CreateFleetSharingAgreementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFleetSharingAgreementResponse.ProtoReflect.Descriptor instead.
+func (*CreateFleetSharingAgreementResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateFleetSharingAgreementResponse) GetAgreement() *FleetSharingAgreement {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+// TerminateFleetSharingAgreementRequest is the request for terminating a fleet sharing agreement
+type TerminateFleetSharingAgreementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateFleetSharingAgreementRequest) Reset() {
+	*x = TerminateFleetSharingAgreementRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateFleetSharingAgreementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateFleetSharingAgreementRequest) ProtoMessage() {}
+
+func (x *TerminateFleetSharingAgreementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateFleetSharingAgreementRequest.ProtoReflect.Descriptor instead.
+func (*TerminateFleetSharingAgreementRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TerminateFleetSharingAgreementRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TerminateFleetSharingAgreementResponse is the response for terminating a fleet sharing agreement
+type TerminateFleetSharingAgreementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agreement     *FleetSharingAgreement `protobuf:"bytes,1,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateFleetSharingAgreementResponse) Reset() {
+	*x = TerminateFleetSharingAgreementResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateFleetSharingAgreementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateFleetSharingAgreementResponse) ProtoMessage() {}
+
+func (x *TerminateFleetSharingAgreementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateFleetSharingAgreementResponse.ProtoReflect.Descriptor instead.
+func (*TerminateFleetSharingAgreementResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TerminateFleetSharingAgreementResponse) GetAgreement() *FleetSharingAgreement {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+// ListFleetSharingAgreementsRequest is the request for listing the agreements of a tenant
+type ListFleetSharingAgreementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFleetSharingAgreementsRequest) Reset() {
+	*x = ListFleetSharingAgreementsRequest{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFleetSharingAgreementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFleetSharingAgreementsRequest) ProtoMessage() {}
+
+func (x *ListFleetSharingAgreementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFleetSharingAgreementsRequest.ProtoReflect.Descriptor instead.
+func (*ListFleetSharingAgreementsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListFleetSharingAgreementsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ListFleetSharingAgreementsResponse is the response for listing the agreements of a tenant
+type ListFleetSharingAgreementsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first, terminated ones included
+	Agreements    []*FleetSharingAgreement `protobuf:"bytes,1,rep,name=agreements,proto3" json:"agreements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFleetSharingAgreementsResponse) Reset() {
+	*x = ListFleetSharingAgreementsResponse{}
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFleetSharingAgreementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFleetSharingAgreementsResponse) ProtoMessage() {}
+
+func (x *ListFleetSharingAgreementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenant_v1_tenant_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFleetSharingAgreementsResponse.ProtoReflect.Descriptor instead.
+func (*ListFleetSharingAgreementsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListFleetSharingAgreementsResponse) GetAgreements() []*FleetSharingAgreement {
+	if x != nil {
+		return x.Agreements
+	}
+	return nil
+}
+
+var File_api_proto_tenant_v1_tenant_service_proto protoreflect.FileDescriptor
+
+const file_api_proto_tenant_v1_tenant_service_proto_rawDesc = "" +
+	"\n" +
+	"(api/proto/tenant/v1/tenant_service.proto\x12\ttenant.v1\x1a api/proto/tenant/v1/tenant.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9d\x01\n" +
+	"\x13CreateTenantRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1b\n" +
+	"\tplan_code\x18\x02 \x01(\tR\bplanCode\x128\n" +
+	"\tisolation\x18\x03 \x01(\x0e2\x1a.tenant.v1.TenantIsolationR\tisolation\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\"A\n" +
+	"\x14CreateTenantResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.tenant.v1.TenantR\x06tenant\"6\n" +
+	"\x10GetTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\">\n" +
+	"\x11GetTenantResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.tenant.v1.TenantR\x06tenant\"&\n" +
+	"\x14SuspendTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x15SuspendTenantResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.tenant.v1.TenantR\x06tenant\")\n" +
+	"\x17ReactivateTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
+	"\x18ReactivateTenantResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.tenant.v1.TenantR\x06tenant\"F\n" +
+	"\x17ChangeTenantPlanRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tplan_code\x18\x02 \x01(\tR\bplanCode\"E\n" +
+	"\x18ChangeTenantPlanResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.tenant.v1.TenantR\x06tenant\"/\n" +
+	"\x1dScheduleTenantDeletionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
+	"\x1eScheduleTenantDeletionResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.tenant.v1.TenantR\x06tenant\"-\n" +
+	"\x1bCancelTenantDeletionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x1cCancelTenantDeletionResponse\x12)\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x11.tenant.v1.TenantR\x06tenant\"-\n" +
+	"\x1bGetTenantPurgeReportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"k\n" +
+	"\x1cGetTenantPurgeReportResponse\x12,\n" +
+	"\x06tables\x18\x01 \x03(\v2\x14.tenant.v1.TableRowsR\x06tables\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\"\x12\n" +
+	"\x10ListPlansRequest\":\n" +
+	"\x11ListPlansResponse\x12%\n" +
+	"\x05plans\x18\x01 \x03(\v2\x0f.tenant.v1.PlanR\x05plans\"%\n" +
+	"\x13ExportTenantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x14ExportTenantResponse\x12'\n" +
+	"\x03job\x18\x01 \x01(\v2\x15.tenant.v1.ArchiveJobR\x03job\"`\n" +
+	"\x13ImportTenantRequest\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\tR\aarchive\x12\x1b\n" +
+	"\tremap_ids\x18\x02 \x01(\bR\bremapIds\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"?\n" +
+	"\x14ImportTenantResponse\x12'\n" +
+	"\x03job\x18\x01 \x01(\v2\x15.tenant.v1.ArchiveJobR\x03job\"&\n" +
+	"\x14GetArchiveJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x15GetArchiveJobResponse\x12'\n" +
+	"\x03job\x18\x01 \x01(\v2\x15.tenant.v1.ArchiveJobR\x03job\"\xcc\x02\n" +
+	"\"CreateFleetSharingAgreementRequest\x12(\n" +
+	"\x10lender_tenant_id\x18\x01 \x01(\tR\x0elenderTenantId\x12,\n" +
+	"\x12borrower_tenant_id\x18\x02 \x01(\tR\x10borrowerTenantId\x129\n" +
+	"\n" +
+	"valid_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12&\n" +
+	"\x0fmax_rental_days\x18\x05 \x01(\x05R\rmaxRentalDays\x12.\n" +
+	"\x13owner_share_percent\x18\x06 \x01(\x05R\x11ownerSharePercent\"e\n" +
+	"#CreateFleetSharingAgreementResponse\x12>\n" +
+	"\tagreement\x18\x01 \x01(\v2 .tenant.v1.FleetSharingAgreementR\tagreement\"7\n" +
+	"%TerminateFleetSharingAgreementRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"h\n" +
+	"&TerminateFleetSharingAgreementResponse\x12>\n" +
+	"\tagreement\x18\x01 \x01(\v2 .tenant.v1.FleetSharingAgreementR\tagreement\"@\n" +
+	"!ListFleetSharingAgreementsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"f\n" +
+	"\"ListFleetSharingAgreementsResponse\x12@\n" +
+	"\n" +
+	"agreements\x18\x01 \x03(\v2 .tenant.v1.FleetSharingAgreementR\n" +
+	"agreements2\xfc\x0f\n" +
+	"\rTenantService\x12g\n" +
+	"\fCreateTenant\x12\x1e.tenant.v1.CreateTenantRequest\x1a\x1f.tenant.v1.CreateTenantResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/tenants\x12c\n" +
+	"\tGetTenant\x12\x1b.tenant.v1.GetTenantRequest\x1a\x1c.tenant.v1.GetTenantResponse\"\x1b\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tenants/{id}\x90\x02\x01\x12w\n" +
+	"\rSuspendTenant\x12\x1f.tenant.v1.SuspendTenantRequest\x1a .tenant.v1.SuspendTenantResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/tenants/{id}:suspend\x12\x83\x01\n" +
+	"\x10ReactivateTenant\x12\".tenant.v1.ReactivateTenantRequest\x1a#.tenant.v1.ReactivateTenantResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/tenants/{id}:reactivate\x12\x83\x01\n" +
+	"\x10ChangeTenantPlan\x12\".tenant.v1.ChangeTenantPlanRequest\x1a#.tenant.v1.ChangeTenantPlanResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/tenants/{id}:changePlan\x12\x9b\x01\n" +
+	"\x16ScheduleTenantDeletion\x12(.tenant.v1.ScheduleTenantDeletionRequest\x1a).tenant.v1.ScheduleTenantDeletionResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/tenants/{id}:scheduleDeletion\x12\x93\x01\n" +
+	"\x14CancelTenantDeletion\x12&.tenant.v1.CancelTenantDeletionRequest\x1a'.tenant.v1.CancelTenantDeletionResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/tenants/{id}:cancelDeletion\x12\x90\x01\n" +
+	"\x14GetTenantPurgeReport\x12&.tenant.v1.GetTenantPurgeReportRequest\x1a'.tenant.v1.GetTenantPurgeReportResponse\"'\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/tenants/{id}/purgeReport\x90\x02\x01\x12\\\n" +
+	"\tListPlans\x12\x1b.tenant.v1.ListPlansRequest\x1a\x1c.tenant.v1.ListPlansResponse\"\x14\x82\xd3\xe4\x93\x02\v\x12\t/v1/plans\x90\x02\x01\x12s\n" +
+	"\fExportTenant\x12\x1e.tenant.v1.ExportTenantRequest\x1a\x1f.tenant.v1.ExportTenantResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/tenants/{id}:export\x12n\n" +
+	"\fImportTenant\x12\x1e.tenant.v1.ImportTenantRequest\x1a\x1f.tenant.v1.ImportTenantResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/tenants:import\x12s\n" +
+	"\rGetArchiveJob\x12\x1f.tenant.v1.GetArchiveJobRequest\x1a .tenant.v1.GetArchiveJobResponse\"\x1f\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/archiveJobs/{id}\x90\x02\x01\x12\xa3\x01\n" +
+	"\x1bCreateFleetSharingAgreement\x12-.tenant.v1.CreateFleetSharingAgreementRequest\x1a..tenant.v1.CreateFleetSharingAgreementResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/fleetSharingAgreements\x12\xbb\x01\n" +
+	"\x1eTerminateFleetSharingAgreement\x120.tenant.v1.TerminateFleetSharingAgreementRequest\x1a1.tenant.v1.TerminateFleetSharingAgreementResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/fleetSharingAgreements/{id}:terminate\x12\xb4\x01\n" +
+	"\x1aListFleetSharingAgreements\x12,.tenant.v1.ListFleetSharingAgreementsRequest\x1a-.tenant.v1.ListFleetSharingAgreementsResponse\"9\x82\xd3\xe4\x93\x020\x12./v1/tenants/{tenant_id}/fleetSharingAgreements\x90\x02\x01BGZEgithub.com/jp-ryuji/go-arch-patterns/api/generated/tenant/v1;tenantv1b\x06proto3"
+
+var (
+	file_api_proto_tenant_v1_tenant_service_proto_rawDescOnce sync.Once
+	file_api_proto_tenant_v1_tenant_service_proto_rawDescData []byte
+)
+
+func file_api_proto_tenant_v1_tenant_service_proto_rawDescGZIP() []byte {
+	file_api_proto_tenant_v1_tenant_service_proto_rawDescOnce.Do(func() {
+		file_api_proto_tenant_v1_tenant_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_tenant_v1_tenant_service_proto_rawDesc), len(file_api_proto_tenant_v1_tenant_service_proto_rawDesc)))
+	})
+	return file_api_proto_tenant_v1_tenant_service_proto_rawDescData
+}
+
+var file_api_proto_tenant_v1_tenant_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_api_proto_tenant_v1_tenant_service_proto_goTypes = []any{
+	(*CreateTenantRequest)(nil),                    // 0: tenant.v1.CreateTenantRequest
+	(*CreateTenantResponse)(nil),                   // 1: tenant.v1.CreateTenantResponse
+	(*GetTenantRequest)(nil),                       // 2: tenant.v1.GetTenantRequest
+	(*GetTenantResponse)(nil),                      // 3: tenant.v1.GetTenantResponse
+	(*SuspendTenantRequest)(nil),                   // 4: tenant.v1.SuspendTenantRequest
+	(*SuspendTenantResponse)(nil),                  // 5: tenant.v1.SuspendTenantResponse
+	(*ReactivateTenantRequest)(nil),                // 6: tenant.v1.ReactivateTenantRequest
+	(*ReactivateTenantResponse)(nil),               // 7: tenant.v1.ReactivateTenantResponse
+	(*ChangeTenantPlanRequest)(nil),                // 8: tenant.v1.ChangeTenantPlanRequest
+	(*ChangeTenantPlanResponse)(nil),               // 9: tenant.v1.ChangeTenantPlanResponse
+	(*ScheduleTenantDeletionRequest)(nil),          // 10: tenant.v1.ScheduleTenantDeletionRequest
+	(*ScheduleTenantDeletionResponse)(nil),         // 11: tenant.v1.ScheduleTenantDeletionResponse
+	(*CancelTenantDeletionRequest)(nil),            // 12: tenant.v1.CancelTenantDeletionRequest
+	(*CancelTenantDeletionResponse)(nil),           // 13: tenant.v1.CancelTenantDeletionResponse
+	(*GetTenantPurgeReportRequest)(nil),            // 14: tenant.v1.GetTenantPurgeReportRequest
+	(*GetTenantPurgeReportResponse)(nil),           // 15: tenant.v1.GetTenantPurgeReportResponse
+	(*ListPlansRequest)(nil),                       // 16: tenant.v1.ListPlansRequest
+	(*ListPlansResponse)(nil),                      // 17: tenant.v1.ListPlansResponse
+	(*ExportTenantRequest)(nil),                    // 18: tenant.v1.ExportTenantRequest
+	(*ExportTenantResponse)(nil),                   // 19: tenant.v1.ExportTenantResponse
+	(*ImportTenantRequest)(nil),                    // 20: tenant.v1.ImportTenantRequest
+	(*ImportTenantResponse)(nil),                   // 21: tenant.v1.ImportTenantResponse
+	(*GetArchiveJobRequest)(nil),                   // 22: tenant.v1.GetArchiveJobRequest
+	(*GetArchiveJobResponse)(nil),                  // 23: tenant.v1.GetArchiveJobResponse
+	(*CreateFleetSharingAgreementRequest)(nil),     // 24: tenant.v1.CreateFleetSharingAgreementRequest
+	(*CreateFleetSharingAgreementResponse)(nil),    // 25: tenant.v1.CreateFleetSharingAgreementResponse
+	(*TerminateFleetSharingAgreementRequest)(nil),  // 26: tenant.v1.TerminateFleetSharingAgreementRequest
+	(*TerminateFleetSharingAgreementResponse)(nil), // 27: tenant.v1.TerminateFleetSharingAgreementResponse
+	(*ListFleetSharingAgreementsRequest)(nil),      // 28: tenant.v1.ListFleetSharingAgreementsRequest
+	(*ListFleetSharingAgreementsResponse)(nil),     // 29: tenant.v1.ListFleetSharingAgreementsResponse
+	(TenantIsolation)(0),                           // 30: tenant.v1.TenantIsolation
+	(*Tenant)(nil),                                 // 31: tenant.v1.Tenant
+	(*TableRows)(nil),                              // 32: tenant.v1.TableRows
+	(*Plan)(nil),                                   // 33: tenant.v1.Plan
+	(*ArchiveJob)(nil),                             // 34: tenant.v1.ArchiveJob
+	(*timestamppb.Timestamp)(nil),                  // 35: google.protobuf.Timestamp
+	(*FleetSharingAgreement)(nil),                  // 36: tenant.v1.FleetSharingAgreement
+}
+var file_api_proto_tenant_v1_tenant_service_proto_depIdxs = []int32{
+	30, // 0: tenant.v1.CreateTenantRequest.isolation:type_name -> tenant.v1.TenantIsolation
+	31, // 1: tenant.v1.CreateTenantResponse.tenant:type_name -> tenant.v1.Tenant
+	31, // 2: tenant.v1.GetTenantResponse.tenant:type_name -> tenant.v1.Tenant
+	31, // 3: tenant.v1.SuspendTenantResponse.tenant:type_name -> tenant.v1.Tenant
+	31, // 4: tenant.v1.ReactivateTenantResponse.tenant:type_name -> tenant.v1.Tenant
+	31, // 5: tenant.v1.ChangeTenantPlanResponse.tenant:type_name -> tenant.v1.Tenant
+	31, // 6: tenant.v1.ScheduleTenantDeletionResponse.tenant:type_name -> tenant.v1.Tenant
+	31, // 7: tenant.v1.CancelTenantDeletionResponse.tenant:type_name -> tenant.v1.Tenant
+	32, // 8: tenant.v1.GetTenantPurgeReportResponse.tables:type_name -> tenant.v1.TableRows
+	33, // 9: tenant.v1.ListPlansResponse.plans:type_name -> tenant.v1.Plan
+	34, // 10: tenant.v1.ExportTenantResponse.job:type_name -> tenant.v1.ArchiveJob
+	34, // 11: tenant.v1.ImportTenantResponse.job:type_name -> tenant.v1.ArchiveJob
+	34, // 12: tenant.v1.GetArchiveJobResponse.job:type_name -> tenant.v1.ArchiveJob
+	35, // 13: tenant.v1.CreateFleetSharingAgreementRequest.valid_from:type_name -> google.protobuf.Timestamp
+	35, // 14: tenant.v1.CreateFleetSharingAgreementRequest.valid_until:type_name -> google.protobuf.Timestamp
+	36, // 15: tenant.v1.CreateFleetSharingAgreementResponse.agreement:type_name -> tenant.v1.FleetSharingAgreement
+	36, // 16: tenant.v1.TerminateFleetSharingAgreementResponse.agreement:type_name -> tenant.v1.FleetSharingAgreement
+	36, // 17: tenant.v1.ListFleetSharingAgreementsResponse.agreements:type_name -> tenant.v1.FleetSharingAgreement
+	0,  // 18: tenant.v1.TenantService.CreateTenant:input_type -> tenant.v1.CreateTenantRequest
+	2,  // 19: tenant.v1.TenantService.GetTenant:input_type -> tenant.v1.GetTenantRequest
+	4,  // 20: tenant.v1.TenantService.SuspendTenant:input_type -> tenant.v1.SuspendTenantRequest
+	6,  // 21: tenant.v1.TenantService.ReactivateTenant:input_type -> tenant.v1.ReactivateTenantRequest
+	8,  // 22: tenant.v1.TenantService.ChangeTenantPlan:input_type -> tenant.v1.ChangeTenantPlanRequest
+	10, // 23: tenant.v1.TenantService.ScheduleTenantDeletion:input_type -> tenant.v1.ScheduleTenantDeletionRequest
+	12, // 24: tenant.v1.TenantService.CancelTenantDeletion:input_type -> tenant.v1.CancelTenantDeletionRequest
+	14, // 25: tenant.v1.TenantService.GetTenantPurgeReport:input_type -> tenant.v1.GetTenantPurgeReportRequest
+	16, // 26: tenant.v1.TenantService.ListPlans:input_type -> tenant.v1.ListPlansRequest
+	18, // 27: tenant.v1.TenantService.ExportTenant:input_type -> tenant.v1.ExportTenantRequest
+	20, // 28: tenant.v1.TenantService.ImportTenant:input_type -> tenant.v1.ImportTenantRequest
+	22, // 29: tenant.v1.TenantService.GetArchiveJob:input_type -> tenant.v1.GetArchiveJobRequest
+	24, // 30: tenant.v1.TenantService.CreateFleetSharingAgreement:input_type -> tenant.v1.CreateFleetSharingAgreementRequest
+	26, // 31: tenant.v1.TenantService.TerminateFleetSharingAgreement:input_type -> tenant.v1.TerminateFleetSharingAgreementRequest
+	28, // 32: tenant.v1.TenantService.ListFleetSharingAgreements:input_type -> tenant.v1.ListFleetSharingAgreementsRequest
+	1,  // 33: tenant.v1.TenantService.CreateTenant:output_type -> tenant.v1.CreateTenantResponse
+	3,  // 34: tenant.v1.TenantService.GetTenant:output_type -> tenant.v1.GetTenantResponse
+	5,  // 35: tenant.v1.TenantService.SuspendTenant:output_type -> tenant.v1.SuspendTenantResponse
+	7,  // 36: tenant.v1.TenantService.ReactivateTenant:output_type -> tenant.v1.ReactivateTenantResponse
+	9,  // 37: tenant.v1.TenantService.ChangeTenantPlan:output_type -> tenant.v1.ChangeTenantPlanResponse
+	11, // 38: tenant.v1.TenantService.ScheduleTenantDeletion:output_type -> tenant.v1.ScheduleTenantDeletionResponse
+	13, // 39: tenant.v1.TenantService.CancelTenantDeletion:output_type -> tenant.v1.CancelTenantDeletionResponse
+	15, // 40: tenant.v1.TenantService.GetTenantPurgeReport:output_type -> tenant.v1.GetTenantPurgeReportResponse
+	17, // 41: tenant.v1.TenantService.ListPlans:output_type -> tenant.v1.ListPlansResponse
+	19, // 42: tenant.v1.TenantService.ExportTenant:output_type -> tenant.v1.ExportTenantResponse
+	21, // 43: tenant.v1.TenantService.ImportTenant:output_type -> tenant.v1.ImportTenantResponse
+	23, // 44: tenant.v1.TenantService.GetArchiveJob:output_type -> tenant.v1.GetArchiveJobResponse
+	25, // 45: tenant.v1.TenantService.CreateFleetSharingAgreement:output_type -> tenant.v1.CreateFleetSharingAgreementResponse
+	27, // 46: tenant.v1.TenantService.TerminateFleetSharingAgreement:output_type -> tenant.v1.TerminateFleetSharingAgreementResponse
+	29, // 47: tenant.v1.TenantService.ListFleetSharingAgreements:output_type -> tenant.v1.ListFleetSharingAgreementsResponse
+	33, // [33:48] is the sub-list for method output_type
+	18, // [18:33] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_tenant_v1_tenant_service_proto_init() }
+func file_api_proto_tenant_v1_tenant_service_proto_init() {
+	if File_api_proto_tenant_v1_tenant_service_proto != nil {
+		return
+	}
+	file_api_proto_tenant_v1_tenant_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_tenant_v1_tenant_service_proto_rawDesc), len(file_api_proto_tenant_v1_tenant_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_tenant_v1_tenant_service_proto_goTypes,
+		DependencyIndexes: file_api_proto_tenant_v1_tenant_service_proto_depIdxs,
+		MessageInfos:      file_api_proto_tenant_v1_tenant_service_proto_msgTypes,
+	}.Build()
+	File_api_proto_tenant_v1_tenant_service_proto = out.File
+	file_api_proto_tenant_v1_tenant_service_proto_goTypes = nil
+	file_api_proto_tenant_v1_tenant_service_proto_depIdxs = nil
+}
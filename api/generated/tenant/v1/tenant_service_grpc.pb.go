@@ -0,0 +1,687 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/proto/tenant/v1/tenant_service.proto
+
+package tenantv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TenantService_CreateTenant_FullMethodName                   = "/tenant.v1.TenantService/CreateTenant"
+	TenantService_GetTenant_FullMethodName                      = "/tenant.v1.TenantService/GetTenant"
+	TenantService_SuspendTenant_FullMethodName                  = "/tenant.v1.TenantService/SuspendTenant"
+	TenantService_ReactivateTenant_FullMethodName               = "/tenant.v1.TenantService/ReactivateTenant"
+	TenantService_ChangeTenantPlan_FullMethodName               = "/tenant.v1.TenantService/ChangeTenantPlan"
+	TenantService_ScheduleTenantDeletion_FullMethodName         = "/tenant.v1.TenantService/ScheduleTenantDeletion"
+	TenantService_CancelTenantDeletion_FullMethodName           = "/tenant.v1.TenantService/CancelTenantDeletion"
+	TenantService_GetTenantPurgeReport_FullMethodName           = "/tenant.v1.TenantService/GetTenantPurgeReport"
+	TenantService_ListPlans_FullMethodName                      = "/tenant.v1.TenantService/ListPlans"
+	TenantService_ExportTenant_FullMethodName                   = "/tenant.v1.TenantService/ExportTenant"
+	TenantService_ImportTenant_FullMethodName                   = "/tenant.v1.TenantService/ImportTenant"
+	TenantService_GetArchiveJob_FullMethodName                  = "/tenant.v1.TenantService/GetArchiveJob"
+	TenantService_CreateFleetSharingAgreement_FullMethodName    = "/tenant.v1.TenantService/CreateFleetSharingAgreement"
+	TenantService_TerminateFleetSharingAgreement_FullMethodName = "/tenant.v1.TenantService/TerminateFleetSharingAgreement"
+	TenantService_ListFleetSharingAgreements_FullMethodName     = "/tenant.v1.TenantService/ListFleetSharingAgreements"
+)
+
+// TenantServiceClient is the client API for TenantService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TenantService provides platform operations on the lifecycle of tenants
+type TenantServiceClient interface {
+	// CreateTenant creates a new active tenant
+	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
+	// GetTenant retrieves a tenant by its ID or by its code
+	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
+	// SuspendTenant blocks a tenant from changing its data
+	SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...grpc.CallOption) (*SuspendTenantResponse, error)
+	// ReactivateTenant lifts the suspension of a tenant
+	ReactivateTenant(ctx context.Context, in *ReactivateTenantRequest, opts ...grpc.CallOption) (*ReactivateTenantResponse, error)
+	// ChangeTenantPlan moves a tenant to another plan
+	ChangeTenantPlan(ctx context.Context, in *ChangeTenantPlanRequest, opts ...grpc.CallOption) (*ChangeTenantPlanResponse, error)
+	// ScheduleTenantDeletion blocks a tenant from changing its data and purges its data once
+	// the grace period is over
+	ScheduleTenantDeletion(ctx context.Context, in *ScheduleTenantDeletionRequest, opts ...grpc.CallOption) (*ScheduleTenantDeletionResponse, error)
+	// CancelTenantDeletion keeps a tenant pending deletion, as long as its grace period is not over
+	CancelTenantDeletion(ctx context.Context, in *CancelTenantDeletionRequest, opts ...grpc.CallOption) (*CancelTenantDeletionResponse, error)
+	// GetTenantPurgeReport counts the rows a purge of a tenant would delete, without deleting any
+	GetTenantPurgeReport(ctx context.Context, in *GetTenantPurgeReportRequest, opts ...grpc.CallOption) (*GetTenantPurgeReportResponse, error)
+	// ListPlans retrieves the plan catalog
+	ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error)
+	// ExportTenant starts writing a tenant to an archive; poll the job with GetArchiveJob
+	ExportTenant(ctx context.Context, in *ExportTenantRequest, opts ...grpc.CallOption) (*ExportTenantResponse, error)
+	// ImportTenant starts restoring a tenant from an archive; poll the job with GetArchiveJob
+	ImportTenant(ctx context.Context, in *ImportTenantRequest, opts ...grpc.CallOption) (*ImportTenantResponse, error)
+	// GetArchiveJob retrieves an export or import job
+	GetArchiveJob(ctx context.Context, in *GetArchiveJobRequest, opts ...grpc.CallOption) (*GetArchiveJobResponse, error)
+	// CreateFleetSharingAgreement lets a tenant book the cars of a sibling tenant of its franchise
+	CreateFleetSharingAgreement(ctx context.Context, in *CreateFleetSharingAgreementRequest, opts ...grpc.CallOption) (*CreateFleetSharingAgreementResponse, error)
+	// TerminateFleetSharingAgreement ends an agreement; rentals booked under it are kept
+	TerminateFleetSharingAgreement(ctx context.Context, in *TerminateFleetSharingAgreementRequest, opts ...grpc.CallOption) (*TerminateFleetSharingAgreementResponse, error)
+	// ListFleetSharingAgreements retrieves the agreements a tenant lends or borrows under
+	ListFleetSharingAgreements(ctx context.Context, in *ListFleetSharingAgreementsRequest, opts ...grpc.CallOption) (*ListFleetSharingAgreementsResponse, error)
+}
+
+type tenantServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantServiceClient(cc grpc.ClientConnInterface) TenantServiceClient {
+	return &tenantServiceClient{cc}
+}
+
+func (c *tenantServiceClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_CreateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) SuspendTenant(ctx context.Context, in *SuspendTenantRequest, opts ...grpc.CallOption) (*SuspendTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_SuspendTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ReactivateTenant(ctx context.Context, in *ReactivateTenantRequest, opts ...grpc.CallOption) (*ReactivateTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_ReactivateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ChangeTenantPlan(ctx context.Context, in *ChangeTenantPlanRequest, opts ...grpc.CallOption) (*ChangeTenantPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeTenantPlanResponse)
+	err := c.cc.Invoke(ctx, TenantService_ChangeTenantPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ScheduleTenantDeletion(ctx context.Context, in *ScheduleTenantDeletionRequest, opts ...grpc.CallOption) (*ScheduleTenantDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleTenantDeletionResponse)
+	err := c.cc.Invoke(ctx, TenantService_ScheduleTenantDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) CancelTenantDeletion(ctx context.Context, in *CancelTenantDeletionRequest, opts ...grpc.CallOption) (*CancelTenantDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelTenantDeletionResponse)
+	err := c.cc.Invoke(ctx, TenantService_CancelTenantDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetTenantPurgeReport(ctx context.Context, in *GetTenantPurgeReportRequest, opts ...grpc.CallOption) (*GetTenantPurgeReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantPurgeReportResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetTenantPurgeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlansResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ExportTenant(ctx context.Context, in *ExportTenantRequest, opts ...grpc.CallOption) (*ExportTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_ExportTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ImportTenant(ctx context.Context, in *ImportTenantRequest, opts ...grpc.CallOption) (*ImportTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_ImportTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetArchiveJob(ctx context.Context, in *GetArchiveJobRequest, opts ...grpc.CallOption) (*GetArchiveJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArchiveJobResponse)
+	err := c.cc.Invoke(ctx, TenantService_GetArchiveJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) CreateFleetSharingAgreement(ctx context.Context, in *CreateFleetSharingAgreementRequest, opts ...grpc.CallOption) (*CreateFleetSharingAgreementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFleetSharingAgreementResponse)
+	err := c.cc.Invoke(ctx, TenantService_CreateFleetSharingAgreement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) TerminateFleetSharingAgreement(ctx context.Context, in *TerminateFleetSharingAgreementRequest, opts ...grpc.CallOption) (*TerminateFleetSharingAgreementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TerminateFleetSharingAgreementResponse)
+	err := c.cc.Invoke(ctx, TenantService_TerminateFleetSharingAgreement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) ListFleetSharingAgreements(ctx context.Context, in *ListFleetSharingAgreementsRequest, opts ...grpc.CallOption) (*ListFleetSharingAgreementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFleetSharingAgreementsResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListFleetSharingAgreements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantServiceServer is the server API for TenantService service.
+// All implementations should embed UnimplementedTenantServiceServer
+// for forward compatibility.
+//
+// TenantService provides platform operations on the lifecycle of tenants
+type TenantServiceServer interface {
+	// CreateTenant creates a new active tenant
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
+	// GetTenant retrieves a tenant by its ID or by its code
+	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
+	// SuspendTenant blocks a tenant from changing its data
+	SuspendTenant(context.Context, *SuspendTenantRequest) (*SuspendTenantResponse, error)
+	// ReactivateTenant lifts the suspension of a tenant
+	ReactivateTenant(context.Context, *ReactivateTenantRequest) (*ReactivateTenantResponse, error)
+	// ChangeTenantPlan moves a tenant to another plan
+	ChangeTenantPlan(context.Context, *ChangeTenantPlanRequest) (*ChangeTenantPlanResponse, error)
+	// ScheduleTenantDeletion blocks a tenant from changing its data and purges its data once
+	// the grace period is over
+	ScheduleTenantDeletion(context.Context, *ScheduleTenantDeletionRequest) (*ScheduleTenantDeletionResponse, error)
+	// CancelTenantDeletion keeps a tenant pending deletion, as long as its grace period is not over
+	CancelTenantDeletion(context.Context, *CancelTenantDeletionRequest) (*CancelTenantDeletionResponse, error)
+	// GetTenantPurgeReport counts the rows a purge of a tenant would delete, without deleting any
+	GetTenantPurgeReport(context.Context, *GetTenantPurgeReportRequest) (*GetTenantPurgeReportResponse, error)
+	// ListPlans retrieves the plan catalog
+	ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error)
+	// ExportTenant starts writing a tenant to an archive; poll the job with GetArchiveJob
+	ExportTenant(context.Context, *ExportTenantRequest) (*ExportTenantResponse, error)
+	// ImportTenant starts restoring a tenant from an archive; poll the job with GetArchiveJob
+	ImportTenant(context.Context, *ImportTenantRequest) (*ImportTenantResponse, error)
+	// GetArchiveJob retrieves an export or import job
+	GetArchiveJob(context.Context, *GetArchiveJobRequest) (*GetArchiveJobResponse, error)
+	// CreateFleetSharingAgreement lets a tenant book the cars of a sibling tenant of its franchise
+	CreateFleetSharingAgreement(context.Context, *CreateFleetSharingAgreementRequest) (*CreateFleetSharingAgreementResponse, error)
+	// TerminateFleetSharingAgreement ends an agreement; rentals booked under it are kept
+	TerminateFleetSharingAgreement(context.Context, *TerminateFleetSharingAgreementRequest) (*TerminateFleetSharingAgreementResponse, error)
+	// ListFleetSharingAgreements retrieves the agreements a tenant lends or borrows under
+	ListFleetSharingAgreements(context.Context, *ListFleetSharingAgreementsRequest) (*ListFleetSharingAgreementsResponse, error)
+}
+
+// UnimplementedTenantServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenantServiceServer struct{}
+
+func (UnimplementedTenantServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) SuspendTenant(context.Context, *SuspendTenantRequest) (*SuspendTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) ReactivateTenant(context.Context, *ReactivateTenantRequest) (*ReactivateTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) ChangeTenantPlan(context.Context, *ChangeTenantPlanRequest) (*ChangeTenantPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTenantPlan not implemented")
+}
+func (UnimplementedTenantServiceServer) ScheduleTenantDeletion(context.Context, *ScheduleTenantDeletionRequest) (*ScheduleTenantDeletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTenantDeletion not implemented")
+}
+func (UnimplementedTenantServiceServer) CancelTenantDeletion(context.Context, *CancelTenantDeletionRequest) (*CancelTenantDeletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTenantDeletion not implemented")
+}
+func (UnimplementedTenantServiceServer) GetTenantPurgeReport(context.Context, *GetTenantPurgeReportRequest) (*GetTenantPurgeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantPurgeReport not implemented")
+}
+func (UnimplementedTenantServiceServer) ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlans not implemented")
+}
+func (UnimplementedTenantServiceServer) ExportTenant(context.Context, *ExportTenantRequest) (*ExportTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) ImportTenant(context.Context, *ImportTenantRequest) (*ImportTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) GetArchiveJob(context.Context, *GetArchiveJobRequest) (*GetArchiveJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchiveJob not implemented")
+}
+func (UnimplementedTenantServiceServer) CreateFleetSharingAgreement(context.Context, *CreateFleetSharingAgreementRequest) (*CreateFleetSharingAgreementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFleetSharingAgreement not implemented")
+}
+func (UnimplementedTenantServiceServer) TerminateFleetSharingAgreement(context.Context, *TerminateFleetSharingAgreementRequest) (*TerminateFleetSharingAgreementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateFleetSharingAgreement not implemented")
+}
+func (UnimplementedTenantServiceServer) ListFleetSharingAgreements(context.Context, *ListFleetSharingAgreementsRequest) (*ListFleetSharingAgreementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFleetSharingAgreements not implemented")
+}
+func (UnimplementedTenantServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTenantServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantServiceServer will
+// result in compilation errors.
+type UnsafeTenantServiceServer interface {
+	mustEmbedUnimplementedTenantServiceServer()
+}
+
+func RegisterTenantServiceServer(s grpc.ServiceRegistrar, srv TenantServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenantServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenantService_ServiceDesc, srv)
+}
+
+func _TenantService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_SuspendTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).SuspendTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_SuspendTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).SuspendTenant(ctx, req.(*SuspendTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ReactivateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ReactivateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ReactivateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ReactivateTenant(ctx, req.(*ReactivateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ChangeTenantPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeTenantPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ChangeTenantPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ChangeTenantPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ChangeTenantPlan(ctx, req.(*ChangeTenantPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ScheduleTenantDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTenantDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ScheduleTenantDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ScheduleTenantDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ScheduleTenantDeletion(ctx, req.(*ScheduleTenantDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_CancelTenantDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTenantDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).CancelTenantDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_CancelTenantDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).CancelTenantDeletion(ctx, req.(*CancelTenantDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetTenantPurgeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantPurgeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetTenantPurgeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetTenantPurgeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetTenantPurgeReport(ctx, req.(*GetTenantPurgeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ListPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ListPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ListPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ListPlans(ctx, req.(*ListPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ExportTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ExportTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ExportTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ExportTenant(ctx, req.(*ExportTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ImportTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ImportTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ImportTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ImportTenant(ctx, req.(*ImportTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetArchiveJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchiveJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetArchiveJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetArchiveJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetArchiveJob(ctx, req.(*GetArchiveJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_CreateFleetSharingAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFleetSharingAgreementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).CreateFleetSharingAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_CreateFleetSharingAgreement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).CreateFleetSharingAgreement(ctx, req.(*CreateFleetSharingAgreementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_TerminateFleetSharingAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateFleetSharingAgreementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).TerminateFleetSharingAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_TerminateFleetSharingAgreement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).TerminateFleetSharingAgreement(ctx, req.(*TerminateFleetSharingAgreementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_ListFleetSharingAgreements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFleetSharingAgreementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ListFleetSharingAgreements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ListFleetSharingAgreements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ListFleetSharingAgreements(ctx, req.(*ListFleetSharingAgreementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantService_ServiceDesc is the grpc.ServiceDesc for TenantService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tenant.v1.TenantService",
+	HandlerType: (*TenantServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTenant",
+			Handler:    _TenantService_CreateTenant_Handler,
+		},
+		{
+			MethodName: "GetTenant",
+			Handler:    _TenantService_GetTenant_Handler,
+		},
+		{
+			MethodName: "SuspendTenant",
+			Handler:    _TenantService_SuspendTenant_Handler,
+		},
+		{
+			MethodName: "ReactivateTenant",
+			Handler:    _TenantService_ReactivateTenant_Handler,
+		},
+		{
+			MethodName: "ChangeTenantPlan",
+			Handler:    _TenantService_ChangeTenantPlan_Handler,
+		},
+		{
+			MethodName: "ScheduleTenantDeletion",
+			Handler:    _TenantService_ScheduleTenantDeletion_Handler,
+		},
+		{
+			MethodName: "CancelTenantDeletion",
+			Handler:    _TenantService_CancelTenantDeletion_Handler,
+		},
+		{
+			MethodName: "GetTenantPurgeReport",
+			Handler:    _TenantService_GetTenantPurgeReport_Handler,
+		},
+		{
+			MethodName: "ListPlans",
+			Handler:    _TenantService_ListPlans_Handler,
+		},
+		{
+			MethodName: "ExportTenant",
+			Handler:    _TenantService_ExportTenant_Handler,
+		},
+		{
+			MethodName: "ImportTenant",
+			Handler:    _TenantService_ImportTenant_Handler,
+		},
+		{
+			MethodName: "GetArchiveJob",
+			Handler:    _TenantService_GetArchiveJob_Handler,
+		},
+		{
+			MethodName: "CreateFleetSharingAgreement",
+			Handler:    _TenantService_CreateFleetSharingAgreement_Handler,
+		},
+		{
+			MethodName: "TerminateFleetSharingAgreement",
+			Handler:    _TenantService_TerminateFleetSharingAgreement_Handler,
+		},
+		{
+			MethodName: "ListFleetSharingAgreements",
+			Handler:    _TenantService_ListFleetSharingAgreements_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/tenant/v1/tenant_service.proto",
+}
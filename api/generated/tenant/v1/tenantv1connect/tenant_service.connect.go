@@ -0,0 +1,555 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/proto/tenant/v1/tenant_service.proto
+
+package tenantv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenant/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TenantServiceName is the fully-qualified name of the TenantService service.
+	TenantServiceName = "tenant.v1.TenantService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TenantServiceCreateTenantProcedure is the fully-qualified name of the TenantService's
+	// CreateTenant RPC.
+	TenantServiceCreateTenantProcedure = "/tenant.v1.TenantService/CreateTenant"
+	// TenantServiceGetTenantProcedure is the fully-qualified name of the TenantService's GetTenant RPC.
+	TenantServiceGetTenantProcedure = "/tenant.v1.TenantService/GetTenant"
+	// TenantServiceSuspendTenantProcedure is the fully-qualified name of the TenantService's
+	// SuspendTenant RPC.
+	TenantServiceSuspendTenantProcedure = "/tenant.v1.TenantService/SuspendTenant"
+	// TenantServiceReactivateTenantProcedure is the fully-qualified name of the TenantService's
+	// ReactivateTenant RPC.
+	TenantServiceReactivateTenantProcedure = "/tenant.v1.TenantService/ReactivateTenant"
+	// TenantServiceChangeTenantPlanProcedure is the fully-qualified name of the TenantService's
+	// ChangeTenantPlan RPC.
+	TenantServiceChangeTenantPlanProcedure = "/tenant.v1.TenantService/ChangeTenantPlan"
+	// TenantServiceScheduleTenantDeletionProcedure is the fully-qualified name of the TenantService's
+	// ScheduleTenantDeletion RPC.
+	TenantServiceScheduleTenantDeletionProcedure = "/tenant.v1.TenantService/ScheduleTenantDeletion"
+	// TenantServiceCancelTenantDeletionProcedure is the fully-qualified name of the TenantService's
+	// CancelTenantDeletion RPC.
+	TenantServiceCancelTenantDeletionProcedure = "/tenant.v1.TenantService/CancelTenantDeletion"
+	// TenantServiceGetTenantPurgeReportProcedure is the fully-qualified name of the TenantService's
+	// GetTenantPurgeReport RPC.
+	TenantServiceGetTenantPurgeReportProcedure = "/tenant.v1.TenantService/GetTenantPurgeReport"
+	// TenantServiceListPlansProcedure is the fully-qualified name of the TenantService's ListPlans RPC.
+	TenantServiceListPlansProcedure = "/tenant.v1.TenantService/ListPlans"
+	// TenantServiceExportTenantProcedure is the fully-qualified name of the TenantService's
+	// ExportTenant RPC.
+	TenantServiceExportTenantProcedure = "/tenant.v1.TenantService/ExportTenant"
+	// TenantServiceImportTenantProcedure is the fully-qualified name of the TenantService's
+	// ImportTenant RPC.
+	TenantServiceImportTenantProcedure = "/tenant.v1.TenantService/ImportTenant"
+	// TenantServiceGetArchiveJobProcedure is the fully-qualified name of the TenantService's
+	// GetArchiveJob RPC.
+	TenantServiceGetArchiveJobProcedure = "/tenant.v1.TenantService/GetArchiveJob"
+	// TenantServiceCreateFleetSharingAgreementProcedure is the fully-qualified name of the
+	// TenantService's CreateFleetSharingAgreement RPC.
+	TenantServiceCreateFleetSharingAgreementProcedure = "/tenant.v1.TenantService/CreateFleetSharingAgreement"
+	// TenantServiceTerminateFleetSharingAgreementProcedure is the fully-qualified name of the
+	// TenantService's TerminateFleetSharingAgreement RPC.
+	TenantServiceTerminateFleetSharingAgreementProcedure = "/tenant.v1.TenantService/TerminateFleetSharingAgreement"
+	// TenantServiceListFleetSharingAgreementsProcedure is the fully-qualified name of the
+	// TenantService's ListFleetSharingAgreements RPC.
+	TenantServiceListFleetSharingAgreementsProcedure = "/tenant.v1.TenantService/ListFleetSharingAgreements"
+)
+
+// TenantServiceClient is a client for the tenant.v1.TenantService service.
+type TenantServiceClient interface {
+	// CreateTenant creates a new active tenant
+	CreateTenant(context.Context, *connect.Request[v1.CreateTenantRequest]) (*connect.Response[v1.CreateTenantResponse], error)
+	// GetTenant retrieves a tenant by its ID or by its code
+	GetTenant(context.Context, *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error)
+	// SuspendTenant blocks a tenant from changing its data
+	SuspendTenant(context.Context, *connect.Request[v1.SuspendTenantRequest]) (*connect.Response[v1.SuspendTenantResponse], error)
+	// ReactivateTenant lifts the suspension of a tenant
+	ReactivateTenant(context.Context, *connect.Request[v1.ReactivateTenantRequest]) (*connect.Response[v1.ReactivateTenantResponse], error)
+	// ChangeTenantPlan moves a tenant to another plan
+	ChangeTenantPlan(context.Context, *connect.Request[v1.ChangeTenantPlanRequest]) (*connect.Response[v1.ChangeTenantPlanResponse], error)
+	// ScheduleTenantDeletion blocks a tenant from changing its data and purges its data once
+	// the grace period is over
+	ScheduleTenantDeletion(context.Context, *connect.Request[v1.ScheduleTenantDeletionRequest]) (*connect.Response[v1.ScheduleTenantDeletionResponse], error)
+	// CancelTenantDeletion keeps a tenant pending deletion, as long as its grace period is not over
+	CancelTenantDeletion(context.Context, *connect.Request[v1.CancelTenantDeletionRequest]) (*connect.Response[v1.CancelTenantDeletionResponse], error)
+	// GetTenantPurgeReport counts the rows a purge of a tenant would delete, without deleting any
+	GetTenantPurgeReport(context.Context, *connect.Request[v1.GetTenantPurgeReportRequest]) (*connect.Response[v1.GetTenantPurgeReportResponse], error)
+	// ListPlans retrieves the plan catalog
+	ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error)
+	// ExportTenant starts writing a tenant to an archive; poll the job with GetArchiveJob
+	ExportTenant(context.Context, *connect.Request[v1.ExportTenantRequest]) (*connect.Response[v1.ExportTenantResponse], error)
+	// ImportTenant starts restoring a tenant from an archive; poll the job with GetArchiveJob
+	ImportTenant(context.Context, *connect.Request[v1.ImportTenantRequest]) (*connect.Response[v1.ImportTenantResponse], error)
+	// GetArchiveJob retrieves an export or import job
+	GetArchiveJob(context.Context, *connect.Request[v1.GetArchiveJobRequest]) (*connect.Response[v1.GetArchiveJobResponse], error)
+	// CreateFleetSharingAgreement lets a tenant book the cars of a sibling tenant of its franchise
+	CreateFleetSharingAgreement(context.Context, *connect.Request[v1.CreateFleetSharingAgreementRequest]) (*connect.Response[v1.CreateFleetSharingAgreementResponse], error)
+	// TerminateFleetSharingAgreement ends an agreement; rentals booked under it are kept
+	TerminateFleetSharingAgreement(context.Context, *connect.Request[v1.TerminateFleetSharingAgreementRequest]) (*connect.Response[v1.TerminateFleetSharingAgreementResponse], error)
+	// ListFleetSharingAgreements retrieves the agreements a tenant lends or borrows under
+	ListFleetSharingAgreements(context.Context, *connect.Request[v1.ListFleetSharingAgreementsRequest]) (*connect.Response[v1.ListFleetSharingAgreementsResponse], error)
+}
+
+// NewTenantServiceClient constructs a client for the tenant.v1.TenantService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTenantServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TenantServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tenantServiceMethods := v1.File_api_proto_tenant_v1_tenant_service_proto.Services().ByName("TenantService").Methods()
+	return &tenantServiceClient{
+		createTenant: connect.NewClient[v1.CreateTenantRequest, v1.CreateTenantResponse](
+			httpClient,
+			baseURL+TenantServiceCreateTenantProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("CreateTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		getTenant: connect.NewClient[v1.GetTenantRequest, v1.GetTenantResponse](
+			httpClient,
+			baseURL+TenantServiceGetTenantProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("GetTenant")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		suspendTenant: connect.NewClient[v1.SuspendTenantRequest, v1.SuspendTenantResponse](
+			httpClient,
+			baseURL+TenantServiceSuspendTenantProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("SuspendTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		reactivateTenant: connect.NewClient[v1.ReactivateTenantRequest, v1.ReactivateTenantResponse](
+			httpClient,
+			baseURL+TenantServiceReactivateTenantProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("ReactivateTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		changeTenantPlan: connect.NewClient[v1.ChangeTenantPlanRequest, v1.ChangeTenantPlanResponse](
+			httpClient,
+			baseURL+TenantServiceChangeTenantPlanProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("ChangeTenantPlan")),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleTenantDeletion: connect.NewClient[v1.ScheduleTenantDeletionRequest, v1.ScheduleTenantDeletionResponse](
+			httpClient,
+			baseURL+TenantServiceScheduleTenantDeletionProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("ScheduleTenantDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelTenantDeletion: connect.NewClient[v1.CancelTenantDeletionRequest, v1.CancelTenantDeletionResponse](
+			httpClient,
+			baseURL+TenantServiceCancelTenantDeletionProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("CancelTenantDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		getTenantPurgeReport: connect.NewClient[v1.GetTenantPurgeReportRequest, v1.GetTenantPurgeReportResponse](
+			httpClient,
+			baseURL+TenantServiceGetTenantPurgeReportProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("GetTenantPurgeReport")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listPlans: connect.NewClient[v1.ListPlansRequest, v1.ListPlansResponse](
+			httpClient,
+			baseURL+TenantServiceListPlansProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("ListPlans")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		exportTenant: connect.NewClient[v1.ExportTenantRequest, v1.ExportTenantResponse](
+			httpClient,
+			baseURL+TenantServiceExportTenantProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("ExportTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		importTenant: connect.NewClient[v1.ImportTenantRequest, v1.ImportTenantResponse](
+			httpClient,
+			baseURL+TenantServiceImportTenantProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("ImportTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		getArchiveJob: connect.NewClient[v1.GetArchiveJobRequest, v1.GetArchiveJobResponse](
+			httpClient,
+			baseURL+TenantServiceGetArchiveJobProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("GetArchiveJob")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createFleetSharingAgreement: connect.NewClient[v1.CreateFleetSharingAgreementRequest, v1.CreateFleetSharingAgreementResponse](
+			httpClient,
+			baseURL+TenantServiceCreateFleetSharingAgreementProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("CreateFleetSharingAgreement")),
+			connect.WithClientOptions(opts...),
+		),
+		terminateFleetSharingAgreement: connect.NewClient[v1.TerminateFleetSharingAgreementRequest, v1.TerminateFleetSharingAgreementResponse](
+			httpClient,
+			baseURL+TenantServiceTerminateFleetSharingAgreementProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("TerminateFleetSharingAgreement")),
+			connect.WithClientOptions(opts...),
+		),
+		listFleetSharingAgreements: connect.NewClient[v1.ListFleetSharingAgreementsRequest, v1.ListFleetSharingAgreementsResponse](
+			httpClient,
+			baseURL+TenantServiceListFleetSharingAgreementsProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("ListFleetSharingAgreements")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tenantServiceClient implements TenantServiceClient.
+type tenantServiceClient struct {
+	createTenant                   *connect.Client[v1.CreateTenantRequest, v1.CreateTenantResponse]
+	getTenant                      *connect.Client[v1.GetTenantRequest, v1.GetTenantResponse]
+	suspendTenant                  *connect.Client[v1.SuspendTenantRequest, v1.SuspendTenantResponse]
+	reactivateTenant               *connect.Client[v1.ReactivateTenantRequest, v1.ReactivateTenantResponse]
+	changeTenantPlan               *connect.Client[v1.ChangeTenantPlanRequest, v1.ChangeTenantPlanResponse]
+	scheduleTenantDeletion         *connect.Client[v1.ScheduleTenantDeletionRequest, v1.ScheduleTenantDeletionResponse]
+	cancelTenantDeletion           *connect.Client[v1.CancelTenantDeletionRequest, v1.CancelTenantDeletionResponse]
+	getTenantPurgeReport           *connect.Client[v1.GetTenantPurgeReportRequest, v1.GetTenantPurgeReportResponse]
+	listPlans                      *connect.Client[v1.ListPlansRequest, v1.ListPlansResponse]
+	exportTenant                   *connect.Client[v1.ExportTenantRequest, v1.ExportTenantResponse]
+	importTenant                   *connect.Client[v1.ImportTenantRequest, v1.ImportTenantResponse]
+	getArchiveJob                  *connect.Client[v1.GetArchiveJobRequest, v1.GetArchiveJobResponse]
+	createFleetSharingAgreement    *connect.Client[v1.CreateFleetSharingAgreementRequest, v1.CreateFleetSharingAgreementResponse]
+	terminateFleetSharingAgreement *connect.Client[v1.TerminateFleetSharingAgreementRequest, v1.TerminateFleetSharingAgreementResponse]
+	listFleetSharingAgreements     *connect.Client[v1.ListFleetSharingAgreementsRequest, v1.ListFleetSharingAgreementsResponse]
+}
+
+// CreateTenant calls tenant.v1.TenantService.CreateTenant.
+func (c *tenantServiceClient) CreateTenant(ctx context.Context, req *connect.Request[v1.CreateTenantRequest]) (*connect.Response[v1.CreateTenantResponse], error) {
+	return c.createTenant.CallUnary(ctx, req)
+}
+
+// GetTenant calls tenant.v1.TenantService.GetTenant.
+func (c *tenantServiceClient) GetTenant(ctx context.Context, req *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error) {
+	return c.getTenant.CallUnary(ctx, req)
+}
+
+// SuspendTenant calls tenant.v1.TenantService.SuspendTenant.
+func (c *tenantServiceClient) SuspendTenant(ctx context.Context, req *connect.Request[v1.SuspendTenantRequest]) (*connect.Response[v1.SuspendTenantResponse], error) {
+	return c.suspendTenant.CallUnary(ctx, req)
+}
+
+// ReactivateTenant calls tenant.v1.TenantService.ReactivateTenant.
+func (c *tenantServiceClient) ReactivateTenant(ctx context.Context, req *connect.Request[v1.ReactivateTenantRequest]) (*connect.Response[v1.ReactivateTenantResponse], error) {
+	return c.reactivateTenant.CallUnary(ctx, req)
+}
+
+// ChangeTenantPlan calls tenant.v1.TenantService.ChangeTenantPlan.
+func (c *tenantServiceClient) ChangeTenantPlan(ctx context.Context, req *connect.Request[v1.ChangeTenantPlanRequest]) (*connect.Response[v1.ChangeTenantPlanResponse], error) {
+	return c.changeTenantPlan.CallUnary(ctx, req)
+}
+
+// ScheduleTenantDeletion calls tenant.v1.TenantService.ScheduleTenantDeletion.
+func (c *tenantServiceClient) ScheduleTenantDeletion(ctx context.Context, req *connect.Request[v1.ScheduleTenantDeletionRequest]) (*connect.Response[v1.ScheduleTenantDeletionResponse], error) {
+	return c.scheduleTenantDeletion.CallUnary(ctx, req)
+}
+
+// CancelTenantDeletion calls tenant.v1.TenantService.CancelTenantDeletion.
+func (c *tenantServiceClient) CancelTenantDeletion(ctx context.Context, req *connect.Request[v1.CancelTenantDeletionRequest]) (*connect.Response[v1.CancelTenantDeletionResponse], error) {
+	return c.cancelTenantDeletion.CallUnary(ctx, req)
+}
+
+// GetTenantPurgeReport calls tenant.v1.TenantService.GetTenantPurgeReport.
+func (c *tenantServiceClient) GetTenantPurgeReport(ctx context.Context, req *connect.Request[v1.GetTenantPurgeReportRequest]) (*connect.Response[v1.GetTenantPurgeReportResponse], error) {
+	return c.getTenantPurgeReport.CallUnary(ctx, req)
+}
+
+// ListPlans calls tenant.v1.TenantService.ListPlans.
+func (c *tenantServiceClient) ListPlans(ctx context.Context, req *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error) {
+	return c.listPlans.CallUnary(ctx, req)
+}
+
+// ExportTenant calls tenant.v1.TenantService.ExportTenant.
+func (c *tenantServiceClient) ExportTenant(ctx context.Context, req *connect.Request[v1.ExportTenantRequest]) (*connect.Response[v1.ExportTenantResponse], error) {
+	return c.exportTenant.CallUnary(ctx, req)
+}
+
+// ImportTenant calls tenant.v1.TenantService.ImportTenant.
+func (c *tenantServiceClient) ImportTenant(ctx context.Context, req *connect.Request[v1.ImportTenantRequest]) (*connect.Response[v1.ImportTenantResponse], error) {
+	return c.importTenant.CallUnary(ctx, req)
+}
+
+// GetArchiveJob calls tenant.v1.TenantService.GetArchiveJob.
+func (c *tenantServiceClient) GetArchiveJob(ctx context.Context, req *connect.Request[v1.GetArchiveJobRequest]) (*connect.Response[v1.GetArchiveJobResponse], error) {
+	return c.getArchiveJob.CallUnary(ctx, req)
+}
+
+// CreateFleetSharingAgreement calls tenant.v1.TenantService.CreateFleetSharingAgreement.
+func (c *tenantServiceClient) CreateFleetSharingAgreement(ctx context.Context, req *connect.Request[v1.CreateFleetSharingAgreementRequest]) (*connect.Response[v1.CreateFleetSharingAgreementResponse], error) {
+	return c.createFleetSharingAgreement.CallUnary(ctx, req)
+}
+
+// TerminateFleetSharingAgreement calls tenant.v1.TenantService.TerminateFleetSharingAgreement.
+func (c *tenantServiceClient) TerminateFleetSharingAgreement(ctx context.Context, req *connect.Request[v1.TerminateFleetSharingAgreementRequest]) (*connect.Response[v1.TerminateFleetSharingAgreementResponse], error) {
+	return c.terminateFleetSharingAgreement.CallUnary(ctx, req)
+}
+
+// ListFleetSharingAgreements calls tenant.v1.TenantService.ListFleetSharingAgreements.
+func (c *tenantServiceClient) ListFleetSharingAgreements(ctx context.Context, req *connect.Request[v1.ListFleetSharingAgreementsRequest]) (*connect.Response[v1.ListFleetSharingAgreementsResponse], error) {
+	return c.listFleetSharingAgreements.CallUnary(ctx, req)
+}
+
+// TenantServiceHandler is an implementation of the tenant.v1.TenantService service.
+type TenantServiceHandler interface {
+	// CreateTenant creates a new active tenant
+	CreateTenant(context.Context, *connect.Request[v1.CreateTenantRequest]) (*connect.Response[v1.CreateTenantResponse], error)
+	// GetTenant retrieves a tenant by its ID or by its code
+	GetTenant(context.Context, *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error)
+	// SuspendTenant blocks a tenant from changing its data
+	SuspendTenant(context.Context, *connect.Request[v1.SuspendTenantRequest]) (*connect.Response[v1.SuspendTenantResponse], error)
+	// ReactivateTenant lifts the suspension of a tenant
+	ReactivateTenant(context.Context, *connect.Request[v1.ReactivateTenantRequest]) (*connect.Response[v1.ReactivateTenantResponse], error)
+	// ChangeTenantPlan moves a tenant to another plan
+	ChangeTenantPlan(context.Context, *connect.Request[v1.ChangeTenantPlanRequest]) (*connect.Response[v1.ChangeTenantPlanResponse], error)
+	// ScheduleTenantDeletion blocks a tenant from changing its data and purges its data once
+	// the grace period is over
+	ScheduleTenantDeletion(context.Context, *connect.Request[v1.ScheduleTenantDeletionRequest]) (*connect.Response[v1.ScheduleTenantDeletionResponse], error)
+	// CancelTenantDeletion keeps a tenant pending deletion, as long as its grace period is not over
+	CancelTenantDeletion(context.Context, *connect.Request[v1.CancelTenantDeletionRequest]) (*connect.Response[v1.CancelTenantDeletionResponse], error)
+	// GetTenantPurgeReport counts the rows a purge of a tenant would delete, without deleting any
+	GetTenantPurgeReport(context.Context, *connect.Request[v1.GetTenantPurgeReportRequest]) (*connect.Response[v1.GetTenantPurgeReportResponse], error)
+	// ListPlans retrieves the plan catalog
+	ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error)
+	// ExportTenant starts writing a tenant to an archive; poll the job with GetArchiveJob
+	ExportTenant(context.Context, *connect.Request[v1.ExportTenantRequest]) (*connect.Response[v1.ExportTenantResponse], error)
+	// ImportTenant starts restoring a tenant from an archive; poll the job with GetArchiveJob
+	ImportTenant(context.Context, *connect.Request[v1.ImportTenantRequest]) (*connect.Response[v1.ImportTenantResponse], error)
+	// GetArchiveJob retrieves an export or import job
+	GetArchiveJob(context.Context, *connect.Request[v1.GetArchiveJobRequest]) (*connect.Response[v1.GetArchiveJobResponse], error)
+	// CreateFleetSharingAgreement lets a tenant book the cars of a sibling tenant of its franchise
+	CreateFleetSharingAgreement(context.Context, *connect.Request[v1.CreateFleetSharingAgreementRequest]) (*connect.Response[v1.CreateFleetSharingAgreementResponse], error)
+	// TerminateFleetSharingAgreement ends an agreement; rentals booked under it are kept
+	TerminateFleetSharingAgreement(context.Context, *connect.Request[v1.TerminateFleetSharingAgreementRequest]) (*connect.Response[v1.TerminateFleetSharingAgreementResponse], error)
+	// ListFleetSharingAgreements retrieves the agreements a tenant lends or borrows under
+	ListFleetSharingAgreements(context.Context, *connect.Request[v1.ListFleetSharingAgreementsRequest]) (*connect.Response[v1.ListFleetSharingAgreementsResponse], error)
+}
+
+// NewTenantServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTenantServiceHandler(svc TenantServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tenantServiceMethods := v1.File_api_proto_tenant_v1_tenant_service_proto.Services().ByName("TenantService").Methods()
+	tenantServiceCreateTenantHandler := connect.NewUnaryHandler(
+		TenantServiceCreateTenantProcedure,
+		svc.CreateTenant,
+		connect.WithSchema(tenantServiceMethods.ByName("CreateTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceGetTenantHandler := connect.NewUnaryHandler(
+		TenantServiceGetTenantProcedure,
+		svc.GetTenant,
+		connect.WithSchema(tenantServiceMethods.ByName("GetTenant")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceSuspendTenantHandler := connect.NewUnaryHandler(
+		TenantServiceSuspendTenantProcedure,
+		svc.SuspendTenant,
+		connect.WithSchema(tenantServiceMethods.ByName("SuspendTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceReactivateTenantHandler := connect.NewUnaryHandler(
+		TenantServiceReactivateTenantProcedure,
+		svc.ReactivateTenant,
+		connect.WithSchema(tenantServiceMethods.ByName("ReactivateTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceChangeTenantPlanHandler := connect.NewUnaryHandler(
+		TenantServiceChangeTenantPlanProcedure,
+		svc.ChangeTenantPlan,
+		connect.WithSchema(tenantServiceMethods.ByName("ChangeTenantPlan")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceScheduleTenantDeletionHandler := connect.NewUnaryHandler(
+		TenantServiceScheduleTenantDeletionProcedure,
+		svc.ScheduleTenantDeletion,
+		connect.WithSchema(tenantServiceMethods.ByName("ScheduleTenantDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceCancelTenantDeletionHandler := connect.NewUnaryHandler(
+		TenantServiceCancelTenantDeletionProcedure,
+		svc.CancelTenantDeletion,
+		connect.WithSchema(tenantServiceMethods.ByName("CancelTenantDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceGetTenantPurgeReportHandler := connect.NewUnaryHandler(
+		TenantServiceGetTenantPurgeReportProcedure,
+		svc.GetTenantPurgeReport,
+		connect.WithSchema(tenantServiceMethods.ByName("GetTenantPurgeReport")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceListPlansHandler := connect.NewUnaryHandler(
+		TenantServiceListPlansProcedure,
+		svc.ListPlans,
+		connect.WithSchema(tenantServiceMethods.ByName("ListPlans")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceExportTenantHandler := connect.NewUnaryHandler(
+		TenantServiceExportTenantProcedure,
+		svc.ExportTenant,
+		connect.WithSchema(tenantServiceMethods.ByName("ExportTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceImportTenantHandler := connect.NewUnaryHandler(
+		TenantServiceImportTenantProcedure,
+		svc.ImportTenant,
+		connect.WithSchema(tenantServiceMethods.ByName("ImportTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceGetArchiveJobHandler := connect.NewUnaryHandler(
+		TenantServiceGetArchiveJobProcedure,
+		svc.GetArchiveJob,
+		connect.WithSchema(tenantServiceMethods.ByName("GetArchiveJob")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceCreateFleetSharingAgreementHandler := connect.NewUnaryHandler(
+		TenantServiceCreateFleetSharingAgreementProcedure,
+		svc.CreateFleetSharingAgreement,
+		connect.WithSchema(tenantServiceMethods.ByName("CreateFleetSharingAgreement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceTerminateFleetSharingAgreementHandler := connect.NewUnaryHandler(
+		TenantServiceTerminateFleetSharingAgreementProcedure,
+		svc.TerminateFleetSharingAgreement,
+		connect.WithSchema(tenantServiceMethods.ByName("TerminateFleetSharingAgreement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantServiceListFleetSharingAgreementsHandler := connect.NewUnaryHandler(
+		TenantServiceListFleetSharingAgreementsProcedure,
+		svc.ListFleetSharingAgreements,
+		connect.WithSchema(tenantServiceMethods.ByName("ListFleetSharingAgreements")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/tenant.v1.TenantService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TenantServiceCreateTenantProcedure:
+			tenantServiceCreateTenantHandler.ServeHTTP(w, r)
+		case TenantServiceGetTenantProcedure:
+			tenantServiceGetTenantHandler.ServeHTTP(w, r)
+		case TenantServiceSuspendTenantProcedure:
+			tenantServiceSuspendTenantHandler.ServeHTTP(w, r)
+		case TenantServiceReactivateTenantProcedure:
+			tenantServiceReactivateTenantHandler.ServeHTTP(w, r)
+		case TenantServiceChangeTenantPlanProcedure:
+			tenantServiceChangeTenantPlanHandler.ServeHTTP(w, r)
+		case TenantServiceScheduleTenantDeletionProcedure:
+			tenantServiceScheduleTenantDeletionHandler.ServeHTTP(w, r)
+		case TenantServiceCancelTenantDeletionProcedure:
+			tenantServiceCancelTenantDeletionHandler.ServeHTTP(w, r)
+		case TenantServiceGetTenantPurgeReportProcedure:
+			tenantServiceGetTenantPurgeReportHandler.ServeHTTP(w, r)
+		case TenantServiceListPlansProcedure:
+			tenantServiceListPlansHandler.ServeHTTP(w, r)
+		case TenantServiceExportTenantProcedure:
+			tenantServiceExportTenantHandler.ServeHTTP(w, r)
+		case TenantServiceImportTenantProcedure:
+			tenantServiceImportTenantHandler.ServeHTTP(w, r)
+		case TenantServiceGetArchiveJobProcedure:
+			tenantServiceGetArchiveJobHandler.ServeHTTP(w, r)
+		case TenantServiceCreateFleetSharingAgreementProcedure:
+			tenantServiceCreateFleetSharingAgreementHandler.ServeHTTP(w, r)
+		case TenantServiceTerminateFleetSharingAgreementProcedure:
+			tenantServiceTerminateFleetSharingAgreementHandler.ServeHTTP(w, r)
+		case TenantServiceListFleetSharingAgreementsProcedure:
+			tenantServiceListFleetSharingAgreementsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTenantServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTenantServiceHandler struct{}
+
+func (UnimplementedTenantServiceHandler) CreateTenant(context.Context, *connect.Request[v1.CreateTenantRequest]) (*connect.Response[v1.CreateTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.CreateTenant is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) GetTenant(context.Context, *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.GetTenant is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) SuspendTenant(context.Context, *connect.Request[v1.SuspendTenantRequest]) (*connect.Response[v1.SuspendTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.SuspendTenant is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) ReactivateTenant(context.Context, *connect.Request[v1.ReactivateTenantRequest]) (*connect.Response[v1.ReactivateTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.ReactivateTenant is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) ChangeTenantPlan(context.Context, *connect.Request[v1.ChangeTenantPlanRequest]) (*connect.Response[v1.ChangeTenantPlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.ChangeTenantPlan is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) ScheduleTenantDeletion(context.Context, *connect.Request[v1.ScheduleTenantDeletionRequest]) (*connect.Response[v1.ScheduleTenantDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.ScheduleTenantDeletion is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) CancelTenantDeletion(context.Context, *connect.Request[v1.CancelTenantDeletionRequest]) (*connect.Response[v1.CancelTenantDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.CancelTenantDeletion is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) GetTenantPurgeReport(context.Context, *connect.Request[v1.GetTenantPurgeReportRequest]) (*connect.Response[v1.GetTenantPurgeReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.GetTenantPurgeReport is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) ListPlans(context.Context, *connect.Request[v1.ListPlansRequest]) (*connect.Response[v1.ListPlansResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.ListPlans is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) ExportTenant(context.Context, *connect.Request[v1.ExportTenantRequest]) (*connect.Response[v1.ExportTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.ExportTenant is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) ImportTenant(context.Context, *connect.Request[v1.ImportTenantRequest]) (*connect.Response[v1.ImportTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.ImportTenant is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) GetArchiveJob(context.Context, *connect.Request[v1.GetArchiveJobRequest]) (*connect.Response[v1.GetArchiveJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.GetArchiveJob is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) CreateFleetSharingAgreement(context.Context, *connect.Request[v1.CreateFleetSharingAgreementRequest]) (*connect.Response[v1.CreateFleetSharingAgreementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.CreateFleetSharingAgreement is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) TerminateFleetSharingAgreement(context.Context, *connect.Request[v1.TerminateFleetSharingAgreementRequest]) (*connect.Response[v1.TerminateFleetSharingAgreementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.TerminateFleetSharingAgreement is not implemented"))
+}
+
+func (UnimplementedTenantServiceHandler) ListFleetSharingAgreements(context.Context, *connect.Request[v1.ListFleetSharingAgreementsRequest]) (*connect.Response[v1.ListFleetSharingAgreementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenant.v1.TenantService.ListFleetSharingAgreements is not implemented"))
+}
@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/proto/tenantadmin/v1/tenant_admin_service.proto
+
+package tenantadminv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantadmin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TenantAdminServiceName is the fully-qualified name of the TenantAdminService service.
+	TenantAdminServiceName = "tenantadmin.v1.TenantAdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TenantAdminServiceCreateAPIKeyProcedure is the fully-qualified name of the TenantAdminService's
+	// CreateAPIKey RPC.
+	TenantAdminServiceCreateAPIKeyProcedure = "/tenantadmin.v1.TenantAdminService/CreateAPIKey"
+	// TenantAdminServiceListAPIKeysProcedure is the fully-qualified name of the TenantAdminService's
+	// ListAPIKeys RPC.
+	TenantAdminServiceListAPIKeysProcedure = "/tenantadmin.v1.TenantAdminService/ListAPIKeys"
+	// TenantAdminServiceRotateAPIKeyProcedure is the fully-qualified name of the TenantAdminService's
+	// RotateAPIKey RPC.
+	TenantAdminServiceRotateAPIKeyProcedure = "/tenantadmin.v1.TenantAdminService/RotateAPIKey"
+	// TenantAdminServiceRevokeAPIKeyProcedure is the fully-qualified name of the TenantAdminService's
+	// RevokeAPIKey RPC.
+	TenantAdminServiceRevokeAPIKeyProcedure = "/tenantadmin.v1.TenantAdminService/RevokeAPIKey"
+	// TenantAdminServiceGetUsageProcedure is the fully-qualified name of the TenantAdminService's
+	// GetUsage RPC.
+	TenantAdminServiceGetUsageProcedure = "/tenantadmin.v1.TenantAdminService/GetUsage"
+)
+
+// TenantAdminServiceClient is a client for the tenantadmin.v1.TenantAdminService service.
+type TenantAdminServiceClient interface {
+	// CreateAPIKey creates an API key and returns the key
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// ListAPIKeys retrieves a list of API keys, revoked ones included
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// RotateAPIKey replaces the key of an API key; the previous key stops working at once
+	RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error)
+	// RevokeAPIKey permanently disables an API key
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	// GetUsage retrieves the plan of the tenant and how much of its limits the tenant uses
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+}
+
+// NewTenantAdminServiceClient constructs a client for the tenantadmin.v1.TenantAdminService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTenantAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TenantAdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tenantAdminServiceMethods := v1.File_api_proto_tenantadmin_v1_tenant_admin_service_proto.Services().ByName("TenantAdminService").Methods()
+	return &tenantAdminServiceClient{
+		createAPIKey: connect.NewClient[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+TenantAdminServiceCreateAPIKeyProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listAPIKeys: connect.NewClient[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse](
+			httpClient,
+			baseURL+TenantAdminServiceListAPIKeysProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("ListAPIKeys")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		rotateAPIKey: connect.NewClient[v1.RotateAPIKeyRequest, v1.RotateAPIKeyResponse](
+			httpClient,
+			baseURL+TenantAdminServiceRotateAPIKeyProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("RotateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse](
+			httpClient,
+			baseURL+TenantAdminServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("RevokeAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getUsage: connect.NewClient[v1.GetUsageRequest, v1.GetUsageResponse](
+			httpClient,
+			baseURL+TenantAdminServiceGetUsageProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("GetUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tenantAdminServiceClient implements TenantAdminServiceClient.
+type tenantAdminServiceClient struct {
+	createAPIKey *connect.Client[v1.CreateAPIKeyRequest, v1.CreateAPIKeyResponse]
+	listAPIKeys  *connect.Client[v1.ListAPIKeysRequest, v1.ListAPIKeysResponse]
+	rotateAPIKey *connect.Client[v1.RotateAPIKeyRequest, v1.RotateAPIKeyResponse]
+	revokeAPIKey *connect.Client[v1.RevokeAPIKeyRequest, v1.RevokeAPIKeyResponse]
+	getUsage     *connect.Client[v1.GetUsageRequest, v1.GetUsageResponse]
+}
+
+// CreateAPIKey calls tenantadmin.v1.TenantAdminService.CreateAPIKey.
+func (c *tenantAdminServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// ListAPIKeys calls tenantadmin.v1.TenantAdminService.ListAPIKeys.
+func (c *tenantAdminServiceClient) ListAPIKeys(ctx context.Context, req *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return c.listAPIKeys.CallUnary(ctx, req)
+}
+
+// RotateAPIKey calls tenantadmin.v1.TenantAdminService.RotateAPIKey.
+func (c *tenantAdminServiceClient) RotateAPIKey(ctx context.Context, req *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error) {
+	return c.rotateAPIKey.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls tenantadmin.v1.TenantAdminService.RevokeAPIKey.
+func (c *tenantAdminServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// GetUsage calls tenantadmin.v1.TenantAdminService.GetUsage.
+func (c *tenantAdminServiceClient) GetUsage(ctx context.Context, req *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
+// TenantAdminServiceHandler is an implementation of the tenantadmin.v1.TenantAdminService service.
+type TenantAdminServiceHandler interface {
+	// CreateAPIKey creates an API key and returns the key
+	CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error)
+	// ListAPIKeys retrieves a list of API keys, revoked ones included
+	ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error)
+	// RotateAPIKey replaces the key of an API key; the previous key stops working at once
+	RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error)
+	// RevokeAPIKey permanently disables an API key
+	RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error)
+	// GetUsage retrieves the plan of the tenant and how much of its limits the tenant uses
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+}
+
+// NewTenantAdminServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTenantAdminServiceHandler(svc TenantAdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tenantAdminServiceMethods := v1.File_api_proto_tenantadmin_v1_tenant_admin_service_proto.Services().ByName("TenantAdminService").Methods()
+	tenantAdminServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		TenantAdminServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceListAPIKeysHandler := connect.NewUnaryHandler(
+		TenantAdminServiceListAPIKeysProcedure,
+		svc.ListAPIKeys,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("ListAPIKeys")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceRotateAPIKeyHandler := connect.NewUnaryHandler(
+		TenantAdminServiceRotateAPIKeyProcedure,
+		svc.RotateAPIKey,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("RotateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		TenantAdminServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("RevokeAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceGetUsageHandler := connect.NewUnaryHandler(
+		TenantAdminServiceGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("GetUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/tenantadmin.v1.TenantAdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TenantAdminServiceCreateAPIKeyProcedure:
+			tenantAdminServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case TenantAdminServiceListAPIKeysProcedure:
+			tenantAdminServiceListAPIKeysHandler.ServeHTTP(w, r)
+		case TenantAdminServiceRotateAPIKeyProcedure:
+			tenantAdminServiceRotateAPIKeyHandler.ServeHTTP(w, r)
+		case TenantAdminServiceRevokeAPIKeyProcedure:
+			tenantAdminServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case TenantAdminServiceGetUsageProcedure:
+			tenantAdminServiceGetUsageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTenantAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTenantAdminServiceHandler struct{}
+
+func (UnimplementedTenantAdminServiceHandler) CreateAPIKey(context.Context, *connect.Request[v1.CreateAPIKeyRequest]) (*connect.Response[v1.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenantadmin.v1.TenantAdminService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) ListAPIKeys(context.Context, *connect.Request[v1.ListAPIKeysRequest]) (*connect.Response[v1.ListAPIKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenantadmin.v1.TenantAdminService.ListAPIKeys is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) RotateAPIKey(context.Context, *connect.Request[v1.RotateAPIKeyRequest]) (*connect.Response[v1.RotateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenantadmin.v1.TenantAdminService.RotateAPIKey is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) RevokeAPIKey(context.Context, *connect.Request[v1.RevokeAPIKeyRequest]) (*connect.Response[v1.RevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenantadmin.v1.TenantAdminService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenantadmin.v1.TenantAdminService.GetUsage is not implemented"))
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/tenantsettings/v1/tenant_settings.proto
+
+package tenantsettingsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DayOfWeek is a day of the week
+type DayOfWeek int32
+
+const (
+	DayOfWeek_DAY_OF_WEEK_UNSPECIFIED DayOfWeek = 0
+	DayOfWeek_DAY_OF_WEEK_MONDAY      DayOfWeek = 1
+	DayOfWeek_DAY_OF_WEEK_TUESDAY     DayOfWeek = 2
+	DayOfWeek_DAY_OF_WEEK_WEDNESDAY   DayOfWeek = 3
+	DayOfWeek_DAY_OF_WEEK_THURSDAY    DayOfWeek = 4
+	DayOfWeek_DAY_OF_WEEK_FRIDAY      DayOfWeek = 5
+	DayOfWeek_DAY_OF_WEEK_SATURDAY    DayOfWeek = 6
+	DayOfWeek_DAY_OF_WEEK_SUNDAY      DayOfWeek = 7
+)
+
+// Enum value maps for DayOfWeek.
+var (
+	DayOfWeek_name = map[int32]string{
+		0: "DAY_OF_WEEK_UNSPECIFIED",
+		1: "DAY_OF_WEEK_MONDAY",
+		2: "DAY_OF_WEEK_TUESDAY",
+		3: "DAY_OF_WEEK_WEDNESDAY",
+		4: "DAY_OF_WEEK_THURSDAY",
+		5: "DAY_OF_WEEK_FRIDAY",
+		6: "DAY_OF_WEEK_SATURDAY",
+		7: "DAY_OF_WEEK_SUNDAY",
+	}
+	DayOfWeek_value = map[string]int32{
+		"DAY_OF_WEEK_UNSPECIFIED": 0,
+		"DAY_OF_WEEK_MONDAY":      1,
+		"DAY_OF_WEEK_TUESDAY":     2,
+		"DAY_OF_WEEK_WEDNESDAY":   3,
+		"DAY_OF_WEEK_THURSDAY":    4,
+		"DAY_OF_WEEK_FRIDAY":      5,
+		"DAY_OF_WEEK_SATURDAY":    6,
+		"DAY_OF_WEEK_SUNDAY":      7,
+	}
+)
+
+func (x DayOfWeek) Enum() *DayOfWeek {
+	p := new(DayOfWeek)
+	*p = x
+	return p
+}
+
+func (x DayOfWeek) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DayOfWeek) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_tenantsettings_v1_tenant_settings_proto_enumTypes[0].Descriptor()
+}
+
+func (DayOfWeek) Type() protoreflect.EnumType {
+	return &file_api_proto_tenantsettings_v1_tenant_settings_proto_enumTypes[0]
+}
+
+func (x DayOfWeek) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DayOfWeek.Descriptor instead.
+func (DayOfWeek) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescGZIP(), []int{0}
+}
+
+// OpeningHours is a period during which a tenant is open, in its timezone
+type OpeningHours struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Day   DayOfWeek              `protobuf:"varint,1,opt,name=day,proto3,enum=tenantsettings.v1.DayOfWeek" json:"day,omitempty"`
+	// "HH:MM", inclusive
+	Opens string `protobuf:"bytes,2,opt,name=opens,proto3" json:"opens,omitempty"`
+	// "HH:MM", exclusive; "24:00" closes at midnight
+	Closes        string `protobuf:"bytes,3,opt,name=closes,proto3" json:"closes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpeningHours) Reset() {
+	*x = OpeningHours{}
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpeningHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpeningHours) ProtoMessage() {}
+
+func (x *OpeningHours) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
+func (*OpeningHours) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpeningHours) GetDay() DayOfWeek {
+	if x != nil {
+		return x.Day
+	}
+	return DayOfWeek_DAY_OF_WEEK_UNSPECIFIED
+}
+
+func (x *OpeningHours) GetOpens() string {
+	if x != nil {
+		return x.Opens
+	}
+	return ""
+}
+
+func (x *OpeningHours) GetCloses() string {
+	if x != nil {
+		return x.Closes
+	}
+	return ""
+}
+
+// TenantSettings is the regional and operational context of a tenant
+type TenantSettings struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TenantId string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// IANA time zone name, e.g. "Asia/Tokyo"
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// ISO 4217 currency code, e.g. "JPY"
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// BCP 47 language tag, e.g. "ja-JP"
+	Locale string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Days without a period are closed days; without any period, the tenant is always open
+	BusinessHours []*OpeningHours        `protobuf:"bytes,5,rep,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantSettings) Reset() {
+	*x = TenantSettings{}
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSettings) ProtoMessage() {}
+
+func (x *TenantSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSettings.ProtoReflect.Descriptor instead.
+func (*TenantSettings) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TenantSettings) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetBusinessHours() []*OpeningHours {
+	if x != nil {
+		return x.BusinessHours
+	}
+	return nil
+}
+
+func (x *TenantSettings) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TenantSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_api_proto_tenantsettings_v1_tenant_settings_proto protoreflect.FileDescriptor
+
+const file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDesc = "" +
+	"\n" +
+	"1api/proto/tenantsettings/v1/tenant_settings.proto\x12\x11tenantsettings.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"l\n" +
+	"\fOpeningHours\x12.\n" +
+	"\x03day\x18\x01 \x01(\x0e2\x1c.tenantsettings.v1.DayOfWeekR\x03day\x12\x14\n" +
+	"\x05opens\x18\x02 \x01(\tR\x05opens\x12\x16\n" +
+	"\x06closes\x18\x03 \x01(\tR\x06closes\"\xbb\x02\n" +
+	"\x0eTenantSettings\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\x12F\n" +
+	"\x0ebusiness_hours\x18\x05 \x03(\v2\x1f.tenantsettings.v1.OpeningHoursR\rbusinessHours\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt*\xd8\x01\n" +
+	"\tDayOfWeek\x12\x1b\n" +
+	"\x17DAY_OF_WEEK_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12DAY_OF_WEEK_MONDAY\x10\x01\x12\x17\n" +
+	"\x13DAY_OF_WEEK_TUESDAY\x10\x02\x12\x19\n" +
+	"\x15DAY_OF_WEEK_WEDNESDAY\x10\x03\x12\x18\n" +
+	"\x14DAY_OF_WEEK_THURSDAY\x10\x04\x12\x16\n" +
+	"\x12DAY_OF_WEEK_FRIDAY\x10\x05\x12\x18\n" +
+	"\x14DAY_OF_WEEK_SATURDAY\x10\x06\x12\x16\n" +
+	"\x12DAY_OF_WEEK_SUNDAY\x10\aBWZUgithub.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1;tenantsettingsv1b\x06proto3"
+
+var (
+	file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescOnce sync.Once
+	file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescData []byte
+)
+
+func file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescGZIP() []byte {
+	file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescOnce.Do(func() {
+		file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDesc), len(file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDesc)))
+	})
+	return file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDescData
+}
+
+var file_api_proto_tenantsettings_v1_tenant_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_tenantsettings_v1_tenant_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_proto_tenantsettings_v1_tenant_settings_proto_goTypes = []any{
+	(DayOfWeek)(0),                // 0: tenantsettings.v1.DayOfWeek
+	(*OpeningHours)(nil),          // 1: tenantsettings.v1.OpeningHours
+	(*TenantSettings)(nil),        // 2: tenantsettings.v1.TenantSettings
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_api_proto_tenantsettings_v1_tenant_settings_proto_depIdxs = []int32{
+	0, // 0: tenantsettings.v1.OpeningHours.day:type_name -> tenantsettings.v1.DayOfWeek
+	1, // 1: tenantsettings.v1.TenantSettings.business_hours:type_name -> tenantsettings.v1.OpeningHours
+	3, // 2: tenantsettings.v1.TenantSettings.created_at:type_name -> google.protobuf.Timestamp
+	3, // 3: tenantsettings.v1.TenantSettings.updated_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_tenantsettings_v1_tenant_settings_proto_init() }
+func file_api_proto_tenantsettings_v1_tenant_settings_proto_init() {
+	if File_api_proto_tenantsettings_v1_tenant_settings_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDesc), len(file_api_proto_tenantsettings_v1_tenant_settings_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_tenantsettings_v1_tenant_settings_proto_goTypes,
+		DependencyIndexes: file_api_proto_tenantsettings_v1_tenant_settings_proto_depIdxs,
+		EnumInfos:         file_api_proto_tenantsettings_v1_tenant_settings_proto_enumTypes,
+		MessageInfos:      file_api_proto_tenantsettings_v1_tenant_settings_proto_msgTypes,
+	}.Build()
+	File_api_proto_tenantsettings_v1_tenant_settings_proto = out.File
+	file_api_proto_tenantsettings_v1_tenant_settings_proto_goTypes = nil
+	file_api_proto_tenantsettings_v1_tenant_settings_proto_depIdxs = nil
+}
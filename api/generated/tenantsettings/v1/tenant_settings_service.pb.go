@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: api/proto/tenantsettings/v1/tenant_settings_service.proto
+
+package tenantsettingsv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetTenantSettingsRequest is the request for retrieving the settings of a tenant
+type GetTenantSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantSettingsRequest) Reset() {
+	*x = GetTenantSettingsRequest{}
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantSettingsRequest) ProtoMessage() {}
+
+func (x *GetTenantSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetTenantSettingsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// GetTenantSettingsResponse is the response for retrieving the settings of a tenant
+type GetTenantSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantSettingsResponse) Reset() {
+	*x = GetTenantSettingsResponse{}
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantSettingsResponse) ProtoMessage() {}
+
+func (x *GetTenantSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTenantSettingsResponse) GetSettings() *TenantSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// UpdateTenantSettingsRequest is the request for replacing the settings of a tenant
+type UpdateTenantSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: the tenant is resolved from the credentials or the host, and a
+	// different tenant_id is rejected
+	TenantId      string          `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Timezone      string          `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Currency      string          `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Locale        string          `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	BusinessHours []*OpeningHours `protobuf:"bytes,5,rep,name=business_hours,json=businessHours,proto3" json:"business_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantSettingsRequest) Reset() {
+	*x = UpdateTenantSettingsRequest{}
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTenantSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateTenantSettingsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpdateTenantSettingsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateTenantSettingsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateTenantSettingsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *UpdateTenantSettingsRequest) GetBusinessHours() []*OpeningHours {
+	if x != nil {
+		return x.BusinessHours
+	}
+	return nil
+}
+
+// UpdateTenantSettingsResponse is the response for replacing the settings of a tenant
+type UpdateTenantSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantSettingsResponse) Reset() {
+	*x = UpdateTenantSettingsResponse{}
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateTenantSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateTenantSettingsResponse) GetSettings() *TenantSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+var File_api_proto_tenantsettings_v1_tenant_settings_service_proto protoreflect.FileDescriptor
+
+const file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDesc = "" +
+	"\n" +
+	"9api/proto/tenantsettings/v1/tenant_settings_service.proto\x12\x11tenantsettings.v1\x1a1api/proto/tenantsettings/v1/tenant_settings.proto\x1a\x1cgoogle/api/annotations.proto\"7\n" +
+	"\x18GetTenantSettingsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"Z\n" +
+	"\x19GetTenantSettingsResponse\x12=\n" +
+	"\bsettings\x18\x01 \x01(\v2!.tenantsettings.v1.TenantSettingsR\bsettings\"\xd2\x01\n" +
+	"\x1bUpdateTenantSettingsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\x12F\n" +
+	"\x0ebusiness_hours\x18\x05 \x03(\v2\x1f.tenantsettings.v1.OpeningHoursR\rbusinessHours\"]\n" +
+	"\x1cUpdateTenantSettingsResponse\x12=\n" +
+	"\bsettings\x18\x01 \x01(\v2!.tenantsettings.v1.TenantSettingsR\bsettings2\xb4\x02\n" +
+	"\x15TenantSettingsService\x12\x87\x01\n" +
+	"\x11GetTenantSettings\x12+.tenantsettings.v1.GetTenantSettingsRequest\x1a,.tenantsettings.v1.GetTenantSettingsResponse\"\x17\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/settings\x90\x02\x01\x12\x90\x01\n" +
+	"\x14UpdateTenantSettings\x12..tenantsettings.v1.UpdateTenantSettingsRequest\x1a/.tenantsettings.v1.UpdateTenantSettingsResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/v1/settingsBWZUgithub.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1;tenantsettingsv1b\x06proto3"
+
+var (
+	file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescOnce sync.Once
+	file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescData []byte
+)
+
+func file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescGZIP() []byte {
+	file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescOnce.Do(func() {
+		file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDesc), len(file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDesc)))
+	})
+	return file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDescData
+}
+
+var file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_proto_tenantsettings_v1_tenant_settings_service_proto_goTypes = []any{
+	(*GetTenantSettingsRequest)(nil),     // 0: tenantsettings.v1.GetTenantSettingsRequest
+	(*GetTenantSettingsResponse)(nil),    // 1: tenantsettings.v1.GetTenantSettingsResponse
+	(*UpdateTenantSettingsRequest)(nil),  // 2: tenantsettings.v1.UpdateTenantSettingsRequest
+	(*UpdateTenantSettingsResponse)(nil), // 3: tenantsettings.v1.UpdateTenantSettingsResponse
+	(*TenantSettings)(nil),               // 4: tenantsettings.v1.TenantSettings
+	(*OpeningHours)(nil),                 // 5: tenantsettings.v1.OpeningHours
+}
+var file_api_proto_tenantsettings_v1_tenant_settings_service_proto_depIdxs = []int32{
+	4, // 0: tenantsettings.v1.GetTenantSettingsResponse.settings:type_name -> tenantsettings.v1.TenantSettings
+	5, // 1: tenantsettings.v1.UpdateTenantSettingsRequest.business_hours:type_name -> tenantsettings.v1.OpeningHours
+	4, // 2: tenantsettings.v1.UpdateTenantSettingsResponse.settings:type_name -> tenantsettings.v1.TenantSettings
+	0, // 3: tenantsettings.v1.TenantSettingsService.GetTenantSettings:input_type -> tenantsettings.v1.GetTenantSettingsRequest
+	2, // 4: tenantsettings.v1.TenantSettingsService.UpdateTenantSettings:input_type -> tenantsettings.v1.UpdateTenantSettingsRequest
+	1, // 5: tenantsettings.v1.TenantSettingsService.GetTenantSettings:output_type -> tenantsettings.v1.GetTenantSettingsResponse
+	3, // 6: tenantsettings.v1.TenantSettingsService.UpdateTenantSettings:output_type -> tenantsettings.v1.UpdateTenantSettingsResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_tenantsettings_v1_tenant_settings_service_proto_init() }
+func file_api_proto_tenantsettings_v1_tenant_settings_service_proto_init() {
+	if File_api_proto_tenantsettings_v1_tenant_settings_service_proto != nil {
+		return
+	}
+	file_api_proto_tenantsettings_v1_tenant_settings_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDesc), len(file_api_proto_tenantsettings_v1_tenant_settings_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_tenantsettings_v1_tenant_settings_service_proto_goTypes,
+		DependencyIndexes: file_api_proto_tenantsettings_v1_tenant_settings_service_proto_depIdxs,
+		MessageInfos:      file_api_proto_tenantsettings_v1_tenant_settings_service_proto_msgTypes,
+	}.Build()
+	File_api_proto_tenantsettings_v1_tenant_settings_service_proto = out.File
+	file_api_proto_tenantsettings_v1_tenant_settings_service_proto_goTypes = nil
+	file_api_proto_tenantsettings_v1_tenant_settings_service_proto_depIdxs = nil
+}
@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/proto/tenantsettings/v1/tenant_settings_service.proto
+
+package tenantsettingsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TenantSettingsService_GetTenantSettings_FullMethodName    = "/tenantsettings.v1.TenantSettingsService/GetTenantSettings"
+	TenantSettingsService_UpdateTenantSettings_FullMethodName = "/tenantsettings.v1.TenantSettingsService/UpdateTenantSettings"
+)
+
+// TenantSettingsServiceClient is the client API for TenantSettingsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TenantSettingsService provides operations on the settings of a tenant
+type TenantSettingsServiceClient interface {
+	// GetTenantSettings retrieves the settings of the tenant, or the defaults if it never changed them
+	GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...grpc.CallOption) (*GetTenantSettingsResponse, error)
+	// UpdateTenantSettings replaces the settings of the tenant
+	UpdateTenantSettings(ctx context.Context, in *UpdateTenantSettingsRequest, opts ...grpc.CallOption) (*UpdateTenantSettingsResponse, error)
+}
+
+type tenantSettingsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenantSettingsServiceClient(cc grpc.ClientConnInterface) TenantSettingsServiceClient {
+	return &tenantSettingsServiceClient{cc}
+}
+
+func (c *tenantSettingsServiceClient) GetTenantSettings(ctx context.Context, in *GetTenantSettingsRequest, opts ...grpc.CallOption) (*GetTenantSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenantSettingsResponse)
+	err := c.cc.Invoke(ctx, TenantSettingsService_GetTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantSettingsServiceClient) UpdateTenantSettings(ctx context.Context, in *UpdateTenantSettingsRequest, opts ...grpc.CallOption) (*UpdateTenantSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTenantSettingsResponse)
+	err := c.cc.Invoke(ctx, TenantSettingsService_UpdateTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TenantSettingsServiceServer is the server API for TenantSettingsService service.
+// All implementations should embed UnimplementedTenantSettingsServiceServer
+// for forward compatibility.
+//
+// TenantSettingsService provides operations on the settings of a tenant
+type TenantSettingsServiceServer interface {
+	// GetTenantSettings retrieves the settings of the tenant, or the defaults if it never changed them
+	GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*GetTenantSettingsResponse, error)
+	// UpdateTenantSettings replaces the settings of the tenant
+	UpdateTenantSettings(context.Context, *UpdateTenantSettingsRequest) (*UpdateTenantSettingsResponse, error)
+}
+
+// UnimplementedTenantSettingsServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenantSettingsServiceServer struct{}
+
+func (UnimplementedTenantSettingsServiceServer) GetTenantSettings(context.Context, *GetTenantSettingsRequest) (*GetTenantSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantSettings not implemented")
+}
+func (UnimplementedTenantSettingsServiceServer) UpdateTenantSettings(context.Context, *UpdateTenantSettingsRequest) (*UpdateTenantSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenantSettings not implemented")
+}
+func (UnimplementedTenantSettingsServiceServer) testEmbeddedByValue() {}
+
+// UnsafeTenantSettingsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenantSettingsServiceServer will
+// result in compilation errors.
+type UnsafeTenantSettingsServiceServer interface {
+	mustEmbedUnimplementedTenantSettingsServiceServer()
+}
+
+func RegisterTenantSettingsServiceServer(s grpc.ServiceRegistrar, srv TenantSettingsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenantSettingsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenantSettingsService_ServiceDesc, srv)
+}
+
+func _TenantSettingsService_GetTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantSettingsServiceServer).GetTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantSettingsService_GetTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantSettingsServiceServer).GetTenantSettings(ctx, req.(*GetTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantSettingsService_UpdateTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantSettingsServiceServer).UpdateTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantSettingsService_UpdateTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantSettingsServiceServer).UpdateTenantSettings(ctx, req.(*UpdateTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TenantSettingsService_ServiceDesc is the grpc.ServiceDesc for TenantSettingsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenantSettingsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tenantsettings.v1.TenantSettingsService",
+	HandlerType: (*TenantSettingsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTenantSettings",
+			Handler:    _TenantSettingsService_GetTenantSettings_Handler,
+		},
+		{
+			MethodName: "UpdateTenantSettings",
+			Handler:    _TenantSettingsService_UpdateTenantSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/tenantsettings/v1/tenant_settings_service.proto",
+}
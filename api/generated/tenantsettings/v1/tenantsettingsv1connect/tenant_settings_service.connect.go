@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/proto/tenantsettings/v1/tenant_settings_service.proto
+
+package tenantsettingsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TenantSettingsServiceName is the fully-qualified name of the TenantSettingsService service.
+	TenantSettingsServiceName = "tenantsettings.v1.TenantSettingsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TenantSettingsServiceGetTenantSettingsProcedure is the fully-qualified name of the
+	// TenantSettingsService's GetTenantSettings RPC.
+	TenantSettingsServiceGetTenantSettingsProcedure = "/tenantsettings.v1.TenantSettingsService/GetTenantSettings"
+	// TenantSettingsServiceUpdateTenantSettingsProcedure is the fully-qualified name of the
+	// TenantSettingsService's UpdateTenantSettings RPC.
+	TenantSettingsServiceUpdateTenantSettingsProcedure = "/tenantsettings.v1.TenantSettingsService/UpdateTenantSettings"
+)
+
+// TenantSettingsServiceClient is a client for the tenantsettings.v1.TenantSettingsService service.
+type TenantSettingsServiceClient interface {
+	// GetTenantSettings retrieves the settings of the tenant, or the defaults if it never changed them
+	GetTenantSettings(context.Context, *connect.Request[v1.GetTenantSettingsRequest]) (*connect.Response[v1.GetTenantSettingsResponse], error)
+	// UpdateTenantSettings replaces the settings of the tenant
+	UpdateTenantSettings(context.Context, *connect.Request[v1.UpdateTenantSettingsRequest]) (*connect.Response[v1.UpdateTenantSettingsResponse], error)
+}
+
+// NewTenantSettingsServiceClient constructs a client for the
+// tenantsettings.v1.TenantSettingsService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTenantSettingsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TenantSettingsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tenantSettingsServiceMethods := v1.File_api_proto_tenantsettings_v1_tenant_settings_service_proto.Services().ByName("TenantSettingsService").Methods()
+	return &tenantSettingsServiceClient{
+		getTenantSettings: connect.NewClient[v1.GetTenantSettingsRequest, v1.GetTenantSettingsResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceGetTenantSettingsProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("GetTenantSettings")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateTenantSettings: connect.NewClient[v1.UpdateTenantSettingsRequest, v1.UpdateTenantSettingsResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceUpdateTenantSettingsProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateTenantSettings")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tenantSettingsServiceClient implements TenantSettingsServiceClient.
+type tenantSettingsServiceClient struct {
+	getTenantSettings    *connect.Client[v1.GetTenantSettingsRequest, v1.GetTenantSettingsResponse]
+	updateTenantSettings *connect.Client[v1.UpdateTenantSettingsRequest, v1.UpdateTenantSettingsResponse]
+}
+
+// GetTenantSettings calls tenantsettings.v1.TenantSettingsService.GetTenantSettings.
+func (c *tenantSettingsServiceClient) GetTenantSettings(ctx context.Context, req *connect.Request[v1.GetTenantSettingsRequest]) (*connect.Response[v1.GetTenantSettingsResponse], error) {
+	return c.getTenantSettings.CallUnary(ctx, req)
+}
+
+// UpdateTenantSettings calls tenantsettings.v1.TenantSettingsService.UpdateTenantSettings.
+func (c *tenantSettingsServiceClient) UpdateTenantSettings(ctx context.Context, req *connect.Request[v1.UpdateTenantSettingsRequest]) (*connect.Response[v1.UpdateTenantSettingsResponse], error) {
+	return c.updateTenantSettings.CallUnary(ctx, req)
+}
+
+// TenantSettingsServiceHandler is an implementation of the tenantsettings.v1.TenantSettingsService
+// service.
+type TenantSettingsServiceHandler interface {
+	// GetTenantSettings retrieves the settings of the tenant, or the defaults if it never changed them
+	GetTenantSettings(context.Context, *connect.Request[v1.GetTenantSettingsRequest]) (*connect.Response[v1.GetTenantSettingsResponse], error)
+	// UpdateTenantSettings replaces the settings of the tenant
+	UpdateTenantSettings(context.Context, *connect.Request[v1.UpdateTenantSettingsRequest]) (*connect.Response[v1.UpdateTenantSettingsResponse], error)
+}
+
+// NewTenantSettingsServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTenantSettingsServiceHandler(svc TenantSettingsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tenantSettingsServiceMethods := v1.File_api_proto_tenantsettings_v1_tenant_settings_service_proto.Services().ByName("TenantSettingsService").Methods()
+	tenantSettingsServiceGetTenantSettingsHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceGetTenantSettingsProcedure,
+		svc.GetTenantSettings,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("GetTenantSettings")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceUpdateTenantSettingsHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceUpdateTenantSettingsProcedure,
+		svc.UpdateTenantSettings,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("UpdateTenantSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/tenantsettings.v1.TenantSettingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TenantSettingsServiceGetTenantSettingsProcedure:
+			tenantSettingsServiceGetTenantSettingsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceUpdateTenantSettingsProcedure:
+			tenantSettingsServiceUpdateTenantSettingsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTenantSettingsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTenantSettingsServiceHandler struct{}
+
+func (UnimplementedTenantSettingsServiceHandler) GetTenantSettings(context.Context, *connect.Request[v1.GetTenantSettingsRequest]) (*connect.Response[v1.GetTenantSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenantsettings.v1.TenantSettingsService.GetTenantSettings is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) UpdateTenantSettings(context.Context, *connect.Request[v1.UpdateTenantSettingsRequest]) (*connect.Response[v1.UpdateTenantSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tenantsettings.v1.TenantSettingsService.UpdateTenantSettings is not implemented"))
+}
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/proto/webhook/v1/webhook_service.proto
+
+package webhookv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jp-ryuji/go-arch-patterns/api/generated/webhook/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "webhook.v1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceCreateWebhookEndpointProcedure is the fully-qualified name of the WebhookService's
+	// CreateWebhookEndpoint RPC.
+	WebhookServiceCreateWebhookEndpointProcedure = "/webhook.v1.WebhookService/CreateWebhookEndpoint"
+	// WebhookServiceGetWebhookEndpointProcedure is the fully-qualified name of the WebhookService's
+	// GetWebhookEndpoint RPC.
+	WebhookServiceGetWebhookEndpointProcedure = "/webhook.v1.WebhookService/GetWebhookEndpoint"
+	// WebhookServiceListWebhookEndpointsProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhookEndpoints RPC.
+	WebhookServiceListWebhookEndpointsProcedure = "/webhook.v1.WebhookService/ListWebhookEndpoints"
+	// WebhookServiceUpdateWebhookEndpointProcedure is the fully-qualified name of the WebhookService's
+	// UpdateWebhookEndpoint RPC.
+	WebhookServiceUpdateWebhookEndpointProcedure = "/webhook.v1.WebhookService/UpdateWebhookEndpoint"
+	// WebhookServiceDeleteWebhookEndpointProcedure is the fully-qualified name of the WebhookService's
+	// DeleteWebhookEndpoint RPC.
+	WebhookServiceDeleteWebhookEndpointProcedure = "/webhook.v1.WebhookService/DeleteWebhookEndpoint"
+	// WebhookServiceRotateWebhookEndpointSecretProcedure is the fully-qualified name of the
+	// WebhookService's RotateWebhookEndpointSecret RPC.
+	WebhookServiceRotateWebhookEndpointSecretProcedure = "/webhook.v1.WebhookService/RotateWebhookEndpointSecret"
+	// WebhookServiceListWebhookDeliveriesProcedure is the fully-qualified name of the WebhookService's
+	// ListWebhookDeliveries RPC.
+	WebhookServiceListWebhookDeliveriesProcedure = "/webhook.v1.WebhookService/ListWebhookDeliveries"
+)
+
+// WebhookServiceClient is a client for the webhook.v1.WebhookService service.
+type WebhookServiceClient interface {
+	// CreateWebhookEndpoint registers a new endpoint and returns its signing secret
+	CreateWebhookEndpoint(context.Context, *connect.Request[v1.CreateWebhookEndpointRequest]) (*connect.Response[v1.CreateWebhookEndpointResponse], error)
+	// GetWebhookEndpoint retrieves an endpoint by ID
+	GetWebhookEndpoint(context.Context, *connect.Request[v1.GetWebhookEndpointRequest]) (*connect.Response[v1.GetWebhookEndpointResponse], error)
+	// ListWebhookEndpoints retrieves a list of endpoints
+	ListWebhookEndpoints(context.Context, *connect.Request[v1.ListWebhookEndpointsRequest]) (*connect.Response[v1.ListWebhookEndpointsResponse], error)
+	// UpdateWebhookEndpoint updates an endpoint; enabling it clears its failure streak
+	UpdateWebhookEndpoint(context.Context, *connect.Request[v1.UpdateWebhookEndpointRequest]) (*connect.Response[v1.UpdateWebhookEndpointResponse], error)
+	// DeleteWebhookEndpoint deletes an endpoint; its delivery log is kept
+	DeleteWebhookEndpoint(context.Context, *connect.Request[v1.DeleteWebhookEndpointRequest]) (*connect.Response[v1.DeleteWebhookEndpointResponse], error)
+	// RotateWebhookEndpointSecret replaces the signing secret of an endpoint
+	RotateWebhookEndpointSecret(context.Context, *connect.Request[v1.RotateWebhookEndpointSecretRequest]) (*connect.Response[v1.RotateWebhookEndpointSecretResponse], error)
+	// ListWebhookDeliveries retrieves the delivery log, newest first
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+}
+
+// NewWebhookServiceClient constructs a client for the webhook.v1.WebhookService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	webhookServiceMethods := v1.File_api_proto_webhook_v1_webhook_service_proto.Services().ByName("WebhookService").Methods()
+	return &webhookServiceClient{
+		createWebhookEndpoint: connect.NewClient[v1.CreateWebhookEndpointRequest, v1.CreateWebhookEndpointResponse](
+			httpClient,
+			baseURL+WebhookServiceCreateWebhookEndpointProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("CreateWebhookEndpoint")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhookEndpoint: connect.NewClient[v1.GetWebhookEndpointRequest, v1.GetWebhookEndpointResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookEndpointProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetWebhookEndpoint")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookEndpoints: connect.NewClient[v1.ListWebhookEndpointsRequest, v1.ListWebhookEndpointsResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookEndpointsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookEndpoints")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhookEndpoint: connect.NewClient[v1.UpdateWebhookEndpointRequest, v1.UpdateWebhookEndpointResponse](
+			httpClient,
+			baseURL+WebhookServiceUpdateWebhookEndpointProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("UpdateWebhookEndpoint")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookEndpoint: connect.NewClient[v1.DeleteWebhookEndpointRequest, v1.DeleteWebhookEndpointResponse](
+			httpClient,
+			baseURL+WebhookServiceDeleteWebhookEndpointProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhookEndpoint")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateWebhookEndpointSecret: connect.NewClient[v1.RotateWebhookEndpointSecretRequest, v1.RotateWebhookEndpointSecretResponse](
+			httpClient,
+			baseURL+WebhookServiceRotateWebhookEndpointSecretProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RotateWebhookEndpointSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+WebhookServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveries")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	createWebhookEndpoint       *connect.Client[v1.CreateWebhookEndpointRequest, v1.CreateWebhookEndpointResponse]
+	getWebhookEndpoint          *connect.Client[v1.GetWebhookEndpointRequest, v1.GetWebhookEndpointResponse]
+	listWebhookEndpoints        *connect.Client[v1.ListWebhookEndpointsRequest, v1.ListWebhookEndpointsResponse]
+	updateWebhookEndpoint       *connect.Client[v1.UpdateWebhookEndpointRequest, v1.UpdateWebhookEndpointResponse]
+	deleteWebhookEndpoint       *connect.Client[v1.DeleteWebhookEndpointRequest, v1.DeleteWebhookEndpointResponse]
+	rotateWebhookEndpointSecret *connect.Client[v1.RotateWebhookEndpointSecretRequest, v1.RotateWebhookEndpointSecretResponse]
+	listWebhookDeliveries       *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+}
+
+// CreateWebhookEndpoint calls webhook.v1.WebhookService.CreateWebhookEndpoint.
+func (c *webhookServiceClient) CreateWebhookEndpoint(ctx context.Context, req *connect.Request[v1.CreateWebhookEndpointRequest]) (*connect.Response[v1.CreateWebhookEndpointResponse], error) {
+	return c.createWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// GetWebhookEndpoint calls webhook.v1.WebhookService.GetWebhookEndpoint.
+func (c *webhookServiceClient) GetWebhookEndpoint(ctx context.Context, req *connect.Request[v1.GetWebhookEndpointRequest]) (*connect.Response[v1.GetWebhookEndpointResponse], error) {
+	return c.getWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// ListWebhookEndpoints calls webhook.v1.WebhookService.ListWebhookEndpoints.
+func (c *webhookServiceClient) ListWebhookEndpoints(ctx context.Context, req *connect.Request[v1.ListWebhookEndpointsRequest]) (*connect.Response[v1.ListWebhookEndpointsResponse], error) {
+	return c.listWebhookEndpoints.CallUnary(ctx, req)
+}
+
+// UpdateWebhookEndpoint calls webhook.v1.WebhookService.UpdateWebhookEndpoint.
+func (c *webhookServiceClient) UpdateWebhookEndpoint(ctx context.Context, req *connect.Request[v1.UpdateWebhookEndpointRequest]) (*connect.Response[v1.UpdateWebhookEndpointResponse], error) {
+	return c.updateWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// DeleteWebhookEndpoint calls webhook.v1.WebhookService.DeleteWebhookEndpoint.
+func (c *webhookServiceClient) DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[v1.DeleteWebhookEndpointRequest]) (*connect.Response[v1.DeleteWebhookEndpointResponse], error) {
+	return c.deleteWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// RotateWebhookEndpointSecret calls webhook.v1.WebhookService.RotateWebhookEndpointSecret.
+func (c *webhookServiceClient) RotateWebhookEndpointSecret(ctx context.Context, req *connect.Request[v1.RotateWebhookEndpointSecretRequest]) (*connect.Response[v1.RotateWebhookEndpointSecretResponse], error) {
+	return c.rotateWebhookEndpointSecret.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls webhook.v1.WebhookService.ListWebhookDeliveries.
+func (c *webhookServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the webhook.v1.WebhookService service.
+type WebhookServiceHandler interface {
+	// CreateWebhookEndpoint registers a new endpoint and returns its signing secret
+	CreateWebhookEndpoint(context.Context, *connect.Request[v1.CreateWebhookEndpointRequest]) (*connect.Response[v1.CreateWebhookEndpointResponse], error)
+	// GetWebhookEndpoint retrieves an endpoint by ID
+	GetWebhookEndpoint(context.Context, *connect.Request[v1.GetWebhookEndpointRequest]) (*connect.Response[v1.GetWebhookEndpointResponse], error)
+	// ListWebhookEndpoints retrieves a list of endpoints
+	ListWebhookEndpoints(context.Context, *connect.Request[v1.ListWebhookEndpointsRequest]) (*connect.Response[v1.ListWebhookEndpointsResponse], error)
+	// UpdateWebhookEndpoint updates an endpoint; enabling it clears its failure streak
+	UpdateWebhookEndpoint(context.Context, *connect.Request[v1.UpdateWebhookEndpointRequest]) (*connect.Response[v1.UpdateWebhookEndpointResponse], error)
+	// DeleteWebhookEndpoint deletes an endpoint; its delivery log is kept
+	DeleteWebhookEndpoint(context.Context, *connect.Request[v1.DeleteWebhookEndpointRequest]) (*connect.Response[v1.DeleteWebhookEndpointResponse], error)
+	// RotateWebhookEndpointSecret replaces the signing secret of an endpoint
+	RotateWebhookEndpointSecret(context.Context, *connect.Request[v1.RotateWebhookEndpointSecretRequest]) (*connect.Response[v1.RotateWebhookEndpointSecretResponse], error)
+	// ListWebhookDeliveries retrieves the delivery log, newest first
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceMethods := v1.File_api_proto_webhook_v1_webhook_service_proto.Services().ByName("WebhookService").Methods()
+	webhookServiceCreateWebhookEndpointHandler := connect.NewUnaryHandler(
+		WebhookServiceCreateWebhookEndpointProcedure,
+		svc.CreateWebhookEndpoint,
+		connect.WithSchema(webhookServiceMethods.ByName("CreateWebhookEndpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetWebhookEndpointHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookEndpointProcedure,
+		svc.GetWebhookEndpoint,
+		connect.WithSchema(webhookServiceMethods.ByName("GetWebhookEndpoint")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookEndpointsHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookEndpointsProcedure,
+		svc.ListWebhookEndpoints,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookEndpoints")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceUpdateWebhookEndpointHandler := connect.NewUnaryHandler(
+		WebhookServiceUpdateWebhookEndpointProcedure,
+		svc.UpdateWebhookEndpoint,
+		connect.WithSchema(webhookServiceMethods.ByName("UpdateWebhookEndpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteWebhookEndpointHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteWebhookEndpointProcedure,
+		svc.DeleteWebhookEndpoint,
+		connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhookEndpoint")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceRotateWebhookEndpointSecretHandler := connect.NewUnaryHandler(
+		WebhookServiceRotateWebhookEndpointSecretProcedure,
+		svc.RotateWebhookEndpointSecret,
+		connect.WithSchema(webhookServiceMethods.ByName("RotateWebhookEndpointSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		WebhookServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(webhookServiceMethods.ByName("ListWebhookDeliveries")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/webhook.v1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceCreateWebhookEndpointProcedure:
+			webhookServiceCreateWebhookEndpointHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookEndpointProcedure:
+			webhookServiceGetWebhookEndpointHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookEndpointsProcedure:
+			webhookServiceListWebhookEndpointsHandler.ServeHTTP(w, r)
+		case WebhookServiceUpdateWebhookEndpointProcedure:
+			webhookServiceUpdateWebhookEndpointHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteWebhookEndpointProcedure:
+			webhookServiceDeleteWebhookEndpointHandler.ServeHTTP(w, r)
+		case WebhookServiceRotateWebhookEndpointSecretProcedure:
+			webhookServiceRotateWebhookEndpointSecretHandler.ServeHTTP(w, r)
+		case WebhookServiceListWebhookDeliveriesProcedure:
+			webhookServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) CreateWebhookEndpoint(context.Context, *connect.Request[v1.CreateWebhookEndpointRequest]) (*connect.Response[v1.CreateWebhookEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.v1.WebhookService.CreateWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetWebhookEndpoint(context.Context, *connect.Request[v1.GetWebhookEndpointRequest]) (*connect.Response[v1.GetWebhookEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.v1.WebhookService.GetWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookEndpoints(context.Context, *connect.Request[v1.ListWebhookEndpointsRequest]) (*connect.Response[v1.ListWebhookEndpointsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.v1.WebhookService.ListWebhookEndpoints is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) UpdateWebhookEndpoint(context.Context, *connect.Request[v1.UpdateWebhookEndpointRequest]) (*connect.Response[v1.UpdateWebhookEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.v1.WebhookService.UpdateWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteWebhookEndpoint(context.Context, *connect.Request[v1.DeleteWebhookEndpointRequest]) (*connect.Response[v1.DeleteWebhookEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.v1.WebhookService.DeleteWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) RotateWebhookEndpointSecret(context.Context, *connect.Request[v1.RotateWebhookEndpointSecretRequest]) (*connect.Response[v1.RotateWebhookEndpointSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.v1.WebhookService.RotateWebhookEndpointSecret is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.v1.WebhookService.ListWebhookDeliveries is not implemented"))
+}
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
 	"syscall"
+	_ "time/tzdata" // Tenant timezones must load on hosts without a zone database
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/config"
 	"github.com/jp-ryuji/go-arch-patterns/internal/di"
@@ -18,16 +20,32 @@ func main() {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
-	// Create database client
-	client := postgres.NewClient(cfg.DatabaseURL())
+	// Create database client as the application role, subject to row-level security
+	client := postgres.NewClient(cfg.AppDatabaseURL())
 
 	// Create dependency injection container
-	container, err := di.NewContainer(client, cfg.GRPCPort, cfg.HTTPPort)
+	container, err := di.NewContainer(client, cfg)
 	if err != nil {
 		log.Fatalf("Failed to create container: %v", err)
 	}
 	defer container.Close()
 
+	// Start the outbox relays, their LISTEN connections, the webhook dispatcher, the inbox
+	// cleanup, the tenant purger, the usage meters and the API key usage recorder in the
+	// background
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = container.OutboxListener.Run(ctx) }()
+	go func() { _ = container.OutboxRelay.Run(ctx) }()
+	go func() { _ = container.OutboxRelayGroup.Run(ctx) }()
+	go func() { _ = container.WebhookDispatcher.Run(ctx) }()
+	go func() { _ = container.InboxCleaner.Run(ctx) }()
+	go func() { _ = container.TenantPurger.Run(ctx) }()
+	go func() { _ = container.CallCounter.Run(ctx) }()
+	go func() { _ = container.ActiveCarsSampler.Run(ctx) }()
+	go func() { _ = container.MeteringCleaner.Run(ctx) }()
+	go func() { _ = container.APIKeyUsageRecorder.Run(ctx) }()
+
 	// Start the server
 	log.Println("Starting server...")
 	if err := container.HTTPServer.Start(); err != nil {
@@ -40,6 +58,8 @@ func main() {
 	<-quit
 
 	log.Println("Shutting down server...")
+	cancel()
+
 	// Give the server 5 seconds to shutdown gracefully
 	// ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	// defer cancel()
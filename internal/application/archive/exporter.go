@@ -0,0 +1,79 @@
+package archive
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// Summary describes an archive that was exported or imported
+type Summary struct {
+	TenantID string
+	Counts   map[Kind]int
+}
+
+// Exporter writes tenants to archives
+type Exporter struct {
+	txManager repository.TransactionManager
+	store     Store
+}
+
+// NewExporter creates a new exporter
+func NewExporter(txManager repository.TransactionManager, store Store) *Exporter {
+	return &Exporter{
+		txManager: txManager,
+		store:     store,
+	}
+}
+
+// Export writes a tenant to w as an archive. Every row is read in one repeatable-read
+// transaction, so the archive is a consistent snapshot even while the tenant is in use.
+// Read-only snapshots never fail with serialization errors, so the transaction is never
+// retried after records were written.
+func (e *Exporter) Export(ctx context.Context, tenantID string, w io.Writer) (Summary, error) {
+	ctx = tenantctx.WithTenantID(ctx, tenantID)
+	buf := bufio.NewWriter(w)
+
+	var summary Summary
+	err := e.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		tenant, err := e.store.ExportTenant(ctx, tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to read tenant %s: %w", tenantID, err)
+		}
+
+		aw, err := NewWriter(buf, Header{
+			TenantID:   tenant.ID,
+			TenantCode: tenant.Code,
+			ExportedAt: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		if err := aw.Write(Record{Kind: KindTenant, Data: tenant}); err != nil {
+			return err
+		}
+		if err := e.store.Export(ctx, tenantID, aw.Write); err != nil {
+			return fmt.Errorf("failed to export tenant %s: %w", tenantID, err)
+		}
+
+		counts, err := aw.Close()
+		if err != nil {
+			return err
+		}
+		summary = Summary{TenantID: tenant.ID, Counts: counts}
+		return nil
+	}, repository.TxOptions{Isolation: repository.IsolationRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return Summary{}, err
+	}
+
+	if err := buf.Flush(); err != nil {
+		return Summary{}, fmt.Errorf("failed to write archive: %w", err)
+	}
+	return summary, nil
+}
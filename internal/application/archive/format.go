@@ -0,0 +1,356 @@
+// Package archive exports a tenant to a versioned NDJSON archive and imports it back.
+//
+// An archive is one JSON object per line, each with a kind and the data of that kind. It
+// starts with a header, ends with a trailer counting the records, and in between holds the
+// tenant and its rows in foreign key order: every record only references records of the
+// kinds before it.
+//
+//	{"kind":"header","data":{"version":5,"tenant_id":"01J...","tenant_code":"acme","exported_at":"..."}}
+//	{"kind":"tenant","data":{"id":"01J...","code":"acme",...}}
+//	{"kind":"car_model","data":{"id":"01J...","tenant_id":"01J...","make":"Toyota","name":"Prius",...}}
+//	{"kind":"car","data":{"id":"01J...","tenant_id":"01J...","car_model_id":"01J...",...}}
+//	{"kind":"trailer","data":{"counts":{"car":1,"car_model":1,"tenant":1}}}
+package archive
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aarondl/null/v9"
+)
+
+// Version is the version of the archive format written by Writer. Readers accept every
+// version up to it. Version 2 added the car model catalog; cars of version 1 archives
+// name their model instead of referencing it. Version 3 added branches; cars and rentals
+// of earlier archives have none. Version 4 added maintenance windows, and version 5 the
+// handover inspections of rentals.
+const Version = 5
+
+// Kind is the kind of a record of an archive
+type Kind string
+
+const (
+	KindHeader            Kind = "header"
+	KindTenant            Kind = "tenant"
+	KindTenantSettings    Kind = "tenant_settings"
+	KindCarOption         Kind = "car_option"
+	KindCarModel          Kind = "car_model"
+	KindBranch            Kind = "branch"
+	KindCar               Kind = "car"
+	KindMaintenanceWindow Kind = "maintenance_window"
+	KindRenter            Kind = "renter"
+	KindCompany           Kind = "company"
+	KindIndividual        Kind = "individual"
+	KindRental            Kind = "rental"
+	KindRentalOption      Kind = "rental_option"
+	KindRentalHandover    Kind = "rental_handover"
+	KindOutboxMessage     Kind = "outbox_message"
+	KindTrailer           Kind = "trailer"
+)
+
+// Kinds lists the kinds of the records between the header and the trailer, in the order
+// they appear in an archive
+var Kinds = []Kind{
+	KindTenant,
+	KindTenantSettings,
+	KindCarOption,
+	KindCarModel,
+	KindBranch,
+	KindCar,
+	KindMaintenanceWindow,
+	KindRenter,
+	KindCompany,
+	KindIndividual,
+	KindRental,
+	KindRentalOption,
+	KindRentalHandover,
+	KindOutboxMessage,
+}
+
+// rank returns the position of a kind in Kinds, or -1 for other kinds
+func (k Kind) rank() int {
+	return slices.Index(Kinds, k)
+}
+
+// Record is one record between the header and the trailer: its kind and its data, e.g. a
+// *Car for KindCar
+type Record struct {
+	Kind Kind
+	Data Data
+}
+
+// Data is the data of a record
+type Data interface {
+	// RecordID returns the ID of the record
+	RecordID() string
+	// RecordTenantID returns the tenant the record belongs to
+	RecordTenantID() string
+	// references returns the IDs of the records it references, by their kind
+	references() map[Kind][]string
+	// validate checks that the required fields are set
+	validate() error
+	// remap replaces the IDs of the record and of its references
+	remap(ids *idMap)
+}
+
+// newData returns an empty record of a kind to decode into
+func newData(kind Kind) (Data, error) {
+	switch kind {
+	case KindTenant:
+		return &Tenant{}, nil
+	case KindTenantSettings:
+		return &TenantSettings{}, nil
+	case KindCarOption:
+		return &CarOption{}, nil
+	case KindCarModel:
+		return &CarModel{}, nil
+	case KindBranch:
+		return &Branch{}, nil
+	case KindCar:
+		return &Car{}, nil
+	case KindMaintenanceWindow:
+		return &MaintenanceWindow{}, nil
+	case KindRenter:
+		return &Renter{}, nil
+	case KindCompany:
+		return &Company{}, nil
+	case KindIndividual:
+		return &Individual{}, nil
+	case KindRental:
+		return &Rental{}, nil
+	case KindRentalOption:
+		return &RentalOption{}, nil
+	case KindRentalHandover:
+		return &RentalHandover{}, nil
+	case KindOutboxMessage:
+		return &OutboxMessage{}, nil
+	case KindHeader, KindTrailer:
+	}
+	return nil, fmt.Errorf("unknown record kind %q", kind)
+}
+
+// Header is the first record of an archive
+type Header struct {
+	Version    int       `json:"version"`
+	TenantID   string    `json:"tenant_id"`
+	TenantCode string    `json:"tenant_code"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// Trailer is the last record of an archive. Its counts reveal a truncated archive.
+type Trailer struct {
+	Counts map[Kind]int `json:"counts"`
+}
+
+// Tenant is the record of the exported tenant. The plan is referenced by its code, since
+// plan IDs differ between environments.
+type Tenant struct {
+	ID          string    `json:"id"`
+	Code        string    `json:"code"`
+	Status      string    `json:"status"`
+	SuspendedAt null.Time `json:"suspended_at"`
+	PurgeAt     null.Time `json:"purge_at"`
+	PlanCode    string    `json:"plan_code,omitempty"`
+	Isolation   string    `json:"isolation"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	DeletedAt   null.Time `json:"deleted_at"`
+}
+
+// TenantSettings is the record of the settings of the tenant
+type TenantSettings struct {
+	ID            string         `json:"id"`
+	TenantID      string         `json:"tenant_id"`
+	Timezone      string         `json:"timezone"`
+	Currency      string         `json:"currency"`
+	Locale        string         `json:"locale"`
+	BusinessHours []OpeningHours `json:"business_hours"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// OpeningHours is a period of the business hours of the tenant or the opening hours of a
+// branch
+type OpeningHours struct {
+	Weekday int    `json:"weekday"`
+	Opens   string `json:"opens"`
+	Closes  string `json:"closes"`
+}
+
+// CarOption is the record of an option cars can be rented with
+type CarOption struct {
+	ID        string    `json:"id"`
+	TenantID  string    `json:"tenant_id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt null.Time `json:"deleted_at"`
+}
+
+// CarModel is the record of a model of the car catalog of the tenant
+type CarModel struct {
+	ID           string    `json:"id"`
+	TenantID     string    `json:"tenant_id"`
+	Make         string    `json:"make"`
+	Name         string    `json:"name"`
+	Category     string    `json:"category"`
+	Seats        int       `json:"seats"`
+	Transmission string    `json:"transmission"`
+	FuelType     string    `json:"fuel_type"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	DeletedAt    null.Time `json:"deleted_at"`
+}
+
+// Branch is the record of a branch of the tenant
+type Branch struct {
+	ID           string         `json:"id"`
+	TenantID     string         `json:"tenant_id"`
+	Name         string         `json:"name"`
+	Street       string         `json:"street"`
+	City         string         `json:"city"`
+	Region       string         `json:"region"`
+	PostalCode   string         `json:"postal_code"`
+	Country      string         `json:"country"`
+	Latitude     float64        `json:"latitude"`
+	Longitude    float64        `json:"longitude"`
+	OpeningHours []OpeningHours `json:"opening_hours"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    null.Time      `json:"deleted_at"`
+}
+
+// Car is the record of a car
+type Car struct {
+	ID                  string      `json:"id"`
+	TenantID            string      `json:"tenant_id"`
+	CarModelID          string      `json:"car_model_id,omitempty"`
+	VIN                 null.String `json:"vin"`
+	LicensePlate        null.String `json:"license_plate"`
+	LicensePlateCountry null.String `json:"license_plate_country"`
+	HomeBranchID        null.String `json:"home_branch_id"`
+	CurrentBranchID     null.String `json:"current_branch_id"`
+	// Model is the model name of a car of a version 1 archive, which has no catalog. It is
+	// added to the catalog when the car is imported.
+	Model     string    `json:"model,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt null.Time `json:"deleted_at"`
+}
+
+// MaintenanceWindow is the record of a period a car is out of service
+type MaintenanceWindow struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenant_id"`
+	CarID       string    `json:"car_id"`
+	Type        string    `json:"type"`
+	Reason      string    `json:"reason"`
+	StartsAt    time.Time `json:"starts_at"`
+	EndsAt      time.Time `json:"ends_at"`
+	Status      string    `json:"status"`
+	CompletedAt null.Time `json:"completed_at"`
+	CanceledAt  null.Time `json:"canceled_at"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Renter is the record of a renter; its company or individual record follows it
+type Renter struct {
+	ID        string    `json:"id"`
+	TenantID  string    `json:"tenant_id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt null.Time `json:"deleted_at"`
+}
+
+// Company is the record of a renter that is a company
+type Company struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenant_id"`
+	RenterID    string    `json:"renter_id"`
+	Name        string    `json:"name"`
+	CompanySize string    `json:"company_size"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	DeletedAt   null.Time `json:"deleted_at"`
+}
+
+// Individual is the record of a renter that is a person
+type Individual struct {
+	ID        string    `json:"id"`
+	TenantID  string    `json:"tenant_id"`
+	RenterID  string    `json:"renter_id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt null.Time `json:"deleted_at"`
+}
+
+// Rental is the record of a rental of a car by a renter
+type Rental struct {
+	ID             string      `json:"id"`
+	TenantID       string      `json:"tenant_id"`
+	CarID          string      `json:"car_id"`
+	RenterID       string      `json:"renter_id"`
+	PickupBranchID null.String `json:"pickup_branch_id"`
+	ReturnBranchID null.String `json:"return_branch_id"`
+	StartsAt       time.Time   `json:"starts_at"`
+	EndsAt         time.Time   `json:"ends_at"`
+	ReturnedAt     null.Time   `json:"returned_at"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+	DeletedAt      null.Time   `json:"deleted_at"`
+}
+
+// RentalOption is the record of an option booked with a rental
+type RentalOption struct {
+	ID        string    `json:"id"`
+	TenantID  string    `json:"tenant_id"`
+	RentalID  string    `json:"rental_id"`
+	OptionID  string    `json:"option_id"`
+	Count     int       `json:"count"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	DeletedAt null.Time `json:"deleted_at"`
+}
+
+// RentalHandover is the record of the inspection of the car of a rental at pickup or return
+type RentalHandover struct {
+	ID            string           `json:"id"`
+	TenantID      string           `json:"tenant_id"`
+	RentalID      string           `json:"rental_id"`
+	Kind          string           `json:"kind"`
+	OdometerKm    int              `json:"odometer_km"`
+	FuelLevel     int              `json:"fuel_level"`
+	Damages       []HandoverDamage `json:"damages"`
+	DistanceKm    int              `json:"distance_km"`
+	FuelShortfall int              `json:"fuel_shortfall"`
+	InspectedAt   time.Time        `json:"inspected_at"`
+	CreatedAt     time.Time        `json:"created_at"`
+}
+
+// HandoverDamage is an entry of the damage checklist of a handover
+type HandoverDamage struct {
+	Area        string `json:"area"`
+	Description string `json:"description"`
+}
+
+// OutboxMessage is the record of an event of the tenant recorded in the outbox. Payloads
+// are kept as they are, even when IDs are remapped.
+type OutboxMessage struct {
+	ID            string                 `json:"id"`
+	TenantID      string                 `json:"tenant_id"`
+	AggregateType string                 `json:"aggregate_type"`
+	AggregateID   string                 `json:"aggregate_id"`
+	EventType     string                 `json:"event_type"`
+	Payload       map[string]interface{} `json:"payload"`
+	Status        string                 `json:"status"`
+	ErrorMessage  null.String            `json:"error_message"`
+	Version       int64                  `json:"version"`
+	CreatedAt     time.Time              `json:"created_at"`
+	ProcessedAt   null.Time              `json:"processed_at"`
+}
@@ -0,0 +1,159 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// ImportOptions controls how an archive is imported
+type ImportOptions struct {
+	// RemapIDs gives every record a new ID, so that a tenant can be imported next to the
+	// tenant it was exported from. Otherwise IDs are preserved and must be free.
+	RemapIDs bool
+	// Code replaces the code of the tenant, which must be free
+	Code string
+}
+
+// Importer restores tenants from archives
+type Importer struct {
+	txManager repository.TransactionManager
+	store     Store
+}
+
+// NewImporter creates a new importer
+func NewImporter(txManager repository.TransactionManager, store Store) *Importer {
+	return &Importer{
+		txManager: txManager,
+		store:     store,
+	}
+}
+
+// Import restores the tenant of an archive. The whole archive is validated before anything
+// is written, then imported in one transaction, so a failed import leaves nothing behind.
+// The tenant is imported with shared tables, whatever its isolation mode was.
+func (i *Importer) Import(ctx context.Context, src io.ReadSeeker, opts ImportOptions) (Summary, error) {
+	header, tenant, err := validateArchive(src)
+	if err != nil {
+		return Summary{}, err
+	}
+	code := tenant.Code
+	if opts.Code != "" {
+		code = opts.Code
+	}
+	if err := entity.ValidateTenantCode(code); err != nil {
+		return Summary{}, err
+	}
+
+	// The new IDs are made up once, so that a retried transaction imports the same ones
+	ids := newIDMap()
+	tenantID := header.TenantID
+	if opts.RemapIDs {
+		tenantID = ids.remap(tenantID)
+	}
+	ctx = tenantctx.WithTenantID(ctx, tenantID)
+
+	var counts map[Kind]int
+	err = i.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		legacyModels := make(legacyCarModels)
+		if _, err := src.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("failed to rewind archive: %w", err)
+		}
+		ar, err := NewReader(src)
+		if err != nil {
+			return err
+		}
+
+		for {
+			record, err := ar.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+
+			if opts.RemapIDs {
+				record.Data.remap(ids)
+			}
+			if t, ok := record.Data.(*Tenant); ok {
+				t.Code = code
+				t.Isolation = entity.TenantIsolationShared.String()
+			}
+			if c, ok := record.Data.(*Car); ok && c.CarModelID == "" {
+				if err := legacyModels.catalog(ctx, i.store, c); err != nil {
+					return err
+				}
+			}
+			if err := i.store.Import(ctx, record); err != nil {
+				return fmt.Errorf("failed to import %s %s: %w", record.Kind, record.Data.RecordID(), err)
+			}
+		}
+		counts = ar.counts
+		return nil
+	})
+	if err != nil {
+		return Summary{}, err
+	}
+	return Summary{TenantID: tenantID, Counts: counts}, nil
+}
+
+// validateArchive reads a whole archive and returns its header and tenant record
+func validateArchive(src io.ReadSeeker) (Header, *Tenant, error) {
+	ar, err := NewReader(src)
+	if err != nil {
+		return Header{}, nil, err
+	}
+
+	var tenant *Tenant
+	for {
+		record, err := ar.Next()
+		if errors.Is(err, io.EOF) {
+			return ar.Header(), tenant, nil
+		}
+		if err != nil {
+			return Header{}, nil, err
+		}
+		if t, ok := record.Data.(*Tenant); ok {
+			tenant = t
+		}
+	}
+}
+
+// legacyCarModels adds the model names of the cars of version 1 archives to the car model
+// catalog, and holds the IDs of the models added by make and name
+type legacyCarModels map[[2]string]string
+
+// catalog makes a car of a version 1 archive reference the catalog entry of its model name,
+// adding the entry on first use
+func (m legacyCarModels) catalog(ctx context.Context, store Store, car *Car) error {
+	model := entity.LegacyCarModel(car.TenantID, car.Model, car.CreatedAt)
+	key := [2]string{model.Spec.Make, model.Spec.Name}
+	if _, ok := m[key]; !ok {
+		record := Record{Kind: KindCarModel, Data: &CarModel{
+			ID:           model.ID,
+			TenantID:     model.TenantID,
+			Make:         model.Spec.Make,
+			Name:         model.Spec.Name,
+			Category:     model.Spec.Category.String(),
+			Seats:        model.Spec.Seats,
+			Transmission: model.Spec.Transmission.String(),
+			FuelType:     model.Spec.FuelType.String(),
+			CreatedAt:    model.CreatedAt,
+			UpdatedAt:    model.UpdatedAt,
+		}}
+		if err := store.Import(ctx, record); err != nil {
+			return fmt.Errorf("failed to import car model %q: %w", car.Model, err)
+		}
+		m[key] = model.ID
+	}
+
+	car.CarModelID = m[key]
+	car.Model = ""
+	return nil
+}
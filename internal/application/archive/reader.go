@@ -0,0 +1,222 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// ErrInvalidArchive is returned for archives that are malformed, inconsistent or truncated
+var ErrInvalidArchive = errors.New("invalid archive")
+
+// Reader reads an archive and validates it as it goes: records must come in the order of
+// Kinds, belong to the archived tenant and only reference records read before them. The
+// trailer must match the records read, so a truncated archive is never read to the end.
+type Reader struct {
+	r      *bufio.Reader
+	header Header
+	line   int
+	last   Kind
+	counts map[Kind]int
+	done   bool
+
+	// seen holds the IDs read per kind, to resolve references
+	seen map[Kind]map[string]struct{}
+	// renterTypes holds the type of each renter read, and subtyped the renters with a
+	// company or individual record
+	renterTypes map[string]string
+	subtyped    map[string]struct{}
+}
+
+// NewReader reads and validates the header of an archive and returns a reader for its records
+func NewReader(r io.Reader) (*Reader, error) {
+	ar := &Reader{
+		r:           bufio.NewReader(r),
+		last:        KindTenant,
+		counts:      make(map[Kind]int),
+		seen:        make(map[Kind]map[string]struct{}),
+		renterTypes: make(map[string]string),
+		subtyped:    make(map[string]struct{}),
+	}
+
+	l, err := ar.readLine()
+	if errors.Is(err, io.EOF) {
+		return nil, ar.invalid("archive is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if l.Kind != KindHeader {
+		return nil, ar.invalid("archive starts with a %s record instead of its header", l.Kind)
+	}
+	if err := ar.decode(l.Data, &ar.header); err != nil {
+		return nil, err
+	}
+	if ar.header.Version < 1 || ar.header.Version > Version {
+		return nil, ar.invalid("archive version %d is not supported; the latest is %d", ar.header.Version, Version)
+	}
+	if ar.header.TenantID == "" {
+		return nil, ar.invalid("tenant_id is required")
+	}
+	return ar, nil
+}
+
+// Header returns the header of the archive
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next record, or io.EOF once the trailer has been read and matched
+func (r *Reader) Next() (Record, error) {
+	if r.done {
+		return Record{}, io.EOF
+	}
+
+	l, err := r.readLine()
+	if errors.Is(err, io.EOF) {
+		return Record{}, r.invalid("archive is truncated: it has no trailer")
+	}
+	if err != nil {
+		return Record{}, err
+	}
+	if l.Kind == KindTrailer {
+		return Record{}, r.readTrailer(l.Data)
+	}
+
+	data, err := newData(l.Kind)
+	if err != nil {
+		return Record{}, r.invalid("%v", err)
+	}
+	if l.Kind.rank() < r.last.rank() {
+		return Record{}, r.invalid("%s record after %s records", l.Kind, r.last)
+	}
+	if err := r.decode(l.Data, data); err != nil {
+		return Record{}, err
+	}
+	if err := r.check(l.Kind, data); err != nil {
+		return Record{}, err
+	}
+
+	r.last = l.Kind
+	r.counts[l.Kind]++
+	if r.seen[l.Kind] == nil {
+		r.seen[l.Kind] = make(map[string]struct{})
+	}
+	r.seen[l.Kind][data.RecordID()] = struct{}{}
+	if renter, ok := data.(*Renter); ok {
+		r.renterTypes[renter.ID] = renter.Type
+	}
+	return Record{Kind: l.Kind, Data: data}, nil
+}
+
+// check validates a record against the records read before it
+func (r *Reader) check(kind Kind, data Data) error {
+	if err := data.validate(); err != nil {
+		return r.invalid("%s record: %v", kind, err)
+	}
+	if data.RecordTenantID() != r.header.TenantID {
+		return r.invalid("%s %s belongs to tenant %s, not to the archived tenant", kind, data.RecordID(), data.RecordTenantID())
+	}
+	if kind != KindTenant && r.counts[KindTenant] == 0 {
+		return r.invalid("%s record before the tenant record", kind)
+	}
+	if (kind == KindTenant || kind == KindTenantSettings) && r.counts[kind] > 0 {
+		return r.invalid("archive has more than one %s record", kind)
+	}
+	if _, ok := r.seen[kind][data.RecordID()]; ok {
+		return r.invalid("duplicate %s %s", kind, data.RecordID())
+	}
+
+	for refKind, ids := range data.references() {
+		for _, id := range ids {
+			if _, ok := r.seen[refKind][id]; !ok {
+				return r.invalid("%s %s references unknown %s %s", kind, data.RecordID(), refKind, id)
+			}
+		}
+	}
+
+	// A renter is a company or an individual, as its type says, and never both
+	if kind == KindCompany || kind == KindIndividual {
+		renterID := data.references()[KindRenter][0]
+		want := string(entity.CompanyRenter)
+		if kind == KindIndividual {
+			want = string(entity.IndividualRenter)
+		}
+		if r.renterTypes[renterID] != want {
+			return r.invalid("%s %s belongs to renter %s of type %s", kind, data.RecordID(), renterID, r.renterTypes[renterID])
+		}
+		if _, ok := r.subtyped[renterID]; ok {
+			return r.invalid("renter %s has more than one company or individual record", renterID)
+		}
+		r.subtyped[renterID] = struct{}{}
+	}
+	return nil
+}
+
+// readTrailer checks the trailer against the records read and that nothing follows it
+func (r *Reader) readTrailer(raw json.RawMessage) error {
+	var trailer Trailer
+	if err := r.decode(raw, &trailer); err != nil {
+		return err
+	}
+	if r.counts[KindTenant] == 0 {
+		return r.invalid("archive has no tenant record")
+	}
+	if !maps.Equal(trailer.Counts, r.counts) {
+		return r.invalid("trailer counts %v records, but the archive has %v", trailer.Counts, r.counts)
+	}
+	if _, err := r.readLine(); !errors.Is(err, io.EOF) {
+		if err != nil {
+			return err
+		}
+		return r.invalid("records follow the trailer")
+	}
+
+	r.done = true
+	return io.EOF
+}
+
+// readLine reads the next non-empty line, or returns io.EOF
+func (r *Reader) readLine() (line, error) {
+	for {
+		raw, err := r.r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return line{}, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if len(bytes.TrimSpace(raw)) == 0 {
+			if err != nil {
+				return line{}, io.EOF
+			}
+			r.line++
+			continue
+		}
+
+		r.line++
+		var l line
+		if err := r.decode(raw, &l); err != nil {
+			return line{}, err
+		}
+		return l, nil
+	}
+}
+
+// decode decodes JSON strictly, so that fields of a newer format are not silently dropped
+func (r *Reader) decode(raw []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return r.invalid("%v", err)
+	}
+	return nil
+}
+
+// invalid returns an ErrInvalidArchive error located at the current line
+func (r *Reader) invalid(format string, args ...any) error {
+	return fmt.Errorf("%w: line %d: %s", ErrInvalidArchive, r.line, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,434 @@
+package archive
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aarondl/null/v9"
+	"github.com/oklog/ulid/v2"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// RecordID returns the ID of the tenant
+func (t *Tenant) RecordID() string {
+	return t.ID
+}
+
+// RecordTenantID returns the ID of the tenant
+func (t *Tenant) RecordTenantID() string {
+	return t.ID
+}
+
+func (t *Tenant) references() map[Kind][]string {
+	return nil
+}
+
+func (t *Tenant) validate() error {
+	return requireFields("id", t.ID, "code", t.Code, "status", t.Status)
+}
+
+func (t *Tenant) remap(ids *idMap) {
+	t.ID = ids.remap(t.ID)
+}
+
+// RecordID returns the ID of the settings, which is the ID of their tenant
+func (s *TenantSettings) RecordID() string {
+	return s.ID
+}
+
+// RecordTenantID returns the tenant of the settings
+func (s *TenantSettings) RecordTenantID() string {
+	return s.TenantID
+}
+
+func (s *TenantSettings) references() map[Kind][]string {
+	return nil
+}
+
+func (s *TenantSettings) validate() error {
+	return requireFields("id", s.ID, "tenant_id", s.TenantID, "timezone", s.Timezone,
+		"currency", s.Currency, "locale", s.Locale)
+}
+
+func (s *TenantSettings) remap(ids *idMap) {
+	s.ID = ids.remap(s.ID)
+	s.TenantID = ids.remap(s.TenantID)
+}
+
+// RecordID returns the ID of the option
+func (o *CarOption) RecordID() string {
+	return o.ID
+}
+
+// RecordTenantID returns the tenant of the option
+func (o *CarOption) RecordTenantID() string {
+	return o.TenantID
+}
+
+func (o *CarOption) references() map[Kind][]string {
+	return nil
+}
+
+func (o *CarOption) validate() error {
+	return requireFields("id", o.ID, "tenant_id", o.TenantID, "name", o.Name)
+}
+
+func (o *CarOption) remap(ids *idMap) {
+	o.ID = ids.remap(o.ID)
+	o.TenantID = ids.remap(o.TenantID)
+}
+
+// RecordID returns the ID of the car model
+func (m *CarModel) RecordID() string {
+	return m.ID
+}
+
+// RecordTenantID returns the tenant of the car model
+func (m *CarModel) RecordTenantID() string {
+	return m.TenantID
+}
+
+func (m *CarModel) references() map[Kind][]string {
+	return nil
+}
+
+func (m *CarModel) validate() error {
+	return requireFields("id", m.ID, "tenant_id", m.TenantID, "make", m.Make, "name", m.Name,
+		"category", m.Category, "transmission", m.Transmission, "fuel_type", m.FuelType)
+}
+
+func (m *CarModel) remap(ids *idMap) {
+	m.ID = ids.remap(m.ID)
+	m.TenantID = ids.remap(m.TenantID)
+}
+
+// RecordID returns the ID of the branch
+func (b *Branch) RecordID() string {
+	return b.ID
+}
+
+// RecordTenantID returns the tenant of the branch
+func (b *Branch) RecordTenantID() string {
+	return b.TenantID
+}
+
+func (b *Branch) references() map[Kind][]string {
+	return nil
+}
+
+func (b *Branch) validate() error {
+	return requireFields("id", b.ID, "tenant_id", b.TenantID, "name", b.Name, "street", b.Street,
+		"city", b.City, "country", b.Country)
+}
+
+func (b *Branch) remap(ids *idMap) {
+	b.ID = ids.remap(b.ID)
+	b.TenantID = ids.remap(b.TenantID)
+}
+
+// branchIDs returns the branches set among the given ones
+func branchIDs(branches ...null.String) []string {
+	var ids []string
+	for _, branch := range branches {
+		if branch.Valid {
+			ids = append(ids, branch.String)
+		}
+	}
+	return ids
+}
+
+// remapBranch remaps the ID of an optional branch reference
+func remapBranch(ids *idMap, branch null.String) null.String {
+	if !branch.Valid {
+		return branch
+	}
+	return null.StringFrom(ids.remap(branch.String))
+}
+
+// RecordID returns the ID of the car
+func (c *Car) RecordID() string {
+	return c.ID
+}
+
+// RecordTenantID returns the tenant of the car
+func (c *Car) RecordTenantID() string {
+	return c.TenantID
+}
+
+func (c *Car) references() map[Kind][]string {
+	refs := make(map[Kind][]string)
+	if c.CarModelID != "" {
+		refs[KindCarModel] = []string{c.CarModelID}
+	}
+	refs[KindBranch] = branchIDs(c.HomeBranchID, c.CurrentBranchID)
+	return refs
+}
+
+func (c *Car) validate() error {
+	if err := requireFields("id", c.ID, "tenant_id", c.TenantID); err != nil {
+		return err
+	}
+	if c.CarModelID == "" && c.Model == "" {
+		return errors.New("car_model_id or model is required")
+	}
+	return nil
+}
+
+func (c *Car) remap(ids *idMap) {
+	c.ID = ids.remap(c.ID)
+	c.TenantID = ids.remap(c.TenantID)
+	if c.CarModelID != "" {
+		c.CarModelID = ids.remap(c.CarModelID)
+	}
+	c.HomeBranchID = remapBranch(ids, c.HomeBranchID)
+	c.CurrentBranchID = remapBranch(ids, c.CurrentBranchID)
+}
+
+// RecordID returns the ID of the maintenance window
+func (w *MaintenanceWindow) RecordID() string {
+	return w.ID
+}
+
+// RecordTenantID returns the tenant of the maintenance window
+func (w *MaintenanceWindow) RecordTenantID() string {
+	return w.TenantID
+}
+
+func (w *MaintenanceWindow) references() map[Kind][]string {
+	return map[Kind][]string{
+		KindCar: {w.CarID},
+	}
+}
+
+func (w *MaintenanceWindow) validate() error {
+	return requireFields("id", w.ID, "tenant_id", w.TenantID, "car_id", w.CarID, "type", w.Type, "status", w.Status)
+}
+
+func (w *MaintenanceWindow) remap(ids *idMap) {
+	w.ID = ids.remap(w.ID)
+	w.TenantID = ids.remap(w.TenantID)
+	w.CarID = ids.remap(w.CarID)
+}
+
+// RecordID returns the ID of the renter
+func (r *Renter) RecordID() string {
+	return r.ID
+}
+
+// RecordTenantID returns the tenant of the renter
+func (r *Renter) RecordTenantID() string {
+	return r.TenantID
+}
+
+func (r *Renter) references() map[Kind][]string {
+	return nil
+}
+
+func (r *Renter) validate() error {
+	if err := requireFields("id", r.ID, "tenant_id", r.TenantID, "type", r.Type); err != nil {
+		return err
+	}
+	if r.Type != string(entity.CompanyRenter) && r.Type != string(entity.IndividualRenter) {
+		return fmt.Errorf("unknown renter type %q", r.Type)
+	}
+	return nil
+}
+
+func (r *Renter) remap(ids *idMap) {
+	r.ID = ids.remap(r.ID)
+	r.TenantID = ids.remap(r.TenantID)
+}
+
+// RecordID returns the ID of the company
+func (c *Company) RecordID() string {
+	return c.ID
+}
+
+// RecordTenantID returns the tenant of the company
+func (c *Company) RecordTenantID() string {
+	return c.TenantID
+}
+
+func (c *Company) references() map[Kind][]string {
+	return map[Kind][]string{KindRenter: {c.RenterID}}
+}
+
+func (c *Company) validate() error {
+	return requireFields("id", c.ID, "tenant_id", c.TenantID, "renter_id", c.RenterID,
+		"name", c.Name, "company_size", c.CompanySize)
+}
+
+func (c *Company) remap(ids *idMap) {
+	c.ID = ids.remap(c.ID)
+	c.TenantID = ids.remap(c.TenantID)
+	c.RenterID = ids.remap(c.RenterID)
+}
+
+// RecordID returns the ID of the individual
+func (i *Individual) RecordID() string {
+	return i.ID
+}
+
+// RecordTenantID returns the tenant of the individual
+func (i *Individual) RecordTenantID() string {
+	return i.TenantID
+}
+
+func (i *Individual) references() map[Kind][]string {
+	return map[Kind][]string{KindRenter: {i.RenterID}}
+}
+
+func (i *Individual) validate() error {
+	return requireFields("id", i.ID, "tenant_id", i.TenantID, "renter_id", i.RenterID, "email", i.Email)
+}
+
+func (i *Individual) remap(ids *idMap) {
+	i.ID = ids.remap(i.ID)
+	i.TenantID = ids.remap(i.TenantID)
+	i.RenterID = ids.remap(i.RenterID)
+}
+
+// RecordID returns the ID of the rental
+func (r *Rental) RecordID() string {
+	return r.ID
+}
+
+// RecordTenantID returns the tenant of the rental
+func (r *Rental) RecordTenantID() string {
+	return r.TenantID
+}
+
+func (r *Rental) references() map[Kind][]string {
+	return map[Kind][]string{
+		KindCar:    {r.CarID},
+		KindRenter: {r.RenterID},
+		KindBranch: branchIDs(r.PickupBranchID, r.ReturnBranchID),
+	}
+}
+
+func (r *Rental) validate() error {
+	return requireFields("id", r.ID, "tenant_id", r.TenantID, "car_id", r.CarID, "renter_id", r.RenterID)
+}
+
+func (r *Rental) remap(ids *idMap) {
+	r.ID = ids.remap(r.ID)
+	r.TenantID = ids.remap(r.TenantID)
+	r.CarID = ids.remap(r.CarID)
+	r.RenterID = ids.remap(r.RenterID)
+	r.PickupBranchID = remapBranch(ids, r.PickupBranchID)
+	r.ReturnBranchID = remapBranch(ids, r.ReturnBranchID)
+}
+
+// RecordID returns the ID of the rental option
+func (o *RentalOption) RecordID() string {
+	return o.ID
+}
+
+// RecordTenantID returns the tenant of the rental option
+func (o *RentalOption) RecordTenantID() string {
+	return o.TenantID
+}
+
+func (o *RentalOption) references() map[Kind][]string {
+	return map[Kind][]string{KindRental: {o.RentalID}, KindCarOption: {o.OptionID}}
+}
+
+func (o *RentalOption) validate() error {
+	return requireFields("id", o.ID, "tenant_id", o.TenantID, "rental_id", o.RentalID, "option_id", o.OptionID)
+}
+
+func (o *RentalOption) remap(ids *idMap) {
+	o.ID = ids.remap(o.ID)
+	o.TenantID = ids.remap(o.TenantID)
+	o.RentalID = ids.remap(o.RentalID)
+	o.OptionID = ids.remap(o.OptionID)
+}
+
+// RecordID returns the ID of the rental handover
+func (h *RentalHandover) RecordID() string {
+	return h.ID
+}
+
+// RecordTenantID returns the tenant of the rental handover
+func (h *RentalHandover) RecordTenantID() string {
+	return h.TenantID
+}
+
+func (h *RentalHandover) references() map[Kind][]string {
+	return map[Kind][]string{KindRental: {h.RentalID}}
+}
+
+func (h *RentalHandover) validate() error {
+	return requireFields("id", h.ID, "tenant_id", h.TenantID, "rental_id", h.RentalID, "kind", h.Kind)
+}
+
+func (h *RentalHandover) remap(ids *idMap) {
+	h.ID = ids.remap(h.ID)
+	h.TenantID = ids.remap(h.TenantID)
+	h.RentalID = ids.remap(h.RentalID)
+}
+
+// RecordID returns the ID of the outbox message
+func (m *OutboxMessage) RecordID() string {
+	return m.ID
+}
+
+// RecordTenantID returns the tenant of the outbox message
+func (m *OutboxMessage) RecordTenantID() string {
+	return m.TenantID
+}
+
+// references returns nothing: the aggregate of an event may have been deleted since
+func (m *OutboxMessage) references() map[Kind][]string {
+	return nil
+}
+
+func (m *OutboxMessage) validate() error {
+	return requireFields("id", m.ID, "tenant_id", m.TenantID, "aggregate_type", m.AggregateType,
+		"aggregate_id", m.AggregateID, "event_type", m.EventType, "status", m.Status)
+}
+
+func (m *OutboxMessage) remap(ids *idMap) {
+	m.ID = ids.remap(m.ID)
+	m.TenantID = ids.remap(m.TenantID)
+	m.AggregateID = ids.lookup(m.AggregateID)
+}
+
+// requireFields returns an error naming the first empty field of name and value pairs
+func requireFields(pairs ...string) error {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			return fmt.Errorf("%s is required", pairs[i])
+		}
+	}
+	return nil
+}
+
+// idMap gives the records of an archive new IDs, the same one wherever an ID appears
+type idMap struct {
+	ids map[string]string
+}
+
+// newIDMap creates an empty ID map
+func newIDMap() *idMap {
+	return &idMap{ids: make(map[string]string)}
+}
+
+// remap returns the new ID of an archived ID, making it up on first use
+func (m *idMap) remap(id string) string {
+	if newID, ok := m.ids[id]; ok {
+		return newID
+	}
+	newID := ulid.Make().String()
+	m.ids[id] = newID
+	return newID
+}
+
+// lookup returns the new ID of an archived ID already remapped, or the ID itself
+func (m *idMap) lookup(id string) string {
+	if newID, ok := m.ids[id]; ok {
+		return newID
+	}
+	return id
+}
@@ -0,0 +1,28 @@
+package archive
+
+import (
+	"context"
+	"io"
+)
+
+// Store reads and writes the rows of a tenant (secondary port)
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_archive
+type Store interface {
+	// ExportTenant reads the record of a tenant
+	ExportTenant(ctx context.Context, tenantID string) (*Tenant, error)
+	// Export reads the other records of a tenant and passes them to write in the order of Kinds
+	Export(ctx context.Context, tenantID string, write func(Record) error) error
+	// Import inserts a record
+	Import(ctx context.Context, record Record) error
+}
+
+// Storage keeps archives by name (secondary port)
+type Storage interface {
+	// Create creates a new archive; it fails if the name is taken
+	Create(ctx context.Context, name string) (io.WriteCloser, error)
+	// Open opens an archive; it fails with an error matching fs.ErrNotExist if there is none
+	Open(ctx context.Context, name string) (io.ReadSeekCloser, error)
+	// Remove deletes an archive
+	Remove(ctx context.Context, name string) error
+}
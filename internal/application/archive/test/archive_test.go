@@ -0,0 +1,358 @@
+package archive_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/archive"
+	mock_archive "github.com/jp-ryuji/go-arch-patterns/internal/application/archive/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+const tenantID = "tenant-1"
+
+// sampleRecords returns the records of a small tenant, in archive order
+func sampleRecords() []archive.Record {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []archive.Record{
+		{Kind: archive.KindCarOption, Data: &archive.CarOption{ID: "option-1", TenantID: tenantID, Name: "GPS", CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindCarModel, Data: &archive.CarModel{ID: "model-1", TenantID: tenantID, Make: "Toyota", Name: "Prius", Category: "compact", Seats: 5, Transmission: "automatic", FuelType: "hybrid", CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindBranch, Data: &archive.Branch{ID: "branch-1", TenantID: tenantID, Name: "Shinjuku", Street: "3-38-1 Shinjuku", City: "Shinjuku", Country: "JP", Latitude: 35.6896, Longitude: 139.7006, CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindCar, Data: &archive.Car{ID: "car-1", TenantID: tenantID, CarModelID: "model-1", HomeBranchID: null.StringFrom("branch-1"), CurrentBranchID: null.StringFrom("branch-1"), CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindMaintenanceWindow, Data: &archive.MaintenanceWindow{ID: "maintenance-1", TenantID: tenantID, CarID: "car-1", Type: "inspection", Status: "scheduled", StartsAt: now, EndsAt: now.Add(time.Hour), CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindRenter, Data: &archive.Renter{ID: "renter-1", TenantID: tenantID, Type: string(entity.IndividualRenter), CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindIndividual, Data: &archive.Individual{ID: "individual-1", TenantID: tenantID, RenterID: "renter-1", Email: "jane@example.com", CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindRental, Data: &archive.Rental{ID: "rental-1", TenantID: tenantID, CarID: "car-1", RenterID: "renter-1", PickupBranchID: null.StringFrom("branch-1"), ReturnBranchID: null.StringFrom("branch-1"), StartsAt: now, EndsAt: now.Add(24 * time.Hour), CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindRentalOption, Data: &archive.RentalOption{ID: "rental-option-1", TenantID: tenantID, RentalID: "rental-1", OptionID: "option-1", Count: 1, CreatedAt: now, UpdatedAt: now}},
+		{Kind: archive.KindRentalHandover, Data: &archive.RentalHandover{ID: "handover-1", TenantID: tenantID, RentalID: "rental-1", Kind: "pickup", OdometerKm: 12000, FuelLevel: 100, Damages: []archive.HandoverDamage{{Area: "rear", Description: "Scratch"}}, InspectedAt: now, CreatedAt: now}},
+		{Kind: archive.KindOutboxMessage, Data: &archive.OutboxMessage{ID: "message-1", TenantID: tenantID, AggregateType: "car", AggregateID: "car-1", EventType: "car.created", Status: "processed", CreatedAt: now}},
+	}
+}
+
+// sampleTenant returns the record of the sample tenant
+func sampleTenant() *archive.Tenant {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &archive.Tenant{ID: tenantID, Code: "acme", Status: "active", Isolation: "schema", CreatedAt: now, UpdatedAt: now}
+}
+
+// newTxManager returns a transaction manager mock running functions without a transaction
+func newTxManager(ctrl *gomock.Controller) *mock_repository.MockTransactionManager {
+	txManager := mock_repository.NewMockTransactionManager(ctrl)
+	txManager.EXPECT().RunInTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+	return txManager
+}
+
+// exportSample exports the sample tenant and returns the archive
+func exportSample(t *testing.T) []byte {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	store := mock_archive.NewMockStore(ctrl)
+	store.EXPECT().ExportTenant(gomock.Any(), tenantID).Return(sampleTenant(), nil)
+	store.EXPECT().Export(gomock.Any(), tenantID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, write func(archive.Record) error) error {
+			for _, record := range sampleRecords() {
+				if err := write(record); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+
+	var buf bytes.Buffer
+	summary, err := archive.NewExporter(newTxManager(ctrl), store).Export(context.Background(), tenantID, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, tenantID, summary.TenantID)
+	assert.Equal(t, 1, summary.Counts[archive.KindTenant])
+	assert.Equal(t, 1, summary.Counts[archive.KindCar])
+	return buf.Bytes()
+}
+
+// TestExportImport_PreservesIDs tests that an import restores the exported records as they were
+func TestExportImport_PreservesIDs(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	store := mock_archive.NewMockStore(ctrl)
+	importer := archive.NewImporter(newTxManager(ctrl), store)
+	data := exportSample(t)
+
+	// Set up expectations
+	var imported []archive.Record
+	store.EXPECT().Import(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, record archive.Record) error {
+			imported = append(imported, record)
+			return nil
+		},
+	).Times(12)
+
+	// Execute
+	summary, err := importer.Import(context.Background(), bytes.NewReader(data), archive.ImportOptions{})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, tenantID, summary.TenantID)
+	tenant := imported[0].Data.(*archive.Tenant)
+	assert.Equal(t, "acme", tenant.Code)
+	assert.Equal(t, entity.TenantIsolationShared.String(), tenant.Isolation)
+	for i, want := range sampleRecords() {
+		assert.Equal(t, want.Kind, imported[i+1].Kind)
+		assert.Equal(t, want.Data.RecordID(), imported[i+1].Data.RecordID())
+		assert.Equal(t, tenantID, imported[i+1].Data.RecordTenantID())
+	}
+	assert.Equal(t, "car-1", imported[8].Data.(*archive.Rental).CarID)
+}
+
+// TestExportImport_RemapsIDs tests that remapped records get new IDs and keep referencing each other
+func TestExportImport_RemapsIDs(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	store := mock_archive.NewMockStore(ctrl)
+	importer := archive.NewImporter(newTxManager(ctrl), store)
+	data := exportSample(t)
+
+	// Set up expectations
+	records := make(map[archive.Kind]archive.Data)
+	store.EXPECT().Import(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, record archive.Record) error {
+			records[record.Kind] = record.Data
+			return nil
+		},
+	).Times(12)
+
+	// Execute
+	summary, err := importer.Import(context.Background(), bytes.NewReader(data), archive.ImportOptions{
+		RemapIDs: true,
+		Code:     "acme-copy",
+	})
+	require.NoError(t, err)
+
+	// Assert
+	tenant := records[archive.KindTenant].(*archive.Tenant)
+	model := records[archive.KindCarModel].(*archive.CarModel)
+	car := records[archive.KindCar].(*archive.Car)
+	renter := records[archive.KindRenter].(*archive.Renter)
+	rental := records[archive.KindRental].(*archive.Rental)
+	message := records[archive.KindOutboxMessage].(*archive.OutboxMessage)
+	assert.NotEqual(t, tenantID, tenant.ID)
+	assert.Equal(t, tenant.ID, summary.TenantID)
+	assert.Equal(t, "acme-copy", tenant.Code)
+	assert.NotEqual(t, "car-1", car.ID)
+	assert.Equal(t, tenant.ID, car.TenantID)
+	assert.NotEqual(t, "model-1", model.ID)
+	assert.Equal(t, model.ID, car.CarModelID)
+	branch := records[archive.KindBranch].(*archive.Branch)
+	assert.NotEqual(t, "branch-1", branch.ID)
+	assert.Equal(t, branch.ID, car.HomeBranchID.String)
+	assert.Equal(t, branch.ID, car.CurrentBranchID.String)
+	assert.Equal(t, branch.ID, rental.PickupBranchID.String)
+	assert.Equal(t, branch.ID, rental.ReturnBranchID.String)
+	assert.Equal(t, car.ID, records[archive.KindMaintenanceWindow].(*archive.MaintenanceWindow).CarID)
+	assert.Equal(t, car.ID, rental.CarID)
+	assert.Equal(t, renter.ID, rental.RenterID)
+	assert.Equal(t, renter.ID, records[archive.KindIndividual].(*archive.Individual).RenterID)
+	assert.Equal(t, rental.ID, records[archive.KindRentalHandover].(*archive.RentalHandover).RentalID)
+	assert.Equal(t, car.ID, message.AggregateID)
+}
+
+// TestImport_Version1 tests that the model names of the cars of a version 1 archive are
+// added to the car model catalog once each
+func TestImport_Version1(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	store := mock_archive.NewMockStore(ctrl)
+	importer := archive.NewImporter(newTxManager(ctrl), store)
+	header := `{"kind":"header","data":{"version":1,"tenant_id":"tenant-1","tenant_code":"acme","exported_at":"2026-01-02T03:04:05Z"}}`
+	tenant := `{"kind":"tenant","data":{"id":"tenant-1","code":"acme","status":"active","isolation":"shared","created_at":"2026-01-02T03:04:05Z","updated_at":"2026-01-02T03:04:05Z"}}`
+	car := `{"kind":"car","data":{"id":"car-%d","tenant_id":"tenant-1","model":"%s","created_at":"2026-01-02T03:04:05Z","updated_at":"2026-01-02T03:04:05Z","deleted_at":null}}`
+	trailer := `{"kind":"trailer","data":{"counts":{"tenant":1,"car":3}}}`
+	lines := []string{header, tenant, fmt.Sprintf(car, 1, "Toyota Prius"), fmt.Sprintf(car, 2, "PRIUS"), fmt.Sprintf(car, 3, "Toyota Prius"), trailer}
+
+	// Set up expectations
+	var models []*archive.CarModel
+	var cars []*archive.Car
+	store.EXPECT().Import(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, record archive.Record) error {
+			switch data := record.Data.(type) {
+			case *archive.CarModel:
+				models = append(models, data)
+			case *archive.Car:
+				cars = append(cars, data)
+			}
+			return nil
+		},
+	).Times(6)
+
+	// Execute
+	_, err := importer.Import(context.Background(), strings.NewReader(strings.Join(lines, "\n")), archive.ImportOptions{})
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, models, 2)
+	assert.Equal(t, "Toyota", models[0].Make)
+	assert.Equal(t, "Prius", models[0].Name)
+	assert.Equal(t, entity.CarCategoryUnspecified.String(), models[0].Category)
+	assert.Equal(t, "PRIUS", models[1].Make)
+	assert.Equal(t, "PRIUS", models[1].Name)
+	require.Len(t, cars, 3)
+	assert.Equal(t, models[0].ID, cars[0].CarModelID)
+	assert.Equal(t, models[1].ID, cars[1].CarModelID)
+	assert.Equal(t, models[0].ID, cars[2].CarModelID)
+	assert.Empty(t, cars[0].Model)
+}
+
+// TestImport_InvalidCode tests that an archive is not imported under an invalid code
+func TestImport_InvalidCode(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	importer := archive.NewImporter(mock_repository.NewMockTransactionManager(ctrl), mock_archive.NewMockStore(ctrl))
+	data := exportSample(t)
+
+	// Execute
+	_, err := importer.Import(context.Background(), bytes.NewReader(data), archive.ImportOptions{Code: "Not A Code"})
+	assert.ErrorContains(t, err, "tenant code must contain only lowercase letters")
+}
+
+// TestImport_InvalidArchive tests that invalid archives are rejected before anything is imported
+func TestImport_InvalidArchive(t *testing.T) {
+	t.Parallel()
+
+	header := `{"kind":"header","data":{"version":1,"tenant_id":"tenant-1","tenant_code":"acme","exported_at":"2026-01-02T03:04:05Z"}}`
+	tenant := `{"kind":"tenant","data":{"id":"tenant-1","code":"acme","status":"active","suspended_at":null,"isolation":"shared","created_at":"2026-01-02T03:04:05Z","updated_at":"2026-01-02T03:04:05Z","deleted_at":null}}`
+	car := `{"kind":"car","data":{"id":"car-1","tenant_id":"tenant-1","model":"PRIUS","created_at":"2026-01-02T03:04:05Z","updated_at":"2026-01-02T03:04:05Z","deleted_at":null}}`
+	renter := `{"kind":"renter","data":{"id":"renter-1","tenant_id":"tenant-1","type":"individual","created_at":"2026-01-02T03:04:05Z","updated_at":"2026-01-02T03:04:05Z","deleted_at":null}}`
+	trailer := `{"kind":"trailer","data":{"counts":{"tenant":1,"car":1}}}`
+
+	tests := map[string]struct {
+		lines []string
+		want  string
+	}{
+		"empty": {
+			lines: nil,
+			want:  "archive is empty",
+		},
+		"no header": {
+			lines: []string{tenant, car, trailer},
+			want:  "instead of its header",
+		},
+		"unsupported version": {
+			lines: []string{fmt.Sprintf(`{"kind":"header","data":{"version":%d,"tenant_id":"tenant-1"}}`, archive.Version+1), tenant, trailer},
+			want:  fmt.Sprintf("version %d is not supported", archive.Version+1),
+		},
+		"unknown field": {
+			lines: []string{header, tenant, `{"kind":"car","data":{"id":"car-1","tenant_id":"tenant-1","model":"PRIUS","color":"red"}}`, trailer},
+			want:  `unknown field "color"`,
+		},
+		"missing field": {
+			lines: []string{header, tenant, `{"kind":"car","data":{"id":"car-1","tenant_id":"tenant-1"}}`, trailer},
+			want:  "car_model_id or model is required",
+		},
+		"out of order": {
+			lines: []string{header, tenant, renter, car, trailer},
+			want:  "car record after renter records",
+		},
+		"record before tenant": {
+			lines: []string{header, car, tenant, trailer},
+			want:  "car record before the tenant record",
+		},
+		"other tenant": {
+			lines: []string{header, tenant, strings.ReplaceAll(car, `"tenant_id":"tenant-1"`, `"tenant_id":"tenant-2"`), trailer},
+			want:  "belongs to tenant tenant-2",
+		},
+		"duplicate": {
+			lines: []string{header, tenant, car, car, trailer},
+			want:  "duplicate car car-1",
+		},
+		"unknown reference": {
+			lines: []string{header, tenant, car, renter, `{"kind":"rental","data":{"id":"rental-1","tenant_id":"tenant-1","car_id":"car-2","renter_id":"renter-1"}}`, trailer},
+			want:  "references unknown car car-2",
+		},
+		"subtype of another type": {
+			lines: []string{header, tenant, renter, `{"kind":"company","data":{"id":"company-1","tenant_id":"tenant-1","renter_id":"renter-1","name":"Acme","company_size":"small"}}`, trailer},
+			want:  "of type individual",
+		},
+		"truncated": {
+			lines: []string{header, tenant, car},
+			want:  "archive is truncated",
+		},
+		"counts differ": {
+			lines: []string{header, tenant, trailer},
+			want:  "trailer counts",
+		},
+		"records after trailer": {
+			lines: []string{header, tenant, car, trailer, car},
+			want:  "records follow the trailer",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup: no transaction is started and nothing is imported
+			ctrl := gomock.NewController(t)
+			importer := archive.NewImporter(mock_repository.NewMockTransactionManager(ctrl), mock_archive.NewMockStore(ctrl))
+			src := strings.NewReader(strings.Join(tt.lines, "\n"))
+
+			// Execute
+			_, err := importer.Import(context.Background(), src, archive.ImportOptions{})
+
+			// Assert
+			require.ErrorIs(t, err, archive.ErrInvalidArchive)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+// TestReader_ValidArchive tests that a valid archive reads to its end
+func TestReader_ValidArchive(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r, err := archive.NewReader(bytes.NewReader(exportSample(t)))
+	require.NoError(t, err)
+	assert.Equal(t, "acme", r.Header().TenantCode)
+
+	// Execute
+	var kinds []archive.Kind
+	for {
+		record, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		kinds = append(kinds, record.Kind)
+	}
+
+	// Assert
+	assert.Equal(t, []archive.Kind{
+		archive.KindTenant, archive.KindCarOption, archive.KindCarModel, archive.KindBranch, archive.KindCar,
+		archive.KindMaintenanceWindow, archive.KindRenter, archive.KindIndividual,
+		archive.KindRental, archive.KindRentalOption, archive.KindRentalHandover, archive.KindOutboxMessage,
+	}, kinds)
+}
@@ -0,0 +1,73 @@
+package archive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// line is a record as it is encoded on one line of an archive
+type line struct {
+	Kind Kind            `json:"kind"`
+	Data json.RawMessage `json:"data"`
+}
+
+// Writer writes an archive. Records must be written in the order of Kinds, and the
+// archive is only complete once the writer is closed.
+type Writer struct {
+	w      io.Writer
+	last   Kind
+	counts map[Kind]int
+}
+
+// NewWriter writes the header of an archive to w and returns a writer for its records
+func NewWriter(w io.Writer, header Header) (*Writer, error) {
+	header.Version = Version
+	aw := &Writer{
+		w:      w,
+		last:   KindTenant,
+		counts: make(map[Kind]int),
+	}
+	if err := aw.writeLine(KindHeader, header); err != nil {
+		return nil, err
+	}
+	return aw, nil
+}
+
+// Write writes a record
+func (w *Writer) Write(record Record) error {
+	if record.Kind.rank() < w.last.rank() {
+		return fmt.Errorf("failed to write %s record: %s records are already written", record.Kind, w.last)
+	}
+	if err := w.writeLine(record.Kind, record.Data); err != nil {
+		return err
+	}
+	w.last = record.Kind
+	w.counts[record.Kind]++
+	return nil
+}
+
+// Close writes the trailer and returns the number of records written per kind. It does
+// not close the underlying writer.
+func (w *Writer) Close() (map[Kind]int, error) {
+	if err := w.writeLine(KindTrailer, Trailer{Counts: w.counts}); err != nil {
+		return nil, err
+	}
+	return w.counts, nil
+}
+
+// writeLine writes one record and its line break
+func (w *Writer) writeLine(kind Kind, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s record: %w", kind, err)
+	}
+	encoded, err := json.Marshal(line{Kind: kind, Data: raw})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s record: %w", kind, err)
+	}
+	if _, err := w.w.Write(append(encoded, '\n')); err != nil {
+		return fmt.Errorf("failed to write %s record: %w", kind, err)
+	}
+	return nil
+}
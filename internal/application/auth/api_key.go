@@ -0,0 +1,60 @@
+// Package auth authenticates the callers of the API.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+)
+
+// ErrInvalidCredentials is returned for credentials that are unknown, revoked or expired
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// APIKeyAuthenticator authenticates tenants' API keys
+type APIKeyAuthenticator struct {
+	apiKeyRepo repository.APIKeyRepository
+	recorder   *UsageRecorder
+}
+
+// NewAPIKeyAuthenticator creates a new API key authenticator recording each use with recorder
+func NewAPIKeyAuthenticator(apiKeyRepo repository.APIKeyRepository, recorder *UsageRecorder) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{
+		apiKeyRepo: apiKeyRepo,
+		recorder:   recorder,
+	}
+}
+
+// Authenticate returns the principal of an API key. ok is false when token is not an API
+// key at all, so that other kinds of bearer tokens can be tried.
+func (a *APIKeyAuthenticator) Authenticate(ctx context.Context, token string) (*authctx.Principal, bool, error) {
+	prefix, ok := entity.ParseAPIKeyPrefix(token)
+	if !ok {
+		return nil, false, nil
+	}
+
+	apiKey, err := a.apiKeyRepo.GetByPrefix(ctx, prefix)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, true, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	now := time.Now()
+	if !apiKey.Matches(token) || !apiKey.Active(now) {
+		return nil, true, ErrInvalidCredentials
+	}
+
+	a.recorder.Record(apiKey.ID, now)
+
+	return &authctx.Principal{
+		TenantID: apiKey.TenantID,
+		Subject:  apiKey.ID,
+		Scopes:   apiKey.Scopes,
+	}, true, nil
+}
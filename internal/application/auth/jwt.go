@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+)
+
+// DefaultJWTLeeway is the clock skew tolerated when checking the time claims of a JWT
+const DefaultJWTLeeway = time.Minute
+
+// jwtAlgorithms are the signature algorithms accepted in JWTs. Symmetric algorithms are
+// left out: the keys come from a public JWKS.
+var jwtAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTConfig holds the claims a JWT must carry to be accepted
+type JWTConfig struct {
+	// Issuer is the required iss claim
+	Issuer string
+	// Audience must be one of the aud claims
+	Audience string
+	// Leeway is the clock skew tolerated when checking exp, nbf and iat
+	Leeway time.Duration
+}
+
+// jwtClaims are the private claims read from a JWT
+type jwtClaims struct {
+	TenantID string   `json:"tenant_id"`
+	Roles    []string `json:"roles"`
+	RenterID string   `json:"renter_id"`
+}
+
+// JWTAuthenticator authenticates JWTs issued to users by an OIDC identity provider
+type JWTAuthenticator struct {
+	keySet KeySet
+	cfg    JWTConfig
+}
+
+// NewJWTAuthenticator creates a new JWT authenticator verifying signatures with keySet. A
+// zero Leeway is replaced with the default.
+func NewJWTAuthenticator(keySet KeySet, cfg JWTConfig) *JWTAuthenticator {
+	if cfg.Leeway <= 0 {
+		cfg.Leeway = DefaultJWTLeeway
+	}
+
+	return &JWTAuthenticator{
+		keySet: keySet,
+		cfg:    cfg,
+	}
+}
+
+// Authenticate returns the principal of a JWT. ok is false when token is not a JWT at all,
+// so that other kinds of bearer tokens can be tried.
+func (a *JWTAuthenticator) Authenticate(ctx context.Context, token string) (*authctx.Principal, bool, error) {
+	if strings.Count(token, ".") != 2 {
+		return nil, false, nil
+	}
+
+	parsed, err := jwt.ParseSigned(token, jwtAlgorithms)
+	if err != nil {
+		return nil, true, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	key, err := a.keySet.Key(ctx, parsed.Headers[0].KeyID)
+	if errors.Is(err, ErrUnknownKey) {
+		return nil, true, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to get signing key: %w", err)
+	}
+
+	var registered jwt.Claims
+	var private jwtClaims
+	if err := parsed.Claims(key, &registered, &private); err != nil {
+		return nil, true, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	if err := a.validate(&registered, &private); err != nil {
+		return nil, true, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+
+	return &authctx.Principal{
+		TenantID: private.TenantID,
+		Subject:  registered.Subject,
+		Roles:    roles(private.Roles),
+		RenterID: private.RenterID,
+	}, true, nil
+}
+
+// validate checks the claims against the configuration
+func (a *JWTAuthenticator) validate(registered *jwt.Claims, private *jwtClaims) error {
+	if registered.Expiry == nil {
+		return errors.New("token has no expiry")
+	}
+	err := registered.ValidateWithLeeway(jwt.Expected{
+		Issuer:      a.cfg.Issuer,
+		AnyAudience: jwt.Audience{a.cfg.Audience},
+		Time:        time.Now(),
+	}, a.cfg.Leeway)
+	if err != nil {
+		return err
+	}
+
+	if registered.Subject == "" {
+		return errors.New("token has no subject")
+	}
+	if private.TenantID == "" && !slices.Contains(private.Roles, string(authctx.RolePlatformAdmin)) {
+		return errors.New("token has no tenant")
+	}
+	if slices.Contains(private.Roles, string(authctx.RoleRenter)) && private.RenterID == "" {
+		return errors.New("renter token has no renter ID")
+	}
+	return nil
+}
+
+// roles returns the known roles among names; roles of other applications are ignored
+func roles(names []string) []authctx.Role {
+	var known []authctx.Role
+	for _, name := range names {
+		switch role := authctx.Role(name); role {
+		case authctx.RolePlatformAdmin, authctx.RoleTenantAdmin, authctx.RoleAgent, authctx.RoleRenter:
+			known = append(known, role)
+		}
+	}
+	return known
+}
@@ -0,0 +1,20 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-jose/go-jose/v4"
+)
+
+// ErrUnknownKey is returned by a KeySet that has no key with the requested ID
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeySet provides the public keys that sign JWTs (secondary port).
+// Identity providers publish them as a JWKS.
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_auth
+type KeySet interface {
+	// Key returns the key with ID kid, or ErrUnknownKey
+	Key(ctx context.Context, kid string) (*jose.JSONWebKey, error)
+}
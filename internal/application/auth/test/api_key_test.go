@@ -0,0 +1,163 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/auth"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// setupTest creates a mock repository and an authenticator recording uses in a usage recorder
+func setupTest(t *testing.T) (*mock_repository.MockAPIKeyRepository, *auth.UsageRecorder, *auth.APIKeyAuthenticator) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockAPIKeyRepo := mock_repository.NewMockAPIKeyRepository(ctrl)
+	recorder := auth.NewUsageRecorder(mockAPIKeyRepo, auth.UsageRecorderConfig{BufferSize: 2})
+	return mockAPIKeyRepo, recorder, auth.NewAPIKeyAuthenticator(mockAPIKeyRepo, recorder)
+}
+
+// TestAPIKeyAuthenticator_Authenticate tests that a valid key authenticates its tenant and its use is recorded later
+func TestAPIKeyAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockAPIKeyRepo, recorder, authenticator := setupTest(t)
+	apiKey, key, err := entity.NewAPIKey("tenant-123", "CI", []string{"cars:read"}, null.Time{}, time.Now())
+	require.NoError(t, err)
+
+	// Set up expectations
+	mockAPIKeyRepo.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil)
+
+	// Execute
+	principal, ok, err := authenticator.Authenticate(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "tenant-123", principal.TenantID)
+	assert.Equal(t, apiKey.ID, principal.Subject)
+	assert.Equal(t, []string{"cars:read"}, principal.Scopes)
+
+	// The use is written on the next flush, not during authentication
+	mockAPIKeyRepo.EXPECT().TouchLastUsed(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, lastUsed map[string]time.Time) error {
+			assert.Len(t, lastUsed, 1)
+			assert.WithinDuration(t, time.Now(), lastUsed[apiKey.ID], time.Second)
+			return nil
+		},
+	)
+	require.NoError(t, recorder.Flush(ctx))
+
+	// Nothing is left to flush
+	require.NoError(t, recorder.Flush(ctx))
+}
+
+// TestAPIKeyAuthenticator_Authenticate_Invalid tests that unknown, wrong, revoked and expired keys are rejected
+func TestAPIKeyAuthenticator_Authenticate_Invalid(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := map[string]func(apiKey *entity.APIKey, key string) string{
+		"wrong secret": func(_ *entity.APIKey, key string) string {
+			last := "0"
+			if strings.HasSuffix(key, last) {
+				last = "1"
+			}
+			return key[:len(key)-1] + last
+		},
+		"revoked": func(apiKey *entity.APIKey, key string) string {
+			apiKey.Revoke(now)
+			return key
+		},
+		"expired": func(apiKey *entity.APIKey, key string) string {
+			apiKey.ExpiresAt = null.TimeFrom(now.Add(-time.Minute))
+			return key
+		},
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctx := context.Background()
+			mockAPIKeyRepo, _, authenticator := setupTest(t)
+			apiKey, key, err := entity.NewAPIKey("tenant-123", "CI", nil, null.Time{}, now)
+			require.NoError(t, err)
+			token := modify(apiKey, key)
+
+			// Set up expectations
+			mockAPIKeyRepo.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil)
+
+			// Execute
+			principal, ok, err := authenticator.Authenticate(ctx, token)
+			assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+			assert.True(t, ok)
+			assert.Nil(t, principal)
+		})
+	}
+}
+
+// TestAPIKeyAuthenticator_Authenticate_Unknown tests that a key with an unknown prefix is rejected
+func TestAPIKeyAuthenticator_Authenticate_Unknown(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockAPIKeyRepo, _, authenticator := setupTest(t)
+
+	// Set up expectations
+	mockAPIKeyRepo.EXPECT().GetByPrefix(ctx, "ak_0123456789ab").Return(nil, repository.ErrNotFound)
+
+	// Execute
+	_, ok, err := authenticator.Authenticate(ctx, "ak_0123456789ab_secret")
+	assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+	assert.True(t, ok)
+}
+
+// TestAPIKeyAuthenticator_Authenticate_NotAPIKey tests that other bearer tokens are left to other authenticators
+func TestAPIKeyAuthenticator_Authenticate_NotAPIKey(t *testing.T) {
+	t.Parallel()
+
+	// Setup; the repository must not be queried
+	_, _, authenticator := setupTest(t)
+
+	// Execute
+	principal, ok, err := authenticator.Authenticate(context.Background(), "eyJhbGciOiJSUzI1NiJ9.e30.sig")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, principal)
+}
+
+// TestUsageRecorder_Record tests that uses are coalesced per key and bounded by the buffer size
+func TestUsageRecorder_Record(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockAPIKeyRepo, recorder, _ := setupTest(t)
+	now := time.Now()
+
+	recorder.Record("key-1", now)
+	recorder.Record("key-1", now.Add(-time.Minute)) // older use is ignored
+	recorder.Record("key-2", now.Add(time.Second))
+	recorder.Record("key-3", now) // buffer of 2 is full
+
+	// Set up expectations
+	mockAPIKeyRepo.EXPECT().TouchLastUsed(ctx, map[string]time.Time{
+		"key-1": now,
+		"key-2": now.Add(time.Second),
+	}).Return(nil)
+
+	// Execute
+	require.NoError(t, recorder.Flush(ctx))
+}
@@ -0,0 +1,212 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/auth"
+	mock_auth "github.com/jp-ryuji/go-arch-patterns/internal/application/auth/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+)
+
+const (
+	testIssuer   = "https://id.example.com/"
+	testAudience = "car-rental-api"
+	testKeyID    = "key-1"
+)
+
+// tokenSigner signs JWTs with a new ES256 key and serves its public key from a mock key set
+type tokenSigner struct {
+	signer jose.Signer
+	public *jose.JSONWebKey
+}
+
+// newTokenSigner creates a signer with a new key
+func newTokenSigner(t *testing.T) *tokenSigner {
+	t.Helper()
+
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: private},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader(jose.HeaderKey("kid"), testKeyID),
+	)
+	require.NoError(t, err)
+
+	return &tokenSigner{
+		signer: signer,
+		public: &jose.JSONWebKey{Key: &private.PublicKey, KeyID: testKeyID, Algorithm: string(jose.ES256)},
+	}
+}
+
+// sign returns a JWT with the given claims
+func (s *tokenSigner) sign(t *testing.T, claims ...any) string {
+	t.Helper()
+
+	builder := jwt.Signed(s.signer)
+	for _, c := range claims {
+		builder = builder.Claims(c)
+	}
+	token, err := builder.Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+// validClaims returns registered claims that the authenticator accepts
+func validClaims() jwt.Claims {
+	now := time.Now()
+	return jwt.Claims{
+		Issuer:   testIssuer,
+		Audience: jwt.Audience{testAudience},
+		Subject:  "user-123",
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+}
+
+// setupJWTTest creates a signer and an authenticator trusting its key
+func setupJWTTest(t *testing.T) (*tokenSigner, *mock_auth.MockKeySet, *auth.JWTAuthenticator) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockKeySet := mock_auth.NewMockKeySet(ctrl)
+	authenticator := auth.NewJWTAuthenticator(mockKeySet, auth.JWTConfig{Issuer: testIssuer, Audience: testAudience})
+	return newTokenSigner(t), mockKeySet, authenticator
+}
+
+// TestJWTAuthenticator_Authenticate tests that a valid token authenticates its user with their roles
+func TestJWTAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	signer, mockKeySet, authenticator := setupJWTTest(t)
+	token := signer.sign(t, validClaims(), map[string]any{
+		"tenant_id": "tenant-123",
+		"roles":     []string{"renter", "offline_access"},
+		"renter_id": "renter-123",
+	})
+
+	// Set up expectations
+	mockKeySet.EXPECT().Key(ctx, testKeyID).Return(signer.public, nil)
+
+	// Execute
+	principal, ok, err := authenticator.Authenticate(ctx, token)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, &authctx.Principal{
+		TenantID: "tenant-123",
+		Subject:  "user-123",
+		Roles:    []authctx.Role{authctx.RoleRenter},
+		RenterID: "renter-123",
+	}, principal)
+}
+
+// TestJWTAuthenticator_InvalidClaims tests that tokens with unexpected claims are rejected
+func TestJWTAuthenticator_InvalidClaims(t *testing.T) {
+	t.Parallel()
+
+	tenant := map[string]any{"tenant_id": "tenant-123", "roles": []string{"agent"}}
+
+	tests := map[string]struct {
+		modify  func(c *jwt.Claims)
+		private map[string]any
+	}{
+		"other issuer":   {modify: func(c *jwt.Claims) { c.Issuer = "https://evil.example.com/" }, private: tenant},
+		"other audience": {modify: func(c *jwt.Claims) { c.Audience = jwt.Audience{"other-api"} }, private: tenant},
+		"expired":        {modify: func(c *jwt.Claims) { c.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour)) }, private: tenant},
+		"no expiry":      {modify: func(c *jwt.Claims) { c.Expiry = nil }, private: tenant},
+		"not yet valid":  {modify: func(c *jwt.Claims) { c.NotBefore = jwt.NewNumericDate(time.Now().Add(time.Hour)) }, private: tenant},
+		"no subject":     {modify: func(c *jwt.Claims) { c.Subject = "" }, private: tenant},
+		"no tenant":      {modify: func(*jwt.Claims) {}, private: map[string]any{"roles": []string{"agent"}}},
+		"renter without renter ID": {
+			modify:  func(*jwt.Claims) {},
+			private: map[string]any{"tenant_id": "tenant-123", "roles": []string{"renter"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctx := context.Background()
+			signer, mockKeySet, authenticator := setupJWTTest(t)
+			claims := validClaims()
+			tt.modify(&claims)
+			token := signer.sign(t, claims, tt.private)
+
+			// Set up expectations
+			mockKeySet.EXPECT().Key(ctx, testKeyID).Return(signer.public, nil)
+
+			// Execute
+			_, ok, err := authenticator.Authenticate(ctx, token)
+			assert.True(t, ok)
+			assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+		})
+	}
+}
+
+// TestJWTAuthenticator_WrongKey tests that a token signed by another key is rejected
+func TestJWTAuthenticator_WrongKey(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	signer, mockKeySet, authenticator := setupJWTTest(t)
+	token := newTokenSigner(t).sign(t, validClaims(), map[string]any{"tenant_id": "tenant-123"})
+
+	// Set up expectations
+	mockKeySet.EXPECT().Key(ctx, testKeyID).Return(signer.public, nil)
+
+	// Execute
+	_, ok, err := authenticator.Authenticate(ctx, token)
+	assert.True(t, ok)
+	assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+}
+
+// TestJWTAuthenticator_KeySetErrors tests how key lookup failures are reported
+func TestJWTAuthenticator_KeySetErrors(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	signer, mockKeySet, authenticator := setupJWTTest(t)
+	token := signer.sign(t, validClaims(), map[string]any{"tenant_id": "tenant-123"})
+
+	// Set up expectations
+	gomock.InOrder(
+		mockKeySet.EXPECT().Key(ctx, testKeyID).Return(nil, auth.ErrUnknownKey),
+		mockKeySet.EXPECT().Key(ctx, testKeyID).Return(nil, assert.AnError),
+	)
+
+	// Execute: an unknown key means invalid credentials, an unreachable key set does not
+	_, _, err := authenticator.Authenticate(ctx, token)
+	assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+
+	_, _, err = authenticator.Authenticate(ctx, token)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.NotErrorIs(t, err, auth.ErrInvalidCredentials)
+}
+
+// TestJWTAuthenticator_NotAJWT tests that other bearer tokens are left to other authenticators
+func TestJWTAuthenticator_NotAJWT(t *testing.T) {
+	t.Parallel()
+
+	// Setup; the key set must not be queried
+	_, _, authenticator := setupJWTTest(t)
+
+	// Execute
+	_, ok, err := authenticator.Authenticate(context.Background(), "ak_0123456789ab_secret")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
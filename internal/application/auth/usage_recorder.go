@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// Usage recorder defaults
+const (
+	DefaultUsageFlushInterval = 10 * time.Second
+	DefaultUsageBufferSize    = 1024
+)
+
+// droppedUsages counts key uses that were not recorded because the buffer was full.
+// It is published at /debug/vars.
+var droppedUsages = expvar.NewInt("api_key_usages_dropped")
+
+// UsageRecorderConfig holds the tuning knobs of a UsageRecorder
+type UsageRecorderConfig struct {
+	// FlushInterval is how often recorded uses are written to the database
+	FlushInterval time.Duration
+	// BufferSize is the number of distinct keys remembered between two flushes; uses
+	// of further keys are dropped until the next flush
+	BufferSize int
+}
+
+// UsageRecorder records the last use of API keys in the background, so that
+// authentication never waits for a database write
+type UsageRecorder struct {
+	apiKeyRepo repository.APIKeyRepository
+	cfg        UsageRecorderConfig
+
+	mu      sync.Mutex
+	pending map[string]time.Time
+}
+
+// NewUsageRecorder creates a new usage recorder. Zero values in cfg are replaced with defaults.
+func NewUsageRecorder(apiKeyRepo repository.APIKeyRepository, cfg UsageRecorderConfig) *UsageRecorder {
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = DefaultUsageFlushInterval
+	}
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = DefaultUsageBufferSize
+	}
+
+	return &UsageRecorder{
+		apiKeyRepo: apiKeyRepo,
+		cfg:        cfg,
+		pending:    make(map[string]time.Time),
+	}
+}
+
+// Record remembers that the key was used at usedAt. It never blocks on the database.
+func (r *UsageRecorder) Record(apiKeyID string, usedAt time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last, ok := r.pending[apiKeyID]
+	if !ok && len(r.pending) >= r.cfg.BufferSize {
+		droppedUsages.Add(1)
+		return
+	}
+	if !ok || usedAt.After(last) {
+		r.pending[apiKeyID] = usedAt
+	}
+}
+
+// Run writes recorded uses every FlushInterval until ctx is cancelled, then flushes
+// what is left
+func (r *UsageRecorder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Flush the last uses with a context that is not cancelled yet
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), r.cfg.FlushInterval)
+			if err := r.Flush(flushCtx); err != nil {
+				log.Printf("Failed to record API key usage: %v", err)
+			}
+			cancel()
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.Flush(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to record API key usage: %v", err)
+			}
+		}
+	}
+}
+
+// Flush writes the uses recorded since the last flush
+func (r *UsageRecorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[string]time.Time, len(pending))
+	r.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	return r.apiKeyRepo.TouchLastUsed(ctx, pending)
+}
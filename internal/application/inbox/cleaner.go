@@ -0,0 +1,68 @@
+package inbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// Cleaner defaults
+const (
+	DefaultCleanupInterval = time.Hour
+	DefaultRetention       = 7 * 24 * time.Hour
+)
+
+// CleanerConfig holds the tuning knobs of a Cleaner
+type CleanerConfig struct {
+	// Interval is how often old inbox records are removed
+	Interval time.Duration
+	// Retention is how long processed messages are remembered. A message redelivered
+	// after its record was removed is processed again, so it must exceed the longest
+	// redelivery delay of every transport.
+	Retention time.Duration
+}
+
+// Cleaner periodically removes old inbox records
+type Cleaner struct {
+	inboxRepo repository.InboxRepository
+	cfg       CleanerConfig
+}
+
+// NewCleaner creates a new inbox cleaner. Zero values in cfg are replaced with defaults.
+func NewCleaner(inboxRepo repository.InboxRepository, cfg CleanerConfig) *Cleaner {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultCleanupInterval
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultRetention
+	}
+
+	return &Cleaner{
+		inboxRepo: inboxRepo,
+		cfg:       cfg,
+	}
+}
+
+// Run removes old inbox records every Interval until ctx is cancelled
+func (c *Cleaner) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := c.Cleanup(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to clean up inbox: %v", err)
+			}
+		}
+	}
+}
+
+// Cleanup removes processed messages older than Retention and returns how many were removed
+func (c *Cleaner) Cleanup(ctx context.Context) (int, error) {
+	return c.inboxRepo.CleanupProcessedMessages(ctx, c.cfg.Retention)
+}
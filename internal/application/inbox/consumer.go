@@ -0,0 +1,131 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// Message is an event received from another system
+type Message struct {
+	// ID identifies the message within its source, e.g. the producer's outbox ID
+	ID     string
+	Source string
+	// TenantID is the tenant the message concerns, if any. The message is then recorded and
+	// handled in the scope of the tenant, in its own database when it has one.
+	TenantID  string
+	EventType string
+	Payload   map[string]interface{}
+}
+
+// Handler applies the effects of a message. ctx carries the transaction of the inbox
+// record, so every write made through repositories with ctx is committed together with
+// the record, or not at all.
+type Handler interface {
+	Handle(ctx context.Context, msg *Message) error
+}
+
+// HandlerFunc adapts an ordinary function to the Handler interface
+type HandlerFunc func(ctx context.Context, msg *Message) error
+
+// Handle calls f(ctx, msg)
+func (f HandlerFunc) Handle(ctx context.Context, msg *Message) error {
+	return f(ctx, msg)
+}
+
+// Consumer dispatches incoming messages to the handler registered for their event type
+// and records each message in the inbox in the same transaction as the handler's writes.
+//
+// Transports deliver at least once; recording the message ID under a unique index turns
+// that into exactly-once effects: a redelivered message is skipped, and of two concurrent
+// deliveries only one commits.
+type Consumer struct {
+	inboxRepo repository.InboxRepository
+	txManager repository.TransactionManager
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewConsumer creates a new inbox consumer
+func NewConsumer(inboxRepo repository.InboxRepository, txManager repository.TransactionManager) *Consumer {
+	return &Consumer{
+		inboxRepo: inboxRepo,
+		txManager: txManager,
+		handlers:  make(map[string]Handler),
+	}
+}
+
+// Register sets the handler for an event type. It panics if a handler is already
+// registered for the event type.
+func (c *Consumer) Register(eventType string, handler Handler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.handlers[eventType]; ok {
+		panic(fmt.Sprintf("inbox: handler for event type %q already registered", eventType))
+	}
+	c.handlers[eventType] = handler
+}
+
+// Consume processes a message exactly once. Duplicates and messages without a registered
+// handler are skipped and reported as success, so that the transport acknowledges them.
+// An error means nothing was committed and the message should be redelivered.
+func (c *Consumer) Consume(ctx context.Context, msg *Message) error {
+	c.mu.RLock()
+	handler, ok := c.handlers[msg.EventType]
+	c.mu.RUnlock()
+	if !ok {
+		log.Printf("Inbox has no handler for event type %s; skipping message %s from %s", msg.EventType, msg.ID, msg.Source)
+		return nil
+	}
+
+	if msg.TenantID != "" {
+		ctx = tenantctx.WithTenantID(ctx, msg.TenantID)
+	}
+
+	// Cheap check outside of a transaction; the unique index is the actual guarantee
+	exists, err := c.inboxRepo.Exists(ctx, msg.Source, msg.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check inbox: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	return c.process(ctx, handler, msg)
+}
+
+// errDuplicate rolls back the transaction of a message another consumer already recorded
+var errDuplicate = errors.New("inbox message already processed")
+
+// process records the message and runs its handler in one transaction
+func (c *Consumer) process(ctx context.Context, handler Handler, msg *Message) error {
+	err := c.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		// Record the message first: a concurrent delivery of the same message blocks here
+		// until this transaction finishes, then fails on the unique index
+		record := entity.NewInboxMessage(msg.Source, msg.ID, msg.EventType, msg.Payload, time.Now())
+		if err := c.inboxRepo.Create(ctx, record); err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return errDuplicate
+			}
+			return fmt.Errorf("failed to record inbox message: %w", err)
+		}
+
+		if err := handler.Handle(ctx, msg); err != nil {
+			return fmt.Errorf("failed to handle %s message %s: %w", msg.EventType, msg.ID, err)
+		}
+		return nil
+	})
+	if errors.Is(err, errDuplicate) {
+		return nil
+	}
+	return err
+}
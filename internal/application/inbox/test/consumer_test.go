@@ -0,0 +1,207 @@
+package inbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/inbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupTest creates mocks and an inbox consumer with a handler for "rental_booked"
+func setupTest(t *testing.T, handler inbox.HandlerFunc) (*mock_repository.MockInboxRepository, *mock_repository.MockTransactionManager, *inbox.Consumer) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockInboxRepo := mock_repository.NewMockInboxRepository(ctrl)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	consumer := inbox.NewConsumer(mockInboxRepo, mockTxManager)
+	consumer.Register("rental_booked", handler)
+	return mockInboxRepo, mockTxManager, consumer
+}
+
+// runInTx runs fn as RunInTx would, without a database
+func runInTx(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+	return fn(ctx)
+}
+
+// newMessage creates an incoming message for testing
+func newMessage() *inbox.Message {
+	return &inbox.Message{
+		ID:        "msg-1",
+		Source:    "booking",
+		EventType: "rental_booked",
+		Payload:   map[string]interface{}{"rental_id": "rental-1"},
+	}
+}
+
+// TestConsumer_Consume_Success tests that the message is recorded and handled in one transaction
+func TestConsumer_Consume_Success(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	var handled bool
+	mockInboxRepo, mockTxManager, consumer := setupTest(t, func(ctx context.Context, msg *inbox.Message) error {
+		handled = true
+		return nil
+	})
+
+	// Set up expectations
+	mockInboxRepo.EXPECT().Exists(ctx, "booking", "msg-1").Return(false, nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+	mockInboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, record *entity.InboxMessage) error {
+			assert.Equal(t, "booking", record.Source)
+			assert.Equal(t, "msg-1", record.MessageID)
+			assert.Equal(t, "rental_booked", record.EventType)
+			assert.NotEmpty(t, record.ID)
+			assert.True(t, record.ProcessedAt.Valid)
+			assert.WithinDuration(t, time.Now(), record.ReceivedAt, time.Second)
+			return nil
+		},
+	)
+
+	// Execute
+	err := consumer.Consume(ctx, newMessage())
+	assert.NoError(t, err)
+	assert.True(t, handled)
+}
+
+// TestConsumer_Consume_Tenant tests that a message of a tenant is recorded and handled in
+// the scope of the tenant, so that both land in the tenant's database when it has one
+func TestConsumer_Consume_Tenant(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	tenantCtx := tenantctx.WithTenantID(ctx, "tenant-1")
+	mockInboxRepo, mockTxManager, consumer := setupTest(t, func(ctx context.Context, msg *inbox.Message) error {
+		tenantID, ok := tenantctx.TenantID(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "tenant-1", tenantID)
+		return nil
+	})
+	msg := newMessage()
+	msg.TenantID = "tenant-1"
+
+	// Set up expectations
+	mockInboxRepo.EXPECT().Exists(tenantCtx, "booking", "msg-1").Return(false, nil)
+	mockTxManager.EXPECT().RunInTx(tenantCtx, gomock.Any()).DoAndReturn(runInTx)
+	mockInboxRepo.EXPECT().Create(tenantCtx, gomock.Any()).Return(nil)
+
+	// Execute
+	err := consumer.Consume(ctx, msg)
+	assert.NoError(t, err)
+}
+
+// TestConsumer_Consume_AlreadyProcessed tests that a redelivered message is skipped
+func TestConsumer_Consume_AlreadyProcessed(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockInboxRepo, _, consumer := setupTest(t, func(ctx context.Context, msg *inbox.Message) error {
+		t.Fatal("handler must not be called for a duplicate")
+		return nil
+	})
+
+	// Set up expectations
+	mockInboxRepo.EXPECT().Exists(ctx, "booking", "msg-1").Return(true, nil)
+
+	// Execute
+	err := consumer.Consume(ctx, newMessage())
+	assert.NoError(t, err)
+}
+
+// TestConsumer_Consume_ConcurrentDuplicate tests that losing the race on the unique index skips the handler
+func TestConsumer_Consume_ConcurrentDuplicate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockInboxRepo, mockTxManager, consumer := setupTest(t, func(ctx context.Context, msg *inbox.Message) error {
+		t.Fatal("handler must not be called for a duplicate")
+		return nil
+	})
+
+	// Set up expectations
+	mockInboxRepo.EXPECT().Exists(ctx, "booking", "msg-1").Return(false, nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+	mockInboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(repository.ErrAlreadyExists)
+
+	// Execute
+	err := consumer.Consume(ctx, newMessage())
+	assert.NoError(t, err)
+}
+
+// TestConsumer_Consume_HandlerError tests that a handler failure rolls back the inbox record
+func TestConsumer_Consume_HandlerError(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockInboxRepo, mockTxManager, consumer := setupTest(t, func(ctx context.Context, msg *inbox.Message) error {
+		return assert.AnError
+	})
+
+	// Set up expectations
+	mockInboxRepo.EXPECT().Exists(ctx, "booking", "msg-1").Return(false, nil)
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+	mockInboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	// Execute
+	err := consumer.Consume(ctx, newMessage())
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+// TestConsumer_Consume_NoHandler tests that messages without a registered handler are skipped
+func TestConsumer_Consume_NoHandler(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, _, consumer := setupTest(t, nil)
+	msg := newMessage()
+	msg.EventType = "rental_cancelled"
+
+	// Execute
+	err := consumer.Consume(context.Background(), msg)
+	assert.NoError(t, err)
+}
+
+// TestConsumer_Register_Duplicate tests that registering two handlers for one event type panics
+func TestConsumer_Register_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, _, consumer := setupTest(t, nil)
+
+	// Execute
+	assert.Panics(t, func() {
+		consumer.Register("rental_booked", inbox.HandlerFunc(nil))
+	})
+}
+
+// TestCleaner_Cleanup tests that processed messages older than the retention are removed
+func TestCleaner_Cleanup(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockInboxRepo := mock_repository.NewMockInboxRepository(ctrl)
+	cleaner := inbox.NewCleaner(mockInboxRepo, inbox.CleanerConfig{})
+	ctx := context.Background()
+
+	// Set up expectations
+	mockInboxRepo.EXPECT().CleanupProcessedMessages(ctx, inbox.DefaultRetention).Return(3, nil)
+
+	// Execute
+	n, err := cleaner.Cleanup(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
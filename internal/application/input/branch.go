@@ -0,0 +1,44 @@
+package input
+
+// BranchSpec represents the attributes of a branch; the domain checks that the address and
+// the coordinates are complete
+type BranchSpec struct {
+	Name       string `validate:"max=255"`
+	Street     string `validate:"max=255"`
+	City       string `validate:"max=100"`
+	Region     string `validate:"max=100"`
+	PostalCode string `validate:"max=20"`
+	Country    string
+	Latitude   float64
+	Longitude  float64
+	// OpeningHours are on the tenant's clocks; without any, the business hours of the
+	// tenant apply
+	OpeningHours []OpeningHours `validate:"dive"`
+}
+
+// CreateBranch represents the input data for adding a branch to a tenant
+type CreateBranch struct {
+	TenantID string `validate:"required"`
+	Spec     BranchSpec
+}
+
+// UpdateBranch represents the input data for replacing the attributes of a branch
+type UpdateBranch struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+	Spec     BranchSpec
+}
+
+// ListBranches represents the input data for listing the branches of a tenant
+type ListBranches struct {
+	TenantID string `validate:"required"`
+}
+
+// FindNearestBranches represents the input data for finding the branches of a tenant
+// closest to a position
+type FindNearestBranches struct {
+	TenantID  string `validate:"required"`
+	Latitude  float64
+	Longitude float64
+	Limit     int32
+}
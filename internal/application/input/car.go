@@ -2,7 +2,8 @@ package input
 
 // GetCarByID represents the input data for retrieving a car by ID
 type GetCarByID struct {
-	ID string `validate:"required"`
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
 }
 
 // ListCars represents the input data for listing cars
@@ -14,6 +15,24 @@ type ListCars struct {
 
 // CreateCar represents the input data for creating a car
 type CreateCar struct {
-	TenantID string `validate:"required"`
-	Model    string `validate:"required"`
+	TenantID   string `validate:"required"`
+	CarModelID string `validate:"required"`
+	// VIN and LicensePlate are optional; empty means not recorded
+	VIN          string
+	LicensePlate string
+	// LicensePlateCountry is the ISO 3166-1 alpha-2 code of the country that issued the
+	// plate; required with a license plate
+	LicensePlateCountry string
+	// HomeBranchID is the branch of the tenant the car belongs to and starts at; optional
+	HomeBranchID string
+}
+
+// AssignCarBranches represents the input data for setting the branch a car belongs to and
+// the one it is at
+type AssignCarBranches struct {
+	TenantID     string `validate:"required"`
+	ID           string `validate:"required"`
+	HomeBranchID string `validate:"required"`
+	// CurrentBranchID is empty when the car is at its home branch
+	CurrentBranchID string
 }
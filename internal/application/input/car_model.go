@@ -0,0 +1,30 @@
+package input
+
+// CarModelSpec represents the attributes of a car model; the domain checks that they are set
+// to known values
+type CarModelSpec struct {
+	Make         string `validate:"max=100"`
+	Name         string `validate:"max=255"`
+	Category     string
+	Seats        int
+	Transmission string
+	FuelType     string
+}
+
+// CreateCarModel represents the input data for adding a model to a tenant's catalog
+type CreateCarModel struct {
+	TenantID string `validate:"required"`
+	Spec     CarModelSpec
+}
+
+// UpdateCarModel represents the input data for replacing the attributes of a model
+type UpdateCarModel struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+	Spec     CarModelSpec
+}
+
+// ListCarModels represents the input data for listing a tenant's catalog
+type ListCarModels struct {
+	TenantID string `validate:"required"`
+}
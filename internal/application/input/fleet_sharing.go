@@ -0,0 +1,28 @@
+package input
+
+import "time"
+
+// CreateFleetSharingAgreement represents the input data for letting a tenant book the cars
+// of a sibling tenant
+type CreateFleetSharingAgreement struct {
+	LenderTenantID   string `validate:"required"`
+	BorrowerTenantID string `validate:"required,nefield=LenderTenantID"`
+	// ValidFrom is when the first rental may start; zero means now
+	ValidFrom time.Time
+	// ValidUntil is when the last rental must end; zero means no end
+	ValidUntil time.Time
+	// MaxRentalDays caps the length of rentals; zero means no cap
+	MaxRentalDays int `validate:"gte=0"`
+	// OwnerSharePercent is the part of the revenue that goes to the lender, for reporting
+	OwnerSharePercent int `validate:"gte=0,lte=100"`
+}
+
+// TerminateFleetSharingAgreement represents the input data for ending an agreement
+type TerminateFleetSharingAgreement struct {
+	ID string `validate:"required"`
+}
+
+// ListFleetSharingAgreements represents the input data for listing the agreements of a tenant
+type ListFleetSharingAgreements struct {
+	TenantID string `validate:"required"`
+}
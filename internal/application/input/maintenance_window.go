@@ -0,0 +1,35 @@
+package input
+
+import "time"
+
+// ScheduleMaintenanceWindow represents the input data for taking a car of the tenant out of
+// service over a period
+type ScheduleMaintenanceWindow struct {
+	TenantID string `validate:"required"`
+	CarID    string `validate:"required"`
+	// Type is inspection, service, repair or cleaning
+	Type     string    `validate:"required"`
+	Reason   string    `validate:"max=1000"`
+	StartsAt time.Time `validate:"required"`
+	EndsAt   time.Time `validate:"required,gtfield=StartsAt"`
+}
+
+// CompleteMaintenanceWindow represents the input data for recording that the work of a
+// maintenance window is done
+type CompleteMaintenanceWindow struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+}
+
+// CancelMaintenanceWindow represents the input data for calling off a maintenance window
+type CancelMaintenanceWindow struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+}
+
+// ListMaintenanceWindows represents the input data for listing the maintenance windows of a
+// car of the tenant
+type ListMaintenanceWindows struct {
+	TenantID string `validate:"required"`
+	CarID    string `validate:"required"`
+}
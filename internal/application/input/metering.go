@@ -0,0 +1,21 @@
+package input
+
+import "time"
+
+// GetMeteredUsage represents the input data for retrieving the metered usage of a tenant
+type GetMeteredUsage struct {
+	TenantID string `validate:"required"`
+	// Period is "day" or "month"; empty means "day"
+	Period string `validate:"omitempty,oneof=day month"`
+	// From and To bound the starts of the returned periods; zero values mean the current
+	// UTC month
+	From time.Time
+	To   time.Time
+}
+
+// GetUsageStatement represents the input data for a tenant's monthly usage statement
+type GetUsageStatement struct {
+	TenantID string `validate:"required"`
+	// Month is any time in the UTC month of the statement; zero means the current month
+	Month time.Time
+}
@@ -0,0 +1,77 @@
+package input
+
+import "time"
+
+// SearchAvailableCars represents the input data for finding the cars a tenant can book over
+// a period, its own and the ones shared with it
+type SearchAvailableCars struct {
+	TenantID string `validate:"required"`
+	// BranchID narrows the search down to the cars at a branch; empty searches them all
+	BranchID string
+	StartsAt time.Time `validate:"required"`
+	EndsAt   time.Time `validate:"required,gtfield=StartsAt"`
+	PageSize int32
+}
+
+// BookRental represents the input data for booking a car, owned by the tenant or shared
+// with it, for one of its renters
+type BookRental struct {
+	TenantID string `validate:"required"`
+	CarID    string `validate:"required"`
+	RenterID string `validate:"required"`
+	// PickupBranchID defaults to the branch the car is at, and ReturnBranchID to the pickup
+	// branch
+	PickupBranchID string
+	ReturnBranchID string
+	StartsAt       time.Time `validate:"required"`
+	EndsAt         time.Time `validate:"required,gtfield=StartsAt"`
+}
+
+// HandoverInspection represents what is read off the car of a rental as it is handed over
+type HandoverInspection struct {
+	OdometerKm int
+	// FuelLevel is the fuel or charge level, in percent
+	FuelLevel int
+	Damages   []HandoverDamage
+}
+
+// HandoverDamage represents an entry of the damage checklist of a handover inspection
+type HandoverDamage struct {
+	Area        string
+	Description string
+}
+
+// PickUpRental represents the input data for recording that the car of a rental the tenant
+// booked was picked up, with its inspection
+type PickUpRental struct {
+	TenantID   string `validate:"required"`
+	ID         string `validate:"required"`
+	Inspection HandoverInspection
+}
+
+// ReturnRental represents the input data for recording that the car of a rental the tenant
+// booked was brought back
+type ReturnRental struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+	// Inspection is compared with the inspection at pickup. It is required; nil is rejected
+	// with entity.ErrReturnNotInspected.
+	Inspection *HandoverInspection
+}
+
+// ListRentalHandovers represents the input data for listing the handover inspections of a
+// rental the tenant booked or owns the car of
+type ListRentalHandovers struct {
+	TenantID string `validate:"required"`
+	RentalID string `validate:"required"`
+}
+
+// ListRentals represents the input data for listing the rentals a tenant booked or owns the
+// car of
+type ListRentals struct {
+	TenantID string `validate:"required"`
+	// RenterID narrows the rentals down to the ones of a renter, if set
+	RenterID  string
+	PageSize  int32
+	PageToken string
+}
@@ -0,0 +1,70 @@
+package input
+
+// CreateTenant represents the input data for creating a tenant
+type CreateTenant struct {
+	Code string `validate:"required"`
+	// PlanCode is optional; tenants without a plan are not limited
+	PlanCode string
+	// Isolation is optional and defaults to shared tables; it cannot change later
+	Isolation string `validate:"omitempty,oneof=shared schema database"`
+	// ParentID is optional and makes the tenant a child of a franchise parent; it cannot
+	// change later
+	ParentID string
+}
+
+// GetTenant represents the input data for retrieving a tenant by ID or by code
+type GetTenant struct {
+	ID   string `validate:"required_without=Code,excluded_with=Code"`
+	Code string `validate:"required_without=ID"`
+}
+
+// SuspendTenant represents the input data for suspending a tenant
+type SuspendTenant struct {
+	ID string `validate:"required"`
+}
+
+// ReactivateTenant represents the input data for reactivating a suspended tenant
+type ReactivateTenant struct {
+	ID string `validate:"required"`
+}
+
+// ChangeTenantPlan represents the input data for moving a tenant to another plan
+type ChangeTenantPlan struct {
+	ID       string `validate:"required"`
+	PlanCode string `validate:"required"`
+}
+
+// ScheduleTenantDeletion represents the input data for scheduling a tenant to be purged
+type ScheduleTenantDeletion struct {
+	ID string `validate:"required"`
+}
+
+// CancelTenantDeletion represents the input data for canceling the deletion of a tenant
+type CancelTenantDeletion struct {
+	ID string `validate:"required"`
+}
+
+// GetTenantPurgeReport represents the input data for counting the rows a purge would delete
+type GetTenantPurgeReport struct {
+	ID string `validate:"required"`
+}
+
+// ExportTenant represents the input data for exporting a tenant to an archive
+type ExportTenant struct {
+	TenantID string `validate:"required"`
+}
+
+// ImportTenant represents the input data for importing a tenant from an archive
+type ImportTenant struct {
+	// Archive is the name of an archive in the archive storage
+	Archive string `validate:"required,excludesall=/\\"`
+	// RemapIDs gives every imported row a new ID instead of its archived one
+	RemapIDs bool
+	// Code is optional and replaces the archived code of the tenant
+	Code string
+}
+
+// GetArchiveJob represents the input data for retrieving an export or import job
+type GetArchiveJob struct {
+	ID string `validate:"required"`
+}
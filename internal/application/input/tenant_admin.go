@@ -0,0 +1,36 @@
+package input
+
+import "time"
+
+// CreateAPIKey represents the input data for creating an API key
+type CreateAPIKey struct {
+	TenantID string   `validate:"required"`
+	Name     string   `validate:"required,max=255"`
+	Scopes   []string `validate:"omitempty,dive,required"`
+	// ExpiresAt is when the key stops working; nil keys never expire
+	ExpiresAt *time.Time
+}
+
+// ListAPIKeys represents the input data for listing API keys
+type ListAPIKeys struct {
+	TenantID  string `validate:"required"`
+	PageSize  int32
+	PageToken string
+}
+
+// RotateAPIKey represents the input data for replacing an API key
+type RotateAPIKey struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+}
+
+// RevokeAPIKey represents the input data for revoking an API key
+type RevokeAPIKey struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+}
+
+// GetUsage represents the input data for retrieving a tenant's usage of its plan
+type GetUsage struct {
+	TenantID string `validate:"required"`
+}
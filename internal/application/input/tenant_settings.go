@@ -0,0 +1,26 @@
+package input
+
+import "time"
+
+// GetTenantSettings represents the input data for retrieving the settings of a tenant
+type GetTenantSettings struct {
+	TenantID string `validate:"required"`
+}
+
+// UpdateTenantSettings represents the input data for replacing the settings of a tenant
+type UpdateTenantSettings struct {
+	TenantID string `validate:"required"`
+	Timezone string `validate:"required"`
+	Currency string `validate:"required"`
+	Locale   string `validate:"required"`
+	// BusinessHours replace the current ones; without any, the tenant is always open
+	BusinessHours []OpeningHours `validate:"dive"`
+}
+
+// OpeningHours represents a period during which a tenant or a branch is open, as "HH:MM"
+// times
+type OpeningHours struct {
+	Weekday time.Weekday
+	Opens   string `validate:"required"`
+	Closes  string `validate:"required"`
+}
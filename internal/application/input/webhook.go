@@ -0,0 +1,54 @@
+package input
+
+// CreateWebhookEndpoint represents the input data for registering a webhook endpoint
+type CreateWebhookEndpoint struct {
+	TenantID    string   `validate:"required"`
+	URL         string   `validate:"required,url"`
+	EventTypes  []string `validate:"required,min=1,dive,required"`
+	Description string   `validate:"max=255"`
+}
+
+// GetWebhookEndpoint represents the input data for retrieving a webhook endpoint
+type GetWebhookEndpoint struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+}
+
+// ListWebhookEndpoints represents the input data for listing webhook endpoints
+type ListWebhookEndpoints struct {
+	TenantID  string `validate:"required"`
+	PageSize  int32
+	PageToken string
+}
+
+// UpdateWebhookEndpoint represents the input data for updating a webhook endpoint.
+// Nil fields are left unchanged.
+type UpdateWebhookEndpoint struct {
+	TenantID    string `validate:"required"`
+	ID          string `validate:"required"`
+	URL         *string
+	EventTypes  []string `validate:"omitempty,dive,required"`
+	Description *string  `validate:"omitempty,max=255"`
+	Enabled     *bool
+}
+
+// DeleteWebhookEndpoint represents the input data for deleting a webhook endpoint
+type DeleteWebhookEndpoint struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+}
+
+// RotateWebhookEndpointSecret represents the input data for rotating a signing secret
+type RotateWebhookEndpointSecret struct {
+	TenantID string `validate:"required"`
+	ID       string `validate:"required"`
+}
+
+// ListWebhookDeliveries represents the input data for listing webhook deliveries
+type ListWebhookDeliveries struct {
+	TenantID   string `validate:"required"`
+	EndpointID string
+	Status     string `validate:"omitempty,oneof=pending succeeded failed"`
+	PageSize   int32
+	PageToken  string
+}
@@ -0,0 +1,69 @@
+package metering
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// Cleaner defaults
+const (
+	DefaultCleanupInterval = time.Hour
+	DefaultRetention       = 30 * 24 * time.Hour
+)
+
+// CleanerConfig holds the tuning knobs of a Cleaner
+type CleanerConfig struct {
+	// Interval is how often old usage records are removed
+	Interval time.Duration
+	// Retention is how long usage records are kept. A message relayed again after its
+	// record was removed is counted twice, so it must exceed the longest time a message
+	// can stay pending in the outbox.
+	Retention time.Duration
+}
+
+// Cleaner periodically removes old usage records. Rollups are kept, since they are what
+// tenants are billed on.
+type Cleaner struct {
+	meteringRepo repository.MeteringRepository
+	cfg          CleanerConfig
+}
+
+// NewCleaner creates a new usage record cleaner. Zero values in cfg are replaced with defaults.
+func NewCleaner(meteringRepo repository.MeteringRepository, cfg CleanerConfig) *Cleaner {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultCleanupInterval
+	}
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultRetention
+	}
+
+	return &Cleaner{
+		meteringRepo: meteringRepo,
+		cfg:          cfg,
+	}
+}
+
+// Run removes old usage records every Interval until ctx is cancelled
+func (c *Cleaner) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := c.Cleanup(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to clean up usage records: %v", err)
+			}
+		}
+	}
+}
+
+// Cleanup removes usage records older than Retention and returns how many were removed
+func (c *Cleaner) Cleanup(ctx context.Context) (int, error) {
+	return c.meteringRepo.CleanupRecords(ctx, c.cfg.Retention)
+}
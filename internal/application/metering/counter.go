@@ -0,0 +1,109 @@
+package metering
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// DefaultCallFlushInterval is how often counted API calls are reported by default
+const DefaultCallFlushInterval = time.Minute
+
+// CallCounterConfig holds the tuning knobs of a CallCounter
+type CallCounterConfig struct {
+	// FlushInterval is how often the counted calls are written to the outbox. Calls
+	// counted since the last flush are lost if the server stops abruptly.
+	FlushInterval time.Duration
+}
+
+// CallCounter counts the API calls of each tenant in memory and reports them to the
+// outbox as api_calls_counted events, so that calls never wait for a database write
+type CallCounter struct {
+	uowFactory repository.UnitOfWorkFactory
+	cfg        CallCounterConfig
+
+	mu    sync.Mutex
+	calls map[string]int64
+	since time.Time
+}
+
+// NewCallCounter creates a new call counter. Zero values in cfg are replaced with defaults.
+func NewCallCounter(uowFactory repository.UnitOfWorkFactory, cfg CallCounterConfig) *CallCounter {
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = DefaultCallFlushInterval
+	}
+
+	return &CallCounter{
+		uowFactory: uowFactory,
+		cfg:        cfg,
+		calls:      make(map[string]int64),
+		since:      time.Now(),
+	}
+}
+
+// Count counts one call made on behalf of the tenant. It never blocks on the database.
+func (c *CallCounter) Count(tenantID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls[tenantID]++
+}
+
+// Run reports the counted calls every FlushInterval until ctx is cancelled, then reports
+// what is left
+func (c *CallCounter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Report the last calls with a context that is not cancelled yet
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.cfg.FlushInterval)
+			if err := c.Flush(flushCtx); err != nil {
+				log.Printf("Failed to report API calls: %v", err)
+			}
+			cancel()
+			return ctx.Err()
+		case <-ticker.C:
+			if err := c.Flush(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to report API calls: %v", err)
+			}
+		}
+	}
+}
+
+// Flush reports the calls counted since the last flush, one event per tenant in a single
+// transaction. Calls that fail to be reported are kept for the next flush.
+func (c *CallCounter) Flush(ctx context.Context) error {
+	now := time.Now()
+	c.mu.Lock()
+	calls, since := c.calls, c.since
+	c.calls = make(map[string]int64, len(calls))
+	c.since = now
+	c.mu.Unlock()
+
+	if len(calls) == 0 {
+		return nil
+	}
+
+	uow := c.uowFactory.New()
+	for tenantID, n := range calls {
+		uow.RegisterNew(entity.NewAPICallsReport(tenantID, n, since, now))
+	}
+	if err := uow.Commit(ctx); err != nil {
+		c.mu.Lock()
+		for tenantID, n := range calls {
+			c.calls[tenantID] += n
+		}
+		c.since = since
+		c.mu.Unlock()
+		return fmt.Errorf("failed to write API calls to the outbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Package metering meters the billable usage of tenants from the outbox stream.
+package metering
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// Recorder adds the billable usage carried by outbox messages to the daily and monthly
+// rollups of their tenants. It implements outbox.Publisher so that it can be plugged into
+// the outbox relay.
+type Recorder struct {
+	txManager    repository.TransactionManager
+	meteringRepo repository.MeteringRepository
+}
+
+// NewRecorder creates a new usage recorder
+func NewRecorder(txManager repository.TransactionManager, meteringRepo repository.MeteringRepository) *Recorder {
+	return &Recorder{
+		txManager:    txManager,
+		meteringRepo: meteringRepo,
+	}
+}
+
+// Publish records the usage carried by msg, if any. The outbox message ID is recorded with
+// the usage, so publishing the same message twice does not count it twice.
+func (r *Recorder) Publish(ctx context.Context, msg *entity.OutboxMessage) error {
+	record, err := usageOf(msg)
+	if err != nil {
+		return fmt.Errorf("failed to read usage of %s message %s: %w", msg.EventType, msg.ID, err)
+	}
+	if record == nil {
+		return nil
+	}
+
+	return r.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := r.meteringRepo.Record(ctx, record); err != nil {
+			return fmt.Errorf("failed to record usage: %w", err)
+		}
+		return nil
+	})
+}
+
+// usageOf returns the usage carried by a message, or nil for messages that carry none.
+// Usage counts towards the UTC day and month the message was created in.
+func usageOf(msg *entity.OutboxMessage) (*entity.UsageRecord, error) {
+	// Usage that belongs to no tenant is billed to nobody
+	if msg.TenantID == "" {
+		return nil, nil
+	}
+
+	record := &entity.UsageRecord{
+		ID:         msg.ID,
+		TenantID:   msg.TenantID,
+		OccurredAt: msg.CreatedAt,
+	}
+	switch msg.EventType {
+	case entity.RentalCreated{}.EventType():
+		record.Meter = entity.MeterRentalsCreated
+		record.Quantity = 1
+	case entity.APICallsCounted{}.EventType():
+		var event entity.APICallsCounted
+		if err := decodePayload(msg.Payload, &event); err != nil {
+			return nil, err
+		}
+		record.Meter = entity.MeterAPICalls
+		record.Quantity = event.Calls
+	case entity.ActiveCarsSampled{}.EventType():
+		var event entity.ActiveCarsSampled
+		if err := decodePayload(msg.Payload, &event); err != nil {
+			return nil, err
+		}
+		record.Meter = entity.MeterActiveCars
+		record.Quantity = event.Cars
+	default:
+		return nil, nil
+	}
+	return record, nil
+}
+
+// decodePayload decodes the payload of an outbox message into its event
+func decodePayload(payload map[string]interface{}, event entity.DomainEvent) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, event)
+}
@@ -0,0 +1,116 @@
+package metering
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// Sampler defaults
+const (
+	DefaultSampleInterval = time.Hour
+	DefaultSamplePageSize = 100
+)
+
+// SamplerConfig holds the tuning knobs of a Sampler
+type SamplerConfig struct {
+	// Interval is how often the fleets of tenants are sampled. The active cars of a day
+	// are the most sampled that day, so cars added and removed between two samples are
+	// not billed.
+	Interval time.Duration
+}
+
+// Sampler periodically counts the cars of every tenant and reports them to the outbox as
+// active_cars_sampled events. Tenants pending deletion are skipped, so that their grace
+// period is not billed.
+type Sampler struct {
+	tenantRepo repository.TenantRepository
+	usageRepo  repository.UsageRepository
+	uowFactory repository.UnitOfWorkFactory
+	cfg        SamplerConfig
+}
+
+// NewSampler creates a new sampler. Zero values in cfg are replaced with defaults.
+func NewSampler(
+	tenantRepo repository.TenantRepository,
+	usageRepo repository.UsageRepository,
+	uowFactory repository.UnitOfWorkFactory,
+	cfg SamplerConfig,
+) *Sampler {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultSampleInterval
+	}
+
+	return &Sampler{
+		tenantRepo: tenantRepo,
+		usageRepo:  usageRepo,
+		uowFactory: uowFactory,
+		cfg:        cfg,
+	}
+}
+
+// Run samples every Interval until ctx is cancelled
+func (s *Sampler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := s.Sample(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to sample active cars: %v", err)
+			}
+		}
+	}
+}
+
+// Sample reports the cars of every tenant and returns how many tenants were sampled. A
+// tenant that fails is logged and sampled again on the next run.
+func (s *Sampler) Sample(ctx context.Context) (int, error) {
+	sampled := 0
+	afterID := ""
+	for {
+		tenants, err := s.tenantRepo.List(ctx, afterID, DefaultSamplePageSize)
+		if err != nil {
+			return sampled, fmt.Errorf("failed to list tenants: %w", err)
+		}
+
+		for _, tenant := range tenants {
+			if tenant.PendingDeletion() {
+				continue
+			}
+			if err := s.sampleTenant(ctx, tenant.ID); err != nil {
+				if ctx.Err() != nil {
+					return sampled, ctx.Err()
+				}
+				log.Printf("Failed to sample active cars of tenant %s: %v", tenant.Code, err)
+				continue
+			}
+			sampled++
+		}
+
+		if len(tenants) < DefaultSamplePageSize {
+			return sampled, nil
+		}
+		afterID = tenants[len(tenants)-1].ID
+	}
+}
+
+// sampleTenant reports the cars of a tenant
+func (s *Sampler) sampleTenant(ctx context.Context, tenantID string) error {
+	cars, err := s.usageRepo.Count(tenantctx.WithTenantID(ctx, tenantID), tenantID, entity.ResourceCars, time.Time{})
+	if err != nil {
+		return fmt.Errorf("failed to count cars: %w", err)
+	}
+
+	uow := s.uowFactory.New()
+	uow.RegisterNew(entity.NewActiveCarsReport(tenantID, int64(cars), time.Now()))
+	return uow.Commit(ctx)
+}
@@ -0,0 +1,199 @@
+package metering_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/metering"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// inlineTxManager returns a transaction manager mock whose transactions run inline
+func inlineTxManager(ctrl *gomock.Controller) *mock_repository.MockTransactionManager {
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().RunInTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+	return mockTxManager
+}
+
+// TestRecorder_Publish tests which messages are metered and how
+func TestRecorder_Publish(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		msg  *entity.OutboxMessage
+		want *entity.UsageRecord
+	}{
+		"rental created": {
+			msg: &entity.OutboxMessage{
+				ID: "msg-1", TenantID: "tenant-1", EventType: "rental_created", CreatedAt: createdAt,
+				Payload: map[string]interface{}{"id": "rental-1"},
+			},
+			want: &entity.UsageRecord{ID: "msg-1", TenantID: "tenant-1", Meter: entity.MeterRentalsCreated, Quantity: 1, OccurredAt: createdAt},
+		},
+		"API calls counted": {
+			msg: &entity.OutboxMessage{
+				ID: "msg-2", TenantID: "tenant-1", EventType: "api_calls_counted", CreatedAt: createdAt,
+				Payload: map[string]interface{}{"tenant_id": "tenant-1", "calls": float64(42)},
+			},
+			want: &entity.UsageRecord{ID: "msg-2", TenantID: "tenant-1", Meter: entity.MeterAPICalls, Quantity: 42, OccurredAt: createdAt},
+		},
+		"active cars sampled": {
+			msg: &entity.OutboxMessage{
+				ID: "msg-3", TenantID: "tenant-1", EventType: "active_cars_sampled", CreatedAt: createdAt,
+				Payload: map[string]interface{}{"tenant_id": "tenant-1", "cars": float64(7)},
+			},
+			want: &entity.UsageRecord{ID: "msg-3", TenantID: "tenant-1", Meter: entity.MeterActiveCars, Quantity: 7, OccurredAt: createdAt},
+		},
+		"event without usage": {
+			msg: &entity.OutboxMessage{ID: "msg-4", TenantID: "tenant-1", EventType: "car_created"},
+		},
+		"message without tenant": {
+			msg: &entity.OutboxMessage{ID: "msg-5", EventType: "rental_created"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctrl := gomock.NewController(t)
+			mockMeteringRepo := mock_repository.NewMockMeteringRepository(ctrl)
+			recorder := metering.NewRecorder(inlineTxManager(ctrl), mockMeteringRepo)
+
+			// Set up expectations
+			if tt.want != nil {
+				mockMeteringRepo.EXPECT().Record(gomock.Any(), tt.want).Return(true, nil)
+			}
+
+			// Execute
+			err := recorder.Publish(context.Background(), tt.msg)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestRecorder_Publish_Error tests that failed records are retried by the relay
+func TestRecorder_Publish_Error(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockMeteringRepo := mock_repository.NewMockMeteringRepository(ctrl)
+	recorder := metering.NewRecorder(inlineTxManager(ctrl), mockMeteringRepo)
+
+	// Set up expectations
+	mockMeteringRepo.EXPECT().Record(gomock.Any(), gomock.Any()).Return(false, errors.New("db down"))
+
+	// Execute
+	err := recorder.Publish(context.Background(), &entity.OutboxMessage{ID: "msg-1", TenantID: "tenant-1", EventType: "rental_created"})
+	assert.Error(t, err)
+}
+
+// TestCallCounter_Flush tests that counted calls are reported once per tenant, and kept
+// when they cannot be
+func TestCallCounter_Flush(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockUowFactory := mock_repository.NewMockUnitOfWorkFactory(ctrl)
+	counter := metering.NewCallCounter(mockUowFactory, metering.CallCounterConfig{})
+	counter.Count("tenant-1")
+	counter.Count("tenant-1")
+	counter.Count("tenant-2")
+
+	// Set up expectations: the first flush fails, the second reports everything
+	calls := make(map[string]int64)
+	failingUow := mock_repository.NewMockUnitOfWork(ctrl)
+	failingUow.EXPECT().RegisterNew(gomock.Any()).Times(2)
+	failingUow.EXPECT().Commit(ctx).Return(errors.New("db down"))
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUow.EXPECT().RegisterNew(gomock.Any()).Do(func(aggregate entity.Aggregate) {
+		report, ok := aggregate.(*entity.UsageReport)
+		require.True(t, ok)
+		require.Len(t, report.Events(), 1)
+		event, ok := report.Events()[0].(entity.APICallsCounted)
+		require.True(t, ok)
+		assert.Equal(t, report.TenantID, event.TenantID)
+		calls[event.TenantID] = event.Calls
+	}).Times(2)
+	mockUow.EXPECT().Commit(ctx).Return(nil)
+	gomock.InOrder(
+		mockUowFactory.EXPECT().New().Return(failingUow),
+		mockUowFactory.EXPECT().New().Return(mockUow),
+	)
+
+	// Execute
+	require.Error(t, counter.Flush(ctx))
+	counter.Count("tenant-2")
+	require.NoError(t, counter.Flush(ctx))
+	assert.Equal(t, map[string]int64{"tenant-1": 2, "tenant-2": 2}, calls)
+
+	// Nothing is left to report
+	require.NoError(t, counter.Flush(ctx))
+}
+
+// TestSampler_Sample tests that the cars of every tenant but those pending deletion are
+// reported
+func TestSampler_Sample(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	mockUsageRepo := mock_repository.NewMockUsageRepository(ctrl)
+	mockUowFactory := mock_repository.NewMockUnitOfWorkFactory(ctrl)
+	sampler := metering.NewSampler(mockTenantRepo, mockUsageRepo, mockUowFactory, metering.SamplerConfig{})
+
+	active := entity.NewTenant("acme", time.Now()).WithID("tenant-1")
+	leaving := entity.NewTenant("gone", time.Now()).WithID("tenant-2")
+	require.NoError(t, leaving.ScheduleDeletion(time.Hour, time.Now()))
+	failing := entity.NewTenant("flaky", time.Now()).WithID("tenant-3")
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().List(ctx, "", metering.DefaultSamplePageSize).Return(entity.Tenants{active, leaving, failing}, nil)
+	mockUsageRepo.EXPECT().Count(gomock.Any(), "tenant-1", entity.ResourceCars, time.Time{}).DoAndReturn(
+		func(ctx context.Context, tenantID string, _ entity.Resource, _ time.Time) (int, error) {
+			// Cars are counted where the tenant keeps them
+			scoped, ok := tenantctx.TenantID(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, tenantID, scoped)
+			return 5, nil
+		},
+	)
+	mockUsageRepo.EXPECT().Count(gomock.Any(), "tenant-3", entity.ResourceCars, time.Time{}).Return(0, errors.New("db down"))
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterNew(gomock.Any()).Do(func(aggregate entity.Aggregate) {
+		report, ok := aggregate.(*entity.UsageReport)
+		require.True(t, ok)
+		require.Len(t, report.Events(), 1)
+		event, ok := report.Events()[0].(entity.ActiveCarsSampled)
+		require.True(t, ok)
+		assert.Equal(t, "tenant-1", event.TenantID)
+		assert.Equal(t, int64(5), event.Cars)
+	})
+	mockUow.EXPECT().Commit(ctx).Return(nil)
+
+	// Execute
+	sampled, err := sampler.Sample(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, sampled)
+}
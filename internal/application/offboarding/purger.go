@@ -0,0 +1,164 @@
+// Package offboarding purges the data of tenants whose deletion is due.
+package offboarding
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// Purger defaults
+const (
+	DefaultPurgeInterval  = time.Hour
+	DefaultPurgeBatchSize = 1000
+	DefaultPurgeTenants   = 10
+)
+
+// PurgerConfig holds the tuning knobs of a Purger
+type PurgerConfig struct {
+	// Interval is how often tenants due for purging are looked for
+	Interval time.Duration
+	// BatchSize is how many rows are deleted per statement and transaction, so that a
+	// purge never holds many locks or long transactions
+	BatchSize int
+	// Tenants is how many tenants are purged per run at most
+	Tenants int
+}
+
+// Purger periodically purges the data of the tenants whose grace period is over: it
+// deletes their rows table by table in batches, then deletes each tenant with a
+// tenant_purged event
+type Purger struct {
+	tenantRepo repository.TenantRepository
+	dataRepo   repository.TenantDataRepository
+	txManager  repository.TransactionManager
+	uowFactory repository.UnitOfWorkFactory
+	cfg        PurgerConfig
+}
+
+// NewPurger creates a new purger. Zero values in cfg are replaced with defaults.
+func NewPurger(
+	tenantRepo repository.TenantRepository,
+	dataRepo repository.TenantDataRepository,
+	txManager repository.TransactionManager,
+	uowFactory repository.UnitOfWorkFactory,
+	cfg PurgerConfig,
+) *Purger {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultPurgeInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultPurgeBatchSize
+	}
+	if cfg.Tenants <= 0 {
+		cfg.Tenants = DefaultPurgeTenants
+	}
+
+	return &Purger{
+		tenantRepo: tenantRepo,
+		dataRepo:   dataRepo,
+		txManager:  txManager,
+		uowFactory: uowFactory,
+		cfg:        cfg,
+	}
+}
+
+// Run purges the tenants due every Interval until ctx is cancelled
+func (p *Purger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := p.PurgeDue(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to purge tenants: %v", err)
+			}
+		}
+	}
+}
+
+// PurgeDue purges up to Tenants tenants whose grace period is over and returns how many
+// were purged. A tenant that fails is logged and retried on the next run.
+func (p *Purger) PurgeDue(ctx context.Context) (int, error) {
+	tenants, err := p.tenantRepo.ListDueForPurge(ctx, time.Now(), p.cfg.Tenants)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tenants due for purging: %w", err)
+	}
+
+	purged := 0
+	for _, tenant := range tenants {
+		rows, err := p.Purge(ctx, tenant.ID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return purged, ctx.Err()
+			}
+			log.Printf("Failed to purge tenant %s: %v", tenant.Code, err)
+			continue
+		}
+		log.Printf("Purged tenant %s: %d rows", tenant.Code, rows.Total())
+		purged++
+	}
+	return purged, nil
+}
+
+// Purge deletes the data of a tenant whose grace period is over, then the tenant itself
+// with a tenant_purged event, and returns the rows deleted per table. It can resume a purge
+// that was interrupted; the rows deleted before are not counted again.
+func (p *Purger) Purge(ctx context.Context, tenantID string) (entity.TenantRows, error) {
+	tenant, err := p.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tenant.CheckPurgeable(time.Now()); err != nil {
+		return nil, err
+	}
+
+	// Delete the rows table by table, each table emptied before the tables it references
+	scoped := tenantctx.WithTenantID(ctx, tenantID)
+	tables, err := p.dataRepo.Count(scoped, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	deleted := make(entity.TenantRows, len(tables))
+	for i, table := range tables {
+		deleted[i] = entity.TableRows{Table: table.Table}
+		for {
+			n, err := p.dataRepo.DeleteBatch(scoped, tenantID, table.Table, p.cfg.BatchSize)
+			if err != nil {
+				return nil, err
+			}
+			deleted[i].Rows += n
+			if n < p.cfg.BatchSize {
+				break
+			}
+		}
+	}
+
+	// Delete the tenant with its event. The tenant is locked and checked again, although its
+	// deletion cannot be canceled once the grace period is over.
+	err = p.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		tenant, err := p.tenantRepo.GetByIDForUpdate(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+		if err := tenant.Purge(deleted, time.Now()); err != nil {
+			return err
+		}
+
+		uow := p.uowFactory.New()
+		uow.RegisterDeleted(tenant)
+		return uow.Commit(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete tenant: %w", err)
+	}
+	return deleted, nil
+}
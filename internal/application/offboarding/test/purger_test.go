@@ -0,0 +1,139 @@
+package offboarding_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/offboarding"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// purgerMocks holds the mocks behind a purger
+type purgerMocks struct {
+	ctrl       *gomock.Controller
+	tenantRepo *mock_repository.MockTenantRepository
+	dataRepo   *mock_repository.MockTenantDataRepository
+	uowFactory *mock_repository.MockUnitOfWorkFactory
+}
+
+// setupTest creates mocks and a purger deleting two rows per batch, whose transactions run inline
+func setupTest(t *testing.T) (purgerMocks, *offboarding.Purger) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mocks := purgerMocks{
+		ctrl:       ctrl,
+		tenantRepo: mock_repository.NewMockTenantRepository(ctrl),
+		dataRepo:   mock_repository.NewMockTenantDataRepository(ctrl),
+		uowFactory: mock_repository.NewMockUnitOfWorkFactory(ctrl),
+	}
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().RunInTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+	return mocks, offboarding.NewPurger(mocks.tenantRepo, mocks.dataRepo, mockTxManager, mocks.uowFactory, offboarding.PurgerConfig{
+		BatchSize: 2,
+	})
+}
+
+// newDueTenant creates a tenant whose grace period is over
+func newDueTenant(t *testing.T, code string) *entity.Tenant {
+	t.Helper()
+	tenant := entity.NewTenant(code, time.Now())
+	require.NoError(t, tenant.ScheduleDeletion(time.Hour, time.Now().Add(-2*time.Hour)))
+	tenant.ClearEvents()
+	return tenant
+}
+
+// TestPurger_Purge tests that the rows are deleted table by table in batches before the
+// tenant is deleted with its event
+func TestPurger_Purge(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mocks, purger := setupTest(t)
+	tenant := newDueTenant(t, "acme")
+
+	// Set up expectations
+	mocks.tenantRepo.EXPECT().GetByID(ctx, tenant.ID).Return(tenant, nil)
+	mocks.dataRepo.EXPECT().Count(gomock.Any(), tenant.ID).Return(entity.TenantRows{
+		{Table: "rentals", Rows: 3},
+		{Table: "cars", Rows: 0},
+	}, nil)
+	gomock.InOrder(
+		mocks.dataRepo.EXPECT().DeleteBatch(gomock.Any(), tenant.ID, "rentals", 2).Return(2, nil),
+		mocks.dataRepo.EXPECT().DeleteBatch(gomock.Any(), tenant.ID, "rentals", 2).Return(1, nil),
+		mocks.dataRepo.EXPECT().DeleteBatch(gomock.Any(), tenant.ID, "cars", 2).Return(0, nil),
+	)
+	mocks.tenantRepo.EXPECT().GetByIDForUpdate(gomock.Any(), tenant.ID).Return(tenant, nil)
+	mockUow := mock_repository.NewMockUnitOfWork(mocks.ctrl)
+	mocks.uowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterDeleted(tenant).Do(func(entity.Aggregate) {
+		require.Len(t, tenant.Events(), 1)
+		purged, ok := tenant.Events()[0].(entity.TenantPurged)
+		require.True(t, ok)
+		assert.Equal(t, map[string]int{"rentals": 3, "cars": 0}, purged.Rows)
+	})
+	mockUow.EXPECT().Commit(gomock.Any()).Return(nil)
+
+	// Execute
+	rows, err := purger.Purge(ctx, tenant.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.TenantRows{{Table: "rentals", Rows: 3}, {Table: "cars", Rows: 0}}, rows)
+}
+
+// TestPurger_Purge_NotDue tests that nothing is deleted during the grace period
+func TestPurger_Purge_NotDue(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mocks, purger := setupTest(t)
+	tenant := entity.NewTenant("acme", time.Now())
+	require.NoError(t, tenant.ScheduleDeletion(time.Hour, time.Now()))
+
+	// Set up expectations
+	mocks.tenantRepo.EXPECT().GetByID(ctx, tenant.ID).Return(tenant, nil)
+
+	// Execute
+	_, err := purger.Purge(ctx, tenant.ID)
+	assert.ErrorIs(t, err, entity.ErrTenantGracePeriodRunning)
+}
+
+// TestPurger_PurgeDue tests that a failing tenant does not stop the others from being purged
+func TestPurger_PurgeDue(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mocks, purger := setupTest(t)
+	failing := newDueTenant(t, "acme")
+	purged := newDueTenant(t, "globex")
+
+	// Set up expectations
+	mocks.tenantRepo.EXPECT().ListDueForPurge(ctx, gomock.Any(), offboarding.DefaultPurgeTenants).
+		Return(entity.Tenants{failing, purged}, nil)
+	mocks.tenantRepo.EXPECT().GetByID(ctx, failing.ID).Return(failing, nil)
+	mocks.dataRepo.EXPECT().Count(gomock.Any(), failing.ID).Return(nil, assert.AnError)
+	mocks.tenantRepo.EXPECT().GetByID(ctx, purged.ID).Return(purged, nil)
+	mocks.dataRepo.EXPECT().Count(gomock.Any(), purged.ID).Return(entity.TenantRows{}, nil)
+	mocks.tenantRepo.EXPECT().GetByIDForUpdate(gomock.Any(), purged.ID).Return(purged, nil)
+	mockUow := mock_repository.NewMockUnitOfWork(mocks.ctrl)
+	mocks.uowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterDeleted(purged)
+	mockUow.EXPECT().Commit(gomock.Any()).Return(nil)
+
+	// Execute
+	n, err := purger.PurgeDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: relay_group.go
+//
+// Generated by this command:
+//
+//	mockgen -source=relay_group.go -destination=mock/relay_group.go -package=mock_outbox
+//
+
+// Package mock_outbox is a generated GoMock package.
+package mock_outbox
+
+import (
+	context "context"
+	reflect "reflect"
+
+	outbox "github.com/jp-ryuji/go-arch-patterns/internal/application/outbox"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListener is a mock of Listener interface.
+type MockListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockListenerMockRecorder
+	isgomock struct{}
+}
+
+// MockListenerMockRecorder is the mock recorder for MockListener.
+type MockListenerMockRecorder struct {
+	mock *MockListener
+}
+
+// NewMockListener creates a new mock instance.
+func NewMockListener(ctrl *gomock.Controller) *MockListener {
+	mock := &MockListener{ctrl: ctrl}
+	mock.recorder = &MockListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListener) EXPECT() *MockListenerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockListener) Run(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockListenerMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockListener)(nil).Run), ctx)
+}
+
+// Wakeups mocks base method.
+func (m *MockListener) Wakeups() <-chan struct{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wakeups")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// Wakeups indicates an expected call of Wakeups.
+func (mr *MockListenerMockRecorder) Wakeups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wakeups", reflect.TypeOf((*MockListener)(nil).Wakeups))
+}
+
+// MockSourceFinder is a mock of SourceFinder interface.
+type MockSourceFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockSourceFinderMockRecorder
+	isgomock struct{}
+}
+
+// MockSourceFinderMockRecorder is the mock recorder for MockSourceFinder.
+type MockSourceFinderMockRecorder struct {
+	mock *MockSourceFinder
+}
+
+// NewMockSourceFinder creates a new mock instance.
+func NewMockSourceFinder(ctrl *gomock.Controller) *MockSourceFinder {
+	mock := &MockSourceFinder{ctrl: ctrl}
+	mock.recorder = &MockSourceFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSourceFinder) EXPECT() *MockSourceFinderMockRecorder {
+	return m.recorder
+}
+
+// Sources mocks base method.
+func (m *MockSourceFinder) Sources(ctx context.Context) ([]outbox.Source, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sources", ctx)
+	ret0, _ := ret[0].([]outbox.Source)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sources indicates an expected call of Sources.
+func (mr *MockSourceFinderMockRecorder) Sources(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sources", reflect.TypeOf((*MockSourceFinder)(nil).Sources), ctx)
+}
@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// Publisher delivers outbox messages to an external system (secondary port)
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_outbox
+type Publisher interface {
+	Publish(ctx context.Context, msg *entity.OutboxMessage) error
+}
+
+// PublisherFunc adapts an ordinary function to the Publisher interface
+type PublisherFunc func(ctx context.Context, msg *entity.OutboxMessage) error
+
+// Publish calls f(ctx, msg)
+func (f PublisherFunc) Publish(ctx context.Context, msg *entity.OutboxMessage) error {
+	return f(ctx, msg)
+}
+
+// Notifier signals that new outbox messages may be available (secondary port)
+type Notifier interface {
+	Wakeups() <-chan struct{}
+}
+
+// FanoutPublisher publishes every message to each of its publishers in order.
+// It stops at the first error so that the relay retries the whole message with
+// backoff; publishers must therefore be idempotent per outbox message ID.
+type FanoutPublisher []Publisher
+
+// Publish publishes msg to every publisher
+func (f FanoutPublisher) Publish(ctx context.Context, msg *entity.OutboxMessage) error {
+	for _, p := range f {
+		if err := p.Publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/id"
+)
+
+// Relay defaults
+const (
+	DefaultBatchSize    = 100
+	DefaultPollInterval = 30 * time.Second
+	DefaultLockTimeout  = 5 * time.Minute
+)
+
+// RelayConfig holds the tuning knobs of a Relay
+type RelayConfig struct {
+	// BatchSize is the maximum number of messages claimed per round trip
+	BatchSize int
+	// PollInterval is the slow fallback used when no notification arrives
+	PollInterval time.Duration
+	// LockTimeout is how long a claimed message may stay locked before it is
+	// considered orphaned and released for another relay to pick up
+	LockTimeout time.Duration
+}
+
+// Relay moves pending outbox messages to a Publisher.
+//
+// It is woken up immediately by a Notifier (LISTEN/NOTIFY) and falls back to
+// polling at PollInterval, so a lost notification only delays delivery instead
+// of losing it.
+type Relay struct {
+	outboxRepo  repository.OutboxRepository
+	publisher   Publisher
+	notifier    Notifier
+	cfg         RelayConfig
+	processorID string
+}
+
+// NewRelay creates a new outbox relay. Zero values in cfg are replaced with defaults.
+func NewRelay(outboxRepo repository.OutboxRepository, publisher Publisher, notifier Notifier, cfg RelayConfig) *Relay {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.LockTimeout <= 0 {
+		cfg.LockTimeout = DefaultLockTimeout
+	}
+
+	return &Relay{
+		outboxRepo:  outboxRepo,
+		publisher:   publisher,
+		notifier:    notifier,
+		cfg:         cfg,
+		processorID: "relay-" + id.New(),
+	}
+}
+
+// Run relays messages until ctx is cancelled
+func (r *Relay) Run(ctx context.Context) error {
+	poll := time.NewTicker(r.cfg.PollInterval)
+	defer poll.Stop()
+
+	unlock := time.NewTicker(r.cfg.LockTimeout)
+	defer unlock.Stop()
+
+	var wakeups <-chan struct{}
+	if r.notifier != nil {
+		wakeups = r.notifier.Wakeups()
+	}
+
+	for {
+		if err := r.Drain(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Outbox relay %s failed to drain: %v", r.processorID, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wakeups:
+		case <-poll.C:
+		case <-unlock.C:
+			if n, err := r.outboxRepo.UnlockOrphanedMessages(ctx, r.cfg.LockTimeout); err != nil {
+				log.Printf("Outbox relay %s failed to unlock orphaned messages: %v", r.processorID, err)
+			} else if n > 0 {
+				log.Printf("Outbox relay %s unlocked %d orphaned messages", r.processorID, n)
+			}
+		}
+	}
+}
+
+// Drain processes batches until no pending messages are left
+func (r *Relay) Drain(ctx context.Context) error {
+	for {
+		n, err := r.ProcessBatch(ctx)
+		if err != nil {
+			return err
+		}
+		if n < r.cfg.BatchSize {
+			return nil
+		}
+	}
+}
+
+// ProcessBatch claims up to BatchSize pending messages, publishes them and
+// records the outcome. A message whose publishing fails stays pending and is
+// retried with exponential backoff until entity.MaxOutboxPublishAttempts is
+// reached. It returns the number of messages claimed.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	messages, err := r.outboxRepo.GetPendingWithLock(ctx, r.cfg.BatchSize, r.processorID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim pending outbox messages: %w", err)
+	}
+
+	for _, msg := range messages {
+		if err := ctx.Err(); err != nil {
+			return len(messages), err
+		}
+
+		if pubErr := r.publisher.Publish(ctx, msg); pubErr != nil {
+			msg.RecordPublishFailure(time.Now(), pubErr.Error())
+			if msg.Status == entity.OutboxStatusFailed {
+				log.Printf("Outbox relay %s gave up on message %s after %d attempts: %v", r.processorID, msg.ID, msg.Attempts, pubErr)
+			}
+			if err := r.outboxRepo.RecordPublishFailure(ctx, msg); err != nil {
+				return len(messages), fmt.Errorf("failed to record publish failure of outbox message %s: %w", msg.ID, err)
+			}
+			continue
+		}
+
+		if err := r.outboxRepo.MarkAsProcessed(ctx, msg.ID, time.Now()); err != nil {
+			return len(messages), fmt.Errorf("failed to mark outbox message %s as processed: %w", msg.ID, err)
+		}
+	}
+
+	return len(messages), nil
+}
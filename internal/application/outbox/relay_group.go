@@ -0,0 +1,135 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// Listener is a Notifier that delivers wakeups while it runs (secondary port)
+type Listener interface {
+	Notifier
+	Run(ctx context.Context) error
+}
+
+// Source is an outbox of its own, such as the one in the database of a tenant isolated in
+// its own database, which is written in the same transaction as the tenant's changes
+type Source struct {
+	// Name identifies the source; a source keeps its relay as long as its name is found
+	Name string
+	Repo repository.OutboxRepository
+	// Listener wakes up the relay of the source; without one, it only polls
+	Listener Listener
+}
+
+// SourceFinder finds the outboxes to relay besides the shared one (secondary port)
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_outbox
+type SourceFinder interface {
+	Sources(ctx context.Context) ([]Source, error)
+}
+
+// RelayGroup runs a Relay for every Source, looking for new sources every PollInterval
+type RelayGroup struct {
+	finder    SourceFinder
+	publisher Publisher
+	cfg       RelayConfig
+
+	mu      sync.Mutex
+	running map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewRelayGroup creates a new relay group. Zero values in cfg are replaced with defaults.
+func NewRelayGroup(finder SourceFinder, publisher Publisher, cfg RelayConfig) *RelayGroup {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+
+	return &RelayGroup{
+		finder:    finder,
+		publisher: publisher,
+		cfg:       cfg,
+		running:   make(map[string]context.CancelFunc),
+	}
+}
+
+// Run relays the messages of every source until ctx is cancelled, and waits for the
+// relays to stop
+func (g *RelayGroup) Run(ctx context.Context) error {
+	defer g.wg.Wait()
+
+	poll := time.NewTicker(g.cfg.PollInterval)
+	defer poll.Stop()
+
+	for {
+		if _, err := g.Sync(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Outbox relay group failed to find sources: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-poll.C:
+		}
+	}
+}
+
+// Sync starts a relay, bound to ctx, for every source not relayed yet and stops the relays
+// of the sources no longer found. It returns the number of relays started.
+func (g *RelayGroup) Sync(ctx context.Context) (int, error) {
+	sources, err := g.finder.Sources(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find outbox sources: %w", err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	found := make(map[string]bool, len(sources))
+	started := 0
+	for _, source := range sources {
+		found[source.Name] = true
+		if _, ok := g.running[source.Name]; ok {
+			continue
+		}
+
+		relayCtx, cancel := context.WithCancel(ctx)
+		g.running[source.Name] = cancel
+		g.start(relayCtx, source)
+		started++
+	}
+
+	for name, cancel := range g.running {
+		if !found[name] {
+			cancel()
+			delete(g.running, name)
+		}
+	}
+	return started, nil
+}
+
+// start runs the listener and the relay of a source until ctx is cancelled
+func (g *RelayGroup) start(ctx context.Context, source Source) {
+	var notifier Notifier
+	if source.Listener != nil {
+		notifier = source.Listener
+		g.wg.Add(1)
+		go func() {
+			defer g.wg.Done()
+			_ = source.Listener.Run(ctx)
+		}()
+	}
+
+	relay := NewRelay(source.Repo, g.publisher, notifier, g.cfg)
+	log.Printf("Outbox relay %s started for %s", relay.processorID, source.Name)
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		_ = relay.Run(ctx)
+	}()
+}
@@ -0,0 +1,97 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/outbox"
+	mock_outbox "github.com/jp-ryuji/go-arch-patterns/internal/application/outbox/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// TestRelayGroup_Run tests that every source gets a relay of its own until ctx is cancelled
+func TestRelayGroup_Run(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockFinder := mock_outbox.NewMockSourceFinder(ctrl)
+	mockPublisher := mock_outbox.NewMockPublisher(ctrl)
+	repoA := mock_repository.NewMockOutboxRepository(ctrl)
+	repoB := mock_repository.NewMockOutboxRepository(ctrl)
+	group := outbox.NewRelayGroup(mockFinder, mockPublisher, outbox.RelayConfig{BatchSize: 10, PollInterval: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgA := &entity.OutboxMessage{ID: "msg-a"}
+	msgB := &entity.OutboxMessage{ID: "msg-b"}
+	published := make(chan string, 2)
+
+	// Set up expectations
+	mockFinder.EXPECT().Sources(gomock.Any()).Return([]outbox.Source{
+		{Name: "tenant_a", Repo: repoA},
+		{Name: "tenant_b", Repo: repoB},
+	}, nil)
+	repoA.EXPECT().GetPendingWithLock(gomock.Any(), 10, gomock.Any()).Return([]*entity.OutboxMessage{msgA}, nil)
+	repoB.EXPECT().GetPendingWithLock(gomock.Any(), 10, gomock.Any()).Return([]*entity.OutboxMessage{msgB}, nil)
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msg *entity.OutboxMessage) error {
+			published <- msg.ID
+			return nil
+		}).Times(2)
+	repoA.EXPECT().MarkAsProcessed(gomock.Any(), "msg-a", gomock.Any()).Return(nil)
+	repoB.EXPECT().MarkAsProcessed(gomock.Any(), "msg-b", gomock.Any()).Return(nil)
+
+	// Execute
+	done := make(chan error, 1)
+	go func() { done <- group.Run(ctx) }()
+
+	ids := []string{<-published, <-published}
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	assert.ElementsMatch(t, []string{"msg-a", "msg-b"}, ids)
+}
+
+// TestRelayGroup_Sync tests that only sources not relayed yet get a new relay
+func TestRelayGroup_Sync(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockFinder := mock_outbox.NewMockSourceFinder(ctrl)
+	mockPublisher := mock_outbox.NewMockPublisher(ctrl)
+	repoA := mock_repository.NewMockOutboxRepository(ctrl)
+	repoB := mock_repository.NewMockOutboxRepository(ctrl)
+	group := outbox.NewRelayGroup(mockFinder, mockPublisher, outbox.RelayConfig{BatchSize: 10, PollInterval: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sourceA := outbox.Source{Name: "tenant_a", Repo: repoA}
+	sourceB := outbox.Source{Name: "tenant_b", Repo: repoB}
+
+	// Set up expectations
+	gomock.InOrder(
+		mockFinder.EXPECT().Sources(ctx).Return([]outbox.Source{sourceA}, nil),
+		mockFinder.EXPECT().Sources(ctx).Return([]outbox.Source{sourceA, sourceB}, nil),
+		mockFinder.EXPECT().Sources(ctx).Return(nil, assert.AnError),
+	)
+	repoA.EXPECT().GetPendingWithLock(gomock.Any(), 10, gomock.Any()).Return(nil, nil).AnyTimes()
+	repoB.EXPECT().GetPendingWithLock(gomock.Any(), 10, gomock.Any()).Return(nil, nil).AnyTimes()
+
+	// Execute
+	n, err := group.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	n, err = group.Sync(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	_, err = group.Sync(ctx)
+	assert.ErrorIs(t, err, assert.AnError)
+}
@@ -0,0 +1,219 @@
+package outbox_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/outbox"
+	mock_outbox "github.com/jp-ryuji/go-arch-patterns/internal/application/outbox/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeNotifier is a Notifier whose wakeups are triggered by the test
+type fakeNotifier struct {
+	ch chan struct{}
+}
+
+func (n *fakeNotifier) Wakeups() <-chan struct{} {
+	return n.ch
+}
+
+// TestRelay_ProcessBatch_Success tests that published messages are marked as processed
+func TestRelay_ProcessBatch_Success(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	mockPublisher := mock_outbox.NewMockPublisher(ctrl)
+	relay := outbox.NewRelay(mockOutboxRepo, mockPublisher, nil, outbox.RelayConfig{BatchSize: 10})
+
+	ctx := context.Background()
+	messages := []*entity.OutboxMessage{{ID: "msg-1"}, {ID: "msg-2"}}
+
+	// Set up expectations
+	mockOutboxRepo.EXPECT().GetPendingWithLock(ctx, 10, gomock.Any()).Return(messages, nil)
+	mockPublisher.EXPECT().Publish(ctx, messages[0]).Return(nil)
+	mockPublisher.EXPECT().Publish(ctx, messages[1]).Return(nil)
+	mockOutboxRepo.EXPECT().MarkAsProcessed(ctx, "msg-1", gomock.Any()).Return(nil)
+	mockOutboxRepo.EXPECT().MarkAsProcessed(ctx, "msg-2", gomock.Any()).Return(nil)
+
+	// Execute
+	n, err := relay.ProcessBatch(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+// TestRelay_ProcessBatch_PublishError tests that a publish failure re-queues only that message with a backoff
+func TestRelay_ProcessBatch_PublishError(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	mockPublisher := mock_outbox.NewMockPublisher(ctrl)
+	relay := outbox.NewRelay(mockOutboxRepo, mockPublisher, nil, outbox.RelayConfig{BatchSize: 10})
+
+	ctx := context.Background()
+	messages := []*entity.OutboxMessage{{ID: "msg-1"}, {ID: "msg-2"}}
+
+	// Set up expectations
+	mockOutboxRepo.EXPECT().GetPendingWithLock(ctx, 10, gomock.Any()).Return(messages, nil)
+	mockPublisher.EXPECT().Publish(ctx, messages[0]).Return(assert.AnError)
+	mockPublisher.EXPECT().Publish(ctx, messages[1]).Return(nil)
+	mockOutboxRepo.EXPECT().RecordPublishFailure(ctx, messages[0]).Return(nil)
+	mockOutboxRepo.EXPECT().MarkAsProcessed(ctx, "msg-2", gomock.Any()).Return(nil)
+
+	// Execute
+	n, err := relay.ProcessBatch(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, entity.OutboxStatusPending, messages[0].Status)
+	assert.Equal(t, 1, messages[0].Attempts)
+	assert.Equal(t, assert.AnError.Error(), messages[0].ErrorMessage.String)
+	assert.True(t, messages[0].NextAttemptAt.Valid)
+}
+
+// TestRelay_ProcessBatch_RetryAfterPublishError tests that a message whose first publish
+// failed is published on the next attempt
+func TestRelay_ProcessBatch_RetryAfterPublishError(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	mockPublisher := mock_outbox.NewMockPublisher(ctrl)
+	relay := outbox.NewRelay(mockOutboxRepo, mockPublisher, nil, outbox.RelayConfig{BatchSize: 10})
+
+	ctx := context.Background()
+	msg := &entity.OutboxMessage{ID: "msg-1", Status: entity.OutboxStatusPending}
+
+	// Set up expectations: the first publish fails, the retry succeeds
+	gomock.InOrder(
+		mockOutboxRepo.EXPECT().GetPendingWithLock(ctx, 10, gomock.Any()).Return([]*entity.OutboxMessage{msg}, nil),
+		mockPublisher.EXPECT().Publish(ctx, msg).Return(assert.AnError),
+		mockOutboxRepo.EXPECT().RecordPublishFailure(ctx, msg).Return(nil),
+		mockOutboxRepo.EXPECT().GetPendingWithLock(ctx, 10, gomock.Any()).Return([]*entity.OutboxMessage{msg}, nil),
+		mockPublisher.EXPECT().Publish(ctx, msg).Return(nil),
+		mockOutboxRepo.EXPECT().MarkAsProcessed(ctx, "msg-1", gomock.Any()).Return(nil),
+	)
+
+	// Execute
+	n, err := relay.ProcessBatch(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, entity.OutboxStatusPending, msg.Status)
+
+	n, err = relay.ProcessBatch(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+// TestRelay_ProcessBatch_GivesUpAfterMaxAttempts tests that a message is marked as failed
+// once it has used up its publish attempts
+func TestRelay_ProcessBatch_GivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	mockPublisher := mock_outbox.NewMockPublisher(ctrl)
+	relay := outbox.NewRelay(mockOutboxRepo, mockPublisher, nil, outbox.RelayConfig{BatchSize: 10})
+
+	ctx := context.Background()
+	msg := &entity.OutboxMessage{
+		ID:       "msg-1",
+		Status:   entity.OutboxStatusPending,
+		Attempts: entity.MaxOutboxPublishAttempts - 1,
+	}
+
+	// Set up expectations
+	mockOutboxRepo.EXPECT().GetPendingWithLock(ctx, 10, gomock.Any()).Return([]*entity.OutboxMessage{msg}, nil)
+	mockPublisher.EXPECT().Publish(ctx, msg).Return(assert.AnError)
+	mockOutboxRepo.EXPECT().RecordPublishFailure(ctx, msg).Return(nil)
+
+	// Execute
+	_, err := relay.ProcessBatch(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.OutboxStatusFailed, msg.Status)
+	assert.Equal(t, entity.MaxOutboxPublishAttempts, msg.Attempts)
+	assert.False(t, msg.NextAttemptAt.Valid)
+}
+
+// TestRelay_Drain tests that full batches are followed by another batch until the outbox is empty
+func TestRelay_Drain(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	mockPublisher := mock_outbox.NewMockPublisher(ctrl)
+	relay := outbox.NewRelay(mockOutboxRepo, mockPublisher, nil, outbox.RelayConfig{BatchSize: 1})
+
+	ctx := context.Background()
+
+	// Set up expectations: one full batch, then an empty one
+	gomock.InOrder(
+		mockOutboxRepo.EXPECT().GetPendingWithLock(ctx, 1, gomock.Any()).Return([]*entity.OutboxMessage{{ID: "msg-1"}}, nil),
+		mockOutboxRepo.EXPECT().GetPendingWithLock(ctx, 1, gomock.Any()).Return(nil, nil),
+	)
+	mockPublisher.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
+	mockOutboxRepo.EXPECT().MarkAsProcessed(ctx, "msg-1", gomock.Any()).Return(nil)
+
+	// Execute
+	assert.NoError(t, relay.Drain(ctx))
+}
+
+// TestRelay_Run_WakeupTriggersDrain tests that a notification wakes the relay before the poll interval
+func TestRelay_Run_WakeupTriggersDrain(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	mockPublisher := mock_outbox.NewMockPublisher(ctrl)
+	notifier := &fakeNotifier{ch: make(chan struct{}, 1)}
+	relay := outbox.NewRelay(mockOutboxRepo, mockPublisher, notifier, outbox.RelayConfig{
+		BatchSize:    10,
+		PollInterval: time.Hour,
+		LockTimeout:  time.Hour,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	published := make(chan struct{})
+	msg := &entity.OutboxMessage{ID: "msg-1"}
+
+	// Set up expectations: the initial drain finds nothing, the wakeup finds one message
+	gomock.InOrder(
+		mockOutboxRepo.EXPECT().GetPendingWithLock(gomock.Any(), 10, gomock.Any()).Return(nil, nil),
+		mockOutboxRepo.EXPECT().GetPendingWithLock(gomock.Any(), 10, gomock.Any()).Return([]*entity.OutboxMessage{msg}, nil),
+	)
+	mockOutboxRepo.EXPECT().GetPendingWithLock(gomock.Any(), 10, gomock.Any()).Return(nil, nil).AnyTimes()
+	mockPublisher.EXPECT().Publish(gomock.Any(), msg).Return(nil)
+	mockOutboxRepo.EXPECT().MarkAsProcessed(gomock.Any(), "msg-1", gomock.Any()).DoAndReturn(
+		func(context.Context, string, time.Time) error {
+			close(published)
+			return nil
+		},
+	)
+
+	// Execute
+	done := make(chan error, 1)
+	go func() { done <- relay.Run(ctx) }()
+	notifier.ch <- struct{}{}
+
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatal("relay did not process the message after a wakeup")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
@@ -0,0 +1,11 @@
+package output
+
+import (
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// NearestBranch is a branch with its distance to the position searched from
+type NearestBranch struct {
+	Branch     *entity.Branch `json:"branch"`
+	DistanceKm float64        `json:"distance_km"`
+}
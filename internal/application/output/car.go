@@ -1,5 +1,7 @@
 package output
 
+import "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+
 // ListCars represents the response data for listing cars
 type ListCars struct {
 	Cars          []CarSummary `json:"cars"`
@@ -9,6 +11,12 @@ type ListCars struct {
 
 // CarSummary represents a summary view of a car for listing
 type CarSummary struct {
-	ID    string `json:"id"`
-	Model string `json:"model"`
+	ID                  string           `json:"id"`
+	ModelID             string           `json:"car_model_id"`
+	Model               *entity.CarModel `json:"model,omitempty"`
+	VIN                 string           `json:"vin,omitempty"`
+	LicensePlate        string           `json:"license_plate,omitempty"`
+	LicensePlateCountry string           `json:"license_plate_country,omitempty"`
+	HomeBranchID        string           `json:"home_branch_id,omitempty"`
+	CurrentBranchID     string           `json:"current_branch_id,omitempty"`
 }
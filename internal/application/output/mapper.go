@@ -7,8 +7,14 @@ import (
 // CarEntityToSummary converts a domain Car entity to CarSummary DTO
 func CarEntityToSummary(car *entity.Car) CarSummary {
 	return CarSummary{
-		ID:    car.ID,
-		Model: car.Model,
+		ID:                  car.ID,
+		ModelID:             car.ModelID,
+		Model:               car.Model(),
+		VIN:                 car.VINString(),
+		LicensePlate:        car.LicensePlateString(),
+		LicensePlateCountry: car.LicensePlateCountry(),
+		HomeBranchID:        car.HomeBranchID,
+		CurrentBranchID:     car.CurrentBranchID,
 	}
 }
 
@@ -0,0 +1,67 @@
+package output
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// UsageStatement represents the metered usage of a tenant over a UTC month, day by day
+type UsageStatement struct {
+	TenantID   string              `json:"tenant_id"`
+	TenantCode string              `json:"tenant_code"`
+	Month      time.Time           `json:"month"`
+	Days       []UsageStatementDay `json:"days"`
+	// Totals are the monthly rollups: the peak of gauges and the sum of counters
+	Totals map[entity.Meter]int64 `json:"totals"`
+}
+
+// UsageStatementDay represents the metered usage of a tenant over a UTC day
+type UsageStatementDay struct {
+	Date  time.Time              `json:"date"`
+	Usage map[entity.Meter]int64 `json:"usage"`
+}
+
+// Filename returns the name the statement is downloaded as
+func (s *UsageStatement) Filename() string {
+	return fmt.Sprintf("usage-%s-%s.csv", s.TenantCode, s.Month.Format("2006-01"))
+}
+
+// WriteCSV writes the statement as CSV: a header, one row per day and a total row, with
+// one column per meter
+func (s *UsageStatement) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{"date"}
+	for _, meter := range entity.Meters {
+		header = append(header, meter.String())
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, day := range s.Days {
+		if err := cw.Write(usageRow(day.Date.Format(time.DateOnly), day.Usage)); err != nil {
+			return err
+		}
+	}
+	if err := cw.Write(usageRow("total", s.Totals)); err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// usageRow returns a CSV row of usage, in the order of entity.Meters
+func usageRow(label string, usage map[entity.Meter]int64) []string {
+	row := []string{label}
+	for _, meter := range entity.Meters {
+		row = append(row, strconv.FormatInt(usage[meter], 10))
+	}
+	return row
+}
@@ -0,0 +1,17 @@
+package output
+
+import (
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// Usage represents how much a tenant uses of the limits of its plan
+type Usage struct {
+	// Plan is nil for tenants without a plan, which are not limited
+	Plan *entity.Plan `json:"plan,omitempty"`
+	// PeriodStart is when the current month started on the tenant's clocks; monthly
+	// resources are counted from then
+	PeriodStart time.Time              `json:"period_start"`
+	Resources   []entity.ResourceUsage `json:"resources"`
+}
@@ -0,0 +1,33 @@
+package output
+
+import (
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// AvailableCar is a car a tenant can book, with whether it is shared by another tenant
+type AvailableCar struct {
+	Car *entity.Car `json:"car"`
+	// AgreementID is the fleet sharing agreement the car is shared under; empty for the
+	// tenant's own cars
+	AgreementID string `json:"agreement_id,omitempty"`
+}
+
+// Shared reports whether the car belongs to another tenant
+func (c AvailableCar) Shared() bool {
+	return c.AgreementID != ""
+}
+
+// ListRentals represents the response data for listing rentals
+type ListRentals struct {
+	Rentals       entity.Rentals `json:"rentals"`
+	NextPageToken string         `json:"next_page_token,omitempty"`
+	TotalCount    int            `json:"total_count"`
+}
+
+// ReturnRental represents the response data for returning a rental
+type ReturnRental struct {
+	Rental *entity.Rental `json:"rental"`
+	// Handover is the inspection at return, with the distance driven and the fuel shortfall
+	// since pickup; nil when the car was returned uninspected
+	Handover *entity.RentalHandover `json:"handover,omitempty"`
+}
@@ -0,0 +1,11 @@
+package output
+
+import (
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// ListAPIKeys represents the response data for listing API keys
+type ListAPIKeys struct {
+	APIKeys       []*entity.APIKey `json:"api_keys"`
+	NextPageToken string           `json:"next_page_token,omitempty"`
+}
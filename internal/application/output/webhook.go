@@ -0,0 +1,17 @@
+package output
+
+import (
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// ListWebhookEndpoints represents the response data for listing webhook endpoints
+type ListWebhookEndpoints struct {
+	Endpoints     []*entity.WebhookEndpoint `json:"endpoints"`
+	NextPageToken string                    `json:"next_page_token,omitempty"`
+}
+
+// ListWebhookDeliveries represents the response data for listing webhook deliveries
+type ListWebhookDeliveries struct {
+	Deliveries    []*entity.WebhookDelivery `json:"deliveries"`
+	NextPageToken string                    `json:"next_page_token,omitempty"`
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// BranchService defines the interface for managing the branches of a tenant, where its cars
+// are kept, picked up and returned
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type BranchService interface {
+	Create(ctx context.Context, input input.CreateBranch) (*entity.Branch, error)
+	Update(ctx context.Context, input input.UpdateBranch) (*entity.Branch, error)
+	List(ctx context.Context, input input.ListBranches) (entity.Branches, error)
+	FindNearest(ctx context.Context, input input.FindNearestBranches) ([]output.NearestBranch, error)
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+)
+
+// defaultNearestBranches is the number of branches returned when a search for the nearest
+// ones does not specify a limit
+const defaultNearestBranches = 5
+
+// branchService implements BranchService interface
+type branchService struct {
+	branchRepo repository.BranchRepository
+}
+
+// NewBranchService creates a new branch service
+func NewBranchService(branchRepo repository.BranchRepository) BranchService {
+	return &branchService{
+		branchRepo: branchRepo,
+	}
+}
+
+// Create adds a branch to the tenant. Names are unique among the branches of a tenant.
+func (s *branchService) Create(ctx context.Context, input input.CreateBranch) (*entity.Branch, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	spec, err := toBranchSpec(input.Spec)
+	if err != nil {
+		return nil, err
+	}
+	branch, err := entity.NewBranch(input.TenantID, spec, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.branchRepo.Create(ctx, branch); err != nil {
+		return nil, fmt.Errorf("failed to create branch: %w", err)
+	}
+
+	return branch, nil
+}
+
+// Update replaces the attributes of a branch; its cars stay where they are
+func (s *branchService) Update(ctx context.Context, input input.UpdateBranch) (*entity.Branch, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	spec, err := toBranchSpec(input.Spec)
+	if err != nil {
+		return nil, err
+	}
+	branch, err := s.branchRepo.GetByID(ctx, input.TenantID, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch: %w", err)
+	}
+	if err := branch.Update(spec, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.branchRepo.Update(ctx, branch); err != nil {
+		return nil, fmt.Errorf("failed to update branch: %w", err)
+	}
+
+	return branch, nil
+}
+
+// List retrieves the branches of a tenant by name
+func (s *branchService) List(ctx context.Context, input input.ListBranches) (entity.Branches, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	branches, err := s.branchRepo.ListByTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	return branches, nil
+}
+
+// FindNearest retrieves the branches of a tenant closest to a position, the nearest first.
+// Tenants have few branches, so distances are computed over all of them rather than by the
+// database.
+func (s *branchService) FindNearest(ctx context.Context, input input.FindNearestBranches) ([]output.NearestBranch, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	from, err := value.NewCoordinates(input.Latitude, input.Longitude)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(input.Limit)
+	if limit <= 0 {
+		limit = defaultNearestBranches
+	}
+
+	branches, err := s.branchRepo.ListByTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	nearest := make([]output.NearestBranch, len(branches))
+	for i, branch := range branches {
+		nearest[i] = output.NearestBranch{Branch: branch, DistanceKm: branch.DistanceKm(from)}
+	}
+	// Branches come by name, which breaks ties between branches as far away
+	slices.SortStableFunc(nearest, func(a, b output.NearestBranch) int {
+		return cmp.Compare(a.DistanceKm, b.DistanceKm)
+	})
+	if len(nearest) > limit {
+		nearest = nearest[:limit]
+	}
+	return nearest, nil
+}
+
+// toBranchSpec converts the input attributes of a branch to their domain form
+func toBranchSpec(spec input.BranchSpec) (entity.BranchSpec, error) {
+	coordinates, err := value.NewCoordinates(spec.Latitude, spec.Longitude)
+	if err != nil {
+		return entity.BranchSpec{}, fmt.Errorf("%w: %w", entity.ErrInvalidBranch, err)
+	}
+	hours, err := toBusinessHours(spec.OpeningHours)
+	if err != nil {
+		return entity.BranchSpec{}, fmt.Errorf("%w: %w", entity.ErrInvalidBranch, err)
+	}
+
+	return entity.BranchSpec{
+		Name: spec.Name,
+		Address: entity.Address{
+			Street:     spec.Street,
+			City:       spec.City,
+			Region:     spec.Region,
+			PostalCode: spec.PostalCode,
+			Country:    spec.Country,
+		},
+		Coordinates:  coordinates,
+		OpeningHours: hours,
+	}, nil
+}
@@ -16,4 +16,5 @@ type CarService interface {
 	GetByID(ctx context.Context, input input.GetCarByID) (*entity.Car, error)
 	GetByIDWithTenant(ctx context.Context, input input.GetCarByID) (*entity.Car, error)
 	List(ctx context.Context, input input.ListCars) (*output.ListCars, error)
+	AssignBranches(ctx context.Context, input input.AssignCarBranches) (*entity.Car, error)
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,107 +10,97 @@ import (
 	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
-	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
-	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/id"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
 )
 
 // carService implements CarService interface
 type carService struct {
-	carRepo    repository.CarRepository
-	outboxRepo repository.OutboxRepository
-	txManager  repository.TransactionManager
+	carRepo      repository.CarRepository
+	carModelRepo repository.CarModelRepository
+	branchRepo   repository.BranchRepository
+	uowFactory   repository.UnitOfWorkFactory
+	quotaService QuotaService
 }
 
 // NewCarService creates a new car service
 func NewCarService(
 	carRepo repository.CarRepository,
-	outboxRepo repository.OutboxRepository,
-	txManager repository.TransactionManager,
+	carModelRepo repository.CarModelRepository,
+	branchRepo repository.BranchRepository,
+	uowFactory repository.UnitOfWorkFactory,
+	quotaService QuotaService,
 ) CarService {
 	return &carService{
-		carRepo:    carRepo,
-		outboxRepo: outboxRepo,
-		txManager:  txManager,
+		carRepo:      carRepo,
+		carModelRepo: carModelRepo,
+		branchRepo:   branchRepo,
+		uowFactory:   uowFactory,
+		quotaService: quotaService,
 	}
 }
 
-// Create creates a new car using the outbox pattern with transactional guarantees
+// Create creates a new car of a model in the tenant's catalog, at its home branch if it has
+// one, within the car limit of the tenant's plan. The car and its CarCreated event are committed atomically through a unit
+// of work, which writes the event to the outbox. A VIN or license plate already used by
+// another car of the tenant fails with entity.ErrDuplicateCar.
 func (s *carService) Create(ctx context.Context, input input.CreateCar) (*entity.Car, error) {
 	// Validate input
 	if err := Validate(input); err != nil {
 		return nil, err
 	}
 
-	now := time.Now()
-	car := entity.NewCar(input.TenantID, input.Model, now)
-
-	// Start a transaction
-	tx, err := s.txManager.BeginTx(ctx)
+	model, err := s.carModelRepo.GetByID(ctx, input.TenantID, input.CarModelID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to start transaction: %w", err)
-	}
-
-	// Ensure rollback in case of error
-	defer func() {
-		if r := recover(); r != nil {
-			if err := s.txManager.RollbackTx(ctx, tx); err != nil {
-				// Log the error but continue with the panic
-				// In a production system, you might want to use a proper logger
-				fmt.Printf("Failed to rollback transaction: %v\n", err)
-			}
-			panic(r) // re-panic
-		}
-	}()
+		return nil, fmt.Errorf("failed to get car model: %w", err)
+	}
 
-	// Step 1: Save to PostgreSQL within transaction
-	if err := s.carRepo.CreateInTx(ctx, tx, car); err != nil {
-		if rollbackErr := s.txManager.RollbackTx(ctx, tx); rollbackErr != nil {
-			return nil, fmt.Errorf("failed to create car in database: %w; also failed to rollback transaction: %v", err, rollbackErr)
+	var vin *value.VIN
+	if input.VIN != "" {
+		if vin, err = value.NewVIN(input.VIN); err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("failed to create car in database: %w", err)
-	}
-
-	// Step 2: Create outbox message for external systems within transaction
-	outbox := &entgen.Outbox{
-		ID:            id.New(),
-		AggregateType: "car",
-		AggregateID:   car.ID,
-		EventType:     "car_created",
-		Payload: map[string]interface{}{
-			"id":         car.ID,
-			"tenant_id":  car.TenantID,
-			"model":      car.Model,
-			"created_at": car.CreatedAt,
-			"updated_at": car.UpdatedAt,
-		},
-		CreatedAt: now,
-		Status:    "pending",
-	}
-
-	if err := s.outboxRepo.CreateInTx(ctx, tx, outbox); err != nil {
-		if rollbackErr := s.txManager.RollbackTx(ctx, tx); rollbackErr != nil {
-			return nil, fmt.Errorf("failed to create outbox message: %w; also failed to rollback transaction: %v", err, rollbackErr)
+	}
+	var plate *value.LicensePlate
+	if input.LicensePlate != "" {
+		if plate, err = value.NewLicensePlate(input.LicensePlateCountry, input.LicensePlate); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.HomeBranchID != "" {
+		if _, err := s.branchRepo.GetByID(ctx, input.TenantID, input.HomeBranchID); err != nil {
+			return nil, fmt.Errorf("failed to get home branch: %w", err)
 		}
-		return nil, fmt.Errorf("failed to create outbox message: %w", err)
 	}
 
-	// Commit the transaction
-	if err := s.txManager.CommitTx(ctx, tx); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	// The car is built inside the transaction, so that a retry records its events again
+	var car *entity.Car
+	err = s.quotaService.WithinQuota(ctx, input.TenantID, entity.ResourceCars, func(ctx context.Context) error {
+		car = entity.NewCar(input.TenantID, model.ID, input.HomeBranchID, vin, plate, time.Now())
+		car.Refs = &entity.CarRefs{Model: model}
+		uow := s.uowFactory.New()
+		uow.RegisterNew(car)
+		return uow.Commit(ctx)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, fmt.Errorf("%w: %w", entity.ErrDuplicateCar, err)
+		}
+		return nil, fmt.Errorf("failed to create car: %w", err)
 	}
 
 	// Return the entity directly
 	return car, nil
 }
 
-// GetByID retrieves a car by its ID
+// GetByID retrieves a tenant's car by its ID
 func (s *carService) GetByID(ctx context.Context, input input.GetCarByID) (*entity.Car, error) {
 	// Validate input
 	if err := Validate(input); err != nil {
 		return nil, err
 	}
 
-	car, err := s.carRepo.GetByID(ctx, input.ID)
+	car, err := s.carRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -117,14 +108,14 @@ func (s *carService) GetByID(ctx context.Context, input input.GetCarByID) (*enti
 	return car, nil
 }
 
-// GetByIDWithTenant retrieves a car by its ID along with its tenant information
+// GetByIDWithTenant retrieves a tenant's car by its ID along with its tenant information
 func (s *carService) GetByIDWithTenant(ctx context.Context, input input.GetCarByID) (*entity.Car, error) {
 	// Validate input
 	if err := Validate(input); err != nil {
 		return nil, err
 	}
 
-	car, err := s.carRepo.GetByIDWithTenant(ctx, input.ID)
+	car, err := s.carRepo.GetByIDWithTenant(ctx, input.TenantID, input.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -164,3 +155,35 @@ func (s *carService) List(ctx context.Context, input input.ListCars) (*output.Li
 	// Convert entities to DTO before returning
 	return output.CarEntitiesToList(cars, nextPageToken, totalCount), nil
 }
+
+// AssignBranches sets the branch a tenant's car belongs to and the one it is at, both
+// branches of the tenant
+func (s *carService) AssignBranches(ctx context.Context, input input.AssignCarBranches) (*entity.Car, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	for _, branchID := range []string{input.HomeBranchID, input.CurrentBranchID} {
+		if branchID == "" {
+			continue
+		}
+		if _, err := s.branchRepo.GetByID(ctx, input.TenantID, branchID); err != nil {
+			return nil, fmt.Errorf("failed to get branch %s: %w", branchID, err)
+		}
+	}
+
+	car, err := s.carRepo.GetByID(ctx, input.TenantID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	car.AssignBranches(input.HomeBranchID, input.CurrentBranchID, time.Now())
+
+	uow := s.uowFactory.New()
+	uow.RegisterDirty(car)
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to assign branches: %w", err)
+	}
+
+	return car, nil
+}
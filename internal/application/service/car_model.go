@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// CarModelService defines the interface for managing a tenant's catalog of car models
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type CarModelService interface {
+	Create(ctx context.Context, input input.CreateCarModel) (*entity.CarModel, error)
+	Update(ctx context.Context, input input.UpdateCarModel) (*entity.CarModel, error)
+	List(ctx context.Context, input input.ListCarModels) (entity.CarModels, error)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// carModelService implements CarModelService interface
+type carModelService struct {
+	carModelRepo repository.CarModelRepository
+}
+
+// NewCarModelService creates a new car model service
+func NewCarModelService(carModelRepo repository.CarModelRepository) CarModelService {
+	return &carModelService{
+		carModelRepo: carModelRepo,
+	}
+}
+
+// Create adds a model to the tenant's catalog. Make and name are unique within a catalog.
+func (s *carModelService) Create(ctx context.Context, input input.CreateCarModel) (*entity.CarModel, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	model, err := entity.NewCarModel(input.TenantID, toCarModelSpec(input.Spec), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.carModelRepo.Create(ctx, model); err != nil {
+		return nil, fmt.Errorf("failed to create car model: %w", err)
+	}
+
+	return model, nil
+}
+
+// Update replaces the attributes of a model; every car of the model picks them up
+func (s *carModelService) Update(ctx context.Context, input input.UpdateCarModel) (*entity.CarModel, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	model, err := s.carModelRepo.GetByID(ctx, input.TenantID, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get car model: %w", err)
+	}
+	if err := model.Update(toCarModelSpec(input.Spec), time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.carModelRepo.Update(ctx, model); err != nil {
+		return nil, fmt.Errorf("failed to update car model: %w", err)
+	}
+
+	return model, nil
+}
+
+// List retrieves the catalog of a tenant
+func (s *carModelService) List(ctx context.Context, input input.ListCarModels) (entity.CarModels, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	models, err := s.carModelRepo.ListByTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list car models: %w", err)
+	}
+
+	return models, nil
+}
+
+// toCarModelSpec converts the input attributes of a model to their domain form
+func toCarModelSpec(spec input.CarModelSpec) entity.CarModelSpec {
+	return entity.CarModelSpec{
+		Make:         spec.Make,
+		Name:         spec.Name,
+		Category:     entity.CarCategory(spec.Category),
+		Seats:        spec.Seats,
+		Transmission: entity.Transmission(spec.Transmission),
+		FuelType:     entity.FuelType(spec.FuelType),
+	}
+}
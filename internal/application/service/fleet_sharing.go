@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// FleetSharingService defines the interface for managing the agreements under which tenants
+// of a franchise book each other's cars
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type FleetSharingService interface {
+	Create(ctx context.Context, input input.CreateFleetSharingAgreement) (*entity.FleetSharingAgreement, error)
+	Terminate(ctx context.Context, input input.TerminateFleetSharingAgreement) (*entity.FleetSharingAgreement, error)
+	List(ctx context.Context, input input.ListFleetSharingAgreements) (entity.FleetSharingAgreements, error)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// fleetSharingService implements FleetSharingService interface
+type fleetSharingService struct {
+	tenantRepo  repository.TenantRepository
+	sharingRepo repository.FleetSharingRepository
+}
+
+// NewFleetSharingService creates a new fleet sharing service
+func NewFleetSharingService(
+	tenantRepo repository.TenantRepository,
+	sharingRepo repository.FleetSharingRepository,
+) FleetSharingService {
+	return &fleetSharingService{
+		tenantRepo:  tenantRepo,
+		sharingRepo: sharingRepo,
+	}
+}
+
+// Create lets the borrower book the cars of the lender under the given terms. A lender has
+// at most one active agreement with each borrower.
+func (s *fleetSharingService) Create(ctx context.Context, input input.CreateFleetSharingAgreement) (*entity.FleetSharingAgreement, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	lender, err := s.tenantRepo.GetByID(ctx, input.LenderTenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lender: %w", err)
+	}
+	borrower, err := s.tenantRepo.GetByID(ctx, input.BorrowerTenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get borrower: %w", err)
+	}
+
+	now := time.Now()
+	terms := entity.FleetSharingTerms{
+		ValidFrom:         input.ValidFrom,
+		MaxRentalDays:     input.MaxRentalDays,
+		OwnerSharePercent: input.OwnerSharePercent,
+	}
+	if terms.ValidFrom.IsZero() {
+		terms.ValidFrom = now
+	}
+	if !input.ValidUntil.IsZero() {
+		terms.ValidUntil = null.TimeFrom(input.ValidUntil)
+	}
+
+	agreement, err := entity.NewFleetSharingAgreement(lender, borrower, terms, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.sharingRepo.Create(ctx, agreement); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, fmt.Errorf("%w: %w", entity.ErrSharingAgreementExists, err)
+		}
+		return nil, fmt.Errorf("failed to create fleet sharing agreement: %w", err)
+	}
+
+	return agreement, nil
+}
+
+// Terminate ends an agreement. The borrower can no longer see or book the cars of the
+// lender; the rentals it booked before are kept.
+func (s *fleetSharingService) Terminate(ctx context.Context, input input.TerminateFleetSharingAgreement) (*entity.FleetSharingAgreement, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	agreement, err := s.sharingRepo.GetByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := agreement.Terminate(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.sharingRepo.Update(ctx, agreement); err != nil {
+		return nil, fmt.Errorf("failed to terminate fleet sharing agreement: %w", err)
+	}
+
+	return agreement, nil
+}
+
+// List retrieves the agreements a tenant lends or borrows under, terminated ones included
+func (s *fleetSharingService) List(ctx context.Context, input input.ListFleetSharingAgreements) (entity.FleetSharingAgreements, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	return s.sharingRepo.ListByTenant(ctx, input.TenantID)
+}
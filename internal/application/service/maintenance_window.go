@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// MaintenanceWindowService defines the interface for taking the cars of a tenant out of
+// service, which keeps them from being booked
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type MaintenanceWindowService interface {
+	Schedule(ctx context.Context, input input.ScheduleMaintenanceWindow) (*entity.MaintenanceWindow, error)
+	Complete(ctx context.Context, input input.CompleteMaintenanceWindow) (*entity.MaintenanceWindow, error)
+	Cancel(ctx context.Context, input input.CancelMaintenanceWindow) (*entity.MaintenanceWindow, error)
+	List(ctx context.Context, input input.ListMaintenanceWindows) (entity.MaintenanceWindows, error)
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// maintenanceWindowService implements MaintenanceWindowService interface
+type maintenanceWindowService struct {
+	carRepo         repository.CarRepository
+	rentalRepo      repository.RentalRepository
+	maintenanceRepo repository.MaintenanceWindowRepository
+	txManager       repository.TransactionManager
+	uowFactory      repository.UnitOfWorkFactory
+}
+
+// NewMaintenanceWindowService creates a new maintenance window service
+func NewMaintenanceWindowService(
+	carRepo repository.CarRepository,
+	rentalRepo repository.RentalRepository,
+	maintenanceRepo repository.MaintenanceWindowRepository,
+	txManager repository.TransactionManager,
+	uowFactory repository.UnitOfWorkFactory,
+) MaintenanceWindowService {
+	return &maintenanceWindowService{
+		carRepo:         carRepo,
+		rentalRepo:      rentalRepo,
+		maintenanceRepo: maintenanceRepo,
+		txManager:       txManager,
+		uowFactory:      uowFactory,
+	}
+}
+
+// Schedule takes a car of the tenant out of service over a period. Scheduling is
+// serialized with the bookings of the car, and a window overlapping rentals of it fails
+// with an entity.MaintenanceConflictError listing them, so that they can be moved to
+// another car first.
+func (s *maintenanceWindowService) Schedule(ctx context.Context, input input.ScheduleMaintenanceWindow) (*entity.MaintenanceWindow, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	var window *entity.MaintenanceWindow
+	err := s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		car, err := s.carRepo.GetByID(ctx, input.TenantID, input.CarID)
+		if err != nil {
+			return fmt.Errorf("failed to get car: %w", err)
+		}
+
+		if err := s.rentalRepo.LockCar(ctx, car.ID); err != nil {
+			return err
+		}
+		rentals, err := s.rentalRepo.ListOverlapping(ctx, car.ID, input.StartsAt, input.EndsAt)
+		if err != nil {
+			return err
+		}
+		if len(rentals) > 0 {
+			conflict := &entity.MaintenanceConflictError{RentalIDs: make([]string, len(rentals))}
+			for i, rental := range rentals {
+				conflict.RentalIDs[i] = rental.ID
+			}
+			return conflict
+		}
+
+		window, err = entity.NewMaintenanceWindow(input.TenantID, car.ID, entity.NewMaintenanceType(input.Type),
+			input.Reason, input.StartsAt, input.EndsAt, time.Now())
+		if err != nil {
+			return err
+		}
+		uow := s.uowFactory.New()
+		uow.RegisterNew(window)
+		return uow.Commit(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule maintenance window: %w", err)
+	}
+
+	return window, nil
+}
+
+// Complete records that the work of a maintenance window of the tenant is done. A window
+// completed early frees the car from then on.
+func (s *maintenanceWindowService) Complete(ctx context.Context, input input.CompleteMaintenanceWindow) (*entity.MaintenanceWindow, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	window, err := s.transition(ctx, input.TenantID, input.ID, (*entity.MaintenanceWindow).Complete)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete maintenance window: %w", err)
+	}
+	return window, nil
+}
+
+// Cancel calls off a maintenance window of the tenant, which frees the car over its period
+func (s *maintenanceWindowService) Cancel(ctx context.Context, input input.CancelMaintenanceWindow) (*entity.MaintenanceWindow, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	window, err := s.transition(ctx, input.TenantID, input.ID, (*entity.MaintenanceWindow).Cancel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel maintenance window: %w", err)
+	}
+	return window, nil
+}
+
+// transition applies a change of status to a maintenance window. It is serialized with the
+// bookings of the car, and the window is read again once the car is locked so that it
+// changes once.
+func (s *maintenanceWindowService) transition(ctx context.Context, tenantID, id string, change func(*entity.MaintenanceWindow, time.Time) error) (*entity.MaintenanceWindow, error) {
+	var window *entity.MaintenanceWindow
+	err := s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		scheduled, err := s.maintenanceRepo.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+		if err := s.rentalRepo.LockCar(ctx, scheduled.CarID); err != nil {
+			return err
+		}
+		if window, err = s.maintenanceRepo.GetByID(ctx, tenantID, id); err != nil {
+			return err
+		}
+
+		if err := change(window, time.Now()); err != nil {
+			return err
+		}
+		uow := s.uowFactory.New()
+		uow.RegisterDirty(window)
+		return uow.Commit(ctx)
+	})
+	return window, err
+}
+
+// List retrieves the maintenance windows of a car of the tenant, the earliest first
+func (s *maintenanceWindowService) List(ctx context.Context, input input.ListMaintenanceWindows) (entity.MaintenanceWindows, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	windows, err := s.maintenanceRepo.ListByCar(ctx, input.TenantID, input.CarID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+	return windows, nil
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// MeteringService defines the interface for reading the metered usage tenants are billed on
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type MeteringService interface {
+	GetUsage(ctx context.Context, input input.GetMeteredUsage) (entity.UsageRollups, error)
+	Statement(ctx context.Context, input input.GetUsageStatement) (*output.UsageStatement, error)
+}
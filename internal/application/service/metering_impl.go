@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// meteringService implements MeteringService interface
+type meteringService struct {
+	tenantRepo   repository.TenantRepository
+	meteringRepo repository.MeteringRepository
+}
+
+// NewMeteringService creates a new metering service
+func NewMeteringService(
+	tenantRepo repository.TenantRepository,
+	meteringRepo repository.MeteringRepository,
+) MeteringService {
+	return &meteringService{
+		tenantRepo:   tenantRepo,
+		meteringRepo: meteringRepo,
+	}
+}
+
+// GetUsage retrieves the daily or monthly rollups of a tenant
+func (s *meteringService) GetUsage(ctx context.Context, input input.GetMeteredUsage) (entity.UsageRollups, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	period := entity.UsagePeriodDay
+	if input.Period != "" {
+		period = entity.NewUsagePeriod(input.Period)
+	}
+	from, to := input.From, input.To
+	if from.IsZero() {
+		from = entity.UsagePeriodMonth.Start(time.Now())
+	}
+	if to.IsZero() {
+		to = entity.UsagePeriodMonth.Next(entity.UsagePeriodMonth.Start(from))
+	}
+	if !to.After(from) {
+		return nil, fmt.Errorf("validation failed for field 'To': must be after From")
+	}
+
+	rollups, err := s.meteringRepo.ListRollups(ctx, input.TenantID, period, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list usage rollups: %w", err)
+	}
+	return rollups, nil
+}
+
+// Statement builds the usage statement of a tenant for a month. Every day of the month is
+// listed, even without usage, up to today for the current month.
+func (s *meteringService) Statement(ctx context.Context, input input.GetUsageStatement) (*output.UsageStatement, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.tenantRepo.GetByID(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	month := input.Month
+	if month.IsZero() {
+		month = now
+	}
+	start := entity.UsagePeriodMonth.Start(month)
+	end := entity.UsagePeriodMonth.Next(start)
+	if today := entity.UsagePeriodDay.Next(entity.UsagePeriodDay.Start(now)); today.Before(end) {
+		end = today
+	}
+
+	statement := &output.UsageStatement{
+		TenantID:   tenant.ID,
+		TenantCode: tenant.Code,
+		Month:      start,
+		Totals:     make(map[entity.Meter]int64, len(entity.Meters)),
+	}
+	if !end.After(start) {
+		// A month that has not started yet has no usage
+		return statement, nil
+	}
+
+	days, err := s.meteringRepo.ListRollups(ctx, tenant.ID, entity.UsagePeriodDay, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daily usage: %w", err)
+	}
+	usageByDay := make(map[time.Time]map[entity.Meter]int64)
+	for _, rollup := range days {
+		day := rollup.PeriodStart.UTC()
+		if usageByDay[day] == nil {
+			usageByDay[day] = make(map[entity.Meter]int64, len(entity.Meters))
+		}
+		usageByDay[day][rollup.Meter] = rollup.Quantity
+	}
+	for day := start; day.Before(end); day = entity.UsagePeriodDay.Next(day) {
+		usage := usageByDay[day]
+		if usage == nil {
+			usage = make(map[entity.Meter]int64)
+		}
+		statement.Days = append(statement.Days, output.UsageStatementDay{Date: day, Usage: usage})
+	}
+
+	months, err := s.meteringRepo.ListRollups(ctx, tenant.ID, entity.UsagePeriodMonth, start, entity.UsagePeriodMonth.Next(start))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monthly usage: %w", err)
+	}
+	for _, rollup := range months {
+		statement.Totals[rollup.Meter] = rollup.Quantity
+	}
+
+	return statement, nil
+}
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: branch.go
+//
+// Generated by this command:
+//
+//	mockgen -source=branch.go -destination=mock/branch.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	output "github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBranchService is a mock of BranchService interface.
+type MockBranchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBranchServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBranchServiceMockRecorder is the mock recorder for MockBranchService.
+type MockBranchServiceMockRecorder struct {
+	mock *MockBranchService
+}
+
+// NewMockBranchService creates a new mock instance.
+func NewMockBranchService(ctrl *gomock.Controller) *MockBranchService {
+	mock := &MockBranchService{ctrl: ctrl}
+	mock.recorder = &MockBranchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBranchService) EXPECT() *MockBranchServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBranchService) Create(ctx context.Context, arg1 input.CreateBranch) (*entity.Branch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Branch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBranchServiceMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBranchService)(nil).Create), ctx, arg1)
+}
+
+// FindNearest mocks base method.
+func (m *MockBranchService) FindNearest(ctx context.Context, arg1 input.FindNearestBranches) ([]output.NearestBranch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNearest", ctx, arg1)
+	ret0, _ := ret[0].([]output.NearestBranch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNearest indicates an expected call of FindNearest.
+func (mr *MockBranchServiceMockRecorder) FindNearest(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNearest", reflect.TypeOf((*MockBranchService)(nil).FindNearest), ctx, arg1)
+}
+
+// List mocks base method.
+func (m *MockBranchService) List(ctx context.Context, arg1 input.ListBranches) (entity.Branches, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, arg1)
+	ret0, _ := ret[0].(entity.Branches)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockBranchServiceMockRecorder) List(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBranchService)(nil).List), ctx, arg1)
+}
+
+// Update mocks base method.
+func (m *MockBranchService) Update(ctx context.Context, arg1 input.UpdateBranch) (*entity.Branch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Branch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBranchServiceMockRecorder) Update(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBranchService)(nil).Update), ctx, arg1)
+}
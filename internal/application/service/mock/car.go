@@ -43,6 +43,21 @@ func (m *MockCarService) EXPECT() *MockCarServiceMockRecorder {
 	return m.recorder
 }
 
+// AssignBranches mocks base method.
+func (m *MockCarService) AssignBranches(ctx context.Context, arg1 input.AssignCarBranches) (*entity.Car, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignBranches", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignBranches indicates an expected call of AssignBranches.
+func (mr *MockCarServiceMockRecorder) AssignBranches(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignBranches", reflect.TypeOf((*MockCarService)(nil).AssignBranches), ctx, arg1)
+}
+
 // Create mocks base method.
 func (m *MockCarService) Create(ctx context.Context, arg1 input.CreateCar) (*entity.Car, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: car_model.go
+//
+// Generated by this command:
+//
+//	mockgen -source=car_model.go -destination=mock/car_model.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCarModelService is a mock of CarModelService interface.
+type MockCarModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCarModelServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCarModelServiceMockRecorder is the mock recorder for MockCarModelService.
+type MockCarModelServiceMockRecorder struct {
+	mock *MockCarModelService
+}
+
+// NewMockCarModelService creates a new mock instance.
+func NewMockCarModelService(ctrl *gomock.Controller) *MockCarModelService {
+	mock := &MockCarModelService{ctrl: ctrl}
+	mock.recorder = &MockCarModelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCarModelService) EXPECT() *MockCarModelServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCarModelService) Create(ctx context.Context, arg1 input.CreateCarModel) (*entity.CarModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(*entity.CarModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCarModelServiceMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCarModelService)(nil).Create), ctx, arg1)
+}
+
+// List mocks base method.
+func (m *MockCarModelService) List(ctx context.Context, arg1 input.ListCarModels) (entity.CarModels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, arg1)
+	ret0, _ := ret[0].(entity.CarModels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCarModelServiceMockRecorder) List(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCarModelService)(nil).List), ctx, arg1)
+}
+
+// Update mocks base method.
+func (m *MockCarModelService) Update(ctx context.Context, arg1 input.UpdateCarModel) (*entity.CarModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, arg1)
+	ret0, _ := ret[0].(*entity.CarModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCarModelServiceMockRecorder) Update(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCarModelService)(nil).Update), ctx, arg1)
+}
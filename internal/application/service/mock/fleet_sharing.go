@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fleet_sharing.go
+//
+// Generated by this command:
+//
+//	mockgen -source=fleet_sharing.go -destination=mock/fleet_sharing.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFleetSharingService is a mock of FleetSharingService interface.
+type MockFleetSharingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFleetSharingServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFleetSharingServiceMockRecorder is the mock recorder for MockFleetSharingService.
+type MockFleetSharingServiceMockRecorder struct {
+	mock *MockFleetSharingService
+}
+
+// NewMockFleetSharingService creates a new mock instance.
+func NewMockFleetSharingService(ctrl *gomock.Controller) *MockFleetSharingService {
+	mock := &MockFleetSharingService{ctrl: ctrl}
+	mock.recorder = &MockFleetSharingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFleetSharingService) EXPECT() *MockFleetSharingServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFleetSharingService) Create(ctx context.Context, arg1 input.CreateFleetSharingAgreement) (*entity.FleetSharingAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(*entity.FleetSharingAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFleetSharingServiceMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFleetSharingService)(nil).Create), ctx, arg1)
+}
+
+// List mocks base method.
+func (m *MockFleetSharingService) List(ctx context.Context, arg1 input.ListFleetSharingAgreements) (entity.FleetSharingAgreements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, arg1)
+	ret0, _ := ret[0].(entity.FleetSharingAgreements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockFleetSharingServiceMockRecorder) List(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFleetSharingService)(nil).List), ctx, arg1)
+}
+
+// Terminate mocks base method.
+func (m *MockFleetSharingService) Terminate(ctx context.Context, arg1 input.TerminateFleetSharingAgreement) (*entity.FleetSharingAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Terminate", ctx, arg1)
+	ret0, _ := ret[0].(*entity.FleetSharingAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Terminate indicates an expected call of Terminate.
+func (mr *MockFleetSharingServiceMockRecorder) Terminate(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Terminate", reflect.TypeOf((*MockFleetSharingService)(nil).Terminate), ctx, arg1)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: maintenance_window.go
+//
+// Generated by this command:
+//
+//	mockgen -source=maintenance_window.go -destination=mock/maintenance_window.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMaintenanceWindowService is a mock of MaintenanceWindowService interface.
+type MockMaintenanceWindowService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaintenanceWindowServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMaintenanceWindowServiceMockRecorder is the mock recorder for MockMaintenanceWindowService.
+type MockMaintenanceWindowServiceMockRecorder struct {
+	mock *MockMaintenanceWindowService
+}
+
+// NewMockMaintenanceWindowService creates a new mock instance.
+func NewMockMaintenanceWindowService(ctrl *gomock.Controller) *MockMaintenanceWindowService {
+	mock := &MockMaintenanceWindowService{ctrl: ctrl}
+	mock.recorder = &MockMaintenanceWindowServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaintenanceWindowService) EXPECT() *MockMaintenanceWindowServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockMaintenanceWindowService) Cancel(ctx context.Context, arg1 input.CancelMaintenanceWindow) (*entity.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, arg1)
+	ret0, _ := ret[0].(*entity.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockMaintenanceWindowServiceMockRecorder) Cancel(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockMaintenanceWindowService)(nil).Cancel), ctx, arg1)
+}
+
+// Complete mocks base method.
+func (m *MockMaintenanceWindowService) Complete(ctx context.Context, arg1 input.CompleteMaintenanceWindow) (*entity.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, arg1)
+	ret0, _ := ret[0].(*entity.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockMaintenanceWindowServiceMockRecorder) Complete(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockMaintenanceWindowService)(nil).Complete), ctx, arg1)
+}
+
+// List mocks base method.
+func (m *MockMaintenanceWindowService) List(ctx context.Context, arg1 input.ListMaintenanceWindows) (entity.MaintenanceWindows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, arg1)
+	ret0, _ := ret[0].(entity.MaintenanceWindows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockMaintenanceWindowServiceMockRecorder) List(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockMaintenanceWindowService)(nil).List), ctx, arg1)
+}
+
+// Schedule mocks base method.
+func (m *MockMaintenanceWindowService) Schedule(ctx context.Context, arg1 input.ScheduleMaintenanceWindow) (*entity.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, arg1)
+	ret0, _ := ret[0].(*entity.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockMaintenanceWindowServiceMockRecorder) Schedule(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockMaintenanceWindowService)(nil).Schedule), ctx, arg1)
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: metering.go
+//
+// Generated by this command:
+//
+//	mockgen -source=metering.go -destination=mock/metering.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	output "github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMeteringService is a mock of MeteringService interface.
+type MockMeteringService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMeteringServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMeteringServiceMockRecorder is the mock recorder for MockMeteringService.
+type MockMeteringServiceMockRecorder struct {
+	mock *MockMeteringService
+}
+
+// NewMockMeteringService creates a new mock instance.
+func NewMockMeteringService(ctrl *gomock.Controller) *MockMeteringService {
+	mock := &MockMeteringService{ctrl: ctrl}
+	mock.recorder = &MockMeteringServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMeteringService) EXPECT() *MockMeteringServiceMockRecorder {
+	return m.recorder
+}
+
+// GetUsage mocks base method.
+func (m *MockMeteringService) GetUsage(ctx context.Context, arg1 input.GetMeteredUsage) (entity.UsageRollups, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, arg1)
+	ret0, _ := ret[0].(entity.UsageRollups)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockMeteringServiceMockRecorder) GetUsage(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockMeteringService)(nil).GetUsage), ctx, arg1)
+}
+
+// Statement mocks base method.
+func (m *MockMeteringService) Statement(ctx context.Context, arg1 input.GetUsageStatement) (*output.UsageStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Statement", ctx, arg1)
+	ret0, _ := ret[0].(*output.UsageStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Statement indicates an expected call of Statement.
+func (mr *MockMeteringServiceMockRecorder) Statement(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Statement", reflect.TypeOf((*MockMeteringService)(nil).Statement), ctx, arg1)
+}
@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rental.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rental.go -destination=mock/rental.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	output "github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRentalService is a mock of RentalService interface.
+type MockRentalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRentalServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRentalServiceMockRecorder is the mock recorder for MockRentalService.
+type MockRentalServiceMockRecorder struct {
+	mock *MockRentalService
+}
+
+// NewMockRentalService creates a new mock instance.
+func NewMockRentalService(ctrl *gomock.Controller) *MockRentalService {
+	mock := &MockRentalService{ctrl: ctrl}
+	mock.recorder = &MockRentalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRentalService) EXPECT() *MockRentalServiceMockRecorder {
+	return m.recorder
+}
+
+// Book mocks base method.
+func (m *MockRentalService) Book(ctx context.Context, arg1 input.BookRental) (*entity.Rental, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Book", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Rental)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Book indicates an expected call of Book.
+func (mr *MockRentalServiceMockRecorder) Book(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Book", reflect.TypeOf((*MockRentalService)(nil).Book), ctx, arg1)
+}
+
+// List mocks base method.
+func (m *MockRentalService) List(ctx context.Context, arg1 input.ListRentals) (*output.ListRentals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, arg1)
+	ret0, _ := ret[0].(*output.ListRentals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRentalServiceMockRecorder) List(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRentalService)(nil).List), ctx, arg1)
+}
+
+// ListHandovers mocks base method.
+func (m *MockRentalService) ListHandovers(ctx context.Context, arg1 input.ListRentalHandovers) (entity.RentalHandovers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHandovers", ctx, arg1)
+	ret0, _ := ret[0].(entity.RentalHandovers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHandovers indicates an expected call of ListHandovers.
+func (mr *MockRentalServiceMockRecorder) ListHandovers(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHandovers", reflect.TypeOf((*MockRentalService)(nil).ListHandovers), ctx, arg1)
+}
+
+// PickUp mocks base method.
+func (m *MockRentalService) PickUp(ctx context.Context, arg1 input.PickUpRental) (*entity.RentalHandover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickUp", ctx, arg1)
+	ret0, _ := ret[0].(*entity.RentalHandover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickUp indicates an expected call of PickUp.
+func (mr *MockRentalServiceMockRecorder) PickUp(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickUp", reflect.TypeOf((*MockRentalService)(nil).PickUp), ctx, arg1)
+}
+
+// Return mocks base method.
+func (m *MockRentalService) Return(ctx context.Context, arg1 input.ReturnRental) (*output.ReturnRental, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Return", ctx, arg1)
+	ret0, _ := ret[0].(*output.ReturnRental)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Return indicates an expected call of Return.
+func (mr *MockRentalServiceMockRecorder) Return(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Return", reflect.TypeOf((*MockRentalService)(nil).Return), ctx, arg1)
+}
+
+// SearchAvailableCars mocks base method.
+func (m *MockRentalService) SearchAvailableCars(ctx context.Context, arg1 input.SearchAvailableCars) ([]output.AvailableCar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAvailableCars", ctx, arg1)
+	ret0, _ := ret[0].([]output.AvailableCar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAvailableCars indicates an expected call of SearchAvailableCars.
+func (mr *MockRentalServiceMockRecorder) SearchAvailableCars(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAvailableCars", reflect.TypeOf((*MockRentalService)(nil).SearchAvailableCars), ctx, arg1)
+}
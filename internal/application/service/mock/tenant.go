@@ -0,0 +1,178 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tenant.go -destination=mock/tenant.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantService is a mock of TenantService interface.
+type MockTenantService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantServiceMockRecorder is the mock recorder for MockTenantService.
+type MockTenantServiceMockRecorder struct {
+	mock *MockTenantService
+}
+
+// NewMockTenantService creates a new mock instance.
+func NewMockTenantService(ctrl *gomock.Controller) *MockTenantService {
+	mock := &MockTenantService{ctrl: ctrl}
+	mock.recorder = &MockTenantServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantService) EXPECT() *MockTenantServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelDeletion mocks base method.
+func (m *MockTenantService) CancelDeletion(ctx context.Context, arg1 input.CancelTenantDeletion) (*entity.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDeletion", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelDeletion indicates an expected call of CancelDeletion.
+func (mr *MockTenantServiceMockRecorder) CancelDeletion(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDeletion", reflect.TypeOf((*MockTenantService)(nil).CancelDeletion), ctx, arg1)
+}
+
+// ChangePlan mocks base method.
+func (m *MockTenantService) ChangePlan(ctx context.Context, arg1 input.ChangeTenantPlan) (*entity.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePlan", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePlan indicates an expected call of ChangePlan.
+func (mr *MockTenantServiceMockRecorder) ChangePlan(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePlan", reflect.TypeOf((*MockTenantService)(nil).ChangePlan), ctx, arg1)
+}
+
+// Create mocks base method.
+func (m *MockTenantService) Create(ctx context.Context, arg1 input.CreateTenant) (*entity.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTenantServiceMockRecorder) Create(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTenantService)(nil).Create), ctx, arg1)
+}
+
+// Get mocks base method.
+func (m *MockTenantService) Get(ctx context.Context, arg1 input.GetTenant) (*entity.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTenantServiceMockRecorder) Get(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTenantService)(nil).Get), ctx, arg1)
+}
+
+// ListPlans mocks base method.
+func (m *MockTenantService) ListPlans(ctx context.Context) (entity.Plans, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlans", ctx)
+	ret0, _ := ret[0].(entity.Plans)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlans indicates an expected call of ListPlans.
+func (mr *MockTenantServiceMockRecorder) ListPlans(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlans", reflect.TypeOf((*MockTenantService)(nil).ListPlans), ctx)
+}
+
+// PurgeReport mocks base method.
+func (m *MockTenantService) PurgeReport(ctx context.Context, arg1 input.GetTenantPurgeReport) (entity.TenantRows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeReport", ctx, arg1)
+	ret0, _ := ret[0].(entity.TenantRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeReport indicates an expected call of PurgeReport.
+func (mr *MockTenantServiceMockRecorder) PurgeReport(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeReport", reflect.TypeOf((*MockTenantService)(nil).PurgeReport), ctx, arg1)
+}
+
+// Reactivate mocks base method.
+func (m *MockTenantService) Reactivate(ctx context.Context, arg1 input.ReactivateTenant) (*entity.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactivate", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reactivate indicates an expected call of Reactivate.
+func (mr *MockTenantServiceMockRecorder) Reactivate(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactivate", reflect.TypeOf((*MockTenantService)(nil).Reactivate), ctx, arg1)
+}
+
+// ScheduleDeletion mocks base method.
+func (m *MockTenantService) ScheduleDeletion(ctx context.Context, arg1 input.ScheduleTenantDeletion) (*entity.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleDeletion", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleDeletion indicates an expected call of ScheduleDeletion.
+func (mr *MockTenantServiceMockRecorder) ScheduleDeletion(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDeletion", reflect.TypeOf((*MockTenantService)(nil).ScheduleDeletion), ctx, arg1)
+}
+
+// Suspend mocks base method.
+func (m *MockTenantService) Suspend(ctx context.Context, arg1 input.SuspendTenant) (*entity.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", ctx, arg1)
+	ret0, _ := ret[0].(*entity.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockTenantServiceMockRecorder) Suspend(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockTenantService)(nil).Suspend), ctx, arg1)
+}
@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant_admin.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tenant_admin.go -destination=mock/tenant_admin.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	output "github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantAdminService is a mock of TenantAdminService interface.
+type MockTenantAdminService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantAdminServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantAdminServiceMockRecorder is the mock recorder for MockTenantAdminService.
+type MockTenantAdminServiceMockRecorder struct {
+	mock *MockTenantAdminService
+}
+
+// NewMockTenantAdminService creates a new mock instance.
+func NewMockTenantAdminService(ctrl *gomock.Controller) *MockTenantAdminService {
+	mock := &MockTenantAdminService{ctrl: ctrl}
+	mock.recorder = &MockTenantAdminServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantAdminService) EXPECT() *MockTenantAdminServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockTenantAdminService) CreateAPIKey(ctx context.Context, arg1 input.CreateAPIKey) (*entity.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, arg1)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockTenantAdminServiceMockRecorder) CreateAPIKey(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockTenantAdminService)(nil).CreateAPIKey), ctx, arg1)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockTenantAdminService) ListAPIKeys(ctx context.Context, arg1 input.ListAPIKeys) (*output.ListAPIKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, arg1)
+	ret0, _ := ret[0].(*output.ListAPIKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockTenantAdminServiceMockRecorder) ListAPIKeys(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockTenantAdminService)(nil).ListAPIKeys), ctx, arg1)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockTenantAdminService) RevokeAPIKey(ctx context.Context, arg1 input.RevokeAPIKey) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, arg1)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockTenantAdminServiceMockRecorder) RevokeAPIKey(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockTenantAdminService)(nil).RevokeAPIKey), ctx, arg1)
+}
+
+// RotateAPIKey mocks base method.
+func (m *MockTenantAdminService) RotateAPIKey(ctx context.Context, arg1 input.RotateAPIKey) (*entity.APIKey, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAPIKey", ctx, arg1)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RotateAPIKey indicates an expected call of RotateAPIKey.
+func (mr *MockTenantAdminServiceMockRecorder) RotateAPIKey(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAPIKey", reflect.TypeOf((*MockTenantAdminService)(nil).RotateAPIKey), ctx, arg1)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant_archive.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tenant_archive.go -destination=mock/tenant_archive.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantArchiveService is a mock of TenantArchiveService interface.
+type MockTenantArchiveService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantArchiveServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantArchiveServiceMockRecorder is the mock recorder for MockTenantArchiveService.
+type MockTenantArchiveServiceMockRecorder struct {
+	mock *MockTenantArchiveService
+}
+
+// NewMockTenantArchiveService creates a new mock instance.
+func NewMockTenantArchiveService(ctrl *gomock.Controller) *MockTenantArchiveService {
+	mock := &MockTenantArchiveService{ctrl: ctrl}
+	mock.recorder = &MockTenantArchiveServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantArchiveService) EXPECT() *MockTenantArchiveServiceMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockTenantArchiveService) Export(ctx context.Context, arg1 input.ExportTenant) (*entity.ArchiveJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, arg1)
+	ret0, _ := ret[0].(*entity.ArchiveJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockTenantArchiveServiceMockRecorder) Export(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockTenantArchiveService)(nil).Export), ctx, arg1)
+}
+
+// GetJob mocks base method.
+func (m *MockTenantArchiveService) GetJob(ctx context.Context, arg1 input.GetArchiveJob) (*entity.ArchiveJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, arg1)
+	ret0, _ := ret[0].(*entity.ArchiveJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockTenantArchiveServiceMockRecorder) GetJob(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockTenantArchiveService)(nil).GetJob), ctx, arg1)
+}
+
+// Import mocks base method.
+func (m *MockTenantArchiveService) Import(ctx context.Context, arg1 input.ImportTenant) (*entity.ArchiveJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, arg1)
+	ret0, _ := ret[0].(*entity.ArchiveJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockTenantArchiveServiceMockRecorder) Import(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTenantArchiveService)(nil).Import), ctx, arg1)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant_settings.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tenant_settings.go -destination=mock/tenant_settings.go -package=mock_service
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	input "github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantSettingsService is a mock of TenantSettingsService interface.
+type MockTenantSettingsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantSettingsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantSettingsServiceMockRecorder is the mock recorder for MockTenantSettingsService.
+type MockTenantSettingsServiceMockRecorder struct {
+	mock *MockTenantSettingsService
+}
+
+// NewMockTenantSettingsService creates a new mock instance.
+func NewMockTenantSettingsService(ctrl *gomock.Controller) *MockTenantSettingsService {
+	mock := &MockTenantSettingsService{ctrl: ctrl}
+	mock.recorder = &MockTenantSettingsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantSettingsService) EXPECT() *MockTenantSettingsServiceMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockTenantSettingsService) Get(ctx context.Context, arg1 input.GetTenantSettings) (*entity.TenantSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, arg1)
+	ret0, _ := ret[0].(*entity.TenantSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTenantSettingsServiceMockRecorder) Get(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTenantSettingsService)(nil).Get), ctx, arg1)
+}
+
+// Update mocks base method.
+func (m *MockTenantSettingsService) Update(ctx context.Context, arg1 input.UpdateTenantSettings) (*entity.TenantSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, arg1)
+	ret0, _ := ret[0].(*entity.TenantSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTenantSettingsServiceMockRecorder) Update(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTenantSettingsService)(nil).Update), ctx, arg1)
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// QuotaService defines the interface for enforcing the limits of the plans of tenants
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type QuotaService interface {
+	// WithinQuota runs create in a transaction if the tenant may create one more of resource
+	// under its plan, and returns a *entity.QuotaExceededError otherwise. The tenant stays
+	// locked until the transaction ends, so concurrent creates are checked one at a time.
+	WithinQuota(ctx context.Context, tenantID string, resource entity.Resource, create func(ctx context.Context) error) error
+	Usage(ctx context.Context, input input.GetUsage) (*output.Usage, error)
+}
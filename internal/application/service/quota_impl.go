@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// quotaService implements QuotaService interface
+type quotaService struct {
+	txManager       repository.TransactionManager
+	tenantRepo      repository.TenantRepository
+	planRepo        repository.PlanRepository
+	usageRepo       repository.UsageRepository
+	settingsService TenantSettingsService
+}
+
+// NewQuotaService creates a new quota service
+func NewQuotaService(
+	txManager repository.TransactionManager,
+	tenantRepo repository.TenantRepository,
+	planRepo repository.PlanRepository,
+	usageRepo repository.UsageRepository,
+	settingsService TenantSettingsService,
+) QuotaService {
+	return &quotaService{
+		txManager:       txManager,
+		tenantRepo:      tenantRepo,
+		planRepo:        planRepo,
+		usageRepo:       usageRepo,
+		settingsService: settingsService,
+	}
+}
+
+// WithinQuota checks the limit of resource and runs create in the same transaction. Locking
+// the tenant row makes concurrent creates of a tenant wait for each other, and each of them
+// counts what the previous ones committed.
+func (s *quotaService) WithinQuota(ctx context.Context, tenantID string, resource entity.Resource, create func(ctx context.Context) error) error {
+	return s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		tenant, err := s.tenantRepo.GetByIDForUpdate(ctx, tenantID)
+		if err != nil {
+			return fmt.Errorf("failed to lock tenant: %w", err)
+		}
+
+		if tenant.PlanID.Valid {
+			plan, err := s.planRepo.GetByID(ctx, tenant.PlanID.String)
+			if err != nil {
+				return fmt.Errorf("failed to get plan: %w", err)
+			}
+			// Unlimited resources need no count
+			if plan.Limits.Limit(resource) > 0 {
+				used, err := s.count(ctx, tenantID, resource)
+				if err != nil {
+					return err
+				}
+				if err := plan.Limits.CheckCreate(resource, used); err != nil {
+					return err
+				}
+			}
+		}
+
+		return create(ctx)
+	})
+}
+
+// Usage retrieves the plan of a tenant and how much of each resource it uses
+func (s *quotaService) Usage(ctx context.Context, input input.GetUsage) (*output.Usage, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.tenantRepo.GetByID(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	usage := &output.Usage{}
+	if tenant.PlanID.Valid {
+		usage.Plan, err = s.planRepo.GetByID(ctx, tenant.PlanID.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get plan: %w", err)
+		}
+	}
+
+	usage.PeriodStart, err = s.periodStart(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range entity.Resources {
+		used, err := s.usageRepo.Count(ctx, input.TenantID, resource, usage.PeriodStart)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s: %w", resource, err)
+		}
+		resourceUsage := entity.ResourceUsage{Resource: resource, Used: used}
+		if usage.Plan != nil {
+			resourceUsage.Limit = usage.Plan.Limits.Limit(resource)
+		}
+		usage.Resources = append(usage.Resources, resourceUsage)
+	}
+
+	return usage, nil
+}
+
+// count counts how much of resource a tenant uses in the current period
+func (s *quotaService) count(ctx context.Context, tenantID string, resource entity.Resource) (int, error) {
+	since, err := s.periodStart(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	used, err := s.usageRepo.Count(ctx, tenantID, resource, since)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count %s: %w", resource, err)
+	}
+	return used, nil
+}
+
+// periodStart returns the start of the current month in the tenant's timezone
+func (s *quotaService) periodStart(ctx context.Context, tenantID string) (time.Time, error) {
+	settings, err := s.settingsService.Get(ctx, input.GetTenantSettings{TenantID: tenantID})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get tenant settings: %w", err)
+	}
+	return settings.StartOfMonth(time.Now()), nil
+}
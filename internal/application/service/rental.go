@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// RentalService defines the interface for finding and booking cars, including the cars
+// sibling tenants share under fleet sharing agreements
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type RentalService interface {
+	SearchAvailableCars(ctx context.Context, input input.SearchAvailableCars) ([]output.AvailableCar, error)
+	Book(ctx context.Context, input input.BookRental) (*entity.Rental, error)
+	PickUp(ctx context.Context, input input.PickUpRental) (*entity.RentalHandover, error)
+	Return(ctx context.Context, input input.ReturnRental) (*output.ReturnRental, error)
+	List(ctx context.Context, input input.ListRentals) (*output.ListRentals, error)
+	ListHandovers(ctx context.Context, input input.ListRentalHandovers) (entity.RentalHandovers, error)
+}
@@ -0,0 +1,399 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// defaultRentalPageSize is used when a search or list request does not specify a page size
+const defaultRentalPageSize = 20
+
+// rentalService implements RentalService interface
+type rentalService struct {
+	carRepo         repository.CarRepository
+	rentalRepo      repository.RentalRepository
+	maintenanceRepo repository.MaintenanceWindowRepository
+	handoverRepo    repository.RentalHandoverRepository
+	renterRepo      repository.RenterRepository
+	sharingRepo     repository.FleetSharingRepository
+	branchRepo      repository.BranchRepository
+	txManager       repository.TransactionManager
+	uowFactory      repository.UnitOfWorkFactory
+	quotaService    QuotaService
+	settingsService TenantSettingsService
+}
+
+// NewRentalService creates a new rental service
+func NewRentalService(
+	carRepo repository.CarRepository,
+	rentalRepo repository.RentalRepository,
+	maintenanceRepo repository.MaintenanceWindowRepository,
+	handoverRepo repository.RentalHandoverRepository,
+	renterRepo repository.RenterRepository,
+	sharingRepo repository.FleetSharingRepository,
+	branchRepo repository.BranchRepository,
+	txManager repository.TransactionManager,
+	uowFactory repository.UnitOfWorkFactory,
+	quotaService QuotaService,
+	settingsService TenantSettingsService,
+) RentalService {
+	return &rentalService{
+		carRepo:         carRepo,
+		rentalRepo:      rentalRepo,
+		maintenanceRepo: maintenanceRepo,
+		handoverRepo:    handoverRepo,
+		renterRepo:      renterRepo,
+		sharingRepo:     sharingRepo,
+		branchRepo:      branchRepo,
+		txManager:       txManager,
+		uowFactory:      uowFactory,
+		quotaService:    quotaService,
+		settingsService: settingsService,
+	}
+}
+
+// SearchAvailableCars retrieves the cars free over the period among the tenant's own and
+// the ones shared with it under agreements whose terms allow the period, at a branch if the
+// input names one
+func (s *rentalService) SearchAvailableCars(ctx context.Context, input input.SearchAvailableCars) ([]output.AvailableCar, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	pageSize := int(input.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultRentalPageSize
+	}
+
+	agreements, err := s.sharingRepo.ListActiveByBorrower(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fleet sharing agreements: %w", err)
+	}
+	tenantIDs := []string{input.TenantID}
+	agreementIDs := make(map[string]string, len(agreements))
+	for _, agreement := range agreements {
+		if agreement.CheckRental(input.StartsAt, input.EndsAt) != nil {
+			continue
+		}
+		tenantIDs = append(tenantIDs, agreement.LenderTenantID)
+		agreementIDs[agreement.LenderTenantID] = agreement.ID
+	}
+
+	cars, err := s.carRepo.ListAvailable(ctx, tenantIDs, input.BranchID, input.StartsAt, input.EndsAt, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make([]output.AvailableCar, len(cars))
+	for i, car := range cars {
+		available[i] = output.AvailableCar{Car: car, AgreementID: agreementIDs[car.TenantID]}
+	}
+	return available, nil
+}
+
+// Book books a car for a renter of the tenant within the monthly rental limit of its plan.
+// The car is the tenant's own or shared with it, in which case the rental must fit the
+// terms of the agreement. It is picked up at the branch it is at, and returned there
+// unless the input names another branch of its owner. Bookings of a car are serialized,
+// whichever tenant makes them, with each other and with its maintenance windows, so that
+// the car is never booked twice or while it is out of service.
+func (s *rentalService) Book(ctx context.Context, input input.BookRental) (*entity.Rental, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.tenantSettings(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rental *entity.Rental
+	err = s.quotaService.WithinQuota(ctx, input.TenantID, entity.ResourceMonthlyRentals, func(ctx context.Context) error {
+		car, err := s.findCar(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		if err := s.rentalRepo.LockCar(ctx, car.ID); err != nil {
+			return err
+		}
+		overlap, err := s.rentalRepo.HasOverlap(ctx, car.ID, input.StartsAt, input.EndsAt)
+		if err != nil {
+			return err
+		}
+		if overlap {
+			return entity.ErrCarUnavailable
+		}
+		// Maintenance windows are scheduled under the same lock, so they block like rentals
+		inMaintenance, err := s.maintenanceRepo.HasOverlap(ctx, car.ID, input.StartsAt, input.EndsAt)
+		if err != nil {
+			return err
+		}
+		if inMaintenance {
+			return entity.ErrCarUnavailable
+		}
+
+		renter, err := s.renterRepo.GetByID(ctx, input.RenterID)
+		if err != nil {
+			return fmt.Errorf("failed to get renter: %w", err)
+		}
+		if renter.TenantID != input.TenantID {
+			return fmt.Errorf("renter of another tenant: %w", repository.ErrNotFound)
+		}
+
+		branches, err := s.rentalBranches(ctx, car, input)
+		if err != nil {
+			return err
+		}
+
+		rental, err = entity.NewRental(settings, car, renter.ID, branches, input.StartsAt, input.EndsAt)
+		if err != nil {
+			return err
+		}
+		uow := s.uowFactory.New()
+		uow.RegisterNew(rental)
+		return uow.Commit(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to book rental: %w", err)
+	}
+
+	return rental, nil
+}
+
+// findCar retrieves the car to book among the tenant's own, then among the cars shared
+// with it, checking the rental against the terms of the agreement
+func (s *rentalService) findCar(ctx context.Context, input input.BookRental) (*entity.Car, error) {
+	car, err := s.carRepo.GetByID(ctx, input.TenantID, input.CarID)
+	if err == nil || !errors.Is(err, repository.ErrNotFound) {
+		return car, err
+	}
+
+	agreements, listErr := s.sharingRepo.ListActiveByBorrower(ctx, input.TenantID)
+	if listErr != nil {
+		return nil, fmt.Errorf("failed to list fleet sharing agreements: %w", listErr)
+	}
+	for _, agreement := range agreements {
+		shared, sharedErr := s.carRepo.GetByID(ctx, agreement.LenderTenantID, input.CarID)
+		if errors.Is(sharedErr, repository.ErrNotFound) {
+			continue
+		}
+		if sharedErr != nil {
+			return nil, sharedErr
+		}
+		if err := agreement.CheckRental(input.StartsAt, input.EndsAt); err != nil {
+			return nil, err
+		}
+		return shared, nil
+	}
+	return nil, err
+}
+
+// rentalBranches retrieves the branches of the owner of the car where it is picked up and
+// returned; both are nil for cars without a branch that the input names none for
+func (s *rentalService) rentalBranches(ctx context.Context, car *entity.Car, input input.BookRental) (entity.RentalBranches, error) {
+	var branches entity.RentalBranches
+	pickupID := input.PickupBranchID
+	if pickupID == "" {
+		pickupID = car.CurrentBranchID
+	}
+	if pickupID != "" {
+		pickup, err := s.branchRepo.GetByID(ctx, car.TenantID, pickupID)
+		if err != nil {
+			return branches, fmt.Errorf("failed to get pickup branch: %w", err)
+		}
+		branches.Pickup = pickup
+	}
+	if input.ReturnBranchID != "" {
+		ret, err := s.branchRepo.GetByID(ctx, car.TenantID, input.ReturnBranchID)
+		if err != nil {
+			return branches, fmt.Errorf("failed to get return branch: %w", err)
+		}
+		branches.Return = ret
+	}
+	return branches, nil
+}
+
+// PickUp records that the car of a rental the tenant booked was picked up, with the
+// inspection of its odometer, fuel level and damages that its return is compared with.
+// Pickups are serialized with the bookings and returns of the car, and the rental is read
+// again once the car is locked so that it is picked up once.
+func (s *rentalService) PickUp(ctx context.Context, input input.PickUpRental) (*entity.RentalHandover, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	var handover *entity.RentalHandover
+	err := s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		rental, err := s.lockRental(ctx, input.TenantID, input.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.handoverRepo.GetByRental(ctx, input.TenantID, rental.ID, entity.HandoverKindPickup)
+		if err == nil {
+			return entity.ErrRentalAlreadyPickedUp
+		}
+		if !errors.Is(err, repository.ErrNotFound) {
+			return fmt.Errorf("failed to get pickup handover: %w", err)
+		}
+
+		handover, err = entity.NewPickupHandover(rental, toHandoverReading(input.Inspection), time.Now())
+		if err != nil {
+			return err
+		}
+		uow := s.uowFactory.New()
+		uow.RegisterNew(handover)
+		return uow.Commit(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to pick up rental: %w", err)
+	}
+
+	return handover, nil
+}
+
+// Return records that the car of a rental the tenant booked was brought back, which
+// requires it to have been picked up. The car of a one-way rental is then at the return
+// branch. The inspection of the car, which is required, is compared with the one at pickup
+// for the distance driven and the fuel shortfall. Returns are serialized
+// with the bookings of the car, and the rental is read again once the car is locked so
+// that it is returned once.
+func (s *rentalService) Return(ctx context.Context, input input.ReturnRental) (*output.ReturnRental, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	// Without a return inspection, damage and fuel could not be charged against the pickup
+	if input.Inspection == nil {
+		return nil, entity.ErrReturnNotInspected
+	}
+
+	var result output.ReturnRental
+	err := s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		rental, err := s.lockRental(ctx, input.TenantID, input.ID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := rental.Return(now); err != nil {
+			return err
+		}
+		// A car that was never picked up has no baseline to charge the renter against, so
+		// it cannot be returned
+		pickup, err := s.handoverRepo.GetByRental(ctx, input.TenantID, rental.ID, entity.HandoverKindPickup)
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.ErrRentalNotPickedUp
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get pickup handover: %w", err)
+		}
+		uow := s.uowFactory.New()
+		uow.RegisterDirty(rental)
+		handover, err := entity.NewReturnHandover(rental, pickup, toHandoverReading(*input.Inspection), now)
+		if err != nil {
+			return err
+		}
+		uow.RegisterNew(handover)
+		result.Handover = handover
+		if rental.OneWay() {
+			// Only the owner of a car returns it elsewhere, so the car is the tenant's own
+			car, err := s.carRepo.GetByID(ctx, rental.OwnerTenantID, rental.CarID)
+			if err != nil {
+				return fmt.Errorf("failed to get car: %w", err)
+			}
+			car.MoveTo(rental.ReturnBranchID, now)
+			uow.RegisterDirty(car)
+		}
+		result.Rental = rental
+		return uow.Commit(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to return rental: %w", err)
+	}
+
+	return &result, nil
+}
+
+// lockRental locks the car of a rental the tenant booked, then reads the rental again so
+// that it reflects the pickups and returns committed while waiting for the lock
+func (s *rentalService) lockRental(ctx context.Context, tenantID, id string) (*entity.Rental, error) {
+	booked, err := s.rentalRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.rentalRepo.LockCar(ctx, booked.CarID); err != nil {
+		return nil, err
+	}
+	return s.rentalRepo.GetByID(ctx, tenantID, id)
+}
+
+// toHandoverReading converts a handover inspection of the input to the reading of a handover
+func toHandoverReading(inspection input.HandoverInspection) entity.HandoverReading {
+	damages := make([]entity.Damage, len(inspection.Damages))
+	for i, d := range inspection.Damages {
+		damages[i] = entity.Damage{Area: entity.NewDamageArea(d.Area), Description: d.Description}
+	}
+	return entity.HandoverReading{
+		OdometerKm: inspection.OdometerKm,
+		FuelLevel:  inspection.FuelLevel,
+		Damages:    damages,
+	}
+}
+
+// tenantSettings retrieves the settings of the booking tenant, whose business hours apply
+func (s *rentalService) tenantSettings(ctx context.Context, tenantID string) (*entity.TenantSettings, error) {
+	settings, err := s.settingsService.Get(ctx, input.GetTenantSettings{TenantID: tenantID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant settings: %w", err)
+	}
+	return settings, nil
+}
+
+// List retrieves the rentals the tenant booked or owns the car of, the latest first
+func (s *rentalService) List(ctx context.Context, input input.ListRentals) (*output.ListRentals, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	pageSize, offset, err := parsePage(input.PageSize, input.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.RentalFilter{RenterID: input.RenterID}
+	rentals, total, err := s.rentalRepo.ListByTenant(ctx, input.TenantID, filter, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.ListRentals{
+		Rentals:       rentals,
+		NextPageToken: nextPageToken(len(rentals), pageSize, offset),
+		TotalCount:    total,
+	}, nil
+}
+
+// ListHandovers retrieves the handover inspections of a rental the tenant booked or owns
+// the car of, the pickup first
+func (s *rentalService) ListHandovers(ctx context.Context, input input.ListRentalHandovers) (entity.RentalHandovers, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	return s.handoverRepo.ListByRental(ctx, input.TenantID, input.RentalID)
+}
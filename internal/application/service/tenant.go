@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TenantService defines the interface for managing the lifecycle of tenants
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type TenantService interface {
+	Create(ctx context.Context, input input.CreateTenant) (*entity.Tenant, error)
+	Get(ctx context.Context, input input.GetTenant) (*entity.Tenant, error)
+	Suspend(ctx context.Context, input input.SuspendTenant) (*entity.Tenant, error)
+	Reactivate(ctx context.Context, input input.ReactivateTenant) (*entity.Tenant, error)
+	ChangePlan(ctx context.Context, input input.ChangeTenantPlan) (*entity.Tenant, error)
+	ListPlans(ctx context.Context) (entity.Plans, error)
+	ScheduleDeletion(ctx context.Context, input input.ScheduleTenantDeletion) (*entity.Tenant, error)
+	CancelDeletion(ctx context.Context, input input.CancelTenantDeletion) (*entity.Tenant, error)
+	PurgeReport(ctx context.Context, input input.GetTenantPurgeReport) (entity.TenantRows, error)
+}
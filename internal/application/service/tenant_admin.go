@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TenantAdminService defines the interface for the administration of a tenant's credentials
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type TenantAdminService interface {
+	// CreateAPIKey creates an API key and returns it with the key, which is not stored
+	CreateAPIKey(ctx context.Context, input input.CreateAPIKey) (*entity.APIKey, string, error)
+	ListAPIKeys(ctx context.Context, input input.ListAPIKeys) (*output.ListAPIKeys, error)
+	// RotateAPIKey replaces the key of an API key and returns the new key
+	RotateAPIKey(ctx context.Context, input input.RotateAPIKey) (*entity.APIKey, string, error)
+	RevokeAPIKey(ctx context.Context, input input.RevokeAPIKey) (*entity.APIKey, error)
+}
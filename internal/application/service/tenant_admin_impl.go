@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// tenantAdminService implements TenantAdminService interface
+type tenantAdminService struct {
+	apiKeyRepo repository.APIKeyRepository
+}
+
+// NewTenantAdminService creates a new tenant admin service
+func NewTenantAdminService(apiKeyRepo repository.APIKeyRepository) TenantAdminService {
+	return &tenantAdminService{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+// CreateAPIKey creates an API key with a freshly generated key
+func (s *tenantAdminService) CreateAPIKey(ctx context.Context, input input.CreateAPIKey) (*entity.APIKey, string, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, "", err
+	}
+
+	apiKey, key, err := entity.NewAPIKey(input.TenantID, input.Name, input.Scopes, null.TimeFromPtr(input.ExpiresAt), time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.apiKeyRepo.Create(ctx, apiKey); err != nil {
+		return nil, "", fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	return apiKey, key, nil
+}
+
+// ListAPIKeys retrieves a tenant's API keys, revoked ones included
+func (s *tenantAdminService) ListAPIKeys(ctx context.Context, input input.ListAPIKeys) (*output.ListAPIKeys, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	pageSize, offset, err := parsePage(input.PageSize, input.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := s.apiKeyRepo.ListByTenant(ctx, input.TenantID, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.ListAPIKeys{
+		APIKeys:       apiKeys,
+		NextPageToken: nextPageToken(len(apiKeys), pageSize, offset),
+	}, nil
+}
+
+// RotateAPIKey replaces the key of an API key; the previous key stops working at once
+func (s *tenantAdminService) RotateAPIKey(ctx context.Context, input input.RotateAPIKey) (*entity.APIKey, string, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, "", err
+	}
+
+	apiKey, err := s.apiKeyRepo.GetByID(ctx, input.TenantID, input.ID)
+	if err != nil {
+		return nil, "", err
+	}
+	if apiKey.RevokedAt.Valid {
+		return nil, "", errors.New("revoked API keys cannot be rotated")
+	}
+
+	key, err := apiKey.Rotate(time.Now())
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	if err := s.apiKeyRepo.Update(ctx, apiKey); err != nil {
+		return nil, "", fmt.Errorf("failed to update API key: %w", err)
+	}
+
+	return apiKey, key, nil
+}
+
+// RevokeAPIKey permanently disables an API key
+func (s *tenantAdminService) RevokeAPIKey(ctx context.Context, input input.RevokeAPIKey) (*entity.APIKey, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	apiKey, err := s.apiKeyRepo.GetByID(ctx, input.TenantID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey.Revoke(time.Now())
+
+	if err := s.apiKeyRepo.Update(ctx, apiKey); err != nil {
+		return nil, fmt.Errorf("failed to update API key: %w", err)
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TenantArchiveService defines the interface for exporting tenants to archives and
+// importing them back, as jobs running in the background
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type TenantArchiveService interface {
+	Export(ctx context.Context, input input.ExportTenant) (*entity.ArchiveJob, error)
+	Import(ctx context.Context, input input.ImportTenant) (*entity.ArchiveJob, error)
+	GetJob(ctx context.Context, input input.GetArchiveJob) (*entity.ArchiveJob, error)
+}
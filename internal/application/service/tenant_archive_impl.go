@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"time"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/archive"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// tenantArchiveService implements TenantArchiveService interface
+type tenantArchiveService struct {
+	jobRepo    repository.ArchiveJobRepository
+	tenantRepo repository.TenantRepository
+	exporter   *archive.Exporter
+	importer   *archive.Importer
+	storage    archive.Storage
+}
+
+// NewTenantArchiveService creates a new tenant archive service
+func NewTenantArchiveService(
+	jobRepo repository.ArchiveJobRepository,
+	tenantRepo repository.TenantRepository,
+	exporter *archive.Exporter,
+	importer *archive.Importer,
+	storage archive.Storage,
+) TenantArchiveService {
+	return &tenantArchiveService{
+		jobRepo:    jobRepo,
+		tenantRepo: tenantRepo,
+		exporter:   exporter,
+		importer:   importer,
+		storage:    storage,
+	}
+}
+
+// Export starts exporting a tenant to a new archive, named after the tenant and the job
+func (s *tenantArchiveService) Export(ctx context.Context, input input.ExportTenant) (*entity.ArchiveJob, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	tenant, err := s.tenantRepo.GetByID(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	job := entity.NewArchiveJob(entity.ArchiveJobKindExport, null.StringFrom(tenant.ID), "", time.Now())
+	job.Archive = fmt.Sprintf("%s-%s.ndjson", tenant.Code, job.ID)
+	w, err := s.storage.Create(ctx, job.Archive)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		_ = w.Close()
+		_ = s.storage.Remove(ctx, job.Archive)
+		return nil, fmt.Errorf("failed to create archive job: %w", err)
+	}
+
+	return s.run(ctx, job, func(ctx context.Context) (archive.Summary, error) {
+		summary, err := s.exporter.Export(ctx, tenant.ID, w)
+		if closeErr := w.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("failed to write archive: %w", closeErr)
+		}
+		if err != nil {
+			// Never leave an incomplete archive behind to be imported
+			_ = s.storage.Remove(ctx, job.Archive)
+		}
+		return summary, err
+	}), nil
+}
+
+// Import starts importing the tenant of an archive
+func (s *tenantArchiveService) Import(ctx context.Context, input input.ImportTenant) (*entity.ArchiveJob, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+	if input.Code != "" {
+		if err := entity.ValidateTenantCode(input.Code); err != nil {
+			return nil, err
+		}
+	}
+
+	r, err := s.storage.Open(ctx, input.Archive)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("archive %q: %w", input.Archive, repository.ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	job := entity.NewArchiveJob(entity.ArchiveJobKindImport, null.String{}, input.Archive, time.Now())
+	if err := s.jobRepo.Create(ctx, job); err != nil {
+		_ = r.Close()
+		return nil, fmt.Errorf("failed to create archive job: %w", err)
+	}
+
+	return s.run(ctx, job, func(ctx context.Context) (archive.Summary, error) {
+		defer r.Close()
+		return s.importer.Import(ctx, r, archive.ImportOptions{
+			RemapIDs: input.RemapIDs,
+			Code:     input.Code,
+		})
+	}), nil
+}
+
+// GetJob retrieves an export or import job
+func (s *tenantArchiveService) GetJob(ctx context.Context, input input.GetArchiveJob) (*entity.ArchiveJob, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	return s.jobRepo.GetByID(ctx, input.ID)
+}
+
+// run runs fn in the background, beyond the request that started the job, and records its
+// outcome on the job. It returns a copy of the running job for the caller. A job cut short
+// by a restart stays running.
+func (s *tenantArchiveService) run(ctx context.Context, job *entity.ArchiveJob, fn func(ctx context.Context) (archive.Summary, error)) *entity.ArchiveJob {
+	started := *job
+	ctx = context.WithoutCancel(ctx)
+
+	go func() {
+		summary, err := fn(ctx)
+		if err != nil {
+			log.Printf("Archive job %s failed: %v", job.ID, err)
+			job.Fail(err.Error(), time.Now())
+		} else {
+			counts := make(map[string]int, len(summary.Counts))
+			for kind, count := range summary.Counts {
+				counts[string(kind)] = count
+			}
+			job.Succeed(summary.TenantID, counts, time.Now())
+		}
+
+		if err := s.jobRepo.Update(ctx, job); err != nil {
+			log.Printf("Failed to record the outcome of archive job %s: %v", job.ID, err)
+		}
+	}()
+
+	return &started
+}
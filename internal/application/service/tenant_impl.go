@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// DefaultDeletionGracePeriod is how long a tenant pending deletion is kept by default
+const DefaultDeletionGracePeriod = 30 * 24 * time.Hour
+
+// TenantServiceConfig holds the settings of a tenant service
+type TenantServiceConfig struct {
+	// DeletionGracePeriod is how long a tenant pending deletion is kept before its data is
+	// purged, during which the deletion can be canceled
+	DeletionGracePeriod time.Duration
+}
+
+// tenantService implements TenantService interface
+type tenantService struct {
+	tenantRepo repository.TenantRepository
+	planRepo   repository.PlanRepository
+	dataRepo   repository.TenantDataRepository
+	txManager  repository.TransactionManager
+	uowFactory repository.UnitOfWorkFactory
+	cfg        TenantServiceConfig
+}
+
+// NewTenantService creates a new tenant service. Zero values in cfg are replaced with defaults.
+func NewTenantService(
+	tenantRepo repository.TenantRepository,
+	planRepo repository.PlanRepository,
+	dataRepo repository.TenantDataRepository,
+	txManager repository.TransactionManager,
+	uowFactory repository.UnitOfWorkFactory,
+	cfg TenantServiceConfig,
+) TenantService {
+	if cfg.DeletionGracePeriod <= 0 {
+		cfg.DeletionGracePeriod = DefaultDeletionGracePeriod
+	}
+
+	return &tenantService{
+		tenantRepo: tenantRepo,
+		planRepo:   planRepo,
+		dataRepo:   dataRepo,
+		txManager:  txManager,
+		uowFactory: uowFactory,
+		cfg:        cfg,
+	}
+}
+
+// Create creates a new active tenant, on a plan and under a franchise parent if they are
+// given. The tenant and its events are committed atomically through a unit of work.
+func (s *tenantService) Create(ctx context.Context, input input.CreateTenant) (*entity.Tenant, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+	if err := entity.ValidateTenantCode(input.Code); err != nil {
+		return nil, err
+	}
+
+	// Check the code up front for a clear error; the unique index still guards against races
+	_, err := s.tenantRepo.GetByCode(ctx, input.Code)
+	if err == nil {
+		return nil, fmt.Errorf("tenant code %q is taken: %w", input.Code, repository.ErrAlreadyExists)
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+	tenant := entity.NewTenant(input.Code, now)
+	if input.Isolation != "" {
+		tenant.Isolation = entity.NewTenantIsolation(input.Isolation)
+	}
+	if input.PlanCode != "" {
+		plan, err := s.planRepo.GetByCode(ctx, input.PlanCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get plan %q: %w", input.PlanCode, err)
+		}
+		tenant.ChangePlan(plan.ID, now)
+	}
+	if input.ParentID != "" {
+		parent, err := s.tenantRepo.GetByID(ctx, input.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent tenant: %w", err)
+		}
+		if err := tenant.JoinFranchise(parent); err != nil {
+			return nil, err
+		}
+	}
+
+	uow := s.uowFactory.New()
+	uow.RegisterNew(tenant)
+	if err := uow.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to create tenant: %w", err)
+	}
+
+	return tenant, nil
+}
+
+// Get retrieves a tenant by its ID or by its code
+func (s *tenantService) Get(ctx context.Context, input input.GetTenant) (*entity.Tenant, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	if input.ID != "" {
+		return s.tenantRepo.GetByID(ctx, input.ID)
+	}
+	return s.tenantRepo.GetByCode(ctx, input.Code)
+}
+
+// Suspend suspends a tenant, blocking it from changing its data
+func (s *tenantService) Suspend(ctx context.Context, input input.SuspendTenant) (*entity.Tenant, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	return s.change(ctx, input.ID, (*entity.Tenant).Suspend)
+}
+
+// Reactivate lifts the suspension of a tenant
+func (s *tenantService) Reactivate(ctx context.Context, input input.ReactivateTenant) (*entity.Tenant, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	return s.change(ctx, input.ID, (*entity.Tenant).Reactivate)
+}
+
+// ChangePlan moves a tenant to another plan of the catalog
+func (s *tenantService) ChangePlan(ctx context.Context, input input.ChangeTenantPlan) (*entity.Tenant, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	plan, err := s.planRepo.GetByCode(ctx, input.PlanCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan %q: %w", input.PlanCode, err)
+	}
+
+	return s.change(ctx, input.ID, func(tenant *entity.Tenant, now time.Time) error {
+		tenant.ChangePlan(plan.ID, now)
+		return nil
+	})
+}
+
+// ListPlans retrieves the plan catalog
+func (s *tenantService) ListPlans(ctx context.Context) (entity.Plans, error) {
+	return s.planRepo.List(ctx)
+}
+
+// ScheduleDeletion blocks a tenant from changing its data and schedules its data to be
+// purged once the grace period is over
+func (s *tenantService) ScheduleDeletion(ctx context.Context, input input.ScheduleTenantDeletion) (*entity.Tenant, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	return s.change(ctx, input.ID, func(tenant *entity.Tenant, now time.Time) error {
+		return tenant.ScheduleDeletion(s.cfg.DeletionGracePeriod, now)
+	})
+}
+
+// CancelDeletion keeps a tenant pending deletion, as long as its grace period is not over
+func (s *tenantService) CancelDeletion(ctx context.Context, input input.CancelTenantDeletion) (*entity.Tenant, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	return s.change(ctx, input.ID, (*entity.Tenant).CancelDeletion)
+}
+
+// PurgeReport counts the rows a purge of a tenant would delete, without deleting any
+func (s *tenantService) PurgeReport(ctx context.Context, input input.GetTenantPurgeReport) (entity.TenantRows, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.tenantRepo.GetByID(ctx, input.ID); err != nil {
+		return nil, err
+	}
+	return s.dataRepo.Count(tenantctx.WithTenantID(ctx, input.ID), input.ID)
+}
+
+// change applies a lifecycle change to a tenant and commits it with its event. The read
+// and the write share a repeatable read transaction, so that concurrent changes of the
+// same tenant are retried against its new state instead of both recording an event.
+func (s *tenantService) change(ctx context.Context, id string, apply func(*entity.Tenant, time.Time) error) (*entity.Tenant, error) {
+	var tenant *entity.Tenant
+	err := s.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		var err error
+		tenant, err = s.tenantRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := apply(tenant, time.Now()); err != nil {
+			return err
+		}
+
+		uow := s.uowFactory.New()
+		uow.RegisterDirty(tenant)
+		return uow.Commit(ctx)
+	}, repository.TxOptions{Isolation: repository.IsolationRepeatableRead})
+	if err != nil {
+		return nil, err
+	}
+
+	return tenant, nil
+}
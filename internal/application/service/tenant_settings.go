@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TenantSettingsService defines the interface for the settings of a tenant
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type TenantSettingsService interface {
+	// Get returns the settings of a tenant, or the defaults if it never changed them.
+	// Within a context prepared by WithTenantSettingsCache, they are loaded once per tenant.
+	Get(ctx context.Context, input input.GetTenantSettings) (*entity.TenantSettings, error)
+	Update(ctx context.Context, input input.UpdateTenantSettings) (*entity.TenantSettings, error)
+}
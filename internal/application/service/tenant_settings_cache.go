@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+type tenantSettingsCacheKey struct{}
+
+// tenantSettingsCache holds the settings loaded during one request
+type tenantSettingsCache struct {
+	mu       sync.Mutex
+	settings map[string]*entity.TenantSettings
+}
+
+// WithTenantSettingsCache returns a copy of ctx in which TenantSettingsService loads the
+// settings of each tenant at most once. It is meant to wrap a single request, so that
+// settings changed by other requests are seen by the next one.
+func WithTenantSettingsCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, tenantSettingsCacheKey{}, &tenantSettingsCache{
+		settings: make(map[string]*entity.TenantSettings),
+	})
+}
+
+// tenantSettingsCacheFrom returns the cache of ctx, or nil if it has none
+func tenantSettingsCacheFrom(ctx context.Context) *tenantSettingsCache {
+	cache, _ := ctx.Value(tenantSettingsCacheKey{}).(*tenantSettingsCache)
+	return cache
+}
+
+// get returns the cached settings of a tenant. A nil cache holds nothing.
+func (c *tenantSettingsCache) get(tenantID string) (*entity.TenantSettings, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	settings, ok := c.settings[tenantID]
+	return settings, ok
+}
+
+// put caches the settings of a tenant. A nil cache ignores them.
+func (c *tenantSettingsCache) put(settings *entity.TenantSettings) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.settings[settings.TenantID] = settings
+}
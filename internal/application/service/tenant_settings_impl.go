@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// tenantSettingsService implements TenantSettingsService interface
+type tenantSettingsService struct {
+	settingsRepo repository.TenantSettingsRepository
+}
+
+// NewTenantSettingsService creates a new tenant settings service
+func NewTenantSettingsService(settingsRepo repository.TenantSettingsRepository) TenantSettingsService {
+	return &tenantSettingsService{
+		settingsRepo: settingsRepo,
+	}
+}
+
+// Get retrieves the settings of a tenant, falling back to the defaults
+func (s *tenantSettingsService) Get(ctx context.Context, input input.GetTenantSettings) (*entity.TenantSettings, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	cache := tenantSettingsCacheFrom(ctx)
+	if settings, ok := cache.get(input.TenantID); ok {
+		return settings, nil
+	}
+
+	settings, _, err := s.load(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.put(settings)
+	return settings, nil
+}
+
+// Update replaces the settings of a tenant
+func (s *tenantSettingsService) Update(ctx context.Context, input input.UpdateTenantSettings) (*entity.TenantSettings, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+	hours, err := toBusinessHours(input.BusinessHours)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", entity.ErrInvalidTenantSettings, err)
+	}
+
+	settings, stored, err := s.load(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := settings.Update(input.Timezone, input.Currency, input.Locale, hours, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if stored {
+		err = s.settingsRepo.Update(ctx, settings)
+	} else {
+		err = s.settingsRepo.Create(ctx, settings)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save tenant settings: %w", err)
+	}
+
+	// Later reads within the request must see the new settings
+	tenantSettingsCacheFrom(ctx).put(settings)
+	return settings, nil
+}
+
+// load reads the stored settings of a tenant, or returns the defaults with stored false
+func (s *tenantSettingsService) load(ctx context.Context, tenantID string) (settings *entity.TenantSettings, stored bool, err error) {
+	settings, err = s.settingsRepo.Get(ctx, tenantID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return entity.DefaultTenantSettings(tenantID, time.Now()), false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return settings, true, nil
+}
+
+// toBusinessHours parses the opening hours of the input
+func toBusinessHours(periods []input.OpeningHours) (entity.BusinessHours, error) {
+	hours := make(entity.BusinessHours, len(periods))
+	for i, period := range periods {
+		opens, err := entity.ParseTimeOfDay(period.Opens)
+		if err != nil {
+			return nil, err
+		}
+		closes, err := entity.ParseTimeOfDay(period.Closes)
+		if err != nil {
+			return nil, err
+		}
+		hours[i] = entity.OpeningHours{Weekday: period.Weekday, Opens: opens, Closes: closes}
+	}
+	return hours, nil
+}
@@ -0,0 +1,124 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+)
+
+// setupBranchTest creates a mock repository and a branch service
+func setupBranchTest(t *testing.T) (*mock_repository.MockBranchRepository, service.BranchService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockBranchRepo := mock_repository.NewMockBranchRepository(ctrl)
+	return mockBranchRepo, service.NewBranchService(mockBranchRepo)
+}
+
+// shinjuku returns the input attributes of a branch in Shinjuku, open on Saturdays
+func shinjuku() input.BranchSpec {
+	return input.BranchSpec{
+		Name:         "Shinjuku",
+		Street:       "3-38-1 Shinjuku",
+		City:         "Shinjuku",
+		Region:       "Tokyo",
+		Country:      "jp",
+		Latitude:     35.6896,
+		Longitude:    139.7006,
+		OpeningHours: []input.OpeningHours{{Weekday: time.Saturday, Opens: "10:00", Closes: "16:00"}},
+	}
+}
+
+// TestBranchService_Create tests that branches are added with valid coordinates and opening
+// hours only
+func TestBranchService_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		modify  func(spec *input.BranchSpec)
+		wantErr bool
+	}{
+		"valid":             {},
+		"latitude too far":  {modify: func(spec *input.BranchSpec) { spec.Latitude = 91 }, wantErr: true},
+		"malformed hours":   {modify: func(spec *input.BranchSpec) { spec.OpeningHours[0].Opens = "10am" }, wantErr: true},
+		"closing too early": {modify: func(spec *input.BranchSpec) { spec.OpeningHours[0].Closes = "09:00" }, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			mockBranchRepo, branchService := setupBranchTest(t)
+			ctx := context.Background()
+			spec := shinjuku()
+			if tt.modify != nil {
+				tt.modify(&spec)
+			}
+
+			// Set up expectations
+			if !tt.wantErr {
+				mockBranchRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			}
+
+			// Execute
+			branch, err := branchService.Create(ctx, input.CreateBranch{TenantID: "tenant-123", Spec: spec})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidBranch)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "tenant-123", branch.TenantID)
+			assert.Equal(t, "JP", branch.Spec.Address.Country)
+			assert.Equal(t, entity.BusinessHours{{Weekday: time.Saturday, Opens: 10 * 60, Closes: 16 * 60}}, branch.Spec.OpeningHours)
+		})
+	}
+}
+
+// TestBranchService_FindNearest tests that branches are returned nearest first, up to the limit
+func TestBranchService_FindNearest(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockBranchRepo, branchService := setupBranchTest(t)
+	ctx := context.Background()
+	at := func(id string, latitude, longitude float64) *entity.Branch {
+		coordinates, err := value.NewCoordinates(latitude, longitude)
+		require.NoError(t, err)
+		return &entity.Branch{ID: id, TenantID: "tenant-123", Spec: entity.BranchSpec{Name: id, Coordinates: coordinates}}
+	}
+
+	// Set up expectations: branches come ordered by name
+	mockBranchRepo.EXPECT().ListByTenant(ctx, "tenant-123").Return(entity.Branches{
+		at("nagoya", 35.1709, 136.8815),
+		at("osaka", 34.7025, 135.4959),
+		at("shinjuku", 35.6896, 139.7006),
+	}, nil).Times(2)
+
+	// Execute: from Tokyo station
+	nearest, err := branchService.FindNearest(ctx, input.FindNearestBranches{TenantID: "tenant-123", Latitude: 35.6812, Longitude: 139.7671})
+	require.NoError(t, err)
+	require.Len(t, nearest, 3)
+	assert.Equal(t, "shinjuku", nearest[0].Branch.ID)
+	assert.Equal(t, "nagoya", nearest[1].Branch.ID)
+	assert.Equal(t, "osaka", nearest[2].Branch.ID)
+	assert.InDelta(t, 6, nearest[0].DistanceKm, 1)
+
+	nearest, err = branchService.FindNearest(ctx, input.FindNearestBranches{TenantID: "tenant-123", Latitude: 34.7, Longitude: 135.5, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, nearest, 1)
+	assert.Equal(t, "osaka", nearest[0].Branch.ID)
+
+	// A position off the globe is rejected before the repository is called
+	_, err = branchService.FindNearest(ctx, input.FindNearestBranches{TenantID: "tenant-123", Latitude: 0, Longitude: 181})
+	assert.ErrorIs(t, err, value.ErrInvalidCoordinates)
+}
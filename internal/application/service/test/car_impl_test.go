@@ -2,27 +2,53 @@ package service_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
 	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	mock_service "github.com/jp-ryuji/go-arch-patterns/internal/application/service/mock"
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
 	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
-	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
 
 // setupTest creates a new mock controller and car service for testing
-func setupTest(t *testing.T) (*gomock.Controller, *mock_repository.MockCarRepository, *mock_repository.MockOutboxRepository, *mock_repository.MockTransactionManager, service.CarService) {
+func setupTest(t *testing.T) (*gomock.Controller, *mock_repository.MockCarRepository, *mock_repository.MockCarModelRepository, *mock_repository.MockUnitOfWorkFactory, service.CarService) {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	mockCarRepo := mock_repository.NewMockCarRepository(ctrl)
-	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
-	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
-	carService := service.NewCarService(mockCarRepo, mockOutboxRepo, mockTxManager)
-	return ctrl, mockCarRepo, mockOutboxRepo, mockTxManager, carService
+	mockCarModelRepo := mock_repository.NewMockCarModelRepository(ctrl)
+	mockUowFactory := mock_repository.NewMockUnitOfWorkFactory(ctrl)
+	// Quotas are tested with the quota service; here every create is within them
+	mockQuotaService := mock_service.NewMockQuotaService(ctrl)
+	mockQuotaService.EXPECT().WithinQuota(gomock.Any(), gomock.Any(), entity.ResourceCars, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ string, _ entity.Resource, create func(ctx context.Context) error) error {
+			return create(ctx)
+		},
+	).AnyTimes()
+	// Branches are tested with TestCarService_AssignBranches; here cars have none
+	carService := service.NewCarService(mockCarRepo, mockCarModelRepo, mock_repository.NewMockBranchRepository(ctrl), mockUowFactory, mockQuotaService)
+	return ctrl, mockCarRepo, mockCarModelRepo, mockUowFactory, carService
+}
+
+// testCarModel returns a model in the catalog of tenant-123
+func testCarModel(t *testing.T) *entity.CarModel {
+	t.Helper()
+	model, err := entity.NewCarModel("tenant-123", entity.CarModelSpec{
+		Make:         "Toyota",
+		Name:         "Prius",
+		Category:     entity.CarCategoryCompact,
+		Seats:        5,
+		Transmission: entity.TransmissionAutomatic,
+		FuelType:     entity.FuelTypeHybrid,
+	}, time.Now())
+	assert.NoError(t, err)
+	return model.WithID("model-1")
 }
 
 // TestCarService_Create_Success tests the successful creation of a car
@@ -30,42 +56,57 @@ func TestCarService_Create_Success(t *testing.T) {
 	t.Parallel()
 
 	// Setup
-	ctrl, mockCarRepo, mockOutboxRepo, mockTxManager, carService := setupTest(t)
+	ctrl, _, mockCarModelRepo, mockUowFactory, carService := setupTest(t)
 	defer ctrl.Finish()
 
 	// Test data
 	ctx := context.Background()
+	model := testCarModel(t)
 	registerInput := input.CreateCar{
-		TenantID: "tenant-123",
-		Model:    "Toyota Prius",
+		TenantID:            "tenant-123",
+		CarModelID:          "model-1",
+		VIN:                 "1hgcm82633a004352",
+		LicensePlate:        "abc-1234",
+		LicensePlateCountry: "us",
 	}
 
-	// Create a mock transaction
-	mockTx := &entgen.Tx{}
-
-	// Set up expectations for transaction management
-	mockTxManager.EXPECT().BeginTx(ctx).Return(mockTx, nil)
-	mockTxManager.EXPECT().CommitTx(ctx, mockTx).Return(nil)
+	// Set up expectations for the model lookup and the unit of work
+	mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", "model-1").Return(model, nil)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow)
 
-	// Set up expectations for creating a car
 	var createdCar *entity.Car
-	mockCarRepo.EXPECT().CreateInTx(ctx, mockTx, gomock.Any()).DoAndReturn(
-		func(ctx context.Context, tx *entgen.Tx, car *entity.Car) error {
-			// Capture the car that was created for later verification
+	mockUow.EXPECT().RegisterNew(gomock.Any()).Do(
+		func(aggregate entity.Aggregate) {
+			// Capture the car that was registered for later verification
+			car, ok := aggregate.(*entity.Car)
+			assert.True(t, ok)
 			createdCar = car
 
 			// Verify that the car has the correct properties (similar to car_test.go)
 			assert.Equal(t, registerInput.TenantID, car.TenantID)
-			assert.Equal(t, registerInput.Model, car.Model)
+			assert.Equal(t, registerInput.CarModelID, car.ModelID)
+			assert.Equal(t, "1HGCM82633A004352", car.VINString())
+			assert.Equal(t, "ABC 1234", car.LicensePlateString())
+			assert.Equal(t, "US", car.LicensePlateCountry())
 			assert.NotEmpty(t, car.ID)
 			assert.WithinDuration(t, time.Now(), car.CreatedAt, time.Second)
 			assert.WithinDuration(t, time.Now(), car.UpdatedAt, time.Second)
-			return nil
+
+			// Verify that the car recorded its creation event
+			assert.Equal(t, []entity.DomainEvent{entity.CarCreated{
+				ID:                  car.ID,
+				TenantID:            car.TenantID,
+				ModelID:             car.ModelID,
+				VIN:                 "1HGCM82633A004352",
+				LicensePlate:        "ABC 1234",
+				LicensePlateCountry: "US",
+				CreatedAt:           car.CreatedAt,
+				UpdatedAt:           car.UpdatedAt,
+			}}, car.Events())
 		},
 	)
-
-	// Set up expectations for outbox message creation
-	mockOutboxRepo.EXPECT().CreateInTx(ctx, mockTx, gomock.Any()).Return(nil)
+	mockUow.EXPECT().Commit(ctx).Return(nil)
 
 	// Execute - Create a new car using the service
 	createdCarOutput, err := carService.Create(ctx, registerInput)
@@ -74,41 +115,102 @@ func TestCarService_Create_Success(t *testing.T) {
 
 	// Verify the returned car DTO
 	assert.Equal(t, registerInput.TenantID, createdCarOutput.TenantID)
-	assert.Equal(t, registerInput.Model, createdCarOutput.Model)
+	assert.Equal(t, registerInput.CarModelID, createdCarOutput.ModelID)
+	assert.Equal(t, model, createdCarOutput.Model())
 	assert.NotEmpty(t, createdCarOutput.ID)
 	assert.NotZero(t, createdCarOutput.CreatedAt)
 
-	// Verify that the car sent to the repository matches what was used to create the DTO
+	// Verify that the registered car matches what was used to create the DTO
 	assert.Equal(t, createdCarOutput.ID, createdCar.ID)
 	assert.Equal(t, createdCarOutput.TenantID, createdCar.TenantID)
-	assert.Equal(t, createdCarOutput.Model, createdCar.Model)
+	assert.Equal(t, createdCarOutput.ModelID, createdCar.ModelID)
 }
 
-// TestCarService_Create_RepositoryError tests creation when repository returns an error
+// TestCarService_Create_Rejected tests that no car is created of an unknown model or with
+// an invalid VIN or license plate
+func TestCarService_Create_Rejected(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input    input.CreateCar
+		modelErr error
+		wantErr  error
+	}{
+		"unknown model": {
+			input:    input.CreateCar{TenantID: "tenant-123", CarModelID: "model-2"},
+			modelErr: repository.ErrNotFound,
+			wantErr:  repository.ErrNotFound,
+		},
+		"invalid VIN": {
+			input:   input.CreateCar{TenantID: "tenant-123", CarModelID: "model-1", VIN: "1HGCM82633A00435I"},
+			wantErr: value.ErrInvalidVIN,
+		},
+		"VIN with a wrong check digit": {
+			input:   input.CreateCar{TenantID: "tenant-123", CarModelID: "model-1", VIN: "1HGCM82643A004352"},
+			wantErr: value.ErrInvalidVIN,
+		},
+		"invalid license plate": {
+			input:   input.CreateCar{TenantID: "tenant-123", CarModelID: "model-1", LicensePlate: "ABC_1234", LicensePlateCountry: "US"},
+			wantErr: value.ErrInvalidLicensePlate,
+		},
+		"license plate of another country's format": {
+			input:   input.CreateCar{TenantID: "tenant-123", CarModelID: "model-1", LicensePlate: "ABC-1234", LicensePlateCountry: "FR"},
+			wantErr: value.ErrInvalidLicensePlate,
+		},
+		"license plate without a country": {
+			input:   input.CreateCar{TenantID: "tenant-123", CarModelID: "model-1", LicensePlate: "ABC-1234"},
+			wantErr: value.ErrInvalidLicensePlate,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctrl, _, mockCarModelRepo, _, carService := setupTest(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			// Set up expectations; the unit of work is never created
+			if tt.modelErr != nil {
+				mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", tt.input.CarModelID).Return(nil, tt.modelErr)
+			} else {
+				mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", tt.input.CarModelID).Return(testCarModel(t), nil)
+			}
+
+			// Execute
+			car, err := carService.Create(ctx, tt.input)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, car)
+		})
+	}
+}
+
+// TestCarService_Create_RepositoryError tests creation when committing the unit of work fails
 func TestCarService_Create_RepositoryError(t *testing.T) {
 	t.Parallel()
 
 	// Setup
-	ctrl, mockCarRepo, _, mockTxManager, carService := setupTest(t)
+	ctrl, _, mockCarModelRepo, mockUowFactory, carService := setupTest(t)
 	defer ctrl.Finish()
 
 	// Test data
 	ctx := context.Background()
 	registerInput := input.CreateCar{
-		TenantID: "tenant-123",
-		Model:    "Toyota Prius",
+		TenantID:   "tenant-123",
+		CarModelID: "model-1",
 	}
 
-	// Create a mock transaction
-	mockTx := &entgen.Tx{}
-
-	// Set up expectations for transaction management
-	mockTxManager.EXPECT().BeginTx(ctx).Return(mockTx, nil)
-	mockTxManager.EXPECT().RollbackTx(ctx, mockTx).Return(nil)
+	// Set up expectations for the model lookup and the unit of work
+	mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", "model-1").Return(testCarModel(t), nil)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterNew(gomock.Any())
 
 	// Set up expectations for repository error
 	expectedError := assert.AnError
-	mockCarRepo.EXPECT().CreateInTx(ctx, mockTx, gomock.Any()).Return(expectedError)
+	mockUow.EXPECT().Commit(ctx).Return(expectedError)
 
 	// Execute - Try to create a car when repository fails
 	createdCar, err := carService.Create(ctx, registerInput)
@@ -117,6 +219,168 @@ func TestCarService_Create_RepositoryError(t *testing.T) {
 	assert.Nil(t, createdCar)
 }
 
+// TestCarService_Create_Duplicate tests that a VIN or license plate already used in the tenant
+// fails as a duplicate car
+func TestCarService_Create_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl, _, mockCarModelRepo, mockUowFactory, carService := setupTest(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	// Set up expectations; the repository rejects the plate as taken
+	mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", "model-1").Return(testCarModel(t), nil)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterNew(gomock.Any())
+	mockUow.EXPECT().Commit(ctx).Return(fmt.Errorf("failed to persist car: %w", repository.ErrAlreadyExists))
+
+	// Execute
+	car, err := carService.Create(ctx, input.CreateCar{
+		TenantID:            "tenant-123",
+		CarModelID:          "model-1",
+		LicensePlate:        "ABC-1234",
+		LicensePlateCountry: "US",
+	})
+	assert.ErrorIs(t, err, entity.ErrDuplicateCar)
+	assert.ErrorIs(t, err, repository.ErrAlreadyExists)
+	assert.Nil(t, car)
+}
+
+// TestCarService_Create_QuotaExceeded tests that no car is created over the limit of the tenant's plan
+func TestCarService_Create_QuotaExceeded(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockUowFactory := mock_repository.NewMockUnitOfWorkFactory(ctrl)
+	mockCarModelRepo := mock_repository.NewMockCarModelRepository(ctrl)
+	mockQuotaService := mock_service.NewMockQuotaService(ctrl)
+	carService := service.NewCarService(mock_repository.NewMockCarRepository(ctrl), mockCarModelRepo, mock_repository.NewMockBranchRepository(ctrl), mockUowFactory, mockQuotaService)
+	ctx := context.Background()
+
+	// Set up expectations; the unit of work is never created
+	mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", "model-1").Return(testCarModel(t), nil)
+	quotaErr := &entity.QuotaExceededError{Resource: entity.ResourceCars, Limit: 10, Used: 10}
+	mockQuotaService.EXPECT().WithinQuota(ctx, "tenant-123", entity.ResourceCars, gomock.Any()).Return(quotaErr)
+
+	// Execute
+	car, err := carService.Create(ctx, input.CreateCar{TenantID: "tenant-123", CarModelID: "model-1"})
+	assert.ErrorIs(t, err, entity.ErrQuotaExceeded)
+	assert.Nil(t, car)
+}
+
+// TestCarService_Create_Retry tests that every attempt of a retried transaction registers a
+// car with its creation event
+func TestCarService_Create_Retry(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockUowFactory := mock_repository.NewMockUnitOfWorkFactory(ctrl)
+	mockCarModelRepo := mock_repository.NewMockCarModelRepository(ctrl)
+	mockQuotaService := mock_service.NewMockQuotaService(ctrl)
+	carService := service.NewCarService(mock_repository.NewMockCarRepository(ctrl), mockCarModelRepo, mock_repository.NewMockBranchRepository(ctrl), mockUowFactory, mockQuotaService)
+	ctx := context.Background()
+
+	// Set up expectations; the first attempt is rolled back and run again
+	mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", "model-1").Return(testCarModel(t), nil)
+	mockQuotaService.EXPECT().WithinQuota(ctx, "tenant-123", entity.ResourceCars, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ string, _ entity.Resource, create func(ctx context.Context) error) error {
+			if err := create(ctx); err != nil {
+				return err
+			}
+			return create(ctx)
+		},
+	)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow).Times(2)
+	var registered []entity.Aggregate
+	mockUow.EXPECT().RegisterNew(gomock.Any()).Do(func(aggregate entity.Aggregate) {
+		assert.Len(t, aggregate.Events(), 1)
+		registered = append(registered, aggregate)
+	}).Times(2)
+	mockUow.EXPECT().Commit(ctx).Return(nil).Times(2)
+
+	// Execute
+	car, err := carService.Create(ctx, input.CreateCar{TenantID: "tenant-123", CarModelID: "model-1"})
+	assert.NoError(t, err)
+	assert.NotSame(t, registered[0], registered[1])
+	assert.Same(t, registered[1], car)
+}
+
+// TestCarService_AssignBranches tests that a car is assigned to branches of its tenant, and
+// is at its home branch unless told otherwise
+func TestCarService_AssignBranches(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input       input.AssignCarBranches
+		wantCurrent string
+		wantErr     error
+	}{
+		"at its home branch": {
+			input:       input.AssignCarBranches{TenantID: "tenant-123", ID: "car-1", HomeBranchID: "tokyo"},
+			wantCurrent: "tokyo",
+		},
+		"away from its home branch": {
+			input:       input.AssignCarBranches{TenantID: "tenant-123", ID: "car-1", HomeBranchID: "tokyo", CurrentBranchID: "osaka"},
+			wantCurrent: "osaka",
+		},
+		"unknown branch": {
+			input:   input.AssignCarBranches{TenantID: "tenant-123", ID: "car-1", HomeBranchID: "tokyo", CurrentBranchID: "nagoya"},
+			wantErr: repository.ErrNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctrl := gomock.NewController(t)
+			mockCarRepo := mock_repository.NewMockCarRepository(ctrl)
+			mockBranchRepo := mock_repository.NewMockBranchRepository(ctrl)
+			mockUowFactory := mock_repository.NewMockUnitOfWorkFactory(ctrl)
+			carService := service.NewCarService(mockCarRepo, mock_repository.NewMockCarModelRepository(ctrl), mockBranchRepo,
+				mockUowFactory, mock_service.NewMockQuotaService(ctrl))
+			ctx := context.Background()
+
+			// Set up expectations; only Tokyo and Osaka are branches of the tenant
+			mockBranchRepo.EXPECT().GetByID(ctx, "tenant-123", gomock.Any()).DoAndReturn(
+				func(_ context.Context, _, id string) (*entity.Branch, error) {
+					if id != "tokyo" && id != "osaka" {
+						return nil, repository.ErrNotFound
+					}
+					return &entity.Branch{ID: id, TenantID: "tenant-123"}, nil
+				},
+			).AnyTimes()
+			if tt.wantErr == nil {
+				car := entity.NewCar("tenant-123", "model-1", "", nil, nil, time.Now()).WithID("car-1")
+				car.ClearEvents()
+				mockCarRepo.EXPECT().GetByID(ctx, "tenant-123", "car-1").Return(car, nil)
+				mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+				mockUowFactory.EXPECT().New().Return(mockUow)
+				mockUow.EXPECT().RegisterDirty(car)
+				mockUow.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			// Execute
+			car, err := carService.AssignBranches(ctx, tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, car)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "tokyo", car.HomeBranchID)
+			assert.Equal(t, tt.wantCurrent, car.CurrentBranchID)
+			assert.Len(t, car.Events(), 1)
+		})
+	}
+}
+
 // TestCarService_GetByID_Success tests successful retrieval of a car by ID
 func TestCarService_GetByID_Success(t *testing.T) {
 	t.Parallel()
@@ -129,15 +393,16 @@ func TestCarService_GetByID_Success(t *testing.T) {
 	ctx := context.Background()
 	carID := "car-123"
 	getInput := input.GetCarByID{
-		ID: carID,
+		TenantID: "tenant-123",
+		ID:       carID,
 	}
 
 	// Create expected car using the factory method (similar to car_test.go)
 	now := time.Now()
-	expectedCar := entity.NewCar("tenant-123", "Toyota Prius", now)
+	expectedCar := entity.NewCar("tenant-123", "model-1", "", nil, nil, now)
 
 	// Set up expectations for retrieving the car
-	mockCarRepo.EXPECT().GetByID(ctx, carID).Return(expectedCar, nil)
+	mockCarRepo.EXPECT().GetByID(ctx, "tenant-123", carID).Return(expectedCar, nil)
 
 	// Execute - Retrieve the car using the service
 	retrievedCar, err := carService.GetByID(ctx, getInput)
@@ -147,7 +412,7 @@ func TestCarService_GetByID_Success(t *testing.T) {
 	// Verify the returned car DTO
 	assert.Equal(t, expectedCar.ID, retrievedCar.ID)
 	assert.Equal(t, expectedCar.TenantID, retrievedCar.TenantID)
-	assert.Equal(t, expectedCar.Model, retrievedCar.Model)
+	assert.Equal(t, expectedCar.ModelID, retrievedCar.ModelID)
 }
 
 // TestCarService_GetByID_NotFound tests retrieval when car doesn't exist
@@ -162,12 +427,13 @@ func TestCarService_GetByID_NotFound(t *testing.T) {
 	ctx := context.Background()
 	carID := "non-existent-car"
 	getInput := input.GetCarByID{
-		ID: carID,
+		TenantID: "tenant-123",
+		ID:       carID,
 	}
 
 	// Set up expectations for not found error
 	expectedError := assert.AnError
-	mockCarRepo.EXPECT().GetByID(ctx, carID).Return(nil, expectedError)
+	mockCarRepo.EXPECT().GetByID(ctx, "tenant-123", carID).Return(nil, expectedError)
 
 	// Execute - Try to retrieve a non-existent car
 	retrievedCar, err := carService.GetByID(ctx, getInput)
@@ -188,19 +454,20 @@ func TestCarService_GetByIDWithTenant_Success(t *testing.T) {
 	ctx := context.Background()
 	carID := "car-123"
 	getInput := input.GetCarByID{
-		ID: carID,
+		TenantID: "tenant-123",
+		ID:       carID,
 	}
 
 	// Create expected car with tenant using the factory method
 	now := time.Now()
-	expectedCar := entity.NewCar("tenant-123", "Toyota Prius", now)
+	expectedCar := entity.NewCar("tenant-123", "model-1", "", nil, nil, now)
 	expectedTenant := entity.NewTenant("tenant-code", now)
 	expectedCar.Refs = &entity.CarRefs{
 		Tenant: expectedTenant,
 	}
 
 	// Set up expectations for retrieving the car with tenant
-	mockCarRepo.EXPECT().GetByIDWithTenant(ctx, carID).Return(expectedCar, nil)
+	mockCarRepo.EXPECT().GetByIDWithTenant(ctx, "tenant-123", carID).Return(expectedCar, nil)
 
 	// Execute - Retrieve the car with tenant using the service
 	retrievedCar, err := carService.GetByIDWithTenant(ctx, getInput)
@@ -210,7 +477,7 @@ func TestCarService_GetByIDWithTenant_Success(t *testing.T) {
 	// Verify the returned car DTO
 	assert.Equal(t, expectedCar.ID, retrievedCar.ID)
 	assert.Equal(t, expectedCar.TenantID, retrievedCar.TenantID)
-	assert.Equal(t, expectedCar.Model, retrievedCar.Model)
+	assert.Equal(t, expectedCar.ModelID, retrievedCar.ModelID)
 }
 
 // TestCarService_GetByIDWithTenant_NotFound tests retrieval when car with tenant doesn't exist
@@ -225,12 +492,13 @@ func TestCarService_GetByIDWithTenant_NotFound(t *testing.T) {
 	ctx := context.Background()
 	carID := "non-existent-car"
 	getInput := input.GetCarByID{
-		ID: carID,
+		TenantID: "tenant-123",
+		ID:       carID,
 	}
 
 	// Set up expectations for not found error
 	expectedError := assert.AnError
-	mockCarRepo.EXPECT().GetByIDWithTenant(ctx, carID).Return(nil, expectedError)
+	mockCarRepo.EXPECT().GetByIDWithTenant(ctx, "tenant-123", carID).Return(nil, expectedError)
 
 	// Execute - Try to retrieve a non-existent car with tenant
 	retrievedCar, err := carService.GetByIDWithTenant(ctx, getInput)
@@ -258,8 +526,8 @@ func TestCarService_List_Success(t *testing.T) {
 	// Create expected cars using the factory method
 	now := time.Now()
 	expectedCars := []*entity.Car{
-		entity.NewCar(tenantID, "Toyota Prius", now),
-		entity.NewCar(tenantID, "Honda Civic", now),
+		entity.NewCar(tenantID, "model-1", "", nil, nil, now),
+		entity.NewCar(tenantID, "model-2", "", nil, nil, now),
 	}
 	expectedNextPageToken := "next-page-token"
 	expectedTotalCount := int32(2)
@@ -277,9 +545,9 @@ func TestCarService_List_Success(t *testing.T) {
 	assert.Equal(t, expectedTotalCount, listOutput.TotalCount)
 	assert.Len(t, listOutput.Cars, 2)
 	assert.Equal(t, expectedCars[0].ID, listOutput.Cars[0].ID)
-	assert.Equal(t, expectedCars[0].Model, listOutput.Cars[0].Model)
+	assert.Equal(t, expectedCars[0].ModelID, listOutput.Cars[0].ModelID)
 	assert.Equal(t, expectedCars[1].ID, listOutput.Cars[1].ID)
-	assert.Equal(t, expectedCars[1].Model, listOutput.Cars[1].Model)
+	assert.Equal(t, expectedCars[1].ModelID, listOutput.Cars[1].ModelID)
 }
 
 // TestCarService_Create_Validation tests validation failures for Create
@@ -292,22 +560,22 @@ func TestCarService_Register_Validation(t *testing.T) {
 	}{
 		"empty tenant ID": {
 			input: input.CreateCar{
-				TenantID: "", // Missing required field
-				Model:    "Toyota Prius",
+				TenantID:   "", // Missing required field
+				CarModelID: "model-1",
 			},
 			wantErr: "validation failed",
 		},
 		"empty model": {
 			input: input.CreateCar{
-				TenantID: "tenant-123",
-				Model:    "", // Missing required field
+				TenantID:   "tenant-123",
+				CarModelID: "", // Missing required field
 			},
 			wantErr: "validation failed",
 		},
 		"both fields empty": {
 			input: input.CreateCar{
-				TenantID: "", // Missing required field
-				Model:    "", // Missing required field
+				TenantID:   "", // Missing required field
+				CarModelID: "", // Missing required field
 			},
 			wantErr: "validation failed",
 		},
@@ -345,7 +613,15 @@ func TestCarService_GetByID_Validation(t *testing.T) {
 	}{
 		"empty ID": {
 			input: input.GetCarByID{
-				ID: "", // Missing required field
+				TenantID: "tenant-123",
+				ID:       "", // Missing required field
+			},
+			wantErr: "validation failed",
+		},
+		"empty tenant ID": {
+			input: input.GetCarByID{
+				TenantID: "", // Missing required field
+				ID:       "car-123",
 			},
 			wantErr: "validation failed",
 		},
@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// setupCarModelTest creates a mock repository and a car model service
+func setupCarModelTest(t *testing.T) (*mock_repository.MockCarModelRepository, service.CarModelService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockCarModelRepo := mock_repository.NewMockCarModelRepository(ctrl)
+	return mockCarModelRepo, service.NewCarModelService(mockCarModelRepo)
+}
+
+// compactHybrid returns the input attributes of a compact hybrid
+func compactHybrid() input.CarModelSpec {
+	return input.CarModelSpec{
+		Make:         "Toyota",
+		Name:         "Prius",
+		Category:     "compact",
+		Seats:        5,
+		Transmission: "automatic",
+		FuelType:     "hybrid",
+	}
+}
+
+// TestCarModelService_Create tests that a model is added to the tenant's catalog
+func TestCarModelService_Create(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockCarModelRepo, carModelService := setupCarModelTest(t)
+	ctx := context.Background()
+
+	// Set up expectations
+	mockCarModelRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	// Execute
+	model, err := carModelService.Create(ctx, input.CreateCarModel{TenantID: "tenant-123", Spec: compactHybrid()})
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-123", model.TenantID)
+	assert.Equal(t, entity.CarModelSpec{
+		Make:         "Toyota",
+		Name:         "Prius",
+		Category:     entity.CarCategoryCompact,
+		Seats:        5,
+		Transmission: entity.TransmissionAutomatic,
+		FuelType:     entity.FuelTypeHybrid,
+	}, model.Spec)
+}
+
+// TestCarModelService_Create_Rejected tests that invalid and duplicate models are not added
+func TestCarModelService_Create_Rejected(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockCarModelRepo, carModelService := setupCarModelTest(t)
+	ctx := context.Background()
+
+	// Execute: an unknown category is rejected before the repository is called
+	spec := compactHybrid()
+	spec.Category = "truck"
+	_, err := carModelService.Create(ctx, input.CreateCarModel{TenantID: "tenant-123", Spec: spec})
+	assert.ErrorIs(t, err, entity.ErrInvalidCarModel)
+
+	// Set up expectations
+	mockCarModelRepo.EXPECT().Create(ctx, gomock.Any()).Return(repository.ErrAlreadyExists)
+
+	// Execute: the catalog already has a Toyota Prius
+	_, err = carModelService.Create(ctx, input.CreateCarModel{TenantID: "tenant-123", Spec: compactHybrid()})
+	assert.ErrorIs(t, err, repository.ErrAlreadyExists)
+}
+
+// TestCarModelService_Update tests that an update replaces the attributes of a model
+func TestCarModelService_Update(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockCarModelRepo, carModelService := setupCarModelTest(t)
+	ctx := context.Background()
+	model := entity.LegacyCarModel("tenant-123", "Toyota Prius", time.Now()).WithID("model-1")
+
+	// Set up expectations
+	mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", "model-1").Return(model, nil)
+	mockCarModelRepo.EXPECT().Update(ctx, model).Return(nil)
+
+	// Execute
+	updated, err := carModelService.Update(ctx, input.UpdateCarModel{TenantID: "tenant-123", ID: "model-1", Spec: compactHybrid()})
+	require.NoError(t, err)
+	assert.Equal(t, entity.CarCategoryCompact, updated.Spec.Category)
+	assert.Equal(t, 5, updated.Spec.Seats)
+}
+
+// TestCarModelService_Update_NotFound tests that a model of another catalog is not updated
+func TestCarModelService_Update_NotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockCarModelRepo, carModelService := setupCarModelTest(t)
+	ctx := context.Background()
+
+	// Set up expectations
+	mockCarModelRepo.EXPECT().GetByID(ctx, "tenant-123", "model-2").Return(nil, repository.ErrNotFound)
+
+	// Execute
+	_, err := carModelService.Update(ctx, input.UpdateCarModel{TenantID: "tenant-123", ID: "model-2", Spec: compactHybrid()})
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// setupFleetSharingTest creates mocks and a fleet sharing service, with two sibling tenants
+func setupFleetSharingTest(t *testing.T) (*mock_repository.MockTenantRepository, *mock_repository.MockFleetSharingRepository, service.FleetSharingService, *entity.Tenant, *entity.Tenant) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	mockSharingRepo := mock_repository.NewMockFleetSharingRepository(ctrl)
+	sharingService := service.NewFleetSharingService(mockTenantRepo, mockSharingRepo)
+
+	parent := entity.NewTenant("franchise", time.Now())
+	lender := entity.NewTenant("north", time.Now())
+	borrower := entity.NewTenant("south", time.Now())
+	require.NoError(t, lender.JoinFranchise(parent))
+	require.NoError(t, borrower.JoinFranchise(parent))
+	return mockTenantRepo, mockSharingRepo, sharingService, lender, borrower
+}
+
+// TestFleetSharingService_Create tests that agreements between siblings start now by default
+func TestFleetSharingService_Create(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockTenantRepo, mockSharingRepo, sharingService, lender, borrower := setupFleetSharingTest(t)
+	ctx := context.Background()
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(ctx, lender.ID).Return(lender, nil)
+	mockTenantRepo.EXPECT().GetByID(ctx, borrower.ID).Return(borrower, nil)
+	mockSharingRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	// Execute
+	agreement, err := sharingService.Create(ctx, input.CreateFleetSharingAgreement{
+		LenderTenantID:    lender.ID,
+		BorrowerTenantID:  borrower.ID,
+		MaxRentalDays:     7,
+		OwnerSharePercent: 70,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, lender.ID, agreement.LenderTenantID)
+	assert.Equal(t, borrower.ID, agreement.BorrowerTenantID)
+	assert.WithinDuration(t, time.Now(), agreement.Terms.ValidFrom, time.Second)
+	assert.False(t, agreement.Terms.ValidUntil.Valid)
+	assert.Equal(t, 7, agreement.Terms.MaxRentalDays)
+	assert.Equal(t, 70, agreement.Terms.OwnerSharePercent)
+}
+
+// TestFleetSharingService_Create_Rejected tests that agreements are only created once between
+// siblings, and never from a tenant to itself
+func TestFleetSharingService_Create_Rejected(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockTenantRepo, mockSharingRepo, sharingService, lender, borrower := setupFleetSharingTest(t)
+	ctx := context.Background()
+	outsider := entity.NewTenant("outsider", time.Now())
+
+	// Execute: a tenant cannot lend to itself
+	_, err := sharingService.Create(ctx, input.CreateFleetSharingAgreement{LenderTenantID: lender.ID, BorrowerTenantID: lender.ID})
+	assert.Error(t, err)
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(ctx, lender.ID).Return(lender, nil).Times(2)
+	mockTenantRepo.EXPECT().GetByID(ctx, outsider.ID).Return(outsider, nil)
+	mockTenantRepo.EXPECT().GetByID(ctx, borrower.ID).Return(borrower, nil)
+	mockSharingRepo.EXPECT().Create(ctx, gomock.Any()).Return(repository.ErrAlreadyExists)
+
+	// Execute
+	_, err = sharingService.Create(ctx, input.CreateFleetSharingAgreement{LenderTenantID: lender.ID, BorrowerTenantID: outsider.ID})
+	assert.ErrorIs(t, err, entity.ErrSharingNotSiblings)
+
+	_, err = sharingService.Create(ctx, input.CreateFleetSharingAgreement{LenderTenantID: lender.ID, BorrowerTenantID: borrower.ID})
+	assert.ErrorIs(t, err, entity.ErrSharingAgreementExists)
+	assert.ErrorIs(t, err, repository.ErrAlreadyExists)
+}
+
+// TestFleetSharingService_Terminate tests that agreements are terminated once
+func TestFleetSharingService_Terminate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, mockSharingRepo, sharingService, lender, borrower := setupFleetSharingTest(t)
+	ctx := context.Background()
+	agreement, err := entity.NewFleetSharingAgreement(lender, borrower, entity.FleetSharingTerms{ValidFrom: time.Now()}, time.Now())
+	require.NoError(t, err)
+
+	// Set up expectations
+	mockSharingRepo.EXPECT().GetByID(ctx, agreement.ID).Return(agreement, nil).Times(2)
+	mockSharingRepo.EXPECT().Update(ctx, agreement).Return(nil)
+
+	// Execute
+	terminated, err := sharingService.Terminate(ctx, input.TerminateFleetSharingAgreement{ID: agreement.ID})
+	require.NoError(t, err)
+	assert.True(t, terminated.Terminated())
+
+	_, err = sharingService.Terminate(ctx, input.TerminateFleetSharingAgreement{ID: agreement.ID})
+	assert.ErrorIs(t, err, entity.ErrSharingAgreementEnded)
+}
@@ -0,0 +1,208 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// maintenanceMocks holds the mocks of a maintenance window service
+type maintenanceMocks struct {
+	ctrl            *gomock.Controller
+	carRepo         *mock_repository.MockCarRepository
+	rentalRepo      *mock_repository.MockRentalRepository
+	maintenanceRepo *mock_repository.MockMaintenanceWindowRepository
+	uowFactory      *mock_repository.MockUnitOfWorkFactory
+}
+
+// setupMaintenanceTest creates mocks and a maintenance window service
+func setupMaintenanceTest(t *testing.T) (maintenanceMocks, service.MaintenanceWindowService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := maintenanceMocks{
+		ctrl:            ctrl,
+		carRepo:         mock_repository.NewMockCarRepository(ctrl),
+		rentalRepo:      mock_repository.NewMockRentalRepository(ctrl),
+		maintenanceRepo: mock_repository.NewMockMaintenanceWindowRepository(ctrl),
+		uowFactory:      mock_repository.NewMockUnitOfWorkFactory(ctrl),
+	}
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().RunInTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+
+	maintenanceService := service.NewMaintenanceWindowService(m.carRepo, m.rentalRepo, m.maintenanceRepo, mockTxManager, m.uowFactory)
+	return m, maintenanceService
+}
+
+// TestMaintenanceWindowService_Schedule tests that cars are taken out of service only over
+// periods free of rentals, and that the rentals in the way are reported otherwise
+func TestMaintenanceWindowService_Schedule(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.AddDate(0, 0, 1)
+
+	tests := map[string]struct {
+		typ        string
+		overlaps   entity.Rentals
+		wantErr    error
+		wantRental []string
+	}{
+		"free period":  {typ: "inspection"},
+		"unknown type": {typ: "wash", wantErr: entity.ErrInvalidMaintenanceWindow},
+		"over rentals": {
+			typ:        "repair",
+			overlaps:   entity.Rentals{{ID: "rental-1"}, {ID: "rental-2"}},
+			wantErr:    entity.ErrMaintenanceConflict,
+			wantRental: []string{"rental-1", "rental-2"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, maintenanceService := setupMaintenanceTest(t)
+			ctx := context.Background()
+			car := entity.NewCar("south", "model-1", "", nil, nil, time.Now())
+
+			// Set up expectations
+			m.carRepo.EXPECT().GetByID(ctx, "south", car.ID).Return(car, nil)
+			gomock.InOrder(
+				m.rentalRepo.EXPECT().LockCar(ctx, car.ID).Return(nil),
+				m.rentalRepo.EXPECT().ListOverlapping(ctx, car.ID, startsAt, endsAt).Return(tt.overlaps, nil),
+			)
+			if tt.wantErr == nil {
+				mockUow := mock_repository.NewMockUnitOfWork(m.ctrl)
+				m.uowFactory.EXPECT().New().Return(mockUow)
+				mockUow.EXPECT().RegisterNew(gomock.Any())
+				mockUow.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			// Execute
+			window, err := maintenanceService.Schedule(ctx, input.ScheduleMaintenanceWindow{
+				TenantID: "south",
+				CarID:    car.ID,
+				Type:     tt.typ,
+				Reason:   " 12-month inspection ",
+				StartsAt: startsAt,
+				EndsAt:   endsAt,
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				var conflict *entity.MaintenanceConflictError
+				if tt.wantRental != nil && assert.ErrorAs(t, err, &conflict) {
+					assert.Equal(t, tt.wantRental, conflict.RentalIDs)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.MaintenanceTypeInspection, window.Type)
+			assert.Equal(t, "12-month inspection", window.Reason)
+			assert.Equal(t, entity.MaintenanceStatusScheduled, window.Status)
+		})
+	}
+}
+
+// TestMaintenanceWindowService_Schedule_CarOfAnotherTenant tests that only the tenant's own
+// cars are taken out of service
+func TestMaintenanceWindowService_Schedule_CarOfAnotherTenant(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	m, maintenanceService := setupMaintenanceTest(t)
+	ctx := context.Background()
+	startsAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	// Set up expectations
+	m.carRepo.EXPECT().GetByID(ctx, "south", "car-1").Return(nil, repository.ErrNotFound)
+
+	// Execute
+	_, err := maintenanceService.Schedule(ctx, input.ScheduleMaintenanceWindow{
+		TenantID: "south",
+		CarID:    "car-1",
+		Type:     "service",
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(time.Hour),
+	})
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestMaintenanceWindowService_Transitions tests that windows are completed or canceled
+// once, under the lock of their car
+func TestMaintenanceWindowService_Transitions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status     entity.MaintenanceStatus
+		cancel     bool
+		wantStatus entity.MaintenanceStatus
+		wantErr    error
+	}{
+		"complete":          {status: entity.MaintenanceStatusScheduled, wantStatus: entity.MaintenanceStatusCompleted},
+		"cancel":            {status: entity.MaintenanceStatusScheduled, cancel: true, wantStatus: entity.MaintenanceStatusCanceled},
+		"complete canceled": {status: entity.MaintenanceStatusCanceled, wantErr: entity.ErrMaintenanceNotScheduled},
+		"cancel completed":  {status: entity.MaintenanceStatusCompleted, cancel: true, wantErr: entity.ErrMaintenanceNotScheduled},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, maintenanceService := setupMaintenanceTest(t)
+			ctx := context.Background()
+			startsAt := time.Now().Add(-time.Hour)
+			window := &entity.MaintenanceWindow{
+				ID:       "window-1",
+				TenantID: "south",
+				CarID:    "car-1",
+				Type:     entity.MaintenanceTypeRepair,
+				StartsAt: startsAt,
+				EndsAt:   startsAt.AddDate(0, 0, 1),
+				Status:   tt.status,
+			}
+
+			// Set up expectations: the window is read again once the car is locked
+			gomock.InOrder(
+				m.maintenanceRepo.EXPECT().GetByID(ctx, "south", "window-1").Return(window, nil),
+				m.rentalRepo.EXPECT().LockCar(ctx, "car-1").Return(nil),
+				m.maintenanceRepo.EXPECT().GetByID(ctx, "south", "window-1").Return(window, nil),
+			)
+			if tt.wantErr == nil {
+				mockUow := mock_repository.NewMockUnitOfWork(m.ctrl)
+				m.uowFactory.EXPECT().New().Return(mockUow)
+				mockUow.EXPECT().RegisterDirty(window)
+				mockUow.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			// Execute
+			var err error
+			if tt.cancel {
+				_, err = maintenanceService.Cancel(ctx, input.CancelMaintenanceWindow{TenantID: "south", ID: "window-1"})
+			} else {
+				_, err = maintenanceService.Complete(ctx, input.CompleteMaintenanceWindow{TenantID: "south", ID: "window-1"})
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, window.Status)
+		})
+	}
+}
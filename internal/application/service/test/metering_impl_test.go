@@ -0,0 +1,151 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// setupMeteringTest creates mocks and a metering service
+func setupMeteringTest(t *testing.T) (*mock_repository.MockTenantRepository, *mock_repository.MockMeteringRepository, service.MeteringService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	mockMeteringRepo := mock_repository.NewMockMeteringRepository(ctrl)
+	return mockTenantRepo, mockMeteringRepo, service.NewMeteringService(mockTenantRepo, mockMeteringRepo)
+}
+
+// TestMeteringService_GetUsage tests which rollups are listed
+func TestMeteringService_GetUsage(t *testing.T) {
+	t.Parallel()
+
+	thisMonth := entity.UsagePeriodMonth.Start(time.Now())
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		input      input.GetMeteredUsage
+		wantPeriod entity.UsagePeriod
+		wantFrom   time.Time
+		wantTo     time.Time
+		wantErr    bool
+	}{
+		"defaults to the days of this month": {
+			input:      input.GetMeteredUsage{TenantID: "tenant-1"},
+			wantPeriod: entity.UsagePeriodDay,
+			wantFrom:   thisMonth,
+			wantTo:     thisMonth.AddDate(0, 1, 0),
+		},
+		"months from a date": {
+			input:      input.GetMeteredUsage{TenantID: "tenant-1", Period: "month", From: from},
+			wantPeriod: entity.UsagePeriodMonth,
+			wantFrom:   from,
+			wantTo:     from.AddDate(0, 1, 0),
+		},
+		"unknown period": {
+			input:   input.GetMeteredUsage{TenantID: "tenant-1", Period: "week"},
+			wantErr: true,
+		},
+		"empty range": {
+			input:   input.GetMeteredUsage{TenantID: "tenant-1", From: from, To: from},
+			wantErr: true,
+		},
+		"missing tenant": {
+			input:   input.GetMeteredUsage{},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctx := context.Background()
+			_, mockMeteringRepo, svc := setupMeteringTest(t)
+			rollups := entity.UsageRollups{{TenantID: "tenant-1", Meter: entity.MeterAPICalls, Period: tt.wantPeriod, Quantity: 3}}
+
+			// Set up expectations
+			if !tt.wantErr {
+				mockMeteringRepo.EXPECT().ListRollups(ctx, "tenant-1", tt.wantPeriod, tt.wantFrom, tt.wantTo).Return(rollups, nil)
+			}
+
+			// Execute
+			got, err := svc.GetUsage(ctx, tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, rollups, got)
+		})
+	}
+}
+
+// TestMeteringService_Statement tests that a past month lists every day and its totals as CSV
+func TestMeteringService_Statement(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockTenantRepo, mockMeteringRepo, svc := setupMeteringTest(t)
+	tenant := entity.NewTenant("acme", time.Now()).WithID("tenant-1")
+	month := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2026, 2, d, 0, 0, 0, 0, time.UTC) }
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(ctx, "tenant-1").Return(tenant, nil)
+	mockMeteringRepo.EXPECT().ListRollups(ctx, "tenant-1", entity.UsagePeriodDay, month, month.AddDate(0, 1, 0)).Return(entity.UsageRollups{
+		{Meter: entity.MeterActiveCars, Period: entity.UsagePeriodDay, PeriodStart: day(1), Quantity: 4},
+		{Meter: entity.MeterAPICalls, Period: entity.UsagePeriodDay, PeriodStart: day(1), Quantity: 10},
+		{Meter: entity.MeterActiveCars, Period: entity.UsagePeriodDay, PeriodStart: day(28), Quantity: 6},
+		{Meter: entity.MeterRentalsCreated, Period: entity.UsagePeriodDay, PeriodStart: day(28), Quantity: 2},
+	}, nil)
+	mockMeteringRepo.EXPECT().ListRollups(ctx, "tenant-1", entity.UsagePeriodMonth, month, month.AddDate(0, 1, 0)).Return(entity.UsageRollups{
+		{Meter: entity.MeterActiveCars, Period: entity.UsagePeriodMonth, PeriodStart: month, Quantity: 6},
+		{Meter: entity.MeterRentalsCreated, Period: entity.UsagePeriodMonth, PeriodStart: month, Quantity: 2},
+		{Meter: entity.MeterAPICalls, Period: entity.UsagePeriodMonth, PeriodStart: month, Quantity: 10},
+	}, nil)
+
+	// Execute
+	statement, err := svc.Statement(ctx, input.GetUsageStatement{TenantID: "tenant-1", Month: day(14)})
+	require.NoError(t, err)
+	assert.Equal(t, "usage-acme-2026-02.csv", statement.Filename())
+	require.Len(t, statement.Days, 28)
+
+	var buf bytes.Buffer
+	require.NoError(t, statement.WriteCSV(&buf))
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 30)
+	assert.Equal(t, "date,active_cars,rentals_created,api_calls", string(lines[0]))
+	assert.Equal(t, "2026-02-01,4,0,10", string(lines[1]))
+	assert.Equal(t, "2026-02-02,0,0,0", string(lines[2]))
+	assert.Equal(t, "2026-02-28,6,2,0", string(lines[28]))
+	assert.Equal(t, "total,6,2,10", string(lines[29]))
+}
+
+// TestMeteringService_Statement_FutureMonth tests that a month that has not started has no usage
+func TestMeteringService_Statement_FutureMonth(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockTenantRepo, _, svc := setupMeteringTest(t)
+	tenant := entity.NewTenant("acme", time.Now()).WithID("tenant-1")
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(ctx, "tenant-1").Return(tenant, nil)
+
+	// Execute
+	statement, err := svc.Statement(ctx, input.GetUsageStatement{TenantID: "tenant-1", Month: time.Now().AddDate(0, 2, 0)})
+	require.NoError(t, err)
+	assert.Empty(t, statement.Days)
+}
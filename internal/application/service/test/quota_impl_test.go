@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	mock_service "github.com/jp-ryuji/go-arch-patterns/internal/application/service/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// quotaTestMocks are the dependencies of the quota service under test
+type quotaTestMocks struct {
+	tenantRepo      *mock_repository.MockTenantRepository
+	planRepo        *mock_repository.MockPlanRepository
+	usageRepo       *mock_repository.MockUsageRepository
+	settingsService *mock_service.MockTenantSettingsService
+}
+
+// setupQuotaTest creates mocks and a quota service whose transactions run inline
+func setupQuotaTest(t *testing.T) (quotaTestMocks, service.QuotaService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mocks := quotaTestMocks{
+		tenantRepo:      mock_repository.NewMockTenantRepository(ctrl),
+		planRepo:        mock_repository.NewMockPlanRepository(ctrl),
+		usageRepo:       mock_repository.NewMockUsageRepository(ctrl),
+		settingsService: mock_service.NewMockTenantSettingsService(ctrl),
+	}
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().RunInTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+	// Usage is counted from the start of the month in Tokyo
+	mocks.settingsService.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input input.GetTenantSettings) (*entity.TenantSettings, error) {
+			settings := entity.DefaultTenantSettings(input.TenantID, time.Now())
+			settings.Timezone = "Asia/Tokyo"
+			return settings, nil
+		},
+	).AnyTimes()
+
+	return mocks, service.NewQuotaService(mockTxManager, mocks.tenantRepo, mocks.planRepo, mocks.usageRepo, mocks.settingsService)
+}
+
+// tenantOnPlan returns a tenant on a plan with limits
+func tenantOnPlan(limits entity.PlanLimits) (*entity.Tenant, *entity.Plan) {
+	plan := entity.NewPlan("standard", "Standard", limits, time.Now())
+	tenant := entity.NewTenant("acme", time.Now())
+	tenant.PlanID = null.StringFrom(plan.ID)
+	return tenant, plan
+}
+
+// TestQuotaService_WithinQuota tests that creates run only while the tenant is below the limit of its plan
+func TestQuotaService_WithinQuota(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		limits  entity.PlanLimits
+		used    int
+		counted bool
+		wantErr error
+	}{
+		"below the limit": {
+			limits:  entity.PlanLimits{MaxCars: 10},
+			used:    9,
+			counted: true,
+		},
+		"at the limit": {
+			limits:  entity.PlanLimits{MaxCars: 10},
+			used:    10,
+			counted: true,
+			wantErr: entity.ErrQuotaExceeded,
+		},
+		"unlimited": {
+			limits: entity.PlanLimits{MaxRenters: 10},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			mocks, quotaService := setupQuotaTest(t)
+			ctx := context.Background()
+			tenant, plan := tenantOnPlan(tt.limits)
+
+			// Set up expectations; the tenant is locked before its usage is counted
+			mocks.tenantRepo.EXPECT().GetByIDForUpdate(ctx, tenant.ID).Return(tenant, nil)
+			mocks.planRepo.EXPECT().GetByID(ctx, plan.ID).Return(plan, nil)
+			if tt.counted {
+				mocks.usageRepo.EXPECT().Count(ctx, tenant.ID, entity.ResourceCars, gomock.Any()).Return(tt.used, nil)
+			}
+
+			// Execute
+			created := false
+			err := quotaService.WithinQuota(ctx, tenant.ID, entity.ResourceCars, func(context.Context) error {
+				created = true
+				return nil
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				var quotaErr *entity.QuotaExceededError
+				require.ErrorAs(t, err, &quotaErr)
+				assert.Equal(t, entity.QuotaExceededError{Resource: entity.ResourceCars, Limit: 10, Used: 10}, *quotaErr)
+				assert.False(t, created)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, created)
+		})
+	}
+}
+
+// TestQuotaService_WithinQuota_NoPlan tests that tenants without a plan are unlimited
+func TestQuotaService_WithinQuota_NoPlan(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mocks, quotaService := setupQuotaTest(t)
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+
+	// Set up expectations; neither the plan nor the usage is read
+	mocks.tenantRepo.EXPECT().GetByIDForUpdate(ctx, tenant.ID).Return(tenant, nil)
+
+	// Execute
+	err := quotaService.WithinQuota(ctx, tenant.ID, entity.ResourceCars, func(context.Context) error {
+		return assert.AnError
+	})
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+// TestQuotaService_Usage tests that usage is reported for every resource from the start of the tenant's month
+func TestQuotaService_Usage(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mocks, quotaService := setupQuotaTest(t)
+	ctx := context.Background()
+	tenant, plan := tenantOnPlan(entity.PlanLimits{MaxCars: 10, MaxMonthlyRentals: 100})
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	now := time.Now().In(tokyo)
+	periodStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, tokyo)
+
+	// Set up expectations
+	mocks.tenantRepo.EXPECT().GetByID(ctx, tenant.ID).Return(tenant, nil)
+	mocks.planRepo.EXPECT().GetByID(ctx, plan.ID).Return(plan, nil)
+	mocks.usageRepo.EXPECT().Count(ctx, tenant.ID, entity.ResourceCars, periodStart).Return(3, nil)
+	mocks.usageRepo.EXPECT().Count(ctx, tenant.ID, entity.ResourceRenters, periodStart).Return(40, nil)
+	mocks.usageRepo.EXPECT().Count(ctx, tenant.ID, entity.ResourceMonthlyRentals, periodStart).Return(12, nil)
+
+	// Execute
+	usage, err := quotaService.Usage(ctx, input.GetUsage{TenantID: tenant.ID})
+	require.NoError(t, err)
+	assert.Equal(t, plan, usage.Plan)
+	assert.True(t, periodStart.Equal(usage.PeriodStart))
+	assert.Equal(t, []entity.ResourceUsage{
+		{Resource: entity.ResourceCars, Used: 3, Limit: 10},
+		{Resource: entity.ResourceRenters, Used: 40, Limit: 0},
+		{Resource: entity.ResourceMonthlyRentals, Used: 12, Limit: 100},
+	}, usage.Resources)
+}
@@ -0,0 +1,643 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	mock_service "github.com/jp-ryuji/go-arch-patterns/internal/application/service/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+)
+
+// rentalMocks holds the mocks of a rental service
+type rentalMocks struct {
+	ctrl            *gomock.Controller
+	carRepo         *mock_repository.MockCarRepository
+	rentalRepo      *mock_repository.MockRentalRepository
+	maintenanceRepo *mock_repository.MockMaintenanceWindowRepository
+	handoverRepo    *mock_repository.MockRentalHandoverRepository
+	renterRepo      *mock_repository.MockRenterRepository
+	sharingRepo     *mock_repository.MockFleetSharingRepository
+	branchRepo      *mock_repository.MockBranchRepository
+	uowFactory      *mock_repository.MockUnitOfWorkFactory
+}
+
+// setupRentalTest creates mocks and a rental service for tenants that are always open and
+// within their quotas
+func setupRentalTest(t *testing.T) (rentalMocks, service.RentalService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := rentalMocks{
+		ctrl:            ctrl,
+		carRepo:         mock_repository.NewMockCarRepository(ctrl),
+		rentalRepo:      mock_repository.NewMockRentalRepository(ctrl),
+		maintenanceRepo: mock_repository.NewMockMaintenanceWindowRepository(ctrl),
+		handoverRepo:    mock_repository.NewMockRentalHandoverRepository(ctrl),
+		renterRepo:      mock_repository.NewMockRenterRepository(ctrl),
+		sharingRepo:     mock_repository.NewMockFleetSharingRepository(ctrl),
+		branchRepo:      mock_repository.NewMockBranchRepository(ctrl),
+		uowFactory:      mock_repository.NewMockUnitOfWorkFactory(ctrl),
+	}
+	mockQuotaService := mock_service.NewMockQuotaService(ctrl)
+	mockQuotaService.EXPECT().WithinQuota(gomock.Any(), gomock.Any(), entity.ResourceMonthlyRentals, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ string, _ entity.Resource, create func(ctx context.Context) error) error {
+			return create(ctx)
+		},
+	).AnyTimes()
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().RunInTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+	mockSettingsService := mock_service.NewMockTenantSettingsService(ctrl)
+	mockSettingsService.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, in input.GetTenantSettings) (*entity.TenantSettings, error) {
+			return entity.DefaultTenantSettings(in.TenantID, time.Now()), nil
+		},
+	).AnyTimes()
+
+	rentalService := service.NewRentalService(
+		m.carRepo, m.rentalRepo, m.maintenanceRepo, m.handoverRepo, m.renterRepo, m.sharingRepo, m.branchRepo, mockTxManager,
+		m.uowFactory, mockQuotaService, mockSettingsService,
+	)
+	return m, rentalService
+}
+
+// sharingAgreement returns an agreement letting borrower book the cars of lender for up to
+// maxDays days
+func sharingAgreement(lender, borrower string, maxDays int) *entity.FleetSharingAgreement {
+	return &entity.FleetSharingAgreement{
+		ID:               "agreement-" + lender,
+		LenderTenantID:   lender,
+		BorrowerTenantID: borrower,
+		Terms: entity.FleetSharingTerms{
+			ValidFrom:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			ValidUntil:    null.Time{},
+			MaxRentalDays: maxDays,
+		},
+	}
+}
+
+// TestRentalService_SearchAvailableCars tests that shared cars are searched only under
+// agreements whose terms allow the period
+func TestRentalService_SearchAvailableCars(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	m, rentalService := setupRentalTest(t)
+	ctx := context.Background()
+	startsAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.AddDate(0, 0, 5)
+	own := entity.NewCar("south", "model-1", "", nil, nil, time.Now())
+	shared := entity.NewCar("north", "model-2", "", nil, nil, time.Now())
+
+	// Set up expectations: west caps rentals at 3 days, so its cars are left out
+	m.sharingRepo.EXPECT().ListActiveByBorrower(ctx, "south").Return(entity.FleetSharingAgreements{
+		sharingAgreement("north", "south", 7),
+		sharingAgreement("west", "south", 3),
+	}, nil)
+	m.carRepo.EXPECT().ListAvailable(ctx, []string{"south", "north"}, "shinjuku", startsAt, endsAt, 20).
+		Return(entity.Cars{own, shared}, nil)
+
+	// Execute
+	cars, err := rentalService.SearchAvailableCars(ctx, input.SearchAvailableCars{
+		TenantID: "south",
+		BranchID: "shinjuku",
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	})
+	require.NoError(t, err)
+	require.Len(t, cars, 2)
+	assert.False(t, cars[0].Shared())
+	assert.True(t, cars[1].Shared())
+	assert.Equal(t, "agreement-north", cars[1].AgreementID)
+}
+
+// TestRentalService_List tests that rentals are listed per tenant, narrowed down to a
+// renter when one is given
+func TestRentalService_List(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		renterID   string
+		wantFilter repository.RentalFilter
+	}{
+		"every renter": {},
+		"one renter":   {renterID: "renter-1", wantFilter: repository.RentalFilter{RenterID: "renter-1"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, rentalService := setupRentalTest(t)
+			ctx := context.Background()
+			rental := &entity.Rental{ID: "rental-1", TenantID: "south", OwnerTenantID: "north", RenterID: "renter-1"}
+
+			// Set up expectations
+			m.rentalRepo.EXPECT().ListByTenant(ctx, "south", tt.wantFilter, 20, 0).Return(entity.Rentals{rental}, 1, nil)
+
+			// Execute
+			result, err := rentalService.List(ctx, input.ListRentals{TenantID: "south", RenterID: tt.renterID})
+			require.NoError(t, err)
+			assert.Equal(t, entity.Rentals{rental}, result.Rentals)
+			assert.Equal(t, 1, result.TotalCount)
+		})
+	}
+}
+
+// TestRentalService_Book tests that own and shared cars are booked for renters of the
+// booking tenant, attributed to the tenant owning the car
+func TestRentalService_Book(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		car       *entity.Car
+		shared    bool
+		wantOwner string
+	}{
+		"own car":    {car: entity.NewCar("south", "model-1", "", nil, nil, time.Now()), wantOwner: "south"},
+		"shared car": {car: entity.NewCar("north", "model-2", "", nil, nil, time.Now()), shared: true, wantOwner: "north"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, rentalService := setupRentalTest(t)
+			ctx := context.Background()
+			renter := entity.NewRenter("south", entity.IndividualRenter, time.Now())
+
+			// Set up expectations
+			if tt.shared {
+				m.carRepo.EXPECT().GetByID(ctx, "south", tt.car.ID).Return(nil, repository.ErrNotFound)
+				m.sharingRepo.EXPECT().ListActiveByBorrower(ctx, "south").Return(entity.FleetSharingAgreements{
+					sharingAgreement("west", "south", 0),
+					sharingAgreement("north", "south", 7),
+				}, nil)
+				m.carRepo.EXPECT().GetByID(ctx, "west", tt.car.ID).Return(nil, repository.ErrNotFound)
+				m.carRepo.EXPECT().GetByID(ctx, "north", tt.car.ID).Return(tt.car, nil)
+			} else {
+				m.carRepo.EXPECT().GetByID(ctx, "south", tt.car.ID).Return(tt.car, nil)
+			}
+			gomock.InOrder(
+				m.rentalRepo.EXPECT().LockCar(ctx, tt.car.ID).Return(nil),
+				m.rentalRepo.EXPECT().HasOverlap(ctx, tt.car.ID, startsAt, startsAt.AddDate(0, 0, 2)).Return(false, nil),
+				m.maintenanceRepo.EXPECT().HasOverlap(ctx, tt.car.ID, startsAt, startsAt.AddDate(0, 0, 2)).Return(false, nil),
+			)
+			m.renterRepo.EXPECT().GetByID(ctx, renter.ID).Return(renter, nil)
+			mockUow := mock_repository.NewMockUnitOfWork(m.ctrl)
+			m.uowFactory.EXPECT().New().Return(mockUow)
+			mockUow.EXPECT().RegisterNew(gomock.Any())
+			mockUow.EXPECT().Commit(ctx).Return(nil)
+
+			// Execute
+			rental, err := rentalService.Book(ctx, input.BookRental{
+				TenantID: "south",
+				CarID:    tt.car.ID,
+				RenterID: renter.ID,
+				StartsAt: startsAt,
+				EndsAt:   startsAt.AddDate(0, 0, 2),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "south", rental.TenantID)
+			assert.Equal(t, tt.wantOwner, rental.OwnerTenantID)
+			assert.Equal(t, tt.shared, rental.Shared())
+		})
+	}
+}
+
+// TestRentalService_Book_Rejected tests that cars are not booked outside the terms of the
+// agreement, over another rental or maintenance window, or when no agreement shares them
+func TestRentalService_Book_Rejected(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	car := entity.NewCar("north", "model-2", "", nil, nil, time.Now())
+
+	tests := map[string]struct {
+		setup   func(ctx context.Context, m rentalMocks)
+		wantErr error
+	}{
+		"outside sharing terms": {
+			setup: func(ctx context.Context, m rentalMocks) {
+				m.carRepo.EXPECT().GetByID(ctx, "south", car.ID).Return(nil, repository.ErrNotFound)
+				m.sharingRepo.EXPECT().ListActiveByBorrower(ctx, "south").Return(entity.FleetSharingAgreements{
+					sharingAgreement("north", "south", 1),
+				}, nil)
+				m.carRepo.EXPECT().GetByID(ctx, "north", car.ID).Return(car, nil)
+			},
+			wantErr: entity.ErrRentalOutsideSharingTerms,
+		},
+		"not shared": {
+			setup: func(ctx context.Context, m rentalMocks) {
+				m.carRepo.EXPECT().GetByID(ctx, "south", car.ID).Return(nil, repository.ErrNotFound)
+				m.sharingRepo.EXPECT().ListActiveByBorrower(ctx, "south").Return(nil, nil)
+			},
+			wantErr: repository.ErrNotFound,
+		},
+		"already rented": {
+			setup: func(ctx context.Context, m rentalMocks) {
+				m.carRepo.EXPECT().GetByID(ctx, "south", car.ID).Return(car, nil)
+				m.rentalRepo.EXPECT().LockCar(ctx, car.ID).Return(nil)
+				m.rentalRepo.EXPECT().HasOverlap(ctx, car.ID, gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			wantErr: entity.ErrCarUnavailable,
+		},
+		"in maintenance": {
+			setup: func(ctx context.Context, m rentalMocks) {
+				m.carRepo.EXPECT().GetByID(ctx, "south", car.ID).Return(car, nil)
+				m.rentalRepo.EXPECT().LockCar(ctx, car.ID).Return(nil)
+				m.rentalRepo.EXPECT().HasOverlap(ctx, car.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+				m.maintenanceRepo.EXPECT().HasOverlap(ctx, car.ID, gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			wantErr: entity.ErrCarUnavailable,
+		},
+		"renter of another tenant": {
+			setup: func(ctx context.Context, m rentalMocks) {
+				m.carRepo.EXPECT().GetByID(ctx, "south", car.ID).Return(car, nil)
+				m.rentalRepo.EXPECT().LockCar(ctx, car.ID).Return(nil)
+				m.rentalRepo.EXPECT().HasOverlap(ctx, car.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+				m.maintenanceRepo.EXPECT().HasOverlap(ctx, car.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+				m.renterRepo.EXPECT().GetByID(ctx, "renter-1").
+					Return(entity.NewRenter("north", entity.IndividualRenter, time.Now()), nil)
+			},
+			wantErr: repository.ErrNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, rentalService := setupRentalTest(t)
+			ctx := context.Background()
+			tt.setup(ctx, m)
+
+			// Execute
+			_, err := rentalService.Book(ctx, input.BookRental{
+				TenantID: "south",
+				CarID:    car.ID,
+				RenterID: "renter-1",
+				StartsAt: startsAt,
+				EndsAt:   startsAt.AddDate(0, 0, 2),
+			})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// branchOf returns an always open branch of a tenant
+func branchOf(t *testing.T, tenantID, id string) *entity.Branch {
+	t.Helper()
+	coordinates, err := value.NewCoordinates(35.6812, 139.7671)
+	require.NoError(t, err)
+	branch, err := entity.NewBranch(tenantID, entity.BranchSpec{
+		Name:        id,
+		Address:     entity.Address{Street: "1-1-1", City: "Tokyo", Country: "JP"},
+		Coordinates: coordinates,
+	}, time.Now())
+	require.NoError(t, err)
+	return branch.WithID(id)
+}
+
+// TestRentalService_Book_Branches tests that cars are picked up at their current branch
+// unless the input names another, and returned at the pickup branch unless told otherwise
+func TestRentalService_Book_Branches(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		input      input.BookRental
+		wantPickup string
+		wantReturn string
+		wantErr    error
+	}{
+		"at the current branch": {
+			wantPickup: "shinjuku",
+			wantReturn: "shinjuku",
+		},
+		"one-way": {
+			input:      input.BookRental{ReturnBranchID: "shibuya"},
+			wantPickup: "shinjuku",
+			wantReturn: "shibuya",
+		},
+		"at another branch": {
+			input:   input.BookRental{PickupBranchID: "shibuya"},
+			wantErr: entity.ErrCarNotAtBranch,
+		},
+		"unknown branch": {
+			input:   input.BookRental{ReturnBranchID: "ikebukuro"},
+			wantErr: repository.ErrNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, rentalService := setupRentalTest(t)
+			ctx := context.Background()
+			car := entity.NewCar("south", "model-1", "shinjuku", nil, nil, time.Now())
+			renter := entity.NewRenter("south", entity.IndividualRenter, time.Now())
+
+			// Set up expectations; south has branches in Shinjuku and Shibuya
+			m.carRepo.EXPECT().GetByID(ctx, "south", car.ID).Return(car, nil)
+			m.rentalRepo.EXPECT().LockCar(ctx, car.ID).Return(nil)
+			m.rentalRepo.EXPECT().HasOverlap(ctx, car.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+			m.maintenanceRepo.EXPECT().HasOverlap(ctx, car.ID, gomock.Any(), gomock.Any()).Return(false, nil)
+			m.renterRepo.EXPECT().GetByID(ctx, renter.ID).Return(renter, nil)
+			m.branchRepo.EXPECT().GetByID(ctx, "south", gomock.Any()).DoAndReturn(
+				func(_ context.Context, tenantID, id string) (*entity.Branch, error) {
+					if id != "shinjuku" && id != "shibuya" {
+						return nil, repository.ErrNotFound
+					}
+					return branchOf(t, tenantID, id), nil
+				},
+			).AnyTimes()
+			if tt.wantErr == nil {
+				mockUow := mock_repository.NewMockUnitOfWork(m.ctrl)
+				m.uowFactory.EXPECT().New().Return(mockUow)
+				mockUow.EXPECT().RegisterNew(gomock.Any())
+				mockUow.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			// Execute
+			in := tt.input
+			in.TenantID, in.CarID, in.RenterID = "south", car.ID, renter.ID
+			in.StartsAt, in.EndsAt = startsAt, startsAt.AddDate(0, 0, 2)
+			rental, err := rentalService.Book(ctx, in)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPickup, rental.PickupBranchID)
+			assert.Equal(t, tt.wantReturn, rental.ReturnBranchID)
+		})
+	}
+}
+
+// TestRentalService_Return tests that returns are recorded once, with their handover, for
+// rentals that were picked up, that a return without an inspection is rejected, and that
+// the car of a one-way rental is then at the return branch
+func TestRentalService_Return(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Now().Add(-24 * time.Hour)
+
+	tests := map[string]struct {
+		returnBranch string
+		returned     bool
+		notPickedUp  bool
+		noInspection bool
+		wantMove     bool
+		wantErr      error
+	}{
+		"round trip":       {returnBranch: "shinjuku"},
+		"one-way":          {returnBranch: "shibuya", wantMove: true},
+		"already returned": {returnBranch: "shinjuku", returned: true, wantErr: entity.ErrRentalAlreadyReturned},
+		"not picked up":    {returnBranch: "shinjuku", notPickedUp: true, wantErr: entity.ErrRentalNotPickedUp},
+		"no inspection":    {returnBranch: "shinjuku", noInspection: true, wantErr: entity.ErrReturnNotInspected},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, rentalService := setupRentalTest(t)
+			ctx := context.Background()
+			car := entity.NewCar("south", "model-1", "shinjuku", nil, nil, time.Now())
+			car.ClearEvents()
+			rental := &entity.Rental{
+				ID:             "rental-1",
+				TenantID:       "south",
+				OwnerTenantID:  "south",
+				CarID:          car.ID,
+				PickupBranchID: "shinjuku",
+				ReturnBranchID: tt.returnBranch,
+				StartsAt:       startsAt,
+				EndsAt:         startsAt.AddDate(0, 0, 2),
+			}
+			if tt.returned {
+				rental.ReturnedAt = null.TimeFrom(time.Now())
+			}
+
+			in := input.ReturnRental{TenantID: "south", ID: "rental-1"}
+			if !tt.noInspection {
+				in.Inspection = &input.HandoverInspection{OdometerKm: 12420, FuelLevel: 90}
+			}
+
+			// Set up expectations: nothing is read without an inspection, and the rental is
+			// read again once the car is locked
+			if !tt.noInspection {
+				gomock.InOrder(
+					m.rentalRepo.EXPECT().GetByID(ctx, "south", "rental-1").Return(rental, nil),
+					m.rentalRepo.EXPECT().LockCar(ctx, car.ID).Return(nil),
+					m.rentalRepo.EXPECT().GetByID(ctx, "south", "rental-1").Return(rental, nil),
+				)
+			}
+			if !tt.returned && !tt.noInspection {
+				pickup, err := entity.NewPickupHandover(rental, entity.HandoverReading{OdometerKm: 12000, FuelLevel: 90}, startsAt)
+				require.NoError(t, err)
+				if tt.notPickedUp {
+					pickup, err = nil, repository.ErrNotFound
+				}
+				m.handoverRepo.EXPECT().GetByRental(ctx, "south", "rental-1", entity.HandoverKindPickup).Return(pickup, err)
+			}
+			if tt.wantErr == nil {
+				mockUow := mock_repository.NewMockUnitOfWork(m.ctrl)
+				m.uowFactory.EXPECT().New().Return(mockUow)
+				mockUow.EXPECT().RegisterDirty(rental)
+				mockUow.EXPECT().RegisterNew(gomock.AssignableToTypeOf(&entity.RentalHandover{}))
+				if tt.wantMove {
+					m.carRepo.EXPECT().GetByID(ctx, "south", car.ID).Return(car, nil)
+					mockUow.EXPECT().RegisterDirty(car)
+				}
+				mockUow.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			// Execute
+			returned, err := rentalService.Return(ctx, in)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, returned.Rental.Returned())
+			require.NotNil(t, returned.Handover)
+			assert.Equal(t, entity.HandoverKindReturn, returned.Handover.Kind)
+			if tt.wantMove {
+				assert.Equal(t, "shibuya", car.CurrentBranchID)
+				assert.Equal(t, "shinjuku", car.HomeBranchID)
+			}
+		})
+	}
+}
+
+// startedRental returns a rental south booked of a car north shares with it, which started
+// a day ago
+func startedRental() *entity.Rental {
+	startsAt := time.Now().Add(-24 * time.Hour)
+	return &entity.Rental{
+		ID:            "rental-1",
+		TenantID:      "south",
+		OwnerTenantID: "north",
+		CarID:         "car-1",
+		StartsAt:      startsAt,
+		EndsAt:        startsAt.AddDate(0, 0, 2),
+	}
+}
+
+// TestRentalService_PickUp tests that the car of a rental is picked up once, after the
+// rental starts, with an inspection in range
+func TestRentalService_PickUp(t *testing.T) {
+	t.Parallel()
+
+	valid := input.HandoverInspection{
+		OdometerKm: 12000,
+		FuelLevel:  90,
+		Damages:    []input.HandoverDamage{{Area: "rear", Description: "Scratch"}},
+	}
+
+	tests := map[string]struct {
+		inspection input.HandoverInspection
+		startsIn   time.Duration
+		pickedUp   bool
+		wantErr    error
+	}{
+		"picked up":         {inspection: valid},
+		"already picked up": {inspection: valid, pickedUp: true, wantErr: entity.ErrRentalAlreadyPickedUp},
+		"not started":       {inspection: valid, startsIn: 48 * time.Hour, wantErr: entity.ErrRentalNotStarted},
+		"unknown area":      {inspection: input.HandoverInspection{OdometerKm: 12000, FuelLevel: 90, Damages: []input.HandoverDamage{{Area: "trunk"}}}, wantErr: entity.ErrInvalidHandover},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, rentalService := setupRentalTest(t)
+			ctx := context.Background()
+			rental := startedRental()
+			rental.StartsAt = rental.StartsAt.Add(tt.startsIn)
+
+			// Set up expectations: the rental is read again once the car is locked
+			gomock.InOrder(
+				m.rentalRepo.EXPECT().GetByID(ctx, "south", "rental-1").Return(rental, nil),
+				m.rentalRepo.EXPECT().LockCar(ctx, "car-1").Return(nil),
+				m.rentalRepo.EXPECT().GetByID(ctx, "south", "rental-1").Return(rental, nil),
+			)
+			if tt.pickedUp {
+				m.handoverRepo.EXPECT().GetByRental(ctx, "south", "rental-1", entity.HandoverKindPickup).
+					Return(&entity.RentalHandover{ID: "handover-1"}, nil)
+			} else {
+				m.handoverRepo.EXPECT().GetByRental(ctx, "south", "rental-1", entity.HandoverKindPickup).
+					Return(nil, repository.ErrNotFound)
+			}
+			if tt.wantErr == nil {
+				mockUow := mock_repository.NewMockUnitOfWork(m.ctrl)
+				m.uowFactory.EXPECT().New().Return(mockUow)
+				mockUow.EXPECT().RegisterNew(gomock.Any())
+				mockUow.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			// Execute
+			handover, err := rentalService.PickUp(ctx, input.PickUpRental{TenantID: "south", ID: "rental-1", Inspection: tt.inspection})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.HandoverKindPickup, handover.Kind)
+			assert.Equal(t, "north", handover.OwnerTenantID)
+			assert.Equal(t, 12000, handover.Reading.OdometerKm)
+			assert.Equal(t, []entity.Damage{{Area: entity.DamageAreaRear, Description: "Scratch"}}, handover.Reading.Damages)
+		})
+	}
+}
+
+// TestRentalService_Return_Inspection tests that the inspection at return is compared with
+// the one at pickup for the distance driven and the fuel shortfall
+func TestRentalService_Return_Inspection(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		inspection    input.HandoverInspection
+		pickedUp      bool
+		wantDistance  int
+		wantShortfall int
+		wantErr       error
+	}{
+		"fuel used":         {inspection: input.HandoverInspection{OdometerKm: 12420, FuelLevel: 35}, pickedUp: true, wantDistance: 420, wantShortfall: 55},
+		"refueled":          {inspection: input.HandoverInspection{OdometerKm: 12420, FuelLevel: 100}, pickedUp: true, wantDistance: 420},
+		"odometer backward": {inspection: input.HandoverInspection{OdometerKm: 11000, FuelLevel: 90}, pickedUp: true, wantErr: entity.ErrOdometerWentBackwards},
+		"not picked up":     {inspection: input.HandoverInspection{OdometerKm: 12420, FuelLevel: 90}, wantErr: entity.ErrRentalNotPickedUp},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			m, rentalService := setupRentalTest(t)
+			ctx := context.Background()
+			rental := startedRental()
+			pickup, err := entity.NewPickupHandover(rental, entity.HandoverReading{OdometerKm: 12000, FuelLevel: 90}, rental.StartsAt)
+			require.NoError(t, err)
+
+			// Set up expectations
+			gomock.InOrder(
+				m.rentalRepo.EXPECT().GetByID(ctx, "south", "rental-1").Return(rental, nil),
+				m.rentalRepo.EXPECT().LockCar(ctx, "car-1").Return(nil),
+				m.rentalRepo.EXPECT().GetByID(ctx, "south", "rental-1").Return(rental, nil),
+			)
+			mockUow := mock_repository.NewMockUnitOfWork(m.ctrl)
+			if tt.pickedUp {
+				m.handoverRepo.EXPECT().GetByRental(ctx, "south", "rental-1", entity.HandoverKindPickup).Return(pickup, nil)
+				m.uowFactory.EXPECT().New().Return(mockUow)
+				mockUow.EXPECT().RegisterDirty(rental)
+			} else {
+				m.handoverRepo.EXPECT().GetByRental(ctx, "south", "rental-1", entity.HandoverKindPickup).
+					Return(nil, repository.ErrNotFound)
+			}
+			if tt.wantErr == nil {
+				mockUow.EXPECT().RegisterNew(gomock.Any())
+				mockUow.EXPECT().Commit(ctx).Return(nil)
+			}
+
+			// Execute
+			inspection := tt.inspection
+			returned, err := rentalService.Return(ctx, input.ReturnRental{TenantID: "south", ID: "rental-1", Inspection: &inspection})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, returned.Rental.Returned())
+			require.NotNil(t, returned.Handover)
+			assert.Equal(t, entity.HandoverKindReturn, returned.Handover.Kind)
+			assert.Equal(t, tt.wantDistance, returned.Handover.DistanceKm)
+			assert.Equal(t, tt.wantShortfall, returned.Handover.FuelShortfall)
+		})
+	}
+}
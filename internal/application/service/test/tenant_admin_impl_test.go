@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// setupTenantAdminTest creates a mock repository and a tenant admin service for testing
+func setupTenantAdminTest(t *testing.T) (*mock_repository.MockAPIKeyRepository, service.TenantAdminService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockAPIKeyRepo := mock_repository.NewMockAPIKeyRepository(ctrl)
+	return mockAPIKeyRepo, service.NewTenantAdminService(mockAPIKeyRepo)
+}
+
+// newTestAPIKey creates an API key for testing
+func newTestAPIKey(t *testing.T) (*entity.APIKey, string) {
+	t.Helper()
+	apiKey, key, err := entity.NewAPIKey("tenant-123", "CI", []string{"cars:read"}, null.Time{}, time.Now())
+	require.NoError(t, err)
+	return apiKey, key
+}
+
+// TestTenantAdminService_CreateAPIKey tests that a key is created and returned once in plain text
+func TestTenantAdminService_CreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockAPIKeyRepo, tenantAdminService := setupTenantAdminTest(t)
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	// Set up expectations
+	var stored *entity.APIKey
+	mockAPIKeyRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, apiKey *entity.APIKey) error {
+			stored = apiKey
+			return nil
+		},
+	)
+
+	// Execute
+	apiKey, key, err := tenantAdminService.CreateAPIKey(ctx, input.CreateAPIKey{
+		TenantID:  "tenant-123",
+		Name:      "CI",
+		Scopes:    []string{"cars:read"},
+		ExpiresAt: &expiresAt,
+	})
+	require.NoError(t, err)
+	assert.Same(t, stored, apiKey)
+	assert.Equal(t, "tenant-123", apiKey.TenantID)
+	assert.Equal(t, []string{"cars:read"}, apiKey.Scopes)
+	assert.True(t, apiKey.ExpiresAt.Time.Equal(expiresAt))
+	assert.True(t, apiKey.Matches(key))
+	assert.NotEqual(t, key, apiKey.SecretHash)
+}
+
+// TestTenantAdminService_CreateAPIKey_Validation tests that invalid keys are not created
+func TestTenantAdminService_CreateAPIKey_Validation(t *testing.T) {
+	t.Parallel()
+
+	past := time.Now().Add(-time.Hour)
+	tests := map[string]input.CreateAPIKey{
+		"no tenant":   {Name: "CI"},
+		"no name":     {TenantID: "tenant-123"},
+		"empty scope": {TenantID: "tenant-123", Name: "CI", Scopes: []string{""}},
+		"past expiry": {TenantID: "tenant-123", Name: "CI", ExpiresAt: &past},
+	}
+
+	for name, in := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup; the repository must not be called
+			_, tenantAdminService := setupTenantAdminTest(t)
+
+			// Execute
+			_, _, err := tenantAdminService.CreateAPIKey(context.Background(), in)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestTenantAdminService_RotateAPIKey tests that rotation replaces the key
+func TestTenantAdminService_RotateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockAPIKeyRepo, tenantAdminService := setupTenantAdminTest(t)
+	apiKey, oldKey := newTestAPIKey(t)
+
+	// Set up expectations
+	mockAPIKeyRepo.EXPECT().GetByID(ctx, "tenant-123", apiKey.ID).Return(apiKey, nil)
+	mockAPIKeyRepo.EXPECT().Update(ctx, apiKey).Return(nil)
+
+	// Execute
+	rotated, newKey, err := tenantAdminService.RotateAPIKey(ctx, input.RotateAPIKey{TenantID: "tenant-123", ID: apiKey.ID})
+	require.NoError(t, err)
+	assert.False(t, rotated.Matches(oldKey))
+	assert.True(t, rotated.Matches(newKey))
+}
+
+// TestTenantAdminService_RotateAPIKey_Revoked tests that a revoked key cannot be brought back by rotation
+func TestTenantAdminService_RotateAPIKey_Revoked(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockAPIKeyRepo, tenantAdminService := setupTenantAdminTest(t)
+	apiKey, _ := newTestAPIKey(t)
+	apiKey.Revoke(time.Now())
+
+	// Set up expectations
+	mockAPIKeyRepo.EXPECT().GetByID(ctx, "tenant-123", apiKey.ID).Return(apiKey, nil)
+
+	// Execute
+	_, _, err := tenantAdminService.RotateAPIKey(ctx, input.RotateAPIKey{TenantID: "tenant-123", ID: apiKey.ID})
+	assert.Error(t, err)
+}
+
+// TestTenantAdminService_RevokeAPIKey tests that revocation disables the key
+func TestTenantAdminService_RevokeAPIKey(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockAPIKeyRepo, tenantAdminService := setupTenantAdminTest(t)
+	apiKey, _ := newTestAPIKey(t)
+
+	// Set up expectations
+	mockAPIKeyRepo.EXPECT().GetByID(ctx, "tenant-123", apiKey.ID).Return(apiKey, nil)
+	mockAPIKeyRepo.EXPECT().Update(ctx, apiKey).Return(nil)
+
+	// Execute
+	revoked, err := tenantAdminService.RevokeAPIKey(ctx, input.RevokeAPIKey{TenantID: "tenant-123", ID: apiKey.ID})
+	require.NoError(t, err)
+	assert.True(t, revoked.RevokedAt.Valid)
+	assert.False(t, revoked.Active(time.Now()))
+}
+
+// TestTenantAdminService_ListAPIKeys tests paging through a tenant's keys
+func TestTenantAdminService_ListAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mockAPIKeyRepo, tenantAdminService := setupTenantAdminTest(t)
+	apiKey, _ := newTestAPIKey(t)
+
+	// Set up expectations
+	mockAPIKeyRepo.EXPECT().ListByTenant(ctx, "tenant-123", 1, 0).Return([]*entity.APIKey{apiKey}, nil)
+
+	// Execute
+	listOutput, err := tenantAdminService.ListAPIKeys(ctx, input.ListAPIKeys{TenantID: "tenant-123", PageSize: 1})
+	require.NoError(t, err)
+	assert.Len(t, listOutput.APIKeys, 1)
+	assert.Equal(t, "1", listOutput.NextPageToken)
+}
@@ -0,0 +1,213 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/archive"
+	mock_archive "github.com/jp-ryuji/go-arch-patterns/internal/application/archive/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// tenantArchiveMocks holds the mocks behind a tenant archive service
+type tenantArchiveMocks struct {
+	jobRepo    *mock_repository.MockArchiveJobRepository
+	tenantRepo *mock_repository.MockTenantRepository
+	store      *mock_archive.MockStore
+	storage    *mock_archive.MockStorage
+}
+
+// setupTenantArchiveTest creates mocks and a tenant archive service for testing
+func setupTenantArchiveTest(t *testing.T) (tenantArchiveMocks, service.TenantArchiveService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mocks := tenantArchiveMocks{
+		jobRepo:    mock_repository.NewMockArchiveJobRepository(ctrl),
+		tenantRepo: mock_repository.NewMockTenantRepository(ctrl),
+		store:      mock_archive.NewMockStore(ctrl),
+		storage:    mock_archive.NewMockStorage(ctrl),
+	}
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().RunInTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+	return mocks, service.NewTenantArchiveService(
+		mocks.jobRepo,
+		mocks.tenantRepo,
+		archive.NewExporter(mockTxManager, mocks.store),
+		archive.NewImporter(mockTxManager, mocks.store),
+		mocks.storage,
+	)
+}
+
+// archiveBuffer is an archive kept in memory
+type archiveBuffer struct {
+	bytes.Buffer
+}
+
+func (b *archiveBuffer) Close() error {
+	return nil
+}
+
+// awaitJobUpdate returns a channel receiving the job once its outcome is recorded
+func awaitJobUpdate(mockJobRepo *mock_repository.MockArchiveJobRepository) <-chan *entity.ArchiveJob {
+	updated := make(chan *entity.ArchiveJob, 1)
+	mockJobRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, job *entity.ArchiveJob) error {
+			updated <- job
+			return nil
+		},
+	)
+	return updated
+}
+
+// TestTenantArchiveService_Export tests that an export job writes the archive in the background
+func TestTenantArchiveService_Export(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mocks, archiveService := setupTenantArchiveTest(t)
+	tenant := entity.NewTenant("acme", time.Now())
+	buf := &archiveBuffer{}
+
+	// Set up expectations
+	mocks.tenantRepo.EXPECT().GetByID(ctx, tenant.ID).Return(tenant, nil)
+	mocks.storage.EXPECT().Create(ctx, gomock.Any()).Return(buf, nil)
+	mocks.jobRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	mocks.store.EXPECT().ExportTenant(gomock.Any(), tenant.ID).Return(&archive.Tenant{
+		ID: tenant.ID, Code: tenant.Code, Status: "active", Isolation: "shared",
+	}, nil)
+	mocks.store.EXPECT().Export(gomock.Any(), tenant.ID, gomock.Any()).Return(nil)
+	updated := awaitJobUpdate(mocks.jobRepo)
+
+	// Execute
+	job, err := archiveService.Export(ctx, input.ExportTenant{TenantID: tenant.ID})
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, entity.ArchiveJobStatusRunning, job.Status)
+	assert.Equal(t, fmt.Sprintf("acme-%s.ndjson", job.ID), job.Archive)
+
+	finished := <-updated
+	assert.Equal(t, entity.ArchiveJobStatusSucceeded, finished.Status)
+	assert.Equal(t, map[string]int{"tenant": 1}, finished.Counts)
+	assert.True(t, finished.FinishedAt.Valid)
+	assert.Contains(t, buf.String(), `"kind":"trailer"`)
+}
+
+// TestTenantArchiveService_Export_Failure tests that a failed export is recorded and its archive removed
+func TestTenantArchiveService_Export_Failure(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mocks, archiveService := setupTenantArchiveTest(t)
+	tenant := entity.NewTenant("acme", time.Now())
+
+	// Set up expectations
+	mocks.tenantRepo.EXPECT().GetByID(ctx, tenant.ID).Return(tenant, nil)
+	mocks.storage.EXPECT().Create(ctx, gomock.Any()).Return(&archiveBuffer{}, nil)
+	mocks.jobRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+	mocks.store.EXPECT().ExportTenant(gomock.Any(), tenant.ID).Return(nil, assert.AnError)
+	mocks.storage.EXPECT().Remove(gomock.Any(), gomock.Any()).Return(nil)
+	updated := awaitJobUpdate(mocks.jobRepo)
+
+	// Execute
+	_, err := archiveService.Export(ctx, input.ExportTenant{TenantID: tenant.ID})
+	require.NoError(t, err)
+
+	// Assert
+	finished := <-updated
+	assert.Equal(t, entity.ArchiveJobStatusFailed, finished.Status)
+	assert.Contains(t, finished.Error.String, assert.AnError.Error())
+}
+
+// TestTenantArchiveService_Export_TenantNotFound tests that no job is started for unknown tenants
+func TestTenantArchiveService_Export_TenantNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mocks, archiveService := setupTenantArchiveTest(t)
+
+	// Set up expectations
+	mocks.tenantRepo.EXPECT().GetByID(ctx, "missing").Return(nil, repository.ErrNotFound)
+
+	// Execute
+	_, err := archiveService.Export(ctx, input.ExportTenant{TenantID: "missing"})
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestTenantArchiveService_Import_InvalidInput tests that imports are validated before starting
+func TestTenantArchiveService_Import_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]input.ImportTenant{
+		"missing archive": {},
+		"archive path":    {Archive: "../acme.ndjson"},
+		"invalid code":    {Archive: "acme.ndjson", Code: "Acme"},
+	}
+
+	for name, in := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			_, archiveService := setupTenantArchiveTest(t)
+
+			// Execute
+			_, err := archiveService.Import(context.Background(), in)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestTenantArchiveService_Import_ArchiveNotFound tests that importing a missing archive is not found
+func TestTenantArchiveService_Import_ArchiveNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mocks, archiveService := setupTenantArchiveTest(t)
+
+	// Set up expectations
+	mocks.storage.EXPECT().Open(ctx, "acme.ndjson").Return(nil, fmt.Errorf("open acme.ndjson: %w", fs.ErrNotExist))
+
+	// Execute
+	_, err := archiveService.Import(ctx, input.ImportTenant{Archive: "acme.ndjson"})
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestTenantArchiveService_GetJob tests retrieving a job
+func TestTenantArchiveService_GetJob(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	mocks, archiveService := setupTenantArchiveTest(t)
+	job := entity.NewArchiveJob(entity.ArchiveJobKindImport, null.String{}, "acme.ndjson", time.Now())
+
+	// Set up expectations
+	mocks.jobRepo.EXPECT().GetByID(ctx, job.ID).Return(job, nil)
+
+	// Execute
+	got, err := archiveService.GetJob(ctx, input.GetArchiveJob{ID: job.ID})
+	require.NoError(t, err)
+	assert.Equal(t, job, got)
+}
@@ -0,0 +1,412 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// setupTenantTest creates mocks and a tenant service whose transactions run inline
+func setupTenantTest(t *testing.T) (*gomock.Controller, *mock_repository.MockTenantRepository, *mock_repository.MockPlanRepository, *mock_repository.MockUnitOfWorkFactory, service.TenantService) {
+	t.Helper()
+	ctrl, mockTenantRepo, mockPlanRepo, _, mockUowFactory, tenantService := setupTenantDeletionTest(t)
+	return ctrl, mockTenantRepo, mockPlanRepo, mockUowFactory, tenantService
+}
+
+// setupTenantDeletionTest is setupTenantTest with the tenant data repository, for a grace
+// period of a week
+func setupTenantDeletionTest(t *testing.T) (*gomock.Controller, *mock_repository.MockTenantRepository, *mock_repository.MockPlanRepository, *mock_repository.MockTenantDataRepository, *mock_repository.MockUnitOfWorkFactory, service.TenantService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	mockPlanRepo := mock_repository.NewMockPlanRepository(ctrl)
+	mockDataRepo := mock_repository.NewMockTenantDataRepository(ctrl)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().RunInTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+	mockUowFactory := mock_repository.NewMockUnitOfWorkFactory(ctrl)
+	tenantService := service.NewTenantService(mockTenantRepo, mockPlanRepo, mockDataRepo, mockTxManager, mockUowFactory, service.TenantServiceConfig{
+		DeletionGracePeriod: 7 * 24 * time.Hour,
+	})
+	return ctrl, mockTenantRepo, mockPlanRepo, mockDataRepo, mockUowFactory, tenantService
+}
+
+// TestTenantService_Create tests that a tenant is created with its TenantCreated event
+func TestTenantService_Create(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl, mockTenantRepo, _, mockUowFactory, tenantService := setupTenantTest(t)
+	ctx := context.Background()
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByCode(ctx, "acme").Return(nil, repository.ErrNotFound)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterNew(gomock.Any()).Do(func(aggregate entity.Aggregate) {
+		tenant, ok := aggregate.(*entity.Tenant)
+		require.True(t, ok)
+		assert.Equal(t, "acme", tenant.Code)
+		assert.Equal(t, entity.TenantStatusActive, tenant.Status)
+		assert.Equal(t, []entity.DomainEvent{entity.TenantCreated{
+			ID:        tenant.ID,
+			Code:      "acme",
+			CreatedAt: tenant.CreatedAt,
+		}}, tenant.Events())
+	})
+	mockUow.EXPECT().Commit(ctx).Return(nil)
+
+	// Execute
+	tenant, err := tenantService.Create(ctx, input.CreateTenant{Code: "acme"})
+	require.NoError(t, err)
+	assert.Equal(t, "acme", tenant.Code)
+}
+
+// TestTenantService_Create_Invalid tests that invalid and taken codes are rejected
+func TestTenantService_Create_Invalid(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, mockTenantRepo, _, _, tenantService := setupTenantTest(t)
+	ctx := context.Background()
+
+	// Execute: the repository is not queried for malformed codes
+	_, err := tenantService.Create(ctx, input.CreateTenant{Code: "Not A Code"})
+	assert.Error(t, err)
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByCode(ctx, "acme").Return(entity.NewTenant("acme", time.Now()), nil)
+
+	// Execute
+	_, err = tenantService.Create(ctx, input.CreateTenant{Code: "acme"})
+	assert.ErrorIs(t, err, repository.ErrAlreadyExists)
+}
+
+// TestTenantService_Create_WithIsolation tests that tenants are created in the requested
+// isolation mode and share tables by default
+func TestTenantService_Create_WithIsolation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		isolation string
+		want      entity.TenantIsolation
+	}{
+		"default":  {isolation: "", want: entity.TenantIsolationShared},
+		"shared":   {isolation: "shared", want: entity.TenantIsolationShared},
+		"schema":   {isolation: "schema", want: entity.TenantIsolationSchema},
+		"database": {isolation: "database", want: entity.TenantIsolationDatabase},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctrl, mockTenantRepo, _, mockUowFactory, tenantService := setupTenantTest(t)
+			ctx := context.Background()
+
+			// Set up expectations
+			mockTenantRepo.EXPECT().GetByCode(ctx, "acme").Return(nil, repository.ErrNotFound)
+			mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+			mockUowFactory.EXPECT().New().Return(mockUow)
+			mockUow.EXPECT().RegisterNew(gomock.Any())
+			mockUow.EXPECT().Commit(ctx).Return(nil)
+
+			// Execute
+			tenant, err := tenantService.Create(ctx, input.CreateTenant{Code: "acme", Isolation: tt.isolation})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tenant.Isolation)
+		})
+	}
+
+	// Unknown modes are rejected before anything is created
+	_, _, _, _, tenantService := setupTenantTest(t)
+	_, err := tenantService.Create(context.Background(), input.CreateTenant{Code: "acme", Isolation: "cluster"})
+	assert.Error(t, err)
+}
+
+// TestTenantService_Get tests that tenants are found by ID or by code
+func TestTenantService_Get(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, mockTenantRepo, _, _, tenantService := setupTenantTest(t)
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(ctx, tenant.ID).Return(tenant, nil)
+	mockTenantRepo.EXPECT().GetByCode(ctx, "acme").Return(tenant, nil)
+
+	// Execute
+	got, err := tenantService.Get(ctx, input.GetTenant{ID: tenant.ID})
+	require.NoError(t, err)
+	assert.Equal(t, tenant, got)
+
+	got, err = tenantService.Get(ctx, input.GetTenant{Code: "acme"})
+	require.NoError(t, err)
+	assert.Equal(t, tenant, got)
+
+	// Exactly one of ID and code is required
+	_, err = tenantService.Get(ctx, input.GetTenant{})
+	assert.Error(t, err)
+	_, err = tenantService.Get(ctx, input.GetTenant{ID: tenant.ID, Code: "acme"})
+	assert.Error(t, err)
+}
+
+// TestTenantService_SuspendReactivate tests that lifecycle changes are committed with their events
+func TestTenantService_SuspendReactivate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl, mockTenantRepo, _, mockUowFactory, tenantService := setupTenantTest(t)
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+	tenant.ClearEvents()
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), tenant.ID).Return(tenant, nil).Times(2)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow).Times(2)
+	gomock.InOrder(
+		mockUow.EXPECT().RegisterDirty(tenant).Do(func(entity.Aggregate) {
+			require.Len(t, tenant.Events(), 1)
+			assert.Equal(t, "tenant_suspended", tenant.Events()[0].EventType())
+			tenant.ClearEvents()
+		}),
+		mockUow.EXPECT().Commit(gomock.Any()).Return(nil),
+		mockUow.EXPECT().RegisterDirty(tenant).Do(func(entity.Aggregate) {
+			require.Len(t, tenant.Events(), 1)
+			assert.Equal(t, "tenant_reactivated", tenant.Events()[0].EventType())
+		}),
+		mockUow.EXPECT().Commit(gomock.Any()).Return(nil),
+	)
+
+	// Execute
+	suspended, err := tenantService.Suspend(ctx, input.SuspendTenant{ID: tenant.ID})
+	require.NoError(t, err)
+	assert.True(t, suspended.Suspended())
+
+	reactivated, err := tenantService.Reactivate(ctx, input.ReactivateTenant{ID: tenant.ID})
+	require.NoError(t, err)
+	assert.False(t, reactivated.Suspended())
+}
+
+// TestTenantService_Suspend_AlreadySuspended tests that suspending twice records nothing
+func TestTenantService_Suspend_AlreadySuspended(t *testing.T) {
+	t.Parallel()
+
+	// Setup; no unit of work is started
+	_, mockTenantRepo, _, _, tenantService := setupTenantTest(t)
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+	require.NoError(t, tenant.Suspend(time.Now()))
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), tenant.ID).Return(tenant, nil)
+
+	// Execute
+	_, err := tenantService.Suspend(ctx, input.SuspendTenant{ID: tenant.ID})
+	assert.ErrorIs(t, err, entity.ErrTenantSuspended)
+}
+
+// TestTenantService_Create_WithPlan tests that a tenant created on a plan records it
+func TestTenantService_Create_WithPlan(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl, mockTenantRepo, mockPlanRepo, mockUowFactory, tenantService := setupTenantTest(t)
+	ctx := context.Background()
+	plan := entity.NewPlan("standard", "Standard", entity.PlanLimits{MaxCars: 50}, time.Now())
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByCode(ctx, "acme").Return(nil, repository.ErrNotFound)
+	mockPlanRepo.EXPECT().GetByCode(ctx, "standard").Return(plan, nil)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterNew(gomock.Any()).Do(func(aggregate entity.Aggregate) {
+		tenant, ok := aggregate.(*entity.Tenant)
+		require.True(t, ok)
+		require.Len(t, tenant.Events(), 2)
+		assert.Equal(t, "tenant_created", tenant.Events()[0].EventType())
+		assert.Equal(t, "tenant_plan_changed", tenant.Events()[1].EventType())
+	})
+	mockUow.EXPECT().Commit(ctx).Return(nil)
+
+	// Execute
+	tenant, err := tenantService.Create(ctx, input.CreateTenant{Code: "acme", PlanCode: "standard"})
+	require.NoError(t, err)
+	assert.Equal(t, plan.ID, tenant.PlanID.String)
+
+	// Unknown plans are not found
+	mockTenantRepo.EXPECT().GetByCode(ctx, "globex").Return(nil, repository.ErrNotFound)
+	mockPlanRepo.EXPECT().GetByCode(ctx, "unknown").Return(nil, repository.ErrNotFound)
+	_, err = tenantService.Create(ctx, input.CreateTenant{Code: "globex", PlanCode: "unknown"})
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestTenantService_Create_WithParent tests that tenants join the franchise of their parent,
+// which cannot be a child itself
+func TestTenantService_Create_WithParent(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl, mockTenantRepo, _, mockUowFactory, tenantService := setupTenantTest(t)
+	ctx := context.Background()
+	parent := entity.NewTenant("franchise", time.Now())
+	child := entity.NewTenant("north", time.Now())
+	require.NoError(t, child.JoinFranchise(parent))
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByCode(ctx, "south").Return(nil, repository.ErrNotFound)
+	mockTenantRepo.EXPECT().GetByID(ctx, parent.ID).Return(parent, nil)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterNew(gomock.Any())
+	mockUow.EXPECT().Commit(ctx).Return(nil)
+
+	// Execute
+	tenant, err := tenantService.Create(ctx, input.CreateTenant{Code: "south", ParentID: parent.ID})
+	require.NoError(t, err)
+	assert.Equal(t, parent.ID, tenant.ParentID.String)
+	assert.True(t, tenant.SiblingOf(child))
+
+	// Franchises have a single level
+	mockTenantRepo.EXPECT().GetByCode(ctx, "east").Return(nil, repository.ErrNotFound)
+	mockTenantRepo.EXPECT().GetByID(ctx, child.ID).Return(child, nil)
+	_, err = tenantService.Create(ctx, input.CreateTenant{Code: "east", ParentID: child.ID})
+	assert.ErrorIs(t, err, entity.ErrTenantParentIsChild)
+}
+
+// TestTenantService_ChangePlan tests that plan changes are committed with their event
+func TestTenantService_ChangePlan(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl, mockTenantRepo, mockPlanRepo, mockUowFactory, tenantService := setupTenantTest(t)
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+	tenant.ClearEvents()
+	plan := entity.NewPlan("enterprise", "Enterprise", entity.PlanLimits{}, time.Now())
+
+	// Set up expectations
+	mockPlanRepo.EXPECT().GetByCode(ctx, "enterprise").Return(plan, nil)
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), tenant.ID).Return(tenant, nil)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow)
+	mockUow.EXPECT().RegisterDirty(tenant).Do(func(entity.Aggregate) {
+		assert.Equal(t, []entity.DomainEvent{entity.TenantPlanChanged{
+			ID:        tenant.ID,
+			Code:      "acme",
+			PlanID:    plan.ID,
+			ChangedAt: tenant.UpdatedAt,
+		}}, tenant.Events())
+	})
+	mockUow.EXPECT().Commit(gomock.Any()).Return(nil)
+
+	// Execute
+	changed, err := tenantService.ChangePlan(ctx, input.ChangeTenantPlan{ID: tenant.ID, PlanCode: "enterprise"})
+	require.NoError(t, err)
+	assert.Equal(t, plan.ID, changed.PlanID.String)
+}
+
+// TestTenantService_ScheduleCancelDeletion tests that a deletion is scheduled after the grace
+// period and can be canceled within it
+func TestTenantService_ScheduleCancelDeletion(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl, mockTenantRepo, _, _, mockUowFactory, tenantService := setupTenantDeletionTest(t)
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+	tenant.ClearEvents()
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), tenant.ID).Return(tenant, nil).Times(2)
+	mockUow := mock_repository.NewMockUnitOfWork(ctrl)
+	mockUowFactory.EXPECT().New().Return(mockUow).Times(2)
+	gomock.InOrder(
+		mockUow.EXPECT().RegisterDirty(tenant).Do(func(entity.Aggregate) {
+			require.Len(t, tenant.Events(), 1)
+			assert.Equal(t, "tenant_deletion_scheduled", tenant.Events()[0].EventType())
+			tenant.ClearEvents()
+		}),
+		mockUow.EXPECT().Commit(gomock.Any()).Return(nil),
+		mockUow.EXPECT().RegisterDirty(tenant).Do(func(entity.Aggregate) {
+			require.Len(t, tenant.Events(), 1)
+			assert.Equal(t, "tenant_deletion_canceled", tenant.Events()[0].EventType())
+		}),
+		mockUow.EXPECT().Commit(gomock.Any()).Return(nil),
+	)
+
+	// Execute
+	scheduled, err := tenantService.ScheduleDeletion(ctx, input.ScheduleTenantDeletion{ID: tenant.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entity.TenantStatusPendingDeletion, scheduled.Status)
+	assert.Equal(t, 7*24*time.Hour, scheduled.PurgeAt.Time.Sub(scheduled.UpdatedAt))
+
+	canceled, err := tenantService.CancelDeletion(ctx, input.CancelTenantDeletion{ID: tenant.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entity.TenantStatusActive, canceled.Status)
+	assert.False(t, canceled.PurgeAt.Valid)
+}
+
+// TestTenantService_CancelDeletion_GracePeriodOver tests that a due deletion cannot be canceled
+func TestTenantService_CancelDeletion_GracePeriodOver(t *testing.T) {
+	t.Parallel()
+
+	// Setup; no unit of work is started
+	_, mockTenantRepo, _, _, _, tenantService := setupTenantDeletionTest(t)
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+	require.NoError(t, tenant.ScheduleDeletion(time.Hour, time.Now().Add(-2*time.Hour)))
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), tenant.ID).Return(tenant, nil)
+
+	// Execute
+	_, err := tenantService.CancelDeletion(ctx, input.CancelTenantDeletion{ID: tenant.ID})
+	assert.ErrorIs(t, err, entity.ErrTenantGracePeriodOver)
+}
+
+// TestTenantService_PurgeReport tests that the report counts the rows of the tenant
+func TestTenantService_PurgeReport(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, mockTenantRepo, _, mockDataRepo, _, tenantService := setupTenantDeletionTest(t)
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+	rows := entity.TenantRows{{Table: "rentals", Rows: 3}, {Table: "cars", Rows: 2}}
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByID(ctx, tenant.ID).Return(tenant, nil)
+	mockDataRepo.EXPECT().Count(gomock.Any(), tenant.ID).DoAndReturn(
+		func(ctx context.Context, _ string) (entity.TenantRows, error) {
+			tenantID, ok := tenantctx.TenantID(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, tenant.ID, tenantID)
+			return rows, nil
+		},
+	)
+
+	// Execute
+	got, err := tenantService.PurgeReport(ctx, input.GetTenantPurgeReport{ID: tenant.ID})
+	require.NoError(t, err)
+	assert.Equal(t, rows, got)
+	assert.Equal(t, 5, got.Total())
+}
@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+)
+
+// setupTenantSettingsTest creates mocks and a tenant settings service
+func setupTenantSettingsTest(t *testing.T) (*mock_repository.MockTenantSettingsRepository, service.TenantSettingsService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockSettingsRepo := mock_repository.NewMockTenantSettingsRepository(ctrl)
+	return mockSettingsRepo, service.NewTenantSettingsService(mockSettingsRepo)
+}
+
+// TestTenantSettingsService_Get_Defaults tests that tenants without settings get the defaults
+func TestTenantSettingsService_Get_Defaults(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockSettingsRepo, settingsService := setupTenantSettingsTest(t)
+	ctx := context.Background()
+
+	// Set up expectations
+	mockSettingsRepo.EXPECT().Get(ctx, "tenant-a").Return(nil, repository.ErrNotFound)
+
+	// Execute
+	settings, err := settingsService.Get(ctx, input.GetTenantSettings{TenantID: "tenant-a"})
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", settings.TenantID)
+	assert.Equal(t, entity.DefaultTimezone, settings.Timezone)
+	assert.Equal(t, entity.DefaultCurrency, settings.Currency)
+	assert.Equal(t, entity.DefaultLocale, settings.Locale)
+}
+
+// TestTenantSettingsService_Get_Cache tests that settings are loaded once per tenant within a request
+func TestTenantSettingsService_Get_Cache(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockSettingsRepo, settingsService := setupTenantSettingsTest(t)
+	ctx := service.WithTenantSettingsCache(context.Background())
+	stored := entity.DefaultTenantSettings("tenant-a", time.Now())
+
+	// Set up expectations
+	mockSettingsRepo.EXPECT().Get(ctx, "tenant-a").Return(stored, nil).Times(1)
+	mockSettingsRepo.EXPECT().Get(ctx, "tenant-b").Return(nil, repository.ErrNotFound).Times(1)
+
+	// Execute
+	for range 2 {
+		settings, err := settingsService.Get(ctx, input.GetTenantSettings{TenantID: "tenant-a"})
+		require.NoError(t, err)
+		assert.Same(t, stored, settings)
+
+		settings, err = settingsService.Get(ctx, input.GetTenantSettings{TenantID: "tenant-b"})
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-b", settings.TenantID)
+	}
+
+	// A new request loads them again
+	otherCtx := service.WithTenantSettingsCache(context.Background())
+	mockSettingsRepo.EXPECT().Get(otherCtx, "tenant-a").Return(stored, nil).Times(1)
+	_, err := settingsService.Get(otherCtx, input.GetTenantSettings{TenantID: "tenant-a"})
+	require.NoError(t, err)
+}
+
+// TestTenantSettingsService_Update tests that settings are created the first time and updated afterwards
+func TestTenantSettingsService_Update(t *testing.T) {
+	t.Parallel()
+
+	updateInput := input.UpdateTenantSettings{
+		TenantID: "tenant-a",
+		Timezone: "Asia/Tokyo",
+		Currency: "JPY",
+		Locale:   "ja-JP",
+		BusinessHours: []input.OpeningHours{
+			{Weekday: time.Tuesday, Opens: "09:00", Closes: "18:00"},
+			{Weekday: time.Monday, Opens: "09:00", Closes: "18:00"},
+		},
+	}
+	wantHours := entity.BusinessHours{
+		{Weekday: time.Monday, Opens: 9 * 60, Closes: 18 * 60},
+		{Weekday: time.Tuesday, Opens: 9 * 60, Closes: 18 * 60},
+	}
+
+	tests := map[string]struct {
+		stored *entity.TenantSettings
+	}{
+		"first change":  {},
+		"later changes": {stored: entity.DefaultTenantSettings("tenant-a", time.Now())},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			mockSettingsRepo, settingsService := setupTenantSettingsTest(t)
+			ctx := service.WithTenantSettingsCache(context.Background())
+
+			// Set up expectations
+			if tt.stored == nil {
+				mockSettingsRepo.EXPECT().Get(ctx, "tenant-a").Return(nil, repository.ErrNotFound)
+				mockSettingsRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			} else {
+				mockSettingsRepo.EXPECT().Get(ctx, "tenant-a").Return(tt.stored, nil)
+				mockSettingsRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+			}
+
+			// Execute
+			settings, err := settingsService.Update(ctx, updateInput)
+			require.NoError(t, err)
+			assert.Equal(t, "Asia/Tokyo", settings.Timezone)
+			assert.Equal(t, wantHours, settings.BusinessHours)
+
+			// The request sees the new settings without loading them again
+			cached, err := settingsService.Get(ctx, input.GetTenantSettings{TenantID: "tenant-a"})
+			require.NoError(t, err)
+			assert.Same(t, settings, cached)
+		})
+	}
+}
+
+// TestTenantSettingsService_Update_Invalid tests that invalid settings are not saved
+func TestTenantSettingsService_Update_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input  input.UpdateTenantSettings
+		loaded bool
+	}{
+		"missing currency": {
+			input: input.UpdateTenantSettings{TenantID: "tenant-a", Timezone: "UTC", Locale: "en-US"},
+		},
+		"malformed time": {
+			input: input.UpdateTenantSettings{TenantID: "tenant-a", Timezone: "UTC", Currency: "USD", Locale: "en-US",
+				BusinessHours: []input.OpeningHours{{Weekday: time.Monday, Opens: "9am", Closes: "18:00"}}},
+		},
+		"unknown timezone": {
+			input:  input.UpdateTenantSettings{TenantID: "tenant-a", Timezone: "Mars/Olympus", Currency: "USD", Locale: "en-US"},
+			loaded: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			mockSettingsRepo, settingsService := setupTenantSettingsTest(t)
+			ctx := context.Background()
+
+			// Set up expectations: nothing is saved
+			if tt.loaded {
+				mockSettingsRepo.EXPECT().Get(ctx, "tenant-a").Return(nil, repository.ErrNotFound)
+			}
+
+			// Execute
+			_, err := settingsService.Update(ctx, tt.input)
+			assert.Error(t, err)
+		})
+	}
+}
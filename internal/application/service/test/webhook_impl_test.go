@@ -0,0 +1,122 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupWebhookTest creates mock repositories and a webhook service for testing
+func setupWebhookTest(t *testing.T) (*mock_repository.MockWebhookEndpointRepository, *mock_repository.MockWebhookDeliveryRepository, service.WebhookService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mockEndpointRepo := mock_repository.NewMockWebhookEndpointRepository(ctrl)
+	mockDeliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+	webhookService := service.NewWebhookService(mockEndpointRepo, mockDeliveryRepo)
+	return mockEndpointRepo, mockDeliveryRepo, webhookService
+}
+
+// TestWebhookService_CreateEndpoint tests endpoint registration and its validation
+func TestWebhookService_CreateEndpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		input   input.CreateWebhookEndpoint
+		wantErr bool
+	}{
+		"ok": {
+			input: input.CreateWebhookEndpoint{TenantID: "tenant-123", URL: "https://example.com/hooks", EventTypes: []string{"car_created"}},
+		},
+		"ng (plain http)": {
+			input:   input.CreateWebhookEndpoint{TenantID: "tenant-123", URL: "http://example.com/hooks", EventTypes: []string{"car_created"}},
+			wantErr: true,
+		},
+		"ng (no event types)": {
+			input:   input.CreateWebhookEndpoint{TenantID: "tenant-123", URL: "https://example.com/hooks"},
+			wantErr: true,
+		},
+		"ng (missing tenant)": {
+			input:   input.CreateWebhookEndpoint{URL: "https://example.com/hooks", EventTypes: []string{"car_created"}},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			mockEndpointRepo, _, webhookService := setupWebhookTest(t)
+			ctx := context.Background()
+			if !tt.wantErr {
+				mockEndpointRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			}
+
+			// Execute
+			endpoint, err := webhookService.CreateEndpoint(ctx, tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, endpoint.Enabled)
+			assert.True(t, strings.HasPrefix(endpoint.Secret, "whsec_"))
+		})
+	}
+}
+
+// TestWebhookService_UpdateEndpoint_Enable tests that re-enabling an endpoint clears its failure streak
+func TestWebhookService_UpdateEndpoint_Enable(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	mockEndpointRepo, _, webhookService := setupWebhookTest(t)
+	ctx := context.Background()
+
+	endpoint := &entity.WebhookEndpoint{ID: "endpoint-1", TenantID: "tenant-123", ConsecutiveFailures: entity.MaxWebhookConsecutiveFailures}
+	endpoint.Disable(endpoint.UpdatedAt, "too many consecutive delivery failures")
+
+	mockEndpointRepo.EXPECT().GetByID(ctx, "tenant-123", "endpoint-1").Return(endpoint, nil)
+	mockEndpointRepo.EXPECT().Update(ctx, endpoint).Return(nil)
+
+	// Execute
+	enabled := true
+	updated, err := webhookService.UpdateEndpoint(ctx, input.UpdateWebhookEndpoint{TenantID: "tenant-123", ID: "endpoint-1", Enabled: &enabled})
+	assert.NoError(t, err)
+	assert.True(t, updated.Enabled)
+	assert.Zero(t, updated.ConsecutiveFailures)
+	assert.False(t, updated.DisabledAt.Valid)
+}
+
+// TestWebhookService_ListDeliveries tests filtering and paging of the delivery log
+func TestWebhookService_ListDeliveries(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, mockDeliveryRepo, webhookService := setupWebhookTest(t)
+	ctx := context.Background()
+
+	deliveries := []*entity.WebhookDelivery{{ID: "delivery-1"}, {ID: "delivery-2"}}
+	filter := repository.WebhookDeliveryFilter{EndpointID: "endpoint-1", Status: entity.WebhookDeliveryStatusFailed}
+	mockDeliveryRepo.EXPECT().ListByTenant(ctx, "tenant-123", filter, 2, 4).Return(deliveries, nil)
+
+	// Execute
+	out, err := webhookService.ListDeliveries(ctx, input.ListWebhookDeliveries{
+		TenantID:   "tenant-123",
+		EndpointID: "endpoint-1",
+		Status:     "failed",
+		PageSize:   2,
+		PageToken:  "4",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, out.Deliveries, 2)
+	assert.Equal(t, "6", out.NextPageToken)
+}
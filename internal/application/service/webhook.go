@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// WebhookService defines the interface for managing webhook endpoints and their delivery log
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_service
+type WebhookService interface {
+	CreateEndpoint(ctx context.Context, input input.CreateWebhookEndpoint) (*entity.WebhookEndpoint, error)
+	GetEndpoint(ctx context.Context, input input.GetWebhookEndpoint) (*entity.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context, input input.ListWebhookEndpoints) (*output.ListWebhookEndpoints, error)
+	UpdateEndpoint(ctx context.Context, input input.UpdateWebhookEndpoint) (*entity.WebhookEndpoint, error)
+	DeleteEndpoint(ctx context.Context, input input.DeleteWebhookEndpoint) error
+	RotateSecret(ctx context.Context, input input.RotateWebhookEndpointSecret) (*entity.WebhookEndpoint, error)
+	ListDeliveries(ctx context.Context, input input.ListWebhookDeliveries) (*output.ListWebhookDeliveries, error)
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/output"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// defaultWebhookPageSize is used when a list request does not specify a page size
+const defaultWebhookPageSize = 20
+
+// webhookService implements WebhookService interface
+type webhookService struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(
+	endpointRepo repository.WebhookEndpointRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+) WebhookService {
+	return &webhookService{
+		endpointRepo: endpointRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// CreateEndpoint registers a new webhook endpoint with a generated signing secret
+func (s *webhookService) CreateEndpoint(ctx context.Context, input input.CreateWebhookEndpoint) (*entity.WebhookEndpoint, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := entity.NewWebhookEndpoint(input.TenantID, input.URL, input.EventTypes, input.Description, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.endpointRepo.Create(ctx, endpoint); err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// GetEndpoint retrieves a webhook endpoint by its ID
+func (s *webhookService) GetEndpoint(ctx context.Context, input input.GetWebhookEndpoint) (*entity.WebhookEndpoint, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	return s.endpointRepo.GetByID(ctx, input.TenantID, input.ID)
+}
+
+// ListEndpoints retrieves a tenant's webhook endpoints
+func (s *webhookService) ListEndpoints(ctx context.Context, input input.ListWebhookEndpoints) (*output.ListWebhookEndpoints, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	pageSize, offset, err := parsePage(input.PageSize, input.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := s.endpointRepo.ListByTenant(ctx, input.TenantID, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.ListWebhookEndpoints{
+		Endpoints:     endpoints,
+		NextPageToken: nextPageToken(len(endpoints), pageSize, offset),
+	}, nil
+}
+
+// UpdateEndpoint updates the given fields of a webhook endpoint.
+// Re-enabling an endpoint clears its failure streak.
+func (s *webhookService) UpdateEndpoint(ctx context.Context, input input.UpdateWebhookEndpoint) (*entity.WebhookEndpoint, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.endpointRepo.GetByID(ctx, input.TenantID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if input.URL != nil {
+		if err := entity.ValidateWebhookURL(*input.URL); err != nil {
+			return nil, err
+		}
+		endpoint.URL = *input.URL
+	}
+	if len(input.EventTypes) > 0 {
+		endpoint.EventTypes = input.EventTypes
+	}
+	if input.Description != nil {
+		endpoint.Description = *input.Description
+	}
+	if input.Enabled != nil && *input.Enabled != endpoint.Enabled {
+		if *input.Enabled {
+			endpoint.Enable(now)
+		} else {
+			endpoint.Disable(now, "disabled by tenant")
+		}
+	}
+	endpoint.UpdatedAt = now
+
+	if err := s.endpointRepo.Update(ctx, endpoint); err != nil {
+		return nil, fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// DeleteEndpoint deletes a webhook endpoint
+func (s *webhookService) DeleteEndpoint(ctx context.Context, input input.DeleteWebhookEndpoint) error {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return err
+	}
+
+	return s.endpointRepo.Delete(ctx, input.TenantID, input.ID)
+}
+
+// RotateSecret replaces the signing secret of a webhook endpoint
+func (s *webhookService) RotateSecret(ctx context.Context, input input.RotateWebhookEndpointSecret) (*entity.WebhookEndpoint, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.endpointRepo.GetByID(ctx, input.TenantID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := entity.NewWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	endpoint.Secret = secret
+	endpoint.UpdatedAt = time.Now()
+
+	if err := s.endpointRepo.Update(ctx, endpoint); err != nil {
+		return nil, fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// ListDeliveries retrieves a tenant's webhook delivery log, newest first
+func (s *webhookService) ListDeliveries(ctx context.Context, input input.ListWebhookDeliveries) (*output.ListWebhookDeliveries, error) {
+	// Validate input
+	if err := Validate(input); err != nil {
+		return nil, err
+	}
+
+	pageSize, offset, err := parsePage(input.PageSize, input.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.WebhookDeliveryFilter{
+		EndpointID: input.EndpointID,
+	}
+	if input.Status != "" {
+		filter.Status = entity.NewWebhookDeliveryStatus(input.Status)
+	}
+
+	deliveries, err := s.deliveryRepo.ListByTenant(ctx, input.TenantID, filter, pageSize, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.ListWebhookDeliveries{
+		Deliveries:    deliveries,
+		NextPageToken: nextPageToken(len(deliveries), pageSize, offset),
+	}, nil
+}
+
+// parsePage resolves the page size and the offset encoded in a page token
+func parsePage(pageSize int32, pageToken string) (int, int, error) {
+	size := int(pageSize)
+	if size <= 0 {
+		size = defaultWebhookPageSize
+	}
+
+	if pageToken == "" {
+		return size, 0, nil
+	}
+	offset, err := strconv.Atoi(pageToken)
+	if err != nil || offset < 0 {
+		return 0, 0, errors.New("invalid page token")
+	}
+	return size, offset, nil
+}
+
+// nextPageToken returns the token of the following page, or "" if this was the last page
+func nextPageToken(count, pageSize, offset int) string {
+	if count < pageSize {
+		return ""
+	}
+	return strconv.Itoa(offset + count)
+}
@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// Dispatcher defaults
+const (
+	DefaultBatchSize    = 50
+	DefaultPollInterval = 5 * time.Second
+	DefaultLease        = time.Minute
+)
+
+// DispatcherConfig holds the tuning knobs of a Dispatcher
+type DispatcherConfig struct {
+	// BatchSize is the maximum number of deliveries claimed per round trip
+	BatchSize int
+	// PollInterval is how often due deliveries are looked up
+	PollInterval time.Duration
+	// Lease is how long a claimed delivery is hidden from other dispatchers.
+	// It must be longer than the sender's timeout.
+	Lease time.Duration
+}
+
+// Dispatcher sends due webhook deliveries and records their outcome, retrying failed
+// deliveries with backoff and disabling endpoints that keep failing
+type Dispatcher struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+	sender       Sender
+	cfg          DispatcherConfig
+}
+
+// NewDispatcher creates a new webhook dispatcher. Zero values in cfg are replaced with defaults.
+func NewDispatcher(
+	endpointRepo repository.WebhookEndpointRepository,
+	deliveryRepo repository.WebhookDeliveryRepository,
+	sender Sender,
+	cfg DispatcherConfig,
+) *Dispatcher {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = DefaultLease
+	}
+
+	return &Dispatcher{
+		endpointRepo: endpointRepo,
+		deliveryRepo: deliveryRepo,
+		sender:       sender,
+		cfg:          cfg,
+	}
+}
+
+// Run dispatches deliveries until ctx is cancelled
+func (d *Dispatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			n, err := d.DispatchBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("Webhook dispatcher failed to dispatch: %v", err)
+			}
+			if err != nil || n < d.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchBatch claims up to BatchSize due deliveries and attempts each of them.
+// It returns the number of deliveries claimed.
+func (d *Dispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	deliveries, err := d.deliveryRepo.ClaimDue(ctx, time.Now(), d.cfg.Lease, d.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim due webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return len(deliveries), err
+		}
+		// A delivery that cannot be recorded keeps its lease and is retried once it expires
+		if err := d.dispatch(ctx, delivery); err != nil {
+			log.Printf("Webhook dispatcher failed to dispatch delivery %s: %v", delivery.ID, err)
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// dispatch sends one delivery and records the outcome on the delivery and the failure
+// streak of its endpoint
+func (d *Dispatcher) dispatch(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	// Deliveries are claimed across tenants, but their endpoints are protected by
+	// row-level security, so the tenant of the delivery must be set
+	ctx = tenantctx.WithTenantID(ctx, delivery.TenantID)
+
+	endpoint, err := d.endpointRepo.GetByID(ctx, delivery.TenantID, delivery.EndpointID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+	if endpoint == nil || !endpoint.Enabled {
+		delivery.Abandon(time.Now(), "webhook endpoint is disabled or deleted")
+		return d.deliveryRepo.Update(ctx, delivery)
+	}
+
+	status, sendErr := d.sender.Send(ctx, endpoint, delivery)
+	now := time.Now()
+
+	if sendErr == nil && status >= 200 && status < 300 {
+		delivery.RecordSuccess(now, status)
+		if err := d.deliveryRepo.Update(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to record webhook delivery success: %w", err)
+		}
+		return d.endpointRepo.ResetFailures(ctx, endpoint.TenantID, endpoint.ID, now)
+	}
+
+	errMessage := fmt.Sprintf("unexpected response status %d", status)
+	if sendErr != nil {
+		errMessage = sendErr.Error()
+	}
+	delivery.RecordFailure(now, status, errMessage)
+	if err := d.deliveryRepo.Update(ctx, delivery); err != nil {
+		return fmt.Errorf("failed to record webhook delivery failure: %w", err)
+	}
+
+	// The streak is updated in place, so that admin changes and the failures of concurrent
+	// deliveries made while sending are kept
+	disabled, err := d.endpointRepo.RecordFailure(ctx, endpoint.TenantID, endpoint.ID, now)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook endpoint failure: %w", err)
+	}
+	if disabled {
+		log.Printf("Webhook endpoint %s of tenant %s disabled after %d consecutive failures", endpoint.ID, endpoint.TenantID, entity.MaxWebhookConsecutiveFailures)
+	}
+	return nil
+}
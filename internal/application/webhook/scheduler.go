@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// Scheduler turns outbox messages into webhook deliveries for every subscribed endpoint
+// of the message's tenant. It implements outbox.Publisher so that it can be plugged into
+// the outbox relay.
+type Scheduler struct {
+	endpointRepo repository.WebhookEndpointRepository
+	deliveryRepo repository.WebhookDeliveryRepository
+}
+
+// NewScheduler creates a new webhook scheduler
+func NewScheduler(endpointRepo repository.WebhookEndpointRepository, deliveryRepo repository.WebhookDeliveryRepository) *Scheduler {
+	return &Scheduler{
+		endpointRepo: endpointRepo,
+		deliveryRepo: deliveryRepo,
+	}
+}
+
+// Publish schedules one delivery per subscribed endpoint. The outbox message ID is used as
+// the event ID, so publishing the same message twice does not duplicate deliveries.
+func (s *Scheduler) Publish(ctx context.Context, msg *entity.OutboxMessage) error {
+	// Events that do not belong to a tenant have nobody to notify
+	if msg.TenantID == "" {
+		return nil
+	}
+
+	// Webhook endpoints are protected by row-level security, so the tenant must be set
+	ctx = tenantctx.WithTenantID(ctx, msg.TenantID)
+	endpoints, err := s.endpointRepo.ListSubscribed(ctx, msg.TenantID, msg.EventType)
+	if err != nil {
+		return fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+
+	now := time.Now()
+	for _, endpoint := range endpoints {
+		delivery := entity.NewWebhookDelivery(endpoint, msg.ID, msg.EventType, msg.Payload, now)
+		if err := s.deliveryRepo.CreateIfNotExists(ctx, delivery); err != nil {
+			return fmt.Errorf("failed to schedule webhook delivery to endpoint %s: %w", endpoint.ID, err)
+		}
+	}
+
+	return nil
+}
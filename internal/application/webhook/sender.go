@@ -0,0 +1,15 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// Sender delivers a webhook delivery to its endpoint (secondary port).
+// It returns the HTTP status code of the response, or zero if no response was received.
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_webhook
+type Sender interface {
+	Send(ctx context.Context, endpoint *entity.WebhookEndpoint, delivery *entity.WebhookDelivery) (int, error)
+}
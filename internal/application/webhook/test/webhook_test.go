@@ -0,0 +1,191 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/webhook"
+	mock_webhook "github.com/jp-ryuji/go-arch-patterns/internal/application/webhook/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// newEndpoint creates an enabled webhook endpoint for testing
+func newEndpoint(id string, failures int) *entity.WebhookEndpoint {
+	return &entity.WebhookEndpoint{
+		ID:                  id,
+		TenantID:            "tenant-1",
+		URL:                 "https://example.com/hooks",
+		Secret:              "whsec_test",
+		EventTypes:          []string{entity.WebhookEventAll},
+		Enabled:             true,
+		ConsecutiveFailures: failures,
+	}
+}
+
+// newDelivery creates a due webhook delivery for testing
+func newDelivery(endpoint *entity.WebhookEndpoint) *entity.WebhookDelivery {
+	return entity.NewWebhookDelivery(endpoint, "event-1", "car_created", nil, time.Now()).WithID("delivery-1")
+}
+
+// TestScheduler_Publish tests that a delivery is scheduled for each subscribed endpoint
+func TestScheduler_Publish(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockEndpointRepo := mock_repository.NewMockWebhookEndpointRepository(ctrl)
+	mockDeliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+	scheduler := webhook.NewScheduler(mockEndpointRepo, mockDeliveryRepo)
+
+	ctx := context.Background()
+	msg := &entity.OutboxMessage{ID: "msg-1", TenantID: "tenant-1", EventType: "car_created"}
+	endpoints := []*entity.WebhookEndpoint{newEndpoint("endpoint-1", 0), newEndpoint("endpoint-2", 0)}
+
+	tenantCtx := tenantctx.WithTenantID(ctx, "tenant-1")
+
+	// Set up expectations
+	mockEndpointRepo.EXPECT().ListSubscribed(tenantCtx, "tenant-1", "car_created").Return(endpoints, nil)
+	for _, endpoint := range endpoints {
+		mockDeliveryRepo.EXPECT().CreateIfNotExists(tenantCtx, gomock.Cond(func(d *entity.WebhookDelivery) bool {
+			return d.EndpointID == endpoint.ID && d.EventID == "msg-1" && d.Status == entity.WebhookDeliveryStatusPending
+		})).Return(nil)
+	}
+
+	// Execute
+	err := scheduler.Publish(ctx, msg)
+	assert.NoError(t, err)
+}
+
+// TestScheduler_Publish_NoTenant tests that events without a tenant are skipped
+func TestScheduler_Publish_NoTenant(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	scheduler := webhook.NewScheduler(
+		mock_repository.NewMockWebhookEndpointRepository(ctrl),
+		mock_repository.NewMockWebhookDeliveryRepository(ctrl),
+	)
+
+	// Execute
+	err := scheduler.Publish(context.Background(), &entity.OutboxMessage{ID: "msg-1", EventType: "car_created"})
+	assert.NoError(t, err)
+}
+
+// TestDispatcher_DispatchBatch tests how delivery outcomes are recorded
+func TestDispatcher_DispatchBatch(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status        int
+		sendErr       error
+		disabled      bool
+		wantStatus    entity.WebhookDeliveryStatus
+		recordFailure bool
+	}{
+		"ok (resets failure streak)": {
+			status:     http.StatusOK,
+			wantStatus: entity.WebhookDeliveryStatusSucceeded,
+		},
+		"ng (error status is retried)": {
+			status:        http.StatusInternalServerError,
+			wantStatus:    entity.WebhookDeliveryStatusPending,
+			recordFailure: true,
+		},
+		"ng (transport error is retried)": {
+			sendErr:       errors.New("connection refused"),
+			wantStatus:    entity.WebhookDeliveryStatusPending,
+			recordFailure: true,
+		},
+		"ng (endpoint disabled after sustained failures)": {
+			status:        http.StatusBadGateway,
+			disabled:      true,
+			wantStatus:    entity.WebhookDeliveryStatusPending,
+			recordFailure: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctrl := gomock.NewController(t)
+			mockEndpointRepo := mock_repository.NewMockWebhookEndpointRepository(ctrl)
+			mockDeliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+			mockSender := mock_webhook.NewMockSender(ctrl)
+			dispatcher := webhook.NewDispatcher(mockEndpointRepo, mockDeliveryRepo, mockSender, webhook.DispatcherConfig{BatchSize: 10})
+
+			ctx := context.Background()
+			endpoint := newEndpoint("endpoint-1", 3)
+			delivery := newDelivery(endpoint)
+			tenantCtx := tenantctx.WithTenantID(ctx, "tenant-1")
+
+			// Set up expectations
+			mockDeliveryRepo.EXPECT().ClaimDue(ctx, gomock.Any(), webhook.DefaultLease, 10).Return([]*entity.WebhookDelivery{delivery}, nil)
+			mockEndpointRepo.EXPECT().GetByID(tenantCtx, "tenant-1", "endpoint-1").Return(endpoint, nil)
+			mockSender.EXPECT().Send(tenantCtx, endpoint, delivery).Return(tt.status, tt.sendErr)
+			mockDeliveryRepo.EXPECT().Update(tenantCtx, delivery).Return(nil)
+			// The endpoint is never written back whole, which would undo concurrent changes
+			if tt.recordFailure {
+				mockEndpointRepo.EXPECT().RecordFailure(tenantCtx, "tenant-1", "endpoint-1", gomock.Any()).Return(tt.disabled, nil)
+			} else {
+				mockEndpointRepo.EXPECT().ResetFailures(tenantCtx, "tenant-1", "endpoint-1", gomock.Any()).Return(nil)
+			}
+
+			// Execute
+			n, err := dispatcher.DispatchBatch(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+			assert.Equal(t, tt.wantStatus, delivery.Status)
+			assert.Equal(t, 1, delivery.Attempts)
+		})
+	}
+}
+
+// TestDispatcher_DispatchBatch_DisabledEndpoint tests that deliveries to disabled endpoints are abandoned
+func TestDispatcher_DispatchBatch_DisabledEndpoint(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockEndpointRepo := mock_repository.NewMockWebhookEndpointRepository(ctrl)
+	mockDeliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+	mockSender := mock_webhook.NewMockSender(ctrl)
+	dispatcher := webhook.NewDispatcher(mockEndpointRepo, mockDeliveryRepo, mockSender, webhook.DispatcherConfig{})
+
+	ctx := context.Background()
+	endpoint := newEndpoint("endpoint-1", 0)
+	endpoint.Disable(time.Now(), "disabled by tenant")
+	delivery := newDelivery(endpoint)
+	tenantCtx := tenantctx.WithTenantID(ctx, "tenant-1")
+
+	// Set up expectations
+	mockDeliveryRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return([]*entity.WebhookDelivery{delivery}, nil)
+	mockEndpointRepo.EXPECT().GetByID(tenantCtx, "tenant-1", "endpoint-1").Return(endpoint, nil)
+	mockDeliveryRepo.EXPECT().Update(tenantCtx, delivery).Return(nil)
+
+	// Execute
+	_, err := dispatcher.DispatchBatch(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.WebhookDeliveryStatusFailed, delivery.Status)
+	assert.Zero(t, delivery.Attempts)
+}
+
+// TestWebhookRetryDelay tests the exponential backoff between delivery attempts
+func TestWebhookRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, entity.WebhookRetryBaseDelay, entity.WebhookRetryDelay(1))
+	assert.Equal(t, 2*entity.WebhookRetryBaseDelay, entity.WebhookRetryDelay(2))
+	assert.Equal(t, 8*entity.WebhookRetryBaseDelay, entity.WebhookRetryDelay(4))
+	assert.Equal(t, entity.WebhookRetryMaxDelay, entity.WebhookRetryDelay(50))
+}
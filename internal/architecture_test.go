@@ -0,0 +1,54 @@
+// Package internal_test enforces the dependency rules of the onion architecture.
+package internal_test
+
+import (
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const modulePath = "github.com/jp-ryuji/go-arch-patterns/internal/"
+
+// layerRules lists, for each inner layer, the layers it must not import
+var layerRules = map[string][]string{
+	"domain":      {"application", "infrastructure", "presentation", "di"},
+	"application": {"infrastructure", "presentation", "di"},
+}
+
+// TestLayerDependencies tests that inner layers never import outer layers
+func TestLayerDependencies(t *testing.T) {
+	t.Parallel()
+
+	for layer, forbidden := range layerRules {
+		err := filepath.WalkDir(layer, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") {
+				return err
+			}
+
+			file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+			if err != nil {
+				return err
+			}
+
+			for _, spec := range file.Imports {
+				importPath, err := strconv.Unquote(spec.Path.Value)
+				if err != nil {
+					return err
+				}
+				for _, outer := range forbidden {
+					if strings.HasPrefix(importPath, modulePath+outer+"/") || importPath == modulePath+outer {
+						t.Errorf("%s: %s layer must not import %s", path, layer, importPath)
+					}
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("failed to walk %s: %v", layer, err)
+		}
+	}
+}
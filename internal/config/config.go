@@ -22,17 +22,76 @@ type Config struct {
 	DBMaxIdleConns    int           `mapstructure:"DB_MAX_IDLE_CONNS"`
 	DBConnMaxLifetime time.Duration `mapstructure:"DB_CONN_MAX_LIFETIME"`
 
+	// DBAppUser is the non-owner role the application connects as, so that row-level
+	// security applies to it. DBUser owns the tables and runs migrations.
+	DBAppUser     string `mapstructure:"DB_APP_USER"`
+	DBAppPassword string `mapstructure:"DB_APP_PASSWORD"`
+
+	// Transaction retry on serialization failures and deadlocks
+	DBTxMaxAttempts    int           `mapstructure:"DB_TX_MAX_ATTEMPTS"`
+	DBTxRetryBaseDelay time.Duration `mapstructure:"DB_TX_RETRY_BASE_DELAY"`
+	DBTxRetryMaxDelay  time.Duration `mapstructure:"DB_TX_RETRY_MAX_DELAY"`
+
 	// Redis configuration
 	RedisHost string `mapstructure:"REDIS_HOST"`
 	RedisPort int    `mapstructure:"REDIS_PORT"`
 	RedisURL  string `mapstructure:"REDIS_URL"`
 
+	// RedisStreamMaxLen caps each outbox stream at roughly this many entries
+	RedisStreamMaxLen int64 `mapstructure:"REDIS_STREAM_MAXLEN"`
+
 	// Server configuration
 	GRPCPort int `mapstructure:"GRPC_PORT"`
 	HTTPPort int `mapstructure:"HTTP_PORT"`
 
+	// TenantBaseDomain is the domain under which each tenant has a subdomain named after
+	// its code, e.g. acme.localhost
+	TenantBaseDomain string `mapstructure:"TENANT_BASE_DOMAIN"`
+
+	// ArchiveDir is the directory tenant exports are written to and imports read from
+	ArchiveDir string `mapstructure:"ARCHIVE_DIR"`
+
 	// OpenSearch configuration
 	OpenSearchPortExternal int `mapstructure:"OPENSEARCH_PORT_EXTERNAL"`
+
+	// Outbox relay configuration
+	OutboxBatchSize    int           `mapstructure:"OUTBOX_BATCH_SIZE"`
+	OutboxPollInterval time.Duration `mapstructure:"OUTBOX_POLL_INTERVAL"`
+	OutboxLockTimeout  time.Duration `mapstructure:"OUTBOX_LOCK_TIMEOUT"`
+
+	// Webhook dispatcher configuration
+	WebhookBatchSize    int           `mapstructure:"WEBHOOK_BATCH_SIZE"`
+	WebhookPollInterval time.Duration `mapstructure:"WEBHOOK_POLL_INTERVAL"`
+	WebhookTimeout      time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+
+	// Inbox configuration
+	InboxRetention       time.Duration `mapstructure:"INBOX_RETENTION"`
+	InboxCleanupInterval time.Duration `mapstructure:"INBOX_CLEANUP_INTERVAL"`
+
+	// Tenant offboarding configuration
+	TenantDeletionGracePeriod time.Duration `mapstructure:"TENANT_DELETION_GRACE_PERIOD"`
+	TenantPurgeInterval       time.Duration `mapstructure:"TENANT_PURGE_INTERVAL"`
+	TenantPurgeBatchSize      int           `mapstructure:"TENANT_PURGE_BATCH_SIZE"`
+
+	// Usage metering configuration
+	MeteringFlushInterval   time.Duration `mapstructure:"METERING_FLUSH_INTERVAL"`
+	MeteringSampleInterval  time.Duration `mapstructure:"METERING_SAMPLE_INTERVAL"`
+	MeteringRecordRetention time.Duration `mapstructure:"METERING_RECORD_RETENTION"`
+	MeteringCleanupInterval time.Duration `mapstructure:"METERING_CLEANUP_INTERVAL"`
+
+	// API key usage recording configuration
+	APIKeyUsageFlushInterval time.Duration `mapstructure:"API_KEY_USAGE_FLUSH_INTERVAL"`
+	APIKeyUsageBufferSize    int           `mapstructure:"API_KEY_USAGE_BUFFER_SIZE"`
+
+	// AuthRequired rejects requests without credentials. Turn it off for local development only.
+	AuthRequired bool `mapstructure:"AUTH_REQUIRED"`
+
+	// JWT configuration; JWTs are accepted when a JWKS file or URL is set
+	JWTIssuer       string        `mapstructure:"JWT_ISSUER"`
+	JWTAudience     string        `mapstructure:"JWT_AUDIENCE"`
+	JWTJWKSURL      string        `mapstructure:"JWT_JWKS_URL"`
+	JWTJWKSFile     string        `mapstructure:"JWT_JWKS_FILE"`
+	JWTJWKSCacheTTL time.Duration `mapstructure:"JWT_JWKS_CACHE_TTL"`
 }
 
 // LoadConfig loads the configuration from environment variables
@@ -72,18 +131,59 @@ func setDefaults() {
 	viper.SetDefault("DB_MAX_OPEN_CONNS", 25)
 	viper.SetDefault("DB_MAX_IDLE_CONNS", 25)
 	viper.SetDefault("DB_CONN_MAX_LIFETIME", 5*time.Minute)
+	viper.SetDefault("DB_APP_USER", "app")
+	viper.SetDefault("DB_APP_PASSWORD", "app_password")
+	viper.SetDefault("DB_TX_MAX_ATTEMPTS", 5)
+	viper.SetDefault("DB_TX_RETRY_BASE_DELAY", 10*time.Millisecond)
+	viper.SetDefault("DB_TX_RETRY_MAX_DELAY", time.Second)
 
 	// Redis defaults
 	viper.SetDefault("REDIS_HOST", "redis")
 	viper.SetDefault("REDIS_PORT", 6379)
 	viper.SetDefault("REDIS_URL", "redis://redis:6379")
+	viper.SetDefault("REDIS_STREAM_MAXLEN", 10000)
 
 	// Server defaults
 	viper.SetDefault("GRPC_PORT", 50051)
 	viper.SetDefault("HTTP_PORT", 8081)
+	viper.SetDefault("TENANT_BASE_DOMAIN", "localhost")
+	viper.SetDefault("ARCHIVE_DIR", "./archives")
 
 	// OpenSearch defaults
 	viper.SetDefault("OPENSEARCH_PORT_EXTERNAL", 9201)
+
+	// Outbox relay defaults
+	viper.SetDefault("OUTBOX_BATCH_SIZE", 100)
+	viper.SetDefault("OUTBOX_POLL_INTERVAL", 30*time.Second)
+	viper.SetDefault("OUTBOX_LOCK_TIMEOUT", 5*time.Minute)
+
+	// Webhook dispatcher defaults
+	viper.SetDefault("WEBHOOK_BATCH_SIZE", 50)
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL", 5*time.Second)
+	viper.SetDefault("WEBHOOK_TIMEOUT", 10*time.Second)
+
+	// Inbox defaults
+	viper.SetDefault("INBOX_RETENTION", 7*24*time.Hour)
+	viper.SetDefault("INBOX_CLEANUP_INTERVAL", time.Hour)
+
+	// Tenant offboarding defaults
+	viper.SetDefault("TENANT_DELETION_GRACE_PERIOD", 30*24*time.Hour)
+	viper.SetDefault("TENANT_PURGE_INTERVAL", time.Hour)
+	viper.SetDefault("TENANT_PURGE_BATCH_SIZE", 1000)
+
+	// Usage metering defaults
+	viper.SetDefault("METERING_FLUSH_INTERVAL", time.Minute)
+	viper.SetDefault("METERING_SAMPLE_INTERVAL", time.Hour)
+	viper.SetDefault("METERING_RECORD_RETENTION", 30*24*time.Hour)
+	viper.SetDefault("METERING_CLEANUP_INTERVAL", time.Hour)
+
+	// API key usage recording defaults
+	viper.SetDefault("API_KEY_USAGE_FLUSH_INTERVAL", 10*time.Second)
+	viper.SetDefault("API_KEY_USAGE_BUFFER_SIZE", 1024)
+
+	// Authentication defaults
+	viper.SetDefault("AUTH_REQUIRED", true)
+	viper.SetDefault("JWT_JWKS_CACHE_TTL", 15*time.Minute)
 }
 
 // bindEnv binds environment variables to Viper keys
@@ -99,22 +199,86 @@ func bindEnv() {
 	_ = viper.BindEnv("DB_MAX_OPEN_CONNS")
 	_ = viper.BindEnv("DB_MAX_IDLE_CONNS")
 	_ = viper.BindEnv("DB_CONN_MAX_LIFETIME")
+	_ = viper.BindEnv("DB_APP_USER")
+	_ = viper.BindEnv("DB_APP_PASSWORD")
+	_ = viper.BindEnv("DB_TX_MAX_ATTEMPTS")
+	_ = viper.BindEnv("DB_TX_RETRY_BASE_DELAY")
+	_ = viper.BindEnv("DB_TX_RETRY_MAX_DELAY")
 
 	// Redis
 	_ = viper.BindEnv("REDIS_HOST")
 	_ = viper.BindEnv("REDIS_PORT")
 	_ = viper.BindEnv("REDIS_URL")
+	_ = viper.BindEnv("REDIS_STREAM_MAXLEN")
 
 	// Server
 	_ = viper.BindEnv("GRPC_PORT")
 	_ = viper.BindEnv("HTTP_PORT")
+	_ = viper.BindEnv("TENANT_BASE_DOMAIN")
+	_ = viper.BindEnv("ARCHIVE_DIR")
 
 	// OpenSearch
 	_ = viper.BindEnv("OPENSEARCH_PORT_EXTERNAL")
+
+	// Outbox relay
+	_ = viper.BindEnv("OUTBOX_BATCH_SIZE")
+	_ = viper.BindEnv("OUTBOX_POLL_INTERVAL")
+	_ = viper.BindEnv("OUTBOX_LOCK_TIMEOUT")
+
+	// Webhook dispatcher
+	_ = viper.BindEnv("WEBHOOK_BATCH_SIZE")
+	_ = viper.BindEnv("WEBHOOK_POLL_INTERVAL")
+	_ = viper.BindEnv("WEBHOOK_TIMEOUT")
+
+	// Inbox
+	_ = viper.BindEnv("INBOX_RETENTION")
+	_ = viper.BindEnv("INBOX_CLEANUP_INTERVAL")
+
+	// Tenant offboarding
+	_ = viper.BindEnv("TENANT_DELETION_GRACE_PERIOD")
+	_ = viper.BindEnv("TENANT_PURGE_INTERVAL")
+	_ = viper.BindEnv("TENANT_PURGE_BATCH_SIZE")
+
+	// Usage metering
+	_ = viper.BindEnv("METERING_FLUSH_INTERVAL")
+	_ = viper.BindEnv("METERING_SAMPLE_INTERVAL")
+	_ = viper.BindEnv("METERING_RECORD_RETENTION")
+	_ = viper.BindEnv("METERING_CLEANUP_INTERVAL")
+
+	// API key usage recording
+	_ = viper.BindEnv("API_KEY_USAGE_FLUSH_INTERVAL")
+	_ = viper.BindEnv("API_KEY_USAGE_BUFFER_SIZE")
+
+	// Authentication
+	_ = viper.BindEnv("AUTH_REQUIRED")
+	_ = viper.BindEnv("JWT_ISSUER")
+	_ = viper.BindEnv("JWT_AUDIENCE")
+	_ = viper.BindEnv("JWT_JWKS_URL")
+	_ = viper.BindEnv("JWT_JWKS_FILE")
+	_ = viper.BindEnv("JWT_JWKS_CACHE_TTL")
 }
 
-// DatabaseURL returns the database connection string
+// DatabaseURL returns the connection string of the table owner, used for migrations
 func (c *Config) DatabaseURL() string {
+	return c.DatabaseURLOf(c.DBName)
+}
+
+// AppDatabaseURL returns the connection string of the application role, which is
+// subject to row-level security
+func (c *Config) AppDatabaseURL() string {
+	return c.AppDatabaseURLOf(c.DBName)
+}
+
+// DatabaseURLOf returns the connection string of the table owner to another database of
+// the same server, e.g. the database of a tenant isolated in its own
+func (c *Config) DatabaseURLOf(name string) string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s",
+		c.DBUser, c.DBPassword, c.DBHost, c.DBPortExternal, name, c.DBSSLMode)
+}
+
+// AppDatabaseURLOf returns the connection string of the application role to another
+// database of the same server
+func (c *Config) AppDatabaseURLOf(name string) string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s",
-		c.DBUser, c.DBPassword, c.DBHost, c.DBPortExternal, c.DBName, c.DBSSLMode)
+		c.DBAppUser, c.DBAppPassword, c.DBHost, c.DBPortExternal, name, c.DBSSLMode)
 }
@@ -1,48 +1,318 @@
 package di
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/tenant/v1/tenantv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/archive"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/auth"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/inbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/metering"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/offboarding"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/outbox"
 	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/webhook"
+	"github.com/jp-ryuji/go-arch-patterns/internal/config"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/archivestore"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/jwks"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/redis"
+	webhookhttp "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/webhook"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
 	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/http"
 )
 
 // Container holds all the dependencies
 type Container struct {
-	Client     *entgen.Client
-	CarService service.CarService
-	HTTPServer *http.Server
-	grpcPort   int
-	httpPort   int
+	Client                *entgen.Client
+	Router                *repository.Router
+	RedisClient           *goredis.Client
+	CarService            service.CarService
+	CarModelService       service.CarModelService
+	WebhookService        service.WebhookService
+	TenantAdminService    service.TenantAdminService
+	TenantService         service.TenantService
+	TenantSettingsService service.TenantSettingsService
+	QuotaService          service.QuotaService
+	TenantArchiveService  service.TenantArchiveService
+	MeteringService       service.MeteringService
+	FleetSharingService   service.FleetSharingService
+	RentalService         service.RentalService
+	BranchService         service.BranchService
+	MaintenanceService    service.MaintenanceWindowService
+	ArchiveExporter       *archive.Exporter
+	ArchiveImporter       *archive.Importer
+	HTTPServer            *http.Server
+	OutboxListener        *postgres.Listener
+	OutboxRelay           *outbox.Relay
+	OutboxRelayGroup      *outbox.RelayGroup
+	WebhookDispatcher     *webhook.Dispatcher
+	InboxConsumer         *inbox.Consumer
+	InboxCleaner          *inbox.Cleaner
+	TenantPurger          *offboarding.Purger
+	CallCounter           *metering.CallCounter
+	ActiveCarsSampler     *metering.Sampler
+	MeteringCleaner       *metering.Cleaner
+	APIKeyUsageRecorder   *auth.UsageRecorder
+	grpcPort              int
+	httpPort              int
 }
 
 // NewContainer creates a new dependency injection container with an existing client
-func NewContainer(client *entgen.Client, grpcPort, httpPort int) (*Container, error) {
+func NewContainer(client *entgen.Client, cfg *config.Config) (*Container, error) {
+	// Route the tenant-scoped tables of each tenant to where its isolation mode keeps
+	// them, connecting to the databases of isolated tenants on first use
+	router := repository.NewRouter(client, func(ctx context.Context, name string) (*entgen.Client, error) {
+		return postgres.OpenClient(ctx, cfg.AppDatabaseURLOf(name))
+	})
+
 	// Create repositories
-	carRepo := repository.NewCarRepository(client)
+	tenantRepo := repository.NewTenantRepository(client)
+	tenantSettingsRepo := repository.NewTenantSettingsRepository(router)
+	planRepo := repository.NewPlanRepository(client)
+	usageRepo := repository.NewUsageRepository(router)
+	carRepo := repository.NewCarRepository(router)
+	carModelRepo := repository.NewCarModelRepository(router)
+	branchRepo := repository.NewBranchRepository(router)
+	rentalRepo := repository.NewRentalRepository(router)
+	maintenanceRepo := repository.NewMaintenanceWindowRepository(router)
+	handoverRepo := repository.NewRentalHandoverRepository(router)
+	renterRepo := repository.NewRenterRepository(router)
+	sharingRepo := repository.NewFleetSharingRepository(client)
 	outboxRepo := repository.NewOutboxRepository(client)
+	webhookEndpointRepo := repository.NewWebhookEndpointRepository(client)
+	webhookDeliveryRepo := repository.NewWebhookDeliveryRepository(client)
+	inboxRepo := repository.NewInboxRepository(router)
+	apiKeyRepo := repository.NewAPIKeyRepository(client)
+	archiveJobRepo := repository.NewArchiveJobRepository(client)
+	tenantDataRepo := repository.NewTenantDataRepository(client, router)
+	meteringRepo := repository.NewMeteringRepository(client)
 
-	// Create transaction manager
-	txManager := repository.NewTransactionManager(client)
+	// Create transaction manager and unit of work factory
+	txManager := repository.NewTransactionManager(router, repository.TxRetryConfig{
+		MaxAttempts: cfg.DBTxMaxAttempts,
+		BaseDelay:   cfg.DBTxRetryBaseDelay,
+		MaxDelay:    cfg.DBTxRetryMaxDelay,
+	})
+	uowFactory := repository.NewUnitOfWorkFactory(txManager, carRepo, tenantRepo, rentalRepo, maintenanceRepo, handoverRepo, outboxRepo)
 
 	// Create application services
-	carService := service.NewCarService(carRepo, outboxRepo, txManager)
+	tenantSettingsService := service.NewTenantSettingsService(tenantSettingsRepo)
+	quotaService := service.NewQuotaService(txManager, tenantRepo, planRepo, usageRepo, tenantSettingsService)
+	carService := service.NewCarService(carRepo, carModelRepo, branchRepo, uowFactory, quotaService)
+	carModelService := service.NewCarModelService(carModelRepo)
+	branchService := service.NewBranchService(branchRepo)
+	webhookService := service.NewWebhookService(webhookEndpointRepo, webhookDeliveryRepo)
+	tenantAdminService := service.NewTenantAdminService(apiKeyRepo)
+	tenantService := service.NewTenantService(tenantRepo, planRepo, tenantDataRepo, txManager, uowFactory, service.TenantServiceConfig{
+		DeletionGracePeriod: cfg.TenantDeletionGracePeriod,
+	})
+	meteringService := service.NewMeteringService(tenantRepo, meteringRepo)
+	fleetSharingService := service.NewFleetSharingService(tenantRepo, sharingRepo)
+	maintenanceService := service.NewMaintenanceWindowService(carRepo, rentalRepo, maintenanceRepo, txManager, uowFactory)
+	rentalService := service.NewRentalService(
+		carRepo, rentalRepo, maintenanceRepo, handoverRepo, renterRepo, sharingRepo, branchRepo, txManager, uowFactory, quotaService, tenantSettingsService,
+	)
+
+	// Create the tenant exporter and importer, keeping archives in a directory
+	archiveStore := repository.NewTenantArchiveStore(client, router)
+	archiveExporter := archive.NewExporter(txManager, archiveStore)
+	archiveImporter := archive.NewImporter(txManager, archiveStore)
+	tenantArchiveService := service.NewTenantArchiveService(
+		archiveJobRepo, tenantRepo, archiveExporter, archiveImporter, archivestore.NewDir(cfg.ArchiveDir),
+	)
+
+	// Create Redis client
+	redisClient, err := redis.NewClient(cfg.RedisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create redis client: %w", err)
+	}
+
+	// Create the outbox relays publishing to Redis Streams, scheduling webhook deliveries and
+	// metering usage, woken up by LISTEN/NOTIFY with polling as a fallback: one for the
+	// shared database and one for the database of each tenant isolated in its own
+	publisher := outbox.FanoutPublisher{
+		redis.NewStreamPublisher(redisClient, cfg.RedisStreamMaxLen),
+		webhook.NewScheduler(webhookEndpointRepo, webhookDeliveryRepo),
+		metering.NewRecorder(txManager, meteringRepo),
+	}
+	outboxListener := postgres.NewListener(cfg.AppDatabaseURL(), postgres.OutboxChannel)
+	outboxRelay := outbox.NewRelay(outboxRepo, publisher, outboxListener, outbox.RelayConfig{
+		BatchSize:    cfg.OutboxBatchSize,
+		PollInterval: cfg.OutboxPollInterval,
+		LockTimeout:  cfg.OutboxLockTimeout,
+	})
+	outboxRelayGroup := outbox.NewRelayGroup(
+		repository.NewTenantOutboxFinder(router, cfg.AppDatabaseURLOf),
+		publisher,
+		outbox.RelayConfig{
+			BatchSize:    cfg.OutboxBatchSize,
+			PollInterval: cfg.OutboxPollInterval,
+			LockTimeout:  cfg.OutboxLockTimeout,
+		},
+	)
+
+	// Create the webhook dispatcher sending signed requests to tenant endpoints
+	webhookDispatcher := webhook.NewDispatcher(
+		webhookEndpointRepo,
+		webhookDeliveryRepo,
+		webhookhttp.NewHTTPSender(cfg.WebhookTimeout),
+		webhook.DispatcherConfig{
+			BatchSize:    cfg.WebhookBatchSize,
+			PollInterval: cfg.WebhookPollInterval,
+			// Keep a claimed delivery hidden for a few request timeouts
+			Lease: 3 * cfg.WebhookTimeout,
+		},
+	)
+
+	// Create the inbox consumer for events from other systems; handlers are registered
+	// per event type by the transports feeding it
+	inboxConsumer := inbox.NewConsumer(inboxRepo, txManager)
+	inboxCleaner := inbox.NewCleaner(inboxRepo, inbox.CleanerConfig{
+		Interval:  cfg.InboxCleanupInterval,
+		Retention: cfg.InboxRetention,
+	})
+
+	// Create the purger deleting the data of tenants whose deletion is due
+	tenantPurger := offboarding.NewPurger(tenantRepo, tenantDataRepo, txManager, uowFactory, offboarding.PurgerConfig{
+		Interval:  cfg.TenantPurgeInterval,
+		BatchSize: cfg.TenantPurgeBatchSize,
+	})
 
-	// Create HTTP server with gRPC Connect
-	server := http.NewServer(grpcPort, httpPort, carService)
+	// Create the usage meters reporting API calls and active cars to the outbox, and the
+	// cleaner of the usage records the recorder deduplicates on
+	callCounter := metering.NewCallCounter(uowFactory, metering.CallCounterConfig{
+		FlushInterval: cfg.MeteringFlushInterval,
+	})
+	activeCarsSampler := metering.NewSampler(tenantRepo, usageRepo, uowFactory, metering.SamplerConfig{
+		Interval: cfg.MeteringSampleInterval,
+	})
+	meteringCleaner := metering.NewCleaner(meteringRepo, metering.CleanerConfig{
+		Interval:  cfg.MeteringCleanupInterval,
+		Retention: cfg.MeteringRecordRetention,
+	})
+
+	// Create the API key authenticator, recording key usage in the background
+	apiKeyUsageRecorder := auth.NewUsageRecorder(apiKeyRepo, auth.UsageRecorderConfig{
+		FlushInterval: cfg.APIKeyUsageFlushInterval,
+		BufferSize:    cfg.APIKeyUsageBufferSize,
+	})
+	authenticators := []interceptor.Authenticator{
+		auth.NewAPIKeyAuthenticator(apiKeyRepo, apiKeyUsageRecorder),
+	}
+
+	// Accept JWTs of the identity provider when its keys are configured
+	jwtAuthenticator, err := newJWTAuthenticator(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if jwtAuthenticator != nil {
+		authenticators = append(authenticators, jwtAuthenticator)
+	}
+
+	// Create HTTP server with gRPC Connect, caching tenant settings per request,
+	// authenticating bearer credentials, authorizing them against the access policy,
+	// resolving the tenant of each request from its credentials or the subdomain of its
+	// host, blocking changes by suspended tenants, reporting creates over the limits of the
+	// tenant's plan and counting the calls of each tenant. The tenant service is a platform
+	// service that acts for no tenant.
+	server := http.NewServer(
+		cfg.GRPCPort, cfg.HTTPPort,
+		carService, carModelService, webhookService, tenantAdminService, tenantService, tenantSettingsService,
+		quotaService, tenantArchiveService, meteringService, fleetSharingService, rentalService,
+		branchService, maintenanceService,
+		interceptor.NewContextInterceptor(service.WithTenantSettingsCache),
+		interceptor.NewAuthInterceptor(authenticators...),
+		interceptor.NewAuthorizationInterceptor(http.AccessPolicy(), !cfg.AuthRequired),
+		interceptor.SkipServices(
+			interceptor.NewTenantInterceptor(
+				interceptor.CredentialsResolver{},
+				interceptor.NewSubdomainResolver(tenantRepo, cfg.TenantBaseDomain),
+			),
+			tenantv1connect.TenantServiceName,
+		),
+		interceptor.NewSuspensionInterceptor(tenantRepo),
+		interceptor.NewQuotaInterceptor(),
+		interceptor.NewMeteringInterceptor(callCounter),
+	)
 
 	return &Container{
-		Client:     client,
-		CarService: carService,
-		HTTPServer: server,
-		grpcPort:   grpcPort,
-		httpPort:   httpPort,
+		Client:                client,
+		Router:                router,
+		RedisClient:           redisClient,
+		CarService:            carService,
+		CarModelService:       carModelService,
+		WebhookService:        webhookService,
+		TenantAdminService:    tenantAdminService,
+		TenantService:         tenantService,
+		TenantSettingsService: tenantSettingsService,
+		QuotaService:          quotaService,
+		TenantArchiveService:  tenantArchiveService,
+		MeteringService:       meteringService,
+		FleetSharingService:   fleetSharingService,
+		RentalService:         rentalService,
+		BranchService:         branchService,
+		MaintenanceService:    maintenanceService,
+		ArchiveExporter:       archiveExporter,
+		ArchiveImporter:       archiveImporter,
+		HTTPServer:            server,
+		OutboxListener:        outboxListener,
+		OutboxRelay:           outboxRelay,
+		OutboxRelayGroup:      outboxRelayGroup,
+		WebhookDispatcher:     webhookDispatcher,
+		InboxConsumer:         inboxConsumer,
+		InboxCleaner:          inboxCleaner,
+		TenantPurger:          tenantPurger,
+		CallCounter:           callCounter,
+		ActiveCarsSampler:     activeCarsSampler,
+		MeteringCleaner:       meteringCleaner,
+		APIKeyUsageRecorder:   apiKeyUsageRecorder,
+		grpcPort:              cfg.GRPCPort,
+		httpPort:              cfg.HTTPPort,
 	}, nil
 }
 
+// newJWTAuthenticator creates the JWT authenticator, or returns nil when no JWKS is configured
+func newJWTAuthenticator(cfg *config.Config) (*auth.JWTAuthenticator, error) {
+	if cfg.JWTJWKSURL == "" && cfg.JWTJWKSFile == "" {
+		return nil, nil
+	}
+	if cfg.JWTJWKSURL != "" && cfg.JWTJWKSFile != "" {
+		return nil, errors.New("only one of JWT_JWKS_URL and JWT_JWKS_FILE can be set")
+	}
+	if cfg.JWTIssuer == "" || cfg.JWTAudience == "" {
+		return nil, errors.New("JWT_ISSUER and JWT_AUDIENCE are required with a JWKS")
+	}
+
+	keySetCfg := jwks.Config{CacheTTL: cfg.JWTJWKSCacheTTL}
+	var keySet auth.KeySet
+	if cfg.JWTJWKSURL != "" {
+		keySet = jwks.NewURLKeySet(cfg.JWTJWKSURL, keySetCfg)
+	} else {
+		keySet = jwks.NewFileKeySet(cfg.JWTJWKSFile, keySetCfg)
+	}
+
+	return auth.NewJWTAuthenticator(keySet, auth.JWTConfig{
+		Issuer:   cfg.JWTIssuer,
+		Audience: cfg.JWTAudience,
+	}), nil
+}
+
 // Close closes all resources in the container
 func (c *Container) Close() {
+	if c.Router != nil {
+		c.Router.Close()
+	}
 	if c.Client != nil {
 		c.Client.Close()
 	}
+	if c.RedisClient != nil {
+		c.RedisClient.Close()
+	}
 }
@@ -0,0 +1,39 @@
+package entity
+
+// DomainEvent is something that happened to an aggregate that other parts of
+// the system may react to
+type DomainEvent interface {
+	// EventType identifies the event, e.g. "car_created"
+	EventType() string
+}
+
+// Aggregate is an entity whose changes are persisted as a unit together with
+// the domain events it recorded
+type Aggregate interface {
+	AggregateType() string
+	AggregateID() string
+	AggregateTenantID() string
+	Events() []DomainEvent
+	ClearEvents()
+}
+
+// AggregateRoot collects the domain events recorded by an aggregate until they are persisted.
+// Embed it in an entity to let it record events.
+type AggregateRoot struct {
+	events []DomainEvent
+}
+
+// RecordEvent records a domain event to be published when the aggregate is committed
+func (a *AggregateRoot) RecordEvent(event DomainEvent) {
+	a.events = append(a.events, event)
+}
+
+// Events returns the recorded domain events that have not been persisted yet
+func (a *AggregateRoot) Events() []DomainEvent {
+	return a.events
+}
+
+// ClearEvents forgets the recorded domain events once they have been persisted
+func (a *AggregateRoot) ClearEvents() {
+	a.events = nil
+}
@@ -0,0 +1,105 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TestNewAPIKey tests that a new key matches its entity and only its hash is stored
+func TestNewAPIKey(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	apiKey, key, err := entity.NewAPIKey("tenant-123", "CI", []string{"cars:read"}, null.Time{}, now)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(key, apiKey.Prefix+"_"))
+	assert.NotContains(t, apiKey.SecretHash, key[len(apiKey.Prefix)+1:])
+	assert.True(t, apiKey.Matches(key))
+	assert.False(t, apiKey.Matches(key+"x"))
+	assert.True(t, apiKey.Active(now))
+
+	prefix, ok := entity.ParseAPIKeyPrefix(key)
+	assert.True(t, ok)
+	assert.Equal(t, apiKey.Prefix, prefix)
+}
+
+// TestNewAPIKey_Validation tests that invalid keys are rejected
+func TestNewAPIKey_Validation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	_, _, err := entity.NewAPIKey("tenant-123", "", nil, null.Time{}, now)
+	assert.Error(t, err)
+
+	_, _, err = entity.NewAPIKey("tenant-123", "CI", nil, null.TimeFrom(now.Add(-time.Hour)), now)
+	assert.Error(t, err)
+}
+
+// TestAPIKey_Rotate tests that rotation invalidates the previous key
+func TestAPIKey_Rotate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	apiKey, oldKey, err := entity.NewAPIKey("tenant-123", "CI", nil, null.Time{}, now)
+	require.NoError(t, err)
+
+	newKey, err := apiKey.Rotate(now)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, oldKey, newKey)
+	assert.False(t, apiKey.Matches(oldKey))
+	assert.True(t, apiKey.Matches(newKey))
+}
+
+// TestAPIKey_Active tests that revoked and expired keys are inactive
+func TestAPIKey_Active(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	expiring, _, err := entity.NewAPIKey("tenant-123", "CI", nil, null.TimeFrom(now.Add(time.Hour)), now)
+	require.NoError(t, err)
+	assert.True(t, expiring.Active(now))
+	assert.False(t, expiring.Active(now.Add(time.Hour)))
+
+	revoked, _, err := entity.NewAPIKey("tenant-123", "CI", nil, null.Time{}, now)
+	require.NoError(t, err)
+	revoked.Revoke(now)
+	assert.False(t, revoked.Active(now))
+}
+
+// TestParseAPIKeyPrefix tests that only well-formed API keys are recognised
+func TestParseAPIKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		key        string
+		wantPrefix string
+		wantOK     bool
+	}{
+		"api key":      {key: "ak_0123456789ab_secret", wantPrefix: "ak_0123456789ab", wantOK: true},
+		"jwt":          {key: "eyJhbGciOiJSUzI1NiJ9.e30.sig"},
+		"missing part": {key: "ak_0123456789ab"},
+		"empty prefix": {key: "ak__secret"},
+		"empty":        {key: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix, ok := entity.ParseAPIKeyPrefix(tt.key)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantPrefix, prefix)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/oklog/ulid/v2"
+)
+
+// MaxArchiveJobErrorLength is the length errors of archive jobs are truncated to
+const MaxArchiveJobErrorLength = 1000
+
+// ArchiveJob represents the export of a tenant to an archive, or the import of a tenant
+// from one, running in the background
+type ArchiveJob struct {
+	ID   string
+	Kind ArchiveJobKind
+	// TenantID is the exported tenant, or the imported one once the import succeeded
+	TenantID null.String
+	// Archive is the name of the archive file in the archive storage
+	Archive string
+	Status  ArchiveJobStatus
+	// Counts is the number of records per kind written or read, set on success
+	Counts     map[string]int
+	Error      null.String
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	FinishedAt null.Time
+}
+
+// NewArchiveJob creates a new running ArchiveJob
+func NewArchiveJob(kind ArchiveJobKind, tenantID null.String, archive string, createdAt time.Time) *ArchiveJob {
+	return &ArchiveJob{
+		ID:        ulid.Make().String(),
+		Kind:      kind,
+		TenantID:  tenantID,
+		Archive:   archive,
+		Status:    ArchiveJobStatusRunning,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+// Succeed marks the job as succeeded with the tenant it exported or imported
+func (j *ArchiveJob) Succeed(tenantID string, counts map[string]int, now time.Time) {
+	j.Status = ArchiveJobStatusSucceeded
+	j.TenantID = null.StringFrom(tenantID)
+	j.Counts = counts
+	j.Error = null.String{}
+	j.FinishedAt = null.TimeFrom(now)
+	j.UpdatedAt = now
+}
+
+// Fail marks the job as failed
+func (j *ArchiveJob) Fail(errMessage string, now time.Time) {
+	if len(errMessage) > MaxArchiveJobErrorLength {
+		// Drop a character cut in half rather than store invalid UTF-8
+		errMessage = strings.ToValidUTF8(errMessage[:MaxArchiveJobErrorLength], "")
+	}
+	j.Status = ArchiveJobStatusFailed
+	j.Error = null.StringFrom(errMessage)
+	j.FinishedAt = null.TimeFrom(now)
+	j.UpdatedAt = now
+}
+
+type ArchiveJobKind string
+
+const (
+	ArchiveJobKindUnknown ArchiveJobKind = "unknown"
+	ArchiveJobKindExport  ArchiveJobKind = "export"
+	ArchiveJobKindImport  ArchiveJobKind = "import"
+)
+
+func NewArchiveJobKind(s string) ArchiveJobKind {
+	switch s {
+	case ArchiveJobKindExport.String(),
+		ArchiveJobKindImport.String():
+		return ArchiveJobKind(s)
+	}
+	return ArchiveJobKindUnknown
+}
+
+func (k ArchiveJobKind) String() string {
+	return string(k)
+}
+
+type ArchiveJobStatus string
+
+const (
+	ArchiveJobStatusUnknown   ArchiveJobStatus = "unknown"
+	ArchiveJobStatusRunning   ArchiveJobStatus = "running"
+	ArchiveJobStatusSucceeded ArchiveJobStatus = "succeeded"
+	ArchiveJobStatusFailed    ArchiveJobStatus = "failed"
+)
+
+func NewArchiveJobStatus(s string) ArchiveJobStatus {
+	switch s {
+	case ArchiveJobStatusRunning.String(),
+		ArchiveJobStatusSucceeded.String(),
+		ArchiveJobStatusFailed.String():
+		return ArchiveJobStatus(s)
+	}
+	return ArchiveJobStatusUnknown
+}
+
+func (s ArchiveJobStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,38 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+func TestArchiveJob_Succeed(t *testing.T) {
+	t.Parallel()
+
+	job := entity.NewArchiveJob(entity.ArchiveJobKindImport, null.String{}, "acme.ndjson", time.Now())
+	assert.Equal(t, entity.ArchiveJobStatusRunning, job.Status)
+
+	now := time.Now()
+	job.Succeed("tenant-1", map[string]int{"car": 2}, now)
+
+	assert.Equal(t, entity.ArchiveJobStatusSucceeded, job.Status)
+	assert.Equal(t, null.StringFrom("tenant-1"), job.TenantID)
+	assert.Equal(t, map[string]int{"car": 2}, job.Counts)
+	assert.Equal(t, null.TimeFrom(now), job.FinishedAt)
+}
+
+func TestArchiveJob_Fail(t *testing.T) {
+	t.Parallel()
+
+	job := entity.NewArchiveJob(entity.ArchiveJobKindExport, null.StringFrom("tenant-1"), "acme.ndjson", time.Now())
+	job.Fail(strings.Repeat("x", entity.MaxArchiveJobErrorLength+1), time.Now())
+
+	assert.Equal(t, entity.ArchiveJobStatusFailed, job.Status)
+	assert.Len(t, job.Error.String, entity.MaxArchiveJobErrorLength)
+	assert.True(t, job.FinishedAt.Valid)
+}
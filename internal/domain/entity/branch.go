@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+)
+
+// ErrInvalidBranch is returned for branches with missing or malformed attributes
+var ErrInvalidBranch = errors.New("invalid branch")
+
+// countryCodePattern matches ISO 3166-1 alpha-2 country codes
+var countryCodePattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Branches is a slice of Branch
+type Branches []*Branch
+
+// Branch is a location of a tenant where cars are kept, picked up and returned
+type Branch struct {
+	ID        string
+	TenantID  string
+	Spec      BranchSpec
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BranchSpec holds the attributes of a branch
+type BranchSpec struct {
+	// Name is unique among the branches of a tenant, e.g. "Shinjuku"
+	Name        string
+	Address     Address
+	Coordinates *value.Coordinates
+	// OpeningHours are on the tenant's clocks. A branch without any period has the
+	// business hours of the tenant.
+	OpeningHours BusinessHours
+}
+
+// Address is the postal address of a branch
+type Address struct {
+	Street string
+	City   string
+	// Region is the state, province or prefecture, where the country has them
+	Region     string
+	PostalCode string
+	// Country is an ISO 3166-1 alpha-2 code, e.g. "JP"
+	Country string
+}
+
+// normalized returns the spec with surrounding spaces trimmed, the country upper-cased and
+// the opening hours sorted
+func (s BranchSpec) normalized() BranchSpec {
+	s.Name = strings.TrimSpace(s.Name)
+	s.Address = Address{
+		Street:     strings.TrimSpace(s.Address.Street),
+		City:       strings.TrimSpace(s.Address.City),
+		Region:     strings.TrimSpace(s.Address.Region),
+		PostalCode: strings.TrimSpace(s.Address.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(s.Address.Country)),
+	}
+	s.OpeningHours = s.OpeningHours.Sorted()
+	return s
+}
+
+// Validate checks that every required attribute is set and the opening hours are well formed
+func (s BranchSpec) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidBranch)
+	}
+	if strings.TrimSpace(s.Address.Street) == "" {
+		return fmt.Errorf("%w: street is required", ErrInvalidBranch)
+	}
+	if strings.TrimSpace(s.Address.City) == "" {
+		return fmt.Errorf("%w: city is required", ErrInvalidBranch)
+	}
+	if !countryCodePattern.MatchString(s.Address.Country) {
+		return fmt.Errorf("%w: country must be an ISO 3166-1 alpha-2 code", ErrInvalidBranch)
+	}
+	if s.Coordinates == nil {
+		return fmt.Errorf("%w: coordinates are required", ErrInvalidBranch)
+	}
+	if err := s.OpeningHours.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidBranch, err)
+	}
+	return nil
+}
+
+// NewBranch creates a new Branch of a tenant
+func NewBranch(tenantID string, spec BranchSpec, createdAt time.Time) (*Branch, error) {
+	spec = spec.normalized()
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Branch{
+		ID:        ulid.Make().String(),
+		TenantID:  tenantID,
+		Spec:      spec,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}, nil
+}
+
+// WithID creates a Branch with a specific ID (for testing)
+func (b *Branch) WithID(id string) *Branch {
+	b.ID = id
+	return b
+}
+
+// Update replaces the attributes of the branch
+func (b *Branch) Update(spec BranchSpec, now time.Time) error {
+	spec = spec.normalized()
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+
+	b.Spec = spec
+	b.UpdatedAt = now
+	return nil
+}
+
+// CheckPickup checks that a car can be picked up at the branch at t: within its opening
+// hours, or the business hours of the tenant of settings if it has none
+func (b *Branch) CheckPickup(settings *TenantSettings, t time.Time) error {
+	if len(b.Spec.OpeningHours) == 0 {
+		return settings.CheckPickup(t)
+	}
+
+	local := settings.LocalTime(t)
+	if !b.Spec.OpeningHours.OpenAt(local) {
+		return fmt.Errorf("%w: pickup at %s on %s at branch %s",
+			ErrOutsideBusinessHours, local.Format("2006-01-02 15:04 MST"), local.Weekday(), b.Spec.Name)
+	}
+	return nil
+}
+
+// DistanceKm returns the great-circle distance from the branch to a position in kilometers
+func (b *Branch) DistanceKm(to *value.Coordinates) float64 {
+	return b.Spec.Coordinates.DistanceKm(to)
+}
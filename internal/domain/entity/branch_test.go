@@ -0,0 +1,200 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+)
+
+// validBranchSpec returns the attributes of a branch by Tokyo station, open on Mondays
+func validBranchSpec(t *testing.T) entity.BranchSpec {
+	t.Helper()
+	coordinates, err := value.NewCoordinates(35.6812, 139.7671)
+	require.NoError(t, err)
+	return entity.BranchSpec{
+		Name: "Tokyo Station",
+		Address: entity.Address{
+			Street:     "1-9-1 Marunouchi",
+			City:       "Chiyoda",
+			Region:     "Tokyo",
+			PostalCode: "100-0005",
+			Country:    "JP",
+		},
+		Coordinates:  coordinates,
+		OpeningHours: entity.BusinessHours{{Weekday: time.Monday, Opens: 9 * 60, Closes: 18 * 60}},
+	}
+}
+
+// TestNewBranch tests that branches are only created with a name, an address and coordinates
+func TestNewBranch(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		modify  func(spec *entity.BranchSpec)
+		wantErr bool
+	}{
+		"valid":               {},
+		"lower-case country":  {modify: func(spec *entity.BranchSpec) { spec.Address.Country = " jp" }},
+		"always open":         {modify: func(spec *entity.BranchSpec) { spec.OpeningHours = nil }},
+		"no name":             {modify: func(spec *entity.BranchSpec) { spec.Name = " " }, wantErr: true},
+		"no street":           {modify: func(spec *entity.BranchSpec) { spec.Address.Street = "" }, wantErr: true},
+		"no city":             {modify: func(spec *entity.BranchSpec) { spec.Address.City = "" }, wantErr: true},
+		"unknown country":     {modify: func(spec *entity.BranchSpec) { spec.Address.Country = "JPN" }, wantErr: true},
+		"no coordinates":      {modify: func(spec *entity.BranchSpec) { spec.Coordinates = nil }, wantErr: true},
+		"closing before open": {modify: func(spec *entity.BranchSpec) { spec.OpeningHours[0].Closes = 8 * 60 }, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := validBranchSpec(t)
+			if tt.modify != nil {
+				tt.modify(&spec)
+			}
+
+			branch, err := entity.NewBranch("tenant-1", spec, time.Now())
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidBranch)
+				assert.Nil(t, branch)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, branch.ID)
+			assert.Equal(t, "JP", branch.Spec.Address.Country)
+		})
+	}
+}
+
+// TestBranch_CheckPickup tests that cars are picked up during the opening hours of the
+// branch, or the business hours of the tenant for branches without any
+func TestBranch_CheckPickup(t *testing.T) {
+	t.Parallel()
+
+	settings := entity.DefaultTenantSettings("tenant-1", time.Now())
+	tenantHours := entity.BusinessHours{{Weekday: time.Monday, Opens: 12 * 60, Closes: 20 * 60}}
+	require.NoError(t, settings.Update("Asia/Tokyo", "JPY", "ja-JP", tenantHours, time.Now()))
+	// 01:00 UTC on Monday is 10:00 in Tokyo, and 10:00 UTC is 19:00
+	morning := time.Date(2025, 1, 6, 1, 0, 0, 0, time.UTC)
+	evening := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+
+	branch, err := entity.NewBranch("tenant-1", validBranchSpec(t), time.Now())
+	require.NoError(t, err)
+	assert.NoError(t, branch.CheckPickup(settings, morning))
+	assert.ErrorIs(t, branch.CheckPickup(settings, evening), entity.ErrOutsideBusinessHours)
+
+	spec := validBranchSpec(t)
+	spec.OpeningHours = nil
+	require.NoError(t, branch.Update(spec, time.Now()))
+	assert.ErrorIs(t, branch.CheckPickup(settings, morning), entity.ErrOutsideBusinessHours)
+	assert.NoError(t, branch.CheckPickup(settings, evening))
+}
+
+// TestNewRental_Branches tests that cars are picked up where they are, and that only the
+// tenant's own cars are returned elsewhere
+func TestNewRental_Branches(t *testing.T) {
+	t.Parallel()
+
+	settings := entity.DefaultTenantSettings("tenant-a", time.Now())
+	startsAt := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.AddDate(0, 0, 2)
+	newBranch := func(id string) *entity.Branch {
+		spec := validBranchSpec(t)
+		spec.OpeningHours = nil
+		branch, err := entity.NewBranch("tenant-a", spec, time.Now())
+		require.NoError(t, err)
+		return branch.WithID(id)
+	}
+	tokyo, osaka := newBranch("tokyo"), newBranch("osaka")
+	car := entity.NewCar("tenant-a", "model-1", "tokyo", nil, nil, time.Now())
+
+	// The car goes back to where it was picked up unless told otherwise
+	rental, err := entity.NewRental(settings, car, "renter-1", entity.RentalBranches{Pickup: tokyo}, startsAt, endsAt)
+	require.NoError(t, err)
+	assert.Equal(t, "tokyo", rental.PickupBranchID)
+	assert.Equal(t, "tokyo", rental.ReturnBranchID)
+	assert.False(t, rental.OneWay())
+
+	rental, err = entity.NewRental(settings, car, "renter-1", entity.RentalBranches{Pickup: tokyo, Return: osaka}, startsAt, endsAt)
+	require.NoError(t, err)
+	assert.Equal(t, "osaka", rental.ReturnBranchID)
+	assert.True(t, rental.OneWay())
+
+	_, err = entity.NewRental(settings, car, "renter-1", entity.RentalBranches{Pickup: osaka}, startsAt, endsAt)
+	assert.ErrorIs(t, err, entity.ErrCarNotAtBranch)
+
+	_, err = entity.NewRental(settings, car, "renter-1", entity.RentalBranches{Return: osaka}, startsAt, endsAt)
+	assert.ErrorIs(t, err, entity.ErrReturnWithoutPickup)
+
+	shared := entity.NewCar("tenant-b", "model-2", "tokyo", nil, nil, time.Now())
+	_, err = entity.NewRental(settings, shared, "renter-1", entity.RentalBranches{Pickup: tokyo, Return: osaka}, startsAt, endsAt)
+	assert.ErrorIs(t, err, entity.ErrOneWaySharedCar)
+	_, err = entity.NewRental(settings, shared, "renter-1", entity.RentalBranches{Pickup: tokyo}, startsAt, endsAt)
+	assert.NoError(t, err)
+}
+
+// TestRental_Return tests that a rental is returned once, after it started
+func TestRental_Return(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Now().Add(-time.Hour)
+	rental := &entity.Rental{
+		ID:             "rental-1",
+		TenantID:       "tenant-a",
+		OwnerTenantID:  "tenant-a",
+		CarID:          "car-1",
+		PickupBranchID: "tokyo",
+		ReturnBranchID: "osaka",
+		StartsAt:       startsAt,
+		EndsAt:         startsAt.AddDate(0, 0, 1),
+	}
+
+	err := rental.Return(startsAt.Add(-time.Minute))
+	assert.ErrorIs(t, err, entity.ErrRentalNotStarted)
+	assert.False(t, rental.Returned())
+
+	returnedAt := startsAt.Add(2 * time.Hour)
+	require.NoError(t, rental.Return(returnedAt))
+	assert.True(t, rental.Returned())
+	assert.Equal(t, returnedAt, rental.ReturnedAt.Time)
+	assert.Equal(t, []entity.DomainEvent{entity.RentalReturned{
+		ID:             "rental-1",
+		TenantID:       "tenant-a",
+		OwnerTenantID:  "tenant-a",
+		CarID:          "car-1",
+		ReturnBranchID: "osaka",
+		OneWay:         true,
+		ReturnedAt:     returnedAt,
+	}}, rental.Events())
+
+	assert.ErrorIs(t, rental.Return(returnedAt), entity.ErrRentalAlreadyReturned)
+}
+
+// TestCar_AssignBranches tests that a car without a current branch is at its home branch
+func TestCar_AssignBranches(t *testing.T) {
+	t.Parallel()
+
+	car := entity.NewCar("tenant-a", "model-1", "", nil, nil, time.Now())
+	car.ClearEvents()
+
+	now := time.Now()
+	car.AssignBranches("tokyo", "", now)
+	assert.Equal(t, "tokyo", car.HomeBranchID)
+	assert.Equal(t, "tokyo", car.CurrentBranchID)
+
+	car.MoveTo("osaka", now)
+	assert.Equal(t, "tokyo", car.HomeBranchID)
+	assert.Equal(t, "osaka", car.CurrentBranchID)
+	assert.Equal(t, entity.CarBranchesChanged{
+		ID:              car.ID,
+		TenantID:        "tenant-a",
+		HomeBranchID:    "tokyo",
+		CurrentBranchID: "osaka",
+		UpdatedAt:       now,
+	}, car.Events()[1])
+}
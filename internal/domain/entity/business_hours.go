@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrInvalidOpeningHours is returned for malformed or overlapping opening hours
+var ErrInvalidOpeningHours = errors.New("invalid opening hours")
+
+// MinutesPerDay is the number of minutes in a day, and the largest TimeOfDay: "24:00"
+const MinutesPerDay = 24 * 60
+
+// TimeOfDay is a wall clock time, in minutes after midnight
+type TimeOfDay int
+
+// ParseTimeOfDay parses a "HH:MM" wall clock time between "00:00" and "24:00"
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	if s == "24:00" {
+		return MinutesPerDay, nil
+	}
+	t, err := time.Parse("15:04", s)
+	if err != nil || len(s) != len("15:04") {
+		return 0, fmt.Errorf("%w: time %q must be formatted as HH:MM", ErrInvalidOpeningHours, s)
+	}
+	return TimeOfDayOf(t), nil
+}
+
+// TimeOfDayOf returns the wall clock time of t in its location
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	return TimeOfDay(t.Hour()*60 + t.Minute())
+}
+
+// String returns the time formatted as "HH:MM"
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+// OpeningHours is a period during which a tenant or a branch is open on a day of the week. Opens is
+// inclusive and Closes exclusive; a period cannot span midnight.
+type OpeningHours struct {
+	Weekday time.Weekday
+	Opens   TimeOfDay
+	Closes  TimeOfDay
+}
+
+// BusinessHours are the weekly opening hours of a tenant or a branch. Days without a period
+// are closed days, but hours without any period are always open.
+type BusinessHours []OpeningHours
+
+// Validate checks that every period is well formed and that the periods of a day do not
+// overlap
+func (h BusinessHours) Validate() error {
+	for i, period := range h {
+		if period.Weekday < time.Sunday || period.Weekday > time.Saturday {
+			return fmt.Errorf("%w: unknown weekday %d", ErrInvalidOpeningHours, period.Weekday)
+		}
+		if period.Opens < 0 || period.Closes > MinutesPerDay || period.Opens >= period.Closes {
+			return fmt.Errorf("%w: opening hours %s-%s on %s must open before they close",
+				ErrInvalidOpeningHours, period.Opens, period.Closes, period.Weekday)
+		}
+		for _, other := range h[:i] {
+			if other.Weekday == period.Weekday && other.Opens < period.Closes && period.Opens < other.Closes {
+				return fmt.Errorf("%w: opening hours %s-%s and %s-%s on %s overlap",
+					ErrInvalidOpeningHours, other.Opens, other.Closes, period.Opens, period.Closes, period.Weekday)
+			}
+		}
+	}
+	return nil
+}
+
+// Sorted returns a copy of the periods ordered by weekday, starting on Sunday, and opening time
+func (h BusinessHours) Sorted() BusinessHours {
+	sorted := slices.Clone(h)
+	slices.SortFunc(sorted, func(a, b OpeningHours) int {
+		if a.Weekday != b.Weekday {
+			return int(a.Weekday) - int(b.Weekday)
+		}
+		return int(a.Opens) - int(b.Opens)
+	})
+	return sorted
+}
+
+// OpenAt reports whether the business is open at the wall clock time of local, which must
+// already be in the tenant's location
+func (h BusinessHours) OpenAt(local time.Time) bool {
+	if len(h) == 0 {
+		return true
+	}
+
+	weekday, at := local.Weekday(), TimeOfDayOf(local)
+	return slices.ContainsFunc(h, func(period OpeningHours) bool {
+		return period.Weekday == weekday && period.Opens <= at && at < period.Closes
+	})
+}
@@ -0,0 +1,111 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TestParseTimeOfDay tests that only HH:MM times of one day are accepted
+func TestParseTimeOfDay(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		s       string
+		want    entity.TimeOfDay
+		wantErr bool
+	}{
+		"midnight":      {s: "00:00", want: 0},
+		"morning":       {s: "09:30", want: 9*60 + 30},
+		"end of day":    {s: "24:00", want: entity.MinutesPerDay},
+		"single digit":  {s: "9:30", wantErr: true},
+		"seconds":       {s: "09:30:00", wantErr: true},
+		"hour too late": {s: "25:00", wantErr: true},
+		"after 24:00":   {s: "24:01", wantErr: true},
+		"empty":         {s: "", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := entity.ParseTimeOfDay(tt.s)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidOpeningHours)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.s, got.String())
+		})
+	}
+}
+
+// TestBusinessHours_Validate tests that malformed and overlapping periods are rejected
+func TestBusinessHours_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		hours   entity.BusinessHours
+		wantErr bool
+	}{
+		"always open": {},
+		"split day": {hours: entity.BusinessHours{
+			{Weekday: time.Monday, Opens: 9 * 60, Closes: 12 * 60},
+			{Weekday: time.Monday, Opens: 13 * 60, Closes: 18 * 60},
+		}},
+		"adjacent": {hours: entity.BusinessHours{
+			{Weekday: time.Monday, Opens: 9 * 60, Closes: 12 * 60},
+			{Weekday: time.Monday, Opens: 12 * 60, Closes: 18 * 60},
+		}},
+		"same hours other day": {hours: entity.BusinessHours{
+			{Weekday: time.Monday, Opens: 9 * 60, Closes: 18 * 60},
+			{Weekday: time.Tuesday, Opens: 9 * 60, Closes: 18 * 60},
+		}},
+		"overlap": {hours: entity.BusinessHours{
+			{Weekday: time.Monday, Opens: 9 * 60, Closes: 12 * 60},
+			{Weekday: time.Monday, Opens: 11 * 60, Closes: 18 * 60},
+		}, wantErr: true},
+		"closes before opening": {hours: entity.BusinessHours{
+			{Weekday: time.Monday, Opens: 18 * 60, Closes: 9 * 60},
+		}, wantErr: true},
+		"empty period": {hours: entity.BusinessHours{
+			{Weekday: time.Monday, Opens: 9 * 60, Closes: 9 * 60},
+		}, wantErr: true},
+		"unknown weekday": {hours: entity.BusinessHours{
+			{Weekday: 7, Opens: 9 * 60, Closes: 18 * 60},
+		}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.hours.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidOpeningHours)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestBusinessHours_OpenAt tests that opening is inclusive and closing exclusive
+func TestBusinessHours_OpenAt(t *testing.T) {
+	t.Parallel()
+
+	hours := entity.BusinessHours{{Weekday: time.Monday, Opens: 9 * 60, Closes: 18 * 60}}
+	monday := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	assert.False(t, hours.OpenAt(monday.Add(8*time.Hour+59*time.Minute)))
+	assert.True(t, hours.OpenAt(monday.Add(9*time.Hour)))
+	assert.True(t, hours.OpenAt(monday.Add(17*time.Hour+59*time.Minute)))
+	assert.False(t, hours.OpenAt(monday.Add(18*time.Hour)))
+	assert.False(t, hours.OpenAt(monday.AddDate(0, 0, 1).Add(12*time.Hour)))
+	assert.True(t, entity.BusinessHours{}.OpenAt(monday))
+}
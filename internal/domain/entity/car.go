@@ -1,21 +1,38 @@
 package entity
 
 import (
+	"errors"
 	"time"
 
 	"github.com/oklog/ulid/v2"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
 )
 
+// ErrDuplicateCar is returned when another car of the tenant has the same VIN or license plate
+var ErrDuplicateCar = errors.New("a car with this VIN or license plate already exists")
+
 // Cars is a slice of Car
 type Cars []*Car
 
-// Car represents a car entity
+// Car represents a car entity: a physical unit of a model of the tenant's catalog
 type Car struct {
-	ID        string
-	TenantID  string
-	Model     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	AggregateRoot
+
+	ID       string
+	TenantID string
+	// ModelID is the catalog entry of the car's model
+	ModelID string
+	// VIN and LicensePlate identify the unit; nil when not recorded. Each is unique among
+	// the cars of a tenant.
+	VIN          *value.VIN
+	LicensePlate *value.LicensePlate
+	// HomeBranchID is the branch the car belongs to and CurrentBranchID the one it is at,
+	// which one-way rentals change. Both are empty for cars without a branch.
+	HomeBranchID    string
+	CurrentBranchID string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 
 	// References to related entities
 	Refs *CarRefs
@@ -24,18 +41,36 @@ type Car struct {
 // CarRefs holds references to related entities
 type CarRefs struct {
 	Tenant  *Tenant
+	Model   *CarModel
 	Rentals Rentals
 }
 
-// NewCar creates a new Car
-func NewCar(tenantID, model string, createdAt time.Time) *Car {
-	return &Car{
-		ID:        ulid.Make().String(),
-		TenantID:  tenantID,
-		Model:     model,
-		CreatedAt: createdAt,
-		UpdatedAt: createdAt,
+// NewCar creates a new Car of a model of the tenant's catalog, at its home branch if it
+// has one
+func NewCar(tenantID, modelID, homeBranchID string, vin *value.VIN, plate *value.LicensePlate, createdAt time.Time) *Car {
+	car := &Car{
+		ID:              ulid.Make().String(),
+		TenantID:        tenantID,
+		ModelID:         modelID,
+		VIN:             vin,
+		LicensePlate:    plate,
+		HomeBranchID:    homeBranchID,
+		CurrentBranchID: homeBranchID,
+		CreatedAt:       createdAt,
+		UpdatedAt:       createdAt,
 	}
+	car.RecordEvent(CarCreated{
+		ID:                  car.ID,
+		TenantID:            car.TenantID,
+		ModelID:             car.ModelID,
+		VIN:                 car.VINString(),
+		LicensePlate:        car.LicensePlateString(),
+		LicensePlateCountry: car.LicensePlateCountry(),
+		HomeBranchID:        car.HomeBranchID,
+		CreatedAt:           car.CreatedAt,
+		UpdatedAt:           car.UpdatedAt,
+	})
+	return car
 }
 
 // WithID creates a Car with a specific ID (for testing)
@@ -43,3 +78,79 @@ func (c *Car) WithID(id string) *Car {
 	c.ID = id
 	return c
 }
+
+// VINString returns the VIN of the car, or "" when it is not recorded
+func (c *Car) VINString() string {
+	if c.VIN == nil {
+		return ""
+	}
+	return c.VIN.String()
+}
+
+// LicensePlateString returns the license plate of the car, or "" when it is not recorded
+func (c *Car) LicensePlateString() string {
+	if c.LicensePlate == nil {
+		return ""
+	}
+	return c.LicensePlate.String()
+}
+
+// LicensePlateCountry returns the country that issued the license plate of the car, or ""
+// when it is not recorded
+func (c *Car) LicensePlateCountry() string {
+	if c.LicensePlate == nil {
+		return ""
+	}
+	return c.LicensePlate.Country()
+}
+
+// AssignBranches sets the branch the car belongs to and the one it is at. An empty current
+// branch means the car is at its home branch.
+func (c *Car) AssignBranches(homeBranchID, currentBranchID string, now time.Time) {
+	if currentBranchID == "" {
+		currentBranchID = homeBranchID
+	}
+	c.HomeBranchID = homeBranchID
+	c.setCurrentBranch(currentBranchID, now)
+}
+
+// MoveTo records that the car is now at a branch, e.g. where a one-way rental returned it
+func (c *Car) MoveTo(branchID string, now time.Time) {
+	c.setCurrentBranch(branchID, now)
+}
+
+// setCurrentBranch sets the branch the car is at and records the change of its branches
+func (c *Car) setCurrentBranch(branchID string, now time.Time) {
+	c.CurrentBranchID = branchID
+	c.UpdatedAt = now
+	c.RecordEvent(CarBranchesChanged{
+		ID:              c.ID,
+		TenantID:        c.TenantID,
+		HomeBranchID:    c.HomeBranchID,
+		CurrentBranchID: c.CurrentBranchID,
+		UpdatedAt:       c.UpdatedAt,
+	})
+}
+
+// Model returns the catalog entry of the car's model when it was loaded with the car
+func (c *Car) Model() *CarModel {
+	if c.Refs == nil {
+		return nil
+	}
+	return c.Refs.Model
+}
+
+// AggregateType returns the aggregate type used for the car's events
+func (c *Car) AggregateType() string {
+	return "car"
+}
+
+// AggregateID returns the ID of the car
+func (c *Car) AggregateID() string {
+	return c.ID
+}
+
+// AggregateTenantID returns the ID of the tenant owning the car
+func (c *Car) AggregateTenantID() string {
+	return c.TenantID
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// CarCreated is recorded when a car is added to a tenant's fleet
+type CarCreated struct {
+	ID                  string    `json:"id"`
+	TenantID            string    `json:"tenant_id"`
+	ModelID             string    `json:"car_model_id"`
+	VIN                 string    `json:"vin,omitempty"`
+	LicensePlate        string    `json:"license_plate,omitempty"`
+	LicensePlateCountry string    `json:"license_plate_country,omitempty"`
+	HomeBranchID        string    `json:"home_branch_id,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+// EventType returns the type of the event
+func (CarCreated) EventType() string {
+	return "car_created"
+}
+
+// CarBranchesChanged is recorded when a car is assigned to branches or moved to another
+// branch, e.g. by a one-way rental
+type CarBranchesChanged struct {
+	ID              string    `json:"id"`
+	TenantID        string    `json:"tenant_id"`
+	HomeBranchID    string    `json:"home_branch_id,omitempty"`
+	CurrentBranchID string    `json:"current_branch_id,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// EventType returns the type of the event
+func (CarBranchesChanged) EventType() string {
+	return "car_branches_changed"
+}
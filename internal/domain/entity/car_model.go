@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrInvalidCarModel is returned for car models with missing or unknown attributes
+var ErrInvalidCarModel = errors.New("invalid car model")
+
+// MaxCarModelSeats is the most seats a car model may have
+const MaxCarModelSeats = 60
+
+// CarCategory is the class of cars a model belongs to, e.g. what renters book by
+type CarCategory string
+
+const (
+	// CarCategoryUnspecified is the category of models moved into the catalog from the
+	// model names of cars created before it; it cannot be set otherwise
+	CarCategoryUnspecified CarCategory = "unspecified"
+	CarCategoryEconomy     CarCategory = "economy"
+	CarCategoryCompact     CarCategory = "compact"
+	CarCategoryMidsize     CarCategory = "midsize"
+	CarCategoryFullsize    CarCategory = "fullsize"
+	CarCategorySUV         CarCategory = "suv"
+	CarCategoryVan         CarCategory = "van"
+	CarCategoryLuxury      CarCategory = "luxury"
+)
+
+// CarCategories lists every category a car model can be created with
+var CarCategories = []CarCategory{
+	CarCategoryEconomy, CarCategoryCompact, CarCategoryMidsize, CarCategoryFullsize,
+	CarCategorySUV, CarCategoryVan, CarCategoryLuxury,
+}
+
+func (c CarCategory) String() string {
+	return string(c)
+}
+
+// Transmission is the gearbox of a car model
+type Transmission string
+
+const (
+	// TransmissionUnspecified is the transmission of models moved into the catalog from the
+	// model names of cars created before it; it cannot be set otherwise
+	TransmissionUnspecified Transmission = "unspecified"
+	TransmissionAutomatic   Transmission = "automatic"
+	TransmissionManual      Transmission = "manual"
+)
+
+// Transmissions lists every transmission a car model can be created with
+var Transmissions = []Transmission{TransmissionAutomatic, TransmissionManual}
+
+func (t Transmission) String() string {
+	return string(t)
+}
+
+// FuelType is what a car model runs on
+type FuelType string
+
+const (
+	// FuelTypeUnspecified is the fuel type of models moved into the catalog from the model
+	// names of cars created before it; it cannot be set otherwise
+	FuelTypeUnspecified FuelType = "unspecified"
+	FuelTypeGasoline    FuelType = "gasoline"
+	FuelTypeDiesel      FuelType = "diesel"
+	FuelTypeHybrid      FuelType = "hybrid"
+	FuelTypeElectric    FuelType = "electric"
+)
+
+// FuelTypes lists every fuel type a car model can be created with
+var FuelTypes = []FuelType{FuelTypeGasoline, FuelTypeDiesel, FuelTypeHybrid, FuelTypeElectric}
+
+func (f FuelType) String() string {
+	return string(f)
+}
+
+// CarModels is a slice of CarModel
+type CarModels []*CarModel
+
+// CarModel is an entry of a tenant's catalog of car models, e.g. "Toyota Camry". Cars are
+// physical units of a model, so a tenant can own any number of cars of the same model.
+type CarModel struct {
+	ID        string
+	TenantID  string
+	Spec      CarModelSpec
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CarModelSpec holds the attributes of a car model
+type CarModelSpec struct {
+	Make string
+	// Name is the name of the model within its make, e.g. "Camry"
+	Name         string
+	Category     CarCategory
+	Seats        int
+	Transmission Transmission
+	FuelType     FuelType
+}
+
+// Validate checks that every attribute is set to a known value
+func (s CarModelSpec) Validate() error {
+	if strings.TrimSpace(s.Make) == "" {
+		return fmt.Errorf("%w: make is required", ErrInvalidCarModel)
+	}
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidCarModel)
+	}
+	if !slices.Contains(CarCategories, s.Category) {
+		return fmt.Errorf("%w: unknown category %q", ErrInvalidCarModel, s.Category)
+	}
+	if s.Seats < 1 || s.Seats > MaxCarModelSeats {
+		return fmt.Errorf("%w: seats must be between 1 and %d", ErrInvalidCarModel, MaxCarModelSeats)
+	}
+	if !slices.Contains(Transmissions, s.Transmission) {
+		return fmt.Errorf("%w: unknown transmission %q", ErrInvalidCarModel, s.Transmission)
+	}
+	if !slices.Contains(FuelTypes, s.FuelType) {
+		return fmt.Errorf("%w: unknown fuel type %q", ErrInvalidCarModel, s.FuelType)
+	}
+	return nil
+}
+
+// NewCarModel creates a new CarModel in a tenant's catalog
+func NewCarModel(tenantID string, spec CarModelSpec, createdAt time.Time) (*CarModel, error) {
+	spec.Make = strings.TrimSpace(spec.Make)
+	spec.Name = strings.TrimSpace(spec.Name)
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &CarModel{
+		ID:        ulid.Make().String(),
+		TenantID:  tenantID,
+		Spec:      spec,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}, nil
+}
+
+// LegacyCarModel creates the catalog entry of a model name of a car created before the
+// catalog, e.g. "Toyota Camry". The first word is taken as the make; a single word is both
+// the make and the name. The other attributes are unspecified until the model is updated.
+func LegacyCarModel(tenantID, model string, createdAt time.Time) *CarModel {
+	model = strings.TrimSpace(model)
+	brand, name, found := strings.Cut(model, " ")
+	if !found || strings.TrimSpace(name) == "" {
+		brand, name = model, model
+	}
+
+	return &CarModel{
+		ID:       ulid.Make().String(),
+		TenantID: tenantID,
+		Spec: CarModelSpec{
+			Make:         brand,
+			Name:         strings.TrimSpace(name),
+			Category:     CarCategoryUnspecified,
+			Transmission: TransmissionUnspecified,
+			FuelType:     FuelTypeUnspecified,
+		},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+// WithID creates a CarModel with a specific ID (for testing)
+func (m *CarModel) WithID(id string) *CarModel {
+	m.ID = id
+	return m
+}
+
+// Update replaces the attributes of the model
+func (m *CarModel) Update(spec CarModelSpec, now time.Time) error {
+	spec.Make = strings.TrimSpace(spec.Make)
+	spec.Name = strings.TrimSpace(spec.Name)
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+
+	m.Spec = spec
+	m.UpdatedAt = now
+	return nil
+}
+
+// DisplayName returns the make and the name of the model, e.g. "Toyota Camry"
+func (m *CarModel) DisplayName() string {
+	if m.Spec.Make == m.Spec.Name {
+		return m.Spec.Name
+	}
+	return m.Spec.Make + " " + m.Spec.Name
+}
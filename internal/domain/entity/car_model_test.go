@@ -0,0 +1,119 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// validCarModelSpec returns the attributes of a compact hybrid
+func validCarModelSpec() entity.CarModelSpec {
+	return entity.CarModelSpec{
+		Make:         "Toyota",
+		Name:         "Prius",
+		Category:     entity.CarCategoryCompact,
+		Seats:        5,
+		Transmission: entity.TransmissionAutomatic,
+		FuelType:     entity.FuelTypeHybrid,
+	}
+}
+
+// TestNewCarModel tests that models are only created with every attribute set to a known value
+func TestNewCarModel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		modify  func(spec *entity.CarModelSpec)
+		wantErr bool
+	}{
+		"valid":                {},
+		"surrounding spaces":   {modify: func(spec *entity.CarModelSpec) { spec.Make = "  Toyota " }},
+		"no make":              {modify: func(spec *entity.CarModelSpec) { spec.Make = " " }, wantErr: true},
+		"no name":              {modify: func(spec *entity.CarModelSpec) { spec.Name = "" }, wantErr: true},
+		"unknown category":     {modify: func(spec *entity.CarModelSpec) { spec.Category = "truck" }, wantErr: true},
+		"unspecified category": {modify: func(spec *entity.CarModelSpec) { spec.Category = entity.CarCategoryUnspecified }, wantErr: true},
+		"no seats":             {modify: func(spec *entity.CarModelSpec) { spec.Seats = 0 }, wantErr: true},
+		"too many seats":       {modify: func(spec *entity.CarModelSpec) { spec.Seats = entity.MaxCarModelSeats + 1 }, wantErr: true},
+		"unknown transmission": {modify: func(spec *entity.CarModelSpec) { spec.Transmission = "cvt" }, wantErr: true},
+		"unknown fuel type":    {modify: func(spec *entity.CarModelSpec) { spec.FuelType = entity.FuelTypeUnspecified }, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := validCarModelSpec()
+			if tt.modify != nil {
+				tt.modify(&spec)
+			}
+
+			model, err := entity.NewCarModel("tenant-1", spec, time.Now())
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidCarModel)
+				assert.Nil(t, model)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, model.ID)
+			assert.Equal(t, "Toyota", model.Spec.Make)
+			assert.Equal(t, "Toyota Prius", model.DisplayName())
+		})
+	}
+}
+
+// TestCarModel_Update tests that an update replaces every attribute, and keeps them on failure
+func TestCarModel_Update(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	model, err := entity.NewCarModel("tenant-1", validCarModelSpec(), createdAt)
+	require.NoError(t, err)
+
+	spec := validCarModelSpec()
+	spec.Seats = 0
+	assert.ErrorIs(t, model.Update(spec, createdAt.Add(time.Hour)), entity.ErrInvalidCarModel)
+	assert.Equal(t, validCarModelSpec(), model.Spec)
+	assert.Equal(t, createdAt, model.UpdatedAt)
+
+	spec.Seats = 7
+	spec.Category = entity.CarCategoryVan
+	require.NoError(t, model.Update(spec, createdAt.Add(time.Hour)))
+	assert.Equal(t, spec, model.Spec)
+	assert.Equal(t, createdAt.Add(time.Hour), model.UpdatedAt)
+}
+
+// TestLegacyCarModel tests that the model names of cars created before the catalog are split
+// into make and name
+func TestLegacyCarModel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		model       string
+		wantMake    string
+		wantName    string
+		wantDisplay string
+	}{
+		"make and name":  {model: "Toyota Prius", wantMake: "Toyota", wantName: "Prius", wantDisplay: "Toyota Prius"},
+		"several words":  {model: "Tesla Model Y", wantMake: "Tesla", wantName: "Model Y", wantDisplay: "Tesla Model Y"},
+		"one word":       {model: "PRIUS", wantMake: "PRIUS", wantName: "PRIUS", wantDisplay: "PRIUS"},
+		"trailing space": {model: " Leaf ", wantMake: "Leaf", wantName: "Leaf", wantDisplay: "Leaf"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model := entity.LegacyCarModel("tenant-1", tt.model, time.Now())
+			assert.Equal(t, tt.wantMake, model.Spec.Make)
+			assert.Equal(t, tt.wantName, model.Spec.Name)
+			assert.Equal(t, tt.wantDisplay, model.DisplayName())
+			assert.Equal(t, entity.CarCategoryUnspecified, model.Spec.Category)
+			assert.Equal(t, entity.TransmissionUnspecified, model.Spec.Transmission)
+			assert.Equal(t, entity.FuelTypeUnspecified, model.Spec.FuelType)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/id"
+)
+
+// NewBranch creates a new always open Branch with a unique name at a position for testing purposes
+func NewBranch(tenantID string, latitude, longitude float64) (*entity.Branch, error) {
+	coordinates, err := value.NewCoordinates(latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewBranch(tenantID, entity.BranchSpec{
+		Name: "Branch " + id.New(),
+		Address: entity.Address{
+			Street:  "1-1-1 Marunouchi",
+			City:    "Chiyoda",
+			Region:  "Tokyo",
+			Country: "JP",
+		},
+		Coordinates: coordinates,
+	}, time.Now())
+}
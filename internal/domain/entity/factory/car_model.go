@@ -0,0 +1,20 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/id"
+)
+
+// NewCarModel creates a new CarModel with a unique name for testing purposes
+func NewCarModel(tenantID string) (*entity.CarModel, error) {
+	return entity.NewCarModel(tenantID, entity.CarModelSpec{
+		Make:         "Toyota",
+		Name:         "Prius " + id.New(),
+		Category:     entity.CarCategoryCompact,
+		Seats:        5,
+		Transmission: entity.TransmissionAutomatic,
+		FuelType:     entity.FuelTypeHybrid,
+	}, time.Now())
+}
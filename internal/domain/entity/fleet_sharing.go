@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/oklog/ulid/v2"
+)
+
+// Errors returned by fleet sharing agreements
+var (
+	ErrSharingNotSiblings        = errors.New("fleets can only be shared between children of the same franchise parent")
+	ErrSharingNeedsSharedTables  = errors.New("fleets can only be shared between tenants in shared tables")
+	ErrSharingAgreementExists    = errors.New("an active fleet sharing agreement already exists between these tenants")
+	ErrSharingAgreementEnded     = errors.New("fleet sharing agreement is terminated")
+	ErrInvalidSharingTerms       = errors.New("invalid fleet sharing terms")
+	ErrRentalOutsideSharingTerms = errors.New("rental is outside the terms of the fleet sharing agreement")
+)
+
+// FleetSharingAgreements is a slice of FleetSharingAgreement
+type FleetSharingAgreements []*FleetSharingAgreement
+
+// FleetSharingAgreement lets a borrower tenant book the cars of a lender tenant of the same
+// franchise. Agreements go one way; two tenants lending to each other need two of them.
+type FleetSharingAgreement struct {
+	ID               string
+	LenderTenantID   string
+	BorrowerTenantID string
+	Terms            FleetSharingTerms
+	// TerminatedAt is when the agreement was ended; rentals booked before stay
+	TerminatedAt null.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// FleetSharingTerms are the conditions under which shared cars can be booked
+type FleetSharingTerms struct {
+	// ValidFrom and ValidUntil bound the periods of the rentals of shared cars; rentals must
+	// start on or after ValidFrom and end by ValidUntil, if set
+	ValidFrom  time.Time
+	ValidUntil null.Time
+	// MaxRentalDays caps the length of rentals of shared cars; zero means no cap
+	MaxRentalDays int
+	// OwnerSharePercent is the part of the revenue of rentals of shared cars that goes to
+	// the lender, for reporting
+	OwnerSharePercent int
+}
+
+// Validate checks that the terms are consistent
+func (t FleetSharingTerms) Validate() error {
+	if t.ValidFrom.IsZero() {
+		return fmt.Errorf("%w: valid from is required", ErrInvalidSharingTerms)
+	}
+	if t.ValidUntil.Valid && !t.ValidUntil.Time.After(t.ValidFrom) {
+		return fmt.Errorf("%w: valid until must be after valid from", ErrInvalidSharingTerms)
+	}
+	if t.MaxRentalDays < 0 {
+		return fmt.Errorf("%w: max rental days cannot be negative", ErrInvalidSharingTerms)
+	}
+	if t.OwnerSharePercent < 0 || t.OwnerSharePercent > 100 {
+		return fmt.Errorf("%w: owner share must be between 0 and 100 percent", ErrInvalidSharingTerms)
+	}
+	return nil
+}
+
+// NewFleetSharingAgreement lets borrower book the cars of lender under terms. Both tenants
+// must be children of the same parent, and keep their rows in shared tables, where
+// row-level security can let one see the cars of the other.
+func NewFleetSharingAgreement(lender, borrower *Tenant, terms FleetSharingTerms, now time.Time) (*FleetSharingAgreement, error) {
+	if !lender.SiblingOf(borrower) {
+		return nil, ErrSharingNotSiblings
+	}
+	if lender.Isolation != TenantIsolationShared || borrower.Isolation != TenantIsolationShared {
+		return nil, ErrSharingNeedsSharedTables
+	}
+	if err := terms.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &FleetSharingAgreement{
+		ID:               ulid.Make().String(),
+		LenderTenantID:   lender.ID,
+		BorrowerTenantID: borrower.ID,
+		Terms:            terms,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}, nil
+}
+
+// WithID creates a FleetSharingAgreement with a specific ID (for testing)
+func (a *FleetSharingAgreement) WithID(id string) *FleetSharingAgreement {
+	a.ID = id
+	return a
+}
+
+// Terminated reports whether the agreement was ended
+func (a *FleetSharingAgreement) Terminated() bool {
+	return a.TerminatedAt.Valid
+}
+
+// Terminate ends the agreement: the borrower no longer sees the cars of the lender
+func (a *FleetSharingAgreement) Terminate(now time.Time) error {
+	if a.Terminated() {
+		return ErrSharingAgreementEnded
+	}
+	a.TerminatedAt = null.TimeFrom(now)
+	a.UpdatedAt = now
+	return nil
+}
+
+// CheckRental returns why a shared car may not be rented from startsAt to endsAt under the
+// agreement, or nil if it may
+func (a *FleetSharingAgreement) CheckRental(startsAt, endsAt time.Time) error {
+	if a.Terminated() {
+		return ErrSharingAgreementEnded
+	}
+	if startsAt.Before(a.Terms.ValidFrom) {
+		return fmt.Errorf("%w: sharing starts on %s", ErrRentalOutsideSharingTerms, a.Terms.ValidFrom.Format(time.DateOnly))
+	}
+	if a.Terms.ValidUntil.Valid && endsAt.After(a.Terms.ValidUntil.Time) {
+		return fmt.Errorf("%w: sharing ends on %s", ErrRentalOutsideSharingTerms, a.Terms.ValidUntil.Time.Format(time.DateOnly))
+	}
+	if a.Terms.MaxRentalDays > 0 && endsAt.Sub(startsAt) > time.Duration(a.Terms.MaxRentalDays)*24*time.Hour {
+		return fmt.Errorf("%w: rentals last at most %d days", ErrRentalOutsideSharingTerms, a.Terms.MaxRentalDays)
+	}
+	return nil
+}
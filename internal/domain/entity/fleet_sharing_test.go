@@ -0,0 +1,145 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// siblings returns two children of the same franchise parent
+func siblings(t *testing.T) (*entity.Tenant, *entity.Tenant) {
+	t.Helper()
+
+	parent := entity.NewTenant("franchise", time.Now())
+	lender := entity.NewTenant("lender", time.Now())
+	borrower := entity.NewTenant("borrower", time.Now())
+	require.NoError(t, lender.JoinFranchise(parent))
+	require.NoError(t, borrower.JoinFranchise(parent))
+	return lender, borrower
+}
+
+// TestNewFleetSharingAgreement tests that fleets are only shared between siblings in shared
+// tables, under consistent terms
+func TestNewFleetSharingAgreement(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	terms := entity.FleetSharingTerms{ValidFrom: now, MaxRentalDays: 7, OwnerSharePercent: 70}
+
+	tests := map[string]struct {
+		setup   func(lender, borrower *entity.Tenant)
+		terms   entity.FleetSharingTerms
+		wantErr error
+	}{
+		"siblings": {
+			terms: terms,
+		},
+		"not siblings": {
+			setup:   func(_, borrower *entity.Tenant) { borrower.ParentID = null.StringFrom("another-parent") },
+			terms:   terms,
+			wantErr: entity.ErrSharingNotSiblings,
+		},
+		"borrower in its own schema": {
+			setup:   func(_, borrower *entity.Tenant) { borrower.Isolation = entity.TenantIsolationSchema },
+			terms:   terms,
+			wantErr: entity.ErrSharingNeedsSharedTables,
+		},
+		"lender in its own database": {
+			setup:   func(lender, _ *entity.Tenant) { lender.Isolation = entity.TenantIsolationDatabase },
+			terms:   terms,
+			wantErr: entity.ErrSharingNeedsSharedTables,
+		},
+		"no start": {
+			terms:   entity.FleetSharingTerms{},
+			wantErr: entity.ErrInvalidSharingTerms,
+		},
+		"ends before it starts": {
+			terms:   entity.FleetSharingTerms{ValidFrom: now, ValidUntil: null.TimeFrom(now.AddDate(0, 0, -1))},
+			wantErr: entity.ErrInvalidSharingTerms,
+		},
+		"owner share over 100": {
+			terms:   entity.FleetSharingTerms{ValidFrom: now, OwnerSharePercent: 101},
+			wantErr: entity.ErrInvalidSharingTerms,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lender, borrower := siblings(t)
+			if tt.setup != nil {
+				tt.setup(lender, borrower)
+			}
+
+			agreement, err := entity.NewFleetSharingAgreement(lender, borrower, tt.terms, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, lender.ID, agreement.LenderTenantID)
+			assert.Equal(t, borrower.ID, agreement.BorrowerTenantID)
+			assert.False(t, agreement.Terminated())
+		})
+	}
+}
+
+// TestFleetSharingAgreement_CheckRental tests that rentals of shared cars must fit the terms
+// of an active agreement
+func TestFleetSharingAgreement_CheckRental(t *testing.T) {
+	t.Parallel()
+
+	validFrom := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	lender, borrower := siblings(t)
+	agreement, err := entity.NewFleetSharingAgreement(lender, borrower, entity.FleetSharingTerms{
+		ValidFrom:     validFrom,
+		ValidUntil:    null.TimeFrom(validFrom.AddDate(0, 1, 0)),
+		MaxRentalDays: 3,
+	}, validFrom)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		startsAt time.Time
+		days     int
+		wantErr  bool
+	}{
+		"within terms":       {startsAt: validFrom.AddDate(0, 0, 2), days: 3},
+		"before valid from":  {startsAt: validFrom.AddDate(0, 0, -1), days: 2, wantErr: true},
+		"after valid until":  {startsAt: validFrom.AddDate(0, 0, 30), days: 2, wantErr: true},
+		"longer than capped": {startsAt: validFrom.AddDate(0, 0, 2), days: 4, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := agreement.CheckRental(tt.startsAt, tt.startsAt.AddDate(0, 0, tt.days))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrRentalOutsideSharingTerms)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// TestFleetSharingAgreement_Terminate tests that terminated agreements allow no more rentals
+func TestFleetSharingAgreement_Terminate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	lender, borrower := siblings(t)
+	agreement, err := entity.NewFleetSharingAgreement(lender, borrower, entity.FleetSharingTerms{ValidFrom: now}, now)
+	require.NoError(t, err)
+
+	require.NoError(t, agreement.Terminate(now.AddDate(0, 0, 1)))
+	assert.True(t, agreement.Terminated())
+	assert.ErrorIs(t, agreement.Terminate(now.AddDate(0, 0, 2)), entity.ErrSharingAgreementEnded)
+	assert.ErrorIs(t, agreement.CheckRental(now.AddDate(0, 0, 3), now.AddDate(0, 0, 4)), entity.ErrSharingAgreementEnded)
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/oklog/ulid/v2"
+)
+
+// InboxMessage records an incoming message that has been handled, so that redeliveries
+// of the same message can be recognised and skipped
+type InboxMessage struct {
+	ID          string
+	Source      string
+	MessageID   string
+	EventType   string
+	Payload     map[string]interface{}
+	ReceivedAt  time.Time
+	ProcessedAt null.Time
+}
+
+// NewInboxMessage creates a new InboxMessage for a message received and processed at now
+func NewInboxMessage(source, messageID, eventType string, payload map[string]interface{}, now time.Time) *InboxMessage {
+	return &InboxMessage{
+		ID:          ulid.Make().String(),
+		Source:      source,
+		MessageID:   messageID,
+		EventType:   eventType,
+		Payload:     payload,
+		ReceivedAt:  now,
+		ProcessedAt: null.TimeFrom(now),
+	}
+}
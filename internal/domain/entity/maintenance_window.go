@@ -0,0 +1,216 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/oklog/ulid/v2"
+)
+
+// Errors returned by maintenance windows
+var (
+	ErrInvalidMaintenanceWindow = errors.New("invalid maintenance window")
+	ErrMaintenanceConflict      = errors.New("maintenance window overlaps rentals of the car")
+	ErrMaintenanceNotScheduled  = errors.New("maintenance window is not scheduled")
+	ErrMaintenanceNotStarted    = errors.New("maintenance window has not started yet")
+)
+
+// MaxMaintenanceReasonLength is the longest reason a maintenance window may have
+const MaxMaintenanceReasonLength = 1000
+
+// MaintenanceType is the kind of work a car is taken out of service for
+type MaintenanceType string
+
+const (
+	MaintenanceTypeUnknown    MaintenanceType = "unknown"
+	MaintenanceTypeInspection MaintenanceType = "inspection"
+	MaintenanceTypeService    MaintenanceType = "service"
+	MaintenanceTypeRepair     MaintenanceType = "repair"
+	MaintenanceTypeCleaning   MaintenanceType = "cleaning"
+)
+
+// MaintenanceTypes lists every type a maintenance window can be scheduled with
+var MaintenanceTypes = []MaintenanceType{
+	MaintenanceTypeInspection, MaintenanceTypeService, MaintenanceTypeRepair, MaintenanceTypeCleaning,
+}
+
+func (t MaintenanceType) String() string {
+	return string(t)
+}
+
+func NewMaintenanceType(s string) MaintenanceType {
+	if t := MaintenanceType(s); slices.Contains(MaintenanceTypes, t) {
+		return t
+	}
+	return MaintenanceTypeUnknown
+}
+
+// MaintenanceStatus is where a maintenance window is in its lifecycle
+type MaintenanceStatus string
+
+const (
+	MaintenanceStatusUnknown   MaintenanceStatus = "unknown"
+	MaintenanceStatusScheduled MaintenanceStatus = "scheduled"
+	MaintenanceStatusCompleted MaintenanceStatus = "completed"
+	MaintenanceStatusCanceled  MaintenanceStatus = "canceled"
+)
+
+func (s MaintenanceStatus) String() string {
+	return string(s)
+}
+
+func NewMaintenanceStatus(s string) MaintenanceStatus {
+	switch s {
+	case MaintenanceStatusScheduled.String(),
+		MaintenanceStatusCompleted.String(),
+		MaintenanceStatusCanceled.String():
+		return MaintenanceStatus(s)
+	}
+	return MaintenanceStatusUnknown
+}
+
+// MaintenanceWindows is a slice of MaintenanceWindow
+type MaintenanceWindows []*MaintenanceWindow
+
+// MaintenanceWindow takes a car of a tenant out of service over [StartsAt, EndsAt). Until
+// it is canceled, the car cannot be booked over the window, as if it were rented.
+type MaintenanceWindow struct {
+	AggregateRoot
+
+	ID       string
+	TenantID string
+	CarID    string
+	Type     MaintenanceType
+	Reason   string
+	StartsAt time.Time
+	// EndsAt is when the car is expected back in service, or when it was if the work was
+	// completed early
+	EndsAt      time.Time
+	Status      MaintenanceStatus
+	CompletedAt null.Time
+	CanceledAt  null.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// MaintenanceConflictError is returned when a maintenance window would overlap rentals of
+// the car, which must be moved to another car first
+type MaintenanceConflictError struct {
+	RentalIDs []string
+}
+
+func (e *MaintenanceConflictError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrMaintenanceConflict, strings.Join(e.RentalIDs, ", "))
+}
+
+// Is makes MaintenanceConflictError match ErrMaintenanceConflict
+func (e *MaintenanceConflictError) Is(target error) bool {
+	return target == ErrMaintenanceConflict
+}
+
+// NewMaintenanceWindow schedules a new MaintenanceWindow for a car of a tenant. Rentals of
+// the car overlapping the window are checked by the caller; see MaintenanceConflictError.
+func NewMaintenanceWindow(tenantID, carID string, typ MaintenanceType, reason string, startsAt, endsAt, now time.Time) (*MaintenanceWindow, error) {
+	reason = strings.TrimSpace(reason)
+	if !slices.Contains(MaintenanceTypes, typ) {
+		return nil, fmt.Errorf("%w: unknown type %q", ErrInvalidMaintenanceWindow, typ)
+	}
+	if len(reason) > MaxMaintenanceReasonLength {
+		return nil, fmt.Errorf("%w: reason must be at most %d characters", ErrInvalidMaintenanceWindow, MaxMaintenanceReasonLength)
+	}
+	if !endsAt.After(startsAt) {
+		return nil, fmt.Errorf("%w: it must end after it starts", ErrInvalidMaintenanceWindow)
+	}
+
+	window := &MaintenanceWindow{
+		ID:        ulid.Make().String(),
+		TenantID:  tenantID,
+		CarID:     carID,
+		Type:      typ,
+		Reason:    reason,
+		StartsAt:  startsAt,
+		EndsAt:    endsAt,
+		Status:    MaintenanceStatusScheduled,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	window.RecordEvent(MaintenanceWindowScheduled{
+		ID:        window.ID,
+		TenantID:  window.TenantID,
+		CarID:     window.CarID,
+		Type:      window.Type.String(),
+		Reason:    window.Reason,
+		StartsAt:  window.StartsAt,
+		EndsAt:    window.EndsAt,
+		CreatedAt: window.CreatedAt,
+	})
+	return window, nil
+}
+
+// Blocking reports whether the window keeps the car from being booked over its period
+func (w *MaintenanceWindow) Blocking() bool {
+	return w.Status != MaintenanceStatusCanceled
+}
+
+// Complete records that the work was done at now, once the window started. A window
+// completed early ends then, so the car can be booked again right away.
+func (w *MaintenanceWindow) Complete(now time.Time) error {
+	if w.Status != MaintenanceStatusScheduled {
+		return fmt.Errorf("%w: it is %s", ErrMaintenanceNotScheduled, w.Status)
+	}
+	if now.Before(w.StartsAt) {
+		return fmt.Errorf("%w: it starts at %s", ErrMaintenanceNotStarted, w.StartsAt.Format(time.RFC3339))
+	}
+
+	if now.Before(w.EndsAt) {
+		w.EndsAt = now
+	}
+	w.Status = MaintenanceStatusCompleted
+	w.CompletedAt = null.TimeFrom(now)
+	w.UpdatedAt = now
+	w.RecordEvent(MaintenanceWindowCompleted{
+		ID:          w.ID,
+		TenantID:    w.TenantID,
+		CarID:       w.CarID,
+		EndsAt:      w.EndsAt,
+		CompletedAt: now,
+	})
+	return nil
+}
+
+// Cancel calls off the window, which no longer blocks the car
+func (w *MaintenanceWindow) Cancel(now time.Time) error {
+	if w.Status != MaintenanceStatusScheduled {
+		return fmt.Errorf("%w: it is %s", ErrMaintenanceNotScheduled, w.Status)
+	}
+
+	w.Status = MaintenanceStatusCanceled
+	w.CanceledAt = null.TimeFrom(now)
+	w.UpdatedAt = now
+	w.RecordEvent(MaintenanceWindowCanceled{
+		ID:         w.ID,
+		TenantID:   w.TenantID,
+		CarID:      w.CarID,
+		CanceledAt: now,
+	})
+	return nil
+}
+
+// AggregateType returns the aggregate type used for the window's events
+func (w *MaintenanceWindow) AggregateType() string {
+	return "maintenance_window"
+}
+
+// AggregateID returns the ID of the window
+func (w *MaintenanceWindow) AggregateID() string {
+	return w.ID
+}
+
+// AggregateTenantID returns the ID of the tenant owning the car
+func (w *MaintenanceWindow) AggregateTenantID() string {
+	return w.TenantID
+}
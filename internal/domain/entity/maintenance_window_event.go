@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+// MaintenanceWindowScheduled is recorded when a car is scheduled to be taken out of service
+type MaintenanceWindowScheduled struct {
+	ID        string    `json:"id"`
+	TenantID  string    `json:"tenant_id"`
+	CarID     string    `json:"car_id"`
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason,omitempty"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EventType returns the type of the event
+func (MaintenanceWindowScheduled) EventType() string {
+	return "maintenance_window_scheduled"
+}
+
+// MaintenanceWindowCompleted is recorded when the work of a maintenance window is done.
+// EndsAt is when the car is back in service.
+type MaintenanceWindowCompleted struct {
+	ID          string    `json:"id"`
+	TenantID    string    `json:"tenant_id"`
+	CarID       string    `json:"car_id"`
+	EndsAt      time.Time `json:"ends_at"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// EventType returns the type of the event
+func (MaintenanceWindowCompleted) EventType() string {
+	return "maintenance_window_completed"
+}
+
+// MaintenanceWindowCanceled is recorded when a maintenance window is called off
+type MaintenanceWindowCanceled struct {
+	ID         string    `json:"id"`
+	TenantID   string    `json:"tenant_id"`
+	CarID      string    `json:"car_id"`
+	CanceledAt time.Time `json:"canceled_at"`
+}
+
+// EventType returns the type of the event
+func (MaintenanceWindowCanceled) EventType() string {
+	return "maintenance_window_canceled"
+}
@@ -0,0 +1,112 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TestNewMaintenanceWindow tests that windows are only scheduled with a known type over a
+// period
+func TestNewMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		typ     entity.MaintenanceType
+		reason  string
+		endsAt  time.Time
+		wantErr bool
+	}{
+		"valid":            {typ: entity.MaintenanceTypeInspection, reason: " Annual inspection ", endsAt: startsAt.Add(4 * time.Hour)},
+		"without a reason": {typ: entity.MaintenanceTypeCleaning, endsAt: startsAt.Add(time.Hour)},
+		"unknown type":     {typ: entity.MaintenanceTypeUnknown, endsAt: startsAt.Add(time.Hour), wantErr: true},
+		"reason too long":  {typ: entity.MaintenanceTypeRepair, reason: strings.Repeat("a", entity.MaxMaintenanceReasonLength+1), endsAt: startsAt.Add(time.Hour), wantErr: true},
+		"empty period":     {typ: entity.MaintenanceTypeRepair, endsAt: startsAt, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			window, err := entity.NewMaintenanceWindow("tenant-1", "car-1", tt.typ, tt.reason, startsAt, tt.endsAt, time.Now())
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidMaintenanceWindow)
+				assert.Nil(t, window)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.MaintenanceStatusScheduled, window.Status)
+			assert.Equal(t, strings.TrimSpace(tt.reason), window.Reason)
+			assert.True(t, window.Blocking())
+			require.Len(t, window.Events(), 1)
+			assert.IsType(t, entity.MaintenanceWindowScheduled{}, window.Events()[0])
+		})
+	}
+}
+
+// TestMaintenanceWindow_Complete tests that windows are completed once they started, and end
+// when completed early
+func TestMaintenanceWindow_Complete(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(8 * time.Hour)
+	window, err := entity.NewMaintenanceWindow("tenant-1", "car-1", entity.MaintenanceTypeRepair, "", startsAt, endsAt, startsAt)
+	require.NoError(t, err)
+	window.ClearEvents()
+
+	assert.ErrorIs(t, window.Complete(startsAt.Add(-time.Minute)), entity.ErrMaintenanceNotStarted)
+
+	completedAt := startsAt.Add(3 * time.Hour)
+	require.NoError(t, window.Complete(completedAt))
+	assert.Equal(t, entity.MaintenanceStatusCompleted, window.Status)
+	assert.Equal(t, completedAt, window.EndsAt)
+	assert.Equal(t, completedAt, window.CompletedAt.Time)
+	assert.True(t, window.Blocking())
+	assert.Equal(t, []entity.DomainEvent{entity.MaintenanceWindowCompleted{
+		ID:          window.ID,
+		TenantID:    "tenant-1",
+		CarID:       "car-1",
+		EndsAt:      completedAt,
+		CompletedAt: completedAt,
+	}}, window.Events())
+
+	assert.ErrorIs(t, window.Complete(completedAt), entity.ErrMaintenanceNotScheduled)
+	assert.ErrorIs(t, window.Cancel(completedAt), entity.ErrMaintenanceNotScheduled)
+
+	// Completing late keeps the scheduled end
+	late, err := entity.NewMaintenanceWindow("tenant-1", "car-1", entity.MaintenanceTypeRepair, "", startsAt, endsAt, startsAt)
+	require.NoError(t, err)
+	require.NoError(t, late.Complete(endsAt.Add(time.Hour)))
+	assert.Equal(t, endsAt, late.EndsAt)
+}
+
+// TestMaintenanceWindow_Cancel tests that canceled windows no longer block the car
+func TestMaintenanceWindow_Cancel(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	window, err := entity.NewMaintenanceWindow("tenant-1", "car-1", entity.MaintenanceTypeService, "", startsAt, startsAt.Add(time.Hour), startsAt)
+	require.NoError(t, err)
+
+	require.NoError(t, window.Cancel(startsAt))
+	assert.Equal(t, entity.MaintenanceStatusCanceled, window.Status)
+	assert.False(t, window.Blocking())
+	assert.ErrorIs(t, window.Cancel(startsAt), entity.ErrMaintenanceNotScheduled)
+}
+
+// TestMaintenanceConflictError tests that conflicts list the rentals and match their sentinel
+func TestMaintenanceConflictError(t *testing.T) {
+	t.Parallel()
+
+	var err error = &entity.MaintenanceConflictError{RentalIDs: []string{"rental-1", "rental-2"}}
+	assert.ErrorIs(t, err, entity.ErrMaintenanceConflict)
+	assert.Contains(t, err.Error(), "rental-1, rental-2")
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/oklog/ulid/v2"
+)
+
+// Outbox publish retry policy
+const (
+	// MaxOutboxPublishAttempts is the number of attempts after which a message is given up
+	MaxOutboxPublishAttempts = 15
+	// OutboxRetryBaseDelay is the delay before the first retry; it doubles with every attempt
+	OutboxRetryBaseDelay = 5 * time.Second
+	// OutboxRetryMaxDelay caps the delay between two attempts
+	OutboxRetryMaxDelay = 30 * time.Minute
+)
+
+// OutboxMessage represents a domain event recorded in the outbox, waiting to be
+// relayed to external systems
+type OutboxMessage struct {
+	ID            string
+	TenantID      string
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	Payload       map[string]interface{}
+	Status        OutboxStatus
+	ErrorMessage  null.String
+	Attempts      int
+	NextAttemptAt null.Time
+	Version       int64
+	CreatedAt     time.Time
+	ProcessedAt   null.Time
+	LockedAt      null.Time
+	LockedBy      null.String
+}
+
+// NewOutboxMessage creates a new pending OutboxMessage for an event of the aggregate
+func NewOutboxMessage(aggregate Aggregate, eventType string, payload map[string]interface{}, createdAt time.Time) *OutboxMessage {
+	return &OutboxMessage{
+		ID:            ulid.Make().String(),
+		TenantID:      aggregate.AggregateTenantID(),
+		AggregateType: aggregate.AggregateType(),
+		AggregateID:   aggregate.AggregateID(),
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        OutboxStatusPending,
+		Version:       1,
+		CreatedAt:     createdAt,
+	}
+}
+
+// RecordPublishFailure records a failed publish attempt and schedules a retry with
+// exponential backoff, or marks the message as failed once MaxOutboxPublishAttempts
+// is reached
+func (m *OutboxMessage) RecordPublishFailure(now time.Time, errMessage string) {
+	m.Attempts++
+	m.ErrorMessage = null.StringFrom(errMessage)
+
+	if m.Attempts >= MaxOutboxPublishAttempts {
+		m.Status = OutboxStatusFailed
+		m.NextAttemptAt = null.Time{}
+		return
+	}
+	m.Status = OutboxStatusPending
+	m.NextAttemptAt = null.TimeFrom(now.Add(OutboxRetryDelay(m.Attempts)))
+}
+
+// OutboxRetryDelay returns the delay before the next attempt after the given number of attempts
+func OutboxRetryDelay(attempts int) time.Duration {
+	delay := OutboxRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= OutboxRetryMaxDelay {
+			return OutboxRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+type OutboxStatus string
+
+const (
+	OutboxStatusUnknown   OutboxStatus = "unknown"
+	OutboxStatusPending   OutboxStatus = "pending"
+	OutboxStatusProcessed OutboxStatus = "processed"
+	OutboxStatusFailed    OutboxStatus = "failed"
+)
+
+func NewOutboxStatus(s string) OutboxStatus {
+	switch s {
+	case OutboxStatusPending.String(),
+		OutboxStatusProcessed.String(),
+		OutboxStatusFailed.String():
+		return OutboxStatus(s)
+	}
+	return OutboxStatusUnknown
+}
+
+func (s OutboxStatus) String() string {
+	return string(s)
+}
+
+func (s OutboxStatus) Valid() bool {
+	return s != OutboxStatusUnknown && s != ""
+}
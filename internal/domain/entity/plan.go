@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// ErrQuotaExceeded is matched by every QuotaExceededError
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Resource is something a tenant creates that plans limit
+type Resource string
+
+const (
+	ResourceCars           Resource = "cars"
+	ResourceRenters        Resource = "renters"
+	ResourceMonthlyRentals Resource = "monthly_rentals"
+)
+
+// Resources lists every resource plans limit
+var Resources = []Resource{ResourceCars, ResourceRenters, ResourceMonthlyRentals}
+
+func (r Resource) String() string {
+	return string(r)
+}
+
+// Plans is a slice of Plan
+type Plans []*Plan
+
+// Plan is a subscription plan of the platform, with the limits of the tenants on it
+type Plan struct {
+	ID string
+	// Code identifies the plan in the catalog, e.g. "standard"
+	Code      string
+	Name      string
+	Limits    PlanLimits
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PlanLimits are the most of each resource a tenant may have. Zero means unlimited.
+type PlanLimits struct {
+	MaxCars           int
+	MaxRenters        int
+	MaxMonthlyRentals int
+}
+
+// NewPlan creates a new Plan
+func NewPlan(code, name string, limits PlanLimits, createdAt time.Time) *Plan {
+	return &Plan{
+		ID:        ulid.Make().String(),
+		Code:      code,
+		Name:      name,
+		Limits:    limits,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+// WithID creates a Plan with a specific ID (for testing)
+func (p *Plan) WithID(id string) *Plan {
+	p.ID = id
+	return p
+}
+
+// Limit returns the most of resource a tenant may have; zero means unlimited
+func (l PlanLimits) Limit(resource Resource) int {
+	switch resource {
+	case ResourceCars:
+		return l.MaxCars
+	case ResourceRenters:
+		return l.MaxRenters
+	case ResourceMonthlyRentals:
+		return l.MaxMonthlyRentals
+	}
+	return 0
+}
+
+// CheckCreate checks that a tenant already using used of resource may create one more
+func (l PlanLimits) CheckCreate(resource Resource, used int) error {
+	limit := l.Limit(resource)
+	if limit > 0 && used >= limit {
+		return &QuotaExceededError{Resource: resource, Limit: limit, Used: used}
+	}
+	return nil
+}
+
+// ResourceUsage is how much of a resource a tenant uses against the limit of its plan
+type ResourceUsage struct {
+	Resource Resource
+	Used     int
+	// Limit is zero for unlimited resources
+	Limit int
+}
+
+// QuotaExceededError is returned when a create would take a tenant over the limit of its plan
+type QuotaExceededError struct {
+	Resource Resource
+	Limit    int
+	Used     int
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded: %d of %d %s used", e.Used, e.Limit, e.Resource)
+}
+
+// Is makes QuotaExceededError match ErrQuotaExceeded
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
@@ -0,0 +1,44 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+func TestPlanLimits_CheckCreate(t *testing.T) {
+	t.Parallel()
+
+	limits := entity.PlanLimits{MaxCars: 10, MaxMonthlyRentals: 100}
+
+	tests := map[string]struct {
+		resource entity.Resource
+		used     int
+		wantErr  bool
+	}{
+		"below the limit": {resource: entity.ResourceCars, used: 9},
+		"at the limit":    {resource: entity.ResourceCars, used: 10, wantErr: true},
+		"over the limit":  {resource: entity.ResourceMonthlyRentals, used: 150, wantErr: true},
+		"unlimited":       {resource: entity.ResourceRenters, used: 1_000_000},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := limits.CheckCreate(tt.resource, tt.used)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, entity.ErrQuotaExceeded)
+			var quotaErr *entity.QuotaExceededError
+			assert.ErrorAs(t, err, &quotaErr)
+			assert.Equal(t, tt.resource, quotaErr.Resource)
+			assert.Equal(t, limits.Limit(tt.resource), quotaErr.Limit)
+			assert.Equal(t, tt.used, quotaErr.Used)
+		})
+	}
+}
@@ -1,24 +1,51 @@
 package entity
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/aarondl/null/v9"
 	"github.com/oklog/ulid/v2"
 )
 
+// Errors returned by rentals
+var (
+	ErrInvalidRentalPeriod   = errors.New("rental must end after it starts")
+	ErrCarUnavailable        = errors.New("car is already rented or in maintenance over the period")
+	ErrCarNotAtBranch        = errors.New("car is not at the pickup branch")
+	ErrReturnWithoutPickup   = errors.New("a return branch needs a pickup branch")
+	ErrOneWaySharedCar       = errors.New("cars shared under a fleet sharing agreement must be returned to their pickup branch")
+	ErrRentalNotStarted      = errors.New("rental has not started yet")
+	ErrRentalAlreadyReturned = errors.New("rental is already returned")
+)
+
 // Rentals is a slice of Rental
 type Rentals []*Rental
 
 // Rental represents a rental entity
 type Rental struct {
-	ID        string
-	TenantID  string
-	CarID     string
-	RenterID  string
-	StartsAt  time.Time
-	EndsAt    time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	AggregateRoot
+
+	ID string
+	// TenantID is the tenant that booked the rental, whose renter it is
+	TenantID string
+	// OwnerTenantID is the tenant owning the car. It differs from TenantID for cars shared
+	// under a fleet sharing agreement, and the rental is reported to both.
+	OwnerTenantID string
+	CarID         string
+	RenterID      string
+	// PickupBranchID and ReturnBranchID are branches of the owner of the car, and empty
+	// for rentals of cars without a branch. A rental returned elsewhere than it was picked
+	// up is one-way.
+	PickupBranchID string
+	ReturnBranchID string
+	StartsAt       time.Time
+	EndsAt         time.Time
+	// ReturnedAt is when the car was brought back; null until then
+	ReturnedAt null.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 
 	// References to related entities
 	Refs *RentalRefs
@@ -32,19 +59,71 @@ type RentalRefs struct {
 	RentalOptions RentalOptions
 }
 
-// NewRental creates a new Rental
-func NewRental(tenantID, carID, renterID string, startsAt, endsAt time.Time) *Rental {
+// RentalBranches are where the car of a rental is picked up and returned. A nil Return
+// branch is the Pickup branch; both are nil for cars without a branch.
+type RentalBranches struct {
+	Pickup *Branch
+	Return *Branch
+}
+
+// NewRental creates a new Rental of car booked by the tenant of settings. The car is picked
+// up at the branch it is at, during the opening hours of the branch or, without any, the
+// booking tenant's business hours. Shared cars go back to where they were picked up.
+func NewRental(settings *TenantSettings, car *Car, renterID string, branches RentalBranches, startsAt, endsAt time.Time) (*Rental, error) {
+	if !endsAt.After(startsAt) {
+		return nil, ErrInvalidRentalPeriod
+	}
+	if branches.Pickup == nil {
+		if branches.Return != nil {
+			return nil, ErrReturnWithoutPickup
+		}
+		if err := settings.CheckPickup(startsAt); err != nil {
+			return nil, err
+		}
+	} else {
+		if car.CurrentBranchID != "" && car.CurrentBranchID != branches.Pickup.ID {
+			return nil, fmt.Errorf("%w: it is at branch %s", ErrCarNotAtBranch, car.CurrentBranchID)
+		}
+		if err := branches.Pickup.CheckPickup(settings, startsAt); err != nil {
+			return nil, err
+		}
+		if branches.Return == nil {
+			branches.Return = branches.Pickup
+		}
+		if branches.Return.ID != branches.Pickup.ID && car.TenantID != settings.TenantID {
+			return nil, ErrOneWaySharedCar
+		}
+	}
+
 	now := time.Now()
-	return &Rental{
-		ID:        ulid.Make().String(),
-		TenantID:  tenantID,
-		CarID:     carID,
-		RenterID:  renterID,
-		StartsAt:  startsAt,
-		EndsAt:    endsAt,
-		CreatedAt: now,
-		UpdatedAt: now,
+	rental := &Rental{
+		ID:            ulid.Make().String(),
+		TenantID:      settings.TenantID,
+		OwnerTenantID: car.TenantID,
+		CarID:         car.ID,
+		RenterID:      renterID,
+		StartsAt:      startsAt,
+		EndsAt:        endsAt,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if branches.Pickup != nil {
+		rental.PickupBranchID = branches.Pickup.ID
+		rental.ReturnBranchID = branches.Return.ID
 	}
+	rental.RecordEvent(RentalCreated{
+		ID:             rental.ID,
+		TenantID:       rental.TenantID,
+		OwnerTenantID:  rental.OwnerTenantID,
+		CarID:          rental.CarID,
+		RenterID:       rental.RenterID,
+		PickupBranchID: rental.PickupBranchID,
+		ReturnBranchID: rental.ReturnBranchID,
+		StartsAt:       rental.StartsAt,
+		EndsAt:         rental.EndsAt,
+		CreatedAt:      rental.CreatedAt,
+	})
+	return rental, nil
 }
 
 // WithID creates a Rental with a specific ID (for testing)
@@ -52,3 +131,58 @@ func (r *Rental) WithID(id string) *Rental {
 	r.ID = id
 	return r
 }
+
+// OneWay reports whether the car is returned to another branch than it was picked up at
+func (r *Rental) OneWay() bool {
+	return r.PickupBranchID != r.ReturnBranchID
+}
+
+// Returned reports whether the car of the rental was brought back
+func (r *Rental) Returned() bool {
+	return r.ReturnedAt.Valid
+}
+
+// Return records that the car was brought back to the return branch at now. The car of a
+// one-way rental is then at the return branch; see Car.MoveTo.
+func (r *Rental) Return(now time.Time) error {
+	if r.Returned() {
+		return ErrRentalAlreadyReturned
+	}
+	if now.Before(r.StartsAt) {
+		return fmt.Errorf("%w: it starts at %s", ErrRentalNotStarted, r.StartsAt.Format(time.RFC3339))
+	}
+
+	r.ReturnedAt = null.TimeFrom(now)
+	r.UpdatedAt = now
+	r.RecordEvent(RentalReturned{
+		ID:             r.ID,
+		TenantID:       r.TenantID,
+		OwnerTenantID:  r.OwnerTenantID,
+		CarID:          r.CarID,
+		ReturnBranchID: r.ReturnBranchID,
+		OneWay:         r.OneWay(),
+		ReturnedAt:     now,
+	})
+	return nil
+}
+
+// Shared reports whether the car of the rental belongs to another tenant than the one
+// that booked it
+func (r *Rental) Shared() bool {
+	return r.OwnerTenantID != r.TenantID
+}
+
+// AggregateType returns the aggregate type used for the rental's events
+func (r *Rental) AggregateType() string {
+	return "rental"
+}
+
+// AggregateID returns the ID of the rental
+func (r *Rental) AggregateID() string {
+	return r.ID
+}
+
+// AggregateTenantID returns the ID of the tenant that booked the rental
+func (r *Rental) AggregateTenantID() string {
+	return r.TenantID
+}
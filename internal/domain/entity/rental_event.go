@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// RentalCreated is recorded when a renter books a car. OwnerTenantID differs from
+// TenantID for cars shared by another tenant.
+type RentalCreated struct {
+	ID            string `json:"id"`
+	TenantID      string `json:"tenant_id"`
+	OwnerTenantID string `json:"owner_tenant_id"`
+	CarID         string `json:"car_id"`
+	RenterID      string `json:"renter_id"`
+	// PickupBranchID and ReturnBranchID are empty for cars without a branch
+	PickupBranchID string    `json:"pickup_branch_id,omitempty"`
+	ReturnBranchID string    `json:"return_branch_id,omitempty"`
+	StartsAt       time.Time `json:"starts_at"`
+	EndsAt         time.Time `json:"ends_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// EventType returns the type of the event
+func (RentalCreated) EventType() string {
+	return "rental_created"
+}
+
+// RentalReturned is recorded when the car of a rental is brought back. The car of a
+// one-way rental is then at the return branch.
+type RentalReturned struct {
+	ID             string    `json:"id"`
+	TenantID       string    `json:"tenant_id"`
+	OwnerTenantID  string    `json:"owner_tenant_id"`
+	CarID          string    `json:"car_id"`
+	ReturnBranchID string    `json:"return_branch_id,omitempty"`
+	OneWay         bool      `json:"one_way"`
+	ReturnedAt     time.Time `json:"returned_at"`
+}
+
+// EventType returns the type of the event
+func (RentalReturned) EventType() string {
+	return "rental_returned"
+}
@@ -0,0 +1,208 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Errors returned by handover inspections
+var (
+	ErrInvalidHandover       = errors.New("invalid handover inspection")
+	ErrOdometerWentBackwards = errors.New("odometer reading is lower than at pickup")
+	ErrRentalNotPickedUp     = errors.New("rental has not been picked up")
+	ErrRentalAlreadyPickedUp = errors.New("rental is already picked up")
+	ErrReturnNotInspected    = errors.New("a return needs an inspection")
+)
+
+// MaxDamageDescriptionLength is the longest description a damage may have
+const MaxDamageDescriptionLength = 500
+
+// HandoverKind is the moment of a rental a handover inspection is made at
+type HandoverKind string
+
+const (
+	HandoverKindUnknown HandoverKind = "unknown"
+	HandoverKindPickup  HandoverKind = "pickup"
+	HandoverKindReturn  HandoverKind = "return"
+)
+
+func (k HandoverKind) String() string {
+	return string(k)
+}
+
+func NewHandoverKind(s string) HandoverKind {
+	switch s {
+	case HandoverKindPickup.String(), HandoverKindReturn.String():
+		return HandoverKind(s)
+	}
+	return HandoverKindUnknown
+}
+
+// DamageArea is the part of a car a damage of the checklist is found on
+type DamageArea string
+
+const (
+	DamageAreaUnknown   DamageArea = "unknown"
+	DamageAreaFront     DamageArea = "front"
+	DamageAreaRear      DamageArea = "rear"
+	DamageAreaLeftSide  DamageArea = "left_side"
+	DamageAreaRightSide DamageArea = "right_side"
+	DamageAreaRoof      DamageArea = "roof"
+	DamageAreaGlass     DamageArea = "glass"
+	DamageAreaWheels    DamageArea = "wheels"
+	DamageAreaInterior  DamageArea = "interior"
+)
+
+// DamageAreas lists every area of the damage checklist
+var DamageAreas = []DamageArea{
+	DamageAreaFront, DamageAreaRear, DamageAreaLeftSide, DamageAreaRightSide,
+	DamageAreaRoof, DamageAreaGlass, DamageAreaWheels, DamageAreaInterior,
+}
+
+func (a DamageArea) String() string {
+	return string(a)
+}
+
+func NewDamageArea(s string) DamageArea {
+	if a := DamageArea(s); slices.Contains(DamageAreas, a) {
+		return a
+	}
+	return DamageAreaUnknown
+}
+
+// Damage is an entry of the damage checklist of a handover, e.g. a scratch on the rear
+type Damage struct {
+	Area        DamageArea
+	Description string
+}
+
+// HandoverReading is what is read off a car when it is handed over
+type HandoverReading struct {
+	OdometerKm int
+	// FuelLevel is the fuel or charge level, in percent of a full tank or battery
+	FuelLevel int
+	Damages   []Damage
+}
+
+// normalized returns the reading with the descriptions of its damages trimmed
+func (r HandoverReading) normalized() HandoverReading {
+	damages := make([]Damage, len(r.Damages))
+	for i, d := range r.Damages {
+		damages[i] = Damage{Area: d.Area, Description: strings.TrimSpace(d.Description)}
+	}
+	r.Damages = damages
+	return r
+}
+
+// Validate checks that the readings are in range and every damage is on a known area
+func (r HandoverReading) Validate() error {
+	if r.OdometerKm < 0 {
+		return fmt.Errorf("%w: odometer must not be negative", ErrInvalidHandover)
+	}
+	if r.FuelLevel < 0 || r.FuelLevel > 100 {
+		return fmt.Errorf("%w: fuel level must be from 0 to 100 percent", ErrInvalidHandover)
+	}
+	for _, d := range r.Damages {
+		if !slices.Contains(DamageAreas, d.Area) {
+			return fmt.Errorf("%w: unknown damage area %q", ErrInvalidHandover, d.Area)
+		}
+		if len(d.Description) > MaxDamageDescriptionLength {
+			return fmt.Errorf("%w: damage description must be at most %d characters",
+				ErrInvalidHandover, MaxDamageDescriptionLength)
+		}
+	}
+	return nil
+}
+
+// RentalHandovers is a slice of RentalHandover
+type RentalHandovers []*RentalHandover
+
+// RentalHandover is the inspection of the car of a rental when it is picked up or returned.
+// A rental has at most one of each kind.
+type RentalHandover struct {
+	AggregateRoot
+
+	ID string
+	// TenantID is the tenant that booked the rental, which hands the car over
+	TenantID      string
+	OwnerTenantID string
+	RentalID      string
+	CarID         string
+	Kind          HandoverKind
+	Reading       HandoverReading
+	// DistanceKm and FuelShortfall compare a return with the pickup of the rental, for
+	// the charges of the renter; both are zero for pickups. FuelShortfall is in
+	// percentage points and zero when the car came back with more.
+	DistanceKm    int
+	FuelShortfall int
+	InspectedAt   time.Time
+	CreatedAt     time.Time
+}
+
+// NewPickupHandover records the inspection of the car of a rental as it is picked up at now.
+// The car cannot be picked up before the rental starts or once it is returned.
+func NewPickupHandover(rental *Rental, reading HandoverReading, now time.Time) (*RentalHandover, error) {
+	if rental.Returned() {
+		return nil, ErrRentalAlreadyReturned
+	}
+	if now.Before(rental.StartsAt) {
+		return nil, fmt.Errorf("%w: it starts at %s", ErrRentalNotStarted, rental.StartsAt.Format(time.RFC3339))
+	}
+	return newRentalHandover(rental, HandoverKindPickup, reading, 0, 0, now)
+}
+
+// NewReturnHandover records the inspection of the car of a rental as it is returned at now,
+// against the inspection at pickup. The odometer cannot read less than it did then.
+func NewReturnHandover(rental *Rental, pickup *RentalHandover, reading HandoverReading, now time.Time) (*RentalHandover, error) {
+	if reading.OdometerKm < pickup.Reading.OdometerKm {
+		return nil, fmt.Errorf("%w: %d km at return, %d km at pickup",
+			ErrOdometerWentBackwards, reading.OdometerKm, pickup.Reading.OdometerKm)
+	}
+	distance := reading.OdometerKm - pickup.Reading.OdometerKm
+	shortfall := max(pickup.Reading.FuelLevel-reading.FuelLevel, 0)
+	return newRentalHandover(rental, HandoverKindReturn, reading, distance, shortfall, now)
+}
+
+// newRentalHandover validates reading and creates a handover of a kind
+func newRentalHandover(rental *Rental, kind HandoverKind, reading HandoverReading, distance, shortfall int, now time.Time) (*RentalHandover, error) {
+	reading = reading.normalized()
+	if err := reading.Validate(); err != nil {
+		return nil, err
+	}
+
+	handover := &RentalHandover{
+		ID:            ulid.Make().String(),
+		TenantID:      rental.TenantID,
+		OwnerTenantID: rental.OwnerTenantID,
+		RentalID:      rental.ID,
+		CarID:         rental.CarID,
+		Kind:          kind,
+		Reading:       reading,
+		DistanceKm:    distance,
+		FuelShortfall: shortfall,
+		InspectedAt:   now,
+		CreatedAt:     now,
+	}
+	handover.RecordEvent(newRentalHandoverRecorded(handover))
+	return handover, nil
+}
+
+// AggregateType returns the aggregate type used for the handover's events
+func (h *RentalHandover) AggregateType() string {
+	return "rental_handover"
+}
+
+// AggregateID returns the ID of the handover
+func (h *RentalHandover) AggregateID() string {
+	return h.ID
+}
+
+// AggregateTenantID returns the ID of the tenant that booked the rental
+func (h *RentalHandover) AggregateTenantID() string {
+	return h.TenantID
+}
@@ -0,0 +1,53 @@
+package entity
+
+import "time"
+
+// RentalHandoverRecorded is recorded when the car of a rental is inspected as it is picked
+// up or returned. Returns carry the distance driven and the fuel shortfall since pickup.
+type RentalHandoverRecorded struct {
+	ID            string                 `json:"id"`
+	TenantID      string                 `json:"tenant_id"`
+	OwnerTenantID string                 `json:"owner_tenant_id"`
+	RentalID      string                 `json:"rental_id"`
+	CarID         string                 `json:"car_id"`
+	Kind          string                 `json:"kind"`
+	OdometerKm    int                    `json:"odometer_km"`
+	FuelLevel     int                    `json:"fuel_level"`
+	Damages       []RentalHandoverDamage `json:"damages"`
+	DistanceKm    int                    `json:"distance_km,omitempty"`
+	FuelShortfall int                    `json:"fuel_shortfall,omitempty"`
+	InspectedAt   time.Time              `json:"inspected_at"`
+}
+
+// RentalHandoverDamage is an entry of the damage checklist of a RentalHandoverRecorded event
+type RentalHandoverDamage struct {
+	Area        string `json:"area"`
+	Description string `json:"description,omitempty"`
+}
+
+// EventType returns the type of the event
+func (RentalHandoverRecorded) EventType() string {
+	return "rental_handover_recorded"
+}
+
+// newRentalHandoverRecorded returns the event recording a handover
+func newRentalHandoverRecorded(h *RentalHandover) RentalHandoverRecorded {
+	damages := make([]RentalHandoverDamage, len(h.Reading.Damages))
+	for i, d := range h.Reading.Damages {
+		damages[i] = RentalHandoverDamage{Area: d.Area.String(), Description: d.Description}
+	}
+	return RentalHandoverRecorded{
+		ID:            h.ID,
+		TenantID:      h.TenantID,
+		OwnerTenantID: h.OwnerTenantID,
+		RentalID:      h.RentalID,
+		CarID:         h.CarID,
+		Kind:          h.Kind.String(),
+		OdometerKm:    h.Reading.OdometerKm,
+		FuelLevel:     h.Reading.FuelLevel,
+		Damages:       damages,
+		DistanceKm:    h.DistanceKm,
+		FuelShortfall: h.FuelShortfall,
+		InspectedAt:   h.InspectedAt,
+	}
+}
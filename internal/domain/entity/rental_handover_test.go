@@ -0,0 +1,148 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// handoverRental returns a rental of a car shared by north that south booked
+func handoverRental() *entity.Rental {
+	startsAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	return &entity.Rental{
+		ID:            "rental-1",
+		TenantID:      "south",
+		OwnerTenantID: "north",
+		CarID:         "car-1",
+		StartsAt:      startsAt,
+		EndsAt:        startsAt.AddDate(0, 0, 2),
+	}
+}
+
+// TestNewPickupHandover tests that pickups are only inspected with readings in range and
+// damages on known areas
+func TestNewPickupHandover(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		reading entity.HandoverReading
+		wantErr bool
+	}{
+		"valid":             {reading: entity.HandoverReading{OdometerKm: 12000, FuelLevel: 100}},
+		"empty tank":        {reading: entity.HandoverReading{OdometerKm: 12000}},
+		"with damages":      {reading: entity.HandoverReading{OdometerKm: 12000, FuelLevel: 80, Damages: []entity.Damage{{Area: entity.DamageAreaRear, Description: " Scratch "}}}},
+		"negative odometer": {reading: entity.HandoverReading{OdometerKm: -1, FuelLevel: 100}, wantErr: true},
+		"overfull tank":     {reading: entity.HandoverReading{OdometerKm: 12000, FuelLevel: 101}, wantErr: true},
+		"unknown area":      {reading: entity.HandoverReading{Damages: []entity.Damage{{Area: entity.DamageAreaUnknown}}}, wantErr: true},
+		"long description":  {reading: entity.HandoverReading{Damages: []entity.Damage{{Area: entity.DamageAreaRoof, Description: strings.Repeat("a", entity.MaxDamageDescriptionLength+1)}}}, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rental := handoverRental()
+			handover, err := entity.NewPickupHandover(rental, tt.reading, rental.StartsAt)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidHandover)
+				assert.Nil(t, handover)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.HandoverKindPickup, handover.Kind)
+			assert.Equal(t, "south", handover.TenantID)
+			assert.Equal(t, "north", handover.OwnerTenantID)
+			assert.Zero(t, handover.DistanceKm)
+			for _, d := range handover.Reading.Damages {
+				assert.Equal(t, strings.TrimSpace(d.Description), d.Description)
+			}
+			require.Len(t, handover.Events(), 1)
+			assert.IsType(t, entity.RentalHandoverRecorded{}, handover.Events()[0])
+		})
+	}
+}
+
+// TestNewPickupHandover_RentalState tests that cars are not picked up before their rental
+// starts or after they are returned
+func TestNewPickupHandover_RentalState(t *testing.T) {
+	t.Parallel()
+
+	reading := entity.HandoverReading{OdometerKm: 12000, FuelLevel: 100}
+
+	t.Run("not started", func(t *testing.T) {
+		t.Parallel()
+
+		rental := handoverRental()
+		_, err := entity.NewPickupHandover(rental, reading, rental.StartsAt.Add(-time.Minute))
+		assert.ErrorIs(t, err, entity.ErrRentalNotStarted)
+	})
+
+	t.Run("returned", func(t *testing.T) {
+		t.Parallel()
+
+		rental := handoverRental()
+		require.NoError(t, rental.Return(rental.StartsAt.Add(time.Hour)))
+		_, err := entity.NewPickupHandover(rental, reading, rental.StartsAt.Add(2*time.Hour))
+		assert.ErrorIs(t, err, entity.ErrRentalAlreadyReturned)
+	})
+}
+
+// TestNewReturnHandover tests that returns are compared with the pickup for the distance
+// driven and the fuel shortfall, and that the odometer does not go backwards
+func TestNewReturnHandover(t *testing.T) {
+	t.Parallel()
+
+	rental := handoverRental()
+	pickup, err := entity.NewPickupHandover(rental, entity.HandoverReading{OdometerKm: 12000, FuelLevel: 90}, rental.StartsAt)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		reading       entity.HandoverReading
+		wantDistance  int
+		wantShortfall int
+		wantErr       error
+	}{
+		"fuel used":         {reading: entity.HandoverReading{OdometerKm: 12350, FuelLevel: 40}, wantDistance: 350, wantShortfall: 50},
+		"refueled":          {reading: entity.HandoverReading{OdometerKm: 12350, FuelLevel: 100}, wantDistance: 350},
+		"not driven":        {reading: entity.HandoverReading{OdometerKm: 12000, FuelLevel: 90}},
+		"odometer backward": {reading: entity.HandoverReading{OdometerKm: 11999, FuelLevel: 90}, wantErr: entity.ErrOdometerWentBackwards},
+		"overfull tank":     {reading: entity.HandoverReading{OdometerKm: 12350, FuelLevel: 120}, wantErr: entity.ErrInvalidHandover},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			inspectedAt := rental.StartsAt.AddDate(0, 0, 2)
+			handover, err := entity.NewReturnHandover(rental, pickup, tt.reading, inspectedAt)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, handover)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entity.HandoverKindReturn, handover.Kind)
+			assert.Equal(t, tt.wantDistance, handover.DistanceKm)
+			assert.Equal(t, tt.wantShortfall, handover.FuelShortfall)
+			assert.Equal(t, []entity.DomainEvent{entity.RentalHandoverRecorded{
+				ID:            handover.ID,
+				TenantID:      "south",
+				OwnerTenantID: "north",
+				RentalID:      "rental-1",
+				CarID:         "car-1",
+				Kind:          "return",
+				OdometerKm:    tt.reading.OdometerKm,
+				FuelLevel:     tt.reading.FuelLevel,
+				Damages:       []entity.RentalHandoverDamage{},
+				DistanceKm:    tt.wantDistance,
+				FuelShortfall: tt.wantShortfall,
+				InspectedAt:   inspectedAt,
+			}}, handover.Events())
+		})
+	}
+}
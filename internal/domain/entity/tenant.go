@@ -1,18 +1,62 @@
 package entity
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
 	"time"
 
+	"github.com/aarondl/null/v9"
 	"github.com/oklog/ulid/v2"
 )
 
+// Tenant code constraints. Codes name the tenant's subdomain, so they must be valid DNS labels.
+const (
+	MinTenantCodeLength = 3
+	MaxTenantCodeLength = 50
+)
+
+// tenantCodePattern matches lowercase DNS labels: letters, digits and inner hyphens,
+// starting with a letter
+var tenantCodePattern = regexp.MustCompile(`^[a-z]([a-z0-9-]*[a-z0-9])?$`)
+
+// reservedTenantCodes are subdomains kept for the platform itself
+var reservedTenantCodes = []string{"admin", "api", "app", "auth", "static", "status", "www"}
+
+// Errors returned by tenant lifecycle changes
+var (
+	ErrTenantSuspended          = errors.New("tenant is suspended")
+	ErrTenantNotSuspended       = errors.New("tenant is not suspended")
+	ErrTenantPendingDeletion    = errors.New("tenant is pending deletion")
+	ErrTenantNotPendingDeletion = errors.New("tenant is not pending deletion")
+	ErrTenantGracePeriodOver    = errors.New("grace period of the tenant deletion is over")
+	ErrTenantGracePeriodRunning = errors.New("grace period of the tenant deletion is not over")
+	ErrTenantParentIsChild      = errors.New("a child tenant cannot be a franchise parent")
+)
+
 // Tenants is a slice of Tenant
 type Tenants []*Tenant
 
 // Tenant represents a tenant entity
 type Tenant struct {
-	ID        string
-	Code      string
+	AggregateRoot
+
+	ID          string
+	Code        string
+	Status      TenantStatus
+	SuspendedAt null.Time
+	// PlanID is the plan whose limits apply to the tenant; tenants without a plan are not limited
+	PlanID null.String
+	// Isolation is where the tenant's data is kept. It is chosen when the tenant is created
+	// and cannot change afterwards.
+	Isolation TenantIsolation
+	// ParentID is the franchise parent of a child tenant. Children of the same parent can
+	// share their fleets under fleet sharing agreements.
+	ParentID null.String
+	// PurgeAt is when the data of a tenant pending deletion is purged; until then the
+	// deletion can be canceled
+	PurgeAt   null.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -25,14 +69,22 @@ type TenantRefs struct {
 	Cars Cars
 }
 
-// NewTenant creates a new Tenant
+// NewTenant creates a new active Tenant. The code is not validated; see ValidateTenantCode.
 func NewTenant(code string, createdAt time.Time) *Tenant {
-	return &Tenant{
+	tenant := &Tenant{
 		ID:        ulid.Make().String(),
 		Code:      code,
+		Status:    TenantStatusActive,
+		Isolation: TenantIsolationShared,
 		CreatedAt: createdAt,
 		UpdatedAt: createdAt,
 	}
+	tenant.RecordEvent(TenantCreated{
+		ID:        tenant.ID,
+		Code:      tenant.Code,
+		CreatedAt: tenant.CreatedAt,
+	})
+	return tenant
 }
 
 // WithID creates a Tenant with a specific ID (for testing)
@@ -40,3 +92,250 @@ func (t *Tenant) WithID(id string) *Tenant {
 	t.ID = id
 	return t
 }
+
+// JoinFranchise makes the tenant a child of parent. Franchises have a single level, so
+// the parent cannot be a child itself.
+func (t *Tenant) JoinFranchise(parent *Tenant) error {
+	if parent.ParentID.Valid {
+		return ErrTenantParentIsChild
+	}
+	t.ParentID = null.StringFrom(parent.ID)
+	return nil
+}
+
+// SiblingOf reports whether the tenant and other are different children of the same parent
+func (t *Tenant) SiblingOf(other *Tenant) bool {
+	return t.ParentID.Valid && t.ParentID == other.ParentID && t.ID != other.ID
+}
+
+// ValidateTenantCode checks that code can be used as the subdomain of a new tenant
+func ValidateTenantCode(code string) error {
+	if len(code) < MinTenantCodeLength || len(code) > MaxTenantCodeLength {
+		return fmt.Errorf("tenant code must be %d to %d characters long", MinTenantCodeLength, MaxTenantCodeLength)
+	}
+	if !tenantCodePattern.MatchString(code) {
+		return errors.New("tenant code must contain only lowercase letters, digits and hyphens, and start with a letter")
+	}
+	if slices.Contains(reservedTenantCodes, code) {
+		return fmt.Errorf("tenant code %q is reserved", code)
+	}
+	return nil
+}
+
+// Suspended reports whether the tenant is suspended
+func (t *Tenant) Suspended() bool {
+	return t.Status == TenantStatusSuspended
+}
+
+// PendingDeletion reports whether the tenant is scheduled to be purged
+func (t *Tenant) PendingDeletion() bool {
+	return t.Status == TenantStatusPendingDeletion
+}
+
+// CheckWritable returns why the tenant may not change its data, or nil if it may
+func (t *Tenant) CheckWritable() error {
+	switch {
+	case t.Suspended():
+		return ErrTenantSuspended
+	case t.PendingDeletion():
+		return ErrTenantPendingDeletion
+	}
+	return nil
+}
+
+// Suspend blocks the tenant from changing its data until it is reactivated
+func (t *Tenant) Suspend(now time.Time) error {
+	if t.PendingDeletion() {
+		return ErrTenantPendingDeletion
+	}
+	if t.Suspended() {
+		return ErrTenantSuspended
+	}
+
+	t.Status = TenantStatusSuspended
+	t.SuspendedAt = null.TimeFrom(now)
+	t.UpdatedAt = now
+	t.RecordEvent(TenantSuspended{
+		ID:          t.ID,
+		Code:        t.Code,
+		SuspendedAt: now,
+	})
+	return nil
+}
+
+// Reactivate lifts the suspension of the tenant
+func (t *Tenant) Reactivate(now time.Time) error {
+	if t.PendingDeletion() {
+		return ErrTenantPendingDeletion
+	}
+	if !t.Suspended() {
+		return ErrTenantNotSuspended
+	}
+
+	t.Status = TenantStatusActive
+	t.SuspendedAt = null.Time{}
+	t.UpdatedAt = now
+	t.RecordEvent(TenantReactivated{
+		ID:            t.ID,
+		Code:          t.Code,
+		ReactivatedAt: now,
+	})
+	return nil
+}
+
+// ScheduleDeletion blocks the tenant from changing its data and schedules its data to be
+// purged once gracePeriod is over. Until then the deletion can be canceled.
+func (t *Tenant) ScheduleDeletion(gracePeriod time.Duration, now time.Time) error {
+	if t.PendingDeletion() {
+		return ErrTenantPendingDeletion
+	}
+
+	t.Status = TenantStatusPendingDeletion
+	t.PurgeAt = null.TimeFrom(now.Add(gracePeriod))
+	t.UpdatedAt = now
+	t.RecordEvent(TenantDeletionScheduled{
+		ID:          t.ID,
+		Code:        t.Code,
+		ScheduledAt: now,
+		PurgeAt:     t.PurgeAt.Time,
+	})
+	return nil
+}
+
+// CancelDeletion keeps the tenant during the grace period of its deletion. It returns to
+// the status it had before, so a suspended tenant stays suspended.
+func (t *Tenant) CancelDeletion(now time.Time) error {
+	if !t.PendingDeletion() {
+		return ErrTenantNotPendingDeletion
+	}
+	if !now.Before(t.PurgeAt.Time) {
+		return ErrTenantGracePeriodOver
+	}
+
+	t.Status = TenantStatusActive
+	if t.SuspendedAt.Valid {
+		t.Status = TenantStatusSuspended
+	}
+	t.PurgeAt = null.Time{}
+	t.UpdatedAt = now
+	t.RecordEvent(TenantDeletionCanceled{
+		ID:         t.ID,
+		Code:       t.Code,
+		CanceledAt: now,
+	})
+	return nil
+}
+
+// CheckPurgeable returns why the data of the tenant may not be purged at now, or nil if
+// its deletion is due
+func (t *Tenant) CheckPurgeable(now time.Time) error {
+	if !t.PendingDeletion() {
+		return ErrTenantNotPendingDeletion
+	}
+	if now.Before(t.PurgeAt.Time) {
+		return ErrTenantGracePeriodRunning
+	}
+	return nil
+}
+
+// Purge records that the data of the tenant was purged, with the rows deleted per table.
+// The tenant itself is deleted with the event.
+func (t *Tenant) Purge(rows TenantRows, now time.Time) error {
+	if err := t.CheckPurgeable(now); err != nil {
+		return err
+	}
+
+	t.UpdatedAt = now
+	t.RecordEvent(TenantPurged{
+		ID:       t.ID,
+		Code:     t.Code,
+		Rows:     rows.Map(),
+		PurgedAt: now,
+	})
+	return nil
+}
+
+// ChangePlan moves the tenant to another plan. Tenants above the limits of the new plan
+// keep what they have, but cannot create more until they are below them.
+func (t *Tenant) ChangePlan(planID string, now time.Time) {
+	if t.PlanID.Valid && t.PlanID.String == planID {
+		return
+	}
+
+	previous := t.PlanID
+	t.PlanID = null.StringFrom(planID)
+	t.UpdatedAt = now
+	t.RecordEvent(TenantPlanChanged{
+		ID:             t.ID,
+		Code:           t.Code,
+		PlanID:         planID,
+		PreviousPlanID: previous.String,
+		ChangedAt:      now,
+	})
+}
+
+// AggregateType returns the aggregate type used for the tenant's events
+func (t *Tenant) AggregateType() string {
+	return "tenant"
+}
+
+// AggregateID returns the ID of the tenant
+func (t *Tenant) AggregateID() string {
+	return t.ID
+}
+
+// AggregateTenantID returns the ID of the tenant itself
+func (t *Tenant) AggregateTenantID() string {
+	return t.ID
+}
+
+type TenantStatus string
+
+const (
+	TenantStatusUnknown         TenantStatus = "unknown"
+	TenantStatusActive          TenantStatus = "active"
+	TenantStatusSuspended       TenantStatus = "suspended"
+	TenantStatusPendingDeletion TenantStatus = "pending_deletion"
+)
+
+func NewTenantStatus(s string) TenantStatus {
+	switch s {
+	case TenantStatusActive.String(),
+		TenantStatusSuspended.String(),
+		TenantStatusPendingDeletion.String():
+		return TenantStatus(s)
+	}
+	return TenantStatusUnknown
+}
+
+func (s TenantStatus) String() string {
+	return string(s)
+}
+
+// TenantIsolation is how the data of a tenant is separated from the data of other tenants
+type TenantIsolation string
+
+const (
+	// TenantIsolationShared keeps the tenant's rows in tables shared by every tenant,
+	// separated by row-level security
+	TenantIsolationShared TenantIsolation = "shared"
+	// TenantIsolationSchema keeps the tenant's rows in tables of its own schema
+	TenantIsolationSchema TenantIsolation = "schema"
+	// TenantIsolationDatabase keeps the tenant's rows in tables of its own database
+	TenantIsolationDatabase TenantIsolation = "database"
+)
+
+// TenantIsolations lists every isolation mode
+var TenantIsolations = []TenantIsolation{TenantIsolationShared, TenantIsolationSchema, TenantIsolationDatabase}
+
+// NewTenantIsolation returns the isolation mode named s; tenants without one share tables
+func NewTenantIsolation(s string) TenantIsolation {
+	if slices.Contains(TenantIsolations, TenantIsolation(s)) {
+		return TenantIsolation(s)
+	}
+	return TenantIsolationShared
+}
+
+func (i TenantIsolation) String() string {
+	return string(i)
+}
@@ -0,0 +1,94 @@
+package entity
+
+import "time"
+
+// TenantCreated is recorded when a tenant signs up
+type TenantCreated struct {
+	ID        string    `json:"id"`
+	Code      string    `json:"code"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EventType returns the type of the event
+func (TenantCreated) EventType() string {
+	return "tenant_created"
+}
+
+// TenantSuspended is recorded when a tenant is suspended
+type TenantSuspended struct {
+	ID          string    `json:"id"`
+	Code        string    `json:"code"`
+	SuspendedAt time.Time `json:"suspended_at"`
+}
+
+// EventType returns the type of the event
+func (TenantSuspended) EventType() string {
+	return "tenant_suspended"
+}
+
+// TenantReactivated is recorded when the suspension of a tenant is lifted
+type TenantReactivated struct {
+	ID            string    `json:"id"`
+	Code          string    `json:"code"`
+	ReactivatedAt time.Time `json:"reactivated_at"`
+}
+
+// EventType returns the type of the event
+func (TenantReactivated) EventType() string {
+	return "tenant_reactivated"
+}
+
+// TenantPlanChanged is recorded when a tenant moves to another plan
+type TenantPlanChanged struct {
+	ID     string `json:"id"`
+	Code   string `json:"code"`
+	PlanID string `json:"plan_id"`
+	// PreviousPlanID is empty when the tenant had no plan
+	PreviousPlanID string    `json:"previous_plan_id,omitempty"`
+	ChangedAt      time.Time `json:"changed_at"`
+}
+
+// EventType returns the type of the event
+func (TenantPlanChanged) EventType() string {
+	return "tenant_plan_changed"
+}
+
+// TenantDeletionScheduled is recorded when a tenant is scheduled to be purged
+type TenantDeletionScheduled struct {
+	ID          string    `json:"id"`
+	Code        string    `json:"code"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	PurgeAt     time.Time `json:"purge_at"`
+}
+
+// EventType returns the type of the event
+func (TenantDeletionScheduled) EventType() string {
+	return "tenant_deletion_scheduled"
+}
+
+// TenantDeletionCanceled is recorded when the deletion of a tenant is canceled during its
+// grace period
+type TenantDeletionCanceled struct {
+	ID         string    `json:"id"`
+	Code       string    `json:"code"`
+	CanceledAt time.Time `json:"canceled_at"`
+}
+
+// EventType returns the type of the event
+func (TenantDeletionCanceled) EventType() string {
+	return "tenant_deletion_canceled"
+}
+
+// TenantPurged is recorded when the data of a tenant has been purged and the tenant deleted
+type TenantPurged struct {
+	ID   string `json:"id"`
+	Code string `json:"code"`
+	// Rows is how many rows were deleted per table
+	Rows     map[string]int `json:"rows"`
+	PurgedAt time.Time      `json:"purged_at"`
+}
+
+// EventType returns the type of the event
+func (TenantPurged) EventType() string {
+	return "tenant_purged"
+}
@@ -0,0 +1,28 @@
+package entity
+
+// TableRows is how many rows of a tenant a table holds
+type TableRows struct {
+	Table string
+	Rows  int
+}
+
+// TenantRows lists the tables holding the data of a tenant, in the order they are purged
+type TenantRows []TableRows
+
+// Total returns the rows of every table together
+func (r TenantRows) Total() int {
+	total := 0
+	for _, t := range r {
+		total += t.Rows
+	}
+	return total
+}
+
+// Map returns the rows by table
+func (r TenantRows) Map() map[string]int {
+	m := make(map[string]int, len(r))
+	for _, t := range r {
+		m[t.Table] = t.Rows
+	}
+	return m
+}
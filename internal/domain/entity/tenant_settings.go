@@ -0,0 +1,143 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+// Settings of tenants that have not changed them
+const (
+	DefaultTimezone = "UTC"
+	DefaultCurrency = "USD"
+	DefaultLocale   = "en-US"
+)
+
+// Errors returned by tenant settings
+var (
+	ErrInvalidTenantSettings = errors.New("invalid tenant settings")
+	ErrOutsideBusinessHours  = errors.New("outside business hours")
+)
+
+// TenantSettings is the regional and operational context of a tenant: where its clocks
+// are, what it charges in, how it formats text and when it is open
+type TenantSettings struct {
+	TenantID string
+	// Timezone is an IANA time zone name, e.g. "Asia/Tokyo"
+	Timezone string
+	// Currency is an ISO 4217 currency code, e.g. "JPY"
+	Currency string
+	// Locale is a BCP 47 language tag, e.g. "ja-JP"
+	Locale        string
+	BusinessHours BusinessHours
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// DefaultTenantSettings returns the settings of a tenant that has not changed them: UTC,
+// US dollars, US English and always open
+func DefaultTenantSettings(tenantID string, now time.Time) *TenantSettings {
+	return &TenantSettings{
+		TenantID:  tenantID,
+		Timezone:  DefaultTimezone,
+		Currency:  DefaultCurrency,
+		Locale:    DefaultLocale,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Update replaces the settings after validating them. Currency codes and locales are
+// stored in their canonical form.
+func (s *TenantSettings) Update(timezone, currencyCode, locale string, hours BusinessHours, now time.Time) error {
+	if _, err := loadLocation(timezone); err != nil {
+		return err
+	}
+	unit, err := currency.ParseISO(currencyCode)
+	if err != nil {
+		return fmt.Errorf("%w: unknown currency %q", ErrInvalidTenantSettings, currencyCode)
+	}
+	tag, err := language.Parse(locale)
+	if err != nil || tag == language.Und {
+		return fmt.Errorf("%w: unknown locale %q", ErrInvalidTenantSettings, locale)
+	}
+	if err := hours.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTenantSettings, err)
+	}
+
+	s.Timezone = timezone
+	s.Currency = unit.String()
+	s.Locale = tag.String()
+	s.BusinessHours = hours.Sorted()
+	s.UpdatedAt = now
+	return nil
+}
+
+// Location returns the time zone of the tenant
+func (s *TenantSettings) Location() *time.Location {
+	loc, err := loadLocation(s.Timezone)
+	if err != nil {
+		// Timezones are validated before they are stored
+		return time.UTC
+	}
+	return loc
+}
+
+// LocalTime returns t on the tenant's clocks
+func (s *TenantSettings) LocalTime(t time.Time) time.Time {
+	return t.In(s.Location())
+}
+
+// ParseDate parses a "2006-01-02" calendar date as the start of that day in the tenant's
+// timezone
+func (s *TenantSettings) ParseDate(date string) (time.Time, error) {
+	t, err := time.ParseInLocation(time.DateOnly, date, s.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+	return t, nil
+}
+
+// StartOfMonth returns the start of the calendar month of t, on the tenant's clocks
+func (s *TenantSettings) StartOfMonth(t time.Time) time.Time {
+	local := s.LocalTime(t)
+	return time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, local.Location())
+}
+
+// IsOpen reports whether the tenant is open at t, on its own clocks
+func (s *TenantSettings) IsOpen(t time.Time) bool {
+	return s.BusinessHours.OpenAt(s.LocalTime(t))
+}
+
+// CheckPickup checks that a car can be picked up at t
+func (s *TenantSettings) CheckPickup(t time.Time) error {
+	if !s.IsOpen(t) {
+		local := s.LocalTime(t)
+		return fmt.Errorf("%w: pickup at %s on %s", ErrOutsideBusinessHours, local.Format("2006-01-02 15:04 MST"), local.Weekday())
+	}
+	return nil
+}
+
+// locations caches loaded time zones by name, since loading one reads the zone database
+var locations sync.Map
+
+// loadLocation returns the time zone named by an IANA name
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	// LoadLocation treats "" as UTC and "Local" as the server's zone; neither belongs to a tenant
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidTenantSettings, name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidTenantSettings, name)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
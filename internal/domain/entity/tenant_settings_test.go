@@ -0,0 +1,106 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TestTenantSettings_Update tests that settings are validated and canonicalised
+func TestTenantSettings_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		timezone     string
+		currency     string
+		locale       string
+		wantErr      bool
+		wantCurrency string
+		wantLocale   string
+	}{
+		"valid":            {timezone: "Asia/Tokyo", currency: "JPY", locale: "ja-JP", wantCurrency: "JPY", wantLocale: "ja-JP"},
+		"canonicalised":    {timezone: "Europe/Paris", currency: "eur", locale: "fr-fr", wantCurrency: "EUR", wantLocale: "fr-FR"},
+		"unknown timezone": {timezone: "Mars/Olympus", currency: "JPY", locale: "ja-JP", wantErr: true},
+		"server timezone":  {timezone: "Local", currency: "JPY", locale: "ja-JP", wantErr: true},
+		"unknown currency": {timezone: "Asia/Tokyo", currency: "ABC", locale: "ja-JP", wantErr: true},
+		"unknown locale":   {timezone: "Asia/Tokyo", currency: "JPY", locale: "not a locale", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			settings := entity.DefaultTenantSettings("tenant-a", time.Now())
+			err := settings.Update(tt.timezone, tt.currency, tt.locale, nil, time.Now())
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidTenantSettings)
+				assert.Equal(t, entity.DefaultTimezone, settings.Timezone)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.timezone, settings.Timezone)
+			assert.Equal(t, tt.wantCurrency, settings.Currency)
+			assert.Equal(t, tt.wantLocale, settings.Locale)
+		})
+	}
+}
+
+// TestTenantSettings_LocalTime tests that dates and opening hours use the tenant's timezone
+func TestTenantSettings_LocalTime(t *testing.T) {
+	t.Parallel()
+
+	settings := entity.DefaultTenantSettings("tenant-a", time.Now())
+	hours := entity.BusinessHours{{Weekday: time.Monday, Opens: 9 * 60, Closes: 18 * 60}}
+	require.NoError(t, settings.Update("Asia/Tokyo", "JPY", "ja-JP", hours, time.Now()))
+
+	// Midnight in Tokyo is 15:00 UTC on the previous day
+	day, err := settings.ParseDate("2025-01-06")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 5, 15, 0, 0, 0, time.UTC), day.UTC())
+	_, err = settings.ParseDate("06/01/2025")
+	assert.Error(t, err)
+
+	// 00:30 UTC on Monday is 09:30 in Tokyo
+	assert.True(t, settings.IsOpen(time.Date(2025, 1, 6, 0, 30, 0, 0, time.UTC)))
+	assert.NoError(t, settings.CheckPickup(time.Date(2025, 1, 6, 0, 30, 0, 0, time.UTC)))
+
+	// 12:00 UTC on Monday is 21:00 in Tokyo
+	assert.False(t, settings.IsOpen(time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)))
+	assert.ErrorIs(t, settings.CheckPickup(time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)), entity.ErrOutsideBusinessHours)
+}
+
+// TestNewRental tests that rentals are picked up during business hours and end after they start
+func TestNewRental(t *testing.T) {
+	t.Parallel()
+
+	settings := entity.DefaultTenantSettings("tenant-a", time.Now())
+	hours := entity.BusinessHours{{Weekday: time.Monday, Opens: 9 * 60, Closes: 18 * 60}}
+	require.NoError(t, settings.Update("UTC", "USD", "en-US", hours, time.Now()))
+	open := time.Date(2025, 1, 6, 10, 0, 0, 0, time.UTC)
+	closed := time.Date(2025, 1, 6, 20, 0, 0, 0, time.UTC)
+	car := entity.NewCar("tenant-a", "model-1", "", nil, nil, time.Now()).WithID("car-1")
+
+	rental, err := entity.NewRental(settings, car, "renter-1", entity.RentalBranches{}, open, open.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", rental.TenantID)
+	assert.Equal(t, "tenant-a", rental.OwnerTenantID)
+	assert.False(t, rental.Shared())
+
+	// A car shared by another tenant is owned by it and booked by the tenant of settings
+	shared := entity.NewCar("tenant-b", "model-2", "", nil, nil, time.Now()).WithID("car-2")
+	rental, err = entity.NewRental(settings, shared, "renter-1", entity.RentalBranches{}, open, open.AddDate(0, 0, 2))
+	require.NoError(t, err)
+	assert.Equal(t, "tenant-a", rental.TenantID)
+	assert.Equal(t, "tenant-b", rental.OwnerTenantID)
+	assert.True(t, rental.Shared())
+
+	_, err = entity.NewRental(settings, car, "renter-1", entity.RentalBranches{}, closed, closed.AddDate(0, 0, 2))
+	assert.ErrorIs(t, err, entity.ErrOutsideBusinessHours)
+
+	_, err = entity.NewRental(settings, car, "renter-1", entity.RentalBranches{}, open, open)
+	assert.ErrorIs(t, err, entity.ErrInvalidRentalPeriod)
+}
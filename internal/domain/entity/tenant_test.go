@@ -0,0 +1,193 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TestValidateTenantCode tests that only DNS-safe, unreserved codes are accepted
+func TestValidateTenantCode(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		code    string
+		wantErr bool
+	}{
+		"letters":         {code: "acme"},
+		"digits hyphens":  {code: "acme-rentals-2"},
+		"too short":       {code: "ab", wantErr: true},
+		"too long":        {code: strings.Repeat("a", 51), wantErr: true},
+		"uppercase":       {code: "Acme", wantErr: true},
+		"leading digit":   {code: "1acme", wantErr: true},
+		"leading hyphen":  {code: "-acme", wantErr: true},
+		"trailing hyphen": {code: "acme-", wantErr: true},
+		"dot":             {code: "acme.rentals", wantErr: true},
+		"underscore":      {code: "acme_rentals", wantErr: true},
+		"reserved":        {code: "www", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := entity.ValidateTenantCode(tt.code)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestNewTenantIsolation tests that isolation modes are parsed and unknown ones fall back to shared tables
+func TestNewTenantIsolation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		s    string
+		want entity.TenantIsolation
+	}{
+		"shared":   {s: "shared", want: entity.TenantIsolationShared},
+		"schema":   {s: "schema", want: entity.TenantIsolationSchema},
+		"database": {s: "database", want: entity.TenantIsolationDatabase},
+		"empty":    {s: "", want: entity.TenantIsolationShared},
+		"unknown":  {s: "cluster", want: entity.TenantIsolationShared},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, entity.NewTenantIsolation(tt.s))
+		})
+	}
+}
+
+// TestTenant_Lifecycle tests that suspension and reactivation change the status and record events
+func TestTenant_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tenant := entity.NewTenant("acme", now)
+	assert.Equal(t, entity.TenantStatusActive, tenant.Status)
+	require.Len(t, tenant.Events(), 1)
+	assert.Equal(t, "tenant_created", tenant.Events()[0].EventType())
+	tenant.ClearEvents()
+
+	// Suspend
+	require.NoError(t, tenant.Suspend(now))
+	assert.True(t, tenant.Suspended())
+	assert.Equal(t, now, tenant.SuspendedAt.Time)
+	assert.ErrorIs(t, tenant.Suspend(now), entity.ErrTenantSuspended)
+
+	// Reactivate
+	require.NoError(t, tenant.Reactivate(now))
+	assert.False(t, tenant.Suspended())
+	assert.False(t, tenant.SuspendedAt.Valid)
+	assert.ErrorIs(t, tenant.Reactivate(now), entity.ErrTenantNotSuspended)
+
+	// Only the actual changes were recorded
+	require.Len(t, tenant.Events(), 2)
+	assert.Equal(t, "tenant_suspended", tenant.Events()[0].EventType())
+	assert.Equal(t, "tenant_reactivated", tenant.Events()[1].EventType())
+}
+
+// TestTenant_Deletion tests that a scheduled deletion blocks changes and can be canceled
+// during the grace period only
+func TestTenant_Deletion(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	purgeAt := now.Add(time.Hour)
+	tenant := entity.NewTenant("acme", now)
+	require.NoError(t, tenant.Suspend(now))
+	tenant.ClearEvents()
+
+	// Schedule
+	require.NoError(t, tenant.ScheduleDeletion(time.Hour, now))
+	assert.True(t, tenant.PendingDeletion())
+	assert.Equal(t, purgeAt, tenant.PurgeAt.Time)
+	assert.ErrorIs(t, tenant.CheckWritable(), entity.ErrTenantPendingDeletion)
+	assert.ErrorIs(t, tenant.ScheduleDeletion(time.Hour, now), entity.ErrTenantPendingDeletion)
+	assert.ErrorIs(t, tenant.Reactivate(now), entity.ErrTenantPendingDeletion)
+	assert.ErrorIs(t, tenant.CheckPurgeable(now), entity.ErrTenantGracePeriodRunning)
+
+	// Cancel; the tenant is suspended again
+	require.NoError(t, tenant.CancelDeletion(now))
+	assert.True(t, tenant.Suspended())
+	assert.False(t, tenant.PurgeAt.Valid)
+	assert.ErrorIs(t, tenant.CancelDeletion(now), entity.ErrTenantNotPendingDeletion)
+	assert.ErrorIs(t, tenant.CheckPurgeable(now), entity.ErrTenantNotPendingDeletion)
+
+	// Once the grace period is over, the deletion cannot be canceled
+	require.NoError(t, tenant.ScheduleDeletion(time.Hour, now))
+	assert.ErrorIs(t, tenant.CancelDeletion(purgeAt), entity.ErrTenantGracePeriodOver)
+	assert.NoError(t, tenant.CheckPurgeable(purgeAt))
+
+	require.Len(t, tenant.Events(), 3)
+	assert.Equal(t, entity.TenantDeletionScheduled{
+		ID:          tenant.ID,
+		Code:        "acme",
+		ScheduledAt: now,
+		PurgeAt:     purgeAt,
+	}, tenant.Events()[0])
+	assert.Equal(t, "tenant_deletion_canceled", tenant.Events()[1].EventType())
+	assert.Equal(t, "tenant_deletion_scheduled", tenant.Events()[2].EventType())
+}
+
+// TestTenant_Purge tests that a purge is recorded with its rows once the grace period is over
+func TestTenant_Purge(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tenant := entity.NewTenant("acme", now)
+	rows := entity.TenantRows{{Table: "rentals", Rows: 3}, {Table: "cars", Rows: 2}}
+
+	// Active tenants are not purged
+	assert.ErrorIs(t, tenant.Purge(rows, now), entity.ErrTenantNotPendingDeletion)
+
+	require.NoError(t, tenant.ScheduleDeletion(time.Hour, now))
+	assert.ErrorIs(t, tenant.Purge(rows, now), entity.ErrTenantGracePeriodRunning)
+	tenant.ClearEvents()
+
+	// Purge
+	purgedAt := now.Add(time.Hour)
+	require.NoError(t, tenant.Purge(rows, purgedAt))
+	assert.Equal(t, []entity.DomainEvent{entity.TenantPurged{
+		ID:       tenant.ID,
+		Code:     "acme",
+		Rows:     map[string]int{"rentals": 3, "cars": 2},
+		PurgedAt: purgedAt,
+	}}, tenant.Events())
+	assert.Equal(t, 5, rows.Total())
+}
+
+// TestTenant_JoinFranchise tests that franchises have a single level and that only children
+// of the same parent are siblings
+func TestTenant_JoinFranchise(t *testing.T) {
+	t.Parallel()
+
+	parent := entity.NewTenant("franchise", time.Now())
+	north := entity.NewTenant("north", time.Now())
+	south := entity.NewTenant("south", time.Now())
+	other := entity.NewTenant("other", time.Now())
+
+	require.NoError(t, north.JoinFranchise(parent))
+	require.NoError(t, south.JoinFranchise(parent))
+	assert.Equal(t, parent.ID, north.ParentID.String)
+
+	assert.True(t, north.SiblingOf(south))
+	assert.False(t, north.SiblingOf(north))
+	assert.False(t, north.SiblingOf(parent))
+	assert.False(t, north.SiblingOf(other))
+	assert.False(t, other.SiblingOf(entity.NewTenant("another", time.Now())))
+
+	assert.ErrorIs(t, other.JoinFranchise(north), entity.ErrTenantParentIsChild)
+}
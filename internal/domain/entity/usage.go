@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/oklog/ulid/v2"
+)
+
+// Meter is a billable quantity metered per tenant
+type Meter string
+
+const (
+	MeterUnknown Meter = "unknown"
+	// MeterActiveCars is the number of cars in the fleet, sampled periodically
+	MeterActiveCars Meter = "active_cars"
+	// MeterRentalsCreated is the number of rentals created
+	MeterRentalsCreated Meter = "rentals_created"
+	// MeterAPICalls is the number of calls made to the API on behalf of the tenant
+	MeterAPICalls Meter = "api_calls"
+)
+
+// Meters lists every billable meter, in the order of usage statements
+var Meters = []Meter{MeterActiveCars, MeterRentalsCreated, MeterAPICalls}
+
+// NewMeter parses a meter, returning MeterUnknown for anything else
+func NewMeter(s string) Meter {
+	for _, m := range Meters {
+		if s == m.String() {
+			return m
+		}
+	}
+	return MeterUnknown
+}
+
+func (m Meter) String() string {
+	return string(m)
+}
+
+// Gauge reports whether the meter measures a level rather than counting occurrences.
+// Rollups of gauges keep the peak of the period; rollups of counters add up.
+func (m Meter) Gauge() bool {
+	return m == MeterActiveCars
+}
+
+// UsagePeriod is the length of the periods usage is rolled up over
+type UsagePeriod string
+
+const (
+	UsagePeriodUnknown UsagePeriod = "unknown"
+	UsagePeriodDay     UsagePeriod = "day"
+	UsagePeriodMonth   UsagePeriod = "month"
+)
+
+// UsagePeriods lists every period usage is rolled up over
+var UsagePeriods = []UsagePeriod{UsagePeriodDay, UsagePeriodMonth}
+
+// NewUsagePeriod parses a usage period, returning UsagePeriodUnknown for anything else
+func NewUsagePeriod(s string) UsagePeriod {
+	switch s {
+	case UsagePeriodDay.String(), UsagePeriodMonth.String():
+		return UsagePeriod(s)
+	}
+	return UsagePeriodUnknown
+}
+
+func (p UsagePeriod) String() string {
+	return string(p)
+}
+
+// Start returns the start of the period t falls in. Periods are calendar days and months
+// in UTC, so that they are the same for every tenant and do not move when a tenant
+// changes its timezone.
+func (p UsagePeriod) Start(t time.Time) time.Time {
+	t = t.UTC()
+	if p == UsagePeriodMonth {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Next returns the start of the period after the one starting at start
+func (p UsagePeriod) Next(start time.Time) time.Time {
+	if p == UsagePeriodMonth {
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// UsageRecord is a quantity of a meter used by a tenant, taken from one outbox message
+type UsageRecord struct {
+	// ID is the ID of the outbox message, so that a message relayed twice is counted once
+	ID         string
+	TenantID   string
+	Meter      Meter
+	Quantity   int64
+	OccurredAt time.Time
+}
+
+// UsageRollups is a slice of UsageRollup
+type UsageRollups []*UsageRollup
+
+// UsageRollup is the usage of a meter by a tenant over a day or a month
+type UsageRollup struct {
+	TenantID    string
+	Meter       Meter
+	Period      UsagePeriod
+	PeriodStart time.Time
+	Quantity    int64
+	UpdatedAt   time.Time
+}
+
+// UsageReport is an aggregate of the usage a tenant made outside of other aggregates,
+// such as its API calls. Reports are not stored; only their events are.
+type UsageReport struct {
+	AggregateRoot
+
+	ID       string
+	TenantID string
+}
+
+// NewAPICallsReport reports the API calls made on behalf of a tenant between from and to
+func NewAPICallsReport(tenantID string, calls int64, from, to time.Time) *UsageReport {
+	report := newUsageReport(tenantID)
+	report.RecordEvent(APICallsCounted{
+		TenantID: tenantID,
+		Calls:    calls,
+		From:     from,
+		To:       to,
+	})
+	return report
+}
+
+// NewActiveCarsReport reports the number of cars in the fleet of a tenant at sampledAt
+func NewActiveCarsReport(tenantID string, cars int64, sampledAt time.Time) *UsageReport {
+	report := newUsageReport(tenantID)
+	report.RecordEvent(ActiveCarsSampled{
+		TenantID:  tenantID,
+		Cars:      cars,
+		SampledAt: sampledAt,
+	})
+	return report
+}
+
+// newUsageReport creates a new UsageReport without events
+func newUsageReport(tenantID string) *UsageReport {
+	return &UsageReport{
+		ID:       ulid.Make().String(),
+		TenantID: tenantID,
+	}
+}
+
+// AggregateType returns the aggregate type used for the report's events
+func (r *UsageReport) AggregateType() string {
+	return "usage"
+}
+
+// AggregateID returns the ID of the report
+func (r *UsageReport) AggregateID() string {
+	return r.ID
+}
+
+// AggregateTenantID returns the tenant the usage was made by
+func (r *UsageReport) AggregateTenantID() string {
+	return r.TenantID
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// APICallsCounted is recorded with the number of API calls made on behalf of a tenant
+// over a period
+type APICallsCounted struct {
+	TenantID string    `json:"tenant_id"`
+	Calls    int64     `json:"calls"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+}
+
+// EventType returns the type of the event
+func (APICallsCounted) EventType() string {
+	return "api_calls_counted"
+}
+
+// ActiveCarsSampled is recorded with the number of cars in the fleet of a tenant
+type ActiveCarsSampled struct {
+	TenantID  string    `json:"tenant_id"`
+	Cars      int64     `json:"cars"`
+	SampledAt time.Time `json:"sampled_at"`
+}
+
+// EventType returns the type of the event
+func (ActiveCarsSampled) EventType() string {
+	return "active_cars_sampled"
+}
@@ -0,0 +1,70 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+func TestUsagePeriod_Start(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	// 2026-03-01 08:30 in Tokyo is still February in UTC
+	at := time.Date(2026, 3, 1, 8, 30, 0, 0, tokyo)
+
+	tests := map[string]struct {
+		period    entity.UsagePeriod
+		wantStart time.Time
+		wantNext  time.Time
+	}{
+		"day": {
+			period:    entity.UsagePeriodDay,
+			wantStart: time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC),
+			wantNext:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"month": {
+			period:    entity.UsagePeriodMonth,
+			wantStart: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantNext:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start := tt.period.Start(at)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantNext, tt.period.Next(start))
+		})
+	}
+}
+
+func TestNewMeter(t *testing.T) {
+	t.Parallel()
+
+	for _, meter := range entity.Meters {
+		assert.Equal(t, meter, entity.NewMeter(meter.String()))
+	}
+	assert.Equal(t, entity.MeterUnknown, entity.NewMeter("cars"))
+	assert.True(t, entity.MeterActiveCars.Gauge())
+	assert.False(t, entity.MeterAPICalls.Gauge())
+}
+
+func TestNewActiveCarsReport(t *testing.T) {
+	t.Parallel()
+
+	sampledAt := time.Now()
+	report := entity.NewActiveCarsReport("tenant-1", 7, sampledAt)
+
+	assert.NotEmpty(t, report.ID)
+	assert.Equal(t, "tenant-1", report.AggregateTenantID())
+	require.Len(t, report.Events(), 1)
+	assert.Equal(t, entity.ActiveCarsSampled{TenantID: "tenant-1", Cars: 7, SampledAt: sampledAt}, report.Events()[0])
+}
@@ -0,0 +1,146 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/oklog/ulid/v2"
+)
+
+// WebhookDeliveries is a slice of WebhookDelivery
+type WebhookDeliveries []*WebhookDelivery
+
+// Webhook delivery retry policy
+const (
+	// MaxWebhookDeliveryAttempts is the number of attempts after which a delivery is given up
+	MaxWebhookDeliveryAttempts = 8
+	// WebhookRetryBaseDelay is the delay before the first retry; it doubles with every attempt
+	WebhookRetryBaseDelay = 30 * time.Second
+	// WebhookRetryMaxDelay caps the delay between two attempts
+	WebhookRetryMaxDelay = 6 * time.Hour
+)
+
+// WebhookDelivery represents one event to be delivered to one webhook endpoint,
+// together with the outcome of its latest attempt
+type WebhookDelivery struct {
+	ID             string
+	TenantID       string
+	EndpointID     string
+	EventID        string
+	EventType      string
+	Payload        map[string]interface{}
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  null.Time
+	LastAttemptAt  null.Time
+	ResponseStatus null.Int
+	LastError      null.String
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// References to related entities
+	Refs *WebhookDeliveryRefs
+}
+
+// WebhookDeliveryRefs holds references to related entities
+type WebhookDeliveryRefs struct {
+	Endpoint *WebhookEndpoint
+}
+
+// NewWebhookDelivery creates a new pending WebhookDelivery that is due immediately
+func NewWebhookDelivery(endpoint *WebhookEndpoint, eventID, eventType string, payload map[string]interface{}, createdAt time.Time) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:            ulid.Make().String(),
+		TenantID:      endpoint.TenantID,
+		EndpointID:    endpoint.ID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		Status:        WebhookDeliveryStatusPending,
+		NextAttemptAt: null.TimeFrom(createdAt),
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt,
+	}
+}
+
+// WithID creates a WebhookDelivery with a specific ID (for testing)
+func (d *WebhookDelivery) WithID(id string) *WebhookDelivery {
+	d.ID = id
+	return d
+}
+
+// RecordSuccess marks the delivery as succeeded
+func (d *WebhookDelivery) RecordSuccess(now time.Time, responseStatus int) {
+	d.Attempts++
+	d.Status = WebhookDeliveryStatusSucceeded
+	d.LastAttemptAt = null.TimeFrom(now)
+	d.ResponseStatus = null.IntFrom(responseStatus)
+	d.LastError = null.String{}
+	d.NextAttemptAt = null.Time{}
+	d.UpdatedAt = now
+}
+
+// RecordFailure records a failed attempt and schedules a retry with exponential backoff,
+// or marks the delivery as failed once MaxWebhookDeliveryAttempts is reached.
+// A zero responseStatus means no response was received.
+func (d *WebhookDelivery) RecordFailure(now time.Time, responseStatus int, errMessage string) {
+	d.Attempts++
+	d.LastAttemptAt = null.TimeFrom(now)
+	d.ResponseStatus = null.NewInt(responseStatus, responseStatus != 0)
+	d.LastError = null.StringFrom(errMessage)
+	d.UpdatedAt = now
+
+	if d.Attempts >= MaxWebhookDeliveryAttempts {
+		d.Status = WebhookDeliveryStatusFailed
+		d.NextAttemptAt = null.Time{}
+		return
+	}
+	d.NextAttemptAt = null.TimeFrom(now.Add(WebhookRetryDelay(d.Attempts)))
+}
+
+// Abandon marks the delivery as failed without another attempt
+func (d *WebhookDelivery) Abandon(now time.Time, reason string) {
+	d.Status = WebhookDeliveryStatusFailed
+	d.LastError = null.StringFrom(reason)
+	d.NextAttemptAt = null.Time{}
+	d.UpdatedAt = now
+}
+
+// WebhookRetryDelay returns the delay before the next attempt after the given number of attempts
+func WebhookRetryDelay(attempts int) time.Duration {
+	delay := WebhookRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= WebhookRetryMaxDelay {
+			return WebhookRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusUnknown   WebhookDeliveryStatus = "unknown"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+func NewWebhookDeliveryStatus(s string) WebhookDeliveryStatus {
+	switch s {
+	case WebhookDeliveryStatusPending.String(),
+		WebhookDeliveryStatusSucceeded.String(),
+		WebhookDeliveryStatusFailed.String():
+		return WebhookDeliveryStatus(s)
+	}
+	return WebhookDeliveryStatusUnknown
+}
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+func (s WebhookDeliveryStatus) Valid() bool {
+	return s != WebhookDeliveryStatusUnknown && s != ""
+}
@@ -0,0 +1,195 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/oklog/ulid/v2"
+)
+
+// WebhookEndpoints is a slice of WebhookEndpoint
+type WebhookEndpoints []*WebhookEndpoint
+
+// MaxWebhookConsecutiveFailures is the number of failed delivery attempts in a row
+// after which an endpoint is disabled automatically
+const MaxWebhookConsecutiveFailures = 15
+
+// WebhookFailuresDisabledReason is the reason recorded on endpoints disabled after
+// MaxWebhookConsecutiveFailures
+const WebhookFailuresDisabledReason = "too many consecutive delivery failures"
+
+// WebhookEventAll subscribes an endpoint to every event type
+const WebhookEventAll = "*"
+
+// ErrWebhookAddressNotAllowed is returned for webhook URLs pointing into the platform's own
+// network, which would let a tenant make the dispatcher send requests there
+var ErrWebhookAddressNotAllowed = errors.New("webhook URL must not point to a loopback, private, link-local or unspecified address")
+
+// WebhookEndpoint represents an HTTPS endpoint of a tenant that receives events
+type WebhookEndpoint struct {
+	ID                  string
+	TenantID            string
+	URL                 string
+	Secret              string
+	EventTypes          []string
+	Description         string
+	Enabled             bool
+	ConsecutiveFailures int
+	DisabledAt          null.Time
+	DisabledReason      null.String
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+
+	// References to related entities
+	Refs *WebhookEndpointRefs
+}
+
+// WebhookEndpointRefs holds references to related entities
+type WebhookEndpointRefs struct {
+	Tenant     *Tenant
+	Deliveries WebhookDeliveries
+}
+
+// NewWebhookEndpoint creates a new enabled WebhookEndpoint with a freshly generated signing secret
+func NewWebhookEndpoint(tenantID, rawURL string, eventTypes []string, description string, createdAt time.Time) (*WebhookEndpoint, error) {
+	if err := ValidateWebhookURL(rawURL); err != nil {
+		return nil, err
+	}
+	if len(eventTypes) == 0 {
+		return nil, errors.New("at least one event type is required")
+	}
+
+	secret, err := NewWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookEndpoint{
+		ID:          ulid.Make().String(),
+		TenantID:    tenantID,
+		URL:         rawURL,
+		Secret:      secret,
+		EventTypes:  eventTypes,
+		Description: description,
+		Enabled:     true,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}, nil
+}
+
+// WithID creates a WebhookEndpoint with a specific ID (for testing)
+func (w *WebhookEndpoint) WithID(id string) *WebhookEndpoint {
+	w.ID = id
+	return w
+}
+
+// ValidateWebhookURL checks that the URL is an absolute HTTPS URL whose host is not an
+// address of a non-public network. Host names are checked again against the addresses
+// they resolve to when requests are sent.
+func ValidateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.New("invalid webhook URL")
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.New("webhook URL must be an absolute https URL")
+	}
+	host := u.Hostname()
+	if strings.EqualFold(strings.TrimSuffix(host, "."), "localhost") {
+		return ErrWebhookAddressNotAllowed
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return ValidateWebhookAddr(addr)
+	}
+	return nil
+}
+
+// nonPublicPrefixes are the IPv4 ranges that are not reachable from the internet but that
+// netip.Addr does not classify as private
+var nonPublicPrefixes = []netip.Prefix{
+	// "This network", e.g. 0.1.2.3, which some systems route to the local host
+	netip.MustParsePrefix("0.0.0.0/8"),
+	// Shared address space of carrier-grade NAT, also used inside cloud networks
+	netip.MustParsePrefix("100.64.0.0/10"),
+	// IETF protocol assignments
+	netip.MustParsePrefix("192.0.0.0/24"),
+	// Benchmarking of network devices
+	netip.MustParsePrefix("198.18.0.0/15"),
+	// Reserved for future use, and the limited broadcast address
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// ValidateWebhookAddr checks that webhooks may be sent to an IP address: loopback, private,
+// link-local, multicast and unspecified addresses are rejected, and so are the other
+// non-public ranges of nonPublicPrefixes, including IPv4 addresses mapped to IPv6
+func ValidateWebhookAddr(addr netip.Addr) error {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return ErrWebhookAddressNotAllowed
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return ErrWebhookAddressNotAllowed
+		}
+	}
+	return nil
+}
+
+// NewWebhookSecret generates a random signing secret
+func NewWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Subscribes reports whether the endpoint wants to receive the given event type
+func (w *WebhookEndpoint) Subscribes(eventType string) bool {
+	return slices.Contains(w.EventTypes, WebhookEventAll) || slices.Contains(w.EventTypes, eventType)
+}
+
+// RecordSuccess resets the failure streak after a successful delivery
+func (w *WebhookEndpoint) RecordSuccess(now time.Time) {
+	w.ConsecutiveFailures = 0
+	w.UpdatedAt = now
+}
+
+// RecordFailure extends the failure streak and disables the endpoint once it reaches
+// MaxWebhookConsecutiveFailures. It reports whether the endpoint was disabled by this call.
+func (w *WebhookEndpoint) RecordFailure(now time.Time) bool {
+	w.ConsecutiveFailures++
+	w.UpdatedAt = now
+
+	if w.Enabled && w.ConsecutiveFailures >= MaxWebhookConsecutiveFailures {
+		w.Disable(now, WebhookFailuresDisabledReason)
+		return true
+	}
+	return false
+}
+
+// Disable stops deliveries to the endpoint
+func (w *WebhookEndpoint) Disable(now time.Time, reason string) {
+	w.Enabled = false
+	w.DisabledAt = null.TimeFrom(now)
+	w.DisabledReason = null.StringFrom(reason)
+	w.UpdatedAt = now
+}
+
+// Enable resumes deliveries to the endpoint and clears the failure streak
+func (w *WebhookEndpoint) Enable(now time.Time) {
+	w.Enabled = true
+	w.ConsecutiveFailures = 0
+	w.DisabledAt = null.Time{}
+	w.DisabledReason = null.String{}
+	w.UpdatedAt = now
+}
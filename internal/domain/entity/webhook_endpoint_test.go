@@ -0,0 +1,104 @@
+package entity_test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TestValidateWebhookURL tests that webhook URLs are HTTPS and do not point into a
+// non-public network
+func TestValidateWebhookURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		url           string
+		wantErr       bool
+		wantForbidden bool
+	}{
+		"host name":          {url: "https://hooks.example.com/events"},
+		"public IPv4":        {url: "https://203.0.113.10/events"},
+		"public IPv6":        {url: "https://[2001:db8::1]:8443/events"},
+		"plain http":         {url: "http://hooks.example.com/events", wantErr: true},
+		"relative":           {url: "/events", wantErr: true},
+		"localhost":          {url: "https://localhost/events", wantErr: true, wantForbidden: true},
+		"loopback":           {url: "https://127.0.0.1/events", wantErr: true, wantForbidden: true},
+		"IPv6 loopback":      {url: "https://[::1]/events", wantErr: true, wantForbidden: true},
+		"metadata service":   {url: "https://169.254.169.254/latest/meta-data", wantErr: true, wantForbidden: true},
+		"private 10/8":       {url: "https://10.0.0.5/events", wantErr: true, wantForbidden: true},
+		"private 172.16/12":  {url: "https://172.16.3.4/events", wantErr: true, wantForbidden: true},
+		"private 192.168/16": {url: "https://192.168.1.1:8443/events", wantErr: true, wantForbidden: true},
+		"unspecified":        {url: "https://0.0.0.0/events", wantErr: true, wantForbidden: true},
+		"IPv4-mapped IPv6":   {url: "https://[::ffff:127.0.0.1]/events", wantErr: true, wantForbidden: true},
+		"unique local IPv6":  {url: "https://[fd00::1]/events", wantErr: true, wantForbidden: true},
+		"CGNAT":              {url: "https://100.64.0.1/events", wantErr: true, wantForbidden: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := entity.ValidateWebhookURL(tt.url)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Equal(t, tt.wantForbidden, errors.Is(err, entity.ErrWebhookAddressNotAllowed))
+		})
+	}
+}
+
+// TestValidateWebhookAddr tests that webhooks are only sent to public addresses
+func TestValidateWebhookAddr(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		addr          string
+		wantForbidden bool
+	}{
+		"public IPv4":                 {addr: "93.184.216.34"},
+		"public IPv6":                 {addr: "2606:2800:220:1:248:1893:25c8:1946"},
+		"below CGNAT":                 {addr: "100.63.255.255"},
+		"above CGNAT":                 {addr: "100.128.0.0"},
+		"above benchmarking":          {addr: "198.20.0.0"},
+		"loopback":                    {addr: "127.0.0.1", wantForbidden: true},
+		"IPv6 loopback":               {addr: "::1", wantForbidden: true},
+		"private":                     {addr: "10.1.2.3", wantForbidden: true},
+		"IPv6 private":                {addr: "fc00::1", wantForbidden: true},
+		"link-local":                  {addr: "169.254.169.254", wantForbidden: true},
+		"IPv6 link-local":             {addr: "fe80::1", wantForbidden: true},
+		"unspecified":                 {addr: "0.0.0.0", wantForbidden: true},
+		"IPv6 unspecified":            {addr: "::", wantForbidden: true},
+		"multicast":                   {addr: "224.0.0.1", wantForbidden: true},
+		"this network":                {addr: "0.1.2.3", wantForbidden: true},
+		"CGNAT first":                 {addr: "100.64.0.0", wantForbidden: true},
+		"CGNAT last":                  {addr: "100.127.255.255", wantForbidden: true},
+		"CGNAT mapped to IPv6":        {addr: "::ffff:100.64.0.1", wantForbidden: true},
+		"IETF protocol":               {addr: "192.0.0.8", wantForbidden: true},
+		"benchmarking first":          {addr: "198.18.0.0", wantForbidden: true},
+		"benchmarking last":           {addr: "198.19.255.255", wantForbidden: true},
+		"benchmarking mapped to IPv6": {addr: "::ffff:198.18.0.1", wantForbidden: true},
+		"reserved":                    {addr: "240.0.0.1", wantForbidden: true},
+		"broadcast":                   {addr: "255.255.255.255", wantForbidden: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := entity.ValidateWebhookAddr(netip.MustParseAddr(tt.addr))
+			if !tt.wantForbidden {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, entity.ErrWebhookAddressNotAllowed)
+		})
+	}
+
+	assert.ErrorIs(t, entity.ValidateWebhookAddr(netip.Addr{}), entity.ErrWebhookAddressNotAllowed)
+}
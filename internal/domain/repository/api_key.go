@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type APIKeyRepository interface {
+	Create(ctx context.Context, apiKey *entity.APIKey) error
+	GetByID(ctx context.Context, tenantID, id string) (*entity.APIKey, error)
+	// GetByPrefix retrieves a key of any tenant by its public prefix, for authentication
+	GetByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error)
+	ListByTenant(ctx context.Context, tenantID string, limit int, offset int) ([]*entity.APIKey, error)
+	Update(ctx context.Context, apiKey *entity.APIKey) error
+	// TouchLastUsed moves the last use of each key forward to the given time; earlier times are ignored
+	TouchLastUsed(ctx context.Context, lastUsed map[string]time.Time) error
+}
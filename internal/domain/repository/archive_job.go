@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type ArchiveJobRepository interface {
+	Create(ctx context.Context, job *entity.ArchiveJob) error
+	GetByID(ctx context.Context, id string) (*entity.ArchiveJob, error)
+	Update(ctx context.Context, job *entity.ArchiveJob) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type BranchRepository interface {
+	// Create stores a new branch; it returns ErrAlreadyExists if the tenant already has a
+	// branch of the same name
+	Create(ctx context.Context, branch *entity.Branch) error
+	// GetByID retrieves a branch of a tenant; the branches of a tenant sharing its fleet
+	// with the tenant of ctx are seen too
+	GetByID(ctx context.Context, tenantID, id string) (*entity.Branch, error)
+	// ListByTenant retrieves the branches of a tenant, ordered by name
+	ListByTenant(ctx context.Context, tenantID string) (entity.Branches, error)
+	Update(ctx context.Context, branch *entity.Branch) error
+}
@@ -2,9 +2,9 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
-	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
 )
 
 // CarLoadOptions defines options for loading related entities
@@ -15,14 +15,18 @@ type CarLoadOptions struct {
 
 //go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
 type CarRepository interface {
+	// Create stores a new car; it returns ErrAlreadyExists if another car of the tenant
+	// has the same VIN or license plate
 	Create(ctx context.Context, car *entity.Car) error
-	CreateInTx(ctx context.Context, tx *entgen.Tx, car *entity.Car) error
-	GetByID(ctx context.Context, id string) (*entity.Car, error)
-	GetByIDWithTenant(ctx context.Context, id string) (*entity.Car, error)
+	GetByID(ctx context.Context, tenantID, id string) (*entity.Car, error)
+	GetByIDWithTenant(ctx context.Context, tenantID, id string) (*entity.Car, error)
 	ListByTenant(ctx context.Context, tenantID string, limit int, offset int) ([]*entity.Car, string, int32, error)
 	ListByTenantWithOptions(ctx context.Context, tenantID string, limit int, offset int, opts ...CarLoadOptions) ([]*entity.Car, string, int32, error)
+	// ListAvailable retrieves up to limit live cars of the given tenants without a rental or
+	// a maintenance window that is not canceled overlapping [startsAt, endsAt), ordered by ID. A non-empty branchID only matches the
+	// cars currently at that branch. Cars of other tenants are only seen when they are
+	// shared with the tenant of ctx.
+	ListAvailable(ctx context.Context, tenantIDs []string, branchID string, startsAt, endsAt time.Time, limit int) (entity.Cars, error)
 	Update(ctx context.Context, car *entity.Car) error
-	UpdateInTx(ctx context.Context, tx *entgen.Tx, car *entity.Car) error
-	Delete(ctx context.Context, id string) error
-	DeleteInTx(ctx context.Context, tx *entgen.Tx, id string) error
+	Delete(ctx context.Context, tenantID, id string) error
 }
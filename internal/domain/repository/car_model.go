@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type CarModelRepository interface {
+	// Create stores a new model; it returns ErrAlreadyExists if the tenant's catalog already
+	// has a model of the same make and name
+	Create(ctx context.Context, model *entity.CarModel) error
+	GetByID(ctx context.Context, tenantID, id string) (*entity.CarModel, error)
+	// ListByTenant retrieves the catalog of a tenant, ordered by make and name
+	ListByTenant(ctx context.Context, tenantID string) (entity.CarModels, error)
+	Update(ctx context.Context, model *entity.CarModel) error
+}
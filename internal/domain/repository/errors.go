@@ -0,0 +1,10 @@
+package repository
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when the requested record does not exist
+	ErrNotFound = errors.New("record not found")
+	// ErrAlreadyExists is returned when a record conflicts with an existing one
+	ErrAlreadyExists = errors.New("record already exists")
+)
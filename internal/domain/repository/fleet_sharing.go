@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type FleetSharingRepository interface {
+	// Create stores a new agreement; it returns ErrAlreadyExists if the lender already has
+	// an active agreement with the borrower
+	Create(ctx context.Context, agreement *entity.FleetSharingAgreement) error
+	GetByID(ctx context.Context, id string) (*entity.FleetSharingAgreement, error)
+	// ListByTenant retrieves the agreements a tenant lends or borrows under, the newest first
+	ListByTenant(ctx context.Context, tenantID string) (entity.FleetSharingAgreements, error)
+	// ListActiveByBorrower retrieves the agreements a tenant can book shared cars under
+	ListActiveByBorrower(ctx context.Context, borrowerTenantID string) (entity.FleetSharingAgreements, error)
+	Update(ctx context.Context, agreement *entity.FleetSharingAgreement) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type InboxRepository interface {
+	// Create returns ErrAlreadyExists if the message was already recorded
+	Create(ctx context.Context, msg *entity.InboxMessage) error
+	Exists(ctx context.Context, source string, messageID string) (bool, error)
+	CleanupProcessedMessages(ctx context.Context, olderThan time.Duration) (int, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type MaintenanceWindowRepository interface {
+	Create(ctx context.Context, window *entity.MaintenanceWindow) error
+	// GetByID retrieves a maintenance window of a car of the tenant
+	GetByID(ctx context.Context, tenantID, id string) (*entity.MaintenanceWindow, error)
+	// Update stores the completion or cancellation of a window
+	Update(ctx context.Context, window *entity.MaintenanceWindow) error
+	// ListByCar retrieves the maintenance windows of a car of the tenant, the earliest first
+	ListByCar(ctx context.Context, tenantID, carID string) (entity.MaintenanceWindows, error)
+	// HasOverlap reports whether a window of the car that is not canceled overlaps
+	// [startsAt, endsAt), so that the car cannot be booked over it
+	HasOverlap(ctx context.Context, carID string, startsAt, endsAt time.Time) (bool, error)
+}
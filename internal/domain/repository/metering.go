@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type MeteringRepository interface {
+	// Record adds a usage record to the daily and monthly rollups of its tenant and meter,
+	// and reports whether it did: a record whose ID was recorded before is skipped. Call it
+	// in a transaction, so that the record and its rollups are written together.
+	Record(ctx context.Context, record *entity.UsageRecord) (bool, error)
+	// ListRollups returns the rollups of a tenant for the periods starting in [from, to),
+	// ordered by period start and meter
+	ListRollups(ctx context.Context, tenantID string, period entity.UsagePeriod, from, to time.Time) (entity.UsageRollups, error)
+	// CleanupRecords removes records older than the specified duration; their rollups stay
+	CleanupRecords(ctx context.Context, olderThan time.Duration) (int, error)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: archive_job.go
+//
+// Generated by this command:
+//
+//	mockgen -source=archive_job.go -destination=mock/archive_job.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArchiveJobRepository is a mock of ArchiveJobRepository interface.
+type MockArchiveJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveJobRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockArchiveJobRepositoryMockRecorder is the mock recorder for MockArchiveJobRepository.
+type MockArchiveJobRepositoryMockRecorder struct {
+	mock *MockArchiveJobRepository
+}
+
+// NewMockArchiveJobRepository creates a new mock instance.
+func NewMockArchiveJobRepository(ctrl *gomock.Controller) *MockArchiveJobRepository {
+	mock := &MockArchiveJobRepository{ctrl: ctrl}
+	mock.recorder = &MockArchiveJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveJobRepository) EXPECT() *MockArchiveJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockArchiveJobRepository) Create(ctx context.Context, job *entity.ArchiveJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockArchiveJobRepositoryMockRecorder) Create(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockArchiveJobRepository)(nil).Create), ctx, job)
+}
+
+// GetByID mocks base method.
+func (m *MockArchiveJobRepository) GetByID(ctx context.Context, id string) (*entity.ArchiveJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.ArchiveJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockArchiveJobRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockArchiveJobRepository)(nil).GetByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockArchiveJobRepository) Update(ctx context.Context, job *entity.ArchiveJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockArchiveJobRepositoryMockRecorder) Update(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockArchiveJobRepository)(nil).Update), ctx, job)
+}
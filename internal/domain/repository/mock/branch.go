@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: branch.go
+//
+// Generated by this command:
+//
+//	mockgen -source=branch.go -destination=mock/branch.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBranchRepository is a mock of BranchRepository interface.
+type MockBranchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBranchRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBranchRepositoryMockRecorder is the mock recorder for MockBranchRepository.
+type MockBranchRepositoryMockRecorder struct {
+	mock *MockBranchRepository
+}
+
+// NewMockBranchRepository creates a new mock instance.
+func NewMockBranchRepository(ctrl *gomock.Controller) *MockBranchRepository {
+	mock := &MockBranchRepository{ctrl: ctrl}
+	mock.recorder = &MockBranchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBranchRepository) EXPECT() *MockBranchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBranchRepository) Create(ctx context.Context, branch *entity.Branch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, branch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBranchRepositoryMockRecorder) Create(ctx, branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBranchRepository)(nil).Create), ctx, branch)
+}
+
+// GetByID mocks base method.
+func (m *MockBranchRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.Branch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*entity.Branch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBranchRepositoryMockRecorder) GetByID(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBranchRepository)(nil).GetByID), ctx, tenantID, id)
+}
+
+// ListByTenant mocks base method.
+func (m *MockBranchRepository) ListByTenant(ctx context.Context, tenantID string) (entity.Branches, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(entity.Branches)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTenant indicates an expected call of ListByTenant.
+func (mr *MockBranchRepositoryMockRecorder) ListByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTenant", reflect.TypeOf((*MockBranchRepository)(nil).ListByTenant), ctx, tenantID)
+}
+
+// Update mocks base method.
+func (m *MockBranchRepository) Update(ctx context.Context, branch *entity.Branch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, branch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBranchRepositoryMockRecorder) Update(ctx, branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBranchRepository)(nil).Update), ctx, branch)
+}
@@ -12,10 +12,10 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
-	entgen "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -57,76 +57,63 @@ func (mr *MockCarRepositoryMockRecorder) Create(ctx, car any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCarRepository)(nil).Create), ctx, car)
 }
 
-// CreateInTx mocks base method.
-func (m *MockCarRepository) CreateInTx(ctx context.Context, tx *entgen.Tx, car *entity.Car) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInTx", ctx, tx, car)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CreateInTx indicates an expected call of CreateInTx.
-func (mr *MockCarRepositoryMockRecorder) CreateInTx(ctx, tx, car any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInTx", reflect.TypeOf((*MockCarRepository)(nil).CreateInTx), ctx, tx, car)
-}
-
 // Delete mocks base method.
-func (m *MockCarRepository) Delete(ctx context.Context, id string) error {
+func (m *MockCarRepository) Delete(ctx context.Context, tenantID, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, tenantID, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockCarRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockCarRepositoryMockRecorder) Delete(ctx, tenantID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCarRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCarRepository)(nil).Delete), ctx, tenantID, id)
 }
 
-// DeleteInTx mocks base method.
-func (m *MockCarRepository) DeleteInTx(ctx context.Context, tx *entgen.Tx, id string) error {
+// GetByID mocks base method.
+func (m *MockCarRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.Car, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteInTx", ctx, tx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*entity.Car)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteInTx indicates an expected call of DeleteInTx.
-func (mr *MockCarRepositoryMockRecorder) DeleteInTx(ctx, tx, id any) *gomock.Call {
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCarRepositoryMockRecorder) GetByID(ctx, tenantID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInTx", reflect.TypeOf((*MockCarRepository)(nil).DeleteInTx), ctx, tx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCarRepository)(nil).GetByID), ctx, tenantID, id)
 }
 
-// GetByID mocks base method.
-func (m *MockCarRepository) GetByID(ctx context.Context, id string) (*entity.Car, error) {
+// GetByIDWithTenant mocks base method.
+func (m *MockCarRepository) GetByIDWithTenant(ctx context.Context, tenantID, id string) (*entity.Car, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret := m.ctrl.Call(m, "GetByIDWithTenant", ctx, tenantID, id)
 	ret0, _ := ret[0].(*entity.Car)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByID indicates an expected call of GetByID.
-func (mr *MockCarRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+// GetByIDWithTenant indicates an expected call of GetByIDWithTenant.
+func (mr *MockCarRepositoryMockRecorder) GetByIDWithTenant(ctx, tenantID, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCarRepository)(nil).GetByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithTenant", reflect.TypeOf((*MockCarRepository)(nil).GetByIDWithTenant), ctx, tenantID, id)
 }
 
-// GetByIDWithTenant mocks base method.
-func (m *MockCarRepository) GetByIDWithTenant(ctx context.Context, id string) (*entity.Car, error) {
+// ListAvailable mocks base method.
+func (m *MockCarRepository) ListAvailable(ctx context.Context, tenantIDs []string, branchID string, startsAt, endsAt time.Time, limit int) (entity.Cars, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByIDWithTenant", ctx, id)
-	ret0, _ := ret[0].(*entity.Car)
+	ret := m.ctrl.Call(m, "ListAvailable", ctx, tenantIDs, branchID, startsAt, endsAt, limit)
+	ret0, _ := ret[0].(entity.Cars)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByIDWithTenant indicates an expected call of GetByIDWithTenant.
-func (mr *MockCarRepositoryMockRecorder) GetByIDWithTenant(ctx, id any) *gomock.Call {
+// ListAvailable indicates an expected call of ListAvailable.
+func (mr *MockCarRepositoryMockRecorder) ListAvailable(ctx, tenantIDs, branchID, startsAt, endsAt, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithTenant", reflect.TypeOf((*MockCarRepository)(nil).GetByIDWithTenant), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailable", reflect.TypeOf((*MockCarRepository)(nil).ListAvailable), ctx, tenantIDs, branchID, startsAt, endsAt, limit)
 }
 
 // ListByTenant mocks base method.
@@ -181,17 +168,3 @@ func (mr *MockCarRepositoryMockRecorder) Update(ctx, car any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCarRepository)(nil).Update), ctx, car)
 }
-
-// UpdateInTx mocks base method.
-func (m *MockCarRepository) UpdateInTx(ctx context.Context, tx *entgen.Tx, car *entity.Car) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateInTx", ctx, tx, car)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpdateInTx indicates an expected call of UpdateInTx.
-func (mr *MockCarRepositoryMockRecorder) UpdateInTx(ctx, tx, car any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInTx", reflect.TypeOf((*MockCarRepository)(nil).UpdateInTx), ctx, tx, car)
-}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: car_model.go
+//
+// Generated by this command:
+//
+//	mockgen -source=car_model.go -destination=mock/car_model.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCarModelRepository is a mock of CarModelRepository interface.
+type MockCarModelRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCarModelRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCarModelRepositoryMockRecorder is the mock recorder for MockCarModelRepository.
+type MockCarModelRepositoryMockRecorder struct {
+	mock *MockCarModelRepository
+}
+
+// NewMockCarModelRepository creates a new mock instance.
+func NewMockCarModelRepository(ctrl *gomock.Controller) *MockCarModelRepository {
+	mock := &MockCarModelRepository{ctrl: ctrl}
+	mock.recorder = &MockCarModelRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCarModelRepository) EXPECT() *MockCarModelRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCarModelRepository) Create(ctx context.Context, model *entity.CarModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCarModelRepositoryMockRecorder) Create(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCarModelRepository)(nil).Create), ctx, model)
+}
+
+// GetByID mocks base method.
+func (m *MockCarModelRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.CarModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*entity.CarModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCarModelRepositoryMockRecorder) GetByID(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCarModelRepository)(nil).GetByID), ctx, tenantID, id)
+}
+
+// ListByTenant mocks base method.
+func (m *MockCarModelRepository) ListByTenant(ctx context.Context, tenantID string) (entity.CarModels, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(entity.CarModels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTenant indicates an expected call of ListByTenant.
+func (mr *MockCarModelRepositoryMockRecorder) ListByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTenant", reflect.TypeOf((*MockCarModelRepository)(nil).ListByTenant), ctx, tenantID)
+}
+
+// Update mocks base method.
+func (m *MockCarModelRepository) Update(ctx context.Context, model *entity.CarModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, model)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCarModelRepositoryMockRecorder) Update(ctx, model any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCarModelRepository)(nil).Update), ctx, model)
+}
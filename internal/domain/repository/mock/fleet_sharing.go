@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fleet_sharing.go
+//
+// Generated by this command:
+//
+//	mockgen -source=fleet_sharing.go -destination=mock/fleet_sharing.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFleetSharingRepository is a mock of FleetSharingRepository interface.
+type MockFleetSharingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFleetSharingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFleetSharingRepositoryMockRecorder is the mock recorder for MockFleetSharingRepository.
+type MockFleetSharingRepositoryMockRecorder struct {
+	mock *MockFleetSharingRepository
+}
+
+// NewMockFleetSharingRepository creates a new mock instance.
+func NewMockFleetSharingRepository(ctrl *gomock.Controller) *MockFleetSharingRepository {
+	mock := &MockFleetSharingRepository{ctrl: ctrl}
+	mock.recorder = &MockFleetSharingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFleetSharingRepository) EXPECT() *MockFleetSharingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFleetSharingRepository) Create(ctx context.Context, agreement *entity.FleetSharingAgreement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, agreement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFleetSharingRepositoryMockRecorder) Create(ctx, agreement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFleetSharingRepository)(nil).Create), ctx, agreement)
+}
+
+// GetByID mocks base method.
+func (m *MockFleetSharingRepository) GetByID(ctx context.Context, id string) (*entity.FleetSharingAgreement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.FleetSharingAgreement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockFleetSharingRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockFleetSharingRepository)(nil).GetByID), ctx, id)
+}
+
+// ListActiveByBorrower mocks base method.
+func (m *MockFleetSharingRepository) ListActiveByBorrower(ctx context.Context, borrowerTenantID string) (entity.FleetSharingAgreements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveByBorrower", ctx, borrowerTenantID)
+	ret0, _ := ret[0].(entity.FleetSharingAgreements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveByBorrower indicates an expected call of ListActiveByBorrower.
+func (mr *MockFleetSharingRepositoryMockRecorder) ListActiveByBorrower(ctx, borrowerTenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveByBorrower", reflect.TypeOf((*MockFleetSharingRepository)(nil).ListActiveByBorrower), ctx, borrowerTenantID)
+}
+
+// ListByTenant mocks base method.
+func (m *MockFleetSharingRepository) ListByTenant(ctx context.Context, tenantID string) (entity.FleetSharingAgreements, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTenant", ctx, tenantID)
+	ret0, _ := ret[0].(entity.FleetSharingAgreements)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTenant indicates an expected call of ListByTenant.
+func (mr *MockFleetSharingRepositoryMockRecorder) ListByTenant(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTenant", reflect.TypeOf((*MockFleetSharingRepository)(nil).ListByTenant), ctx, tenantID)
+}
+
+// Update mocks base method.
+func (m *MockFleetSharingRepository) Update(ctx context.Context, agreement *entity.FleetSharingAgreement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, agreement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockFleetSharingRepositoryMockRecorder) Update(ctx, agreement any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFleetSharingRepository)(nil).Update), ctx, agreement)
+}
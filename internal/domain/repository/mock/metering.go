@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: metering.go
+//
+// Generated by this command:
+//
+//	mockgen -source=metering.go -destination=mock/metering.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMeteringRepository is a mock of MeteringRepository interface.
+type MockMeteringRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMeteringRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMeteringRepositoryMockRecorder is the mock recorder for MockMeteringRepository.
+type MockMeteringRepositoryMockRecorder struct {
+	mock *MockMeteringRepository
+}
+
+// NewMockMeteringRepository creates a new mock instance.
+func NewMockMeteringRepository(ctrl *gomock.Controller) *MockMeteringRepository {
+	mock := &MockMeteringRepository{ctrl: ctrl}
+	mock.recorder = &MockMeteringRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMeteringRepository) EXPECT() *MockMeteringRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CleanupRecords mocks base method.
+func (m *MockMeteringRepository) CleanupRecords(ctx context.Context, olderThan time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupRecords", ctx, olderThan)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupRecords indicates an expected call of CleanupRecords.
+func (mr *MockMeteringRepositoryMockRecorder) CleanupRecords(ctx, olderThan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupRecords", reflect.TypeOf((*MockMeteringRepository)(nil).CleanupRecords), ctx, olderThan)
+}
+
+// ListRollups mocks base method.
+func (m *MockMeteringRepository) ListRollups(ctx context.Context, tenantID string, period entity.UsagePeriod, from, to time.Time) (entity.UsageRollups, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRollups", ctx, tenantID, period, from, to)
+	ret0, _ := ret[0].(entity.UsageRollups)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRollups indicates an expected call of ListRollups.
+func (mr *MockMeteringRepositoryMockRecorder) ListRollups(ctx, tenantID, period, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRollups", reflect.TypeOf((*MockMeteringRepository)(nil).ListRollups), ctx, tenantID, period, from, to)
+}
+
+// Record mocks base method.
+func (m *MockMeteringRepository) Record(ctx context.Context, record *entity.UsageRecord) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, record)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockMeteringRepositoryMockRecorder) Record(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockMeteringRepository)(nil).Record), ctx, record)
+}
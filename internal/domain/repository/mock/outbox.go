@@ -14,7 +14,7 @@ import (
 	reflect "reflect"
 	time "time"
 
-	entgen "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -58,38 +58,24 @@ func (mr *MockOutboxRepositoryMockRecorder) CleanupProcessedMessages(ctx, olderT
 }
 
 // Create mocks base method.
-func (m *MockOutboxRepository) Create(ctx context.Context, outbox *entgen.Outbox) error {
+func (m *MockOutboxRepository) Create(ctx context.Context, msg *entity.OutboxMessage) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, outbox)
+	ret := m.ctrl.Call(m, "Create", ctx, msg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockOutboxRepositoryMockRecorder) Create(ctx, outbox any) *gomock.Call {
+func (mr *MockOutboxRepositoryMockRecorder) Create(ctx, msg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOutboxRepository)(nil).Create), ctx, outbox)
-}
-
-// CreateInTx mocks base method.
-func (m *MockOutboxRepository) CreateInTx(ctx context.Context, tx *entgen.Tx, outbox *entgen.Outbox) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInTx", ctx, tx, outbox)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CreateInTx indicates an expected call of CreateInTx.
-func (mr *MockOutboxRepositoryMockRecorder) CreateInTx(ctx, tx, outbox any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInTx", reflect.TypeOf((*MockOutboxRepository)(nil).CreateInTx), ctx, tx, outbox)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOutboxRepository)(nil).Create), ctx, msg)
 }
 
 // GetFailed mocks base method.
-func (m *MockOutboxRepository) GetFailed(ctx context.Context, limit int) ([]*entgen.Outbox, error) {
+func (m *MockOutboxRepository) GetFailed(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetFailed", ctx, limit)
-	ret0, _ := ret[0].([]*entgen.Outbox)
+	ret0, _ := ret[0].([]*entity.OutboxMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -101,10 +87,10 @@ func (mr *MockOutboxRepositoryMockRecorder) GetFailed(ctx, limit any) *gomock.Ca
 }
 
 // GetPending mocks base method.
-func (m *MockOutboxRepository) GetPending(ctx context.Context, limit int) ([]*entgen.Outbox, error) {
+func (m *MockOutboxRepository) GetPending(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPending", ctx, limit)
-	ret0, _ := ret[0].([]*entgen.Outbox)
+	ret0, _ := ret[0].([]*entity.OutboxMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -116,10 +102,10 @@ func (mr *MockOutboxRepositoryMockRecorder) GetPending(ctx, limit any) *gomock.C
 }
 
 // GetPendingWithLock mocks base method.
-func (m *MockOutboxRepository) GetPendingWithLock(ctx context.Context, limit int, processorID string) ([]*entgen.Outbox, error) {
+func (m *MockOutboxRepository) GetPendingWithLock(ctx context.Context, limit int, processorID string) ([]*entity.OutboxMessage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPendingWithLock", ctx, limit, processorID)
-	ret0, _ := ret[0].([]*entgen.Outbox)
+	ret0, _ := ret[0].([]*entity.OutboxMessage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -144,20 +130,6 @@ func (mr *MockOutboxRepositoryMockRecorder) MarkAsFailed(ctx, id, errorMessage a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsFailed", reflect.TypeOf((*MockOutboxRepository)(nil).MarkAsFailed), ctx, id, errorMessage)
 }
 
-// MarkAsFailedInTx mocks base method.
-func (m *MockOutboxRepository) MarkAsFailedInTx(ctx context.Context, tx *entgen.Tx, id, errorMessage string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkAsFailedInTx", ctx, tx, id, errorMessage)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// MarkAsFailedInTx indicates an expected call of MarkAsFailedInTx.
-func (mr *MockOutboxRepositoryMockRecorder) MarkAsFailedInTx(ctx, tx, id, errorMessage any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsFailedInTx", reflect.TypeOf((*MockOutboxRepository)(nil).MarkAsFailedInTx), ctx, tx, id, errorMessage)
-}
-
 // MarkAsProcessed mocks base method.
 func (m *MockOutboxRepository) MarkAsProcessed(ctx context.Context, id string, processedAt time.Time) error {
 	m.ctrl.T.Helper()
@@ -172,18 +144,18 @@ func (mr *MockOutboxRepositoryMockRecorder) MarkAsProcessed(ctx, id, processedAt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsProcessed", reflect.TypeOf((*MockOutboxRepository)(nil).MarkAsProcessed), ctx, id, processedAt)
 }
 
-// MarkAsProcessedInTx mocks base method.
-func (m *MockOutboxRepository) MarkAsProcessedInTx(ctx context.Context, tx *entgen.Tx, id string, processedAt time.Time) error {
+// RecordPublishFailure mocks base method.
+func (m *MockOutboxRepository) RecordPublishFailure(ctx context.Context, msg *entity.OutboxMessage) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkAsProcessedInTx", ctx, tx, id, processedAt)
+	ret := m.ctrl.Call(m, "RecordPublishFailure", ctx, msg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkAsProcessedInTx indicates an expected call of MarkAsProcessedInTx.
-func (mr *MockOutboxRepositoryMockRecorder) MarkAsProcessedInTx(ctx, tx, id, processedAt any) *gomock.Call {
+// RecordPublishFailure indicates an expected call of RecordPublishFailure.
+func (mr *MockOutboxRepositoryMockRecorder) RecordPublishFailure(ctx, msg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsProcessedInTx", reflect.TypeOf((*MockOutboxRepository)(nil).MarkAsProcessedInTx), ctx, tx, id, processedAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPublishFailure", reflect.TypeOf((*MockOutboxRepository)(nil).RecordPublishFailure), ctx, msg)
 }
 
 // UnlockOrphanedMessages mocks base method.
@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rental.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rental.go -destination=mock/rental.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRentalRepository is a mock of RentalRepository interface.
+type MockRentalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRentalRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRentalRepositoryMockRecorder is the mock recorder for MockRentalRepository.
+type MockRentalRepositoryMockRecorder struct {
+	mock *MockRentalRepository
+}
+
+// NewMockRentalRepository creates a new mock instance.
+func NewMockRentalRepository(ctrl *gomock.Controller) *MockRentalRepository {
+	mock := &MockRentalRepository{ctrl: ctrl}
+	mock.recorder = &MockRentalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRentalRepository) EXPECT() *MockRentalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRentalRepository) Create(ctx context.Context, rental *entity.Rental) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rental)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRentalRepositoryMockRecorder) Create(ctx, rental any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRentalRepository)(nil).Create), ctx, rental)
+}
+
+// GetByID mocks base method.
+func (m *MockRentalRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.Rental, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, tenantID, id)
+	ret0, _ := ret[0].(*entity.Rental)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRentalRepositoryMockRecorder) GetByID(ctx, tenantID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRentalRepository)(nil).GetByID), ctx, tenantID, id)
+}
+
+// HasOverlap mocks base method.
+func (m *MockRentalRepository) HasOverlap(ctx context.Context, carID string, startsAt, endsAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOverlap", ctx, carID, startsAt, endsAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOverlap indicates an expected call of HasOverlap.
+func (mr *MockRentalRepositoryMockRecorder) HasOverlap(ctx, carID, startsAt, endsAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOverlap", reflect.TypeOf((*MockRentalRepository)(nil).HasOverlap), ctx, carID, startsAt, endsAt)
+}
+
+// ListByTenant mocks base method.
+func (m *MockRentalRepository) ListByTenant(ctx context.Context, tenantID string, filter repository.RentalFilter, limit, offset int) (entity.Rentals, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTenant", ctx, tenantID, filter, limit, offset)
+	ret0, _ := ret[0].(entity.Rentals)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByTenant indicates an expected call of ListByTenant.
+func (mr *MockRentalRepositoryMockRecorder) ListByTenant(ctx, tenantID, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTenant", reflect.TypeOf((*MockRentalRepository)(nil).ListByTenant), ctx, tenantID, filter, limit, offset)
+}
+
+// ListOverlapping mocks base method.
+func (m *MockRentalRepository) ListOverlapping(ctx context.Context, carID string, startsAt, endsAt time.Time) (entity.Rentals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverlapping", ctx, carID, startsAt, endsAt)
+	ret0, _ := ret[0].(entity.Rentals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverlapping indicates an expected call of ListOverlapping.
+func (mr *MockRentalRepositoryMockRecorder) ListOverlapping(ctx, carID, startsAt, endsAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverlapping", reflect.TypeOf((*MockRentalRepository)(nil).ListOverlapping), ctx, carID, startsAt, endsAt)
+}
+
+// LockCar mocks base method.
+func (m *MockRentalRepository) LockCar(ctx context.Context, carID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCar", ctx, carID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockCar indicates an expected call of LockCar.
+func (mr *MockRentalRepositoryMockRecorder) LockCar(ctx, carID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCar", reflect.TypeOf((*MockRentalRepository)(nil).LockCar), ctx, carID)
+}
+
+// Update mocks base method.
+func (m *MockRentalRepository) Update(ctx context.Context, rental *entity.Rental) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, rental)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRentalRepositoryMockRecorder) Update(ctx, rental any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRentalRepository)(nil).Update), ctx, rental)
+}
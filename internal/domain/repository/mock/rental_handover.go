@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rental_handover.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rental_handover.go -destination=mock/rental_handover.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRentalHandoverRepository is a mock of RentalHandoverRepository interface.
+type MockRentalHandoverRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRentalHandoverRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRentalHandoverRepositoryMockRecorder is the mock recorder for MockRentalHandoverRepository.
+type MockRentalHandoverRepositoryMockRecorder struct {
+	mock *MockRentalHandoverRepository
+}
+
+// NewMockRentalHandoverRepository creates a new mock instance.
+func NewMockRentalHandoverRepository(ctrl *gomock.Controller) *MockRentalHandoverRepository {
+	mock := &MockRentalHandoverRepository{ctrl: ctrl}
+	mock.recorder = &MockRentalHandoverRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRentalHandoverRepository) EXPECT() *MockRentalHandoverRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRentalHandoverRepository) Create(ctx context.Context, handover *entity.RentalHandover) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, handover)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRentalHandoverRepositoryMockRecorder) Create(ctx, handover any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRentalHandoverRepository)(nil).Create), ctx, handover)
+}
+
+// GetByRental mocks base method.
+func (m *MockRentalHandoverRepository) GetByRental(ctx context.Context, tenantID, rentalID string, kind entity.HandoverKind) (*entity.RentalHandover, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByRental", ctx, tenantID, rentalID, kind)
+	ret0, _ := ret[0].(*entity.RentalHandover)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByRental indicates an expected call of GetByRental.
+func (mr *MockRentalHandoverRepositoryMockRecorder) GetByRental(ctx, tenantID, rentalID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRental", reflect.TypeOf((*MockRentalHandoverRepository)(nil).GetByRental), ctx, tenantID, rentalID, kind)
+}
+
+// ListByRental mocks base method.
+func (m *MockRentalHandoverRepository) ListByRental(ctx context.Context, tenantID, rentalID string) (entity.RentalHandovers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByRental", ctx, tenantID, rentalID)
+	ret0, _ := ret[0].(entity.RentalHandovers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByRental indicates an expected call of ListByRental.
+func (mr *MockRentalHandoverRepositoryMockRecorder) ListByRental(ctx, tenantID, rentalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRental", reflect.TypeOf((*MockRentalHandoverRepository)(nil).ListByRental), ctx, tenantID, rentalID)
+}
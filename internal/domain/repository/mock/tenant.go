@@ -12,6 +12,7 @@ package mock_repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -99,6 +100,21 @@ func (mr *MockTenantRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTenantRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByIDForUpdate mocks base method.
+func (m *MockTenantRepository) GetByIDForUpdate(ctx context.Context, id string) (*entity.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDForUpdate", ctx, id)
+	ret0, _ := ret[0].(*entity.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDForUpdate indicates an expected call of GetByIDForUpdate.
+func (mr *MockTenantRepositoryMockRecorder) GetByIDForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockTenantRepository)(nil).GetByIDForUpdate), ctx, id)
+}
+
 // GetByIDWithCars mocks base method.
 func (m *MockTenantRepository) GetByIDWithCars(ctx context.Context, id string) (*entity.Tenant, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +130,36 @@ func (mr *MockTenantRepositoryMockRecorder) GetByIDWithCars(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithCars", reflect.TypeOf((*MockTenantRepository)(nil).GetByIDWithCars), ctx, id)
 }
 
+// List mocks base method.
+func (m *MockTenantRepository) List(ctx context.Context, afterID string, limit int) (entity.Tenants, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, afterID, limit)
+	ret0, _ := ret[0].(entity.Tenants)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTenantRepositoryMockRecorder) List(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTenantRepository)(nil).List), ctx, afterID, limit)
+}
+
+// ListDueForPurge mocks base method.
+func (m *MockTenantRepository) ListDueForPurge(ctx context.Context, now time.Time, limit int) (entity.Tenants, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueForPurge", ctx, now, limit)
+	ret0, _ := ret[0].(entity.Tenants)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueForPurge indicates an expected call of ListDueForPurge.
+func (mr *MockTenantRepositoryMockRecorder) ListDueForPurge(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueForPurge", reflect.TypeOf((*MockTenantRepository)(nil).ListDueForPurge), ctx, now, limit)
+}
+
 // Update mocks base method.
 func (m *MockTenantRepository) Update(ctx context.Context, tenant *entity.Tenant) error {
 	m.ctrl.T.Helper()
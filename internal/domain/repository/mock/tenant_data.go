@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant_data.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tenant_data.go -destination=mock/tenant_data.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantDataRepository is a mock of TenantDataRepository interface.
+type MockTenantDataRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantDataRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantDataRepositoryMockRecorder is the mock recorder for MockTenantDataRepository.
+type MockTenantDataRepositoryMockRecorder struct {
+	mock *MockTenantDataRepository
+}
+
+// NewMockTenantDataRepository creates a new mock instance.
+func NewMockTenantDataRepository(ctrl *gomock.Controller) *MockTenantDataRepository {
+	mock := &MockTenantDataRepository{ctrl: ctrl}
+	mock.recorder = &MockTenantDataRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantDataRepository) EXPECT() *MockTenantDataRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockTenantDataRepository) Count(ctx context.Context, tenantID string) (entity.TenantRows, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, tenantID)
+	ret0, _ := ret[0].(entity.TenantRows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockTenantDataRepositoryMockRecorder) Count(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockTenantDataRepository)(nil).Count), ctx, tenantID)
+}
+
+// DeleteBatch mocks base method.
+func (m *MockTenantDataRepository) DeleteBatch(ctx context.Context, tenantID, table string, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatch", ctx, tenantID, table, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBatch indicates an expected call of DeleteBatch.
+func (mr *MockTenantDataRepositoryMockRecorder) DeleteBatch(ctx, tenantID, table, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatch", reflect.TypeOf((*MockTenantDataRepository)(nil).DeleteBatch), ctx, tenantID, table, limit)
+}
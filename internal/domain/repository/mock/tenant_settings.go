@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tenant_settings.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tenant_settings.go -destination=mock/tenant_settings.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTenantSettingsRepository is a mock of TenantSettingsRepository interface.
+type MockTenantSettingsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTenantSettingsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTenantSettingsRepositoryMockRecorder is the mock recorder for MockTenantSettingsRepository.
+type MockTenantSettingsRepositoryMockRecorder struct {
+	mock *MockTenantSettingsRepository
+}
+
+// NewMockTenantSettingsRepository creates a new mock instance.
+func NewMockTenantSettingsRepository(ctrl *gomock.Controller) *MockTenantSettingsRepository {
+	mock := &MockTenantSettingsRepository{ctrl: ctrl}
+	mock.recorder = &MockTenantSettingsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTenantSettingsRepository) EXPECT() *MockTenantSettingsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTenantSettingsRepository) Create(ctx context.Context, settings *entity.TenantSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTenantSettingsRepositoryMockRecorder) Create(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTenantSettingsRepository)(nil).Create), ctx, settings)
+}
+
+// Get mocks base method.
+func (m *MockTenantSettingsRepository) Get(ctx context.Context, tenantID string) (*entity.TenantSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tenantID)
+	ret0, _ := ret[0].(*entity.TenantSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockTenantSettingsRepositoryMockRecorder) Get(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTenantSettingsRepository)(nil).Get), ctx, tenantID)
+}
+
+// Update mocks base method.
+func (m *MockTenantSettingsRepository) Update(ctx context.Context, settings *entity.TenantSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTenantSettingsRepositoryMockRecorder) Update(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTenantSettingsRepository)(nil).Update), ctx, settings)
+}
@@ -13,7 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
-	entgen "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -41,45 +41,21 @@ func (m *MockTransactionManager) EXPECT() *MockTransactionManagerMockRecorder {
 	return m.recorder
 }
 
-// BeginTx mocks base method.
-func (m *MockTransactionManager) BeginTx(ctx context.Context) (*entgen.Tx, error) {
+// RunInTx mocks base method.
+func (m *MockTransactionManager) RunInTx(ctx context.Context, fn func(context.Context) error, opts ...repository.TxOptions) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BeginTx", ctx)
-	ret0, _ := ret[0].(*entgen.Tx)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// BeginTx indicates an expected call of BeginTx.
-func (mr *MockTransactionManagerMockRecorder) BeginTx(ctx any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTx", reflect.TypeOf((*MockTransactionManager)(nil).BeginTx), ctx)
-}
-
-// CommitTx mocks base method.
-func (m *MockTransactionManager) CommitTx(ctx context.Context, tx *entgen.Tx) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CommitTx", ctx, tx)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CommitTx indicates an expected call of CommitTx.
-func (mr *MockTransactionManagerMockRecorder) CommitTx(ctx, tx any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitTx", reflect.TypeOf((*MockTransactionManager)(nil).CommitTx), ctx, tx)
-}
-
-// RollbackTx mocks base method.
-func (m *MockTransactionManager) RollbackTx(ctx context.Context, tx *entgen.Tx) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackTx", ctx, tx)
+	varargs := []any{ctx, fn}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunInTx", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackTx indicates an expected call of RollbackTx.
-func (mr *MockTransactionManagerMockRecorder) RollbackTx(ctx, tx any) *gomock.Call {
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MockTransactionManagerMockRecorder) RunInTx(ctx, fn any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTx", reflect.TypeOf((*MockTransactionManager)(nil).RollbackTx), ctx, tx)
+	varargs := append([]any{ctx, fn}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*MockTransactionManager)(nil).RunInTx), varargs...)
 }
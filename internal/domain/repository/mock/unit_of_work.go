@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: unit_of_work.go
+//
+// Generated by this command:
+//
+//	mockgen -source=unit_of_work.go -destination=mock/unit_of_work.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnitOfWork is a mock of UnitOfWork interface.
+type MockUnitOfWork struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitOfWorkMockRecorder
+	isgomock struct{}
+}
+
+// MockUnitOfWorkMockRecorder is the mock recorder for MockUnitOfWork.
+type MockUnitOfWorkMockRecorder struct {
+	mock *MockUnitOfWork
+}
+
+// NewMockUnitOfWork creates a new mock instance.
+func NewMockUnitOfWork(ctrl *gomock.Controller) *MockUnitOfWork {
+	mock := &MockUnitOfWork{ctrl: ctrl}
+	mock.recorder = &MockUnitOfWorkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitOfWork) EXPECT() *MockUnitOfWorkMockRecorder {
+	return m.recorder
+}
+
+// Commit mocks base method.
+func (m *MockUnitOfWork) Commit(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockUnitOfWorkMockRecorder) Commit(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockUnitOfWork)(nil).Commit), ctx)
+}
+
+// RegisterDeleted mocks base method.
+func (m *MockUnitOfWork) RegisterDeleted(aggregate entity.Aggregate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterDeleted", aggregate)
+}
+
+// RegisterDeleted indicates an expected call of RegisterDeleted.
+func (mr *MockUnitOfWorkMockRecorder) RegisterDeleted(aggregate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDeleted", reflect.TypeOf((*MockUnitOfWork)(nil).RegisterDeleted), aggregate)
+}
+
+// RegisterDirty mocks base method.
+func (m *MockUnitOfWork) RegisterDirty(aggregate entity.Aggregate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterDirty", aggregate)
+}
+
+// RegisterDirty indicates an expected call of RegisterDirty.
+func (mr *MockUnitOfWorkMockRecorder) RegisterDirty(aggregate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDirty", reflect.TypeOf((*MockUnitOfWork)(nil).RegisterDirty), aggregate)
+}
+
+// RegisterNew mocks base method.
+func (m *MockUnitOfWork) RegisterNew(aggregate entity.Aggregate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterNew", aggregate)
+}
+
+// RegisterNew indicates an expected call of RegisterNew.
+func (mr *MockUnitOfWorkMockRecorder) RegisterNew(aggregate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNew", reflect.TypeOf((*MockUnitOfWork)(nil).RegisterNew), aggregate)
+}
+
+// MockUnitOfWorkFactory is a mock of UnitOfWorkFactory interface.
+type MockUnitOfWorkFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitOfWorkFactoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUnitOfWorkFactoryMockRecorder is the mock recorder for MockUnitOfWorkFactory.
+type MockUnitOfWorkFactoryMockRecorder struct {
+	mock *MockUnitOfWorkFactory
+}
+
+// NewMockUnitOfWorkFactory creates a new mock instance.
+func NewMockUnitOfWorkFactory(ctrl *gomock.Controller) *MockUnitOfWorkFactory {
+	mock := &MockUnitOfWorkFactory{ctrl: ctrl}
+	mock.recorder = &MockUnitOfWorkFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitOfWorkFactory) EXPECT() *MockUnitOfWorkFactoryMockRecorder {
+	return m.recorder
+}
+
+// New mocks base method.
+func (m *MockUnitOfWorkFactory) New() repository.UnitOfWork {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "New")
+	ret0, _ := ret[0].(repository.UnitOfWork)
+	return ret0
+}
+
+// New indicates an expected call of New.
+func (mr *MockUnitOfWorkFactoryMockRecorder) New() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockUnitOfWorkFactory)(nil).New))
+}
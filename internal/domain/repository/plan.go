@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type PlanRepository interface {
+	Create(ctx context.Context, plan *entity.Plan) error
+	GetByID(ctx context.Context, id string) (*entity.Plan, error)
+	GetByCode(ctx context.Context, code string) (*entity.Plan, error)
+	List(ctx context.Context) (entity.Plans, error)
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// RentalFilter narrows down a rental list query
+type RentalFilter struct {
+	RenterID string
+}
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type RentalRepository interface {
+	Create(ctx context.Context, rental *entity.Rental) error
+	// GetByID retrieves a rental the tenant booked
+	GetByID(ctx context.Context, tenantID, id string) (*entity.Rental, error)
+	// Update stores the return of a rental
+	Update(ctx context.Context, rental *entity.Rental) error
+	// LockCar serializes the bookings of a car until the end of the transaction, for the
+	// tenant owning it and every tenant it is shared with
+	LockCar(ctx context.Context, carID string) error
+	// HasOverlap reports whether a live rental of the car overlaps [startsAt, endsAt),
+	// whichever tenant booked it. A returned rental ends when its car was returned.
+	HasOverlap(ctx context.Context, carID string, startsAt, endsAt time.Time) (bool, error)
+	// ListOverlapping retrieves the live rentals of the car overlapping [startsAt, endsAt),
+	// whichever tenant booked them, the earliest first
+	ListOverlapping(ctx context.Context, carID string, startsAt, endsAt time.Time) (entity.Rentals, error)
+	// ListByTenant retrieves the rentals a tenant booked or owns the car of, the latest
+	// first, with the total count
+	ListByTenant(ctx context.Context, tenantID string, filter RentalFilter, limit int, offset int) (entity.Rentals, int, error)
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type RentalHandoverRepository interface {
+	// Create stores a new handover; it returns ErrAlreadyExists if the rental already has
+	// one of the same kind
+	Create(ctx context.Context, handover *entity.RentalHandover) error
+	// GetByRental retrieves the handover of a kind of a rental the tenant booked
+	GetByRental(ctx context.Context, tenantID, rentalID string, kind entity.HandoverKind) (*entity.RentalHandover, error)
+	// ListByRental retrieves the handovers of a rental the tenant booked or owns the car
+	// of, the pickup first
+	ListByRental(ctx context.Context, tenantID, rentalID string) (entity.RentalHandovers, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 )
@@ -10,8 +11,16 @@ import (
 type TenantRepository interface {
 	Create(ctx context.Context, tenant *entity.Tenant) error
 	GetByID(ctx context.Context, id string) (*entity.Tenant, error)
+	// GetByIDForUpdate retrieves a tenant and locks it until the end of the transaction
+	GetByIDForUpdate(ctx context.Context, id string) (*entity.Tenant, error)
 	GetByCode(ctx context.Context, code string) (*entity.Tenant, error)
 	GetByIDWithCars(ctx context.Context, id string) (*entity.Tenant, error)
+	// List retrieves up to limit tenants ordered by ID, starting after afterID; pass the ID
+	// of the last tenant of a page to get the next one, or "" for the first
+	List(ctx context.Context, afterID string, limit int) (entity.Tenants, error)
+	// ListDueForPurge retrieves up to limit tenants pending deletion whose grace period is
+	// over at now, the longest overdue first
+	ListDueForPurge(ctx context.Context, now time.Time, limit int) (entity.Tenants, error)
 	Update(ctx context.Context, tenant *entity.Tenant) error
 	Delete(ctx context.Context, id string) error
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// TenantDataRepository counts and deletes the rows a tenant owns across every table,
+// wherever its isolation mode keeps them
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type TenantDataRepository interface {
+	// Count returns how many rows of the tenant each table holds, in the order they must
+	// be deleted: tables referencing others come first
+	Count(ctx context.Context, tenantID string) (entity.TenantRows, error)
+	// DeleteBatch deletes up to limit rows of the tenant from a table returned by Count and
+	// returns how many were deleted. Each batch commits on its own.
+	DeleteBatch(ctx context.Context, tenantID, table string, limit int) (int, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type TenantSettingsRepository interface {
+	Create(ctx context.Context, settings *entity.TenantSettings) error
+	// Get retrieves the stored settings of a tenant; tenants that never changed them have none
+	Get(ctx context.Context, tenantID string) (*entity.TenantSettings, error)
+	Update(ctx context.Context, settings *entity.TenantSettings) error
+}
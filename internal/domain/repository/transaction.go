@@ -2,13 +2,36 @@ package repository
 
 import (
 	"context"
+)
+
+// IsolationLevel is the isolation level of a transaction
+type IsolationLevel int
 
-	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+const (
+	// IsolationDefault uses the database default (read committed in PostgreSQL)
+	IsolationDefault IsolationLevel = iota
+	IsolationReadCommitted
+	IsolationRepeatableRead
+	IsolationSerializable
 )
 
+// TxOptions configures a transaction started by RunInTx
+type TxOptions struct {
+	Isolation IsolationLevel
+	ReadOnly  bool
+}
+
+// TransactionManager runs functions inside a database transaction
+//
 //go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
 type TransactionManager interface {
-	BeginTx(ctx context.Context) (*entgen.Tx, error)
-	CommitTx(ctx context.Context, tx *entgen.Tx) error
-	RollbackTx(ctx context.Context, tx *entgen.Tx) error
+	// RunInTx runs fn in a transaction carried by the context passed to fn. Repositories
+	// called with that context join the transaction. The transaction is committed when fn
+	// returns nil and rolled back when it returns an error or panics. Nested calls join the
+	// outer transaction and ignore opts.
+	//
+	// When the database aborts the transaction with a serialization failure or a deadlock,
+	// the whole transaction is retried with backoff, so fn may run more than once and must
+	// not have side effects outside the transaction.
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error, opts ...TxOptions) error
 }
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// UnitOfWork tracks the aggregates changed by a use case and, on Commit, persists
+// them together with the domain events they recorded in a single transaction
+//
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type UnitOfWork interface {
+	RegisterNew(aggregate entity.Aggregate)
+	RegisterDirty(aggregate entity.Aggregate)
+	RegisterDeleted(aggregate entity.Aggregate)
+	Commit(ctx context.Context) error
+}
+
+// UnitOfWorkFactory starts a new UnitOfWork for each use case
+type UnitOfWorkFactory interface {
+	New() UnitOfWork
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type UsageRepository interface {
+	// Count returns how much of resource a tenant uses. Periodic resources, such as monthly
+	// rentals, are counted from since; the others ignore it.
+	Count(ctx context.Context, tenantID string, resource entity.Resource, since time.Time) (int, error)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// WebhookDeliveryFilter narrows down a delivery log query
+type WebhookDeliveryFilter struct {
+	EndpointID string
+	Status     entity.WebhookDeliveryStatus
+}
+
+//go:generate go run go.uber.org/mock/mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+type WebhookEndpointRepository interface {
+	Create(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	GetByID(ctx context.Context, tenantID, id string) (*entity.WebhookEndpoint, error)
+	ListByTenant(ctx context.Context, tenantID string, limit int, offset int) ([]*entity.WebhookEndpoint, error)
+	ListSubscribed(ctx context.Context, tenantID, eventType string) ([]*entity.WebhookEndpoint, error)
+	Update(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	// RecordFailure extends the failure streak of an enabled endpoint and disables it once
+	// the streak reaches entity.MaxWebhookConsecutiveFailures, in one statement. It reports
+	// whether the endpoint was disabled by this call.
+	RecordFailure(ctx context.Context, tenantID, id string, now time.Time) (bool, error)
+	// ResetFailures ends the failure streak of an endpoint
+	ResetFailures(ctx context.Context, tenantID, id string, now time.Time) error
+	Delete(ctx context.Context, tenantID, id string) error
+}
+
+type WebhookDeliveryRepository interface {
+	// CreateIfNotExists inserts a delivery unless one already exists for the same endpoint and event
+	CreateIfNotExists(ctx context.Context, delivery *entity.WebhookDelivery) error
+	GetByID(ctx context.Context, tenantID, id string) (*entity.WebhookDelivery, error)
+	ListByTenant(ctx context.Context, tenantID string, filter WebhookDeliveryFilter, limit int, offset int) ([]*entity.WebhookDelivery, error)
+	// ClaimDue leases up to limit pending deliveries that are due at now by pushing their
+	// next attempt to now+lease, so that concurrent dispatchers do not pick them up twice
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error)
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
@@ -0,0 +1,81 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidCoordinates is returned for latitudes and longitudes off the globe
+var ErrInvalidCoordinates = errors.New("invalid coordinates")
+
+// earthRadiusKm is the mean radius of the Earth
+const earthRadiusKm = 6371.0088
+
+// Coordinates represents a validated WGS 84 position value object, in decimal degrees
+type Coordinates struct {
+	latitude  float64
+	longitude float64
+}
+
+// NewCoordinates creates a new Coordinates value object after checking that the latitude
+// is between -90 and 90 and the longitude between -180 and 180
+func NewCoordinates(latitude, longitude float64) (*Coordinates, error) {
+	if err := validateCoordinates(latitude, longitude); err != nil {
+		return nil, err
+	}
+
+	return &Coordinates{latitude: latitude, longitude: longitude}, nil
+}
+
+// validateCoordinates checks if a latitude and a longitude are on the globe
+func validateCoordinates(latitude, longitude float64) error {
+	if math.IsNaN(latitude) || latitude < -90 || latitude > 90 {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", ErrInvalidCoordinates)
+	}
+
+	if math.IsNaN(longitude) || longitude < -180 || longitude > 180 {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", ErrInvalidCoordinates)
+	}
+
+	return nil
+}
+
+// Latitude returns the latitude in decimal degrees, north positive
+func (c *Coordinates) Latitude() float64 {
+	return c.latitude
+}
+
+// Longitude returns the longitude in decimal degrees, east positive
+func (c *Coordinates) Longitude() float64 {
+	return c.longitude
+}
+
+// DistanceKm returns the great-circle distance to other in kilometers, by the haversine
+// formula on a spherical Earth
+func (c *Coordinates) DistanceKm(other *Coordinates) float64 {
+	lat1, lat2 := radians(c.latitude), radians(other.latitude)
+	dLat := lat2 - lat1
+	dLng := radians(other.longitude - c.longitude)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// radians converts decimal degrees to radians
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// String returns the coordinates as "latitude,longitude"
+func (c *Coordinates) String() string {
+	return fmt.Sprintf("%.6f,%.6f", c.latitude, c.longitude)
+}
+
+// Equals checks if two Coordinates objects are equal
+func (c *Coordinates) Equals(other *Coordinates) bool {
+	if other == nil {
+		return false
+	}
+	return c.latitude == other.latitude && c.longitude == other.longitude
+}
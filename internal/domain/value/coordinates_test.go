@@ -0,0 +1,96 @@
+package value
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCoordinates(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		latitude  float64
+		longitude float64
+		wantErr   string
+	}{
+		"ok": {
+			latitude:  35.6812,
+			longitude: 139.7671,
+		},
+		"ok (poles and antimeridian)": {
+			latitude:  -90,
+			longitude: 180,
+		},
+		"ng (latitude too far north)": {
+			latitude:  90.5,
+			longitude: 0,
+			wantErr:   "latitude must be between -90 and 90",
+		},
+		"ng (longitude too far west)": {
+			latitude:  0,
+			longitude: -180.1,
+			wantErr:   "longitude must be between -180 and 180",
+		},
+		"ng (not a number)": {
+			latitude:  math.NaN(),
+			longitude: 0,
+			wantErr:   "latitude must be between -90 and 90",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewCoordinates(tt.latitude, tt.longitude)
+
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrInvalidCoordinates)
+				require.Contains(t, err.Error(), tt.wantErr)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.latitude, got.Latitude())
+			require.Equal(t, tt.longitude, got.Longitude())
+		})
+	}
+}
+
+func TestCoordinatesDistanceKm(t *testing.T) {
+	t.Parallel()
+
+	tokyo, err := NewCoordinates(35.6812, 139.7671)
+	require.NoError(t, err)
+	osaka, err := NewCoordinates(34.7025, 135.4959)
+	require.NoError(t, err)
+	fiji, err := NewCoordinates(-17.7134, 178.065)
+	require.NoError(t, err)
+	samoa, err := NewCoordinates(-13.759, -172.1046)
+	require.NoError(t, err)
+
+	require.Zero(t, tokyo.DistanceKm(tokyo))
+	require.InDelta(t, 403, tokyo.DistanceKm(osaka), 1)
+	require.Equal(t, tokyo.DistanceKm(osaka), osaka.DistanceKm(tokyo))
+	// Across the antimeridian, not around the globe
+	require.InDelta(t, 1140, fiji.DistanceKm(samoa), 5)
+}
+
+func TestCoordinatesEquals(t *testing.T) {
+	t.Parallel()
+
+	c1, err := NewCoordinates(35.6812, 139.7671)
+	require.NoError(t, err)
+
+	c2, err := NewCoordinates(35.6812, 139.7671)
+	require.NoError(t, err)
+
+	c3, err := NewCoordinates(34.7025, 135.4959)
+	require.NoError(t, err)
+
+	require.True(t, c1.Equals(c2))
+	require.False(t, c1.Equals(c3))
+	require.False(t, c1.Equals(nil))
+}
@@ -0,0 +1,140 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidLicensePlate is returned for strings that are not license plate numbers
+var ErrInvalidLicensePlate = errors.New("invalid license plate")
+
+// MaxLicensePlateLength is the maximum allowed length for a license plate number
+const MaxLicensePlateLength = 15
+
+// licensePlatePattern matches plate numbers of every country, as written: letters and
+// digits, separated by single spaces or hyphens
+var licensePlatePattern = regexp.MustCompile(`^[A-Z0-9]+([ -][A-Z0-9]+)*$`)
+
+// countryPattern matches ISO 3166-1 alpha-2 country codes
+var countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// LicensePlateFormat is the format of the plate numbers issued in a country
+type LicensePlateFormat struct {
+	// Pattern matches the normalized plate numbers of the country, separated by spaces only
+	Pattern *regexp.Regexp
+	// Example is a plate number of the format, shown when a number does not match it
+	Example string
+}
+
+var (
+	licensePlateFormatsMu sync.RWMutex
+	// licensePlateFormats are the formats of plate numbers by country. Plates of countries
+	// without a format only need to meet the rules of every country.
+	licensePlateFormats = map[string]LicensePlateFormat{
+		// Each state has its own formats, so only their common length is checked
+		"US": {Pattern: regexp.MustCompile(`^[A-Z0-9]( ?[A-Z0-9]){0,7}$`), Example: "7ABC123"},
+		"CA": {Pattern: regexp.MustCompile(`^[A-Z0-9]( ?[A-Z0-9]){1,7}$`), Example: "ABCD 123"},
+		"GB": {Pattern: regexp.MustCompile(`^[A-Z]{2}[0-9]{2} [A-Z]{3}$`), Example: "AB12 CDE"},
+		"DE": {Pattern: regexp.MustCompile(`^[A-Z]{1,3} [A-Z]{1,2} [1-9][0-9]{0,3}[EH]?$`), Example: "M-AB 1234"},
+		"FR": {Pattern: regexp.MustCompile(`^[A-Z]{2} [0-9]{3} [A-Z]{2}$`), Example: "AB-123-CD"},
+		"IT": {Pattern: regexp.MustCompile(`^[A-Z]{2} [0-9]{3}[A-Z]{2}$`), Example: "AB 123CD"},
+		"ES": {Pattern: regexp.MustCompile(`^[0-9]{4} [B-DF-HJ-NP-TV-Z]{3}$`), Example: "1234 BCD"},
+	}
+)
+
+// RegisterLicensePlateFormat sets the format of the plate numbers of a country, replacing
+// any format it had. pattern matches normalized numbers: upper-case, with single spaces
+// where the number was written with spaces or hyphens.
+func RegisterLicensePlateFormat(country, pattern, example string) error {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if !countryPattern.MatchString(country) {
+		return fmt.Errorf("%w: unknown country %q", ErrInvalidLicensePlate, country)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid license plate pattern of %s: %w", country, err)
+	}
+
+	licensePlateFormatsMu.Lock()
+	defer licensePlateFormatsMu.Unlock()
+	licensePlateFormats[country] = LicensePlateFormat{Pattern: re, Example: example}
+	return nil
+}
+
+// LookupLicensePlateFormat returns the format of the plate numbers of a country, if it has one
+func LookupLicensePlateFormat(country string) (LicensePlateFormat, bool) {
+	licensePlateFormatsMu.RLock()
+	defer licensePlateFormatsMu.RUnlock()
+	format, ok := licensePlateFormats[strings.ToUpper(country)]
+	return format, ok
+}
+
+// LicensePlate represents a validated license plate number value object, issued in a
+// country. The same number can be issued in two countries, so plates are only equal if both
+// their countries and numbers are.
+type LicensePlate struct {
+	country string
+	value   string
+}
+
+// NewLicensePlate creates a new LicensePlate value object after normalizing and validating
+// the number against the format of the country, an ISO 3166-1 alpha-2 code. Letters are
+// upper-cased, runs of spaces collapsed and hyphens replaced with spaces, so "ab-123",
+// "AB  123" and "AB 123" are the same plate.
+func NewLicensePlate(country, plate string) (*LicensePlate, error) {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	plate = strings.Join(strings.Fields(strings.ToUpper(plate)), " ")
+	if err := validateLicensePlate(country, plate); err != nil {
+		return nil, err
+	}
+
+	return &LicensePlate{country: country, value: strings.ReplaceAll(plate, "-", " ")}, nil
+}
+
+// validateLicensePlate checks if a plate number, upper-cased with single spaces, meets the
+// rules of every country and, once its hyphens are replaced with spaces, the format of its
+// own
+func validateLicensePlate(country, plate string) error {
+	if !countryPattern.MatchString(country) {
+		return fmt.Errorf("%w: country must be an ISO 3166-1 alpha-2 code", ErrInvalidLicensePlate)
+	}
+
+	if plate == "" {
+		return fmt.Errorf("%w: cannot be empty", ErrInvalidLicensePlate)
+	}
+
+	if len(plate) > MaxLicensePlateLength {
+		return fmt.Errorf("%w: exceeds maximum length", ErrInvalidLicensePlate)
+	}
+
+	if !licensePlatePattern.MatchString(plate) {
+		return fmt.Errorf("%w: must contain only letters and digits, separated by spaces or hyphens", ErrInvalidLicensePlate)
+	}
+
+	if format, ok := LookupLicensePlateFormat(country); ok && !format.Pattern.MatchString(strings.ReplaceAll(plate, "-", " ")) {
+		return fmt.Errorf("%w: does not match the format of %s, e.g. %q", ErrInvalidLicensePlate, country, format.Example)
+	}
+
+	return nil
+}
+
+// Country returns the ISO 3166-1 alpha-2 code of the country that issued the plate
+func (p *LicensePlate) Country() string {
+	return p.country
+}
+
+// String returns the string representation of the license plate
+func (p *LicensePlate) String() string {
+	return p.value
+}
+
+// Equals checks if two LicensePlate objects are equal
+func (p *LicensePlate) Equals(other *LicensePlate) bool {
+	if other == nil {
+		return false
+	}
+	return p.country == other.country && p.value == other.value
+}
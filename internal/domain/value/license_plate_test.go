@@ -0,0 +1,161 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLicensePlate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		country string
+		plate   string
+		want    string
+		wantErr string
+	}{
+		"ok (letters and digits)": {
+			country: "US",
+			plate:   "ABC123",
+			want:    "ABC123",
+		},
+		"ok (lowercase with extra spaces)": {
+			country: "gb",
+			plate:   "  ab12   cde ",
+			want:    "AB12 CDE",
+		},
+		"ok (hyphen)": {
+			country: "US",
+			plate:   "ABC-123",
+			want:    "ABC 123",
+		},
+		"ok (space)": {
+			country: "US",
+			plate:   "ABC 123",
+			want:    "ABC 123",
+		},
+		"ok (country written with hyphens)": {
+			country: "FR",
+			plate:   "ab-123-cd",
+			want:    "AB 123 CD",
+		},
+		"ok (country written with hyphens, with spaces)": {
+			country: "FR",
+			plate:   "AB 123 CD",
+			want:    "AB 123 CD",
+		},
+		"ok (country mixing hyphens and spaces)": {
+			country: "DE",
+			plate:   "m-ab 1234",
+			want:    "M AB 1234",
+		},
+		"ok (country without a format)": {
+			country: "NZ",
+			plate:   "ABC 123",
+			want:    "ABC 123",
+		},
+		"ng (empty plate)": {
+			country: "US",
+			plate:   "  ",
+			wantErr: "cannot be empty",
+		},
+		"ng (unknown country code)": {
+			country: "USA",
+			plate:   "ABC123",
+			wantErr: "country must be an ISO 3166-1 alpha-2 code",
+		},
+		"ng (empty country)": {
+			plate:   "ABC123",
+			wantErr: "country must be an ISO 3166-1 alpha-2 code",
+		},
+		"ng (plate too long)": {
+			country: "NZ",
+			plate:   "ABCDEFGH12345678",
+			wantErr: "exceeds maximum length",
+		},
+		"ng (punctuation)": {
+			country: "NZ",
+			plate:   "AB.123",
+			wantErr: "must contain only letters and digits",
+		},
+		"ng (double hyphen)": {
+			country: "NZ",
+			plate:   "AB--123",
+			wantErr: "must contain only letters and digits",
+		},
+		"ng (too long for the country)": {
+			country: "US",
+			plate:   "ABCD12345",
+			wantErr: "does not match the format of US",
+		},
+		"ng (format of another country)": {
+			country: "DE",
+			plate:   "AB-123-CD",
+			wantErr: `does not match the format of DE, e.g. "M-AB 1234"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewLicensePlate(tt.country, tt.plate)
+
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrInvalidLicensePlate)
+				require.Contains(t, err.Error(), tt.wantErr)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestRegisterLicensePlateFormat(t *testing.T) {
+	t.Parallel()
+
+	// ZZ is a user-assigned code, so no other test relies on its format
+	_, err := NewLicensePlate("ZZ", "1234")
+	require.NoError(t, err)
+
+	require.NoError(t, RegisterLicensePlateFormat("zz", `^[A-Z]{3}$`, "ABC"))
+	format, ok := LookupLicensePlateFormat("ZZ")
+	require.True(t, ok)
+	require.Equal(t, "ABC", format.Example)
+
+	_, err = NewLicensePlate("ZZ", "1234")
+	require.ErrorIs(t, err, ErrInvalidLicensePlate)
+	_, err = NewLicensePlate("ZZ", "abc")
+	require.NoError(t, err)
+
+	require.Error(t, RegisterLicensePlateFormat("ZZZ", `^[A-Z]{3}$`, "ABC"))
+	require.Error(t, RegisterLicensePlateFormat("ZY", `^[A-Z`, "ABC"))
+}
+
+func TestLicensePlateEquals(t *testing.T) {
+	t.Parallel()
+
+	plate1, err := NewLicensePlate("US", "AB 123")
+	require.NoError(t, err)
+
+	plate2, err := NewLicensePlate("us", "ab  123")
+	require.NoError(t, err)
+
+	plate3, err := NewLicensePlate("US", "AB 124")
+	require.NoError(t, err)
+
+	plate4, err := NewLicensePlate("CA", "AB 123")
+	require.NoError(t, err)
+
+	plate5, err := NewLicensePlate("US", "ab-123")
+	require.NoError(t, err)
+
+	require.True(t, plate1.Equals(plate2))
+	require.True(t, plate1.Equals(plate5))
+	require.False(t, plate1.Equals(plate3))
+	require.False(t, plate1.Equals(plate4))
+	require.False(t, plate1.Equals(nil))
+}
@@ -0,0 +1,97 @@
+package value
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalidVIN is returned for strings that are not vehicle identification numbers
+var ErrInvalidVIN = errors.New("invalid VIN")
+
+// VINLength is the length of a vehicle identification number
+const VINLength = 17
+
+// vinPattern matches the characters of a VIN: digits and capital letters except I, O and
+// Q, which read like 1 and 0
+var vinPattern = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]+$`)
+
+// vinCheckDigitPosition is the index of the check digit within a VIN
+const vinCheckDigitPosition = 8
+
+// vinWeights are the weights of the characters of a VIN in its check digit, by position
+var vinWeights = [VINLength]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// vinLetterValues are the values of the letters of a VIN in its check digit, from A to Z;
+// I, O and Q never occur
+var vinLetterValues = [26]int{1, 2, 3, 4, 5, 6, 7, 8, 0, 1, 2, 3, 4, 5, 0, 7, 0, 9, 2, 3, 4, 5, 6, 7, 8, 9}
+
+// VIN represents a validated vehicle identification number value object
+type VIN struct {
+	value string
+}
+
+// NewVIN creates a new VIN value object after normalizing and validating the number. The
+// ninth character must be the check digit of ISO 3779 as computed in North America: the
+// weighted sum of the transliterated characters modulo 11, with 10 written as X.
+func NewVIN(vin string) (*VIN, error) {
+	vin = strings.ToUpper(strings.TrimSpace(vin))
+	if err := validateVIN(vin); err != nil {
+		return nil, err
+	}
+
+	return &VIN{value: vin}, nil
+}
+
+// validateVIN checks if a normalized VIN has the required length and characters
+func validateVIN(vin string) error {
+	if vin == "" {
+		return fmt.Errorf("%w: cannot be empty", ErrInvalidVIN)
+	}
+
+	if len(vin) != VINLength {
+		return fmt.Errorf("%w: must be %d characters long", ErrInvalidVIN, VINLength)
+	}
+
+	if !vinPattern.MatchString(vin) {
+		return fmt.Errorf("%w: must contain only digits and letters other than I, O and Q", ErrInvalidVIN)
+	}
+
+	if want := vinCheckDigit(vin); vin[vinCheckDigitPosition] != want {
+		return fmt.Errorf("%w: check digit must be %c", ErrInvalidVIN, want)
+	}
+
+	return nil
+}
+
+// vinCheckDigit computes the check digit of a VIN of valid length and characters
+func vinCheckDigit(vin string) byte {
+	sum := 0
+	for i := range VINLength {
+		c := vin[i]
+		value := int(c - '0')
+		if c >= 'A' && c <= 'Z' {
+			value = vinLetterValues[c-'A']
+		}
+		sum += value * vinWeights[i]
+	}
+
+	if digit := sum % 11; digit < 10 {
+		return byte('0' + digit)
+	}
+	return 'X'
+}
+
+// String returns the string representation of the VIN
+func (v *VIN) String() string {
+	return v.value
+}
+
+// Equals checks if two VIN objects are equal
+func (v *VIN) Equals(other *VIN) bool {
+	if other == nil {
+		return false
+	}
+	return v.value == other.value
+}
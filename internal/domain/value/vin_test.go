@@ -0,0 +1,84 @@
+package value
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVIN(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		args    string
+		want    string
+		wantErr string
+	}{
+		"ok (valid VIN)": {
+			args: "1HGCM82633A004352",
+			want: "1HGCM82633A004352",
+		},
+		"ok (lowercase with surrounding spaces)": {
+			args: " 1hgcm82633a004352 ",
+			want: "1HGCM82633A004352",
+		},
+		"ok (check digit X)": {
+			args: "1M8GDM9AXKP042788",
+			want: "1M8GDM9AXKP042788",
+		},
+		"ng (empty VIN)": {
+			args:    "",
+			wantErr: "cannot be empty",
+		},
+		"ng (too short)": {
+			args:    "1HGCM82633A00435",
+			wantErr: "must be 17 characters long",
+		},
+		"ng (letter O)": {
+			args:    "1HGCM82633AO04352",
+			wantErr: "must contain only digits and letters other than I, O and Q",
+		},
+		"ng (wrong check digit)": {
+			args:    "1HGCM82643A004352",
+			wantErr: "check digit must be",
+		},
+		"ng (mistyped character)": {
+			args:    "1HGCM82633A004353",
+			wantErr: "check digit must be",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewVIN(tt.args)
+
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrInvalidVIN)
+				require.Contains(t, err.Error(), tt.wantErr)
+				require.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestVINEquals(t *testing.T) {
+	t.Parallel()
+
+	vin1, err := NewVIN("1HGCM82633A004352")
+	require.NoError(t, err)
+
+	vin2, err := NewVIN("1hgcm82633a004352")
+	require.NoError(t, err)
+
+	vin3, err := NewVIN("1M8GDM9AXKP042788")
+	require.NoError(t, err)
+
+	require.True(t, vin1.Equals(vin2))
+	require.False(t, vin1.Equals(vin3))
+	require.False(t, vin1.Equals(nil))
+}
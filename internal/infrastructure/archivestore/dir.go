@@ -0,0 +1,77 @@
+// Package archivestore keeps tenant archives on the file system.
+package archivestore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/archive"
+)
+
+// Dir keeps archives as files of one directory, which is created on first use. Mount a
+// volume there to keep archives across restarts and share them between instances.
+type Dir struct {
+	dir string
+}
+
+var _ archive.Storage = (*Dir)(nil)
+
+// NewDir creates a storage keeping archives in dir
+func NewDir(dir string) *Dir {
+	return &Dir{dir: dir}
+}
+
+// Create creates a new archive file
+func (d *Dir) Create(_ context.Context, name string) (io.WriteCloser, error) {
+	path, err := d.path(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(d.dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive %s: %w", name, err)
+	}
+	return f, nil
+}
+
+// Open opens an archive file
+func (d *Dir) Open(_ context.Context, name string) (io.ReadSeekCloser, error) {
+	path, err := d.path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path) //nolint:gosec // the name cannot leave the directory
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive %s: %w", name, err)
+	}
+	return f, nil
+}
+
+// Remove deletes an archive file
+func (d *Dir) Remove(_ context.Context, name string) error {
+	path, err := d.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove archive %s: %w", name, err)
+	}
+	return nil
+}
+
+// path returns the path of an archive, rejecting names that are not plain file names so
+// that callers cannot reach files outside the directory
+func (d *Dir) path(name string) (string, error) {
+	if name == "" || name == "." || name == ".." || filepath.Base(name) != name {
+		return "", fmt.Errorf("invalid archive name %q", name)
+	}
+	return filepath.Join(d.dir, name), nil
+}
@@ -0,0 +1,55 @@
+package archivestore_test
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/archivestore"
+)
+
+// TestDir tests that archives are created once, read back and removed
+func TestDir(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctx := context.Background()
+	dir := archivestore.NewDir(t.TempDir() + "/archives")
+
+	// Execute
+	w, err := dir.Create(ctx, "acme.ndjson")
+	require.NoError(t, err)
+	_, err = io.WriteString(w, "archive\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	_, err = dir.Create(ctx, "acme.ndjson")
+	assert.ErrorIs(t, err, fs.ErrExist)
+
+	r, err := dir.Open(ctx, "acme.ndjson")
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "archive\n", string(content))
+
+	require.NoError(t, dir.Remove(ctx, "acme.ndjson"))
+	_, err = dir.Open(ctx, "acme.ndjson")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+// TestDir_InvalidName tests that names cannot reach files outside the directory
+func TestDir_InvalidName(t *testing.T) {
+	t.Parallel()
+
+	dir := archivestore.NewDir(t.TempDir())
+	for _, name := range []string{"", ".", "..", "../etc/passwd", "sub/acme.ndjson", "/etc/passwd"} {
+		_, err := dir.Open(context.Background(), name)
+		assert.Error(t, err, name)
+		assert.NotErrorIs(t, err, fs.ErrNotExist, name)
+	}
+}
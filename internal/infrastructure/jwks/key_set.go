@@ -0,0 +1,178 @@
+// Package jwks loads the JSON Web Key Sets that identity providers publish to verify the
+// JWTs they issue.
+package jwks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/auth"
+)
+
+// Key set defaults
+const (
+	DefaultCacheTTL       = 15 * time.Minute
+	DefaultRefreshBackoff = time.Minute
+	DefaultTimeout        = 10 * time.Second
+)
+
+// maxBodySize caps the size of a JWKS document
+const maxBodySize = 1 << 20
+
+// Config holds the caching knobs of a KeySet
+type Config struct {
+	// CacheTTL is how long a loaded key set is used before it is loaded again
+	CacheTTL time.Duration
+	// RefreshBackoff is the minimum time between two loads triggered by an unknown key
+	// ID, so that tokens with made-up key IDs cannot flood the identity provider
+	RefreshBackoff time.Duration
+}
+
+// KeySet is a cached JWKS, loaded from a file or a URL. It is loaded again when the cache
+// expires and when a token names an unknown key, which happens after the identity
+// provider rotates its keys.
+type KeySet struct {
+	load func(ctx context.Context) ([]byte, error)
+	cfg  Config
+
+	mu          sync.Mutex
+	keys        *jose.JSONWebKeySet
+	loadedAt    time.Time
+	attemptedAt time.Time
+	err         error
+}
+
+// NewFileKeySet creates a key set loaded from the JWKS file at path
+func NewFileKeySet(path string, cfg Config) *KeySet {
+	return newKeySet(func(context.Context) ([]byte, error) {
+		return os.ReadFile(path) //nolint:gosec // the path comes from configuration
+	}, cfg)
+}
+
+// NewURLKeySet creates a key set fetched from the JWKS URL of an identity provider
+func NewURLKeySet(url string, cfg Config) *KeySet {
+	client := &http.Client{Timeout: DefaultTimeout}
+	return newKeySet(func(ctx context.Context) ([]byte, error) {
+		return fetch(ctx, client, url)
+	}, cfg)
+}
+
+// newKeySet creates a key set loaded with load. Zero values in cfg are replaced with defaults.
+func newKeySet(load func(ctx context.Context) ([]byte, error), cfg Config) *KeySet {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultCacheTTL
+	}
+	if cfg.RefreshBackoff <= 0 {
+		cfg.RefreshBackoff = DefaultRefreshBackoff
+	}
+
+	return &KeySet{
+		load: load,
+		cfg:  cfg,
+	}
+}
+
+// Key returns the public key with ID kid
+func (s *KeySet) Key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.keys == nil || now.Sub(s.loadedAt) >= s.cfg.CacheTTL {
+		s.reload(ctx, now)
+	}
+	if s.keys == nil {
+		return nil, s.err
+	}
+
+	key, ok := s.find(kid)
+	if !ok && now.Sub(s.attemptedAt) >= s.cfg.RefreshBackoff {
+		s.reload(ctx, now)
+		key, ok = s.find(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", auth.ErrUnknownKey, kid)
+	}
+	return key, nil
+}
+
+// reload loads the key set, at most once per RefreshBackoff. When loading fails, the keys
+// loaded before keep being used.
+func (s *KeySet) reload(ctx context.Context, now time.Time) {
+	if !s.attemptedAt.IsZero() && now.Sub(s.attemptedAt) < s.cfg.RefreshBackoff {
+		return
+	}
+	s.attemptedAt = now
+
+	keys, err := s.parse(ctx)
+	if err != nil {
+		s.err = err
+		if s.keys != nil {
+			log.Printf("Failed to reload JWKS, keeping the cached keys: %v", err)
+		}
+		return
+	}
+
+	s.keys = keys
+	s.loadedAt = now
+	s.err = nil
+}
+
+// parse loads and decodes the key set, keeping only valid public signing keys
+func (s *KeySet) parse(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	data, err := s.load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JWKS: %w", err)
+	}
+
+	var set jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := &jose.JSONWebKeySet{}
+	for _, key := range set.Keys {
+		if key.Valid() && key.IsPublic() && (key.Use == "" || key.Use == "sig") {
+			keys.Keys = append(keys.Keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// find returns the key with ID kid
+func (s *KeySet) find(kid string) (*jose.JSONWebKey, bool) {
+	keys := s.keys.Key(kid)
+	if len(keys) == 0 {
+		return nil, false
+	}
+	return &keys[0], true
+}
+
+// fetch downloads the JWKS at url
+func fetch(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxBodySize))
+}
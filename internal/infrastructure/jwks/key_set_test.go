@@ -0,0 +1,145 @@
+package jwks_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/auth"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/jwks"
+)
+
+// publicJWKS returns a JWKS document with the public part of a new key for each kid
+func publicJWKS(t *testing.T, kids ...string) []byte {
+	t.Helper()
+
+	set := jose.JSONWebKeySet{}
+	for _, kid := range kids {
+		private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		set.Keys = append(set.Keys, jose.JSONWebKey{Key: &private.PublicKey, KeyID: kid, Algorithm: string(jose.ES256), Use: "sig"})
+	}
+
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+	return data
+}
+
+// TestFileKeySet tests that keys are read from a JWKS file
+func TestFileKeySet(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, publicJWKS(t, "key-1"), 0o600))
+	keySet := jwks.NewFileKeySet(path, jwks.Config{})
+
+	// Execute
+	key, err := keySet.Key(context.Background(), "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key.KeyID)
+
+	_, err = keySet.Key(context.Background(), "key-2")
+	assert.ErrorIs(t, err, auth.ErrUnknownKey)
+}
+
+// TestURLKeySet_Cache tests that the JWKS is fetched again only when the cache expires or
+// an unknown key is requested after the backoff
+func TestURLKeySet_Cache(t *testing.T) {
+	t.Parallel()
+
+	// Setup: the identity provider rotates to key-2 after the first fetch
+	var fetches atomic.Int32
+	first, rotated := publicJWKS(t, "key-1"), publicJWKS(t, "key-1", "key-2")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fetches.Add(1) == 1 {
+			_, _ = w.Write(first)
+			return
+		}
+		_, _ = w.Write(rotated)
+	}))
+	defer server.Close()
+
+	keySet := jwks.NewURLKeySet(server.URL, jwks.Config{CacheTTL: time.Hour, RefreshBackoff: 50 * time.Millisecond})
+	ctx := context.Background()
+
+	// Execute: cached keys are served without fetching
+	_, err := keySet.Key(ctx, "key-1")
+	require.NoError(t, err)
+	_, err = keySet.Key(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// An unknown key within the backoff does not fetch
+	_, err = keySet.Key(ctx, "key-2")
+	assert.ErrorIs(t, err, auth.ErrUnknownKey)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// After the backoff it does, and finds the rotated key
+	time.Sleep(60 * time.Millisecond)
+	key, err := keySet.Key(ctx, "key-2")
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", key.KeyID)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+// TestURLKeySet_Unavailable tests that cached keys survive a failing identity provider
+func TestURLKeySet_Unavailable(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	var fetches atomic.Int32
+	data := publicJWKS(t, "key-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fetches.Add(1) == 1 {
+			_, _ = w.Write(data)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	keySet := jwks.NewURLKeySet(server.URL, jwks.Config{CacheTTL: time.Millisecond, RefreshBackoff: time.Millisecond})
+	ctx := context.Background()
+
+	_, err := keySet.Key(ctx, "key-1")
+	require.NoError(t, err)
+
+	// Execute
+	time.Sleep(5 * time.Millisecond)
+	key, err := keySet.Key(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key.KeyID)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+// TestURLKeySet_NeverLoaded tests that an unreachable identity provider is reported as an error
+func TestURLKeySet_NeverLoaded(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	keySet := jwks.NewURLKeySet(server.URL, jwks.Config{})
+
+	// Execute
+	_, err := keySet.Key(context.Background(), "key-1")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, auth.ErrUnknownKey)
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+)
+
+// MigrateCarCatalog moves the cars of a schema created before the car model catalog onto
+// it. Each distinct model name of a tenant becomes a catalog entry with unspecified
+// attributes (see entity.LegacyCarModel), its cars are pointed at it and the model column
+// is dropped. The auto migration never drops columns, so it must run as the table owner
+// after it. It does nothing once the column is gone, and is safe to run repeatedly.
+func MigrateCarCatalog(ctx context.Context, client *entgen.Client, schemaName string) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err := migrateCarCatalog(ctx, tx.Client(), schemaName); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to migrate car catalog of %s: %w", schemaName, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit car catalog of %s: %w", schemaName, err)
+	}
+	return nil
+}
+
+// migrateCarCatalog runs MigrateCarCatalog within a transaction
+func migrateCarCatalog(ctx context.Context, client *entgen.Client, schemaName string) error {
+	rows, err := client.QueryContext(ctx, "SELECT 1 FROM information_schema.columns"+
+		" WHERE table_schema = $1 AND table_name = 'cars' AND column_name = 'model'", schemaName)
+	if err != nil {
+		return err
+	}
+	legacy := rows.Next()
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if !legacy {
+		return nil
+	}
+
+	cars := pgx.Identifier{schemaName, "cars"}.Sanitize()
+	carModels := pgx.Identifier{schemaName, "car_models"}.Sanitize()
+
+	rows, err = client.QueryContext(ctx, fmt.Sprintf(
+		"SELECT DISTINCT tenant_id, model FROM %s WHERE car_model_id IS NULL", cars))
+	if err != nil {
+		return err
+	}
+	var names [][2]string
+	for rows.Next() {
+		var tenantID, model string
+		if err := rows.Scan(&tenantID, &model); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		names = append(names, [2]string{tenantID, model})
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, name := range names {
+		model := entity.LegacyCarModel(name[0], name[1], now)
+		// Names differing only in spacing end up as the same entry
+		if _, err := client.ExecContext(ctx, fmt.Sprintf(
+			"INSERT INTO %s (id, tenant_id, make, name, category, seats, transmission, fuel_type, created_at, updated_at)"+
+				" VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9) ON CONFLICT DO NOTHING", carModels),
+			model.ID, model.TenantID, model.Spec.Make, model.Spec.Name, model.Spec.Category.String(),
+			model.Spec.Seats, model.Spec.Transmission.String(), model.Spec.FuelType.String(), now,
+		); err != nil {
+			return err
+		}
+		if _, err := client.ExecContext(ctx, fmt.Sprintf(
+			"UPDATE %s SET car_model_id = (SELECT id FROM %s WHERE tenant_id = $1 AND make = $2 AND name = $3)"+
+				" WHERE tenant_id = $1 AND model = $4 AND car_model_id IS NULL", cars, carModels),
+			model.TenantID, model.Spec.Make, model.Spec.Name, name[1],
+		); err != nil {
+			return err
+		}
+	}
+
+	// Dropping the column drops the unique index on the tenant and model name with it
+	_, err = client.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s DROP COLUMN model", cars))
+	return err
+}
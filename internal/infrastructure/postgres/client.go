@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log"
 	"os"
 	"strconv"
@@ -57,11 +58,24 @@ func getConnectionPoolSettings() (maxOpenConns, maxIdleConns int, maxLifetime ti
 func NewClient(databaseUrl string) *entgen.Client {
 	log.Printf("Connecting to database with connection string: %s", databaseUrl)
 
+	entClient, err := OpenClient(context.Background(), databaseUrl)
+	if err != nil {
+		log.Printf("Failed to connect to database: %v", err)
+		panic(err)
+	}
+
+	log.Printf("Successfully connected to database")
+
+	return entClient
+}
+
+// OpenClient creates a new Ent client with pgx driver, returning an error instead of
+// panicking when the database cannot be reached
+func OpenClient(ctx context.Context, databaseUrl string) (*entgen.Client, error) {
 	// Create database connection with pgx driver
 	db, err := sql.Open("pgx", databaseUrl)
 	if err != nil {
-		log.Printf("Failed to open SQL DB: %v", err)
-		panic(err)
+		return nil, fmt.Errorf("failed to open SQL DB: %w", err)
 	}
 
 	// Configure connection pool settings
@@ -74,19 +88,12 @@ func NewClient(databaseUrl string) *entgen.Client {
 		maxOpenConns, maxIdleConns, maxLifetime)
 
 	// Ping to verify connection
-	log.Printf("Pinging database...")
-	if err := db.PingContext(context.Background()); err != nil {
-		log.Printf("Failed to ping database: %v", err)
-		panic(err)
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	// Create Ent driver with the database connection
+	// Create Ent client with the database connection
 	drv := entsql.OpenDB(dialect.Postgres, db)
-
-	// Create Ent client with the driver
-	entClient := entgen.NewClient(entgen.Driver(drv))
-
-	log.Printf("Successfully connected to database")
-
-	return entClient
+	return entgen.NewClient(entgen.Driver(drv)), nil
 }
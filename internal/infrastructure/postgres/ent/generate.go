@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --target ../entgen --feature sql/lock ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --target ../entgen --feature sql/lock,sql/execquery ./schema
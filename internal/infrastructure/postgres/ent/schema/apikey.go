@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("prefix").
+			MaxLen(32).
+			NotEmpty(),
+		field.String("secret_hash").
+			MaxLen(64).
+			NotEmpty().
+			Sensitive(),
+		field.JSON("scopes", []string{}).
+			Optional(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("api_keys").
+			Field("tenant_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("prefix").
+			Unique(),
+		index.Fields("tenant_id", "created_at"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// ArchiveJob holds the schema definition for the ArchiveJob entity.
+type ArchiveJob struct {
+	ent.Schema
+}
+
+// Fields of the ArchiveJob.
+func (ArchiveJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		// kind is export or import
+		field.String("kind").
+			MaxLen(20).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+		field.String("archive").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("status").
+			MaxLen(20).
+			Default("running"),
+		field.JSON("counts", map[string]int{}).
+			Optional(),
+		field.String("error").
+			MaxLen(1000).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
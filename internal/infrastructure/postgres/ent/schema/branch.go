@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Branch holds the schema definition for the Branch entity.
+type Branch struct {
+	ent.Schema
+}
+
+// Fields of the Branch.
+func (Branch) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("street").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("city").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("region").
+			MaxLen(100).
+			Optional(),
+		field.String("postal_code").
+			MaxLen(20).
+			Optional(),
+		// country is an ISO 3166-1 alpha-2 code
+		field.String("country").
+			MaxLen(2).
+			NotEmpty(),
+		field.Float("latitude").
+			Min(-90).
+			Max(90),
+		field.Float("longitude").
+			Min(-180).
+			Max(180),
+		// opening_hours are on the tenant's clocks; without any, the tenant's business
+		// hours apply
+		field.JSON("opening_hours", []OpeningHours{}).
+			Optional(),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Branch.
+func (Branch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("branches").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.To("home_cars", Car.Type),
+		edge.To("current_cars", Car.Type),
+		edge.To("pickup_rentals", Rental.Type),
+		edge.To("return_rentals", Rental.Type),
+	}
+}
+
+// Indexes of the Branch.
+func (Branch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+		index.Fields("deleted_at"),
+		index.Fields("tenant_id"),
+	}
+}
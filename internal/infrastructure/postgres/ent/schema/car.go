@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -21,9 +22,35 @@ func (Car) Fields() []ent.Field {
 		field.String("tenant_id").
 			MaxLen(36).
 			NotEmpty(),
-		field.String("model").
-			MaxLen(255).
-			NotEmpty(),
+		// car_model_id is only empty for cars created before the catalog, until their model
+		// names are moved into it by the migration
+		field.String("car_model_id").
+			MaxLen(36).
+			Optional(),
+		field.String("vin").
+			MaxLen(17).
+			Optional().
+			Nillable(),
+		field.String("license_plate").
+			MaxLen(15).
+			Optional().
+			Nillable(),
+		// license_plate_country is the ISO 3166-1 alpha-2 code of the country that issued
+		// the license plate
+		field.String("license_plate_country").
+			MaxLen(2).
+			Optional().
+			Nillable(),
+		// home_branch_id is the branch the car belongs to and current_branch_id the one it
+		// is at; both are empty for cars without a branch
+		field.String("home_branch_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+		field.String("current_branch_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Optional(),
 		field.Time("updated_at").
@@ -42,15 +69,36 @@ func (Car) Edges() []ent.Edge {
 			Field("tenant_id").
 			Required().
 			Unique(),
+		edge.From("car_model", CarModel.Type).
+			Ref("cars").
+			Field("car_model_id").
+			Unique(),
+		edge.From("home_branch", Branch.Type).
+			Ref("home_cars").
+			Field("home_branch_id").
+			Unique(),
+		edge.From("current_branch", Branch.Type).
+			Ref("current_cars").
+			Field("current_branch_id").
+			Unique(),
 		edge.To("rentals", Rental.Type),
+		edge.To("maintenance_windows", MaintenanceWindow.Type),
 	}
 }
 
 // Indexes of the Car.
 func (Car) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("tenant_id", "model").
-			Unique(),
+		// A VIN or license plate identifies one live car of a tenant, so a deleted car's
+		// can be reused. Rows without them hold NULLs, which never conflict.
+		index.Fields("tenant_id", "vin").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		index.Fields("tenant_id", "license_plate_country", "license_plate").
+			Unique().
+			Annotations(entsql.IndexWhere("deleted_at IS NULL")),
+		index.Fields("car_model_id"),
+		index.Fields("current_branch_id"),
 		index.Fields("deleted_at"),
 		index.Fields("tenant_id"),
 	}
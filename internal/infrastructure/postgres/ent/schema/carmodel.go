@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CarModel holds the schema definition for the CarModel entity.
+type CarModel struct {
+	ent.Schema
+}
+
+// Fields of the CarModel.
+func (CarModel) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("make").
+			MaxLen(100).
+			NotEmpty(),
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		// category, transmission and fuel_type are "unspecified" for models moved into the
+		// catalog from the model names of existing cars
+		field.String("category").
+			MaxLen(20).
+			Default("unspecified"),
+		// Zero for models moved into the catalog from the model names of existing cars
+		field.Int("seats").
+			NonNegative().
+			Default(0),
+		field.String("transmission").
+			MaxLen(20).
+			Default("unspecified"),
+		field.String("fuel_type").
+			MaxLen(20).
+			Default("unspecified"),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the CarModel.
+func (CarModel) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("car_models").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.To("cars", Car.Type),
+	}
+}
+
+// Indexes of the CarModel.
+func (CarModel) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "make", "name").
+			Unique(),
+		index.Fields("deleted_at"),
+		index.Fields("tenant_id"),
+	}
+}
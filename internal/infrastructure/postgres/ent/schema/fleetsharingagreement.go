@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FleetSharingAgreement holds the schema definition for the FleetSharingAgreement entity.
+type FleetSharingAgreement struct {
+	ent.Schema
+}
+
+// Fields of the FleetSharingAgreement.
+func (FleetSharingAgreement) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		// lender_tenant_id is the tenant whose cars are shared
+		field.String("lender_tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		// borrower_tenant_id is the tenant that may book them
+		field.String("borrower_tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		// max_rental_days is zero for rentals of any length
+		field.Int("max_rental_days").
+			Default(0),
+		field.Int("owner_share_percent").
+			Default(0),
+		field.Time("valid_from"),
+		field.Time("valid_until").
+			Optional().
+			Nillable(),
+		field.Time("terminated_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+	}
+}
+
+// Indexes of the FleetSharingAgreement.
+func (FleetSharingAgreement) Indexes() []ent.Index {
+	return []ent.Index{
+		// One active agreement per lender and borrower. Row-level security also looks up
+		// the lenders of the current tenant on it on every query of cars and rentals.
+		index.Fields("borrower_tenant_id", "lender_tenant_id").
+			Unique().
+			Annotations(entsql.IndexWhere("terminated_at IS NULL")),
+		index.Fields("lender_tenant_id"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Inbox struct {
+	ent.Schema
+}
+
+// Fields of the Inbox.
+func (Inbox) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("source").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("message_id").
+			MaxLen(255).
+			NotEmpty(),
+		field.String("event_type").
+			MaxLen(255).
+			NotEmpty(),
+		field.JSON("payload", map[string]interface{}{}).
+			Optional(),
+		field.Time("received_at").
+			Optional(),
+		field.Time("processed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Inbox.
+func (Inbox) Indexes() []ent.Index {
+	return []ent.Index{
+		// Deduplicates messages: the same message ID may be reused by different sources
+		index.Fields("source", "message_id").
+			Unique(),
+		index.Fields("event_type"),
+		index.Fields("processed_at"),
+	}
+}
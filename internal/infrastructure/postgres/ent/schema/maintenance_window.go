@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// MaintenanceWindow holds the schema definition for the MaintenanceWindow entity.
+type MaintenanceWindow struct {
+	ent.Schema
+}
+
+// Fields of the MaintenanceWindow.
+func (MaintenanceWindow) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("car_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("type").
+			MaxLen(50).
+			NotEmpty(),
+		field.String("reason").
+			MaxLen(1000).
+			Optional(),
+		field.Time("starts_at"),
+		// ends_at is moved to the completion of a window completed early
+		field.Time("ends_at"),
+		// status is scheduled, completed or canceled; only canceled windows leave the car
+		// free to book
+		field.String("status").
+			MaxLen(50).
+			Default("scheduled"),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("canceled_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+	}
+}
+
+// Edges of the MaintenanceWindow.
+func (MaintenanceWindow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("maintenance_windows").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("car", Car.Type).
+			Ref("maintenance_windows").
+			Field("car_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the MaintenanceWindow.
+func (MaintenanceWindow) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("car_id", "starts_at"),
+		index.Fields("tenant_id"),
+	}
+}
@@ -16,6 +16,9 @@ func (Outbox) Fields() []ent.Field {
 		field.String("id").
 			MaxLen(36).
 			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			Optional(),
 		field.String("aggregate_type").
 			MaxLen(255).
 			NotEmpty(),
@@ -39,6 +42,11 @@ func (Outbox) Fields() []ent.Field {
 			MaxLen(1000).
 			Optional().
 			Nillable(),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable(),
 		field.Int64("version").
 			Default(1),
 		field.Time("locked_at").
@@ -54,6 +62,8 @@ func (Outbox) Fields() []ent.Field {
 func (Outbox) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("status"),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("tenant_id", "created_at"),
 		index.Fields("created_at"),
 		index.Fields("processed_at"),
 		index.Fields("version"),
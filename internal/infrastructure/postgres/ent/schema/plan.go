@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Plan holds the schema definition for the Plan entity.
+type Plan struct {
+	ent.Schema
+}
+
+// Fields of the Plan.
+func (Plan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("code").
+			MaxLen(50).
+			NotEmpty(),
+		field.String("name").
+			MaxLen(255).
+			NotEmpty(),
+		// Zero means unlimited
+		field.Int("max_cars").
+			NonNegative().
+			Default(0),
+		field.Int("max_renters").
+			NonNegative().
+			Default(0),
+		field.Int("max_monthly_rentals").
+			NonNegative().
+			Default(0),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+	}
+}
+
+// Edges of the Plan.
+func (Plan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tenants", Tenant.Type),
+	}
+}
+
+// Indexes of the Plan.
+func (Plan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").
+			Unique(),
+	}
+}
@@ -27,10 +27,30 @@ func (Rental) Fields() []ent.Field {
 		field.String("renter_id").
 			MaxLen(36).
 			NotEmpty(),
+		// owner_tenant_id is the tenant owning the car, which differs from tenant_id, the
+		// booking tenant, for cars shared under a fleet sharing agreement. Rentals booked
+		// before fleet sharing have none and belong to tenant_id.
+		field.String("owner_tenant_id").
+			MaxLen(36).
+			Optional(),
+		// pickup_branch_id and return_branch_id are branches of the owner of the car, and
+		// empty for rentals of cars without a branch
+		field.String("pickup_branch_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+		field.String("return_branch_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
 		field.Time("starts_at").
 			Optional(),
 		field.Time("ends_at").
 			Optional(),
+		// returned_at is when the car was brought back
+		field.Time("returned_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Optional(),
 		field.Time("updated_at").
@@ -59,7 +79,16 @@ func (Rental) Edges() []ent.Edge {
 			Field("renter_id").
 			Required().
 			Unique(),
+		edge.From("pickup_branch", Branch.Type).
+			Ref("pickup_rentals").
+			Field("pickup_branch_id").
+			Unique(),
+		edge.From("return_branch", Branch.Type).
+			Ref("return_rentals").
+			Field("return_branch_id").
+			Unique(),
 		edge.To("rental_options", RentalOption.Type),
+		edge.To("handovers", RentalHandover.Type),
 	}
 }
 
@@ -70,5 +99,6 @@ func (Rental) Indexes() []ent.Index {
 		index.Fields("deleted_at"),
 		index.Fields("renter_id"),
 		index.Fields("tenant_id"),
+		index.Fields("owner_tenant_id"),
 	}
 }
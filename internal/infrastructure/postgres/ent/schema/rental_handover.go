@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// HandoverDamage is a stored entry of the damage checklist of a handover
+type HandoverDamage struct {
+	Area        string `json:"area"`
+	Description string `json:"description,omitempty"`
+}
+
+// RentalHandover holds the schema definition for the RentalHandover entity.
+type RentalHandover struct {
+	ent.Schema
+}
+
+// Fields of the RentalHandover.
+func (RentalHandover) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		// tenant_id is the tenant that booked the rental
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("rental_id").
+			MaxLen(36).
+			NotEmpty(),
+		// kind is pickup or return
+		field.String("kind").
+			MaxLen(50).
+			NotEmpty(),
+		field.Int("odometer_km").
+			Min(0),
+		// fuel_level is the fuel or charge level in percent
+		field.Int("fuel_level").
+			Range(0, 100),
+		field.JSON("damages", []HandoverDamage{}).
+			Optional(),
+		// distance_km and fuel_shortfall compare a return with the pickup; both are zero
+		// for pickups
+		field.Int("distance_km").
+			Min(0).
+			Default(0),
+		field.Int("fuel_shortfall").
+			Range(0, 100).
+			Default(0),
+		field.Time("inspected_at"),
+		field.Time("created_at").
+			Optional(),
+	}
+}
+
+// Edges of the RentalHandover.
+func (RentalHandover) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("rental_handovers").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("rental", Rental.Type).
+			Ref("handovers").
+			Field("rental_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RentalHandover.
+func (RentalHandover) Indexes() []ent.Index {
+	return []ent.Index{
+		// A rental is handed over once at pickup and once at return
+		index.Fields("rental_id", "kind").
+			Unique(),
+		index.Fields("tenant_id"),
+	}
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -21,6 +22,29 @@ func (Tenant) Fields() []ent.Field {
 		field.String("code").
 			MaxLen(50).
 			NotEmpty(),
+		field.String("status").
+			MaxLen(50).
+			Default("active"),
+		field.Time("suspended_at").
+			Optional().
+			Nillable(),
+		field.String("plan_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+		// isolation is shared, schema or database
+		field.String("isolation").
+			MaxLen(20).
+			Default("shared"),
+		// parent_id is the franchise parent of a child tenant
+		field.String("parent_id").
+			MaxLen(36).
+			Optional().
+			Nillable(),
+		// purge_at is when a tenant pending deletion is purged
+		field.Time("purge_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Optional(),
 		field.Time("updated_at").
@@ -34,13 +58,32 @@ func (Tenant) Fields() []ent.Field {
 // Edges of the Tenant.
 func (Tenant) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("plan", Plan.Type).
+			Ref("tenants").
+			Field("plan_id").
+			Unique(),
+		// Children outlive a purged parent as standalone tenants
+		edge.To("children", Tenant.Type).
+			From("parent").
+			Field("parent_id").
+			Unique().
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+		edge.To("api_keys", APIKey.Type),
+		edge.To("branches", Branch.Type),
 		edge.To("cars", Car.Type),
+		edge.To("car_models", CarModel.Type),
 		edge.To("companies", Company.Type),
 		edge.To("individuals", Individual.Type),
+		edge.To("maintenance_windows", MaintenanceWindow.Type),
 		edge.To("options", CarOption.Type),
+		edge.To("rental_handovers", RentalHandover.Type),
 		edge.To("rental_options", RentalOption.Type),
 		edge.To("rentals", Rental.Type),
 		edge.To("renters", Renter.Type),
+		edge.To("settings", TenantSetting.Type).
+			Unique(),
+		edge.To("webhook_endpoints", WebhookEndpoint.Type),
+		edge.To("webhook_deliveries", WebhookDelivery.Type),
 	}
 }
 
@@ -50,5 +93,8 @@ func (Tenant) Indexes() []ent.Index {
 		index.Fields("code").
 			Unique(),
 		index.Fields("deleted_at"),
+		index.Fields("plan_id"),
+		index.Fields("parent_id"),
+		index.Fields("purge_at"),
 	}
 }
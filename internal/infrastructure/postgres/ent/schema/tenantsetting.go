@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHours is a stored period of the business hours of a tenant
+type OpeningHours struct {
+	Weekday int    `json:"weekday"`
+	Opens   string `json:"opens"`
+	Closes  string `json:"closes"`
+}
+
+// TenantSetting holds the schema definition for the TenantSetting entity.
+type TenantSetting struct {
+	ent.Schema
+}
+
+// Fields of the TenantSetting.
+func (TenantSetting) Fields() []ent.Field {
+	return []ent.Field{
+		// Settings are one per tenant and share its ID
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty().
+			Unique(),
+		field.String("timezone").
+			MaxLen(64).
+			NotEmpty(),
+		field.String("currency").
+			MaxLen(3).
+			NotEmpty(),
+		field.String("locale").
+			MaxLen(35).
+			NotEmpty(),
+		field.JSON("business_hours", []OpeningHours{}).
+			Optional(),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+	}
+}
+
+// Edges of the TenantSetting.
+func (TenantSetting) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("settings").
+			Field("tenant_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UsageRecord holds the schema definition for the UsageRecord entity.
+type UsageRecord struct {
+	ent.Schema
+}
+
+// Fields of the UsageRecord.
+func (UsageRecord) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the ID of the outbox message the usage was recorded from, so that a
+		// message relayed twice is counted once
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("meter").
+			MaxLen(50).
+			NotEmpty(),
+		field.Int64("quantity"),
+		field.Time("occurred_at"),
+		field.Time("recorded_at").
+			Optional(),
+	}
+}
+
+// Indexes of the UsageRecord.
+func (UsageRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("recorded_at"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UsageRollup holds the schema definition for the UsageRollup entity.
+type UsageRollup struct {
+	ent.Schema
+}
+
+// Fields of the UsageRollup.
+func (UsageRollup) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("meter").
+			MaxLen(50).
+			NotEmpty(),
+		// period is day or month
+		field.String("period").
+			MaxLen(10).
+			NotEmpty(),
+		// period_start is midnight UTC of the first day of the period
+		field.Time("period_start"),
+		field.Int64("quantity").
+			Default(0),
+		field.Time("updated_at").
+			Optional(),
+	}
+}
+
+// Indexes of the UsageRollup.
+func (UsageRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		// One rollup per tenant, meter and period; usage is added with upserts on it
+		index.Fields("tenant_id", "period", "period_start", "meter").
+			Unique(),
+	}
+}
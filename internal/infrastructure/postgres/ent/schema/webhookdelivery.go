@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("endpoint_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("event_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("event_type").
+			MaxLen(255).
+			NotEmpty(),
+		field.JSON("payload", map[string]interface{}{}).
+			Optional(),
+		field.String("status").
+			MaxLen(50).
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable(),
+		field.Time("last_attempt_at").
+			Optional().
+			Nillable(),
+		field.Int("response_status").
+			Optional().
+			Nillable(),
+		field.String("last_error").
+			MaxLen(1000).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("webhook_deliveries").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.From("endpoint", WebhookEndpoint.Type).
+			Ref("deliveries").
+			Field("endpoint_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("endpoint_id", "event_id").
+			Unique(),
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("tenant_id", "created_at"),
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookEndpoint holds the schema definition for the WebhookEndpoint entity.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("tenant_id").
+			MaxLen(36).
+			NotEmpty(),
+		field.String("url").
+			MaxLen(2048).
+			NotEmpty(),
+		field.String("secret").
+			MaxLen(255).
+			NotEmpty().
+			Sensitive(),
+		field.JSON("event_types", []string{}),
+		field.String("description").
+			MaxLen(255).
+			Optional(),
+		field.Bool("enabled").
+			Default(true),
+		field.Int("consecutive_failures").
+			Default(0),
+		field.Time("disabled_at").
+			Optional().
+			Nillable(),
+		field.String("disabled_reason").
+			MaxLen(255).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Optional(),
+		field.Time("updated_at").
+			Optional(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the WebhookEndpoint.
+func (WebhookEndpoint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).
+			Ref("webhook_endpoints").
+			Field("tenant_id").
+			Required().
+			Unique(),
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+// Indexes of the WebhookEndpoint.
+func (WebhookEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "enabled"),
+		index.Fields("deleted_at"),
+	}
+}
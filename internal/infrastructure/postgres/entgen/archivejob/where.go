@@ -0,0 +1,625 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivejob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContainsFold(FieldID, id))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldKind, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Archive applies equality check predicate on the "archive" field. It's identical to ArchiveEQ.
+func Archive(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldArchive, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContainsFold(FieldKind, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotNull(FieldTenantID))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// ArchiveEQ applies the EQ predicate on the "archive" field.
+func ArchiveEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldArchive, v))
+}
+
+// ArchiveNEQ applies the NEQ predicate on the "archive" field.
+func ArchiveNEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldArchive, v))
+}
+
+// ArchiveIn applies the In predicate on the "archive" field.
+func ArchiveIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldArchive, vs...))
+}
+
+// ArchiveNotIn applies the NotIn predicate on the "archive" field.
+func ArchiveNotIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldArchive, vs...))
+}
+
+// ArchiveGT applies the GT predicate on the "archive" field.
+func ArchiveGT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldArchive, v))
+}
+
+// ArchiveGTE applies the GTE predicate on the "archive" field.
+func ArchiveGTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldArchive, v))
+}
+
+// ArchiveLT applies the LT predicate on the "archive" field.
+func ArchiveLT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldArchive, v))
+}
+
+// ArchiveLTE applies the LTE predicate on the "archive" field.
+func ArchiveLTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldArchive, v))
+}
+
+// ArchiveContains applies the Contains predicate on the "archive" field.
+func ArchiveContains(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContains(FieldArchive, v))
+}
+
+// ArchiveHasPrefix applies the HasPrefix predicate on the "archive" field.
+func ArchiveHasPrefix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasPrefix(FieldArchive, v))
+}
+
+// ArchiveHasSuffix applies the HasSuffix predicate on the "archive" field.
+func ArchiveHasSuffix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasSuffix(FieldArchive, v))
+}
+
+// ArchiveEqualFold applies the EqualFold predicate on the "archive" field.
+func ArchiveEqualFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEqualFold(FieldArchive, v))
+}
+
+// ArchiveContainsFold applies the ContainsFold predicate on the "archive" field.
+func ArchiveContainsFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContainsFold(FieldArchive, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CountsIsNil applies the IsNil predicate on the "counts" field.
+func CountsIsNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIsNull(FieldCounts))
+}
+
+// CountsNotNil applies the NotNil predicate on the "counts" field.
+func CountsNotNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotNull(FieldCounts))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArchiveJob) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArchiveJob) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArchiveJob) predicate.ArchiveJob {
+	return predicate.ArchiveJob(sql.NotPredicates(p))
+}
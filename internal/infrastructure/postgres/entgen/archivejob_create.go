@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/archivejob"
+)
+
+// ArchiveJobCreate is the builder for creating a ArchiveJob entity.
+type ArchiveJobCreate struct {
+	config
+	mutation *ArchiveJobMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (_c *ArchiveJobCreate) SetKind(v string) *ArchiveJobCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ArchiveJobCreate) SetTenantID(v string) *ArchiveJobCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ArchiveJobCreate) SetNillableTenantID(v *string) *ArchiveJobCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetArchive sets the "archive" field.
+func (_c *ArchiveJobCreate) SetArchive(v string) *ArchiveJobCreate {
+	_c.mutation.SetArchive(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ArchiveJobCreate) SetStatus(v string) *ArchiveJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ArchiveJobCreate) SetNillableStatus(v *string) *ArchiveJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCounts sets the "counts" field.
+func (_c *ArchiveJobCreate) SetCounts(v map[string]int) *ArchiveJobCreate {
+	_c.mutation.SetCounts(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *ArchiveJobCreate) SetError(v string) *ArchiveJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *ArchiveJobCreate) SetNillableError(v *string) *ArchiveJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ArchiveJobCreate) SetCreatedAt(v time.Time) *ArchiveJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ArchiveJobCreate) SetNillableCreatedAt(v *time.Time) *ArchiveJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ArchiveJobCreate) SetUpdatedAt(v time.Time) *ArchiveJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ArchiveJobCreate) SetNillableUpdatedAt(v *time.Time) *ArchiveJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *ArchiveJobCreate) SetFinishedAt(v time.Time) *ArchiveJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *ArchiveJobCreate) SetNillableFinishedAt(v *time.Time) *ArchiveJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ArchiveJobCreate) SetID(v string) *ArchiveJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ArchiveJobMutation object of the builder.
+func (_c *ArchiveJobCreate) Mutation() *ArchiveJobMutation {
+	return _c.mutation
+}
+
+// Save creates the ArchiveJob in the database.
+func (_c *ArchiveJobCreate) Save(ctx context.Context) (*ArchiveJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ArchiveJobCreate) SaveX(ctx context.Context) *ArchiveJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchiveJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchiveJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ArchiveJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := archivejob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ArchiveJobCreate) check() error {
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`entgen: missing required field "ArchiveJob.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := archivejob.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.kind": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := archivejob.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Archive(); !ok {
+		return &ValidationError{Name: "archive", err: errors.New(`entgen: missing required field "ArchiveJob.archive"`)}
+	}
+	if v, ok := _c.mutation.Archive(); ok {
+		if err := archivejob.ArchiveValidator(v); err != nil {
+			return &ValidationError{Name: "archive", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.archive": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entgen: missing required field "ArchiveJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := archivejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Error(); ok {
+		if err := archivejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.error": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := archivejob.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ArchiveJobCreate) sqlSave(ctx context.Context) (*ArchiveJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ArchiveJob.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ArchiveJobCreate) createSpec() (*ArchiveJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArchiveJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(archivejob.Table, sqlgraph.NewFieldSpec(archivejob.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(archivejob.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(archivejob.FieldTenantID, field.TypeString, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Archive(); ok {
+		_spec.SetField(archivejob.FieldArchive, field.TypeString, value)
+		_node.Archive = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(archivejob.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Counts(); ok {
+		_spec.SetField(archivejob.FieldCounts, field.TypeJSON, value)
+		_node.Counts = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(archivejob.FieldError, field.TypeString, value)
+		_node.Error = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(archivejob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(archivejob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(archivejob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// ArchiveJobCreateBulk is the builder for creating many ArchiveJob entities in bulk.
+type ArchiveJobCreateBulk struct {
+	config
+	err      error
+	builders []*ArchiveJobCreate
+}
+
+// Save creates the ArchiveJob entities in the database.
+func (_c *ArchiveJobCreateBulk) Save(ctx context.Context) ([]*ArchiveJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ArchiveJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchiveJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ArchiveJobCreateBulk) SaveX(ctx context.Context) []*ArchiveJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchiveJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchiveJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,640 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/archivejob"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// ArchiveJobUpdate is the builder for updating ArchiveJob entities.
+type ArchiveJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArchiveJobMutation
+}
+
+// Where appends a list predicates to the ArchiveJobUpdate builder.
+func (_u *ArchiveJobUpdate) Where(ps ...predicate.ArchiveJob) *ArchiveJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ArchiveJobUpdate) SetKind(v string) *ArchiveJobUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ArchiveJobUpdate) SetNillableKind(v *string) *ArchiveJobUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ArchiveJobUpdate) SetTenantID(v string) *ArchiveJobUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ArchiveJobUpdate) SetNillableTenantID(v *string) *ArchiveJobUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (_u *ArchiveJobUpdate) ClearTenantID() *ArchiveJobUpdate {
+	_u.mutation.ClearTenantID()
+	return _u
+}
+
+// SetArchive sets the "archive" field.
+func (_u *ArchiveJobUpdate) SetArchive(v string) *ArchiveJobUpdate {
+	_u.mutation.SetArchive(v)
+	return _u
+}
+
+// SetNillableArchive sets the "archive" field if the given value is not nil.
+func (_u *ArchiveJobUpdate) SetNillableArchive(v *string) *ArchiveJobUpdate {
+	if v != nil {
+		_u.SetArchive(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ArchiveJobUpdate) SetStatus(v string) *ArchiveJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ArchiveJobUpdate) SetNillableStatus(v *string) *ArchiveJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCounts sets the "counts" field.
+func (_u *ArchiveJobUpdate) SetCounts(v map[string]int) *ArchiveJobUpdate {
+	_u.mutation.SetCounts(v)
+	return _u
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (_u *ArchiveJobUpdate) ClearCounts() *ArchiveJobUpdate {
+	_u.mutation.ClearCounts()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ArchiveJobUpdate) SetError(v string) *ArchiveJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ArchiveJobUpdate) SetNillableError(v *string) *ArchiveJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ArchiveJobUpdate) ClearError() *ArchiveJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ArchiveJobUpdate) SetCreatedAt(v time.Time) *ArchiveJobUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ArchiveJobUpdate) SetNillableCreatedAt(v *time.Time) *ArchiveJobUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *ArchiveJobUpdate) ClearCreatedAt() *ArchiveJobUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ArchiveJobUpdate) SetUpdatedAt(v time.Time) *ArchiveJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ArchiveJobUpdate) SetNillableUpdatedAt(v *time.Time) *ArchiveJobUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ArchiveJobUpdate) ClearUpdatedAt() *ArchiveJobUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ArchiveJobUpdate) SetFinishedAt(v time.Time) *ArchiveJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ArchiveJobUpdate) SetNillableFinishedAt(v *time.Time) *ArchiveJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ArchiveJobUpdate) ClearFinishedAt() *ArchiveJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the ArchiveJobMutation object of the builder.
+func (_u *ArchiveJobUpdate) Mutation() *ArchiveJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ArchiveJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchiveJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ArchiveJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchiveJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArchiveJobUpdate) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := archivejob.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := archivejob.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Archive(); ok {
+		if err := archivejob.ArchiveValidator(v); err != nil {
+			return &ValidationError{Name: "archive", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.archive": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := archivejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := archivejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ArchiveJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivejob.Table, archivejob.Columns, sqlgraph.NewFieldSpec(archivejob.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(archivejob.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(archivejob.FieldTenantID, field.TypeString, value)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(archivejob.FieldTenantID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Archive(); ok {
+		_spec.SetField(archivejob.FieldArchive, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(archivejob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Counts(); ok {
+		_spec.SetField(archivejob.FieldCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.CountsCleared() {
+		_spec.ClearField(archivejob.FieldCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(archivejob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(archivejob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(archivejob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(archivejob.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(archivejob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(archivejob.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(archivejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(archivejob.FieldFinishedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ArchiveJobUpdateOne is the builder for updating a single ArchiveJob entity.
+type ArchiveJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArchiveJobMutation
+}
+
+// SetKind sets the "kind" field.
+func (_u *ArchiveJobUpdateOne) SetKind(v string) *ArchiveJobUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ArchiveJobUpdateOne) SetNillableKind(v *string) *ArchiveJobUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *ArchiveJobUpdateOne) SetTenantID(v string) *ArchiveJobUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *ArchiveJobUpdateOne) SetNillableTenantID(v *string) *ArchiveJobUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (_u *ArchiveJobUpdateOne) ClearTenantID() *ArchiveJobUpdateOne {
+	_u.mutation.ClearTenantID()
+	return _u
+}
+
+// SetArchive sets the "archive" field.
+func (_u *ArchiveJobUpdateOne) SetArchive(v string) *ArchiveJobUpdateOne {
+	_u.mutation.SetArchive(v)
+	return _u
+}
+
+// SetNillableArchive sets the "archive" field if the given value is not nil.
+func (_u *ArchiveJobUpdateOne) SetNillableArchive(v *string) *ArchiveJobUpdateOne {
+	if v != nil {
+		_u.SetArchive(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ArchiveJobUpdateOne) SetStatus(v string) *ArchiveJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ArchiveJobUpdateOne) SetNillableStatus(v *string) *ArchiveJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCounts sets the "counts" field.
+func (_u *ArchiveJobUpdateOne) SetCounts(v map[string]int) *ArchiveJobUpdateOne {
+	_u.mutation.SetCounts(v)
+	return _u
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (_u *ArchiveJobUpdateOne) ClearCounts() *ArchiveJobUpdateOne {
+	_u.mutation.ClearCounts()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *ArchiveJobUpdateOne) SetError(v string) *ArchiveJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *ArchiveJobUpdateOne) SetNillableError(v *string) *ArchiveJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *ArchiveJobUpdateOne) ClearError() *ArchiveJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ArchiveJobUpdateOne) SetCreatedAt(v time.Time) *ArchiveJobUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ArchiveJobUpdateOne) SetNillableCreatedAt(v *time.Time) *ArchiveJobUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *ArchiveJobUpdateOne) ClearCreatedAt() *ArchiveJobUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ArchiveJobUpdateOne) SetUpdatedAt(v time.Time) *ArchiveJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ArchiveJobUpdateOne) SetNillableUpdatedAt(v *time.Time) *ArchiveJobUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ArchiveJobUpdateOne) ClearUpdatedAt() *ArchiveJobUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *ArchiveJobUpdateOne) SetFinishedAt(v time.Time) *ArchiveJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *ArchiveJobUpdateOne) SetNillableFinishedAt(v *time.Time) *ArchiveJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *ArchiveJobUpdateOne) ClearFinishedAt() *ArchiveJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the ArchiveJobMutation object of the builder.
+func (_u *ArchiveJobUpdateOne) Mutation() *ArchiveJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ArchiveJobUpdate builder.
+func (_u *ArchiveJobUpdateOne) Where(ps ...predicate.ArchiveJob) *ArchiveJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ArchiveJobUpdateOne) Select(field string, fields ...string) *ArchiveJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ArchiveJob entity.
+func (_u *ArchiveJobUpdateOne) Save(ctx context.Context) (*ArchiveJob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchiveJobUpdateOne) SaveX(ctx context.Context) *ArchiveJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ArchiveJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchiveJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArchiveJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := archivejob.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := archivejob.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Archive(); ok {
+		if err := archivejob.ArchiveValidator(v); err != nil {
+			return &ValidationError{Name: "archive", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.archive": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := archivejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Error(); ok {
+		if err := archivejob.ErrorValidator(v); err != nil {
+			return &ValidationError{Name: "error", err: fmt.Errorf(`entgen: validator failed for field "ArchiveJob.error": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ArchiveJobUpdateOne) sqlSave(ctx context.Context) (_node *ArchiveJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivejob.Table, archivejob.Columns, sqlgraph.NewFieldSpec(archivejob.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "ArchiveJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivejob.FieldID)
+		for _, f := range fields {
+			if !archivejob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != archivejob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(archivejob.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(archivejob.FieldTenantID, field.TypeString, value)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(archivejob.FieldTenantID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Archive(); ok {
+		_spec.SetField(archivejob.FieldArchive, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(archivejob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Counts(); ok {
+		_spec.SetField(archivejob.FieldCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.CountsCleared() {
+		_spec.ClearField(archivejob.FieldCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(archivejob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(archivejob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(archivejob.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(archivejob.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(archivejob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(archivejob.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(archivejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(archivejob.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &ArchiveJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
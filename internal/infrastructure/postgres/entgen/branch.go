@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// Branch is the model entity for the Branch schema.
+type Branch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Street holds the value of the "street" field.
+	Street string `json:"street,omitempty"`
+	// City holds the value of the "city" field.
+	City string `json:"city,omitempty"`
+	// Region holds the value of the "region" field.
+	Region string `json:"region,omitempty"`
+	// PostalCode holds the value of the "postal_code" field.
+	PostalCode string `json:"postal_code,omitempty"`
+	// Country holds the value of the "country" field.
+	Country string `json:"country,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude float64 `json:"longitude,omitempty"`
+	// OpeningHours holds the value of the "opening_hours" field.
+	OpeningHours []schema.OpeningHours `json:"opening_hours,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BranchQuery when eager-loading is set.
+	Edges        BranchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BranchEdges holds the relations/edges for other nodes in the graph.
+type BranchEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// HomeCars holds the value of the home_cars edge.
+	HomeCars []*Car `json:"home_cars,omitempty"`
+	// CurrentCars holds the value of the current_cars edge.
+	CurrentCars []*Car `json:"current_cars,omitempty"`
+	// PickupRentals holds the value of the pickup_rentals edge.
+	PickupRentals []*Rental `json:"pickup_rentals,omitempty"`
+	// ReturnRentals holds the value of the return_rentals edge.
+	ReturnRentals []*Rental `json:"return_rentals,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BranchEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// HomeCarsOrErr returns the HomeCars value or an error if the edge
+// was not loaded in eager-loading.
+func (e BranchEdges) HomeCarsOrErr() ([]*Car, error) {
+	if e.loadedTypes[1] {
+		return e.HomeCars, nil
+	}
+	return nil, &NotLoadedError{edge: "home_cars"}
+}
+
+// CurrentCarsOrErr returns the CurrentCars value or an error if the edge
+// was not loaded in eager-loading.
+func (e BranchEdges) CurrentCarsOrErr() ([]*Car, error) {
+	if e.loadedTypes[2] {
+		return e.CurrentCars, nil
+	}
+	return nil, &NotLoadedError{edge: "current_cars"}
+}
+
+// PickupRentalsOrErr returns the PickupRentals value or an error if the edge
+// was not loaded in eager-loading.
+func (e BranchEdges) PickupRentalsOrErr() ([]*Rental, error) {
+	if e.loadedTypes[3] {
+		return e.PickupRentals, nil
+	}
+	return nil, &NotLoadedError{edge: "pickup_rentals"}
+}
+
+// ReturnRentalsOrErr returns the ReturnRentals value or an error if the edge
+// was not loaded in eager-loading.
+func (e BranchEdges) ReturnRentalsOrErr() ([]*Rental, error) {
+	if e.loadedTypes[4] {
+		return e.ReturnRentals, nil
+	}
+	return nil, &NotLoadedError{edge: "return_rentals"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Branch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case branch.FieldOpeningHours:
+			values[i] = new([]byte)
+		case branch.FieldLatitude, branch.FieldLongitude:
+			values[i] = new(sql.NullFloat64)
+		case branch.FieldID, branch.FieldTenantID, branch.FieldName, branch.FieldStreet, branch.FieldCity, branch.FieldRegion, branch.FieldPostalCode, branch.FieldCountry:
+			values[i] = new(sql.NullString)
+		case branch.FieldCreatedAt, branch.FieldUpdatedAt, branch.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Branch fields.
+func (_m *Branch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case branch.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case branch.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case branch.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case branch.FieldStreet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field street", values[i])
+			} else if value.Valid {
+				_m.Street = value.String
+			}
+		case branch.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				_m.City = value.String
+			}
+		case branch.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				_m.Region = value.String
+			}
+		case branch.FieldPostalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field postal_code", values[i])
+			} else if value.Valid {
+				_m.PostalCode = value.String
+			}
+		case branch.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case branch.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				_m.Latitude = value.Float64
+			}
+		case branch.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				_m.Longitude = value.Float64
+			}
+		case branch.FieldOpeningHours:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field opening_hours", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OpeningHours); err != nil {
+					return fmt.Errorf("unmarshal field opening_hours: %w", err)
+				}
+			}
+		case branch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case branch.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case branch.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Branch.
+// This includes values selected through modifiers, order, etc.
+func (_m *Branch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the Branch entity.
+func (_m *Branch) QueryTenant() *TenantQuery {
+	return NewBranchClient(_m.config).QueryTenant(_m)
+}
+
+// QueryHomeCars queries the "home_cars" edge of the Branch entity.
+func (_m *Branch) QueryHomeCars() *CarQuery {
+	return NewBranchClient(_m.config).QueryHomeCars(_m)
+}
+
+// QueryCurrentCars queries the "current_cars" edge of the Branch entity.
+func (_m *Branch) QueryCurrentCars() *CarQuery {
+	return NewBranchClient(_m.config).QueryCurrentCars(_m)
+}
+
+// QueryPickupRentals queries the "pickup_rentals" edge of the Branch entity.
+func (_m *Branch) QueryPickupRentals() *RentalQuery {
+	return NewBranchClient(_m.config).QueryPickupRentals(_m)
+}
+
+// QueryReturnRentals queries the "return_rentals" edge of the Branch entity.
+func (_m *Branch) QueryReturnRentals() *RentalQuery {
+	return NewBranchClient(_m.config).QueryReturnRentals(_m)
+}
+
+// Update returns a builder for updating this Branch.
+// Note that you need to call Branch.Unwrap() before calling this method if this Branch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Branch) Update() *BranchUpdateOne {
+	return NewBranchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Branch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Branch) Unwrap() *Branch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: Branch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Branch) String() string {
+	var builder strings.Builder
+	builder.WriteString("Branch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("street=")
+	builder.WriteString(_m.Street)
+	builder.WriteString(", ")
+	builder.WriteString("city=")
+	builder.WriteString(_m.City)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(_m.Region)
+	builder.WriteString(", ")
+	builder.WriteString("postal_code=")
+	builder.WriteString(_m.PostalCode)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	builder.WriteString("latitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Latitude))
+	builder.WriteString(", ")
+	builder.WriteString("longitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Longitude))
+	builder.WriteString(", ")
+	builder.WriteString("opening_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpeningHours))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Branches is a parsable slice of Branch.
+type Branches []*Branch
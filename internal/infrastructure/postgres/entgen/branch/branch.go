@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package branch
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the branch type in the database.
+	Label = "branch"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStreet holds the string denoting the street field in the database.
+	FieldStreet = "street"
+	// FieldCity holds the string denoting the city field in the database.
+	FieldCity = "city"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldPostalCode holds the string denoting the postal_code field in the database.
+	FieldPostalCode = "postal_code"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldOpeningHours holds the string denoting the opening_hours field in the database.
+	FieldOpeningHours = "opening_hours"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeHomeCars holds the string denoting the home_cars edge name in mutations.
+	EdgeHomeCars = "home_cars"
+	// EdgeCurrentCars holds the string denoting the current_cars edge name in mutations.
+	EdgeCurrentCars = "current_cars"
+	// EdgePickupRentals holds the string denoting the pickup_rentals edge name in mutations.
+	EdgePickupRentals = "pickup_rentals"
+	// EdgeReturnRentals holds the string denoting the return_rentals edge name in mutations.
+	EdgeReturnRentals = "return_rentals"
+	// Table holds the table name of the branch in the database.
+	Table = "branches"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "branches"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_id"
+	// HomeCarsTable is the table that holds the home_cars relation/edge.
+	HomeCarsTable = "cars"
+	// HomeCarsInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	HomeCarsInverseTable = "cars"
+	// HomeCarsColumn is the table column denoting the home_cars relation/edge.
+	HomeCarsColumn = "home_branch_id"
+	// CurrentCarsTable is the table that holds the current_cars relation/edge.
+	CurrentCarsTable = "cars"
+	// CurrentCarsInverseTable is the table name for the Car entity.
+	// It exists in this package in order to avoid circular dependency with the "car" package.
+	CurrentCarsInverseTable = "cars"
+	// CurrentCarsColumn is the table column denoting the current_cars relation/edge.
+	CurrentCarsColumn = "current_branch_id"
+	// PickupRentalsTable is the table that holds the pickup_rentals relation/edge.
+	PickupRentalsTable = "rentals"
+	// PickupRentalsInverseTable is the table name for the Rental entity.
+	// It exists in this package in order to avoid circular dependency with the "rental" package.
+	PickupRentalsInverseTable = "rentals"
+	// PickupRentalsColumn is the table column denoting the pickup_rentals relation/edge.
+	PickupRentalsColumn = "pickup_branch_id"
+	// ReturnRentalsTable is the table that holds the return_rentals relation/edge.
+	ReturnRentalsTable = "rentals"
+	// ReturnRentalsInverseTable is the table name for the Rental entity.
+	// It exists in this package in order to avoid circular dependency with the "rental" package.
+	ReturnRentalsInverseTable = "rentals"
+	// ReturnRentalsColumn is the table column denoting the return_rentals relation/edge.
+	ReturnRentalsColumn = "return_branch_id"
+)
+
+// Columns holds all SQL columns for branch fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldName,
+	FieldStreet,
+	FieldCity,
+	FieldRegion,
+	FieldPostalCode,
+	FieldCountry,
+	FieldLatitude,
+	FieldLongitude,
+	FieldOpeningHours,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// StreetValidator is a validator for the "street" field. It is called by the builders before save.
+	StreetValidator func(string) error
+	// CityValidator is a validator for the "city" field. It is called by the builders before save.
+	CityValidator func(string) error
+	// RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	RegionValidator func(string) error
+	// PostalCodeValidator is a validator for the "postal_code" field. It is called by the builders before save.
+	PostalCodeValidator func(string) error
+	// CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	CountryValidator func(string) error
+	// LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	LatitudeValidator func(float64) error
+	// LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	LongitudeValidator func(float64) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Branch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStreet orders the results by the street field.
+func ByStreet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStreet, opts...).ToFunc()
+}
+
+// ByCity orders the results by the city field.
+func ByCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCity, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
+// ByPostalCode orders the results by the postal_code field.
+func ByPostalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostalCode, opts...).ToFunc()
+}
+
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHomeCarsCount orders the results by home_cars count.
+func ByHomeCarsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHomeCarsStep(), opts...)
+	}
+}
+
+// ByHomeCars orders the results by home_cars terms.
+func ByHomeCars(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHomeCarsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCurrentCarsCount orders the results by current_cars count.
+func ByCurrentCarsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCurrentCarsStep(), opts...)
+	}
+}
+
+// ByCurrentCars orders the results by current_cars terms.
+func ByCurrentCars(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrentCarsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPickupRentalsCount orders the results by pickup_rentals count.
+func ByPickupRentalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPickupRentalsStep(), opts...)
+	}
+}
+
+// ByPickupRentals orders the results by pickup_rentals terms.
+func ByPickupRentals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPickupRentalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReturnRentalsCount orders the results by return_rentals count.
+func ByReturnRentalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReturnRentalsStep(), opts...)
+	}
+}
+
+// ByReturnRentals orders the results by return_rentals terms.
+func ByReturnRentals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReturnRentalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newHomeCarsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HomeCarsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HomeCarsTable, HomeCarsColumn),
+	)
+}
+func newCurrentCarsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrentCarsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CurrentCarsTable, CurrentCarsColumn),
+	)
+}
+func newPickupRentalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PickupRentalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PickupRentalsTable, PickupRentalsColumn),
+	)
+}
+func newReturnRentalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReturnRentalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReturnRentalsTable, ReturnRentalsColumn),
+	)
+}
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// BranchCreate is the builder for creating a Branch entity.
+type BranchCreate struct {
+	config
+	mutation *BranchMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *BranchCreate) SetTenantID(v string) *BranchCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *BranchCreate) SetName(v string) *BranchCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetStreet sets the "street" field.
+func (_c *BranchCreate) SetStreet(v string) *BranchCreate {
+	_c.mutation.SetStreet(v)
+	return _c
+}
+
+// SetCity sets the "city" field.
+func (_c *BranchCreate) SetCity(v string) *BranchCreate {
+	_c.mutation.SetCity(v)
+	return _c
+}
+
+// SetRegion sets the "region" field.
+func (_c *BranchCreate) SetRegion(v string) *BranchCreate {
+	_c.mutation.SetRegion(v)
+	return _c
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_c *BranchCreate) SetNillableRegion(v *string) *BranchCreate {
+	if v != nil {
+		_c.SetRegion(*v)
+	}
+	return _c
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_c *BranchCreate) SetPostalCode(v string) *BranchCreate {
+	_c.mutation.SetPostalCode(v)
+	return _c
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_c *BranchCreate) SetNillablePostalCode(v *string) *BranchCreate {
+	if v != nil {
+		_c.SetPostalCode(*v)
+	}
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *BranchCreate) SetCountry(v string) *BranchCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetLatitude sets the "latitude" field.
+func (_c *BranchCreate) SetLatitude(v float64) *BranchCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *BranchCreate) SetLongitude(v float64) *BranchCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetOpeningHours sets the "opening_hours" field.
+func (_c *BranchCreate) SetOpeningHours(v []schema.OpeningHours) *BranchCreate {
+	_c.mutation.SetOpeningHours(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BranchCreate) SetCreatedAt(v time.Time) *BranchCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BranchCreate) SetNillableCreatedAt(v *time.Time) *BranchCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BranchCreate) SetUpdatedAt(v time.Time) *BranchCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BranchCreate) SetNillableUpdatedAt(v *time.Time) *BranchCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BranchCreate) SetDeletedAt(v time.Time) *BranchCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BranchCreate) SetNillableDeletedAt(v *time.Time) *BranchCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BranchCreate) SetID(v string) *BranchCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *BranchCreate) SetTenant(v *Tenant) *BranchCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// AddHomeCarIDs adds the "home_cars" edge to the Car entity by IDs.
+func (_c *BranchCreate) AddHomeCarIDs(ids ...string) *BranchCreate {
+	_c.mutation.AddHomeCarIDs(ids...)
+	return _c
+}
+
+// AddHomeCars adds the "home_cars" edges to the Car entity.
+func (_c *BranchCreate) AddHomeCars(v ...*Car) *BranchCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHomeCarIDs(ids...)
+}
+
+// AddCurrentCarIDs adds the "current_cars" edge to the Car entity by IDs.
+func (_c *BranchCreate) AddCurrentCarIDs(ids ...string) *BranchCreate {
+	_c.mutation.AddCurrentCarIDs(ids...)
+	return _c
+}
+
+// AddCurrentCars adds the "current_cars" edges to the Car entity.
+func (_c *BranchCreate) AddCurrentCars(v ...*Car) *BranchCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCurrentCarIDs(ids...)
+}
+
+// AddPickupRentalIDs adds the "pickup_rentals" edge to the Rental entity by IDs.
+func (_c *BranchCreate) AddPickupRentalIDs(ids ...string) *BranchCreate {
+	_c.mutation.AddPickupRentalIDs(ids...)
+	return _c
+}
+
+// AddPickupRentals adds the "pickup_rentals" edges to the Rental entity.
+func (_c *BranchCreate) AddPickupRentals(v ...*Rental) *BranchCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPickupRentalIDs(ids...)
+}
+
+// AddReturnRentalIDs adds the "return_rentals" edge to the Rental entity by IDs.
+func (_c *BranchCreate) AddReturnRentalIDs(ids ...string) *BranchCreate {
+	_c.mutation.AddReturnRentalIDs(ids...)
+	return _c
+}
+
+// AddReturnRentals adds the "return_rentals" edges to the Rental entity.
+func (_c *BranchCreate) AddReturnRentals(v ...*Rental) *BranchCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReturnRentalIDs(ids...)
+}
+
+// Mutation returns the BranchMutation object of the builder.
+func (_c *BranchCreate) Mutation() *BranchMutation {
+	return _c.mutation
+}
+
+// Save creates the Branch in the database.
+func (_c *BranchCreate) Save(ctx context.Context) (*Branch, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BranchCreate) SaveX(ctx context.Context) *Branch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BranchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BranchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BranchCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`entgen: missing required field "Branch.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := branch.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Branch.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entgen: missing required field "Branch.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := branch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "Branch.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Street(); !ok {
+		return &ValidationError{Name: "street", err: errors.New(`entgen: missing required field "Branch.street"`)}
+	}
+	if v, ok := _c.mutation.Street(); ok {
+		if err := branch.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`entgen: validator failed for field "Branch.street": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.City(); !ok {
+		return &ValidationError{Name: "city", err: errors.New(`entgen: missing required field "Branch.city"`)}
+	}
+	if v, ok := _c.mutation.City(); ok {
+		if err := branch.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`entgen: validator failed for field "Branch.city": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Region(); ok {
+		if err := branch.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`entgen: validator failed for field "Branch.region": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PostalCode(); ok {
+		if err := branch.PostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "postal_code", err: fmt.Errorf(`entgen: validator failed for field "Branch.postal_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Country(); !ok {
+		return &ValidationError{Name: "country", err: errors.New(`entgen: missing required field "Branch.country"`)}
+	}
+	if v, ok := _c.mutation.Country(); ok {
+		if err := branch.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`entgen: validator failed for field "Branch.country": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Latitude(); !ok {
+		return &ValidationError{Name: "latitude", err: errors.New(`entgen: missing required field "Branch.latitude"`)}
+	}
+	if v, ok := _c.mutation.Latitude(); ok {
+		if err := branch.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`entgen: validator failed for field "Branch.latitude": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Longitude(); !ok {
+		return &ValidationError{Name: "longitude", err: errors.New(`entgen: missing required field "Branch.longitude"`)}
+	}
+	if v, ok := _c.mutation.Longitude(); ok {
+		if err := branch.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`entgen: validator failed for field "Branch.longitude": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := branch.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "Branch.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`entgen: missing required edge "Branch.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *BranchCreate) sqlSave(ctx context.Context) (*Branch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Branch.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BranchCreate) createSpec() (*Branch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Branch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(branch.Table, sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(branch.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Street(); ok {
+		_spec.SetField(branch.FieldStreet, field.TypeString, value)
+		_node.Street = value
+	}
+	if value, ok := _c.mutation.City(); ok {
+		_spec.SetField(branch.FieldCity, field.TypeString, value)
+		_node.City = value
+	}
+	if value, ok := _c.mutation.Region(); ok {
+		_spec.SetField(branch.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	if value, ok := _c.mutation.PostalCode(); ok {
+		_spec.SetField(branch.FieldPostalCode, field.TypeString, value)
+		_node.PostalCode = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(branch.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(branch.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(branch.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = value
+	}
+	if value, ok := _c.mutation.OpeningHours(); ok {
+		_spec.SetField(branch.FieldOpeningHours, field.TypeJSON, value)
+		_node.OpeningHours = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(branch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(branch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(branch.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   branch.TenantTable,
+			Columns: []string{branch.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HomeCarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.HomeCarsTable,
+			Columns: []string{branch.HomeCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CurrentCarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.CurrentCarsTable,
+			Columns: []string{branch.CurrentCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PickupRentalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.PickupRentalsTable,
+			Columns: []string{branch.PickupRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReturnRentalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.ReturnRentalsTable,
+			Columns: []string{branch.ReturnRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BranchCreateBulk is the builder for creating many Branch entities in bulk.
+type BranchCreateBulk struct {
+	config
+	err      error
+	builders []*BranchCreate
+}
+
+// Save creates the Branch entities in the database.
+func (_c *BranchCreateBulk) Save(ctx context.Context) ([]*Branch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Branch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BranchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BranchCreateBulk) SaveX(ctx context.Context) []*Branch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BranchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BranchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
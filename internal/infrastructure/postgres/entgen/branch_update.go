@@ -0,0 +1,1611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// BranchUpdate is the builder for updating Branch entities.
+type BranchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BranchMutation
+}
+
+// Where appends a list predicates to the BranchUpdate builder.
+func (_u *BranchUpdate) Where(ps ...predicate.Branch) *BranchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BranchUpdate) SetTenantID(v string) *BranchUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableTenantID(v *string) *BranchUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BranchUpdate) SetName(v string) *BranchUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableName(v *string) *BranchUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStreet sets the "street" field.
+func (_u *BranchUpdate) SetStreet(v string) *BranchUpdate {
+	_u.mutation.SetStreet(v)
+	return _u
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableStreet(v *string) *BranchUpdate {
+	if v != nil {
+		_u.SetStreet(*v)
+	}
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *BranchUpdate) SetCity(v string) *BranchUpdate {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableCity(v *string) *BranchUpdate {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BranchUpdate) SetRegion(v string) *BranchUpdate {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableRegion(v *string) *BranchUpdate {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *BranchUpdate) ClearRegion() *BranchUpdate {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_u *BranchUpdate) SetPostalCode(v string) *BranchUpdate {
+	_u.mutation.SetPostalCode(v)
+	return _u
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillablePostalCode(v *string) *BranchUpdate {
+	if v != nil {
+		_u.SetPostalCode(*v)
+	}
+	return _u
+}
+
+// ClearPostalCode clears the value of the "postal_code" field.
+func (_u *BranchUpdate) ClearPostalCode() *BranchUpdate {
+	_u.mutation.ClearPostalCode()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *BranchUpdate) SetCountry(v string) *BranchUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableCountry(v *string) *BranchUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *BranchUpdate) SetLatitude(v float64) *BranchUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableLatitude(v *float64) *BranchUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *BranchUpdate) AddLatitude(v float64) *BranchUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *BranchUpdate) SetLongitude(v float64) *BranchUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableLongitude(v *float64) *BranchUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *BranchUpdate) AddLongitude(v float64) *BranchUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// SetOpeningHours sets the "opening_hours" field.
+func (_u *BranchUpdate) SetOpeningHours(v []schema.OpeningHours) *BranchUpdate {
+	_u.mutation.SetOpeningHours(v)
+	return _u
+}
+
+// AppendOpeningHours appends value to the "opening_hours" field.
+func (_u *BranchUpdate) AppendOpeningHours(v []schema.OpeningHours) *BranchUpdate {
+	_u.mutation.AppendOpeningHours(v)
+	return _u
+}
+
+// ClearOpeningHours clears the value of the "opening_hours" field.
+func (_u *BranchUpdate) ClearOpeningHours() *BranchUpdate {
+	_u.mutation.ClearOpeningHours()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BranchUpdate) SetCreatedAt(v time.Time) *BranchUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableCreatedAt(v *time.Time) *BranchUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *BranchUpdate) ClearCreatedAt() *BranchUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BranchUpdate) SetUpdatedAt(v time.Time) *BranchUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableUpdatedAt(v *time.Time) *BranchUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BranchUpdate) ClearUpdatedAt() *BranchUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BranchUpdate) SetDeletedAt(v time.Time) *BranchUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BranchUpdate) SetNillableDeletedAt(v *time.Time) *BranchUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BranchUpdate) ClearDeletedAt() *BranchUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *BranchUpdate) SetTenant(v *Tenant) *BranchUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddHomeCarIDs adds the "home_cars" edge to the Car entity by IDs.
+func (_u *BranchUpdate) AddHomeCarIDs(ids ...string) *BranchUpdate {
+	_u.mutation.AddHomeCarIDs(ids...)
+	return _u
+}
+
+// AddHomeCars adds the "home_cars" edges to the Car entity.
+func (_u *BranchUpdate) AddHomeCars(v ...*Car) *BranchUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHomeCarIDs(ids...)
+}
+
+// AddCurrentCarIDs adds the "current_cars" edge to the Car entity by IDs.
+func (_u *BranchUpdate) AddCurrentCarIDs(ids ...string) *BranchUpdate {
+	_u.mutation.AddCurrentCarIDs(ids...)
+	return _u
+}
+
+// AddCurrentCars adds the "current_cars" edges to the Car entity.
+func (_u *BranchUpdate) AddCurrentCars(v ...*Car) *BranchUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCurrentCarIDs(ids...)
+}
+
+// AddPickupRentalIDs adds the "pickup_rentals" edge to the Rental entity by IDs.
+func (_u *BranchUpdate) AddPickupRentalIDs(ids ...string) *BranchUpdate {
+	_u.mutation.AddPickupRentalIDs(ids...)
+	return _u
+}
+
+// AddPickupRentals adds the "pickup_rentals" edges to the Rental entity.
+func (_u *BranchUpdate) AddPickupRentals(v ...*Rental) *BranchUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPickupRentalIDs(ids...)
+}
+
+// AddReturnRentalIDs adds the "return_rentals" edge to the Rental entity by IDs.
+func (_u *BranchUpdate) AddReturnRentalIDs(ids ...string) *BranchUpdate {
+	_u.mutation.AddReturnRentalIDs(ids...)
+	return _u
+}
+
+// AddReturnRentals adds the "return_rentals" edges to the Rental entity.
+func (_u *BranchUpdate) AddReturnRentals(v ...*Rental) *BranchUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReturnRentalIDs(ids...)
+}
+
+// Mutation returns the BranchMutation object of the builder.
+func (_u *BranchUpdate) Mutation() *BranchMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *BranchUpdate) ClearTenant() *BranchUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearHomeCars clears all "home_cars" edges to the Car entity.
+func (_u *BranchUpdate) ClearHomeCars() *BranchUpdate {
+	_u.mutation.ClearHomeCars()
+	return _u
+}
+
+// RemoveHomeCarIDs removes the "home_cars" edge to Car entities by IDs.
+func (_u *BranchUpdate) RemoveHomeCarIDs(ids ...string) *BranchUpdate {
+	_u.mutation.RemoveHomeCarIDs(ids...)
+	return _u
+}
+
+// RemoveHomeCars removes "home_cars" edges to Car entities.
+func (_u *BranchUpdate) RemoveHomeCars(v ...*Car) *BranchUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHomeCarIDs(ids...)
+}
+
+// ClearCurrentCars clears all "current_cars" edges to the Car entity.
+func (_u *BranchUpdate) ClearCurrentCars() *BranchUpdate {
+	_u.mutation.ClearCurrentCars()
+	return _u
+}
+
+// RemoveCurrentCarIDs removes the "current_cars" edge to Car entities by IDs.
+func (_u *BranchUpdate) RemoveCurrentCarIDs(ids ...string) *BranchUpdate {
+	_u.mutation.RemoveCurrentCarIDs(ids...)
+	return _u
+}
+
+// RemoveCurrentCars removes "current_cars" edges to Car entities.
+func (_u *BranchUpdate) RemoveCurrentCars(v ...*Car) *BranchUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCurrentCarIDs(ids...)
+}
+
+// ClearPickupRentals clears all "pickup_rentals" edges to the Rental entity.
+func (_u *BranchUpdate) ClearPickupRentals() *BranchUpdate {
+	_u.mutation.ClearPickupRentals()
+	return _u
+}
+
+// RemovePickupRentalIDs removes the "pickup_rentals" edge to Rental entities by IDs.
+func (_u *BranchUpdate) RemovePickupRentalIDs(ids ...string) *BranchUpdate {
+	_u.mutation.RemovePickupRentalIDs(ids...)
+	return _u
+}
+
+// RemovePickupRentals removes "pickup_rentals" edges to Rental entities.
+func (_u *BranchUpdate) RemovePickupRentals(v ...*Rental) *BranchUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePickupRentalIDs(ids...)
+}
+
+// ClearReturnRentals clears all "return_rentals" edges to the Rental entity.
+func (_u *BranchUpdate) ClearReturnRentals() *BranchUpdate {
+	_u.mutation.ClearReturnRentals()
+	return _u
+}
+
+// RemoveReturnRentalIDs removes the "return_rentals" edge to Rental entities by IDs.
+func (_u *BranchUpdate) RemoveReturnRentalIDs(ids ...string) *BranchUpdate {
+	_u.mutation.RemoveReturnRentalIDs(ids...)
+	return _u
+}
+
+// RemoveReturnRentals removes "return_rentals" edges to Rental entities.
+func (_u *BranchUpdate) RemoveReturnRentals(v ...*Rental) *BranchUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReturnRentalIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BranchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BranchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BranchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BranchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BranchUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := branch.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Branch.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := branch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "Branch.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Street(); ok {
+		if err := branch.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`entgen: validator failed for field "Branch.street": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := branch.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`entgen: validator failed for field "Branch.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := branch.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`entgen: validator failed for field "Branch.region": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostalCode(); ok {
+		if err := branch.PostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "postal_code", err: fmt.Errorf(`entgen: validator failed for field "Branch.postal_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := branch.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`entgen: validator failed for field "Branch.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Latitude(); ok {
+		if err := branch.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`entgen: validator failed for field "Branch.latitude": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Longitude(); ok {
+		if err := branch.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`entgen: validator failed for field "Branch.longitude": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "Branch.tenant"`)
+	}
+	return nil
+}
+
+func (_u *BranchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(branch.Table, branch.Columns, sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(branch.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Street(); ok {
+		_spec.SetField(branch.FieldStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(branch.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(branch.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(branch.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostalCode(); ok {
+		_spec.SetField(branch.FieldPostalCode, field.TypeString, value)
+	}
+	if _u.mutation.PostalCodeCleared() {
+		_spec.ClearField(branch.FieldPostalCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(branch.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(branch.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(branch.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(branch.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(branch.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OpeningHours(); ok {
+		_spec.SetField(branch.FieldOpeningHours, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOpeningHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, branch.FieldOpeningHours, value)
+		})
+	}
+	if _u.mutation.OpeningHoursCleared() {
+		_spec.ClearField(branch.FieldOpeningHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(branch.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(branch.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(branch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(branch.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(branch.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(branch.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   branch.TenantTable,
+			Columns: []string{branch.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   branch.TenantTable,
+			Columns: []string{branch.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HomeCarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.HomeCarsTable,
+			Columns: []string{branch.HomeCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHomeCarsIDs(); len(nodes) > 0 && !_u.mutation.HomeCarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.HomeCarsTable,
+			Columns: []string{branch.HomeCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HomeCarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.HomeCarsTable,
+			Columns: []string{branch.HomeCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CurrentCarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.CurrentCarsTable,
+			Columns: []string{branch.CurrentCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCurrentCarsIDs(); len(nodes) > 0 && !_u.mutation.CurrentCarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.CurrentCarsTable,
+			Columns: []string{branch.CurrentCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CurrentCarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.CurrentCarsTable,
+			Columns: []string{branch.CurrentCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PickupRentalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.PickupRentalsTable,
+			Columns: []string{branch.PickupRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPickupRentalsIDs(); len(nodes) > 0 && !_u.mutation.PickupRentalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.PickupRentalsTable,
+			Columns: []string{branch.PickupRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PickupRentalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.PickupRentalsTable,
+			Columns: []string{branch.PickupRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnRentalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.ReturnRentalsTable,
+			Columns: []string{branch.ReturnRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReturnRentalsIDs(); len(nodes) > 0 && !_u.mutation.ReturnRentalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.ReturnRentalsTable,
+			Columns: []string{branch.ReturnRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnRentalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.ReturnRentalsTable,
+			Columns: []string{branch.ReturnRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{branch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BranchUpdateOne is the builder for updating a single Branch entity.
+type BranchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BranchMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *BranchUpdateOne) SetTenantID(v string) *BranchUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableTenantID(v *string) *BranchUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *BranchUpdateOne) SetName(v string) *BranchUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableName(v *string) *BranchUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetStreet sets the "street" field.
+func (_u *BranchUpdateOne) SetStreet(v string) *BranchUpdateOne {
+	_u.mutation.SetStreet(v)
+	return _u
+}
+
+// SetNillableStreet sets the "street" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableStreet(v *string) *BranchUpdateOne {
+	if v != nil {
+		_u.SetStreet(*v)
+	}
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *BranchUpdateOne) SetCity(v string) *BranchUpdateOne {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableCity(v *string) *BranchUpdateOne {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// SetRegion sets the "region" field.
+func (_u *BranchUpdateOne) SetRegion(v string) *BranchUpdateOne {
+	_u.mutation.SetRegion(v)
+	return _u
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableRegion(v *string) *BranchUpdateOne {
+	if v != nil {
+		_u.SetRegion(*v)
+	}
+	return _u
+}
+
+// ClearRegion clears the value of the "region" field.
+func (_u *BranchUpdateOne) ClearRegion() *BranchUpdateOne {
+	_u.mutation.ClearRegion()
+	return _u
+}
+
+// SetPostalCode sets the "postal_code" field.
+func (_u *BranchUpdateOne) SetPostalCode(v string) *BranchUpdateOne {
+	_u.mutation.SetPostalCode(v)
+	return _u
+}
+
+// SetNillablePostalCode sets the "postal_code" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillablePostalCode(v *string) *BranchUpdateOne {
+	if v != nil {
+		_u.SetPostalCode(*v)
+	}
+	return _u
+}
+
+// ClearPostalCode clears the value of the "postal_code" field.
+func (_u *BranchUpdateOne) ClearPostalCode() *BranchUpdateOne {
+	_u.mutation.ClearPostalCode()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *BranchUpdateOne) SetCountry(v string) *BranchUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableCountry(v *string) *BranchUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *BranchUpdateOne) SetLatitude(v float64) *BranchUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableLatitude(v *float64) *BranchUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *BranchUpdateOne) AddLatitude(v float64) *BranchUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *BranchUpdateOne) SetLongitude(v float64) *BranchUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableLongitude(v *float64) *BranchUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *BranchUpdateOne) AddLongitude(v float64) *BranchUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// SetOpeningHours sets the "opening_hours" field.
+func (_u *BranchUpdateOne) SetOpeningHours(v []schema.OpeningHours) *BranchUpdateOne {
+	_u.mutation.SetOpeningHours(v)
+	return _u
+}
+
+// AppendOpeningHours appends value to the "opening_hours" field.
+func (_u *BranchUpdateOne) AppendOpeningHours(v []schema.OpeningHours) *BranchUpdateOne {
+	_u.mutation.AppendOpeningHours(v)
+	return _u
+}
+
+// ClearOpeningHours clears the value of the "opening_hours" field.
+func (_u *BranchUpdateOne) ClearOpeningHours() *BranchUpdateOne {
+	_u.mutation.ClearOpeningHours()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BranchUpdateOne) SetCreatedAt(v time.Time) *BranchUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableCreatedAt(v *time.Time) *BranchUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *BranchUpdateOne) ClearCreatedAt() *BranchUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BranchUpdateOne) SetUpdatedAt(v time.Time) *BranchUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableUpdatedAt(v *time.Time) *BranchUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BranchUpdateOne) ClearUpdatedAt() *BranchUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BranchUpdateOne) SetDeletedAt(v time.Time) *BranchUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BranchUpdateOne) SetNillableDeletedAt(v *time.Time) *BranchUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BranchUpdateOne) ClearDeletedAt() *BranchUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *BranchUpdateOne) SetTenant(v *Tenant) *BranchUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddHomeCarIDs adds the "home_cars" edge to the Car entity by IDs.
+func (_u *BranchUpdateOne) AddHomeCarIDs(ids ...string) *BranchUpdateOne {
+	_u.mutation.AddHomeCarIDs(ids...)
+	return _u
+}
+
+// AddHomeCars adds the "home_cars" edges to the Car entity.
+func (_u *BranchUpdateOne) AddHomeCars(v ...*Car) *BranchUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHomeCarIDs(ids...)
+}
+
+// AddCurrentCarIDs adds the "current_cars" edge to the Car entity by IDs.
+func (_u *BranchUpdateOne) AddCurrentCarIDs(ids ...string) *BranchUpdateOne {
+	_u.mutation.AddCurrentCarIDs(ids...)
+	return _u
+}
+
+// AddCurrentCars adds the "current_cars" edges to the Car entity.
+func (_u *BranchUpdateOne) AddCurrentCars(v ...*Car) *BranchUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCurrentCarIDs(ids...)
+}
+
+// AddPickupRentalIDs adds the "pickup_rentals" edge to the Rental entity by IDs.
+func (_u *BranchUpdateOne) AddPickupRentalIDs(ids ...string) *BranchUpdateOne {
+	_u.mutation.AddPickupRentalIDs(ids...)
+	return _u
+}
+
+// AddPickupRentals adds the "pickup_rentals" edges to the Rental entity.
+func (_u *BranchUpdateOne) AddPickupRentals(v ...*Rental) *BranchUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPickupRentalIDs(ids...)
+}
+
+// AddReturnRentalIDs adds the "return_rentals" edge to the Rental entity by IDs.
+func (_u *BranchUpdateOne) AddReturnRentalIDs(ids ...string) *BranchUpdateOne {
+	_u.mutation.AddReturnRentalIDs(ids...)
+	return _u
+}
+
+// AddReturnRentals adds the "return_rentals" edges to the Rental entity.
+func (_u *BranchUpdateOne) AddReturnRentals(v ...*Rental) *BranchUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReturnRentalIDs(ids...)
+}
+
+// Mutation returns the BranchMutation object of the builder.
+func (_u *BranchUpdateOne) Mutation() *BranchMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *BranchUpdateOne) ClearTenant() *BranchUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearHomeCars clears all "home_cars" edges to the Car entity.
+func (_u *BranchUpdateOne) ClearHomeCars() *BranchUpdateOne {
+	_u.mutation.ClearHomeCars()
+	return _u
+}
+
+// RemoveHomeCarIDs removes the "home_cars" edge to Car entities by IDs.
+func (_u *BranchUpdateOne) RemoveHomeCarIDs(ids ...string) *BranchUpdateOne {
+	_u.mutation.RemoveHomeCarIDs(ids...)
+	return _u
+}
+
+// RemoveHomeCars removes "home_cars" edges to Car entities.
+func (_u *BranchUpdateOne) RemoveHomeCars(v ...*Car) *BranchUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHomeCarIDs(ids...)
+}
+
+// ClearCurrentCars clears all "current_cars" edges to the Car entity.
+func (_u *BranchUpdateOne) ClearCurrentCars() *BranchUpdateOne {
+	_u.mutation.ClearCurrentCars()
+	return _u
+}
+
+// RemoveCurrentCarIDs removes the "current_cars" edge to Car entities by IDs.
+func (_u *BranchUpdateOne) RemoveCurrentCarIDs(ids ...string) *BranchUpdateOne {
+	_u.mutation.RemoveCurrentCarIDs(ids...)
+	return _u
+}
+
+// RemoveCurrentCars removes "current_cars" edges to Car entities.
+func (_u *BranchUpdateOne) RemoveCurrentCars(v ...*Car) *BranchUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCurrentCarIDs(ids...)
+}
+
+// ClearPickupRentals clears all "pickup_rentals" edges to the Rental entity.
+func (_u *BranchUpdateOne) ClearPickupRentals() *BranchUpdateOne {
+	_u.mutation.ClearPickupRentals()
+	return _u
+}
+
+// RemovePickupRentalIDs removes the "pickup_rentals" edge to Rental entities by IDs.
+func (_u *BranchUpdateOne) RemovePickupRentalIDs(ids ...string) *BranchUpdateOne {
+	_u.mutation.RemovePickupRentalIDs(ids...)
+	return _u
+}
+
+// RemovePickupRentals removes "pickup_rentals" edges to Rental entities.
+func (_u *BranchUpdateOne) RemovePickupRentals(v ...*Rental) *BranchUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePickupRentalIDs(ids...)
+}
+
+// ClearReturnRentals clears all "return_rentals" edges to the Rental entity.
+func (_u *BranchUpdateOne) ClearReturnRentals() *BranchUpdateOne {
+	_u.mutation.ClearReturnRentals()
+	return _u
+}
+
+// RemoveReturnRentalIDs removes the "return_rentals" edge to Rental entities by IDs.
+func (_u *BranchUpdateOne) RemoveReturnRentalIDs(ids ...string) *BranchUpdateOne {
+	_u.mutation.RemoveReturnRentalIDs(ids...)
+	return _u
+}
+
+// RemoveReturnRentals removes "return_rentals" edges to Rental entities.
+func (_u *BranchUpdateOne) RemoveReturnRentals(v ...*Rental) *BranchUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReturnRentalIDs(ids...)
+}
+
+// Where appends a list predicates to the BranchUpdate builder.
+func (_u *BranchUpdateOne) Where(ps ...predicate.Branch) *BranchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BranchUpdateOne) Select(field string, fields ...string) *BranchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Branch entity.
+func (_u *BranchUpdateOne) Save(ctx context.Context) (*Branch, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BranchUpdateOne) SaveX(ctx context.Context) *Branch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BranchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BranchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BranchUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := branch.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Branch.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := branch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "Branch.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Street(); ok {
+		if err := branch.StreetValidator(v); err != nil {
+			return &ValidationError{Name: "street", err: fmt.Errorf(`entgen: validator failed for field "Branch.street": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.City(); ok {
+		if err := branch.CityValidator(v); err != nil {
+			return &ValidationError{Name: "city", err: fmt.Errorf(`entgen: validator failed for field "Branch.city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Region(); ok {
+		if err := branch.RegionValidator(v); err != nil {
+			return &ValidationError{Name: "region", err: fmt.Errorf(`entgen: validator failed for field "Branch.region": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PostalCode(); ok {
+		if err := branch.PostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "postal_code", err: fmt.Errorf(`entgen: validator failed for field "Branch.postal_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Country(); ok {
+		if err := branch.CountryValidator(v); err != nil {
+			return &ValidationError{Name: "country", err: fmt.Errorf(`entgen: validator failed for field "Branch.country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Latitude(); ok {
+		if err := branch.LatitudeValidator(v); err != nil {
+			return &ValidationError{Name: "latitude", err: fmt.Errorf(`entgen: validator failed for field "Branch.latitude": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Longitude(); ok {
+		if err := branch.LongitudeValidator(v); err != nil {
+			return &ValidationError{Name: "longitude", err: fmt.Errorf(`entgen: validator failed for field "Branch.longitude": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "Branch.tenant"`)
+	}
+	return nil
+}
+
+func (_u *BranchUpdateOne) sqlSave(ctx context.Context) (_node *Branch, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(branch.Table, branch.Columns, sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "Branch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, branch.FieldID)
+		for _, f := range fields {
+			if !branch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != branch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(branch.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Street(); ok {
+		_spec.SetField(branch.FieldStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(branch.FieldCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Region(); ok {
+		_spec.SetField(branch.FieldRegion, field.TypeString, value)
+	}
+	if _u.mutation.RegionCleared() {
+		_spec.ClearField(branch.FieldRegion, field.TypeString)
+	}
+	if value, ok := _u.mutation.PostalCode(); ok {
+		_spec.SetField(branch.FieldPostalCode, field.TypeString, value)
+	}
+	if _u.mutation.PostalCodeCleared() {
+		_spec.ClearField(branch.FieldPostalCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(branch.FieldCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(branch.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(branch.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(branch.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(branch.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OpeningHours(); ok {
+		_spec.SetField(branch.FieldOpeningHours, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOpeningHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, branch.FieldOpeningHours, value)
+		})
+	}
+	if _u.mutation.OpeningHoursCleared() {
+		_spec.ClearField(branch.FieldOpeningHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(branch.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(branch.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(branch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(branch.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(branch.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(branch.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   branch.TenantTable,
+			Columns: []string{branch.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   branch.TenantTable,
+			Columns: []string{branch.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HomeCarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.HomeCarsTable,
+			Columns: []string{branch.HomeCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHomeCarsIDs(); len(nodes) > 0 && !_u.mutation.HomeCarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.HomeCarsTable,
+			Columns: []string{branch.HomeCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HomeCarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.HomeCarsTable,
+			Columns: []string{branch.HomeCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CurrentCarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.CurrentCarsTable,
+			Columns: []string{branch.CurrentCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCurrentCarsIDs(); len(nodes) > 0 && !_u.mutation.CurrentCarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.CurrentCarsTable,
+			Columns: []string{branch.CurrentCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CurrentCarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.CurrentCarsTable,
+			Columns: []string{branch.CurrentCarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PickupRentalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.PickupRentalsTable,
+			Columns: []string{branch.PickupRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPickupRentalsIDs(); len(nodes) > 0 && !_u.mutation.PickupRentalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.PickupRentalsTable,
+			Columns: []string{branch.PickupRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PickupRentalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.PickupRentalsTable,
+			Columns: []string{branch.PickupRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnRentalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.ReturnRentalsTable,
+			Columns: []string{branch.ReturnRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReturnRentalsIDs(); len(nodes) > 0 && !_u.mutation.ReturnRentalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.ReturnRentalsTable,
+			Columns: []string{branch.ReturnRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnRentalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   branch.ReturnRentalsTable,
+			Columns: []string{branch.ReturnRentalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Branch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{branch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
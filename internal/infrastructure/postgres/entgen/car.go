@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
 )
 
@@ -20,8 +22,18 @@ type Car struct {
 	ID string `json:"id,omitempty"`
 	// TenantID holds the value of the "tenant_id" field.
 	TenantID string `json:"tenant_id,omitempty"`
-	// Model holds the value of the "model" field.
-	Model string `json:"model,omitempty"`
+	// CarModelID holds the value of the "car_model_id" field.
+	CarModelID string `json:"car_model_id,omitempty"`
+	// Vin holds the value of the "vin" field.
+	Vin *string `json:"vin,omitempty"`
+	// LicensePlate holds the value of the "license_plate" field.
+	LicensePlate *string `json:"license_plate,omitempty"`
+	// LicensePlateCountry holds the value of the "license_plate_country" field.
+	LicensePlateCountry *string `json:"license_plate_country,omitempty"`
+	// HomeBranchID holds the value of the "home_branch_id" field.
+	HomeBranchID *string `json:"home_branch_id,omitempty"`
+	// CurrentBranchID holds the value of the "current_branch_id" field.
+	CurrentBranchID *string `json:"current_branch_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -38,11 +50,19 @@ type Car struct {
 type CarEdges struct {
 	// Tenant holds the value of the tenant edge.
 	Tenant *Tenant `json:"tenant,omitempty"`
+	// CarModel holds the value of the car_model edge.
+	CarModel *CarModel `json:"car_model,omitempty"`
+	// HomeBranch holds the value of the home_branch edge.
+	HomeBranch *Branch `json:"home_branch,omitempty"`
+	// CurrentBranch holds the value of the current_branch edge.
+	CurrentBranch *Branch `json:"current_branch,omitempty"`
 	// Rentals holds the value of the rentals edge.
 	Rentals []*Rental `json:"rentals,omitempty"`
+	// MaintenanceWindows holds the value of the maintenance_windows edge.
+	MaintenanceWindows []*MaintenanceWindow `json:"maintenance_windows,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -56,21 +76,63 @@ func (e CarEdges) TenantOrErr() (*Tenant, error) {
 	return nil, &NotLoadedError{edge: "tenant"}
 }
 
+// CarModelOrErr returns the CarModel value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarEdges) CarModelOrErr() (*CarModel, error) {
+	if e.CarModel != nil {
+		return e.CarModel, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: carmodel.Label}
+	}
+	return nil, &NotLoadedError{edge: "car_model"}
+}
+
+// HomeBranchOrErr returns the HomeBranch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarEdges) HomeBranchOrErr() (*Branch, error) {
+	if e.HomeBranch != nil {
+		return e.HomeBranch, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: branch.Label}
+	}
+	return nil, &NotLoadedError{edge: "home_branch"}
+}
+
+// CurrentBranchOrErr returns the CurrentBranch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarEdges) CurrentBranchOrErr() (*Branch, error) {
+	if e.CurrentBranch != nil {
+		return e.CurrentBranch, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: branch.Label}
+	}
+	return nil, &NotLoadedError{edge: "current_branch"}
+}
+
 // RentalsOrErr returns the Rentals value or an error if the edge
 // was not loaded in eager-loading.
 func (e CarEdges) RentalsOrErr() ([]*Rental, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[4] {
 		return e.Rentals, nil
 	}
 	return nil, &NotLoadedError{edge: "rentals"}
 }
 
+// MaintenanceWindowsOrErr returns the MaintenanceWindows value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarEdges) MaintenanceWindowsOrErr() ([]*MaintenanceWindow, error) {
+	if e.loadedTypes[5] {
+		return e.MaintenanceWindows, nil
+	}
+	return nil, &NotLoadedError{edge: "maintenance_windows"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Car) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case car.FieldID, car.FieldTenantID, car.FieldModel:
+		case car.FieldID, car.FieldTenantID, car.FieldCarModelID, car.FieldVin, car.FieldLicensePlate, car.FieldLicensePlateCountry, car.FieldHomeBranchID, car.FieldCurrentBranchID:
 			values[i] = new(sql.NullString)
 		case car.FieldCreatedAt, car.FieldUpdatedAt, car.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -101,11 +163,46 @@ func (_m *Car) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TenantID = value.String
 			}
-		case car.FieldModel:
+		case car.FieldCarModelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field car_model_id", values[i])
+			} else if value.Valid {
+				_m.CarModelID = value.String
+			}
+		case car.FieldVin:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vin", values[i])
+			} else if value.Valid {
+				_m.Vin = new(string)
+				*_m.Vin = value.String
+			}
+		case car.FieldLicensePlate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field license_plate", values[i])
+			} else if value.Valid {
+				_m.LicensePlate = new(string)
+				*_m.LicensePlate = value.String
+			}
+		case car.FieldLicensePlateCountry:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field model", values[i])
+				return fmt.Errorf("unexpected type %T for field license_plate_country", values[i])
 			} else if value.Valid {
-				_m.Model = value.String
+				_m.LicensePlateCountry = new(string)
+				*_m.LicensePlateCountry = value.String
+			}
+		case car.FieldHomeBranchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field home_branch_id", values[i])
+			} else if value.Valid {
+				_m.HomeBranchID = new(string)
+				*_m.HomeBranchID = value.String
+			}
+		case car.FieldCurrentBranchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field current_branch_id", values[i])
+			} else if value.Valid {
+				_m.CurrentBranchID = new(string)
+				*_m.CurrentBranchID = value.String
 			}
 		case car.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -144,11 +241,31 @@ func (_m *Car) QueryTenant() *TenantQuery {
 	return NewCarClient(_m.config).QueryTenant(_m)
 }
 
+// QueryCarModel queries the "car_model" edge of the Car entity.
+func (_m *Car) QueryCarModel() *CarModelQuery {
+	return NewCarClient(_m.config).QueryCarModel(_m)
+}
+
+// QueryHomeBranch queries the "home_branch" edge of the Car entity.
+func (_m *Car) QueryHomeBranch() *BranchQuery {
+	return NewCarClient(_m.config).QueryHomeBranch(_m)
+}
+
+// QueryCurrentBranch queries the "current_branch" edge of the Car entity.
+func (_m *Car) QueryCurrentBranch() *BranchQuery {
+	return NewCarClient(_m.config).QueryCurrentBranch(_m)
+}
+
 // QueryRentals queries the "rentals" edge of the Car entity.
 func (_m *Car) QueryRentals() *RentalQuery {
 	return NewCarClient(_m.config).QueryRentals(_m)
 }
 
+// QueryMaintenanceWindows queries the "maintenance_windows" edge of the Car entity.
+func (_m *Car) QueryMaintenanceWindows() *MaintenanceWindowQuery {
+	return NewCarClient(_m.config).QueryMaintenanceWindows(_m)
+}
+
 // Update returns a builder for updating this Car.
 // Note that you need to call Car.Unwrap() before calling this method if this Car
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -175,8 +292,33 @@ func (_m *Car) String() string {
 	builder.WriteString("tenant_id=")
 	builder.WriteString(_m.TenantID)
 	builder.WriteString(", ")
-	builder.WriteString("model=")
-	builder.WriteString(_m.Model)
+	builder.WriteString("car_model_id=")
+	builder.WriteString(_m.CarModelID)
+	builder.WriteString(", ")
+	if v := _m.Vin; v != nil {
+		builder.WriteString("vin=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LicensePlate; v != nil {
+		builder.WriteString("license_plate=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LicensePlateCountry; v != nil {
+		builder.WriteString("license_plate_country=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.HomeBranchID; v != nil {
+		builder.WriteString("home_branch_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.CurrentBranchID; v != nil {
+		builder.WriteString("current_branch_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
@@ -14,8 +14,18 @@ const (
 	FieldID = "id"
 	// FieldTenantID holds the string denoting the tenant_id field in the database.
 	FieldTenantID = "tenant_id"
-	// FieldModel holds the string denoting the model field in the database.
-	FieldModel = "model"
+	// FieldCarModelID holds the string denoting the car_model_id field in the database.
+	FieldCarModelID = "car_model_id"
+	// FieldVin holds the string denoting the vin field in the database.
+	FieldVin = "vin"
+	// FieldLicensePlate holds the string denoting the license_plate field in the database.
+	FieldLicensePlate = "license_plate"
+	// FieldLicensePlateCountry holds the string denoting the license_plate_country field in the database.
+	FieldLicensePlateCountry = "license_plate_country"
+	// FieldHomeBranchID holds the string denoting the home_branch_id field in the database.
+	FieldHomeBranchID = "home_branch_id"
+	// FieldCurrentBranchID holds the string denoting the current_branch_id field in the database.
+	FieldCurrentBranchID = "current_branch_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -24,8 +34,16 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// EdgeTenant holds the string denoting the tenant edge name in mutations.
 	EdgeTenant = "tenant"
+	// EdgeCarModel holds the string denoting the car_model edge name in mutations.
+	EdgeCarModel = "car_model"
+	// EdgeHomeBranch holds the string denoting the home_branch edge name in mutations.
+	EdgeHomeBranch = "home_branch"
+	// EdgeCurrentBranch holds the string denoting the current_branch edge name in mutations.
+	EdgeCurrentBranch = "current_branch"
 	// EdgeRentals holds the string denoting the rentals edge name in mutations.
 	EdgeRentals = "rentals"
+	// EdgeMaintenanceWindows holds the string denoting the maintenance_windows edge name in mutations.
+	EdgeMaintenanceWindows = "maintenance_windows"
 	// Table holds the table name of the car in the database.
 	Table = "cars"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -35,6 +53,27 @@ const (
 	TenantInverseTable = "tenants"
 	// TenantColumn is the table column denoting the tenant relation/edge.
 	TenantColumn = "tenant_id"
+	// CarModelTable is the table that holds the car_model relation/edge.
+	CarModelTable = "cars"
+	// CarModelInverseTable is the table name for the CarModel entity.
+	// It exists in this package in order to avoid circular dependency with the "carmodel" package.
+	CarModelInverseTable = "car_models"
+	// CarModelColumn is the table column denoting the car_model relation/edge.
+	CarModelColumn = "car_model_id"
+	// HomeBranchTable is the table that holds the home_branch relation/edge.
+	HomeBranchTable = "cars"
+	// HomeBranchInverseTable is the table name for the Branch entity.
+	// It exists in this package in order to avoid circular dependency with the "branch" package.
+	HomeBranchInverseTable = "branches"
+	// HomeBranchColumn is the table column denoting the home_branch relation/edge.
+	HomeBranchColumn = "home_branch_id"
+	// CurrentBranchTable is the table that holds the current_branch relation/edge.
+	CurrentBranchTable = "cars"
+	// CurrentBranchInverseTable is the table name for the Branch entity.
+	// It exists in this package in order to avoid circular dependency with the "branch" package.
+	CurrentBranchInverseTable = "branches"
+	// CurrentBranchColumn is the table column denoting the current_branch relation/edge.
+	CurrentBranchColumn = "current_branch_id"
 	// RentalsTable is the table that holds the rentals relation/edge.
 	RentalsTable = "rentals"
 	// RentalsInverseTable is the table name for the Rental entity.
@@ -42,13 +81,25 @@ const (
 	RentalsInverseTable = "rentals"
 	// RentalsColumn is the table column denoting the rentals relation/edge.
 	RentalsColumn = "car_id"
+	// MaintenanceWindowsTable is the table that holds the maintenance_windows relation/edge.
+	MaintenanceWindowsTable = "maintenance_windows"
+	// MaintenanceWindowsInverseTable is the table name for the MaintenanceWindow entity.
+	// It exists in this package in order to avoid circular dependency with the "maintenancewindow" package.
+	MaintenanceWindowsInverseTable = "maintenance_windows"
+	// MaintenanceWindowsColumn is the table column denoting the maintenance_windows relation/edge.
+	MaintenanceWindowsColumn = "car_id"
 )
 
 // Columns holds all SQL columns for car fields.
 var Columns = []string{
 	FieldID,
 	FieldTenantID,
-	FieldModel,
+	FieldCarModelID,
+	FieldVin,
+	FieldLicensePlate,
+	FieldLicensePlateCountry,
+	FieldHomeBranchID,
+	FieldCurrentBranchID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
@@ -67,8 +118,18 @@ func ValidColumn(column string) bool {
 var (
 	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	TenantIDValidator func(string) error
-	// ModelValidator is a validator for the "model" field. It is called by the builders before save.
-	ModelValidator func(string) error
+	// CarModelIDValidator is a validator for the "car_model_id" field. It is called by the builders before save.
+	CarModelIDValidator func(string) error
+	// VinValidator is a validator for the "vin" field. It is called by the builders before save.
+	VinValidator func(string) error
+	// LicensePlateValidator is a validator for the "license_plate" field. It is called by the builders before save.
+	LicensePlateValidator func(string) error
+	// LicensePlateCountryValidator is a validator for the "license_plate_country" field. It is called by the builders before save.
+	LicensePlateCountryValidator func(string) error
+	// HomeBranchIDValidator is a validator for the "home_branch_id" field. It is called by the builders before save.
+	HomeBranchIDValidator func(string) error
+	// CurrentBranchIDValidator is a validator for the "current_branch_id" field. It is called by the builders before save.
+	CurrentBranchIDValidator func(string) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -86,9 +147,34 @@ func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
 }
 
-// ByModel orders the results by the model field.
-func ByModel(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldModel, opts...).ToFunc()
+// ByCarModelID orders the results by the car_model_id field.
+func ByCarModelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCarModelID, opts...).ToFunc()
+}
+
+// ByVin orders the results by the vin field.
+func ByVin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVin, opts...).ToFunc()
+}
+
+// ByLicensePlate orders the results by the license_plate field.
+func ByLicensePlate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLicensePlate, opts...).ToFunc()
+}
+
+// ByLicensePlateCountry orders the results by the license_plate_country field.
+func ByLicensePlateCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLicensePlateCountry, opts...).ToFunc()
+}
+
+// ByHomeBranchID orders the results by the home_branch_id field.
+func ByHomeBranchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHomeBranchID, opts...).ToFunc()
+}
+
+// ByCurrentBranchID orders the results by the current_branch_id field.
+func ByCurrentBranchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentBranchID, opts...).ToFunc()
 }
 
 // ByCreatedAt orders the results by the created_at field.
@@ -113,6 +199,27 @@ func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByCarModelField orders the results by car_model field.
+func ByCarModelField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCarModelStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByHomeBranchField orders the results by home_branch field.
+func ByHomeBranchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHomeBranchStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCurrentBranchField orders the results by current_branch field.
+func ByCurrentBranchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCurrentBranchStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByRentalsCount orders the results by rentals count.
 func ByRentalsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -126,6 +233,20 @@ func ByRentals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRentalsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMaintenanceWindowsCount orders the results by maintenance_windows count.
+func ByMaintenanceWindowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMaintenanceWindowsStep(), opts...)
+	}
+}
+
+// ByMaintenanceWindows orders the results by maintenance_windows terms.
+func ByMaintenanceWindows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMaintenanceWindowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -133,6 +254,27 @@ func newTenantStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
 	)
 }
+func newCarModelStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CarModelInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CarModelTable, CarModelColumn),
+	)
+}
+func newHomeBranchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HomeBranchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HomeBranchTable, HomeBranchColumn),
+	)
+}
+func newCurrentBranchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CurrentBranchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CurrentBranchTable, CurrentBranchColumn),
+	)
+}
 func newRentalsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -140,3 +282,10 @@ func newRentalsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RentalsTable, RentalsColumn),
 	)
 }
+func newMaintenanceWindowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MaintenanceWindowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceWindowsTable, MaintenanceWindowsColumn),
+	)
+}
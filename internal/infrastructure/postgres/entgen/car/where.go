@@ -70,9 +70,34 @@ func TenantID(v string) predicate.Car {
 	return predicate.Car(sql.FieldEQ(FieldTenantID, v))
 }
 
-// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
-func Model(v string) predicate.Car {
-	return predicate.Car(sql.FieldEQ(FieldModel, v))
+// CarModelID applies equality check predicate on the "car_model_id" field. It's identical to CarModelIDEQ.
+func CarModelID(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldCarModelID, v))
+}
+
+// Vin applies equality check predicate on the "vin" field. It's identical to VinEQ.
+func Vin(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldVin, v))
+}
+
+// LicensePlate applies equality check predicate on the "license_plate" field. It's identical to LicensePlateEQ.
+func LicensePlate(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldLicensePlate, v))
+}
+
+// LicensePlateCountry applies equality check predicate on the "license_plate_country" field. It's identical to LicensePlateCountryEQ.
+func LicensePlateCountry(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldLicensePlateCountry, v))
+}
+
+// HomeBranchID applies equality check predicate on the "home_branch_id" field. It's identical to HomeBranchIDEQ.
+func HomeBranchID(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldHomeBranchID, v))
+}
+
+// CurrentBranchID applies equality check predicate on the "current_branch_id" field. It's identical to CurrentBranchIDEQ.
+func CurrentBranchID(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldCurrentBranchID, v))
 }
 
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
@@ -155,69 +180,454 @@ func TenantIDContainsFold(v string) predicate.Car {
 	return predicate.Car(sql.FieldContainsFold(FieldTenantID, v))
 }
 
-// ModelEQ applies the EQ predicate on the "model" field.
-func ModelEQ(v string) predicate.Car {
-	return predicate.Car(sql.FieldEQ(FieldModel, v))
+// CarModelIDEQ applies the EQ predicate on the "car_model_id" field.
+func CarModelIDEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldCarModelID, v))
+}
+
+// CarModelIDNEQ applies the NEQ predicate on the "car_model_id" field.
+func CarModelIDNEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldNEQ(FieldCarModelID, v))
+}
+
+// CarModelIDIn applies the In predicate on the "car_model_id" field.
+func CarModelIDIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldIn(FieldCarModelID, vs...))
+}
+
+// CarModelIDNotIn applies the NotIn predicate on the "car_model_id" field.
+func CarModelIDNotIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldNotIn(FieldCarModelID, vs...))
+}
+
+// CarModelIDGT applies the GT predicate on the "car_model_id" field.
+func CarModelIDGT(v string) predicate.Car {
+	return predicate.Car(sql.FieldGT(FieldCarModelID, v))
+}
+
+// CarModelIDGTE applies the GTE predicate on the "car_model_id" field.
+func CarModelIDGTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldGTE(FieldCarModelID, v))
+}
+
+// CarModelIDLT applies the LT predicate on the "car_model_id" field.
+func CarModelIDLT(v string) predicate.Car {
+	return predicate.Car(sql.FieldLT(FieldCarModelID, v))
+}
+
+// CarModelIDLTE applies the LTE predicate on the "car_model_id" field.
+func CarModelIDLTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldLTE(FieldCarModelID, v))
+}
+
+// CarModelIDContains applies the Contains predicate on the "car_model_id" field.
+func CarModelIDContains(v string) predicate.Car {
+	return predicate.Car(sql.FieldContains(FieldCarModelID, v))
+}
+
+// CarModelIDHasPrefix applies the HasPrefix predicate on the "car_model_id" field.
+func CarModelIDHasPrefix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefix(FieldCarModelID, v))
+}
+
+// CarModelIDHasSuffix applies the HasSuffix predicate on the "car_model_id" field.
+func CarModelIDHasSuffix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasSuffix(FieldCarModelID, v))
+}
+
+// CarModelIDIsNil applies the IsNil predicate on the "car_model_id" field.
+func CarModelIDIsNil() predicate.Car {
+	return predicate.Car(sql.FieldIsNull(FieldCarModelID))
+}
+
+// CarModelIDNotNil applies the NotNil predicate on the "car_model_id" field.
+func CarModelIDNotNil() predicate.Car {
+	return predicate.Car(sql.FieldNotNull(FieldCarModelID))
+}
+
+// CarModelIDEqualFold applies the EqualFold predicate on the "car_model_id" field.
+func CarModelIDEqualFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldEqualFold(FieldCarModelID, v))
+}
+
+// CarModelIDContainsFold applies the ContainsFold predicate on the "car_model_id" field.
+func CarModelIDContainsFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldContainsFold(FieldCarModelID, v))
+}
+
+// VinEQ applies the EQ predicate on the "vin" field.
+func VinEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldVin, v))
+}
+
+// VinNEQ applies the NEQ predicate on the "vin" field.
+func VinNEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldNEQ(FieldVin, v))
+}
+
+// VinIn applies the In predicate on the "vin" field.
+func VinIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldIn(FieldVin, vs...))
+}
+
+// VinNotIn applies the NotIn predicate on the "vin" field.
+func VinNotIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldNotIn(FieldVin, vs...))
+}
+
+// VinGT applies the GT predicate on the "vin" field.
+func VinGT(v string) predicate.Car {
+	return predicate.Car(sql.FieldGT(FieldVin, v))
+}
+
+// VinGTE applies the GTE predicate on the "vin" field.
+func VinGTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldGTE(FieldVin, v))
+}
+
+// VinLT applies the LT predicate on the "vin" field.
+func VinLT(v string) predicate.Car {
+	return predicate.Car(sql.FieldLT(FieldVin, v))
+}
+
+// VinLTE applies the LTE predicate on the "vin" field.
+func VinLTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldLTE(FieldVin, v))
+}
+
+// VinContains applies the Contains predicate on the "vin" field.
+func VinContains(v string) predicate.Car {
+	return predicate.Car(sql.FieldContains(FieldVin, v))
+}
+
+// VinHasPrefix applies the HasPrefix predicate on the "vin" field.
+func VinHasPrefix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefix(FieldVin, v))
+}
+
+// VinHasSuffix applies the HasSuffix predicate on the "vin" field.
+func VinHasSuffix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasSuffix(FieldVin, v))
+}
+
+// VinIsNil applies the IsNil predicate on the "vin" field.
+func VinIsNil() predicate.Car {
+	return predicate.Car(sql.FieldIsNull(FieldVin))
+}
+
+// VinNotNil applies the NotNil predicate on the "vin" field.
+func VinNotNil() predicate.Car {
+	return predicate.Car(sql.FieldNotNull(FieldVin))
+}
+
+// VinEqualFold applies the EqualFold predicate on the "vin" field.
+func VinEqualFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldEqualFold(FieldVin, v))
+}
+
+// VinContainsFold applies the ContainsFold predicate on the "vin" field.
+func VinContainsFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldContainsFold(FieldVin, v))
+}
+
+// LicensePlateEQ applies the EQ predicate on the "license_plate" field.
+func LicensePlateEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldLicensePlate, v))
+}
+
+// LicensePlateNEQ applies the NEQ predicate on the "license_plate" field.
+func LicensePlateNEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldNEQ(FieldLicensePlate, v))
+}
+
+// LicensePlateIn applies the In predicate on the "license_plate" field.
+func LicensePlateIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldIn(FieldLicensePlate, vs...))
+}
+
+// LicensePlateNotIn applies the NotIn predicate on the "license_plate" field.
+func LicensePlateNotIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldNotIn(FieldLicensePlate, vs...))
+}
+
+// LicensePlateGT applies the GT predicate on the "license_plate" field.
+func LicensePlateGT(v string) predicate.Car {
+	return predicate.Car(sql.FieldGT(FieldLicensePlate, v))
+}
+
+// LicensePlateGTE applies the GTE predicate on the "license_plate" field.
+func LicensePlateGTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldGTE(FieldLicensePlate, v))
+}
+
+// LicensePlateLT applies the LT predicate on the "license_plate" field.
+func LicensePlateLT(v string) predicate.Car {
+	return predicate.Car(sql.FieldLT(FieldLicensePlate, v))
+}
+
+// LicensePlateLTE applies the LTE predicate on the "license_plate" field.
+func LicensePlateLTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldLTE(FieldLicensePlate, v))
+}
+
+// LicensePlateContains applies the Contains predicate on the "license_plate" field.
+func LicensePlateContains(v string) predicate.Car {
+	return predicate.Car(sql.FieldContains(FieldLicensePlate, v))
+}
+
+// LicensePlateHasPrefix applies the HasPrefix predicate on the "license_plate" field.
+func LicensePlateHasPrefix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefix(FieldLicensePlate, v))
+}
+
+// LicensePlateHasSuffix applies the HasSuffix predicate on the "license_plate" field.
+func LicensePlateHasSuffix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasSuffix(FieldLicensePlate, v))
+}
+
+// LicensePlateIsNil applies the IsNil predicate on the "license_plate" field.
+func LicensePlateIsNil() predicate.Car {
+	return predicate.Car(sql.FieldIsNull(FieldLicensePlate))
+}
+
+// LicensePlateNotNil applies the NotNil predicate on the "license_plate" field.
+func LicensePlateNotNil() predicate.Car {
+	return predicate.Car(sql.FieldNotNull(FieldLicensePlate))
+}
+
+// LicensePlateEqualFold applies the EqualFold predicate on the "license_plate" field.
+func LicensePlateEqualFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldEqualFold(FieldLicensePlate, v))
+}
+
+// LicensePlateContainsFold applies the ContainsFold predicate on the "license_plate" field.
+func LicensePlateContainsFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldContainsFold(FieldLicensePlate, v))
+}
+
+// LicensePlateCountryEQ applies the EQ predicate on the "license_plate_country" field.
+func LicensePlateCountryEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldLicensePlateCountry, v))
+}
+
+// LicensePlateCountryNEQ applies the NEQ predicate on the "license_plate_country" field.
+func LicensePlateCountryNEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldNEQ(FieldLicensePlateCountry, v))
+}
+
+// LicensePlateCountryIn applies the In predicate on the "license_plate_country" field.
+func LicensePlateCountryIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldIn(FieldLicensePlateCountry, vs...))
+}
+
+// LicensePlateCountryNotIn applies the NotIn predicate on the "license_plate_country" field.
+func LicensePlateCountryNotIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldNotIn(FieldLicensePlateCountry, vs...))
+}
+
+// LicensePlateCountryGT applies the GT predicate on the "license_plate_country" field.
+func LicensePlateCountryGT(v string) predicate.Car {
+	return predicate.Car(sql.FieldGT(FieldLicensePlateCountry, v))
+}
+
+// LicensePlateCountryGTE applies the GTE predicate on the "license_plate_country" field.
+func LicensePlateCountryGTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldGTE(FieldLicensePlateCountry, v))
+}
+
+// LicensePlateCountryLT applies the LT predicate on the "license_plate_country" field.
+func LicensePlateCountryLT(v string) predicate.Car {
+	return predicate.Car(sql.FieldLT(FieldLicensePlateCountry, v))
+}
+
+// LicensePlateCountryLTE applies the LTE predicate on the "license_plate_country" field.
+func LicensePlateCountryLTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldLTE(FieldLicensePlateCountry, v))
+}
+
+// LicensePlateCountryContains applies the Contains predicate on the "license_plate_country" field.
+func LicensePlateCountryContains(v string) predicate.Car {
+	return predicate.Car(sql.FieldContains(FieldLicensePlateCountry, v))
 }
 
-// ModelNEQ applies the NEQ predicate on the "model" field.
-func ModelNEQ(v string) predicate.Car {
-	return predicate.Car(sql.FieldNEQ(FieldModel, v))
+// LicensePlateCountryHasPrefix applies the HasPrefix predicate on the "license_plate_country" field.
+func LicensePlateCountryHasPrefix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefix(FieldLicensePlateCountry, v))
 }
 
-// ModelIn applies the In predicate on the "model" field.
-func ModelIn(vs ...string) predicate.Car {
-	return predicate.Car(sql.FieldIn(FieldModel, vs...))
+// LicensePlateCountryHasSuffix applies the HasSuffix predicate on the "license_plate_country" field.
+func LicensePlateCountryHasSuffix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasSuffix(FieldLicensePlateCountry, v))
 }
 
-// ModelNotIn applies the NotIn predicate on the "model" field.
-func ModelNotIn(vs ...string) predicate.Car {
-	return predicate.Car(sql.FieldNotIn(FieldModel, vs...))
+// LicensePlateCountryIsNil applies the IsNil predicate on the "license_plate_country" field.
+func LicensePlateCountryIsNil() predicate.Car {
+	return predicate.Car(sql.FieldIsNull(FieldLicensePlateCountry))
 }
 
-// ModelGT applies the GT predicate on the "model" field.
-func ModelGT(v string) predicate.Car {
-	return predicate.Car(sql.FieldGT(FieldModel, v))
+// LicensePlateCountryNotNil applies the NotNil predicate on the "license_plate_country" field.
+func LicensePlateCountryNotNil() predicate.Car {
+	return predicate.Car(sql.FieldNotNull(FieldLicensePlateCountry))
 }
 
-// ModelGTE applies the GTE predicate on the "model" field.
-func ModelGTE(v string) predicate.Car {
-	return predicate.Car(sql.FieldGTE(FieldModel, v))
+// LicensePlateCountryEqualFold applies the EqualFold predicate on the "license_plate_country" field.
+func LicensePlateCountryEqualFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldEqualFold(FieldLicensePlateCountry, v))
 }
 
-// ModelLT applies the LT predicate on the "model" field.
-func ModelLT(v string) predicate.Car {
-	return predicate.Car(sql.FieldLT(FieldModel, v))
+// LicensePlateCountryContainsFold applies the ContainsFold predicate on the "license_plate_country" field.
+func LicensePlateCountryContainsFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldContainsFold(FieldLicensePlateCountry, v))
 }
 
-// ModelLTE applies the LTE predicate on the "model" field.
-func ModelLTE(v string) predicate.Car {
-	return predicate.Car(sql.FieldLTE(FieldModel, v))
+// HomeBranchIDEQ applies the EQ predicate on the "home_branch_id" field.
+func HomeBranchIDEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldHomeBranchID, v))
 }
 
-// ModelContains applies the Contains predicate on the "model" field.
-func ModelContains(v string) predicate.Car {
-	return predicate.Car(sql.FieldContains(FieldModel, v))
+// HomeBranchIDNEQ applies the NEQ predicate on the "home_branch_id" field.
+func HomeBranchIDNEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldNEQ(FieldHomeBranchID, v))
 }
 
-// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
-func ModelHasPrefix(v string) predicate.Car {
-	return predicate.Car(sql.FieldHasPrefix(FieldModel, v))
+// HomeBranchIDIn applies the In predicate on the "home_branch_id" field.
+func HomeBranchIDIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldIn(FieldHomeBranchID, vs...))
 }
 
-// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
-func ModelHasSuffix(v string) predicate.Car {
-	return predicate.Car(sql.FieldHasSuffix(FieldModel, v))
+// HomeBranchIDNotIn applies the NotIn predicate on the "home_branch_id" field.
+func HomeBranchIDNotIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldNotIn(FieldHomeBranchID, vs...))
 }
 
-// ModelEqualFold applies the EqualFold predicate on the "model" field.
-func ModelEqualFold(v string) predicate.Car {
-	return predicate.Car(sql.FieldEqualFold(FieldModel, v))
+// HomeBranchIDGT applies the GT predicate on the "home_branch_id" field.
+func HomeBranchIDGT(v string) predicate.Car {
+	return predicate.Car(sql.FieldGT(FieldHomeBranchID, v))
 }
 
-// ModelContainsFold applies the ContainsFold predicate on the "model" field.
-func ModelContainsFold(v string) predicate.Car {
-	return predicate.Car(sql.FieldContainsFold(FieldModel, v))
+// HomeBranchIDGTE applies the GTE predicate on the "home_branch_id" field.
+func HomeBranchIDGTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldGTE(FieldHomeBranchID, v))
+}
+
+// HomeBranchIDLT applies the LT predicate on the "home_branch_id" field.
+func HomeBranchIDLT(v string) predicate.Car {
+	return predicate.Car(sql.FieldLT(FieldHomeBranchID, v))
+}
+
+// HomeBranchIDLTE applies the LTE predicate on the "home_branch_id" field.
+func HomeBranchIDLTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldLTE(FieldHomeBranchID, v))
+}
+
+// HomeBranchIDContains applies the Contains predicate on the "home_branch_id" field.
+func HomeBranchIDContains(v string) predicate.Car {
+	return predicate.Car(sql.FieldContains(FieldHomeBranchID, v))
+}
+
+// HomeBranchIDHasPrefix applies the HasPrefix predicate on the "home_branch_id" field.
+func HomeBranchIDHasPrefix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefix(FieldHomeBranchID, v))
+}
+
+// HomeBranchIDHasSuffix applies the HasSuffix predicate on the "home_branch_id" field.
+func HomeBranchIDHasSuffix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasSuffix(FieldHomeBranchID, v))
+}
+
+// HomeBranchIDIsNil applies the IsNil predicate on the "home_branch_id" field.
+func HomeBranchIDIsNil() predicate.Car {
+	return predicate.Car(sql.FieldIsNull(FieldHomeBranchID))
+}
+
+// HomeBranchIDNotNil applies the NotNil predicate on the "home_branch_id" field.
+func HomeBranchIDNotNil() predicate.Car {
+	return predicate.Car(sql.FieldNotNull(FieldHomeBranchID))
+}
+
+// HomeBranchIDEqualFold applies the EqualFold predicate on the "home_branch_id" field.
+func HomeBranchIDEqualFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldEqualFold(FieldHomeBranchID, v))
+}
+
+// HomeBranchIDContainsFold applies the ContainsFold predicate on the "home_branch_id" field.
+func HomeBranchIDContainsFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldContainsFold(FieldHomeBranchID, v))
+}
+
+// CurrentBranchIDEQ applies the EQ predicate on the "current_branch_id" field.
+func CurrentBranchIDEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldEQ(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDNEQ applies the NEQ predicate on the "current_branch_id" field.
+func CurrentBranchIDNEQ(v string) predicate.Car {
+	return predicate.Car(sql.FieldNEQ(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDIn applies the In predicate on the "current_branch_id" field.
+func CurrentBranchIDIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldIn(FieldCurrentBranchID, vs...))
+}
+
+// CurrentBranchIDNotIn applies the NotIn predicate on the "current_branch_id" field.
+func CurrentBranchIDNotIn(vs ...string) predicate.Car {
+	return predicate.Car(sql.FieldNotIn(FieldCurrentBranchID, vs...))
+}
+
+// CurrentBranchIDGT applies the GT predicate on the "current_branch_id" field.
+func CurrentBranchIDGT(v string) predicate.Car {
+	return predicate.Car(sql.FieldGT(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDGTE applies the GTE predicate on the "current_branch_id" field.
+func CurrentBranchIDGTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldGTE(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDLT applies the LT predicate on the "current_branch_id" field.
+func CurrentBranchIDLT(v string) predicate.Car {
+	return predicate.Car(sql.FieldLT(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDLTE applies the LTE predicate on the "current_branch_id" field.
+func CurrentBranchIDLTE(v string) predicate.Car {
+	return predicate.Car(sql.FieldLTE(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDContains applies the Contains predicate on the "current_branch_id" field.
+func CurrentBranchIDContains(v string) predicate.Car {
+	return predicate.Car(sql.FieldContains(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDHasPrefix applies the HasPrefix predicate on the "current_branch_id" field.
+func CurrentBranchIDHasPrefix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasPrefix(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDHasSuffix applies the HasSuffix predicate on the "current_branch_id" field.
+func CurrentBranchIDHasSuffix(v string) predicate.Car {
+	return predicate.Car(sql.FieldHasSuffix(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDIsNil applies the IsNil predicate on the "current_branch_id" field.
+func CurrentBranchIDIsNil() predicate.Car {
+	return predicate.Car(sql.FieldIsNull(FieldCurrentBranchID))
+}
+
+// CurrentBranchIDNotNil applies the NotNil predicate on the "current_branch_id" field.
+func CurrentBranchIDNotNil() predicate.Car {
+	return predicate.Car(sql.FieldNotNull(FieldCurrentBranchID))
+}
+
+// CurrentBranchIDEqualFold applies the EqualFold predicate on the "current_branch_id" field.
+func CurrentBranchIDEqualFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldEqualFold(FieldCurrentBranchID, v))
+}
+
+// CurrentBranchIDContainsFold applies the ContainsFold predicate on the "current_branch_id" field.
+func CurrentBranchIDContainsFold(v string) predicate.Car {
+	return predicate.Car(sql.FieldContainsFold(FieldCurrentBranchID, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -393,6 +803,75 @@ func HasTenantWith(preds ...predicate.Tenant) predicate.Car {
 	})
 }
 
+// HasCarModel applies the HasEdge predicate on the "car_model" edge.
+func HasCarModel() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CarModelTable, CarModelColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarModelWith applies the HasEdge predicate on the "car_model" edge with a given conditions (other predicates).
+func HasCarModelWith(preds ...predicate.CarModel) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := newCarModelStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHomeBranch applies the HasEdge predicate on the "home_branch" edge.
+func HasHomeBranch() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HomeBranchTable, HomeBranchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHomeBranchWith applies the HasEdge predicate on the "home_branch" edge with a given conditions (other predicates).
+func HasHomeBranchWith(preds ...predicate.Branch) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := newHomeBranchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCurrentBranch applies the HasEdge predicate on the "current_branch" edge.
+func HasCurrentBranch() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CurrentBranchTable, CurrentBranchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCurrentBranchWith applies the HasEdge predicate on the "current_branch" edge with a given conditions (other predicates).
+func HasCurrentBranchWith(preds ...predicate.Branch) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := newCurrentBranchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasRentals applies the HasEdge predicate on the "rentals" edge.
 func HasRentals() predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -416,6 +895,29 @@ func HasRentalsWith(preds ...predicate.Rental) predicate.Car {
 	})
 }
 
+// HasMaintenanceWindows applies the HasEdge predicate on the "maintenance_windows" edge.
+func HasMaintenanceWindows() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MaintenanceWindowsTable, MaintenanceWindowsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMaintenanceWindowsWith applies the HasEdge predicate on the "maintenance_windows" edge with a given conditions (other predicates).
+func HasMaintenanceWindowsWith(preds ...predicate.MaintenanceWindow) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		step := newMaintenanceWindowsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Car) predicate.Car {
 	return predicate.Car(sql.AndPredicates(predicates...))
@@ -10,7 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
 )
@@ -28,9 +31,87 @@ func (_c *CarCreate) SetTenantID(v string) *CarCreate {
 	return _c
 }
 
-// SetModel sets the "model" field.
-func (_c *CarCreate) SetModel(v string) *CarCreate {
-	_c.mutation.SetModel(v)
+// SetCarModelID sets the "car_model_id" field.
+func (_c *CarCreate) SetCarModelID(v string) *CarCreate {
+	_c.mutation.SetCarModelID(v)
+	return _c
+}
+
+// SetNillableCarModelID sets the "car_model_id" field if the given value is not nil.
+func (_c *CarCreate) SetNillableCarModelID(v *string) *CarCreate {
+	if v != nil {
+		_c.SetCarModelID(*v)
+	}
+	return _c
+}
+
+// SetVin sets the "vin" field.
+func (_c *CarCreate) SetVin(v string) *CarCreate {
+	_c.mutation.SetVin(v)
+	return _c
+}
+
+// SetNillableVin sets the "vin" field if the given value is not nil.
+func (_c *CarCreate) SetNillableVin(v *string) *CarCreate {
+	if v != nil {
+		_c.SetVin(*v)
+	}
+	return _c
+}
+
+// SetLicensePlate sets the "license_plate" field.
+func (_c *CarCreate) SetLicensePlate(v string) *CarCreate {
+	_c.mutation.SetLicensePlate(v)
+	return _c
+}
+
+// SetNillableLicensePlate sets the "license_plate" field if the given value is not nil.
+func (_c *CarCreate) SetNillableLicensePlate(v *string) *CarCreate {
+	if v != nil {
+		_c.SetLicensePlate(*v)
+	}
+	return _c
+}
+
+// SetLicensePlateCountry sets the "license_plate_country" field.
+func (_c *CarCreate) SetLicensePlateCountry(v string) *CarCreate {
+	_c.mutation.SetLicensePlateCountry(v)
+	return _c
+}
+
+// SetNillableLicensePlateCountry sets the "license_plate_country" field if the given value is not nil.
+func (_c *CarCreate) SetNillableLicensePlateCountry(v *string) *CarCreate {
+	if v != nil {
+		_c.SetLicensePlateCountry(*v)
+	}
+	return _c
+}
+
+// SetHomeBranchID sets the "home_branch_id" field.
+func (_c *CarCreate) SetHomeBranchID(v string) *CarCreate {
+	_c.mutation.SetHomeBranchID(v)
+	return _c
+}
+
+// SetNillableHomeBranchID sets the "home_branch_id" field if the given value is not nil.
+func (_c *CarCreate) SetNillableHomeBranchID(v *string) *CarCreate {
+	if v != nil {
+		_c.SetHomeBranchID(*v)
+	}
+	return _c
+}
+
+// SetCurrentBranchID sets the "current_branch_id" field.
+func (_c *CarCreate) SetCurrentBranchID(v string) *CarCreate {
+	_c.mutation.SetCurrentBranchID(v)
+	return _c
+}
+
+// SetNillableCurrentBranchID sets the "current_branch_id" field if the given value is not nil.
+func (_c *CarCreate) SetNillableCurrentBranchID(v *string) *CarCreate {
+	if v != nil {
+		_c.SetCurrentBranchID(*v)
+	}
 	return _c
 }
 
@@ -87,6 +168,21 @@ func (_c *CarCreate) SetTenant(v *Tenant) *CarCreate {
 	return _c.SetTenantID(v.ID)
 }
 
+// SetCarModel sets the "car_model" edge to the CarModel entity.
+func (_c *CarCreate) SetCarModel(v *CarModel) *CarCreate {
+	return _c.SetCarModelID(v.ID)
+}
+
+// SetHomeBranch sets the "home_branch" edge to the Branch entity.
+func (_c *CarCreate) SetHomeBranch(v *Branch) *CarCreate {
+	return _c.SetHomeBranchID(v.ID)
+}
+
+// SetCurrentBranch sets the "current_branch" edge to the Branch entity.
+func (_c *CarCreate) SetCurrentBranch(v *Branch) *CarCreate {
+	return _c.SetCurrentBranchID(v.ID)
+}
+
 // AddRentalIDs adds the "rentals" edge to the Rental entity by IDs.
 func (_c *CarCreate) AddRentalIDs(ids ...string) *CarCreate {
 	_c.mutation.AddRentalIDs(ids...)
@@ -102,6 +198,21 @@ func (_c *CarCreate) AddRentals(v ...*Rental) *CarCreate {
 	return _c.AddRentalIDs(ids...)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (_c *CarCreate) AddMaintenanceWindowIDs(ids ...string) *CarCreate {
+	_c.mutation.AddMaintenanceWindowIDs(ids...)
+	return _c
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_c *CarCreate) AddMaintenanceWindows(v ...*MaintenanceWindow) *CarCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMaintenanceWindowIDs(ids...)
+}
+
 // Mutation returns the CarMutation object of the builder.
 func (_c *CarCreate) Mutation() *CarMutation {
 	return _c.mutation
@@ -144,12 +255,34 @@ func (_c *CarCreate) check() error {
 			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Car.tenant_id": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.Model(); !ok {
-		return &ValidationError{Name: "model", err: errors.New(`entgen: missing required field "Car.model"`)}
+	if v, ok := _c.mutation.CarModelID(); ok {
+		if err := car.CarModelIDValidator(v); err != nil {
+			return &ValidationError{Name: "car_model_id", err: fmt.Errorf(`entgen: validator failed for field "Car.car_model_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Vin(); ok {
+		if err := car.VinValidator(v); err != nil {
+			return &ValidationError{Name: "vin", err: fmt.Errorf(`entgen: validator failed for field "Car.vin": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LicensePlate(); ok {
+		if err := car.LicensePlateValidator(v); err != nil {
+			return &ValidationError{Name: "license_plate", err: fmt.Errorf(`entgen: validator failed for field "Car.license_plate": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LicensePlateCountry(); ok {
+		if err := car.LicensePlateCountryValidator(v); err != nil {
+			return &ValidationError{Name: "license_plate_country", err: fmt.Errorf(`entgen: validator failed for field "Car.license_plate_country": %w`, err)}
+		}
 	}
-	if v, ok := _c.mutation.Model(); ok {
-		if err := car.ModelValidator(v); err != nil {
-			return &ValidationError{Name: "model", err: fmt.Errorf(`entgen: validator failed for field "Car.model": %w`, err)}
+	if v, ok := _c.mutation.HomeBranchID(); ok {
+		if err := car.HomeBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "home_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Car.home_branch_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CurrentBranchID(); ok {
+		if err := car.CurrentBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "current_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Car.current_branch_id": %w`, err)}
 		}
 	}
 	if v, ok := _c.mutation.ID(); ok {
@@ -195,9 +328,17 @@ func (_c *CarCreate) createSpec() (*Car, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
-	if value, ok := _c.mutation.Model(); ok {
-		_spec.SetField(car.FieldModel, field.TypeString, value)
-		_node.Model = value
+	if value, ok := _c.mutation.Vin(); ok {
+		_spec.SetField(car.FieldVin, field.TypeString, value)
+		_node.Vin = &value
+	}
+	if value, ok := _c.mutation.LicensePlate(); ok {
+		_spec.SetField(car.FieldLicensePlate, field.TypeString, value)
+		_node.LicensePlate = &value
+	}
+	if value, ok := _c.mutation.LicensePlateCountry(); ok {
+		_spec.SetField(car.FieldLicensePlateCountry, field.TypeString, value)
+		_node.LicensePlateCountry = &value
 	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(car.FieldCreatedAt, field.TypeTime, value)
@@ -228,6 +369,57 @@ func (_c *CarCreate) createSpec() (*Car, *sqlgraph.CreateSpec) {
 		_node.TenantID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CarModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CarModelTable,
+			Columns: []string{car.CarModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CarModelID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.HomeBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.HomeBranchTable,
+			Columns: []string{car.HomeBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.HomeBranchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CurrentBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CurrentBranchTable,
+			Columns: []string{car.CurrentBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CurrentBranchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.RentalsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -244,6 +436,22 @@ func (_c *CarCreate) createSpec() (*Car, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceWindowsTable,
+			Columns: []string{car.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
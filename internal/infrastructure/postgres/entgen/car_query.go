@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
@@ -22,13 +25,17 @@ import (
 // CarQuery is the builder for querying Car entities.
 type CarQuery struct {
 	config
-	ctx         *QueryContext
-	order       []car.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Car
-	withTenant  *TenantQuery
-	withRentals *RentalQuery
-	modifiers   []func(*sql.Selector)
+	ctx                    *QueryContext
+	order                  []car.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Car
+	withTenant             *TenantQuery
+	withCarModel           *CarModelQuery
+	withHomeBranch         *BranchQuery
+	withCurrentBranch      *BranchQuery
+	withRentals            *RentalQuery
+	withMaintenanceWindows *MaintenanceWindowQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +94,72 @@ func (_q *CarQuery) QueryTenant() *TenantQuery {
 	return query
 }
 
+// QueryCarModel chains the current query on the "car_model" edge.
+func (_q *CarQuery) QueryCarModel() *CarModelQuery {
+	query := (&CarModelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(carmodel.Table, carmodel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.CarModelTable, car.CarModelColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHomeBranch chains the current query on the "home_branch" edge.
+func (_q *CarQuery) QueryHomeBranch() *BranchQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.HomeBranchTable, car.HomeBranchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCurrentBranch chains the current query on the "current_branch" edge.
+func (_q *CarQuery) QueryCurrentBranch() *BranchQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.CurrentBranchTable, car.CurrentBranchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryRentals chains the current query on the "rentals" edge.
 func (_q *CarQuery) QueryRentals() *RentalQuery {
 	query := (&RentalClient{config: _q.config}).Query()
@@ -109,6 +182,28 @@ func (_q *CarQuery) QueryRentals() *RentalQuery {
 	return query
 }
 
+// QueryMaintenanceWindows chains the current query on the "maintenance_windows" edge.
+func (_q *CarQuery) QueryMaintenanceWindows() *MaintenanceWindowQuery {
+	query := (&MaintenanceWindowClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, selector),
+			sqlgraph.To(maintenancewindow.Table, maintenancewindow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.MaintenanceWindowsTable, car.MaintenanceWindowsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Car entity from the query.
 // Returns a *NotFoundError when no Car was found.
 func (_q *CarQuery) First(ctx context.Context) (*Car, error) {
@@ -296,13 +391,17 @@ func (_q *CarQuery) Clone() *CarQuery {
 		return nil
 	}
 	return &CarQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]car.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Car{}, _q.predicates...),
-		withTenant:  _q.withTenant.Clone(),
-		withRentals: _q.withRentals.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]car.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.Car{}, _q.predicates...),
+		withTenant:             _q.withTenant.Clone(),
+		withCarModel:           _q.withCarModel.Clone(),
+		withHomeBranch:         _q.withHomeBranch.Clone(),
+		withCurrentBranch:      _q.withCurrentBranch.Clone(),
+		withRentals:            _q.withRentals.Clone(),
+		withMaintenanceWindows: _q.withMaintenanceWindows.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -320,6 +419,39 @@ func (_q *CarQuery) WithTenant(opts ...func(*TenantQuery)) *CarQuery {
 	return _q
 }
 
+// WithCarModel tells the query-builder to eager-load the nodes that are connected to
+// the "car_model" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CarQuery) WithCarModel(opts ...func(*CarModelQuery)) *CarQuery {
+	query := (&CarModelClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCarModel = query
+	return _q
+}
+
+// WithHomeBranch tells the query-builder to eager-load the nodes that are connected to
+// the "home_branch" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CarQuery) WithHomeBranch(opts ...func(*BranchQuery)) *CarQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHomeBranch = query
+	return _q
+}
+
+// WithCurrentBranch tells the query-builder to eager-load the nodes that are connected to
+// the "current_branch" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CarQuery) WithCurrentBranch(opts ...func(*BranchQuery)) *CarQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCurrentBranch = query
+	return _q
+}
+
 // WithRentals tells the query-builder to eager-load the nodes that are connected to
 // the "rentals" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *CarQuery) WithRentals(opts ...func(*RentalQuery)) *CarQuery {
@@ -331,6 +463,17 @@ func (_q *CarQuery) WithRentals(opts ...func(*RentalQuery)) *CarQuery {
 	return _q
 }
 
+// WithMaintenanceWindows tells the query-builder to eager-load the nodes that are connected to
+// the "maintenance_windows" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CarQuery) WithMaintenanceWindows(opts ...func(*MaintenanceWindowQuery)) *CarQuery {
+	query := (&MaintenanceWindowClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMaintenanceWindows = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +552,13 @@ func (_q *CarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Car, err
 	var (
 		nodes       = []*Car{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [6]bool{
 			_q.withTenant != nil,
+			_q.withCarModel != nil,
+			_q.withHomeBranch != nil,
+			_q.withCurrentBranch != nil,
 			_q.withRentals != nil,
+			_q.withMaintenanceWindows != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +588,24 @@ func (_q *CarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Car, err
 			return nil, err
 		}
 	}
+	if query := _q.withCarModel; query != nil {
+		if err := _q.loadCarModel(ctx, query, nodes, nil,
+			func(n *Car, e *CarModel) { n.Edges.CarModel = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withHomeBranch; query != nil {
+		if err := _q.loadHomeBranch(ctx, query, nodes, nil,
+			func(n *Car, e *Branch) { n.Edges.HomeBranch = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCurrentBranch; query != nil {
+		if err := _q.loadCurrentBranch(ctx, query, nodes, nil,
+			func(n *Car, e *Branch) { n.Edges.CurrentBranch = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withRentals; query != nil {
 		if err := _q.loadRentals(ctx, query, nodes,
 			func(n *Car) { n.Edges.Rentals = []*Rental{} },
@@ -448,6 +613,13 @@ func (_q *CarQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Car, err
 			return nil, err
 		}
 	}
+	if query := _q.withMaintenanceWindows; query != nil {
+		if err := _q.loadMaintenanceWindows(ctx, query, nodes,
+			func(n *Car) { n.Edges.MaintenanceWindows = []*MaintenanceWindow{} },
+			func(n *Car, e *MaintenanceWindow) { n.Edges.MaintenanceWindows = append(n.Edges.MaintenanceWindows, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -480,6 +652,99 @@ func (_q *CarQuery) loadTenant(ctx context.Context, query *TenantQuery, nodes []
 	}
 	return nil
 }
+func (_q *CarQuery) loadCarModel(ctx context.Context, query *CarModelQuery, nodes []*Car, init func(*Car), assign func(*Car, *CarModel)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Car)
+	for i := range nodes {
+		fk := nodes[i].CarModelID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(carmodel.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "car_model_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CarQuery) loadHomeBranch(ctx context.Context, query *BranchQuery, nodes []*Car, init func(*Car), assign func(*Car, *Branch)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Car)
+	for i := range nodes {
+		if nodes[i].HomeBranchID == nil {
+			continue
+		}
+		fk := *nodes[i].HomeBranchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(branch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "home_branch_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CarQuery) loadCurrentBranch(ctx context.Context, query *BranchQuery, nodes []*Car, init func(*Car), assign func(*Car, *Branch)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Car)
+	for i := range nodes {
+		if nodes[i].CurrentBranchID == nil {
+			continue
+		}
+		fk := *nodes[i].CurrentBranchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(branch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "current_branch_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *CarQuery) loadRentals(ctx context.Context, query *RentalQuery, nodes []*Car, init func(*Car), assign func(*Car, *Rental)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Car)
@@ -510,6 +775,36 @@ func (_q *CarQuery) loadRentals(ctx context.Context, query *RentalQuery, nodes [
 	}
 	return nil
 }
+func (_q *CarQuery) loadMaintenanceWindows(ctx context.Context, query *MaintenanceWindowQuery, nodes []*Car, init func(*Car), assign func(*Car, *MaintenanceWindow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Car)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(maintenancewindow.FieldCarID)
+	}
+	query.Where(predicate.MaintenanceWindow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(car.MaintenanceWindowsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CarID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "car_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *CarQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -542,6 +837,15 @@ func (_q *CarQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withTenant != nil {
 			_spec.Node.AddColumnOnce(car.FieldTenantID)
 		}
+		if _q.withCarModel != nil {
+			_spec.Node.AddColumnOnce(car.FieldCarModelID)
+		}
+		if _q.withHomeBranch != nil {
+			_spec.Node.AddColumnOnce(car.FieldHomeBranchID)
+		}
+		if _q.withCurrentBranch != nil {
+			_spec.Node.AddColumnOnce(car.FieldCurrentBranchID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
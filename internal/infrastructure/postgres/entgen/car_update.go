@@ -11,7 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
@@ -44,20 +47,126 @@ func (_u *CarUpdate) SetNillableTenantID(v *string) *CarUpdate {
 	return _u
 }
 
-// SetModel sets the "model" field.
-func (_u *CarUpdate) SetModel(v string) *CarUpdate {
-	_u.mutation.SetModel(v)
+// SetCarModelID sets the "car_model_id" field.
+func (_u *CarUpdate) SetCarModelID(v string) *CarUpdate {
+	_u.mutation.SetCarModelID(v)
 	return _u
 }
 
-// SetNillableModel sets the "model" field if the given value is not nil.
-func (_u *CarUpdate) SetNillableModel(v *string) *CarUpdate {
+// SetNillableCarModelID sets the "car_model_id" field if the given value is not nil.
+func (_u *CarUpdate) SetNillableCarModelID(v *string) *CarUpdate {
 	if v != nil {
-		_u.SetModel(*v)
+		_u.SetCarModelID(*v)
 	}
 	return _u
 }
 
+// ClearCarModelID clears the value of the "car_model_id" field.
+func (_u *CarUpdate) ClearCarModelID() *CarUpdate {
+	_u.mutation.ClearCarModelID()
+	return _u
+}
+
+// SetVin sets the "vin" field.
+func (_u *CarUpdate) SetVin(v string) *CarUpdate {
+	_u.mutation.SetVin(v)
+	return _u
+}
+
+// SetNillableVin sets the "vin" field if the given value is not nil.
+func (_u *CarUpdate) SetNillableVin(v *string) *CarUpdate {
+	if v != nil {
+		_u.SetVin(*v)
+	}
+	return _u
+}
+
+// ClearVin clears the value of the "vin" field.
+func (_u *CarUpdate) ClearVin() *CarUpdate {
+	_u.mutation.ClearVin()
+	return _u
+}
+
+// SetLicensePlate sets the "license_plate" field.
+func (_u *CarUpdate) SetLicensePlate(v string) *CarUpdate {
+	_u.mutation.SetLicensePlate(v)
+	return _u
+}
+
+// SetNillableLicensePlate sets the "license_plate" field if the given value is not nil.
+func (_u *CarUpdate) SetNillableLicensePlate(v *string) *CarUpdate {
+	if v != nil {
+		_u.SetLicensePlate(*v)
+	}
+	return _u
+}
+
+// ClearLicensePlate clears the value of the "license_plate" field.
+func (_u *CarUpdate) ClearLicensePlate() *CarUpdate {
+	_u.mutation.ClearLicensePlate()
+	return _u
+}
+
+// SetLicensePlateCountry sets the "license_plate_country" field.
+func (_u *CarUpdate) SetLicensePlateCountry(v string) *CarUpdate {
+	_u.mutation.SetLicensePlateCountry(v)
+	return _u
+}
+
+// SetNillableLicensePlateCountry sets the "license_plate_country" field if the given value is not nil.
+func (_u *CarUpdate) SetNillableLicensePlateCountry(v *string) *CarUpdate {
+	if v != nil {
+		_u.SetLicensePlateCountry(*v)
+	}
+	return _u
+}
+
+// ClearLicensePlateCountry clears the value of the "license_plate_country" field.
+func (_u *CarUpdate) ClearLicensePlateCountry() *CarUpdate {
+	_u.mutation.ClearLicensePlateCountry()
+	return _u
+}
+
+// SetHomeBranchID sets the "home_branch_id" field.
+func (_u *CarUpdate) SetHomeBranchID(v string) *CarUpdate {
+	_u.mutation.SetHomeBranchID(v)
+	return _u
+}
+
+// SetNillableHomeBranchID sets the "home_branch_id" field if the given value is not nil.
+func (_u *CarUpdate) SetNillableHomeBranchID(v *string) *CarUpdate {
+	if v != nil {
+		_u.SetHomeBranchID(*v)
+	}
+	return _u
+}
+
+// ClearHomeBranchID clears the value of the "home_branch_id" field.
+func (_u *CarUpdate) ClearHomeBranchID() *CarUpdate {
+	_u.mutation.ClearHomeBranchID()
+	return _u
+}
+
+// SetCurrentBranchID sets the "current_branch_id" field.
+func (_u *CarUpdate) SetCurrentBranchID(v string) *CarUpdate {
+	_u.mutation.SetCurrentBranchID(v)
+	return _u
+}
+
+// SetNillableCurrentBranchID sets the "current_branch_id" field if the given value is not nil.
+func (_u *CarUpdate) SetNillableCurrentBranchID(v *string) *CarUpdate {
+	if v != nil {
+		_u.SetCurrentBranchID(*v)
+	}
+	return _u
+}
+
+// ClearCurrentBranchID clears the value of the "current_branch_id" field.
+func (_u *CarUpdate) ClearCurrentBranchID() *CarUpdate {
+	_u.mutation.ClearCurrentBranchID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *CarUpdate) SetCreatedAt(v time.Time) *CarUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -123,6 +232,21 @@ func (_u *CarUpdate) SetTenant(v *Tenant) *CarUpdate {
 	return _u.SetTenantID(v.ID)
 }
 
+// SetCarModel sets the "car_model" edge to the CarModel entity.
+func (_u *CarUpdate) SetCarModel(v *CarModel) *CarUpdate {
+	return _u.SetCarModelID(v.ID)
+}
+
+// SetHomeBranch sets the "home_branch" edge to the Branch entity.
+func (_u *CarUpdate) SetHomeBranch(v *Branch) *CarUpdate {
+	return _u.SetHomeBranchID(v.ID)
+}
+
+// SetCurrentBranch sets the "current_branch" edge to the Branch entity.
+func (_u *CarUpdate) SetCurrentBranch(v *Branch) *CarUpdate {
+	return _u.SetCurrentBranchID(v.ID)
+}
+
 // AddRentalIDs adds the "rentals" edge to the Rental entity by IDs.
 func (_u *CarUpdate) AddRentalIDs(ids ...string) *CarUpdate {
 	_u.mutation.AddRentalIDs(ids...)
@@ -138,6 +262,21 @@ func (_u *CarUpdate) AddRentals(v ...*Rental) *CarUpdate {
 	return _u.AddRentalIDs(ids...)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (_u *CarUpdate) AddMaintenanceWindowIDs(ids ...string) *CarUpdate {
+	_u.mutation.AddMaintenanceWindowIDs(ids...)
+	return _u
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_u *CarUpdate) AddMaintenanceWindows(v ...*MaintenanceWindow) *CarUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMaintenanceWindowIDs(ids...)
+}
+
 // Mutation returns the CarMutation object of the builder.
 func (_u *CarUpdate) Mutation() *CarMutation {
 	return _u.mutation
@@ -149,6 +288,24 @@ func (_u *CarUpdate) ClearTenant() *CarUpdate {
 	return _u
 }
 
+// ClearCarModel clears the "car_model" edge to the CarModel entity.
+func (_u *CarUpdate) ClearCarModel() *CarUpdate {
+	_u.mutation.ClearCarModel()
+	return _u
+}
+
+// ClearHomeBranch clears the "home_branch" edge to the Branch entity.
+func (_u *CarUpdate) ClearHomeBranch() *CarUpdate {
+	_u.mutation.ClearHomeBranch()
+	return _u
+}
+
+// ClearCurrentBranch clears the "current_branch" edge to the Branch entity.
+func (_u *CarUpdate) ClearCurrentBranch() *CarUpdate {
+	_u.mutation.ClearCurrentBranch()
+	return _u
+}
+
 // ClearRentals clears all "rentals" edges to the Rental entity.
 func (_u *CarUpdate) ClearRentals() *CarUpdate {
 	_u.mutation.ClearRentals()
@@ -170,6 +327,27 @@ func (_u *CarUpdate) RemoveRentals(v ...*Rental) *CarUpdate {
 	return _u.RemoveRentalIDs(ids...)
 }
 
+// ClearMaintenanceWindows clears all "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_u *CarUpdate) ClearMaintenanceWindows() *CarUpdate {
+	_u.mutation.ClearMaintenanceWindows()
+	return _u
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to MaintenanceWindow entities by IDs.
+func (_u *CarUpdate) RemoveMaintenanceWindowIDs(ids ...string) *CarUpdate {
+	_u.mutation.RemoveMaintenanceWindowIDs(ids...)
+	return _u
+}
+
+// RemoveMaintenanceWindows removes "maintenance_windows" edges to MaintenanceWindow entities.
+func (_u *CarUpdate) RemoveMaintenanceWindows(v ...*MaintenanceWindow) *CarUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMaintenanceWindowIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CarUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -204,9 +382,34 @@ func (_u *CarUpdate) check() error {
 			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Car.tenant_id": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Model(); ok {
-		if err := car.ModelValidator(v); err != nil {
-			return &ValidationError{Name: "model", err: fmt.Errorf(`entgen: validator failed for field "Car.model": %w`, err)}
+	if v, ok := _u.mutation.CarModelID(); ok {
+		if err := car.CarModelIDValidator(v); err != nil {
+			return &ValidationError{Name: "car_model_id", err: fmt.Errorf(`entgen: validator failed for field "Car.car_model_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Vin(); ok {
+		if err := car.VinValidator(v); err != nil {
+			return &ValidationError{Name: "vin", err: fmt.Errorf(`entgen: validator failed for field "Car.vin": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LicensePlate(); ok {
+		if err := car.LicensePlateValidator(v); err != nil {
+			return &ValidationError{Name: "license_plate", err: fmt.Errorf(`entgen: validator failed for field "Car.license_plate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LicensePlateCountry(); ok {
+		if err := car.LicensePlateCountryValidator(v); err != nil {
+			return &ValidationError{Name: "license_plate_country", err: fmt.Errorf(`entgen: validator failed for field "Car.license_plate_country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HomeBranchID(); ok {
+		if err := car.HomeBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "home_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Car.home_branch_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CurrentBranchID(); ok {
+		if err := car.CurrentBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "current_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Car.current_branch_id": %w`, err)}
 		}
 	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
@@ -227,8 +430,23 @@ func (_u *CarUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.Model(); ok {
-		_spec.SetField(car.FieldModel, field.TypeString, value)
+	if value, ok := _u.mutation.Vin(); ok {
+		_spec.SetField(car.FieldVin, field.TypeString, value)
+	}
+	if _u.mutation.VinCleared() {
+		_spec.ClearField(car.FieldVin, field.TypeString)
+	}
+	if value, ok := _u.mutation.LicensePlate(); ok {
+		_spec.SetField(car.FieldLicensePlate, field.TypeString, value)
+	}
+	if _u.mutation.LicensePlateCleared() {
+		_spec.ClearField(car.FieldLicensePlate, field.TypeString)
+	}
+	if value, ok := _u.mutation.LicensePlateCountry(); ok {
+		_spec.SetField(car.FieldLicensePlateCountry, field.TypeString, value)
+	}
+	if _u.mutation.LicensePlateCountryCleared() {
+		_spec.ClearField(car.FieldLicensePlateCountry, field.TypeString)
 	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(car.FieldCreatedAt, field.TypeTime, value)
@@ -277,6 +495,93 @@ func (_u *CarUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CarModelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CarModelTable,
+			Columns: []string{car.CarModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CarModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CarModelTable,
+			Columns: []string{car.CarModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HomeBranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.HomeBranchTable,
+			Columns: []string{car.HomeBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HomeBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.HomeBranchTable,
+			Columns: []string{car.HomeBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CurrentBranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CurrentBranchTable,
+			Columns: []string{car.CurrentBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CurrentBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CurrentBranchTable,
+			Columns: []string{car.CurrentBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.RentalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -322,6 +627,51 @@ func (_u *CarUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceWindowsTable,
+			Columns: []string{car.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMaintenanceWindowsIDs(); len(nodes) > 0 && !_u.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceWindowsTable,
+			Columns: []string{car.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceWindowsTable,
+			Columns: []string{car.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{car.Label}
@@ -356,20 +706,126 @@ func (_u *CarUpdateOne) SetNillableTenantID(v *string) *CarUpdateOne {
 	return _u
 }
 
-// SetModel sets the "model" field.
-func (_u *CarUpdateOne) SetModel(v string) *CarUpdateOne {
-	_u.mutation.SetModel(v)
+// SetCarModelID sets the "car_model_id" field.
+func (_u *CarUpdateOne) SetCarModelID(v string) *CarUpdateOne {
+	_u.mutation.SetCarModelID(v)
 	return _u
 }
 
-// SetNillableModel sets the "model" field if the given value is not nil.
-func (_u *CarUpdateOne) SetNillableModel(v *string) *CarUpdateOne {
+// SetNillableCarModelID sets the "car_model_id" field if the given value is not nil.
+func (_u *CarUpdateOne) SetNillableCarModelID(v *string) *CarUpdateOne {
 	if v != nil {
-		_u.SetModel(*v)
+		_u.SetCarModelID(*v)
 	}
 	return _u
 }
 
+// ClearCarModelID clears the value of the "car_model_id" field.
+func (_u *CarUpdateOne) ClearCarModelID() *CarUpdateOne {
+	_u.mutation.ClearCarModelID()
+	return _u
+}
+
+// SetVin sets the "vin" field.
+func (_u *CarUpdateOne) SetVin(v string) *CarUpdateOne {
+	_u.mutation.SetVin(v)
+	return _u
+}
+
+// SetNillableVin sets the "vin" field if the given value is not nil.
+func (_u *CarUpdateOne) SetNillableVin(v *string) *CarUpdateOne {
+	if v != nil {
+		_u.SetVin(*v)
+	}
+	return _u
+}
+
+// ClearVin clears the value of the "vin" field.
+func (_u *CarUpdateOne) ClearVin() *CarUpdateOne {
+	_u.mutation.ClearVin()
+	return _u
+}
+
+// SetLicensePlate sets the "license_plate" field.
+func (_u *CarUpdateOne) SetLicensePlate(v string) *CarUpdateOne {
+	_u.mutation.SetLicensePlate(v)
+	return _u
+}
+
+// SetNillableLicensePlate sets the "license_plate" field if the given value is not nil.
+func (_u *CarUpdateOne) SetNillableLicensePlate(v *string) *CarUpdateOne {
+	if v != nil {
+		_u.SetLicensePlate(*v)
+	}
+	return _u
+}
+
+// ClearLicensePlate clears the value of the "license_plate" field.
+func (_u *CarUpdateOne) ClearLicensePlate() *CarUpdateOne {
+	_u.mutation.ClearLicensePlate()
+	return _u
+}
+
+// SetLicensePlateCountry sets the "license_plate_country" field.
+func (_u *CarUpdateOne) SetLicensePlateCountry(v string) *CarUpdateOne {
+	_u.mutation.SetLicensePlateCountry(v)
+	return _u
+}
+
+// SetNillableLicensePlateCountry sets the "license_plate_country" field if the given value is not nil.
+func (_u *CarUpdateOne) SetNillableLicensePlateCountry(v *string) *CarUpdateOne {
+	if v != nil {
+		_u.SetLicensePlateCountry(*v)
+	}
+	return _u
+}
+
+// ClearLicensePlateCountry clears the value of the "license_plate_country" field.
+func (_u *CarUpdateOne) ClearLicensePlateCountry() *CarUpdateOne {
+	_u.mutation.ClearLicensePlateCountry()
+	return _u
+}
+
+// SetHomeBranchID sets the "home_branch_id" field.
+func (_u *CarUpdateOne) SetHomeBranchID(v string) *CarUpdateOne {
+	_u.mutation.SetHomeBranchID(v)
+	return _u
+}
+
+// SetNillableHomeBranchID sets the "home_branch_id" field if the given value is not nil.
+func (_u *CarUpdateOne) SetNillableHomeBranchID(v *string) *CarUpdateOne {
+	if v != nil {
+		_u.SetHomeBranchID(*v)
+	}
+	return _u
+}
+
+// ClearHomeBranchID clears the value of the "home_branch_id" field.
+func (_u *CarUpdateOne) ClearHomeBranchID() *CarUpdateOne {
+	_u.mutation.ClearHomeBranchID()
+	return _u
+}
+
+// SetCurrentBranchID sets the "current_branch_id" field.
+func (_u *CarUpdateOne) SetCurrentBranchID(v string) *CarUpdateOne {
+	_u.mutation.SetCurrentBranchID(v)
+	return _u
+}
+
+// SetNillableCurrentBranchID sets the "current_branch_id" field if the given value is not nil.
+func (_u *CarUpdateOne) SetNillableCurrentBranchID(v *string) *CarUpdateOne {
+	if v != nil {
+		_u.SetCurrentBranchID(*v)
+	}
+	return _u
+}
+
+// ClearCurrentBranchID clears the value of the "current_branch_id" field.
+func (_u *CarUpdateOne) ClearCurrentBranchID() *CarUpdateOne {
+	_u.mutation.ClearCurrentBranchID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *CarUpdateOne) SetCreatedAt(v time.Time) *CarUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -435,6 +891,21 @@ func (_u *CarUpdateOne) SetTenant(v *Tenant) *CarUpdateOne {
 	return _u.SetTenantID(v.ID)
 }
 
+// SetCarModel sets the "car_model" edge to the CarModel entity.
+func (_u *CarUpdateOne) SetCarModel(v *CarModel) *CarUpdateOne {
+	return _u.SetCarModelID(v.ID)
+}
+
+// SetHomeBranch sets the "home_branch" edge to the Branch entity.
+func (_u *CarUpdateOne) SetHomeBranch(v *Branch) *CarUpdateOne {
+	return _u.SetHomeBranchID(v.ID)
+}
+
+// SetCurrentBranch sets the "current_branch" edge to the Branch entity.
+func (_u *CarUpdateOne) SetCurrentBranch(v *Branch) *CarUpdateOne {
+	return _u.SetCurrentBranchID(v.ID)
+}
+
 // AddRentalIDs adds the "rentals" edge to the Rental entity by IDs.
 func (_u *CarUpdateOne) AddRentalIDs(ids ...string) *CarUpdateOne {
 	_u.mutation.AddRentalIDs(ids...)
@@ -450,6 +921,21 @@ func (_u *CarUpdateOne) AddRentals(v ...*Rental) *CarUpdateOne {
 	return _u.AddRentalIDs(ids...)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (_u *CarUpdateOne) AddMaintenanceWindowIDs(ids ...string) *CarUpdateOne {
+	_u.mutation.AddMaintenanceWindowIDs(ids...)
+	return _u
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_u *CarUpdateOne) AddMaintenanceWindows(v ...*MaintenanceWindow) *CarUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMaintenanceWindowIDs(ids...)
+}
+
 // Mutation returns the CarMutation object of the builder.
 func (_u *CarUpdateOne) Mutation() *CarMutation {
 	return _u.mutation
@@ -461,6 +947,24 @@ func (_u *CarUpdateOne) ClearTenant() *CarUpdateOne {
 	return _u
 }
 
+// ClearCarModel clears the "car_model" edge to the CarModel entity.
+func (_u *CarUpdateOne) ClearCarModel() *CarUpdateOne {
+	_u.mutation.ClearCarModel()
+	return _u
+}
+
+// ClearHomeBranch clears the "home_branch" edge to the Branch entity.
+func (_u *CarUpdateOne) ClearHomeBranch() *CarUpdateOne {
+	_u.mutation.ClearHomeBranch()
+	return _u
+}
+
+// ClearCurrentBranch clears the "current_branch" edge to the Branch entity.
+func (_u *CarUpdateOne) ClearCurrentBranch() *CarUpdateOne {
+	_u.mutation.ClearCurrentBranch()
+	return _u
+}
+
 // ClearRentals clears all "rentals" edges to the Rental entity.
 func (_u *CarUpdateOne) ClearRentals() *CarUpdateOne {
 	_u.mutation.ClearRentals()
@@ -482,6 +986,27 @@ func (_u *CarUpdateOne) RemoveRentals(v ...*Rental) *CarUpdateOne {
 	return _u.RemoveRentalIDs(ids...)
 }
 
+// ClearMaintenanceWindows clears all "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_u *CarUpdateOne) ClearMaintenanceWindows() *CarUpdateOne {
+	_u.mutation.ClearMaintenanceWindows()
+	return _u
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to MaintenanceWindow entities by IDs.
+func (_u *CarUpdateOne) RemoveMaintenanceWindowIDs(ids ...string) *CarUpdateOne {
+	_u.mutation.RemoveMaintenanceWindowIDs(ids...)
+	return _u
+}
+
+// RemoveMaintenanceWindows removes "maintenance_windows" edges to MaintenanceWindow entities.
+func (_u *CarUpdateOne) RemoveMaintenanceWindows(v ...*MaintenanceWindow) *CarUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMaintenanceWindowIDs(ids...)
+}
+
 // Where appends a list predicates to the CarUpdate builder.
 func (_u *CarUpdateOne) Where(ps ...predicate.Car) *CarUpdateOne {
 	_u.mutation.Where(ps...)
@@ -529,9 +1054,34 @@ func (_u *CarUpdateOne) check() error {
 			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Car.tenant_id": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Model(); ok {
-		if err := car.ModelValidator(v); err != nil {
-			return &ValidationError{Name: "model", err: fmt.Errorf(`entgen: validator failed for field "Car.model": %w`, err)}
+	if v, ok := _u.mutation.CarModelID(); ok {
+		if err := car.CarModelIDValidator(v); err != nil {
+			return &ValidationError{Name: "car_model_id", err: fmt.Errorf(`entgen: validator failed for field "Car.car_model_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Vin(); ok {
+		if err := car.VinValidator(v); err != nil {
+			return &ValidationError{Name: "vin", err: fmt.Errorf(`entgen: validator failed for field "Car.vin": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LicensePlate(); ok {
+		if err := car.LicensePlateValidator(v); err != nil {
+			return &ValidationError{Name: "license_plate", err: fmt.Errorf(`entgen: validator failed for field "Car.license_plate": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LicensePlateCountry(); ok {
+		if err := car.LicensePlateCountryValidator(v); err != nil {
+			return &ValidationError{Name: "license_plate_country", err: fmt.Errorf(`entgen: validator failed for field "Car.license_plate_country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.HomeBranchID(); ok {
+		if err := car.HomeBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "home_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Car.home_branch_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CurrentBranchID(); ok {
+		if err := car.CurrentBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "current_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Car.current_branch_id": %w`, err)}
 		}
 	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
@@ -569,8 +1119,23 @@ func (_u *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.Model(); ok {
-		_spec.SetField(car.FieldModel, field.TypeString, value)
+	if value, ok := _u.mutation.Vin(); ok {
+		_spec.SetField(car.FieldVin, field.TypeString, value)
+	}
+	if _u.mutation.VinCleared() {
+		_spec.ClearField(car.FieldVin, field.TypeString)
+	}
+	if value, ok := _u.mutation.LicensePlate(); ok {
+		_spec.SetField(car.FieldLicensePlate, field.TypeString, value)
+	}
+	if _u.mutation.LicensePlateCleared() {
+		_spec.ClearField(car.FieldLicensePlate, field.TypeString)
+	}
+	if value, ok := _u.mutation.LicensePlateCountry(); ok {
+		_spec.SetField(car.FieldLicensePlateCountry, field.TypeString, value)
+	}
+	if _u.mutation.LicensePlateCountryCleared() {
+		_spec.ClearField(car.FieldLicensePlateCountry, field.TypeString)
 	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(car.FieldCreatedAt, field.TypeTime, value)
@@ -619,6 +1184,93 @@ func (_u *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CarModelCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CarModelTable,
+			Columns: []string{car.CarModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CarModelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CarModelTable,
+			Columns: []string{car.CarModelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.HomeBranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.HomeBranchTable,
+			Columns: []string{car.HomeBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HomeBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.HomeBranchTable,
+			Columns: []string{car.HomeBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CurrentBranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CurrentBranchTable,
+			Columns: []string{car.CurrentBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CurrentBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.CurrentBranchTable,
+			Columns: []string{car.CurrentBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.RentalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -664,6 +1316,51 @@ func (_u *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceWindowsTable,
+			Columns: []string{car.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMaintenanceWindowsIDs(); len(nodes) > 0 && !_u.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceWindowsTable,
+			Columns: []string{car.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   car.MaintenanceWindowsTable,
+			Columns: []string{car.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Car{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,253 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// CarModel is the model entity for the CarModel schema.
+type CarModel struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Make holds the value of the "make" field.
+	Make string `json:"make,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// Seats holds the value of the "seats" field.
+	Seats int `json:"seats,omitempty"`
+	// Transmission holds the value of the "transmission" field.
+	Transmission string `json:"transmission,omitempty"`
+	// FuelType holds the value of the "fuel_type" field.
+	FuelType string `json:"fuel_type,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CarModelQuery when eager-loading is set.
+	Edges        CarModelEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CarModelEdges holds the relations/edges for other nodes in the graph.
+type CarModelEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Cars holds the value of the cars edge.
+	Cars []*Car `json:"cars,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CarModelEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// CarsOrErr returns the Cars value or an error if the edge
+// was not loaded in eager-loading.
+func (e CarModelEdges) CarsOrErr() ([]*Car, error) {
+	if e.loadedTypes[1] {
+		return e.Cars, nil
+	}
+	return nil, &NotLoadedError{edge: "cars"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CarModel) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case carmodel.FieldSeats:
+			values[i] = new(sql.NullInt64)
+		case carmodel.FieldID, carmodel.FieldTenantID, carmodel.FieldMake, carmodel.FieldName, carmodel.FieldCategory, carmodel.FieldTransmission, carmodel.FieldFuelType:
+			values[i] = new(sql.NullString)
+		case carmodel.FieldCreatedAt, carmodel.FieldUpdatedAt, carmodel.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CarModel fields.
+func (_m *CarModel) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case carmodel.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case carmodel.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case carmodel.FieldMake:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field make", values[i])
+			} else if value.Valid {
+				_m.Make = value.String
+			}
+		case carmodel.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case carmodel.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case carmodel.FieldSeats:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seats", values[i])
+			} else if value.Valid {
+				_m.Seats = int(value.Int64)
+			}
+		case carmodel.FieldTransmission:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transmission", values[i])
+			} else if value.Valid {
+				_m.Transmission = value.String
+			}
+		case carmodel.FieldFuelType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fuel_type", values[i])
+			} else if value.Valid {
+				_m.FuelType = value.String
+			}
+		case carmodel.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case carmodel.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case carmodel.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CarModel.
+// This includes values selected through modifiers, order, etc.
+func (_m *CarModel) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the CarModel entity.
+func (_m *CarModel) QueryTenant() *TenantQuery {
+	return NewCarModelClient(_m.config).QueryTenant(_m)
+}
+
+// QueryCars queries the "cars" edge of the CarModel entity.
+func (_m *CarModel) QueryCars() *CarQuery {
+	return NewCarModelClient(_m.config).QueryCars(_m)
+}
+
+// Update returns a builder for updating this CarModel.
+// Note that you need to call CarModel.Unwrap() before calling this method if this CarModel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CarModel) Update() *CarModelUpdateOne {
+	return NewCarModelClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CarModel entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CarModel) Unwrap() *CarModel {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: CarModel is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CarModel) String() string {
+	var builder strings.Builder
+	builder.WriteString("CarModel(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("make=")
+	builder.WriteString(_m.Make)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("seats=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seats))
+	builder.WriteString(", ")
+	builder.WriteString("transmission=")
+	builder.WriteString(_m.Transmission)
+	builder.WriteString(", ")
+	builder.WriteString("fuel_type=")
+	builder.WriteString(_m.FuelType)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CarModels is a parsable slice of CarModel.
+type CarModels []*CarModel
@@ -0,0 +1,757 @@
+// Code generated by ent, DO NOT EDIT.
+
+package carmodel
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Make applies equality check predicate on the "make" field. It's identical to MakeEQ.
+func Make(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldMake, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldName, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldCategory, v))
+}
+
+// Seats applies equality check predicate on the "seats" field. It's identical to SeatsEQ.
+func Seats(v int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldSeats, v))
+}
+
+// Transmission applies equality check predicate on the "transmission" field. It's identical to TransmissionEQ.
+func Transmission(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldTransmission, v))
+}
+
+// FuelType applies equality check predicate on the "fuel_type" field. It's identical to FuelTypeEQ.
+func FuelType(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldFuelType, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// MakeEQ applies the EQ predicate on the "make" field.
+func MakeEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldMake, v))
+}
+
+// MakeNEQ applies the NEQ predicate on the "make" field.
+func MakeNEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldMake, v))
+}
+
+// MakeIn applies the In predicate on the "make" field.
+func MakeIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldMake, vs...))
+}
+
+// MakeNotIn applies the NotIn predicate on the "make" field.
+func MakeNotIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldMake, vs...))
+}
+
+// MakeGT applies the GT predicate on the "make" field.
+func MakeGT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldMake, v))
+}
+
+// MakeGTE applies the GTE predicate on the "make" field.
+func MakeGTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldMake, v))
+}
+
+// MakeLT applies the LT predicate on the "make" field.
+func MakeLT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldMake, v))
+}
+
+// MakeLTE applies the LTE predicate on the "make" field.
+func MakeLTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldMake, v))
+}
+
+// MakeContains applies the Contains predicate on the "make" field.
+func MakeContains(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContains(FieldMake, v))
+}
+
+// MakeHasPrefix applies the HasPrefix predicate on the "make" field.
+func MakeHasPrefix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasPrefix(FieldMake, v))
+}
+
+// MakeHasSuffix applies the HasSuffix predicate on the "make" field.
+func MakeHasSuffix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasSuffix(FieldMake, v))
+}
+
+// MakeEqualFold applies the EqualFold predicate on the "make" field.
+func MakeEqualFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEqualFold(FieldMake, v))
+}
+
+// MakeContainsFold applies the ContainsFold predicate on the "make" field.
+func MakeContainsFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContainsFold(FieldMake, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContainsFold(FieldName, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// SeatsEQ applies the EQ predicate on the "seats" field.
+func SeatsEQ(v int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldSeats, v))
+}
+
+// SeatsNEQ applies the NEQ predicate on the "seats" field.
+func SeatsNEQ(v int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldSeats, v))
+}
+
+// SeatsIn applies the In predicate on the "seats" field.
+func SeatsIn(vs ...int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldSeats, vs...))
+}
+
+// SeatsNotIn applies the NotIn predicate on the "seats" field.
+func SeatsNotIn(vs ...int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldSeats, vs...))
+}
+
+// SeatsGT applies the GT predicate on the "seats" field.
+func SeatsGT(v int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldSeats, v))
+}
+
+// SeatsGTE applies the GTE predicate on the "seats" field.
+func SeatsGTE(v int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldSeats, v))
+}
+
+// SeatsLT applies the LT predicate on the "seats" field.
+func SeatsLT(v int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldSeats, v))
+}
+
+// SeatsLTE applies the LTE predicate on the "seats" field.
+func SeatsLTE(v int) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldSeats, v))
+}
+
+// TransmissionEQ applies the EQ predicate on the "transmission" field.
+func TransmissionEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldTransmission, v))
+}
+
+// TransmissionNEQ applies the NEQ predicate on the "transmission" field.
+func TransmissionNEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldTransmission, v))
+}
+
+// TransmissionIn applies the In predicate on the "transmission" field.
+func TransmissionIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldTransmission, vs...))
+}
+
+// TransmissionNotIn applies the NotIn predicate on the "transmission" field.
+func TransmissionNotIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldTransmission, vs...))
+}
+
+// TransmissionGT applies the GT predicate on the "transmission" field.
+func TransmissionGT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldTransmission, v))
+}
+
+// TransmissionGTE applies the GTE predicate on the "transmission" field.
+func TransmissionGTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldTransmission, v))
+}
+
+// TransmissionLT applies the LT predicate on the "transmission" field.
+func TransmissionLT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldTransmission, v))
+}
+
+// TransmissionLTE applies the LTE predicate on the "transmission" field.
+func TransmissionLTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldTransmission, v))
+}
+
+// TransmissionContains applies the Contains predicate on the "transmission" field.
+func TransmissionContains(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContains(FieldTransmission, v))
+}
+
+// TransmissionHasPrefix applies the HasPrefix predicate on the "transmission" field.
+func TransmissionHasPrefix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasPrefix(FieldTransmission, v))
+}
+
+// TransmissionHasSuffix applies the HasSuffix predicate on the "transmission" field.
+func TransmissionHasSuffix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasSuffix(FieldTransmission, v))
+}
+
+// TransmissionEqualFold applies the EqualFold predicate on the "transmission" field.
+func TransmissionEqualFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEqualFold(FieldTransmission, v))
+}
+
+// TransmissionContainsFold applies the ContainsFold predicate on the "transmission" field.
+func TransmissionContainsFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContainsFold(FieldTransmission, v))
+}
+
+// FuelTypeEQ applies the EQ predicate on the "fuel_type" field.
+func FuelTypeEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldFuelType, v))
+}
+
+// FuelTypeNEQ applies the NEQ predicate on the "fuel_type" field.
+func FuelTypeNEQ(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldFuelType, v))
+}
+
+// FuelTypeIn applies the In predicate on the "fuel_type" field.
+func FuelTypeIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldFuelType, vs...))
+}
+
+// FuelTypeNotIn applies the NotIn predicate on the "fuel_type" field.
+func FuelTypeNotIn(vs ...string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldFuelType, vs...))
+}
+
+// FuelTypeGT applies the GT predicate on the "fuel_type" field.
+func FuelTypeGT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldFuelType, v))
+}
+
+// FuelTypeGTE applies the GTE predicate on the "fuel_type" field.
+func FuelTypeGTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldFuelType, v))
+}
+
+// FuelTypeLT applies the LT predicate on the "fuel_type" field.
+func FuelTypeLT(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldFuelType, v))
+}
+
+// FuelTypeLTE applies the LTE predicate on the "fuel_type" field.
+func FuelTypeLTE(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldFuelType, v))
+}
+
+// FuelTypeContains applies the Contains predicate on the "fuel_type" field.
+func FuelTypeContains(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContains(FieldFuelType, v))
+}
+
+// FuelTypeHasPrefix applies the HasPrefix predicate on the "fuel_type" field.
+func FuelTypeHasPrefix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasPrefix(FieldFuelType, v))
+}
+
+// FuelTypeHasSuffix applies the HasSuffix predicate on the "fuel_type" field.
+func FuelTypeHasSuffix(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldHasSuffix(FieldFuelType, v))
+}
+
+// FuelTypeEqualFold applies the EqualFold predicate on the "fuel_type" field.
+func FuelTypeEqualFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEqualFold(FieldFuelType, v))
+}
+
+// FuelTypeContainsFold applies the ContainsFold predicate on the "fuel_type" field.
+func FuelTypeContainsFold(v string) predicate.CarModel {
+	return predicate.CarModel(sql.FieldContainsFold(FieldFuelType, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.CarModel {
+	return predicate.CarModel(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.CarModel {
+	return predicate.CarModel(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.CarModel {
+	return predicate.CarModel(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.CarModel {
+	return predicate.CarModel(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.CarModel {
+	return predicate.CarModel(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.CarModel {
+	return predicate.CarModel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.CarModel {
+	return predicate.CarModel(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCars applies the HasEdge predicate on the "cars" edge.
+func HasCars() predicate.CarModel {
+	return predicate.CarModel(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CarsTable, CarsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCarsWith applies the HasEdge predicate on the "cars" edge with a given conditions (other predicates).
+func HasCarsWith(preds ...predicate.Car) predicate.CarModel {
+	return predicate.CarModel(func(s *sql.Selector) {
+		step := newCarsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CarModel) predicate.CarModel {
+	return predicate.CarModel(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CarModel) predicate.CarModel {
+	return predicate.CarModel(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CarModel) predicate.CarModel {
+	return predicate.CarModel(sql.NotPredicates(p))
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// CarModelCreate is the builder for creating a CarModel entity.
+type CarModelCreate struct {
+	config
+	mutation *CarModelMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *CarModelCreate) SetTenantID(v string) *CarModelCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetMake sets the "make" field.
+func (_c *CarModelCreate) SetMake(v string) *CarModelCreate {
+	_c.mutation.SetMake(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *CarModelCreate) SetName(v string) *CarModelCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *CarModelCreate) SetCategory(v string) *CarModelCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *CarModelCreate) SetNillableCategory(v *string) *CarModelCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetSeats sets the "seats" field.
+func (_c *CarModelCreate) SetSeats(v int) *CarModelCreate {
+	_c.mutation.SetSeats(v)
+	return _c
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (_c *CarModelCreate) SetNillableSeats(v *int) *CarModelCreate {
+	if v != nil {
+		_c.SetSeats(*v)
+	}
+	return _c
+}
+
+// SetTransmission sets the "transmission" field.
+func (_c *CarModelCreate) SetTransmission(v string) *CarModelCreate {
+	_c.mutation.SetTransmission(v)
+	return _c
+}
+
+// SetNillableTransmission sets the "transmission" field if the given value is not nil.
+func (_c *CarModelCreate) SetNillableTransmission(v *string) *CarModelCreate {
+	if v != nil {
+		_c.SetTransmission(*v)
+	}
+	return _c
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (_c *CarModelCreate) SetFuelType(v string) *CarModelCreate {
+	_c.mutation.SetFuelType(v)
+	return _c
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (_c *CarModelCreate) SetNillableFuelType(v *string) *CarModelCreate {
+	if v != nil {
+		_c.SetFuelType(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CarModelCreate) SetCreatedAt(v time.Time) *CarModelCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CarModelCreate) SetNillableCreatedAt(v *time.Time) *CarModelCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CarModelCreate) SetUpdatedAt(v time.Time) *CarModelCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CarModelCreate) SetNillableUpdatedAt(v *time.Time) *CarModelCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CarModelCreate) SetDeletedAt(v time.Time) *CarModelCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CarModelCreate) SetNillableDeletedAt(v *time.Time) *CarModelCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CarModelCreate) SetID(v string) *CarModelCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *CarModelCreate) SetTenant(v *Tenant) *CarModelCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (_c *CarModelCreate) AddCarIDs(ids ...string) *CarModelCreate {
+	_c.mutation.AddCarIDs(ids...)
+	return _c
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (_c *CarModelCreate) AddCars(v ...*Car) *CarModelCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCarIDs(ids...)
+}
+
+// Mutation returns the CarModelMutation object of the builder.
+func (_c *CarModelCreate) Mutation() *CarModelMutation {
+	return _c.mutation
+}
+
+// Save creates the CarModel in the database.
+func (_c *CarModelCreate) Save(ctx context.Context) (*CarModel, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CarModelCreate) SaveX(ctx context.Context) *CarModel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CarModelCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CarModelCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CarModelCreate) defaults() {
+	if _, ok := _c.mutation.Category(); !ok {
+		v := carmodel.DefaultCategory
+		_c.mutation.SetCategory(v)
+	}
+	if _, ok := _c.mutation.Seats(); !ok {
+		v := carmodel.DefaultSeats
+		_c.mutation.SetSeats(v)
+	}
+	if _, ok := _c.mutation.Transmission(); !ok {
+		v := carmodel.DefaultTransmission
+		_c.mutation.SetTransmission(v)
+	}
+	if _, ok := _c.mutation.FuelType(); !ok {
+		v := carmodel.DefaultFuelType
+		_c.mutation.SetFuelType(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CarModelCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`entgen: missing required field "CarModel.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := carmodel.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "CarModel.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Make(); !ok {
+		return &ValidationError{Name: "make", err: errors.New(`entgen: missing required field "CarModel.make"`)}
+	}
+	if v, ok := _c.mutation.Make(); ok {
+		if err := carmodel.MakeValidator(v); err != nil {
+			return &ValidationError{Name: "make", err: fmt.Errorf(`entgen: validator failed for field "CarModel.make": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entgen: missing required field "CarModel.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := carmodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "CarModel.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`entgen: missing required field "CarModel.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := carmodel.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`entgen: validator failed for field "CarModel.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Seats(); !ok {
+		return &ValidationError{Name: "seats", err: errors.New(`entgen: missing required field "CarModel.seats"`)}
+	}
+	if v, ok := _c.mutation.Seats(); ok {
+		if err := carmodel.SeatsValidator(v); err != nil {
+			return &ValidationError{Name: "seats", err: fmt.Errorf(`entgen: validator failed for field "CarModel.seats": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Transmission(); !ok {
+		return &ValidationError{Name: "transmission", err: errors.New(`entgen: missing required field "CarModel.transmission"`)}
+	}
+	if v, ok := _c.mutation.Transmission(); ok {
+		if err := carmodel.TransmissionValidator(v); err != nil {
+			return &ValidationError{Name: "transmission", err: fmt.Errorf(`entgen: validator failed for field "CarModel.transmission": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FuelType(); !ok {
+		return &ValidationError{Name: "fuel_type", err: errors.New(`entgen: missing required field "CarModel.fuel_type"`)}
+	}
+	if v, ok := _c.mutation.FuelType(); ok {
+		if err := carmodel.FuelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_type", err: fmt.Errorf(`entgen: validator failed for field "CarModel.fuel_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := carmodel.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "CarModel.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`entgen: missing required edge "CarModel.tenant"`)}
+	}
+	return nil
+}
+
+func (_c *CarModelCreate) sqlSave(ctx context.Context) (*CarModel, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CarModel.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CarModelCreate) createSpec() (*CarModel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CarModel{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(carmodel.Table, sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Make(); ok {
+		_spec.SetField(carmodel.FieldMake, field.TypeString, value)
+		_node.Make = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(carmodel.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(carmodel.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Seats(); ok {
+		_spec.SetField(carmodel.FieldSeats, field.TypeInt, value)
+		_node.Seats = value
+	}
+	if value, ok := _c.mutation.Transmission(); ok {
+		_spec.SetField(carmodel.FieldTransmission, field.TypeString, value)
+		_node.Transmission = value
+	}
+	if value, ok := _c.mutation.FuelType(); ok {
+		_spec.SetField(carmodel.FieldFuelType, field.TypeString, value)
+		_node.FuelType = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(carmodel.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(carmodel.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(carmodel.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carmodel.TenantTable,
+			Columns: []string{carmodel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carmodel.CarsTable,
+			Columns: []string{carmodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CarModelCreateBulk is the builder for creating many CarModel entities in bulk.
+type CarModelCreateBulk struct {
+	config
+	err      error
+	builders []*CarModelCreate
+}
+
+// Save creates the CarModel entities in the database.
+func (_c *CarModelCreateBulk) Save(ctx context.Context) ([]*CarModel, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CarModel, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CarModelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CarModelCreateBulk) SaveX(ctx context.Context) []*CarModel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CarModelCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CarModelCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,920 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// CarModelUpdate is the builder for updating CarModel entities.
+type CarModelUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CarModelMutation
+}
+
+// Where appends a list predicates to the CarModelUpdate builder.
+func (_u *CarModelUpdate) Where(ps ...predicate.CarModel) *CarModelUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CarModelUpdate) SetTenantID(v string) *CarModelUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableTenantID(v *string) *CarModelUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMake sets the "make" field.
+func (_u *CarModelUpdate) SetMake(v string) *CarModelUpdate {
+	_u.mutation.SetMake(v)
+	return _u
+}
+
+// SetNillableMake sets the "make" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableMake(v *string) *CarModelUpdate {
+	if v != nil {
+		_u.SetMake(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CarModelUpdate) SetName(v string) *CarModelUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableName(v *string) *CarModelUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *CarModelUpdate) SetCategory(v string) *CarModelUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableCategory(v *string) *CarModelUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetSeats sets the "seats" field.
+func (_u *CarModelUpdate) SetSeats(v int) *CarModelUpdate {
+	_u.mutation.ResetSeats()
+	_u.mutation.SetSeats(v)
+	return _u
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableSeats(v *int) *CarModelUpdate {
+	if v != nil {
+		_u.SetSeats(*v)
+	}
+	return _u
+}
+
+// AddSeats adds value to the "seats" field.
+func (_u *CarModelUpdate) AddSeats(v int) *CarModelUpdate {
+	_u.mutation.AddSeats(v)
+	return _u
+}
+
+// SetTransmission sets the "transmission" field.
+func (_u *CarModelUpdate) SetTransmission(v string) *CarModelUpdate {
+	_u.mutation.SetTransmission(v)
+	return _u
+}
+
+// SetNillableTransmission sets the "transmission" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableTransmission(v *string) *CarModelUpdate {
+	if v != nil {
+		_u.SetTransmission(*v)
+	}
+	return _u
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (_u *CarModelUpdate) SetFuelType(v string) *CarModelUpdate {
+	_u.mutation.SetFuelType(v)
+	return _u
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableFuelType(v *string) *CarModelUpdate {
+	if v != nil {
+		_u.SetFuelType(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CarModelUpdate) SetCreatedAt(v time.Time) *CarModelUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableCreatedAt(v *time.Time) *CarModelUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *CarModelUpdate) ClearCreatedAt() *CarModelUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CarModelUpdate) SetUpdatedAt(v time.Time) *CarModelUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableUpdatedAt(v *time.Time) *CarModelUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *CarModelUpdate) ClearUpdatedAt() *CarModelUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CarModelUpdate) SetDeletedAt(v time.Time) *CarModelUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CarModelUpdate) SetNillableDeletedAt(v *time.Time) *CarModelUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CarModelUpdate) ClearDeletedAt() *CarModelUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *CarModelUpdate) SetTenant(v *Tenant) *CarModelUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (_u *CarModelUpdate) AddCarIDs(ids ...string) *CarModelUpdate {
+	_u.mutation.AddCarIDs(ids...)
+	return _u
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (_u *CarModelUpdate) AddCars(v ...*Car) *CarModelUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCarIDs(ids...)
+}
+
+// Mutation returns the CarModelMutation object of the builder.
+func (_u *CarModelUpdate) Mutation() *CarModelMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *CarModelUpdate) ClearTenant() *CarModelUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCars clears all "cars" edges to the Car entity.
+func (_u *CarModelUpdate) ClearCars() *CarModelUpdate {
+	_u.mutation.ClearCars()
+	return _u
+}
+
+// RemoveCarIDs removes the "cars" edge to Car entities by IDs.
+func (_u *CarModelUpdate) RemoveCarIDs(ids ...string) *CarModelUpdate {
+	_u.mutation.RemoveCarIDs(ids...)
+	return _u
+}
+
+// RemoveCars removes "cars" edges to Car entities.
+func (_u *CarModelUpdate) RemoveCars(v ...*Car) *CarModelUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCarIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CarModelUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CarModelUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CarModelUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CarModelUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CarModelUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := carmodel.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "CarModel.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Make(); ok {
+		if err := carmodel.MakeValidator(v); err != nil {
+			return &ValidationError{Name: "make", err: fmt.Errorf(`entgen: validator failed for field "CarModel.make": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := carmodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "CarModel.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := carmodel.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`entgen: validator failed for field "CarModel.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Seats(); ok {
+		if err := carmodel.SeatsValidator(v); err != nil {
+			return &ValidationError{Name: "seats", err: fmt.Errorf(`entgen: validator failed for field "CarModel.seats": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Transmission(); ok {
+		if err := carmodel.TransmissionValidator(v); err != nil {
+			return &ValidationError{Name: "transmission", err: fmt.Errorf(`entgen: validator failed for field "CarModel.transmission": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FuelType(); ok {
+		if err := carmodel.FuelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_type", err: fmt.Errorf(`entgen: validator failed for field "CarModel.fuel_type": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "CarModel.tenant"`)
+	}
+	return nil
+}
+
+func (_u *CarModelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(carmodel.Table, carmodel.Columns, sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Make(); ok {
+		_spec.SetField(carmodel.FieldMake, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(carmodel.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(carmodel.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Seats(); ok {
+		_spec.SetField(carmodel.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeats(); ok {
+		_spec.AddField(carmodel.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Transmission(); ok {
+		_spec.SetField(carmodel.FieldTransmission, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FuelType(); ok {
+		_spec.SetField(carmodel.FieldFuelType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(carmodel.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(carmodel.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(carmodel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(carmodel.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(carmodel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(carmodel.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carmodel.TenantTable,
+			Columns: []string{carmodel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carmodel.TenantTable,
+			Columns: []string{carmodel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carmodel.CarsTable,
+			Columns: []string{carmodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCarsIDs(); len(nodes) > 0 && !_u.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carmodel.CarsTable,
+			Columns: []string{carmodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carmodel.CarsTable,
+			Columns: []string{carmodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carmodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CarModelUpdateOne is the builder for updating a single CarModel entity.
+type CarModelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CarModelMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *CarModelUpdateOne) SetTenantID(v string) *CarModelUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableTenantID(v *string) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMake sets the "make" field.
+func (_u *CarModelUpdateOne) SetMake(v string) *CarModelUpdateOne {
+	_u.mutation.SetMake(v)
+	return _u
+}
+
+// SetNillableMake sets the "make" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableMake(v *string) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetMake(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CarModelUpdateOne) SetName(v string) *CarModelUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableName(v *string) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *CarModelUpdateOne) SetCategory(v string) *CarModelUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableCategory(v *string) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetSeats sets the "seats" field.
+func (_u *CarModelUpdateOne) SetSeats(v int) *CarModelUpdateOne {
+	_u.mutation.ResetSeats()
+	_u.mutation.SetSeats(v)
+	return _u
+}
+
+// SetNillableSeats sets the "seats" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableSeats(v *int) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetSeats(*v)
+	}
+	return _u
+}
+
+// AddSeats adds value to the "seats" field.
+func (_u *CarModelUpdateOne) AddSeats(v int) *CarModelUpdateOne {
+	_u.mutation.AddSeats(v)
+	return _u
+}
+
+// SetTransmission sets the "transmission" field.
+func (_u *CarModelUpdateOne) SetTransmission(v string) *CarModelUpdateOne {
+	_u.mutation.SetTransmission(v)
+	return _u
+}
+
+// SetNillableTransmission sets the "transmission" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableTransmission(v *string) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetTransmission(*v)
+	}
+	return _u
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (_u *CarModelUpdateOne) SetFuelType(v string) *CarModelUpdateOne {
+	_u.mutation.SetFuelType(v)
+	return _u
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableFuelType(v *string) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetFuelType(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CarModelUpdateOne) SetCreatedAt(v time.Time) *CarModelUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableCreatedAt(v *time.Time) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *CarModelUpdateOne) ClearCreatedAt() *CarModelUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CarModelUpdateOne) SetUpdatedAt(v time.Time) *CarModelUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableUpdatedAt(v *time.Time) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *CarModelUpdateOne) ClearUpdatedAt() *CarModelUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CarModelUpdateOne) SetDeletedAt(v time.Time) *CarModelUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CarModelUpdateOne) SetNillableDeletedAt(v *time.Time) *CarModelUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CarModelUpdateOne) ClearDeletedAt() *CarModelUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *CarModelUpdateOne) SetTenant(v *Tenant) *CarModelUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by IDs.
+func (_u *CarModelUpdateOne) AddCarIDs(ids ...string) *CarModelUpdateOne {
+	_u.mutation.AddCarIDs(ids...)
+	return _u
+}
+
+// AddCars adds the "cars" edges to the Car entity.
+func (_u *CarModelUpdateOne) AddCars(v ...*Car) *CarModelUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCarIDs(ids...)
+}
+
+// Mutation returns the CarModelMutation object of the builder.
+func (_u *CarModelUpdateOne) Mutation() *CarModelMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *CarModelUpdateOne) ClearTenant() *CarModelUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCars clears all "cars" edges to the Car entity.
+func (_u *CarModelUpdateOne) ClearCars() *CarModelUpdateOne {
+	_u.mutation.ClearCars()
+	return _u
+}
+
+// RemoveCarIDs removes the "cars" edge to Car entities by IDs.
+func (_u *CarModelUpdateOne) RemoveCarIDs(ids ...string) *CarModelUpdateOne {
+	_u.mutation.RemoveCarIDs(ids...)
+	return _u
+}
+
+// RemoveCars removes "cars" edges to Car entities.
+func (_u *CarModelUpdateOne) RemoveCars(v ...*Car) *CarModelUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCarIDs(ids...)
+}
+
+// Where appends a list predicates to the CarModelUpdate builder.
+func (_u *CarModelUpdateOne) Where(ps ...predicate.CarModel) *CarModelUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CarModelUpdateOne) Select(field string, fields ...string) *CarModelUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CarModel entity.
+func (_u *CarModelUpdateOne) Save(ctx context.Context) (*CarModel, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CarModelUpdateOne) SaveX(ctx context.Context) *CarModel {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CarModelUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CarModelUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CarModelUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := carmodel.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "CarModel.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Make(); ok {
+		if err := carmodel.MakeValidator(v); err != nil {
+			return &ValidationError{Name: "make", err: fmt.Errorf(`entgen: validator failed for field "CarModel.make": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := carmodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "CarModel.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := carmodel.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`entgen: validator failed for field "CarModel.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Seats(); ok {
+		if err := carmodel.SeatsValidator(v); err != nil {
+			return &ValidationError{Name: "seats", err: fmt.Errorf(`entgen: validator failed for field "CarModel.seats": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Transmission(); ok {
+		if err := carmodel.TransmissionValidator(v); err != nil {
+			return &ValidationError{Name: "transmission", err: fmt.Errorf(`entgen: validator failed for field "CarModel.transmission": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FuelType(); ok {
+		if err := carmodel.FuelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_type", err: fmt.Errorf(`entgen: validator failed for field "CarModel.fuel_type": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "CarModel.tenant"`)
+	}
+	return nil
+}
+
+func (_u *CarModelUpdateOne) sqlSave(ctx context.Context) (_node *CarModel, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(carmodel.Table, carmodel.Columns, sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "CarModel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, carmodel.FieldID)
+		for _, f := range fields {
+			if !carmodel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != carmodel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Make(); ok {
+		_spec.SetField(carmodel.FieldMake, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(carmodel.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(carmodel.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Seats(); ok {
+		_spec.SetField(carmodel.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeats(); ok {
+		_spec.AddField(carmodel.FieldSeats, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Transmission(); ok {
+		_spec.SetField(carmodel.FieldTransmission, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FuelType(); ok {
+		_spec.SetField(carmodel.FieldFuelType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(carmodel.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(carmodel.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(carmodel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(carmodel.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(carmodel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(carmodel.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carmodel.TenantTable,
+			Columns: []string{carmodel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carmodel.TenantTable,
+			Columns: []string{carmodel.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carmodel.CarsTable,
+			Columns: []string{carmodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCarsIDs(); len(nodes) > 0 && !_u.mutation.CarsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carmodel.CarsTable,
+			Columns: []string{carmodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CarsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carmodel.CarsTable,
+			Columns: []string{carmodel.CarsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CarModel{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carmodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
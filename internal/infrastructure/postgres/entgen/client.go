@@ -15,15 +15,31 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/apikey"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/archivejob"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/caroption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/company"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/inbox"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/individual"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/outbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerecord"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerollup"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookdelivery"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookendpoint"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -31,24 +47,52 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// ArchiveJob is the client for interacting with the ArchiveJob builders.
+	ArchiveJob *ArchiveJobClient
+	// Branch is the client for interacting with the Branch builders.
+	Branch *BranchClient
 	// Car is the client for interacting with the Car builders.
 	Car *CarClient
+	// CarModel is the client for interacting with the CarModel builders.
+	CarModel *CarModelClient
 	// CarOption is the client for interacting with the CarOption builders.
 	CarOption *CarOptionClient
 	// Company is the client for interacting with the Company builders.
 	Company *CompanyClient
+	// FleetSharingAgreement is the client for interacting with the FleetSharingAgreement builders.
+	FleetSharingAgreement *FleetSharingAgreementClient
+	// Inbox is the client for interacting with the Inbox builders.
+	Inbox *InboxClient
 	// Individual is the client for interacting with the Individual builders.
 	Individual *IndividualClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
 	// Outbox is the client for interacting with the Outbox builders.
 	Outbox *OutboxClient
+	// Plan is the client for interacting with the Plan builders.
+	Plan *PlanClient
 	// Rental is the client for interacting with the Rental builders.
 	Rental *RentalClient
+	// RentalHandover is the client for interacting with the RentalHandover builders.
+	RentalHandover *RentalHandoverClient
 	// RentalOption is the client for interacting with the RentalOption builders.
 	RentalOption *RentalOptionClient
 	// Renter is the client for interacting with the Renter builders.
 	Renter *RenterClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantSetting is the client for interacting with the TenantSetting builders.
+	TenantSetting *TenantSettingClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
+	// UsageRollup is the client for interacting with the UsageRollup builders.
+	UsageRollup *UsageRollupClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -60,15 +104,29 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.APIKey = NewAPIKeyClient(c.config)
+	c.ArchiveJob = NewArchiveJobClient(c.config)
+	c.Branch = NewBranchClient(c.config)
 	c.Car = NewCarClient(c.config)
+	c.CarModel = NewCarModelClient(c.config)
 	c.CarOption = NewCarOptionClient(c.config)
 	c.Company = NewCompanyClient(c.config)
+	c.FleetSharingAgreement = NewFleetSharingAgreementClient(c.config)
+	c.Inbox = NewInboxClient(c.config)
 	c.Individual = NewIndividualClient(c.config)
+	c.MaintenanceWindow = NewMaintenanceWindowClient(c.config)
 	c.Outbox = NewOutboxClient(c.config)
+	c.Plan = NewPlanClient(c.config)
 	c.Rental = NewRentalClient(c.config)
+	c.RentalHandover = NewRentalHandoverClient(c.config)
 	c.RentalOption = NewRentalOptionClient(c.config)
 	c.Renter = NewRenterClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
+	c.TenantSetting = NewTenantSettingClient(c.config)
+	c.UsageRecord = NewUsageRecordClient(c.config)
+	c.UsageRollup = NewUsageRollupClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookEndpoint = NewWebhookEndpointClient(c.config)
 }
 
 type (
@@ -159,17 +217,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Car:          NewCarClient(cfg),
-		CarOption:    NewCarOptionClient(cfg),
-		Company:      NewCompanyClient(cfg),
-		Individual:   NewIndividualClient(cfg),
-		Outbox:       NewOutboxClient(cfg),
-		Rental:       NewRentalClient(cfg),
-		RentalOption: NewRentalOptionClient(cfg),
-		Renter:       NewRenterClient(cfg),
-		Tenant:       NewTenantClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		APIKey:                NewAPIKeyClient(cfg),
+		ArchiveJob:            NewArchiveJobClient(cfg),
+		Branch:                NewBranchClient(cfg),
+		Car:                   NewCarClient(cfg),
+		CarModel:              NewCarModelClient(cfg),
+		CarOption:             NewCarOptionClient(cfg),
+		Company:               NewCompanyClient(cfg),
+		FleetSharingAgreement: NewFleetSharingAgreementClient(cfg),
+		Inbox:                 NewInboxClient(cfg),
+		Individual:            NewIndividualClient(cfg),
+		MaintenanceWindow:     NewMaintenanceWindowClient(cfg),
+		Outbox:                NewOutboxClient(cfg),
+		Plan:                  NewPlanClient(cfg),
+		Rental:                NewRentalClient(cfg),
+		RentalHandover:        NewRentalHandoverClient(cfg),
+		RentalOption:          NewRentalOptionClient(cfg),
+		Renter:                NewRenterClient(cfg),
+		Tenant:                NewTenantClient(cfg),
+		TenantSetting:         NewTenantSettingClient(cfg),
+		UsageRecord:           NewUsageRecordClient(cfg),
+		UsageRollup:           NewUsageRollupClient(cfg),
+		WebhookDelivery:       NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:       NewWebhookEndpointClient(cfg),
 	}, nil
 }
 
@@ -187,24 +259,38 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Car:          NewCarClient(cfg),
-		CarOption:    NewCarOptionClient(cfg),
-		Company:      NewCompanyClient(cfg),
-		Individual:   NewIndividualClient(cfg),
-		Outbox:       NewOutboxClient(cfg),
-		Rental:       NewRentalClient(cfg),
-		RentalOption: NewRentalOptionClient(cfg),
-		Renter:       NewRenterClient(cfg),
-		Tenant:       NewTenantClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		APIKey:                NewAPIKeyClient(cfg),
+		ArchiveJob:            NewArchiveJobClient(cfg),
+		Branch:                NewBranchClient(cfg),
+		Car:                   NewCarClient(cfg),
+		CarModel:              NewCarModelClient(cfg),
+		CarOption:             NewCarOptionClient(cfg),
+		Company:               NewCompanyClient(cfg),
+		FleetSharingAgreement: NewFleetSharingAgreementClient(cfg),
+		Inbox:                 NewInboxClient(cfg),
+		Individual:            NewIndividualClient(cfg),
+		MaintenanceWindow:     NewMaintenanceWindowClient(cfg),
+		Outbox:                NewOutboxClient(cfg),
+		Plan:                  NewPlanClient(cfg),
+		Rental:                NewRentalClient(cfg),
+		RentalHandover:        NewRentalHandoverClient(cfg),
+		RentalOption:          NewRentalOptionClient(cfg),
+		Renter:                NewRenterClient(cfg),
+		Tenant:                NewTenantClient(cfg),
+		TenantSetting:         NewTenantSettingClient(cfg),
+		UsageRecord:           NewUsageRecordClient(cfg),
+		UsageRollup:           NewUsageRollupClient(cfg),
+		WebhookDelivery:       NewWebhookDeliveryClient(cfg),
+		WebhookEndpoint:       NewWebhookEndpointClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Car.
+//		APIKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -227,8 +313,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Car, c.CarOption, c.Company, c.Individual, c.Outbox, c.Rental, c.RentalOption,
-		c.Renter, c.Tenant,
+		c.APIKey, c.ArchiveJob, c.Branch, c.Car, c.CarModel, c.CarOption, c.Company,
+		c.FleetSharingAgreement, c.Inbox, c.Individual, c.MaintenanceWindow, c.Outbox,
+		c.Plan, c.Rental, c.RentalHandover, c.RentalOption, c.Renter, c.Tenant,
+		c.TenantSetting, c.UsageRecord, c.UsageRollup, c.WebhookDelivery,
+		c.WebhookEndpoint,
 	} {
 		n.Use(hooks...)
 	}
@@ -238,8 +327,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Car, c.CarOption, c.Company, c.Individual, c.Outbox, c.Rental, c.RentalOption,
-		c.Renter, c.Tenant,
+		c.APIKey, c.ArchiveJob, c.Branch, c.Car, c.CarModel, c.CarOption, c.Company,
+		c.FleetSharingAgreement, c.Inbox, c.Individual, c.MaintenanceWindow, c.Outbox,
+		c.Plan, c.Rental, c.RentalHandover, c.RentalOption, c.Renter, c.Tenant,
+		c.TenantSetting, c.UsageRecord, c.UsageRollup, c.WebhookDelivery,
+		c.WebhookEndpoint,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -248,130 +340,158 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *APIKeyMutation:
+		return c.APIKey.mutate(ctx, m)
+	case *ArchiveJobMutation:
+		return c.ArchiveJob.mutate(ctx, m)
+	case *BranchMutation:
+		return c.Branch.mutate(ctx, m)
 	case *CarMutation:
 		return c.Car.mutate(ctx, m)
+	case *CarModelMutation:
+		return c.CarModel.mutate(ctx, m)
 	case *CarOptionMutation:
 		return c.CarOption.mutate(ctx, m)
 	case *CompanyMutation:
 		return c.Company.mutate(ctx, m)
+	case *FleetSharingAgreementMutation:
+		return c.FleetSharingAgreement.mutate(ctx, m)
+	case *InboxMutation:
+		return c.Inbox.mutate(ctx, m)
 	case *IndividualMutation:
 		return c.Individual.mutate(ctx, m)
+	case *MaintenanceWindowMutation:
+		return c.MaintenanceWindow.mutate(ctx, m)
 	case *OutboxMutation:
 		return c.Outbox.mutate(ctx, m)
+	case *PlanMutation:
+		return c.Plan.mutate(ctx, m)
 	case *RentalMutation:
 		return c.Rental.mutate(ctx, m)
+	case *RentalHandoverMutation:
+		return c.RentalHandover.mutate(ctx, m)
 	case *RentalOptionMutation:
 		return c.RentalOption.mutate(ctx, m)
 	case *RenterMutation:
 		return c.Renter.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
+	case *TenantSettingMutation:
+		return c.TenantSetting.mutate(ctx, m)
+	case *UsageRecordMutation:
+		return c.UsageRecord.mutate(ctx, m)
+	case *UsageRollupMutation:
+		return c.UsageRollup.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookEndpointMutation:
+		return c.WebhookEndpoint.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("entgen: unknown mutation type %T", m)
 	}
 }
 
-// CarClient is a client for the Car schema.
-type CarClient struct {
+// APIKeyClient is a client for the APIKey schema.
+type APIKeyClient struct {
 	config
 }
 
-// NewCarClient returns a client for the Car from the given config.
-func NewCarClient(c config) *CarClient {
-	return &CarClient{config: c}
+// NewAPIKeyClient returns a client for the APIKey from the given config.
+func NewAPIKeyClient(c config) *APIKeyClient {
+	return &APIKeyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `car.Hooks(f(g(h())))`.
-func (c *CarClient) Use(hooks ...Hook) {
-	c.hooks.Car = append(c.hooks.Car, hooks...)
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *APIKeyClient) Use(hooks ...Hook) {
+	c.hooks.APIKey = append(c.hooks.APIKey, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `car.Intercept(f(g(h())))`.
-func (c *CarClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Car = append(c.inters.Car, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *APIKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.APIKey = append(c.inters.APIKey, interceptors...)
 }
 
-// Create returns a builder for creating a Car entity.
-func (c *CarClient) Create() *CarCreate {
-	mutation := newCarMutation(c.config, OpCreate)
-	return &CarCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a APIKey entity.
+func (c *APIKeyClient) Create() *APIKeyCreate {
+	mutation := newAPIKeyMutation(c.config, OpCreate)
+	return &APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Car entities.
-func (c *CarClient) CreateBulk(builders ...*CarCreate) *CarCreateBulk {
-	return &CarCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of APIKey entities.
+func (c *APIKeyClient) CreateBulk(builders ...*APIKeyCreate) *APIKeyCreateBulk {
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CarClient) MapCreateBulk(slice any, setFunc func(*CarCreate, int)) *CarCreateBulk {
+func (c *APIKeyClient) MapCreateBulk(slice any, setFunc func(*APIKeyCreate, int)) *APIKeyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CarCreateBulk{err: fmt.Errorf("calling to CarClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &APIKeyCreateBulk{err: fmt.Errorf("calling to APIKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CarCreate, rv.Len())
+	builders := make([]*APIKeyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CarCreateBulk{config: c.config, builders: builders}
+	return &APIKeyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Car.
-func (c *CarClient) Update() *CarUpdate {
-	mutation := newCarMutation(c.config, OpUpdate)
-	return &CarUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for APIKey.
+func (c *APIKeyClient) Update() *APIKeyUpdate {
+	mutation := newAPIKeyMutation(c.config, OpUpdate)
+	return &APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CarClient) UpdateOne(_m *Car) *CarUpdateOne {
-	mutation := newCarMutation(c.config, OpUpdateOne, withCar(_m))
-	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIKeyClient) UpdateOne(_m *APIKey) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKey(_m))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CarClient) UpdateOneID(id string) *CarUpdateOne {
-	mutation := newCarMutation(c.config, OpUpdateOne, withCarID(id))
-	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *APIKeyClient) UpdateOneID(id string) *APIKeyUpdateOne {
+	mutation := newAPIKeyMutation(c.config, OpUpdateOne, withAPIKeyID(id))
+	return &APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Car.
-func (c *CarClient) Delete() *CarDelete {
-	mutation := newCarMutation(c.config, OpDelete)
-	return &CarDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for APIKey.
+func (c *APIKeyClient) Delete() *APIKeyDelete {
+	mutation := newAPIKeyMutation(c.config, OpDelete)
+	return &APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CarClient) DeleteOne(_m *Car) *CarDeleteOne {
+func (c *APIKeyClient) DeleteOne(_m *APIKey) *APIKeyDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CarClient) DeleteOneID(id string) *CarDeleteOne {
-	builder := c.Delete().Where(car.ID(id))
+func (c *APIKeyClient) DeleteOneID(id string) *APIKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CarDeleteOne{builder}
+	return &APIKeyDeleteOne{builder}
 }
 
-// Query returns a query builder for Car.
-func (c *CarClient) Query() *CarQuery {
-	return &CarQuery{
+// Query returns a query builder for APIKey.
+func (c *APIKeyClient) Query() *APIKeyQuery {
+	return &APIKeyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCar},
+		ctx:    &QueryContext{Type: TypeAPIKey},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Car entity by its id.
-func (c *CarClient) Get(ctx context.Context, id string) (*Car, error) {
-	return c.Query().Where(car.ID(id)).Only(ctx)
+// Get returns a APIKey entity by its id.
+func (c *APIKeyClient) Get(ctx context.Context, id string) (*APIKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CarClient) GetX(ctx context.Context, id string) *Car {
+func (c *APIKeyClient) GetX(ctx context.Context, id string) *APIKey {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -379,31 +499,15 @@ func (c *CarClient) GetX(ctx context.Context, id string) *Car {
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a Car.
-func (c *CarClient) QueryTenant(_m *Car) *TenantQuery {
+// QueryTenant queries the tenant edge of a APIKey.
+func (c *APIKeyClient) QueryTenant(_m *APIKey) *TenantQuery {
 	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.From(apikey.Table, apikey.FieldID, id),
 			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, car.TenantTable, car.TenantColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRentals queries the rentals edge of a Car.
-func (c *CarClient) QueryRentals(_m *Car) *RentalQuery {
-	query := (&RentalClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(car.Table, car.FieldID, id),
-			sqlgraph.To(rental.Table, rental.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, car.RentalsTable, car.RentalsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, apikey.TenantTable, apikey.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -412,131 +516,131 @@ func (c *CarClient) QueryRentals(_m *Car) *RentalQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *CarClient) Hooks() []Hook {
-	return c.hooks.Car
+func (c *APIKeyClient) Hooks() []Hook {
+	return c.hooks.APIKey
 }
 
 // Interceptors returns the client interceptors.
-func (c *CarClient) Interceptors() []Interceptor {
-	return c.inters.Car
+func (c *APIKeyClient) Interceptors() []Interceptor {
+	return c.inters.APIKey
 }
 
-func (c *CarClient) mutate(ctx context.Context, m *CarMutation) (Value, error) {
+func (c *APIKeyClient) mutate(ctx context.Context, m *APIKeyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CarCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CarUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&APIKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CarDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&APIKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown Car mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown APIKey mutation op: %q", m.Op())
 	}
 }
 
-// CarOptionClient is a client for the CarOption schema.
-type CarOptionClient struct {
+// ArchiveJobClient is a client for the ArchiveJob schema.
+type ArchiveJobClient struct {
 	config
 }
 
-// NewCarOptionClient returns a client for the CarOption from the given config.
-func NewCarOptionClient(c config) *CarOptionClient {
-	return &CarOptionClient{config: c}
+// NewArchiveJobClient returns a client for the ArchiveJob from the given config.
+func NewArchiveJobClient(c config) *ArchiveJobClient {
+	return &ArchiveJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `caroption.Hooks(f(g(h())))`.
-func (c *CarOptionClient) Use(hooks ...Hook) {
-	c.hooks.CarOption = append(c.hooks.CarOption, hooks...)
+// A call to `Use(f, g, h)` equals to `archivejob.Hooks(f(g(h())))`.
+func (c *ArchiveJobClient) Use(hooks ...Hook) {
+	c.hooks.ArchiveJob = append(c.hooks.ArchiveJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `caroption.Intercept(f(g(h())))`.
-func (c *CarOptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CarOption = append(c.inters.CarOption, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `archivejob.Intercept(f(g(h())))`.
+func (c *ArchiveJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArchiveJob = append(c.inters.ArchiveJob, interceptors...)
 }
 
-// Create returns a builder for creating a CarOption entity.
-func (c *CarOptionClient) Create() *CarOptionCreate {
-	mutation := newCarOptionMutation(c.config, OpCreate)
-	return &CarOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ArchiveJob entity.
+func (c *ArchiveJobClient) Create() *ArchiveJobCreate {
+	mutation := newArchiveJobMutation(c.config, OpCreate)
+	return &ArchiveJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CarOption entities.
-func (c *CarOptionClient) CreateBulk(builders ...*CarOptionCreate) *CarOptionCreateBulk {
-	return &CarOptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ArchiveJob entities.
+func (c *ArchiveJobClient) CreateBulk(builders ...*ArchiveJobCreate) *ArchiveJobCreateBulk {
+	return &ArchiveJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CarOptionClient) MapCreateBulk(slice any, setFunc func(*CarOptionCreate, int)) *CarOptionCreateBulk {
+func (c *ArchiveJobClient) MapCreateBulk(slice any, setFunc func(*ArchiveJobCreate, int)) *ArchiveJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CarOptionCreateBulk{err: fmt.Errorf("calling to CarOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ArchiveJobCreateBulk{err: fmt.Errorf("calling to ArchiveJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CarOptionCreate, rv.Len())
+	builders := make([]*ArchiveJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CarOptionCreateBulk{config: c.config, builders: builders}
+	return &ArchiveJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CarOption.
-func (c *CarOptionClient) Update() *CarOptionUpdate {
-	mutation := newCarOptionMutation(c.config, OpUpdate)
-	return &CarOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ArchiveJob.
+func (c *ArchiveJobClient) Update() *ArchiveJobUpdate {
+	mutation := newArchiveJobMutation(c.config, OpUpdate)
+	return &ArchiveJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CarOptionClient) UpdateOne(_m *CarOption) *CarOptionUpdateOne {
-	mutation := newCarOptionMutation(c.config, OpUpdateOne, withCarOption(_m))
-	return &CarOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArchiveJobClient) UpdateOne(_m *ArchiveJob) *ArchiveJobUpdateOne {
+	mutation := newArchiveJobMutation(c.config, OpUpdateOne, withArchiveJob(_m))
+	return &ArchiveJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CarOptionClient) UpdateOneID(id string) *CarOptionUpdateOne {
-	mutation := newCarOptionMutation(c.config, OpUpdateOne, withCarOptionID(id))
-	return &CarOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ArchiveJobClient) UpdateOneID(id string) *ArchiveJobUpdateOne {
+	mutation := newArchiveJobMutation(c.config, OpUpdateOne, withArchiveJobID(id))
+	return &ArchiveJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CarOption.
-func (c *CarOptionClient) Delete() *CarOptionDelete {
-	mutation := newCarOptionMutation(c.config, OpDelete)
-	return &CarOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ArchiveJob.
+func (c *ArchiveJobClient) Delete() *ArchiveJobDelete {
+	mutation := newArchiveJobMutation(c.config, OpDelete)
+	return &ArchiveJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CarOptionClient) DeleteOne(_m *CarOption) *CarOptionDeleteOne {
+func (c *ArchiveJobClient) DeleteOne(_m *ArchiveJob) *ArchiveJobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CarOptionClient) DeleteOneID(id string) *CarOptionDeleteOne {
-	builder := c.Delete().Where(caroption.ID(id))
+func (c *ArchiveJobClient) DeleteOneID(id string) *ArchiveJobDeleteOne {
+	builder := c.Delete().Where(archivejob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CarOptionDeleteOne{builder}
+	return &ArchiveJobDeleteOne{builder}
 }
 
-// Query returns a query builder for CarOption.
-func (c *CarOptionClient) Query() *CarOptionQuery {
-	return &CarOptionQuery{
+// Query returns a query builder for ArchiveJob.
+func (c *ArchiveJobClient) Query() *ArchiveJobQuery {
+	return &ArchiveJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCarOption},
+		ctx:    &QueryContext{Type: TypeArchiveJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CarOption entity by its id.
-func (c *CarOptionClient) Get(ctx context.Context, id string) (*CarOption, error) {
-	return c.Query().Where(caroption.ID(id)).Only(ctx)
+// Get returns a ArchiveJob entity by its id.
+func (c *ArchiveJobClient) Get(ctx context.Context, id string) (*ArchiveJob, error) {
+	return c.Query().Where(archivejob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CarOptionClient) GetX(ctx context.Context, id string) *CarOption {
+func (c *ArchiveJobClient) GetX(ctx context.Context, id string) *ArchiveJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -544,164 +648,132 @@ func (c *CarOptionClient) GetX(ctx context.Context, id string) *CarOption {
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a CarOption.
-func (c *CarOptionClient) QueryTenant(_m *CarOption) *TenantQuery {
-	query := (&TenantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(caroption.Table, caroption.FieldID, id),
-			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, caroption.TenantTable, caroption.TenantColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRentalOptions queries the rental_options edge of a CarOption.
-func (c *CarOptionClient) QueryRentalOptions(_m *CarOption) *RentalOptionQuery {
-	query := (&RentalOptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(caroption.Table, caroption.FieldID, id),
-			sqlgraph.To(rentaloption.Table, rentaloption.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, caroption.RentalOptionsTable, caroption.RentalOptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *CarOptionClient) Hooks() []Hook {
-	return c.hooks.CarOption
+func (c *ArchiveJobClient) Hooks() []Hook {
+	return c.hooks.ArchiveJob
 }
 
 // Interceptors returns the client interceptors.
-func (c *CarOptionClient) Interceptors() []Interceptor {
-	return c.inters.CarOption
+func (c *ArchiveJobClient) Interceptors() []Interceptor {
+	return c.inters.ArchiveJob
 }
 
-func (c *CarOptionClient) mutate(ctx context.Context, m *CarOptionMutation) (Value, error) {
+func (c *ArchiveJobClient) mutate(ctx context.Context, m *ArchiveJobMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CarOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArchiveJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CarOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArchiveJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CarOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ArchiveJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CarOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ArchiveJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown CarOption mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown ArchiveJob mutation op: %q", m.Op())
 	}
 }
 
-// CompanyClient is a client for the Company schema.
-type CompanyClient struct {
+// BranchClient is a client for the Branch schema.
+type BranchClient struct {
 	config
 }
 
-// NewCompanyClient returns a client for the Company from the given config.
-func NewCompanyClient(c config) *CompanyClient {
-	return &CompanyClient{config: c}
+// NewBranchClient returns a client for the Branch from the given config.
+func NewBranchClient(c config) *BranchClient {
+	return &BranchClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `company.Hooks(f(g(h())))`.
-func (c *CompanyClient) Use(hooks ...Hook) {
-	c.hooks.Company = append(c.hooks.Company, hooks...)
+// A call to `Use(f, g, h)` equals to `branch.Hooks(f(g(h())))`.
+func (c *BranchClient) Use(hooks ...Hook) {
+	c.hooks.Branch = append(c.hooks.Branch, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `company.Intercept(f(g(h())))`.
-func (c *CompanyClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Company = append(c.inters.Company, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `branch.Intercept(f(g(h())))`.
+func (c *BranchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Branch = append(c.inters.Branch, interceptors...)
 }
 
-// Create returns a builder for creating a Company entity.
-func (c *CompanyClient) Create() *CompanyCreate {
-	mutation := newCompanyMutation(c.config, OpCreate)
-	return &CompanyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Branch entity.
+func (c *BranchClient) Create() *BranchCreate {
+	mutation := newBranchMutation(c.config, OpCreate)
+	return &BranchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Company entities.
-func (c *CompanyClient) CreateBulk(builders ...*CompanyCreate) *CompanyCreateBulk {
-	return &CompanyCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Branch entities.
+func (c *BranchClient) CreateBulk(builders ...*BranchCreate) *BranchCreateBulk {
+	return &BranchCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CompanyClient) MapCreateBulk(slice any, setFunc func(*CompanyCreate, int)) *CompanyCreateBulk {
+func (c *BranchClient) MapCreateBulk(slice any, setFunc func(*BranchCreate, int)) *BranchCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CompanyCreateBulk{err: fmt.Errorf("calling to CompanyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BranchCreateBulk{err: fmt.Errorf("calling to BranchClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CompanyCreate, rv.Len())
+	builders := make([]*BranchCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CompanyCreateBulk{config: c.config, builders: builders}
+	return &BranchCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Company.
-func (c *CompanyClient) Update() *CompanyUpdate {
-	mutation := newCompanyMutation(c.config, OpUpdate)
-	return &CompanyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Branch.
+func (c *BranchClient) Update() *BranchUpdate {
+	mutation := newBranchMutation(c.config, OpUpdate)
+	return &BranchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CompanyClient) UpdateOne(_m *Company) *CompanyUpdateOne {
-	mutation := newCompanyMutation(c.config, OpUpdateOne, withCompany(_m))
-	return &CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BranchClient) UpdateOne(_m *Branch) *BranchUpdateOne {
+	mutation := newBranchMutation(c.config, OpUpdateOne, withBranch(_m))
+	return &BranchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CompanyClient) UpdateOneID(id string) *CompanyUpdateOne {
-	mutation := newCompanyMutation(c.config, OpUpdateOne, withCompanyID(id))
-	return &CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BranchClient) UpdateOneID(id string) *BranchUpdateOne {
+	mutation := newBranchMutation(c.config, OpUpdateOne, withBranchID(id))
+	return &BranchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Company.
-func (c *CompanyClient) Delete() *CompanyDelete {
-	mutation := newCompanyMutation(c.config, OpDelete)
-	return &CompanyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Branch.
+func (c *BranchClient) Delete() *BranchDelete {
+	mutation := newBranchMutation(c.config, OpDelete)
+	return &BranchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CompanyClient) DeleteOne(_m *Company) *CompanyDeleteOne {
+func (c *BranchClient) DeleteOne(_m *Branch) *BranchDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CompanyClient) DeleteOneID(id string) *CompanyDeleteOne {
-	builder := c.Delete().Where(company.ID(id))
+func (c *BranchClient) DeleteOneID(id string) *BranchDeleteOne {
+	builder := c.Delete().Where(branch.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CompanyDeleteOne{builder}
+	return &BranchDeleteOne{builder}
 }
 
-// Query returns a query builder for Company.
-func (c *CompanyClient) Query() *CompanyQuery {
-	return &CompanyQuery{
+// Query returns a query builder for Branch.
+func (c *BranchClient) Query() *BranchQuery {
+	return &BranchQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCompany},
+		ctx:    &QueryContext{Type: TypeBranch},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Company entity by its id.
-func (c *CompanyClient) Get(ctx context.Context, id string) (*Company, error) {
-	return c.Query().Where(company.ID(id)).Only(ctx)
+// Get returns a Branch entity by its id.
+func (c *BranchClient) Get(ctx context.Context, id string) (*Branch, error) {
+	return c.Query().Where(branch.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CompanyClient) GetX(ctx context.Context, id string) *Company {
+func (c *BranchClient) GetX(ctx context.Context, id string) *Branch {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -709,15 +781,15 @@ func (c *CompanyClient) GetX(ctx context.Context, id string) *Company {
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a Company.
-func (c *CompanyClient) QueryTenant(_m *Company) *TenantQuery {
+// QueryTenant queries the tenant edge of a Branch.
+func (c *BranchClient) QueryTenant(_m *Branch) *TenantQuery {
 	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.From(branch.Table, branch.FieldID, id),
 			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, company.TenantTable, company.TenantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, branch.TenantTable, branch.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -725,15 +797,15 @@ func (c *CompanyClient) QueryTenant(_m *Company) *TenantQuery {
 	return query
 }
 
-// QueryRenter queries the renter edge of a Company.
-func (c *CompanyClient) QueryRenter(_m *Company) *RenterQuery {
-	query := (&RenterClient{config: c.config}).Query()
+// QueryHomeCars queries the home_cars edge of a Branch.
+func (c *BranchClient) QueryHomeCars(_m *Branch) *CarQuery {
+	query := (&CarClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(company.Table, company.FieldID, id),
-			sqlgraph.To(renter.Table, renter.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, company.RenterTable, company.RenterColumn),
+			sqlgraph.From(branch.Table, branch.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, branch.HomeCarsTable, branch.HomeCarsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -741,132 +813,180 @@ func (c *CompanyClient) QueryRenter(_m *Company) *RenterQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *CompanyClient) Hooks() []Hook {
-	return c.hooks.Company
+// QueryCurrentCars queries the current_cars edge of a Branch.
+func (c *BranchClient) QueryCurrentCars(_m *Branch) *CarQuery {
+	query := (&CarClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(branch.Table, branch.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, branch.CurrentCarsTable, branch.CurrentCarsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// Interceptors returns the client interceptors.
-func (c *CompanyClient) Interceptors() []Interceptor {
-	return c.inters.Company
+// QueryPickupRentals queries the pickup_rentals edge of a Branch.
+func (c *BranchClient) QueryPickupRentals(_m *Branch) *RentalQuery {
+	query := (&RentalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(branch.Table, branch.FieldID, id),
+			sqlgraph.To(rental.Table, rental.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, branch.PickupRentalsTable, branch.PickupRentalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *CompanyClient) mutate(ctx context.Context, m *CompanyMutation) (Value, error) {
+// QueryReturnRentals queries the return_rentals edge of a Branch.
+func (c *BranchClient) QueryReturnRentals(_m *Branch) *RentalQuery {
+	query := (&RentalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(branch.Table, branch.FieldID, id),
+			sqlgraph.To(rental.Table, rental.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, branch.ReturnRentalsTable, branch.ReturnRentalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BranchClient) Hooks() []Hook {
+	return c.hooks.Branch
+}
+
+// Interceptors returns the client interceptors.
+func (c *BranchClient) Interceptors() []Interceptor {
+	return c.inters.Branch
+}
+
+func (c *BranchClient) mutate(ctx context.Context, m *BranchMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CompanyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BranchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CompanyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BranchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BranchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CompanyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BranchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown Company mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown Branch mutation op: %q", m.Op())
 	}
 }
 
-// IndividualClient is a client for the Individual schema.
-type IndividualClient struct {
+// CarClient is a client for the Car schema.
+type CarClient struct {
 	config
 }
 
-// NewIndividualClient returns a client for the Individual from the given config.
-func NewIndividualClient(c config) *IndividualClient {
-	return &IndividualClient{config: c}
+// NewCarClient returns a client for the Car from the given config.
+func NewCarClient(c config) *CarClient {
+	return &CarClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `individual.Hooks(f(g(h())))`.
-func (c *IndividualClient) Use(hooks ...Hook) {
-	c.hooks.Individual = append(c.hooks.Individual, hooks...)
+// A call to `Use(f, g, h)` equals to `car.Hooks(f(g(h())))`.
+func (c *CarClient) Use(hooks ...Hook) {
+	c.hooks.Car = append(c.hooks.Car, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `individual.Intercept(f(g(h())))`.
-func (c *IndividualClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Individual = append(c.inters.Individual, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `car.Intercept(f(g(h())))`.
+func (c *CarClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Car = append(c.inters.Car, interceptors...)
 }
 
-// Create returns a builder for creating a Individual entity.
-func (c *IndividualClient) Create() *IndividualCreate {
-	mutation := newIndividualMutation(c.config, OpCreate)
-	return &IndividualCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Car entity.
+func (c *CarClient) Create() *CarCreate {
+	mutation := newCarMutation(c.config, OpCreate)
+	return &CarCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Individual entities.
-func (c *IndividualClient) CreateBulk(builders ...*IndividualCreate) *IndividualCreateBulk {
-	return &IndividualCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Car entities.
+func (c *CarClient) CreateBulk(builders ...*CarCreate) *CarCreateBulk {
+	return &CarCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *IndividualClient) MapCreateBulk(slice any, setFunc func(*IndividualCreate, int)) *IndividualCreateBulk {
+func (c *CarClient) MapCreateBulk(slice any, setFunc func(*CarCreate, int)) *CarCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &IndividualCreateBulk{err: fmt.Errorf("calling to IndividualClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CarCreateBulk{err: fmt.Errorf("calling to CarClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*IndividualCreate, rv.Len())
+	builders := make([]*CarCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &IndividualCreateBulk{config: c.config, builders: builders}
+	return &CarCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Individual.
-func (c *IndividualClient) Update() *IndividualUpdate {
-	mutation := newIndividualMutation(c.config, OpUpdate)
-	return &IndividualUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Car.
+func (c *CarClient) Update() *CarUpdate {
+	mutation := newCarMutation(c.config, OpUpdate)
+	return &CarUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *IndividualClient) UpdateOne(_m *Individual) *IndividualUpdateOne {
-	mutation := newIndividualMutation(c.config, OpUpdateOne, withIndividual(_m))
-	return &IndividualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CarClient) UpdateOne(_m *Car) *CarUpdateOne {
+	mutation := newCarMutation(c.config, OpUpdateOne, withCar(_m))
+	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *IndividualClient) UpdateOneID(id string) *IndividualUpdateOne {
-	mutation := newIndividualMutation(c.config, OpUpdateOne, withIndividualID(id))
-	return &IndividualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CarClient) UpdateOneID(id string) *CarUpdateOne {
+	mutation := newCarMutation(c.config, OpUpdateOne, withCarID(id))
+	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Individual.
-func (c *IndividualClient) Delete() *IndividualDelete {
-	mutation := newIndividualMutation(c.config, OpDelete)
-	return &IndividualDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Car.
+func (c *CarClient) Delete() *CarDelete {
+	mutation := newCarMutation(c.config, OpDelete)
+	return &CarDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *IndividualClient) DeleteOne(_m *Individual) *IndividualDeleteOne {
+func (c *CarClient) DeleteOne(_m *Car) *CarDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *IndividualClient) DeleteOneID(id string) *IndividualDeleteOne {
-	builder := c.Delete().Where(individual.ID(id))
+func (c *CarClient) DeleteOneID(id string) *CarDeleteOne {
+	builder := c.Delete().Where(car.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &IndividualDeleteOne{builder}
+	return &CarDeleteOne{builder}
 }
 
-// Query returns a query builder for Individual.
-func (c *IndividualClient) Query() *IndividualQuery {
-	return &IndividualQuery{
+// Query returns a query builder for Car.
+func (c *CarClient) Query() *CarQuery {
+	return &CarQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeIndividual},
+		ctx:    &QueryContext{Type: TypeCar},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Individual entity by its id.
-func (c *IndividualClient) Get(ctx context.Context, id string) (*Individual, error) {
-	return c.Query().Where(individual.ID(id)).Only(ctx)
+// Get returns a Car entity by its id.
+func (c *CarClient) Get(ctx context.Context, id string) (*Car, error) {
+	return c.Query().Where(car.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *IndividualClient) GetX(ctx context.Context, id string) *Individual {
+func (c *CarClient) GetX(ctx context.Context, id string) *Car {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -874,15 +994,15 @@ func (c *IndividualClient) GetX(ctx context.Context, id string) *Individual {
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a Individual.
-func (c *IndividualClient) QueryTenant(_m *Individual) *TenantQuery {
+// QueryTenant queries the tenant edge of a Car.
+func (c *CarClient) QueryTenant(_m *Car) *TenantQuery {
 	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(individual.Table, individual.FieldID, id),
+			sqlgraph.From(car.Table, car.FieldID, id),
 			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, individual.TenantTable, individual.TenantColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.TenantTable, car.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -890,15 +1010,79 @@ func (c *IndividualClient) QueryTenant(_m *Individual) *TenantQuery {
 	return query
 }
 
-// QueryRenter queries the renter edge of a Individual.
-func (c *IndividualClient) QueryRenter(_m *Individual) *RenterQuery {
-	query := (&RenterClient{config: c.config}).Query()
+// QueryCarModel queries the car_model edge of a Car.
+func (c *CarClient) QueryCarModel(_m *Car) *CarModelQuery {
+	query := (&CarModelClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(individual.Table, individual.FieldID, id),
-			sqlgraph.To(renter.Table, renter.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, individual.RenterTable, individual.RenterColumn),
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(carmodel.Table, carmodel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.CarModelTable, car.CarModelColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHomeBranch queries the home_branch edge of a Car.
+func (c *CarClient) QueryHomeBranch(_m *Car) *BranchQuery {
+	query := (&BranchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.HomeBranchTable, car.HomeBranchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCurrentBranch queries the current_branch edge of a Car.
+func (c *CarClient) QueryCurrentBranch(_m *Car) *BranchQuery {
+	query := (&BranchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, car.CurrentBranchTable, car.CurrentBranchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRentals queries the rentals edge of a Car.
+func (c *CarClient) QueryRentals(_m *Car) *RentalQuery {
+	query := (&RentalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(rental.Table, rental.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.RentalsTable, car.RentalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMaintenanceWindows queries the maintenance_windows edge of a Car.
+func (c *CarClient) QueryMaintenanceWindows(_m *Car) *MaintenanceWindowQuery {
+	query := (&MaintenanceWindowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(car.Table, car.FieldID, id),
+			sqlgraph.To(maintenancewindow.Table, maintenancewindow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, car.MaintenanceWindowsTable, car.MaintenanceWindowsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -907,131 +1091,2713 @@ func (c *IndividualClient) QueryRenter(_m *Individual) *RenterQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *IndividualClient) Hooks() []Hook {
-	return c.hooks.Individual
+func (c *CarClient) Hooks() []Hook {
+	return c.hooks.Car
 }
 
 // Interceptors returns the client interceptors.
-func (c *IndividualClient) Interceptors() []Interceptor {
-	return c.inters.Individual
+func (c *CarClient) Interceptors() []Interceptor {
+	return c.inters.Car
 }
 
-func (c *IndividualClient) mutate(ctx context.Context, m *IndividualMutation) (Value, error) {
+func (c *CarClient) mutate(ctx context.Context, m *CarMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&IndividualCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CarCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IndividualUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CarUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IndividualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IndividualDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CarDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown Individual mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown Car mutation op: %q", m.Op())
 	}
 }
 
-// OutboxClient is a client for the Outbox schema.
-type OutboxClient struct {
+// CarModelClient is a client for the CarModel schema.
+type CarModelClient struct {
 	config
 }
 
-// NewOutboxClient returns a client for the Outbox from the given config.
-func NewOutboxClient(c config) *OutboxClient {
-	return &OutboxClient{config: c}
+// NewCarModelClient returns a client for the CarModel from the given config.
+func NewCarModelClient(c config) *CarModelClient {
+	return &CarModelClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `outbox.Hooks(f(g(h())))`.
-func (c *OutboxClient) Use(hooks ...Hook) {
-	c.hooks.Outbox = append(c.hooks.Outbox, hooks...)
+// A call to `Use(f, g, h)` equals to `carmodel.Hooks(f(g(h())))`.
+func (c *CarModelClient) Use(hooks ...Hook) {
+	c.hooks.CarModel = append(c.hooks.CarModel, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `outbox.Intercept(f(g(h())))`.
-func (c *OutboxClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Outbox = append(c.inters.Outbox, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `carmodel.Intercept(f(g(h())))`.
+func (c *CarModelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CarModel = append(c.inters.CarModel, interceptors...)
 }
 
-// Create returns a builder for creating a Outbox entity.
-func (c *OutboxClient) Create() *OutboxCreate {
-	mutation := newOutboxMutation(c.config, OpCreate)
-	return &OutboxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CarModel entity.
+func (c *CarModelClient) Create() *CarModelCreate {
+	mutation := newCarModelMutation(c.config, OpCreate)
+	return &CarModelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Outbox entities.
-func (c *OutboxClient) CreateBulk(builders ...*OutboxCreate) *OutboxCreateBulk {
-	return &OutboxCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CarModel entities.
+func (c *CarModelClient) CreateBulk(builders ...*CarModelCreate) *CarModelCreateBulk {
+	return &CarModelCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OutboxClient) MapCreateBulk(slice any, setFunc func(*OutboxCreate, int)) *OutboxCreateBulk {
+func (c *CarModelClient) MapCreateBulk(slice any, setFunc func(*CarModelCreate, int)) *CarModelCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OutboxCreateBulk{err: fmt.Errorf("calling to OutboxClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CarModelCreateBulk{err: fmt.Errorf("calling to CarModelClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OutboxCreate, rv.Len())
+	builders := make([]*CarModelCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OutboxCreateBulk{config: c.config, builders: builders}
+	return &CarModelCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Outbox.
-func (c *OutboxClient) Update() *OutboxUpdate {
-	mutation := newOutboxMutation(c.config, OpUpdate)
-	return &OutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CarModel.
+func (c *CarModelClient) Update() *CarModelUpdate {
+	mutation := newCarModelMutation(c.config, OpUpdate)
+	return &CarModelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OutboxClient) UpdateOne(_m *Outbox) *OutboxUpdateOne {
-	mutation := newOutboxMutation(c.config, OpUpdateOne, withOutbox(_m))
-	return &OutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CarModelClient) UpdateOne(_m *CarModel) *CarModelUpdateOne {
+	mutation := newCarModelMutation(c.config, OpUpdateOne, withCarModel(_m))
+	return &CarModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OutboxClient) UpdateOneID(id string) *OutboxUpdateOne {
-	mutation := newOutboxMutation(c.config, OpUpdateOne, withOutboxID(id))
-	return &OutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CarModelClient) UpdateOneID(id string) *CarModelUpdateOne {
+	mutation := newCarModelMutation(c.config, OpUpdateOne, withCarModelID(id))
+	return &CarModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Outbox.
-func (c *OutboxClient) Delete() *OutboxDelete {
-	mutation := newOutboxMutation(c.config, OpDelete)
-	return &OutboxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CarModel.
+func (c *CarModelClient) Delete() *CarModelDelete {
+	mutation := newCarModelMutation(c.config, OpDelete)
+	return &CarModelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OutboxClient) DeleteOne(_m *Outbox) *OutboxDeleteOne {
+func (c *CarModelClient) DeleteOne(_m *CarModel) *CarModelDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OutboxClient) DeleteOneID(id string) *OutboxDeleteOne {
-	builder := c.Delete().Where(outbox.ID(id))
+func (c *CarModelClient) DeleteOneID(id string) *CarModelDeleteOne {
+	builder := c.Delete().Where(carmodel.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OutboxDeleteOne{builder}
+	return &CarModelDeleteOne{builder}
 }
 
-// Query returns a query builder for Outbox.
-func (c *OutboxClient) Query() *OutboxQuery {
-	return &OutboxQuery{
+// Query returns a query builder for CarModel.
+func (c *CarModelClient) Query() *CarModelQuery {
+	return &CarModelQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOutbox},
+		ctx:    &QueryContext{Type: TypeCarModel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CarModel entity by its id.
+func (c *CarModelClient) Get(ctx context.Context, id string) (*CarModel, error) {
+	return c.Query().Where(carmodel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CarModelClient) GetX(ctx context.Context, id string) *CarModel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a CarModel.
+func (c *CarModelClient) QueryTenant(_m *CarModel) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carmodel.Table, carmodel.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carmodel.TenantTable, carmodel.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCars queries the cars edge of a CarModel.
+func (c *CarModelClient) QueryCars(_m *CarModel) *CarQuery {
+	query := (&CarClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(carmodel.Table, carmodel.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, carmodel.CarsTable, carmodel.CarsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CarModelClient) Hooks() []Hook {
+	return c.hooks.CarModel
+}
+
+// Interceptors returns the client interceptors.
+func (c *CarModelClient) Interceptors() []Interceptor {
+	return c.inters.CarModel
+}
+
+func (c *CarModelClient) mutate(ctx context.Context, m *CarModelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CarModelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CarModelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CarModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CarModelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown CarModel mutation op: %q", m.Op())
+	}
+}
+
+// CarOptionClient is a client for the CarOption schema.
+type CarOptionClient struct {
+	config
+}
+
+// NewCarOptionClient returns a client for the CarOption from the given config.
+func NewCarOptionClient(c config) *CarOptionClient {
+	return &CarOptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `caroption.Hooks(f(g(h())))`.
+func (c *CarOptionClient) Use(hooks ...Hook) {
+	c.hooks.CarOption = append(c.hooks.CarOption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `caroption.Intercept(f(g(h())))`.
+func (c *CarOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CarOption = append(c.inters.CarOption, interceptors...)
+}
+
+// Create returns a builder for creating a CarOption entity.
+func (c *CarOptionClient) Create() *CarOptionCreate {
+	mutation := newCarOptionMutation(c.config, OpCreate)
+	return &CarOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CarOption entities.
+func (c *CarOptionClient) CreateBulk(builders ...*CarOptionCreate) *CarOptionCreateBulk {
+	return &CarOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CarOptionClient) MapCreateBulk(slice any, setFunc func(*CarOptionCreate, int)) *CarOptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CarOptionCreateBulk{err: fmt.Errorf("calling to CarOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CarOptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CarOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CarOption.
+func (c *CarOptionClient) Update() *CarOptionUpdate {
+	mutation := newCarOptionMutation(c.config, OpUpdate)
+	return &CarOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CarOptionClient) UpdateOne(_m *CarOption) *CarOptionUpdateOne {
+	mutation := newCarOptionMutation(c.config, OpUpdateOne, withCarOption(_m))
+	return &CarOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CarOptionClient) UpdateOneID(id string) *CarOptionUpdateOne {
+	mutation := newCarOptionMutation(c.config, OpUpdateOne, withCarOptionID(id))
+	return &CarOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CarOption.
+func (c *CarOptionClient) Delete() *CarOptionDelete {
+	mutation := newCarOptionMutation(c.config, OpDelete)
+	return &CarOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CarOptionClient) DeleteOne(_m *CarOption) *CarOptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CarOptionClient) DeleteOneID(id string) *CarOptionDeleteOne {
+	builder := c.Delete().Where(caroption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CarOptionDeleteOne{builder}
+}
+
+// Query returns a query builder for CarOption.
+func (c *CarOptionClient) Query() *CarOptionQuery {
+	return &CarOptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCarOption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CarOption entity by its id.
+func (c *CarOptionClient) Get(ctx context.Context, id string) (*CarOption, error) {
+	return c.Query().Where(caroption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CarOptionClient) GetX(ctx context.Context, id string) *CarOption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a CarOption.
+func (c *CarOptionClient) QueryTenant(_m *CarOption) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(caroption.Table, caroption.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, caroption.TenantTable, caroption.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRentalOptions queries the rental_options edge of a CarOption.
+func (c *CarOptionClient) QueryRentalOptions(_m *CarOption) *RentalOptionQuery {
+	query := (&RentalOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(caroption.Table, caroption.FieldID, id),
+			sqlgraph.To(rentaloption.Table, rentaloption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, caroption.RentalOptionsTable, caroption.RentalOptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CarOptionClient) Hooks() []Hook {
+	return c.hooks.CarOption
+}
+
+// Interceptors returns the client interceptors.
+func (c *CarOptionClient) Interceptors() []Interceptor {
+	return c.inters.CarOption
+}
+
+func (c *CarOptionClient) mutate(ctx context.Context, m *CarOptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CarOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CarOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CarOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CarOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown CarOption mutation op: %q", m.Op())
+	}
+}
+
+// CompanyClient is a client for the Company schema.
+type CompanyClient struct {
+	config
+}
+
+// NewCompanyClient returns a client for the Company from the given config.
+func NewCompanyClient(c config) *CompanyClient {
+	return &CompanyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `company.Hooks(f(g(h())))`.
+func (c *CompanyClient) Use(hooks ...Hook) {
+	c.hooks.Company = append(c.hooks.Company, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `company.Intercept(f(g(h())))`.
+func (c *CompanyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Company = append(c.inters.Company, interceptors...)
+}
+
+// Create returns a builder for creating a Company entity.
+func (c *CompanyClient) Create() *CompanyCreate {
+	mutation := newCompanyMutation(c.config, OpCreate)
+	return &CompanyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Company entities.
+func (c *CompanyClient) CreateBulk(builders ...*CompanyCreate) *CompanyCreateBulk {
+	return &CompanyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CompanyClient) MapCreateBulk(slice any, setFunc func(*CompanyCreate, int)) *CompanyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CompanyCreateBulk{err: fmt.Errorf("calling to CompanyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CompanyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CompanyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Company.
+func (c *CompanyClient) Update() *CompanyUpdate {
+	mutation := newCompanyMutation(c.config, OpUpdate)
+	return &CompanyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CompanyClient) UpdateOne(_m *Company) *CompanyUpdateOne {
+	mutation := newCompanyMutation(c.config, OpUpdateOne, withCompany(_m))
+	return &CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CompanyClient) UpdateOneID(id string) *CompanyUpdateOne {
+	mutation := newCompanyMutation(c.config, OpUpdateOne, withCompanyID(id))
+	return &CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Company.
+func (c *CompanyClient) Delete() *CompanyDelete {
+	mutation := newCompanyMutation(c.config, OpDelete)
+	return &CompanyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CompanyClient) DeleteOne(_m *Company) *CompanyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CompanyClient) DeleteOneID(id string) *CompanyDeleteOne {
+	builder := c.Delete().Where(company.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CompanyDeleteOne{builder}
+}
+
+// Query returns a query builder for Company.
+func (c *CompanyClient) Query() *CompanyQuery {
+	return &CompanyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCompany},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Company entity by its id.
+func (c *CompanyClient) Get(ctx context.Context, id string) (*Company, error) {
+	return c.Query().Where(company.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CompanyClient) GetX(ctx context.Context, id string) *Company {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a Company.
+func (c *CompanyClient) QueryTenant(_m *Company) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, company.TenantTable, company.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRenter queries the renter edge of a Company.
+func (c *CompanyClient) QueryRenter(_m *Company) *RenterQuery {
+	query := (&RenterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(renter.Table, renter.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, company.RenterTable, company.RenterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CompanyClient) Hooks() []Hook {
+	return c.hooks.Company
+}
+
+// Interceptors returns the client interceptors.
+func (c *CompanyClient) Interceptors() []Interceptor {
+	return c.inters.Company
+}
+
+func (c *CompanyClient) mutate(ctx context.Context, m *CompanyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CompanyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CompanyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CompanyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CompanyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown Company mutation op: %q", m.Op())
+	}
+}
+
+// FleetSharingAgreementClient is a client for the FleetSharingAgreement schema.
+type FleetSharingAgreementClient struct {
+	config
+}
+
+// NewFleetSharingAgreementClient returns a client for the FleetSharingAgreement from the given config.
+func NewFleetSharingAgreementClient(c config) *FleetSharingAgreementClient {
+	return &FleetSharingAgreementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fleetsharingagreement.Hooks(f(g(h())))`.
+func (c *FleetSharingAgreementClient) Use(hooks ...Hook) {
+	c.hooks.FleetSharingAgreement = append(c.hooks.FleetSharingAgreement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `fleetsharingagreement.Intercept(f(g(h())))`.
+func (c *FleetSharingAgreementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FleetSharingAgreement = append(c.inters.FleetSharingAgreement, interceptors...)
+}
+
+// Create returns a builder for creating a FleetSharingAgreement entity.
+func (c *FleetSharingAgreementClient) Create() *FleetSharingAgreementCreate {
+	mutation := newFleetSharingAgreementMutation(c.config, OpCreate)
+	return &FleetSharingAgreementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FleetSharingAgreement entities.
+func (c *FleetSharingAgreementClient) CreateBulk(builders ...*FleetSharingAgreementCreate) *FleetSharingAgreementCreateBulk {
+	return &FleetSharingAgreementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FleetSharingAgreementClient) MapCreateBulk(slice any, setFunc func(*FleetSharingAgreementCreate, int)) *FleetSharingAgreementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FleetSharingAgreementCreateBulk{err: fmt.Errorf("calling to FleetSharingAgreementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FleetSharingAgreementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FleetSharingAgreementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FleetSharingAgreement.
+func (c *FleetSharingAgreementClient) Update() *FleetSharingAgreementUpdate {
+	mutation := newFleetSharingAgreementMutation(c.config, OpUpdate)
+	return &FleetSharingAgreementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FleetSharingAgreementClient) UpdateOne(_m *FleetSharingAgreement) *FleetSharingAgreementUpdateOne {
+	mutation := newFleetSharingAgreementMutation(c.config, OpUpdateOne, withFleetSharingAgreement(_m))
+	return &FleetSharingAgreementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FleetSharingAgreementClient) UpdateOneID(id string) *FleetSharingAgreementUpdateOne {
+	mutation := newFleetSharingAgreementMutation(c.config, OpUpdateOne, withFleetSharingAgreementID(id))
+	return &FleetSharingAgreementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FleetSharingAgreement.
+func (c *FleetSharingAgreementClient) Delete() *FleetSharingAgreementDelete {
+	mutation := newFleetSharingAgreementMutation(c.config, OpDelete)
+	return &FleetSharingAgreementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FleetSharingAgreementClient) DeleteOne(_m *FleetSharingAgreement) *FleetSharingAgreementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FleetSharingAgreementClient) DeleteOneID(id string) *FleetSharingAgreementDeleteOne {
+	builder := c.Delete().Where(fleetsharingagreement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FleetSharingAgreementDeleteOne{builder}
+}
+
+// Query returns a query builder for FleetSharingAgreement.
+func (c *FleetSharingAgreementClient) Query() *FleetSharingAgreementQuery {
+	return &FleetSharingAgreementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFleetSharingAgreement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FleetSharingAgreement entity by its id.
+func (c *FleetSharingAgreementClient) Get(ctx context.Context, id string) (*FleetSharingAgreement, error) {
+	return c.Query().Where(fleetsharingagreement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FleetSharingAgreementClient) GetX(ctx context.Context, id string) *FleetSharingAgreement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FleetSharingAgreementClient) Hooks() []Hook {
+	return c.hooks.FleetSharingAgreement
+}
+
+// Interceptors returns the client interceptors.
+func (c *FleetSharingAgreementClient) Interceptors() []Interceptor {
+	return c.inters.FleetSharingAgreement
+}
+
+func (c *FleetSharingAgreementClient) mutate(ctx context.Context, m *FleetSharingAgreementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FleetSharingAgreementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FleetSharingAgreementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FleetSharingAgreementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FleetSharingAgreementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown FleetSharingAgreement mutation op: %q", m.Op())
+	}
+}
+
+// InboxClient is a client for the Inbox schema.
+type InboxClient struct {
+	config
+}
+
+// NewInboxClient returns a client for the Inbox from the given config.
+func NewInboxClient(c config) *InboxClient {
+	return &InboxClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `inbox.Hooks(f(g(h())))`.
+func (c *InboxClient) Use(hooks ...Hook) {
+	c.hooks.Inbox = append(c.hooks.Inbox, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `inbox.Intercept(f(g(h())))`.
+func (c *InboxClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Inbox = append(c.inters.Inbox, interceptors...)
+}
+
+// Create returns a builder for creating a Inbox entity.
+func (c *InboxClient) Create() *InboxCreate {
+	mutation := newInboxMutation(c.config, OpCreate)
+	return &InboxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Inbox entities.
+func (c *InboxClient) CreateBulk(builders ...*InboxCreate) *InboxCreateBulk {
+	return &InboxCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InboxClient) MapCreateBulk(slice any, setFunc func(*InboxCreate, int)) *InboxCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InboxCreateBulk{err: fmt.Errorf("calling to InboxClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InboxCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InboxCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Inbox.
+func (c *InboxClient) Update() *InboxUpdate {
+	mutation := newInboxMutation(c.config, OpUpdate)
+	return &InboxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InboxClient) UpdateOne(_m *Inbox) *InboxUpdateOne {
+	mutation := newInboxMutation(c.config, OpUpdateOne, withInbox(_m))
+	return &InboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InboxClient) UpdateOneID(id string) *InboxUpdateOne {
+	mutation := newInboxMutation(c.config, OpUpdateOne, withInboxID(id))
+	return &InboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Inbox.
+func (c *InboxClient) Delete() *InboxDelete {
+	mutation := newInboxMutation(c.config, OpDelete)
+	return &InboxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InboxClient) DeleteOne(_m *Inbox) *InboxDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InboxClient) DeleteOneID(id string) *InboxDeleteOne {
+	builder := c.Delete().Where(inbox.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InboxDeleteOne{builder}
+}
+
+// Query returns a query builder for Inbox.
+func (c *InboxClient) Query() *InboxQuery {
+	return &InboxQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInbox},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Inbox entity by its id.
+func (c *InboxClient) Get(ctx context.Context, id string) (*Inbox, error) {
+	return c.Query().Where(inbox.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InboxClient) GetX(ctx context.Context, id string) *Inbox {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InboxClient) Hooks() []Hook {
+	return c.hooks.Inbox
+}
+
+// Interceptors returns the client interceptors.
+func (c *InboxClient) Interceptors() []Interceptor {
+	return c.inters.Inbox
+}
+
+func (c *InboxClient) mutate(ctx context.Context, m *InboxMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InboxCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InboxUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InboxDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown Inbox mutation op: %q", m.Op())
+	}
+}
+
+// IndividualClient is a client for the Individual schema.
+type IndividualClient struct {
+	config
+}
+
+// NewIndividualClient returns a client for the Individual from the given config.
+func NewIndividualClient(c config) *IndividualClient {
+	return &IndividualClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `individual.Hooks(f(g(h())))`.
+func (c *IndividualClient) Use(hooks ...Hook) {
+	c.hooks.Individual = append(c.hooks.Individual, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `individual.Intercept(f(g(h())))`.
+func (c *IndividualClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Individual = append(c.inters.Individual, interceptors...)
+}
+
+// Create returns a builder for creating a Individual entity.
+func (c *IndividualClient) Create() *IndividualCreate {
+	mutation := newIndividualMutation(c.config, OpCreate)
+	return &IndividualCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Individual entities.
+func (c *IndividualClient) CreateBulk(builders ...*IndividualCreate) *IndividualCreateBulk {
+	return &IndividualCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IndividualClient) MapCreateBulk(slice any, setFunc func(*IndividualCreate, int)) *IndividualCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IndividualCreateBulk{err: fmt.Errorf("calling to IndividualClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IndividualCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IndividualCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Individual.
+func (c *IndividualClient) Update() *IndividualUpdate {
+	mutation := newIndividualMutation(c.config, OpUpdate)
+	return &IndividualUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IndividualClient) UpdateOne(_m *Individual) *IndividualUpdateOne {
+	mutation := newIndividualMutation(c.config, OpUpdateOne, withIndividual(_m))
+	return &IndividualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IndividualClient) UpdateOneID(id string) *IndividualUpdateOne {
+	mutation := newIndividualMutation(c.config, OpUpdateOne, withIndividualID(id))
+	return &IndividualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Individual.
+func (c *IndividualClient) Delete() *IndividualDelete {
+	mutation := newIndividualMutation(c.config, OpDelete)
+	return &IndividualDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IndividualClient) DeleteOne(_m *Individual) *IndividualDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IndividualClient) DeleteOneID(id string) *IndividualDeleteOne {
+	builder := c.Delete().Where(individual.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IndividualDeleteOne{builder}
+}
+
+// Query returns a query builder for Individual.
+func (c *IndividualClient) Query() *IndividualQuery {
+	return &IndividualQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIndividual},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Individual entity by its id.
+func (c *IndividualClient) Get(ctx context.Context, id string) (*Individual, error) {
+	return c.Query().Where(individual.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IndividualClient) GetX(ctx context.Context, id string) *Individual {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a Individual.
+func (c *IndividualClient) QueryTenant(_m *Individual) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(individual.Table, individual.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, individual.TenantTable, individual.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRenter queries the renter edge of a Individual.
+func (c *IndividualClient) QueryRenter(_m *Individual) *RenterQuery {
+	query := (&RenterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(individual.Table, individual.FieldID, id),
+			sqlgraph.To(renter.Table, renter.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, individual.RenterTable, individual.RenterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IndividualClient) Hooks() []Hook {
+	return c.hooks.Individual
+}
+
+// Interceptors returns the client interceptors.
+func (c *IndividualClient) Interceptors() []Interceptor {
+	return c.inters.Individual
+}
+
+func (c *IndividualClient) mutate(ctx context.Context, m *IndividualMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IndividualCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IndividualUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IndividualUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IndividualDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown Individual mutation op: %q", m.Op())
+	}
+}
+
+// MaintenanceWindowClient is a client for the MaintenanceWindow schema.
+type MaintenanceWindowClient struct {
+	config
+}
+
+// NewMaintenanceWindowClient returns a client for the MaintenanceWindow from the given config.
+func NewMaintenanceWindowClient(c config) *MaintenanceWindowClient {
+	return &MaintenanceWindowClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `maintenancewindow.Hooks(f(g(h())))`.
+func (c *MaintenanceWindowClient) Use(hooks ...Hook) {
+	c.hooks.MaintenanceWindow = append(c.hooks.MaintenanceWindow, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `maintenancewindow.Intercept(f(g(h())))`.
+func (c *MaintenanceWindowClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MaintenanceWindow = append(c.inters.MaintenanceWindow, interceptors...)
+}
+
+// Create returns a builder for creating a MaintenanceWindow entity.
+func (c *MaintenanceWindowClient) Create() *MaintenanceWindowCreate {
+	mutation := newMaintenanceWindowMutation(c.config, OpCreate)
+	return &MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MaintenanceWindow entities.
+func (c *MaintenanceWindowClient) CreateBulk(builders ...*MaintenanceWindowCreate) *MaintenanceWindowCreateBulk {
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MaintenanceWindowClient) MapCreateBulk(slice any, setFunc func(*MaintenanceWindowCreate, int)) *MaintenanceWindowCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MaintenanceWindowCreateBulk{err: fmt.Errorf("calling to MaintenanceWindowClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MaintenanceWindowCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MaintenanceWindowCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Update() *MaintenanceWindowUpdate {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdate)
+	return &MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MaintenanceWindowClient) UpdateOne(_m *MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindow(_m))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MaintenanceWindowClient) UpdateOneID(id string) *MaintenanceWindowUpdateOne {
+	mutation := newMaintenanceWindowMutation(c.config, OpUpdateOne, withMaintenanceWindowID(id))
+	return &MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Delete() *MaintenanceWindowDelete {
+	mutation := newMaintenanceWindowMutation(c.config, OpDelete)
+	return &MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MaintenanceWindowClient) DeleteOne(_m *MaintenanceWindow) *MaintenanceWindowDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MaintenanceWindowClient) DeleteOneID(id string) *MaintenanceWindowDeleteOne {
+	builder := c.Delete().Where(maintenancewindow.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MaintenanceWindowDeleteOne{builder}
+}
+
+// Query returns a query builder for MaintenanceWindow.
+func (c *MaintenanceWindowClient) Query() *MaintenanceWindowQuery {
+	return &MaintenanceWindowQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMaintenanceWindow},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MaintenanceWindow entity by its id.
+func (c *MaintenanceWindowClient) Get(ctx context.Context, id string) (*MaintenanceWindow, error) {
+	return c.Query().Where(maintenancewindow.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MaintenanceWindowClient) GetX(ctx context.Context, id string) *MaintenanceWindow {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a MaintenanceWindow.
+func (c *MaintenanceWindowClient) QueryTenant(_m *MaintenanceWindow) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenancewindow.Table, maintenancewindow.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, maintenancewindow.TenantTable, maintenancewindow.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCar queries the car edge of a MaintenanceWindow.
+func (c *MaintenanceWindowClient) QueryCar(_m *MaintenanceWindow) *CarQuery {
+	query := (&CarClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(maintenancewindow.Table, maintenancewindow.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, maintenancewindow.CarTable, maintenancewindow.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MaintenanceWindowClient) Hooks() []Hook {
+	return c.hooks.MaintenanceWindow
+}
+
+// Interceptors returns the client interceptors.
+func (c *MaintenanceWindowClient) Interceptors() []Interceptor {
+	return c.inters.MaintenanceWindow
+}
+
+func (c *MaintenanceWindowClient) mutate(ctx context.Context, m *MaintenanceWindowMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MaintenanceWindowCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MaintenanceWindowUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MaintenanceWindowUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MaintenanceWindowDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown MaintenanceWindow mutation op: %q", m.Op())
+	}
+}
+
+// OutboxClient is a client for the Outbox schema.
+type OutboxClient struct {
+	config
+}
+
+// NewOutboxClient returns a client for the Outbox from the given config.
+func NewOutboxClient(c config) *OutboxClient {
+	return &OutboxClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outbox.Hooks(f(g(h())))`.
+func (c *OutboxClient) Use(hooks ...Hook) {
+	c.hooks.Outbox = append(c.hooks.Outbox, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outbox.Intercept(f(g(h())))`.
+func (c *OutboxClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Outbox = append(c.inters.Outbox, interceptors...)
+}
+
+// Create returns a builder for creating a Outbox entity.
+func (c *OutboxClient) Create() *OutboxCreate {
+	mutation := newOutboxMutation(c.config, OpCreate)
+	return &OutboxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Outbox entities.
+func (c *OutboxClient) CreateBulk(builders ...*OutboxCreate) *OutboxCreateBulk {
+	return &OutboxCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxClient) MapCreateBulk(slice any, setFunc func(*OutboxCreate, int)) *OutboxCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxCreateBulk{err: fmt.Errorf("calling to OutboxClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Outbox.
+func (c *OutboxClient) Update() *OutboxUpdate {
+	mutation := newOutboxMutation(c.config, OpUpdate)
+	return &OutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxClient) UpdateOne(_m *Outbox) *OutboxUpdateOne {
+	mutation := newOutboxMutation(c.config, OpUpdateOne, withOutbox(_m))
+	return &OutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxClient) UpdateOneID(id string) *OutboxUpdateOne {
+	mutation := newOutboxMutation(c.config, OpUpdateOne, withOutboxID(id))
+	return &OutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Outbox.
+func (c *OutboxClient) Delete() *OutboxDelete {
+	mutation := newOutboxMutation(c.config, OpDelete)
+	return &OutboxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxClient) DeleteOne(_m *Outbox) *OutboxDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxClient) DeleteOneID(id string) *OutboxDeleteOne {
+	builder := c.Delete().Where(outbox.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxDeleteOne{builder}
+}
+
+// Query returns a query builder for Outbox.
+func (c *OutboxClient) Query() *OutboxQuery {
+	return &OutboxQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutbox},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Outbox entity by its id.
+func (c *OutboxClient) Get(ctx context.Context, id string) (*Outbox, error) {
+	return c.Query().Where(outbox.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxClient) GetX(ctx context.Context, id string) *Outbox {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxClient) Hooks() []Hook {
+	return c.hooks.Outbox
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxClient) Interceptors() []Interceptor {
+	return c.inters.Outbox
+}
+
+func (c *OutboxClient) mutate(ctx context.Context, m *OutboxMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown Outbox mutation op: %q", m.Op())
+	}
+}
+
+// PlanClient is a client for the Plan schema.
+type PlanClient struct {
+	config
+}
+
+// NewPlanClient returns a client for the Plan from the given config.
+func NewPlanClient(c config) *PlanClient {
+	return &PlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `plan.Hooks(f(g(h())))`.
+func (c *PlanClient) Use(hooks ...Hook) {
+	c.hooks.Plan = append(c.hooks.Plan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `plan.Intercept(f(g(h())))`.
+func (c *PlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Plan = append(c.inters.Plan, interceptors...)
+}
+
+// Create returns a builder for creating a Plan entity.
+func (c *PlanClient) Create() *PlanCreate {
+	mutation := newPlanMutation(c.config, OpCreate)
+	return &PlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Plan entities.
+func (c *PlanClient) CreateBulk(builders ...*PlanCreate) *PlanCreateBulk {
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlanClient) MapCreateBulk(slice any, setFunc func(*PlanCreate, int)) *PlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlanCreateBulk{err: fmt.Errorf("calling to PlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Plan.
+func (c *PlanClient) Update() *PlanUpdate {
+	mutation := newPlanMutation(c.config, OpUpdate)
+	return &PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlanClient) UpdateOne(_m *Plan) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlan(_m))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlanClient) UpdateOneID(id string) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlanID(id))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Plan.
+func (c *PlanClient) Delete() *PlanDelete {
+	mutation := newPlanMutation(c.config, OpDelete)
+	return &PlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlanClient) DeleteOne(_m *Plan) *PlanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlanClient) DeleteOneID(id string) *PlanDeleteOne {
+	builder := c.Delete().Where(plan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlanDeleteOne{builder}
+}
+
+// Query returns a query builder for Plan.
+func (c *PlanClient) Query() *PlanQuery {
+	return &PlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Plan entity by its id.
+func (c *PlanClient) Get(ctx context.Context, id string) (*Plan, error) {
+	return c.Query().Where(plan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlanClient) GetX(ctx context.Context, id string) *Plan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenants queries the tenants edge of a Plan.
+func (c *PlanClient) QueryTenants(_m *Plan) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(plan.Table, plan.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, plan.TenantsTable, plan.TenantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PlanClient) Hooks() []Hook {
+	return c.hooks.Plan
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlanClient) Interceptors() []Interceptor {
+	return c.inters.Plan
+}
+
+func (c *PlanClient) mutate(ctx context.Context, m *PlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown Plan mutation op: %q", m.Op())
+	}
+}
+
+// RentalClient is a client for the Rental schema.
+type RentalClient struct {
+	config
+}
+
+// NewRentalClient returns a client for the Rental from the given config.
+func NewRentalClient(c config) *RentalClient {
+	return &RentalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rental.Hooks(f(g(h())))`.
+func (c *RentalClient) Use(hooks ...Hook) {
+	c.hooks.Rental = append(c.hooks.Rental, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rental.Intercept(f(g(h())))`.
+func (c *RentalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Rental = append(c.inters.Rental, interceptors...)
+}
+
+// Create returns a builder for creating a Rental entity.
+func (c *RentalClient) Create() *RentalCreate {
+	mutation := newRentalMutation(c.config, OpCreate)
+	return &RentalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Rental entities.
+func (c *RentalClient) CreateBulk(builders ...*RentalCreate) *RentalCreateBulk {
+	return &RentalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RentalClient) MapCreateBulk(slice any, setFunc func(*RentalCreate, int)) *RentalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RentalCreateBulk{err: fmt.Errorf("calling to RentalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RentalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RentalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Rental.
+func (c *RentalClient) Update() *RentalUpdate {
+	mutation := newRentalMutation(c.config, OpUpdate)
+	return &RentalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RentalClient) UpdateOne(_m *Rental) *RentalUpdateOne {
+	mutation := newRentalMutation(c.config, OpUpdateOne, withRental(_m))
+	return &RentalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RentalClient) UpdateOneID(id string) *RentalUpdateOne {
+	mutation := newRentalMutation(c.config, OpUpdateOne, withRentalID(id))
+	return &RentalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Rental.
+func (c *RentalClient) Delete() *RentalDelete {
+	mutation := newRentalMutation(c.config, OpDelete)
+	return &RentalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RentalClient) DeleteOne(_m *Rental) *RentalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RentalClient) DeleteOneID(id string) *RentalDeleteOne {
+	builder := c.Delete().Where(rental.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RentalDeleteOne{builder}
+}
+
+// Query returns a query builder for Rental.
+func (c *RentalClient) Query() *RentalQuery {
+	return &RentalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRental},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Rental entity by its id.
+func (c *RentalClient) Get(ctx context.Context, id string) (*Rental, error) {
+	return c.Query().Where(rental.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RentalClient) GetX(ctx context.Context, id string) *Rental {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a Rental.
+func (c *RentalClient) QueryTenant(_m *Rental) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rental.TenantTable, rental.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCar queries the car edge of a Rental.
+func (c *RentalClient) QueryCar(_m *Rental) *CarQuery {
+	query := (&CarClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rental.CarTable, rental.CarColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRenter queries the renter edge of a Rental.
+func (c *RentalClient) QueryRenter(_m *Rental) *RenterQuery {
+	query := (&RenterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, id),
+			sqlgraph.To(renter.Table, renter.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rental.RenterTable, rental.RenterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPickupBranch queries the pickup_branch edge of a Rental.
+func (c *RentalClient) QueryPickupBranch(_m *Rental) *BranchQuery {
+	query := (&BranchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, id),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rental.PickupBranchTable, rental.PickupBranchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReturnBranch queries the return_branch edge of a Rental.
+func (c *RentalClient) QueryReturnBranch(_m *Rental) *BranchQuery {
+	query := (&BranchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, id),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rental.ReturnBranchTable, rental.ReturnBranchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRentalOptions queries the rental_options edge of a Rental.
+func (c *RentalClient) QueryRentalOptions(_m *Rental) *RentalOptionQuery {
+	query := (&RentalOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, id),
+			sqlgraph.To(rentaloption.Table, rentaloption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, rental.RentalOptionsTable, rental.RentalOptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryHandovers queries the handovers edge of a Rental.
+func (c *RentalClient) QueryHandovers(_m *Rental) *RentalHandoverQuery {
+	query := (&RentalHandoverClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, id),
+			sqlgraph.To(rentalhandover.Table, rentalhandover.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, rental.HandoversTable, rental.HandoversColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RentalClient) Hooks() []Hook {
+	return c.hooks.Rental
+}
+
+// Interceptors returns the client interceptors.
+func (c *RentalClient) Interceptors() []Interceptor {
+	return c.inters.Rental
+}
+
+func (c *RentalClient) mutate(ctx context.Context, m *RentalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RentalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RentalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RentalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RentalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown Rental mutation op: %q", m.Op())
+	}
+}
+
+// RentalHandoverClient is a client for the RentalHandover schema.
+type RentalHandoverClient struct {
+	config
+}
+
+// NewRentalHandoverClient returns a client for the RentalHandover from the given config.
+func NewRentalHandoverClient(c config) *RentalHandoverClient {
+	return &RentalHandoverClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rentalhandover.Hooks(f(g(h())))`.
+func (c *RentalHandoverClient) Use(hooks ...Hook) {
+	c.hooks.RentalHandover = append(c.hooks.RentalHandover, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rentalhandover.Intercept(f(g(h())))`.
+func (c *RentalHandoverClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RentalHandover = append(c.inters.RentalHandover, interceptors...)
+}
+
+// Create returns a builder for creating a RentalHandover entity.
+func (c *RentalHandoverClient) Create() *RentalHandoverCreate {
+	mutation := newRentalHandoverMutation(c.config, OpCreate)
+	return &RentalHandoverCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RentalHandover entities.
+func (c *RentalHandoverClient) CreateBulk(builders ...*RentalHandoverCreate) *RentalHandoverCreateBulk {
+	return &RentalHandoverCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RentalHandoverClient) MapCreateBulk(slice any, setFunc func(*RentalHandoverCreate, int)) *RentalHandoverCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RentalHandoverCreateBulk{err: fmt.Errorf("calling to RentalHandoverClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RentalHandoverCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RentalHandoverCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RentalHandover.
+func (c *RentalHandoverClient) Update() *RentalHandoverUpdate {
+	mutation := newRentalHandoverMutation(c.config, OpUpdate)
+	return &RentalHandoverUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RentalHandoverClient) UpdateOne(_m *RentalHandover) *RentalHandoverUpdateOne {
+	mutation := newRentalHandoverMutation(c.config, OpUpdateOne, withRentalHandover(_m))
+	return &RentalHandoverUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RentalHandoverClient) UpdateOneID(id string) *RentalHandoverUpdateOne {
+	mutation := newRentalHandoverMutation(c.config, OpUpdateOne, withRentalHandoverID(id))
+	return &RentalHandoverUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RentalHandover.
+func (c *RentalHandoverClient) Delete() *RentalHandoverDelete {
+	mutation := newRentalHandoverMutation(c.config, OpDelete)
+	return &RentalHandoverDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RentalHandoverClient) DeleteOne(_m *RentalHandover) *RentalHandoverDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RentalHandoverClient) DeleteOneID(id string) *RentalHandoverDeleteOne {
+	builder := c.Delete().Where(rentalhandover.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RentalHandoverDeleteOne{builder}
+}
+
+// Query returns a query builder for RentalHandover.
+func (c *RentalHandoverClient) Query() *RentalHandoverQuery {
+	return &RentalHandoverQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRentalHandover},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RentalHandover entity by its id.
+func (c *RentalHandoverClient) Get(ctx context.Context, id string) (*RentalHandover, error) {
+	return c.Query().Where(rentalhandover.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RentalHandoverClient) GetX(ctx context.Context, id string) *RentalHandover {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a RentalHandover.
+func (c *RentalHandoverClient) QueryTenant(_m *RentalHandover) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rentalhandover.Table, rentalhandover.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rentalhandover.TenantTable, rentalhandover.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRental queries the rental edge of a RentalHandover.
+func (c *RentalHandoverClient) QueryRental(_m *RentalHandover) *RentalQuery {
+	query := (&RentalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rentalhandover.Table, rentalhandover.FieldID, id),
+			sqlgraph.To(rental.Table, rental.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rentalhandover.RentalTable, rentalhandover.RentalColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RentalHandoverClient) Hooks() []Hook {
+	return c.hooks.RentalHandover
+}
+
+// Interceptors returns the client interceptors.
+func (c *RentalHandoverClient) Interceptors() []Interceptor {
+	return c.inters.RentalHandover
+}
+
+func (c *RentalHandoverClient) mutate(ctx context.Context, m *RentalHandoverMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RentalHandoverCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RentalHandoverUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RentalHandoverUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RentalHandoverDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown RentalHandover mutation op: %q", m.Op())
+	}
+}
+
+// RentalOptionClient is a client for the RentalOption schema.
+type RentalOptionClient struct {
+	config
+}
+
+// NewRentalOptionClient returns a client for the RentalOption from the given config.
+func NewRentalOptionClient(c config) *RentalOptionClient {
+	return &RentalOptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rentaloption.Hooks(f(g(h())))`.
+func (c *RentalOptionClient) Use(hooks ...Hook) {
+	c.hooks.RentalOption = append(c.hooks.RentalOption, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rentaloption.Intercept(f(g(h())))`.
+func (c *RentalOptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RentalOption = append(c.inters.RentalOption, interceptors...)
+}
+
+// Create returns a builder for creating a RentalOption entity.
+func (c *RentalOptionClient) Create() *RentalOptionCreate {
+	mutation := newRentalOptionMutation(c.config, OpCreate)
+	return &RentalOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RentalOption entities.
+func (c *RentalOptionClient) CreateBulk(builders ...*RentalOptionCreate) *RentalOptionCreateBulk {
+	return &RentalOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RentalOptionClient) MapCreateBulk(slice any, setFunc func(*RentalOptionCreate, int)) *RentalOptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RentalOptionCreateBulk{err: fmt.Errorf("calling to RentalOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RentalOptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RentalOptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RentalOption.
+func (c *RentalOptionClient) Update() *RentalOptionUpdate {
+	mutation := newRentalOptionMutation(c.config, OpUpdate)
+	return &RentalOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RentalOptionClient) UpdateOne(_m *RentalOption) *RentalOptionUpdateOne {
+	mutation := newRentalOptionMutation(c.config, OpUpdateOne, withRentalOption(_m))
+	return &RentalOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RentalOptionClient) UpdateOneID(id string) *RentalOptionUpdateOne {
+	mutation := newRentalOptionMutation(c.config, OpUpdateOne, withRentalOptionID(id))
+	return &RentalOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RentalOption.
+func (c *RentalOptionClient) Delete() *RentalOptionDelete {
+	mutation := newRentalOptionMutation(c.config, OpDelete)
+	return &RentalOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RentalOptionClient) DeleteOne(_m *RentalOption) *RentalOptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RentalOptionClient) DeleteOneID(id string) *RentalOptionDeleteOne {
+	builder := c.Delete().Where(rentaloption.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RentalOptionDeleteOne{builder}
+}
+
+// Query returns a query builder for RentalOption.
+func (c *RentalOptionClient) Query() *RentalOptionQuery {
+	return &RentalOptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRentalOption},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RentalOption entity by its id.
+func (c *RentalOptionClient) Get(ctx context.Context, id string) (*RentalOption, error) {
+	return c.Query().Where(rentaloption.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RentalOptionClient) GetX(ctx context.Context, id string) *RentalOption {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a RentalOption.
+func (c *RentalOptionClient) QueryTenant(_m *RentalOption) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rentaloption.Table, rentaloption.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rentaloption.TenantTable, rentaloption.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRental queries the rental edge of a RentalOption.
+func (c *RentalOptionClient) QueryRental(_m *RentalOption) *RentalQuery {
+	query := (&RentalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rentaloption.Table, rentaloption.FieldID, id),
+			sqlgraph.To(rental.Table, rental.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rentaloption.RentalTable, rentaloption.RentalColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOption queries the option edge of a RentalOption.
+func (c *RentalOptionClient) QueryOption(_m *RentalOption) *CarOptionQuery {
+	query := (&CarOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rentaloption.Table, rentaloption.FieldID, id),
+			sqlgraph.To(caroption.Table, caroption.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rentaloption.OptionTable, rentaloption.OptionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RentalOptionClient) Hooks() []Hook {
+	return c.hooks.RentalOption
+}
+
+// Interceptors returns the client interceptors.
+func (c *RentalOptionClient) Interceptors() []Interceptor {
+	return c.inters.RentalOption
+}
+
+func (c *RentalOptionClient) mutate(ctx context.Context, m *RentalOptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RentalOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RentalOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RentalOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RentalOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown RentalOption mutation op: %q", m.Op())
+	}
+}
+
+// RenterClient is a client for the Renter schema.
+type RenterClient struct {
+	config
+}
+
+// NewRenterClient returns a client for the Renter from the given config.
+func NewRenterClient(c config) *RenterClient {
+	return &RenterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `renter.Hooks(f(g(h())))`.
+func (c *RenterClient) Use(hooks ...Hook) {
+	c.hooks.Renter = append(c.hooks.Renter, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `renter.Intercept(f(g(h())))`.
+func (c *RenterClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Renter = append(c.inters.Renter, interceptors...)
+}
+
+// Create returns a builder for creating a Renter entity.
+func (c *RenterClient) Create() *RenterCreate {
+	mutation := newRenterMutation(c.config, OpCreate)
+	return &RenterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Renter entities.
+func (c *RenterClient) CreateBulk(builders ...*RenterCreate) *RenterCreateBulk {
+	return &RenterCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RenterClient) MapCreateBulk(slice any, setFunc func(*RenterCreate, int)) *RenterCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RenterCreateBulk{err: fmt.Errorf("calling to RenterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RenterCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RenterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Renter.
+func (c *RenterClient) Update() *RenterUpdate {
+	mutation := newRenterMutation(c.config, OpUpdate)
+	return &RenterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RenterClient) UpdateOne(_m *Renter) *RenterUpdateOne {
+	mutation := newRenterMutation(c.config, OpUpdateOne, withRenter(_m))
+	return &RenterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RenterClient) UpdateOneID(id string) *RenterUpdateOne {
+	mutation := newRenterMutation(c.config, OpUpdateOne, withRenterID(id))
+	return &RenterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Renter.
+func (c *RenterClient) Delete() *RenterDelete {
+	mutation := newRenterMutation(c.config, OpDelete)
+	return &RenterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RenterClient) DeleteOne(_m *Renter) *RenterDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RenterClient) DeleteOneID(id string) *RenterDeleteOne {
+	builder := c.Delete().Where(renter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RenterDeleteOne{builder}
+}
+
+// Query returns a query builder for Renter.
+func (c *RenterClient) Query() *RenterQuery {
+	return &RenterQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRenter},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Renter entity by its id.
+func (c *RenterClient) Get(ctx context.Context, id string) (*Renter, error) {
+	return c.Query().Where(renter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RenterClient) GetX(ctx context.Context, id string) *Renter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a Renter.
+func (c *RenterClient) QueryTenant(_m *Renter) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renter.Table, renter.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, renter.TenantTable, renter.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRentals queries the rentals edge of a Renter.
+func (c *RenterClient) QueryRentals(_m *Renter) *RentalQuery {
+	query := (&RentalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renter.Table, renter.FieldID, id),
+			sqlgraph.To(rental.Table, rental.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, renter.RentalsTable, renter.RentalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCompany queries the company edge of a Renter.
+func (c *RenterClient) QueryCompany(_m *Renter) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renter.Table, renter.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, renter.CompanyTable, renter.CompanyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIndividual queries the individual edge of a Renter.
+func (c *RenterClient) QueryIndividual(_m *Renter) *IndividualQuery {
+	query := (&IndividualClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(renter.Table, renter.FieldID, id),
+			sqlgraph.To(individual.Table, individual.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, renter.IndividualTable, renter.IndividualColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RenterClient) Hooks() []Hook {
+	return c.hooks.Renter
+}
+
+// Interceptors returns the client interceptors.
+func (c *RenterClient) Interceptors() []Interceptor {
+	return c.inters.Renter
+}
+
+func (c *RenterClient) mutate(ctx context.Context, m *RenterMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RenterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RenterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RenterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RenterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown Renter mutation op: %q", m.Op())
+	}
+}
+
+// TenantClient is a client for the Tenant schema.
+type TenantClient struct {
+	config
+}
+
+// NewTenantClient returns a client for the Tenant from the given config.
+func NewTenantClient(c config) *TenantClient {
+	return &TenantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenant.Hooks(f(g(h())))`.
+func (c *TenantClient) Use(hooks ...Hook) {
+	c.hooks.Tenant = append(c.hooks.Tenant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenant.Intercept(f(g(h())))`.
+func (c *TenantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tenant = append(c.inters.Tenant, interceptors...)
+}
+
+// Create returns a builder for creating a Tenant entity.
+func (c *TenantClient) Create() *TenantCreate {
+	mutation := newTenantMutation(c.config, OpCreate)
+	return &TenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tenant entities.
+func (c *TenantClient) CreateBulk(builders ...*TenantCreate) *TenantCreateBulk {
+	return &TenantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantClient) MapCreateBulk(slice any, setFunc func(*TenantCreate, int)) *TenantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantCreateBulk{err: fmt.Errorf("calling to TenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tenant.
+func (c *TenantClient) Update() *TenantUpdate {
+	mutation := newTenantMutation(c.config, OpUpdate)
+	return &TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantClient) UpdateOne(_m *Tenant) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenant(_m))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantClient) UpdateOneID(id string) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenantID(id))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tenant.
+func (c *TenantClient) Delete() *TenantDelete {
+	mutation := newTenantMutation(c.config, OpDelete)
+	return &TenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantClient) DeleteOne(_m *Tenant) *TenantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantClient) DeleteOneID(id string) *TenantDeleteOne {
+	builder := c.Delete().Where(tenant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantDeleteOne{builder}
+}
+
+// Query returns a query builder for Tenant.
+func (c *TenantClient) Query() *TenantQuery {
+	return &TenantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tenant entity by its id.
+func (c *TenantClient) Get(ctx context.Context, id string) (*Tenant, error) {
+	return c.Query().Where(tenant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantClient) GetX(ctx context.Context, id string) *Tenant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlan queries the plan edge of a Tenant.
+func (c *TenantClient) QueryPlan(_m *Tenant) *PlanQuery {
+	query := (&PlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenant.PlanTable, tenant.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Tenant.
+func (c *TenantClient) QueryParent(_m *Tenant) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenant.ParentTable, tenant.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Tenant.
+func (c *TenantClient) QueryChildren(_m *Tenant) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ChildrenTable, tenant.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPIKeys queries the api_keys edge of a Tenant.
+func (c *TenantClient) QueryAPIKeys(_m *Tenant) *APIKeyQuery {
+	query := (&APIKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.APIKeysTable, tenant.APIKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBranches queries the branches edge of a Tenant.
+func (c *TenantClient) QueryBranches(_m *Tenant) *BranchQuery {
+	query := (&BranchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.BranchesTable, tenant.BranchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCars queries the cars edge of a Tenant.
+func (c *TenantClient) QueryCars(_m *Tenant) *CarQuery {
+	query := (&CarClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(car.Table, car.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.CarsTable, tenant.CarsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCarModels queries the car_models edge of a Tenant.
+func (c *TenantClient) QueryCarModels(_m *Tenant) *CarModelQuery {
+	query := (&CarModelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(carmodel.Table, carmodel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.CarModelsTable, tenant.CarModelsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCompanies queries the companies edge of a Tenant.
+func (c *TenantClient) QueryCompanies(_m *Tenant) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.CompaniesTable, tenant.CompaniesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIndividuals queries the individuals edge of a Tenant.
+func (c *TenantClient) QueryIndividuals(_m *Tenant) *IndividualQuery {
+	query := (&IndividualClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(individual.Table, individual.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.IndividualsTable, tenant.IndividualsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMaintenanceWindows queries the maintenance_windows edge of a Tenant.
+func (c *TenantClient) QueryMaintenanceWindows(_m *Tenant) *MaintenanceWindowQuery {
+	query := (&MaintenanceWindowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(maintenancewindow.Table, maintenancewindow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.MaintenanceWindowsTable, tenant.MaintenanceWindowsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOptions queries the options edge of a Tenant.
+func (c *TenantClient) QueryOptions(_m *Tenant) *CarOptionQuery {
+	query := (&CarOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(caroption.Table, caroption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.OptionsTable, tenant.OptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRentalHandovers queries the rental_handovers edge of a Tenant.
+func (c *TenantClient) QueryRentalHandovers(_m *Tenant) *RentalHandoverQuery {
+	query := (&RentalHandoverClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(rentalhandover.Table, rentalhandover.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.RentalHandoversTable, tenant.RentalHandoversColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRentalOptions queries the rental_options edge of a Tenant.
+func (c *TenantClient) QueryRentalOptions(_m *Tenant) *RentalOptionQuery {
+	query := (&RentalOptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(rentaloption.Table, rentaloption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.RentalOptionsTable, tenant.RentalOptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRentals queries the rentals edge of a Tenant.
+func (c *TenantClient) QueryRentals(_m *Tenant) *RentalQuery {
+	query := (&RentalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(rental.Table, rental.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.RentalsTable, tenant.RentalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRenters queries the renters edge of a Tenant.
+func (c *TenantClient) QueryRenters(_m *Tenant) *RenterQuery {
+	query := (&RenterClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(renter.Table, renter.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.RentersTable, tenant.RentersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySettings queries the settings edge of a Tenant.
+func (c *TenantClient) QuerySettings(_m *Tenant) *TenantSettingQuery {
+	query := (&TenantSettingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(tenantsetting.Table, tenantsetting.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, tenant.SettingsTable, tenant.SettingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhookEndpoints queries the webhook_endpoints edge of a Tenant.
+func (c *TenantClient) QueryWebhookEndpoints(_m *Tenant) *WebhookEndpointQuery {
+	query := (&WebhookEndpointClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(webhookendpoint.Table, webhookendpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.WebhookEndpointsTable, tenant.WebhookEndpointsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhookDeliveries queries the webhook_deliveries edge of a Tenant.
+func (c *TenantClient) QueryWebhookDeliveries(_m *Tenant) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.WebhookDeliveriesTable, tenant.WebhookDeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TenantClient) Hooks() []Hook {
+	return c.hooks.Tenant
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantClient) Interceptors() []Interceptor {
+	return c.inters.Tenant
+}
+
+func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entgen: unknown Tenant mutation op: %q", m.Op())
+	}
+}
+
+// TenantSettingClient is a client for the TenantSetting schema.
+type TenantSettingClient struct {
+	config
+}
+
+// NewTenantSettingClient returns a client for the TenantSetting from the given config.
+func NewTenantSettingClient(c config) *TenantSettingClient {
+	return &TenantSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantsetting.Hooks(f(g(h())))`.
+func (c *TenantSettingClient) Use(hooks ...Hook) {
+	c.hooks.TenantSetting = append(c.hooks.TenantSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantsetting.Intercept(f(g(h())))`.
+func (c *TenantSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantSetting = append(c.inters.TenantSetting, interceptors...)
+}
+
+// Create returns a builder for creating a TenantSetting entity.
+func (c *TenantSettingClient) Create() *TenantSettingCreate {
+	mutation := newTenantSettingMutation(c.config, OpCreate)
+	return &TenantSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantSetting entities.
+func (c *TenantSettingClient) CreateBulk(builders ...*TenantSettingCreate) *TenantSettingCreateBulk {
+	return &TenantSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantSettingClient) MapCreateBulk(slice any, setFunc func(*TenantSettingCreate, int)) *TenantSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantSettingCreateBulk{err: fmt.Errorf("calling to TenantSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantSetting.
+func (c *TenantSettingClient) Update() *TenantSettingUpdate {
+	mutation := newTenantSettingMutation(c.config, OpUpdate)
+	return &TenantSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantSettingClient) UpdateOne(_m *TenantSetting) *TenantSettingUpdateOne {
+	mutation := newTenantSettingMutation(c.config, OpUpdateOne, withTenantSetting(_m))
+	return &TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantSettingClient) UpdateOneID(id string) *TenantSettingUpdateOne {
+	mutation := newTenantSettingMutation(c.config, OpUpdateOne, withTenantSettingID(id))
+	return &TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantSetting.
+func (c *TenantSettingClient) Delete() *TenantSettingDelete {
+	mutation := newTenantSettingMutation(c.config, OpDelete)
+	return &TenantSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantSettingClient) DeleteOne(_m *TenantSetting) *TenantSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantSettingClient) DeleteOneID(id string) *TenantSettingDeleteOne {
+	builder := c.Delete().Where(tenantsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantSetting.
+func (c *TenantSettingClient) Query() *TenantSettingQuery {
+	return &TenantSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantSetting},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Outbox entity by its id.
-func (c *OutboxClient) Get(ctx context.Context, id string) (*Outbox, error) {
-	return c.Query().Where(outbox.ID(id)).Only(ctx)
+// Get returns a TenantSetting entity by its id.
+func (c *TenantSettingClient) Get(ctx context.Context, id string) (*TenantSetting, error) {
+	return c.Query().Where(tenantsetting.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OutboxClient) GetX(ctx context.Context, id string) *Outbox {
+func (c *TenantSettingClient) GetX(ctx context.Context, id string) *TenantSetting {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1039,132 +3805,148 @@ func (c *OutboxClient) GetX(ctx context.Context, id string) *Outbox {
 	return obj
 }
 
+// QueryTenant queries the tenant edge of a TenantSetting.
+func (c *TenantSettingClient) QueryTenant(_m *TenantSetting) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenantsetting.Table, tenantsetting.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, tenantsetting.TenantTable, tenantsetting.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *OutboxClient) Hooks() []Hook {
-	return c.hooks.Outbox
+func (c *TenantSettingClient) Hooks() []Hook {
+	return c.hooks.TenantSetting
 }
 
 // Interceptors returns the client interceptors.
-func (c *OutboxClient) Interceptors() []Interceptor {
-	return c.inters.Outbox
+func (c *TenantSettingClient) Interceptors() []Interceptor {
+	return c.inters.TenantSetting
 }
 
-func (c *OutboxClient) mutate(ctx context.Context, m *OutboxMutation) (Value, error) {
+func (c *TenantSettingClient) mutate(ctx context.Context, m *TenantSettingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OutboxCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OutboxUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OutboxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TenantSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OutboxDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TenantSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown Outbox mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown TenantSetting mutation op: %q", m.Op())
 	}
 }
 
-// RentalClient is a client for the Rental schema.
-type RentalClient struct {
+// UsageRecordClient is a client for the UsageRecord schema.
+type UsageRecordClient struct {
 	config
 }
 
-// NewRentalClient returns a client for the Rental from the given config.
-func NewRentalClient(c config) *RentalClient {
-	return &RentalClient{config: c}
+// NewUsageRecordClient returns a client for the UsageRecord from the given config.
+func NewUsageRecordClient(c config) *UsageRecordClient {
+	return &UsageRecordClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `rental.Hooks(f(g(h())))`.
-func (c *RentalClient) Use(hooks ...Hook) {
-	c.hooks.Rental = append(c.hooks.Rental, hooks...)
+// A call to `Use(f, g, h)` equals to `usagerecord.Hooks(f(g(h())))`.
+func (c *UsageRecordClient) Use(hooks ...Hook) {
+	c.hooks.UsageRecord = append(c.hooks.UsageRecord, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `rental.Intercept(f(g(h())))`.
-func (c *RentalClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Rental = append(c.inters.Rental, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `usagerecord.Intercept(f(g(h())))`.
+func (c *UsageRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageRecord = append(c.inters.UsageRecord, interceptors...)
 }
 
-// Create returns a builder for creating a Rental entity.
-func (c *RentalClient) Create() *RentalCreate {
-	mutation := newRentalMutation(c.config, OpCreate)
-	return &RentalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UsageRecord entity.
+func (c *UsageRecordClient) Create() *UsageRecordCreate {
+	mutation := newUsageRecordMutation(c.config, OpCreate)
+	return &UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Rental entities.
-func (c *RentalClient) CreateBulk(builders ...*RentalCreate) *RentalCreateBulk {
-	return &RentalCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UsageRecord entities.
+func (c *UsageRecordClient) CreateBulk(builders ...*UsageRecordCreate) *UsageRecordCreateBulk {
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RentalClient) MapCreateBulk(slice any, setFunc func(*RentalCreate, int)) *RentalCreateBulk {
+func (c *UsageRecordClient) MapCreateBulk(slice any, setFunc func(*UsageRecordCreate, int)) *UsageRecordCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RentalCreateBulk{err: fmt.Errorf("calling to RentalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UsageRecordCreateBulk{err: fmt.Errorf("calling to UsageRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RentalCreate, rv.Len())
+	builders := make([]*UsageRecordCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RentalCreateBulk{config: c.config, builders: builders}
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Rental.
-func (c *RentalClient) Update() *RentalUpdate {
-	mutation := newRentalMutation(c.config, OpUpdate)
-	return &RentalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UsageRecord.
+func (c *UsageRecordClient) Update() *UsageRecordUpdate {
+	mutation := newUsageRecordMutation(c.config, OpUpdate)
+	return &UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RentalClient) UpdateOne(_m *Rental) *RentalUpdateOne {
-	mutation := newRentalMutation(c.config, OpUpdateOne, withRental(_m))
-	return &RentalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageRecordClient) UpdateOne(_m *UsageRecord) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecord(_m))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RentalClient) UpdateOneID(id string) *RentalUpdateOne {
-	mutation := newRentalMutation(c.config, OpUpdateOne, withRentalID(id))
-	return &RentalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageRecordClient) UpdateOneID(id string) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecordID(id))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Rental.
-func (c *RentalClient) Delete() *RentalDelete {
-	mutation := newRentalMutation(c.config, OpDelete)
-	return &RentalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UsageRecord.
+func (c *UsageRecordClient) Delete() *UsageRecordDelete {
+	mutation := newUsageRecordMutation(c.config, OpDelete)
+	return &UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RentalClient) DeleteOne(_m *Rental) *RentalDeleteOne {
+func (c *UsageRecordClient) DeleteOne(_m *UsageRecord) *UsageRecordDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RentalClient) DeleteOneID(id string) *RentalDeleteOne {
-	builder := c.Delete().Where(rental.ID(id))
+func (c *UsageRecordClient) DeleteOneID(id string) *UsageRecordDeleteOne {
+	builder := c.Delete().Where(usagerecord.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RentalDeleteOne{builder}
+	return &UsageRecordDeleteOne{builder}
 }
 
-// Query returns a query builder for Rental.
-func (c *RentalClient) Query() *RentalQuery {
-	return &RentalQuery{
+// Query returns a query builder for UsageRecord.
+func (c *UsageRecordClient) Query() *UsageRecordQuery {
+	return &UsageRecordQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRental},
+		ctx:    &QueryContext{Type: TypeUsageRecord},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Rental entity by its id.
-func (c *RentalClient) Get(ctx context.Context, id string) (*Rental, error) {
-	return c.Query().Where(rental.ID(id)).Only(ctx)
+// Get returns a UsageRecord entity by its id.
+func (c *UsageRecordClient) Get(ctx context.Context, id string) (*UsageRecord, error) {
+	return c.Query().Where(usagerecord.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RentalClient) GetX(ctx context.Context, id string) *Rental {
+func (c *UsageRecordClient) GetX(ctx context.Context, id string) *UsageRecord {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1172,196 +3954,132 @@ func (c *RentalClient) GetX(ctx context.Context, id string) *Rental {
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a Rental.
-func (c *RentalClient) QueryTenant(_m *Rental) *TenantQuery {
-	query := (&TenantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rental.Table, rental.FieldID, id),
-			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rental.TenantTable, rental.TenantColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCar queries the car edge of a Rental.
-func (c *RentalClient) QueryCar(_m *Rental) *CarQuery {
-	query := (&CarClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rental.Table, rental.FieldID, id),
-			sqlgraph.To(car.Table, car.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rental.CarTable, rental.CarColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRenter queries the renter edge of a Rental.
-func (c *RentalClient) QueryRenter(_m *Rental) *RenterQuery {
-	query := (&RenterClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rental.Table, rental.FieldID, id),
-			sqlgraph.To(renter.Table, renter.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rental.RenterTable, rental.RenterColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRentalOptions queries the rental_options edge of a Rental.
-func (c *RentalClient) QueryRentalOptions(_m *Rental) *RentalOptionQuery {
-	query := (&RentalOptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rental.Table, rental.FieldID, id),
-			sqlgraph.To(rentaloption.Table, rentaloption.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, rental.RentalOptionsTable, rental.RentalOptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *RentalClient) Hooks() []Hook {
-	return c.hooks.Rental
+func (c *UsageRecordClient) Hooks() []Hook {
+	return c.hooks.UsageRecord
 }
 
 // Interceptors returns the client interceptors.
-func (c *RentalClient) Interceptors() []Interceptor {
-	return c.inters.Rental
+func (c *UsageRecordClient) Interceptors() []Interceptor {
+	return c.inters.UsageRecord
 }
 
-func (c *RentalClient) mutate(ctx context.Context, m *RentalMutation) (Value, error) {
+func (c *UsageRecordClient) mutate(ctx context.Context, m *UsageRecordMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RentalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RentalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RentalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RentalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown Rental mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown UsageRecord mutation op: %q", m.Op())
 	}
 }
 
-// RentalOptionClient is a client for the RentalOption schema.
-type RentalOptionClient struct {
+// UsageRollupClient is a client for the UsageRollup schema.
+type UsageRollupClient struct {
 	config
 }
 
-// NewRentalOptionClient returns a client for the RentalOption from the given config.
-func NewRentalOptionClient(c config) *RentalOptionClient {
-	return &RentalOptionClient{config: c}
+// NewUsageRollupClient returns a client for the UsageRollup from the given config.
+func NewUsageRollupClient(c config) *UsageRollupClient {
+	return &UsageRollupClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `rentaloption.Hooks(f(g(h())))`.
-func (c *RentalOptionClient) Use(hooks ...Hook) {
-	c.hooks.RentalOption = append(c.hooks.RentalOption, hooks...)
+// A call to `Use(f, g, h)` equals to `usagerollup.Hooks(f(g(h())))`.
+func (c *UsageRollupClient) Use(hooks ...Hook) {
+	c.hooks.UsageRollup = append(c.hooks.UsageRollup, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `rentaloption.Intercept(f(g(h())))`.
-func (c *RentalOptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.RentalOption = append(c.inters.RentalOption, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `usagerollup.Intercept(f(g(h())))`.
+func (c *UsageRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageRollup = append(c.inters.UsageRollup, interceptors...)
 }
 
-// Create returns a builder for creating a RentalOption entity.
-func (c *RentalOptionClient) Create() *RentalOptionCreate {
-	mutation := newRentalOptionMutation(c.config, OpCreate)
-	return &RentalOptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a UsageRollup entity.
+func (c *UsageRollupClient) Create() *UsageRollupCreate {
+	mutation := newUsageRollupMutation(c.config, OpCreate)
+	return &UsageRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of RentalOption entities.
-func (c *RentalOptionClient) CreateBulk(builders ...*RentalOptionCreate) *RentalOptionCreateBulk {
-	return &RentalOptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of UsageRollup entities.
+func (c *UsageRollupClient) CreateBulk(builders ...*UsageRollupCreate) *UsageRollupCreateBulk {
+	return &UsageRollupCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RentalOptionClient) MapCreateBulk(slice any, setFunc func(*RentalOptionCreate, int)) *RentalOptionCreateBulk {
+func (c *UsageRollupClient) MapCreateBulk(slice any, setFunc func(*UsageRollupCreate, int)) *UsageRollupCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RentalOptionCreateBulk{err: fmt.Errorf("calling to RentalOptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &UsageRollupCreateBulk{err: fmt.Errorf("calling to UsageRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RentalOptionCreate, rv.Len())
+	builders := make([]*UsageRollupCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RentalOptionCreateBulk{config: c.config, builders: builders}
+	return &UsageRollupCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for RentalOption.
-func (c *RentalOptionClient) Update() *RentalOptionUpdate {
-	mutation := newRentalOptionMutation(c.config, OpUpdate)
-	return &RentalOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for UsageRollup.
+func (c *UsageRollupClient) Update() *UsageRollupUpdate {
+	mutation := newUsageRollupMutation(c.config, OpUpdate)
+	return &UsageRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RentalOptionClient) UpdateOne(_m *RentalOption) *RentalOptionUpdateOne {
-	mutation := newRentalOptionMutation(c.config, OpUpdateOne, withRentalOption(_m))
-	return &RentalOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageRollupClient) UpdateOne(_m *UsageRollup) *UsageRollupUpdateOne {
+	mutation := newUsageRollupMutation(c.config, OpUpdateOne, withUsageRollup(_m))
+	return &UsageRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RentalOptionClient) UpdateOneID(id string) *RentalOptionUpdateOne {
-	mutation := newRentalOptionMutation(c.config, OpUpdateOne, withRentalOptionID(id))
-	return &RentalOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *UsageRollupClient) UpdateOneID(id string) *UsageRollupUpdateOne {
+	mutation := newUsageRollupMutation(c.config, OpUpdateOne, withUsageRollupID(id))
+	return &UsageRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for RentalOption.
-func (c *RentalOptionClient) Delete() *RentalOptionDelete {
-	mutation := newRentalOptionMutation(c.config, OpDelete)
-	return &RentalOptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for UsageRollup.
+func (c *UsageRollupClient) Delete() *UsageRollupDelete {
+	mutation := newUsageRollupMutation(c.config, OpDelete)
+	return &UsageRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RentalOptionClient) DeleteOne(_m *RentalOption) *RentalOptionDeleteOne {
+func (c *UsageRollupClient) DeleteOne(_m *UsageRollup) *UsageRollupDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RentalOptionClient) DeleteOneID(id string) *RentalOptionDeleteOne {
-	builder := c.Delete().Where(rentaloption.ID(id))
+func (c *UsageRollupClient) DeleteOneID(id string) *UsageRollupDeleteOne {
+	builder := c.Delete().Where(usagerollup.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RentalOptionDeleteOne{builder}
+	return &UsageRollupDeleteOne{builder}
 }
 
-// Query returns a query builder for RentalOption.
-func (c *RentalOptionClient) Query() *RentalOptionQuery {
-	return &RentalOptionQuery{
+// Query returns a query builder for UsageRollup.
+func (c *UsageRollupClient) Query() *UsageRollupQuery {
+	return &UsageRollupQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRentalOption},
+		ctx:    &QueryContext{Type: TypeUsageRollup},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a RentalOption entity by its id.
-func (c *RentalOptionClient) Get(ctx context.Context, id string) (*RentalOption, error) {
-	return c.Query().Where(rentaloption.ID(id)).Only(ctx)
+// Get returns a UsageRollup entity by its id.
+func (c *UsageRollupClient) Get(ctx context.Context, id string) (*UsageRollup, error) {
+	return c.Query().Where(usagerollup.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RentalOptionClient) GetX(ctx context.Context, id string) *RentalOption {
+func (c *UsageRollupClient) GetX(ctx context.Context, id string) *UsageRollup {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1369,180 +4087,132 @@ func (c *RentalOptionClient) GetX(ctx context.Context, id string) *RentalOption
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a RentalOption.
-func (c *RentalOptionClient) QueryTenant(_m *RentalOption) *TenantQuery {
-	query := (&TenantClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rentaloption.Table, rentaloption.FieldID, id),
-			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rentaloption.TenantTable, rentaloption.TenantColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRental queries the rental edge of a RentalOption.
-func (c *RentalOptionClient) QueryRental(_m *RentalOption) *RentalQuery {
-	query := (&RentalClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rentaloption.Table, rentaloption.FieldID, id),
-			sqlgraph.To(rental.Table, rental.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rentaloption.RentalTable, rentaloption.RentalColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryOption queries the option edge of a RentalOption.
-func (c *RentalOptionClient) QueryOption(_m *RentalOption) *CarOptionQuery {
-	query := (&CarOptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(rentaloption.Table, rentaloption.FieldID, id),
-			sqlgraph.To(caroption.Table, caroption.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, rentaloption.OptionTable, rentaloption.OptionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *RentalOptionClient) Hooks() []Hook {
-	return c.hooks.RentalOption
+func (c *UsageRollupClient) Hooks() []Hook {
+	return c.hooks.UsageRollup
 }
 
 // Interceptors returns the client interceptors.
-func (c *RentalOptionClient) Interceptors() []Interceptor {
-	return c.inters.RentalOption
+func (c *UsageRollupClient) Interceptors() []Interceptor {
+	return c.inters.UsageRollup
 }
 
-func (c *RentalOptionClient) mutate(ctx context.Context, m *RentalOptionMutation) (Value, error) {
+func (c *UsageRollupClient) mutate(ctx context.Context, m *UsageRollupMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RentalOptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RentalOptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RentalOptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&UsageRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RentalOptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&UsageRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown RentalOption mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown UsageRollup mutation op: %q", m.Op())
 	}
 }
 
-// RenterClient is a client for the Renter schema.
-type RenterClient struct {
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
 	config
 }
 
-// NewRenterClient returns a client for the Renter from the given config.
-func NewRenterClient(c config) *RenterClient {
-	return &RenterClient{config: c}
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `renter.Hooks(f(g(h())))`.
-func (c *RenterClient) Use(hooks ...Hook) {
-	c.hooks.Renter = append(c.hooks.Renter, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `renter.Intercept(f(g(h())))`.
-func (c *RenterClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Renter = append(c.inters.Renter, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a Renter entity.
-func (c *RenterClient) Create() *RenterCreate {
-	mutation := newRenterMutation(c.config, OpCreate)
-	return &RenterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Renter entities.
-func (c *RenterClient) CreateBulk(builders ...*RenterCreate) *RenterCreateBulk {
-	return &RenterCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *RenterClient) MapCreateBulk(slice any, setFunc func(*RenterCreate, int)) *RenterCreateBulk {
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &RenterCreateBulk{err: fmt.Errorf("calling to RenterClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*RenterCreate, rv.Len())
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &RenterCreateBulk{config: c.config, builders: builders}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Renter.
-func (c *RenterClient) Update() *RenterUpdate {
-	mutation := newRenterMutation(c.config, OpUpdate)
-	return &RenterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *RenterClient) UpdateOne(_m *Renter) *RenterUpdateOne {
-	mutation := newRenterMutation(c.config, OpUpdateOne, withRenter(_m))
-	return &RenterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *RenterClient) UpdateOneID(id string) *RenterUpdateOne {
-	mutation := newRenterMutation(c.config, OpUpdateOne, withRenterID(id))
-	return &RenterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOneID(id string) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Renter.
-func (c *RenterClient) Delete() *RenterDelete {
-	mutation := newRenterMutation(c.config, OpDelete)
-	return &RenterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *RenterClient) DeleteOne(_m *Renter) *RenterDeleteOne {
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *RenterClient) DeleteOneID(id string) *RenterDeleteOne {
-	builder := c.Delete().Where(renter.ID(id))
+func (c *WebhookDeliveryClient) DeleteOneID(id string) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &RenterDeleteOne{builder}
+	return &WebhookDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for Renter.
-func (c *RenterClient) Query() *RenterQuery {
-	return &RenterQuery{
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeRenter},
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Renter entity by its id.
-func (c *RenterClient) Get(ctx context.Context, id string) (*Renter, error) {
-	return c.Query().Where(renter.ID(id)).Only(ctx)
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id string) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *RenterClient) GetX(ctx context.Context, id string) *Renter {
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id string) *WebhookDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1550,47 +4220,15 @@ func (c *RenterClient) GetX(ctx context.Context, id string) *Renter {
 	return obj
 }
 
-// QueryTenant queries the tenant edge of a Renter.
-func (c *RenterClient) QueryTenant(_m *Renter) *TenantQuery {
+// QueryTenant queries the tenant edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryTenant(_m *WebhookDelivery) *TenantQuery {
 	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(renter.Table, renter.FieldID, id),
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
 			sqlgraph.To(tenant.Table, tenant.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, renter.TenantTable, renter.TenantColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRentals queries the rentals edge of a Renter.
-func (c *RenterClient) QueryRentals(_m *Renter) *RentalQuery {
-	query := (&RentalClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(renter.Table, renter.FieldID, id),
-			sqlgraph.To(rental.Table, rental.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, renter.RentalsTable, renter.RentalsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCompany queries the company edge of a Renter.
-func (c *RenterClient) QueryCompany(_m *Renter) *CompanyQuery {
-	query := (&CompanyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(renter.Table, renter.FieldID, id),
-			sqlgraph.To(company.Table, company.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, renter.CompanyTable, renter.CompanyColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.TenantTable, webhookdelivery.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1598,15 +4236,15 @@ func (c *RenterClient) QueryCompany(_m *Renter) *CompanyQuery {
 	return query
 }
 
-// QueryIndividual queries the individual edge of a Renter.
-func (c *RenterClient) QueryIndividual(_m *Renter) *IndividualQuery {
-	query := (&IndividualClient{config: c.config}).Query()
+// QueryEndpoint queries the endpoint edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryEndpoint(_m *WebhookDelivery) *WebhookEndpointQuery {
+	query := (&WebhookEndpointClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(renter.Table, renter.FieldID, id),
-			sqlgraph.To(individual.Table, individual.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, renter.IndividualTable, renter.IndividualColumn),
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhookendpoint.Table, webhookendpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.EndpointTable, webhookdelivery.EndpointColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1615,131 +4253,131 @@ func (c *RenterClient) QueryIndividual(_m *Renter) *IndividualQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *RenterClient) Hooks() []Hook {
-	return c.hooks.Renter
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
 }
 
 // Interceptors returns the client interceptors.
-func (c *RenterClient) Interceptors() []Interceptor {
-	return c.inters.Renter
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
 }
 
-func (c *RenterClient) mutate(ctx context.Context, m *RenterMutation) (Value, error) {
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&RenterCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&RenterUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&RenterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&RenterDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown Renter mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown WebhookDelivery mutation op: %q", m.Op())
 	}
 }
 
-// TenantClient is a client for the Tenant schema.
-type TenantClient struct {
+// WebhookEndpointClient is a client for the WebhookEndpoint schema.
+type WebhookEndpointClient struct {
 	config
 }
 
-// NewTenantClient returns a client for the Tenant from the given config.
-func NewTenantClient(c config) *TenantClient {
-	return &TenantClient{config: c}
+// NewWebhookEndpointClient returns a client for the WebhookEndpoint from the given config.
+func NewWebhookEndpointClient(c config) *WebhookEndpointClient {
+	return &WebhookEndpointClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `tenant.Hooks(f(g(h())))`.
-func (c *TenantClient) Use(hooks ...Hook) {
-	c.hooks.Tenant = append(c.hooks.Tenant, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookendpoint.Hooks(f(g(h())))`.
+func (c *WebhookEndpointClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEndpoint = append(c.hooks.WebhookEndpoint, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `tenant.Intercept(f(g(h())))`.
-func (c *TenantClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Tenant = append(c.inters.Tenant, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookendpoint.Intercept(f(g(h())))`.
+func (c *WebhookEndpointClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEndpoint = append(c.inters.WebhookEndpoint, interceptors...)
 }
 
-// Create returns a builder for creating a Tenant entity.
-func (c *TenantClient) Create() *TenantCreate {
-	mutation := newTenantMutation(c.config, OpCreate)
-	return &TenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookEndpoint entity.
+func (c *WebhookEndpointClient) Create() *WebhookEndpointCreate {
+	mutation := newWebhookEndpointMutation(c.config, OpCreate)
+	return &WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Tenant entities.
-func (c *TenantClient) CreateBulk(builders ...*TenantCreate) *TenantCreateBulk {
-	return &TenantCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookEndpoint entities.
+func (c *WebhookEndpointClient) CreateBulk(builders ...*WebhookEndpointCreate) *WebhookEndpointCreateBulk {
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TenantClient) MapCreateBulk(slice any, setFunc func(*TenantCreate, int)) *TenantCreateBulk {
+func (c *WebhookEndpointClient) MapCreateBulk(slice any, setFunc func(*WebhookEndpointCreate, int)) *WebhookEndpointCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TenantCreateBulk{err: fmt.Errorf("calling to TenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookEndpointCreateBulk{err: fmt.Errorf("calling to WebhookEndpointClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TenantCreate, rv.Len())
+	builders := make([]*WebhookEndpointCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TenantCreateBulk{config: c.config, builders: builders}
+	return &WebhookEndpointCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Tenant.
-func (c *TenantClient) Update() *TenantUpdate {
-	mutation := newTenantMutation(c.config, OpUpdate)
-	return &TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Update() *WebhookEndpointUpdate {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdate)
+	return &WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TenantClient) UpdateOne(_m *Tenant) *TenantUpdateOne {
-	mutation := newTenantMutation(c.config, OpUpdateOne, withTenant(_m))
-	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookEndpointClient) UpdateOne(_m *WebhookEndpoint) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpoint(_m))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TenantClient) UpdateOneID(id string) *TenantUpdateOne {
-	mutation := newTenantMutation(c.config, OpUpdateOne, withTenantID(id))
-	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookEndpointClient) UpdateOneID(id string) *WebhookEndpointUpdateOne {
+	mutation := newWebhookEndpointMutation(c.config, OpUpdateOne, withWebhookEndpointID(id))
+	return &WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Tenant.
-func (c *TenantClient) Delete() *TenantDelete {
-	mutation := newTenantMutation(c.config, OpDelete)
-	return &TenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Delete() *WebhookEndpointDelete {
+	mutation := newWebhookEndpointMutation(c.config, OpDelete)
+	return &WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TenantClient) DeleteOne(_m *Tenant) *TenantDeleteOne {
+func (c *WebhookEndpointClient) DeleteOne(_m *WebhookEndpoint) *WebhookEndpointDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TenantClient) DeleteOneID(id string) *TenantDeleteOne {
-	builder := c.Delete().Where(tenant.ID(id))
+func (c *WebhookEndpointClient) DeleteOneID(id string) *WebhookEndpointDeleteOne {
+	builder := c.Delete().Where(webhookendpoint.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TenantDeleteOne{builder}
+	return &WebhookEndpointDeleteOne{builder}
 }
 
-// Query returns a query builder for Tenant.
-func (c *TenantClient) Query() *TenantQuery {
-	return &TenantQuery{
+// Query returns a query builder for WebhookEndpoint.
+func (c *WebhookEndpointClient) Query() *WebhookEndpointQuery {
+	return &WebhookEndpointQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTenant},
+		ctx:    &QueryContext{Type: TypeWebhookEndpoint},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Tenant entity by its id.
-func (c *TenantClient) Get(ctx context.Context, id string) (*Tenant, error) {
-	return c.Query().Where(tenant.ID(id)).Only(ctx)
+// Get returns a WebhookEndpoint entity by its id.
+func (c *WebhookEndpointClient) Get(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	return c.Query().Where(webhookendpoint.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TenantClient) GetX(ctx context.Context, id string) *Tenant {
+func (c *WebhookEndpointClient) GetX(ctx context.Context, id string) *WebhookEndpoint {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1747,95 +4385,15 @@ func (c *TenantClient) GetX(ctx context.Context, id string) *Tenant {
 	return obj
 }
 
-// QueryCars queries the cars edge of a Tenant.
-func (c *TenantClient) QueryCars(_m *Tenant) *CarQuery {
-	query := (&CarClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(car.Table, car.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.CarsTable, tenant.CarsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCompanies queries the companies edge of a Tenant.
-func (c *TenantClient) QueryCompanies(_m *Tenant) *CompanyQuery {
-	query := (&CompanyClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(company.Table, company.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.CompaniesTable, tenant.CompaniesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryIndividuals queries the individuals edge of a Tenant.
-func (c *TenantClient) QueryIndividuals(_m *Tenant) *IndividualQuery {
-	query := (&IndividualClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(individual.Table, individual.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.IndividualsTable, tenant.IndividualsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryOptions queries the options edge of a Tenant.
-func (c *TenantClient) QueryOptions(_m *Tenant) *CarOptionQuery {
-	query := (&CarOptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(caroption.Table, caroption.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.OptionsTable, tenant.OptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRentalOptions queries the rental_options edge of a Tenant.
-func (c *TenantClient) QueryRentalOptions(_m *Tenant) *RentalOptionQuery {
-	query := (&RentalOptionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(rentaloption.Table, rentaloption.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.RentalOptionsTable, tenant.RentalOptionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRentals queries the rentals edge of a Tenant.
-func (c *TenantClient) QueryRentals(_m *Tenant) *RentalQuery {
-	query := (&RentalClient{config: c.config}).Query()
+// QueryTenant queries the tenant edge of a WebhookEndpoint.
+func (c *WebhookEndpointClient) QueryTenant(_m *WebhookEndpoint) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(rental.Table, rental.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.RentalsTable, tenant.RentalsColumn),
+			sqlgraph.From(webhookendpoint.Table, webhookendpoint.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookendpoint.TenantTable, webhookendpoint.TenantColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1843,15 +4401,15 @@ func (c *TenantClient) QueryRentals(_m *Tenant) *RentalQuery {
 	return query
 }
 
-// QueryRenters queries the renters edge of a Tenant.
-func (c *TenantClient) QueryRenters(_m *Tenant) *RenterQuery {
-	query := (&RenterClient{config: c.config}).Query()
+// QueryDeliveries queries the deliveries edge of a WebhookEndpoint.
+func (c *WebhookEndpointClient) QueryDeliveries(_m *WebhookEndpoint) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(tenant.Table, tenant.FieldID, id),
-			sqlgraph.To(renter.Table, renter.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, tenant.RentersTable, tenant.RentersColumn),
+			sqlgraph.From(webhookendpoint.Table, webhookendpoint.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhookendpoint.DeliveriesTable, webhookendpoint.DeliveriesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1860,38 +4418,66 @@ func (c *TenantClient) QueryRenters(_m *Tenant) *RenterQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *TenantClient) Hooks() []Hook {
-	return c.hooks.Tenant
+func (c *WebhookEndpointClient) Hooks() []Hook {
+	return c.hooks.WebhookEndpoint
 }
 
 // Interceptors returns the client interceptors.
-func (c *TenantClient) Interceptors() []Interceptor {
-	return c.inters.Tenant
+func (c *WebhookEndpointClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEndpoint
 }
 
-func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, error) {
+func (c *WebhookEndpointClient) mutate(ctx context.Context, m *WebhookEndpointMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookEndpointCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookEndpointUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookEndpointUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookEndpointDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("entgen: unknown Tenant mutation op: %q", m.Op())
+		return nil, fmt.Errorf("entgen: unknown WebhookEndpoint mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Car, CarOption, Company, Individual, Outbox, Rental, RentalOption, Renter,
-		Tenant []ent.Hook
+		APIKey, ArchiveJob, Branch, Car, CarModel, CarOption, Company,
+		FleetSharingAgreement, Inbox, Individual, MaintenanceWindow, Outbox, Plan,
+		Rental, RentalHandover, RentalOption, Renter, Tenant, TenantSetting,
+		UsageRecord, UsageRollup, WebhookDelivery, WebhookEndpoint []ent.Hook
 	}
 	inters struct {
-		Car, CarOption, Company, Individual, Outbox, Rental, RentalOption, Renter,
-		Tenant []ent.Interceptor
+		APIKey, ArchiveJob, Branch, Car, CarModel, CarOption, Company,
+		FleetSharingAgreement, Inbox, Individual, MaintenanceWindow, Outbox, Plan,
+		Rental, RentalHandover, RentalOption, Renter, Tenant, TenantSetting,
+		UsageRecord, UsageRollup, WebhookDelivery, WebhookEndpoint []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
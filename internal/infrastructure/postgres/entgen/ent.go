@@ -12,15 +12,29 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/apikey"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/archivejob"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/caroption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/company"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/inbox"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/individual"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/outbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerecord"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerollup"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookdelivery"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookendpoint"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -81,15 +95,29 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			car.Table:          car.ValidColumn,
-			caroption.Table:    caroption.ValidColumn,
-			company.Table:      company.ValidColumn,
-			individual.Table:   individual.ValidColumn,
-			outbox.Table:       outbox.ValidColumn,
-			rental.Table:       rental.ValidColumn,
-			rentaloption.Table: rentaloption.ValidColumn,
-			renter.Table:       renter.ValidColumn,
-			tenant.Table:       tenant.ValidColumn,
+			apikey.Table:                apikey.ValidColumn,
+			archivejob.Table:            archivejob.ValidColumn,
+			branch.Table:                branch.ValidColumn,
+			car.Table:                   car.ValidColumn,
+			carmodel.Table:              carmodel.ValidColumn,
+			caroption.Table:             caroption.ValidColumn,
+			company.Table:               company.ValidColumn,
+			fleetsharingagreement.Table: fleetsharingagreement.ValidColumn,
+			inbox.Table:                 inbox.ValidColumn,
+			individual.Table:            individual.ValidColumn,
+			maintenancewindow.Table:     maintenancewindow.ValidColumn,
+			outbox.Table:                outbox.ValidColumn,
+			plan.Table:                  plan.ValidColumn,
+			rental.Table:                rental.ValidColumn,
+			rentalhandover.Table:        rentalhandover.ValidColumn,
+			rentaloption.Table:          rentaloption.ValidColumn,
+			renter.Table:                renter.ValidColumn,
+			tenant.Table:                tenant.ValidColumn,
+			tenantsetting.Table:         tenantsetting.ValidColumn,
+			usagerecord.Table:           usagerecord.ValidColumn,
+			usagerollup.Table:           usagerollup.ValidColumn,
+			webhookdelivery.Table:       webhookdelivery.ValidColumn,
+			webhookendpoint.Table:       webhookendpoint.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
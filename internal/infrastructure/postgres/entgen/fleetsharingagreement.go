@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+)
+
+// FleetSharingAgreement is the model entity for the FleetSharingAgreement schema.
+type FleetSharingAgreement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// LenderTenantID holds the value of the "lender_tenant_id" field.
+	LenderTenantID string `json:"lender_tenant_id,omitempty"`
+	// BorrowerTenantID holds the value of the "borrower_tenant_id" field.
+	BorrowerTenantID string `json:"borrower_tenant_id,omitempty"`
+	// MaxRentalDays holds the value of the "max_rental_days" field.
+	MaxRentalDays int `json:"max_rental_days,omitempty"`
+	// OwnerSharePercent holds the value of the "owner_share_percent" field.
+	OwnerSharePercent int `json:"owner_share_percent,omitempty"`
+	// ValidFrom holds the value of the "valid_from" field.
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// ValidUntil holds the value of the "valid_until" field.
+	ValidUntil *time.Time `json:"valid_until,omitempty"`
+	// TerminatedAt holds the value of the "terminated_at" field.
+	TerminatedAt *time.Time `json:"terminated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FleetSharingAgreement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fleetsharingagreement.FieldMaxRentalDays, fleetsharingagreement.FieldOwnerSharePercent:
+			values[i] = new(sql.NullInt64)
+		case fleetsharingagreement.FieldID, fleetsharingagreement.FieldLenderTenantID, fleetsharingagreement.FieldBorrowerTenantID:
+			values[i] = new(sql.NullString)
+		case fleetsharingagreement.FieldValidFrom, fleetsharingagreement.FieldValidUntil, fleetsharingagreement.FieldTerminatedAt, fleetsharingagreement.FieldCreatedAt, fleetsharingagreement.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FleetSharingAgreement fields.
+func (_m *FleetSharingAgreement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case fleetsharingagreement.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case fleetsharingagreement.FieldLenderTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lender_tenant_id", values[i])
+			} else if value.Valid {
+				_m.LenderTenantID = value.String
+			}
+		case fleetsharingagreement.FieldBorrowerTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field borrower_tenant_id", values[i])
+			} else if value.Valid {
+				_m.BorrowerTenantID = value.String
+			}
+		case fleetsharingagreement.FieldMaxRentalDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_rental_days", values[i])
+			} else if value.Valid {
+				_m.MaxRentalDays = int(value.Int64)
+			}
+		case fleetsharingagreement.FieldOwnerSharePercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_share_percent", values[i])
+			} else if value.Valid {
+				_m.OwnerSharePercent = int(value.Int64)
+			}
+		case fleetsharingagreement.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case fleetsharingagreement.FieldValidUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_until", values[i])
+			} else if value.Valid {
+				_m.ValidUntil = new(time.Time)
+				*_m.ValidUntil = value.Time
+			}
+		case fleetsharingagreement.FieldTerminatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field terminated_at", values[i])
+			} else if value.Valid {
+				_m.TerminatedAt = new(time.Time)
+				*_m.TerminatedAt = value.Time
+			}
+		case fleetsharingagreement.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case fleetsharingagreement.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FleetSharingAgreement.
+// This includes values selected through modifiers, order, etc.
+func (_m *FleetSharingAgreement) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FleetSharingAgreement.
+// Note that you need to call FleetSharingAgreement.Unwrap() before calling this method if this FleetSharingAgreement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FleetSharingAgreement) Update() *FleetSharingAgreementUpdateOne {
+	return NewFleetSharingAgreementClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FleetSharingAgreement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FleetSharingAgreement) Unwrap() *FleetSharingAgreement {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: FleetSharingAgreement is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FleetSharingAgreement) String() string {
+	var builder strings.Builder
+	builder.WriteString("FleetSharingAgreement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("lender_tenant_id=")
+	builder.WriteString(_m.LenderTenantID)
+	builder.WriteString(", ")
+	builder.WriteString("borrower_tenant_id=")
+	builder.WriteString(_m.BorrowerTenantID)
+	builder.WriteString(", ")
+	builder.WriteString("max_rental_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxRentalDays))
+	builder.WriteString(", ")
+	builder.WriteString("owner_share_percent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OwnerSharePercent))
+	builder.WriteString(", ")
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidUntil; v != nil {
+		builder.WriteString("valid_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TerminatedAt; v != nil {
+		builder.WriteString("terminated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FleetSharingAgreements is a parsable slice of FleetSharingAgreement.
+type FleetSharingAgreements []*FleetSharingAgreement
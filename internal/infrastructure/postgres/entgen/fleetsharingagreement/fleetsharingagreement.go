@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fleetsharingagreement
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the fleetsharingagreement type in the database.
+	Label = "fleet_sharing_agreement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldLenderTenantID holds the string denoting the lender_tenant_id field in the database.
+	FieldLenderTenantID = "lender_tenant_id"
+	// FieldBorrowerTenantID holds the string denoting the borrower_tenant_id field in the database.
+	FieldBorrowerTenantID = "borrower_tenant_id"
+	// FieldMaxRentalDays holds the string denoting the max_rental_days field in the database.
+	FieldMaxRentalDays = "max_rental_days"
+	// FieldOwnerSharePercent holds the string denoting the owner_share_percent field in the database.
+	FieldOwnerSharePercent = "owner_share_percent"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidUntil holds the string denoting the valid_until field in the database.
+	FieldValidUntil = "valid_until"
+	// FieldTerminatedAt holds the string denoting the terminated_at field in the database.
+	FieldTerminatedAt = "terminated_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the fleetsharingagreement in the database.
+	Table = "fleet_sharing_agreements"
+)
+
+// Columns holds all SQL columns for fleetsharingagreement fields.
+var Columns = []string{
+	FieldID,
+	FieldLenderTenantID,
+	FieldBorrowerTenantID,
+	FieldMaxRentalDays,
+	FieldOwnerSharePercent,
+	FieldValidFrom,
+	FieldValidUntil,
+	FieldTerminatedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// LenderTenantIDValidator is a validator for the "lender_tenant_id" field. It is called by the builders before save.
+	LenderTenantIDValidator func(string) error
+	// BorrowerTenantIDValidator is a validator for the "borrower_tenant_id" field. It is called by the builders before save.
+	BorrowerTenantIDValidator func(string) error
+	// DefaultMaxRentalDays holds the default value on creation for the "max_rental_days" field.
+	DefaultMaxRentalDays int
+	// DefaultOwnerSharePercent holds the default value on creation for the "owner_share_percent" field.
+	DefaultOwnerSharePercent int
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the FleetSharingAgreement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByLenderTenantID orders the results by the lender_tenant_id field.
+func ByLenderTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLenderTenantID, opts...).ToFunc()
+}
+
+// ByBorrowerTenantID orders the results by the borrower_tenant_id field.
+func ByBorrowerTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBorrowerTenantID, opts...).ToFunc()
+}
+
+// ByMaxRentalDays orders the results by the max_rental_days field.
+func ByMaxRentalDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRentalDays, opts...).ToFunc()
+}
+
+// ByOwnerSharePercent orders the results by the owner_share_percent field.
+func ByOwnerSharePercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerSharePercent, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidUntil orders the results by the valid_until field.
+func ByValidUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidUntil, opts...).ToFunc()
+}
+
+// ByTerminatedAt orders the results by the terminated_at field.
+func ByTerminatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTerminatedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package fleetsharingagreement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldContainsFold(FieldID, id))
+}
+
+// LenderTenantID applies equality check predicate on the "lender_tenant_id" field. It's identical to LenderTenantIDEQ.
+func LenderTenantID(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldLenderTenantID, v))
+}
+
+// BorrowerTenantID applies equality check predicate on the "borrower_tenant_id" field. It's identical to BorrowerTenantIDEQ.
+func BorrowerTenantID(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldBorrowerTenantID, v))
+}
+
+// MaxRentalDays applies equality check predicate on the "max_rental_days" field. It's identical to MaxRentalDaysEQ.
+func MaxRentalDays(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldMaxRentalDays, v))
+}
+
+// OwnerSharePercent applies equality check predicate on the "owner_share_percent" field. It's identical to OwnerSharePercentEQ.
+func OwnerSharePercent(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldOwnerSharePercent, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidUntil applies equality check predicate on the "valid_until" field. It's identical to ValidUntilEQ.
+func ValidUntil(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// TerminatedAt applies equality check predicate on the "terminated_at" field. It's identical to TerminatedAtEQ.
+func TerminatedAt(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldTerminatedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LenderTenantIDEQ applies the EQ predicate on the "lender_tenant_id" field.
+func LenderTenantIDEQ(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDNEQ applies the NEQ predicate on the "lender_tenant_id" field.
+func LenderTenantIDNEQ(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDIn applies the In predicate on the "lender_tenant_id" field.
+func LenderTenantIDIn(vs ...string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldLenderTenantID, vs...))
+}
+
+// LenderTenantIDNotIn applies the NotIn predicate on the "lender_tenant_id" field.
+func LenderTenantIDNotIn(vs ...string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldLenderTenantID, vs...))
+}
+
+// LenderTenantIDGT applies the GT predicate on the "lender_tenant_id" field.
+func LenderTenantIDGT(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDGTE applies the GTE predicate on the "lender_tenant_id" field.
+func LenderTenantIDGTE(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDLT applies the LT predicate on the "lender_tenant_id" field.
+func LenderTenantIDLT(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDLTE applies the LTE predicate on the "lender_tenant_id" field.
+func LenderTenantIDLTE(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDContains applies the Contains predicate on the "lender_tenant_id" field.
+func LenderTenantIDContains(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldContains(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDHasPrefix applies the HasPrefix predicate on the "lender_tenant_id" field.
+func LenderTenantIDHasPrefix(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldHasPrefix(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDHasSuffix applies the HasSuffix predicate on the "lender_tenant_id" field.
+func LenderTenantIDHasSuffix(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldHasSuffix(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDEqualFold applies the EqualFold predicate on the "lender_tenant_id" field.
+func LenderTenantIDEqualFold(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEqualFold(FieldLenderTenantID, v))
+}
+
+// LenderTenantIDContainsFold applies the ContainsFold predicate on the "lender_tenant_id" field.
+func LenderTenantIDContainsFold(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldContainsFold(FieldLenderTenantID, v))
+}
+
+// BorrowerTenantIDEQ applies the EQ predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDEQ(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDNEQ applies the NEQ predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDNEQ(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDIn applies the In predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDIn(vs ...string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldBorrowerTenantID, vs...))
+}
+
+// BorrowerTenantIDNotIn applies the NotIn predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDNotIn(vs ...string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldBorrowerTenantID, vs...))
+}
+
+// BorrowerTenantIDGT applies the GT predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDGT(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDGTE applies the GTE predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDGTE(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDLT applies the LT predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDLT(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDLTE applies the LTE predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDLTE(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDContains applies the Contains predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDContains(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldContains(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDHasPrefix applies the HasPrefix predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDHasPrefix(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldHasPrefix(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDHasSuffix applies the HasSuffix predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDHasSuffix(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldHasSuffix(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDEqualFold applies the EqualFold predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDEqualFold(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEqualFold(FieldBorrowerTenantID, v))
+}
+
+// BorrowerTenantIDContainsFold applies the ContainsFold predicate on the "borrower_tenant_id" field.
+func BorrowerTenantIDContainsFold(v string) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldContainsFold(FieldBorrowerTenantID, v))
+}
+
+// MaxRentalDaysEQ applies the EQ predicate on the "max_rental_days" field.
+func MaxRentalDaysEQ(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldMaxRentalDays, v))
+}
+
+// MaxRentalDaysNEQ applies the NEQ predicate on the "max_rental_days" field.
+func MaxRentalDaysNEQ(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldMaxRentalDays, v))
+}
+
+// MaxRentalDaysIn applies the In predicate on the "max_rental_days" field.
+func MaxRentalDaysIn(vs ...int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldMaxRentalDays, vs...))
+}
+
+// MaxRentalDaysNotIn applies the NotIn predicate on the "max_rental_days" field.
+func MaxRentalDaysNotIn(vs ...int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldMaxRentalDays, vs...))
+}
+
+// MaxRentalDaysGT applies the GT predicate on the "max_rental_days" field.
+func MaxRentalDaysGT(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldMaxRentalDays, v))
+}
+
+// MaxRentalDaysGTE applies the GTE predicate on the "max_rental_days" field.
+func MaxRentalDaysGTE(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldMaxRentalDays, v))
+}
+
+// MaxRentalDaysLT applies the LT predicate on the "max_rental_days" field.
+func MaxRentalDaysLT(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldMaxRentalDays, v))
+}
+
+// MaxRentalDaysLTE applies the LTE predicate on the "max_rental_days" field.
+func MaxRentalDaysLTE(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldMaxRentalDays, v))
+}
+
+// OwnerSharePercentEQ applies the EQ predicate on the "owner_share_percent" field.
+func OwnerSharePercentEQ(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldOwnerSharePercent, v))
+}
+
+// OwnerSharePercentNEQ applies the NEQ predicate on the "owner_share_percent" field.
+func OwnerSharePercentNEQ(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldOwnerSharePercent, v))
+}
+
+// OwnerSharePercentIn applies the In predicate on the "owner_share_percent" field.
+func OwnerSharePercentIn(vs ...int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldOwnerSharePercent, vs...))
+}
+
+// OwnerSharePercentNotIn applies the NotIn predicate on the "owner_share_percent" field.
+func OwnerSharePercentNotIn(vs ...int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldOwnerSharePercent, vs...))
+}
+
+// OwnerSharePercentGT applies the GT predicate on the "owner_share_percent" field.
+func OwnerSharePercentGT(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldOwnerSharePercent, v))
+}
+
+// OwnerSharePercentGTE applies the GTE predicate on the "owner_share_percent" field.
+func OwnerSharePercentGTE(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldOwnerSharePercent, v))
+}
+
+// OwnerSharePercentLT applies the LT predicate on the "owner_share_percent" field.
+func OwnerSharePercentLT(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldOwnerSharePercent, v))
+}
+
+// OwnerSharePercentLTE applies the LTE predicate on the "owner_share_percent" field.
+func OwnerSharePercentLTE(v int) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldOwnerSharePercent, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidUntilEQ applies the EQ predicate on the "valid_until" field.
+func ValidUntilEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldValidUntil, v))
+}
+
+// ValidUntilNEQ applies the NEQ predicate on the "valid_until" field.
+func ValidUntilNEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldValidUntil, v))
+}
+
+// ValidUntilIn applies the In predicate on the "valid_until" field.
+func ValidUntilIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilNotIn applies the NotIn predicate on the "valid_until" field.
+func ValidUntilNotIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldValidUntil, vs...))
+}
+
+// ValidUntilGT applies the GT predicate on the "valid_until" field.
+func ValidUntilGT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldValidUntil, v))
+}
+
+// ValidUntilGTE applies the GTE predicate on the "valid_until" field.
+func ValidUntilGTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldValidUntil, v))
+}
+
+// ValidUntilLT applies the LT predicate on the "valid_until" field.
+func ValidUntilLT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldValidUntil, v))
+}
+
+// ValidUntilLTE applies the LTE predicate on the "valid_until" field.
+func ValidUntilLTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldValidUntil, v))
+}
+
+// ValidUntilIsNil applies the IsNil predicate on the "valid_until" field.
+func ValidUntilIsNil() predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIsNull(FieldValidUntil))
+}
+
+// ValidUntilNotNil applies the NotNil predicate on the "valid_until" field.
+func ValidUntilNotNil() predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotNull(FieldValidUntil))
+}
+
+// TerminatedAtEQ applies the EQ predicate on the "terminated_at" field.
+func TerminatedAtEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldTerminatedAt, v))
+}
+
+// TerminatedAtNEQ applies the NEQ predicate on the "terminated_at" field.
+func TerminatedAtNEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldTerminatedAt, v))
+}
+
+// TerminatedAtIn applies the In predicate on the "terminated_at" field.
+func TerminatedAtIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldTerminatedAt, vs...))
+}
+
+// TerminatedAtNotIn applies the NotIn predicate on the "terminated_at" field.
+func TerminatedAtNotIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldTerminatedAt, vs...))
+}
+
+// TerminatedAtGT applies the GT predicate on the "terminated_at" field.
+func TerminatedAtGT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldTerminatedAt, v))
+}
+
+// TerminatedAtGTE applies the GTE predicate on the "terminated_at" field.
+func TerminatedAtGTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldTerminatedAt, v))
+}
+
+// TerminatedAtLT applies the LT predicate on the "terminated_at" field.
+func TerminatedAtLT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldTerminatedAt, v))
+}
+
+// TerminatedAtLTE applies the LTE predicate on the "terminated_at" field.
+func TerminatedAtLTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldTerminatedAt, v))
+}
+
+// TerminatedAtIsNil applies the IsNil predicate on the "terminated_at" field.
+func TerminatedAtIsNil() predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIsNull(FieldTerminatedAt))
+}
+
+// TerminatedAtNotNil applies the NotNil predicate on the "terminated_at" field.
+func TerminatedAtNotNil() predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotNull(FieldTerminatedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FleetSharingAgreement) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FleetSharingAgreement) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FleetSharingAgreement) predicate.FleetSharingAgreement {
+	return predicate.FleetSharingAgreement(sql.NotPredicates(p))
+}
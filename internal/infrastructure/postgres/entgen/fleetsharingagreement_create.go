@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+)
+
+// FleetSharingAgreementCreate is the builder for creating a FleetSharingAgreement entity.
+type FleetSharingAgreementCreate struct {
+	config
+	mutation *FleetSharingAgreementMutation
+	hooks    []Hook
+}
+
+// SetLenderTenantID sets the "lender_tenant_id" field.
+func (_c *FleetSharingAgreementCreate) SetLenderTenantID(v string) *FleetSharingAgreementCreate {
+	_c.mutation.SetLenderTenantID(v)
+	return _c
+}
+
+// SetBorrowerTenantID sets the "borrower_tenant_id" field.
+func (_c *FleetSharingAgreementCreate) SetBorrowerTenantID(v string) *FleetSharingAgreementCreate {
+	_c.mutation.SetBorrowerTenantID(v)
+	return _c
+}
+
+// SetMaxRentalDays sets the "max_rental_days" field.
+func (_c *FleetSharingAgreementCreate) SetMaxRentalDays(v int) *FleetSharingAgreementCreate {
+	_c.mutation.SetMaxRentalDays(v)
+	return _c
+}
+
+// SetNillableMaxRentalDays sets the "max_rental_days" field if the given value is not nil.
+func (_c *FleetSharingAgreementCreate) SetNillableMaxRentalDays(v *int) *FleetSharingAgreementCreate {
+	if v != nil {
+		_c.SetMaxRentalDays(*v)
+	}
+	return _c
+}
+
+// SetOwnerSharePercent sets the "owner_share_percent" field.
+func (_c *FleetSharingAgreementCreate) SetOwnerSharePercent(v int) *FleetSharingAgreementCreate {
+	_c.mutation.SetOwnerSharePercent(v)
+	return _c
+}
+
+// SetNillableOwnerSharePercent sets the "owner_share_percent" field if the given value is not nil.
+func (_c *FleetSharingAgreementCreate) SetNillableOwnerSharePercent(v *int) *FleetSharingAgreementCreate {
+	if v != nil {
+		_c.SetOwnerSharePercent(*v)
+	}
+	return _c
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *FleetSharingAgreementCreate) SetValidFrom(v time.Time) *FleetSharingAgreementCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_c *FleetSharingAgreementCreate) SetValidUntil(v time.Time) *FleetSharingAgreementCreate {
+	_c.mutation.SetValidUntil(v)
+	return _c
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_c *FleetSharingAgreementCreate) SetNillableValidUntil(v *time.Time) *FleetSharingAgreementCreate {
+	if v != nil {
+		_c.SetValidUntil(*v)
+	}
+	return _c
+}
+
+// SetTerminatedAt sets the "terminated_at" field.
+func (_c *FleetSharingAgreementCreate) SetTerminatedAt(v time.Time) *FleetSharingAgreementCreate {
+	_c.mutation.SetTerminatedAt(v)
+	return _c
+}
+
+// SetNillableTerminatedAt sets the "terminated_at" field if the given value is not nil.
+func (_c *FleetSharingAgreementCreate) SetNillableTerminatedAt(v *time.Time) *FleetSharingAgreementCreate {
+	if v != nil {
+		_c.SetTerminatedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FleetSharingAgreementCreate) SetCreatedAt(v time.Time) *FleetSharingAgreementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FleetSharingAgreementCreate) SetNillableCreatedAt(v *time.Time) *FleetSharingAgreementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FleetSharingAgreementCreate) SetUpdatedAt(v time.Time) *FleetSharingAgreementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FleetSharingAgreementCreate) SetNillableUpdatedAt(v *time.Time) *FleetSharingAgreementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FleetSharingAgreementCreate) SetID(v string) *FleetSharingAgreementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the FleetSharingAgreementMutation object of the builder.
+func (_c *FleetSharingAgreementCreate) Mutation() *FleetSharingAgreementMutation {
+	return _c.mutation
+}
+
+// Save creates the FleetSharingAgreement in the database.
+func (_c *FleetSharingAgreementCreate) Save(ctx context.Context) (*FleetSharingAgreement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FleetSharingAgreementCreate) SaveX(ctx context.Context) *FleetSharingAgreement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FleetSharingAgreementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FleetSharingAgreementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FleetSharingAgreementCreate) defaults() {
+	if _, ok := _c.mutation.MaxRentalDays(); !ok {
+		v := fleetsharingagreement.DefaultMaxRentalDays
+		_c.mutation.SetMaxRentalDays(v)
+	}
+	if _, ok := _c.mutation.OwnerSharePercent(); !ok {
+		v := fleetsharingagreement.DefaultOwnerSharePercent
+		_c.mutation.SetOwnerSharePercent(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FleetSharingAgreementCreate) check() error {
+	if _, ok := _c.mutation.LenderTenantID(); !ok {
+		return &ValidationError{Name: "lender_tenant_id", err: errors.New(`entgen: missing required field "FleetSharingAgreement.lender_tenant_id"`)}
+	}
+	if v, ok := _c.mutation.LenderTenantID(); ok {
+		if err := fleetsharingagreement.LenderTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "lender_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "FleetSharingAgreement.lender_tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BorrowerTenantID(); !ok {
+		return &ValidationError{Name: "borrower_tenant_id", err: errors.New(`entgen: missing required field "FleetSharingAgreement.borrower_tenant_id"`)}
+	}
+	if v, ok := _c.mutation.BorrowerTenantID(); ok {
+		if err := fleetsharingagreement.BorrowerTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "borrower_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "FleetSharingAgreement.borrower_tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxRentalDays(); !ok {
+		return &ValidationError{Name: "max_rental_days", err: errors.New(`entgen: missing required field "FleetSharingAgreement.max_rental_days"`)}
+	}
+	if _, ok := _c.mutation.OwnerSharePercent(); !ok {
+		return &ValidationError{Name: "owner_share_percent", err: errors.New(`entgen: missing required field "FleetSharingAgreement.owner_share_percent"`)}
+	}
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`entgen: missing required field "FleetSharingAgreement.valid_from"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := fleetsharingagreement.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "FleetSharingAgreement.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *FleetSharingAgreementCreate) sqlSave(ctx context.Context) (*FleetSharingAgreement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected FleetSharingAgreement.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FleetSharingAgreementCreate) createSpec() (*FleetSharingAgreement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FleetSharingAgreement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(fleetsharingagreement.Table, sqlgraph.NewFieldSpec(fleetsharingagreement.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.LenderTenantID(); ok {
+		_spec.SetField(fleetsharingagreement.FieldLenderTenantID, field.TypeString, value)
+		_node.LenderTenantID = value
+	}
+	if value, ok := _c.mutation.BorrowerTenantID(); ok {
+		_spec.SetField(fleetsharingagreement.FieldBorrowerTenantID, field.TypeString, value)
+		_node.BorrowerTenantID = value
+	}
+	if value, ok := _c.mutation.MaxRentalDays(); ok {
+		_spec.SetField(fleetsharingagreement.FieldMaxRentalDays, field.TypeInt, value)
+		_node.MaxRentalDays = value
+	}
+	if value, ok := _c.mutation.OwnerSharePercent(); ok {
+		_spec.SetField(fleetsharingagreement.FieldOwnerSharePercent, field.TypeInt, value)
+		_node.OwnerSharePercent = value
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(fleetsharingagreement.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidUntil(); ok {
+		_spec.SetField(fleetsharingagreement.FieldValidUntil, field.TypeTime, value)
+		_node.ValidUntil = &value
+	}
+	if value, ok := _c.mutation.TerminatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldTerminatedAt, field.TypeTime, value)
+		_node.TerminatedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// FleetSharingAgreementCreateBulk is the builder for creating many FleetSharingAgreement entities in bulk.
+type FleetSharingAgreementCreateBulk struct {
+	config
+	err      error
+	builders []*FleetSharingAgreementCreate
+}
+
+// Save creates the FleetSharingAgreement entities in the database.
+func (_c *FleetSharingAgreementCreateBulk) Save(ctx context.Context) ([]*FleetSharingAgreement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FleetSharingAgreement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FleetSharingAgreementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FleetSharingAgreementCreateBulk) SaveX(ctx context.Context) []*FleetSharingAgreement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FleetSharingAgreementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FleetSharingAgreementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
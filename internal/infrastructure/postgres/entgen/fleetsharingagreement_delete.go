@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// FleetSharingAgreementDelete is the builder for deleting a FleetSharingAgreement entity.
+type FleetSharingAgreementDelete struct {
+	config
+	hooks    []Hook
+	mutation *FleetSharingAgreementMutation
+}
+
+// Where appends a list predicates to the FleetSharingAgreementDelete builder.
+func (_d *FleetSharingAgreementDelete) Where(ps ...predicate.FleetSharingAgreement) *FleetSharingAgreementDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *FleetSharingAgreementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FleetSharingAgreementDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *FleetSharingAgreementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(fleetsharingagreement.Table, sqlgraph.NewFieldSpec(fleetsharingagreement.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// FleetSharingAgreementDeleteOne is the builder for deleting a single FleetSharingAgreement entity.
+type FleetSharingAgreementDeleteOne struct {
+	_d *FleetSharingAgreementDelete
+}
+
+// Where appends a list predicates to the FleetSharingAgreementDelete builder.
+func (_d *FleetSharingAgreementDeleteOne) Where(ps ...predicate.FleetSharingAgreement) *FleetSharingAgreementDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *FleetSharingAgreementDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fleetsharingagreement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FleetSharingAgreementDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
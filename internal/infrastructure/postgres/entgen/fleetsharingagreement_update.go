@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// FleetSharingAgreementUpdate is the builder for updating FleetSharingAgreement entities.
+type FleetSharingAgreementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FleetSharingAgreementMutation
+}
+
+// Where appends a list predicates to the FleetSharingAgreementUpdate builder.
+func (_u *FleetSharingAgreementUpdate) Where(ps ...predicate.FleetSharingAgreement) *FleetSharingAgreementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLenderTenantID sets the "lender_tenant_id" field.
+func (_u *FleetSharingAgreementUpdate) SetLenderTenantID(v string) *FleetSharingAgreementUpdate {
+	_u.mutation.SetLenderTenantID(v)
+	return _u
+}
+
+// SetNillableLenderTenantID sets the "lender_tenant_id" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableLenderTenantID(v *string) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetLenderTenantID(*v)
+	}
+	return _u
+}
+
+// SetBorrowerTenantID sets the "borrower_tenant_id" field.
+func (_u *FleetSharingAgreementUpdate) SetBorrowerTenantID(v string) *FleetSharingAgreementUpdate {
+	_u.mutation.SetBorrowerTenantID(v)
+	return _u
+}
+
+// SetNillableBorrowerTenantID sets the "borrower_tenant_id" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableBorrowerTenantID(v *string) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetBorrowerTenantID(*v)
+	}
+	return _u
+}
+
+// SetMaxRentalDays sets the "max_rental_days" field.
+func (_u *FleetSharingAgreementUpdate) SetMaxRentalDays(v int) *FleetSharingAgreementUpdate {
+	_u.mutation.ResetMaxRentalDays()
+	_u.mutation.SetMaxRentalDays(v)
+	return _u
+}
+
+// SetNillableMaxRentalDays sets the "max_rental_days" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableMaxRentalDays(v *int) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetMaxRentalDays(*v)
+	}
+	return _u
+}
+
+// AddMaxRentalDays adds value to the "max_rental_days" field.
+func (_u *FleetSharingAgreementUpdate) AddMaxRentalDays(v int) *FleetSharingAgreementUpdate {
+	_u.mutation.AddMaxRentalDays(v)
+	return _u
+}
+
+// SetOwnerSharePercent sets the "owner_share_percent" field.
+func (_u *FleetSharingAgreementUpdate) SetOwnerSharePercent(v int) *FleetSharingAgreementUpdate {
+	_u.mutation.ResetOwnerSharePercent()
+	_u.mutation.SetOwnerSharePercent(v)
+	return _u
+}
+
+// SetNillableOwnerSharePercent sets the "owner_share_percent" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableOwnerSharePercent(v *int) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetOwnerSharePercent(*v)
+	}
+	return _u
+}
+
+// AddOwnerSharePercent adds value to the "owner_share_percent" field.
+func (_u *FleetSharingAgreementUpdate) AddOwnerSharePercent(v int) *FleetSharingAgreementUpdate {
+	_u.mutation.AddOwnerSharePercent(v)
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *FleetSharingAgreementUpdate) SetValidFrom(v time.Time) *FleetSharingAgreementUpdate {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableValidFrom(v *time.Time) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_u *FleetSharingAgreementUpdate) SetValidUntil(v time.Time) *FleetSharingAgreementUpdate {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableValidUntil(v *time.Time) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (_u *FleetSharingAgreementUpdate) ClearValidUntil() *FleetSharingAgreementUpdate {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// SetTerminatedAt sets the "terminated_at" field.
+func (_u *FleetSharingAgreementUpdate) SetTerminatedAt(v time.Time) *FleetSharingAgreementUpdate {
+	_u.mutation.SetTerminatedAt(v)
+	return _u
+}
+
+// SetNillableTerminatedAt sets the "terminated_at" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableTerminatedAt(v *time.Time) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetTerminatedAt(*v)
+	}
+	return _u
+}
+
+// ClearTerminatedAt clears the value of the "terminated_at" field.
+func (_u *FleetSharingAgreementUpdate) ClearTerminatedAt() *FleetSharingAgreementUpdate {
+	_u.mutation.ClearTerminatedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *FleetSharingAgreementUpdate) SetCreatedAt(v time.Time) *FleetSharingAgreementUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableCreatedAt(v *time.Time) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *FleetSharingAgreementUpdate) ClearCreatedAt() *FleetSharingAgreementUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FleetSharingAgreementUpdate) SetUpdatedAt(v time.Time) *FleetSharingAgreementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdate) SetNillableUpdatedAt(v *time.Time) *FleetSharingAgreementUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *FleetSharingAgreementUpdate) ClearUpdatedAt() *FleetSharingAgreementUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// Mutation returns the FleetSharingAgreementMutation object of the builder.
+func (_u *FleetSharingAgreementUpdate) Mutation() *FleetSharingAgreementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FleetSharingAgreementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FleetSharingAgreementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FleetSharingAgreementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FleetSharingAgreementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FleetSharingAgreementUpdate) check() error {
+	if v, ok := _u.mutation.LenderTenantID(); ok {
+		if err := fleetsharingagreement.LenderTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "lender_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "FleetSharingAgreement.lender_tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BorrowerTenantID(); ok {
+		if err := fleetsharingagreement.BorrowerTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "borrower_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "FleetSharingAgreement.borrower_tenant_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *FleetSharingAgreementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fleetsharingagreement.Table, fleetsharingagreement.Columns, sqlgraph.NewFieldSpec(fleetsharingagreement.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LenderTenantID(); ok {
+		_spec.SetField(fleetsharingagreement.FieldLenderTenantID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BorrowerTenantID(); ok {
+		_spec.SetField(fleetsharingagreement.FieldBorrowerTenantID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxRentalDays(); ok {
+		_spec.SetField(fleetsharingagreement.FieldMaxRentalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRentalDays(); ok {
+		_spec.AddField(fleetsharingagreement.FieldMaxRentalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OwnerSharePercent(); ok {
+		_spec.SetField(fleetsharingagreement.FieldOwnerSharePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerSharePercent(); ok {
+		_spec.AddField(fleetsharingagreement.FieldOwnerSharePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(fleetsharingagreement.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(fleetsharingagreement.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(fleetsharingagreement.FieldValidUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TerminatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldTerminatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TerminatedAtCleared() {
+		_spec.ClearField(fleetsharingagreement.FieldTerminatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(fleetsharingagreement.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(fleetsharingagreement.FieldUpdatedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fleetsharingagreement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FleetSharingAgreementUpdateOne is the builder for updating a single FleetSharingAgreement entity.
+type FleetSharingAgreementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FleetSharingAgreementMutation
+}
+
+// SetLenderTenantID sets the "lender_tenant_id" field.
+func (_u *FleetSharingAgreementUpdateOne) SetLenderTenantID(v string) *FleetSharingAgreementUpdateOne {
+	_u.mutation.SetLenderTenantID(v)
+	return _u
+}
+
+// SetNillableLenderTenantID sets the "lender_tenant_id" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableLenderTenantID(v *string) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetLenderTenantID(*v)
+	}
+	return _u
+}
+
+// SetBorrowerTenantID sets the "borrower_tenant_id" field.
+func (_u *FleetSharingAgreementUpdateOne) SetBorrowerTenantID(v string) *FleetSharingAgreementUpdateOne {
+	_u.mutation.SetBorrowerTenantID(v)
+	return _u
+}
+
+// SetNillableBorrowerTenantID sets the "borrower_tenant_id" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableBorrowerTenantID(v *string) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetBorrowerTenantID(*v)
+	}
+	return _u
+}
+
+// SetMaxRentalDays sets the "max_rental_days" field.
+func (_u *FleetSharingAgreementUpdateOne) SetMaxRentalDays(v int) *FleetSharingAgreementUpdateOne {
+	_u.mutation.ResetMaxRentalDays()
+	_u.mutation.SetMaxRentalDays(v)
+	return _u
+}
+
+// SetNillableMaxRentalDays sets the "max_rental_days" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableMaxRentalDays(v *int) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetMaxRentalDays(*v)
+	}
+	return _u
+}
+
+// AddMaxRentalDays adds value to the "max_rental_days" field.
+func (_u *FleetSharingAgreementUpdateOne) AddMaxRentalDays(v int) *FleetSharingAgreementUpdateOne {
+	_u.mutation.AddMaxRentalDays(v)
+	return _u
+}
+
+// SetOwnerSharePercent sets the "owner_share_percent" field.
+func (_u *FleetSharingAgreementUpdateOne) SetOwnerSharePercent(v int) *FleetSharingAgreementUpdateOne {
+	_u.mutation.ResetOwnerSharePercent()
+	_u.mutation.SetOwnerSharePercent(v)
+	return _u
+}
+
+// SetNillableOwnerSharePercent sets the "owner_share_percent" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableOwnerSharePercent(v *int) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetOwnerSharePercent(*v)
+	}
+	return _u
+}
+
+// AddOwnerSharePercent adds value to the "owner_share_percent" field.
+func (_u *FleetSharingAgreementUpdateOne) AddOwnerSharePercent(v int) *FleetSharingAgreementUpdateOne {
+	_u.mutation.AddOwnerSharePercent(v)
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *FleetSharingAgreementUpdateOne) SetValidFrom(v time.Time) *FleetSharingAgreementUpdateOne {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableValidFrom(v *time.Time) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (_u *FleetSharingAgreementUpdateOne) SetValidUntil(v time.Time) *FleetSharingAgreementUpdateOne {
+	_u.mutation.SetValidUntil(v)
+	return _u
+}
+
+// SetNillableValidUntil sets the "valid_until" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableValidUntil(v *time.Time) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetValidUntil(*v)
+	}
+	return _u
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (_u *FleetSharingAgreementUpdateOne) ClearValidUntil() *FleetSharingAgreementUpdateOne {
+	_u.mutation.ClearValidUntil()
+	return _u
+}
+
+// SetTerminatedAt sets the "terminated_at" field.
+func (_u *FleetSharingAgreementUpdateOne) SetTerminatedAt(v time.Time) *FleetSharingAgreementUpdateOne {
+	_u.mutation.SetTerminatedAt(v)
+	return _u
+}
+
+// SetNillableTerminatedAt sets the "terminated_at" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableTerminatedAt(v *time.Time) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetTerminatedAt(*v)
+	}
+	return _u
+}
+
+// ClearTerminatedAt clears the value of the "terminated_at" field.
+func (_u *FleetSharingAgreementUpdateOne) ClearTerminatedAt() *FleetSharingAgreementUpdateOne {
+	_u.mutation.ClearTerminatedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *FleetSharingAgreementUpdateOne) SetCreatedAt(v time.Time) *FleetSharingAgreementUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableCreatedAt(v *time.Time) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *FleetSharingAgreementUpdateOne) ClearCreatedAt() *FleetSharingAgreementUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FleetSharingAgreementUpdateOne) SetUpdatedAt(v time.Time) *FleetSharingAgreementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *FleetSharingAgreementUpdateOne) SetNillableUpdatedAt(v *time.Time) *FleetSharingAgreementUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *FleetSharingAgreementUpdateOne) ClearUpdatedAt() *FleetSharingAgreementUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// Mutation returns the FleetSharingAgreementMutation object of the builder.
+func (_u *FleetSharingAgreementUpdateOne) Mutation() *FleetSharingAgreementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FleetSharingAgreementUpdate builder.
+func (_u *FleetSharingAgreementUpdateOne) Where(ps ...predicate.FleetSharingAgreement) *FleetSharingAgreementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FleetSharingAgreementUpdateOne) Select(field string, fields ...string) *FleetSharingAgreementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FleetSharingAgreement entity.
+func (_u *FleetSharingAgreementUpdateOne) Save(ctx context.Context) (*FleetSharingAgreement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FleetSharingAgreementUpdateOne) SaveX(ctx context.Context) *FleetSharingAgreement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FleetSharingAgreementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FleetSharingAgreementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FleetSharingAgreementUpdateOne) check() error {
+	if v, ok := _u.mutation.LenderTenantID(); ok {
+		if err := fleetsharingagreement.LenderTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "lender_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "FleetSharingAgreement.lender_tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BorrowerTenantID(); ok {
+		if err := fleetsharingagreement.BorrowerTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "borrower_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "FleetSharingAgreement.borrower_tenant_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *FleetSharingAgreementUpdateOne) sqlSave(ctx context.Context) (_node *FleetSharingAgreement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(fleetsharingagreement.Table, fleetsharingagreement.Columns, sqlgraph.NewFieldSpec(fleetsharingagreement.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "FleetSharingAgreement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, fleetsharingagreement.FieldID)
+		for _, f := range fields {
+			if !fleetsharingagreement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != fleetsharingagreement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.LenderTenantID(); ok {
+		_spec.SetField(fleetsharingagreement.FieldLenderTenantID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BorrowerTenantID(); ok {
+		_spec.SetField(fleetsharingagreement.FieldBorrowerTenantID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxRentalDays(); ok {
+		_spec.SetField(fleetsharingagreement.FieldMaxRentalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRentalDays(); ok {
+		_spec.AddField(fleetsharingagreement.FieldMaxRentalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OwnerSharePercent(); ok {
+		_spec.SetField(fleetsharingagreement.FieldOwnerSharePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOwnerSharePercent(); ok {
+		_spec.AddField(fleetsharingagreement.FieldOwnerSharePercent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(fleetsharingagreement.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValidUntil(); ok {
+		_spec.SetField(fleetsharingagreement.FieldValidUntil, field.TypeTime, value)
+	}
+	if _u.mutation.ValidUntilCleared() {
+		_spec.ClearField(fleetsharingagreement.FieldValidUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TerminatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldTerminatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TerminatedAtCleared() {
+		_spec.ClearField(fleetsharingagreement.FieldTerminatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(fleetsharingagreement.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(fleetsharingagreement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(fleetsharingagreement.FieldUpdatedAt, field.TypeTime)
+	}
+	_node = &FleetSharingAgreement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fleetsharingagreement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,42 @@ import (
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
 )
 
+// The APIKeyFunc type is an adapter to allow the use of ordinary
+// function as APIKey mutator.
+type APIKeyFunc func(context.Context, *entgen.APIKeyMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f APIKeyFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.APIKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.APIKeyMutation", m)
+}
+
+// The ArchiveJobFunc type is an adapter to allow the use of ordinary
+// function as ArchiveJob mutator.
+type ArchiveJobFunc func(context.Context, *entgen.ArchiveJobMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArchiveJobFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.ArchiveJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.ArchiveJobMutation", m)
+}
+
+// The BranchFunc type is an adapter to allow the use of ordinary
+// function as Branch mutator.
+type BranchFunc func(context.Context, *entgen.BranchMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BranchFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.BranchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.BranchMutation", m)
+}
+
 // The CarFunc type is an adapter to allow the use of ordinary
 // function as Car mutator.
 type CarFunc func(context.Context, *entgen.CarMutation) (entgen.Value, error)
@@ -21,6 +57,18 @@ func (f CarFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.CarMutation", m)
 }
 
+// The CarModelFunc type is an adapter to allow the use of ordinary
+// function as CarModel mutator.
+type CarModelFunc func(context.Context, *entgen.CarModelMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CarModelFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.CarModelMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.CarModelMutation", m)
+}
+
 // The CarOptionFunc type is an adapter to allow the use of ordinary
 // function as CarOption mutator.
 type CarOptionFunc func(context.Context, *entgen.CarOptionMutation) (entgen.Value, error)
@@ -45,6 +93,30 @@ func (f CompanyFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.CompanyMutation", m)
 }
 
+// The FleetSharingAgreementFunc type is an adapter to allow the use of ordinary
+// function as FleetSharingAgreement mutator.
+type FleetSharingAgreementFunc func(context.Context, *entgen.FleetSharingAgreementMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FleetSharingAgreementFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.FleetSharingAgreementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.FleetSharingAgreementMutation", m)
+}
+
+// The InboxFunc type is an adapter to allow the use of ordinary
+// function as Inbox mutator.
+type InboxFunc func(context.Context, *entgen.InboxMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InboxFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.InboxMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.InboxMutation", m)
+}
+
 // The IndividualFunc type is an adapter to allow the use of ordinary
 // function as Individual mutator.
 type IndividualFunc func(context.Context, *entgen.IndividualMutation) (entgen.Value, error)
@@ -57,6 +129,18 @@ func (f IndividualFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.IndividualMutation", m)
 }
 
+// The MaintenanceWindowFunc type is an adapter to allow the use of ordinary
+// function as MaintenanceWindow mutator.
+type MaintenanceWindowFunc func(context.Context, *entgen.MaintenanceWindowMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MaintenanceWindowFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.MaintenanceWindowMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.MaintenanceWindowMutation", m)
+}
+
 // The OutboxFunc type is an adapter to allow the use of ordinary
 // function as Outbox mutator.
 type OutboxFunc func(context.Context, *entgen.OutboxMutation) (entgen.Value, error)
@@ -69,6 +153,18 @@ func (f OutboxFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.OutboxMutation", m)
 }
 
+// The PlanFunc type is an adapter to allow the use of ordinary
+// function as Plan mutator.
+type PlanFunc func(context.Context, *entgen.PlanMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PlanFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.PlanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.PlanMutation", m)
+}
+
 // The RentalFunc type is an adapter to allow the use of ordinary
 // function as Rental mutator.
 type RentalFunc func(context.Context, *entgen.RentalMutation) (entgen.Value, error)
@@ -81,6 +177,18 @@ func (f RentalFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.RentalMutation", m)
 }
 
+// The RentalHandoverFunc type is an adapter to allow the use of ordinary
+// function as RentalHandover mutator.
+type RentalHandoverFunc func(context.Context, *entgen.RentalHandoverMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RentalHandoverFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.RentalHandoverMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.RentalHandoverMutation", m)
+}
+
 // The RentalOptionFunc type is an adapter to allow the use of ordinary
 // function as RentalOption mutator.
 type RentalOptionFunc func(context.Context, *entgen.RentalOptionMutation) (entgen.Value, error)
@@ -117,6 +225,66 @@ func (f TenantFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.TenantMutation", m)
 }
 
+// The TenantSettingFunc type is an adapter to allow the use of ordinary
+// function as TenantSetting mutator.
+type TenantSettingFunc func(context.Context, *entgen.TenantSettingMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantSettingFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.TenantSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.TenantSettingMutation", m)
+}
+
+// The UsageRecordFunc type is an adapter to allow the use of ordinary
+// function as UsageRecord mutator.
+type UsageRecordFunc func(context.Context, *entgen.UsageRecordMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageRecordFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.UsageRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.UsageRecordMutation", m)
+}
+
+// The UsageRollupFunc type is an adapter to allow the use of ordinary
+// function as UsageRollup mutator.
+type UsageRollupFunc func(context.Context, *entgen.UsageRollupMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageRollupFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.UsageRollupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.UsageRollupMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *entgen.WebhookDeliveryMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.WebhookDeliveryMutation", m)
+}
+
+// The WebhookEndpointFunc type is an adapter to allow the use of ordinary
+// function as WebhookEndpoint mutator.
+type WebhookEndpointFunc func(context.Context, *entgen.WebhookEndpointMutation) (entgen.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookEndpointFunc) Mutate(ctx context.Context, m entgen.Mutation) (entgen.Value, error) {
+	if mv, ok := m.(*entgen.WebhookEndpointMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entgen.WebhookEndpointMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, entgen.Mutation) bool
 
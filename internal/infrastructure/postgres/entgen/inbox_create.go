@@ -0,0 +1,287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/inbox"
+)
+
+// InboxCreate is the builder for creating a Inbox entity.
+type InboxCreate struct {
+	config
+	mutation *InboxMutation
+	hooks    []Hook
+}
+
+// SetSource sets the "source" field.
+func (_c *InboxCreate) SetSource(v string) *InboxCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *InboxCreate) SetMessageID(v string) *InboxCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *InboxCreate) SetEventType(v string) *InboxCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *InboxCreate) SetPayload(v map[string]interface{}) *InboxCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *InboxCreate) SetReceivedAt(v time.Time) *InboxCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *InboxCreate) SetNillableReceivedAt(v *time.Time) *InboxCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_c *InboxCreate) SetProcessedAt(v time.Time) *InboxCreate {
+	_c.mutation.SetProcessedAt(v)
+	return _c
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_c *InboxCreate) SetNillableProcessedAt(v *time.Time) *InboxCreate {
+	if v != nil {
+		_c.SetProcessedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InboxCreate) SetID(v string) *InboxCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the InboxMutation object of the builder.
+func (_c *InboxCreate) Mutation() *InboxMutation {
+	return _c.mutation
+}
+
+// Save creates the Inbox in the database.
+func (_c *InboxCreate) Save(ctx context.Context) (*Inbox, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InboxCreate) SaveX(ctx context.Context) *Inbox {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboxCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboxCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InboxCreate) check() error {
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`entgen: missing required field "Inbox.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := inbox.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`entgen: validator failed for field "Inbox.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`entgen: missing required field "Inbox.message_id"`)}
+	}
+	if v, ok := _c.mutation.MessageID(); ok {
+		if err := inbox.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`entgen: validator failed for field "Inbox.message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`entgen: missing required field "Inbox.event_type"`)}
+	}
+	if v, ok := _c.mutation.EventType(); ok {
+		if err := inbox.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`entgen: validator failed for field "Inbox.event_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := inbox.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "Inbox.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *InboxCreate) sqlSave(ctx context.Context) (*Inbox, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Inbox.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InboxCreate) createSpec() (*Inbox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Inbox{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(inbox.Table, sqlgraph.NewFieldSpec(inbox.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(inbox.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(inbox.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(inbox.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(inbox.FieldPayload, field.TypeJSON, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(inbox.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if value, ok := _c.mutation.ProcessedAt(); ok {
+		_spec.SetField(inbox.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	return _node, _spec
+}
+
+// InboxCreateBulk is the builder for creating many Inbox entities in bulk.
+type InboxCreateBulk struct {
+	config
+	err      error
+	builders []*InboxCreate
+}
+
+// Save creates the Inbox entities in the database.
+func (_c *InboxCreateBulk) Save(ctx context.Context) ([]*Inbox, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Inbox, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InboxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InboxCreateBulk) SaveX(ctx context.Context) []*Inbox {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InboxCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InboxCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/inbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// InboxUpdate is the builder for updating Inbox entities.
+type InboxUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InboxMutation
+}
+
+// Where appends a list predicates to the InboxUpdate builder.
+func (_u *InboxUpdate) Where(ps ...predicate.Inbox) *InboxUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *InboxUpdate) SetSource(v string) *InboxUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *InboxUpdate) SetNillableSource(v *string) *InboxUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *InboxUpdate) SetMessageID(v string) *InboxUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *InboxUpdate) SetNillableMessageID(v *string) *InboxUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *InboxUpdate) SetEventType(v string) *InboxUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *InboxUpdate) SetNillableEventType(v *string) *InboxUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *InboxUpdate) SetPayload(v map[string]interface{}) *InboxUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *InboxUpdate) ClearPayload() *InboxUpdate {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *InboxUpdate) SetReceivedAt(v time.Time) *InboxUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *InboxUpdate) SetNillableReceivedAt(v *time.Time) *InboxUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (_u *InboxUpdate) ClearReceivedAt() *InboxUpdate {
+	_u.mutation.ClearReceivedAt()
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *InboxUpdate) SetProcessedAt(v time.Time) *InboxUpdate {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *InboxUpdate) SetNillableProcessedAt(v *time.Time) *InboxUpdate {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *InboxUpdate) ClearProcessedAt() *InboxUpdate {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// Mutation returns the InboxMutation object of the builder.
+func (_u *InboxUpdate) Mutation() *InboxMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InboxUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboxUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InboxUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboxUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboxUpdate) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := inbox.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`entgen: validator failed for field "Inbox.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := inbox.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`entgen: validator failed for field "Inbox.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := inbox.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`entgen: validator failed for field "Inbox.event_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InboxUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inbox.Table, inbox.Columns, sqlgraph.NewFieldSpec(inbox.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(inbox.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(inbox.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(inbox.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(inbox.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(inbox.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(inbox.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReceivedAtCleared() {
+		_spec.ClearField(inbox.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(inbox.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(inbox.FieldProcessedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InboxUpdateOne is the builder for updating a single Inbox entity.
+type InboxUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InboxMutation
+}
+
+// SetSource sets the "source" field.
+func (_u *InboxUpdateOne) SetSource(v string) *InboxUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *InboxUpdateOne) SetNillableSource(v *string) *InboxUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *InboxUpdateOne) SetMessageID(v string) *InboxUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *InboxUpdateOne) SetNillableMessageID(v *string) *InboxUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *InboxUpdateOne) SetEventType(v string) *InboxUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *InboxUpdateOne) SetNillableEventType(v *string) *InboxUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *InboxUpdateOne) SetPayload(v map[string]interface{}) *InboxUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *InboxUpdateOne) ClearPayload() *InboxUpdateOne {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *InboxUpdateOne) SetReceivedAt(v time.Time) *InboxUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *InboxUpdateOne) SetNillableReceivedAt(v *time.Time) *InboxUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (_u *InboxUpdateOne) ClearReceivedAt() *InboxUpdateOne {
+	_u.mutation.ClearReceivedAt()
+	return _u
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (_u *InboxUpdateOne) SetProcessedAt(v time.Time) *InboxUpdateOne {
+	_u.mutation.SetProcessedAt(v)
+	return _u
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (_u *InboxUpdateOne) SetNillableProcessedAt(v *time.Time) *InboxUpdateOne {
+	if v != nil {
+		_u.SetProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (_u *InboxUpdateOne) ClearProcessedAt() *InboxUpdateOne {
+	_u.mutation.ClearProcessedAt()
+	return _u
+}
+
+// Mutation returns the InboxMutation object of the builder.
+func (_u *InboxUpdateOne) Mutation() *InboxMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InboxUpdate builder.
+func (_u *InboxUpdateOne) Where(ps ...predicate.Inbox) *InboxUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InboxUpdateOne) Select(field string, fields ...string) *InboxUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Inbox entity.
+func (_u *InboxUpdateOne) Save(ctx context.Context) (*Inbox, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InboxUpdateOne) SaveX(ctx context.Context) *Inbox {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InboxUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InboxUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InboxUpdateOne) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := inbox.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`entgen: validator failed for field "Inbox.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MessageID(); ok {
+		if err := inbox.MessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`entgen: validator failed for field "Inbox.message_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EventType(); ok {
+		if err := inbox.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`entgen: validator failed for field "Inbox.event_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InboxUpdateOne) sqlSave(ctx context.Context) (_node *Inbox, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(inbox.Table, inbox.Columns, sqlgraph.NewFieldSpec(inbox.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "Inbox.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, inbox.FieldID)
+		for _, f := range fields {
+			if !inbox.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != inbox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(inbox.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(inbox.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(inbox.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(inbox.FieldPayload, field.TypeJSON, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(inbox.FieldPayload, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(inbox.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReceivedAtCleared() {
+		_spec.ClearField(inbox.FieldReceivedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ProcessedAt(); ok {
+		_spec.SetField(inbox.FieldProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProcessedAtCleared() {
+		_spec.ClearField(inbox.FieldProcessedAt, field.TypeTime)
+	}
+	_node = &Inbox{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{inbox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,868 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// MaintenanceWindowUpdate is the builder for updating MaintenanceWindow entities.
+type MaintenanceWindowUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (_u *MaintenanceWindowUpdate) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *MaintenanceWindowUpdate) SetTenantID(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableTenantID(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCarID sets the "car_id" field.
+func (_u *MaintenanceWindowUpdate) SetCarID(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetCarID(v)
+	return _u
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableCarID(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetCarID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *MaintenanceWindowUpdate) SetType(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableType(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *MaintenanceWindowUpdate) SetReason(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableReason(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *MaintenanceWindowUpdate) ClearReason() *MaintenanceWindowUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *MaintenanceWindowUpdate) SetStartsAt(v time.Time) *MaintenanceWindowUpdate {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableStartsAt(v *time.Time) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *MaintenanceWindowUpdate) SetEndsAt(v time.Time) *MaintenanceWindowUpdate {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableEndsAt(v *time.Time) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MaintenanceWindowUpdate) SetStatus(v string) *MaintenanceWindowUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableStatus(v *string) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *MaintenanceWindowUpdate) SetCompletedAt(v time.Time) *MaintenanceWindowUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableCompletedAt(v *time.Time) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *MaintenanceWindowUpdate) ClearCompletedAt() *MaintenanceWindowUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_u *MaintenanceWindowUpdate) SetCanceledAt(v time.Time) *MaintenanceWindowUpdate {
+	_u.mutation.SetCanceledAt(v)
+	return _u
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableCanceledAt(v *time.Time) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetCanceledAt(*v)
+	}
+	return _u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (_u *MaintenanceWindowUpdate) ClearCanceledAt() *MaintenanceWindowUpdate {
+	_u.mutation.ClearCanceledAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MaintenanceWindowUpdate) SetCreatedAt(v time.Time) *MaintenanceWindowUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableCreatedAt(v *time.Time) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *MaintenanceWindowUpdate) ClearCreatedAt() *MaintenanceWindowUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MaintenanceWindowUpdate) SetUpdatedAt(v time.Time) *MaintenanceWindowUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdate) SetNillableUpdatedAt(v *time.Time) *MaintenanceWindowUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *MaintenanceWindowUpdate) ClearUpdatedAt() *MaintenanceWindowUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *MaintenanceWindowUpdate) SetTenant(v *Tenant) *MaintenanceWindowUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (_u *MaintenanceWindowUpdate) SetCar(v *Car) *MaintenanceWindowUpdate {
+	return _u.SetCarID(v.ID)
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (_u *MaintenanceWindowUpdate) Mutation() *MaintenanceWindowMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *MaintenanceWindowUpdate) ClearTenant() *MaintenanceWindowUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (_u *MaintenanceWindowUpdate) ClearCar() *MaintenanceWindowUpdate {
+	_u.mutation.ClearCar()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MaintenanceWindowUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MaintenanceWindowUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MaintenanceWindowUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := maintenancewindow.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CarID(); ok {
+		if err := maintenancewindow.CarIDValidator(v); err != nil {
+			return &ValidationError{Name: "car_id", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.car_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := maintenancewindow.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := maintenancewindow.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := maintenancewindow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "MaintenanceWindow.tenant"`)
+	}
+	if _u.mutation.CarCleared() && len(_u.mutation.CarIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "MaintenanceWindow.car"`)
+	}
+	return nil
+}
+
+func (_u *MaintenanceWindowUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(maintenancewindow.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(maintenancewindow.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(maintenancewindow.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(maintenancewindow.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CanceledAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCanceledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CanceledAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldCanceledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.TenantTable,
+			Columns: []string{maintenancewindow.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.TenantTable,
+			Columns: []string{maintenancewindow.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.CarTable,
+			Columns: []string{maintenancewindow.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.CarTable,
+			Columns: []string{maintenancewindow.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MaintenanceWindowUpdateOne is the builder for updating a single MaintenanceWindow entity.
+type MaintenanceWindowUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MaintenanceWindowMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *MaintenanceWindowUpdateOne) SetTenantID(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableTenantID(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetCarID sets the "car_id" field.
+func (_u *MaintenanceWindowUpdateOne) SetCarID(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetCarID(v)
+	return _u
+}
+
+// SetNillableCarID sets the "car_id" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableCarID(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetCarID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *MaintenanceWindowUpdateOne) SetType(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableType(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *MaintenanceWindowUpdateOne) SetReason(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableReason(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *MaintenanceWindowUpdateOne) ClearReason() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *MaintenanceWindowUpdateOne) SetStartsAt(v time.Time) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableStartsAt(v *time.Time) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (_u *MaintenanceWindowUpdateOne) SetEndsAt(v time.Time) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetEndsAt(v)
+	return _u
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableEndsAt(v *time.Time) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetEndsAt(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *MaintenanceWindowUpdateOne) SetStatus(v string) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableStatus(v *string) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *MaintenanceWindowUpdateOne) SetCompletedAt(v time.Time) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableCompletedAt(v *time.Time) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *MaintenanceWindowUpdateOne) ClearCompletedAt() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_u *MaintenanceWindowUpdateOne) SetCanceledAt(v time.Time) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetCanceledAt(v)
+	return _u
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableCanceledAt(v *time.Time) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetCanceledAt(*v)
+	}
+	return _u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (_u *MaintenanceWindowUpdateOne) ClearCanceledAt() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearCanceledAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *MaintenanceWindowUpdateOne) SetCreatedAt(v time.Time) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableCreatedAt(v *time.Time) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *MaintenanceWindowUpdateOne) ClearCreatedAt() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MaintenanceWindowUpdateOne) SetUpdatedAt(v time.Time) *MaintenanceWindowUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *MaintenanceWindowUpdateOne) SetNillableUpdatedAt(v *time.Time) *MaintenanceWindowUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *MaintenanceWindowUpdateOne) ClearUpdatedAt() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *MaintenanceWindowUpdateOne) SetTenant(v *Tenant) *MaintenanceWindowUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetCar sets the "car" edge to the Car entity.
+func (_u *MaintenanceWindowUpdateOne) SetCar(v *Car) *MaintenanceWindowUpdateOne {
+	return _u.SetCarID(v.ID)
+}
+
+// Mutation returns the MaintenanceWindowMutation object of the builder.
+func (_u *MaintenanceWindowUpdateOne) Mutation() *MaintenanceWindowMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *MaintenanceWindowUpdateOne) ClearTenant() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (_u *MaintenanceWindowUpdateOne) ClearCar() *MaintenanceWindowUpdateOne {
+	_u.mutation.ClearCar()
+	return _u
+}
+
+// Where appends a list predicates to the MaintenanceWindowUpdate builder.
+func (_u *MaintenanceWindowUpdateOne) Where(ps ...predicate.MaintenanceWindow) *MaintenanceWindowUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MaintenanceWindowUpdateOne) Select(field string, fields ...string) *MaintenanceWindowUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MaintenanceWindow entity.
+func (_u *MaintenanceWindowUpdateOne) Save(ctx context.Context) (*MaintenanceWindow, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdateOne) SaveX(ctx context.Context) *MaintenanceWindow {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MaintenanceWindowUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MaintenanceWindowUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MaintenanceWindowUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := maintenancewindow.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CarID(); ok {
+		if err := maintenancewindow.CarIDValidator(v); err != nil {
+			return &ValidationError{Name: "car_id", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.car_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := maintenancewindow.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Reason(); ok {
+		if err := maintenancewindow.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := maintenancewindow.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "MaintenanceWindow.status": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "MaintenanceWindow.tenant"`)
+	}
+	if _u.mutation.CarCleared() && len(_u.mutation.CarIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "MaintenanceWindow.car"`)
+	}
+	return nil
+}
+
+func (_u *MaintenanceWindowUpdateOne) sqlSave(ctx context.Context) (_node *MaintenanceWindow, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(maintenancewindow.Table, maintenancewindow.Columns, sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "MaintenanceWindow.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, maintenancewindow.FieldID)
+		for _, f := range fields {
+			if !maintenancewindow.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != maintenancewindow.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(maintenancewindow.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(maintenancewindow.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(maintenancewindow.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndsAt(); ok {
+		_spec.SetField(maintenancewindow.FieldEndsAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(maintenancewindow.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CanceledAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCanceledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CanceledAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldCanceledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(maintenancewindow.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(maintenancewindow.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.TenantTable,
+			Columns: []string{maintenancewindow.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.TenantTable,
+			Columns: []string{maintenancewindow.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CarCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.CarTable,
+			Columns: []string{maintenancewindow.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CarIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   maintenancewindow.CarTable,
+			Columns: []string{maintenancewindow.CarColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(car.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MaintenanceWindow{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{maintenancewindow.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,18 +3,131 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "prefix", Type: field.TypeString, Size: 32},
+		{Name: "secret_hash", Type: field.TypeString, Size: 64},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_tenants_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_prefix",
+				Unique:  true,
+				Columns: []*schema.Column{APIKeysColumns[2]},
+			},
+			{
+				Name:    "apikey_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[10], APIKeysColumns[8]},
+			},
+		},
+	}
+	// ArchiveJobsColumns holds the columns for the "archive_jobs" table.
+	ArchiveJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "kind", Type: field.TypeString, Size: 20},
+		{Name: "tenant_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "archive", Type: field.TypeString, Size: 255},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "running"},
+		{Name: "counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ArchiveJobsTable holds the schema information for the "archive_jobs" table.
+	ArchiveJobsTable = &schema.Table{
+		Name:       "archive_jobs",
+		Columns:    ArchiveJobsColumns,
+		PrimaryKey: []*schema.Column{ArchiveJobsColumns[0]},
+	}
+	// BranchesColumns holds the columns for the "branches" table.
+	BranchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "street", Type: field.TypeString, Size: 255},
+		{Name: "city", Type: field.TypeString, Size: 100},
+		{Name: "region", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "postal_code", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "country", Type: field.TypeString, Size: 2},
+		{Name: "latitude", Type: field.TypeFloat64},
+		{Name: "longitude", Type: field.TypeFloat64},
+		{Name: "opening_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+	}
+	// BranchesTable holds the schema information for the "branches" table.
+	BranchesTable = &schema.Table{
+		Name:       "branches",
+		Columns:    BranchesColumns,
+		PrimaryKey: []*schema.Column{BranchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "branches_tenants_branches",
+				Columns:    []*schema.Column{BranchesColumns[13]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "branch_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{BranchesColumns[13], BranchesColumns[1]},
+			},
+			{
+				Name:    "branch_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BranchesColumns[12]},
+			},
+			{
+				Name:    "branch_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{BranchesColumns[13]},
+			},
+		},
+	}
 	// CarsColumns holds the columns for the "cars" table.
 	CarsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 36},
-		{Name: "model", Type: field.TypeString, Size: 255},
+		{Name: "vin", Type: field.TypeString, Nullable: true, Size: 17},
+		{Name: "license_plate", Type: field.TypeString, Nullable: true, Size: 15},
+		{Name: "license_plate_country", Type: field.TypeString, Nullable: true, Size: 2},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "home_branch_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "current_branch_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "car_model_id", Type: field.TypeString, Nullable: true, Size: 36},
 		{Name: "tenant_id", Type: field.TypeString, Size: 36},
 	}
 	// CarsTable holds the schema information for the "cars" table.
@@ -23,28 +136,112 @@ var (
 		Columns:    CarsColumns,
 		PrimaryKey: []*schema.Column{CarsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cars_branches_home_cars",
+				Columns:    []*schema.Column{CarsColumns[7]},
+				RefColumns: []*schema.Column{BranchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "cars_branches_current_cars",
+				Columns:    []*schema.Column{CarsColumns[8]},
+				RefColumns: []*schema.Column{BranchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "cars_car_models_cars",
+				Columns:    []*schema.Column{CarsColumns[9]},
+				RefColumns: []*schema.Column{CarModelsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "cars_tenants_cars",
-				Columns:    []*schema.Column{CarsColumns[5]},
+				Columns:    []*schema.Column{CarsColumns[10]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "car_tenant_id_model",
+				Name:    "car_tenant_id_vin",
+				Unique:  true,
+				Columns: []*schema.Column{CarsColumns[10], CarsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "car_tenant_id_license_plate_country_license_plate",
 				Unique:  true,
-				Columns: []*schema.Column{CarsColumns[5], CarsColumns[1]},
+				Columns: []*schema.Column{CarsColumns[10], CarsColumns[3], CarsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
+			},
+			{
+				Name:    "car_car_model_id",
+				Unique:  false,
+				Columns: []*schema.Column{CarsColumns[9]},
+			},
+			{
+				Name:    "car_current_branch_id",
+				Unique:  false,
+				Columns: []*schema.Column{CarsColumns[8]},
 			},
 			{
 				Name:    "car_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{CarsColumns[4]},
+				Columns: []*schema.Column{CarsColumns[6]},
 			},
 			{
 				Name:    "car_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{CarsColumns[5]},
+				Columns: []*schema.Column{CarsColumns[10]},
+			},
+		},
+	}
+	// CarModelsColumns holds the columns for the "car_models" table.
+	CarModelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "make", Type: field.TypeString, Size: 100},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "category", Type: field.TypeString, Size: 20, Default: "unspecified"},
+		{Name: "seats", Type: field.TypeInt, Default: 0},
+		{Name: "transmission", Type: field.TypeString, Size: 20, Default: "unspecified"},
+		{Name: "fuel_type", Type: field.TypeString, Size: 20, Default: "unspecified"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+	}
+	// CarModelsTable holds the schema information for the "car_models" table.
+	CarModelsTable = &schema.Table{
+		Name:       "car_models",
+		Columns:    CarModelsColumns,
+		PrimaryKey: []*schema.Column{CarModelsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "car_models_tenants_car_models",
+				Columns:    []*schema.Column{CarModelsColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "carmodel_tenant_id_make_name",
+				Unique:  true,
+				Columns: []*schema.Column{CarModelsColumns[10], CarModelsColumns[1], CarModelsColumns[2]},
+			},
+			{
+				Name:    "carmodel_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{CarModelsColumns[9]},
+			},
+			{
+				Name:    "carmodel_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{CarModelsColumns[10]},
 			},
 		},
 	}
@@ -131,6 +328,73 @@ var (
 			},
 		},
 	}
+	// FleetSharingAgreementsColumns holds the columns for the "fleet_sharing_agreements" table.
+	FleetSharingAgreementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "lender_tenant_id", Type: field.TypeString, Size: 36},
+		{Name: "borrower_tenant_id", Type: field.TypeString, Size: 36},
+		{Name: "max_rental_days", Type: field.TypeInt, Default: 0},
+		{Name: "owner_share_percent", Type: field.TypeInt, Default: 0},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_until", Type: field.TypeTime, Nullable: true},
+		{Name: "terminated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// FleetSharingAgreementsTable holds the schema information for the "fleet_sharing_agreements" table.
+	FleetSharingAgreementsTable = &schema.Table{
+		Name:       "fleet_sharing_agreements",
+		Columns:    FleetSharingAgreementsColumns,
+		PrimaryKey: []*schema.Column{FleetSharingAgreementsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fleetsharingagreement_borrower_tenant_id_lender_tenant_id",
+				Unique:  true,
+				Columns: []*schema.Column{FleetSharingAgreementsColumns[2], FleetSharingAgreementsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "terminated_at IS NULL",
+				},
+			},
+			{
+				Name:    "fleetsharingagreement_lender_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{FleetSharingAgreementsColumns[1]},
+			},
+		},
+	}
+	// InboxesColumns holds the columns for the "inboxes" table.
+	InboxesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "source", Type: field.TypeString, Size: 255},
+		{Name: "message_id", Type: field.TypeString, Size: 255},
+		{Name: "event_type", Type: field.TypeString, Size: 255},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "received_at", Type: field.TypeTime, Nullable: true},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// InboxesTable holds the schema information for the "inboxes" table.
+	InboxesTable = &schema.Table{
+		Name:       "inboxes",
+		Columns:    InboxesColumns,
+		PrimaryKey: []*schema.Column{InboxesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "inbox_source_message_id",
+				Unique:  true,
+				Columns: []*schema.Column{InboxesColumns[1], InboxesColumns[2]},
+			},
+			{
+				Name:    "inbox_event_type",
+				Unique:  false,
+				Columns: []*schema.Column{InboxesColumns[3]},
+			},
+			{
+				Name:    "inbox_processed_at",
+				Unique:  false,
+				Columns: []*schema.Column{InboxesColumns[6]},
+			},
+		},
+	}
 	// IndividualsColumns holds the columns for the "individuals" table.
 	IndividualsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 36},
@@ -180,9 +444,57 @@ var (
 			},
 		},
 	}
+	// MaintenanceWindowsColumns holds the columns for the "maintenance_windows" table.
+	MaintenanceWindowsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "type", Type: field.TypeString, Size: 50},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Size: 50, Default: "scheduled"},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "canceled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "car_id", Type: field.TypeString, Size: 36},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+	}
+	// MaintenanceWindowsTable holds the schema information for the "maintenance_windows" table.
+	MaintenanceWindowsTable = &schema.Table{
+		Name:       "maintenance_windows",
+		Columns:    MaintenanceWindowsColumns,
+		PrimaryKey: []*schema.Column{MaintenanceWindowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "maintenance_windows_cars_maintenance_windows",
+				Columns:    []*schema.Column{MaintenanceWindowsColumns[10]},
+				RefColumns: []*schema.Column{CarsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "maintenance_windows_tenants_maintenance_windows",
+				Columns:    []*schema.Column{MaintenanceWindowsColumns[11]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "maintenancewindow_car_id_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{MaintenanceWindowsColumns[10], MaintenanceWindowsColumns[3]},
+			},
+			{
+				Name:    "maintenancewindow_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{MaintenanceWindowsColumns[11]},
+			},
+		},
+	}
 	// OutboxesColumns holds the columns for the "outboxes" table.
 	OutboxesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "tenant_id", Type: field.TypeString, Nullable: true, Size: 36},
 		{Name: "aggregate_type", Type: field.TypeString, Size: 255},
 		{Name: "aggregate_id", Type: field.TypeString, Size: 36},
 		{Name: "event_type", Type: field.TypeString, Size: 255},
@@ -191,6 +503,8 @@ var (
 		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status", Type: field.TypeString, Size: 50, Default: "pending"},
 		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
 		{Name: "version", Type: field.TypeInt64, Default: 1},
 		{Name: "locked_at", Type: field.TypeTime, Nullable: true},
 		{Name: "locked_by", Type: field.TypeString, Nullable: true},
@@ -204,38 +518,76 @@ var (
 			{
 				Name:    "outbox_status",
 				Unique:  false,
-				Columns: []*schema.Column{OutboxesColumns[7]},
+				Columns: []*schema.Column{OutboxesColumns[8]},
+			},
+			{
+				Name:    "outbox_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxesColumns[8], OutboxesColumns[11]},
+			},
+			{
+				Name:    "outbox_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxesColumns[1], OutboxesColumns[6]},
 			},
 			{
 				Name:    "outbox_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{OutboxesColumns[5]},
+				Columns: []*schema.Column{OutboxesColumns[6]},
 			},
 			{
 				Name:    "outbox_processed_at",
 				Unique:  false,
-				Columns: []*schema.Column{OutboxesColumns[6]},
+				Columns: []*schema.Column{OutboxesColumns[7]},
 			},
 			{
 				Name:    "outbox_version",
 				Unique:  false,
-				Columns: []*schema.Column{OutboxesColumns[9]},
+				Columns: []*schema.Column{OutboxesColumns[12]},
 			},
 			{
 				Name:    "outbox_locked_at_locked_by",
 				Unique:  false,
-				Columns: []*schema.Column{OutboxesColumns[10], OutboxesColumns[11]},
+				Columns: []*schema.Column{OutboxesColumns[13], OutboxesColumns[14]},
+			},
+		},
+	}
+	// PlansColumns holds the columns for the "plans" table.
+	PlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "code", Type: field.TypeString, Size: 50},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "max_cars", Type: field.TypeInt, Default: 0},
+		{Name: "max_renters", Type: field.TypeInt, Default: 0},
+		{Name: "max_monthly_rentals", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PlansTable holds the schema information for the "plans" table.
+	PlansTable = &schema.Table{
+		Name:       "plans",
+		Columns:    PlansColumns,
+		PrimaryKey: []*schema.Column{PlansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "plan_code",
+				Unique:  true,
+				Columns: []*schema.Column{PlansColumns[1]},
 			},
 		},
 	}
 	// RentalsColumns holds the columns for the "rentals" table.
 	RentalsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "owner_tenant_id", Type: field.TypeString, Nullable: true, Size: 36},
 		{Name: "starts_at", Type: field.TypeTime, Nullable: true},
 		{Name: "ends_at", Type: field.TypeTime, Nullable: true},
+		{Name: "returned_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "pickup_branch_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "return_branch_id", Type: field.TypeString, Nullable: true, Size: 36},
 		{Name: "car_id", Type: field.TypeString, Size: 36},
 		{Name: "renter_id", Type: field.TypeString, Size: 36},
 		{Name: "tenant_id", Type: field.TypeString, Size: 36},
@@ -246,21 +598,33 @@ var (
 		Columns:    RentalsColumns,
 		PrimaryKey: []*schema.Column{RentalsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rentals_branches_pickup_rentals",
+				Columns:    []*schema.Column{RentalsColumns[8]},
+				RefColumns: []*schema.Column{BranchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "rentals_branches_return_rentals",
+				Columns:    []*schema.Column{RentalsColumns[9]},
+				RefColumns: []*schema.Column{BranchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "rentals_cars_rentals",
-				Columns:    []*schema.Column{RentalsColumns[6]},
+				Columns:    []*schema.Column{RentalsColumns[10]},
 				RefColumns: []*schema.Column{CarsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "rentals_renters_rentals",
-				Columns:    []*schema.Column{RentalsColumns[7]},
+				Columns:    []*schema.Column{RentalsColumns[11]},
 				RefColumns: []*schema.Column{RentersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "rentals_tenants_rentals",
-				Columns:    []*schema.Column{RentalsColumns[8]},
+				Columns:    []*schema.Column{RentalsColumns[12]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -269,22 +633,73 @@ var (
 			{
 				Name:    "rental_car_id",
 				Unique:  false,
-				Columns: []*schema.Column{RentalsColumns[6]},
+				Columns: []*schema.Column{RentalsColumns[10]},
 			},
 			{
 				Name:    "rental_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{RentalsColumns[5]},
+				Columns: []*schema.Column{RentalsColumns[7]},
 			},
 			{
 				Name:    "rental_renter_id",
 				Unique:  false,
-				Columns: []*schema.Column{RentalsColumns[7]},
+				Columns: []*schema.Column{RentalsColumns[11]},
 			},
 			{
 				Name:    "rental_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{RentalsColumns[8]},
+				Columns: []*schema.Column{RentalsColumns[12]},
+			},
+			{
+				Name:    "rental_owner_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{RentalsColumns[1]},
+			},
+		},
+	}
+	// RentalHandoversColumns holds the columns for the "rental_handovers" table.
+	RentalHandoversColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "kind", Type: field.TypeString, Size: 50},
+		{Name: "odometer_km", Type: field.TypeInt},
+		{Name: "fuel_level", Type: field.TypeInt},
+		{Name: "damages", Type: field.TypeJSON, Nullable: true},
+		{Name: "distance_km", Type: field.TypeInt, Default: 0},
+		{Name: "fuel_shortfall", Type: field.TypeInt, Default: 0},
+		{Name: "inspected_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rental_id", Type: field.TypeString, Size: 36},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+	}
+	// RentalHandoversTable holds the schema information for the "rental_handovers" table.
+	RentalHandoversTable = &schema.Table{
+		Name:       "rental_handovers",
+		Columns:    RentalHandoversColumns,
+		PrimaryKey: []*schema.Column{RentalHandoversColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rental_handovers_rentals_handovers",
+				Columns:    []*schema.Column{RentalHandoversColumns[9]},
+				RefColumns: []*schema.Column{RentalsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "rental_handovers_tenants_rental_handovers",
+				Columns:    []*schema.Column{RentalHandoversColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rentalhandover_rental_id_kind",
+				Unique:  true,
+				Columns: []*schema.Column{RentalHandoversColumns[9], RentalHandoversColumns[1]},
+			},
+			{
+				Name:    "rentalhandover_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{RentalHandoversColumns[10]},
 			},
 		},
 	}
@@ -395,15 +810,35 @@ var (
 	TenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Size: 36},
 		{Name: "code", Type: field.TypeString, Size: 50},
+		{Name: "status", Type: field.TypeString, Size: 50, Default: "active"},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "isolation", Type: field.TypeString, Size: 20, Default: "shared"},
+		{Name: "purge_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "plan_id", Type: field.TypeString, Nullable: true, Size: 36},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true, Size: 36},
 	}
 	// TenantsTable holds the schema information for the "tenants" table.
 	TenantsTable = &schema.Table{
 		Name:       "tenants",
 		Columns:    TenantsColumns,
 		PrimaryKey: []*schema.Column{TenantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenants_plans_tenants",
+				Columns:    []*schema.Column{TenantsColumns[9]},
+				RefColumns: []*schema.Column{PlansColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tenants_tenants_children",
+				Columns:    []*schema.Column{TenantsColumns[10]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "tenant_code",
@@ -413,38 +848,251 @@ var (
 			{
 				Name:    "tenant_deleted_at",
 				Unique:  false,
-				Columns: []*schema.Column{TenantsColumns[4]},
+				Columns: []*schema.Column{TenantsColumns[8]},
+			},
+			{
+				Name:    "tenant_plan_id",
+				Unique:  false,
+				Columns: []*schema.Column{TenantsColumns[9]},
+			},
+			{
+				Name:    "tenant_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{TenantsColumns[10]},
+			},
+			{
+				Name:    "tenant_purge_at",
+				Unique:  false,
+				Columns: []*schema.Column{TenantsColumns[5]},
+			},
+		},
+	}
+	// TenantSettingsColumns holds the columns for the "tenant_settings" table.
+	TenantSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "timezone", Type: field.TypeString, Size: 64},
+		{Name: "currency", Type: field.TypeString, Size: 3},
+		{Name: "locale", Type: field.TypeString, Size: 35},
+		{Name: "business_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString, Unique: true, Size: 36},
+	}
+	// TenantSettingsTable holds the schema information for the "tenant_settings" table.
+	TenantSettingsTable = &schema.Table{
+		Name:       "tenant_settings",
+		Columns:    TenantSettingsColumns,
+		PrimaryKey: []*schema.Column{TenantSettingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tenant_settings_tenants_settings",
+				Columns:    []*schema.Column{TenantSettingsColumns[7]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// UsageRecordsColumns holds the columns for the "usage_records" table.
+	UsageRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+		{Name: "meter", Type: field.TypeString, Size: 50},
+		{Name: "quantity", Type: field.TypeInt64},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "recorded_at", Type: field.TypeTime, Nullable: true},
+	}
+	// UsageRecordsTable holds the schema information for the "usage_records" table.
+	UsageRecordsTable = &schema.Table{
+		Name:       "usage_records",
+		Columns:    UsageRecordsColumns,
+		PrimaryKey: []*schema.Column{UsageRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagerecord_recorded_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[5]},
+			},
+		},
+	}
+	// UsageRollupsColumns holds the columns for the "usage_rollups" table.
+	UsageRollupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+		{Name: "meter", Type: field.TypeString, Size: 50},
+		{Name: "period", Type: field.TypeString, Size: 10},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "quantity", Type: field.TypeInt64, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// UsageRollupsTable holds the schema information for the "usage_rollups" table.
+	UsageRollupsTable = &schema.Table{
+		Name:       "usage_rollups",
+		Columns:    UsageRollupsColumns,
+		PrimaryKey: []*schema.Column{UsageRollupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagerollup_tenant_id_period_period_start_meter",
+				Unique:  true,
+				Columns: []*schema.Column{UsageRollupsColumns[1], UsageRollupsColumns[3], UsageRollupsColumns[4], UsageRollupsColumns[2]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "event_id", Type: field.TypeString, Size: 36},
+		{Name: "event_type", Type: field.TypeString, Size: 255},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString, Size: 50, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+		{Name: "endpoint_id", Type: field.TypeString, Size: 36},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_tenants_webhook_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "webhook_deliveries_webhook_endpoints_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[13]},
+				RefColumns: []*schema.Column{WebhookEndpointsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_endpoint_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[13], WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4], WebhookDeliveriesColumns[6]},
+			},
+			{
+				Name:    "webhookdelivery_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[10]},
+			},
+		},
+	}
+	// WebhookEndpointsColumns holds the columns for the "webhook_endpoints" table.
+	WebhookEndpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString, Size: 255},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "disabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "disabled_reason", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString, Size: 36},
+	}
+	// WebhookEndpointsTable holds the schema information for the "webhook_endpoints" table.
+	WebhookEndpointsTable = &schema.Table{
+		Name:       "webhook_endpoints",
+		Columns:    WebhookEndpointsColumns,
+		PrimaryKey: []*schema.Column{WebhookEndpointsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_endpoints_tenants_webhook_endpoints",
+				Columns:    []*schema.Column{WebhookEndpointsColumns[12]},
+				RefColumns: []*schema.Column{TenantsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookendpoint_tenant_id_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEndpointsColumns[12], WebhookEndpointsColumns[5]},
+			},
+			{
+				Name:    "webhookendpoint_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEndpointsColumns[11]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		ArchiveJobsTable,
+		BranchesTable,
 		CarsTable,
+		CarModelsTable,
 		CarOptionsTable,
 		CompaniesTable,
+		FleetSharingAgreementsTable,
+		InboxesTable,
 		IndividualsTable,
+		MaintenanceWindowsTable,
 		OutboxesTable,
+		PlansTable,
 		RentalsTable,
+		RentalHandoversTable,
 		RentalOptionsTable,
 		RentersTable,
 		TenantsTable,
+		TenantSettingsTable,
+		UsageRecordsTable,
+		UsageRollupsTable,
+		WebhookDeliveriesTable,
+		WebhookEndpointsTable,
 	}
 )
 
 func init() {
-	CarsTable.ForeignKeys[0].RefTable = TenantsTable
+	APIKeysTable.ForeignKeys[0].RefTable = TenantsTable
+	BranchesTable.ForeignKeys[0].RefTable = TenantsTable
+	CarsTable.ForeignKeys[0].RefTable = BranchesTable
+	CarsTable.ForeignKeys[1].RefTable = BranchesTable
+	CarsTable.ForeignKeys[2].RefTable = CarModelsTable
+	CarsTable.ForeignKeys[3].RefTable = TenantsTable
+	CarModelsTable.ForeignKeys[0].RefTable = TenantsTable
 	CarOptionsTable.ForeignKeys[0].RefTable = TenantsTable
 	CompaniesTable.ForeignKeys[0].RefTable = RentersTable
 	CompaniesTable.ForeignKeys[1].RefTable = TenantsTable
 	IndividualsTable.ForeignKeys[0].RefTable = RentersTable
 	IndividualsTable.ForeignKeys[1].RefTable = TenantsTable
-	RentalsTable.ForeignKeys[0].RefTable = CarsTable
-	RentalsTable.ForeignKeys[1].RefTable = RentersTable
-	RentalsTable.ForeignKeys[2].RefTable = TenantsTable
+	MaintenanceWindowsTable.ForeignKeys[0].RefTable = CarsTable
+	MaintenanceWindowsTable.ForeignKeys[1].RefTable = TenantsTable
+	RentalsTable.ForeignKeys[0].RefTable = BranchesTable
+	RentalsTable.ForeignKeys[1].RefTable = BranchesTable
+	RentalsTable.ForeignKeys[2].RefTable = CarsTable
+	RentalsTable.ForeignKeys[3].RefTable = RentersTable
+	RentalsTable.ForeignKeys[4].RefTable = TenantsTable
+	RentalHandoversTable.ForeignKeys[0].RefTable = RentalsTable
+	RentalHandoversTable.ForeignKeys[1].RefTable = TenantsTable
 	RentalOptionsTable.ForeignKeys[0].RefTable = CarOptionsTable
 	RentalOptionsTable.ForeignKeys[1].RefTable = RentalsTable
 	RentalOptionsTable.ForeignKeys[2].RefTable = TenantsTable
 	RentersTable.ForeignKeys[0].RefTable = CompaniesTable
 	RentersTable.ForeignKeys[1].RefTable = IndividualsTable
 	RentersTable.ForeignKeys[2].RefTable = TenantsTable
+	TenantsTable.ForeignKeys[0].RefTable = PlansTable
+	TenantsTable.ForeignKeys[1].RefTable = TenantsTable
+	TenantSettingsTable.ForeignKeys[0].RefTable = TenantsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = TenantsTable
+	WebhookDeliveriesTable.ForeignKeys[1].RefTable = WebhookEndpointsTable
+	WebhookEndpointsTable.ForeignKeys[0].RefTable = TenantsTable
 }
@@ -11,16 +11,31 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/apikey"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/archivejob"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/caroption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/company"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/inbox"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/individual"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/outbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerecord"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerollup"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookdelivery"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookendpoint"
 )
 
 const (
@@ -32,49 +47,66 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCar          = "Car"
-	TypeCarOption    = "CarOption"
-	TypeCompany      = "Company"
-	TypeIndividual   = "Individual"
-	TypeOutbox       = "Outbox"
-	TypeRental       = "Rental"
-	TypeRentalOption = "RentalOption"
-	TypeRenter       = "Renter"
-	TypeTenant       = "Tenant"
+	TypeAPIKey                = "APIKey"
+	TypeArchiveJob            = "ArchiveJob"
+	TypeBranch                = "Branch"
+	TypeCar                   = "Car"
+	TypeCarModel              = "CarModel"
+	TypeCarOption             = "CarOption"
+	TypeCompany               = "Company"
+	TypeFleetSharingAgreement = "FleetSharingAgreement"
+	TypeInbox                 = "Inbox"
+	TypeIndividual            = "Individual"
+	TypeMaintenanceWindow     = "MaintenanceWindow"
+	TypeOutbox                = "Outbox"
+	TypePlan                  = "Plan"
+	TypeRental                = "Rental"
+	TypeRentalHandover        = "RentalHandover"
+	TypeRentalOption          = "RentalOption"
+	TypeRenter                = "Renter"
+	TypeTenant                = "Tenant"
+	TypeTenantSetting         = "TenantSetting"
+	TypeUsageRecord           = "UsageRecord"
+	TypeUsageRollup           = "UsageRollup"
+	TypeWebhookDelivery       = "WebhookDelivery"
+	TypeWebhookEndpoint       = "WebhookEndpoint"
 )
 
-// CarMutation represents an operation that mutates the Car nodes in the graph.
-type CarMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *string
-	model          *string
-	created_at     *time.Time
-	updated_at     *time.Time
-	deleted_at     *time.Time
-	clearedFields  map[string]struct{}
-	tenant         *string
-	clearedtenant  bool
-	rentals        map[string]struct{}
-	removedrentals map[string]struct{}
-	clearedrentals bool
-	done           bool
-	oldValue       func(context.Context) (*Car, error)
-	predicates     []predicate.Car
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	prefix        *string
+	secret_hash   *string
+	scopes        *[]string
+	appendscopes  []string
+	expires_at    *time.Time
+	last_used_at  *time.Time
+	revoked_at    *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	tenant        *string
+	clearedtenant bool
+	done          bool
+	oldValue      func(context.Context) (*APIKey, error)
+	predicates    []predicate.APIKey
 }
 
-var _ ent.Mutation = (*CarMutation)(nil)
+var _ ent.Mutation = (*APIKeyMutation)(nil)
 
-// carOption allows management of the mutation configuration using functional options.
-type carOption func(*CarMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
 
-// newCarMutation creates new mutation for the Car entity.
-func newCarMutation(c config, op Op, opts ...carOption) *CarMutation {
-	m := &CarMutation{
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCar,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -83,20 +115,20 @@ func newCarMutation(c config, op Op, opts ...carOption) *CarMutation {
 	return m
 }
 
-// withCarID sets the ID field of the mutation.
-func withCarID(id string) carOption {
-	return func(m *CarMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id string) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Car
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Car, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Car.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -105,10 +137,10 @@ func withCarID(id string) carOption {
 	}
 }
 
-// withCar sets the old Car of the mutation.
-func withCar(node *Car) carOption {
-	return func(m *CarMutation) {
-		m.oldValue = func(context.Context) (*Car, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -117,7 +149,7 @@ func withCar(node *Car) carOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CarMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -125,7 +157,7 @@ func (m CarMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CarMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("entgen: mutation is not running in a transaction")
 	}
@@ -135,14 +167,14 @@ func (m CarMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Car entities.
-func (m *CarMutation) SetID(id string) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CarMutation) ID() (id string, exists bool) {
+func (m *APIKeyMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -153,7 +185,7 @@ func (m *CarMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CarMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -162,19 +194,19 @@ func (m *CarMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Car.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *CarMutation) SetTenantID(s string) {
+func (m *APIKeyMutation) SetTenantID(s string) {
 	m.tenant = &s
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *CarMutation) TenantID() (r string, exists bool) {
+func (m *APIKeyMutation) TenantID() (r string, exists bool) {
 	v := m.tenant
 	if v == nil {
 		return
@@ -182,10 +214,10 @@ func (m *CarMutation) TenantID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Car entity.
-// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -200,323 +232,519 @@ func (m *CarMutation) OldTenantID(ctx context.Context) (v string, err error) {
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *CarMutation) ResetTenantID() {
+func (m *APIKeyMutation) ResetTenantID() {
 	m.tenant = nil
 }
 
-// SetModel sets the "model" field.
-func (m *CarMutation) SetModel(s string) {
-	m.model = &s
+// SetName sets the "name" field.
+func (m *APIKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *CarMutation) Model() (r string, exists bool) {
-	v := m.model
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModel returns the old "model" field's value of the Car entity.
-// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarMutation) OldModel(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Model, nil
+	return oldValue.Name, nil
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *CarMutation) ResetModel() {
-	m.model = nil
+// ResetName resets all changes to the "name" field.
+func (m *APIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CarMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CarMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Car entity.
-// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Prefix, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *CarMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[car.FieldCreatedAt] = struct{}{}
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *CarMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[car.FieldCreatedAt]
-	return ok
+// SetSecretHash sets the "secret_hash" field.
+func (m *APIKeyMutation) SetSecretHash(s string) {
+	m.secret_hash = &s
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CarMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, car.FieldCreatedAt)
+// SecretHash returns the value of the "secret_hash" field in the mutation.
+func (m *APIKeyMutation) SecretHash() (r string, exists bool) {
+	v := m.secret_hash
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CarMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// OldSecretHash returns the old "secret_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldSecretHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecretHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecretHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecretHash: %w", err)
+	}
+	return oldValue.SecretHash, nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CarMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetSecretHash resets all changes to the "secret_hash" field.
+func (m *APIKeyMutation) ResetSecretHash() {
+	m.secret_hash = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Car entity.
-// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Scopes, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *CarMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[car.FieldUpdatedAt] = struct{}{}
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *CarMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[car.FieldUpdatedAt]
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *APIKeyMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[apikey.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *APIKeyMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldScopes]
 	return ok
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CarMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, car.FieldUpdatedAt)
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, apikey.FieldScopes)
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *CarMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *APIKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *CarMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APIKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Car entity.
-// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *APIKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *CarMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[car.FieldDeletedAt] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *APIKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apikey.FieldExpiresAt] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *CarMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[car.FieldDeletedAt]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *APIKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldExpiresAt]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *CarMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, car.FieldDeletedAt)
-}
-
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *CarMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[car.FieldTenantID] = struct{}{}
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APIKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apikey.FieldExpiresAt)
 }
 
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *CarMutation) TenantCleared() bool {
-	return m.clearedtenant
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *CarMutation) TenantIDs() (ids []string) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *CarMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
+	return *v, true
 }
 
-// AddRentalIDs adds the "rentals" edge to the Rental entity by ids.
-func (m *CarMutation) AddRentalIDs(ids ...string) {
-	if m.rentals == nil {
-		m.rentals = make(map[string]struct{})
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.rentals[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearRentals clears the "rentals" edge to the Rental entity.
-func (m *CarMutation) ClearRentals() {
-	m.clearedrentals = true
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// RentalsCleared reports if the "rentals" edge to the Rental entity was cleared.
-func (m *CarMutation) RentalsCleared() bool {
-	return m.clearedrentals
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
 }
 
-// RemoveRentalIDs removes the "rentals" edge to the Rental entity by IDs.
-func (m *CarMutation) RemoveRentalIDs(ids ...string) {
-	if m.removedrentals == nil {
-		m.removedrentals = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.rentals, ids[i])
-		m.removedrentals[ids[i]] = struct{}{}
-	}
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// RemovedRentals returns the removed IDs of the "rentals" edge to the Rental entity.
-func (m *CarMutation) RemovedRentalsIDs() (ids []string) {
-	for id := range m.removedrentals {
-		ids = append(ids, id)
-	}
-	return
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// RentalsIDs returns the "rentals" edge IDs in the mutation.
-func (m *CarMutation) RentalsIDs() (ids []string) {
-	for id := range m.rentals {
-		ids = append(ids, id)
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetRentals resets all changes to the "rentals" edge.
-func (m *CarMutation) ResetRentals() {
-	m.rentals = nil
-	m.clearedrentals = false
-	m.removedrentals = nil
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
 }
 
-// Where appends a list predicates to the CarMutation builder.
-func (m *CarMutation) Where(ps ...predicate.Car) {
-	m.predicates = append(m.predicates, ps...)
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the CarMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CarMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Car, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *CarMutation) Op() Op {
-	return m.op
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CarMutation) SetOp(op Op) {
-	m.op = op
+// SetCreatedAt sets the "created_at" field.
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Type returns the node type of this mutation (Car).
-func (m *CarMutation) Type() string {
-	return m.typ
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *APIKeyMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[apikey.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *APIKeyMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, apikey.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *APIKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *APIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *APIKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *APIKeyMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[apikey.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *APIKeyMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *APIKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, apikey.FieldUpdatedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *APIKeyMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[apikey.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *APIKeyMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *APIKeyMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *APIKeyMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *APIKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *APIKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CarMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.tenant != nil {
-		fields = append(fields, car.FieldTenantID)
+		fields = append(fields, apikey.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.model != nil {
-		fields = append(fields, car.FieldModel)
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
+	}
+	if m.secret_hash != nil {
+		fields = append(fields, apikey.FieldSecretHash)
+	}
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, apikey.FieldExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, car.FieldCreatedAt)
+		fields = append(fields, apikey.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, car.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, car.FieldDeletedAt)
+		fields = append(fields, apikey.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -524,18 +752,28 @@ func (m *CarMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CarMutation) Field(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case car.FieldTenantID:
+	case apikey.FieldTenantID:
 		return m.TenantID()
-	case car.FieldModel:
-		return m.Model()
-	case car.FieldCreatedAt:
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldSecretHash:
+		return m.SecretHash()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
+	case apikey.FieldCreatedAt:
 		return m.CreatedAt()
-	case car.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case car.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -543,285 +781,335 @@ func (m *CarMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CarMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case car.FieldTenantID:
+	case apikey.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case car.FieldModel:
-		return m.OldModel(ctx)
-	case car.FieldCreatedAt:
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldSecretHash:
+		return m.OldSecretHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case apikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case car.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case car.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Car field %s", name)
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CarMutation) SetField(name string, value ent.Value) error {
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case car.FieldTenantID:
+	case apikey.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case car.FieldModel:
+	case apikey.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.SetName(v)
 		return nil
-	case car.FieldCreatedAt:
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apikey.FieldSecretHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretHash(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetExpiresAt(v)
 		return nil
-	case car.FieldUpdatedAt:
+	case apikey.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case car.FieldDeletedAt:
+	case apikey.FieldRevokedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetRevokedAt(v)
+		return nil
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case apikey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Car field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CarMutation) AddedFields() []string {
+func (m *APIKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CarMutation) AddedField(name string) (ent.Value, bool) {
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CarMutation) AddField(name string, value ent.Value) error {
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Car numeric field %s", name)
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CarMutation) ClearedFields() []string {
+func (m *APIKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(car.FieldCreatedAt) {
-		fields = append(fields, car.FieldCreatedAt)
+	if m.FieldCleared(apikey.FieldScopes) {
+		fields = append(fields, apikey.FieldScopes)
 	}
-	if m.FieldCleared(car.FieldUpdatedAt) {
-		fields = append(fields, car.FieldUpdatedAt)
+	if m.FieldCleared(apikey.FieldExpiresAt) {
+		fields = append(fields, apikey.FieldExpiresAt)
 	}
-	if m.FieldCleared(car.FieldDeletedAt) {
-		fields = append(fields, car.FieldDeletedAt)
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
+	}
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
+	}
+	if m.FieldCleared(apikey.FieldCreatedAt) {
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	if m.FieldCleared(apikey.FieldUpdatedAt) {
+		fields = append(fields, apikey.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CarMutation) FieldCleared(name string) bool {
+func (m *APIKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CarMutation) ClearField(name string) error {
+func (m *APIKeyMutation) ClearField(name string) error {
 	switch name {
-	case car.FieldCreatedAt:
+	case apikey.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case apikey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case apikey.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case car.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
-	case car.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Car nullable field %s", name)
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CarMutation) ResetField(name string) error {
+func (m *APIKeyMutation) ResetField(name string) error {
 	switch name {
-	case car.FieldTenantID:
+	case apikey.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case car.FieldModel:
-		m.ResetModel()
+	case apikey.FieldName:
+		m.ResetName()
+		return nil
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case car.FieldCreatedAt:
+	case apikey.FieldSecretHash:
+		m.ResetSecretHash()
+		return nil
+	case apikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apikey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case apikey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case car.FieldUpdatedAt:
+	case apikey.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case car.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Car field %s", name)
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CarMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.tenant != nil {
-		edges = append(edges, car.EdgeTenant)
-	}
-	if m.rentals != nil {
-		edges = append(edges, car.EdgeRentals)
+		edges = append(edges, apikey.EdgeTenant)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CarMutation) AddedIDs(name string) []ent.Value {
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case car.EdgeTenant:
+	case apikey.EdgeTenant:
 		if id := m.tenant; id != nil {
 			return []ent.Value{*id}
 		}
-	case car.EdgeRentals:
-		ids := make([]ent.Value, 0, len(m.rentals))
-		for id := range m.rentals {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CarMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedrentals != nil {
-		edges = append(edges, car.EdgeRentals)
-	}
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CarMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case car.EdgeRentals:
-		ids := make([]ent.Value, 0, len(m.removedrentals))
-		for id := range m.removedrentals {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CarMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedtenant {
-		edges = append(edges, car.EdgeTenant)
-	}
-	if m.clearedrentals {
-		edges = append(edges, car.EdgeRentals)
+		edges = append(edges, apikey.EdgeTenant)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CarMutation) EdgeCleared(name string) bool {
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case car.EdgeTenant:
+	case apikey.EdgeTenant:
 		return m.clearedtenant
-	case car.EdgeRentals:
-		return m.clearedrentals
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CarMutation) ClearEdge(name string) error {
+func (m *APIKeyMutation) ClearEdge(name string) error {
 	switch name {
-	case car.EdgeTenant:
+	case apikey.EdgeTenant:
 		m.ClearTenant()
 		return nil
 	}
-	return fmt.Errorf("unknown Car unique edge %s", name)
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CarMutation) ResetEdge(name string) error {
+func (m *APIKeyMutation) ResetEdge(name string) error {
 	switch name {
-	case car.EdgeTenant:
+	case apikey.EdgeTenant:
 		m.ResetTenant()
 		return nil
-	case car.EdgeRentals:
-		m.ResetRentals()
-		return nil
 	}
-	return fmt.Errorf("unknown Car edge %s", name)
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// CarOptionMutation represents an operation that mutates the CarOption nodes in the graph.
-type CarOptionMutation struct {
+// ArchiveJobMutation represents an operation that mutates the ArchiveJob nodes in the graph.
+type ArchiveJobMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *string
-	name                  *string
-	created_at            *time.Time
-	updated_at            *time.Time
-	deleted_at            *time.Time
-	clearedFields         map[string]struct{}
-	tenant                *string
-	clearedtenant         bool
-	rental_options        map[string]struct{}
-	removedrental_options map[string]struct{}
-	clearedrental_options bool
-	done                  bool
-	oldValue              func(context.Context) (*CarOption, error)
-	predicates            []predicate.CarOption
+	op            Op
+	typ           string
+	id            *string
+	kind          *string
+	tenant_id     *string
+	archive       *string
+	status        *string
+	counts        *map[string]int
+	error         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	finished_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ArchiveJob, error)
+	predicates    []predicate.ArchiveJob
 }
 
-var _ ent.Mutation = (*CarOptionMutation)(nil)
+var _ ent.Mutation = (*ArchiveJobMutation)(nil)
 
-// caroptionOption allows management of the mutation configuration using functional options.
-type caroptionOption func(*CarOptionMutation)
+// archivejobOption allows management of the mutation configuration using functional options.
+type archivejobOption func(*ArchiveJobMutation)
 
-// newCarOptionMutation creates new mutation for the CarOption entity.
-func newCarOptionMutation(c config, op Op, opts ...caroptionOption) *CarOptionMutation {
-	m := &CarOptionMutation{
+// newArchiveJobMutation creates new mutation for the ArchiveJob entity.
+func newArchiveJobMutation(c config, op Op, opts ...archivejobOption) *ArchiveJobMutation {
+	m := &ArchiveJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCarOption,
+		typ:           TypeArchiveJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -830,20 +1118,20 @@ func newCarOptionMutation(c config, op Op, opts ...caroptionOption) *CarOptionMu
 	return m
 }
 
-// withCarOptionID sets the ID field of the mutation.
-func withCarOptionID(id string) caroptionOption {
-	return func(m *CarOptionMutation) {
+// withArchiveJobID sets the ID field of the mutation.
+func withArchiveJobID(id string) archivejobOption {
+	return func(m *ArchiveJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CarOption
+			value *ArchiveJob
 		)
-		m.oldValue = func(ctx context.Context) (*CarOption, error) {
+		m.oldValue = func(ctx context.Context) (*ArchiveJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CarOption.Get(ctx, id)
+					value, err = m.Client().ArchiveJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -852,10 +1140,10 @@ func withCarOptionID(id string) caroptionOption {
 	}
 }
 
-// withCarOption sets the old CarOption of the mutation.
-func withCarOption(node *CarOption) caroptionOption {
-	return func(m *CarOptionMutation) {
-		m.oldValue = func(context.Context) (*CarOption, error) {
+// withArchiveJob sets the old ArchiveJob of the mutation.
+func withArchiveJob(node *ArchiveJob) archivejobOption {
+	return func(m *ArchiveJobMutation) {
+		m.oldValue = func(context.Context) (*ArchiveJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -864,7 +1152,7 @@ func withCarOption(node *CarOption) caroptionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CarOptionMutation) Client() *Client {
+func (m ArchiveJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -872,7 +1160,7 @@ func (m CarOptionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CarOptionMutation) Tx() (*Tx, error) {
+func (m ArchiveJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("entgen: mutation is not running in a transaction")
 	}
@@ -882,14 +1170,14 @@ func (m CarOptionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CarOption entities.
-func (m *CarOptionMutation) SetID(id string) {
+// operation is only accepted on creation of ArchiveJob entities.
+func (m *ArchiveJobMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CarOptionMutation) ID() (id string, exists bool) {
+func (m *ArchiveJobMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -900,7 +1188,7 @@ func (m *CarOptionMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CarOptionMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ArchiveJobMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -909,30 +1197,66 @@ func (m *CarOptionMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CarOption.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ArchiveJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetKind sets the "kind" field.
+func (m *ArchiveJobMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ArchiveJobMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveJobMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ArchiveJobMutation) ResetKind() {
+	m.kind = nil
+}
+
 // SetTenantID sets the "tenant_id" field.
-func (m *CarOptionMutation) SetTenantID(s string) {
-	m.tenant = &s
+func (m *ArchiveJobMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *CarOptionMutation) TenantID() (r string, exists bool) {
-	v := m.tenant
+func (m *ArchiveJobMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the CarOption entity.
-// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarOptionMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *ArchiveJobMutation) OldTenantID(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -946,54 +1270,201 @@ func (m *CarOptionMutation) OldTenantID(ctx context.Context) (v string, err erro
 	return oldValue.TenantID, nil
 }
 
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *ArchiveJobMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.clearedFields[archivejob.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *ArchiveJobMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[archivejob.FieldTenantID]
+	return ok
+}
+
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *CarOptionMutation) ResetTenantID() {
-	m.tenant = nil
+func (m *ArchiveJobMutation) ResetTenantID() {
+	m.tenant_id = nil
+	delete(m.clearedFields, archivejob.FieldTenantID)
 }
 
-// SetName sets the "name" field.
-func (m *CarOptionMutation) SetName(s string) {
-	m.name = &s
+// SetArchive sets the "archive" field.
+func (m *ArchiveJobMutation) SetArchive(s string) {
+	m.archive = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CarOptionMutation) Name() (r string, exists bool) {
-	v := m.name
+// Archive returns the value of the "archive" field in the mutation.
+func (m *ArchiveJobMutation) Archive() (r string, exists bool) {
+	v := m.archive
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the CarOption entity.
-// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// OldArchive returns the old "archive" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarOptionMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ArchiveJobMutation) OldArchive(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldArchive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldArchive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldArchive: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Archive, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CarOptionMutation) ResetName() {
-	m.name = nil
+// ResetArchive resets all changes to the "archive" field.
+func (m *ArchiveJobMutation) ResetArchive() {
+	m.archive = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ArchiveJobMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ArchiveJobMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveJobMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ArchiveJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCounts sets the "counts" field.
+func (m *ArchiveJobMutation) SetCounts(value map[string]int) {
+	m.counts = &value
+}
+
+// Counts returns the value of the "counts" field in the mutation.
+func (m *ArchiveJobMutation) Counts() (r map[string]int, exists bool) {
+	v := m.counts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCounts returns the old "counts" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveJobMutation) OldCounts(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCounts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCounts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCounts: %w", err)
+	}
+	return oldValue.Counts, nil
+}
+
+// ClearCounts clears the value of the "counts" field.
+func (m *ArchiveJobMutation) ClearCounts() {
+	m.counts = nil
+	m.clearedFields[archivejob.FieldCounts] = struct{}{}
+}
+
+// CountsCleared returns if the "counts" field was cleared in this mutation.
+func (m *ArchiveJobMutation) CountsCleared() bool {
+	_, ok := m.clearedFields[archivejob.FieldCounts]
+	return ok
+}
+
+// ResetCounts resets all changes to the "counts" field.
+func (m *ArchiveJobMutation) ResetCounts() {
+	m.counts = nil
+	delete(m.clearedFields, archivejob.FieldCounts)
+}
+
+// SetError sets the "error" field.
+func (m *ArchiveJobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ArchiveJobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveJobMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ArchiveJobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[archivejob.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ArchiveJobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[archivejob.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ArchiveJobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, archivejob.FieldError)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CarOptionMutation) SetCreatedAt(t time.Time) {
+func (m *ArchiveJobMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CarOptionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ArchiveJobMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1001,10 +1472,10 @@ func (m *CarOptionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CarOption entity.
-// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarOptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchiveJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1019,30 +1490,30 @@ func (m *CarOptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ClearCreatedAt clears the value of the "created_at" field.
-func (m *CarOptionMutation) ClearCreatedAt() {
+func (m *ArchiveJobMutation) ClearCreatedAt() {
 	m.created_at = nil
-	m.clearedFields[caroption.FieldCreatedAt] = struct{}{}
+	m.clearedFields[archivejob.FieldCreatedAt] = struct{}{}
 }
 
 // CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *CarOptionMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[caroption.FieldCreatedAt]
+func (m *ArchiveJobMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[archivejob.FieldCreatedAt]
 	return ok
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CarOptionMutation) ResetCreatedAt() {
+func (m *ArchiveJobMutation) ResetCreatedAt() {
 	m.created_at = nil
-	delete(m.clearedFields, caroption.FieldCreatedAt)
+	delete(m.clearedFields, archivejob.FieldCreatedAt)
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *CarOptionMutation) SetUpdatedAt(t time.Time) {
+func (m *ArchiveJobMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CarOptionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ArchiveJobMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1050,10 +1521,10 @@ func (m *CarOptionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the CarOption entity.
-// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarOptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchiveJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1068,202 +1539,133 @@ func (m *CarOptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *CarOptionMutation) ClearUpdatedAt() {
+func (m *ArchiveJobMutation) ClearUpdatedAt() {
 	m.updated_at = nil
-	m.clearedFields[caroption.FieldUpdatedAt] = struct{}{}
+	m.clearedFields[archivejob.FieldUpdatedAt] = struct{}{}
 }
 
 // UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *CarOptionMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[caroption.FieldUpdatedAt]
+func (m *ArchiveJobMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[archivejob.FieldUpdatedAt]
 	return ok
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CarOptionMutation) ResetUpdatedAt() {
+func (m *ArchiveJobMutation) ResetUpdatedAt() {
 	m.updated_at = nil
-	delete(m.clearedFields, caroption.FieldUpdatedAt)
+	delete(m.clearedFields, archivejob.FieldUpdatedAt)
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *CarOptionMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetFinishedAt sets the "finished_at" field.
+func (m *ArchiveJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *CarOptionMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *ArchiveJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the CarOption entity.
-// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the ArchiveJob entity.
+// If the ArchiveJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CarOptionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ArchiveJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.FinishedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *CarOptionMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[caroption.FieldDeletedAt] = struct{}{}
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *ArchiveJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[archivejob.FieldFinishedAt] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *CarOptionMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[caroption.FieldDeletedAt]
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *ArchiveJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[archivejob.FieldFinishedAt]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *CarOptionMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, caroption.FieldDeletedAt)
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *ArchiveJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, archivejob.FieldFinishedAt)
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *CarOptionMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[caroption.FieldTenantID] = struct{}{}
+// Where appends a list predicates to the ArchiveJobMutation builder.
+func (m *ArchiveJobMutation) Where(ps ...predicate.ArchiveJob) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *CarOptionMutation) TenantCleared() bool {
-	return m.clearedtenant
+// WhereP appends storage-level predicates to the ArchiveJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ArchiveJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArchiveJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *CarOptionMutation) TenantIDs() (ids []string) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *CarOptionMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
-}
-
-// AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by ids.
-func (m *CarOptionMutation) AddRentalOptionIDs(ids ...string) {
-	if m.rental_options == nil {
-		m.rental_options = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.rental_options[ids[i]] = struct{}{}
-	}
-}
-
-// ClearRentalOptions clears the "rental_options" edge to the RentalOption entity.
-func (m *CarOptionMutation) ClearRentalOptions() {
-	m.clearedrental_options = true
-}
-
-// RentalOptionsCleared reports if the "rental_options" edge to the RentalOption entity was cleared.
-func (m *CarOptionMutation) RentalOptionsCleared() bool {
-	return m.clearedrental_options
-}
-
-// RemoveRentalOptionIDs removes the "rental_options" edge to the RentalOption entity by IDs.
-func (m *CarOptionMutation) RemoveRentalOptionIDs(ids ...string) {
-	if m.removedrental_options == nil {
-		m.removedrental_options = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.rental_options, ids[i])
-		m.removedrental_options[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedRentalOptions returns the removed IDs of the "rental_options" edge to the RentalOption entity.
-func (m *CarOptionMutation) RemovedRentalOptionsIDs() (ids []string) {
-	for id := range m.removedrental_options {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// RentalOptionsIDs returns the "rental_options" edge IDs in the mutation.
-func (m *CarOptionMutation) RentalOptionsIDs() (ids []string) {
-	for id := range m.rental_options {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetRentalOptions resets all changes to the "rental_options" edge.
-func (m *CarOptionMutation) ResetRentalOptions() {
-	m.rental_options = nil
-	m.clearedrental_options = false
-	m.removedrental_options = nil
-}
-
-// Where appends a list predicates to the CarOptionMutation builder.
-func (m *CarOptionMutation) Where(ps ...predicate.CarOption) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the CarOptionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CarOptionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CarOption, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *CarOptionMutation) Op() Op {
-	return m.op
+// Op returns the operation name.
+func (m *ArchiveJobMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CarOptionMutation) SetOp(op Op) {
+func (m *ArchiveJobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CarOption).
-func (m *CarOptionMutation) Type() string {
+// Type returns the node type of this mutation (ArchiveJob).
+func (m *ArchiveJobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CarOptionMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.tenant != nil {
-		fields = append(fields, caroption.FieldTenantID)
+func (m *ArchiveJobMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.kind != nil {
+		fields = append(fields, archivejob.FieldKind)
 	}
-	if m.name != nil {
-		fields = append(fields, caroption.FieldName)
+	if m.tenant_id != nil {
+		fields = append(fields, archivejob.FieldTenantID)
+	}
+	if m.archive != nil {
+		fields = append(fields, archivejob.FieldArchive)
+	}
+	if m.status != nil {
+		fields = append(fields, archivejob.FieldStatus)
+	}
+	if m.counts != nil {
+		fields = append(fields, archivejob.FieldCounts)
+	}
+	if m.error != nil {
+		fields = append(fields, archivejob.FieldError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, caroption.FieldCreatedAt)
+		fields = append(fields, archivejob.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, caroption.FieldUpdatedAt)
+		fields = append(fields, archivejob.FieldUpdatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, caroption.FieldDeletedAt)
+	if m.finished_at != nil {
+		fields = append(fields, archivejob.FieldFinishedAt)
 	}
 	return fields
 }
@@ -1271,18 +1673,26 @@ func (m *CarOptionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CarOptionMutation) Field(name string) (ent.Value, bool) {
+func (m *ArchiveJobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case caroption.FieldTenantID:
+	case archivejob.FieldKind:
+		return m.Kind()
+	case archivejob.FieldTenantID:
 		return m.TenantID()
-	case caroption.FieldName:
-		return m.Name()
-	case caroption.FieldCreatedAt:
+	case archivejob.FieldArchive:
+		return m.Archive()
+	case archivejob.FieldStatus:
+		return m.Status()
+	case archivejob.FieldCounts:
+		return m.Counts()
+	case archivejob.FieldError:
+		return m.Error()
+	case archivejob.FieldCreatedAt:
 		return m.CreatedAt()
-	case caroption.FieldUpdatedAt:
+	case archivejob.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case caroption.FieldDeletedAt:
-		return m.DeletedAt()
+	case archivejob.FieldFinishedAt:
+		return m.FinishedAt()
 	}
 	return nil, false
 }
@@ -1290,285 +1700,317 @@ func (m *CarOptionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CarOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArchiveJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case caroption.FieldTenantID:
+	case archivejob.FieldKind:
+		return m.OldKind(ctx)
+	case archivejob.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case caroption.FieldName:
-		return m.OldName(ctx)
-	case caroption.FieldCreatedAt:
+	case archivejob.FieldArchive:
+		return m.OldArchive(ctx)
+	case archivejob.FieldStatus:
+		return m.OldStatus(ctx)
+	case archivejob.FieldCounts:
+		return m.OldCounts(ctx)
+	case archivejob.FieldError:
+		return m.OldError(ctx)
+	case archivejob.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case caroption.FieldUpdatedAt:
+	case archivejob.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case caroption.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	case archivejob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CarOption field %s", name)
+	return nil, fmt.Errorf("unknown ArchiveJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CarOptionMutation) SetField(name string, value ent.Value) error {
+func (m *ArchiveJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case caroption.FieldTenantID:
+	case archivejob.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case archivejob.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case caroption.FieldName:
+	case archivejob.FieldArchive:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetArchive(v)
 		return nil
-	case caroption.FieldCreatedAt:
+	case archivejob.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case archivejob.FieldCounts:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCounts(v)
+		return nil
+	case archivejob.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case archivejob.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case caroption.FieldUpdatedAt:
+	case archivejob.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case caroption.FieldDeletedAt:
+	case archivejob.FieldFinishedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetFinishedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CarOption field %s", name)
+	return fmt.Errorf("unknown ArchiveJob field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CarOptionMutation) AddedFields() []string {
+func (m *ArchiveJobMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CarOptionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ArchiveJobMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CarOptionMutation) AddField(name string, value ent.Value) error {
+func (m *ArchiveJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown CarOption numeric field %s", name)
+	return fmt.Errorf("unknown ArchiveJob numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CarOptionMutation) ClearedFields() []string {
+func (m *ArchiveJobMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(caroption.FieldCreatedAt) {
-		fields = append(fields, caroption.FieldCreatedAt)
+	if m.FieldCleared(archivejob.FieldTenantID) {
+		fields = append(fields, archivejob.FieldTenantID)
 	}
-	if m.FieldCleared(caroption.FieldUpdatedAt) {
-		fields = append(fields, caroption.FieldUpdatedAt)
+	if m.FieldCleared(archivejob.FieldCounts) {
+		fields = append(fields, archivejob.FieldCounts)
 	}
-	if m.FieldCleared(caroption.FieldDeletedAt) {
-		fields = append(fields, caroption.FieldDeletedAt)
+	if m.FieldCleared(archivejob.FieldError) {
+		fields = append(fields, archivejob.FieldError)
+	}
+	if m.FieldCleared(archivejob.FieldCreatedAt) {
+		fields = append(fields, archivejob.FieldCreatedAt)
+	}
+	if m.FieldCleared(archivejob.FieldUpdatedAt) {
+		fields = append(fields, archivejob.FieldUpdatedAt)
+	}
+	if m.FieldCleared(archivejob.FieldFinishedAt) {
+		fields = append(fields, archivejob.FieldFinishedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CarOptionMutation) FieldCleared(name string) bool {
+func (m *ArchiveJobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CarOptionMutation) ClearField(name string) error {
+func (m *ArchiveJobMutation) ClearField(name string) error {
 	switch name {
-	case caroption.FieldCreatedAt:
+	case archivejob.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case archivejob.FieldCounts:
+		m.ClearCounts()
+		return nil
+	case archivejob.FieldError:
+		m.ClearError()
+		return nil
+	case archivejob.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case caroption.FieldUpdatedAt:
+	case archivejob.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
-	case caroption.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case archivejob.FieldFinishedAt:
+		m.ClearFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CarOption nullable field %s", name)
+	return fmt.Errorf("unknown ArchiveJob nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CarOptionMutation) ResetField(name string) error {
+func (m *ArchiveJobMutation) ResetField(name string) error {
 	switch name {
-	case caroption.FieldTenantID:
+	case archivejob.FieldKind:
+		m.ResetKind()
+		return nil
+	case archivejob.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case caroption.FieldName:
-		m.ResetName()
+	case archivejob.FieldArchive:
+		m.ResetArchive()
 		return nil
-	case caroption.FieldCreatedAt:
+	case archivejob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case archivejob.FieldCounts:
+		m.ResetCounts()
+		return nil
+	case archivejob.FieldError:
+		m.ResetError()
+		return nil
+	case archivejob.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case caroption.FieldUpdatedAt:
+	case archivejob.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case caroption.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case archivejob.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CarOption field %s", name)
+	return fmt.Errorf("unknown ArchiveJob field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CarOptionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.tenant != nil {
-		edges = append(edges, caroption.EdgeTenant)
-	}
-	if m.rental_options != nil {
-		edges = append(edges, caroption.EdgeRentalOptions)
-	}
+func (m *ArchiveJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CarOptionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case caroption.EdgeTenant:
-		if id := m.tenant; id != nil {
-			return []ent.Value{*id}
-		}
-	case caroption.EdgeRentalOptions:
-		ids := make([]ent.Value, 0, len(m.rental_options))
-		for id := range m.rental_options {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ArchiveJobMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CarOptionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedrental_options != nil {
-		edges = append(edges, caroption.EdgeRentalOptions)
-	}
+func (m *ArchiveJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CarOptionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case caroption.EdgeRentalOptions:
-		ids := make([]ent.Value, 0, len(m.removedrental_options))
-		for id := range m.removedrental_options {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ArchiveJobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CarOptionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtenant {
-		edges = append(edges, caroption.EdgeTenant)
-	}
-	if m.clearedrental_options {
-		edges = append(edges, caroption.EdgeRentalOptions)
-	}
+func (m *ArchiveJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CarOptionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case caroption.EdgeTenant:
-		return m.clearedtenant
-	case caroption.EdgeRentalOptions:
-		return m.clearedrental_options
-	}
+func (m *ArchiveJobMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CarOptionMutation) ClearEdge(name string) error {
-	switch name {
-	case caroption.EdgeTenant:
-		m.ClearTenant()
-		return nil
-	}
-	return fmt.Errorf("unknown CarOption unique edge %s", name)
+func (m *ArchiveJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ArchiveJob unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CarOptionMutation) ResetEdge(name string) error {
-	switch name {
-	case caroption.EdgeTenant:
-		m.ResetTenant()
-		return nil
-	case caroption.EdgeRentalOptions:
-		m.ResetRentalOptions()
-		return nil
-	}
-	return fmt.Errorf("unknown CarOption edge %s", name)
+func (m *ArchiveJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ArchiveJob edge %s", name)
 }
 
-// CompanyMutation represents an operation that mutates the Company nodes in the graph.
-type CompanyMutation struct {
+// BranchMutation represents an operation that mutates the Branch nodes in the graph.
+type BranchMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	name          *string
-	company_size  *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	tenant        *string
-	clearedtenant bool
-	renter        *string
-	clearedrenter bool
-	done          bool
-	oldValue      func(context.Context) (*Company, error)
-	predicates    []predicate.Company
+	op                    Op
+	typ                   string
+	id                    *string
+	name                  *string
+	street                *string
+	city                  *string
+	region                *string
+	postal_code           *string
+	country               *string
+	latitude              *float64
+	addlatitude           *float64
+	longitude             *float64
+	addlongitude          *float64
+	opening_hours         *[]schema.OpeningHours
+	appendopening_hours   []schema.OpeningHours
+	created_at            *time.Time
+	updated_at            *time.Time
+	deleted_at            *time.Time
+	clearedFields         map[string]struct{}
+	tenant                *string
+	clearedtenant         bool
+	home_cars             map[string]struct{}
+	removedhome_cars      map[string]struct{}
+	clearedhome_cars      bool
+	current_cars          map[string]struct{}
+	removedcurrent_cars   map[string]struct{}
+	clearedcurrent_cars   bool
+	pickup_rentals        map[string]struct{}
+	removedpickup_rentals map[string]struct{}
+	clearedpickup_rentals bool
+	return_rentals        map[string]struct{}
+	removedreturn_rentals map[string]struct{}
+	clearedreturn_rentals bool
+	done                  bool
+	oldValue              func(context.Context) (*Branch, error)
+	predicates            []predicate.Branch
 }
 
-var _ ent.Mutation = (*CompanyMutation)(nil)
+var _ ent.Mutation = (*BranchMutation)(nil)
 
-// companyOption allows management of the mutation configuration using functional options.
-type companyOption func(*CompanyMutation)
+// branchOption allows management of the mutation configuration using functional options.
+type branchOption func(*BranchMutation)
 
-// newCompanyMutation creates new mutation for the Company entity.
-func newCompanyMutation(c config, op Op, opts ...companyOption) *CompanyMutation {
-	m := &CompanyMutation{
+// newBranchMutation creates new mutation for the Branch entity.
+func newBranchMutation(c config, op Op, opts ...branchOption) *BranchMutation {
+	m := &BranchMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCompany,
+		typ:           TypeBranch,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1577,20 +2019,20 @@ func newCompanyMutation(c config, op Op, opts ...companyOption) *CompanyMutation
 	return m
 }
 
-// withCompanyID sets the ID field of the mutation.
-func withCompanyID(id string) companyOption {
-	return func(m *CompanyMutation) {
+// withBranchID sets the ID field of the mutation.
+func withBranchID(id string) branchOption {
+	return func(m *BranchMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Company
+			value *Branch
 		)
-		m.oldValue = func(ctx context.Context) (*Company, error) {
+		m.oldValue = func(ctx context.Context) (*Branch, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Company.Get(ctx, id)
+					value, err = m.Client().Branch.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1599,10 +2041,10 @@ func withCompanyID(id string) companyOption {
 	}
 }
 
-// withCompany sets the old Company of the mutation.
-func withCompany(node *Company) companyOption {
-	return func(m *CompanyMutation) {
-		m.oldValue = func(context.Context) (*Company, error) {
+// withBranch sets the old Branch of the mutation.
+func withBranch(node *Branch) branchOption {
+	return func(m *BranchMutation) {
+		m.oldValue = func(context.Context) (*Branch, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1611,7 +2053,7 @@ func withCompany(node *Company) companyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CompanyMutation) Client() *Client {
+func (m BranchMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1619,7 +2061,7 @@ func (m CompanyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CompanyMutation) Tx() (*Tx, error) {
+func (m BranchMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("entgen: mutation is not running in a transaction")
 	}
@@ -1629,14 +2071,14 @@ func (m CompanyMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Company entities.
-func (m *CompanyMutation) SetID(id string) {
+// operation is only accepted on creation of Branch entities.
+func (m *BranchMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CompanyMutation) ID() (id string, exists bool) {
+func (m *BranchMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1647,7 +2089,7 @@ func (m *CompanyMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CompanyMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *BranchMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1656,55 +2098,19 @@ func (m *CompanyMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Company.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Branch.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRenterID sets the "renter_id" field.
-func (m *CompanyMutation) SetRenterID(s string) {
-	m.renter = &s
-}
-
-// RenterID returns the value of the "renter_id" field in the mutation.
-func (m *CompanyMutation) RenterID() (r string, exists bool) {
-	v := m.renter
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRenterID returns the old "renter_id" field's value of the Company entity.
-// If the Company object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CompanyMutation) OldRenterID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRenterID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRenterID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRenterID: %w", err)
-	}
-	return oldValue.RenterID, nil
-}
-
-// ResetRenterID resets all changes to the "renter_id" field.
-func (m *CompanyMutation) ResetRenterID() {
-	m.renter = nil
-}
-
 // SetTenantID sets the "tenant_id" field.
-func (m *CompanyMutation) SetTenantID(s string) {
+func (m *BranchMutation) SetTenantID(s string) {
 	m.tenant = &s
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *CompanyMutation) TenantID() (r string, exists bool) {
+func (m *BranchMutation) TenantID() (r string, exists bool) {
 	v := m.tenant
 	if v == nil {
 		return
@@ -1712,10 +2118,10 @@ func (m *CompanyMutation) TenantID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Company entity.
-// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CompanyMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *BranchMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -1730,17 +2136,17 @@ func (m *CompanyMutation) OldTenantID(ctx context.Context) (v string, err error)
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *CompanyMutation) ResetTenantID() {
+func (m *BranchMutation) ResetTenantID() {
 	m.tenant = nil
 }
 
 // SetName sets the "name" field.
-func (m *CompanyMutation) SetName(s string) {
+func (m *BranchMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *CompanyMutation) Name() (r string, exists bool) {
+func (m *BranchMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1748,10 +2154,10 @@ func (m *CompanyMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Company entity.
-// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CompanyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BranchMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1766,1135 +2172,16952 @@ func (m *CompanyMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *CompanyMutation) ResetName() {
+func (m *BranchMutation) ResetName() {
 	m.name = nil
 }
 
-// SetCompanySize sets the "company_size" field.
-func (m *CompanyMutation) SetCompanySize(s string) {
-	m.company_size = &s
+// SetStreet sets the "street" field.
+func (m *BranchMutation) SetStreet(s string) {
+	m.street = &s
 }
 
-// CompanySize returns the value of the "company_size" field in the mutation.
-func (m *CompanyMutation) CompanySize() (r string, exists bool) {
-	v := m.company_size
+// Street returns the value of the "street" field in the mutation.
+func (m *BranchMutation) Street() (r string, exists bool) {
+	v := m.street
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompanySize returns the old "company_size" field's value of the Company entity.
-// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// OldStreet returns the old "street" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CompanyMutation) OldCompanySize(ctx context.Context) (v string, err error) {
+func (m *BranchMutation) OldStreet(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompanySize is only allowed on UpdateOne operations")
+		return v, errors.New("OldStreet is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompanySize requires an ID field in the mutation")
+		return v, errors.New("OldStreet requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompanySize: %w", err)
+		return v, fmt.Errorf("querying old value for OldStreet: %w", err)
 	}
-	return oldValue.CompanySize, nil
+	return oldValue.Street, nil
 }
 
-// ResetCompanySize resets all changes to the "company_size" field.
-func (m *CompanyMutation) ResetCompanySize() {
-	m.company_size = nil
+// ResetStreet resets all changes to the "street" field.
+func (m *BranchMutation) ResetStreet() {
+	m.street = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CompanyMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetCity sets the "city" field.
+func (m *BranchMutation) SetCity(s string) {
+	m.city = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CompanyMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// City returns the value of the "city" field in the mutation.
+func (m *BranchMutation) City() (r string, exists bool) {
+	v := m.city
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Company entity.
-// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// OldCity returns the old "city" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CompanyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BranchMutation) OldCity(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *CompanyMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[company.FieldCreatedAt] = struct{}{}
-}
-
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *CompanyMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[company.FieldCreatedAt]
-	return ok
+	return oldValue.City, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CompanyMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, company.FieldCreatedAt)
+// ResetCity resets all changes to the "city" field.
+func (m *BranchMutation) ResetCity() {
+	m.city = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CompanyMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetRegion sets the "region" field.
+func (m *BranchMutation) SetRegion(s string) {
+	m.region = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CompanyMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Region returns the value of the "region" field in the mutation.
+func (m *BranchMutation) Region() (r string, exists bool) {
+	v := m.region
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Company entity.
-// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// OldRegion returns the old "region" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CompanyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BranchMutation) OldRegion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRegion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Region, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *CompanyMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[company.FieldUpdatedAt] = struct{}{}
+// ClearRegion clears the value of the "region" field.
+func (m *BranchMutation) ClearRegion() {
+	m.region = nil
+	m.clearedFields[branch.FieldRegion] = struct{}{}
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *CompanyMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[company.FieldUpdatedAt]
+// RegionCleared returns if the "region" field was cleared in this mutation.
+func (m *BranchMutation) RegionCleared() bool {
+	_, ok := m.clearedFields[branch.FieldRegion]
 	return ok
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CompanyMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, company.FieldUpdatedAt)
+// ResetRegion resets all changes to the "region" field.
+func (m *BranchMutation) ResetRegion() {
+	m.region = nil
+	delete(m.clearedFields, branch.FieldRegion)
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *CompanyMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetPostalCode sets the "postal_code" field.
+func (m *BranchMutation) SetPostalCode(s string) {
+	m.postal_code = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *CompanyMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// PostalCode returns the value of the "postal_code" field in the mutation.
+func (m *BranchMutation) PostalCode() (r string, exists bool) {
+	v := m.postal_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Company entity.
-// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// OldPostalCode returns the old "postal_code" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CompanyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *BranchMutation) OldPostalCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPostalCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldPostalCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPostalCode: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.PostalCode, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *CompanyMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[company.FieldDeletedAt] = struct{}{}
+// ClearPostalCode clears the value of the "postal_code" field.
+func (m *BranchMutation) ClearPostalCode() {
+	m.postal_code = nil
+	m.clearedFields[branch.FieldPostalCode] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *CompanyMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[company.FieldDeletedAt]
+// PostalCodeCleared returns if the "postal_code" field was cleared in this mutation.
+func (m *BranchMutation) PostalCodeCleared() bool {
+	_, ok := m.clearedFields[branch.FieldPostalCode]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *CompanyMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, company.FieldDeletedAt)
+// ResetPostalCode resets all changes to the "postal_code" field.
+func (m *BranchMutation) ResetPostalCode() {
+	m.postal_code = nil
+	delete(m.clearedFields, branch.FieldPostalCode)
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *CompanyMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[company.FieldTenantID] = struct{}{}
+// SetCountry sets the "country" field.
+func (m *BranchMutation) SetCountry(s string) {
+	m.country = &s
 }
 
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *CompanyMutation) TenantCleared() bool {
-	return m.clearedtenant
+// Country returns the value of the "country" field in the mutation.
+func (m *BranchMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *CompanyMutation) TenantIDs() (ids []string) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
+// OldCountry returns the old "country" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BranchMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
 }
 
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *CompanyMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
+// ResetCountry resets all changes to the "country" field.
+func (m *BranchMutation) ResetCountry() {
+	m.country = nil
 }
 
-// ClearRenter clears the "renter" edge to the Renter entity.
-func (m *CompanyMutation) ClearRenter() {
-	m.clearedrenter = true
-	m.clearedFields[company.FieldRenterID] = struct{}{}
+// SetLatitude sets the "latitude" field.
+func (m *BranchMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
 }
 
-// RenterCleared reports if the "renter" edge to the Renter entity was cleared.
-func (m *CompanyMutation) RenterCleared() bool {
-	return m.clearedrenter
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *BranchMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RenterIDs returns the "renter" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RenterID instead. It exists only for internal usage by the builders.
-func (m *CompanyMutation) RenterIDs() (ids []string) {
-	if id := m.renter; id != nil {
-		ids = append(ids, *id)
+// OldLatitude returns the old "latitude" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BranchMutation) OldLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
 }
 
-// ResetRenter resets all changes to the "renter" edge.
-func (m *CompanyMutation) ResetRenter() {
-	m.renter = nil
-	m.clearedrenter = false
+// AddLatitude adds f to the "latitude" field.
+func (m *BranchMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
 }
 
-// Where appends a list predicates to the CompanyMutation builder.
-func (m *CompanyMutation) Where(ps ...predicate.Company) {
-	m.predicates = append(m.predicates, ps...)
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *BranchMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *BranchMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *BranchMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *BranchMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BranchMutation) OldLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (m *BranchMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
+}
+
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *BranchMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *BranchMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+}
+
+// SetOpeningHours sets the "opening_hours" field.
+func (m *BranchMutation) SetOpeningHours(sh []schema.OpeningHours) {
+	m.opening_hours = &sh
+	m.appendopening_hours = nil
+}
+
+// OpeningHours returns the value of the "opening_hours" field in the mutation.
+func (m *BranchMutation) OpeningHours() (r []schema.OpeningHours, exists bool) {
+	v := m.opening_hours
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpeningHours returns the old "opening_hours" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BranchMutation) OldOpeningHours(ctx context.Context) (v []schema.OpeningHours, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpeningHours is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpeningHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpeningHours: %w", err)
+	}
+	return oldValue.OpeningHours, nil
+}
+
+// AppendOpeningHours adds sh to the "opening_hours" field.
+func (m *BranchMutation) AppendOpeningHours(sh []schema.OpeningHours) {
+	m.appendopening_hours = append(m.appendopening_hours, sh...)
+}
+
+// AppendedOpeningHours returns the list of values that were appended to the "opening_hours" field in this mutation.
+func (m *BranchMutation) AppendedOpeningHours() ([]schema.OpeningHours, bool) {
+	if len(m.appendopening_hours) == 0 {
+		return nil, false
+	}
+	return m.appendopening_hours, true
+}
+
+// ClearOpeningHours clears the value of the "opening_hours" field.
+func (m *BranchMutation) ClearOpeningHours() {
+	m.opening_hours = nil
+	m.appendopening_hours = nil
+	m.clearedFields[branch.FieldOpeningHours] = struct{}{}
+}
+
+// OpeningHoursCleared returns if the "opening_hours" field was cleared in this mutation.
+func (m *BranchMutation) OpeningHoursCleared() bool {
+	_, ok := m.clearedFields[branch.FieldOpeningHours]
+	return ok
+}
+
+// ResetOpeningHours resets all changes to the "opening_hours" field.
+func (m *BranchMutation) ResetOpeningHours() {
+	m.opening_hours = nil
+	m.appendopening_hours = nil
+	delete(m.clearedFields, branch.FieldOpeningHours)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BranchMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BranchMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BranchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *BranchMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[branch.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *BranchMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[branch.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BranchMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, branch.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BranchMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BranchMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BranchMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *BranchMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[branch.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *BranchMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[branch.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BranchMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, branch.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *BranchMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *BranchMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Branch entity.
+// If the Branch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BranchMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *BranchMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[branch.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *BranchMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[branch.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *BranchMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, branch.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *BranchMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[branch.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *BranchMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *BranchMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *BranchMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// AddHomeCarIDs adds the "home_cars" edge to the Car entity by ids.
+func (m *BranchMutation) AddHomeCarIDs(ids ...string) {
+	if m.home_cars == nil {
+		m.home_cars = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.home_cars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHomeCars clears the "home_cars" edge to the Car entity.
+func (m *BranchMutation) ClearHomeCars() {
+	m.clearedhome_cars = true
+}
+
+// HomeCarsCleared reports if the "home_cars" edge to the Car entity was cleared.
+func (m *BranchMutation) HomeCarsCleared() bool {
+	return m.clearedhome_cars
+}
+
+// RemoveHomeCarIDs removes the "home_cars" edge to the Car entity by IDs.
+func (m *BranchMutation) RemoveHomeCarIDs(ids ...string) {
+	if m.removedhome_cars == nil {
+		m.removedhome_cars = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.home_cars, ids[i])
+		m.removedhome_cars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHomeCars returns the removed IDs of the "home_cars" edge to the Car entity.
+func (m *BranchMutation) RemovedHomeCarsIDs() (ids []string) {
+	for id := range m.removedhome_cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HomeCarsIDs returns the "home_cars" edge IDs in the mutation.
+func (m *BranchMutation) HomeCarsIDs() (ids []string) {
+	for id := range m.home_cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHomeCars resets all changes to the "home_cars" edge.
+func (m *BranchMutation) ResetHomeCars() {
+	m.home_cars = nil
+	m.clearedhome_cars = false
+	m.removedhome_cars = nil
+}
+
+// AddCurrentCarIDs adds the "current_cars" edge to the Car entity by ids.
+func (m *BranchMutation) AddCurrentCarIDs(ids ...string) {
+	if m.current_cars == nil {
+		m.current_cars = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.current_cars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCurrentCars clears the "current_cars" edge to the Car entity.
+func (m *BranchMutation) ClearCurrentCars() {
+	m.clearedcurrent_cars = true
+}
+
+// CurrentCarsCleared reports if the "current_cars" edge to the Car entity was cleared.
+func (m *BranchMutation) CurrentCarsCleared() bool {
+	return m.clearedcurrent_cars
+}
+
+// RemoveCurrentCarIDs removes the "current_cars" edge to the Car entity by IDs.
+func (m *BranchMutation) RemoveCurrentCarIDs(ids ...string) {
+	if m.removedcurrent_cars == nil {
+		m.removedcurrent_cars = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.current_cars, ids[i])
+		m.removedcurrent_cars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCurrentCars returns the removed IDs of the "current_cars" edge to the Car entity.
+func (m *BranchMutation) RemovedCurrentCarsIDs() (ids []string) {
+	for id := range m.removedcurrent_cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CurrentCarsIDs returns the "current_cars" edge IDs in the mutation.
+func (m *BranchMutation) CurrentCarsIDs() (ids []string) {
+	for id := range m.current_cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCurrentCars resets all changes to the "current_cars" edge.
+func (m *BranchMutation) ResetCurrentCars() {
+	m.current_cars = nil
+	m.clearedcurrent_cars = false
+	m.removedcurrent_cars = nil
+}
+
+// AddPickupRentalIDs adds the "pickup_rentals" edge to the Rental entity by ids.
+func (m *BranchMutation) AddPickupRentalIDs(ids ...string) {
+	if m.pickup_rentals == nil {
+		m.pickup_rentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.pickup_rentals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPickupRentals clears the "pickup_rentals" edge to the Rental entity.
+func (m *BranchMutation) ClearPickupRentals() {
+	m.clearedpickup_rentals = true
+}
+
+// PickupRentalsCleared reports if the "pickup_rentals" edge to the Rental entity was cleared.
+func (m *BranchMutation) PickupRentalsCleared() bool {
+	return m.clearedpickup_rentals
+}
+
+// RemovePickupRentalIDs removes the "pickup_rentals" edge to the Rental entity by IDs.
+func (m *BranchMutation) RemovePickupRentalIDs(ids ...string) {
+	if m.removedpickup_rentals == nil {
+		m.removedpickup_rentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.pickup_rentals, ids[i])
+		m.removedpickup_rentals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPickupRentals returns the removed IDs of the "pickup_rentals" edge to the Rental entity.
+func (m *BranchMutation) RemovedPickupRentalsIDs() (ids []string) {
+	for id := range m.removedpickup_rentals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PickupRentalsIDs returns the "pickup_rentals" edge IDs in the mutation.
+func (m *BranchMutation) PickupRentalsIDs() (ids []string) {
+	for id := range m.pickup_rentals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPickupRentals resets all changes to the "pickup_rentals" edge.
+func (m *BranchMutation) ResetPickupRentals() {
+	m.pickup_rentals = nil
+	m.clearedpickup_rentals = false
+	m.removedpickup_rentals = nil
+}
+
+// AddReturnRentalIDs adds the "return_rentals" edge to the Rental entity by ids.
+func (m *BranchMutation) AddReturnRentalIDs(ids ...string) {
+	if m.return_rentals == nil {
+		m.return_rentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.return_rentals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReturnRentals clears the "return_rentals" edge to the Rental entity.
+func (m *BranchMutation) ClearReturnRentals() {
+	m.clearedreturn_rentals = true
+}
+
+// ReturnRentalsCleared reports if the "return_rentals" edge to the Rental entity was cleared.
+func (m *BranchMutation) ReturnRentalsCleared() bool {
+	return m.clearedreturn_rentals
+}
+
+// RemoveReturnRentalIDs removes the "return_rentals" edge to the Rental entity by IDs.
+func (m *BranchMutation) RemoveReturnRentalIDs(ids ...string) {
+	if m.removedreturn_rentals == nil {
+		m.removedreturn_rentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.return_rentals, ids[i])
+		m.removedreturn_rentals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReturnRentals returns the removed IDs of the "return_rentals" edge to the Rental entity.
+func (m *BranchMutation) RemovedReturnRentalsIDs() (ids []string) {
+	for id := range m.removedreturn_rentals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReturnRentalsIDs returns the "return_rentals" edge IDs in the mutation.
+func (m *BranchMutation) ReturnRentalsIDs() (ids []string) {
+	for id := range m.return_rentals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReturnRentals resets all changes to the "return_rentals" edge.
+func (m *BranchMutation) ResetReturnRentals() {
+	m.return_rentals = nil
+	m.clearedreturn_rentals = false
+	m.removedreturn_rentals = nil
+}
+
+// Where appends a list predicates to the BranchMutation builder.
+func (m *BranchMutation) Where(ps ...predicate.Branch) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BranchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BranchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Branch, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BranchMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BranchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Branch).
+func (m *BranchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BranchMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.tenant != nil {
+		fields = append(fields, branch.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, branch.FieldName)
+	}
+	if m.street != nil {
+		fields = append(fields, branch.FieldStreet)
+	}
+	if m.city != nil {
+		fields = append(fields, branch.FieldCity)
+	}
+	if m.region != nil {
+		fields = append(fields, branch.FieldRegion)
+	}
+	if m.postal_code != nil {
+		fields = append(fields, branch.FieldPostalCode)
+	}
+	if m.country != nil {
+		fields = append(fields, branch.FieldCountry)
+	}
+	if m.latitude != nil {
+		fields = append(fields, branch.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, branch.FieldLongitude)
+	}
+	if m.opening_hours != nil {
+		fields = append(fields, branch.FieldOpeningHours)
+	}
+	if m.created_at != nil {
+		fields = append(fields, branch.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, branch.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, branch.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BranchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case branch.FieldTenantID:
+		return m.TenantID()
+	case branch.FieldName:
+		return m.Name()
+	case branch.FieldStreet:
+		return m.Street()
+	case branch.FieldCity:
+		return m.City()
+	case branch.FieldRegion:
+		return m.Region()
+	case branch.FieldPostalCode:
+		return m.PostalCode()
+	case branch.FieldCountry:
+		return m.Country()
+	case branch.FieldLatitude:
+		return m.Latitude()
+	case branch.FieldLongitude:
+		return m.Longitude()
+	case branch.FieldOpeningHours:
+		return m.OpeningHours()
+	case branch.FieldCreatedAt:
+		return m.CreatedAt()
+	case branch.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case branch.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BranchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case branch.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case branch.FieldName:
+		return m.OldName(ctx)
+	case branch.FieldStreet:
+		return m.OldStreet(ctx)
+	case branch.FieldCity:
+		return m.OldCity(ctx)
+	case branch.FieldRegion:
+		return m.OldRegion(ctx)
+	case branch.FieldPostalCode:
+		return m.OldPostalCode(ctx)
+	case branch.FieldCountry:
+		return m.OldCountry(ctx)
+	case branch.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case branch.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case branch.FieldOpeningHours:
+		return m.OldOpeningHours(ctx)
+	case branch.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case branch.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case branch.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Branch field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BranchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case branch.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case branch.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case branch.FieldStreet:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStreet(v)
+		return nil
+	case branch.FieldCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCity(v)
+		return nil
+	case branch.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case branch.FieldPostalCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostalCode(v)
+		return nil
+	case branch.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case branch.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case branch.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case branch.FieldOpeningHours:
+		v, ok := value.([]schema.OpeningHours)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpeningHours(v)
+		return nil
+	case branch.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case branch.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case branch.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Branch field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BranchMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatitude != nil {
+		fields = append(fields, branch.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, branch.FieldLongitude)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BranchMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case branch.FieldLatitude:
+		return m.AddedLatitude()
+	case branch.FieldLongitude:
+		return m.AddedLongitude()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BranchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case branch.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case branch.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Branch numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BranchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(branch.FieldRegion) {
+		fields = append(fields, branch.FieldRegion)
+	}
+	if m.FieldCleared(branch.FieldPostalCode) {
+		fields = append(fields, branch.FieldPostalCode)
+	}
+	if m.FieldCleared(branch.FieldOpeningHours) {
+		fields = append(fields, branch.FieldOpeningHours)
+	}
+	if m.FieldCleared(branch.FieldCreatedAt) {
+		fields = append(fields, branch.FieldCreatedAt)
+	}
+	if m.FieldCleared(branch.FieldUpdatedAt) {
+		fields = append(fields, branch.FieldUpdatedAt)
+	}
+	if m.FieldCleared(branch.FieldDeletedAt) {
+		fields = append(fields, branch.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BranchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BranchMutation) ClearField(name string) error {
+	switch name {
+	case branch.FieldRegion:
+		m.ClearRegion()
+		return nil
+	case branch.FieldPostalCode:
+		m.ClearPostalCode()
+		return nil
+	case branch.FieldOpeningHours:
+		m.ClearOpeningHours()
+		return nil
+	case branch.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case branch.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case branch.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Branch nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BranchMutation) ResetField(name string) error {
+	switch name {
+	case branch.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case branch.FieldName:
+		m.ResetName()
+		return nil
+	case branch.FieldStreet:
+		m.ResetStreet()
+		return nil
+	case branch.FieldCity:
+		m.ResetCity()
+		return nil
+	case branch.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case branch.FieldPostalCode:
+		m.ResetPostalCode()
+		return nil
+	case branch.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case branch.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case branch.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case branch.FieldOpeningHours:
+		m.ResetOpeningHours()
+		return nil
+	case branch.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case branch.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case branch.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Branch field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BranchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.tenant != nil {
+		edges = append(edges, branch.EdgeTenant)
+	}
+	if m.home_cars != nil {
+		edges = append(edges, branch.EdgeHomeCars)
+	}
+	if m.current_cars != nil {
+		edges = append(edges, branch.EdgeCurrentCars)
+	}
+	if m.pickup_rentals != nil {
+		edges = append(edges, branch.EdgePickupRentals)
+	}
+	if m.return_rentals != nil {
+		edges = append(edges, branch.EdgeReturnRentals)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BranchMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case branch.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case branch.EdgeHomeCars:
+		ids := make([]ent.Value, 0, len(m.home_cars))
+		for id := range m.home_cars {
+			ids = append(ids, id)
+		}
+		return ids
+	case branch.EdgeCurrentCars:
+		ids := make([]ent.Value, 0, len(m.current_cars))
+		for id := range m.current_cars {
+			ids = append(ids, id)
+		}
+		return ids
+	case branch.EdgePickupRentals:
+		ids := make([]ent.Value, 0, len(m.pickup_rentals))
+		for id := range m.pickup_rentals {
+			ids = append(ids, id)
+		}
+		return ids
+	case branch.EdgeReturnRentals:
+		ids := make([]ent.Value, 0, len(m.return_rentals))
+		for id := range m.return_rentals {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BranchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedhome_cars != nil {
+		edges = append(edges, branch.EdgeHomeCars)
+	}
+	if m.removedcurrent_cars != nil {
+		edges = append(edges, branch.EdgeCurrentCars)
+	}
+	if m.removedpickup_rentals != nil {
+		edges = append(edges, branch.EdgePickupRentals)
+	}
+	if m.removedreturn_rentals != nil {
+		edges = append(edges, branch.EdgeReturnRentals)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BranchMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case branch.EdgeHomeCars:
+		ids := make([]ent.Value, 0, len(m.removedhome_cars))
+		for id := range m.removedhome_cars {
+			ids = append(ids, id)
+		}
+		return ids
+	case branch.EdgeCurrentCars:
+		ids := make([]ent.Value, 0, len(m.removedcurrent_cars))
+		for id := range m.removedcurrent_cars {
+			ids = append(ids, id)
+		}
+		return ids
+	case branch.EdgePickupRentals:
+		ids := make([]ent.Value, 0, len(m.removedpickup_rentals))
+		for id := range m.removedpickup_rentals {
+			ids = append(ids, id)
+		}
+		return ids
+	case branch.EdgeReturnRentals:
+		ids := make([]ent.Value, 0, len(m.removedreturn_rentals))
+		for id := range m.removedreturn_rentals {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BranchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedtenant {
+		edges = append(edges, branch.EdgeTenant)
+	}
+	if m.clearedhome_cars {
+		edges = append(edges, branch.EdgeHomeCars)
+	}
+	if m.clearedcurrent_cars {
+		edges = append(edges, branch.EdgeCurrentCars)
+	}
+	if m.clearedpickup_rentals {
+		edges = append(edges, branch.EdgePickupRentals)
+	}
+	if m.clearedreturn_rentals {
+		edges = append(edges, branch.EdgeReturnRentals)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BranchMutation) EdgeCleared(name string) bool {
+	switch name {
+	case branch.EdgeTenant:
+		return m.clearedtenant
+	case branch.EdgeHomeCars:
+		return m.clearedhome_cars
+	case branch.EdgeCurrentCars:
+		return m.clearedcurrent_cars
+	case branch.EdgePickupRentals:
+		return m.clearedpickup_rentals
+	case branch.EdgeReturnRentals:
+		return m.clearedreturn_rentals
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BranchMutation) ClearEdge(name string) error {
+	switch name {
+	case branch.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown Branch unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BranchMutation) ResetEdge(name string) error {
+	switch name {
+	case branch.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case branch.EdgeHomeCars:
+		m.ResetHomeCars()
+		return nil
+	case branch.EdgeCurrentCars:
+		m.ResetCurrentCars()
+		return nil
+	case branch.EdgePickupRentals:
+		m.ResetPickupRentals()
+		return nil
+	case branch.EdgeReturnRentals:
+		m.ResetReturnRentals()
+		return nil
+	}
+	return fmt.Errorf("unknown Branch edge %s", name)
+}
+
+// CarMutation represents an operation that mutates the Car nodes in the graph.
+type CarMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	vin                        *string
+	license_plate              *string
+	license_plate_country      *string
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	deleted_at                 *time.Time
+	clearedFields              map[string]struct{}
+	tenant                     *string
+	clearedtenant              bool
+	car_model                  *string
+	clearedcar_model           bool
+	home_branch                *string
+	clearedhome_branch         bool
+	current_branch             *string
+	clearedcurrent_branch      bool
+	rentals                    map[string]struct{}
+	removedrentals             map[string]struct{}
+	clearedrentals             bool
+	maintenance_windows        map[string]struct{}
+	removedmaintenance_windows map[string]struct{}
+	clearedmaintenance_windows bool
+	done                       bool
+	oldValue                   func(context.Context) (*Car, error)
+	predicates                 []predicate.Car
+}
+
+var _ ent.Mutation = (*CarMutation)(nil)
+
+// carOption allows management of the mutation configuration using functional options.
+type carOption func(*CarMutation)
+
+// newCarMutation creates new mutation for the Car entity.
+func newCarMutation(c config, op Op, opts ...carOption) *CarMutation {
+	m := &CarMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCar,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCarID sets the ID field of the mutation.
+func withCarID(id string) carOption {
+	return func(m *CarMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Car
+		)
+		m.oldValue = func(ctx context.Context) (*Car, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Car.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCar sets the old Car of the mutation.
+func withCar(node *Car) carOption {
+	return func(m *CarMutation) {
+		m.oldValue = func(context.Context) (*Car, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CarMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CarMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Car entities.
+func (m *CarMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CarMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CarMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Car.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CarMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CarMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CarMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetCarModelID sets the "car_model_id" field.
+func (m *CarMutation) SetCarModelID(s string) {
+	m.car_model = &s
+}
+
+// CarModelID returns the value of the "car_model_id" field in the mutation.
+func (m *CarMutation) CarModelID() (r string, exists bool) {
+	v := m.car_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarModelID returns the old "car_model_id" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldCarModelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarModelID: %w", err)
+	}
+	return oldValue.CarModelID, nil
+}
+
+// ClearCarModelID clears the value of the "car_model_id" field.
+func (m *CarMutation) ClearCarModelID() {
+	m.car_model = nil
+	m.clearedFields[car.FieldCarModelID] = struct{}{}
+}
+
+// CarModelIDCleared returns if the "car_model_id" field was cleared in this mutation.
+func (m *CarMutation) CarModelIDCleared() bool {
+	_, ok := m.clearedFields[car.FieldCarModelID]
+	return ok
+}
+
+// ResetCarModelID resets all changes to the "car_model_id" field.
+func (m *CarMutation) ResetCarModelID() {
+	m.car_model = nil
+	delete(m.clearedFields, car.FieldCarModelID)
+}
+
+// SetVin sets the "vin" field.
+func (m *CarMutation) SetVin(s string) {
+	m.vin = &s
+}
+
+// Vin returns the value of the "vin" field in the mutation.
+func (m *CarMutation) Vin() (r string, exists bool) {
+	v := m.vin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVin returns the old "vin" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldVin(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVin: %w", err)
+	}
+	return oldValue.Vin, nil
+}
+
+// ClearVin clears the value of the "vin" field.
+func (m *CarMutation) ClearVin() {
+	m.vin = nil
+	m.clearedFields[car.FieldVin] = struct{}{}
+}
+
+// VinCleared returns if the "vin" field was cleared in this mutation.
+func (m *CarMutation) VinCleared() bool {
+	_, ok := m.clearedFields[car.FieldVin]
+	return ok
+}
+
+// ResetVin resets all changes to the "vin" field.
+func (m *CarMutation) ResetVin() {
+	m.vin = nil
+	delete(m.clearedFields, car.FieldVin)
+}
+
+// SetLicensePlate sets the "license_plate" field.
+func (m *CarMutation) SetLicensePlate(s string) {
+	m.license_plate = &s
+}
+
+// LicensePlate returns the value of the "license_plate" field in the mutation.
+func (m *CarMutation) LicensePlate() (r string, exists bool) {
+	v := m.license_plate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLicensePlate returns the old "license_plate" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldLicensePlate(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLicensePlate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLicensePlate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLicensePlate: %w", err)
+	}
+	return oldValue.LicensePlate, nil
+}
+
+// ClearLicensePlate clears the value of the "license_plate" field.
+func (m *CarMutation) ClearLicensePlate() {
+	m.license_plate = nil
+	m.clearedFields[car.FieldLicensePlate] = struct{}{}
+}
+
+// LicensePlateCleared returns if the "license_plate" field was cleared in this mutation.
+func (m *CarMutation) LicensePlateCleared() bool {
+	_, ok := m.clearedFields[car.FieldLicensePlate]
+	return ok
+}
+
+// ResetLicensePlate resets all changes to the "license_plate" field.
+func (m *CarMutation) ResetLicensePlate() {
+	m.license_plate = nil
+	delete(m.clearedFields, car.FieldLicensePlate)
+}
+
+// SetLicensePlateCountry sets the "license_plate_country" field.
+func (m *CarMutation) SetLicensePlateCountry(s string) {
+	m.license_plate_country = &s
+}
+
+// LicensePlateCountry returns the value of the "license_plate_country" field in the mutation.
+func (m *CarMutation) LicensePlateCountry() (r string, exists bool) {
+	v := m.license_plate_country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLicensePlateCountry returns the old "license_plate_country" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldLicensePlateCountry(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLicensePlateCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLicensePlateCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLicensePlateCountry: %w", err)
+	}
+	return oldValue.LicensePlateCountry, nil
+}
+
+// ClearLicensePlateCountry clears the value of the "license_plate_country" field.
+func (m *CarMutation) ClearLicensePlateCountry() {
+	m.license_plate_country = nil
+	m.clearedFields[car.FieldLicensePlateCountry] = struct{}{}
+}
+
+// LicensePlateCountryCleared returns if the "license_plate_country" field was cleared in this mutation.
+func (m *CarMutation) LicensePlateCountryCleared() bool {
+	_, ok := m.clearedFields[car.FieldLicensePlateCountry]
+	return ok
+}
+
+// ResetLicensePlateCountry resets all changes to the "license_plate_country" field.
+func (m *CarMutation) ResetLicensePlateCountry() {
+	m.license_plate_country = nil
+	delete(m.clearedFields, car.FieldLicensePlateCountry)
+}
+
+// SetHomeBranchID sets the "home_branch_id" field.
+func (m *CarMutation) SetHomeBranchID(s string) {
+	m.home_branch = &s
+}
+
+// HomeBranchID returns the value of the "home_branch_id" field in the mutation.
+func (m *CarMutation) HomeBranchID() (r string, exists bool) {
+	v := m.home_branch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHomeBranchID returns the old "home_branch_id" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldHomeBranchID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHomeBranchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHomeBranchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHomeBranchID: %w", err)
+	}
+	return oldValue.HomeBranchID, nil
+}
+
+// ClearHomeBranchID clears the value of the "home_branch_id" field.
+func (m *CarMutation) ClearHomeBranchID() {
+	m.home_branch = nil
+	m.clearedFields[car.FieldHomeBranchID] = struct{}{}
+}
+
+// HomeBranchIDCleared returns if the "home_branch_id" field was cleared in this mutation.
+func (m *CarMutation) HomeBranchIDCleared() bool {
+	_, ok := m.clearedFields[car.FieldHomeBranchID]
+	return ok
+}
+
+// ResetHomeBranchID resets all changes to the "home_branch_id" field.
+func (m *CarMutation) ResetHomeBranchID() {
+	m.home_branch = nil
+	delete(m.clearedFields, car.FieldHomeBranchID)
+}
+
+// SetCurrentBranchID sets the "current_branch_id" field.
+func (m *CarMutation) SetCurrentBranchID(s string) {
+	m.current_branch = &s
+}
+
+// CurrentBranchID returns the value of the "current_branch_id" field in the mutation.
+func (m *CarMutation) CurrentBranchID() (r string, exists bool) {
+	v := m.current_branch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentBranchID returns the old "current_branch_id" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldCurrentBranchID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentBranchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentBranchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentBranchID: %w", err)
+	}
+	return oldValue.CurrentBranchID, nil
+}
+
+// ClearCurrentBranchID clears the value of the "current_branch_id" field.
+func (m *CarMutation) ClearCurrentBranchID() {
+	m.current_branch = nil
+	m.clearedFields[car.FieldCurrentBranchID] = struct{}{}
+}
+
+// CurrentBranchIDCleared returns if the "current_branch_id" field was cleared in this mutation.
+func (m *CarMutation) CurrentBranchIDCleared() bool {
+	_, ok := m.clearedFields[car.FieldCurrentBranchID]
+	return ok
+}
+
+// ResetCurrentBranchID resets all changes to the "current_branch_id" field.
+func (m *CarMutation) ResetCurrentBranchID() {
+	m.current_branch = nil
+	delete(m.clearedFields, car.FieldCurrentBranchID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CarMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CarMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *CarMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[car.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *CarMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[car.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CarMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, car.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CarMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CarMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *CarMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[car.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *CarMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[car.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CarMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, car.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CarMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CarMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Car entity.
+// If the Car object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CarMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[car.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CarMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[car.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CarMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, car.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *CarMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[car.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *CarMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *CarMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *CarMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearCarModel clears the "car_model" edge to the CarModel entity.
+func (m *CarMutation) ClearCarModel() {
+	m.clearedcar_model = true
+	m.clearedFields[car.FieldCarModelID] = struct{}{}
+}
+
+// CarModelCleared reports if the "car_model" edge to the CarModel entity was cleared.
+func (m *CarMutation) CarModelCleared() bool {
+	return m.CarModelIDCleared() || m.clearedcar_model
+}
+
+// CarModelIDs returns the "car_model" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarModelID instead. It exists only for internal usage by the builders.
+func (m *CarMutation) CarModelIDs() (ids []string) {
+	if id := m.car_model; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCarModel resets all changes to the "car_model" edge.
+func (m *CarMutation) ResetCarModel() {
+	m.car_model = nil
+	m.clearedcar_model = false
+}
+
+// ClearHomeBranch clears the "home_branch" edge to the Branch entity.
+func (m *CarMutation) ClearHomeBranch() {
+	m.clearedhome_branch = true
+	m.clearedFields[car.FieldHomeBranchID] = struct{}{}
+}
+
+// HomeBranchCleared reports if the "home_branch" edge to the Branch entity was cleared.
+func (m *CarMutation) HomeBranchCleared() bool {
+	return m.HomeBranchIDCleared() || m.clearedhome_branch
+}
+
+// HomeBranchIDs returns the "home_branch" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HomeBranchID instead. It exists only for internal usage by the builders.
+func (m *CarMutation) HomeBranchIDs() (ids []string) {
+	if id := m.home_branch; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHomeBranch resets all changes to the "home_branch" edge.
+func (m *CarMutation) ResetHomeBranch() {
+	m.home_branch = nil
+	m.clearedhome_branch = false
+}
+
+// ClearCurrentBranch clears the "current_branch" edge to the Branch entity.
+func (m *CarMutation) ClearCurrentBranch() {
+	m.clearedcurrent_branch = true
+	m.clearedFields[car.FieldCurrentBranchID] = struct{}{}
+}
+
+// CurrentBranchCleared reports if the "current_branch" edge to the Branch entity was cleared.
+func (m *CarMutation) CurrentBranchCleared() bool {
+	return m.CurrentBranchIDCleared() || m.clearedcurrent_branch
+}
+
+// CurrentBranchIDs returns the "current_branch" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CurrentBranchID instead. It exists only for internal usage by the builders.
+func (m *CarMutation) CurrentBranchIDs() (ids []string) {
+	if id := m.current_branch; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCurrentBranch resets all changes to the "current_branch" edge.
+func (m *CarMutation) ResetCurrentBranch() {
+	m.current_branch = nil
+	m.clearedcurrent_branch = false
+}
+
+// AddRentalIDs adds the "rentals" edge to the Rental entity by ids.
+func (m *CarMutation) AddRentalIDs(ids ...string) {
+	if m.rentals == nil {
+		m.rentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.rentals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRentals clears the "rentals" edge to the Rental entity.
+func (m *CarMutation) ClearRentals() {
+	m.clearedrentals = true
+}
+
+// RentalsCleared reports if the "rentals" edge to the Rental entity was cleared.
+func (m *CarMutation) RentalsCleared() bool {
+	return m.clearedrentals
+}
+
+// RemoveRentalIDs removes the "rentals" edge to the Rental entity by IDs.
+func (m *CarMutation) RemoveRentalIDs(ids ...string) {
+	if m.removedrentals == nil {
+		m.removedrentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.rentals, ids[i])
+		m.removedrentals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRentals returns the removed IDs of the "rentals" edge to the Rental entity.
+func (m *CarMutation) RemovedRentalsIDs() (ids []string) {
+	for id := range m.removedrentals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RentalsIDs returns the "rentals" edge IDs in the mutation.
+func (m *CarMutation) RentalsIDs() (ids []string) {
+	for id := range m.rentals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRentals resets all changes to the "rentals" edge.
+func (m *CarMutation) ResetRentals() {
+	m.rentals = nil
+	m.clearedrentals = false
+	m.removedrentals = nil
+}
+
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by ids.
+func (m *CarMutation) AddMaintenanceWindowIDs(ids ...string) {
+	if m.maintenance_windows == nil {
+		m.maintenance_windows = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.maintenance_windows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMaintenanceWindows clears the "maintenance_windows" edge to the MaintenanceWindow entity.
+func (m *CarMutation) ClearMaintenanceWindows() {
+	m.clearedmaintenance_windows = true
+}
+
+// MaintenanceWindowsCleared reports if the "maintenance_windows" edge to the MaintenanceWindow entity was cleared.
+func (m *CarMutation) MaintenanceWindowsCleared() bool {
+	return m.clearedmaintenance_windows
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (m *CarMutation) RemoveMaintenanceWindowIDs(ids ...string) {
+	if m.removedmaintenance_windows == nil {
+		m.removedmaintenance_windows = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.maintenance_windows, ids[i])
+		m.removedmaintenance_windows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMaintenanceWindows returns the removed IDs of the "maintenance_windows" edge to the MaintenanceWindow entity.
+func (m *CarMutation) RemovedMaintenanceWindowsIDs() (ids []string) {
+	for id := range m.removedmaintenance_windows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MaintenanceWindowsIDs returns the "maintenance_windows" edge IDs in the mutation.
+func (m *CarMutation) MaintenanceWindowsIDs() (ids []string) {
+	for id := range m.maintenance_windows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMaintenanceWindows resets all changes to the "maintenance_windows" edge.
+func (m *CarMutation) ResetMaintenanceWindows() {
+	m.maintenance_windows = nil
+	m.clearedmaintenance_windows = false
+	m.removedmaintenance_windows = nil
+}
+
+// Where appends a list predicates to the CarMutation builder.
+func (m *CarMutation) Where(ps ...predicate.Car) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CarMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CarMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Car, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CarMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CarMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Car).
+func (m *CarMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CarMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.tenant != nil {
+		fields = append(fields, car.FieldTenantID)
+	}
+	if m.car_model != nil {
+		fields = append(fields, car.FieldCarModelID)
+	}
+	if m.vin != nil {
+		fields = append(fields, car.FieldVin)
+	}
+	if m.license_plate != nil {
+		fields = append(fields, car.FieldLicensePlate)
+	}
+	if m.license_plate_country != nil {
+		fields = append(fields, car.FieldLicensePlateCountry)
+	}
+	if m.home_branch != nil {
+		fields = append(fields, car.FieldHomeBranchID)
+	}
+	if m.current_branch != nil {
+		fields = append(fields, car.FieldCurrentBranchID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, car.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, car.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, car.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CarMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case car.FieldTenantID:
+		return m.TenantID()
+	case car.FieldCarModelID:
+		return m.CarModelID()
+	case car.FieldVin:
+		return m.Vin()
+	case car.FieldLicensePlate:
+		return m.LicensePlate()
+	case car.FieldLicensePlateCountry:
+		return m.LicensePlateCountry()
+	case car.FieldHomeBranchID:
+		return m.HomeBranchID()
+	case car.FieldCurrentBranchID:
+		return m.CurrentBranchID()
+	case car.FieldCreatedAt:
+		return m.CreatedAt()
+	case car.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case car.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CarMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case car.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case car.FieldCarModelID:
+		return m.OldCarModelID(ctx)
+	case car.FieldVin:
+		return m.OldVin(ctx)
+	case car.FieldLicensePlate:
+		return m.OldLicensePlate(ctx)
+	case car.FieldLicensePlateCountry:
+		return m.OldLicensePlateCountry(ctx)
+	case car.FieldHomeBranchID:
+		return m.OldHomeBranchID(ctx)
+	case car.FieldCurrentBranchID:
+		return m.OldCurrentBranchID(ctx)
+	case car.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case car.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case car.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Car field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case car.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case car.FieldCarModelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarModelID(v)
+		return nil
+	case car.FieldVin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVin(v)
+		return nil
+	case car.FieldLicensePlate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLicensePlate(v)
+		return nil
+	case car.FieldLicensePlateCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLicensePlateCountry(v)
+		return nil
+	case car.FieldHomeBranchID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHomeBranchID(v)
+		return nil
+	case car.FieldCurrentBranchID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentBranchID(v)
+		return nil
+	case car.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case car.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case car.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Car field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CarMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CarMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Car numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CarMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(car.FieldCarModelID) {
+		fields = append(fields, car.FieldCarModelID)
+	}
+	if m.FieldCleared(car.FieldVin) {
+		fields = append(fields, car.FieldVin)
+	}
+	if m.FieldCleared(car.FieldLicensePlate) {
+		fields = append(fields, car.FieldLicensePlate)
+	}
+	if m.FieldCleared(car.FieldLicensePlateCountry) {
+		fields = append(fields, car.FieldLicensePlateCountry)
+	}
+	if m.FieldCleared(car.FieldHomeBranchID) {
+		fields = append(fields, car.FieldHomeBranchID)
+	}
+	if m.FieldCleared(car.FieldCurrentBranchID) {
+		fields = append(fields, car.FieldCurrentBranchID)
+	}
+	if m.FieldCleared(car.FieldCreatedAt) {
+		fields = append(fields, car.FieldCreatedAt)
+	}
+	if m.FieldCleared(car.FieldUpdatedAt) {
+		fields = append(fields, car.FieldUpdatedAt)
+	}
+	if m.FieldCleared(car.FieldDeletedAt) {
+		fields = append(fields, car.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CarMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CarMutation) ClearField(name string) error {
+	switch name {
+	case car.FieldCarModelID:
+		m.ClearCarModelID()
+		return nil
+	case car.FieldVin:
+		m.ClearVin()
+		return nil
+	case car.FieldLicensePlate:
+		m.ClearLicensePlate()
+		return nil
+	case car.FieldLicensePlateCountry:
+		m.ClearLicensePlateCountry()
+		return nil
+	case car.FieldHomeBranchID:
+		m.ClearHomeBranchID()
+		return nil
+	case car.FieldCurrentBranchID:
+		m.ClearCurrentBranchID()
+		return nil
+	case car.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case car.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case car.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Car nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CarMutation) ResetField(name string) error {
+	switch name {
+	case car.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case car.FieldCarModelID:
+		m.ResetCarModelID()
+		return nil
+	case car.FieldVin:
+		m.ResetVin()
+		return nil
+	case car.FieldLicensePlate:
+		m.ResetLicensePlate()
+		return nil
+	case car.FieldLicensePlateCountry:
+		m.ResetLicensePlateCountry()
+		return nil
+	case car.FieldHomeBranchID:
+		m.ResetHomeBranchID()
+		return nil
+	case car.FieldCurrentBranchID:
+		m.ResetCurrentBranchID()
+		return nil
+	case car.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case car.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case car.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Car field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CarMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.tenant != nil {
+		edges = append(edges, car.EdgeTenant)
+	}
+	if m.car_model != nil {
+		edges = append(edges, car.EdgeCarModel)
+	}
+	if m.home_branch != nil {
+		edges = append(edges, car.EdgeHomeBranch)
+	}
+	if m.current_branch != nil {
+		edges = append(edges, car.EdgeCurrentBranch)
+	}
+	if m.rentals != nil {
+		edges = append(edges, car.EdgeRentals)
+	}
+	if m.maintenance_windows != nil {
+		edges = append(edges, car.EdgeMaintenanceWindows)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CarMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case car.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case car.EdgeCarModel:
+		if id := m.car_model; id != nil {
+			return []ent.Value{*id}
+		}
+	case car.EdgeHomeBranch:
+		if id := m.home_branch; id != nil {
+			return []ent.Value{*id}
+		}
+	case car.EdgeCurrentBranch:
+		if id := m.current_branch; id != nil {
+			return []ent.Value{*id}
+		}
+	case car.EdgeRentals:
+		ids := make([]ent.Value, 0, len(m.rentals))
+		for id := range m.rentals {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeMaintenanceWindows:
+		ids := make([]ent.Value, 0, len(m.maintenance_windows))
+		for id := range m.maintenance_windows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CarMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedrentals != nil {
+		edges = append(edges, car.EdgeRentals)
+	}
+	if m.removedmaintenance_windows != nil {
+		edges = append(edges, car.EdgeMaintenanceWindows)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CarMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case car.EdgeRentals:
+		ids := make([]ent.Value, 0, len(m.removedrentals))
+		for id := range m.removedrentals {
+			ids = append(ids, id)
+		}
+		return ids
+	case car.EdgeMaintenanceWindows:
+		ids := make([]ent.Value, 0, len(m.removedmaintenance_windows))
+		for id := range m.removedmaintenance_windows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CarMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedtenant {
+		edges = append(edges, car.EdgeTenant)
+	}
+	if m.clearedcar_model {
+		edges = append(edges, car.EdgeCarModel)
+	}
+	if m.clearedhome_branch {
+		edges = append(edges, car.EdgeHomeBranch)
+	}
+	if m.clearedcurrent_branch {
+		edges = append(edges, car.EdgeCurrentBranch)
+	}
+	if m.clearedrentals {
+		edges = append(edges, car.EdgeRentals)
+	}
+	if m.clearedmaintenance_windows {
+		edges = append(edges, car.EdgeMaintenanceWindows)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CarMutation) EdgeCleared(name string) bool {
+	switch name {
+	case car.EdgeTenant:
+		return m.clearedtenant
+	case car.EdgeCarModel:
+		return m.clearedcar_model
+	case car.EdgeHomeBranch:
+		return m.clearedhome_branch
+	case car.EdgeCurrentBranch:
+		return m.clearedcurrent_branch
+	case car.EdgeRentals:
+		return m.clearedrentals
+	case car.EdgeMaintenanceWindows:
+		return m.clearedmaintenance_windows
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CarMutation) ClearEdge(name string) error {
+	switch name {
+	case car.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case car.EdgeCarModel:
+		m.ClearCarModel()
+		return nil
+	case car.EdgeHomeBranch:
+		m.ClearHomeBranch()
+		return nil
+	case car.EdgeCurrentBranch:
+		m.ClearCurrentBranch()
+		return nil
+	}
+	return fmt.Errorf("unknown Car unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CarMutation) ResetEdge(name string) error {
+	switch name {
+	case car.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case car.EdgeCarModel:
+		m.ResetCarModel()
+		return nil
+	case car.EdgeHomeBranch:
+		m.ResetHomeBranch()
+		return nil
+	case car.EdgeCurrentBranch:
+		m.ResetCurrentBranch()
+		return nil
+	case car.EdgeRentals:
+		m.ResetRentals()
+		return nil
+	case car.EdgeMaintenanceWindows:
+		m.ResetMaintenanceWindows()
+		return nil
+	}
+	return fmt.Errorf("unknown Car edge %s", name)
+}
+
+// CarModelMutation represents an operation that mutates the CarModel nodes in the graph.
+type CarModelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	make          *string
+	name          *string
+	category      *string
+	seats         *int
+	addseats      *int
+	transmission  *string
+	fuel_type     *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	tenant        *string
+	clearedtenant bool
+	cars          map[string]struct{}
+	removedcars   map[string]struct{}
+	clearedcars   bool
+	done          bool
+	oldValue      func(context.Context) (*CarModel, error)
+	predicates    []predicate.CarModel
+}
+
+var _ ent.Mutation = (*CarModelMutation)(nil)
+
+// carmodelOption allows management of the mutation configuration using functional options.
+type carmodelOption func(*CarModelMutation)
+
+// newCarModelMutation creates new mutation for the CarModel entity.
+func newCarModelMutation(c config, op Op, opts ...carmodelOption) *CarModelMutation {
+	m := &CarModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCarModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCarModelID sets the ID field of the mutation.
+func withCarModelID(id string) carmodelOption {
+	return func(m *CarModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CarModel
+		)
+		m.oldValue = func(ctx context.Context) (*CarModel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CarModel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCarModel sets the old CarModel of the mutation.
+func withCarModel(node *CarModel) carmodelOption {
+	return func(m *CarModelMutation) {
+		m.oldValue = func(context.Context) (*CarModel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CarModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CarModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CarModel entities.
+func (m *CarModelMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CarModelMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CarModelMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CarModel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CarModelMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CarModelMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CarModelMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetMake sets the "make" field.
+func (m *CarModelMutation) SetMake(s string) {
+	m.make = &s
+}
+
+// Make returns the value of the "make" field in the mutation.
+func (m *CarModelMutation) Make() (r string, exists bool) {
+	v := m.make
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMake returns the old "make" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldMake(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMake is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMake requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMake: %w", err)
+	}
+	return oldValue.Make, nil
+}
+
+// ResetMake resets all changes to the "make" field.
+func (m *CarModelMutation) ResetMake() {
+	m.make = nil
+}
+
+// SetName sets the "name" field.
+func (m *CarModelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CarModelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CarModelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *CarModelMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *CarModelMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *CarModelMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetSeats sets the "seats" field.
+func (m *CarModelMutation) SetSeats(i int) {
+	m.seats = &i
+	m.addseats = nil
+}
+
+// Seats returns the value of the "seats" field in the mutation.
+func (m *CarModelMutation) Seats() (r int, exists bool) {
+	v := m.seats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeats returns the old "seats" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldSeats(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeats is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeats: %w", err)
+	}
+	return oldValue.Seats, nil
+}
+
+// AddSeats adds i to the "seats" field.
+func (m *CarModelMutation) AddSeats(i int) {
+	if m.addseats != nil {
+		*m.addseats += i
+	} else {
+		m.addseats = &i
+	}
+}
+
+// AddedSeats returns the value that was added to the "seats" field in this mutation.
+func (m *CarModelMutation) AddedSeats() (r int, exists bool) {
+	v := m.addseats
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeats resets all changes to the "seats" field.
+func (m *CarModelMutation) ResetSeats() {
+	m.seats = nil
+	m.addseats = nil
+}
+
+// SetTransmission sets the "transmission" field.
+func (m *CarModelMutation) SetTransmission(s string) {
+	m.transmission = &s
+}
+
+// Transmission returns the value of the "transmission" field in the mutation.
+func (m *CarModelMutation) Transmission() (r string, exists bool) {
+	v := m.transmission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransmission returns the old "transmission" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldTransmission(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransmission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransmission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransmission: %w", err)
+	}
+	return oldValue.Transmission, nil
+}
+
+// ResetTransmission resets all changes to the "transmission" field.
+func (m *CarModelMutation) ResetTransmission() {
+	m.transmission = nil
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (m *CarModelMutation) SetFuelType(s string) {
+	m.fuel_type = &s
+}
+
+// FuelType returns the value of the "fuel_type" field in the mutation.
+func (m *CarModelMutation) FuelType() (r string, exists bool) {
+	v := m.fuel_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFuelType returns the old "fuel_type" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldFuelType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFuelType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFuelType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFuelType: %w", err)
+	}
+	return oldValue.FuelType, nil
+}
+
+// ResetFuelType resets all changes to the "fuel_type" field.
+func (m *CarModelMutation) ResetFuelType() {
+	m.fuel_type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CarModelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CarModelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *CarModelMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[carmodel.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *CarModelMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[carmodel.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CarModelMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, carmodel.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CarModelMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CarModelMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *CarModelMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[carmodel.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *CarModelMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[carmodel.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CarModelMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, carmodel.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CarModelMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CarModelMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the CarModel entity.
+// If the CarModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarModelMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CarModelMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[carmodel.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CarModelMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[carmodel.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CarModelMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, carmodel.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *CarModelMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[carmodel.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *CarModelMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *CarModelMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *CarModelMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by ids.
+func (m *CarModelMutation) AddCarIDs(ids ...string) {
+	if m.cars == nil {
+		m.cars = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.cars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCars clears the "cars" edge to the Car entity.
+func (m *CarModelMutation) ClearCars() {
+	m.clearedcars = true
+}
+
+// CarsCleared reports if the "cars" edge to the Car entity was cleared.
+func (m *CarModelMutation) CarsCleared() bool {
+	return m.clearedcars
+}
+
+// RemoveCarIDs removes the "cars" edge to the Car entity by IDs.
+func (m *CarModelMutation) RemoveCarIDs(ids ...string) {
+	if m.removedcars == nil {
+		m.removedcars = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.cars, ids[i])
+		m.removedcars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCars returns the removed IDs of the "cars" edge to the Car entity.
+func (m *CarModelMutation) RemovedCarsIDs() (ids []string) {
+	for id := range m.removedcars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CarsIDs returns the "cars" edge IDs in the mutation.
+func (m *CarModelMutation) CarsIDs() (ids []string) {
+	for id := range m.cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCars resets all changes to the "cars" edge.
+func (m *CarModelMutation) ResetCars() {
+	m.cars = nil
+	m.clearedcars = false
+	m.removedcars = nil
+}
+
+// Where appends a list predicates to the CarModelMutation builder.
+func (m *CarModelMutation) Where(ps ...predicate.CarModel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CarModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CarModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CarModel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CarModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CarModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CarModel).
+func (m *CarModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CarModelMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.tenant != nil {
+		fields = append(fields, carmodel.FieldTenantID)
+	}
+	if m.make != nil {
+		fields = append(fields, carmodel.FieldMake)
+	}
+	if m.name != nil {
+		fields = append(fields, carmodel.FieldName)
+	}
+	if m.category != nil {
+		fields = append(fields, carmodel.FieldCategory)
+	}
+	if m.seats != nil {
+		fields = append(fields, carmodel.FieldSeats)
+	}
+	if m.transmission != nil {
+		fields = append(fields, carmodel.FieldTransmission)
+	}
+	if m.fuel_type != nil {
+		fields = append(fields, carmodel.FieldFuelType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, carmodel.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, carmodel.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, carmodel.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CarModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case carmodel.FieldTenantID:
+		return m.TenantID()
+	case carmodel.FieldMake:
+		return m.Make()
+	case carmodel.FieldName:
+		return m.Name()
+	case carmodel.FieldCategory:
+		return m.Category()
+	case carmodel.FieldSeats:
+		return m.Seats()
+	case carmodel.FieldTransmission:
+		return m.Transmission()
+	case carmodel.FieldFuelType:
+		return m.FuelType()
+	case carmodel.FieldCreatedAt:
+		return m.CreatedAt()
+	case carmodel.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case carmodel.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CarModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case carmodel.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case carmodel.FieldMake:
+		return m.OldMake(ctx)
+	case carmodel.FieldName:
+		return m.OldName(ctx)
+	case carmodel.FieldCategory:
+		return m.OldCategory(ctx)
+	case carmodel.FieldSeats:
+		return m.OldSeats(ctx)
+	case carmodel.FieldTransmission:
+		return m.OldTransmission(ctx)
+	case carmodel.FieldFuelType:
+		return m.OldFuelType(ctx)
+	case carmodel.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case carmodel.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case carmodel.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CarModel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case carmodel.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case carmodel.FieldMake:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMake(v)
+		return nil
+	case carmodel.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case carmodel.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case carmodel.FieldSeats:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeats(v)
+		return nil
+	case carmodel.FieldTransmission:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransmission(v)
+		return nil
+	case carmodel.FieldFuelType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFuelType(v)
+		return nil
+	case carmodel.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case carmodel.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case carmodel.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CarModel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CarModelMutation) AddedFields() []string {
+	var fields []string
+	if m.addseats != nil {
+		fields = append(fields, carmodel.FieldSeats)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CarModelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case carmodel.FieldSeats:
+		return m.AddedSeats()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case carmodel.FieldSeats:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeats(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CarModel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CarModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(carmodel.FieldCreatedAt) {
+		fields = append(fields, carmodel.FieldCreatedAt)
+	}
+	if m.FieldCleared(carmodel.FieldUpdatedAt) {
+		fields = append(fields, carmodel.FieldUpdatedAt)
+	}
+	if m.FieldCleared(carmodel.FieldDeletedAt) {
+		fields = append(fields, carmodel.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CarModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CarModelMutation) ClearField(name string) error {
+	switch name {
+	case carmodel.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case carmodel.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case carmodel.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CarModel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CarModelMutation) ResetField(name string) error {
+	switch name {
+	case carmodel.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case carmodel.FieldMake:
+		m.ResetMake()
+		return nil
+	case carmodel.FieldName:
+		m.ResetName()
+		return nil
+	case carmodel.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case carmodel.FieldSeats:
+		m.ResetSeats()
+		return nil
+	case carmodel.FieldTransmission:
+		m.ResetTransmission()
+		return nil
+	case carmodel.FieldFuelType:
+		m.ResetFuelType()
+		return nil
+	case carmodel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case carmodel.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case carmodel.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CarModel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CarModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, carmodel.EdgeTenant)
+	}
+	if m.cars != nil {
+		edges = append(edges, carmodel.EdgeCars)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CarModelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case carmodel.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case carmodel.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.cars))
+		for id := range m.cars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CarModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcars != nil {
+		edges = append(edges, carmodel.EdgeCars)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CarModelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case carmodel.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.removedcars))
+		for id := range m.removedcars {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CarModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, carmodel.EdgeTenant)
+	}
+	if m.clearedcars {
+		edges = append(edges, carmodel.EdgeCars)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CarModelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case carmodel.EdgeTenant:
+		return m.clearedtenant
+	case carmodel.EdgeCars:
+		return m.clearedcars
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CarModelMutation) ClearEdge(name string) error {
+	switch name {
+	case carmodel.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown CarModel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CarModelMutation) ResetEdge(name string) error {
+	switch name {
+	case carmodel.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case carmodel.EdgeCars:
+		m.ResetCars()
+		return nil
+	}
+	return fmt.Errorf("unknown CarModel edge %s", name)
+}
+
+// CarOptionMutation represents an operation that mutates the CarOption nodes in the graph.
+type CarOptionMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	name                  *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	deleted_at            *time.Time
+	clearedFields         map[string]struct{}
+	tenant                *string
+	clearedtenant         bool
+	rental_options        map[string]struct{}
+	removedrental_options map[string]struct{}
+	clearedrental_options bool
+	done                  bool
+	oldValue              func(context.Context) (*CarOption, error)
+	predicates            []predicate.CarOption
+}
+
+var _ ent.Mutation = (*CarOptionMutation)(nil)
+
+// caroptionOption allows management of the mutation configuration using functional options.
+type caroptionOption func(*CarOptionMutation)
+
+// newCarOptionMutation creates new mutation for the CarOption entity.
+func newCarOptionMutation(c config, op Op, opts ...caroptionOption) *CarOptionMutation {
+	m := &CarOptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCarOption,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCarOptionID sets the ID field of the mutation.
+func withCarOptionID(id string) caroptionOption {
+	return func(m *CarOptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CarOption
+		)
+		m.oldValue = func(ctx context.Context) (*CarOption, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CarOption.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCarOption sets the old CarOption of the mutation.
+func withCarOption(node *CarOption) caroptionOption {
+	return func(m *CarOptionMutation) {
+		m.oldValue = func(context.Context) (*CarOption, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CarOptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CarOptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CarOption entities.
+func (m *CarOptionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CarOptionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CarOptionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CarOption.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CarOptionMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CarOptionMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the CarOption entity.
+// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarOptionMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CarOptionMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetName sets the "name" field.
+func (m *CarOptionMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CarOptionMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CarOption entity.
+// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarOptionMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CarOptionMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CarOptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CarOptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CarOption entity.
+// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarOptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *CarOptionMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[caroption.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *CarOptionMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[caroption.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CarOptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, caroption.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CarOptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CarOptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CarOption entity.
+// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarOptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *CarOptionMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[caroption.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *CarOptionMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[caroption.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CarOptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, caroption.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CarOptionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CarOptionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the CarOption entity.
+// If the CarOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CarOptionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CarOptionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[caroption.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CarOptionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[caroption.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CarOptionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, caroption.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *CarOptionMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[caroption.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *CarOptionMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *CarOptionMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *CarOptionMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by ids.
+func (m *CarOptionMutation) AddRentalOptionIDs(ids ...string) {
+	if m.rental_options == nil {
+		m.rental_options = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.rental_options[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRentalOptions clears the "rental_options" edge to the RentalOption entity.
+func (m *CarOptionMutation) ClearRentalOptions() {
+	m.clearedrental_options = true
+}
+
+// RentalOptionsCleared reports if the "rental_options" edge to the RentalOption entity was cleared.
+func (m *CarOptionMutation) RentalOptionsCleared() bool {
+	return m.clearedrental_options
+}
+
+// RemoveRentalOptionIDs removes the "rental_options" edge to the RentalOption entity by IDs.
+func (m *CarOptionMutation) RemoveRentalOptionIDs(ids ...string) {
+	if m.removedrental_options == nil {
+		m.removedrental_options = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.rental_options, ids[i])
+		m.removedrental_options[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRentalOptions returns the removed IDs of the "rental_options" edge to the RentalOption entity.
+func (m *CarOptionMutation) RemovedRentalOptionsIDs() (ids []string) {
+	for id := range m.removedrental_options {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RentalOptionsIDs returns the "rental_options" edge IDs in the mutation.
+func (m *CarOptionMutation) RentalOptionsIDs() (ids []string) {
+	for id := range m.rental_options {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRentalOptions resets all changes to the "rental_options" edge.
+func (m *CarOptionMutation) ResetRentalOptions() {
+	m.rental_options = nil
+	m.clearedrental_options = false
+	m.removedrental_options = nil
+}
+
+// Where appends a list predicates to the CarOptionMutation builder.
+func (m *CarOptionMutation) Where(ps ...predicate.CarOption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CarOptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CarOptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CarOption, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CarOptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CarOptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CarOption).
+func (m *CarOptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CarOptionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant != nil {
+		fields = append(fields, caroption.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, caroption.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, caroption.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, caroption.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, caroption.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CarOptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case caroption.FieldTenantID:
+		return m.TenantID()
+	case caroption.FieldName:
+		return m.Name()
+	case caroption.FieldCreatedAt:
+		return m.CreatedAt()
+	case caroption.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case caroption.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CarOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case caroption.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case caroption.FieldName:
+		return m.OldName(ctx)
+	case caroption.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case caroption.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case caroption.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CarOption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarOptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case caroption.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case caroption.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case caroption.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case caroption.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case caroption.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CarOption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CarOptionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CarOptionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CarOptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CarOption numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CarOptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(caroption.FieldCreatedAt) {
+		fields = append(fields, caroption.FieldCreatedAt)
+	}
+	if m.FieldCleared(caroption.FieldUpdatedAt) {
+		fields = append(fields, caroption.FieldUpdatedAt)
+	}
+	if m.FieldCleared(caroption.FieldDeletedAt) {
+		fields = append(fields, caroption.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CarOptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CarOptionMutation) ClearField(name string) error {
+	switch name {
+	case caroption.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case caroption.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case caroption.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CarOption nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CarOptionMutation) ResetField(name string) error {
+	switch name {
+	case caroption.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case caroption.FieldName:
+		m.ResetName()
+		return nil
+	case caroption.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case caroption.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case caroption.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CarOption field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CarOptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, caroption.EdgeTenant)
+	}
+	if m.rental_options != nil {
+		edges = append(edges, caroption.EdgeRentalOptions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CarOptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case caroption.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case caroption.EdgeRentalOptions:
+		ids := make([]ent.Value, 0, len(m.rental_options))
+		for id := range m.rental_options {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CarOptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedrental_options != nil {
+		edges = append(edges, caroption.EdgeRentalOptions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CarOptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case caroption.EdgeRentalOptions:
+		ids := make([]ent.Value, 0, len(m.removedrental_options))
+		for id := range m.removedrental_options {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CarOptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, caroption.EdgeTenant)
+	}
+	if m.clearedrental_options {
+		edges = append(edges, caroption.EdgeRentalOptions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CarOptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case caroption.EdgeTenant:
+		return m.clearedtenant
+	case caroption.EdgeRentalOptions:
+		return m.clearedrental_options
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CarOptionMutation) ClearEdge(name string) error {
+	switch name {
+	case caroption.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown CarOption unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CarOptionMutation) ResetEdge(name string) error {
+	switch name {
+	case caroption.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case caroption.EdgeRentalOptions:
+		m.ResetRentalOptions()
+		return nil
+	}
+	return fmt.Errorf("unknown CarOption edge %s", name)
+}
+
+// CompanyMutation represents an operation that mutates the Company nodes in the graph.
+type CompanyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	name          *string
+	company_size  *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	tenant        *string
+	clearedtenant bool
+	renter        *string
+	clearedrenter bool
+	done          bool
+	oldValue      func(context.Context) (*Company, error)
+	predicates    []predicate.Company
+}
+
+var _ ent.Mutation = (*CompanyMutation)(nil)
+
+// companyOption allows management of the mutation configuration using functional options.
+type companyOption func(*CompanyMutation)
+
+// newCompanyMutation creates new mutation for the Company entity.
+func newCompanyMutation(c config, op Op, opts ...companyOption) *CompanyMutation {
+	m := &CompanyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCompany,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCompanyID sets the ID field of the mutation.
+func withCompanyID(id string) companyOption {
+	return func(m *CompanyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Company
+		)
+		m.oldValue = func(ctx context.Context) (*Company, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Company.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCompany sets the old Company of the mutation.
+func withCompany(node *Company) companyOption {
+	return func(m *CompanyMutation) {
+		m.oldValue = func(context.Context) (*Company, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CompanyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CompanyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Company entities.
+func (m *CompanyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CompanyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CompanyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Company.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRenterID sets the "renter_id" field.
+func (m *CompanyMutation) SetRenterID(s string) {
+	m.renter = &s
+}
+
+// RenterID returns the value of the "renter_id" field in the mutation.
+func (m *CompanyMutation) RenterID() (r string, exists bool) {
+	v := m.renter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRenterID returns the old "renter_id" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldRenterID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenterID: %w", err)
+	}
+	return oldValue.RenterID, nil
+}
+
+// ResetRenterID resets all changes to the "renter_id" field.
+func (m *CompanyMutation) ResetRenterID() {
+	m.renter = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *CompanyMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *CompanyMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *CompanyMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetName sets the "name" field.
+func (m *CompanyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CompanyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CompanyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCompanySize sets the "company_size" field.
+func (m *CompanyMutation) SetCompanySize(s string) {
+	m.company_size = &s
+}
+
+// CompanySize returns the value of the "company_size" field in the mutation.
+func (m *CompanyMutation) CompanySize() (r string, exists bool) {
+	v := m.company_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanySize returns the old "company_size" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldCompanySize(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanySize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanySize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanySize: %w", err)
+	}
+	return oldValue.CompanySize, nil
+}
+
+// ResetCompanySize resets all changes to the "company_size" field.
+func (m *CompanyMutation) ResetCompanySize() {
+	m.company_size = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CompanyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CompanyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *CompanyMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[company.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *CompanyMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[company.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CompanyMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, company.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CompanyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CompanyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *CompanyMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[company.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *CompanyMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[company.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CompanyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, company.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CompanyMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CompanyMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CompanyMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[company.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CompanyMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[company.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CompanyMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, company.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *CompanyMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[company.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *CompanyMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *CompanyMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *CompanyMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearRenter clears the "renter" edge to the Renter entity.
+func (m *CompanyMutation) ClearRenter() {
+	m.clearedrenter = true
+	m.clearedFields[company.FieldRenterID] = struct{}{}
+}
+
+// RenterCleared reports if the "renter" edge to the Renter entity was cleared.
+func (m *CompanyMutation) RenterCleared() bool {
+	return m.clearedrenter
+}
+
+// RenterIDs returns the "renter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RenterID instead. It exists only for internal usage by the builders.
+func (m *CompanyMutation) RenterIDs() (ids []string) {
+	if id := m.renter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRenter resets all changes to the "renter" edge.
+func (m *CompanyMutation) ResetRenter() {
+	m.renter = nil
+	m.clearedrenter = false
+}
+
+// Where appends a list predicates to the CompanyMutation builder.
+func (m *CompanyMutation) Where(ps ...predicate.Company) {
+	m.predicates = append(m.predicates, ps...)
 }
 
 // WhereP appends storage-level predicates to the CompanyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CompanyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Company, len(ps))
+func (m *CompanyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Company, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CompanyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CompanyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Company).
+func (m *CompanyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CompanyMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.renter != nil {
+		fields = append(fields, company.FieldRenterID)
+	}
+	if m.tenant != nil {
+		fields = append(fields, company.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, company.FieldName)
+	}
+	if m.company_size != nil {
+		fields = append(fields, company.FieldCompanySize)
+	}
+	if m.created_at != nil {
+		fields = append(fields, company.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, company.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, company.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CompanyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case company.FieldRenterID:
+		return m.RenterID()
+	case company.FieldTenantID:
+		return m.TenantID()
+	case company.FieldName:
+		return m.Name()
+	case company.FieldCompanySize:
+		return m.CompanySize()
+	case company.FieldCreatedAt:
+		return m.CreatedAt()
+	case company.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case company.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CompanyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case company.FieldRenterID:
+		return m.OldRenterID(ctx)
+	case company.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case company.FieldName:
+		return m.OldName(ctx)
+	case company.FieldCompanySize:
+		return m.OldCompanySize(ctx)
+	case company.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case company.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case company.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Company field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CompanyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case company.FieldRenterID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenterID(v)
+		return nil
+	case company.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case company.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case company.FieldCompanySize:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanySize(v)
+		return nil
+	case company.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case company.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case company.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Company field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CompanyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CompanyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CompanyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Company numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CompanyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(company.FieldCreatedAt) {
+		fields = append(fields, company.FieldCreatedAt)
+	}
+	if m.FieldCleared(company.FieldUpdatedAt) {
+		fields = append(fields, company.FieldUpdatedAt)
+	}
+	if m.FieldCleared(company.FieldDeletedAt) {
+		fields = append(fields, company.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CompanyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CompanyMutation) ClearField(name string) error {
+	switch name {
+	case company.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case company.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case company.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Company nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CompanyMutation) ResetField(name string) error {
+	switch name {
+	case company.FieldRenterID:
+		m.ResetRenterID()
+		return nil
+	case company.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case company.FieldName:
+		m.ResetName()
+		return nil
+	case company.FieldCompanySize:
+		m.ResetCompanySize()
+		return nil
+	case company.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case company.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case company.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Company field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CompanyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, company.EdgeTenant)
+	}
+	if m.renter != nil {
+		edges = append(edges, company.EdgeRenter)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CompanyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case company.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case company.EdgeRenter:
+		if id := m.renter; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CompanyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CompanyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CompanyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, company.EdgeTenant)
+	}
+	if m.clearedrenter {
+		edges = append(edges, company.EdgeRenter)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CompanyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case company.EdgeTenant:
+		return m.clearedtenant
+	case company.EdgeRenter:
+		return m.clearedrenter
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CompanyMutation) ClearEdge(name string) error {
+	switch name {
+	case company.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case company.EdgeRenter:
+		m.ClearRenter()
+		return nil
+	}
+	return fmt.Errorf("unknown Company unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CompanyMutation) ResetEdge(name string) error {
+	switch name {
+	case company.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case company.EdgeRenter:
+		m.ResetRenter()
+		return nil
+	}
+	return fmt.Errorf("unknown Company edge %s", name)
+}
+
+// FleetSharingAgreementMutation represents an operation that mutates the FleetSharingAgreement nodes in the graph.
+type FleetSharingAgreementMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *string
+	lender_tenant_id       *string
+	borrower_tenant_id     *string
+	max_rental_days        *int
+	addmax_rental_days     *int
+	owner_share_percent    *int
+	addowner_share_percent *int
+	valid_from             *time.Time
+	valid_until            *time.Time
+	terminated_at          *time.Time
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*FleetSharingAgreement, error)
+	predicates             []predicate.FleetSharingAgreement
+}
+
+var _ ent.Mutation = (*FleetSharingAgreementMutation)(nil)
+
+// fleetsharingagreementOption allows management of the mutation configuration using functional options.
+type fleetsharingagreementOption func(*FleetSharingAgreementMutation)
+
+// newFleetSharingAgreementMutation creates new mutation for the FleetSharingAgreement entity.
+func newFleetSharingAgreementMutation(c config, op Op, opts ...fleetsharingagreementOption) *FleetSharingAgreementMutation {
+	m := &FleetSharingAgreementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFleetSharingAgreement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFleetSharingAgreementID sets the ID field of the mutation.
+func withFleetSharingAgreementID(id string) fleetsharingagreementOption {
+	return func(m *FleetSharingAgreementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FleetSharingAgreement
+		)
+		m.oldValue = func(ctx context.Context) (*FleetSharingAgreement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FleetSharingAgreement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFleetSharingAgreement sets the old FleetSharingAgreement of the mutation.
+func withFleetSharingAgreement(node *FleetSharingAgreement) fleetsharingagreementOption {
+	return func(m *FleetSharingAgreementMutation) {
+		m.oldValue = func(context.Context) (*FleetSharingAgreement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FleetSharingAgreementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FleetSharingAgreementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FleetSharingAgreement entities.
+func (m *FleetSharingAgreementMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FleetSharingAgreementMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FleetSharingAgreementMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FleetSharingAgreement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetLenderTenantID sets the "lender_tenant_id" field.
+func (m *FleetSharingAgreementMutation) SetLenderTenantID(s string) {
+	m.lender_tenant_id = &s
+}
+
+// LenderTenantID returns the value of the "lender_tenant_id" field in the mutation.
+func (m *FleetSharingAgreementMutation) LenderTenantID() (r string, exists bool) {
+	v := m.lender_tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLenderTenantID returns the old "lender_tenant_id" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldLenderTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLenderTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLenderTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLenderTenantID: %w", err)
+	}
+	return oldValue.LenderTenantID, nil
+}
+
+// ResetLenderTenantID resets all changes to the "lender_tenant_id" field.
+func (m *FleetSharingAgreementMutation) ResetLenderTenantID() {
+	m.lender_tenant_id = nil
+}
+
+// SetBorrowerTenantID sets the "borrower_tenant_id" field.
+func (m *FleetSharingAgreementMutation) SetBorrowerTenantID(s string) {
+	m.borrower_tenant_id = &s
+}
+
+// BorrowerTenantID returns the value of the "borrower_tenant_id" field in the mutation.
+func (m *FleetSharingAgreementMutation) BorrowerTenantID() (r string, exists bool) {
+	v := m.borrower_tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBorrowerTenantID returns the old "borrower_tenant_id" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldBorrowerTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBorrowerTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBorrowerTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBorrowerTenantID: %w", err)
+	}
+	return oldValue.BorrowerTenantID, nil
+}
+
+// ResetBorrowerTenantID resets all changes to the "borrower_tenant_id" field.
+func (m *FleetSharingAgreementMutation) ResetBorrowerTenantID() {
+	m.borrower_tenant_id = nil
+}
+
+// SetMaxRentalDays sets the "max_rental_days" field.
+func (m *FleetSharingAgreementMutation) SetMaxRentalDays(i int) {
+	m.max_rental_days = &i
+	m.addmax_rental_days = nil
+}
+
+// MaxRentalDays returns the value of the "max_rental_days" field in the mutation.
+func (m *FleetSharingAgreementMutation) MaxRentalDays() (r int, exists bool) {
+	v := m.max_rental_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRentalDays returns the old "max_rental_days" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldMaxRentalDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRentalDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRentalDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRentalDays: %w", err)
+	}
+	return oldValue.MaxRentalDays, nil
+}
+
+// AddMaxRentalDays adds i to the "max_rental_days" field.
+func (m *FleetSharingAgreementMutation) AddMaxRentalDays(i int) {
+	if m.addmax_rental_days != nil {
+		*m.addmax_rental_days += i
+	} else {
+		m.addmax_rental_days = &i
+	}
+}
+
+// AddedMaxRentalDays returns the value that was added to the "max_rental_days" field in this mutation.
+func (m *FleetSharingAgreementMutation) AddedMaxRentalDays() (r int, exists bool) {
+	v := m.addmax_rental_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxRentalDays resets all changes to the "max_rental_days" field.
+func (m *FleetSharingAgreementMutation) ResetMaxRentalDays() {
+	m.max_rental_days = nil
+	m.addmax_rental_days = nil
+}
+
+// SetOwnerSharePercent sets the "owner_share_percent" field.
+func (m *FleetSharingAgreementMutation) SetOwnerSharePercent(i int) {
+	m.owner_share_percent = &i
+	m.addowner_share_percent = nil
+}
+
+// OwnerSharePercent returns the value of the "owner_share_percent" field in the mutation.
+func (m *FleetSharingAgreementMutation) OwnerSharePercent() (r int, exists bool) {
+	v := m.owner_share_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerSharePercent returns the old "owner_share_percent" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldOwnerSharePercent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerSharePercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerSharePercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerSharePercent: %w", err)
+	}
+	return oldValue.OwnerSharePercent, nil
+}
+
+// AddOwnerSharePercent adds i to the "owner_share_percent" field.
+func (m *FleetSharingAgreementMutation) AddOwnerSharePercent(i int) {
+	if m.addowner_share_percent != nil {
+		*m.addowner_share_percent += i
+	} else {
+		m.addowner_share_percent = &i
+	}
+}
+
+// AddedOwnerSharePercent returns the value that was added to the "owner_share_percent" field in this mutation.
+func (m *FleetSharingAgreementMutation) AddedOwnerSharePercent() (r int, exists bool) {
+	v := m.addowner_share_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOwnerSharePercent resets all changes to the "owner_share_percent" field.
+func (m *FleetSharingAgreementMutation) ResetOwnerSharePercent() {
+	m.owner_share_percent = nil
+	m.addowner_share_percent = nil
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *FleetSharingAgreementMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *FleetSharingAgreementMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *FleetSharingAgreementMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidUntil sets the "valid_until" field.
+func (m *FleetSharingAgreementMutation) SetValidUntil(t time.Time) {
+	m.valid_until = &t
+}
+
+// ValidUntil returns the value of the "valid_until" field in the mutation.
+func (m *FleetSharingAgreementMutation) ValidUntil() (r time.Time, exists bool) {
+	v := m.valid_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidUntil returns the old "valid_until" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldValidUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidUntil: %w", err)
+	}
+	return oldValue.ValidUntil, nil
+}
+
+// ClearValidUntil clears the value of the "valid_until" field.
+func (m *FleetSharingAgreementMutation) ClearValidUntil() {
+	m.valid_until = nil
+	m.clearedFields[fleetsharingagreement.FieldValidUntil] = struct{}{}
+}
+
+// ValidUntilCleared returns if the "valid_until" field was cleared in this mutation.
+func (m *FleetSharingAgreementMutation) ValidUntilCleared() bool {
+	_, ok := m.clearedFields[fleetsharingagreement.FieldValidUntil]
+	return ok
+}
+
+// ResetValidUntil resets all changes to the "valid_until" field.
+func (m *FleetSharingAgreementMutation) ResetValidUntil() {
+	m.valid_until = nil
+	delete(m.clearedFields, fleetsharingagreement.FieldValidUntil)
+}
+
+// SetTerminatedAt sets the "terminated_at" field.
+func (m *FleetSharingAgreementMutation) SetTerminatedAt(t time.Time) {
+	m.terminated_at = &t
+}
+
+// TerminatedAt returns the value of the "terminated_at" field in the mutation.
+func (m *FleetSharingAgreementMutation) TerminatedAt() (r time.Time, exists bool) {
+	v := m.terminated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTerminatedAt returns the old "terminated_at" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldTerminatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTerminatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTerminatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTerminatedAt: %w", err)
+	}
+	return oldValue.TerminatedAt, nil
+}
+
+// ClearTerminatedAt clears the value of the "terminated_at" field.
+func (m *FleetSharingAgreementMutation) ClearTerminatedAt() {
+	m.terminated_at = nil
+	m.clearedFields[fleetsharingagreement.FieldTerminatedAt] = struct{}{}
+}
+
+// TerminatedAtCleared returns if the "terminated_at" field was cleared in this mutation.
+func (m *FleetSharingAgreementMutation) TerminatedAtCleared() bool {
+	_, ok := m.clearedFields[fleetsharingagreement.FieldTerminatedAt]
+	return ok
+}
+
+// ResetTerminatedAt resets all changes to the "terminated_at" field.
+func (m *FleetSharingAgreementMutation) ResetTerminatedAt() {
+	m.terminated_at = nil
+	delete(m.clearedFields, fleetsharingagreement.FieldTerminatedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FleetSharingAgreementMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FleetSharingAgreementMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *FleetSharingAgreementMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[fleetsharingagreement.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *FleetSharingAgreementMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[fleetsharingagreement.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FleetSharingAgreementMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, fleetsharingagreement.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FleetSharingAgreementMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FleetSharingAgreementMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FleetSharingAgreement entity.
+// If the FleetSharingAgreement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FleetSharingAgreementMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *FleetSharingAgreementMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[fleetsharingagreement.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *FleetSharingAgreementMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[fleetsharingagreement.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FleetSharingAgreementMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, fleetsharingagreement.FieldUpdatedAt)
+}
+
+// Where appends a list predicates to the FleetSharingAgreementMutation builder.
+func (m *FleetSharingAgreementMutation) Where(ps ...predicate.FleetSharingAgreement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FleetSharingAgreementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FleetSharingAgreementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FleetSharingAgreement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FleetSharingAgreementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FleetSharingAgreementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FleetSharingAgreement).
+func (m *FleetSharingAgreementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FleetSharingAgreementMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.lender_tenant_id != nil {
+		fields = append(fields, fleetsharingagreement.FieldLenderTenantID)
+	}
+	if m.borrower_tenant_id != nil {
+		fields = append(fields, fleetsharingagreement.FieldBorrowerTenantID)
+	}
+	if m.max_rental_days != nil {
+		fields = append(fields, fleetsharingagreement.FieldMaxRentalDays)
+	}
+	if m.owner_share_percent != nil {
+		fields = append(fields, fleetsharingagreement.FieldOwnerSharePercent)
+	}
+	if m.valid_from != nil {
+		fields = append(fields, fleetsharingagreement.FieldValidFrom)
+	}
+	if m.valid_until != nil {
+		fields = append(fields, fleetsharingagreement.FieldValidUntil)
+	}
+	if m.terminated_at != nil {
+		fields = append(fields, fleetsharingagreement.FieldTerminatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, fleetsharingagreement.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, fleetsharingagreement.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FleetSharingAgreementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fleetsharingagreement.FieldLenderTenantID:
+		return m.LenderTenantID()
+	case fleetsharingagreement.FieldBorrowerTenantID:
+		return m.BorrowerTenantID()
+	case fleetsharingagreement.FieldMaxRentalDays:
+		return m.MaxRentalDays()
+	case fleetsharingagreement.FieldOwnerSharePercent:
+		return m.OwnerSharePercent()
+	case fleetsharingagreement.FieldValidFrom:
+		return m.ValidFrom()
+	case fleetsharingagreement.FieldValidUntil:
+		return m.ValidUntil()
+	case fleetsharingagreement.FieldTerminatedAt:
+		return m.TerminatedAt()
+	case fleetsharingagreement.FieldCreatedAt:
+		return m.CreatedAt()
+	case fleetsharingagreement.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FleetSharingAgreementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case fleetsharingagreement.FieldLenderTenantID:
+		return m.OldLenderTenantID(ctx)
+	case fleetsharingagreement.FieldBorrowerTenantID:
+		return m.OldBorrowerTenantID(ctx)
+	case fleetsharingagreement.FieldMaxRentalDays:
+		return m.OldMaxRentalDays(ctx)
+	case fleetsharingagreement.FieldOwnerSharePercent:
+		return m.OldOwnerSharePercent(ctx)
+	case fleetsharingagreement.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case fleetsharingagreement.FieldValidUntil:
+		return m.OldValidUntil(ctx)
+	case fleetsharingagreement.FieldTerminatedAt:
+		return m.OldTerminatedAt(ctx)
+	case fleetsharingagreement.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case fleetsharingagreement.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FleetSharingAgreement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FleetSharingAgreementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fleetsharingagreement.FieldLenderTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLenderTenantID(v)
+		return nil
+	case fleetsharingagreement.FieldBorrowerTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBorrowerTenantID(v)
+		return nil
+	case fleetsharingagreement.FieldMaxRentalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRentalDays(v)
+		return nil
+	case fleetsharingagreement.FieldOwnerSharePercent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerSharePercent(v)
+		return nil
+	case fleetsharingagreement.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case fleetsharingagreement.FieldValidUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidUntil(v)
+		return nil
+	case fleetsharingagreement.FieldTerminatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTerminatedAt(v)
+		return nil
+	case fleetsharingagreement.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case fleetsharingagreement.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FleetSharingAgreement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FleetSharingAgreementMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_rental_days != nil {
+		fields = append(fields, fleetsharingagreement.FieldMaxRentalDays)
+	}
+	if m.addowner_share_percent != nil {
+		fields = append(fields, fleetsharingagreement.FieldOwnerSharePercent)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FleetSharingAgreementMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case fleetsharingagreement.FieldMaxRentalDays:
+		return m.AddedMaxRentalDays()
+	case fleetsharingagreement.FieldOwnerSharePercent:
+		return m.AddedOwnerSharePercent()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FleetSharingAgreementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case fleetsharingagreement.FieldMaxRentalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRentalDays(v)
+		return nil
+	case fleetsharingagreement.FieldOwnerSharePercent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOwnerSharePercent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FleetSharingAgreement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FleetSharingAgreementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(fleetsharingagreement.FieldValidUntil) {
+		fields = append(fields, fleetsharingagreement.FieldValidUntil)
+	}
+	if m.FieldCleared(fleetsharingagreement.FieldTerminatedAt) {
+		fields = append(fields, fleetsharingagreement.FieldTerminatedAt)
+	}
+	if m.FieldCleared(fleetsharingagreement.FieldCreatedAt) {
+		fields = append(fields, fleetsharingagreement.FieldCreatedAt)
+	}
+	if m.FieldCleared(fleetsharingagreement.FieldUpdatedAt) {
+		fields = append(fields, fleetsharingagreement.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FleetSharingAgreementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FleetSharingAgreementMutation) ClearField(name string) error {
+	switch name {
+	case fleetsharingagreement.FieldValidUntil:
+		m.ClearValidUntil()
+		return nil
+	case fleetsharingagreement.FieldTerminatedAt:
+		m.ClearTerminatedAt()
+		return nil
+	case fleetsharingagreement.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case fleetsharingagreement.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FleetSharingAgreement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FleetSharingAgreementMutation) ResetField(name string) error {
+	switch name {
+	case fleetsharingagreement.FieldLenderTenantID:
+		m.ResetLenderTenantID()
+		return nil
+	case fleetsharingagreement.FieldBorrowerTenantID:
+		m.ResetBorrowerTenantID()
+		return nil
+	case fleetsharingagreement.FieldMaxRentalDays:
+		m.ResetMaxRentalDays()
+		return nil
+	case fleetsharingagreement.FieldOwnerSharePercent:
+		m.ResetOwnerSharePercent()
+		return nil
+	case fleetsharingagreement.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case fleetsharingagreement.FieldValidUntil:
+		m.ResetValidUntil()
+		return nil
+	case fleetsharingagreement.FieldTerminatedAt:
+		m.ResetTerminatedAt()
+		return nil
+	case fleetsharingagreement.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case fleetsharingagreement.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FleetSharingAgreement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FleetSharingAgreementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FleetSharingAgreementMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FleetSharingAgreementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FleetSharingAgreementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FleetSharingAgreementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FleetSharingAgreementMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FleetSharingAgreementMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FleetSharingAgreement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FleetSharingAgreementMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FleetSharingAgreement edge %s", name)
+}
+
+// InboxMutation represents an operation that mutates the Inbox nodes in the graph.
+type InboxMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	source        *string
+	message_id    *string
+	event_type    *string
+	payload       *map[string]interface{}
+	received_at   *time.Time
+	processed_at  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Inbox, error)
+	predicates    []predicate.Inbox
+}
+
+var _ ent.Mutation = (*InboxMutation)(nil)
+
+// inboxOption allows management of the mutation configuration using functional options.
+type inboxOption func(*InboxMutation)
+
+// newInboxMutation creates new mutation for the Inbox entity.
+func newInboxMutation(c config, op Op, opts ...inboxOption) *InboxMutation {
+	m := &InboxMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInbox,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInboxID sets the ID field of the mutation.
+func withInboxID(id string) inboxOption {
+	return func(m *InboxMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Inbox
+		)
+		m.oldValue = func(ctx context.Context) (*Inbox, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Inbox.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInbox sets the old Inbox of the mutation.
+func withInbox(node *Inbox) inboxOption {
+	return func(m *InboxMutation) {
+		m.oldValue = func(context.Context) (*Inbox, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InboxMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InboxMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Inbox entities.
+func (m *InboxMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InboxMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InboxMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Inbox.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSource sets the "source" field.
+func (m *InboxMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *InboxMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Inbox entity.
+// If the Inbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *InboxMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *InboxMutation) SetMessageID(s string) {
+	m.message_id = &s
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *InboxMutation) MessageID() (r string, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the Inbox entity.
+// If the Inbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMutation) OldMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *InboxMutation) ResetMessageID() {
+	m.message_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *InboxMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *InboxMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the Inbox entity.
+// If the Inbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *InboxMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *InboxMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *InboxMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the Inbox entity.
+// If the Inbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (m *InboxMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[inbox.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *InboxMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[inbox.FieldPayload]
+	return ok
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *InboxMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, inbox.FieldPayload)
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (m *InboxMutation) SetReceivedAt(t time.Time) {
+	m.received_at = &t
+}
+
+// ReceivedAt returns the value of the "received_at" field in the mutation.
+func (m *InboxMutation) ReceivedAt() (r time.Time, exists bool) {
+	v := m.received_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceivedAt returns the old "received_at" field's value of the Inbox entity.
+// If the Inbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMutation) OldReceivedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceivedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceivedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceivedAt: %w", err)
+	}
+	return oldValue.ReceivedAt, nil
+}
+
+// ClearReceivedAt clears the value of the "received_at" field.
+func (m *InboxMutation) ClearReceivedAt() {
+	m.received_at = nil
+	m.clearedFields[inbox.FieldReceivedAt] = struct{}{}
+}
+
+// ReceivedAtCleared returns if the "received_at" field was cleared in this mutation.
+func (m *InboxMutation) ReceivedAtCleared() bool {
+	_, ok := m.clearedFields[inbox.FieldReceivedAt]
+	return ok
+}
+
+// ResetReceivedAt resets all changes to the "received_at" field.
+func (m *InboxMutation) ResetReceivedAt() {
+	m.received_at = nil
+	delete(m.clearedFields, inbox.FieldReceivedAt)
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (m *InboxMutation) SetProcessedAt(t time.Time) {
+	m.processed_at = &t
+}
+
+// ProcessedAt returns the value of the "processed_at" field in the mutation.
+func (m *InboxMutation) ProcessedAt() (r time.Time, exists bool) {
+	v := m.processed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessedAt returns the old "processed_at" field's value of the Inbox entity.
+// If the Inbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InboxMutation) OldProcessedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+	}
+	return oldValue.ProcessedAt, nil
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (m *InboxMutation) ClearProcessedAt() {
+	m.processed_at = nil
+	m.clearedFields[inbox.FieldProcessedAt] = struct{}{}
+}
+
+// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
+func (m *InboxMutation) ProcessedAtCleared() bool {
+	_, ok := m.clearedFields[inbox.FieldProcessedAt]
+	return ok
+}
+
+// ResetProcessedAt resets all changes to the "processed_at" field.
+func (m *InboxMutation) ResetProcessedAt() {
+	m.processed_at = nil
+	delete(m.clearedFields, inbox.FieldProcessedAt)
+}
+
+// Where appends a list predicates to the InboxMutation builder.
+func (m *InboxMutation) Where(ps ...predicate.Inbox) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InboxMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InboxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Inbox, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InboxMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InboxMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Inbox).
+func (m *InboxMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InboxMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.source != nil {
+		fields = append(fields, inbox.FieldSource)
+	}
+	if m.message_id != nil {
+		fields = append(fields, inbox.FieldMessageID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, inbox.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, inbox.FieldPayload)
+	}
+	if m.received_at != nil {
+		fields = append(fields, inbox.FieldReceivedAt)
+	}
+	if m.processed_at != nil {
+		fields = append(fields, inbox.FieldProcessedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InboxMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case inbox.FieldSource:
+		return m.Source()
+	case inbox.FieldMessageID:
+		return m.MessageID()
+	case inbox.FieldEventType:
+		return m.EventType()
+	case inbox.FieldPayload:
+		return m.Payload()
+	case inbox.FieldReceivedAt:
+		return m.ReceivedAt()
+	case inbox.FieldProcessedAt:
+		return m.ProcessedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case inbox.FieldSource:
+		return m.OldSource(ctx)
+	case inbox.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case inbox.FieldEventType:
+		return m.OldEventType(ctx)
+	case inbox.FieldPayload:
+		return m.OldPayload(ctx)
+	case inbox.FieldReceivedAt:
+		return m.OldReceivedAt(ctx)
+	case inbox.FieldProcessedAt:
+		return m.OldProcessedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Inbox field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InboxMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case inbox.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case inbox.FieldMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case inbox.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case inbox.FieldPayload:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case inbox.FieldReceivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedAt(v)
+		return nil
+	case inbox.FieldProcessedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Inbox field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InboxMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InboxMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InboxMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Inbox numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InboxMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(inbox.FieldPayload) {
+		fields = append(fields, inbox.FieldPayload)
+	}
+	if m.FieldCleared(inbox.FieldReceivedAt) {
+		fields = append(fields, inbox.FieldReceivedAt)
+	}
+	if m.FieldCleared(inbox.FieldProcessedAt) {
+		fields = append(fields, inbox.FieldProcessedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InboxMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InboxMutation) ClearField(name string) error {
+	switch name {
+	case inbox.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case inbox.FieldReceivedAt:
+		m.ClearReceivedAt()
+		return nil
+	case inbox.FieldProcessedAt:
+		m.ClearProcessedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Inbox nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InboxMutation) ResetField(name string) error {
+	switch name {
+	case inbox.FieldSource:
+		m.ResetSource()
+		return nil
+	case inbox.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case inbox.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case inbox.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case inbox.FieldReceivedAt:
+		m.ResetReceivedAt()
+		return nil
+	case inbox.FieldProcessedAt:
+		m.ResetProcessedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Inbox field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InboxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InboxMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InboxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InboxMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InboxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InboxMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InboxMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Inbox unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InboxMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Inbox edge %s", name)
+}
+
+// IndividualMutation represents an operation that mutates the Individual nodes in the graph.
+type IndividualMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	email         *string
+	first_name    *string
+	last_name     *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	tenant        *string
+	clearedtenant bool
+	renter        *string
+	clearedrenter bool
+	done          bool
+	oldValue      func(context.Context) (*Individual, error)
+	predicates    []predicate.Individual
+}
+
+var _ ent.Mutation = (*IndividualMutation)(nil)
+
+// individualOption allows management of the mutation configuration using functional options.
+type individualOption func(*IndividualMutation)
+
+// newIndividualMutation creates new mutation for the Individual entity.
+func newIndividualMutation(c config, op Op, opts ...individualOption) *IndividualMutation {
+	m := &IndividualMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIndividual,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIndividualID sets the ID field of the mutation.
+func withIndividualID(id string) individualOption {
+	return func(m *IndividualMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Individual
+		)
+		m.oldValue = func(ctx context.Context) (*Individual, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Individual.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIndividual sets the old Individual of the mutation.
+func withIndividual(node *Individual) individualOption {
+	return func(m *IndividualMutation) {
+		m.oldValue = func(context.Context) (*Individual, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IndividualMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IndividualMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Individual entities.
+func (m *IndividualMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IndividualMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IndividualMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Individual.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRenterID sets the "renter_id" field.
+func (m *IndividualMutation) SetRenterID(s string) {
+	m.renter = &s
+}
+
+// RenterID returns the value of the "renter_id" field in the mutation.
+func (m *IndividualMutation) RenterID() (r string, exists bool) {
+	v := m.renter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRenterID returns the old "renter_id" field's value of the Individual entity.
+// If the Individual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndividualMutation) OldRenterID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenterID: %w", err)
+	}
+	return oldValue.RenterID, nil
+}
+
+// ResetRenterID resets all changes to the "renter_id" field.
+func (m *IndividualMutation) ResetRenterID() {
+	m.renter = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *IndividualMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *IndividualMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Individual entity.
+// If the Individual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndividualMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *IndividualMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *IndividualMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *IndividualMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Individual entity.
+// If the Individual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndividualMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *IndividualMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetFirstName sets the "first_name" field.
+func (m *IndividualMutation) SetFirstName(s string) {
+	m.first_name = &s
+}
+
+// FirstName returns the value of the "first_name" field in the mutation.
+func (m *IndividualMutation) FirstName() (r string, exists bool) {
+	v := m.first_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstName returns the old "first_name" field's value of the Individual entity.
+// If the Individual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndividualMutation) OldFirstName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	}
+	return oldValue.FirstName, nil
+}
+
+// ClearFirstName clears the value of the "first_name" field.
+func (m *IndividualMutation) ClearFirstName() {
+	m.first_name = nil
+	m.clearedFields[individual.FieldFirstName] = struct{}{}
+}
+
+// FirstNameCleared returns if the "first_name" field was cleared in this mutation.
+func (m *IndividualMutation) FirstNameCleared() bool {
+	_, ok := m.clearedFields[individual.FieldFirstName]
+	return ok
+}
+
+// ResetFirstName resets all changes to the "first_name" field.
+func (m *IndividualMutation) ResetFirstName() {
+	m.first_name = nil
+	delete(m.clearedFields, individual.FieldFirstName)
+}
+
+// SetLastName sets the "last_name" field.
+func (m *IndividualMutation) SetLastName(s string) {
+	m.last_name = &s
+}
+
+// LastName returns the value of the "last_name" field in the mutation.
+func (m *IndividualMutation) LastName() (r string, exists bool) {
+	v := m.last_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastName returns the old "last_name" field's value of the Individual entity.
+// If the Individual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndividualMutation) OldLastName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	}
+	return oldValue.LastName, nil
+}
+
+// ClearLastName clears the value of the "last_name" field.
+func (m *IndividualMutation) ClearLastName() {
+	m.last_name = nil
+	m.clearedFields[individual.FieldLastName] = struct{}{}
+}
+
+// LastNameCleared returns if the "last_name" field was cleared in this mutation.
+func (m *IndividualMutation) LastNameCleared() bool {
+	_, ok := m.clearedFields[individual.FieldLastName]
+	return ok
+}
+
+// ResetLastName resets all changes to the "last_name" field.
+func (m *IndividualMutation) ResetLastName() {
+	m.last_name = nil
+	delete(m.clearedFields, individual.FieldLastName)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IndividualMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IndividualMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Individual entity.
+// If the Individual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndividualMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *IndividualMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[individual.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *IndividualMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[individual.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IndividualMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, individual.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IndividualMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IndividualMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Individual entity.
+// If the Individual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndividualMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *IndividualMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[individual.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *IndividualMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[individual.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IndividualMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, individual.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *IndividualMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *IndividualMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Individual entity.
+// If the Individual object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IndividualMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *IndividualMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[individual.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *IndividualMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[individual.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *IndividualMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, individual.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *IndividualMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[individual.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *IndividualMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *IndividualMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *IndividualMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearRenter clears the "renter" edge to the Renter entity.
+func (m *IndividualMutation) ClearRenter() {
+	m.clearedrenter = true
+	m.clearedFields[individual.FieldRenterID] = struct{}{}
+}
+
+// RenterCleared reports if the "renter" edge to the Renter entity was cleared.
+func (m *IndividualMutation) RenterCleared() bool {
+	return m.clearedrenter
+}
+
+// RenterIDs returns the "renter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RenterID instead. It exists only for internal usage by the builders.
+func (m *IndividualMutation) RenterIDs() (ids []string) {
+	if id := m.renter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRenter resets all changes to the "renter" edge.
+func (m *IndividualMutation) ResetRenter() {
+	m.renter = nil
+	m.clearedrenter = false
+}
+
+// Where appends a list predicates to the IndividualMutation builder.
+func (m *IndividualMutation) Where(ps ...predicate.Individual) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IndividualMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IndividualMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Individual, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IndividualMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IndividualMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Individual).
+func (m *IndividualMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IndividualMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.renter != nil {
+		fields = append(fields, individual.FieldRenterID)
+	}
+	if m.tenant != nil {
+		fields = append(fields, individual.FieldTenantID)
+	}
+	if m.email != nil {
+		fields = append(fields, individual.FieldEmail)
+	}
+	if m.first_name != nil {
+		fields = append(fields, individual.FieldFirstName)
+	}
+	if m.last_name != nil {
+		fields = append(fields, individual.FieldLastName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, individual.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, individual.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, individual.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IndividualMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case individual.FieldRenterID:
+		return m.RenterID()
+	case individual.FieldTenantID:
+		return m.TenantID()
+	case individual.FieldEmail:
+		return m.Email()
+	case individual.FieldFirstName:
+		return m.FirstName()
+	case individual.FieldLastName:
+		return m.LastName()
+	case individual.FieldCreatedAt:
+		return m.CreatedAt()
+	case individual.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case individual.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IndividualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case individual.FieldRenterID:
+		return m.OldRenterID(ctx)
+	case individual.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case individual.FieldEmail:
+		return m.OldEmail(ctx)
+	case individual.FieldFirstName:
+		return m.OldFirstName(ctx)
+	case individual.FieldLastName:
+		return m.OldLastName(ctx)
+	case individual.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case individual.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case individual.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Individual field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IndividualMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case individual.FieldRenterID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenterID(v)
+		return nil
+	case individual.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case individual.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case individual.FieldFirstName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstName(v)
+		return nil
+	case individual.FieldLastName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastName(v)
+		return nil
+	case individual.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case individual.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case individual.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Individual field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IndividualMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IndividualMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IndividualMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Individual numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IndividualMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(individual.FieldFirstName) {
+		fields = append(fields, individual.FieldFirstName)
+	}
+	if m.FieldCleared(individual.FieldLastName) {
+		fields = append(fields, individual.FieldLastName)
+	}
+	if m.FieldCleared(individual.FieldCreatedAt) {
+		fields = append(fields, individual.FieldCreatedAt)
+	}
+	if m.FieldCleared(individual.FieldUpdatedAt) {
+		fields = append(fields, individual.FieldUpdatedAt)
+	}
+	if m.FieldCleared(individual.FieldDeletedAt) {
+		fields = append(fields, individual.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IndividualMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IndividualMutation) ClearField(name string) error {
+	switch name {
+	case individual.FieldFirstName:
+		m.ClearFirstName()
+		return nil
+	case individual.FieldLastName:
+		m.ClearLastName()
+		return nil
+	case individual.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case individual.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case individual.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Individual nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IndividualMutation) ResetField(name string) error {
+	switch name {
+	case individual.FieldRenterID:
+		m.ResetRenterID()
+		return nil
+	case individual.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case individual.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case individual.FieldFirstName:
+		m.ResetFirstName()
+		return nil
+	case individual.FieldLastName:
+		m.ResetLastName()
+		return nil
+	case individual.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case individual.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case individual.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Individual field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IndividualMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, individual.EdgeTenant)
+	}
+	if m.renter != nil {
+		edges = append(edges, individual.EdgeRenter)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IndividualMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case individual.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case individual.EdgeRenter:
+		if id := m.renter; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IndividualMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IndividualMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IndividualMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, individual.EdgeTenant)
+	}
+	if m.clearedrenter {
+		edges = append(edges, individual.EdgeRenter)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IndividualMutation) EdgeCleared(name string) bool {
+	switch name {
+	case individual.EdgeTenant:
+		return m.clearedtenant
+	case individual.EdgeRenter:
+		return m.clearedrenter
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IndividualMutation) ClearEdge(name string) error {
+	switch name {
+	case individual.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case individual.EdgeRenter:
+		m.ClearRenter()
+		return nil
+	}
+	return fmt.Errorf("unknown Individual unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IndividualMutation) ResetEdge(name string) error {
+	switch name {
+	case individual.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case individual.EdgeRenter:
+		m.ResetRenter()
+		return nil
+	}
+	return fmt.Errorf("unknown Individual edge %s", name)
+}
+
+// MaintenanceWindowMutation represents an operation that mutates the MaintenanceWindow nodes in the graph.
+type MaintenanceWindowMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	_type         *string
+	reason        *string
+	starts_at     *time.Time
+	ends_at       *time.Time
+	status        *string
+	completed_at  *time.Time
+	canceled_at   *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	tenant        *string
+	clearedtenant bool
+	car           *string
+	clearedcar    bool
+	done          bool
+	oldValue      func(context.Context) (*MaintenanceWindow, error)
+	predicates    []predicate.MaintenanceWindow
+}
+
+var _ ent.Mutation = (*MaintenanceWindowMutation)(nil)
+
+// maintenancewindowOption allows management of the mutation configuration using functional options.
+type maintenancewindowOption func(*MaintenanceWindowMutation)
+
+// newMaintenanceWindowMutation creates new mutation for the MaintenanceWindow entity.
+func newMaintenanceWindowMutation(c config, op Op, opts ...maintenancewindowOption) *MaintenanceWindowMutation {
+	m := &MaintenanceWindowMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMaintenanceWindow,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMaintenanceWindowID sets the ID field of the mutation.
+func withMaintenanceWindowID(id string) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MaintenanceWindow
+		)
+		m.oldValue = func(ctx context.Context) (*MaintenanceWindow, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MaintenanceWindow.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMaintenanceWindow sets the old MaintenanceWindow of the mutation.
+func withMaintenanceWindow(node *MaintenanceWindow) maintenancewindowOption {
+	return func(m *MaintenanceWindowMutation) {
+		m.oldValue = func(context.Context) (*MaintenanceWindow, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MaintenanceWindowMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MaintenanceWindowMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MaintenanceWindow entities.
+func (m *MaintenanceWindowMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MaintenanceWindowMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MaintenanceWindowMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MaintenanceWindow.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *MaintenanceWindowMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *MaintenanceWindowMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *MaintenanceWindowMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetCarID sets the "car_id" field.
+func (m *MaintenanceWindowMutation) SetCarID(s string) {
+	m.car = &s
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *MaintenanceWindowMutation) CarID() (r string, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldCarID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *MaintenanceWindowMutation) ResetCarID() {
+	m.car = nil
+}
+
+// SetType sets the "type" field.
+func (m *MaintenanceWindowMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *MaintenanceWindowMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *MaintenanceWindowMutation) ResetType() {
+	m._type = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *MaintenanceWindowMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *MaintenanceWindowMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *MaintenanceWindowMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[maintenancewindow.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *MaintenanceWindowMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, maintenancewindow.FieldReason)
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *MaintenanceWindowMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *MaintenanceWindowMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *MaintenanceWindowMutation) ResetStartsAt() {
+	m.starts_at = nil
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *MaintenanceWindowMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *MaintenanceWindowMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *MaintenanceWindowMutation) ResetEndsAt() {
+	m.ends_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *MaintenanceWindowMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *MaintenanceWindowMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *MaintenanceWindowMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *MaintenanceWindowMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *MaintenanceWindowMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *MaintenanceWindowMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[maintenancewindow.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *MaintenanceWindowMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, maintenancewindow.FieldCompletedAt)
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (m *MaintenanceWindowMutation) SetCanceledAt(t time.Time) {
+	m.canceled_at = &t
+}
+
+// CanceledAt returns the value of the "canceled_at" field in the mutation.
+func (m *MaintenanceWindowMutation) CanceledAt() (r time.Time, exists bool) {
+	v := m.canceled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanceledAt returns the old "canceled_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldCanceledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanceledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanceledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanceledAt: %w", err)
+	}
+	return oldValue.CanceledAt, nil
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (m *MaintenanceWindowMutation) ClearCanceledAt() {
+	m.canceled_at = nil
+	m.clearedFields[maintenancewindow.FieldCanceledAt] = struct{}{}
+}
+
+// CanceledAtCleared returns if the "canceled_at" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) CanceledAtCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldCanceledAt]
+	return ok
+}
+
+// ResetCanceledAt resets all changes to the "canceled_at" field.
+func (m *MaintenanceWindowMutation) ResetCanceledAt() {
+	m.canceled_at = nil
+	delete(m.clearedFields, maintenancewindow.FieldCanceledAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MaintenanceWindowMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MaintenanceWindowMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *MaintenanceWindowMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[maintenancewindow.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MaintenanceWindowMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, maintenancewindow.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MaintenanceWindowMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MaintenanceWindowMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the MaintenanceWindow entity.
+// If the MaintenanceWindow object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MaintenanceWindowMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *MaintenanceWindowMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[maintenancewindow.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *MaintenanceWindowMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[maintenancewindow.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MaintenanceWindowMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, maintenancewindow.FieldUpdatedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *MaintenanceWindowMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[maintenancewindow.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *MaintenanceWindowMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *MaintenanceWindowMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *MaintenanceWindowMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *MaintenanceWindowMutation) ClearCar() {
+	m.clearedcar = true
+	m.clearedFields[maintenancewindow.FieldCarID] = struct{}{}
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *MaintenanceWindowMutation) CarCleared() bool {
+	return m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *MaintenanceWindowMutation) CarIDs() (ids []string) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *MaintenanceWindowMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// Where appends a list predicates to the MaintenanceWindowMutation builder.
+func (m *MaintenanceWindowMutation) Where(ps ...predicate.MaintenanceWindow) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MaintenanceWindowMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MaintenanceWindowMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MaintenanceWindow, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MaintenanceWindowMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MaintenanceWindowMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MaintenanceWindow).
+func (m *MaintenanceWindowMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MaintenanceWindowMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.tenant != nil {
+		fields = append(fields, maintenancewindow.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, maintenancewindow.FieldCarID)
+	}
+	if m._type != nil {
+		fields = append(fields, maintenancewindow.FieldType)
+	}
+	if m.reason != nil {
+		fields = append(fields, maintenancewindow.FieldReason)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, maintenancewindow.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, maintenancewindow.FieldEndsAt)
+	}
+	if m.status != nil {
+		fields = append(fields, maintenancewindow.FieldStatus)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, maintenancewindow.FieldCompletedAt)
+	}
+	if m.canceled_at != nil {
+		fields = append(fields, maintenancewindow.FieldCanceledAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, maintenancewindow.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, maintenancewindow.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MaintenanceWindowMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case maintenancewindow.FieldTenantID:
+		return m.TenantID()
+	case maintenancewindow.FieldCarID:
+		return m.CarID()
+	case maintenancewindow.FieldType:
+		return m.GetType()
+	case maintenancewindow.FieldReason:
+		return m.Reason()
+	case maintenancewindow.FieldStartsAt:
+		return m.StartsAt()
+	case maintenancewindow.FieldEndsAt:
+		return m.EndsAt()
+	case maintenancewindow.FieldStatus:
+		return m.Status()
+	case maintenancewindow.FieldCompletedAt:
+		return m.CompletedAt()
+	case maintenancewindow.FieldCanceledAt:
+		return m.CanceledAt()
+	case maintenancewindow.FieldCreatedAt:
+		return m.CreatedAt()
+	case maintenancewindow.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MaintenanceWindowMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case maintenancewindow.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case maintenancewindow.FieldCarID:
+		return m.OldCarID(ctx)
+	case maintenancewindow.FieldType:
+		return m.OldType(ctx)
+	case maintenancewindow.FieldReason:
+		return m.OldReason(ctx)
+	case maintenancewindow.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case maintenancewindow.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case maintenancewindow.FieldStatus:
+		return m.OldStatus(ctx)
+	case maintenancewindow.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case maintenancewindow.FieldCanceledAt:
+		return m.OldCanceledAt(ctx)
+	case maintenancewindow.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case maintenancewindow.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case maintenancewindow.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case maintenancewindow.FieldCarID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case maintenancewindow.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case maintenancewindow.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case maintenancewindow.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case maintenancewindow.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case maintenancewindow.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case maintenancewindow.FieldCanceledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanceledAt(v)
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case maintenancewindow.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MaintenanceWindowMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MaintenanceWindowMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MaintenanceWindowMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MaintenanceWindow numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MaintenanceWindowMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(maintenancewindow.FieldReason) {
+		fields = append(fields, maintenancewindow.FieldReason)
+	}
+	if m.FieldCleared(maintenancewindow.FieldCompletedAt) {
+		fields = append(fields, maintenancewindow.FieldCompletedAt)
+	}
+	if m.FieldCleared(maintenancewindow.FieldCanceledAt) {
+		fields = append(fields, maintenancewindow.FieldCanceledAt)
+	}
+	if m.FieldCleared(maintenancewindow.FieldCreatedAt) {
+		fields = append(fields, maintenancewindow.FieldCreatedAt)
+	}
+	if m.FieldCleared(maintenancewindow.FieldUpdatedAt) {
+		fields = append(fields, maintenancewindow.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MaintenanceWindowMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearField(name string) error {
+	switch name {
+	case maintenancewindow.FieldReason:
+		m.ClearReason()
+		return nil
+	case maintenancewindow.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case maintenancewindow.FieldCanceledAt:
+		m.ClearCanceledAt()
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case maintenancewindow.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetField(name string) error {
+	switch name {
+	case maintenancewindow.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case maintenancewindow.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case maintenancewindow.FieldType:
+		m.ResetType()
+		return nil
+	case maintenancewindow.FieldReason:
+		m.ResetReason()
+		return nil
+	case maintenancewindow.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case maintenancewindow.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case maintenancewindow.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case maintenancewindow.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case maintenancewindow.FieldCanceledAt:
+		m.ResetCanceledAt()
+		return nil
+	case maintenancewindow.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case maintenancewindow.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MaintenanceWindowMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, maintenancewindow.EdgeTenant)
+	}
+	if m.car != nil {
+		edges = append(edges, maintenancewindow.EdgeCar)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MaintenanceWindowMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case maintenancewindow.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case maintenancewindow.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MaintenanceWindowMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MaintenanceWindowMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MaintenanceWindowMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, maintenancewindow.EdgeTenant)
+	}
+	if m.clearedcar {
+		edges = append(edges, maintenancewindow.EdgeCar)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MaintenanceWindowMutation) EdgeCleared(name string) bool {
+	switch name {
+	case maintenancewindow.EdgeTenant:
+		return m.clearedtenant
+	case maintenancewindow.EdgeCar:
+		return m.clearedcar
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ClearEdge(name string) error {
+	switch name {
+	case maintenancewindow.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case maintenancewindow.EdgeCar:
+		m.ClearCar()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MaintenanceWindowMutation) ResetEdge(name string) error {
+	switch name {
+	case maintenancewindow.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case maintenancewindow.EdgeCar:
+		m.ResetCar()
+		return nil
+	}
+	return fmt.Errorf("unknown MaintenanceWindow edge %s", name)
+}
+
+// OutboxMutation represents an operation that mutates the Outbox nodes in the graph.
+type OutboxMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	tenant_id       *string
+	aggregate_type  *string
+	aggregate_id    *string
+	event_type      *string
+	payload         *map[string]interface{}
+	created_at      *time.Time
+	processed_at    *time.Time
+	status          *string
+	error_message   *string
+	attempts        *int
+	addattempts     *int
+	next_attempt_at *time.Time
+	version         *int64
+	addversion      *int64
+	locked_at       *time.Time
+	locked_by       *string
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*Outbox, error)
+	predicates      []predicate.Outbox
+}
+
+var _ ent.Mutation = (*OutboxMutation)(nil)
+
+// outboxOption allows management of the mutation configuration using functional options.
+type outboxOption func(*OutboxMutation)
+
+// newOutboxMutation creates new mutation for the Outbox entity.
+func newOutboxMutation(c config, op Op, opts ...outboxOption) *OutboxMutation {
+	m := &OutboxMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutbox,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxID sets the ID field of the mutation.
+func withOutboxID(id string) outboxOption {
+	return func(m *OutboxMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Outbox
+		)
+		m.oldValue = func(ctx context.Context) (*Outbox, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Outbox.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutbox sets the old Outbox of the mutation.
+func withOutbox(node *Outbox) outboxOption {
+	return func(m *OutboxMutation) {
+		m.oldValue = func(context.Context) (*Outbox, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Outbox entities.
+func (m *OutboxMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Outbox.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *OutboxMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *OutboxMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *OutboxMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.clearedFields[outbox.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *OutboxMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *OutboxMutation) ResetTenantID() {
+	m.tenant_id = nil
+	delete(m.clearedFields, outbox.FieldTenantID)
+}
+
+// SetAggregateType sets the "aggregate_type" field.
+func (m *OutboxMutation) SetAggregateType(s string) {
+	m.aggregate_type = &s
+}
+
+// AggregateType returns the value of the "aggregate_type" field in the mutation.
+func (m *OutboxMutation) AggregateType() (r string, exists bool) {
+	v := m.aggregate_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateType returns the old "aggregate_type" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldAggregateType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateType: %w", err)
+	}
+	return oldValue.AggregateType, nil
+}
+
+// ResetAggregateType resets all changes to the "aggregate_type" field.
+func (m *OutboxMutation) ResetAggregateType() {
+	m.aggregate_type = nil
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (m *OutboxMutation) SetAggregateID(s string) {
+	m.aggregate_id = &s
+}
+
+// AggregateID returns the value of the "aggregate_id" field in the mutation.
+func (m *OutboxMutation) AggregateID() (r string, exists bool) {
+	v := m.aggregate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateID returns the old "aggregate_id" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldAggregateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateID: %w", err)
+	}
+	return oldValue.AggregateID, nil
+}
+
+// ResetAggregateID resets all changes to the "aggregate_id" field.
+func (m *OutboxMutation) ResetAggregateID() {
+	m.aggregate_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *OutboxMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *OutboxMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *OutboxMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (m *OutboxMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[outbox.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *OutboxMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldPayload]
+	return ok
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, outbox.FieldPayload)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *OutboxMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[outbox.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *OutboxMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, outbox.FieldCreatedAt)
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (m *OutboxMutation) SetProcessedAt(t time.Time) {
+	m.processed_at = &t
+}
+
+// ProcessedAt returns the value of the "processed_at" field in the mutation.
+func (m *OutboxMutation) ProcessedAt() (r time.Time, exists bool) {
+	v := m.processed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessedAt returns the old "processed_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldProcessedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+	}
+	return oldValue.ProcessedAt, nil
+}
+
+// ClearProcessedAt clears the value of the "processed_at" field.
+func (m *OutboxMutation) ClearProcessedAt() {
+	m.processed_at = nil
+	m.clearedFields[outbox.FieldProcessedAt] = struct{}{}
+}
+
+// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
+func (m *OutboxMutation) ProcessedAtCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldProcessedAt]
+	return ok
+}
+
+// ResetProcessedAt resets all changes to the "processed_at" field.
+func (m *OutboxMutation) ResetProcessedAt() {
+	m.processed_at = nil
+	delete(m.clearedFields, outbox.FieldProcessedAt)
+}
+
+// SetStatus sets the "status" field.
+func (m *OutboxMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OutboxMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OutboxMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *OutboxMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *OutboxMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *OutboxMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[outbox.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *OutboxMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *OutboxMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, outbox.FieldErrorMessage)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *OutboxMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *OutboxMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *OutboxMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[outbox.FieldNextAttemptAt] = struct{}{}
+}
+
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *OutboxMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldNextAttemptAt]
+	return ok
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *OutboxMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, outbox.FieldNextAttemptAt)
+}
+
+// SetVersion sets the "version" field.
+func (m *OutboxMutation) SetVersion(i int64) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *OutboxMutation) Version() (r int64, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *OutboxMutation) AddVersion(i int64) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *OutboxMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *OutboxMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetLockedAt sets the "locked_at" field.
+func (m *OutboxMutation) SetLockedAt(t time.Time) {
+	m.locked_at = &t
+}
+
+// LockedAt returns the value of the "locked_at" field in the mutation.
+func (m *OutboxMutation) LockedAt() (r time.Time, exists bool) {
+	v := m.locked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedAt returns the old "locked_at" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldLockedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedAt: %w", err)
+	}
+	return oldValue.LockedAt, nil
+}
+
+// ClearLockedAt clears the value of the "locked_at" field.
+func (m *OutboxMutation) ClearLockedAt() {
+	m.locked_at = nil
+	m.clearedFields[outbox.FieldLockedAt] = struct{}{}
+}
+
+// LockedAtCleared returns if the "locked_at" field was cleared in this mutation.
+func (m *OutboxMutation) LockedAtCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldLockedAt]
+	return ok
+}
+
+// ResetLockedAt resets all changes to the "locked_at" field.
+func (m *OutboxMutation) ResetLockedAt() {
+	m.locked_at = nil
+	delete(m.clearedFields, outbox.FieldLockedAt)
+}
+
+// SetLockedBy sets the "locked_by" field.
+func (m *OutboxMutation) SetLockedBy(s string) {
+	m.locked_by = &s
+}
+
+// LockedBy returns the value of the "locked_by" field in the mutation.
+func (m *OutboxMutation) LockedBy() (r string, exists bool) {
+	v := m.locked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedBy returns the old "locked_by" field's value of the Outbox entity.
+// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxMutation) OldLockedBy(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedBy: %w", err)
+	}
+	return oldValue.LockedBy, nil
+}
+
+// ClearLockedBy clears the value of the "locked_by" field.
+func (m *OutboxMutation) ClearLockedBy() {
+	m.locked_by = nil
+	m.clearedFields[outbox.FieldLockedBy] = struct{}{}
+}
+
+// LockedByCleared returns if the "locked_by" field was cleared in this mutation.
+func (m *OutboxMutation) LockedByCleared() bool {
+	_, ok := m.clearedFields[outbox.FieldLockedBy]
+	return ok
+}
+
+// ResetLockedBy resets all changes to the "locked_by" field.
+func (m *OutboxMutation) ResetLockedBy() {
+	m.locked_by = nil
+	delete(m.clearedFields, outbox.FieldLockedBy)
+}
+
+// Where appends a list predicates to the OutboxMutation builder.
+func (m *OutboxMutation) Where(ps ...predicate.Outbox) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Outbox, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Outbox).
+func (m *OutboxMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.tenant_id != nil {
+		fields = append(fields, outbox.FieldTenantID)
+	}
+	if m.aggregate_type != nil {
+		fields = append(fields, outbox.FieldAggregateType)
+	}
+	if m.aggregate_id != nil {
+		fields = append(fields, outbox.FieldAggregateID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, outbox.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, outbox.FieldPayload)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outbox.FieldCreatedAt)
+	}
+	if m.processed_at != nil {
+		fields = append(fields, outbox.FieldProcessedAt)
+	}
+	if m.status != nil {
+		fields = append(fields, outbox.FieldStatus)
+	}
+	if m.error_message != nil {
+		fields = append(fields, outbox.FieldErrorMessage)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outbox.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, outbox.FieldNextAttemptAt)
+	}
+	if m.version != nil {
+		fields = append(fields, outbox.FieldVersion)
+	}
+	if m.locked_at != nil {
+		fields = append(fields, outbox.FieldLockedAt)
+	}
+	if m.locked_by != nil {
+		fields = append(fields, outbox.FieldLockedBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outbox.FieldTenantID:
+		return m.TenantID()
+	case outbox.FieldAggregateType:
+		return m.AggregateType()
+	case outbox.FieldAggregateID:
+		return m.AggregateID()
+	case outbox.FieldEventType:
+		return m.EventType()
+	case outbox.FieldPayload:
+		return m.Payload()
+	case outbox.FieldCreatedAt:
+		return m.CreatedAt()
+	case outbox.FieldProcessedAt:
+		return m.ProcessedAt()
+	case outbox.FieldStatus:
+		return m.Status()
+	case outbox.FieldErrorMessage:
+		return m.ErrorMessage()
+	case outbox.FieldAttempts:
+		return m.Attempts()
+	case outbox.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case outbox.FieldVersion:
+		return m.Version()
+	case outbox.FieldLockedAt:
+		return m.LockedAt()
+	case outbox.FieldLockedBy:
+		return m.LockedBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outbox.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case outbox.FieldAggregateType:
+		return m.OldAggregateType(ctx)
+	case outbox.FieldAggregateID:
+		return m.OldAggregateID(ctx)
+	case outbox.FieldEventType:
+		return m.OldEventType(ctx)
+	case outbox.FieldPayload:
+		return m.OldPayload(ctx)
+	case outbox.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case outbox.FieldProcessedAt:
+		return m.OldProcessedAt(ctx)
+	case outbox.FieldStatus:
+		return m.OldStatus(ctx)
+	case outbox.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case outbox.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outbox.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case outbox.FieldVersion:
+		return m.OldVersion(ctx)
+	case outbox.FieldLockedAt:
+		return m.OldLockedAt(ctx)
+	case outbox.FieldLockedBy:
+		return m.OldLockedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown Outbox field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outbox.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case outbox.FieldAggregateType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateType(v)
+		return nil
+	case outbox.FieldAggregateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateID(v)
+		return nil
+	case outbox.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case outbox.FieldPayload:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outbox.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case outbox.FieldProcessedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedAt(v)
+		return nil
+	case outbox.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case outbox.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case outbox.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outbox.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case outbox.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case outbox.FieldLockedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedAt(v)
+		return nil
+	case outbox.FieldLockedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Outbox field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outbox.FieldAttempts)
+	}
+	if m.addversion != nil {
+		fields = append(fields, outbox.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outbox.FieldAttempts:
+		return m.AddedAttempts()
+	case outbox.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outbox.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case outbox.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Outbox numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outbox.FieldTenantID) {
+		fields = append(fields, outbox.FieldTenantID)
+	}
+	if m.FieldCleared(outbox.FieldPayload) {
+		fields = append(fields, outbox.FieldPayload)
+	}
+	if m.FieldCleared(outbox.FieldCreatedAt) {
+		fields = append(fields, outbox.FieldCreatedAt)
+	}
+	if m.FieldCleared(outbox.FieldProcessedAt) {
+		fields = append(fields, outbox.FieldProcessedAt)
+	}
+	if m.FieldCleared(outbox.FieldErrorMessage) {
+		fields = append(fields, outbox.FieldErrorMessage)
+	}
+	if m.FieldCleared(outbox.FieldNextAttemptAt) {
+		fields = append(fields, outbox.FieldNextAttemptAt)
+	}
+	if m.FieldCleared(outbox.FieldLockedAt) {
+		fields = append(fields, outbox.FieldLockedAt)
+	}
+	if m.FieldCleared(outbox.FieldLockedBy) {
+		fields = append(fields, outbox.FieldLockedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxMutation) ClearField(name string) error {
+	switch name {
+	case outbox.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case outbox.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case outbox.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case outbox.FieldProcessedAt:
+		m.ClearProcessedAt()
+		return nil
+	case outbox.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case outbox.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	case outbox.FieldLockedAt:
+		m.ClearLockedAt()
+		return nil
+	case outbox.FieldLockedBy:
+		m.ClearLockedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Outbox nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxMutation) ResetField(name string) error {
+	switch name {
+	case outbox.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case outbox.FieldAggregateType:
+		m.ResetAggregateType()
+		return nil
+	case outbox.FieldAggregateID:
+		m.ResetAggregateID()
+		return nil
+	case outbox.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case outbox.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outbox.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case outbox.FieldProcessedAt:
+		m.ResetProcessedAt()
+		return nil
+	case outbox.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case outbox.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case outbox.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outbox.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case outbox.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case outbox.FieldLockedAt:
+		m.ResetLockedAt()
+		return nil
+	case outbox.FieldLockedBy:
+		m.ResetLockedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Outbox field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Outbox unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Outbox edge %s", name)
+}
+
+// PlanMutation represents an operation that mutates the Plan nodes in the graph.
+type PlanMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *string
+	code                   *string
+	name                   *string
+	max_cars               *int
+	addmax_cars            *int
+	max_renters            *int
+	addmax_renters         *int
+	max_monthly_rentals    *int
+	addmax_monthly_rentals *int
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	tenants                map[string]struct{}
+	removedtenants         map[string]struct{}
+	clearedtenants         bool
+	done                   bool
+	oldValue               func(context.Context) (*Plan, error)
+	predicates             []predicate.Plan
+}
+
+var _ ent.Mutation = (*PlanMutation)(nil)
+
+// planOption allows management of the mutation configuration using functional options.
+type planOption func(*PlanMutation)
+
+// newPlanMutation creates new mutation for the Plan entity.
+func newPlanMutation(c config, op Op, opts ...planOption) *PlanMutation {
+	m := &PlanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlanID sets the ID field of the mutation.
+func withPlanID(id string) planOption {
+	return func(m *PlanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Plan
+		)
+		m.oldValue = func(ctx context.Context) (*Plan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Plan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlan sets the old Plan of the mutation.
+func withPlan(node *Plan) planOption {
+	return func(m *PlanMutation) {
+		m.oldValue = func(context.Context) (*Plan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Plan entities.
+func (m *PlanMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlanMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlanMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Plan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *PlanMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *PlanMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *PlanMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *PlanMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlanMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlanMutation) ResetName() {
+	m.name = nil
+}
+
+// SetMaxCars sets the "max_cars" field.
+func (m *PlanMutation) SetMaxCars(i int) {
+	m.max_cars = &i
+	m.addmax_cars = nil
+}
+
+// MaxCars returns the value of the "max_cars" field in the mutation.
+func (m *PlanMutation) MaxCars() (r int, exists bool) {
+	v := m.max_cars
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxCars returns the old "max_cars" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMaxCars(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxCars is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxCars requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxCars: %w", err)
+	}
+	return oldValue.MaxCars, nil
+}
+
+// AddMaxCars adds i to the "max_cars" field.
+func (m *PlanMutation) AddMaxCars(i int) {
+	if m.addmax_cars != nil {
+		*m.addmax_cars += i
+	} else {
+		m.addmax_cars = &i
+	}
+}
+
+// AddedMaxCars returns the value that was added to the "max_cars" field in this mutation.
+func (m *PlanMutation) AddedMaxCars() (r int, exists bool) {
+	v := m.addmax_cars
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxCars resets all changes to the "max_cars" field.
+func (m *PlanMutation) ResetMaxCars() {
+	m.max_cars = nil
+	m.addmax_cars = nil
+}
+
+// SetMaxRenters sets the "max_renters" field.
+func (m *PlanMutation) SetMaxRenters(i int) {
+	m.max_renters = &i
+	m.addmax_renters = nil
+}
+
+// MaxRenters returns the value of the "max_renters" field in the mutation.
+func (m *PlanMutation) MaxRenters() (r int, exists bool) {
+	v := m.max_renters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxRenters returns the old "max_renters" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMaxRenters(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxRenters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxRenters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxRenters: %w", err)
+	}
+	return oldValue.MaxRenters, nil
+}
+
+// AddMaxRenters adds i to the "max_renters" field.
+func (m *PlanMutation) AddMaxRenters(i int) {
+	if m.addmax_renters != nil {
+		*m.addmax_renters += i
+	} else {
+		m.addmax_renters = &i
+	}
+}
+
+// AddedMaxRenters returns the value that was added to the "max_renters" field in this mutation.
+func (m *PlanMutation) AddedMaxRenters() (r int, exists bool) {
+	v := m.addmax_renters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxRenters resets all changes to the "max_renters" field.
+func (m *PlanMutation) ResetMaxRenters() {
+	m.max_renters = nil
+	m.addmax_renters = nil
+}
+
+// SetMaxMonthlyRentals sets the "max_monthly_rentals" field.
+func (m *PlanMutation) SetMaxMonthlyRentals(i int) {
+	m.max_monthly_rentals = &i
+	m.addmax_monthly_rentals = nil
+}
+
+// MaxMonthlyRentals returns the value of the "max_monthly_rentals" field in the mutation.
+func (m *PlanMutation) MaxMonthlyRentals() (r int, exists bool) {
+	v := m.max_monthly_rentals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxMonthlyRentals returns the old "max_monthly_rentals" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldMaxMonthlyRentals(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxMonthlyRentals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxMonthlyRentals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxMonthlyRentals: %w", err)
+	}
+	return oldValue.MaxMonthlyRentals, nil
+}
+
+// AddMaxMonthlyRentals adds i to the "max_monthly_rentals" field.
+func (m *PlanMutation) AddMaxMonthlyRentals(i int) {
+	if m.addmax_monthly_rentals != nil {
+		*m.addmax_monthly_rentals += i
+	} else {
+		m.addmax_monthly_rentals = &i
+	}
+}
+
+// AddedMaxMonthlyRentals returns the value that was added to the "max_monthly_rentals" field in this mutation.
+func (m *PlanMutation) AddedMaxMonthlyRentals() (r int, exists bool) {
+	v := m.addmax_monthly_rentals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxMonthlyRentals resets all changes to the "max_monthly_rentals" field.
+func (m *PlanMutation) ResetMaxMonthlyRentals() {
+	m.max_monthly_rentals = nil
+	m.addmax_monthly_rentals = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PlanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PlanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *PlanMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[plan.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *PlanMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[plan.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PlanMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, plan.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PlanMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PlanMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *PlanMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[plan.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *PlanMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[plan.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PlanMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, plan.FieldUpdatedAt)
+}
+
+// AddTenantIDs adds the "tenants" edge to the Tenant entity by ids.
+func (m *PlanMutation) AddTenantIDs(ids ...string) {
+	if m.tenants == nil {
+		m.tenants = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.tenants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTenants clears the "tenants" edge to the Tenant entity.
+func (m *PlanMutation) ClearTenants() {
+	m.clearedtenants = true
+}
+
+// TenantsCleared reports if the "tenants" edge to the Tenant entity was cleared.
+func (m *PlanMutation) TenantsCleared() bool {
+	return m.clearedtenants
+}
+
+// RemoveTenantIDs removes the "tenants" edge to the Tenant entity by IDs.
+func (m *PlanMutation) RemoveTenantIDs(ids ...string) {
+	if m.removedtenants == nil {
+		m.removedtenants = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.tenants, ids[i])
+		m.removedtenants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTenants returns the removed IDs of the "tenants" edge to the Tenant entity.
+func (m *PlanMutation) RemovedTenantsIDs() (ids []string) {
+	for id := range m.removedtenants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TenantsIDs returns the "tenants" edge IDs in the mutation.
+func (m *PlanMutation) TenantsIDs() (ids []string) {
+	for id := range m.tenants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTenants resets all changes to the "tenants" edge.
+func (m *PlanMutation) ResetTenants() {
+	m.tenants = nil
+	m.clearedtenants = false
+	m.removedtenants = nil
+}
+
+// Where appends a list predicates to the PlanMutation builder.
+func (m *PlanMutation) Where(ps ...predicate.Plan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Plan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Plan).
+func (m *PlanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlanMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.code != nil {
+		fields = append(fields, plan.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, plan.FieldName)
+	}
+	if m.max_cars != nil {
+		fields = append(fields, plan.FieldMaxCars)
+	}
+	if m.max_renters != nil {
+		fields = append(fields, plan.FieldMaxRenters)
+	}
+	if m.max_monthly_rentals != nil {
+		fields = append(fields, plan.FieldMaxMonthlyRentals)
+	}
+	if m.created_at != nil {
+		fields = append(fields, plan.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, plan.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldCode:
+		return m.Code()
+	case plan.FieldName:
+		return m.Name()
+	case plan.FieldMaxCars:
+		return m.MaxCars()
+	case plan.FieldMaxRenters:
+		return m.MaxRenters()
+	case plan.FieldMaxMonthlyRentals:
+		return m.MaxMonthlyRentals()
+	case plan.FieldCreatedAt:
+		return m.CreatedAt()
+	case plan.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case plan.FieldCode:
+		return m.OldCode(ctx)
+	case plan.FieldName:
+		return m.OldName(ctx)
+	case plan.FieldMaxCars:
+		return m.OldMaxCars(ctx)
+	case plan.FieldMaxRenters:
+		return m.OldMaxRenters(ctx)
+	case plan.FieldMaxMonthlyRentals:
+		return m.OldMaxMonthlyRentals(ctx)
+	case plan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case plan.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Plan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case plan.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case plan.FieldMaxCars:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxCars(v)
+		return nil
+	case plan.FieldMaxRenters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxRenters(v)
+		return nil
+	case plan.FieldMaxMonthlyRentals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxMonthlyRentals(v)
+		return nil
+	case plan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case plan.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlanMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_cars != nil {
+		fields = append(fields, plan.FieldMaxCars)
+	}
+	if m.addmax_renters != nil {
+		fields = append(fields, plan.FieldMaxRenters)
+	}
+	if m.addmax_monthly_rentals != nil {
+		fields = append(fields, plan.FieldMaxMonthlyRentals)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case plan.FieldMaxCars:
+		return m.AddedMaxCars()
+	case plan.FieldMaxRenters:
+		return m.AddedMaxRenters()
+	case plan.FieldMaxMonthlyRentals:
+		return m.AddedMaxMonthlyRentals()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case plan.FieldMaxCars:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxCars(v)
+		return nil
+	case plan.FieldMaxRenters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxRenters(v)
+		return nil
+	case plan.FieldMaxMonthlyRentals:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxMonthlyRentals(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Plan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(plan.FieldCreatedAt) {
+		fields = append(fields, plan.FieldCreatedAt)
+	}
+	if m.FieldCleared(plan.FieldUpdatedAt) {
+		fields = append(fields, plan.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlanMutation) ClearField(name string) error {
+	switch name {
+	case plan.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case plan.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlanMutation) ResetField(name string) error {
+	switch name {
+	case plan.FieldCode:
+		m.ResetCode()
+		return nil
+	case plan.FieldName:
+		m.ResetName()
+		return nil
+	case plan.FieldMaxCars:
+		m.ResetMaxCars()
+		return nil
+	case plan.FieldMaxRenters:
+		m.ResetMaxRenters()
+		return nil
+	case plan.FieldMaxMonthlyRentals:
+		m.ResetMaxMonthlyRentals()
+		return nil
+	case plan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case plan.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tenants != nil {
+		edges = append(edges, plan.EdgeTenants)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeTenants:
+		ids := make([]ent.Value, 0, len(m.tenants))
+		for id := range m.tenants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedtenants != nil {
+		edges = append(edges, plan.EdgeTenants)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case plan.EdgeTenants:
+		ids := make([]ent.Value, 0, len(m.removedtenants))
+		for id := range m.removedtenants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtenants {
+		edges = append(edges, plan.EdgeTenants)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case plan.EdgeTenants:
+		return m.clearedtenants
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlanMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Plan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlanMutation) ResetEdge(name string) error {
+	switch name {
+	case plan.EdgeTenants:
+		m.ResetTenants()
+		return nil
+	}
+	return fmt.Errorf("unknown Plan edge %s", name)
+}
+
+// RentalMutation represents an operation that mutates the Rental nodes in the graph.
+type RentalMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	owner_tenant_id       *string
+	starts_at             *time.Time
+	ends_at               *time.Time
+	returned_at           *time.Time
+	created_at            *time.Time
+	updated_at            *time.Time
+	deleted_at            *time.Time
+	clearedFields         map[string]struct{}
+	tenant                *string
+	clearedtenant         bool
+	car                   *string
+	clearedcar            bool
+	renter                *string
+	clearedrenter         bool
+	pickup_branch         *string
+	clearedpickup_branch  bool
+	return_branch         *string
+	clearedreturn_branch  bool
+	rental_options        map[string]struct{}
+	removedrental_options map[string]struct{}
+	clearedrental_options bool
+	handovers             map[string]struct{}
+	removedhandovers      map[string]struct{}
+	clearedhandovers      bool
+	done                  bool
+	oldValue              func(context.Context) (*Rental, error)
+	predicates            []predicate.Rental
+}
+
+var _ ent.Mutation = (*RentalMutation)(nil)
+
+// rentalOption allows management of the mutation configuration using functional options.
+type rentalOption func(*RentalMutation)
+
+// newRentalMutation creates new mutation for the Rental entity.
+func newRentalMutation(c config, op Op, opts ...rentalOption) *RentalMutation {
+	m := &RentalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRental,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRentalID sets the ID field of the mutation.
+func withRentalID(id string) rentalOption {
+	return func(m *RentalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Rental
+		)
+		m.oldValue = func(ctx context.Context) (*Rental, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Rental.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRental sets the old Rental of the mutation.
+func withRental(node *Rental) rentalOption {
+	return func(m *RentalMutation) {
+		m.oldValue = func(context.Context) (*Rental, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RentalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RentalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Rental entities.
+func (m *RentalMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RentalMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RentalMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Rental.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RentalMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RentalMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RentalMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetCarID sets the "car_id" field.
+func (m *RentalMutation) SetCarID(s string) {
+	m.car = &s
+}
+
+// CarID returns the value of the "car_id" field in the mutation.
+func (m *RentalMutation) CarID() (r string, exists bool) {
+	v := m.car
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarID returns the old "car_id" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldCarID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
+	}
+	return oldValue.CarID, nil
+}
+
+// ResetCarID resets all changes to the "car_id" field.
+func (m *RentalMutation) ResetCarID() {
+	m.car = nil
+}
+
+// SetRenterID sets the "renter_id" field.
+func (m *RentalMutation) SetRenterID(s string) {
+	m.renter = &s
+}
+
+// RenterID returns the value of the "renter_id" field in the mutation.
+func (m *RentalMutation) RenterID() (r string, exists bool) {
+	v := m.renter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRenterID returns the old "renter_id" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldRenterID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRenterID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRenterID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRenterID: %w", err)
+	}
+	return oldValue.RenterID, nil
+}
+
+// ResetRenterID resets all changes to the "renter_id" field.
+func (m *RentalMutation) ResetRenterID() {
+	m.renter = nil
+}
+
+// SetOwnerTenantID sets the "owner_tenant_id" field.
+func (m *RentalMutation) SetOwnerTenantID(s string) {
+	m.owner_tenant_id = &s
+}
+
+// OwnerTenantID returns the value of the "owner_tenant_id" field in the mutation.
+func (m *RentalMutation) OwnerTenantID() (r string, exists bool) {
+	v := m.owner_tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerTenantID returns the old "owner_tenant_id" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldOwnerTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerTenantID: %w", err)
+	}
+	return oldValue.OwnerTenantID, nil
+}
+
+// ClearOwnerTenantID clears the value of the "owner_tenant_id" field.
+func (m *RentalMutation) ClearOwnerTenantID() {
+	m.owner_tenant_id = nil
+	m.clearedFields[rental.FieldOwnerTenantID] = struct{}{}
+}
+
+// OwnerTenantIDCleared returns if the "owner_tenant_id" field was cleared in this mutation.
+func (m *RentalMutation) OwnerTenantIDCleared() bool {
+	_, ok := m.clearedFields[rental.FieldOwnerTenantID]
+	return ok
+}
+
+// ResetOwnerTenantID resets all changes to the "owner_tenant_id" field.
+func (m *RentalMutation) ResetOwnerTenantID() {
+	m.owner_tenant_id = nil
+	delete(m.clearedFields, rental.FieldOwnerTenantID)
+}
+
+// SetPickupBranchID sets the "pickup_branch_id" field.
+func (m *RentalMutation) SetPickupBranchID(s string) {
+	m.pickup_branch = &s
+}
+
+// PickupBranchID returns the value of the "pickup_branch_id" field in the mutation.
+func (m *RentalMutation) PickupBranchID() (r string, exists bool) {
+	v := m.pickup_branch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPickupBranchID returns the old "pickup_branch_id" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldPickupBranchID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPickupBranchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPickupBranchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickupBranchID: %w", err)
+	}
+	return oldValue.PickupBranchID, nil
+}
+
+// ClearPickupBranchID clears the value of the "pickup_branch_id" field.
+func (m *RentalMutation) ClearPickupBranchID() {
+	m.pickup_branch = nil
+	m.clearedFields[rental.FieldPickupBranchID] = struct{}{}
+}
+
+// PickupBranchIDCleared returns if the "pickup_branch_id" field was cleared in this mutation.
+func (m *RentalMutation) PickupBranchIDCleared() bool {
+	_, ok := m.clearedFields[rental.FieldPickupBranchID]
+	return ok
+}
+
+// ResetPickupBranchID resets all changes to the "pickup_branch_id" field.
+func (m *RentalMutation) ResetPickupBranchID() {
+	m.pickup_branch = nil
+	delete(m.clearedFields, rental.FieldPickupBranchID)
+}
+
+// SetReturnBranchID sets the "return_branch_id" field.
+func (m *RentalMutation) SetReturnBranchID(s string) {
+	m.return_branch = &s
+}
+
+// ReturnBranchID returns the value of the "return_branch_id" field in the mutation.
+func (m *RentalMutation) ReturnBranchID() (r string, exists bool) {
+	v := m.return_branch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReturnBranchID returns the old "return_branch_id" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldReturnBranchID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReturnBranchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReturnBranchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReturnBranchID: %w", err)
+	}
+	return oldValue.ReturnBranchID, nil
+}
+
+// ClearReturnBranchID clears the value of the "return_branch_id" field.
+func (m *RentalMutation) ClearReturnBranchID() {
+	m.return_branch = nil
+	m.clearedFields[rental.FieldReturnBranchID] = struct{}{}
+}
+
+// ReturnBranchIDCleared returns if the "return_branch_id" field was cleared in this mutation.
+func (m *RentalMutation) ReturnBranchIDCleared() bool {
+	_, ok := m.clearedFields[rental.FieldReturnBranchID]
+	return ok
+}
+
+// ResetReturnBranchID resets all changes to the "return_branch_id" field.
+func (m *RentalMutation) ResetReturnBranchID() {
+	m.return_branch = nil
+	delete(m.clearedFields, rental.FieldReturnBranchID)
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (m *RentalMutation) SetStartsAt(t time.Time) {
+	m.starts_at = &t
+}
+
+// StartsAt returns the value of the "starts_at" field in the mutation.
+func (m *RentalMutation) StartsAt() (r time.Time, exists bool) {
+	v := m.starts_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartsAt returns the old "starts_at" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
+	}
+	return oldValue.StartsAt, nil
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (m *RentalMutation) ClearStartsAt() {
+	m.starts_at = nil
+	m.clearedFields[rental.FieldStartsAt] = struct{}{}
+}
+
+// StartsAtCleared returns if the "starts_at" field was cleared in this mutation.
+func (m *RentalMutation) StartsAtCleared() bool {
+	_, ok := m.clearedFields[rental.FieldStartsAt]
+	return ok
+}
+
+// ResetStartsAt resets all changes to the "starts_at" field.
+func (m *RentalMutation) ResetStartsAt() {
+	m.starts_at = nil
+	delete(m.clearedFields, rental.FieldStartsAt)
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (m *RentalMutation) SetEndsAt(t time.Time) {
+	m.ends_at = &t
+}
+
+// EndsAt returns the value of the "ends_at" field in the mutation.
+func (m *RentalMutation) EndsAt() (r time.Time, exists bool) {
+	v := m.ends_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndsAt returns the old "ends_at" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+	}
+	return oldValue.EndsAt, nil
+}
+
+// ClearEndsAt clears the value of the "ends_at" field.
+func (m *RentalMutation) ClearEndsAt() {
+	m.ends_at = nil
+	m.clearedFields[rental.FieldEndsAt] = struct{}{}
+}
+
+// EndsAtCleared returns if the "ends_at" field was cleared in this mutation.
+func (m *RentalMutation) EndsAtCleared() bool {
+	_, ok := m.clearedFields[rental.FieldEndsAt]
+	return ok
+}
+
+// ResetEndsAt resets all changes to the "ends_at" field.
+func (m *RentalMutation) ResetEndsAt() {
+	m.ends_at = nil
+	delete(m.clearedFields, rental.FieldEndsAt)
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (m *RentalMutation) SetReturnedAt(t time.Time) {
+	m.returned_at = &t
+}
+
+// ReturnedAt returns the value of the "returned_at" field in the mutation.
+func (m *RentalMutation) ReturnedAt() (r time.Time, exists bool) {
+	v := m.returned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReturnedAt returns the old "returned_at" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldReturnedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReturnedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReturnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReturnedAt: %w", err)
+	}
+	return oldValue.ReturnedAt, nil
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (m *RentalMutation) ClearReturnedAt() {
+	m.returned_at = nil
+	m.clearedFields[rental.FieldReturnedAt] = struct{}{}
+}
+
+// ReturnedAtCleared returns if the "returned_at" field was cleared in this mutation.
+func (m *RentalMutation) ReturnedAtCleared() bool {
+	_, ok := m.clearedFields[rental.FieldReturnedAt]
+	return ok
+}
+
+// ResetReturnedAt resets all changes to the "returned_at" field.
+func (m *RentalMutation) ResetReturnedAt() {
+	m.returned_at = nil
+	delete(m.clearedFields, rental.FieldReturnedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RentalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RentalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *RentalMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[rental.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *RentalMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[rental.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RentalMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, rental.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RentalMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RentalMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *RentalMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[rental.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *RentalMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[rental.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RentalMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, rental.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *RentalMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *RentalMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Rental entity.
+// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *RentalMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[rental.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *RentalMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[rental.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *RentalMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, rental.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *RentalMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[rental.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *RentalMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *RentalMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *RentalMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearCar clears the "car" edge to the Car entity.
+func (m *RentalMutation) ClearCar() {
+	m.clearedcar = true
+	m.clearedFields[rental.FieldCarID] = struct{}{}
+}
+
+// CarCleared reports if the "car" edge to the Car entity was cleared.
+func (m *RentalMutation) CarCleared() bool {
+	return m.clearedcar
+}
+
+// CarIDs returns the "car" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CarID instead. It exists only for internal usage by the builders.
+func (m *RentalMutation) CarIDs() (ids []string) {
+	if id := m.car; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCar resets all changes to the "car" edge.
+func (m *RentalMutation) ResetCar() {
+	m.car = nil
+	m.clearedcar = false
+}
+
+// ClearRenter clears the "renter" edge to the Renter entity.
+func (m *RentalMutation) ClearRenter() {
+	m.clearedrenter = true
+	m.clearedFields[rental.FieldRenterID] = struct{}{}
+}
+
+// RenterCleared reports if the "renter" edge to the Renter entity was cleared.
+func (m *RentalMutation) RenterCleared() bool {
+	return m.clearedrenter
+}
+
+// RenterIDs returns the "renter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RenterID instead. It exists only for internal usage by the builders.
+func (m *RentalMutation) RenterIDs() (ids []string) {
+	if id := m.renter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRenter resets all changes to the "renter" edge.
+func (m *RentalMutation) ResetRenter() {
+	m.renter = nil
+	m.clearedrenter = false
+}
+
+// ClearPickupBranch clears the "pickup_branch" edge to the Branch entity.
+func (m *RentalMutation) ClearPickupBranch() {
+	m.clearedpickup_branch = true
+	m.clearedFields[rental.FieldPickupBranchID] = struct{}{}
+}
+
+// PickupBranchCleared reports if the "pickup_branch" edge to the Branch entity was cleared.
+func (m *RentalMutation) PickupBranchCleared() bool {
+	return m.PickupBranchIDCleared() || m.clearedpickup_branch
+}
+
+// PickupBranchIDs returns the "pickup_branch" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PickupBranchID instead. It exists only for internal usage by the builders.
+func (m *RentalMutation) PickupBranchIDs() (ids []string) {
+	if id := m.pickup_branch; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPickupBranch resets all changes to the "pickup_branch" edge.
+func (m *RentalMutation) ResetPickupBranch() {
+	m.pickup_branch = nil
+	m.clearedpickup_branch = false
+}
+
+// ClearReturnBranch clears the "return_branch" edge to the Branch entity.
+func (m *RentalMutation) ClearReturnBranch() {
+	m.clearedreturn_branch = true
+	m.clearedFields[rental.FieldReturnBranchID] = struct{}{}
+}
+
+// ReturnBranchCleared reports if the "return_branch" edge to the Branch entity was cleared.
+func (m *RentalMutation) ReturnBranchCleared() bool {
+	return m.ReturnBranchIDCleared() || m.clearedreturn_branch
+}
+
+// ReturnBranchIDs returns the "return_branch" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReturnBranchID instead. It exists only for internal usage by the builders.
+func (m *RentalMutation) ReturnBranchIDs() (ids []string) {
+	if id := m.return_branch; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReturnBranch resets all changes to the "return_branch" edge.
+func (m *RentalMutation) ResetReturnBranch() {
+	m.return_branch = nil
+	m.clearedreturn_branch = false
+}
+
+// AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by ids.
+func (m *RentalMutation) AddRentalOptionIDs(ids ...string) {
+	if m.rental_options == nil {
+		m.rental_options = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.rental_options[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRentalOptions clears the "rental_options" edge to the RentalOption entity.
+func (m *RentalMutation) ClearRentalOptions() {
+	m.clearedrental_options = true
+}
+
+// RentalOptionsCleared reports if the "rental_options" edge to the RentalOption entity was cleared.
+func (m *RentalMutation) RentalOptionsCleared() bool {
+	return m.clearedrental_options
+}
+
+// RemoveRentalOptionIDs removes the "rental_options" edge to the RentalOption entity by IDs.
+func (m *RentalMutation) RemoveRentalOptionIDs(ids ...string) {
+	if m.removedrental_options == nil {
+		m.removedrental_options = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.rental_options, ids[i])
+		m.removedrental_options[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRentalOptions returns the removed IDs of the "rental_options" edge to the RentalOption entity.
+func (m *RentalMutation) RemovedRentalOptionsIDs() (ids []string) {
+	for id := range m.removedrental_options {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RentalOptionsIDs returns the "rental_options" edge IDs in the mutation.
+func (m *RentalMutation) RentalOptionsIDs() (ids []string) {
+	for id := range m.rental_options {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRentalOptions resets all changes to the "rental_options" edge.
+func (m *RentalMutation) ResetRentalOptions() {
+	m.rental_options = nil
+	m.clearedrental_options = false
+	m.removedrental_options = nil
+}
+
+// AddHandoverIDs adds the "handovers" edge to the RentalHandover entity by ids.
+func (m *RentalMutation) AddHandoverIDs(ids ...string) {
+	if m.handovers == nil {
+		m.handovers = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.handovers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHandovers clears the "handovers" edge to the RentalHandover entity.
+func (m *RentalMutation) ClearHandovers() {
+	m.clearedhandovers = true
+}
+
+// HandoversCleared reports if the "handovers" edge to the RentalHandover entity was cleared.
+func (m *RentalMutation) HandoversCleared() bool {
+	return m.clearedhandovers
+}
+
+// RemoveHandoverIDs removes the "handovers" edge to the RentalHandover entity by IDs.
+func (m *RentalMutation) RemoveHandoverIDs(ids ...string) {
+	if m.removedhandovers == nil {
+		m.removedhandovers = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.handovers, ids[i])
+		m.removedhandovers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHandovers returns the removed IDs of the "handovers" edge to the RentalHandover entity.
+func (m *RentalMutation) RemovedHandoversIDs() (ids []string) {
+	for id := range m.removedhandovers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HandoversIDs returns the "handovers" edge IDs in the mutation.
+func (m *RentalMutation) HandoversIDs() (ids []string) {
+	for id := range m.handovers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHandovers resets all changes to the "handovers" edge.
+func (m *RentalMutation) ResetHandovers() {
+	m.handovers = nil
+	m.clearedhandovers = false
+	m.removedhandovers = nil
+}
+
+// Where appends a list predicates to the RentalMutation builder.
+func (m *RentalMutation) Where(ps ...predicate.Rental) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RentalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RentalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rental, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RentalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RentalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Rental).
+func (m *RentalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RentalMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.tenant != nil {
+		fields = append(fields, rental.FieldTenantID)
+	}
+	if m.car != nil {
+		fields = append(fields, rental.FieldCarID)
+	}
+	if m.renter != nil {
+		fields = append(fields, rental.FieldRenterID)
+	}
+	if m.owner_tenant_id != nil {
+		fields = append(fields, rental.FieldOwnerTenantID)
+	}
+	if m.pickup_branch != nil {
+		fields = append(fields, rental.FieldPickupBranchID)
+	}
+	if m.return_branch != nil {
+		fields = append(fields, rental.FieldReturnBranchID)
+	}
+	if m.starts_at != nil {
+		fields = append(fields, rental.FieldStartsAt)
+	}
+	if m.ends_at != nil {
+		fields = append(fields, rental.FieldEndsAt)
+	}
+	if m.returned_at != nil {
+		fields = append(fields, rental.FieldReturnedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rental.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rental.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, rental.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RentalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rental.FieldTenantID:
+		return m.TenantID()
+	case rental.FieldCarID:
+		return m.CarID()
+	case rental.FieldRenterID:
+		return m.RenterID()
+	case rental.FieldOwnerTenantID:
+		return m.OwnerTenantID()
+	case rental.FieldPickupBranchID:
+		return m.PickupBranchID()
+	case rental.FieldReturnBranchID:
+		return m.ReturnBranchID()
+	case rental.FieldStartsAt:
+		return m.StartsAt()
+	case rental.FieldEndsAt:
+		return m.EndsAt()
+	case rental.FieldReturnedAt:
+		return m.ReturnedAt()
+	case rental.FieldCreatedAt:
+		return m.CreatedAt()
+	case rental.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case rental.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RentalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rental.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case rental.FieldCarID:
+		return m.OldCarID(ctx)
+	case rental.FieldRenterID:
+		return m.OldRenterID(ctx)
+	case rental.FieldOwnerTenantID:
+		return m.OldOwnerTenantID(ctx)
+	case rental.FieldPickupBranchID:
+		return m.OldPickupBranchID(ctx)
+	case rental.FieldReturnBranchID:
+		return m.OldReturnBranchID(ctx)
+	case rental.FieldStartsAt:
+		return m.OldStartsAt(ctx)
+	case rental.FieldEndsAt:
+		return m.OldEndsAt(ctx)
+	case rental.FieldReturnedAt:
+		return m.OldReturnedAt(ctx)
+	case rental.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rental.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case rental.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Rental field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RentalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rental.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case rental.FieldCarID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarID(v)
+		return nil
+	case rental.FieldRenterID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRenterID(v)
+		return nil
+	case rental.FieldOwnerTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerTenantID(v)
+		return nil
+	case rental.FieldPickupBranchID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickupBranchID(v)
+		return nil
+	case rental.FieldReturnBranchID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReturnBranchID(v)
+		return nil
+	case rental.FieldStartsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartsAt(v)
+		return nil
+	case rental.FieldEndsAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndsAt(v)
+		return nil
+	case rental.FieldReturnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReturnedAt(v)
+		return nil
+	case rental.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rental.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case rental.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rental field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RentalMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RentalMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RentalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Rental numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RentalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rental.FieldOwnerTenantID) {
+		fields = append(fields, rental.FieldOwnerTenantID)
+	}
+	if m.FieldCleared(rental.FieldPickupBranchID) {
+		fields = append(fields, rental.FieldPickupBranchID)
+	}
+	if m.FieldCleared(rental.FieldReturnBranchID) {
+		fields = append(fields, rental.FieldReturnBranchID)
+	}
+	if m.FieldCleared(rental.FieldStartsAt) {
+		fields = append(fields, rental.FieldStartsAt)
+	}
+	if m.FieldCleared(rental.FieldEndsAt) {
+		fields = append(fields, rental.FieldEndsAt)
+	}
+	if m.FieldCleared(rental.FieldReturnedAt) {
+		fields = append(fields, rental.FieldReturnedAt)
+	}
+	if m.FieldCleared(rental.FieldCreatedAt) {
+		fields = append(fields, rental.FieldCreatedAt)
+	}
+	if m.FieldCleared(rental.FieldUpdatedAt) {
+		fields = append(fields, rental.FieldUpdatedAt)
+	}
+	if m.FieldCleared(rental.FieldDeletedAt) {
+		fields = append(fields, rental.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RentalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RentalMutation) ClearField(name string) error {
+	switch name {
+	case rental.FieldOwnerTenantID:
+		m.ClearOwnerTenantID()
+		return nil
+	case rental.FieldPickupBranchID:
+		m.ClearPickupBranchID()
+		return nil
+	case rental.FieldReturnBranchID:
+		m.ClearReturnBranchID()
+		return nil
+	case rental.FieldStartsAt:
+		m.ClearStartsAt()
+		return nil
+	case rental.FieldEndsAt:
+		m.ClearEndsAt()
+		return nil
+	case rental.FieldReturnedAt:
+		m.ClearReturnedAt()
+		return nil
+	case rental.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case rental.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case rental.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Rental nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RentalMutation) ResetField(name string) error {
+	switch name {
+	case rental.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case rental.FieldCarID:
+		m.ResetCarID()
+		return nil
+	case rental.FieldRenterID:
+		m.ResetRenterID()
+		return nil
+	case rental.FieldOwnerTenantID:
+		m.ResetOwnerTenantID()
+		return nil
+	case rental.FieldPickupBranchID:
+		m.ResetPickupBranchID()
+		return nil
+	case rental.FieldReturnBranchID:
+		m.ResetReturnBranchID()
+		return nil
+	case rental.FieldStartsAt:
+		m.ResetStartsAt()
+		return nil
+	case rental.FieldEndsAt:
+		m.ResetEndsAt()
+		return nil
+	case rental.FieldReturnedAt:
+		m.ResetReturnedAt()
+		return nil
+	case rental.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rental.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case rental.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Rental field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RentalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.tenant != nil {
+		edges = append(edges, rental.EdgeTenant)
+	}
+	if m.car != nil {
+		edges = append(edges, rental.EdgeCar)
+	}
+	if m.renter != nil {
+		edges = append(edges, rental.EdgeRenter)
+	}
+	if m.pickup_branch != nil {
+		edges = append(edges, rental.EdgePickupBranch)
+	}
+	if m.return_branch != nil {
+		edges = append(edges, rental.EdgeReturnBranch)
+	}
+	if m.rental_options != nil {
+		edges = append(edges, rental.EdgeRentalOptions)
+	}
+	if m.handovers != nil {
+		edges = append(edges, rental.EdgeHandovers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RentalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rental.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case rental.EdgeCar:
+		if id := m.car; id != nil {
+			return []ent.Value{*id}
+		}
+	case rental.EdgeRenter:
+		if id := m.renter; id != nil {
+			return []ent.Value{*id}
+		}
+	case rental.EdgePickupBranch:
+		if id := m.pickup_branch; id != nil {
+			return []ent.Value{*id}
+		}
+	case rental.EdgeReturnBranch:
+		if id := m.return_branch; id != nil {
+			return []ent.Value{*id}
+		}
+	case rental.EdgeRentalOptions:
+		ids := make([]ent.Value, 0, len(m.rental_options))
+		for id := range m.rental_options {
+			ids = append(ids, id)
+		}
+		return ids
+	case rental.EdgeHandovers:
+		ids := make([]ent.Value, 0, len(m.handovers))
+		for id := range m.handovers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RentalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedrental_options != nil {
+		edges = append(edges, rental.EdgeRentalOptions)
+	}
+	if m.removedhandovers != nil {
+		edges = append(edges, rental.EdgeHandovers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RentalMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case rental.EdgeRentalOptions:
+		ids := make([]ent.Value, 0, len(m.removedrental_options))
+		for id := range m.removedrental_options {
+			ids = append(ids, id)
+		}
+		return ids
+	case rental.EdgeHandovers:
+		ids := make([]ent.Value, 0, len(m.removedhandovers))
+		for id := range m.removedhandovers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RentalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.clearedtenant {
+		edges = append(edges, rental.EdgeTenant)
+	}
+	if m.clearedcar {
+		edges = append(edges, rental.EdgeCar)
+	}
+	if m.clearedrenter {
+		edges = append(edges, rental.EdgeRenter)
+	}
+	if m.clearedpickup_branch {
+		edges = append(edges, rental.EdgePickupBranch)
+	}
+	if m.clearedreturn_branch {
+		edges = append(edges, rental.EdgeReturnBranch)
+	}
+	if m.clearedrental_options {
+		edges = append(edges, rental.EdgeRentalOptions)
+	}
+	if m.clearedhandovers {
+		edges = append(edges, rental.EdgeHandovers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RentalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rental.EdgeTenant:
+		return m.clearedtenant
+	case rental.EdgeCar:
+		return m.clearedcar
+	case rental.EdgeRenter:
+		return m.clearedrenter
+	case rental.EdgePickupBranch:
+		return m.clearedpickup_branch
+	case rental.EdgeReturnBranch:
+		return m.clearedreturn_branch
+	case rental.EdgeRentalOptions:
+		return m.clearedrental_options
+	case rental.EdgeHandovers:
+		return m.clearedhandovers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RentalMutation) ClearEdge(name string) error {
+	switch name {
+	case rental.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case rental.EdgeCar:
+		m.ClearCar()
+		return nil
+	case rental.EdgeRenter:
+		m.ClearRenter()
+		return nil
+	case rental.EdgePickupBranch:
+		m.ClearPickupBranch()
+		return nil
+	case rental.EdgeReturnBranch:
+		m.ClearReturnBranch()
+		return nil
+	}
+	return fmt.Errorf("unknown Rental unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RentalMutation) ResetEdge(name string) error {
+	switch name {
+	case rental.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case rental.EdgeCar:
+		m.ResetCar()
+		return nil
+	case rental.EdgeRenter:
+		m.ResetRenter()
+		return nil
+	case rental.EdgePickupBranch:
+		m.ResetPickupBranch()
+		return nil
+	case rental.EdgeReturnBranch:
+		m.ResetReturnBranch()
+		return nil
+	case rental.EdgeRentalOptions:
+		m.ResetRentalOptions()
+		return nil
+	case rental.EdgeHandovers:
+		m.ResetHandovers()
+		return nil
+	}
+	return fmt.Errorf("unknown Rental edge %s", name)
+}
+
+// RentalHandoverMutation represents an operation that mutates the RentalHandover nodes in the graph.
+type RentalHandoverMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	kind              *string
+	odometer_km       *int
+	addodometer_km    *int
+	fuel_level        *int
+	addfuel_level     *int
+	damages           *[]schema.HandoverDamage
+	appenddamages     []schema.HandoverDamage
+	distance_km       *int
+	adddistance_km    *int
+	fuel_shortfall    *int
+	addfuel_shortfall *int
+	inspected_at      *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	tenant            *string
+	clearedtenant     bool
+	rental            *string
+	clearedrental     bool
+	done              bool
+	oldValue          func(context.Context) (*RentalHandover, error)
+	predicates        []predicate.RentalHandover
+}
+
+var _ ent.Mutation = (*RentalHandoverMutation)(nil)
+
+// rentalhandoverOption allows management of the mutation configuration using functional options.
+type rentalhandoverOption func(*RentalHandoverMutation)
+
+// newRentalHandoverMutation creates new mutation for the RentalHandover entity.
+func newRentalHandoverMutation(c config, op Op, opts ...rentalhandoverOption) *RentalHandoverMutation {
+	m := &RentalHandoverMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRentalHandover,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRentalHandoverID sets the ID field of the mutation.
+func withRentalHandoverID(id string) rentalhandoverOption {
+	return func(m *RentalHandoverMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RentalHandover
+		)
+		m.oldValue = func(ctx context.Context) (*RentalHandover, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RentalHandover.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRentalHandover sets the old RentalHandover of the mutation.
+func withRentalHandover(node *RentalHandover) rentalhandoverOption {
+	return func(m *RentalHandoverMutation) {
+		m.oldValue = func(context.Context) (*RentalHandover, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RentalHandoverMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RentalHandoverMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RentalHandover entities.
+func (m *RentalHandoverMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RentalHandoverMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RentalHandoverMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RentalHandover.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RentalHandoverMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RentalHandoverMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RentalHandoverMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetRentalID sets the "rental_id" field.
+func (m *RentalHandoverMutation) SetRentalID(s string) {
+	m.rental = &s
+}
+
+// RentalID returns the value of the "rental_id" field in the mutation.
+func (m *RentalHandoverMutation) RentalID() (r string, exists bool) {
+	v := m.rental
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRentalID returns the old "rental_id" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldRentalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRentalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRentalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRentalID: %w", err)
+	}
+	return oldValue.RentalID, nil
+}
+
+// ResetRentalID resets all changes to the "rental_id" field.
+func (m *RentalHandoverMutation) ResetRentalID() {
+	m.rental = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *RentalHandoverMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *RentalHandoverMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *RentalHandoverMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetOdometerKm sets the "odometer_km" field.
+func (m *RentalHandoverMutation) SetOdometerKm(i int) {
+	m.odometer_km = &i
+	m.addodometer_km = nil
+}
+
+// OdometerKm returns the value of the "odometer_km" field in the mutation.
+func (m *RentalHandoverMutation) OdometerKm() (r int, exists bool) {
+	v := m.odometer_km
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOdometerKm returns the old "odometer_km" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldOdometerKm(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOdometerKm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOdometerKm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOdometerKm: %w", err)
+	}
+	return oldValue.OdometerKm, nil
+}
+
+// AddOdometerKm adds i to the "odometer_km" field.
+func (m *RentalHandoverMutation) AddOdometerKm(i int) {
+	if m.addodometer_km != nil {
+		*m.addodometer_km += i
+	} else {
+		m.addodometer_km = &i
+	}
+}
+
+// AddedOdometerKm returns the value that was added to the "odometer_km" field in this mutation.
+func (m *RentalHandoverMutation) AddedOdometerKm() (r int, exists bool) {
+	v := m.addodometer_km
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOdometerKm resets all changes to the "odometer_km" field.
+func (m *RentalHandoverMutation) ResetOdometerKm() {
+	m.odometer_km = nil
+	m.addodometer_km = nil
+}
+
+// SetFuelLevel sets the "fuel_level" field.
+func (m *RentalHandoverMutation) SetFuelLevel(i int) {
+	m.fuel_level = &i
+	m.addfuel_level = nil
+}
+
+// FuelLevel returns the value of the "fuel_level" field in the mutation.
+func (m *RentalHandoverMutation) FuelLevel() (r int, exists bool) {
+	v := m.fuel_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFuelLevel returns the old "fuel_level" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldFuelLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFuelLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFuelLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFuelLevel: %w", err)
+	}
+	return oldValue.FuelLevel, nil
+}
+
+// AddFuelLevel adds i to the "fuel_level" field.
+func (m *RentalHandoverMutation) AddFuelLevel(i int) {
+	if m.addfuel_level != nil {
+		*m.addfuel_level += i
+	} else {
+		m.addfuel_level = &i
+	}
+}
+
+// AddedFuelLevel returns the value that was added to the "fuel_level" field in this mutation.
+func (m *RentalHandoverMutation) AddedFuelLevel() (r int, exists bool) {
+	v := m.addfuel_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFuelLevel resets all changes to the "fuel_level" field.
+func (m *RentalHandoverMutation) ResetFuelLevel() {
+	m.fuel_level = nil
+	m.addfuel_level = nil
+}
+
+// SetDamages sets the "damages" field.
+func (m *RentalHandoverMutation) SetDamages(sd []schema.HandoverDamage) {
+	m.damages = &sd
+	m.appenddamages = nil
+}
+
+// Damages returns the value of the "damages" field in the mutation.
+func (m *RentalHandoverMutation) Damages() (r []schema.HandoverDamage, exists bool) {
+	v := m.damages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDamages returns the old "damages" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldDamages(ctx context.Context) (v []schema.HandoverDamage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDamages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDamages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDamages: %w", err)
+	}
+	return oldValue.Damages, nil
+}
+
+// AppendDamages adds sd to the "damages" field.
+func (m *RentalHandoverMutation) AppendDamages(sd []schema.HandoverDamage) {
+	m.appenddamages = append(m.appenddamages, sd...)
+}
+
+// AppendedDamages returns the list of values that were appended to the "damages" field in this mutation.
+func (m *RentalHandoverMutation) AppendedDamages() ([]schema.HandoverDamage, bool) {
+	if len(m.appenddamages) == 0 {
+		return nil, false
+	}
+	return m.appenddamages, true
+}
+
+// ClearDamages clears the value of the "damages" field.
+func (m *RentalHandoverMutation) ClearDamages() {
+	m.damages = nil
+	m.appenddamages = nil
+	m.clearedFields[rentalhandover.FieldDamages] = struct{}{}
+}
+
+// DamagesCleared returns if the "damages" field was cleared in this mutation.
+func (m *RentalHandoverMutation) DamagesCleared() bool {
+	_, ok := m.clearedFields[rentalhandover.FieldDamages]
+	return ok
+}
+
+// ResetDamages resets all changes to the "damages" field.
+func (m *RentalHandoverMutation) ResetDamages() {
+	m.damages = nil
+	m.appenddamages = nil
+	delete(m.clearedFields, rentalhandover.FieldDamages)
+}
+
+// SetDistanceKm sets the "distance_km" field.
+func (m *RentalHandoverMutation) SetDistanceKm(i int) {
+	m.distance_km = &i
+	m.adddistance_km = nil
+}
+
+// DistanceKm returns the value of the "distance_km" field in the mutation.
+func (m *RentalHandoverMutation) DistanceKm() (r int, exists bool) {
+	v := m.distance_km
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDistanceKm returns the old "distance_km" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldDistanceKm(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDistanceKm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDistanceKm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDistanceKm: %w", err)
+	}
+	return oldValue.DistanceKm, nil
+}
+
+// AddDistanceKm adds i to the "distance_km" field.
+func (m *RentalHandoverMutation) AddDistanceKm(i int) {
+	if m.adddistance_km != nil {
+		*m.adddistance_km += i
+	} else {
+		m.adddistance_km = &i
+	}
+}
+
+// AddedDistanceKm returns the value that was added to the "distance_km" field in this mutation.
+func (m *RentalHandoverMutation) AddedDistanceKm() (r int, exists bool) {
+	v := m.adddistance_km
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDistanceKm resets all changes to the "distance_km" field.
+func (m *RentalHandoverMutation) ResetDistanceKm() {
+	m.distance_km = nil
+	m.adddistance_km = nil
+}
+
+// SetFuelShortfall sets the "fuel_shortfall" field.
+func (m *RentalHandoverMutation) SetFuelShortfall(i int) {
+	m.fuel_shortfall = &i
+	m.addfuel_shortfall = nil
+}
+
+// FuelShortfall returns the value of the "fuel_shortfall" field in the mutation.
+func (m *RentalHandoverMutation) FuelShortfall() (r int, exists bool) {
+	v := m.fuel_shortfall
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFuelShortfall returns the old "fuel_shortfall" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldFuelShortfall(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFuelShortfall is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFuelShortfall requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFuelShortfall: %w", err)
+	}
+	return oldValue.FuelShortfall, nil
+}
+
+// AddFuelShortfall adds i to the "fuel_shortfall" field.
+func (m *RentalHandoverMutation) AddFuelShortfall(i int) {
+	if m.addfuel_shortfall != nil {
+		*m.addfuel_shortfall += i
+	} else {
+		m.addfuel_shortfall = &i
+	}
+}
+
+// AddedFuelShortfall returns the value that was added to the "fuel_shortfall" field in this mutation.
+func (m *RentalHandoverMutation) AddedFuelShortfall() (r int, exists bool) {
+	v := m.addfuel_shortfall
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFuelShortfall resets all changes to the "fuel_shortfall" field.
+func (m *RentalHandoverMutation) ResetFuelShortfall() {
+	m.fuel_shortfall = nil
+	m.addfuel_shortfall = nil
+}
+
+// SetInspectedAt sets the "inspected_at" field.
+func (m *RentalHandoverMutation) SetInspectedAt(t time.Time) {
+	m.inspected_at = &t
+}
+
+// InspectedAt returns the value of the "inspected_at" field in the mutation.
+func (m *RentalHandoverMutation) InspectedAt() (r time.Time, exists bool) {
+	v := m.inspected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInspectedAt returns the old "inspected_at" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldInspectedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInspectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInspectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInspectedAt: %w", err)
+	}
+	return oldValue.InspectedAt, nil
+}
+
+// ResetInspectedAt resets all changes to the "inspected_at" field.
+func (m *RentalHandoverMutation) ResetInspectedAt() {
+	m.inspected_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RentalHandoverMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RentalHandoverMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RentalHandover entity.
+// If the RentalHandover object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalHandoverMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *RentalHandoverMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[rentalhandover.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *RentalHandoverMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[rentalhandover.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RentalHandoverMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, rentalhandover.FieldCreatedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *RentalHandoverMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[rentalhandover.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *RentalHandoverMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *RentalHandoverMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *RentalHandoverMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearRental clears the "rental" edge to the Rental entity.
+func (m *RentalHandoverMutation) ClearRental() {
+	m.clearedrental = true
+	m.clearedFields[rentalhandover.FieldRentalID] = struct{}{}
+}
+
+// RentalCleared reports if the "rental" edge to the Rental entity was cleared.
+func (m *RentalHandoverMutation) RentalCleared() bool {
+	return m.clearedrental
+}
+
+// RentalIDs returns the "rental" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RentalID instead. It exists only for internal usage by the builders.
+func (m *RentalHandoverMutation) RentalIDs() (ids []string) {
+	if id := m.rental; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRental resets all changes to the "rental" edge.
+func (m *RentalHandoverMutation) ResetRental() {
+	m.rental = nil
+	m.clearedrental = false
+}
+
+// Where appends a list predicates to the RentalHandoverMutation builder.
+func (m *RentalHandoverMutation) Where(ps ...predicate.RentalHandover) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RentalHandoverMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RentalHandoverMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RentalHandover, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RentalHandoverMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RentalHandoverMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RentalHandover).
+func (m *RentalHandoverMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RentalHandoverMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.tenant != nil {
+		fields = append(fields, rentalhandover.FieldTenantID)
+	}
+	if m.rental != nil {
+		fields = append(fields, rentalhandover.FieldRentalID)
+	}
+	if m.kind != nil {
+		fields = append(fields, rentalhandover.FieldKind)
+	}
+	if m.odometer_km != nil {
+		fields = append(fields, rentalhandover.FieldOdometerKm)
+	}
+	if m.fuel_level != nil {
+		fields = append(fields, rentalhandover.FieldFuelLevel)
+	}
+	if m.damages != nil {
+		fields = append(fields, rentalhandover.FieldDamages)
+	}
+	if m.distance_km != nil {
+		fields = append(fields, rentalhandover.FieldDistanceKm)
+	}
+	if m.fuel_shortfall != nil {
+		fields = append(fields, rentalhandover.FieldFuelShortfall)
+	}
+	if m.inspected_at != nil {
+		fields = append(fields, rentalhandover.FieldInspectedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rentalhandover.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RentalHandoverMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rentalhandover.FieldTenantID:
+		return m.TenantID()
+	case rentalhandover.FieldRentalID:
+		return m.RentalID()
+	case rentalhandover.FieldKind:
+		return m.Kind()
+	case rentalhandover.FieldOdometerKm:
+		return m.OdometerKm()
+	case rentalhandover.FieldFuelLevel:
+		return m.FuelLevel()
+	case rentalhandover.FieldDamages:
+		return m.Damages()
+	case rentalhandover.FieldDistanceKm:
+		return m.DistanceKm()
+	case rentalhandover.FieldFuelShortfall:
+		return m.FuelShortfall()
+	case rentalhandover.FieldInspectedAt:
+		return m.InspectedAt()
+	case rentalhandover.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RentalHandoverMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rentalhandover.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case rentalhandover.FieldRentalID:
+		return m.OldRentalID(ctx)
+	case rentalhandover.FieldKind:
+		return m.OldKind(ctx)
+	case rentalhandover.FieldOdometerKm:
+		return m.OldOdometerKm(ctx)
+	case rentalhandover.FieldFuelLevel:
+		return m.OldFuelLevel(ctx)
+	case rentalhandover.FieldDamages:
+		return m.OldDamages(ctx)
+	case rentalhandover.FieldDistanceKm:
+		return m.OldDistanceKm(ctx)
+	case rentalhandover.FieldFuelShortfall:
+		return m.OldFuelShortfall(ctx)
+	case rentalhandover.FieldInspectedAt:
+		return m.OldInspectedAt(ctx)
+	case rentalhandover.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RentalHandover field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RentalHandoverMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rentalhandover.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case rentalhandover.FieldRentalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRentalID(v)
+		return nil
+	case rentalhandover.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case rentalhandover.FieldOdometerKm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOdometerKm(v)
+		return nil
+	case rentalhandover.FieldFuelLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFuelLevel(v)
+		return nil
+	case rentalhandover.FieldDamages:
+		v, ok := value.([]schema.HandoverDamage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDamages(v)
+		return nil
+	case rentalhandover.FieldDistanceKm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDistanceKm(v)
+		return nil
+	case rentalhandover.FieldFuelShortfall:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFuelShortfall(v)
+		return nil
+	case rentalhandover.FieldInspectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInspectedAt(v)
+		return nil
+	case rentalhandover.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RentalHandover field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RentalHandoverMutation) AddedFields() []string {
+	var fields []string
+	if m.addodometer_km != nil {
+		fields = append(fields, rentalhandover.FieldOdometerKm)
+	}
+	if m.addfuel_level != nil {
+		fields = append(fields, rentalhandover.FieldFuelLevel)
+	}
+	if m.adddistance_km != nil {
+		fields = append(fields, rentalhandover.FieldDistanceKm)
+	}
+	if m.addfuel_shortfall != nil {
+		fields = append(fields, rentalhandover.FieldFuelShortfall)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RentalHandoverMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rentalhandover.FieldOdometerKm:
+		return m.AddedOdometerKm()
+	case rentalhandover.FieldFuelLevel:
+		return m.AddedFuelLevel()
+	case rentalhandover.FieldDistanceKm:
+		return m.AddedDistanceKm()
+	case rentalhandover.FieldFuelShortfall:
+		return m.AddedFuelShortfall()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RentalHandoverMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rentalhandover.FieldOdometerKm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOdometerKm(v)
+		return nil
+	case rentalhandover.FieldFuelLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFuelLevel(v)
+		return nil
+	case rentalhandover.FieldDistanceKm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDistanceKm(v)
+		return nil
+	case rentalhandover.FieldFuelShortfall:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFuelShortfall(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RentalHandover numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RentalHandoverMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rentalhandover.FieldDamages) {
+		fields = append(fields, rentalhandover.FieldDamages)
+	}
+	if m.FieldCleared(rentalhandover.FieldCreatedAt) {
+		fields = append(fields, rentalhandover.FieldCreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RentalHandoverMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RentalHandoverMutation) ClearField(name string) error {
+	switch name {
+	case rentalhandover.FieldDamages:
+		m.ClearDamages()
+		return nil
+	case rentalhandover.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RentalHandover nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RentalHandoverMutation) ResetField(name string) error {
+	switch name {
+	case rentalhandover.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case rentalhandover.FieldRentalID:
+		m.ResetRentalID()
+		return nil
+	case rentalhandover.FieldKind:
+		m.ResetKind()
+		return nil
+	case rentalhandover.FieldOdometerKm:
+		m.ResetOdometerKm()
+		return nil
+	case rentalhandover.FieldFuelLevel:
+		m.ResetFuelLevel()
+		return nil
+	case rentalhandover.FieldDamages:
+		m.ResetDamages()
+		return nil
+	case rentalhandover.FieldDistanceKm:
+		m.ResetDistanceKm()
+		return nil
+	case rentalhandover.FieldFuelShortfall:
+		m.ResetFuelShortfall()
+		return nil
+	case rentalhandover.FieldInspectedAt:
+		m.ResetInspectedAt()
+		return nil
+	case rentalhandover.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RentalHandover field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RentalHandoverMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, rentalhandover.EdgeTenant)
+	}
+	if m.rental != nil {
+		edges = append(edges, rentalhandover.EdgeRental)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RentalHandoverMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rentalhandover.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case rentalhandover.EdgeRental:
+		if id := m.rental; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RentalHandoverMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RentalHandoverMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RentalHandoverMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, rentalhandover.EdgeTenant)
+	}
+	if m.clearedrental {
+		edges = append(edges, rentalhandover.EdgeRental)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RentalHandoverMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rentalhandover.EdgeTenant:
+		return m.clearedtenant
+	case rentalhandover.EdgeRental:
+		return m.clearedrental
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RentalHandoverMutation) ClearEdge(name string) error {
+	switch name {
+	case rentalhandover.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case rentalhandover.EdgeRental:
+		m.ClearRental()
+		return nil
+	}
+	return fmt.Errorf("unknown RentalHandover unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RentalHandoverMutation) ResetEdge(name string) error {
+	switch name {
+	case rentalhandover.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case rentalhandover.EdgeRental:
+		m.ResetRental()
+		return nil
+	}
+	return fmt.Errorf("unknown RentalHandover edge %s", name)
+}
+
+// RentalOptionMutation represents an operation that mutates the RentalOption nodes in the graph.
+type RentalOptionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	count         *int
+	addcount      *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	tenant        *string
+	clearedtenant bool
+	rental        *string
+	clearedrental bool
+	option        *string
+	clearedoption bool
+	done          bool
+	oldValue      func(context.Context) (*RentalOption, error)
+	predicates    []predicate.RentalOption
+}
+
+var _ ent.Mutation = (*RentalOptionMutation)(nil)
+
+// rentaloptionOption allows management of the mutation configuration using functional options.
+type rentaloptionOption func(*RentalOptionMutation)
+
+// newRentalOptionMutation creates new mutation for the RentalOption entity.
+func newRentalOptionMutation(c config, op Op, opts ...rentaloptionOption) *RentalOptionMutation {
+	m := &RentalOptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRentalOption,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRentalOptionID sets the ID field of the mutation.
+func withRentalOptionID(id string) rentaloptionOption {
+	return func(m *RentalOptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RentalOption
+		)
+		m.oldValue = func(ctx context.Context) (*RentalOption, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RentalOption.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRentalOption sets the old RentalOption of the mutation.
+func withRentalOption(node *RentalOption) rentaloptionOption {
+	return func(m *RentalOptionMutation) {
+		m.oldValue = func(context.Context) (*RentalOption, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RentalOptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RentalOptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RentalOption entities.
+func (m *RentalOptionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RentalOptionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RentalOptionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RentalOption.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RentalOptionMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RentalOptionMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the RentalOption entity.
+// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalOptionMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RentalOptionMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetRentalID sets the "rental_id" field.
+func (m *RentalOptionMutation) SetRentalID(s string) {
+	m.rental = &s
+}
+
+// RentalID returns the value of the "rental_id" field in the mutation.
+func (m *RentalOptionMutation) RentalID() (r string, exists bool) {
+	v := m.rental
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRentalID returns the old "rental_id" field's value of the RentalOption entity.
+// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalOptionMutation) OldRentalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRentalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRentalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRentalID: %w", err)
+	}
+	return oldValue.RentalID, nil
+}
+
+// ResetRentalID resets all changes to the "rental_id" field.
+func (m *RentalOptionMutation) ResetRentalID() {
+	m.rental = nil
+}
+
+// SetOptionID sets the "option_id" field.
+func (m *RentalOptionMutation) SetOptionID(s string) {
+	m.option = &s
+}
+
+// OptionID returns the value of the "option_id" field in the mutation.
+func (m *RentalOptionMutation) OptionID() (r string, exists bool) {
+	v := m.option
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptionID returns the old "option_id" field's value of the RentalOption entity.
+// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalOptionMutation) OldOptionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptionID: %w", err)
+	}
+	return oldValue.OptionID, nil
+}
+
+// ResetOptionID resets all changes to the "option_id" field.
+func (m *RentalOptionMutation) ResetOptionID() {
+	m.option = nil
+}
+
+// SetCount sets the "count" field.
+func (m *RentalOptionMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *RentalOptionMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the RentalOption entity.
+// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalOptionMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *RentalOptionMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *RentalOptionMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *RentalOptionMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RentalOptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RentalOptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RentalOption entity.
+// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalOptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *RentalOptionMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[rentaloption.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *RentalOptionMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[rentaloption.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RentalOptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, rentaloption.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RentalOptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RentalOptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RentalOption entity.
+// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalOptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *RentalOptionMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[rentaloption.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *RentalOptionMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[rentaloption.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RentalOptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, rentaloption.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *RentalOptionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *RentalOptionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the RentalOption entity.
+// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RentalOptionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *RentalOptionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[rentaloption.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *RentalOptionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[rentaloption.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *RentalOptionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, rentaloption.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *RentalOptionMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[rentaloption.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *RentalOptionMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *RentalOptionMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *RentalOptionMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearRental clears the "rental" edge to the Rental entity.
+func (m *RentalOptionMutation) ClearRental() {
+	m.clearedrental = true
+	m.clearedFields[rentaloption.FieldRentalID] = struct{}{}
+}
+
+// RentalCleared reports if the "rental" edge to the Rental entity was cleared.
+func (m *RentalOptionMutation) RentalCleared() bool {
+	return m.clearedrental
+}
+
+// RentalIDs returns the "rental" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RentalID instead. It exists only for internal usage by the builders.
+func (m *RentalOptionMutation) RentalIDs() (ids []string) {
+	if id := m.rental; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRental resets all changes to the "rental" edge.
+func (m *RentalOptionMutation) ResetRental() {
+	m.rental = nil
+	m.clearedrental = false
+}
+
+// ClearOption clears the "option" edge to the CarOption entity.
+func (m *RentalOptionMutation) ClearOption() {
+	m.clearedoption = true
+	m.clearedFields[rentaloption.FieldOptionID] = struct{}{}
+}
+
+// OptionCleared reports if the "option" edge to the CarOption entity was cleared.
+func (m *RentalOptionMutation) OptionCleared() bool {
+	return m.clearedoption
+}
+
+// OptionIDs returns the "option" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OptionID instead. It exists only for internal usage by the builders.
+func (m *RentalOptionMutation) OptionIDs() (ids []string) {
+	if id := m.option; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOption resets all changes to the "option" edge.
+func (m *RentalOptionMutation) ResetOption() {
+	m.option = nil
+	m.clearedoption = false
+}
+
+// Where appends a list predicates to the RentalOptionMutation builder.
+func (m *RentalOptionMutation) Where(ps ...predicate.RentalOption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RentalOptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RentalOptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RentalOption, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RentalOptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RentalOptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RentalOption).
+func (m *RentalOptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RentalOptionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.tenant != nil {
+		fields = append(fields, rentaloption.FieldTenantID)
+	}
+	if m.rental != nil {
+		fields = append(fields, rentaloption.FieldRentalID)
+	}
+	if m.option != nil {
+		fields = append(fields, rentaloption.FieldOptionID)
+	}
+	if m.count != nil {
+		fields = append(fields, rentaloption.FieldCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rentaloption.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rentaloption.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, rentaloption.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RentalOptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rentaloption.FieldTenantID:
+		return m.TenantID()
+	case rentaloption.FieldRentalID:
+		return m.RentalID()
+	case rentaloption.FieldOptionID:
+		return m.OptionID()
+	case rentaloption.FieldCount:
+		return m.Count()
+	case rentaloption.FieldCreatedAt:
+		return m.CreatedAt()
+	case rentaloption.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case rentaloption.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RentalOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rentaloption.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case rentaloption.FieldRentalID:
+		return m.OldRentalID(ctx)
+	case rentaloption.FieldOptionID:
+		return m.OldOptionID(ctx)
+	case rentaloption.FieldCount:
+		return m.OldCount(ctx)
+	case rentaloption.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rentaloption.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case rentaloption.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RentalOption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RentalOptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rentaloption.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case rentaloption.FieldRentalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRentalID(v)
+		return nil
+	case rentaloption.FieldOptionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptionID(v)
+		return nil
+	case rentaloption.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	case rentaloption.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rentaloption.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case rentaloption.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RentalOption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RentalOptionMutation) AddedFields() []string {
+	var fields []string
+	if m.addcount != nil {
+		fields = append(fields, rentaloption.FieldCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RentalOptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rentaloption.FieldCount:
+		return m.AddedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RentalOptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rentaloption.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RentalOption numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RentalOptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rentaloption.FieldCreatedAt) {
+		fields = append(fields, rentaloption.FieldCreatedAt)
+	}
+	if m.FieldCleared(rentaloption.FieldUpdatedAt) {
+		fields = append(fields, rentaloption.FieldUpdatedAt)
+	}
+	if m.FieldCleared(rentaloption.FieldDeletedAt) {
+		fields = append(fields, rentaloption.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RentalOptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RentalOptionMutation) ClearField(name string) error {
+	switch name {
+	case rentaloption.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case rentaloption.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case rentaloption.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RentalOption nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RentalOptionMutation) ResetField(name string) error {
+	switch name {
+	case rentaloption.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case rentaloption.FieldRentalID:
+		m.ResetRentalID()
+		return nil
+	case rentaloption.FieldOptionID:
+		m.ResetOptionID()
+		return nil
+	case rentaloption.FieldCount:
+		m.ResetCount()
+		return nil
+	case rentaloption.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rentaloption.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case rentaloption.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RentalOption field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RentalOptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.tenant != nil {
+		edges = append(edges, rentaloption.EdgeTenant)
+	}
+	if m.rental != nil {
+		edges = append(edges, rentaloption.EdgeRental)
+	}
+	if m.option != nil {
+		edges = append(edges, rentaloption.EdgeOption)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RentalOptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rentaloption.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case rentaloption.EdgeRental:
+		if id := m.rental; id != nil {
+			return []ent.Value{*id}
+		}
+	case rentaloption.EdgeOption:
+		if id := m.option; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RentalOptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RentalOptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RentalOptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtenant {
+		edges = append(edges, rentaloption.EdgeTenant)
+	}
+	if m.clearedrental {
+		edges = append(edges, rentaloption.EdgeRental)
+	}
+	if m.clearedoption {
+		edges = append(edges, rentaloption.EdgeOption)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RentalOptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rentaloption.EdgeTenant:
+		return m.clearedtenant
+	case rentaloption.EdgeRental:
+		return m.clearedrental
+	case rentaloption.EdgeOption:
+		return m.clearedoption
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RentalOptionMutation) ClearEdge(name string) error {
+	switch name {
+	case rentaloption.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case rentaloption.EdgeRental:
+		m.ClearRental()
+		return nil
+	case rentaloption.EdgeOption:
+		m.ClearOption()
+		return nil
+	}
+	return fmt.Errorf("unknown RentalOption unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RentalOptionMutation) ResetEdge(name string) error {
+	switch name {
+	case rentaloption.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case rentaloption.EdgeRental:
+		m.ResetRental()
+		return nil
+	case rentaloption.EdgeOption:
+		m.ResetOption()
+		return nil
+	}
+	return fmt.Errorf("unknown RentalOption edge %s", name)
+}
+
+// RenterMutation represents an operation that mutates the Renter nodes in the graph.
+type RenterMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	_type             *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	deleted_at        *time.Time
+	clearedFields     map[string]struct{}
+	tenant            *string
+	clearedtenant     bool
+	rentals           map[string]struct{}
+	removedrentals    map[string]struct{}
+	clearedrentals    bool
+	company           *string
+	clearedcompany    bool
+	individual        *string
+	clearedindividual bool
+	done              bool
+	oldValue          func(context.Context) (*Renter, error)
+	predicates        []predicate.Renter
+}
+
+var _ ent.Mutation = (*RenterMutation)(nil)
+
+// renterOption allows management of the mutation configuration using functional options.
+type renterOption func(*RenterMutation)
+
+// newRenterMutation creates new mutation for the Renter entity.
+func newRenterMutation(c config, op Op, opts ...renterOption) *RenterMutation {
+	m := &RenterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRenter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRenterID sets the ID field of the mutation.
+func withRenterID(id string) renterOption {
+	return func(m *RenterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Renter
+		)
+		m.oldValue = func(ctx context.Context) (*Renter, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Renter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRenter sets the old Renter of the mutation.
+func withRenter(node *Renter) renterOption {
+	return func(m *RenterMutation) {
+		m.oldValue = func(context.Context) (*Renter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RenterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RenterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Renter entities.
+func (m *RenterMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RenterMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RenterMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Renter.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *RenterMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *RenterMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Renter entity.
+// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenterMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *RenterMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetType sets the "type" field.
+func (m *RenterMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *RenterMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Renter entity.
+// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenterMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *RenterMutation) ResetType() {
+	m._type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RenterMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RenterMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Renter entity.
+// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *RenterMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[renter.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *RenterMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[renter.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RenterMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, renter.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RenterMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RenterMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Renter entity.
+// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenterMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *RenterMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[renter.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *RenterMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[renter.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RenterMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, renter.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *RenterMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *RenterMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Renter entity.
+// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RenterMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *RenterMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[renter.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *RenterMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[renter.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *RenterMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, renter.FieldDeletedAt)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *RenterMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[renter.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *RenterMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *RenterMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *RenterMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// AddRentalIDs adds the "rentals" edge to the Rental entity by ids.
+func (m *RenterMutation) AddRentalIDs(ids ...string) {
+	if m.rentals == nil {
+		m.rentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.rentals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRentals clears the "rentals" edge to the Rental entity.
+func (m *RenterMutation) ClearRentals() {
+	m.clearedrentals = true
+}
+
+// RentalsCleared reports if the "rentals" edge to the Rental entity was cleared.
+func (m *RenterMutation) RentalsCleared() bool {
+	return m.clearedrentals
+}
+
+// RemoveRentalIDs removes the "rentals" edge to the Rental entity by IDs.
+func (m *RenterMutation) RemoveRentalIDs(ids ...string) {
+	if m.removedrentals == nil {
+		m.removedrentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.rentals, ids[i])
+		m.removedrentals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRentals returns the removed IDs of the "rentals" edge to the Rental entity.
+func (m *RenterMutation) RemovedRentalsIDs() (ids []string) {
+	for id := range m.removedrentals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RentalsIDs returns the "rentals" edge IDs in the mutation.
+func (m *RenterMutation) RentalsIDs() (ids []string) {
+	for id := range m.rentals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRentals resets all changes to the "rentals" edge.
+func (m *RenterMutation) ResetRentals() {
+	m.rentals = nil
+	m.clearedrentals = false
+	m.removedrentals = nil
+}
+
+// SetCompanyID sets the "company" edge to the Company entity by id.
+func (m *RenterMutation) SetCompanyID(id string) {
+	m.company = &id
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (m *RenterMutation) ClearCompany() {
+	m.clearedcompany = true
+}
+
+// CompanyCleared reports if the "company" edge to the Company entity was cleared.
+func (m *RenterMutation) CompanyCleared() bool {
+	return m.clearedcompany
+}
+
+// CompanyID returns the "company" edge ID in the mutation.
+func (m *RenterMutation) CompanyID() (id string, exists bool) {
+	if m.company != nil {
+		return *m.company, true
+	}
+	return
+}
+
+// CompanyIDs returns the "company" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompanyID instead. It exists only for internal usage by the builders.
+func (m *RenterMutation) CompanyIDs() (ids []string) {
+	if id := m.company; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCompany resets all changes to the "company" edge.
+func (m *RenterMutation) ResetCompany() {
+	m.company = nil
+	m.clearedcompany = false
+}
+
+// SetIndividualID sets the "individual" edge to the Individual entity by id.
+func (m *RenterMutation) SetIndividualID(id string) {
+	m.individual = &id
+}
+
+// ClearIndividual clears the "individual" edge to the Individual entity.
+func (m *RenterMutation) ClearIndividual() {
+	m.clearedindividual = true
+}
+
+// IndividualCleared reports if the "individual" edge to the Individual entity was cleared.
+func (m *RenterMutation) IndividualCleared() bool {
+	return m.clearedindividual
+}
+
+// IndividualID returns the "individual" edge ID in the mutation.
+func (m *RenterMutation) IndividualID() (id string, exists bool) {
+	if m.individual != nil {
+		return *m.individual, true
+	}
+	return
+}
+
+// IndividualIDs returns the "individual" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// IndividualID instead. It exists only for internal usage by the builders.
+func (m *RenterMutation) IndividualIDs() (ids []string) {
+	if id := m.individual; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIndividual resets all changes to the "individual" edge.
+func (m *RenterMutation) ResetIndividual() {
+	m.individual = nil
+	m.clearedindividual = false
+}
+
+// Where appends a list predicates to the RenterMutation builder.
+func (m *RenterMutation) Where(ps ...predicate.Renter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RenterMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RenterMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Renter, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
-	m.Where(p...)
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RenterMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RenterMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Renter).
+func (m *RenterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RenterMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant != nil {
+		fields = append(fields, renter.FieldTenantID)
+	}
+	if m._type != nil {
+		fields = append(fields, renter.FieldType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, renter.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, renter.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, renter.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RenterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case renter.FieldTenantID:
+		return m.TenantID()
+	case renter.FieldType:
+		return m.GetType()
+	case renter.FieldCreatedAt:
+		return m.CreatedAt()
+	case renter.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case renter.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RenterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case renter.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case renter.FieldType:
+		return m.OldType(ctx)
+	case renter.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case renter.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case renter.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Renter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case renter.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case renter.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case renter.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case renter.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case renter.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Renter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RenterMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RenterMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RenterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Renter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RenterMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(renter.FieldCreatedAt) {
+		fields = append(fields, renter.FieldCreatedAt)
+	}
+	if m.FieldCleared(renter.FieldUpdatedAt) {
+		fields = append(fields, renter.FieldUpdatedAt)
+	}
+	if m.FieldCleared(renter.FieldDeletedAt) {
+		fields = append(fields, renter.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RenterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RenterMutation) ClearField(name string) error {
+	switch name {
+	case renter.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case renter.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	case renter.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Renter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RenterMutation) ResetField(name string) error {
+	switch name {
+	case renter.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case renter.FieldType:
+		m.ResetType()
+		return nil
+	case renter.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case renter.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case renter.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Renter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RenterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.tenant != nil {
+		edges = append(edges, renter.EdgeTenant)
+	}
+	if m.rentals != nil {
+		edges = append(edges, renter.EdgeRentals)
+	}
+	if m.company != nil {
+		edges = append(edges, renter.EdgeCompany)
+	}
+	if m.individual != nil {
+		edges = append(edges, renter.EdgeIndividual)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RenterMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case renter.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case renter.EdgeRentals:
+		ids := make([]ent.Value, 0, len(m.rentals))
+		for id := range m.rentals {
+			ids = append(ids, id)
+		}
+		return ids
+	case renter.EdgeCompany:
+		if id := m.company; id != nil {
+			return []ent.Value{*id}
+		}
+	case renter.EdgeIndividual:
+		if id := m.individual; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RenterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedrentals != nil {
+		edges = append(edges, renter.EdgeRentals)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RenterMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case renter.EdgeRentals:
+		ids := make([]ent.Value, 0, len(m.removedrentals))
+		for id := range m.removedrentals {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RenterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtenant {
+		edges = append(edges, renter.EdgeTenant)
+	}
+	if m.clearedrentals {
+		edges = append(edges, renter.EdgeRentals)
+	}
+	if m.clearedcompany {
+		edges = append(edges, renter.EdgeCompany)
+	}
+	if m.clearedindividual {
+		edges = append(edges, renter.EdgeIndividual)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RenterMutation) EdgeCleared(name string) bool {
+	switch name {
+	case renter.EdgeTenant:
+		return m.clearedtenant
+	case renter.EdgeRentals:
+		return m.clearedrentals
+	case renter.EdgeCompany:
+		return m.clearedcompany
+	case renter.EdgeIndividual:
+		return m.clearedindividual
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RenterMutation) ClearEdge(name string) error {
+	switch name {
+	case renter.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case renter.EdgeCompany:
+		m.ClearCompany()
+		return nil
+	case renter.EdgeIndividual:
+		m.ClearIndividual()
+		return nil
+	}
+	return fmt.Errorf("unknown Renter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RenterMutation) ResetEdge(name string) error {
+	switch name {
+	case renter.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case renter.EdgeRentals:
+		m.ResetRentals()
+		return nil
+	case renter.EdgeCompany:
+		m.ResetCompany()
+		return nil
+	case renter.EdgeIndividual:
+		m.ResetIndividual()
+		return nil
+	}
+	return fmt.Errorf("unknown Renter edge %s", name)
+}
+
+// TenantMutation represents an operation that mutates the Tenant nodes in the graph.
+type TenantMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *string
+	code                       *string
+	status                     *string
+	suspended_at               *time.Time
+	isolation                  *string
+	purge_at                   *time.Time
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	deleted_at                 *time.Time
+	clearedFields              map[string]struct{}
+	plan                       *string
+	clearedplan                bool
+	parent                     *string
+	clearedparent              bool
+	children                   map[string]struct{}
+	removedchildren            map[string]struct{}
+	clearedchildren            bool
+	api_keys                   map[string]struct{}
+	removedapi_keys            map[string]struct{}
+	clearedapi_keys            bool
+	branches                   map[string]struct{}
+	removedbranches            map[string]struct{}
+	clearedbranches            bool
+	cars                       map[string]struct{}
+	removedcars                map[string]struct{}
+	clearedcars                bool
+	car_models                 map[string]struct{}
+	removedcar_models          map[string]struct{}
+	clearedcar_models          bool
+	companies                  map[string]struct{}
+	removedcompanies           map[string]struct{}
+	clearedcompanies           bool
+	individuals                map[string]struct{}
+	removedindividuals         map[string]struct{}
+	clearedindividuals         bool
+	maintenance_windows        map[string]struct{}
+	removedmaintenance_windows map[string]struct{}
+	clearedmaintenance_windows bool
+	options                    map[string]struct{}
+	removedoptions             map[string]struct{}
+	clearedoptions             bool
+	rental_handovers           map[string]struct{}
+	removedrental_handovers    map[string]struct{}
+	clearedrental_handovers    bool
+	rental_options             map[string]struct{}
+	removedrental_options      map[string]struct{}
+	clearedrental_options      bool
+	rentals                    map[string]struct{}
+	removedrentals             map[string]struct{}
+	clearedrentals             bool
+	renters                    map[string]struct{}
+	removedrenters             map[string]struct{}
+	clearedrenters             bool
+	settings                   *string
+	clearedsettings            bool
+	webhook_endpoints          map[string]struct{}
+	removedwebhook_endpoints   map[string]struct{}
+	clearedwebhook_endpoints   bool
+	webhook_deliveries         map[string]struct{}
+	removedwebhook_deliveries  map[string]struct{}
+	clearedwebhook_deliveries  bool
+	done                       bool
+	oldValue                   func(context.Context) (*Tenant, error)
+	predicates                 []predicate.Tenant
+}
+
+var _ ent.Mutation = (*TenantMutation)(nil)
+
+// tenantOption allows management of the mutation configuration using functional options.
+type tenantOption func(*TenantMutation)
+
+// newTenantMutation creates new mutation for the Tenant entity.
+func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
+	m := &TenantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTenant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTenantID sets the ID field of the mutation.
+func withTenantID(id string) tenantOption {
+	return func(m *TenantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tenant
+		)
+		m.oldValue = func(ctx context.Context) (*Tenant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tenant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTenant sets the old Tenant of the mutation.
+func withTenant(node *Tenant) tenantOption {
+	return func(m *TenantMutation) {
+		m.oldValue = func(context.Context) (*Tenant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tenant entities.
+func (m *TenantMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tenant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *TenantMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *TenantMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *TenantMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TenantMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TenantMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TenantMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (m *TenantMutation) SetSuspendedAt(t time.Time) {
+	m.suspended_at = &t
+}
+
+// SuspendedAt returns the value of the "suspended_at" field in the mutation.
+func (m *TenantMutation) SuspendedAt() (r time.Time, exists bool) {
+	v := m.suspended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspendedAt returns the old "suspended_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldSuspendedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspendedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspendedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspendedAt: %w", err)
+	}
+	return oldValue.SuspendedAt, nil
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (m *TenantMutation) ClearSuspendedAt() {
+	m.suspended_at = nil
+	m.clearedFields[tenant.FieldSuspendedAt] = struct{}{}
+}
+
+// SuspendedAtCleared returns if the "suspended_at" field was cleared in this mutation.
+func (m *TenantMutation) SuspendedAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldSuspendedAt]
+	return ok
+}
+
+// ResetSuspendedAt resets all changes to the "suspended_at" field.
+func (m *TenantMutation) ResetSuspendedAt() {
+	m.suspended_at = nil
+	delete(m.clearedFields, tenant.FieldSuspendedAt)
+}
+
+// SetPlanID sets the "plan_id" field.
+func (m *TenantMutation) SetPlanID(s string) {
+	m.plan = &s
+}
+
+// PlanID returns the value of the "plan_id" field in the mutation.
+func (m *TenantMutation) PlanID() (r string, exists bool) {
+	v := m.plan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlanID returns the old "plan_id" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldPlanID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlanID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlanID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlanID: %w", err)
+	}
+	return oldValue.PlanID, nil
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (m *TenantMutation) ClearPlanID() {
+	m.plan = nil
+	m.clearedFields[tenant.FieldPlanID] = struct{}{}
+}
+
+// PlanIDCleared returns if the "plan_id" field was cleared in this mutation.
+func (m *TenantMutation) PlanIDCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldPlanID]
+	return ok
+}
+
+// ResetPlanID resets all changes to the "plan_id" field.
+func (m *TenantMutation) ResetPlanID() {
+	m.plan = nil
+	delete(m.clearedFields, tenant.FieldPlanID)
+}
+
+// SetIsolation sets the "isolation" field.
+func (m *TenantMutation) SetIsolation(s string) {
+	m.isolation = &s
+}
+
+// Isolation returns the value of the "isolation" field in the mutation.
+func (m *TenantMutation) Isolation() (r string, exists bool) {
+	v := m.isolation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsolation returns the old "isolation" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldIsolation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsolation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsolation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsolation: %w", err)
+	}
+	return oldValue.Isolation, nil
+}
+
+// ResetIsolation resets all changes to the "isolation" field.
+func (m *TenantMutation) ResetIsolation() {
+	m.isolation = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *TenantMutation) SetParentID(s string) {
+	m.parent = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *TenantMutation) ParentID() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *TenantMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[tenant.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *TenantMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *TenantMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, tenant.FieldParentID)
+}
+
+// SetPurgeAt sets the "purge_at" field.
+func (m *TenantMutation) SetPurgeAt(t time.Time) {
+	m.purge_at = &t
+}
+
+// PurgeAt returns the value of the "purge_at" field in the mutation.
+func (m *TenantMutation) PurgeAt() (r time.Time, exists bool) {
+	v := m.purge_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPurgeAt returns the old "purge_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldPurgeAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurgeAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurgeAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurgeAt: %w", err)
+	}
+	return oldValue.PurgeAt, nil
+}
+
+// ClearPurgeAt clears the value of the "purge_at" field.
+func (m *TenantMutation) ClearPurgeAt() {
+	m.purge_at = nil
+	m.clearedFields[tenant.FieldPurgeAt] = struct{}{}
+}
+
+// PurgeAtCleared returns if the "purge_at" field was cleared in this mutation.
+func (m *TenantMutation) PurgeAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldPurgeAt]
+	return ok
+}
+
+// ResetPurgeAt resets all changes to the "purge_at" field.
+func (m *TenantMutation) ResetPurgeAt() {
+	m.purge_at = nil
+	delete(m.clearedFields, tenant.FieldPurgeAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TenantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TenantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *TenantMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[tenant.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *TenantMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TenantMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, tenant.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TenantMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TenantMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *TenantMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[tenant.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *TenantMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TenantMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, tenant.FieldUpdatedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TenantMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TenantMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TenantMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[tenant.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TenantMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TenantMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, tenant.FieldDeletedAt)
+}
+
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (m *TenantMutation) ClearPlan() {
+	m.clearedplan = true
+	m.clearedFields[tenant.FieldPlanID] = struct{}{}
+}
+
+// PlanCleared reports if the "plan" edge to the Plan entity was cleared.
+func (m *TenantMutation) PlanCleared() bool {
+	return m.PlanIDCleared() || m.clearedplan
+}
+
+// PlanIDs returns the "plan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlanID instead. It exists only for internal usage by the builders.
+func (m *TenantMutation) PlanIDs() (ids []string) {
+	if id := m.plan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Op returns the operation name.
-func (m *CompanyMutation) Op() Op {
-	return m.op
+// ResetPlan resets all changes to the "plan" edge.
+func (m *TenantMutation) ResetPlan() {
+	m.plan = nil
+	m.clearedplan = false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CompanyMutation) SetOp(op Op) {
-	m.op = op
+// ClearParent clears the "parent" edge to the Tenant entity.
+func (m *TenantMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[tenant.FieldParentID] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Company).
-func (m *CompanyMutation) Type() string {
-	return m.typ
+// ParentCleared reports if the "parent" edge to the Tenant entity was cleared.
+func (m *TenantMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CompanyMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.renter != nil {
-		fields = append(fields, company.FieldRenterID)
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *TenantMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.tenant != nil {
-		fields = append(fields, company.FieldTenantID)
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *TenantMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Tenant entity by ids.
+func (m *TenantMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
 	}
-	if m.name != nil {
-		fields = append(fields, company.FieldName)
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
 	}
-	if m.company_size != nil {
-		fields = append(fields, company.FieldCompanySize)
+}
+
+// ClearChildren clears the "children" edge to the Tenant entity.
+func (m *TenantMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Tenant entity was cleared.
+func (m *TenantMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Tenant entity by IDs.
+func (m *TenantMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
 	}
-	if m.created_at != nil {
-		fields = append(fields, company.FieldCreatedAt)
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
 	}
-	if m.updated_at != nil {
-		fields = append(fields, company.FieldUpdatedAt)
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Tenant entity.
+func (m *TenantMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, company.FieldDeletedAt)
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *TenantMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
 	}
-	return fields
+	return
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CompanyMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case company.FieldRenterID:
-		return m.RenterID()
-	case company.FieldTenantID:
-		return m.TenantID()
-	case company.FieldName:
-		return m.Name()
-	case company.FieldCompanySize:
-		return m.CompanySize()
-	case company.FieldCreatedAt:
-		return m.CreatedAt()
-	case company.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case company.FieldDeletedAt:
-		return m.DeletedAt()
+// ResetChildren resets all changes to the "children" edge.
+func (m *TenantMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
+func (m *TenantMutation) AddAPIKeyIDs(ids ...string) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CompanyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case company.FieldRenterID:
-		return m.OldRenterID(ctx)
-	case company.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case company.FieldName:
-		return m.OldName(ctx)
-	case company.FieldCompanySize:
-		return m.OldCompanySize(ctx)
-	case company.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case company.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case company.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
+func (m *TenantMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
+func (m *TenantMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
+func (m *TenantMutation) RemoveAPIKeyIDs(ids ...string) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
 	}
-	return nil, fmt.Errorf("unknown Company field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CompanyMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case company.FieldRenterID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRenterID(v)
-		return nil
-	case company.FieldTenantID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case company.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case company.FieldCompanySize:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompanySize(v)
-		return nil
-	case company.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case company.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case company.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
+func (m *TenantMutation) RemovedAPIKeysIDs() (ids []string) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Company field %s", name)
+	return
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *CompanyMutation) AddedFields() []string {
-	return nil
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *TenantMutation) APIKeysIDs() (ids []string) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *CompanyMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *TenantMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CompanyMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AddBranchIDs adds the "branches" edge to the Branch entity by ids.
+func (m *TenantMutation) AddBranchIDs(ids ...string) {
+	if m.branches == nil {
+		m.branches = make(map[string]struct{})
 	}
-	return fmt.Errorf("unknown Company numeric field %s", name)
+	for i := range ids {
+		m.branches[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBranches clears the "branches" edge to the Branch entity.
+func (m *TenantMutation) ClearBranches() {
+	m.clearedbranches = true
+}
+
+// BranchesCleared reports if the "branches" edge to the Branch entity was cleared.
+func (m *TenantMutation) BranchesCleared() bool {
+	return m.clearedbranches
+}
+
+// RemoveBranchIDs removes the "branches" edge to the Branch entity by IDs.
+func (m *TenantMutation) RemoveBranchIDs(ids ...string) {
+	if m.removedbranches == nil {
+		m.removedbranches = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.branches, ids[i])
+		m.removedbranches[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBranches returns the removed IDs of the "branches" edge to the Branch entity.
+func (m *TenantMutation) RemovedBranchesIDs() (ids []string) {
+	for id := range m.removedbranches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BranchesIDs returns the "branches" edge IDs in the mutation.
+func (m *TenantMutation) BranchesIDs() (ids []string) {
+	for id := range m.branches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBranches resets all changes to the "branches" edge.
+func (m *TenantMutation) ResetBranches() {
+	m.branches = nil
+	m.clearedbranches = false
+	m.removedbranches = nil
+}
+
+// AddCarIDs adds the "cars" edge to the Car entity by ids.
+func (m *TenantMutation) AddCarIDs(ids ...string) {
+	if m.cars == nil {
+		m.cars = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.cars[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCars clears the "cars" edge to the Car entity.
+func (m *TenantMutation) ClearCars() {
+	m.clearedcars = true
+}
+
+// CarsCleared reports if the "cars" edge to the Car entity was cleared.
+func (m *TenantMutation) CarsCleared() bool {
+	return m.clearedcars
+}
+
+// RemoveCarIDs removes the "cars" edge to the Car entity by IDs.
+func (m *TenantMutation) RemoveCarIDs(ids ...string) {
+	if m.removedcars == nil {
+		m.removedcars = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.cars, ids[i])
+		m.removedcars[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCars returns the removed IDs of the "cars" edge to the Car entity.
+func (m *TenantMutation) RemovedCarsIDs() (ids []string) {
+	for id := range m.removedcars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CarsIDs returns the "cars" edge IDs in the mutation.
+func (m *TenantMutation) CarsIDs() (ids []string) {
+	for id := range m.cars {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCars resets all changes to the "cars" edge.
+func (m *TenantMutation) ResetCars() {
+	m.cars = nil
+	m.clearedcars = false
+	m.removedcars = nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *CompanyMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(company.FieldCreatedAt) {
-		fields = append(fields, company.FieldCreatedAt)
+// AddCarModelIDs adds the "car_models" edge to the CarModel entity by ids.
+func (m *TenantMutation) AddCarModelIDs(ids ...string) {
+	if m.car_models == nil {
+		m.car_models = make(map[string]struct{})
 	}
-	if m.FieldCleared(company.FieldUpdatedAt) {
-		fields = append(fields, company.FieldUpdatedAt)
-	}
-	if m.FieldCleared(company.FieldDeletedAt) {
-		fields = append(fields, company.FieldDeletedAt)
+	for i := range ids {
+		m.car_models[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *CompanyMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ClearCarModels clears the "car_models" edge to the CarModel entity.
+func (m *TenantMutation) ClearCarModels() {
+	m.clearedcar_models = true
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CompanyMutation) ClearField(name string) error {
-	switch name {
-	case company.FieldCreatedAt:
-		m.ClearCreatedAt()
-		return nil
-	case company.FieldUpdatedAt:
-		m.ClearUpdatedAt()
-		return nil
-	case company.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Company nullable field %s", name)
+// CarModelsCleared reports if the "car_models" edge to the CarModel entity was cleared.
+func (m *TenantMutation) CarModelsCleared() bool {
+	return m.clearedcar_models
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *CompanyMutation) ResetField(name string) error {
-	switch name {
-	case company.FieldRenterID:
-		m.ResetRenterID()
-		return nil
-	case company.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case company.FieldName:
-		m.ResetName()
-		return nil
-	case company.FieldCompanySize:
-		m.ResetCompanySize()
-		return nil
-	case company.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case company.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case company.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
+// RemoveCarModelIDs removes the "car_models" edge to the CarModel entity by IDs.
+func (m *TenantMutation) RemoveCarModelIDs(ids ...string) {
+	if m.removedcar_models == nil {
+		m.removedcar_models = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.car_models, ids[i])
+		m.removedcar_models[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Company field %s", name)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CompanyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.tenant != nil {
-		edges = append(edges, company.EdgeTenant)
+// RemovedCarModels returns the removed IDs of the "car_models" edge to the CarModel entity.
+func (m *TenantMutation) RemovedCarModelsIDs() (ids []string) {
+	for id := range m.removedcar_models {
+		ids = append(ids, id)
 	}
-	if m.renter != nil {
-		edges = append(edges, company.EdgeRenter)
+	return
+}
+
+// CarModelsIDs returns the "car_models" edge IDs in the mutation.
+func (m *TenantMutation) CarModelsIDs() (ids []string) {
+	for id := range m.car_models {
+		ids = append(ids, id)
 	}
-	return edges
+	return
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *CompanyMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case company.EdgeTenant:
-		if id := m.tenant; id != nil {
-			return []ent.Value{*id}
-		}
-	case company.EdgeRenter:
-		if id := m.renter; id != nil {
-			return []ent.Value{*id}
-		}
+// ResetCarModels resets all changes to the "car_models" edge.
+func (m *TenantMutation) ResetCarModels() {
+	m.car_models = nil
+	m.clearedcar_models = false
+	m.removedcar_models = nil
+}
+
+// AddCompanyIDs adds the "companies" edge to the Company entity by ids.
+func (m *TenantMutation) AddCompanyIDs(ids ...string) {
+	if m.companies == nil {
+		m.companies = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.companies[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CompanyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// ClearCompanies clears the "companies" edge to the Company entity.
+func (m *TenantMutation) ClearCompanies() {
+	m.clearedcompanies = true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *CompanyMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// CompaniesCleared reports if the "companies" edge to the Company entity was cleared.
+func (m *TenantMutation) CompaniesCleared() bool {
+	return m.clearedcompanies
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CompanyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtenant {
-		edges = append(edges, company.EdgeTenant)
+// RemoveCompanyIDs removes the "companies" edge to the Company entity by IDs.
+func (m *TenantMutation) RemoveCompanyIDs(ids ...string) {
+	if m.removedcompanies == nil {
+		m.removedcompanies = make(map[string]struct{})
 	}
-	if m.clearedrenter {
-		edges = append(edges, company.EdgeRenter)
+	for i := range ids {
+		delete(m.companies, ids[i])
+		m.removedcompanies[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *CompanyMutation) EdgeCleared(name string) bool {
-	switch name {
-	case company.EdgeTenant:
-		return m.clearedtenant
-	case company.EdgeRenter:
-		return m.clearedrenter
+// RemovedCompanies returns the removed IDs of the "companies" edge to the Company entity.
+func (m *TenantMutation) RemovedCompaniesIDs() (ids []string) {
+	for id := range m.removedcompanies {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *CompanyMutation) ClearEdge(name string) error {
-	switch name {
-	case company.EdgeTenant:
-		m.ClearTenant()
-		return nil
-	case company.EdgeRenter:
-		m.ClearRenter()
-		return nil
+// CompaniesIDs returns the "companies" edge IDs in the mutation.
+func (m *TenantMutation) CompaniesIDs() (ids []string) {
+	for id := range m.companies {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Company unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CompanyMutation) ResetEdge(name string) error {
-	switch name {
-	case company.EdgeTenant:
-		m.ResetTenant()
-		return nil
-	case company.EdgeRenter:
-		m.ResetRenter()
-		return nil
-	}
-	return fmt.Errorf("unknown Company edge %s", name)
+// ResetCompanies resets all changes to the "companies" edge.
+func (m *TenantMutation) ResetCompanies() {
+	m.companies = nil
+	m.clearedcompanies = false
+	m.removedcompanies = nil
 }
 
-// IndividualMutation represents an operation that mutates the Individual nodes in the graph.
-type IndividualMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *string
-	email         *string
-	first_name    *string
-	last_name     *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	tenant        *string
-	clearedtenant bool
-	renter        *string
-	clearedrenter bool
-	done          bool
-	oldValue      func(context.Context) (*Individual, error)
-	predicates    []predicate.Individual
+// AddIndividualIDs adds the "individuals" edge to the Individual entity by ids.
+func (m *TenantMutation) AddIndividualIDs(ids ...string) {
+	if m.individuals == nil {
+		m.individuals = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.individuals[ids[i]] = struct{}{}
+	}
 }
 
-var _ ent.Mutation = (*IndividualMutation)(nil)
+// ClearIndividuals clears the "individuals" edge to the Individual entity.
+func (m *TenantMutation) ClearIndividuals() {
+	m.clearedindividuals = true
+}
 
-// individualOption allows management of the mutation configuration using functional options.
-type individualOption func(*IndividualMutation)
+// IndividualsCleared reports if the "individuals" edge to the Individual entity was cleared.
+func (m *TenantMutation) IndividualsCleared() bool {
+	return m.clearedindividuals
+}
 
-// newIndividualMutation creates new mutation for the Individual entity.
-func newIndividualMutation(c config, op Op, opts ...individualOption) *IndividualMutation {
-	m := &IndividualMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeIndividual,
-		clearedFields: make(map[string]struct{}),
+// RemoveIndividualIDs removes the "individuals" edge to the Individual entity by IDs.
+func (m *TenantMutation) RemoveIndividualIDs(ids ...string) {
+	if m.removedindividuals == nil {
+		m.removedindividuals = make(map[string]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.individuals, ids[i])
+		m.removedindividuals[ids[i]] = struct{}{}
 	}
-	return m
-}
-
-// withIndividualID sets the ID field of the mutation.
-func withIndividualID(id string) individualOption {
-	return func(m *IndividualMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Individual
-		)
-		m.oldValue = func(ctx context.Context) (*Individual, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Individual.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+}
+
+// RemovedIndividuals returns the removed IDs of the "individuals" edge to the Individual entity.
+func (m *TenantMutation) RemovedIndividualsIDs() (ids []string) {
+	for id := range m.removedindividuals {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withIndividual sets the old Individual of the mutation.
-func withIndividual(node *Individual) individualOption {
-	return func(m *IndividualMutation) {
-		m.oldValue = func(context.Context) (*Individual, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// IndividualsIDs returns the "individuals" edge IDs in the mutation.
+func (m *TenantMutation) IndividualsIDs() (ids []string) {
+	for id := range m.individuals {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m IndividualMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetIndividuals resets all changes to the "individuals" edge.
+func (m *TenantMutation) ResetIndividuals() {
+	m.individuals = nil
+	m.clearedindividuals = false
+	m.removedindividuals = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m IndividualMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("entgen: mutation is not running in a transaction")
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by ids.
+func (m *TenantMutation) AddMaintenanceWindowIDs(ids ...string) {
+	if m.maintenance_windows == nil {
+		m.maintenance_windows = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.maintenance_windows[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Individual entities.
-func (m *IndividualMutation) SetID(id string) {
-	m.id = &id
+// ClearMaintenanceWindows clears the "maintenance_windows" edge to the MaintenanceWindow entity.
+func (m *TenantMutation) ClearMaintenanceWindows() {
+	m.clearedmaintenance_windows = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *IndividualMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// MaintenanceWindowsCleared reports if the "maintenance_windows" edge to the MaintenanceWindow entity was cleared.
+func (m *TenantMutation) MaintenanceWindowsCleared() bool {
+	return m.clearedmaintenance_windows
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *IndividualMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Individual.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (m *TenantMutation) RemoveMaintenanceWindowIDs(ids ...string) {
+	if m.removedmaintenance_windows == nil {
+		m.removedmaintenance_windows = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.maintenance_windows, ids[i])
+		m.removedmaintenance_windows[ids[i]] = struct{}{}
 	}
 }
 
-// SetRenterID sets the "renter_id" field.
-func (m *IndividualMutation) SetRenterID(s string) {
-	m.renter = &s
+// RemovedMaintenanceWindows returns the removed IDs of the "maintenance_windows" edge to the MaintenanceWindow entity.
+func (m *TenantMutation) RemovedMaintenanceWindowsIDs() (ids []string) {
+	for id := range m.removedmaintenance_windows {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// RenterID returns the value of the "renter_id" field in the mutation.
-func (m *IndividualMutation) RenterID() (r string, exists bool) {
-	v := m.renter
-	if v == nil {
-		return
+// MaintenanceWindowsIDs returns the "maintenance_windows" edge IDs in the mutation.
+func (m *TenantMutation) MaintenanceWindowsIDs() (ids []string) {
+	for id := range m.maintenance_windows {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldRenterID returns the old "renter_id" field's value of the Individual entity.
-// If the Individual object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IndividualMutation) OldRenterID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRenterID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRenterID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRenterID: %w", err)
-	}
-	return oldValue.RenterID, nil
+// ResetMaintenanceWindows resets all changes to the "maintenance_windows" edge.
+func (m *TenantMutation) ResetMaintenanceWindows() {
+	m.maintenance_windows = nil
+	m.clearedmaintenance_windows = false
+	m.removedmaintenance_windows = nil
 }
 
-// ResetRenterID resets all changes to the "renter_id" field.
-func (m *IndividualMutation) ResetRenterID() {
-	m.renter = nil
+// AddOptionIDs adds the "options" edge to the CarOption entity by ids.
+func (m *TenantMutation) AddOptionIDs(ids ...string) {
+	if m.options == nil {
+		m.options = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.options[ids[i]] = struct{}{}
+	}
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *IndividualMutation) SetTenantID(s string) {
-	m.tenant = &s
+// ClearOptions clears the "options" edge to the CarOption entity.
+func (m *TenantMutation) ClearOptions() {
+	m.clearedoptions = true
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *IndividualMutation) TenantID() (r string, exists bool) {
-	v := m.tenant
-	if v == nil {
-		return
-	}
-	return *v, true
+// OptionsCleared reports if the "options" edge to the CarOption entity was cleared.
+func (m *TenantMutation) OptionsCleared() bool {
+	return m.clearedoptions
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Individual entity.
-// If the Individual object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IndividualMutation) OldTenantID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+// RemoveOptionIDs removes the "options" edge to the CarOption entity by IDs.
+func (m *TenantMutation) RemoveOptionIDs(ids ...string) {
+	if m.removedoptions == nil {
+		m.removedoptions = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	for i := range ids {
+		delete(m.options, ids[i])
+		m.removedoptions[ids[i]] = struct{}{}
 	}
-	return oldValue.TenantID, nil
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *IndividualMutation) ResetTenantID() {
-	m.tenant = nil
+// RemovedOptions returns the removed IDs of the "options" edge to the CarOption entity.
+func (m *TenantMutation) RemovedOptionsIDs() (ids []string) {
+	for id := range m.removedoptions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetEmail sets the "email" field.
-func (m *IndividualMutation) SetEmail(s string) {
-	m.email = &s
+// OptionsIDs returns the "options" edge IDs in the mutation.
+func (m *TenantMutation) OptionsIDs() (ids []string) {
+	for id := range m.options {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *IndividualMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetOptions resets all changes to the "options" edge.
+func (m *TenantMutation) ResetOptions() {
+	m.options = nil
+	m.clearedoptions = false
+	m.removedoptions = nil
 }
 
-// OldEmail returns the old "email" field's value of the Individual entity.
-// If the Individual object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IndividualMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+// AddRentalHandoverIDs adds the "rental_handovers" edge to the RentalHandover entity by ids.
+func (m *TenantMutation) AddRentalHandoverIDs(ids ...string) {
+	if m.rental_handovers == nil {
+		m.rental_handovers = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	for i := range ids {
+		m.rental_handovers[ids[i]] = struct{}{}
 	}
-	return oldValue.Email, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *IndividualMutation) ResetEmail() {
-	m.email = nil
+// ClearRentalHandovers clears the "rental_handovers" edge to the RentalHandover entity.
+func (m *TenantMutation) ClearRentalHandovers() {
+	m.clearedrental_handovers = true
 }
 
-// SetFirstName sets the "first_name" field.
-func (m *IndividualMutation) SetFirstName(s string) {
-	m.first_name = &s
+// RentalHandoversCleared reports if the "rental_handovers" edge to the RentalHandover entity was cleared.
+func (m *TenantMutation) RentalHandoversCleared() bool {
+	return m.clearedrental_handovers
 }
 
-// FirstName returns the value of the "first_name" field in the mutation.
-func (m *IndividualMutation) FirstName() (r string, exists bool) {
-	v := m.first_name
-	if v == nil {
-		return
+// RemoveRentalHandoverIDs removes the "rental_handovers" edge to the RentalHandover entity by IDs.
+func (m *TenantMutation) RemoveRentalHandoverIDs(ids ...string) {
+	if m.removedrental_handovers == nil {
+		m.removedrental_handovers = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.rental_handovers, ids[i])
+		m.removedrental_handovers[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldFirstName returns the old "first_name" field's value of the Individual entity.
-// If the Individual object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IndividualMutation) OldFirstName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstName requires an ID field in the mutation")
+// RemovedRentalHandovers returns the removed IDs of the "rental_handovers" edge to the RentalHandover entity.
+func (m *TenantMutation) RemovedRentalHandoversIDs() (ids []string) {
+	for id := range m.removedrental_handovers {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	return
+}
+
+// RentalHandoversIDs returns the "rental_handovers" edge IDs in the mutation.
+func (m *TenantMutation) RentalHandoversIDs() (ids []string) {
+	for id := range m.rental_handovers {
+		ids = append(ids, id)
 	}
-	return oldValue.FirstName, nil
+	return
 }
 
-// ClearFirstName clears the value of the "first_name" field.
-func (m *IndividualMutation) ClearFirstName() {
-	m.first_name = nil
-	m.clearedFields[individual.FieldFirstName] = struct{}{}
+// ResetRentalHandovers resets all changes to the "rental_handovers" edge.
+func (m *TenantMutation) ResetRentalHandovers() {
+	m.rental_handovers = nil
+	m.clearedrental_handovers = false
+	m.removedrental_handovers = nil
 }
 
-// FirstNameCleared returns if the "first_name" field was cleared in this mutation.
-func (m *IndividualMutation) FirstNameCleared() bool {
-	_, ok := m.clearedFields[individual.FieldFirstName]
-	return ok
+// AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by ids.
+func (m *TenantMutation) AddRentalOptionIDs(ids ...string) {
+	if m.rental_options == nil {
+		m.rental_options = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.rental_options[ids[i]] = struct{}{}
+	}
 }
 
-// ResetFirstName resets all changes to the "first_name" field.
-func (m *IndividualMutation) ResetFirstName() {
-	m.first_name = nil
-	delete(m.clearedFields, individual.FieldFirstName)
+// ClearRentalOptions clears the "rental_options" edge to the RentalOption entity.
+func (m *TenantMutation) ClearRentalOptions() {
+	m.clearedrental_options = true
 }
 
-// SetLastName sets the "last_name" field.
-func (m *IndividualMutation) SetLastName(s string) {
-	m.last_name = &s
+// RentalOptionsCleared reports if the "rental_options" edge to the RentalOption entity was cleared.
+func (m *TenantMutation) RentalOptionsCleared() bool {
+	return m.clearedrental_options
+}
+
+// RemoveRentalOptionIDs removes the "rental_options" edge to the RentalOption entity by IDs.
+func (m *TenantMutation) RemoveRentalOptionIDs(ids ...string) {
+	if m.removedrental_options == nil {
+		m.removedrental_options = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.rental_options, ids[i])
+		m.removedrental_options[ids[i]] = struct{}{}
+	}
 }
 
-// LastName returns the value of the "last_name" field in the mutation.
-func (m *IndividualMutation) LastName() (r string, exists bool) {
-	v := m.last_name
-	if v == nil {
-		return
+// RemovedRentalOptions returns the removed IDs of the "rental_options" edge to the RentalOption entity.
+func (m *TenantMutation) RemovedRentalOptionsIDs() (ids []string) {
+	for id := range m.removedrental_options {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldLastName returns the old "last_name" field's value of the Individual entity.
-// If the Individual object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IndividualMutation) OldLastName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+// RentalOptionsIDs returns the "rental_options" edge IDs in the mutation.
+func (m *TenantMutation) RentalOptionsIDs() (ids []string) {
+	for id := range m.rental_options {
+		ids = append(ids, id)
 	}
-	return oldValue.LastName, nil
+	return
 }
 
-// ClearLastName clears the value of the "last_name" field.
-func (m *IndividualMutation) ClearLastName() {
-	m.last_name = nil
-	m.clearedFields[individual.FieldLastName] = struct{}{}
+// ResetRentalOptions resets all changes to the "rental_options" edge.
+func (m *TenantMutation) ResetRentalOptions() {
+	m.rental_options = nil
+	m.clearedrental_options = false
+	m.removedrental_options = nil
 }
 
-// LastNameCleared returns if the "last_name" field was cleared in this mutation.
-func (m *IndividualMutation) LastNameCleared() bool {
-	_, ok := m.clearedFields[individual.FieldLastName]
-	return ok
+// AddRentalIDs adds the "rentals" edge to the Rental entity by ids.
+func (m *TenantMutation) AddRentalIDs(ids ...string) {
+	if m.rentals == nil {
+		m.rentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.rentals[ids[i]] = struct{}{}
+	}
 }
 
-// ResetLastName resets all changes to the "last_name" field.
-func (m *IndividualMutation) ResetLastName() {
-	m.last_name = nil
-	delete(m.clearedFields, individual.FieldLastName)
+// ClearRentals clears the "rentals" edge to the Rental entity.
+func (m *TenantMutation) ClearRentals() {
+	m.clearedrentals = true
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *IndividualMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RentalsCleared reports if the "rentals" edge to the Rental entity was cleared.
+func (m *TenantMutation) RentalsCleared() bool {
+	return m.clearedrentals
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *IndividualMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// RemoveRentalIDs removes the "rentals" edge to the Rental entity by IDs.
+func (m *TenantMutation) RemoveRentalIDs(ids ...string) {
+	if m.removedrentals == nil {
+		m.removedrentals = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.rentals, ids[i])
+		m.removedrentals[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Individual entity.
-// If the Individual object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IndividualMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// RemovedRentals returns the removed IDs of the "rentals" edge to the Rental entity.
+func (m *TenantMutation) RemovedRentalsIDs() (ids []string) {
+	for id := range m.removedrentals {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	return
+}
+
+// RentalsIDs returns the "rentals" edge IDs in the mutation.
+func (m *TenantMutation) RentalsIDs() (ids []string) {
+	for id := range m.rentals {
+		ids = append(ids, id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *IndividualMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[individual.FieldCreatedAt] = struct{}{}
+// ResetRentals resets all changes to the "rentals" edge.
+func (m *TenantMutation) ResetRentals() {
+	m.rentals = nil
+	m.clearedrentals = false
+	m.removedrentals = nil
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *IndividualMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[individual.FieldCreatedAt]
-	return ok
+// AddRenterIDs adds the "renters" edge to the Renter entity by ids.
+func (m *TenantMutation) AddRenterIDs(ids ...string) {
+	if m.renters == nil {
+		m.renters = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.renters[ids[i]] = struct{}{}
+	}
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *IndividualMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, individual.FieldCreatedAt)
+// ClearRenters clears the "renters" edge to the Renter entity.
+func (m *TenantMutation) ClearRenters() {
+	m.clearedrenters = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *IndividualMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RentersCleared reports if the "renters" edge to the Renter entity was cleared.
+func (m *TenantMutation) RentersCleared() bool {
+	return m.clearedrenters
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *IndividualMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemoveRenterIDs removes the "renters" edge to the Renter entity by IDs.
+func (m *TenantMutation) RemoveRenterIDs(ids ...string) {
+	if m.removedrenters == nil {
+		m.removedrenters = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.renters, ids[i])
+		m.removedrenters[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Individual entity.
-// If the Individual object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IndividualMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// RemovedRenters returns the removed IDs of the "renters" edge to the Renter entity.
+func (m *TenantMutation) RemovedRentersIDs() (ids []string) {
+	for id := range m.removedrenters {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// RentersIDs returns the "renters" edge IDs in the mutation.
+func (m *TenantMutation) RentersIDs() (ids []string) {
+	for id := range m.renters {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *IndividualMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[individual.FieldUpdatedAt] = struct{}{}
+// ResetRenters resets all changes to the "renters" edge.
+func (m *TenantMutation) ResetRenters() {
+	m.renters = nil
+	m.clearedrenters = false
+	m.removedrenters = nil
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *IndividualMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[individual.FieldUpdatedAt]
-	return ok
+// SetSettingsID sets the "settings" edge to the TenantSetting entity by id.
+func (m *TenantMutation) SetSettingsID(id string) {
+	m.settings = &id
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *IndividualMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, individual.FieldUpdatedAt)
+// ClearSettings clears the "settings" edge to the TenantSetting entity.
+func (m *TenantMutation) ClearSettings() {
+	m.clearedsettings = true
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *IndividualMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SettingsCleared reports if the "settings" edge to the TenantSetting entity was cleared.
+func (m *TenantMutation) SettingsCleared() bool {
+	return m.clearedsettings
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *IndividualMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
+// SettingsID returns the "settings" edge ID in the mutation.
+func (m *TenantMutation) SettingsID() (id string, exists bool) {
+	if m.settings != nil {
+		return *m.settings, true
 	}
-	return *v, true
+	return
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Individual entity.
-// If the Individual object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *IndividualMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+// SettingsIDs returns the "settings" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SettingsID instead. It exists only for internal usage by the builders.
+func (m *TenantMutation) SettingsIDs() (ids []string) {
+	if id := m.settings; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	return
+}
+
+// ResetSettings resets all changes to the "settings" edge.
+func (m *TenantMutation) ResetSettings() {
+	m.settings = nil
+	m.clearedsettings = false
+}
+
+// AddWebhookEndpointIDs adds the "webhook_endpoints" edge to the WebhookEndpoint entity by ids.
+func (m *TenantMutation) AddWebhookEndpointIDs(ids ...string) {
+	if m.webhook_endpoints == nil {
+		m.webhook_endpoints = make(map[string]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	for i := range ids {
+		m.webhook_endpoints[ids[i]] = struct{}{}
 	}
-	return oldValue.DeletedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *IndividualMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[individual.FieldDeletedAt] = struct{}{}
+// ClearWebhookEndpoints clears the "webhook_endpoints" edge to the WebhookEndpoint entity.
+func (m *TenantMutation) ClearWebhookEndpoints() {
+	m.clearedwebhook_endpoints = true
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *IndividualMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[individual.FieldDeletedAt]
-	return ok
+// WebhookEndpointsCleared reports if the "webhook_endpoints" edge to the WebhookEndpoint entity was cleared.
+func (m *TenantMutation) WebhookEndpointsCleared() bool {
+	return m.clearedwebhook_endpoints
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *IndividualMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, individual.FieldDeletedAt)
+// RemoveWebhookEndpointIDs removes the "webhook_endpoints" edge to the WebhookEndpoint entity by IDs.
+func (m *TenantMutation) RemoveWebhookEndpointIDs(ids ...string) {
+	if m.removedwebhook_endpoints == nil {
+		m.removedwebhook_endpoints = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.webhook_endpoints, ids[i])
+		m.removedwebhook_endpoints[ids[i]] = struct{}{}
+	}
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *IndividualMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[individual.FieldTenantID] = struct{}{}
+// RemovedWebhookEndpoints returns the removed IDs of the "webhook_endpoints" edge to the WebhookEndpoint entity.
+func (m *TenantMutation) RemovedWebhookEndpointsIDs() (ids []string) {
+	for id := range m.removedwebhook_endpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhookEndpointsIDs returns the "webhook_endpoints" edge IDs in the mutation.
+func (m *TenantMutation) WebhookEndpointsIDs() (ids []string) {
+	for id := range m.webhook_endpoints {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *IndividualMutation) TenantCleared() bool {
-	return m.clearedtenant
+// ResetWebhookEndpoints resets all changes to the "webhook_endpoints" edge.
+func (m *TenantMutation) ResetWebhookEndpoints() {
+	m.webhook_endpoints = nil
+	m.clearedwebhook_endpoints = false
+	m.removedwebhook_endpoints = nil
 }
 
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *IndividualMutation) TenantIDs() (ids []string) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by ids.
+func (m *TenantMutation) AddWebhookDeliveryIDs(ids ...string) {
+	if m.webhook_deliveries == nil {
+		m.webhook_deliveries = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.webhook_deliveries[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *IndividualMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
+// ClearWebhookDeliveries clears the "webhook_deliveries" edge to the WebhookDelivery entity.
+func (m *TenantMutation) ClearWebhookDeliveries() {
+	m.clearedwebhook_deliveries = true
 }
 
-// ClearRenter clears the "renter" edge to the Renter entity.
-func (m *IndividualMutation) ClearRenter() {
-	m.clearedrenter = true
-	m.clearedFields[individual.FieldRenterID] = struct{}{}
+// WebhookDeliveriesCleared reports if the "webhook_deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *TenantMutation) WebhookDeliveriesCleared() bool {
+	return m.clearedwebhook_deliveries
 }
 
-// RenterCleared reports if the "renter" edge to the Renter entity was cleared.
-func (m *IndividualMutation) RenterCleared() bool {
-	return m.clearedrenter
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *TenantMutation) RemoveWebhookDeliveryIDs(ids ...string) {
+	if m.removedwebhook_deliveries == nil {
+		m.removedwebhook_deliveries = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.webhook_deliveries, ids[i])
+		m.removedwebhook_deliveries[ids[i]] = struct{}{}
+	}
 }
 
-// RenterIDs returns the "renter" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RenterID instead. It exists only for internal usage by the builders.
-func (m *IndividualMutation) RenterIDs() (ids []string) {
-	if id := m.renter; id != nil {
-		ids = append(ids, *id)
+// RemovedWebhookDeliveries returns the removed IDs of the "webhook_deliveries" edge to the WebhookDelivery entity.
+func (m *TenantMutation) RemovedWebhookDeliveriesIDs() (ids []string) {
+	for id := range m.removedwebhook_deliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRenter resets all changes to the "renter" edge.
-func (m *IndividualMutation) ResetRenter() {
-	m.renter = nil
-	m.clearedrenter = false
+// WebhookDeliveriesIDs returns the "webhook_deliveries" edge IDs in the mutation.
+func (m *TenantMutation) WebhookDeliveriesIDs() (ids []string) {
+	for id := range m.webhook_deliveries {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the IndividualMutation builder.
-func (m *IndividualMutation) Where(ps ...predicate.Individual) {
+// ResetWebhookDeliveries resets all changes to the "webhook_deliveries" edge.
+func (m *TenantMutation) ResetWebhookDeliveries() {
+	m.webhook_deliveries = nil
+	m.clearedwebhook_deliveries = false
+	m.removedwebhook_deliveries = nil
+}
+
+// Where appends a list predicates to the TenantMutation builder.
+func (m *TenantMutation) Where(ps ...predicate.Tenant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the IndividualMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *IndividualMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Individual, len(ps))
+func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tenant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2902,48 +19125,54 @@ func (m *IndividualMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *IndividualMutation) Op() Op {
+func (m *TenantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *IndividualMutation) SetOp(op Op) {
+func (m *TenantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Individual).
-func (m *IndividualMutation) Type() string {
+// Type returns the node type of this mutation (Tenant).
+func (m *TenantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *IndividualMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.renter != nil {
-		fields = append(fields, individual.FieldRenterID)
+func (m *TenantMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.code != nil {
+		fields = append(fields, tenant.FieldCode)
 	}
-	if m.tenant != nil {
-		fields = append(fields, individual.FieldTenantID)
+	if m.status != nil {
+		fields = append(fields, tenant.FieldStatus)
 	}
-	if m.email != nil {
-		fields = append(fields, individual.FieldEmail)
+	if m.suspended_at != nil {
+		fields = append(fields, tenant.FieldSuspendedAt)
 	}
-	if m.first_name != nil {
-		fields = append(fields, individual.FieldFirstName)
+	if m.plan != nil {
+		fields = append(fields, tenant.FieldPlanID)
 	}
-	if m.last_name != nil {
-		fields = append(fields, individual.FieldLastName)
+	if m.isolation != nil {
+		fields = append(fields, tenant.FieldIsolation)
+	}
+	if m.parent != nil {
+		fields = append(fields, tenant.FieldParentID)
+	}
+	if m.purge_at != nil {
+		fields = append(fields, tenant.FieldPurgeAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, individual.FieldCreatedAt)
+		fields = append(fields, tenant.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, individual.FieldUpdatedAt)
+		fields = append(fields, tenant.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, individual.FieldDeletedAt)
+		fields = append(fields, tenant.FieldDeletedAt)
 	}
 	return fields
 }
@@ -2951,23 +19180,27 @@ func (m *IndividualMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *IndividualMutation) Field(name string) (ent.Value, bool) {
+func (m *TenantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case individual.FieldRenterID:
-		return m.RenterID()
-	case individual.FieldTenantID:
-		return m.TenantID()
-	case individual.FieldEmail:
-		return m.Email()
-	case individual.FieldFirstName:
-		return m.FirstName()
-	case individual.FieldLastName:
-		return m.LastName()
-	case individual.FieldCreatedAt:
+	case tenant.FieldCode:
+		return m.Code()
+	case tenant.FieldStatus:
+		return m.Status()
+	case tenant.FieldSuspendedAt:
+		return m.SuspendedAt()
+	case tenant.FieldPlanID:
+		return m.PlanID()
+	case tenant.FieldIsolation:
+		return m.Isolation()
+	case tenant.FieldParentID:
+		return m.ParentID()
+	case tenant.FieldPurgeAt:
+		return m.PurgeAt()
+	case tenant.FieldCreatedAt:
 		return m.CreatedAt()
-	case individual.FieldUpdatedAt:
+	case tenant.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case individual.FieldDeletedAt:
+	case tenant.FieldDeletedAt:
 		return m.DeletedAt()
 	}
 	return nil, false
@@ -2976,83 +19209,101 @@ func (m *IndividualMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *IndividualMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case individual.FieldRenterID:
-		return m.OldRenterID(ctx)
-	case individual.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case individual.FieldEmail:
-		return m.OldEmail(ctx)
-	case individual.FieldFirstName:
-		return m.OldFirstName(ctx)
-	case individual.FieldLastName:
-		return m.OldLastName(ctx)
-	case individual.FieldCreatedAt:
+	case tenant.FieldCode:
+		return m.OldCode(ctx)
+	case tenant.FieldStatus:
+		return m.OldStatus(ctx)
+	case tenant.FieldSuspendedAt:
+		return m.OldSuspendedAt(ctx)
+	case tenant.FieldPlanID:
+		return m.OldPlanID(ctx)
+	case tenant.FieldIsolation:
+		return m.OldIsolation(ctx)
+	case tenant.FieldParentID:
+		return m.OldParentID(ctx)
+	case tenant.FieldPurgeAt:
+		return m.OldPurgeAt(ctx)
+	case tenant.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case individual.FieldUpdatedAt:
+	case tenant.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case individual.FieldDeletedAt:
+	case tenant.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Individual field %s", name)
+	return nil, fmt.Errorf("unknown Tenant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IndividualMutation) SetField(name string, value ent.Value) error {
+func (m *TenantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case individual.FieldRenterID:
+	case tenant.FieldCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRenterID(v)
+		m.SetCode(v)
 		return nil
-	case individual.FieldTenantID:
+	case tenant.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTenantID(v)
+		m.SetStatus(v)
 		return nil
-	case individual.FieldEmail:
+	case tenant.FieldSuspendedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspendedAt(v)
+		return nil
+	case tenant.FieldPlanID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetPlanID(v)
 		return nil
-	case individual.FieldFirstName:
+	case tenant.FieldIsolation:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirstName(v)
+		m.SetIsolation(v)
 		return nil
-	case individual.FieldLastName:
+	case tenant.FieldParentID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastName(v)
+		m.SetParentID(v)
 		return nil
-	case individual.FieldCreatedAt:
+	case tenant.FieldPurgeAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurgeAt(v)
+		return nil
+	case tenant.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case individual.FieldUpdatedAt:
+	case tenant.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case individual.FieldDeletedAt:
+	case tenant.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3060,242 +19311,667 @@ func (m *IndividualMutation) SetField(name string, value ent.Value) error {
 		m.SetDeletedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Individual field %s", name)
+	return fmt.Errorf("unknown Tenant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *IndividualMutation) AddedFields() []string {
+func (m *TenantMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *IndividualMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *IndividualMutation) AddField(name string, value ent.Value) error {
+func (m *TenantMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Individual numeric field %s", name)
+	return fmt.Errorf("unknown Tenant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *IndividualMutation) ClearedFields() []string {
+func (m *TenantMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(individual.FieldFirstName) {
-		fields = append(fields, individual.FieldFirstName)
+	if m.FieldCleared(tenant.FieldSuspendedAt) {
+		fields = append(fields, tenant.FieldSuspendedAt)
 	}
-	if m.FieldCleared(individual.FieldLastName) {
-		fields = append(fields, individual.FieldLastName)
+	if m.FieldCleared(tenant.FieldPlanID) {
+		fields = append(fields, tenant.FieldPlanID)
 	}
-	if m.FieldCleared(individual.FieldCreatedAt) {
-		fields = append(fields, individual.FieldCreatedAt)
+	if m.FieldCleared(tenant.FieldParentID) {
+		fields = append(fields, tenant.FieldParentID)
 	}
-	if m.FieldCleared(individual.FieldUpdatedAt) {
-		fields = append(fields, individual.FieldUpdatedAt)
+	if m.FieldCleared(tenant.FieldPurgeAt) {
+		fields = append(fields, tenant.FieldPurgeAt)
 	}
-	if m.FieldCleared(individual.FieldDeletedAt) {
-		fields = append(fields, individual.FieldDeletedAt)
+	if m.FieldCleared(tenant.FieldCreatedAt) {
+		fields = append(fields, tenant.FieldCreatedAt)
+	}
+	if m.FieldCleared(tenant.FieldUpdatedAt) {
+		fields = append(fields, tenant.FieldUpdatedAt)
+	}
+	if m.FieldCleared(tenant.FieldDeletedAt) {
+		fields = append(fields, tenant.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *IndividualMutation) FieldCleared(name string) bool {
+func (m *TenantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *IndividualMutation) ClearField(name string) error {
+func (m *TenantMutation) ClearField(name string) error {
 	switch name {
-	case individual.FieldFirstName:
-		m.ClearFirstName()
+	case tenant.FieldSuspendedAt:
+		m.ClearSuspendedAt()
 		return nil
-	case individual.FieldLastName:
-		m.ClearLastName()
+	case tenant.FieldPlanID:
+		m.ClearPlanID()
 		return nil
-	case individual.FieldCreatedAt:
+	case tenant.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case tenant.FieldPurgeAt:
+		m.ClearPurgeAt()
+		return nil
+	case tenant.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case individual.FieldUpdatedAt:
+	case tenant.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
-	case individual.FieldDeletedAt:
+	case tenant.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Individual nullable field %s", name)
+	return fmt.Errorf("unknown Tenant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *IndividualMutation) ResetField(name string) error {
+func (m *TenantMutation) ResetField(name string) error {
 	switch name {
-	case individual.FieldRenterID:
-		m.ResetRenterID()
+	case tenant.FieldCode:
+		m.ResetCode()
 		return nil
-	case individual.FieldTenantID:
-		m.ResetTenantID()
+	case tenant.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case individual.FieldEmail:
-		m.ResetEmail()
+	case tenant.FieldSuspendedAt:
+		m.ResetSuspendedAt()
 		return nil
-	case individual.FieldFirstName:
-		m.ResetFirstName()
+	case tenant.FieldPlanID:
+		m.ResetPlanID()
 		return nil
-	case individual.FieldLastName:
-		m.ResetLastName()
+	case tenant.FieldIsolation:
+		m.ResetIsolation()
 		return nil
-	case individual.FieldCreatedAt:
+	case tenant.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case tenant.FieldPurgeAt:
+		m.ResetPurgeAt()
+		return nil
+	case tenant.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case individual.FieldUpdatedAt:
+	case tenant.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case individual.FieldDeletedAt:
+	case tenant.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Individual field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *IndividualMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.tenant != nil {
-		edges = append(edges, individual.EdgeTenant)
+	return fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TenantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.plan != nil {
+		edges = append(edges, tenant.EdgePlan)
+	}
+	if m.parent != nil {
+		edges = append(edges, tenant.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, tenant.EdgeChildren)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, tenant.EdgeAPIKeys)
+	}
+	if m.branches != nil {
+		edges = append(edges, tenant.EdgeBranches)
+	}
+	if m.cars != nil {
+		edges = append(edges, tenant.EdgeCars)
+	}
+	if m.car_models != nil {
+		edges = append(edges, tenant.EdgeCarModels)
+	}
+	if m.companies != nil {
+		edges = append(edges, tenant.EdgeCompanies)
+	}
+	if m.individuals != nil {
+		edges = append(edges, tenant.EdgeIndividuals)
+	}
+	if m.maintenance_windows != nil {
+		edges = append(edges, tenant.EdgeMaintenanceWindows)
+	}
+	if m.options != nil {
+		edges = append(edges, tenant.EdgeOptions)
+	}
+	if m.rental_handovers != nil {
+		edges = append(edges, tenant.EdgeRentalHandovers)
+	}
+	if m.rental_options != nil {
+		edges = append(edges, tenant.EdgeRentalOptions)
+	}
+	if m.rentals != nil {
+		edges = append(edges, tenant.EdgeRentals)
 	}
-	if m.renter != nil {
-		edges = append(edges, individual.EdgeRenter)
+	if m.renters != nil {
+		edges = append(edges, tenant.EdgeRenters)
+	}
+	if m.settings != nil {
+		edges = append(edges, tenant.EdgeSettings)
+	}
+	if m.webhook_endpoints != nil {
+		edges = append(edges, tenant.EdgeWebhookEndpoints)
+	}
+	if m.webhook_deliveries != nil {
+		edges = append(edges, tenant.EdgeWebhookDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *IndividualMutation) AddedIDs(name string) []ent.Value {
+func (m *TenantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case individual.EdgeTenant:
-		if id := m.tenant; id != nil {
+	case tenant.EdgePlan:
+		if id := m.plan; id != nil {
 			return []ent.Value{*id}
 		}
-	case individual.EdgeRenter:
-		if id := m.renter; id != nil {
+	case tenant.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case tenant.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeBranches:
+		ids := make([]ent.Value, 0, len(m.branches))
+		for id := range m.branches {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.cars))
+		for id := range m.cars {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeCarModels:
+		ids := make([]ent.Value, 0, len(m.car_models))
+		for id := range m.car_models {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeCompanies:
+		ids := make([]ent.Value, 0, len(m.companies))
+		for id := range m.companies {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeIndividuals:
+		ids := make([]ent.Value, 0, len(m.individuals))
+		for id := range m.individuals {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeMaintenanceWindows:
+		ids := make([]ent.Value, 0, len(m.maintenance_windows))
+		for id := range m.maintenance_windows {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.options))
+		for id := range m.options {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRentalHandovers:
+		ids := make([]ent.Value, 0, len(m.rental_handovers))
+		for id := range m.rental_handovers {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRentalOptions:
+		ids := make([]ent.Value, 0, len(m.rental_options))
+		for id := range m.rental_options {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRentals:
+		ids := make([]ent.Value, 0, len(m.rentals))
+		for id := range m.rentals {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRenters:
+		ids := make([]ent.Value, 0, len(m.renters))
+		for id := range m.renters {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeSettings:
+		if id := m.settings; id != nil {
 			return []ent.Value{*id}
 		}
+	case tenant.EdgeWebhookEndpoints:
+		ids := make([]ent.Value, 0, len(m.webhook_endpoints))
+		for id := range m.webhook_endpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeWebhookDeliveries:
+		ids := make([]ent.Value, 0, len(m.webhook_deliveries))
+		for id := range m.webhook_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *IndividualMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TenantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.removedchildren != nil {
+		edges = append(edges, tenant.EdgeChildren)
+	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, tenant.EdgeAPIKeys)
+	}
+	if m.removedbranches != nil {
+		edges = append(edges, tenant.EdgeBranches)
+	}
+	if m.removedcars != nil {
+		edges = append(edges, tenant.EdgeCars)
+	}
+	if m.removedcar_models != nil {
+		edges = append(edges, tenant.EdgeCarModels)
+	}
+	if m.removedcompanies != nil {
+		edges = append(edges, tenant.EdgeCompanies)
+	}
+	if m.removedindividuals != nil {
+		edges = append(edges, tenant.EdgeIndividuals)
+	}
+	if m.removedmaintenance_windows != nil {
+		edges = append(edges, tenant.EdgeMaintenanceWindows)
+	}
+	if m.removedoptions != nil {
+		edges = append(edges, tenant.EdgeOptions)
+	}
+	if m.removedrental_handovers != nil {
+		edges = append(edges, tenant.EdgeRentalHandovers)
+	}
+	if m.removedrental_options != nil {
+		edges = append(edges, tenant.EdgeRentalOptions)
+	}
+	if m.removedrentals != nil {
+		edges = append(edges, tenant.EdgeRentals)
+	}
+	if m.removedrenters != nil {
+		edges = append(edges, tenant.EdgeRenters)
+	}
+	if m.removedwebhook_endpoints != nil {
+		edges = append(edges, tenant.EdgeWebhookEndpoints)
+	}
+	if m.removedwebhook_deliveries != nil {
+		edges = append(edges, tenant.EdgeWebhookDeliveries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *IndividualMutation) RemovedIDs(name string) []ent.Value {
+func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tenant.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeBranches:
+		ids := make([]ent.Value, 0, len(m.removedbranches))
+		for id := range m.removedbranches {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeCars:
+		ids := make([]ent.Value, 0, len(m.removedcars))
+		for id := range m.removedcars {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeCarModels:
+		ids := make([]ent.Value, 0, len(m.removedcar_models))
+		for id := range m.removedcar_models {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeCompanies:
+		ids := make([]ent.Value, 0, len(m.removedcompanies))
+		for id := range m.removedcompanies {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeIndividuals:
+		ids := make([]ent.Value, 0, len(m.removedindividuals))
+		for id := range m.removedindividuals {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeMaintenanceWindows:
+		ids := make([]ent.Value, 0, len(m.removedmaintenance_windows))
+		for id := range m.removedmaintenance_windows {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.removedoptions))
+		for id := range m.removedoptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRentalHandovers:
+		ids := make([]ent.Value, 0, len(m.removedrental_handovers))
+		for id := range m.removedrental_handovers {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRentalOptions:
+		ids := make([]ent.Value, 0, len(m.removedrental_options))
+		for id := range m.removedrental_options {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRentals:
+		ids := make([]ent.Value, 0, len(m.removedrentals))
+		for id := range m.removedrentals {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeRenters:
+		ids := make([]ent.Value, 0, len(m.removedrenters))
+		for id := range m.removedrenters {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeWebhookEndpoints:
+		ids := make([]ent.Value, 0, len(m.removedwebhook_endpoints))
+		for id := range m.removedwebhook_endpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case tenant.EdgeWebhookDeliveries:
+		ids := make([]ent.Value, 0, len(m.removedwebhook_deliveries))
+		for id := range m.removedwebhook_deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *IndividualMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtenant {
-		edges = append(edges, individual.EdgeTenant)
+func (m *TenantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 18)
+	if m.clearedplan {
+		edges = append(edges, tenant.EdgePlan)
 	}
-	if m.clearedrenter {
-		edges = append(edges, individual.EdgeRenter)
+	if m.clearedparent {
+		edges = append(edges, tenant.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, tenant.EdgeChildren)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, tenant.EdgeAPIKeys)
+	}
+	if m.clearedbranches {
+		edges = append(edges, tenant.EdgeBranches)
+	}
+	if m.clearedcars {
+		edges = append(edges, tenant.EdgeCars)
+	}
+	if m.clearedcar_models {
+		edges = append(edges, tenant.EdgeCarModels)
+	}
+	if m.clearedcompanies {
+		edges = append(edges, tenant.EdgeCompanies)
+	}
+	if m.clearedindividuals {
+		edges = append(edges, tenant.EdgeIndividuals)
+	}
+	if m.clearedmaintenance_windows {
+		edges = append(edges, tenant.EdgeMaintenanceWindows)
+	}
+	if m.clearedoptions {
+		edges = append(edges, tenant.EdgeOptions)
+	}
+	if m.clearedrental_handovers {
+		edges = append(edges, tenant.EdgeRentalHandovers)
+	}
+	if m.clearedrental_options {
+		edges = append(edges, tenant.EdgeRentalOptions)
+	}
+	if m.clearedrentals {
+		edges = append(edges, tenant.EdgeRentals)
+	}
+	if m.clearedrenters {
+		edges = append(edges, tenant.EdgeRenters)
+	}
+	if m.clearedsettings {
+		edges = append(edges, tenant.EdgeSettings)
+	}
+	if m.clearedwebhook_endpoints {
+		edges = append(edges, tenant.EdgeWebhookEndpoints)
+	}
+	if m.clearedwebhook_deliveries {
+		edges = append(edges, tenant.EdgeWebhookDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *IndividualMutation) EdgeCleared(name string) bool {
+func (m *TenantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case individual.EdgeTenant:
-		return m.clearedtenant
-	case individual.EdgeRenter:
-		return m.clearedrenter
+	case tenant.EdgePlan:
+		return m.clearedplan
+	case tenant.EdgeParent:
+		return m.clearedparent
+	case tenant.EdgeChildren:
+		return m.clearedchildren
+	case tenant.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case tenant.EdgeBranches:
+		return m.clearedbranches
+	case tenant.EdgeCars:
+		return m.clearedcars
+	case tenant.EdgeCarModels:
+		return m.clearedcar_models
+	case tenant.EdgeCompanies:
+		return m.clearedcompanies
+	case tenant.EdgeIndividuals:
+		return m.clearedindividuals
+	case tenant.EdgeMaintenanceWindows:
+		return m.clearedmaintenance_windows
+	case tenant.EdgeOptions:
+		return m.clearedoptions
+	case tenant.EdgeRentalHandovers:
+		return m.clearedrental_handovers
+	case tenant.EdgeRentalOptions:
+		return m.clearedrental_options
+	case tenant.EdgeRentals:
+		return m.clearedrentals
+	case tenant.EdgeRenters:
+		return m.clearedrenters
+	case tenant.EdgeSettings:
+		return m.clearedsettings
+	case tenant.EdgeWebhookEndpoints:
+		return m.clearedwebhook_endpoints
+	case tenant.EdgeWebhookDeliveries:
+		return m.clearedwebhook_deliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *IndividualMutation) ClearEdge(name string) error {
+func (m *TenantMutation) ClearEdge(name string) error {
 	switch name {
-	case individual.EdgeTenant:
-		m.ClearTenant()
+	case tenant.EdgePlan:
+		m.ClearPlan()
 		return nil
-	case individual.EdgeRenter:
-		m.ClearRenter()
+	case tenant.EdgeParent:
+		m.ClearParent()
+		return nil
+	case tenant.EdgeSettings:
+		m.ClearSettings()
 		return nil
 	}
-	return fmt.Errorf("unknown Individual unique edge %s", name)
+	return fmt.Errorf("unknown Tenant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *IndividualMutation) ResetEdge(name string) error {
+func (m *TenantMutation) ResetEdge(name string) error {
 	switch name {
-	case individual.EdgeTenant:
-		m.ResetTenant()
+	case tenant.EdgePlan:
+		m.ResetPlan()
 		return nil
-	case individual.EdgeRenter:
-		m.ResetRenter()
+	case tenant.EdgeParent:
+		m.ResetParent()
+		return nil
+	case tenant.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case tenant.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case tenant.EdgeBranches:
+		m.ResetBranches()
+		return nil
+	case tenant.EdgeCars:
+		m.ResetCars()
+		return nil
+	case tenant.EdgeCarModels:
+		m.ResetCarModels()
+		return nil
+	case tenant.EdgeCompanies:
+		m.ResetCompanies()
+		return nil
+	case tenant.EdgeIndividuals:
+		m.ResetIndividuals()
+		return nil
+	case tenant.EdgeMaintenanceWindows:
+		m.ResetMaintenanceWindows()
+		return nil
+	case tenant.EdgeOptions:
+		m.ResetOptions()
+		return nil
+	case tenant.EdgeRentalHandovers:
+		m.ResetRentalHandovers()
+		return nil
+	case tenant.EdgeRentalOptions:
+		m.ResetRentalOptions()
+		return nil
+	case tenant.EdgeRentals:
+		m.ResetRentals()
+		return nil
+	case tenant.EdgeRenters:
+		m.ResetRenters()
+		return nil
+	case tenant.EdgeSettings:
+		m.ResetSettings()
+		return nil
+	case tenant.EdgeWebhookEndpoints:
+		m.ResetWebhookEndpoints()
+		return nil
+	case tenant.EdgeWebhookDeliveries:
+		m.ResetWebhookDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Individual edge %s", name)
+	return fmt.Errorf("unknown Tenant edge %s", name)
 }
 
-// OutboxMutation represents an operation that mutates the Outbox nodes in the graph.
-type OutboxMutation struct {
+// TenantSettingMutation represents an operation that mutates the TenantSetting nodes in the graph.
+type TenantSettingMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *string
-	aggregate_type *string
-	aggregate_id   *string
-	event_type     *string
-	payload        *map[string]interface{}
-	created_at     *time.Time
-	processed_at   *time.Time
-	status         *string
-	error_message  *string
-	version        *int64
-	addversion     *int64
-	locked_at      *time.Time
-	locked_by      *string
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*Outbox, error)
-	predicates     []predicate.Outbox
-}
-
-var _ ent.Mutation = (*OutboxMutation)(nil)
-
-// outboxOption allows management of the mutation configuration using functional options.
-type outboxOption func(*OutboxMutation)
-
-// newOutboxMutation creates new mutation for the Outbox entity.
-func newOutboxMutation(c config, op Op, opts ...outboxOption) *OutboxMutation {
-	m := &OutboxMutation{
+	op                   Op
+	typ                  string
+	id                   *string
+	timezone             *string
+	currency             *string
+	locale               *string
+	business_hours       *[]schema.OpeningHours
+	appendbusiness_hours []schema.OpeningHours
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	tenant               *string
+	clearedtenant        bool
+	done                 bool
+	oldValue             func(context.Context) (*TenantSetting, error)
+	predicates           []predicate.TenantSetting
+}
+
+var _ ent.Mutation = (*TenantSettingMutation)(nil)
+
+// tenantsettingOption allows management of the mutation configuration using functional options.
+type tenantsettingOption func(*TenantSettingMutation)
+
+// newTenantSettingMutation creates new mutation for the TenantSetting entity.
+func newTenantSettingMutation(c config, op Op, opts ...tenantsettingOption) *TenantSettingMutation {
+	m := &TenantSettingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOutbox,
+		typ:           TypeTenantSetting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3304,20 +19980,20 @@ func newOutboxMutation(c config, op Op, opts ...outboxOption) *OutboxMutation {
 	return m
 }
 
-// withOutboxID sets the ID field of the mutation.
-func withOutboxID(id string) outboxOption {
-	return func(m *OutboxMutation) {
+// withTenantSettingID sets the ID field of the mutation.
+func withTenantSettingID(id string) tenantsettingOption {
+	return func(m *TenantSettingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Outbox
+			value *TenantSetting
 		)
-		m.oldValue = func(ctx context.Context) (*Outbox, error) {
+		m.oldValue = func(ctx context.Context) (*TenantSetting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Outbox.Get(ctx, id)
+					value, err = m.Client().TenantSetting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3326,572 +20002,412 @@ func withOutboxID(id string) outboxOption {
 	}
 }
 
-// withOutbox sets the old Outbox of the mutation.
-func withOutbox(node *Outbox) outboxOption {
-	return func(m *OutboxMutation) {
-		m.oldValue = func(context.Context) (*Outbox, error) {
+// withTenantSetting sets the old TenantSetting of the mutation.
+func withTenantSetting(node *TenantSetting) tenantsettingOption {
+	return func(m *TenantSettingMutation) {
+		m.oldValue = func(context.Context) (*TenantSetting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OutboxMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m OutboxMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("entgen: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Outbox entities.
-func (m *OutboxMutation) SetID(id string) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *OutboxMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *OutboxMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Outbox.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetAggregateType sets the "aggregate_type" field.
-func (m *OutboxMutation) SetAggregateType(s string) {
-	m.aggregate_type = &s
-}
-
-// AggregateType returns the value of the "aggregate_type" field in the mutation.
-func (m *OutboxMutation) AggregateType() (r string, exists bool) {
-	v := m.aggregate_type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAggregateType returns the old "aggregate_type" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldAggregateType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAggregateType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAggregateType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAggregateType: %w", err)
-	}
-	return oldValue.AggregateType, nil
-}
-
-// ResetAggregateType resets all changes to the "aggregate_type" field.
-func (m *OutboxMutation) ResetAggregateType() {
-	m.aggregate_type = nil
-}
-
-// SetAggregateID sets the "aggregate_id" field.
-func (m *OutboxMutation) SetAggregateID(s string) {
-	m.aggregate_id = &s
-}
-
-// AggregateID returns the value of the "aggregate_id" field in the mutation.
-func (m *OutboxMutation) AggregateID() (r string, exists bool) {
-	v := m.aggregate_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAggregateID returns the old "aggregate_id" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldAggregateID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAggregateID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAggregateID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAggregateID: %w", err)
-	}
-	return oldValue.AggregateID, nil
-}
-
-// ResetAggregateID resets all changes to the "aggregate_id" field.
-func (m *OutboxMutation) ResetAggregateID() {
-	m.aggregate_id = nil
-}
-
-// SetEventType sets the "event_type" field.
-func (m *OutboxMutation) SetEventType(s string) {
-	m.event_type = &s
-}
-
-// EventType returns the value of the "event_type" field in the mutation.
-func (m *OutboxMutation) EventType() (r string, exists bool) {
-	v := m.event_type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEventType returns the old "event_type" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldEventType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
-	}
-	return oldValue.EventType, nil
-}
-
-// ResetEventType resets all changes to the "event_type" field.
-func (m *OutboxMutation) ResetEventType() {
-	m.event_type = nil
-}
-
-// SetPayload sets the "payload" field.
-func (m *OutboxMutation) SetPayload(value map[string]interface{}) {
-	m.payload = &value
-}
-
-// Payload returns the value of the "payload" field in the mutation.
-func (m *OutboxMutation) Payload() (r map[string]interface{}, exists bool) {
-	v := m.payload
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPayload returns the old "payload" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayload requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
 	}
-	return oldValue.Payload, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearPayload clears the value of the "payload" field.
-func (m *OutboxMutation) ClearPayload() {
-	m.payload = nil
-	m.clearedFields[outbox.FieldPayload] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TenantSetting entities.
+func (m *TenantSettingMutation) SetID(id string) {
+	m.id = &id
 }
 
-// PayloadCleared returns if the "payload" field was cleared in this mutation.
-func (m *OutboxMutation) PayloadCleared() bool {
-	_, ok := m.clearedFields[outbox.FieldPayload]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantSettingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetPayload resets all changes to the "payload" field.
-func (m *OutboxMutation) ResetPayload() {
-	m.payload = nil
-	delete(m.clearedFields, outbox.FieldPayload)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantSettingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TenantSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *OutboxMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTenantID sets the "tenant_id" field.
+func (m *TenantSettingMutation) SetTenantID(s string) {
+	m.tenant = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OutboxMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TenantSettingMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TenantSettingMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *OutboxMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[outbox.FieldCreatedAt] = struct{}{}
-}
-
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *OutboxMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[outbox.FieldCreatedAt]
-	return ok
+	return oldValue.TenantID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OutboxMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, outbox.FieldCreatedAt)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TenantSettingMutation) ResetTenantID() {
+	m.tenant = nil
 }
 
-// SetProcessedAt sets the "processed_at" field.
-func (m *OutboxMutation) SetProcessedAt(t time.Time) {
-	m.processed_at = &t
+// SetTimezone sets the "timezone" field.
+func (m *TenantSettingMutation) SetTimezone(s string) {
+	m.timezone = &s
 }
 
-// ProcessedAt returns the value of the "processed_at" field in the mutation.
-func (m *OutboxMutation) ProcessedAt() (r time.Time, exists bool) {
-	v := m.processed_at
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *TenantSettingMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessedAt returns the old "processed_at" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// OldTimezone returns the old "timezone" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldProcessedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TenantSettingMutation) OldTimezone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessedAt requires an ID field in the mutation")
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
 	}
-	return oldValue.ProcessedAt, nil
-}
-
-// ClearProcessedAt clears the value of the "processed_at" field.
-func (m *OutboxMutation) ClearProcessedAt() {
-	m.processed_at = nil
-	m.clearedFields[outbox.FieldProcessedAt] = struct{}{}
-}
-
-// ProcessedAtCleared returns if the "processed_at" field was cleared in this mutation.
-func (m *OutboxMutation) ProcessedAtCleared() bool {
-	_, ok := m.clearedFields[outbox.FieldProcessedAt]
-	return ok
+	return oldValue.Timezone, nil
 }
 
-// ResetProcessedAt resets all changes to the "processed_at" field.
-func (m *OutboxMutation) ResetProcessedAt() {
-	m.processed_at = nil
-	delete(m.clearedFields, outbox.FieldProcessedAt)
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *TenantSettingMutation) ResetTimezone() {
+	m.timezone = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *OutboxMutation) SetStatus(s string) {
-	m.status = &s
+// SetCurrency sets the "currency" field.
+func (m *TenantSettingMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *OutboxMutation) Status() (r string, exists bool) {
-	v := m.status
+// Currency returns the value of the "currency" field in the mutation.
+func (m *TenantSettingMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *TenantSettingMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Currency, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *OutboxMutation) ResetStatus() {
-	m.status = nil
+// ResetCurrency resets all changes to the "currency" field.
+func (m *TenantSettingMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *OutboxMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetLocale sets the "locale" field.
+func (m *TenantSettingMutation) SetLocale(s string) {
+	m.locale = &s
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *OutboxMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// Locale returns the value of the "locale" field in the mutation.
+func (m *TenantSettingMutation) Locale() (r string, exists bool) {
+	v := m.locale
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// OldLocale returns the old "locale" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+func (m *TenantSettingMutation) OldLocale(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocale is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldLocale requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocale: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *OutboxMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[outbox.FieldErrorMessage] = struct{}{}
-}
-
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *OutboxMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[outbox.FieldErrorMessage]
-	return ok
+	return oldValue.Locale, nil
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *OutboxMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, outbox.FieldErrorMessage)
+// ResetLocale resets all changes to the "locale" field.
+func (m *TenantSettingMutation) ResetLocale() {
+	m.locale = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *OutboxMutation) SetVersion(i int64) {
-	m.version = &i
-	m.addversion = nil
+// SetBusinessHours sets the "business_hours" field.
+func (m *TenantSettingMutation) SetBusinessHours(sh []schema.OpeningHours) {
+	m.business_hours = &sh
+	m.appendbusiness_hours = nil
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *OutboxMutation) Version() (r int64, exists bool) {
-	v := m.version
+// BusinessHours returns the value of the "business_hours" field in the mutation.
+func (m *TenantSettingMutation) BusinessHours() (r []schema.OpeningHours, exists bool) {
+	v := m.business_hours
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// OldBusinessHours returns the old "business_hours" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldVersion(ctx context.Context) (v int64, err error) {
+func (m *TenantSettingMutation) OldBusinessHours(ctx context.Context) (v []schema.OpeningHours, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldBusinessHours is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldBusinessHours requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldBusinessHours: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.BusinessHours, nil
 }
 
-// AddVersion adds i to the "version" field.
-func (m *OutboxMutation) AddVersion(i int64) {
-	if m.addversion != nil {
-		*m.addversion += i
-	} else {
-		m.addversion = &i
-	}
+// AppendBusinessHours adds sh to the "business_hours" field.
+func (m *TenantSettingMutation) AppendBusinessHours(sh []schema.OpeningHours) {
+	m.appendbusiness_hours = append(m.appendbusiness_hours, sh...)
 }
 
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *OutboxMutation) AddedVersion() (r int64, exists bool) {
-	v := m.addversion
-	if v == nil {
-		return
+// AppendedBusinessHours returns the list of values that were appended to the "business_hours" field in this mutation.
+func (m *TenantSettingMutation) AppendedBusinessHours() ([]schema.OpeningHours, bool) {
+	if len(m.appendbusiness_hours) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendbusiness_hours, true
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *OutboxMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (m *TenantSettingMutation) ClearBusinessHours() {
+	m.business_hours = nil
+	m.appendbusiness_hours = nil
+	m.clearedFields[tenantsetting.FieldBusinessHours] = struct{}{}
 }
 
-// SetLockedAt sets the "locked_at" field.
-func (m *OutboxMutation) SetLockedAt(t time.Time) {
-	m.locked_at = &t
+// BusinessHoursCleared returns if the "business_hours" field was cleared in this mutation.
+func (m *TenantSettingMutation) BusinessHoursCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldBusinessHours]
+	return ok
 }
 
-// LockedAt returns the value of the "locked_at" field in the mutation.
-func (m *OutboxMutation) LockedAt() (r time.Time, exists bool) {
-	v := m.locked_at
+// ResetBusinessHours resets all changes to the "business_hours" field.
+func (m *TenantSettingMutation) ResetBusinessHours() {
+	m.business_hours = nil
+	m.appendbusiness_hours = nil
+	delete(m.clearedFields, tenantsetting.FieldBusinessHours)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TenantSettingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TenantSettingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLockedAt returns the old "locked_at" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldLockedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TenantSettingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLockedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLockedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLockedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.LockedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ClearLockedAt clears the value of the "locked_at" field.
-func (m *OutboxMutation) ClearLockedAt() {
-	m.locked_at = nil
-	m.clearedFields[outbox.FieldLockedAt] = struct{}{}
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *TenantSettingMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[tenantsetting.FieldCreatedAt] = struct{}{}
 }
 
-// LockedAtCleared returns if the "locked_at" field was cleared in this mutation.
-func (m *OutboxMutation) LockedAtCleared() bool {
-	_, ok := m.clearedFields[outbox.FieldLockedAt]
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *TenantSettingMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldCreatedAt]
 	return ok
 }
 
-// ResetLockedAt resets all changes to the "locked_at" field.
-func (m *OutboxMutation) ResetLockedAt() {
-	m.locked_at = nil
-	delete(m.clearedFields, outbox.FieldLockedAt)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TenantSettingMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, tenantsetting.FieldCreatedAt)
 }
 
-// SetLockedBy sets the "locked_by" field.
-func (m *OutboxMutation) SetLockedBy(s string) {
-	m.locked_by = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TenantSettingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// LockedBy returns the value of the "locked_by" field in the mutation.
-func (m *OutboxMutation) LockedBy() (r string, exists bool) {
-	v := m.locked_by
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TenantSettingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLockedBy returns the old "locked_by" field's value of the Outbox entity.
-// If the Outbox object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the TenantSetting entity.
+// If the TenantSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OutboxMutation) OldLockedBy(ctx context.Context) (v *string, err error) {
+func (m *TenantSettingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLockedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLockedBy requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLockedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.LockedBy, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearLockedBy clears the value of the "locked_by" field.
-func (m *OutboxMutation) ClearLockedBy() {
-	m.locked_by = nil
-	m.clearedFields[outbox.FieldLockedBy] = struct{}{}
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *TenantSettingMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[tenantsetting.FieldUpdatedAt] = struct{}{}
 }
 
-// LockedByCleared returns if the "locked_by" field was cleared in this mutation.
-func (m *OutboxMutation) LockedByCleared() bool {
-	_, ok := m.clearedFields[outbox.FieldLockedBy]
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *TenantSettingMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[tenantsetting.FieldUpdatedAt]
 	return ok
 }
 
-// ResetLockedBy resets all changes to the "locked_by" field.
-func (m *OutboxMutation) ResetLockedBy() {
-	m.locked_by = nil
-	delete(m.clearedFields, outbox.FieldLockedBy)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TenantSettingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, tenantsetting.FieldUpdatedAt)
 }
 
-// Where appends a list predicates to the OutboxMutation builder.
-func (m *OutboxMutation) Where(ps ...predicate.Outbox) {
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *TenantSettingMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[tenantsetting.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *TenantSettingMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *TenantSettingMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *TenantSettingMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// Where appends a list predicates to the TenantSettingMutation builder.
+func (m *TenantSettingMutation) Where(ps ...predicate.TenantSetting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OutboxMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TenantSettingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OutboxMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Outbox, len(ps))
+func (m *TenantSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TenantSetting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3899,57 +20415,45 @@ func (m *OutboxMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OutboxMutation) Op() Op {
+func (m *TenantSettingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OutboxMutation) SetOp(op Op) {
+func (m *TenantSettingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Outbox).
-func (m *OutboxMutation) Type() string {
+// Type returns the node type of this mutation (TenantSetting).
+func (m *TenantSettingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OutboxMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.aggregate_type != nil {
-		fields = append(fields, outbox.FieldAggregateType)
-	}
-	if m.aggregate_id != nil {
-		fields = append(fields, outbox.FieldAggregateID)
-	}
-	if m.event_type != nil {
-		fields = append(fields, outbox.FieldEventType)
-	}
-	if m.payload != nil {
-		fields = append(fields, outbox.FieldPayload)
-	}
-	if m.created_at != nil {
-		fields = append(fields, outbox.FieldCreatedAt)
+func (m *TenantSettingMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.tenant != nil {
+		fields = append(fields, tenantsetting.FieldTenantID)
 	}
-	if m.processed_at != nil {
-		fields = append(fields, outbox.FieldProcessedAt)
+	if m.timezone != nil {
+		fields = append(fields, tenantsetting.FieldTimezone)
 	}
-	if m.status != nil {
-		fields = append(fields, outbox.FieldStatus)
+	if m.currency != nil {
+		fields = append(fields, tenantsetting.FieldCurrency)
 	}
-	if m.error_message != nil {
-		fields = append(fields, outbox.FieldErrorMessage)
+	if m.locale != nil {
+		fields = append(fields, tenantsetting.FieldLocale)
 	}
-	if m.version != nil {
-		fields = append(fields, outbox.FieldVersion)
+	if m.business_hours != nil {
+		fields = append(fields, tenantsetting.FieldBusinessHours)
 	}
-	if m.locked_at != nil {
-		fields = append(fields, outbox.FieldLockedAt)
+	if m.created_at != nil {
+		fields = append(fields, tenantsetting.FieldCreatedAt)
 	}
-	if m.locked_by != nil {
-		fields = append(fields, outbox.FieldLockedBy)
+	if m.updated_at != nil {
+		fields = append(fields, tenantsetting.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3957,30 +20461,22 @@ func (m *OutboxMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OutboxMutation) Field(name string) (ent.Value, bool) {
+func (m *TenantSettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case outbox.FieldAggregateType:
-		return m.AggregateType()
-	case outbox.FieldAggregateID:
-		return m.AggregateID()
-	case outbox.FieldEventType:
-		return m.EventType()
-	case outbox.FieldPayload:
-		return m.Payload()
-	case outbox.FieldCreatedAt:
+	case tenantsetting.FieldTenantID:
+		return m.TenantID()
+	case tenantsetting.FieldTimezone:
+		return m.Timezone()
+	case tenantsetting.FieldCurrency:
+		return m.Currency()
+	case tenantsetting.FieldLocale:
+		return m.Locale()
+	case tenantsetting.FieldBusinessHours:
+		return m.BusinessHours()
+	case tenantsetting.FieldCreatedAt:
 		return m.CreatedAt()
-	case outbox.FieldProcessedAt:
-		return m.ProcessedAt()
-	case outbox.FieldStatus:
-		return m.Status()
-	case outbox.FieldErrorMessage:
-		return m.ErrorMessage()
-	case outbox.FieldVersion:
-		return m.Version()
-	case outbox.FieldLockedAt:
-		return m.LockedAt()
-	case outbox.FieldLockedBy:
-		return m.LockedBy()
+	case tenantsetting.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3988,341 +20484,278 @@ func (m *OutboxMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OutboxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TenantSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case outbox.FieldAggregateType:
-		return m.OldAggregateType(ctx)
-	case outbox.FieldAggregateID:
-		return m.OldAggregateID(ctx)
-	case outbox.FieldEventType:
-		return m.OldEventType(ctx)
-	case outbox.FieldPayload:
-		return m.OldPayload(ctx)
-	case outbox.FieldCreatedAt:
+	case tenantsetting.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case tenantsetting.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case tenantsetting.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case tenantsetting.FieldLocale:
+		return m.OldLocale(ctx)
+	case tenantsetting.FieldBusinessHours:
+		return m.OldBusinessHours(ctx)
+	case tenantsetting.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case outbox.FieldProcessedAt:
-		return m.OldProcessedAt(ctx)
-	case outbox.FieldStatus:
-		return m.OldStatus(ctx)
-	case outbox.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case outbox.FieldVersion:
-		return m.OldVersion(ctx)
-	case outbox.FieldLockedAt:
-		return m.OldLockedAt(ctx)
-	case outbox.FieldLockedBy:
-		return m.OldLockedBy(ctx)
+	case tenantsetting.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Outbox field %s", name)
+	return nil, fmt.Errorf("unknown TenantSetting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OutboxMutation) SetField(name string, value ent.Value) error {
+func (m *TenantSettingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case outbox.FieldAggregateType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAggregateType(v)
-		return nil
-	case outbox.FieldAggregateID:
+	case tenantsetting.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAggregateID(v)
+		m.SetTenantID(v)
 		return nil
-	case outbox.FieldEventType:
+	case tenantsetting.FieldTimezone:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEventType(v)
-		return nil
-	case outbox.FieldPayload:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPayload(v)
-		return nil
-	case outbox.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case outbox.FieldProcessedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessedAt(v)
+		m.SetTimezone(v)
 		return nil
-	case outbox.FieldStatus:
+	case tenantsetting.FieldCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetCurrency(v)
 		return nil
-	case outbox.FieldErrorMessage:
+	case tenantsetting.FieldLocale:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetLocale(v)
 		return nil
-	case outbox.FieldVersion:
-		v, ok := value.(int64)
+	case tenantsetting.FieldBusinessHours:
+		v, ok := value.([]schema.OpeningHours)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetBusinessHours(v)
 		return nil
-	case outbox.FieldLockedAt:
+	case tenantsetting.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLockedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case outbox.FieldLockedBy:
-		v, ok := value.(string)
+	case tenantsetting.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLockedBy(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Outbox field %s", name)
+	return fmt.Errorf("unknown TenantSetting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OutboxMutation) AddedFields() []string {
-	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, outbox.FieldVersion)
-	}
-	return fields
+func (m *TenantSettingMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OutboxMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case outbox.FieldVersion:
-		return m.AddedVersion()
-	}
+func (m *TenantSettingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OutboxMutation) AddField(name string, value ent.Value) error {
+func (m *TenantSettingMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case outbox.FieldVersion:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVersion(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Outbox numeric field %s", name)
+	return fmt.Errorf("unknown TenantSetting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OutboxMutation) ClearedFields() []string {
+func (m *TenantSettingMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(outbox.FieldPayload) {
-		fields = append(fields, outbox.FieldPayload)
-	}
-	if m.FieldCleared(outbox.FieldCreatedAt) {
-		fields = append(fields, outbox.FieldCreatedAt)
+	if m.FieldCleared(tenantsetting.FieldBusinessHours) {
+		fields = append(fields, tenantsetting.FieldBusinessHours)
 	}
-	if m.FieldCleared(outbox.FieldProcessedAt) {
-		fields = append(fields, outbox.FieldProcessedAt)
-	}
-	if m.FieldCleared(outbox.FieldErrorMessage) {
-		fields = append(fields, outbox.FieldErrorMessage)
-	}
-	if m.FieldCleared(outbox.FieldLockedAt) {
-		fields = append(fields, outbox.FieldLockedAt)
+	if m.FieldCleared(tenantsetting.FieldCreatedAt) {
+		fields = append(fields, tenantsetting.FieldCreatedAt)
 	}
-	if m.FieldCleared(outbox.FieldLockedBy) {
-		fields = append(fields, outbox.FieldLockedBy)
+	if m.FieldCleared(tenantsetting.FieldUpdatedAt) {
+		fields = append(fields, tenantsetting.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OutboxMutation) FieldCleared(name string) bool {
+func (m *TenantSettingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OutboxMutation) ClearField(name string) error {
+func (m *TenantSettingMutation) ClearField(name string) error {
 	switch name {
-	case outbox.FieldPayload:
-		m.ClearPayload()
+	case tenantsetting.FieldBusinessHours:
+		m.ClearBusinessHours()
 		return nil
-	case outbox.FieldCreatedAt:
+	case tenantsetting.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case outbox.FieldProcessedAt:
-		m.ClearProcessedAt()
-		return nil
-	case outbox.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case outbox.FieldLockedAt:
-		m.ClearLockedAt()
-		return nil
-	case outbox.FieldLockedBy:
-		m.ClearLockedBy()
+	case tenantsetting.FieldUpdatedAt:
+		m.ClearUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Outbox nullable field %s", name)
+	return fmt.Errorf("unknown TenantSetting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OutboxMutation) ResetField(name string) error {
+func (m *TenantSettingMutation) ResetField(name string) error {
 	switch name {
-	case outbox.FieldAggregateType:
-		m.ResetAggregateType()
-		return nil
-	case outbox.FieldAggregateID:
-		m.ResetAggregateID()
-		return nil
-	case outbox.FieldEventType:
-		m.ResetEventType()
-		return nil
-	case outbox.FieldPayload:
-		m.ResetPayload()
-		return nil
-	case outbox.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case tenantsetting.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case outbox.FieldProcessedAt:
-		m.ResetProcessedAt()
+	case tenantsetting.FieldTimezone:
+		m.ResetTimezone()
 		return nil
-	case outbox.FieldStatus:
-		m.ResetStatus()
+	case tenantsetting.FieldCurrency:
+		m.ResetCurrency()
 		return nil
-	case outbox.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case tenantsetting.FieldLocale:
+		m.ResetLocale()
 		return nil
-	case outbox.FieldVersion:
-		m.ResetVersion()
+	case tenantsetting.FieldBusinessHours:
+		m.ResetBusinessHours()
 		return nil
-	case outbox.FieldLockedAt:
-		m.ResetLockedAt()
+	case tenantsetting.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case outbox.FieldLockedBy:
-		m.ResetLockedBy()
+	case tenantsetting.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Outbox field %s", name)
+	return fmt.Errorf("unknown TenantSetting field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OutboxMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TenantSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tenant != nil {
+		edges = append(edges, tenantsetting.EdgeTenant)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OutboxMutation) AddedIDs(name string) []ent.Value {
+func (m *TenantSettingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tenantsetting.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OutboxMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TenantSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OutboxMutation) RemovedIDs(name string) []ent.Value {
+func (m *TenantSettingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OutboxMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TenantSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtenant {
+		edges = append(edges, tenantsetting.EdgeTenant)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OutboxMutation) EdgeCleared(name string) bool {
+func (m *TenantSettingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tenantsetting.EdgeTenant:
+		return m.clearedtenant
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OutboxMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Outbox unique edge %s", name)
+func (m *TenantSettingMutation) ClearEdge(name string) error {
+	switch name {
+	case tenantsetting.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown TenantSetting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OutboxMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Outbox edge %s", name)
+func (m *TenantSettingMutation) ResetEdge(name string) error {
+	switch name {
+	case tenantsetting.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	}
+	return fmt.Errorf("unknown TenantSetting edge %s", name)
 }
 
-// RentalMutation represents an operation that mutates the Rental nodes in the graph.
-type RentalMutation struct {
+// UsageRecordMutation represents an operation that mutates the UsageRecord nodes in the graph.
+type UsageRecordMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *string
-	starts_at             *time.Time
-	ends_at               *time.Time
-	created_at            *time.Time
-	updated_at            *time.Time
-	deleted_at            *time.Time
-	clearedFields         map[string]struct{}
-	tenant                *string
-	clearedtenant         bool
-	car                   *string
-	clearedcar            bool
-	renter                *string
-	clearedrenter         bool
-	rental_options        map[string]struct{}
-	removedrental_options map[string]struct{}
-	clearedrental_options bool
-	done                  bool
-	oldValue              func(context.Context) (*Rental, error)
-	predicates            []predicate.Rental
+	op            Op
+	typ           string
+	id            *string
+	tenant_id     *string
+	meter         *string
+	quantity      *int64
+	addquantity   *int64
+	occurred_at   *time.Time
+	recorded_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UsageRecord, error)
+	predicates    []predicate.UsageRecord
 }
 
-var _ ent.Mutation = (*RentalMutation)(nil)
+var _ ent.Mutation = (*UsageRecordMutation)(nil)
 
-// rentalOption allows management of the mutation configuration using functional options.
-type rentalOption func(*RentalMutation)
+// usagerecordOption allows management of the mutation configuration using functional options.
+type usagerecordOption func(*UsageRecordMutation)
 
-// newRentalMutation creates new mutation for the Rental entity.
-func newRentalMutation(c config, op Op, opts ...rentalOption) *RentalMutation {
-	m := &RentalMutation{
+// newUsageRecordMutation creates new mutation for the UsageRecord entity.
+func newUsageRecordMutation(c config, op Op, opts ...usagerecordOption) *UsageRecordMutation {
+	m := &UsageRecordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRental,
+		typ:           TypeUsageRecord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4331,20 +20764,20 @@ func newRentalMutation(c config, op Op, opts ...rentalOption) *RentalMutation {
 	return m
 }
 
-// withRentalID sets the ID field of the mutation.
-func withRentalID(id string) rentalOption {
-	return func(m *RentalMutation) {
+// withUsageRecordID sets the ID field of the mutation.
+func withUsageRecordID(id string) usagerecordOption {
+	return func(m *UsageRecordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Rental
+			value *UsageRecord
 		)
-		m.oldValue = func(ctx context.Context) (*Rental, error) {
+		m.oldValue = func(ctx context.Context) (*UsageRecord, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Rental.Get(ctx, id)
+					value, err = m.Client().UsageRecord.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4353,10 +20786,10 @@ func withRentalID(id string) rentalOption {
 	}
 }
 
-// withRental sets the old Rental of the mutation.
-func withRental(node *Rental) rentalOption {
-	return func(m *RentalMutation) {
-		m.oldValue = func(context.Context) (*Rental, error) {
+// withUsageRecord sets the old UsageRecord of the mutation.
+func withUsageRecord(node *UsageRecord) usagerecordOption {
+	return func(m *UsageRecordMutation) {
+		m.oldValue = func(context.Context) (*UsageRecord, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4365,7 +20798,7 @@ func withRental(node *Rental) rentalOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RentalMutation) Client() *Client {
+func (m UsageRecordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4373,7 +20806,7 @@ func (m RentalMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RentalMutation) Tx() (*Tx, error) {
+func (m UsageRecordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("entgen: mutation is not running in a transaction")
 	}
@@ -4383,14 +20816,14 @@ func (m RentalMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Rental entities.
-func (m *RentalMutation) SetID(id string) {
+// operation is only accepted on creation of UsageRecord entities.
+func (m *UsageRecordMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RentalMutation) ID() (id string, exists bool) {
+func (m *UsageRecordMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4401,7 +20834,7 @@ func (m *RentalMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RentalMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UsageRecordMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4410,509 +20843,234 @@ func (m *RentalMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Rental.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetTenantID sets the "tenant_id" field.
-func (m *RentalMutation) SetTenantID(s string) {
-	m.tenant = &s
-}
-
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *RentalMutation) TenantID() (r string, exists bool) {
-	v := m.tenant
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTenantID returns the old "tenant_id" field's value of the Rental entity.
-// If the Rental object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalMutation) OldTenantID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
-	}
-	return oldValue.TenantID, nil
-}
-
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *RentalMutation) ResetTenantID() {
-	m.tenant = nil
-}
-
-// SetCarID sets the "car_id" field.
-func (m *RentalMutation) SetCarID(s string) {
-	m.car = &s
-}
-
-// CarID returns the value of the "car_id" field in the mutation.
-func (m *RentalMutation) CarID() (r string, exists bool) {
-	v := m.car
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCarID returns the old "car_id" field's value of the Rental entity.
-// If the Rental object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalMutation) OldCarID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCarID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCarID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCarID: %w", err)
-	}
-	return oldValue.CarID, nil
-}
-
-// ResetCarID resets all changes to the "car_id" field.
-func (m *RentalMutation) ResetCarID() {
-	m.car = nil
-}
-
-// SetRenterID sets the "renter_id" field.
-func (m *RentalMutation) SetRenterID(s string) {
-	m.renter = &s
-}
-
-// RenterID returns the value of the "renter_id" field in the mutation.
-func (m *RentalMutation) RenterID() (r string, exists bool) {
-	v := m.renter
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRenterID returns the old "renter_id" field's value of the Rental entity.
-// If the Rental object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalMutation) OldRenterID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRenterID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRenterID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRenterID: %w", err)
-	}
-	return oldValue.RenterID, nil
-}
-
-// ResetRenterID resets all changes to the "renter_id" field.
-func (m *RentalMutation) ResetRenterID() {
-	m.renter = nil
-}
-
-// SetStartsAt sets the "starts_at" field.
-func (m *RentalMutation) SetStartsAt(t time.Time) {
-	m.starts_at = &t
-}
-
-// StartsAt returns the value of the "starts_at" field in the mutation.
-func (m *RentalMutation) StartsAt() (r time.Time, exists bool) {
-	v := m.starts_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStartsAt returns the old "starts_at" field's value of the Rental entity.
-// If the Rental object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalMutation) OldStartsAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartsAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartsAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartsAt: %w", err)
-	}
-	return oldValue.StartsAt, nil
-}
-
-// ClearStartsAt clears the value of the "starts_at" field.
-func (m *RentalMutation) ClearStartsAt() {
-	m.starts_at = nil
-	m.clearedFields[rental.FieldStartsAt] = struct{}{}
-}
-
-// StartsAtCleared returns if the "starts_at" field was cleared in this mutation.
-func (m *RentalMutation) StartsAtCleared() bool {
-	_, ok := m.clearedFields[rental.FieldStartsAt]
-	return ok
-}
-
-// ResetStartsAt resets all changes to the "starts_at" field.
-func (m *RentalMutation) ResetStartsAt() {
-	m.starts_at = nil
-	delete(m.clearedFields, rental.FieldStartsAt)
+		return m.Client().UsageRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetEndsAt sets the "ends_at" field.
-func (m *RentalMutation) SetEndsAt(t time.Time) {
-	m.ends_at = &t
+// SetTenantID sets the "tenant_id" field.
+func (m *UsageRecordMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
-// EndsAt returns the value of the "ends_at" field in the mutation.
-func (m *RentalMutation) EndsAt() (r time.Time, exists bool) {
-	v := m.ends_at
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *UsageRecordMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndsAt returns the old "ends_at" field's value of the Rental entity.
-// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalMutation) OldEndsAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRecordMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndsAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndsAt requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndsAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.EndsAt, nil
-}
-
-// ClearEndsAt clears the value of the "ends_at" field.
-func (m *RentalMutation) ClearEndsAt() {
-	m.ends_at = nil
-	m.clearedFields[rental.FieldEndsAt] = struct{}{}
-}
-
-// EndsAtCleared returns if the "ends_at" field was cleared in this mutation.
-func (m *RentalMutation) EndsAtCleared() bool {
-	_, ok := m.clearedFields[rental.FieldEndsAt]
-	return ok
+	return oldValue.TenantID, nil
 }
 
-// ResetEndsAt resets all changes to the "ends_at" field.
-func (m *RentalMutation) ResetEndsAt() {
-	m.ends_at = nil
-	delete(m.clearedFields, rental.FieldEndsAt)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *UsageRecordMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RentalMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetMeter sets the "meter" field.
+func (m *UsageRecordMutation) SetMeter(s string) {
+	m.meter = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RentalMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Meter returns the value of the "meter" field in the mutation.
+func (m *UsageRecordMutation) Meter() (r string, exists bool) {
+	v := m.meter
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Rental entity.
-// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// OldMeter returns the old "meter" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRecordMutation) OldMeter(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMeter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMeter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMeter: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *RentalMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[rental.FieldCreatedAt] = struct{}{}
-}
-
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *RentalMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[rental.FieldCreatedAt]
-	return ok
+	return oldValue.Meter, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RentalMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, rental.FieldCreatedAt)
+// ResetMeter resets all changes to the "meter" field.
+func (m *UsageRecordMutation) ResetMeter() {
+	m.meter = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RentalMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetQuantity sets the "quantity" field.
+func (m *UsageRecordMutation) SetQuantity(i int64) {
+	m.quantity = &i
+	m.addquantity = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RentalMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *UsageRecordMutation) Quantity() (r int64, exists bool) {
+	v := m.quantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Rental entity.
-// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// OldQuantity returns the old "quantity" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRecordMutation) OldQuantity(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Quantity, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *RentalMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[rental.FieldUpdatedAt] = struct{}{}
+// AddQuantity adds i to the "quantity" field.
+func (m *UsageRecordMutation) AddQuantity(i int64) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *RentalMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[rental.FieldUpdatedAt]
-	return ok
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *UsageRecordMutation) AddedQuantity() (r int64, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RentalMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, rental.FieldUpdatedAt)
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *UsageRecordMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *RentalMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetOccurredAt sets the "occurred_at" field.
+func (m *UsageRecordMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *RentalMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *UsageRecordMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Rental entity.
-// If the Rental object wasn't provided to the builder, the object is fetched from the database.
+// OldOccurredAt returns the old "occurred_at" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UsageRecordMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *RentalMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[rental.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *RentalMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[rental.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *RentalMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, rental.FieldDeletedAt)
-}
-
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *RentalMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[rental.FieldTenantID] = struct{}{}
-}
-
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *RentalMutation) TenantCleared() bool {
-	return m.clearedtenant
-}
-
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *RentalMutation) TenantIDs() (ids []string) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *RentalMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
-}
-
-// ClearCar clears the "car" edge to the Car entity.
-func (m *RentalMutation) ClearCar() {
-	m.clearedcar = true
-	m.clearedFields[rental.FieldCarID] = struct{}{}
-}
-
-// CarCleared reports if the "car" edge to the Car entity was cleared.
-func (m *RentalMutation) CarCleared() bool {
-	return m.clearedcar
-}
-
-// CarIDs returns the "car" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CarID instead. It exists only for internal usage by the builders.
-func (m *RentalMutation) CarIDs() (ids []string) {
-	if id := m.car; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
 	}
-	return
-}
-
-// ResetCar resets all changes to the "car" edge.
-func (m *RentalMutation) ResetCar() {
-	m.car = nil
-	m.clearedcar = false
+	return oldValue.OccurredAt, nil
 }
 
-// ClearRenter clears the "renter" edge to the Renter entity.
-func (m *RentalMutation) ClearRenter() {
-	m.clearedrenter = true
-	m.clearedFields[rental.FieldRenterID] = struct{}{}
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *UsageRecordMutation) ResetOccurredAt() {
+	m.occurred_at = nil
 }
 
-// RenterCleared reports if the "renter" edge to the Renter entity was cleared.
-func (m *RentalMutation) RenterCleared() bool {
-	return m.clearedrenter
+// SetRecordedAt sets the "recorded_at" field.
+func (m *UsageRecordMutation) SetRecordedAt(t time.Time) {
+	m.recorded_at = &t
 }
 
-// RenterIDs returns the "renter" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RenterID instead. It exists only for internal usage by the builders.
-func (m *RentalMutation) RenterIDs() (ids []string) {
-	if id := m.renter; id != nil {
-		ids = append(ids, *id)
+// RecordedAt returns the value of the "recorded_at" field in the mutation.
+func (m *UsageRecordMutation) RecordedAt() (r time.Time, exists bool) {
+	v := m.recorded_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetRenter resets all changes to the "renter" edge.
-func (m *RentalMutation) ResetRenter() {
-	m.renter = nil
-	m.clearedrenter = false
+	return *v, true
 }
 
-// AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by ids.
-func (m *RentalMutation) AddRentalOptionIDs(ids ...string) {
-	if m.rental_options == nil {
-		m.rental_options = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.rental_options[ids[i]] = struct{}{}
+// OldRecordedAt returns the old "recorded_at" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldRecordedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecordedAt is only allowed on UpdateOne operations")
 	}
-}
-
-// ClearRentalOptions clears the "rental_options" edge to the RentalOption entity.
-func (m *RentalMutation) ClearRentalOptions() {
-	m.clearedrental_options = true
-}
-
-// RentalOptionsCleared reports if the "rental_options" edge to the RentalOption entity was cleared.
-func (m *RentalMutation) RentalOptionsCleared() bool {
-	return m.clearedrental_options
-}
-
-// RemoveRentalOptionIDs removes the "rental_options" edge to the RentalOption entity by IDs.
-func (m *RentalMutation) RemoveRentalOptionIDs(ids ...string) {
-	if m.removedrental_options == nil {
-		m.removedrental_options = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecordedAt requires an ID field in the mutation")
 	}
-	for i := range ids {
-		delete(m.rental_options, ids[i])
-		m.removedrental_options[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecordedAt: %w", err)
 	}
+	return oldValue.RecordedAt, nil
 }
 
-// RemovedRentalOptions returns the removed IDs of the "rental_options" edge to the RentalOption entity.
-func (m *RentalMutation) RemovedRentalOptionsIDs() (ids []string) {
-	for id := range m.removedrental_options {
-		ids = append(ids, id)
-	}
-	return
+// ClearRecordedAt clears the value of the "recorded_at" field.
+func (m *UsageRecordMutation) ClearRecordedAt() {
+	m.recorded_at = nil
+	m.clearedFields[usagerecord.FieldRecordedAt] = struct{}{}
 }
 
-// RentalOptionsIDs returns the "rental_options" edge IDs in the mutation.
-func (m *RentalMutation) RentalOptionsIDs() (ids []string) {
-	for id := range m.rental_options {
-		ids = append(ids, id)
-	}
-	return
+// RecordedAtCleared returns if the "recorded_at" field was cleared in this mutation.
+func (m *UsageRecordMutation) RecordedAtCleared() bool {
+	_, ok := m.clearedFields[usagerecord.FieldRecordedAt]
+	return ok
 }
 
-// ResetRentalOptions resets all changes to the "rental_options" edge.
-func (m *RentalMutation) ResetRentalOptions() {
-	m.rental_options = nil
-	m.clearedrental_options = false
-	m.removedrental_options = nil
+// ResetRecordedAt resets all changes to the "recorded_at" field.
+func (m *UsageRecordMutation) ResetRecordedAt() {
+	m.recorded_at = nil
+	delete(m.clearedFields, usagerecord.FieldRecordedAt)
 }
 
-// Where appends a list predicates to the RentalMutation builder.
-func (m *RentalMutation) Where(ps ...predicate.Rental) {
+// Where appends a list predicates to the UsageRecordMutation builder.
+func (m *UsageRecordMutation) Where(ps ...predicate.UsageRecord) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RentalMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsageRecordMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RentalMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Rental, len(ps))
+func (m *UsageRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsageRecord, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4920,48 +21078,39 @@ func (m *RentalMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RentalMutation) Op() Op {
+func (m *UsageRecordMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RentalMutation) SetOp(op Op) {
+func (m *UsageRecordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Rental).
-func (m *RentalMutation) Type() string {
+// Type returns the node type of this mutation (UsageRecord).
+func (m *UsageRecordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RentalMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.tenant != nil {
-		fields = append(fields, rental.FieldTenantID)
-	}
-	if m.car != nil {
-		fields = append(fields, rental.FieldCarID)
-	}
-	if m.renter != nil {
-		fields = append(fields, rental.FieldRenterID)
-	}
-	if m.starts_at != nil {
-		fields = append(fields, rental.FieldStartsAt)
+func (m *UsageRecordMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tenant_id != nil {
+		fields = append(fields, usagerecord.FieldTenantID)
 	}
-	if m.ends_at != nil {
-		fields = append(fields, rental.FieldEndsAt)
+	if m.meter != nil {
+		fields = append(fields, usagerecord.FieldMeter)
 	}
-	if m.created_at != nil {
-		fields = append(fields, rental.FieldCreatedAt)
+	if m.quantity != nil {
+		fields = append(fields, usagerecord.FieldQuantity)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, rental.FieldUpdatedAt)
+	if m.occurred_at != nil {
+		fields = append(fields, usagerecord.FieldOccurredAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, rental.FieldDeletedAt)
+	if m.recorded_at != nil {
+		fields = append(fields, usagerecord.FieldRecordedAt)
 	}
 	return fields
 }
@@ -4969,24 +21118,18 @@ func (m *RentalMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RentalMutation) Field(name string) (ent.Value, bool) {
+func (m *UsageRecordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case rental.FieldTenantID:
+	case usagerecord.FieldTenantID:
 		return m.TenantID()
-	case rental.FieldCarID:
-		return m.CarID()
-	case rental.FieldRenterID:
-		return m.RenterID()
-	case rental.FieldStartsAt:
-		return m.StartsAt()
-	case rental.FieldEndsAt:
-		return m.EndsAt()
-	case rental.FieldCreatedAt:
-		return m.CreatedAt()
-	case rental.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case rental.FieldDeletedAt:
-		return m.DeletedAt()
+	case usagerecord.FieldMeter:
+		return m.Meter()
+	case usagerecord.FieldQuantity:
+		return m.Quantity()
+	case usagerecord.FieldOccurredAt:
+		return m.OccurredAt()
+	case usagerecord.FieldRecordedAt:
+		return m.RecordedAt()
 	}
 	return nil, false
 }
@@ -4994,371 +21137,232 @@ func (m *RentalMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RentalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsageRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case rental.FieldTenantID:
+	case usagerecord.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case rental.FieldCarID:
-		return m.OldCarID(ctx)
-	case rental.FieldRenterID:
-		return m.OldRenterID(ctx)
-	case rental.FieldStartsAt:
-		return m.OldStartsAt(ctx)
-	case rental.FieldEndsAt:
-		return m.OldEndsAt(ctx)
-	case rental.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case rental.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case rental.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	case usagerecord.FieldMeter:
+		return m.OldMeter(ctx)
+	case usagerecord.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case usagerecord.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	case usagerecord.FieldRecordedAt:
+		return m.OldRecordedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Rental field %s", name)
+	return nil, fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RentalMutation) SetField(name string, value ent.Value) error {
+func (m *UsageRecordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case rental.FieldTenantID:
+	case usagerecord.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case rental.FieldCarID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCarID(v)
-		return nil
-	case rental.FieldRenterID:
+	case usagerecord.FieldMeter:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRenterID(v)
-		return nil
-	case rental.FieldStartsAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartsAt(v)
-		return nil
-	case rental.FieldEndsAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndsAt(v)
+		m.SetMeter(v)
 		return nil
-	case rental.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case usagerecord.FieldQuantity:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetQuantity(v)
 		return nil
-	case rental.FieldUpdatedAt:
+	case usagerecord.FieldOccurredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetOccurredAt(v)
 		return nil
-	case rental.FieldDeletedAt:
+	case usagerecord.FieldRecordedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetRecordedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Rental field %s", name)
+	return fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RentalMutation) AddedFields() []string {
-	return nil
+func (m *UsageRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, usagerecord.FieldQuantity)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RentalMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsageRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case usagerecord.FieldQuantity:
+		return m.AddedQuantity()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RentalMutation) AddField(name string, value ent.Value) error {
+func (m *UsageRecordMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case usagerecord.FieldQuantity:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Rental numeric field %s", name)
+	return fmt.Errorf("unknown UsageRecord numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RentalMutation) ClearedFields() []string {
+func (m *UsageRecordMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(rental.FieldStartsAt) {
-		fields = append(fields, rental.FieldStartsAt)
-	}
-	if m.FieldCleared(rental.FieldEndsAt) {
-		fields = append(fields, rental.FieldEndsAt)
-	}
-	if m.FieldCleared(rental.FieldCreatedAt) {
-		fields = append(fields, rental.FieldCreatedAt)
-	}
-	if m.FieldCleared(rental.FieldUpdatedAt) {
-		fields = append(fields, rental.FieldUpdatedAt)
-	}
-	if m.FieldCleared(rental.FieldDeletedAt) {
-		fields = append(fields, rental.FieldDeletedAt)
+	if m.FieldCleared(usagerecord.FieldRecordedAt) {
+		fields = append(fields, usagerecord.FieldRecordedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RentalMutation) FieldCleared(name string) bool {
+func (m *UsageRecordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RentalMutation) ClearField(name string) error {
+func (m *UsageRecordMutation) ClearField(name string) error {
 	switch name {
-	case rental.FieldStartsAt:
-		m.ClearStartsAt()
-		return nil
-	case rental.FieldEndsAt:
-		m.ClearEndsAt()
-		return nil
-	case rental.FieldCreatedAt:
-		m.ClearCreatedAt()
-		return nil
-	case rental.FieldUpdatedAt:
-		m.ClearUpdatedAt()
-		return nil
-	case rental.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case usagerecord.FieldRecordedAt:
+		m.ClearRecordedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Rental nullable field %s", name)
+	return fmt.Errorf("unknown UsageRecord nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RentalMutation) ResetField(name string) error {
+func (m *UsageRecordMutation) ResetField(name string) error {
 	switch name {
-	case rental.FieldTenantID:
+	case usagerecord.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case rental.FieldCarID:
-		m.ResetCarID()
-		return nil
-	case rental.FieldRenterID:
-		m.ResetRenterID()
-		return nil
-	case rental.FieldStartsAt:
-		m.ResetStartsAt()
-		return nil
-	case rental.FieldEndsAt:
-		m.ResetEndsAt()
+	case usagerecord.FieldMeter:
+		m.ResetMeter()
 		return nil
-	case rental.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case usagerecord.FieldQuantity:
+		m.ResetQuantity()
 		return nil
-	case rental.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case usagerecord.FieldOccurredAt:
+		m.ResetOccurredAt()
 		return nil
-	case rental.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case usagerecord.FieldRecordedAt:
+		m.ResetRecordedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Rental field %s", name)
+	return fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RentalMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.tenant != nil {
-		edges = append(edges, rental.EdgeTenant)
-	}
-	if m.car != nil {
-		edges = append(edges, rental.EdgeCar)
-	}
-	if m.renter != nil {
-		edges = append(edges, rental.EdgeRenter)
-	}
-	if m.rental_options != nil {
-		edges = append(edges, rental.EdgeRentalOptions)
-	}
+func (m *UsageRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RentalMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case rental.EdgeTenant:
-		if id := m.tenant; id != nil {
-			return []ent.Value{*id}
-		}
-	case rental.EdgeCar:
-		if id := m.car; id != nil {
-			return []ent.Value{*id}
-		}
-	case rental.EdgeRenter:
-		if id := m.renter; id != nil {
-			return []ent.Value{*id}
-		}
-	case rental.EdgeRentalOptions:
-		ids := make([]ent.Value, 0, len(m.rental_options))
-		for id := range m.rental_options {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UsageRecordMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RentalMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedrental_options != nil {
-		edges = append(edges, rental.EdgeRentalOptions)
-	}
+func (m *UsageRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RentalMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case rental.EdgeRentalOptions:
-		ids := make([]ent.Value, 0, len(m.removedrental_options))
-		for id := range m.removedrental_options {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UsageRecordMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RentalMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedtenant {
-		edges = append(edges, rental.EdgeTenant)
-	}
-	if m.clearedcar {
-		edges = append(edges, rental.EdgeCar)
-	}
-	if m.clearedrenter {
-		edges = append(edges, rental.EdgeRenter)
-	}
-	if m.clearedrental_options {
-		edges = append(edges, rental.EdgeRentalOptions)
-	}
+func (m *UsageRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RentalMutation) EdgeCleared(name string) bool {
-	switch name {
-	case rental.EdgeTenant:
-		return m.clearedtenant
-	case rental.EdgeCar:
-		return m.clearedcar
-	case rental.EdgeRenter:
-		return m.clearedrenter
-	case rental.EdgeRentalOptions:
-		return m.clearedrental_options
-	}
+func (m *UsageRecordMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RentalMutation) ClearEdge(name string) error {
-	switch name {
-	case rental.EdgeTenant:
-		m.ClearTenant()
-		return nil
-	case rental.EdgeCar:
-		m.ClearCar()
-		return nil
-	case rental.EdgeRenter:
-		m.ClearRenter()
-		return nil
-	}
-	return fmt.Errorf("unknown Rental unique edge %s", name)
+// if that edge is not defined in the schema.
+func (m *UsageRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UsageRecord unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RentalMutation) ResetEdge(name string) error {
-	switch name {
-	case rental.EdgeTenant:
-		m.ResetTenant()
-		return nil
-	case rental.EdgeCar:
-		m.ResetCar()
-		return nil
-	case rental.EdgeRenter:
-		m.ResetRenter()
-		return nil
-	case rental.EdgeRentalOptions:
-		m.ResetRentalOptions()
-		return nil
-	}
-	return fmt.Errorf("unknown Rental edge %s", name)
+func (m *UsageRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UsageRecord edge %s", name)
 }
 
-// RentalOptionMutation represents an operation that mutates the RentalOption nodes in the graph.
-type RentalOptionMutation struct {
+// UsageRollupMutation represents an operation that mutates the UsageRollup nodes in the graph.
+type UsageRollupMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	count         *int
-	addcount      *int
-	created_at    *time.Time
+	tenant_id     *string
+	meter         *string
+	period        *string
+	period_start  *time.Time
+	quantity      *int64
+	addquantity   *int64
 	updated_at    *time.Time
-	deleted_at    *time.Time
 	clearedFields map[string]struct{}
-	tenant        *string
-	clearedtenant bool
-	rental        *string
-	clearedrental bool
-	option        *string
-	clearedoption bool
 	done          bool
-	oldValue      func(context.Context) (*RentalOption, error)
-	predicates    []predicate.RentalOption
+	oldValue      func(context.Context) (*UsageRollup, error)
+	predicates    []predicate.UsageRollup
 }
 
-var _ ent.Mutation = (*RentalOptionMutation)(nil)
+var _ ent.Mutation = (*UsageRollupMutation)(nil)
 
-// rentaloptionOption allows management of the mutation configuration using functional options.
-type rentaloptionOption func(*RentalOptionMutation)
+// usagerollupOption allows management of the mutation configuration using functional options.
+type usagerollupOption func(*UsageRollupMutation)
 
-// newRentalOptionMutation creates new mutation for the RentalOption entity.
-func newRentalOptionMutation(c config, op Op, opts ...rentaloptionOption) *RentalOptionMutation {
-	m := &RentalOptionMutation{
+// newUsageRollupMutation creates new mutation for the UsageRollup entity.
+func newUsageRollupMutation(c config, op Op, opts ...usagerollupOption) *UsageRollupMutation {
+	m := &UsageRollupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRentalOption,
+		typ:           TypeUsageRollup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5367,20 +21371,20 @@ func newRentalOptionMutation(c config, op Op, opts ...rentaloptionOption) *Renta
 	return m
 }
 
-// withRentalOptionID sets the ID field of the mutation.
-func withRentalOptionID(id string) rentaloptionOption {
-	return func(m *RentalOptionMutation) {
+// withUsageRollupID sets the ID field of the mutation.
+func withUsageRollupID(id string) usagerollupOption {
+	return func(m *UsageRollupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RentalOption
+			value *UsageRollup
 		)
-		m.oldValue = func(ctx context.Context) (*RentalOption, error) {
+		m.oldValue = func(ctx context.Context) (*UsageRollup, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RentalOption.Get(ctx, id)
+					value, err = m.Client().UsageRollup.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5389,10 +21393,10 @@ func withRentalOptionID(id string) rentaloptionOption {
 	}
 }
 
-// withRentalOption sets the old RentalOption of the mutation.
-func withRentalOption(node *RentalOption) rentaloptionOption {
-	return func(m *RentalOptionMutation) {
-		m.oldValue = func(context.Context) (*RentalOption, error) {
+// withUsageRollup sets the old UsageRollup of the mutation.
+func withUsageRollup(node *UsageRollup) usagerollupOption {
+	return func(m *UsageRollupMutation) {
+		m.oldValue = func(context.Context) (*UsageRollup, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5401,7 +21405,7 @@ func withRentalOption(node *RentalOption) rentaloptionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RentalOptionMutation) Client() *Client {
+func (m UsageRollupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5409,7 +21413,7 @@ func (m RentalOptionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RentalOptionMutation) Tx() (*Tx, error) {
+func (m UsageRollupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("entgen: mutation is not running in a transaction")
 	}
@@ -5419,14 +21423,14 @@ func (m RentalOptionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RentalOption entities.
-func (m *RentalOptionMutation) SetID(id string) {
+// operation is only accepted on creation of UsageRollup entities.
+func (m *UsageRollupMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RentalOptionMutation) ID() (id string, exists bool) {
+func (m *UsageRollupMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5437,7 +21441,7 @@ func (m *RentalOptionMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RentalOptionMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UsageRollupMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5446,30 +21450,30 @@ func (m *RentalOptionMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RentalOption.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UsageRollup.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetTenantID sets the "tenant_id" field.
-func (m *RentalOptionMutation) SetTenantID(s string) {
-	m.tenant = &s
+func (m *UsageRollupMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
 // TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *RentalOptionMutation) TenantID() (r string, exists bool) {
-	v := m.tenant
+func (m *UsageRollupMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the RentalOption entity.
-// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the UsageRollup entity.
+// If the UsageRollup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalOptionMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *UsageRollupMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
@@ -5484,375 +21488,232 @@ func (m *RentalOptionMutation) OldTenantID(ctx context.Context) (v string, err e
 }
 
 // ResetTenantID resets all changes to the "tenant_id" field.
-func (m *RentalOptionMutation) ResetTenantID() {
-	m.tenant = nil
-}
-
-// SetRentalID sets the "rental_id" field.
-func (m *RentalOptionMutation) SetRentalID(s string) {
-	m.rental = &s
-}
-
-// RentalID returns the value of the "rental_id" field in the mutation.
-func (m *RentalOptionMutation) RentalID() (r string, exists bool) {
-	v := m.rental
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRentalID returns the old "rental_id" field's value of the RentalOption entity.
-// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalOptionMutation) OldRentalID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRentalID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRentalID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRentalID: %w", err)
-	}
-	return oldValue.RentalID, nil
-}
-
-// ResetRentalID resets all changes to the "rental_id" field.
-func (m *RentalOptionMutation) ResetRentalID() {
-	m.rental = nil
-}
-
-// SetOptionID sets the "option_id" field.
-func (m *RentalOptionMutation) SetOptionID(s string) {
-	m.option = &s
-}
-
-// OptionID returns the value of the "option_id" field in the mutation.
-func (m *RentalOptionMutation) OptionID() (r string, exists bool) {
-	v := m.option
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOptionID returns the old "option_id" field's value of the RentalOption entity.
-// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalOptionMutation) OldOptionID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptionID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptionID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptionID: %w", err)
-	}
-	return oldValue.OptionID, nil
-}
-
-// ResetOptionID resets all changes to the "option_id" field.
-func (m *RentalOptionMutation) ResetOptionID() {
-	m.option = nil
-}
-
-// SetCount sets the "count" field.
-func (m *RentalOptionMutation) SetCount(i int) {
-	m.count = &i
-	m.addcount = nil
-}
-
-// Count returns the value of the "count" field in the mutation.
-func (m *RentalOptionMutation) Count() (r int, exists bool) {
-	v := m.count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCount returns the old "count" field's value of the RentalOption entity.
-// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalOptionMutation) OldCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
-	}
-	return oldValue.Count, nil
-}
-
-// AddCount adds i to the "count" field.
-func (m *RentalOptionMutation) AddCount(i int) {
-	if m.addcount != nil {
-		*m.addcount += i
-	} else {
-		m.addcount = &i
-	}
-}
-
-// AddedCount returns the value that was added to the "count" field in this mutation.
-func (m *RentalOptionMutation) AddedCount() (r int, exists bool) {
-	v := m.addcount
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetCount resets all changes to the "count" field.
-func (m *RentalOptionMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
+func (m *UsageRollupMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RentalOptionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetMeter sets the "meter" field.
+func (m *UsageRollupMutation) SetMeter(s string) {
+	m.meter = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RentalOptionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Meter returns the value of the "meter" field in the mutation.
+func (m *UsageRollupMutation) Meter() (r string, exists bool) {
+	v := m.meter
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RentalOption entity.
-// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// OldMeter returns the old "meter" field's value of the UsageRollup entity.
+// If the UsageRollup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalOptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRollupMutation) OldMeter(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMeter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMeter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMeter: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *RentalOptionMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[rentaloption.FieldCreatedAt] = struct{}{}
-}
-
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *RentalOptionMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[rentaloption.FieldCreatedAt]
-	return ok
+	return oldValue.Meter, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RentalOptionMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, rentaloption.FieldCreatedAt)
+// ResetMeter resets all changes to the "meter" field.
+func (m *UsageRollupMutation) ResetMeter() {
+	m.meter = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RentalOptionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetPeriod sets the "period" field.
+func (m *UsageRollupMutation) SetPeriod(s string) {
+	m.period = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RentalOptionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Period returns the value of the "period" field in the mutation.
+func (m *UsageRollupMutation) Period() (r string, exists bool) {
+	v := m.period
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the RentalOption entity.
-// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriod returns the old "period" field's value of the UsageRollup entity.
+// If the UsageRollup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalOptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRollupMutation) OldPeriod(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *RentalOptionMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[rentaloption.FieldUpdatedAt] = struct{}{}
+	return oldValue.Period, nil
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *RentalOptionMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[rentaloption.FieldUpdatedAt]
-	return ok
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RentalOptionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, rentaloption.FieldUpdatedAt)
+// ResetPeriod resets all changes to the "period" field.
+func (m *UsageRollupMutation) ResetPeriod() {
+	m.period = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *RentalOptionMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetPeriodStart sets the "period_start" field.
+func (m *UsageRollupMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *RentalOptionMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *UsageRollupMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the RentalOption entity.
-// If the RentalOption object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodStart returns the old "period_start" field's value of the UsageRollup entity.
+// If the UsageRollup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RentalOptionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *UsageRollupMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *RentalOptionMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[rentaloption.FieldDeletedAt] = struct{}{}
+	return oldValue.PeriodStart, nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *RentalOptionMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[rentaloption.FieldDeletedAt]
-	return ok
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *UsageRollupMutation) ResetPeriodStart() {
+	m.period_start = nil
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *RentalOptionMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, rentaloption.FieldDeletedAt)
+// SetQuantity sets the "quantity" field.
+func (m *UsageRollupMutation) SetQuantity(i int64) {
+	m.quantity = &i
+	m.addquantity = nil
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *RentalOptionMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[rentaloption.FieldTenantID] = struct{}{}
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *UsageRollupMutation) Quantity() (r int64, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *RentalOptionMutation) TenantCleared() bool {
-	return m.clearedtenant
+// OldQuantity returns the old "quantity" field's value of the UsageRollup entity.
+// If the UsageRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRollupMutation) OldQuantity(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
 }
 
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *RentalOptionMutation) TenantIDs() (ids []string) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
+// AddQuantity adds i to the "quantity" field.
+func (m *UsageRollupMutation) AddQuantity(i int64) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
 	}
-	return
 }
 
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *RentalOptionMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *UsageRollupMutation) AddedQuantity() (r int64, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearRental clears the "rental" edge to the Rental entity.
-func (m *RentalOptionMutation) ClearRental() {
-	m.clearedrental = true
-	m.clearedFields[rentaloption.FieldRentalID] = struct{}{}
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *UsageRollupMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
 }
 
-// RentalCleared reports if the "rental" edge to the Rental entity was cleared.
-func (m *RentalOptionMutation) RentalCleared() bool {
-	return m.clearedrental
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UsageRollupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RentalIDs returns the "rental" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RentalID instead. It exists only for internal usage by the builders.
-func (m *RentalOptionMutation) RentalIDs() (ids []string) {
-	if id := m.rental; id != nil {
-		ids = append(ids, *id)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UsageRollupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetRental resets all changes to the "rental" edge.
-func (m *RentalOptionMutation) ResetRental() {
-	m.rental = nil
-	m.clearedrental = false
+	return *v, true
 }
 
-// ClearOption clears the "option" edge to the CarOption entity.
-func (m *RentalOptionMutation) ClearOption() {
-	m.clearedoption = true
-	m.clearedFields[rentaloption.FieldOptionID] = struct{}{}
+// OldUpdatedAt returns the old "updated_at" field's value of the UsageRollup entity.
+// If the UsageRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRollupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// OptionCleared reports if the "option" edge to the CarOption entity was cleared.
-func (m *RentalOptionMutation) OptionCleared() bool {
-	return m.clearedoption
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *UsageRollupMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[usagerollup.FieldUpdatedAt] = struct{}{}
 }
 
-// OptionIDs returns the "option" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OptionID instead. It exists only for internal usage by the builders.
-func (m *RentalOptionMutation) OptionIDs() (ids []string) {
-	if id := m.option; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *UsageRollupMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[usagerollup.FieldUpdatedAt]
+	return ok
 }
 
-// ResetOption resets all changes to the "option" edge.
-func (m *RentalOptionMutation) ResetOption() {
-	m.option = nil
-	m.clearedoption = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UsageRollupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, usagerollup.FieldUpdatedAt)
 }
 
-// Where appends a list predicates to the RentalOptionMutation builder.
-func (m *RentalOptionMutation) Where(ps ...predicate.RentalOption) {
+// Where appends a list predicates to the UsageRollupMutation builder.
+func (m *UsageRollupMutation) Where(ps ...predicate.UsageRollup) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RentalOptionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsageRollupMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RentalOptionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RentalOption, len(ps))
+func (m *UsageRollupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsageRollup, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5860,45 +21721,42 @@ func (m *RentalOptionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RentalOptionMutation) Op() Op {
+func (m *UsageRollupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RentalOptionMutation) SetOp(op Op) {
+func (m *UsageRollupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RentalOption).
-func (m *RentalOptionMutation) Type() string {
+// Type returns the node type of this mutation (UsageRollup).
+func (m *UsageRollupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RentalOptionMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.tenant != nil {
-		fields = append(fields, rentaloption.FieldTenantID)
+func (m *UsageRollupMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.tenant_id != nil {
+		fields = append(fields, usagerollup.FieldTenantID)
 	}
-	if m.rental != nil {
-		fields = append(fields, rentaloption.FieldRentalID)
+	if m.meter != nil {
+		fields = append(fields, usagerollup.FieldMeter)
 	}
-	if m.option != nil {
-		fields = append(fields, rentaloption.FieldOptionID)
+	if m.period != nil {
+		fields = append(fields, usagerollup.FieldPeriod)
 	}
-	if m.count != nil {
-		fields = append(fields, rentaloption.FieldCount)
+	if m.period_start != nil {
+		fields = append(fields, usagerollup.FieldPeriodStart)
 	}
-	if m.created_at != nil {
-		fields = append(fields, rentaloption.FieldCreatedAt)
+	if m.quantity != nil {
+		fields = append(fields, usagerollup.FieldQuantity)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, rentaloption.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, rentaloption.FieldDeletedAt)
+		fields = append(fields, usagerollup.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5906,22 +21764,20 @@ func (m *RentalOptionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RentalOptionMutation) Field(name string) (ent.Value, bool) {
+func (m *UsageRollupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case rentaloption.FieldTenantID:
+	case usagerollup.FieldTenantID:
 		return m.TenantID()
-	case rentaloption.FieldRentalID:
-		return m.RentalID()
-	case rentaloption.FieldOptionID:
-		return m.OptionID()
-	case rentaloption.FieldCount:
-		return m.Count()
-	case rentaloption.FieldCreatedAt:
-		return m.CreatedAt()
-	case rentaloption.FieldUpdatedAt:
+	case usagerollup.FieldMeter:
+		return m.Meter()
+	case usagerollup.FieldPeriod:
+		return m.Period()
+	case usagerollup.FieldPeriodStart:
+		return m.PeriodStart()
+	case usagerollup.FieldQuantity:
+		return m.Quantity()
+	case usagerollup.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case rentaloption.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -5929,90 +21785,81 @@ func (m *RentalOptionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RentalOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsageRollupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case rentaloption.FieldTenantID:
+	case usagerollup.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case rentaloption.FieldRentalID:
-		return m.OldRentalID(ctx)
-	case rentaloption.FieldOptionID:
-		return m.OldOptionID(ctx)
-	case rentaloption.FieldCount:
-		return m.OldCount(ctx)
-	case rentaloption.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case rentaloption.FieldUpdatedAt:
+	case usagerollup.FieldMeter:
+		return m.OldMeter(ctx)
+	case usagerollup.FieldPeriod:
+		return m.OldPeriod(ctx)
+	case usagerollup.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case usagerollup.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case usagerollup.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case rentaloption.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RentalOption field %s", name)
+	return nil, fmt.Errorf("unknown UsageRollup field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RentalOptionMutation) SetField(name string, value ent.Value) error {
+func (m *UsageRollupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case rentaloption.FieldTenantID:
+	case usagerollup.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case rentaloption.FieldRentalID:
+	case usagerollup.FieldMeter:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRentalID(v)
+		m.SetMeter(v)
 		return nil
-	case rentaloption.FieldOptionID:
+	case usagerollup.FieldPeriod:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOptionID(v)
-		return nil
-	case rentaloption.FieldCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCount(v)
+		m.SetPeriod(v)
 		return nil
-	case rentaloption.FieldCreatedAt:
+	case usagerollup.FieldPeriodStart:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetPeriodStart(v)
 		return nil
-	case rentaloption.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case usagerollup.FieldQuantity:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetQuantity(v)
 		return nil
-	case rentaloption.FieldDeletedAt:
+	case usagerollup.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RentalOption field %s", name)
+	return fmt.Errorf("unknown UsageRollup field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RentalOptionMutation) AddedFields() []string {
+func (m *UsageRollupMutation) AddedFields() []string {
 	var fields []string
-	if m.addcount != nil {
-		fields = append(fields, rentaloption.FieldCount)
+	if m.addquantity != nil {
+		fields = append(fields, usagerollup.FieldQuantity)
 	}
 	return fields
 }
@@ -6020,10 +21867,10 @@ func (m *RentalOptionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RentalOptionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsageRollupMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case rentaloption.FieldCount:
-		return m.AddedCount()
+	case usagerollup.FieldQuantity:
+		return m.AddedQuantity()
 	}
 	return nil, false
 }
@@ -6031,234 +21878,161 @@ func (m *RentalOptionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RentalOptionMutation) AddField(name string, value ent.Value) error {
+func (m *UsageRollupMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case rentaloption.FieldCount:
-		v, ok := value.(int)
+	case usagerollup.FieldQuantity:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCount(v)
+		m.AddQuantity(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RentalOption numeric field %s", name)
+	return fmt.Errorf("unknown UsageRollup numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RentalOptionMutation) ClearedFields() []string {
+func (m *UsageRollupMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(rentaloption.FieldCreatedAt) {
-		fields = append(fields, rentaloption.FieldCreatedAt)
-	}
-	if m.FieldCleared(rentaloption.FieldUpdatedAt) {
-		fields = append(fields, rentaloption.FieldUpdatedAt)
-	}
-	if m.FieldCleared(rentaloption.FieldDeletedAt) {
-		fields = append(fields, rentaloption.FieldDeletedAt)
+	if m.FieldCleared(usagerollup.FieldUpdatedAt) {
+		fields = append(fields, usagerollup.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RentalOptionMutation) FieldCleared(name string) bool {
+func (m *UsageRollupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RentalOptionMutation) ClearField(name string) error {
+func (m *UsageRollupMutation) ClearField(name string) error {
 	switch name {
-	case rentaloption.FieldCreatedAt:
-		m.ClearCreatedAt()
-		return nil
-	case rentaloption.FieldUpdatedAt:
+	case usagerollup.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
-	case rentaloption.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown RentalOption nullable field %s", name)
+	return fmt.Errorf("unknown UsageRollup nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RentalOptionMutation) ResetField(name string) error {
+func (m *UsageRollupMutation) ResetField(name string) error {
 	switch name {
-	case rentaloption.FieldTenantID:
+	case usagerollup.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case rentaloption.FieldRentalID:
-		m.ResetRentalID()
+	case usagerollup.FieldMeter:
+		m.ResetMeter()
 		return nil
-	case rentaloption.FieldOptionID:
-		m.ResetOptionID()
+	case usagerollup.FieldPeriod:
+		m.ResetPeriod()
 		return nil
-	case rentaloption.FieldCount:
-		m.ResetCount()
+	case usagerollup.FieldPeriodStart:
+		m.ResetPeriodStart()
 		return nil
-	case rentaloption.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case usagerollup.FieldQuantity:
+		m.ResetQuantity()
 		return nil
-	case rentaloption.FieldUpdatedAt:
+	case usagerollup.FieldUpdatedAt:
 		m.ResetUpdatedAt()
-		return nil
-	case rentaloption.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown RentalOption field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RentalOptionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.tenant != nil {
-		edges = append(edges, rentaloption.EdgeTenant)
-	}
-	if m.rental != nil {
-		edges = append(edges, rentaloption.EdgeRental)
-	}
-	if m.option != nil {
-		edges = append(edges, rentaloption.EdgeOption)
+		return nil
 	}
+	return fmt.Errorf("unknown UsageRollup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UsageRollupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RentalOptionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case rentaloption.EdgeTenant:
-		if id := m.tenant; id != nil {
-			return []ent.Value{*id}
-		}
-	case rentaloption.EdgeRental:
-		if id := m.rental; id != nil {
-			return []ent.Value{*id}
-		}
-	case rentaloption.EdgeOption:
-		if id := m.option; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *UsageRollupMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RentalOptionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *UsageRollupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RentalOptionMutation) RemovedIDs(name string) []ent.Value {
+func (m *UsageRollupMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RentalOptionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedtenant {
-		edges = append(edges, rentaloption.EdgeTenant)
-	}
-	if m.clearedrental {
-		edges = append(edges, rentaloption.EdgeRental)
-	}
-	if m.clearedoption {
-		edges = append(edges, rentaloption.EdgeOption)
-	}
+func (m *UsageRollupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RentalOptionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case rentaloption.EdgeTenant:
-		return m.clearedtenant
-	case rentaloption.EdgeRental:
-		return m.clearedrental
-	case rentaloption.EdgeOption:
-		return m.clearedoption
-	}
+func (m *UsageRollupMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RentalOptionMutation) ClearEdge(name string) error {
-	switch name {
-	case rentaloption.EdgeTenant:
-		m.ClearTenant()
-		return nil
-	case rentaloption.EdgeRental:
-		m.ClearRental()
-		return nil
-	case rentaloption.EdgeOption:
-		m.ClearOption()
-		return nil
-	}
-	return fmt.Errorf("unknown RentalOption unique edge %s", name)
+func (m *UsageRollupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UsageRollup unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RentalOptionMutation) ResetEdge(name string) error {
-	switch name {
-	case rentaloption.EdgeTenant:
-		m.ResetTenant()
-		return nil
-	case rentaloption.EdgeRental:
-		m.ResetRental()
-		return nil
-	case rentaloption.EdgeOption:
-		m.ResetOption()
-		return nil
-	}
-	return fmt.Errorf("unknown RentalOption edge %s", name)
+func (m *UsageRollupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UsageRollup edge %s", name)
 }
 
-// RenterMutation represents an operation that mutates the Renter nodes in the graph.
-type RenterMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	_type             *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	deleted_at        *time.Time
-	clearedFields     map[string]struct{}
-	tenant            *string
-	clearedtenant     bool
-	rentals           map[string]struct{}
-	removedrentals    map[string]struct{}
-	clearedrentals    bool
-	company           *string
-	clearedcompany    bool
-	individual        *string
-	clearedindividual bool
-	done              bool
-	oldValue          func(context.Context) (*Renter, error)
-	predicates        []predicate.Renter
-}
-
-var _ ent.Mutation = (*RenterMutation)(nil)
-
-// renterOption allows management of the mutation configuration using functional options.
-type renterOption func(*RenterMutation)
-
-// newRenterMutation creates new mutation for the Renter entity.
-func newRenterMutation(c config, op Op, opts ...renterOption) *RenterMutation {
-	m := &RenterMutation{
+	op                 Op
+	typ                string
+	id                 *string
+	event_id           *string
+	event_type         *string
+	payload            *map[string]interface{}
+	status             *string
+	attempts           *int
+	addattempts        *int
+	next_attempt_at    *time.Time
+	last_attempt_at    *time.Time
+	response_status    *int
+	addresponse_status *int
+	last_error         *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	tenant             *string
+	clearedtenant      bool
+	endpoint           *string
+	clearedendpoint    bool
+	done               bool
+	oldValue           func(context.Context) (*WebhookDelivery, error)
+	predicates         []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRenter,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6267,20 +22041,20 @@ func newRenterMutation(c config, op Op, opts ...renterOption) *RenterMutation {
 	return m
 }
 
-// withRenterID sets the ID field of the mutation.
-func withRenterID(id string) renterOption {
-	return func(m *RenterMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id string) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Renter
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*Renter, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Renter.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6289,456 +22063,732 @@ func withRenterID(id string) renterOption {
 	}
 }
 
-// withRenter sets the old Renter of the mutation.
-func withRenter(node *Renter) renterOption {
-	return func(m *RenterMutation) {
-		m.oldValue = func(context.Context) (*Renter, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookDeliveryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("entgen: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookDeliveryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *WebhookDeliveryMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *WebhookDeliveryMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *WebhookDeliveryMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetEndpointID sets the "endpoint_id" field.
+func (m *WebhookDeliveryMutation) SetEndpointID(s string) {
+	m.endpoint = &s
+}
+
+// EndpointID returns the value of the "endpoint_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EndpointID() (r string, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndpointID returns the old "endpoint_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEndpointID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpointID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpointID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpointID: %w", err)
+	}
+	return oldValue.EndpointID, nil
+}
+
+// ResetEndpointID resets all changes to the "endpoint_id" field.
+func (m *WebhookDeliveryMutation) ResetEndpointID() {
+	m.endpoint = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(s string) {
+	m.event_id = &s
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r string, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RenterMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RenterMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("entgen: mutation is not running in a transaction")
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Renter entities.
-func (m *RenterMutation) SetID(id string) {
-	m.id = &id
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RenterMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ClearPayload clears the value of the "payload" field.
+func (m *WebhookDeliveryMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[webhookdelivery.FieldPayload] = struct{}{}
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RenterMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Renter.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldPayload]
+	return ok
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *RenterMutation) SetTenantID(s string) {
-	m.tenant = &s
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, webhookdelivery.FieldPayload)
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *RenterMutation) TenantID() (r string, exists bool) {
-	v := m.tenant
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the Renter entity.
-// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RenterMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.Status, nil
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *RenterMutation) ResetTenantID() {
-	m.tenant = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetType sets the "type" field.
-func (m *RenterMutation) SetType(s string) {
-	m._type = &s
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *RenterMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Renter entity.
-// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RenterMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Attempts, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *RenterMutation) ResetType() {
-	m._type = nil
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RenterMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RenterMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Renter entity.
-// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RenterMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *RenterMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[renter.FieldCreatedAt] = struct{}{}
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldNextAttemptAt] = struct{}{}
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *RenterMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[renter.FieldCreatedAt]
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldNextAttemptAt]
 	return ok
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RenterMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, renter.FieldCreatedAt)
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldNextAttemptAt)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RenterMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetLastAttemptAt sets the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetLastAttemptAt(t time.Time) {
+	m.last_attempt_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RenterMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// LastAttemptAt returns the value of the "last_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAt() (r time.Time, exists bool) {
+	v := m.last_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Renter entity.
-// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// OldLastAttemptAt returns the old "last_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RenterMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldLastAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastAttemptAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.LastAttemptAt, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *RenterMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[renter.FieldUpdatedAt] = struct{}{}
+// ClearLastAttemptAt clears the value of the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearLastAttemptAt() {
+	m.last_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldLastAttemptAt] = struct{}{}
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *RenterMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[renter.FieldUpdatedAt]
+// LastAttemptAtCleared returns if the "last_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastAttemptAt]
 	return ok
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RenterMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, renter.FieldUpdatedAt)
+// ResetLastAttemptAt resets all changes to the "last_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetLastAttemptAt() {
+	m.last_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastAttemptAt)
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *RenterMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetResponseStatus sets the "response_status" field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *RenterMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// ResponseStatus returns the value of the "response_status" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Renter entity.
-// If the Renter object wasn't provided to the builder, the object is fetched from the database.
+// OldResponseStatus returns the old "response_status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RenterMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldResponseStatus(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldResponseStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldResponseStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseStatus: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.ResponseStatus, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *RenterMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[renter.FieldDeletedAt] = struct{}{}
+// AddResponseStatus adds i to the "response_status" field.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *RenterMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[renter.FieldDeletedAt]
+// AddedResponseStatus returns the value that was added to the "response_status" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the "response_status" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *RenterMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, renter.FieldDeletedAt)
+// ResetResponseStatus resets all changes to the "response_status" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
 }
 
-// ClearTenant clears the "tenant" edge to the Tenant entity.
-func (m *RenterMutation) ClearTenant() {
-	m.clearedtenant = true
-	m.clearedFields[renter.FieldTenantID] = struct{}{}
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
-func (m *RenterMutation) TenantCleared() bool {
-	return m.clearedtenant
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TenantIDs returns the "tenant" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TenantID instead. It exists only for internal usage by the builders.
-func (m *RenterMutation) TenantIDs() (ids []string) {
-	if id := m.tenant; id != nil {
-		ids = append(ids, *id)
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
 }
 
-// ResetTenant resets all changes to the "tenant" edge.
-func (m *RenterMutation) ResetTenant() {
-	m.tenant = nil
-	m.clearedtenant = false
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddRentalIDs adds the "rentals" edge to the Rental entity by ids.
-func (m *RenterMutation) AddRentalIDs(ids ...string) {
-	if m.rentals == nil {
-		m.rentals = make(map[string]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.rentals[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearRentals clears the "rentals" edge to the Rental entity.
-func (m *RenterMutation) ClearRentals() {
-	m.clearedrentals = true
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *WebhookDeliveryMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[webhookdelivery.FieldCreatedAt] = struct{}{}
 }
 
-// RentalsCleared reports if the "rentals" edge to the Rental entity was cleared.
-func (m *RenterMutation) RentalsCleared() bool {
-	return m.clearedrentals
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldCreatedAt]
+	return ok
 }
 
-// RemoveRentalIDs removes the "rentals" edge to the Rental entity by IDs.
-func (m *RenterMutation) RemoveRentalIDs(ids ...string) {
-	if m.removedrentals == nil {
-		m.removedrentals = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.rentals, ids[i])
-		m.removedrentals[ids[i]] = struct{}{}
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldCreatedAt)
 }
 
-// RemovedRentals returns the removed IDs of the "rentals" edge to the Rental entity.
-func (m *RenterMutation) RemovedRentalsIDs() (ids []string) {
-	for id := range m.removedrentals {
-		ids = append(ids, id)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RentalsIDs returns the "rentals" edge IDs in the mutation.
-func (m *RenterMutation) RentalsIDs() (ids []string) {
-	for id := range m.rentals {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetRentals resets all changes to the "rentals" edge.
-func (m *RenterMutation) ResetRentals() {
-	m.rentals = nil
-	m.clearedrentals = false
-	m.removedrentals = nil
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *WebhookDeliveryMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[webhookdelivery.FieldUpdatedAt] = struct{}{}
 }
 
-// SetCompanyID sets the "company" edge to the Company entity by id.
-func (m *RenterMutation) SetCompanyID(id string) {
-	m.company = &id
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldUpdatedAt]
+	return ok
 }
 
-// ClearCompany clears the "company" edge to the Company entity.
-func (m *RenterMutation) ClearCompany() {
-	m.clearedcompany = true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldUpdatedAt)
 }
 
-// CompanyCleared reports if the "company" edge to the Company entity was cleared.
-func (m *RenterMutation) CompanyCleared() bool {
-	return m.clearedcompany
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *WebhookDeliveryMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[webhookdelivery.FieldTenantID] = struct{}{}
 }
 
-// CompanyID returns the "company" edge ID in the mutation.
-func (m *RenterMutation) CompanyID() (id string, exists bool) {
-	if m.company != nil {
-		return *m.company, true
-	}
-	return
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *WebhookDeliveryMutation) TenantCleared() bool {
+	return m.clearedtenant
 }
 
-// CompanyIDs returns the "company" edge IDs in the mutation.
+// TenantIDs returns the "tenant" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CompanyID instead. It exists only for internal usage by the builders.
-func (m *RenterMutation) CompanyIDs() (ids []string) {
-	if id := m.company; id != nil {
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCompany resets all changes to the "company" edge.
-func (m *RenterMutation) ResetCompany() {
-	m.company = nil
-	m.clearedcompany = false
-}
-
-// SetIndividualID sets the "individual" edge to the Individual entity by id.
-func (m *RenterMutation) SetIndividualID(id string) {
-	m.individual = &id
-}
-
-// ClearIndividual clears the "individual" edge to the Individual entity.
-func (m *RenterMutation) ClearIndividual() {
-	m.clearedindividual = true
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *WebhookDeliveryMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
 }
 
-// IndividualCleared reports if the "individual" edge to the Individual entity was cleared.
-func (m *RenterMutation) IndividualCleared() bool {
-	return m.clearedindividual
+// ClearEndpoint clears the "endpoint" edge to the WebhookEndpoint entity.
+func (m *WebhookDeliveryMutation) ClearEndpoint() {
+	m.clearedendpoint = true
+	m.clearedFields[webhookdelivery.FieldEndpointID] = struct{}{}
 }
 
-// IndividualID returns the "individual" edge ID in the mutation.
-func (m *RenterMutation) IndividualID() (id string, exists bool) {
-	if m.individual != nil {
-		return *m.individual, true
-	}
-	return
+// EndpointCleared reports if the "endpoint" edge to the WebhookEndpoint entity was cleared.
+func (m *WebhookDeliveryMutation) EndpointCleared() bool {
+	return m.clearedendpoint
 }
 
-// IndividualIDs returns the "individual" edge IDs in the mutation.
+// EndpointIDs returns the "endpoint" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// IndividualID instead. It exists only for internal usage by the builders.
-func (m *RenterMutation) IndividualIDs() (ids []string) {
-	if id := m.individual; id != nil {
+// EndpointID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) EndpointIDs() (ids []string) {
+	if id := m.endpoint; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetIndividual resets all changes to the "individual" edge.
-func (m *RenterMutation) ResetIndividual() {
-	m.individual = nil
-	m.clearedindividual = false
+// ResetEndpoint resets all changes to the "endpoint" edge.
+func (m *WebhookDeliveryMutation) ResetEndpoint() {
+	m.endpoint = nil
+	m.clearedendpoint = false
 }
 
-// Where appends a list predicates to the RenterMutation builder.
-func (m *RenterMutation) Where(ps ...predicate.Renter) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RenterMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RenterMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Renter, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6746,39 +22796,63 @@ func (m *RenterMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RenterMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RenterMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Renter).
-func (m *RenterMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RenterMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 13)
 	if m.tenant != nil {
-		fields = append(fields, renter.FieldTenantID)
+		fields = append(fields, webhookdelivery.FieldTenantID)
 	}
-	if m._type != nil {
-		fields = append(fields, renter.FieldType)
+	if m.endpoint != nil {
+		fields = append(fields, webhookdelivery.FieldEndpointID)
+	}
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
+	}
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.last_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, renter.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, renter.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, renter.FieldDeletedAt)
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6786,18 +22860,34 @@ func (m *RenterMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RenterMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case renter.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		return m.TenantID()
-	case renter.FieldType:
-		return m.GetType()
-	case renter.FieldCreatedAt:
+	case webhookdelivery.FieldEndpointID:
+		return m.EndpointID()
+	case webhookdelivery.FieldEventID:
+		return m.EventID()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.LastAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case renter.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case renter.FieldDeletedAt:
-		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -6805,189 +22895,320 @@ func (m *RenterMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RenterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case renter.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case renter.FieldType:
-		return m.OldType(ctx)
-	case renter.FieldCreatedAt:
+	case webhookdelivery.FieldEndpointID:
+		return m.OldEndpointID(ctx)
+	case webhookdelivery.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
+		return m.OldStatus(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldLastAttemptAt:
+		return m.OldLastAttemptAt(ctx)
+	case webhookdelivery.FieldResponseStatus:
+		return m.OldResponseStatus(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case renter.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case renter.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Renter field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RenterMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case renter.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case renter.FieldType:
+	case webhookdelivery.FieldEndpointID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetEndpointID(v)
 		return nil
-	case renter.FieldCreatedAt:
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case webhookdelivery.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case renter.FieldUpdatedAt:
+	case webhookdelivery.FieldLastAttemptAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetLastAttemptAt(v)
 		return nil
-	case renter.FieldDeletedAt:
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetCreatedAt(v)
+		return nil
+	case webhookdelivery.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Renter field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *RenterMutation) AddedFields() []string {
-	return nil
+// this mutation.
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RenterMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RenterMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Renter numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RenterMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(renter.FieldCreatedAt) {
-		fields = append(fields, renter.FieldCreatedAt)
+	if m.FieldCleared(webhookdelivery.FieldPayload) {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.FieldCleared(renter.FieldUpdatedAt) {
-		fields = append(fields, renter.FieldUpdatedAt)
+	if m.FieldCleared(webhookdelivery.FieldNextAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
-	if m.FieldCleared(renter.FieldDeletedAt) {
-		fields = append(fields, renter.FieldDeletedAt)
+	if m.FieldCleared(webhookdelivery.FieldLastAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldLastAttemptAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldCreatedAt) {
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldUpdatedAt) {
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RenterMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RenterMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case renter.FieldCreatedAt:
+	case webhookdelivery.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ClearLastAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case renter.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
-	case renter.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Renter nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RenterMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case renter.FieldTenantID:
+	case webhookdelivery.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case renter.FieldType:
-		m.ResetType()
+	case webhookdelivery.FieldEndpointID:
+		m.ResetEndpointID()
 		return nil
-	case renter.FieldCreatedAt:
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldLastAttemptAt:
+		m.ResetLastAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case renter.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case renter.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Renter field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RenterMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.tenant != nil {
-		edges = append(edges, renter.EdgeTenant)
-	}
-	if m.rentals != nil {
-		edges = append(edges, renter.EdgeRentals)
-	}
-	if m.company != nil {
-		edges = append(edges, renter.EdgeCompany)
+		edges = append(edges, webhookdelivery.EdgeTenant)
 	}
-	if m.individual != nil {
-		edges = append(edges, renter.EdgeIndividual)
+	if m.endpoint != nil {
+		edges = append(edges, webhookdelivery.EdgeEndpoint)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RenterMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case renter.EdgeTenant:
+	case webhookdelivery.EdgeTenant:
 		if id := m.tenant; id != nil {
 			return []ent.Value{*id}
 		}
-	case renter.EdgeRentals:
-		ids := make([]ent.Value, 0, len(m.rentals))
-		for id := range m.rentals {
-			ids = append(ids, id)
-		}
-		return ids
-	case renter.EdgeCompany:
-		if id := m.company; id != nil {
-			return []ent.Value{*id}
-		}
-	case renter.EdgeIndividual:
-		if id := m.individual; id != nil {
+	case webhookdelivery.EdgeEndpoint:
+		if id := m.endpoint; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -6995,147 +23216,110 @@ func (m *RenterMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RenterMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedrentals != nil {
-		edges = append(edges, renter.EdgeRentals)
-	}
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RenterMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case renter.EdgeRentals:
-		ids := make([]ent.Value, 0, len(m.removedrentals))
-		for id := range m.removedrentals {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RenterMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.clearedtenant {
-		edges = append(edges, renter.EdgeTenant)
+		edges = append(edges, webhookdelivery.EdgeTenant)
 	}
-	if m.clearedrentals {
-		edges = append(edges, renter.EdgeRentals)
-	}
-	if m.clearedcompany {
-		edges = append(edges, renter.EdgeCompany)
-	}
-	if m.clearedindividual {
-		edges = append(edges, renter.EdgeIndividual)
+	if m.clearedendpoint {
+		edges = append(edges, webhookdelivery.EdgeEndpoint)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RenterMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case renter.EdgeTenant:
+	case webhookdelivery.EdgeTenant:
 		return m.clearedtenant
-	case renter.EdgeRentals:
-		return m.clearedrentals
-	case renter.EdgeCompany:
-		return m.clearedcompany
-	case renter.EdgeIndividual:
-		return m.clearedindividual
+	case webhookdelivery.EdgeEndpoint:
+		return m.clearedendpoint
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RenterMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case renter.EdgeTenant:
+	case webhookdelivery.EdgeTenant:
 		m.ClearTenant()
 		return nil
-	case renter.EdgeCompany:
-		m.ClearCompany()
-		return nil
-	case renter.EdgeIndividual:
-		m.ClearIndividual()
+	case webhookdelivery.EdgeEndpoint:
+		m.ClearEndpoint()
 		return nil
 	}
-	return fmt.Errorf("unknown Renter unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RenterMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case renter.EdgeTenant:
+	case webhookdelivery.EdgeTenant:
 		m.ResetTenant()
 		return nil
-	case renter.EdgeRentals:
-		m.ResetRentals()
-		return nil
-	case renter.EdgeCompany:
-		m.ResetCompany()
-		return nil
-	case renter.EdgeIndividual:
-		m.ResetIndividual()
+	case webhookdelivery.EdgeEndpoint:
+		m.ResetEndpoint()
 		return nil
 	}
-	return fmt.Errorf("unknown Renter edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
 
-// TenantMutation represents an operation that mutates the Tenant nodes in the graph.
-type TenantMutation struct {
+// WebhookEndpointMutation represents an operation that mutates the WebhookEndpoint nodes in the graph.
+type WebhookEndpointMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *string
-	code                  *string
-	created_at            *time.Time
-	updated_at            *time.Time
-	deleted_at            *time.Time
-	clearedFields         map[string]struct{}
-	cars                  map[string]struct{}
-	removedcars           map[string]struct{}
-	clearedcars           bool
-	companies             map[string]struct{}
-	removedcompanies      map[string]struct{}
-	clearedcompanies      bool
-	individuals           map[string]struct{}
-	removedindividuals    map[string]struct{}
-	clearedindividuals    bool
-	options               map[string]struct{}
-	removedoptions        map[string]struct{}
-	clearedoptions        bool
-	rental_options        map[string]struct{}
-	removedrental_options map[string]struct{}
-	clearedrental_options bool
-	rentals               map[string]struct{}
-	removedrentals        map[string]struct{}
-	clearedrentals        bool
-	renters               map[string]struct{}
-	removedrenters        map[string]struct{}
-	clearedrenters        bool
-	done                  bool
-	oldValue              func(context.Context) (*Tenant, error)
-	predicates            []predicate.Tenant
-}
-
-var _ ent.Mutation = (*TenantMutation)(nil)
-
-// tenantOption allows management of the mutation configuration using functional options.
-type tenantOption func(*TenantMutation)
-
-// newTenantMutation creates new mutation for the Tenant entity.
-func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
-	m := &TenantMutation{
+	op                      Op
+	typ                     string
+	id                      *string
+	url                     *string
+	secret                  *string
+	event_types             *[]string
+	appendevent_types       []string
+	description             *string
+	enabled                 *bool
+	consecutive_failures    *int
+	addconsecutive_failures *int
+	disabled_at             *time.Time
+	disabled_reason         *string
+	created_at              *time.Time
+	updated_at              *time.Time
+	deleted_at              *time.Time
+	clearedFields           map[string]struct{}
+	tenant                  *string
+	clearedtenant           bool
+	deliveries              map[string]struct{}
+	removeddeliveries       map[string]struct{}
+	cleareddeliveries       bool
+	done                    bool
+	oldValue                func(context.Context) (*WebhookEndpoint, error)
+	predicates              []predicate.WebhookEndpoint
+}
+
+var _ ent.Mutation = (*WebhookEndpointMutation)(nil)
+
+// webhookendpointOption allows management of the mutation configuration using functional options.
+type webhookendpointOption func(*WebhookEndpointMutation)
+
+// newWebhookEndpointMutation creates new mutation for the WebhookEndpoint entity.
+func newWebhookEndpointMutation(c config, op Op, opts ...webhookendpointOption) *WebhookEndpointMutation {
+	m := &WebhookEndpointMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTenant,
+		typ:           TypeWebhookEndpoint,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7144,20 +23328,20 @@ func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
 	return m
 }
 
-// withTenantID sets the ID field of the mutation.
-func withTenantID(id string) tenantOption {
-	return func(m *TenantMutation) {
+// withWebhookEndpointID sets the ID field of the mutation.
+func withWebhookEndpointID(id string) webhookendpointOption {
+	return func(m *WebhookEndpointMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tenant
+			value *WebhookEndpoint
 		)
-		m.oldValue = func(ctx context.Context) (*Tenant, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookEndpoint, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tenant.Get(ctx, id)
+					value, err = m.Client().WebhookEndpoint.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7166,10 +23350,10 @@ func withTenantID(id string) tenantOption {
 	}
 }
 
-// withTenant sets the old Tenant of the mutation.
-func withTenant(node *Tenant) tenantOption {
-	return func(m *TenantMutation) {
-		m.oldValue = func(context.Context) (*Tenant, error) {
+// withWebhookEndpoint sets the old WebhookEndpoint of the mutation.
+func withWebhookEndpoint(node *WebhookEndpoint) webhookendpointOption {
+	return func(m *WebhookEndpointMutation) {
+		m.oldValue = func(context.Context) (*WebhookEndpoint, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7178,7 +23362,7 @@ func withTenant(node *Tenant) tenantOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TenantMutation) Client() *Client {
+func (m WebhookEndpointMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7186,7 +23370,7 @@ func (m TenantMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TenantMutation) Tx() (*Tx, error) {
+func (m WebhookEndpointMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("entgen: mutation is not running in a transaction")
 	}
@@ -7196,14 +23380,14 @@ func (m TenantMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tenant entities.
-func (m *TenantMutation) SetID(id string) {
+// operation is only accepted on creation of WebhookEndpoint entities.
+func (m *WebhookEndpointMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TenantMutation) ID() (id string, exists bool) {
+func (m *WebhookEndpointMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7214,7 +23398,7 @@ func (m *TenantMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TenantMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *WebhookEndpointMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7223,582 +23407,647 @@ func (m *TenantMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tenant.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookEndpoint.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *TenantMutation) SetCode(s string) {
-	m.code = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *WebhookEndpointMutation) SetTenantID(s string) {
+	m.tenant = &s
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *TenantMutation) Code() (r string, exists bool) {
-	v := m.code
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *WebhookEndpointMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *WebhookEndpointMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *TenantMutation) ResetCode() {
-	m.code = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *WebhookEndpointMutation) ResetTenantID() {
+	m.tenant = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TenantMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetURL sets the "url" field.
+func (m *WebhookEndpointMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TenantMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookEndpointMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookEndpointMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.URL, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *TenantMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[tenant.FieldCreatedAt] = struct{}{}
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookEndpointMutation) ResetURL() {
+	m.url = nil
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *TenantMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldCreatedAt]
-	return ok
+// SetSecret sets the "secret" field.
+func (m *WebhookEndpointMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TenantMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, tenant.FieldCreatedAt)
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookEndpointMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TenantMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// OldSecret returns the old "secret" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookEndpointMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TenantMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookEndpointMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
+}
+
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookEndpointMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldEventTypes returns the old "event_types" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookEndpointMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.EventTypes, nil
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *TenantMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[tenant.FieldUpdatedAt] = struct{}{}
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookEndpointMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *TenantMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldUpdatedAt]
-	return ok
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookEndpointMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
+	}
+	return m.appendevent_types, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TenantMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, tenant.FieldUpdatedAt)
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookEndpointMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *TenantMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetDescription sets the "description" field.
+func (m *WebhookEndpointMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *TenantMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Description returns the value of the "description" field in the mutation.
+func (m *WebhookEndpointMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Tenant entity.
-// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TenantMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WebhookEndpointMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.Description, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *TenantMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[tenant.FieldDeletedAt] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *WebhookEndpointMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[webhookendpoint.FieldDescription] = struct{}{}
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *TenantMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[tenant.FieldDeletedAt]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldDescription]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *TenantMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, tenant.FieldDeletedAt)
+// ResetDescription resets all changes to the "description" field.
+func (m *WebhookEndpointMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, webhookendpoint.FieldDescription)
 }
 
-// AddCarIDs adds the "cars" edge to the Car entity by ids.
-func (m *TenantMutation) AddCarIDs(ids ...string) {
-	if m.cars == nil {
-		m.cars = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.cars[ids[i]] = struct{}{}
+// SetEnabled sets the "enabled" field.
+func (m *WebhookEndpointMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookEndpointMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearCars clears the "cars" edge to the Car entity.
-func (m *TenantMutation) ClearCars() {
-	m.clearedcars = true
+// OldEnabled returns the old "enabled" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
 }
 
-// CarsCleared reports if the "cars" edge to the Car entity was cleared.
-func (m *TenantMutation) CarsCleared() bool {
-	return m.clearedcars
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookEndpointMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// RemoveCarIDs removes the "cars" edge to the Car entity by IDs.
-func (m *TenantMutation) RemoveCarIDs(ids ...string) {
-	if m.removedcars == nil {
-		m.removedcars = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.cars, ids[i])
-		m.removedcars[ids[i]] = struct{}{}
-	}
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *WebhookEndpointMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
 }
 
-// RemovedCars returns the removed IDs of the "cars" edge to the Car entity.
-func (m *TenantMutation) RemovedCarsIDs() (ids []string) {
-	for id := range m.removedcars {
-		ids = append(ids, id)
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *WebhookEndpointMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CarsIDs returns the "cars" edge IDs in the mutation.
-func (m *TenantMutation) CarsIDs() (ids []string) {
-	for id := range m.cars {
-		ids = append(ids, id)
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
 }
 
-// ResetCars resets all changes to the "cars" edge.
-func (m *TenantMutation) ResetCars() {
-	m.cars = nil
-	m.clearedcars = false
-	m.removedcars = nil
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *WebhookEndpointMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
 }
 
-// AddCompanyIDs adds the "companies" edge to the Company entity by ids.
-func (m *TenantMutation) AddCompanyIDs(ids ...string) {
-	if m.companies == nil {
-		m.companies = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.companies[ids[i]] = struct{}{}
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *WebhookEndpointMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearCompanies clears the "companies" edge to the Company entity.
-func (m *TenantMutation) ClearCompanies() {
-	m.clearedcompanies = true
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *WebhookEndpointMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
 }
 
-// CompaniesCleared reports if the "companies" edge to the Company entity was cleared.
-func (m *TenantMutation) CompaniesCleared() bool {
-	return m.clearedcompanies
+// SetDisabledAt sets the "disabled_at" field.
+func (m *WebhookEndpointMutation) SetDisabledAt(t time.Time) {
+	m.disabled_at = &t
 }
 
-// RemoveCompanyIDs removes the "companies" edge to the Company entity by IDs.
-func (m *TenantMutation) RemoveCompanyIDs(ids ...string) {
-	if m.removedcompanies == nil {
-		m.removedcompanies = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.companies, ids[i])
-		m.removedcompanies[ids[i]] = struct{}{}
+// DisabledAt returns the value of the "disabled_at" field in the mutation.
+func (m *WebhookEndpointMutation) DisabledAt() (r time.Time, exists bool) {
+	v := m.disabled_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedCompanies returns the removed IDs of the "companies" edge to the Company entity.
-func (m *TenantMutation) RemovedCompaniesIDs() (ids []string) {
-	for id := range m.removedcompanies {
-		ids = append(ids, id)
+// OldDisabledAt returns the old "disabled_at" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldDisabledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabledAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// CompaniesIDs returns the "companies" edge IDs in the mutation.
-func (m *TenantMutation) CompaniesIDs() (ids []string) {
-	for id := range m.companies {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabledAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabledAt: %w", err)
+	}
+	return oldValue.DisabledAt, nil
 }
 
-// ResetCompanies resets all changes to the "companies" edge.
-func (m *TenantMutation) ResetCompanies() {
-	m.companies = nil
-	m.clearedcompanies = false
-	m.removedcompanies = nil
+// ClearDisabledAt clears the value of the "disabled_at" field.
+func (m *WebhookEndpointMutation) ClearDisabledAt() {
+	m.disabled_at = nil
+	m.clearedFields[webhookendpoint.FieldDisabledAt] = struct{}{}
 }
 
-// AddIndividualIDs adds the "individuals" edge to the Individual entity by ids.
-func (m *TenantMutation) AddIndividualIDs(ids ...string) {
-	if m.individuals == nil {
-		m.individuals = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.individuals[ids[i]] = struct{}{}
-	}
+// DisabledAtCleared returns if the "disabled_at" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) DisabledAtCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldDisabledAt]
+	return ok
 }
 
-// ClearIndividuals clears the "individuals" edge to the Individual entity.
-func (m *TenantMutation) ClearIndividuals() {
-	m.clearedindividuals = true
+// ResetDisabledAt resets all changes to the "disabled_at" field.
+func (m *WebhookEndpointMutation) ResetDisabledAt() {
+	m.disabled_at = nil
+	delete(m.clearedFields, webhookendpoint.FieldDisabledAt)
 }
 
-// IndividualsCleared reports if the "individuals" edge to the Individual entity was cleared.
-func (m *TenantMutation) IndividualsCleared() bool {
-	return m.clearedindividuals
+// SetDisabledReason sets the "disabled_reason" field.
+func (m *WebhookEndpointMutation) SetDisabledReason(s string) {
+	m.disabled_reason = &s
 }
 
-// RemoveIndividualIDs removes the "individuals" edge to the Individual entity by IDs.
-func (m *TenantMutation) RemoveIndividualIDs(ids ...string) {
-	if m.removedindividuals == nil {
-		m.removedindividuals = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.individuals, ids[i])
-		m.removedindividuals[ids[i]] = struct{}{}
+// DisabledReason returns the value of the "disabled_reason" field in the mutation.
+func (m *WebhookEndpointMutation) DisabledReason() (r string, exists bool) {
+	v := m.disabled_reason
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedIndividuals returns the removed IDs of the "individuals" edge to the Individual entity.
-func (m *TenantMutation) RemovedIndividualsIDs() (ids []string) {
-	for id := range m.removedindividuals {
-		ids = append(ids, id)
+// OldDisabledReason returns the old "disabled_reason" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldDisabledReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisabledReason is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisabledReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisabledReason: %w", err)
+	}
+	return oldValue.DisabledReason, nil
 }
 
-// IndividualsIDs returns the "individuals" edge IDs in the mutation.
-func (m *TenantMutation) IndividualsIDs() (ids []string) {
-	for id := range m.individuals {
-		ids = append(ids, id)
-	}
-	return
+// ClearDisabledReason clears the value of the "disabled_reason" field.
+func (m *WebhookEndpointMutation) ClearDisabledReason() {
+	m.disabled_reason = nil
+	m.clearedFields[webhookendpoint.FieldDisabledReason] = struct{}{}
 }
 
-// ResetIndividuals resets all changes to the "individuals" edge.
-func (m *TenantMutation) ResetIndividuals() {
-	m.individuals = nil
-	m.clearedindividuals = false
-	m.removedindividuals = nil
+// DisabledReasonCleared returns if the "disabled_reason" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) DisabledReasonCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldDisabledReason]
+	return ok
 }
 
-// AddOptionIDs adds the "options" edge to the CarOption entity by ids.
-func (m *TenantMutation) AddOptionIDs(ids ...string) {
-	if m.options == nil {
-		m.options = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.options[ids[i]] = struct{}{}
-	}
+// ResetDisabledReason resets all changes to the "disabled_reason" field.
+func (m *WebhookEndpointMutation) ResetDisabledReason() {
+	m.disabled_reason = nil
+	delete(m.clearedFields, webhookendpoint.FieldDisabledReason)
 }
 
-// ClearOptions clears the "options" edge to the CarOption entity.
-func (m *TenantMutation) ClearOptions() {
-	m.clearedoptions = true
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookEndpointMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// OptionsCleared reports if the "options" edge to the CarOption entity was cleared.
-func (m *TenantMutation) OptionsCleared() bool {
-	return m.clearedoptions
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookEndpointMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveOptionIDs removes the "options" edge to the CarOption entity by IDs.
-func (m *TenantMutation) RemoveOptionIDs(ids ...string) {
-	if m.removedoptions == nil {
-		m.removedoptions = make(map[string]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.options, ids[i])
-		m.removedoptions[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *WebhookEndpointMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[webhookendpoint.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldCreatedAt]
+	return ok
 }
 
-// RemovedOptions returns the removed IDs of the "options" edge to the CarOption entity.
-func (m *TenantMutation) RemovedOptionsIDs() (ids []string) {
-	for id := range m.removedoptions {
-		ids = append(ids, id)
-	}
-	return
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookEndpointMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, webhookendpoint.FieldCreatedAt)
 }
 
-// OptionsIDs returns the "options" edge IDs in the mutation.
-func (m *TenantMutation) OptionsIDs() (ids []string) {
-	for id := range m.options {
-		ids = append(ids, id)
-	}
-	return
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WebhookEndpointMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ResetOptions resets all changes to the "options" edge.
-func (m *TenantMutation) ResetOptions() {
-	m.options = nil
-	m.clearedoptions = false
-	m.removedoptions = nil
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WebhookEndpointMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by ids.
-func (m *TenantMutation) AddRentalOptionIDs(ids ...string) {
-	if m.rental_options == nil {
-		m.rental_options = make(map[string]struct{})
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.rental_options[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearRentalOptions clears the "rental_options" edge to the RentalOption entity.
-func (m *TenantMutation) ClearRentalOptions() {
-	m.clearedrental_options = true
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *WebhookEndpointMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[webhookendpoint.FieldUpdatedAt] = struct{}{}
 }
 
-// RentalOptionsCleared reports if the "rental_options" edge to the RentalOption entity was cleared.
-func (m *TenantMutation) RentalOptionsCleared() bool {
-	return m.clearedrental_options
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldUpdatedAt]
+	return ok
 }
 
-// RemoveRentalOptionIDs removes the "rental_options" edge to the RentalOption entity by IDs.
-func (m *TenantMutation) RemoveRentalOptionIDs(ids ...string) {
-	if m.removedrental_options == nil {
-		m.removedrental_options = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.rental_options, ids[i])
-		m.removedrental_options[ids[i]] = struct{}{}
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookEndpointMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, webhookendpoint.FieldUpdatedAt)
 }
 
-// RemovedRentalOptions returns the removed IDs of the "rental_options" edge to the RentalOption entity.
-func (m *TenantMutation) RemovedRentalOptionsIDs() (ids []string) {
-	for id := range m.removedrental_options {
-		ids = append(ids, id)
-	}
-	return
+// SetDeletedAt sets the "deleted_at" field.
+func (m *WebhookEndpointMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// RentalOptionsIDs returns the "rental_options" edge IDs in the mutation.
-func (m *TenantMutation) RentalOptionsIDs() (ids []string) {
-	for id := range m.rental_options {
-		ids = append(ids, id)
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *WebhookEndpointMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetRentalOptions resets all changes to the "rental_options" edge.
-func (m *TenantMutation) ResetRentalOptions() {
-	m.rental_options = nil
-	m.clearedrental_options = false
-	m.removedrental_options = nil
+	return *v, true
 }
 
-// AddRentalIDs adds the "rentals" edge to the Rental entity by ids.
-func (m *TenantMutation) AddRentalIDs(ids ...string) {
-	if m.rentals == nil {
-		m.rentals = make(map[string]struct{})
+// OldDeletedAt returns the old "deleted_at" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEndpointMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.rentals[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
+	return oldValue.DeletedAt, nil
 }
 
-// ClearRentals clears the "rentals" edge to the Rental entity.
-func (m *TenantMutation) ClearRentals() {
-	m.clearedrentals = true
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *WebhookEndpointMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[webhookendpoint.FieldDeletedAt] = struct{}{}
 }
 
-// RentalsCleared reports if the "rentals" edge to the Rental entity was cleared.
-func (m *TenantMutation) RentalsCleared() bool {
-	return m.clearedrentals
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *WebhookEndpointMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[webhookendpoint.FieldDeletedAt]
+	return ok
 }
 
-// RemoveRentalIDs removes the "rentals" edge to the Rental entity by IDs.
-func (m *TenantMutation) RemoveRentalIDs(ids ...string) {
-	if m.removedrentals == nil {
-		m.removedrentals = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.rentals, ids[i])
-		m.removedrentals[ids[i]] = struct{}{}
-	}
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *WebhookEndpointMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, webhookendpoint.FieldDeletedAt)
 }
 
-// RemovedRentals returns the removed IDs of the "rentals" edge to the Rental entity.
-func (m *TenantMutation) RemovedRentalsIDs() (ids []string) {
-	for id := range m.removedrentals {
-		ids = append(ids, id)
-	}
-	return
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *WebhookEndpointMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[webhookendpoint.FieldTenantID] = struct{}{}
 }
 
-// RentalsIDs returns the "rentals" edge IDs in the mutation.
-func (m *TenantMutation) RentalsIDs() (ids []string) {
-	for id := range m.rentals {
-		ids = append(ids, id)
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *WebhookEndpointMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *WebhookEndpointMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRentals resets all changes to the "rentals" edge.
-func (m *TenantMutation) ResetRentals() {
-	m.rentals = nil
-	m.clearedrentals = false
-	m.removedrentals = nil
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *WebhookEndpointMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
 }
 
-// AddRenterIDs adds the "renters" edge to the Renter entity by ids.
-func (m *TenantMutation) AddRenterIDs(ids ...string) {
-	if m.renters == nil {
-		m.renters = make(map[string]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookEndpointMutation) AddDeliveryIDs(ids ...string) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.renters[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearRenters clears the "renters" edge to the Renter entity.
-func (m *TenantMutation) ClearRenters() {
-	m.clearedrenters = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookEndpointMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// RentersCleared reports if the "renters" edge to the Renter entity was cleared.
-func (m *TenantMutation) RentersCleared() bool {
-	return m.clearedrenters
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookEndpointMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemoveRenterIDs removes the "renters" edge to the Renter entity by IDs.
-func (m *TenantMutation) RemoveRenterIDs(ids ...string) {
-	if m.removedrenters == nil {
-		m.removedrenters = make(map[string]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookEndpointMutation) RemoveDeliveryIDs(ids ...string) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[string]struct{})
 	}
 	for i := range ids {
-		delete(m.renters, ids[i])
-		m.removedrenters[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedRenters returns the removed IDs of the "renters" edge to the Renter entity.
-func (m *TenantMutation) RemovedRentersIDs() (ids []string) {
-	for id := range m.removedrenters {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookEndpointMutation) RemovedDeliveriesIDs() (ids []string) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// RentersIDs returns the "renters" edge IDs in the mutation.
-func (m *TenantMutation) RentersIDs() (ids []string) {
-	for id := range m.renters {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookEndpointMutation) DeliveriesIDs() (ids []string) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRenters resets all changes to the "renters" edge.
-func (m *TenantMutation) ResetRenters() {
-	m.renters = nil
-	m.clearedrenters = false
-	m.removedrenters = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookEndpointMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the TenantMutation builder.
-func (m *TenantMutation) Where(ps ...predicate.Tenant) {
+// Where appends a list predicates to the WebhookEndpointMutation builder.
+func (m *WebhookEndpointMutation) Where(ps ...predicate.WebhookEndpoint) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookEndpointMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tenant, len(ps))
+func (m *WebhookEndpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookEndpoint, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7806,36 +24055,60 @@ func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TenantMutation) Op() Op {
+func (m *WebhookEndpointMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TenantMutation) SetOp(op Op) {
+func (m *WebhookEndpointMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tenant).
-func (m *TenantMutation) Type() string {
+// Type returns the node type of this mutation (WebhookEndpoint).
+func (m *WebhookEndpointMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TenantMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.code != nil {
-		fields = append(fields, tenant.FieldCode)
+func (m *WebhookEndpointMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.tenant != nil {
+		fields = append(fields, webhookendpoint.FieldTenantID)
+	}
+	if m.url != nil {
+		fields = append(fields, webhookendpoint.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhookendpoint.FieldSecret)
+	}
+	if m.event_types != nil {
+		fields = append(fields, webhookendpoint.FieldEventTypes)
+	}
+	if m.description != nil {
+		fields = append(fields, webhookendpoint.FieldDescription)
+	}
+	if m.enabled != nil {
+		fields = append(fields, webhookendpoint.FieldEnabled)
+	}
+	if m.consecutive_failures != nil {
+		fields = append(fields, webhookendpoint.FieldConsecutiveFailures)
+	}
+	if m.disabled_at != nil {
+		fields = append(fields, webhookendpoint.FieldDisabledAt)
+	}
+	if m.disabled_reason != nil {
+		fields = append(fields, webhookendpoint.FieldDisabledReason)
 	}
 	if m.created_at != nil {
-		fields = append(fields, tenant.FieldCreatedAt)
+		fields = append(fields, webhookendpoint.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, tenant.FieldUpdatedAt)
+		fields = append(fields, webhookendpoint.FieldUpdatedAt)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, tenant.FieldDeletedAt)
+		fields = append(fields, webhookendpoint.FieldDeletedAt)
 	}
 	return fields
 }
@@ -7843,15 +24116,31 @@ func (m *TenantMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TenantMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookEndpointMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tenant.FieldCode:
-		return m.Code()
-	case tenant.FieldCreatedAt:
+	case webhookendpoint.FieldTenantID:
+		return m.TenantID()
+	case webhookendpoint.FieldURL:
+		return m.URL()
+	case webhookendpoint.FieldSecret:
+		return m.Secret()
+	case webhookendpoint.FieldEventTypes:
+		return m.EventTypes()
+	case webhookendpoint.FieldDescription:
+		return m.Description()
+	case webhookendpoint.FieldEnabled:
+		return m.Enabled()
+	case webhookendpoint.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case webhookendpoint.FieldDisabledAt:
+		return m.DisabledAt()
+	case webhookendpoint.FieldDisabledReason:
+		return m.DisabledReason()
+	case webhookendpoint.FieldCreatedAt:
 		return m.CreatedAt()
-	case tenant.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case tenant.FieldDeletedAt:
+	case webhookendpoint.FieldDeletedAt:
 		return m.DeletedAt()
 	}
 	return nil, false
@@ -7860,47 +24149,119 @@ func (m *TenantMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookEndpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tenant.FieldCode:
-		return m.OldCode(ctx)
-	case tenant.FieldCreatedAt:
+	case webhookendpoint.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case webhookendpoint.FieldURL:
+		return m.OldURL(ctx)
+	case webhookendpoint.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhookendpoint.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhookendpoint.FieldDescription:
+		return m.OldDescription(ctx)
+	case webhookendpoint.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhookendpoint.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case webhookendpoint.FieldDisabledAt:
+		return m.OldDisabledAt(ctx)
+	case webhookendpoint.FieldDisabledReason:
+		return m.OldDisabledReason(ctx)
+	case webhookendpoint.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case tenant.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case tenant.FieldDeletedAt:
+	case webhookendpoint.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tenant field %s", name)
+	return nil, fmt.Errorf("unknown WebhookEndpoint field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TenantMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookEndpointMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tenant.FieldCode:
+	case webhookendpoint.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case webhookendpoint.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhookendpoint.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhookendpoint.FieldEventTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventTypes(v)
+		return nil
+	case webhookendpoint.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case webhookendpoint.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case webhookendpoint.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConsecutiveFailures(v)
+		return nil
+	case webhookendpoint.FieldDisabledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisabledAt(v)
+		return nil
+	case webhookendpoint.FieldDisabledReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetDisabledReason(v)
 		return nil
-	case tenant.FieldCreatedAt:
+	case webhookendpoint.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case tenant.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case tenant.FieldDeletedAt:
+	case webhookendpoint.FieldDeletedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -7908,161 +24269,171 @@ func (m *TenantMutation) SetField(name string, value ent.Value) error {
 		m.SetDeletedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant field %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TenantMutation) AddedFields() []string {
-	return nil
+func (m *WebhookEndpointMutation) AddedFields() []string {
+	var fields []string
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, webhookendpoint.FieldConsecutiveFailures)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookEndpointMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookendpoint.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TenantMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookEndpointMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookendpoint.FieldConsecutiveFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConsecutiveFailures(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Tenant numeric field %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TenantMutation) ClearedFields() []string {
+func (m *WebhookEndpointMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(tenant.FieldCreatedAt) {
-		fields = append(fields, tenant.FieldCreatedAt)
+	if m.FieldCleared(webhookendpoint.FieldDescription) {
+		fields = append(fields, webhookendpoint.FieldDescription)
 	}
-	if m.FieldCleared(tenant.FieldUpdatedAt) {
-		fields = append(fields, tenant.FieldUpdatedAt)
+	if m.FieldCleared(webhookendpoint.FieldDisabledAt) {
+		fields = append(fields, webhookendpoint.FieldDisabledAt)
 	}
-	if m.FieldCleared(tenant.FieldDeletedAt) {
-		fields = append(fields, tenant.FieldDeletedAt)
+	if m.FieldCleared(webhookendpoint.FieldDisabledReason) {
+		fields = append(fields, webhookendpoint.FieldDisabledReason)
+	}
+	if m.FieldCleared(webhookendpoint.FieldCreatedAt) {
+		fields = append(fields, webhookendpoint.FieldCreatedAt)
+	}
+	if m.FieldCleared(webhookendpoint.FieldUpdatedAt) {
+		fields = append(fields, webhookendpoint.FieldUpdatedAt)
+	}
+	if m.FieldCleared(webhookendpoint.FieldDeletedAt) {
+		fields = append(fields, webhookendpoint.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TenantMutation) FieldCleared(name string) bool {
+func (m *WebhookEndpointMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TenantMutation) ClearField(name string) error {
+func (m *WebhookEndpointMutation) ClearField(name string) error {
 	switch name {
-	case tenant.FieldCreatedAt:
+	case webhookendpoint.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case webhookendpoint.FieldDisabledAt:
+		m.ClearDisabledAt()
+		return nil
+	case webhookendpoint.FieldDisabledReason:
+		m.ClearDisabledReason()
+		return nil
+	case webhookendpoint.FieldCreatedAt:
 		m.ClearCreatedAt()
 		return nil
-	case tenant.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		m.ClearUpdatedAt()
 		return nil
-	case tenant.FieldDeletedAt:
+	case webhookendpoint.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant nullable field %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TenantMutation) ResetField(name string) error {
+func (m *WebhookEndpointMutation) ResetField(name string) error {
 	switch name {
-	case tenant.FieldCode:
-		m.ResetCode()
+	case webhookendpoint.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case tenant.FieldCreatedAt:
+	case webhookendpoint.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhookendpoint.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhookendpoint.FieldEventTypes:
+		m.ResetEventTypes()
+		return nil
+	case webhookendpoint.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case webhookendpoint.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case webhookendpoint.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
+		return nil
+	case webhookendpoint.FieldDisabledAt:
+		m.ResetDisabledAt()
+		return nil
+	case webhookendpoint.FieldDisabledReason:
+		m.ResetDisabledReason()
+		return nil
+	case webhookendpoint.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case tenant.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case tenant.FieldDeletedAt:
+	case webhookendpoint.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant field %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TenantMutation) AddedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.cars != nil {
-		edges = append(edges, tenant.EdgeCars)
-	}
-	if m.companies != nil {
-		edges = append(edges, tenant.EdgeCompanies)
-	}
-	if m.individuals != nil {
-		edges = append(edges, tenant.EdgeIndividuals)
-	}
-	if m.options != nil {
-		edges = append(edges, tenant.EdgeOptions)
-	}
-	if m.rental_options != nil {
-		edges = append(edges, tenant.EdgeRentalOptions)
-	}
-	if m.rentals != nil {
-		edges = append(edges, tenant.EdgeRentals)
+func (m *WebhookEndpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, webhookendpoint.EdgeTenant)
 	}
-	if m.renters != nil {
-		edges = append(edges, tenant.EdgeRenters)
+	if m.deliveries != nil {
+		edges = append(edges, webhookendpoint.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TenantMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookEndpointMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tenant.EdgeCars:
-		ids := make([]ent.Value, 0, len(m.cars))
-		for id := range m.cars {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeCompanies:
-		ids := make([]ent.Value, 0, len(m.companies))
-		for id := range m.companies {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeIndividuals:
-		ids := make([]ent.Value, 0, len(m.individuals))
-		for id := range m.individuals {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeOptions:
-		ids := make([]ent.Value, 0, len(m.options))
-		for id := range m.options {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeRentalOptions:
-		ids := make([]ent.Value, 0, len(m.rental_options))
-		for id := range m.rental_options {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeRentals:
-		ids := make([]ent.Value, 0, len(m.rentals))
-		for id := range m.rentals {
-			ids = append(ids, id)
+	case webhookendpoint.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case tenant.EdgeRenters:
-		ids := make([]ent.Value, 0, len(m.renters))
-		for id := range m.renters {
+	case webhookendpoint.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -8071,75 +24442,21 @@ func (m *TenantMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TenantMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.removedcars != nil {
-		edges = append(edges, tenant.EdgeCars)
-	}
-	if m.removedcompanies != nil {
-		edges = append(edges, tenant.EdgeCompanies)
-	}
-	if m.removedindividuals != nil {
-		edges = append(edges, tenant.EdgeIndividuals)
-	}
-	if m.removedoptions != nil {
-		edges = append(edges, tenant.EdgeOptions)
-	}
-	if m.removedrental_options != nil {
-		edges = append(edges, tenant.EdgeRentalOptions)
-	}
-	if m.removedrentals != nil {
-		edges = append(edges, tenant.EdgeRentals)
-	}
-	if m.removedrenters != nil {
-		edges = append(edges, tenant.EdgeRenters)
+func (m *WebhookEndpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhookendpoint.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookEndpointMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case tenant.EdgeCars:
-		ids := make([]ent.Value, 0, len(m.removedcars))
-		for id := range m.removedcars {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeCompanies:
-		ids := make([]ent.Value, 0, len(m.removedcompanies))
-		for id := range m.removedcompanies {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeIndividuals:
-		ids := make([]ent.Value, 0, len(m.removedindividuals))
-		for id := range m.removedindividuals {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeOptions:
-		ids := make([]ent.Value, 0, len(m.removedoptions))
-		for id := range m.removedoptions {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeRentalOptions:
-		ids := make([]ent.Value, 0, len(m.removedrental_options))
-		for id := range m.removedrental_options {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeRentals:
-		ids := make([]ent.Value, 0, len(m.removedrentals))
-		for id := range m.removedrentals {
-			ids = append(ids, id)
-		}
-		return ids
-	case tenant.EdgeRenters:
-		ids := make([]ent.Value, 0, len(m.removedrenters))
-		for id := range m.removedrenters {
+	case webhookendpoint.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -8148,87 +24465,50 @@ func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TenantMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 7)
-	if m.clearedcars {
-		edges = append(edges, tenant.EdgeCars)
-	}
-	if m.clearedcompanies {
-		edges = append(edges, tenant.EdgeCompanies)
-	}
-	if m.clearedindividuals {
-		edges = append(edges, tenant.EdgeIndividuals)
-	}
-	if m.clearedoptions {
-		edges = append(edges, tenant.EdgeOptions)
-	}
-	if m.clearedrental_options {
-		edges = append(edges, tenant.EdgeRentalOptions)
-	}
-	if m.clearedrentals {
-		edges = append(edges, tenant.EdgeRentals)
+func (m *WebhookEndpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, webhookendpoint.EdgeTenant)
 	}
-	if m.clearedrenters {
-		edges = append(edges, tenant.EdgeRenters)
+	if m.cleareddeliveries {
+		edges = append(edges, webhookendpoint.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TenantMutation) EdgeCleared(name string) bool {
+func (m *WebhookEndpointMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tenant.EdgeCars:
-		return m.clearedcars
-	case tenant.EdgeCompanies:
-		return m.clearedcompanies
-	case tenant.EdgeIndividuals:
-		return m.clearedindividuals
-	case tenant.EdgeOptions:
-		return m.clearedoptions
-	case tenant.EdgeRentalOptions:
-		return m.clearedrental_options
-	case tenant.EdgeRentals:
-		return m.clearedrentals
-	case tenant.EdgeRenters:
-		return m.clearedrenters
+	case webhookendpoint.EdgeTenant:
+		return m.clearedtenant
+	case webhookendpoint.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TenantMutation) ClearEdge(name string) error {
+func (m *WebhookEndpointMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookendpoint.EdgeTenant:
+		m.ClearTenant()
+		return nil
 	}
-	return fmt.Errorf("unknown Tenant unique edge %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TenantMutation) ResetEdge(name string) error {
+func (m *WebhookEndpointMutation) ResetEdge(name string) error {
 	switch name {
-	case tenant.EdgeCars:
-		m.ResetCars()
-		return nil
-	case tenant.EdgeCompanies:
-		m.ResetCompanies()
-		return nil
-	case tenant.EdgeIndividuals:
-		m.ResetIndividuals()
-		return nil
-	case tenant.EdgeOptions:
-		m.ResetOptions()
-		return nil
-	case tenant.EdgeRentalOptions:
-		m.ResetRentalOptions()
-		return nil
-	case tenant.EdgeRentals:
-		m.ResetRentals()
+	case webhookendpoint.EdgeTenant:
+		m.ResetTenant()
 		return nil
-	case tenant.EdgeRenters:
-		m.ResetRenters()
+	case webhookendpoint.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Tenant edge %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint edge %s", name)
 }
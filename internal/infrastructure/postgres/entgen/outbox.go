@@ -18,6 +18,8 @@ type Outbox struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
 	// AggregateType holds the value of the "aggregate_type" field.
 	AggregateType string `json:"aggregate_type,omitempty"`
 	// AggregateID holds the value of the "aggregate_id" field.
@@ -34,6 +36,10 @@ type Outbox struct {
 	Status string `json:"status,omitempty"`
 	// ErrorMessage holds the value of the "error_message" field.
 	ErrorMessage *string `json:"error_message,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
 	// Version holds the value of the "version" field.
 	Version int64 `json:"version,omitempty"`
 	// LockedAt holds the value of the "locked_at" field.
@@ -50,11 +56,11 @@ func (*Outbox) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case outbox.FieldPayload:
 			values[i] = new([]byte)
-		case outbox.FieldVersion:
+		case outbox.FieldAttempts, outbox.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case outbox.FieldID, outbox.FieldAggregateType, outbox.FieldAggregateID, outbox.FieldEventType, outbox.FieldStatus, outbox.FieldErrorMessage, outbox.FieldLockedBy:
+		case outbox.FieldID, outbox.FieldTenantID, outbox.FieldAggregateType, outbox.FieldAggregateID, outbox.FieldEventType, outbox.FieldStatus, outbox.FieldErrorMessage, outbox.FieldLockedBy:
 			values[i] = new(sql.NullString)
-		case outbox.FieldCreatedAt, outbox.FieldProcessedAt, outbox.FieldLockedAt:
+		case outbox.FieldCreatedAt, outbox.FieldProcessedAt, outbox.FieldNextAttemptAt, outbox.FieldLockedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -77,6 +83,12 @@ func (_m *Outbox) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ID = value.String
 			}
+		case outbox.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
 		case outbox.FieldAggregateType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field aggregate_type", values[i])
@@ -129,6 +141,19 @@ func (_m *Outbox) assignValues(columns []string, values []any) error {
 				_m.ErrorMessage = new(string)
 				*_m.ErrorMessage = value.String
 			}
+		case outbox.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case outbox.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = new(time.Time)
+				*_m.NextAttemptAt = value.Time
+			}
 		case outbox.FieldVersion:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field version", values[i])
@@ -185,6 +210,9 @@ func (_m *Outbox) String() string {
 	var builder strings.Builder
 	builder.WriteString("Outbox(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
 	builder.WriteString("aggregate_type=")
 	builder.WriteString(_m.AggregateType)
 	builder.WriteString(", ")
@@ -213,6 +241,14 @@ func (_m *Outbox) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	if v := _m.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("version=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteString(", ")
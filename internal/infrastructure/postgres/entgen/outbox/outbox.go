@@ -11,6 +11,8 @@ const (
 	Label = "outbox"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
 	// FieldAggregateType holds the string denoting the aggregate_type field in the database.
 	FieldAggregateType = "aggregate_type"
 	// FieldAggregateID holds the string denoting the aggregate_id field in the database.
@@ -27,6 +29,10 @@ const (
 	FieldStatus = "status"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
 	FieldErrorMessage = "error_message"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
 	// FieldVersion holds the string denoting the version field in the database.
 	FieldVersion = "version"
 	// FieldLockedAt holds the string denoting the locked_at field in the database.
@@ -40,6 +46,7 @@ const (
 // Columns holds all SQL columns for outbox fields.
 var Columns = []string{
 	FieldID,
+	FieldTenantID,
 	FieldAggregateType,
 	FieldAggregateID,
 	FieldEventType,
@@ -48,6 +55,8 @@ var Columns = []string{
 	FieldProcessedAt,
 	FieldStatus,
 	FieldErrorMessage,
+	FieldAttempts,
+	FieldNextAttemptAt,
 	FieldVersion,
 	FieldLockedAt,
 	FieldLockedBy,
@@ -64,6 +73,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
 	// AggregateTypeValidator is a validator for the "aggregate_type" field. It is called by the builders before save.
 	AggregateTypeValidator func(string) error
 	// AggregateIDValidator is a validator for the "aggregate_id" field. It is called by the builders before save.
@@ -76,6 +87,8 @@ var (
 	StatusValidator func(string) error
 	// ErrorMessageValidator is a validator for the "error_message" field. It is called by the builders before save.
 	ErrorMessageValidator func(string) error
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
 	// DefaultVersion holds the default value on creation for the "version" field.
 	DefaultVersion int64
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -90,6 +103,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
 // ByAggregateType orders the results by the aggregate_type field.
 func ByAggregateType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAggregateType, opts...).ToFunc()
@@ -125,6 +143,16 @@ func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
 }
 
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
 // ByVersion orders the results by the version field.
 func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVersion, opts...).ToFunc()
@@ -20,6 +20,20 @@ type OutboxCreate struct {
 	hooks    []Hook
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *OutboxCreate) SetTenantID(v string) *OutboxCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *OutboxCreate) SetNillableTenantID(v *string) *OutboxCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
 // SetAggregateType sets the "aggregate_type" field.
 func (_c *OutboxCreate) SetAggregateType(v string) *OutboxCreate {
 	_c.mutation.SetAggregateType(v)
@@ -100,6 +114,34 @@ func (_c *OutboxCreate) SetNillableErrorMessage(v *string) *OutboxCreate {
 	return _c
 }
 
+// SetAttempts sets the "attempts" field.
+func (_c *OutboxCreate) SetAttempts(v int) *OutboxCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *OutboxCreate) SetNillableAttempts(v *int) *OutboxCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *OutboxCreate) SetNextAttemptAt(v time.Time) *OutboxCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *OutboxCreate) SetNillableNextAttemptAt(v *time.Time) *OutboxCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
 // SetVersion sets the "version" field.
 func (_c *OutboxCreate) SetVersion(v int64) *OutboxCreate {
 	_c.mutation.SetVersion(v)
@@ -187,6 +229,10 @@ func (_c *OutboxCreate) defaults() {
 		v := outbox.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := outbox.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
 	if _, ok := _c.mutation.Version(); !ok {
 		v := outbox.DefaultVersion
 		_c.mutation.SetVersion(v)
@@ -195,6 +241,11 @@ func (_c *OutboxCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *OutboxCreate) check() error {
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := outbox.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Outbox.tenant_id": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.AggregateType(); !ok {
 		return &ValidationError{Name: "aggregate_type", err: errors.New(`entgen: missing required field "Outbox.aggregate_type"`)}
 	}
@@ -232,6 +283,9 @@ func (_c *OutboxCreate) check() error {
 			return &ValidationError{Name: "error_message", err: fmt.Errorf(`entgen: validator failed for field "Outbox.error_message": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`entgen: missing required field "Outbox.attempts"`)}
+	}
 	if _, ok := _c.mutation.Version(); !ok {
 		return &ValidationError{Name: "version", err: errors.New(`entgen: missing required field "Outbox.version"`)}
 	}
@@ -275,6 +329,10 @@ func (_c *OutboxCreate) createSpec() (*Outbox, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(outbox.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
 	if value, ok := _c.mutation.AggregateType(); ok {
 		_spec.SetField(outbox.FieldAggregateType, field.TypeString, value)
 		_node.AggregateType = value
@@ -307,6 +365,14 @@ func (_c *OutboxCreate) createSpec() (*Outbox, *sqlgraph.CreateSpec) {
 		_spec.SetField(outbox.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = &value
 	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(outbox.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outbox.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
 	if value, ok := _c.mutation.Version(); ok {
 		_spec.SetField(outbox.FieldVersion, field.TypeInt64, value)
 		_node.Version = value
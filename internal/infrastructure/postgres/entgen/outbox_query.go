@@ -264,12 +264,12 @@ func (_q *OutboxQuery) Clone() *OutboxQuery {
 // Example:
 //
 //	var v []struct {
-//		AggregateType string `json:"aggregate_type,omitempty"`
+//		TenantID string `json:"tenant_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Outbox.Query().
-//		GroupBy(outbox.FieldAggregateType).
+//		GroupBy(outbox.FieldTenantID).
 //		Aggregate(entgen.Count()).
 //		Scan(ctx, &v)
 func (_q *OutboxQuery) GroupBy(field string, fields ...string) *OutboxGroupBy {
@@ -287,11 +287,11 @@ func (_q *OutboxQuery) GroupBy(field string, fields ...string) *OutboxGroupBy {
 // Example:
 //
 //	var v []struct {
-//		AggregateType string `json:"aggregate_type,omitempty"`
+//		TenantID string `json:"tenant_id,omitempty"`
 //	}
 //
 //	client.Outbox.Query().
-//		Select(outbox.FieldAggregateType).
+//		Select(outbox.FieldTenantID).
 //		Scan(ctx, &v)
 func (_q *OutboxQuery) Select(fields ...string) *OutboxSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
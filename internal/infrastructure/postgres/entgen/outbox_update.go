@@ -28,6 +28,26 @@ func (_u *OutboxUpdate) Where(ps ...predicate.Outbox) *OutboxUpdate {
 	return _u
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_u *OutboxUpdate) SetTenantID(v string) *OutboxUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *OutboxUpdate) SetNillableTenantID(v *string) *OutboxUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (_u *OutboxUpdate) ClearTenantID() *OutboxUpdate {
+	_u.mutation.ClearTenantID()
+	return _u
+}
+
 // SetAggregateType sets the "aggregate_type" field.
 func (_u *OutboxUpdate) SetAggregateType(v string) *OutboxUpdate {
 	_u.mutation.SetAggregateType(v)
@@ -156,6 +176,47 @@ func (_u *OutboxUpdate) ClearErrorMessage() *OutboxUpdate {
 	return _u
 }
 
+// SetAttempts sets the "attempts" field.
+func (_u *OutboxUpdate) SetAttempts(v int) *OutboxUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OutboxUpdate) SetNillableAttempts(v *int) *OutboxUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OutboxUpdate) AddAttempts(v int) *OutboxUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *OutboxUpdate) SetNextAttemptAt(v time.Time) *OutboxUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *OutboxUpdate) SetNillableNextAttemptAt(v *time.Time) *OutboxUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *OutboxUpdate) ClearNextAttemptAt() *OutboxUpdate {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
 // SetVersion sets the "version" field.
 func (_u *OutboxUpdate) SetVersion(v int64) *OutboxUpdate {
 	_u.mutation.ResetVersion()
@@ -251,6 +312,11 @@ func (_u *OutboxUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *OutboxUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := outbox.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Outbox.tenant_id": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.AggregateType(); ok {
 		if err := outbox.AggregateTypeValidator(v); err != nil {
 			return &ValidationError{Name: "aggregate_type", err: fmt.Errorf(`entgen: validator failed for field "Outbox.aggregate_type": %w`, err)}
@@ -291,6 +357,12 @@ func (_u *OutboxUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(outbox.FieldTenantID, field.TypeString, value)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(outbox.FieldTenantID, field.TypeString)
+	}
 	if value, ok := _u.mutation.AggregateType(); ok {
 		_spec.SetField(outbox.FieldAggregateType, field.TypeString, value)
 	}
@@ -327,6 +399,18 @@ func (_u *OutboxUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(outbox.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(outbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(outbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outbox.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(outbox.FieldNextAttemptAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Version(); ok {
 		_spec.SetField(outbox.FieldVersion, field.TypeInt64, value)
 	}
@@ -365,6 +449,26 @@ type OutboxUpdateOne struct {
 	mutation *OutboxMutation
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_u *OutboxUpdateOne) SetTenantID(v string) *OutboxUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *OutboxUpdateOne) SetNillableTenantID(v *string) *OutboxUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (_u *OutboxUpdateOne) ClearTenantID() *OutboxUpdateOne {
+	_u.mutation.ClearTenantID()
+	return _u
+}
+
 // SetAggregateType sets the "aggregate_type" field.
 func (_u *OutboxUpdateOne) SetAggregateType(v string) *OutboxUpdateOne {
 	_u.mutation.SetAggregateType(v)
@@ -493,6 +597,47 @@ func (_u *OutboxUpdateOne) ClearErrorMessage() *OutboxUpdateOne {
 	return _u
 }
 
+// SetAttempts sets the "attempts" field.
+func (_u *OutboxUpdateOne) SetAttempts(v int) *OutboxUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OutboxUpdateOne) SetNillableAttempts(v *int) *OutboxUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OutboxUpdateOne) AddAttempts(v int) *OutboxUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *OutboxUpdateOne) SetNextAttemptAt(v time.Time) *OutboxUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *OutboxUpdateOne) SetNillableNextAttemptAt(v *time.Time) *OutboxUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *OutboxUpdateOne) ClearNextAttemptAt() *OutboxUpdateOne {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
 // SetVersion sets the "version" field.
 func (_u *OutboxUpdateOne) SetVersion(v int64) *OutboxUpdateOne {
 	_u.mutation.ResetVersion()
@@ -601,6 +746,11 @@ func (_u *OutboxUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *OutboxUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := outbox.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Outbox.tenant_id": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.AggregateType(); ok {
 		if err := outbox.AggregateTypeValidator(v); err != nil {
 			return &ValidationError{Name: "aggregate_type", err: fmt.Errorf(`entgen: validator failed for field "Outbox.aggregate_type": %w`, err)}
@@ -658,6 +808,12 @@ func (_u *OutboxUpdateOne) sqlSave(ctx context.Context) (_node *Outbox, err erro
 			}
 		}
 	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(outbox.FieldTenantID, field.TypeString, value)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(outbox.FieldTenantID, field.TypeString)
+	}
 	if value, ok := _u.mutation.AggregateType(); ok {
 		_spec.SetField(outbox.FieldAggregateType, field.TypeString, value)
 	}
@@ -694,6 +850,18 @@ func (_u *OutboxUpdateOne) sqlSave(ctx context.Context) (_node *Outbox, err erro
 	if _u.mutation.ErrorMessageCleared() {
 		_spec.ClearField(outbox.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(outbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(outbox.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outbox.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(outbox.FieldNextAttemptAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Version(); ok {
 		_spec.SetField(outbox.FieldVersion, field.TypeInt64, value)
 	}
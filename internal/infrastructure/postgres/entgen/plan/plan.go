@@ -0,0 +1,147 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plan
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the plan type in the database.
+	Label = "plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldMaxCars holds the string denoting the max_cars field in the database.
+	FieldMaxCars = "max_cars"
+	// FieldMaxRenters holds the string denoting the max_renters field in the database.
+	FieldMaxRenters = "max_renters"
+	// FieldMaxMonthlyRentals holds the string denoting the max_monthly_rentals field in the database.
+	FieldMaxMonthlyRentals = "max_monthly_rentals"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeTenants holds the string denoting the tenants edge name in mutations.
+	EdgeTenants = "tenants"
+	// Table holds the table name of the plan in the database.
+	Table = "plans"
+	// TenantsTable is the table that holds the tenants relation/edge.
+	TenantsTable = "tenants"
+	// TenantsInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantsInverseTable = "tenants"
+	// TenantsColumn is the table column denoting the tenants relation/edge.
+	TenantsColumn = "plan_id"
+)
+
+// Columns holds all SQL columns for plan fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldName,
+	FieldMaxCars,
+	FieldMaxRenters,
+	FieldMaxMonthlyRentals,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultMaxCars holds the default value on creation for the "max_cars" field.
+	DefaultMaxCars int
+	// MaxCarsValidator is a validator for the "max_cars" field. It is called by the builders before save.
+	MaxCarsValidator func(int) error
+	// DefaultMaxRenters holds the default value on creation for the "max_renters" field.
+	DefaultMaxRenters int
+	// MaxRentersValidator is a validator for the "max_renters" field. It is called by the builders before save.
+	MaxRentersValidator func(int) error
+	// DefaultMaxMonthlyRentals holds the default value on creation for the "max_monthly_rentals" field.
+	DefaultMaxMonthlyRentals int
+	// MaxMonthlyRentalsValidator is a validator for the "max_monthly_rentals" field. It is called by the builders before save.
+	MaxMonthlyRentalsValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Plan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByMaxCars orders the results by the max_cars field.
+func ByMaxCars(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxCars, opts...).ToFunc()
+}
+
+// ByMaxRenters orders the results by the max_renters field.
+func ByMaxRenters(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxRenters, opts...).ToFunc()
+}
+
+// ByMaxMonthlyRentals orders the results by the max_monthly_rentals field.
+func ByMaxMonthlyRentals(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxMonthlyRentals, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByTenantsCount orders the results by tenants count.
+func ByTenantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTenantsStep(), opts...)
+	}
+}
+
+// ByTenants orders the results by tenants terms.
+func ByTenants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newTenantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TenantsTable, TenantsColumn),
+	)
+}
@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package plan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldName, v))
+}
+
+// MaxCars applies equality check predicate on the "max_cars" field. It's identical to MaxCarsEQ.
+func MaxCars(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxCars, v))
+}
+
+// MaxRenters applies equality check predicate on the "max_renters" field. It's identical to MaxRentersEQ.
+func MaxRenters(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxRenters, v))
+}
+
+// MaxMonthlyRentals applies equality check predicate on the "max_monthly_rentals" field. It's identical to MaxMonthlyRentalsEQ.
+func MaxMonthlyRentals(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxMonthlyRentals, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Plan {
+	return predicate.Plan(sql.FieldContainsFold(FieldName, v))
+}
+
+// MaxCarsEQ applies the EQ predicate on the "max_cars" field.
+func MaxCarsEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxCars, v))
+}
+
+// MaxCarsNEQ applies the NEQ predicate on the "max_cars" field.
+func MaxCarsNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxCars, v))
+}
+
+// MaxCarsIn applies the In predicate on the "max_cars" field.
+func MaxCarsIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxCars, vs...))
+}
+
+// MaxCarsNotIn applies the NotIn predicate on the "max_cars" field.
+func MaxCarsNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxCars, vs...))
+}
+
+// MaxCarsGT applies the GT predicate on the "max_cars" field.
+func MaxCarsGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxCars, v))
+}
+
+// MaxCarsGTE applies the GTE predicate on the "max_cars" field.
+func MaxCarsGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxCars, v))
+}
+
+// MaxCarsLT applies the LT predicate on the "max_cars" field.
+func MaxCarsLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxCars, v))
+}
+
+// MaxCarsLTE applies the LTE predicate on the "max_cars" field.
+func MaxCarsLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxCars, v))
+}
+
+// MaxRentersEQ applies the EQ predicate on the "max_renters" field.
+func MaxRentersEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxRenters, v))
+}
+
+// MaxRentersNEQ applies the NEQ predicate on the "max_renters" field.
+func MaxRentersNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxRenters, v))
+}
+
+// MaxRentersIn applies the In predicate on the "max_renters" field.
+func MaxRentersIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxRenters, vs...))
+}
+
+// MaxRentersNotIn applies the NotIn predicate on the "max_renters" field.
+func MaxRentersNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxRenters, vs...))
+}
+
+// MaxRentersGT applies the GT predicate on the "max_renters" field.
+func MaxRentersGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxRenters, v))
+}
+
+// MaxRentersGTE applies the GTE predicate on the "max_renters" field.
+func MaxRentersGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxRenters, v))
+}
+
+// MaxRentersLT applies the LT predicate on the "max_renters" field.
+func MaxRentersLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxRenters, v))
+}
+
+// MaxRentersLTE applies the LTE predicate on the "max_renters" field.
+func MaxRentersLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxRenters, v))
+}
+
+// MaxMonthlyRentalsEQ applies the EQ predicate on the "max_monthly_rentals" field.
+func MaxMonthlyRentalsEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldMaxMonthlyRentals, v))
+}
+
+// MaxMonthlyRentalsNEQ applies the NEQ predicate on the "max_monthly_rentals" field.
+func MaxMonthlyRentalsNEQ(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldMaxMonthlyRentals, v))
+}
+
+// MaxMonthlyRentalsIn applies the In predicate on the "max_monthly_rentals" field.
+func MaxMonthlyRentalsIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldMaxMonthlyRentals, vs...))
+}
+
+// MaxMonthlyRentalsNotIn applies the NotIn predicate on the "max_monthly_rentals" field.
+func MaxMonthlyRentalsNotIn(vs ...int) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldMaxMonthlyRentals, vs...))
+}
+
+// MaxMonthlyRentalsGT applies the GT predicate on the "max_monthly_rentals" field.
+func MaxMonthlyRentalsGT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldMaxMonthlyRentals, v))
+}
+
+// MaxMonthlyRentalsGTE applies the GTE predicate on the "max_monthly_rentals" field.
+func MaxMonthlyRentalsGTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldMaxMonthlyRentals, v))
+}
+
+// MaxMonthlyRentalsLT applies the LT predicate on the "max_monthly_rentals" field.
+func MaxMonthlyRentalsLT(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldMaxMonthlyRentals, v))
+}
+
+// MaxMonthlyRentalsLTE applies the LTE predicate on the "max_monthly_rentals" field.
+func MaxMonthlyRentalsLTE(v int) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldMaxMonthlyRentals, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Plan {
+	return predicate.Plan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Plan {
+	return predicate.Plan(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Plan {
+	return predicate.Plan(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// HasTenants applies the HasEdge predicate on the "tenants" edge.
+func HasTenants() predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TenantsTable, TenantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantsWith applies the HasEdge predicate on the "tenants" edge with a given conditions (other predicates).
+func HasTenantsWith(preds ...predicate.Tenant) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		step := newTenantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Plan) predicate.Plan {
+	return predicate.Plan(sql.NotPredicates(p))
+}
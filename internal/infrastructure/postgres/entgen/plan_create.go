@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// PlanCreate is the builder for creating a Plan entity.
+type PlanCreate struct {
+	config
+	mutation *PlanMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *PlanCreate) SetCode(v string) *PlanCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PlanCreate) SetName(v string) *PlanCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetMaxCars sets the "max_cars" field.
+func (_c *PlanCreate) SetMaxCars(v int) *PlanCreate {
+	_c.mutation.SetMaxCars(v)
+	return _c
+}
+
+// SetNillableMaxCars sets the "max_cars" field if the given value is not nil.
+func (_c *PlanCreate) SetNillableMaxCars(v *int) *PlanCreate {
+	if v != nil {
+		_c.SetMaxCars(*v)
+	}
+	return _c
+}
+
+// SetMaxRenters sets the "max_renters" field.
+func (_c *PlanCreate) SetMaxRenters(v int) *PlanCreate {
+	_c.mutation.SetMaxRenters(v)
+	return _c
+}
+
+// SetNillableMaxRenters sets the "max_renters" field if the given value is not nil.
+func (_c *PlanCreate) SetNillableMaxRenters(v *int) *PlanCreate {
+	if v != nil {
+		_c.SetMaxRenters(*v)
+	}
+	return _c
+}
+
+// SetMaxMonthlyRentals sets the "max_monthly_rentals" field.
+func (_c *PlanCreate) SetMaxMonthlyRentals(v int) *PlanCreate {
+	_c.mutation.SetMaxMonthlyRentals(v)
+	return _c
+}
+
+// SetNillableMaxMonthlyRentals sets the "max_monthly_rentals" field if the given value is not nil.
+func (_c *PlanCreate) SetNillableMaxMonthlyRentals(v *int) *PlanCreate {
+	if v != nil {
+		_c.SetMaxMonthlyRentals(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PlanCreate) SetCreatedAt(v time.Time) *PlanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PlanCreate) SetNillableCreatedAt(v *time.Time) *PlanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PlanCreate) SetUpdatedAt(v time.Time) *PlanCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PlanCreate) SetNillableUpdatedAt(v *time.Time) *PlanCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PlanCreate) SetID(v string) *PlanCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddTenantIDs adds the "tenants" edge to the Tenant entity by IDs.
+func (_c *PlanCreate) AddTenantIDs(ids ...string) *PlanCreate {
+	_c.mutation.AddTenantIDs(ids...)
+	return _c
+}
+
+// AddTenants adds the "tenants" edges to the Tenant entity.
+func (_c *PlanCreate) AddTenants(v ...*Tenant) *PlanCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTenantIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (_c *PlanCreate) Mutation() *PlanMutation {
+	return _c.mutation
+}
+
+// Save creates the Plan in the database.
+func (_c *PlanCreate) Save(ctx context.Context) (*Plan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlanCreate) SaveX(ctx context.Context) *Plan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PlanCreate) defaults() {
+	if _, ok := _c.mutation.MaxCars(); !ok {
+		v := plan.DefaultMaxCars
+		_c.mutation.SetMaxCars(v)
+	}
+	if _, ok := _c.mutation.MaxRenters(); !ok {
+		v := plan.DefaultMaxRenters
+		_c.mutation.SetMaxRenters(v)
+	}
+	if _, ok := _c.mutation.MaxMonthlyRentals(); !ok {
+		v := plan.DefaultMaxMonthlyRentals
+		_c.mutation.SetMaxMonthlyRentals(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlanCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`entgen: missing required field "Plan.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := plan.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`entgen: validator failed for field "Plan.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`entgen: missing required field "Plan.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxCars(); !ok {
+		return &ValidationError{Name: "max_cars", err: errors.New(`entgen: missing required field "Plan.max_cars"`)}
+	}
+	if v, ok := _c.mutation.MaxCars(); ok {
+		if err := plan.MaxCarsValidator(v); err != nil {
+			return &ValidationError{Name: "max_cars", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_cars": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxRenters(); !ok {
+		return &ValidationError{Name: "max_renters", err: errors.New(`entgen: missing required field "Plan.max_renters"`)}
+	}
+	if v, ok := _c.mutation.MaxRenters(); ok {
+		if err := plan.MaxRentersValidator(v); err != nil {
+			return &ValidationError{Name: "max_renters", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_renters": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxMonthlyRentals(); !ok {
+		return &ValidationError{Name: "max_monthly_rentals", err: errors.New(`entgen: missing required field "Plan.max_monthly_rentals"`)}
+	}
+	if v, ok := _c.mutation.MaxMonthlyRentals(); ok {
+		if err := plan.MaxMonthlyRentalsValidator(v); err != nil {
+			return &ValidationError{Name: "max_monthly_rentals", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_monthly_rentals": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := plan.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "Plan.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PlanCreate) sqlSave(ctx context.Context) (*Plan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Plan.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlanCreate) createSpec() (*Plan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Plan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(plan.Table, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(plan.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.MaxCars(); ok {
+		_spec.SetField(plan.FieldMaxCars, field.TypeInt, value)
+		_node.MaxCars = value
+	}
+	if value, ok := _c.mutation.MaxRenters(); ok {
+		_spec.SetField(plan.FieldMaxRenters, field.TypeInt, value)
+		_node.MaxRenters = value
+	}
+	if value, ok := _c.mutation.MaxMonthlyRentals(); ok {
+		_spec.SetField(plan.FieldMaxMonthlyRentals, field.TypeInt, value)
+		_node.MaxMonthlyRentals = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TenantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PlanCreateBulk is the builder for creating many Plan entities in bulk.
+type PlanCreateBulk struct {
+	config
+	err      error
+	builders []*PlanCreate
+}
+
+// Save creates the Plan entities in the database.
+func (_c *PlanCreateBulk) Save(ctx context.Context) ([]*Plan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Plan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlanCreateBulk) SaveX(ctx context.Context) []*Plan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
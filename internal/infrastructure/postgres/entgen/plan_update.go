@@ -0,0 +1,739 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// PlanUpdate is the builder for updating Plan entities.
+type PlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (_u *PlanUpdate) Where(ps ...predicate.Plan) *PlanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *PlanUpdate) SetCode(v string) *PlanUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableCode(v *string) *PlanUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlanUpdate) SetName(v string) *PlanUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableName(v *string) *PlanUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMaxCars sets the "max_cars" field.
+func (_u *PlanUpdate) SetMaxCars(v int) *PlanUpdate {
+	_u.mutation.ResetMaxCars()
+	_u.mutation.SetMaxCars(v)
+	return _u
+}
+
+// SetNillableMaxCars sets the "max_cars" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableMaxCars(v *int) *PlanUpdate {
+	if v != nil {
+		_u.SetMaxCars(*v)
+	}
+	return _u
+}
+
+// AddMaxCars adds value to the "max_cars" field.
+func (_u *PlanUpdate) AddMaxCars(v int) *PlanUpdate {
+	_u.mutation.AddMaxCars(v)
+	return _u
+}
+
+// SetMaxRenters sets the "max_renters" field.
+func (_u *PlanUpdate) SetMaxRenters(v int) *PlanUpdate {
+	_u.mutation.ResetMaxRenters()
+	_u.mutation.SetMaxRenters(v)
+	return _u
+}
+
+// SetNillableMaxRenters sets the "max_renters" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableMaxRenters(v *int) *PlanUpdate {
+	if v != nil {
+		_u.SetMaxRenters(*v)
+	}
+	return _u
+}
+
+// AddMaxRenters adds value to the "max_renters" field.
+func (_u *PlanUpdate) AddMaxRenters(v int) *PlanUpdate {
+	_u.mutation.AddMaxRenters(v)
+	return _u
+}
+
+// SetMaxMonthlyRentals sets the "max_monthly_rentals" field.
+func (_u *PlanUpdate) SetMaxMonthlyRentals(v int) *PlanUpdate {
+	_u.mutation.ResetMaxMonthlyRentals()
+	_u.mutation.SetMaxMonthlyRentals(v)
+	return _u
+}
+
+// SetNillableMaxMonthlyRentals sets the "max_monthly_rentals" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableMaxMonthlyRentals(v *int) *PlanUpdate {
+	if v != nil {
+		_u.SetMaxMonthlyRentals(*v)
+	}
+	return _u
+}
+
+// AddMaxMonthlyRentals adds value to the "max_monthly_rentals" field.
+func (_u *PlanUpdate) AddMaxMonthlyRentals(v int) *PlanUpdate {
+	_u.mutation.AddMaxMonthlyRentals(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PlanUpdate) SetCreatedAt(v time.Time) *PlanUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableCreatedAt(v *time.Time) *PlanUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *PlanUpdate) ClearCreatedAt() *PlanUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlanUpdate) SetUpdatedAt(v time.Time) *PlanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PlanUpdate) SetNillableUpdatedAt(v *time.Time) *PlanUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *PlanUpdate) ClearUpdatedAt() *PlanUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// AddTenantIDs adds the "tenants" edge to the Tenant entity by IDs.
+func (_u *PlanUpdate) AddTenantIDs(ids ...string) *PlanUpdate {
+	_u.mutation.AddTenantIDs(ids...)
+	return _u
+}
+
+// AddTenants adds the "tenants" edges to the Tenant entity.
+func (_u *PlanUpdate) AddTenants(v ...*Tenant) *PlanUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTenantIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (_u *PlanUpdate) Mutation() *PlanMutation {
+	return _u.mutation
+}
+
+// ClearTenants clears all "tenants" edges to the Tenant entity.
+func (_u *PlanUpdate) ClearTenants() *PlanUpdate {
+	_u.mutation.ClearTenants()
+	return _u
+}
+
+// RemoveTenantIDs removes the "tenants" edge to Tenant entities by IDs.
+func (_u *PlanUpdate) RemoveTenantIDs(ids ...string) *PlanUpdate {
+	_u.mutation.RemoveTenantIDs(ids...)
+	return _u
+}
+
+// RemoveTenants removes "tenants" edges to Tenant entities.
+func (_u *PlanUpdate) RemoveTenants(v ...*Tenant) *PlanUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTenantIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlanUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := plan.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`entgen: validator failed for field "Plan.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxCars(); ok {
+		if err := plan.MaxCarsValidator(v); err != nil {
+			return &ValidationError{Name: "max_cars", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_cars": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxRenters(); ok {
+		if err := plan.MaxRentersValidator(v); err != nil {
+			return &ValidationError{Name: "max_renters", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_renters": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxMonthlyRentals(); ok {
+		if err := plan.MaxMonthlyRentalsValidator(v); err != nil {
+			return &ValidationError{Name: "max_monthly_rentals", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_monthly_rentals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(plan.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxCars(); ok {
+		_spec.SetField(plan.FieldMaxCars, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCars(); ok {
+		_spec.AddField(plan.FieldMaxCars, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxRenters(); ok {
+		_spec.SetField(plan.FieldMaxRenters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRenters(); ok {
+		_spec.AddField(plan.FieldMaxRenters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxMonthlyRentals(); ok {
+		_spec.SetField(plan.FieldMaxMonthlyRentals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxMonthlyRentals(); ok {
+		_spec.AddField(plan.FieldMaxMonthlyRentals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(plan.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(plan.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTenantsIDs(); len(nodes) > 0 && !_u.mutation.TenantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlanUpdateOne is the builder for updating a single Plan entity.
+type PlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlanMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *PlanUpdateOne) SetCode(v string) *PlanUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableCode(v *string) *PlanUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlanUpdateOne) SetName(v string) *PlanUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableName(v *string) *PlanUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetMaxCars sets the "max_cars" field.
+func (_u *PlanUpdateOne) SetMaxCars(v int) *PlanUpdateOne {
+	_u.mutation.ResetMaxCars()
+	_u.mutation.SetMaxCars(v)
+	return _u
+}
+
+// SetNillableMaxCars sets the "max_cars" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableMaxCars(v *int) *PlanUpdateOne {
+	if v != nil {
+		_u.SetMaxCars(*v)
+	}
+	return _u
+}
+
+// AddMaxCars adds value to the "max_cars" field.
+func (_u *PlanUpdateOne) AddMaxCars(v int) *PlanUpdateOne {
+	_u.mutation.AddMaxCars(v)
+	return _u
+}
+
+// SetMaxRenters sets the "max_renters" field.
+func (_u *PlanUpdateOne) SetMaxRenters(v int) *PlanUpdateOne {
+	_u.mutation.ResetMaxRenters()
+	_u.mutation.SetMaxRenters(v)
+	return _u
+}
+
+// SetNillableMaxRenters sets the "max_renters" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableMaxRenters(v *int) *PlanUpdateOne {
+	if v != nil {
+		_u.SetMaxRenters(*v)
+	}
+	return _u
+}
+
+// AddMaxRenters adds value to the "max_renters" field.
+func (_u *PlanUpdateOne) AddMaxRenters(v int) *PlanUpdateOne {
+	_u.mutation.AddMaxRenters(v)
+	return _u
+}
+
+// SetMaxMonthlyRentals sets the "max_monthly_rentals" field.
+func (_u *PlanUpdateOne) SetMaxMonthlyRentals(v int) *PlanUpdateOne {
+	_u.mutation.ResetMaxMonthlyRentals()
+	_u.mutation.SetMaxMonthlyRentals(v)
+	return _u
+}
+
+// SetNillableMaxMonthlyRentals sets the "max_monthly_rentals" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableMaxMonthlyRentals(v *int) *PlanUpdateOne {
+	if v != nil {
+		_u.SetMaxMonthlyRentals(*v)
+	}
+	return _u
+}
+
+// AddMaxMonthlyRentals adds value to the "max_monthly_rentals" field.
+func (_u *PlanUpdateOne) AddMaxMonthlyRentals(v int) *PlanUpdateOne {
+	_u.mutation.AddMaxMonthlyRentals(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PlanUpdateOne) SetCreatedAt(v time.Time) *PlanUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableCreatedAt(v *time.Time) *PlanUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *PlanUpdateOne) ClearCreatedAt() *PlanUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PlanUpdateOne) SetUpdatedAt(v time.Time) *PlanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *PlanUpdateOne) SetNillableUpdatedAt(v *time.Time) *PlanUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *PlanUpdateOne) ClearUpdatedAt() *PlanUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// AddTenantIDs adds the "tenants" edge to the Tenant entity by IDs.
+func (_u *PlanUpdateOne) AddTenantIDs(ids ...string) *PlanUpdateOne {
+	_u.mutation.AddTenantIDs(ids...)
+	return _u
+}
+
+// AddTenants adds the "tenants" edges to the Tenant entity.
+func (_u *PlanUpdateOne) AddTenants(v ...*Tenant) *PlanUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTenantIDs(ids...)
+}
+
+// Mutation returns the PlanMutation object of the builder.
+func (_u *PlanUpdateOne) Mutation() *PlanMutation {
+	return _u.mutation
+}
+
+// ClearTenants clears all "tenants" edges to the Tenant entity.
+func (_u *PlanUpdateOne) ClearTenants() *PlanUpdateOne {
+	_u.mutation.ClearTenants()
+	return _u
+}
+
+// RemoveTenantIDs removes the "tenants" edge to Tenant entities by IDs.
+func (_u *PlanUpdateOne) RemoveTenantIDs(ids ...string) *PlanUpdateOne {
+	_u.mutation.RemoveTenantIDs(ids...)
+	return _u
+}
+
+// RemoveTenants removes "tenants" edges to Tenant entities.
+func (_u *PlanUpdateOne) RemoveTenants(v ...*Tenant) *PlanUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTenantIDs(ids...)
+}
+
+// Where appends a list predicates to the PlanUpdate builder.
+func (_u *PlanUpdateOne) Where(ps ...predicate.Plan) *PlanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlanUpdateOne) Select(field string, fields ...string) *PlanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Plan entity.
+func (_u *PlanUpdateOne) Save(ctx context.Context) (*Plan, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlanUpdateOne) SaveX(ctx context.Context) *Plan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlanUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := plan.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`entgen: validator failed for field "Plan.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := plan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`entgen: validator failed for field "Plan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxCars(); ok {
+		if err := plan.MaxCarsValidator(v); err != nil {
+			return &ValidationError{Name: "max_cars", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_cars": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxRenters(); ok {
+		if err := plan.MaxRentersValidator(v); err != nil {
+			return &ValidationError{Name: "max_renters", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_renters": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxMonthlyRentals(); ok {
+		if err := plan.MaxMonthlyRentalsValidator(v); err != nil {
+			return &ValidationError{Name: "max_monthly_rentals", err: fmt.Errorf(`entgen: validator failed for field "Plan.max_monthly_rentals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(plan.Table, plan.Columns, sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "Plan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, plan.FieldID)
+		for _, f := range fields {
+			if !plan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != plan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(plan.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(plan.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MaxCars(); ok {
+		_spec.SetField(plan.FieldMaxCars, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxCars(); ok {
+		_spec.AddField(plan.FieldMaxCars, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxRenters(); ok {
+		_spec.SetField(plan.FieldMaxRenters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRenters(); ok {
+		_spec.AddField(plan.FieldMaxRenters, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxMonthlyRentals(); ok {
+		_spec.SetField(plan.FieldMaxMonthlyRentals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxMonthlyRentals(); ok {
+		_spec.AddField(plan.FieldMaxMonthlyRentals, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(plan.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(plan.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(plan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(plan.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTenantsIDs(); len(nodes) > 0 && !_u.mutation.TenantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   plan.TenantsTable,
+			Columns: []string{plan.TenantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Plan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{plan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,24 +6,51 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// ArchiveJob is the predicate function for archivejob builders.
+type ArchiveJob func(*sql.Selector)
+
+// Branch is the predicate function for branch builders.
+type Branch func(*sql.Selector)
+
 // Car is the predicate function for car builders.
 type Car func(*sql.Selector)
 
+// CarModel is the predicate function for carmodel builders.
+type CarModel func(*sql.Selector)
+
 // CarOption is the predicate function for caroption builders.
 type CarOption func(*sql.Selector)
 
 // Company is the predicate function for company builders.
 type Company func(*sql.Selector)
 
+// FleetSharingAgreement is the predicate function for fleetsharingagreement builders.
+type FleetSharingAgreement func(*sql.Selector)
+
+// Inbox is the predicate function for inbox builders.
+type Inbox func(*sql.Selector)
+
 // Individual is the predicate function for individual builders.
 type Individual func(*sql.Selector)
 
+// MaintenanceWindow is the predicate function for maintenancewindow builders.
+type MaintenanceWindow func(*sql.Selector)
+
 // Outbox is the predicate function for outbox builders.
 type Outbox func(*sql.Selector)
 
+// Plan is the predicate function for plan builders.
+type Plan func(*sql.Selector)
+
 // Rental is the predicate function for rental builders.
 type Rental func(*sql.Selector)
 
+// RentalHandover is the predicate function for rentalhandover builders.
+type RentalHandover func(*sql.Selector)
+
 // RentalOption is the predicate function for rentaloption builders.
 type RentalOption func(*sql.Selector)
 
@@ -32,3 +59,18 @@ type Renter func(*sql.Selector)
 
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
+
+// TenantSetting is the predicate function for tenantsetting builders.
+type TenantSetting func(*sql.Selector)
+
+// UsageRecord is the predicate function for usagerecord builders.
+type UsageRecord func(*sql.Selector)
+
+// UsageRollup is the predicate function for usagerollup builders.
+type UsageRollup func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookEndpoint is the predicate function for webhookendpoint builders.
+type WebhookEndpoint func(*sql.Selector)
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
@@ -26,10 +27,18 @@ type Rental struct {
 	CarID string `json:"car_id,omitempty"`
 	// RenterID holds the value of the "renter_id" field.
 	RenterID string `json:"renter_id,omitempty"`
+	// OwnerTenantID holds the value of the "owner_tenant_id" field.
+	OwnerTenantID string `json:"owner_tenant_id,omitempty"`
+	// PickupBranchID holds the value of the "pickup_branch_id" field.
+	PickupBranchID *string `json:"pickup_branch_id,omitempty"`
+	// ReturnBranchID holds the value of the "return_branch_id" field.
+	ReturnBranchID *string `json:"return_branch_id,omitempty"`
 	// StartsAt holds the value of the "starts_at" field.
 	StartsAt time.Time `json:"starts_at,omitempty"`
 	// EndsAt holds the value of the "ends_at" field.
 	EndsAt time.Time `json:"ends_at,omitempty"`
+	// ReturnedAt holds the value of the "returned_at" field.
+	ReturnedAt *time.Time `json:"returned_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -50,11 +59,17 @@ type RentalEdges struct {
 	Car *Car `json:"car,omitempty"`
 	// Renter holds the value of the renter edge.
 	Renter *Renter `json:"renter,omitempty"`
+	// PickupBranch holds the value of the pickup_branch edge.
+	PickupBranch *Branch `json:"pickup_branch,omitempty"`
+	// ReturnBranch holds the value of the return_branch edge.
+	ReturnBranch *Branch `json:"return_branch,omitempty"`
 	// RentalOptions holds the value of the rental_options edge.
 	RentalOptions []*RentalOption `json:"rental_options,omitempty"`
+	// Handovers holds the value of the handovers edge.
+	Handovers []*RentalHandover `json:"handovers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 }
 
 // TenantOrErr returns the Tenant value or an error if the edge
@@ -90,23 +105,54 @@ func (e RentalEdges) RenterOrErr() (*Renter, error) {
 	return nil, &NotLoadedError{edge: "renter"}
 }
 
+// PickupBranchOrErr returns the PickupBranch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RentalEdges) PickupBranchOrErr() (*Branch, error) {
+	if e.PickupBranch != nil {
+		return e.PickupBranch, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: branch.Label}
+	}
+	return nil, &NotLoadedError{edge: "pickup_branch"}
+}
+
+// ReturnBranchOrErr returns the ReturnBranch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RentalEdges) ReturnBranchOrErr() (*Branch, error) {
+	if e.ReturnBranch != nil {
+		return e.ReturnBranch, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: branch.Label}
+	}
+	return nil, &NotLoadedError{edge: "return_branch"}
+}
+
 // RentalOptionsOrErr returns the RentalOptions value or an error if the edge
 // was not loaded in eager-loading.
 func (e RentalEdges) RentalOptionsOrErr() ([]*RentalOption, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[5] {
 		return e.RentalOptions, nil
 	}
 	return nil, &NotLoadedError{edge: "rental_options"}
 }
 
+// HandoversOrErr returns the Handovers value or an error if the edge
+// was not loaded in eager-loading.
+func (e RentalEdges) HandoversOrErr() ([]*RentalHandover, error) {
+	if e.loadedTypes[6] {
+		return e.Handovers, nil
+	}
+	return nil, &NotLoadedError{edge: "handovers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Rental) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case rental.FieldID, rental.FieldTenantID, rental.FieldCarID, rental.FieldRenterID:
+		case rental.FieldID, rental.FieldTenantID, rental.FieldCarID, rental.FieldRenterID, rental.FieldOwnerTenantID, rental.FieldPickupBranchID, rental.FieldReturnBranchID:
 			values[i] = new(sql.NullString)
-		case rental.FieldStartsAt, rental.FieldEndsAt, rental.FieldCreatedAt, rental.FieldUpdatedAt, rental.FieldDeletedAt:
+		case rental.FieldStartsAt, rental.FieldEndsAt, rental.FieldReturnedAt, rental.FieldCreatedAt, rental.FieldUpdatedAt, rental.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -147,6 +193,26 @@ func (_m *Rental) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.RenterID = value.String
 			}
+		case rental.FieldOwnerTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_tenant_id", values[i])
+			} else if value.Valid {
+				_m.OwnerTenantID = value.String
+			}
+		case rental.FieldPickupBranchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pickup_branch_id", values[i])
+			} else if value.Valid {
+				_m.PickupBranchID = new(string)
+				*_m.PickupBranchID = value.String
+			}
+		case rental.FieldReturnBranchID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field return_branch_id", values[i])
+			} else if value.Valid {
+				_m.ReturnBranchID = new(string)
+				*_m.ReturnBranchID = value.String
+			}
 		case rental.FieldStartsAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
@@ -159,6 +225,13 @@ func (_m *Rental) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EndsAt = value.Time
 			}
+		case rental.FieldReturnedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field returned_at", values[i])
+			} else if value.Valid {
+				_m.ReturnedAt = new(time.Time)
+				*_m.ReturnedAt = value.Time
+			}
 		case rental.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -206,11 +279,26 @@ func (_m *Rental) QueryRenter() *RenterQuery {
 	return NewRentalClient(_m.config).QueryRenter(_m)
 }
 
+// QueryPickupBranch queries the "pickup_branch" edge of the Rental entity.
+func (_m *Rental) QueryPickupBranch() *BranchQuery {
+	return NewRentalClient(_m.config).QueryPickupBranch(_m)
+}
+
+// QueryReturnBranch queries the "return_branch" edge of the Rental entity.
+func (_m *Rental) QueryReturnBranch() *BranchQuery {
+	return NewRentalClient(_m.config).QueryReturnBranch(_m)
+}
+
 // QueryRentalOptions queries the "rental_options" edge of the Rental entity.
 func (_m *Rental) QueryRentalOptions() *RentalOptionQuery {
 	return NewRentalClient(_m.config).QueryRentalOptions(_m)
 }
 
+// QueryHandovers queries the "handovers" edge of the Rental entity.
+func (_m *Rental) QueryHandovers() *RentalHandoverQuery {
+	return NewRentalClient(_m.config).QueryHandovers(_m)
+}
+
 // Update returns a builder for updating this Rental.
 // Note that you need to call Rental.Unwrap() before calling this method if this Rental
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -243,12 +331,30 @@ func (_m *Rental) String() string {
 	builder.WriteString("renter_id=")
 	builder.WriteString(_m.RenterID)
 	builder.WriteString(", ")
+	builder.WriteString("owner_tenant_id=")
+	builder.WriteString(_m.OwnerTenantID)
+	builder.WriteString(", ")
+	if v := _m.PickupBranchID; v != nil {
+		builder.WriteString("pickup_branch_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ReturnBranchID; v != nil {
+		builder.WriteString("return_branch_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("starts_at=")
 	builder.WriteString(_m.StartsAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("ends_at=")
 	builder.WriteString(_m.EndsAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.ReturnedAt; v != nil {
+		builder.WriteString("returned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -18,10 +18,18 @@ const (
 	FieldCarID = "car_id"
 	// FieldRenterID holds the string denoting the renter_id field in the database.
 	FieldRenterID = "renter_id"
+	// FieldOwnerTenantID holds the string denoting the owner_tenant_id field in the database.
+	FieldOwnerTenantID = "owner_tenant_id"
+	// FieldPickupBranchID holds the string denoting the pickup_branch_id field in the database.
+	FieldPickupBranchID = "pickup_branch_id"
+	// FieldReturnBranchID holds the string denoting the return_branch_id field in the database.
+	FieldReturnBranchID = "return_branch_id"
 	// FieldStartsAt holds the string denoting the starts_at field in the database.
 	FieldStartsAt = "starts_at"
 	// FieldEndsAt holds the string denoting the ends_at field in the database.
 	FieldEndsAt = "ends_at"
+	// FieldReturnedAt holds the string denoting the returned_at field in the database.
+	FieldReturnedAt = "returned_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -34,8 +42,14 @@ const (
 	EdgeCar = "car"
 	// EdgeRenter holds the string denoting the renter edge name in mutations.
 	EdgeRenter = "renter"
+	// EdgePickupBranch holds the string denoting the pickup_branch edge name in mutations.
+	EdgePickupBranch = "pickup_branch"
+	// EdgeReturnBranch holds the string denoting the return_branch edge name in mutations.
+	EdgeReturnBranch = "return_branch"
 	// EdgeRentalOptions holds the string denoting the rental_options edge name in mutations.
 	EdgeRentalOptions = "rental_options"
+	// EdgeHandovers holds the string denoting the handovers edge name in mutations.
+	EdgeHandovers = "handovers"
 	// Table holds the table name of the rental in the database.
 	Table = "rentals"
 	// TenantTable is the table that holds the tenant relation/edge.
@@ -59,6 +73,20 @@ const (
 	RenterInverseTable = "renters"
 	// RenterColumn is the table column denoting the renter relation/edge.
 	RenterColumn = "renter_id"
+	// PickupBranchTable is the table that holds the pickup_branch relation/edge.
+	PickupBranchTable = "rentals"
+	// PickupBranchInverseTable is the table name for the Branch entity.
+	// It exists in this package in order to avoid circular dependency with the "branch" package.
+	PickupBranchInverseTable = "branches"
+	// PickupBranchColumn is the table column denoting the pickup_branch relation/edge.
+	PickupBranchColumn = "pickup_branch_id"
+	// ReturnBranchTable is the table that holds the return_branch relation/edge.
+	ReturnBranchTable = "rentals"
+	// ReturnBranchInverseTable is the table name for the Branch entity.
+	// It exists in this package in order to avoid circular dependency with the "branch" package.
+	ReturnBranchInverseTable = "branches"
+	// ReturnBranchColumn is the table column denoting the return_branch relation/edge.
+	ReturnBranchColumn = "return_branch_id"
 	// RentalOptionsTable is the table that holds the rental_options relation/edge.
 	RentalOptionsTable = "rental_options"
 	// RentalOptionsInverseTable is the table name for the RentalOption entity.
@@ -66,6 +94,13 @@ const (
 	RentalOptionsInverseTable = "rental_options"
 	// RentalOptionsColumn is the table column denoting the rental_options relation/edge.
 	RentalOptionsColumn = "rental_id"
+	// HandoversTable is the table that holds the handovers relation/edge.
+	HandoversTable = "rental_handovers"
+	// HandoversInverseTable is the table name for the RentalHandover entity.
+	// It exists in this package in order to avoid circular dependency with the "rentalhandover" package.
+	HandoversInverseTable = "rental_handovers"
+	// HandoversColumn is the table column denoting the handovers relation/edge.
+	HandoversColumn = "rental_id"
 )
 
 // Columns holds all SQL columns for rental fields.
@@ -74,8 +109,12 @@ var Columns = []string{
 	FieldTenantID,
 	FieldCarID,
 	FieldRenterID,
+	FieldOwnerTenantID,
+	FieldPickupBranchID,
+	FieldReturnBranchID,
 	FieldStartsAt,
 	FieldEndsAt,
+	FieldReturnedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
@@ -98,6 +137,12 @@ var (
 	CarIDValidator func(string) error
 	// RenterIDValidator is a validator for the "renter_id" field. It is called by the builders before save.
 	RenterIDValidator func(string) error
+	// OwnerTenantIDValidator is a validator for the "owner_tenant_id" field. It is called by the builders before save.
+	OwnerTenantIDValidator func(string) error
+	// PickupBranchIDValidator is a validator for the "pickup_branch_id" field. It is called by the builders before save.
+	PickupBranchIDValidator func(string) error
+	// ReturnBranchIDValidator is a validator for the "return_branch_id" field. It is called by the builders before save.
+	ReturnBranchIDValidator func(string) error
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -125,6 +170,21 @@ func ByRenterID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRenterID, opts...).ToFunc()
 }
 
+// ByOwnerTenantID orders the results by the owner_tenant_id field.
+func ByOwnerTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerTenantID, opts...).ToFunc()
+}
+
+// ByPickupBranchID orders the results by the pickup_branch_id field.
+func ByPickupBranchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickupBranchID, opts...).ToFunc()
+}
+
+// ByReturnBranchID orders the results by the return_branch_id field.
+func ByReturnBranchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturnBranchID, opts...).ToFunc()
+}
+
 // ByStartsAt orders the results by the starts_at field.
 func ByStartsAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartsAt, opts...).ToFunc()
@@ -135,6 +195,11 @@ func ByEndsAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEndsAt, opts...).ToFunc()
 }
 
+// ByReturnedAt orders the results by the returned_at field.
+func ByReturnedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturnedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -171,6 +236,20 @@ func ByRenterField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByPickupBranchField orders the results by pickup_branch field.
+func ByPickupBranchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPickupBranchStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReturnBranchField orders the results by return_branch field.
+func ByReturnBranchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReturnBranchStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByRentalOptionsCount orders the results by rental_options count.
 func ByRentalOptionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -184,6 +263,20 @@ func ByRentalOptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newRentalOptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByHandoversCount orders the results by handovers count.
+func ByHandoversCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHandoversStep(), opts...)
+	}
+}
+
+// ByHandovers orders the results by handovers terms.
+func ByHandovers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHandoversStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTenantStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -205,6 +298,20 @@ func newRenterStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, RenterTable, RenterColumn),
 	)
 }
+func newPickupBranchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PickupBranchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PickupBranchTable, PickupBranchColumn),
+	)
+}
+func newReturnBranchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReturnBranchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReturnBranchTable, ReturnBranchColumn),
+	)
+}
 func newRentalOptionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -212,3 +319,10 @@ func newRentalOptionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, RentalOptionsTable, RentalOptionsColumn),
 	)
 }
+func newHandoversStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HandoversInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HandoversTable, HandoversColumn),
+	)
+}
@@ -80,6 +80,21 @@ func RenterID(v string) predicate.Rental {
 	return predicate.Rental(sql.FieldEQ(FieldRenterID, v))
 }
 
+// OwnerTenantID applies equality check predicate on the "owner_tenant_id" field. It's identical to OwnerTenantIDEQ.
+func OwnerTenantID(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEQ(FieldOwnerTenantID, v))
+}
+
+// PickupBranchID applies equality check predicate on the "pickup_branch_id" field. It's identical to PickupBranchIDEQ.
+func PickupBranchID(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEQ(FieldPickupBranchID, v))
+}
+
+// ReturnBranchID applies equality check predicate on the "return_branch_id" field. It's identical to ReturnBranchIDEQ.
+func ReturnBranchID(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEQ(FieldReturnBranchID, v))
+}
+
 // StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
 func StartsAt(v time.Time) predicate.Rental {
 	return predicate.Rental(sql.FieldEQ(FieldStartsAt, v))
@@ -90,6 +105,11 @@ func EndsAt(v time.Time) predicate.Rental {
 	return predicate.Rental(sql.FieldEQ(FieldEndsAt, v))
 }
 
+// ReturnedAt applies equality check predicate on the "returned_at" field. It's identical to ReturnedAtEQ.
+func ReturnedAt(v time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldEQ(FieldReturnedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Rental {
 	return predicate.Rental(sql.FieldEQ(FieldCreatedAt, v))
@@ -300,6 +320,231 @@ func RenterIDContainsFold(v string) predicate.Rental {
 	return predicate.Rental(sql.FieldContainsFold(FieldRenterID, v))
 }
 
+// OwnerTenantIDEQ applies the EQ predicate on the "owner_tenant_id" field.
+func OwnerTenantIDEQ(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEQ(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDNEQ applies the NEQ predicate on the "owner_tenant_id" field.
+func OwnerTenantIDNEQ(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldNEQ(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDIn applies the In predicate on the "owner_tenant_id" field.
+func OwnerTenantIDIn(vs ...string) predicate.Rental {
+	return predicate.Rental(sql.FieldIn(FieldOwnerTenantID, vs...))
+}
+
+// OwnerTenantIDNotIn applies the NotIn predicate on the "owner_tenant_id" field.
+func OwnerTenantIDNotIn(vs ...string) predicate.Rental {
+	return predicate.Rental(sql.FieldNotIn(FieldOwnerTenantID, vs...))
+}
+
+// OwnerTenantIDGT applies the GT predicate on the "owner_tenant_id" field.
+func OwnerTenantIDGT(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldGT(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDGTE applies the GTE predicate on the "owner_tenant_id" field.
+func OwnerTenantIDGTE(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldGTE(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDLT applies the LT predicate on the "owner_tenant_id" field.
+func OwnerTenantIDLT(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldLT(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDLTE applies the LTE predicate on the "owner_tenant_id" field.
+func OwnerTenantIDLTE(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldLTE(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDContains applies the Contains predicate on the "owner_tenant_id" field.
+func OwnerTenantIDContains(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldContains(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDHasPrefix applies the HasPrefix predicate on the "owner_tenant_id" field.
+func OwnerTenantIDHasPrefix(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldHasPrefix(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDHasSuffix applies the HasSuffix predicate on the "owner_tenant_id" field.
+func OwnerTenantIDHasSuffix(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldHasSuffix(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDIsNil applies the IsNil predicate on the "owner_tenant_id" field.
+func OwnerTenantIDIsNil() predicate.Rental {
+	return predicate.Rental(sql.FieldIsNull(FieldOwnerTenantID))
+}
+
+// OwnerTenantIDNotNil applies the NotNil predicate on the "owner_tenant_id" field.
+func OwnerTenantIDNotNil() predicate.Rental {
+	return predicate.Rental(sql.FieldNotNull(FieldOwnerTenantID))
+}
+
+// OwnerTenantIDEqualFold applies the EqualFold predicate on the "owner_tenant_id" field.
+func OwnerTenantIDEqualFold(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEqualFold(FieldOwnerTenantID, v))
+}
+
+// OwnerTenantIDContainsFold applies the ContainsFold predicate on the "owner_tenant_id" field.
+func OwnerTenantIDContainsFold(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldContainsFold(FieldOwnerTenantID, v))
+}
+
+// PickupBranchIDEQ applies the EQ predicate on the "pickup_branch_id" field.
+func PickupBranchIDEQ(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEQ(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDNEQ applies the NEQ predicate on the "pickup_branch_id" field.
+func PickupBranchIDNEQ(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldNEQ(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDIn applies the In predicate on the "pickup_branch_id" field.
+func PickupBranchIDIn(vs ...string) predicate.Rental {
+	return predicate.Rental(sql.FieldIn(FieldPickupBranchID, vs...))
+}
+
+// PickupBranchIDNotIn applies the NotIn predicate on the "pickup_branch_id" field.
+func PickupBranchIDNotIn(vs ...string) predicate.Rental {
+	return predicate.Rental(sql.FieldNotIn(FieldPickupBranchID, vs...))
+}
+
+// PickupBranchIDGT applies the GT predicate on the "pickup_branch_id" field.
+func PickupBranchIDGT(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldGT(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDGTE applies the GTE predicate on the "pickup_branch_id" field.
+func PickupBranchIDGTE(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldGTE(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDLT applies the LT predicate on the "pickup_branch_id" field.
+func PickupBranchIDLT(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldLT(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDLTE applies the LTE predicate on the "pickup_branch_id" field.
+func PickupBranchIDLTE(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldLTE(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDContains applies the Contains predicate on the "pickup_branch_id" field.
+func PickupBranchIDContains(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldContains(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDHasPrefix applies the HasPrefix predicate on the "pickup_branch_id" field.
+func PickupBranchIDHasPrefix(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldHasPrefix(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDHasSuffix applies the HasSuffix predicate on the "pickup_branch_id" field.
+func PickupBranchIDHasSuffix(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldHasSuffix(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDIsNil applies the IsNil predicate on the "pickup_branch_id" field.
+func PickupBranchIDIsNil() predicate.Rental {
+	return predicate.Rental(sql.FieldIsNull(FieldPickupBranchID))
+}
+
+// PickupBranchIDNotNil applies the NotNil predicate on the "pickup_branch_id" field.
+func PickupBranchIDNotNil() predicate.Rental {
+	return predicate.Rental(sql.FieldNotNull(FieldPickupBranchID))
+}
+
+// PickupBranchIDEqualFold applies the EqualFold predicate on the "pickup_branch_id" field.
+func PickupBranchIDEqualFold(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEqualFold(FieldPickupBranchID, v))
+}
+
+// PickupBranchIDContainsFold applies the ContainsFold predicate on the "pickup_branch_id" field.
+func PickupBranchIDContainsFold(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldContainsFold(FieldPickupBranchID, v))
+}
+
+// ReturnBranchIDEQ applies the EQ predicate on the "return_branch_id" field.
+func ReturnBranchIDEQ(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEQ(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDNEQ applies the NEQ predicate on the "return_branch_id" field.
+func ReturnBranchIDNEQ(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldNEQ(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDIn applies the In predicate on the "return_branch_id" field.
+func ReturnBranchIDIn(vs ...string) predicate.Rental {
+	return predicate.Rental(sql.FieldIn(FieldReturnBranchID, vs...))
+}
+
+// ReturnBranchIDNotIn applies the NotIn predicate on the "return_branch_id" field.
+func ReturnBranchIDNotIn(vs ...string) predicate.Rental {
+	return predicate.Rental(sql.FieldNotIn(FieldReturnBranchID, vs...))
+}
+
+// ReturnBranchIDGT applies the GT predicate on the "return_branch_id" field.
+func ReturnBranchIDGT(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldGT(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDGTE applies the GTE predicate on the "return_branch_id" field.
+func ReturnBranchIDGTE(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldGTE(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDLT applies the LT predicate on the "return_branch_id" field.
+func ReturnBranchIDLT(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldLT(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDLTE applies the LTE predicate on the "return_branch_id" field.
+func ReturnBranchIDLTE(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldLTE(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDContains applies the Contains predicate on the "return_branch_id" field.
+func ReturnBranchIDContains(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldContains(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDHasPrefix applies the HasPrefix predicate on the "return_branch_id" field.
+func ReturnBranchIDHasPrefix(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldHasPrefix(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDHasSuffix applies the HasSuffix predicate on the "return_branch_id" field.
+func ReturnBranchIDHasSuffix(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldHasSuffix(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDIsNil applies the IsNil predicate on the "return_branch_id" field.
+func ReturnBranchIDIsNil() predicate.Rental {
+	return predicate.Rental(sql.FieldIsNull(FieldReturnBranchID))
+}
+
+// ReturnBranchIDNotNil applies the NotNil predicate on the "return_branch_id" field.
+func ReturnBranchIDNotNil() predicate.Rental {
+	return predicate.Rental(sql.FieldNotNull(FieldReturnBranchID))
+}
+
+// ReturnBranchIDEqualFold applies the EqualFold predicate on the "return_branch_id" field.
+func ReturnBranchIDEqualFold(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldEqualFold(FieldReturnBranchID, v))
+}
+
+// ReturnBranchIDContainsFold applies the ContainsFold predicate on the "return_branch_id" field.
+func ReturnBranchIDContainsFold(v string) predicate.Rental {
+	return predicate.Rental(sql.FieldContainsFold(FieldReturnBranchID, v))
+}
+
 // StartsAtEQ applies the EQ predicate on the "starts_at" field.
 func StartsAtEQ(v time.Time) predicate.Rental {
 	return predicate.Rental(sql.FieldEQ(FieldStartsAt, v))
@@ -400,6 +645,56 @@ func EndsAtNotNil() predicate.Rental {
 	return predicate.Rental(sql.FieldNotNull(FieldEndsAt))
 }
 
+// ReturnedAtEQ applies the EQ predicate on the "returned_at" field.
+func ReturnedAtEQ(v time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldEQ(FieldReturnedAt, v))
+}
+
+// ReturnedAtNEQ applies the NEQ predicate on the "returned_at" field.
+func ReturnedAtNEQ(v time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldNEQ(FieldReturnedAt, v))
+}
+
+// ReturnedAtIn applies the In predicate on the "returned_at" field.
+func ReturnedAtIn(vs ...time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldIn(FieldReturnedAt, vs...))
+}
+
+// ReturnedAtNotIn applies the NotIn predicate on the "returned_at" field.
+func ReturnedAtNotIn(vs ...time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldNotIn(FieldReturnedAt, vs...))
+}
+
+// ReturnedAtGT applies the GT predicate on the "returned_at" field.
+func ReturnedAtGT(v time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldGT(FieldReturnedAt, v))
+}
+
+// ReturnedAtGTE applies the GTE predicate on the "returned_at" field.
+func ReturnedAtGTE(v time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldGTE(FieldReturnedAt, v))
+}
+
+// ReturnedAtLT applies the LT predicate on the "returned_at" field.
+func ReturnedAtLT(v time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldLT(FieldReturnedAt, v))
+}
+
+// ReturnedAtLTE applies the LTE predicate on the "returned_at" field.
+func ReturnedAtLTE(v time.Time) predicate.Rental {
+	return predicate.Rental(sql.FieldLTE(FieldReturnedAt, v))
+}
+
+// ReturnedAtIsNil applies the IsNil predicate on the "returned_at" field.
+func ReturnedAtIsNil() predicate.Rental {
+	return predicate.Rental(sql.FieldIsNull(FieldReturnedAt))
+}
+
+// ReturnedAtNotNil applies the NotNil predicate on the "returned_at" field.
+func ReturnedAtNotNil() predicate.Rental {
+	return predicate.Rental(sql.FieldNotNull(FieldReturnedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Rental {
 	return predicate.Rental(sql.FieldEQ(FieldCreatedAt, v))
@@ -619,6 +914,52 @@ func HasRenterWith(preds ...predicate.Renter) predicate.Rental {
 	})
 }
 
+// HasPickupBranch applies the HasEdge predicate on the "pickup_branch" edge.
+func HasPickupBranch() predicate.Rental {
+	return predicate.Rental(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PickupBranchTable, PickupBranchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPickupBranchWith applies the HasEdge predicate on the "pickup_branch" edge with a given conditions (other predicates).
+func HasPickupBranchWith(preds ...predicate.Branch) predicate.Rental {
+	return predicate.Rental(func(s *sql.Selector) {
+		step := newPickupBranchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReturnBranch applies the HasEdge predicate on the "return_branch" edge.
+func HasReturnBranch() predicate.Rental {
+	return predicate.Rental(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReturnBranchTable, ReturnBranchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReturnBranchWith applies the HasEdge predicate on the "return_branch" edge with a given conditions (other predicates).
+func HasReturnBranchWith(preds ...predicate.Branch) predicate.Rental {
+	return predicate.Rental(func(s *sql.Selector) {
+		step := newReturnBranchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasRentalOptions applies the HasEdge predicate on the "rental_options" edge.
 func HasRentalOptions() predicate.Rental {
 	return predicate.Rental(func(s *sql.Selector) {
@@ -642,6 +983,29 @@ func HasRentalOptionsWith(preds ...predicate.RentalOption) predicate.Rental {
 	})
 }
 
+// HasHandovers applies the HasEdge predicate on the "handovers" edge.
+func HasHandovers() predicate.Rental {
+	return predicate.Rental(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HandoversTable, HandoversColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHandoversWith applies the HasEdge predicate on the "handovers" edge with a given conditions (other predicates).
+func HasHandoversWith(preds ...predicate.RentalHandover) predicate.Rental {
+	return predicate.Rental(func(s *sql.Selector) {
+		step := newHandoversStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Rental) predicate.Rental {
 	return predicate.Rental(sql.AndPredicates(predicates...))
@@ -10,8 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
@@ -42,6 +44,48 @@ func (_c *RentalCreate) SetRenterID(v string) *RentalCreate {
 	return _c
 }
 
+// SetOwnerTenantID sets the "owner_tenant_id" field.
+func (_c *RentalCreate) SetOwnerTenantID(v string) *RentalCreate {
+	_c.mutation.SetOwnerTenantID(v)
+	return _c
+}
+
+// SetNillableOwnerTenantID sets the "owner_tenant_id" field if the given value is not nil.
+func (_c *RentalCreate) SetNillableOwnerTenantID(v *string) *RentalCreate {
+	if v != nil {
+		_c.SetOwnerTenantID(*v)
+	}
+	return _c
+}
+
+// SetPickupBranchID sets the "pickup_branch_id" field.
+func (_c *RentalCreate) SetPickupBranchID(v string) *RentalCreate {
+	_c.mutation.SetPickupBranchID(v)
+	return _c
+}
+
+// SetNillablePickupBranchID sets the "pickup_branch_id" field if the given value is not nil.
+func (_c *RentalCreate) SetNillablePickupBranchID(v *string) *RentalCreate {
+	if v != nil {
+		_c.SetPickupBranchID(*v)
+	}
+	return _c
+}
+
+// SetReturnBranchID sets the "return_branch_id" field.
+func (_c *RentalCreate) SetReturnBranchID(v string) *RentalCreate {
+	_c.mutation.SetReturnBranchID(v)
+	return _c
+}
+
+// SetNillableReturnBranchID sets the "return_branch_id" field if the given value is not nil.
+func (_c *RentalCreate) SetNillableReturnBranchID(v *string) *RentalCreate {
+	if v != nil {
+		_c.SetReturnBranchID(*v)
+	}
+	return _c
+}
+
 // SetStartsAt sets the "starts_at" field.
 func (_c *RentalCreate) SetStartsAt(v time.Time) *RentalCreate {
 	_c.mutation.SetStartsAt(v)
@@ -70,6 +114,20 @@ func (_c *RentalCreate) SetNillableEndsAt(v *time.Time) *RentalCreate {
 	return _c
 }
 
+// SetReturnedAt sets the "returned_at" field.
+func (_c *RentalCreate) SetReturnedAt(v time.Time) *RentalCreate {
+	_c.mutation.SetReturnedAt(v)
+	return _c
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_c *RentalCreate) SetNillableReturnedAt(v *time.Time) *RentalCreate {
+	if v != nil {
+		_c.SetReturnedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *RentalCreate) SetCreatedAt(v time.Time) *RentalCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -133,6 +191,16 @@ func (_c *RentalCreate) SetRenter(v *Renter) *RentalCreate {
 	return _c.SetRenterID(v.ID)
 }
 
+// SetPickupBranch sets the "pickup_branch" edge to the Branch entity.
+func (_c *RentalCreate) SetPickupBranch(v *Branch) *RentalCreate {
+	return _c.SetPickupBranchID(v.ID)
+}
+
+// SetReturnBranch sets the "return_branch" edge to the Branch entity.
+func (_c *RentalCreate) SetReturnBranch(v *Branch) *RentalCreate {
+	return _c.SetReturnBranchID(v.ID)
+}
+
 // AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by IDs.
 func (_c *RentalCreate) AddRentalOptionIDs(ids ...string) *RentalCreate {
 	_c.mutation.AddRentalOptionIDs(ids...)
@@ -148,6 +216,21 @@ func (_c *RentalCreate) AddRentalOptions(v ...*RentalOption) *RentalCreate {
 	return _c.AddRentalOptionIDs(ids...)
 }
 
+// AddHandoverIDs adds the "handovers" edge to the RentalHandover entity by IDs.
+func (_c *RentalCreate) AddHandoverIDs(ids ...string) *RentalCreate {
+	_c.mutation.AddHandoverIDs(ids...)
+	return _c
+}
+
+// AddHandovers adds the "handovers" edges to the RentalHandover entity.
+func (_c *RentalCreate) AddHandovers(v ...*RentalHandover) *RentalCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHandoverIDs(ids...)
+}
+
 // Mutation returns the RentalMutation object of the builder.
 func (_c *RentalCreate) Mutation() *RentalMutation {
 	return _c.mutation
@@ -206,6 +289,21 @@ func (_c *RentalCreate) check() error {
 			return &ValidationError{Name: "renter_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.renter_id": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.OwnerTenantID(); ok {
+		if err := rental.OwnerTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.owner_tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PickupBranchID(); ok {
+		if err := rental.PickupBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "pickup_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.pickup_branch_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReturnBranchID(); ok {
+		if err := rental.ReturnBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "return_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.return_branch_id": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := rental.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "Rental.id": %w`, err)}
@@ -255,6 +353,10 @@ func (_c *RentalCreate) createSpec() (*Rental, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.OwnerTenantID(); ok {
+		_spec.SetField(rental.FieldOwnerTenantID, field.TypeString, value)
+		_node.OwnerTenantID = value
+	}
 	if value, ok := _c.mutation.StartsAt(); ok {
 		_spec.SetField(rental.FieldStartsAt, field.TypeTime, value)
 		_node.StartsAt = value
@@ -263,6 +365,10 @@ func (_c *RentalCreate) createSpec() (*Rental, *sqlgraph.CreateSpec) {
 		_spec.SetField(rental.FieldEndsAt, field.TypeTime, value)
 		_node.EndsAt = value
 	}
+	if value, ok := _c.mutation.ReturnedAt(); ok {
+		_spec.SetField(rental.FieldReturnedAt, field.TypeTime, value)
+		_node.ReturnedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(rental.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -326,6 +432,40 @@ func (_c *RentalCreate) createSpec() (*Rental, *sqlgraph.CreateSpec) {
 		_node.RenterID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PickupBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.PickupBranchTable,
+			Columns: []string{rental.PickupBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PickupBranchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReturnBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.ReturnBranchTable,
+			Columns: []string{rental.ReturnBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReturnBranchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.RentalOptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -342,6 +482,22 @@ func (_c *RentalCreate) createSpec() (*Rental, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.HandoversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rental.HandoversTable,
+			Columns: []string{rental.HandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,9 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
@@ -31,7 +33,10 @@ type RentalQuery struct {
 	withTenant        *TenantQuery
 	withCar           *CarQuery
 	withRenter        *RenterQuery
+	withPickupBranch  *BranchQuery
+	withReturnBranch  *BranchQuery
 	withRentalOptions *RentalOptionQuery
+	withHandovers     *RentalHandoverQuery
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -135,6 +140,50 @@ func (_q *RentalQuery) QueryRenter() *RenterQuery {
 	return query
 }
 
+// QueryPickupBranch chains the current query on the "pickup_branch" edge.
+func (_q *RentalQuery) QueryPickupBranch() *BranchQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, selector),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rental.PickupBranchTable, rental.PickupBranchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReturnBranch chains the current query on the "return_branch" edge.
+func (_q *RentalQuery) QueryReturnBranch() *BranchQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, selector),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rental.ReturnBranchTable, rental.ReturnBranchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryRentalOptions chains the current query on the "rental_options" edge.
 func (_q *RentalQuery) QueryRentalOptions() *RentalOptionQuery {
 	query := (&RentalOptionClient{config: _q.config}).Query()
@@ -157,6 +206,28 @@ func (_q *RentalQuery) QueryRentalOptions() *RentalOptionQuery {
 	return query
 }
 
+// QueryHandovers chains the current query on the "handovers" edge.
+func (_q *RentalQuery) QueryHandovers() *RentalHandoverQuery {
+	query := (&RentalHandoverClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rental.Table, rental.FieldID, selector),
+			sqlgraph.To(rentalhandover.Table, rentalhandover.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, rental.HandoversTable, rental.HandoversColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Rental entity from the query.
 // Returns a *NotFoundError when no Rental was found.
 func (_q *RentalQuery) First(ctx context.Context) (*Rental, error) {
@@ -352,7 +423,10 @@ func (_q *RentalQuery) Clone() *RentalQuery {
 		withTenant:        _q.withTenant.Clone(),
 		withCar:           _q.withCar.Clone(),
 		withRenter:        _q.withRenter.Clone(),
+		withPickupBranch:  _q.withPickupBranch.Clone(),
+		withReturnBranch:  _q.withReturnBranch.Clone(),
 		withRentalOptions: _q.withRentalOptions.Clone(),
+		withHandovers:     _q.withHandovers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -392,6 +466,28 @@ func (_q *RentalQuery) WithRenter(opts ...func(*RenterQuery)) *RentalQuery {
 	return _q
 }
 
+// WithPickupBranch tells the query-builder to eager-load the nodes that are connected to
+// the "pickup_branch" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RentalQuery) WithPickupBranch(opts ...func(*BranchQuery)) *RentalQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPickupBranch = query
+	return _q
+}
+
+// WithReturnBranch tells the query-builder to eager-load the nodes that are connected to
+// the "return_branch" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RentalQuery) WithReturnBranch(opts ...func(*BranchQuery)) *RentalQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReturnBranch = query
+	return _q
+}
+
 // WithRentalOptions tells the query-builder to eager-load the nodes that are connected to
 // the "rental_options" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *RentalQuery) WithRentalOptions(opts ...func(*RentalOptionQuery)) *RentalQuery {
@@ -403,6 +499,17 @@ func (_q *RentalQuery) WithRentalOptions(opts ...func(*RentalOptionQuery)) *Rent
 	return _q
 }
 
+// WithHandovers tells the query-builder to eager-load the nodes that are connected to
+// the "handovers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RentalQuery) WithHandovers(opts ...func(*RentalHandoverQuery)) *RentalQuery {
+	query := (&RentalHandoverClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHandovers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -481,11 +588,14 @@ func (_q *RentalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Renta
 	var (
 		nodes       = []*Rental{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			_q.withTenant != nil,
 			_q.withCar != nil,
 			_q.withRenter != nil,
+			_q.withPickupBranch != nil,
+			_q.withReturnBranch != nil,
 			_q.withRentalOptions != nil,
+			_q.withHandovers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -527,6 +637,18 @@ func (_q *RentalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Renta
 			return nil, err
 		}
 	}
+	if query := _q.withPickupBranch; query != nil {
+		if err := _q.loadPickupBranch(ctx, query, nodes, nil,
+			func(n *Rental, e *Branch) { n.Edges.PickupBranch = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReturnBranch; query != nil {
+		if err := _q.loadReturnBranch(ctx, query, nodes, nil,
+			func(n *Rental, e *Branch) { n.Edges.ReturnBranch = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withRentalOptions; query != nil {
 		if err := _q.loadRentalOptions(ctx, query, nodes,
 			func(n *Rental) { n.Edges.RentalOptions = []*RentalOption{} },
@@ -534,6 +656,13 @@ func (_q *RentalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Renta
 			return nil, err
 		}
 	}
+	if query := _q.withHandovers; query != nil {
+		if err := _q.loadHandovers(ctx, query, nodes,
+			func(n *Rental) { n.Edges.Handovers = []*RentalHandover{} },
+			func(n *Rental, e *RentalHandover) { n.Edges.Handovers = append(n.Edges.Handovers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -624,6 +753,70 @@ func (_q *RentalQuery) loadRenter(ctx context.Context, query *RenterQuery, nodes
 	}
 	return nil
 }
+func (_q *RentalQuery) loadPickupBranch(ctx context.Context, query *BranchQuery, nodes []*Rental, init func(*Rental), assign func(*Rental, *Branch)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Rental)
+	for i := range nodes {
+		if nodes[i].PickupBranchID == nil {
+			continue
+		}
+		fk := *nodes[i].PickupBranchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(branch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pickup_branch_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RentalQuery) loadReturnBranch(ctx context.Context, query *BranchQuery, nodes []*Rental, init func(*Rental), assign func(*Rental, *Branch)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Rental)
+	for i := range nodes {
+		if nodes[i].ReturnBranchID == nil {
+			continue
+		}
+		fk := *nodes[i].ReturnBranchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(branch.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "return_branch_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *RentalQuery) loadRentalOptions(ctx context.Context, query *RentalOptionQuery, nodes []*Rental, init func(*Rental), assign func(*Rental, *RentalOption)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Rental)
@@ -654,6 +847,36 @@ func (_q *RentalQuery) loadRentalOptions(ctx context.Context, query *RentalOptio
 	}
 	return nil
 }
+func (_q *RentalQuery) loadHandovers(ctx context.Context, query *RentalHandoverQuery, nodes []*Rental, init func(*Rental), assign func(*Rental, *RentalHandover)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Rental)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rentalhandover.FieldRentalID)
+	}
+	query.Where(predicate.RentalHandover(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(rental.HandoversColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RentalID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "rental_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *RentalQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -692,6 +915,12 @@ func (_q *RentalQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withRenter != nil {
 			_spec.Node.AddColumnOnce(rental.FieldRenterID)
 		}
+		if _q.withPickupBranch != nil {
+			_spec.Node.AddColumnOnce(rental.FieldPickupBranchID)
+		}
+		if _q.withReturnBranch != nil {
+			_spec.Node.AddColumnOnce(rental.FieldReturnBranchID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
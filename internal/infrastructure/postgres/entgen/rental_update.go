@@ -11,9 +11,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
@@ -74,6 +76,66 @@ func (_u *RentalUpdate) SetNillableRenterID(v *string) *RentalUpdate {
 	return _u
 }
 
+// SetOwnerTenantID sets the "owner_tenant_id" field.
+func (_u *RentalUpdate) SetOwnerTenantID(v string) *RentalUpdate {
+	_u.mutation.SetOwnerTenantID(v)
+	return _u
+}
+
+// SetNillableOwnerTenantID sets the "owner_tenant_id" field if the given value is not nil.
+func (_u *RentalUpdate) SetNillableOwnerTenantID(v *string) *RentalUpdate {
+	if v != nil {
+		_u.SetOwnerTenantID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerTenantID clears the value of the "owner_tenant_id" field.
+func (_u *RentalUpdate) ClearOwnerTenantID() *RentalUpdate {
+	_u.mutation.ClearOwnerTenantID()
+	return _u
+}
+
+// SetPickupBranchID sets the "pickup_branch_id" field.
+func (_u *RentalUpdate) SetPickupBranchID(v string) *RentalUpdate {
+	_u.mutation.SetPickupBranchID(v)
+	return _u
+}
+
+// SetNillablePickupBranchID sets the "pickup_branch_id" field if the given value is not nil.
+func (_u *RentalUpdate) SetNillablePickupBranchID(v *string) *RentalUpdate {
+	if v != nil {
+		_u.SetPickupBranchID(*v)
+	}
+	return _u
+}
+
+// ClearPickupBranchID clears the value of the "pickup_branch_id" field.
+func (_u *RentalUpdate) ClearPickupBranchID() *RentalUpdate {
+	_u.mutation.ClearPickupBranchID()
+	return _u
+}
+
+// SetReturnBranchID sets the "return_branch_id" field.
+func (_u *RentalUpdate) SetReturnBranchID(v string) *RentalUpdate {
+	_u.mutation.SetReturnBranchID(v)
+	return _u
+}
+
+// SetNillableReturnBranchID sets the "return_branch_id" field if the given value is not nil.
+func (_u *RentalUpdate) SetNillableReturnBranchID(v *string) *RentalUpdate {
+	if v != nil {
+		_u.SetReturnBranchID(*v)
+	}
+	return _u
+}
+
+// ClearReturnBranchID clears the value of the "return_branch_id" field.
+func (_u *RentalUpdate) ClearReturnBranchID() *RentalUpdate {
+	_u.mutation.ClearReturnBranchID()
+	return _u
+}
+
 // SetStartsAt sets the "starts_at" field.
 func (_u *RentalUpdate) SetStartsAt(v time.Time) *RentalUpdate {
 	_u.mutation.SetStartsAt(v)
@@ -114,6 +176,26 @@ func (_u *RentalUpdate) ClearEndsAt() *RentalUpdate {
 	return _u
 }
 
+// SetReturnedAt sets the "returned_at" field.
+func (_u *RentalUpdate) SetReturnedAt(v time.Time) *RentalUpdate {
+	_u.mutation.SetReturnedAt(v)
+	return _u
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_u *RentalUpdate) SetNillableReturnedAt(v *time.Time) *RentalUpdate {
+	if v != nil {
+		_u.SetReturnedAt(*v)
+	}
+	return _u
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (_u *RentalUpdate) ClearReturnedAt() *RentalUpdate {
+	_u.mutation.ClearReturnedAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *RentalUpdate) SetCreatedAt(v time.Time) *RentalUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -189,6 +271,16 @@ func (_u *RentalUpdate) SetRenter(v *Renter) *RentalUpdate {
 	return _u.SetRenterID(v.ID)
 }
 
+// SetPickupBranch sets the "pickup_branch" edge to the Branch entity.
+func (_u *RentalUpdate) SetPickupBranch(v *Branch) *RentalUpdate {
+	return _u.SetPickupBranchID(v.ID)
+}
+
+// SetReturnBranch sets the "return_branch" edge to the Branch entity.
+func (_u *RentalUpdate) SetReturnBranch(v *Branch) *RentalUpdate {
+	return _u.SetReturnBranchID(v.ID)
+}
+
 // AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by IDs.
 func (_u *RentalUpdate) AddRentalOptionIDs(ids ...string) *RentalUpdate {
 	_u.mutation.AddRentalOptionIDs(ids...)
@@ -204,6 +296,21 @@ func (_u *RentalUpdate) AddRentalOptions(v ...*RentalOption) *RentalUpdate {
 	return _u.AddRentalOptionIDs(ids...)
 }
 
+// AddHandoverIDs adds the "handovers" edge to the RentalHandover entity by IDs.
+func (_u *RentalUpdate) AddHandoverIDs(ids ...string) *RentalUpdate {
+	_u.mutation.AddHandoverIDs(ids...)
+	return _u
+}
+
+// AddHandovers adds the "handovers" edges to the RentalHandover entity.
+func (_u *RentalUpdate) AddHandovers(v ...*RentalHandover) *RentalUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHandoverIDs(ids...)
+}
+
 // Mutation returns the RentalMutation object of the builder.
 func (_u *RentalUpdate) Mutation() *RentalMutation {
 	return _u.mutation
@@ -227,6 +334,18 @@ func (_u *RentalUpdate) ClearRenter() *RentalUpdate {
 	return _u
 }
 
+// ClearPickupBranch clears the "pickup_branch" edge to the Branch entity.
+func (_u *RentalUpdate) ClearPickupBranch() *RentalUpdate {
+	_u.mutation.ClearPickupBranch()
+	return _u
+}
+
+// ClearReturnBranch clears the "return_branch" edge to the Branch entity.
+func (_u *RentalUpdate) ClearReturnBranch() *RentalUpdate {
+	_u.mutation.ClearReturnBranch()
+	return _u
+}
+
 // ClearRentalOptions clears all "rental_options" edges to the RentalOption entity.
 func (_u *RentalUpdate) ClearRentalOptions() *RentalUpdate {
 	_u.mutation.ClearRentalOptions()
@@ -248,6 +367,27 @@ func (_u *RentalUpdate) RemoveRentalOptions(v ...*RentalOption) *RentalUpdate {
 	return _u.RemoveRentalOptionIDs(ids...)
 }
 
+// ClearHandovers clears all "handovers" edges to the RentalHandover entity.
+func (_u *RentalUpdate) ClearHandovers() *RentalUpdate {
+	_u.mutation.ClearHandovers()
+	return _u
+}
+
+// RemoveHandoverIDs removes the "handovers" edge to RentalHandover entities by IDs.
+func (_u *RentalUpdate) RemoveHandoverIDs(ids ...string) *RentalUpdate {
+	_u.mutation.RemoveHandoverIDs(ids...)
+	return _u
+}
+
+// RemoveHandovers removes "handovers" edges to RentalHandover entities.
+func (_u *RentalUpdate) RemoveHandovers(v ...*RentalHandover) *RentalUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHandoverIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *RentalUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -292,6 +432,21 @@ func (_u *RentalUpdate) check() error {
 			return &ValidationError{Name: "renter_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.renter_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.OwnerTenantID(); ok {
+		if err := rental.OwnerTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.owner_tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PickupBranchID(); ok {
+		if err := rental.PickupBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "pickup_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.pickup_branch_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReturnBranchID(); ok {
+		if err := rental.ReturnBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "return_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.return_branch_id": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`entgen: clearing a required unique edge "Rental.tenant"`)
 	}
@@ -316,6 +471,12 @@ func (_u *RentalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.OwnerTenantID(); ok {
+		_spec.SetField(rental.FieldOwnerTenantID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerTenantIDCleared() {
+		_spec.ClearField(rental.FieldOwnerTenantID, field.TypeString)
+	}
 	if value, ok := _u.mutation.StartsAt(); ok {
 		_spec.SetField(rental.FieldStartsAt, field.TypeTime, value)
 	}
@@ -328,6 +489,12 @@ func (_u *RentalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.EndsAtCleared() {
 		_spec.ClearField(rental.FieldEndsAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ReturnedAt(); ok {
+		_spec.SetField(rental.FieldReturnedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReturnedAtCleared() {
+		_spec.ClearField(rental.FieldReturnedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(rental.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -433,6 +600,64 @@ func (_u *RentalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PickupBranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.PickupBranchTable,
+			Columns: []string{rental.PickupBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PickupBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.PickupBranchTable,
+			Columns: []string{rental.PickupBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnBranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.ReturnBranchTable,
+			Columns: []string{rental.ReturnBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.ReturnBranchTable,
+			Columns: []string{rental.ReturnBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.RentalOptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -478,6 +703,51 @@ func (_u *RentalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.HandoversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rental.HandoversTable,
+			Columns: []string{rental.HandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHandoversIDs(); len(nodes) > 0 && !_u.mutation.HandoversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rental.HandoversTable,
+			Columns: []string{rental.HandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandoversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rental.HandoversTable,
+			Columns: []string{rental.HandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{rental.Label}
@@ -540,6 +810,66 @@ func (_u *RentalUpdateOne) SetNillableRenterID(v *string) *RentalUpdateOne {
 	return _u
 }
 
+// SetOwnerTenantID sets the "owner_tenant_id" field.
+func (_u *RentalUpdateOne) SetOwnerTenantID(v string) *RentalUpdateOne {
+	_u.mutation.SetOwnerTenantID(v)
+	return _u
+}
+
+// SetNillableOwnerTenantID sets the "owner_tenant_id" field if the given value is not nil.
+func (_u *RentalUpdateOne) SetNillableOwnerTenantID(v *string) *RentalUpdateOne {
+	if v != nil {
+		_u.SetOwnerTenantID(*v)
+	}
+	return _u
+}
+
+// ClearOwnerTenantID clears the value of the "owner_tenant_id" field.
+func (_u *RentalUpdateOne) ClearOwnerTenantID() *RentalUpdateOne {
+	_u.mutation.ClearOwnerTenantID()
+	return _u
+}
+
+// SetPickupBranchID sets the "pickup_branch_id" field.
+func (_u *RentalUpdateOne) SetPickupBranchID(v string) *RentalUpdateOne {
+	_u.mutation.SetPickupBranchID(v)
+	return _u
+}
+
+// SetNillablePickupBranchID sets the "pickup_branch_id" field if the given value is not nil.
+func (_u *RentalUpdateOne) SetNillablePickupBranchID(v *string) *RentalUpdateOne {
+	if v != nil {
+		_u.SetPickupBranchID(*v)
+	}
+	return _u
+}
+
+// ClearPickupBranchID clears the value of the "pickup_branch_id" field.
+func (_u *RentalUpdateOne) ClearPickupBranchID() *RentalUpdateOne {
+	_u.mutation.ClearPickupBranchID()
+	return _u
+}
+
+// SetReturnBranchID sets the "return_branch_id" field.
+func (_u *RentalUpdateOne) SetReturnBranchID(v string) *RentalUpdateOne {
+	_u.mutation.SetReturnBranchID(v)
+	return _u
+}
+
+// SetNillableReturnBranchID sets the "return_branch_id" field if the given value is not nil.
+func (_u *RentalUpdateOne) SetNillableReturnBranchID(v *string) *RentalUpdateOne {
+	if v != nil {
+		_u.SetReturnBranchID(*v)
+	}
+	return _u
+}
+
+// ClearReturnBranchID clears the value of the "return_branch_id" field.
+func (_u *RentalUpdateOne) ClearReturnBranchID() *RentalUpdateOne {
+	_u.mutation.ClearReturnBranchID()
+	return _u
+}
+
 // SetStartsAt sets the "starts_at" field.
 func (_u *RentalUpdateOne) SetStartsAt(v time.Time) *RentalUpdateOne {
 	_u.mutation.SetStartsAt(v)
@@ -580,6 +910,26 @@ func (_u *RentalUpdateOne) ClearEndsAt() *RentalUpdateOne {
 	return _u
 }
 
+// SetReturnedAt sets the "returned_at" field.
+func (_u *RentalUpdateOne) SetReturnedAt(v time.Time) *RentalUpdateOne {
+	_u.mutation.SetReturnedAt(v)
+	return _u
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_u *RentalUpdateOne) SetNillableReturnedAt(v *time.Time) *RentalUpdateOne {
+	if v != nil {
+		_u.SetReturnedAt(*v)
+	}
+	return _u
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (_u *RentalUpdateOne) ClearReturnedAt() *RentalUpdateOne {
+	_u.mutation.ClearReturnedAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *RentalUpdateOne) SetCreatedAt(v time.Time) *RentalUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -655,6 +1005,16 @@ func (_u *RentalUpdateOne) SetRenter(v *Renter) *RentalUpdateOne {
 	return _u.SetRenterID(v.ID)
 }
 
+// SetPickupBranch sets the "pickup_branch" edge to the Branch entity.
+func (_u *RentalUpdateOne) SetPickupBranch(v *Branch) *RentalUpdateOne {
+	return _u.SetPickupBranchID(v.ID)
+}
+
+// SetReturnBranch sets the "return_branch" edge to the Branch entity.
+func (_u *RentalUpdateOne) SetReturnBranch(v *Branch) *RentalUpdateOne {
+	return _u.SetReturnBranchID(v.ID)
+}
+
 // AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by IDs.
 func (_u *RentalUpdateOne) AddRentalOptionIDs(ids ...string) *RentalUpdateOne {
 	_u.mutation.AddRentalOptionIDs(ids...)
@@ -670,6 +1030,21 @@ func (_u *RentalUpdateOne) AddRentalOptions(v ...*RentalOption) *RentalUpdateOne
 	return _u.AddRentalOptionIDs(ids...)
 }
 
+// AddHandoverIDs adds the "handovers" edge to the RentalHandover entity by IDs.
+func (_u *RentalUpdateOne) AddHandoverIDs(ids ...string) *RentalUpdateOne {
+	_u.mutation.AddHandoverIDs(ids...)
+	return _u
+}
+
+// AddHandovers adds the "handovers" edges to the RentalHandover entity.
+func (_u *RentalUpdateOne) AddHandovers(v ...*RentalHandover) *RentalUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHandoverIDs(ids...)
+}
+
 // Mutation returns the RentalMutation object of the builder.
 func (_u *RentalUpdateOne) Mutation() *RentalMutation {
 	return _u.mutation
@@ -693,6 +1068,18 @@ func (_u *RentalUpdateOne) ClearRenter() *RentalUpdateOne {
 	return _u
 }
 
+// ClearPickupBranch clears the "pickup_branch" edge to the Branch entity.
+func (_u *RentalUpdateOne) ClearPickupBranch() *RentalUpdateOne {
+	_u.mutation.ClearPickupBranch()
+	return _u
+}
+
+// ClearReturnBranch clears the "return_branch" edge to the Branch entity.
+func (_u *RentalUpdateOne) ClearReturnBranch() *RentalUpdateOne {
+	_u.mutation.ClearReturnBranch()
+	return _u
+}
+
 // ClearRentalOptions clears all "rental_options" edges to the RentalOption entity.
 func (_u *RentalUpdateOne) ClearRentalOptions() *RentalUpdateOne {
 	_u.mutation.ClearRentalOptions()
@@ -714,6 +1101,27 @@ func (_u *RentalUpdateOne) RemoveRentalOptions(v ...*RentalOption) *RentalUpdate
 	return _u.RemoveRentalOptionIDs(ids...)
 }
 
+// ClearHandovers clears all "handovers" edges to the RentalHandover entity.
+func (_u *RentalUpdateOne) ClearHandovers() *RentalUpdateOne {
+	_u.mutation.ClearHandovers()
+	return _u
+}
+
+// RemoveHandoverIDs removes the "handovers" edge to RentalHandover entities by IDs.
+func (_u *RentalUpdateOne) RemoveHandoverIDs(ids ...string) *RentalUpdateOne {
+	_u.mutation.RemoveHandoverIDs(ids...)
+	return _u
+}
+
+// RemoveHandovers removes "handovers" edges to RentalHandover entities.
+func (_u *RentalUpdateOne) RemoveHandovers(v ...*RentalHandover) *RentalUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHandoverIDs(ids...)
+}
+
 // Where appends a list predicates to the RentalUpdate builder.
 func (_u *RentalUpdateOne) Where(ps ...predicate.Rental) *RentalUpdateOne {
 	_u.mutation.Where(ps...)
@@ -771,6 +1179,21 @@ func (_u *RentalUpdateOne) check() error {
 			return &ValidationError{Name: "renter_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.renter_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.OwnerTenantID(); ok {
+		if err := rental.OwnerTenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "owner_tenant_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.owner_tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PickupBranchID(); ok {
+		if err := rental.PickupBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "pickup_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.pickup_branch_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReturnBranchID(); ok {
+		if err := rental.ReturnBranchIDValidator(v); err != nil {
+			return &ValidationError{Name: "return_branch_id", err: fmt.Errorf(`entgen: validator failed for field "Rental.return_branch_id": %w`, err)}
+		}
+	}
 	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
 		return errors.New(`entgen: clearing a required unique edge "Rental.tenant"`)
 	}
@@ -812,6 +1235,12 @@ func (_u *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err erro
 			}
 		}
 	}
+	if value, ok := _u.mutation.OwnerTenantID(); ok {
+		_spec.SetField(rental.FieldOwnerTenantID, field.TypeString, value)
+	}
+	if _u.mutation.OwnerTenantIDCleared() {
+		_spec.ClearField(rental.FieldOwnerTenantID, field.TypeString)
+	}
 	if value, ok := _u.mutation.StartsAt(); ok {
 		_spec.SetField(rental.FieldStartsAt, field.TypeTime, value)
 	}
@@ -824,6 +1253,12 @@ func (_u *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err erro
 	if _u.mutation.EndsAtCleared() {
 		_spec.ClearField(rental.FieldEndsAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ReturnedAt(); ok {
+		_spec.SetField(rental.FieldReturnedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReturnedAtCleared() {
+		_spec.ClearField(rental.FieldReturnedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(rental.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -929,6 +1364,64 @@ func (_u *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PickupBranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.PickupBranchTable,
+			Columns: []string{rental.PickupBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PickupBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.PickupBranchTable,
+			Columns: []string{rental.PickupBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnBranchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.ReturnBranchTable,
+			Columns: []string{rental.ReturnBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnBranchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rental.ReturnBranchTable,
+			Columns: []string{rental.ReturnBranchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.RentalOptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -974,6 +1467,51 @@ func (_u *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.HandoversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rental.HandoversTable,
+			Columns: []string{rental.HandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHandoversIDs(); len(nodes) > 0 && !_u.mutation.HandoversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rental.HandoversTable,
+			Columns: []string{rental.HandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HandoversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   rental.HandoversTable,
+			Columns: []string{rental.HandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Rental{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
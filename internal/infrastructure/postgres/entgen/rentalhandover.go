@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// RentalHandover is the model entity for the RentalHandover schema.
+type RentalHandover struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID string `json:"tenant_id,omitempty"`
+	// RentalID holds the value of the "rental_id" field.
+	RentalID string `json:"rental_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// OdometerKm holds the value of the "odometer_km" field.
+	OdometerKm int `json:"odometer_km,omitempty"`
+	// FuelLevel holds the value of the "fuel_level" field.
+	FuelLevel int `json:"fuel_level,omitempty"`
+	// Damages holds the value of the "damages" field.
+	Damages []schema.HandoverDamage `json:"damages,omitempty"`
+	// DistanceKm holds the value of the "distance_km" field.
+	DistanceKm int `json:"distance_km,omitempty"`
+	// FuelShortfall holds the value of the "fuel_shortfall" field.
+	FuelShortfall int `json:"fuel_shortfall,omitempty"`
+	// InspectedAt holds the value of the "inspected_at" field.
+	InspectedAt time.Time `json:"inspected_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RentalHandoverQuery when eager-loading is set.
+	Edges        RentalHandoverEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RentalHandoverEdges holds the relations/edges for other nodes in the graph.
+type RentalHandoverEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Rental holds the value of the rental edge.
+	Rental *Rental `json:"rental,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RentalHandoverEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// RentalOrErr returns the Rental value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RentalHandoverEdges) RentalOrErr() (*Rental, error) {
+	if e.Rental != nil {
+		return e.Rental, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: rental.Label}
+	}
+	return nil, &NotLoadedError{edge: "rental"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RentalHandover) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rentalhandover.FieldDamages:
+			values[i] = new([]byte)
+		case rentalhandover.FieldOdometerKm, rentalhandover.FieldFuelLevel, rentalhandover.FieldDistanceKm, rentalhandover.FieldFuelShortfall:
+			values[i] = new(sql.NullInt64)
+		case rentalhandover.FieldID, rentalhandover.FieldTenantID, rentalhandover.FieldRentalID, rentalhandover.FieldKind:
+			values[i] = new(sql.NullString)
+		case rentalhandover.FieldInspectedAt, rentalhandover.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RentalHandover fields.
+func (_m *RentalHandover) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rentalhandover.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case rentalhandover.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case rentalhandover.FieldRentalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rental_id", values[i])
+			} else if value.Valid {
+				_m.RentalID = value.String
+			}
+		case rentalhandover.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case rentalhandover.FieldOdometerKm:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field odometer_km", values[i])
+			} else if value.Valid {
+				_m.OdometerKm = int(value.Int64)
+			}
+		case rentalhandover.FieldFuelLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fuel_level", values[i])
+			} else if value.Valid {
+				_m.FuelLevel = int(value.Int64)
+			}
+		case rentalhandover.FieldDamages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field damages", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Damages); err != nil {
+					return fmt.Errorf("unmarshal field damages: %w", err)
+				}
+			}
+		case rentalhandover.FieldDistanceKm:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field distance_km", values[i])
+			} else if value.Valid {
+				_m.DistanceKm = int(value.Int64)
+			}
+		case rentalhandover.FieldFuelShortfall:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fuel_shortfall", values[i])
+			} else if value.Valid {
+				_m.FuelShortfall = int(value.Int64)
+			}
+		case rentalhandover.FieldInspectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field inspected_at", values[i])
+			} else if value.Valid {
+				_m.InspectedAt = value.Time
+			}
+		case rentalhandover.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RentalHandover.
+// This includes values selected through modifiers, order, etc.
+func (_m *RentalHandover) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the RentalHandover entity.
+func (_m *RentalHandover) QueryTenant() *TenantQuery {
+	return NewRentalHandoverClient(_m.config).QueryTenant(_m)
+}
+
+// QueryRental queries the "rental" edge of the RentalHandover entity.
+func (_m *RentalHandover) QueryRental() *RentalQuery {
+	return NewRentalHandoverClient(_m.config).QueryRental(_m)
+}
+
+// Update returns a builder for updating this RentalHandover.
+// Note that you need to call RentalHandover.Unwrap() before calling this method if this RentalHandover
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RentalHandover) Update() *RentalHandoverUpdateOne {
+	return NewRentalHandoverClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RentalHandover entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RentalHandover) Unwrap() *RentalHandover {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("entgen: RentalHandover is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RentalHandover) String() string {
+	var builder strings.Builder
+	builder.WriteString("RentalHandover(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("rental_id=")
+	builder.WriteString(_m.RentalID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("odometer_km=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OdometerKm))
+	builder.WriteString(", ")
+	builder.WriteString("fuel_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FuelLevel))
+	builder.WriteString(", ")
+	builder.WriteString("damages=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Damages))
+	builder.WriteString(", ")
+	builder.WriteString("distance_km=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DistanceKm))
+	builder.WriteString(", ")
+	builder.WriteString("fuel_shortfall=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FuelShortfall))
+	builder.WriteString(", ")
+	builder.WriteString("inspected_at=")
+	builder.WriteString(_m.InspectedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RentalHandovers is a parsable slice of RentalHandover.
+type RentalHandovers []*RentalHandover
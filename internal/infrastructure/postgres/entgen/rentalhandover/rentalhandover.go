@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rentalhandover
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the rentalhandover type in the database.
+	Label = "rental_handover"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldRentalID holds the string denoting the rental_id field in the database.
+	FieldRentalID = "rental_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldOdometerKm holds the string denoting the odometer_km field in the database.
+	FieldOdometerKm = "odometer_km"
+	// FieldFuelLevel holds the string denoting the fuel_level field in the database.
+	FieldFuelLevel = "fuel_level"
+	// FieldDamages holds the string denoting the damages field in the database.
+	FieldDamages = "damages"
+	// FieldDistanceKm holds the string denoting the distance_km field in the database.
+	FieldDistanceKm = "distance_km"
+	// FieldFuelShortfall holds the string denoting the fuel_shortfall field in the database.
+	FieldFuelShortfall = "fuel_shortfall"
+	// FieldInspectedAt holds the string denoting the inspected_at field in the database.
+	FieldInspectedAt = "inspected_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeTenant holds the string denoting the tenant edge name in mutations.
+	EdgeTenant = "tenant"
+	// EdgeRental holds the string denoting the rental edge name in mutations.
+	EdgeRental = "rental"
+	// Table holds the table name of the rentalhandover in the database.
+	Table = "rental_handovers"
+	// TenantTable is the table that holds the tenant relation/edge.
+	TenantTable = "rental_handovers"
+	// TenantInverseTable is the table name for the Tenant entity.
+	// It exists in this package in order to avoid circular dependency with the "tenant" package.
+	TenantInverseTable = "tenants"
+	// TenantColumn is the table column denoting the tenant relation/edge.
+	TenantColumn = "tenant_id"
+	// RentalTable is the table that holds the rental relation/edge.
+	RentalTable = "rental_handovers"
+	// RentalInverseTable is the table name for the Rental entity.
+	// It exists in this package in order to avoid circular dependency with the "rental" package.
+	RentalInverseTable = "rentals"
+	// RentalColumn is the table column denoting the rental relation/edge.
+	RentalColumn = "rental_id"
+)
+
+// Columns holds all SQL columns for rentalhandover fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldRentalID,
+	FieldKind,
+	FieldOdometerKm,
+	FieldFuelLevel,
+	FieldDamages,
+	FieldDistanceKm,
+	FieldFuelShortfall,
+	FieldInspectedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// RentalIDValidator is a validator for the "rental_id" field. It is called by the builders before save.
+	RentalIDValidator func(string) error
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// OdometerKmValidator is a validator for the "odometer_km" field. It is called by the builders before save.
+	OdometerKmValidator func(int) error
+	// FuelLevelValidator is a validator for the "fuel_level" field. It is called by the builders before save.
+	FuelLevelValidator func(int) error
+	// DefaultDistanceKm holds the default value on creation for the "distance_km" field.
+	DefaultDistanceKm int
+	// DistanceKmValidator is a validator for the "distance_km" field. It is called by the builders before save.
+	DistanceKmValidator func(int) error
+	// DefaultFuelShortfall holds the default value on creation for the "fuel_shortfall" field.
+	DefaultFuelShortfall int
+	// FuelShortfallValidator is a validator for the "fuel_shortfall" field. It is called by the builders before save.
+	FuelShortfallValidator func(int) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the RentalHandover queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByRentalID orders the results by the rental_id field.
+func ByRentalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRentalID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByOdometerKm orders the results by the odometer_km field.
+func ByOdometerKm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOdometerKm, opts...).ToFunc()
+}
+
+// ByFuelLevel orders the results by the fuel_level field.
+func ByFuelLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFuelLevel, opts...).ToFunc()
+}
+
+// ByDistanceKm orders the results by the distance_km field.
+func ByDistanceKm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDistanceKm, opts...).ToFunc()
+}
+
+// ByFuelShortfall orders the results by the fuel_shortfall field.
+func ByFuelShortfall(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFuelShortfall, opts...).ToFunc()
+}
+
+// ByInspectedAt orders the results by the inspected_at field.
+func ByInspectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInspectedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTenantField orders the results by tenant field.
+func ByTenantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTenantStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRentalField orders the results by rental field.
+func ByRentalField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRentalStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTenantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TenantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+	)
+}
+func newRentalStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RentalInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RentalTable, RentalColumn),
+	)
+}
@@ -0,0 +1,627 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rentalhandover
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldTenantID, v))
+}
+
+// RentalID applies equality check predicate on the "rental_id" field. It's identical to RentalIDEQ.
+func RentalID(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldRentalID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldKind, v))
+}
+
+// OdometerKm applies equality check predicate on the "odometer_km" field. It's identical to OdometerKmEQ.
+func OdometerKm(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldOdometerKm, v))
+}
+
+// FuelLevel applies equality check predicate on the "fuel_level" field. It's identical to FuelLevelEQ.
+func FuelLevel(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldFuelLevel, v))
+}
+
+// DistanceKm applies equality check predicate on the "distance_km" field. It's identical to DistanceKmEQ.
+func DistanceKm(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldDistanceKm, v))
+}
+
+// FuelShortfall applies equality check predicate on the "fuel_shortfall" field. It's identical to FuelShortfallEQ.
+func FuelShortfall(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldFuelShortfall, v))
+}
+
+// InspectedAt applies equality check predicate on the "inspected_at" field. It's identical to InspectedAtEQ.
+func InspectedAt(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldInspectedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// RentalIDEQ applies the EQ predicate on the "rental_id" field.
+func RentalIDEQ(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldRentalID, v))
+}
+
+// RentalIDNEQ applies the NEQ predicate on the "rental_id" field.
+func RentalIDNEQ(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldRentalID, v))
+}
+
+// RentalIDIn applies the In predicate on the "rental_id" field.
+func RentalIDIn(vs ...string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldRentalID, vs...))
+}
+
+// RentalIDNotIn applies the NotIn predicate on the "rental_id" field.
+func RentalIDNotIn(vs ...string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldRentalID, vs...))
+}
+
+// RentalIDGT applies the GT predicate on the "rental_id" field.
+func RentalIDGT(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldRentalID, v))
+}
+
+// RentalIDGTE applies the GTE predicate on the "rental_id" field.
+func RentalIDGTE(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldRentalID, v))
+}
+
+// RentalIDLT applies the LT predicate on the "rental_id" field.
+func RentalIDLT(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldRentalID, v))
+}
+
+// RentalIDLTE applies the LTE predicate on the "rental_id" field.
+func RentalIDLTE(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldRentalID, v))
+}
+
+// RentalIDContains applies the Contains predicate on the "rental_id" field.
+func RentalIDContains(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldContains(FieldRentalID, v))
+}
+
+// RentalIDHasPrefix applies the HasPrefix predicate on the "rental_id" field.
+func RentalIDHasPrefix(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldHasPrefix(FieldRentalID, v))
+}
+
+// RentalIDHasSuffix applies the HasSuffix predicate on the "rental_id" field.
+func RentalIDHasSuffix(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldHasSuffix(FieldRentalID, v))
+}
+
+// RentalIDEqualFold applies the EqualFold predicate on the "rental_id" field.
+func RentalIDEqualFold(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEqualFold(FieldRentalID, v))
+}
+
+// RentalIDContainsFold applies the ContainsFold predicate on the "rental_id" field.
+func RentalIDContainsFold(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldContainsFold(FieldRentalID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldContainsFold(FieldKind, v))
+}
+
+// OdometerKmEQ applies the EQ predicate on the "odometer_km" field.
+func OdometerKmEQ(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldOdometerKm, v))
+}
+
+// OdometerKmNEQ applies the NEQ predicate on the "odometer_km" field.
+func OdometerKmNEQ(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldOdometerKm, v))
+}
+
+// OdometerKmIn applies the In predicate on the "odometer_km" field.
+func OdometerKmIn(vs ...int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldOdometerKm, vs...))
+}
+
+// OdometerKmNotIn applies the NotIn predicate on the "odometer_km" field.
+func OdometerKmNotIn(vs ...int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldOdometerKm, vs...))
+}
+
+// OdometerKmGT applies the GT predicate on the "odometer_km" field.
+func OdometerKmGT(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldOdometerKm, v))
+}
+
+// OdometerKmGTE applies the GTE predicate on the "odometer_km" field.
+func OdometerKmGTE(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldOdometerKm, v))
+}
+
+// OdometerKmLT applies the LT predicate on the "odometer_km" field.
+func OdometerKmLT(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldOdometerKm, v))
+}
+
+// OdometerKmLTE applies the LTE predicate on the "odometer_km" field.
+func OdometerKmLTE(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldOdometerKm, v))
+}
+
+// FuelLevelEQ applies the EQ predicate on the "fuel_level" field.
+func FuelLevelEQ(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldFuelLevel, v))
+}
+
+// FuelLevelNEQ applies the NEQ predicate on the "fuel_level" field.
+func FuelLevelNEQ(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldFuelLevel, v))
+}
+
+// FuelLevelIn applies the In predicate on the "fuel_level" field.
+func FuelLevelIn(vs ...int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldFuelLevel, vs...))
+}
+
+// FuelLevelNotIn applies the NotIn predicate on the "fuel_level" field.
+func FuelLevelNotIn(vs ...int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldFuelLevel, vs...))
+}
+
+// FuelLevelGT applies the GT predicate on the "fuel_level" field.
+func FuelLevelGT(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldFuelLevel, v))
+}
+
+// FuelLevelGTE applies the GTE predicate on the "fuel_level" field.
+func FuelLevelGTE(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldFuelLevel, v))
+}
+
+// FuelLevelLT applies the LT predicate on the "fuel_level" field.
+func FuelLevelLT(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldFuelLevel, v))
+}
+
+// FuelLevelLTE applies the LTE predicate on the "fuel_level" field.
+func FuelLevelLTE(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldFuelLevel, v))
+}
+
+// DamagesIsNil applies the IsNil predicate on the "damages" field.
+func DamagesIsNil() predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIsNull(FieldDamages))
+}
+
+// DamagesNotNil applies the NotNil predicate on the "damages" field.
+func DamagesNotNil() predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotNull(FieldDamages))
+}
+
+// DistanceKmEQ applies the EQ predicate on the "distance_km" field.
+func DistanceKmEQ(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldDistanceKm, v))
+}
+
+// DistanceKmNEQ applies the NEQ predicate on the "distance_km" field.
+func DistanceKmNEQ(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldDistanceKm, v))
+}
+
+// DistanceKmIn applies the In predicate on the "distance_km" field.
+func DistanceKmIn(vs ...int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldDistanceKm, vs...))
+}
+
+// DistanceKmNotIn applies the NotIn predicate on the "distance_km" field.
+func DistanceKmNotIn(vs ...int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldDistanceKm, vs...))
+}
+
+// DistanceKmGT applies the GT predicate on the "distance_km" field.
+func DistanceKmGT(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldDistanceKm, v))
+}
+
+// DistanceKmGTE applies the GTE predicate on the "distance_km" field.
+func DistanceKmGTE(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldDistanceKm, v))
+}
+
+// DistanceKmLT applies the LT predicate on the "distance_km" field.
+func DistanceKmLT(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldDistanceKm, v))
+}
+
+// DistanceKmLTE applies the LTE predicate on the "distance_km" field.
+func DistanceKmLTE(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldDistanceKm, v))
+}
+
+// FuelShortfallEQ applies the EQ predicate on the "fuel_shortfall" field.
+func FuelShortfallEQ(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldFuelShortfall, v))
+}
+
+// FuelShortfallNEQ applies the NEQ predicate on the "fuel_shortfall" field.
+func FuelShortfallNEQ(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldFuelShortfall, v))
+}
+
+// FuelShortfallIn applies the In predicate on the "fuel_shortfall" field.
+func FuelShortfallIn(vs ...int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldFuelShortfall, vs...))
+}
+
+// FuelShortfallNotIn applies the NotIn predicate on the "fuel_shortfall" field.
+func FuelShortfallNotIn(vs ...int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldFuelShortfall, vs...))
+}
+
+// FuelShortfallGT applies the GT predicate on the "fuel_shortfall" field.
+func FuelShortfallGT(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldFuelShortfall, v))
+}
+
+// FuelShortfallGTE applies the GTE predicate on the "fuel_shortfall" field.
+func FuelShortfallGTE(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldFuelShortfall, v))
+}
+
+// FuelShortfallLT applies the LT predicate on the "fuel_shortfall" field.
+func FuelShortfallLT(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldFuelShortfall, v))
+}
+
+// FuelShortfallLTE applies the LTE predicate on the "fuel_shortfall" field.
+func FuelShortfallLTE(v int) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldFuelShortfall, v))
+}
+
+// InspectedAtEQ applies the EQ predicate on the "inspected_at" field.
+func InspectedAtEQ(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldInspectedAt, v))
+}
+
+// InspectedAtNEQ applies the NEQ predicate on the "inspected_at" field.
+func InspectedAtNEQ(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldInspectedAt, v))
+}
+
+// InspectedAtIn applies the In predicate on the "inspected_at" field.
+func InspectedAtIn(vs ...time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldInspectedAt, vs...))
+}
+
+// InspectedAtNotIn applies the NotIn predicate on the "inspected_at" field.
+func InspectedAtNotIn(vs ...time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldInspectedAt, vs...))
+}
+
+// InspectedAtGT applies the GT predicate on the "inspected_at" field.
+func InspectedAtGT(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldInspectedAt, v))
+}
+
+// InspectedAtGTE applies the GTE predicate on the "inspected_at" field.
+func InspectedAtGTE(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldInspectedAt, v))
+}
+
+// InspectedAtLT applies the LT predicate on the "inspected_at" field.
+func InspectedAtLT(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldInspectedAt, v))
+}
+
+// InspectedAtLTE applies the LTE predicate on the "inspected_at" field.
+func InspectedAtLTE(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldInspectedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.RentalHandover {
+	return predicate.RentalHandover(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// HasTenant applies the HasEdge predicate on the "tenant" edge.
+func HasTenant() predicate.RentalHandover {
+	return predicate.RentalHandover(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TenantTable, TenantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTenantWith applies the HasEdge predicate on the "tenant" edge with a given conditions (other predicates).
+func HasTenantWith(preds ...predicate.Tenant) predicate.RentalHandover {
+	return predicate.RentalHandover(func(s *sql.Selector) {
+		step := newTenantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRental applies the HasEdge predicate on the "rental" edge.
+func HasRental() predicate.RentalHandover {
+	return predicate.RentalHandover(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RentalTable, RentalColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRentalWith applies the HasEdge predicate on the "rental" edge with a given conditions (other predicates).
+func HasRentalWith(preds ...predicate.Rental) predicate.RentalHandover {
+	return predicate.RentalHandover(func(s *sql.Selector) {
+		step := newRentalStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RentalHandover) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RentalHandover) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RentalHandover) predicate.RentalHandover {
+	return predicate.RentalHandover(sql.NotPredicates(p))
+}
@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// RentalHandoverCreate is the builder for creating a RentalHandover entity.
+type RentalHandoverCreate struct {
+	config
+	mutation *RentalHandoverMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *RentalHandoverCreate) SetTenantID(v string) *RentalHandoverCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetRentalID sets the "rental_id" field.
+func (_c *RentalHandoverCreate) SetRentalID(v string) *RentalHandoverCreate {
+	_c.mutation.SetRentalID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *RentalHandoverCreate) SetKind(v string) *RentalHandoverCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetOdometerKm sets the "odometer_km" field.
+func (_c *RentalHandoverCreate) SetOdometerKm(v int) *RentalHandoverCreate {
+	_c.mutation.SetOdometerKm(v)
+	return _c
+}
+
+// SetFuelLevel sets the "fuel_level" field.
+func (_c *RentalHandoverCreate) SetFuelLevel(v int) *RentalHandoverCreate {
+	_c.mutation.SetFuelLevel(v)
+	return _c
+}
+
+// SetDamages sets the "damages" field.
+func (_c *RentalHandoverCreate) SetDamages(v []schema.HandoverDamage) *RentalHandoverCreate {
+	_c.mutation.SetDamages(v)
+	return _c
+}
+
+// SetDistanceKm sets the "distance_km" field.
+func (_c *RentalHandoverCreate) SetDistanceKm(v int) *RentalHandoverCreate {
+	_c.mutation.SetDistanceKm(v)
+	return _c
+}
+
+// SetNillableDistanceKm sets the "distance_km" field if the given value is not nil.
+func (_c *RentalHandoverCreate) SetNillableDistanceKm(v *int) *RentalHandoverCreate {
+	if v != nil {
+		_c.SetDistanceKm(*v)
+	}
+	return _c
+}
+
+// SetFuelShortfall sets the "fuel_shortfall" field.
+func (_c *RentalHandoverCreate) SetFuelShortfall(v int) *RentalHandoverCreate {
+	_c.mutation.SetFuelShortfall(v)
+	return _c
+}
+
+// SetNillableFuelShortfall sets the "fuel_shortfall" field if the given value is not nil.
+func (_c *RentalHandoverCreate) SetNillableFuelShortfall(v *int) *RentalHandoverCreate {
+	if v != nil {
+		_c.SetFuelShortfall(*v)
+	}
+	return _c
+}
+
+// SetInspectedAt sets the "inspected_at" field.
+func (_c *RentalHandoverCreate) SetInspectedAt(v time.Time) *RentalHandoverCreate {
+	_c.mutation.SetInspectedAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RentalHandoverCreate) SetCreatedAt(v time.Time) *RentalHandoverCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RentalHandoverCreate) SetNillableCreatedAt(v *time.Time) *RentalHandoverCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RentalHandoverCreate) SetID(v string) *RentalHandoverCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *RentalHandoverCreate) SetTenant(v *Tenant) *RentalHandoverCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetRental sets the "rental" edge to the Rental entity.
+func (_c *RentalHandoverCreate) SetRental(v *Rental) *RentalHandoverCreate {
+	return _c.SetRentalID(v.ID)
+}
+
+// Mutation returns the RentalHandoverMutation object of the builder.
+func (_c *RentalHandoverCreate) Mutation() *RentalHandoverMutation {
+	return _c.mutation
+}
+
+// Save creates the RentalHandover in the database.
+func (_c *RentalHandoverCreate) Save(ctx context.Context) (*RentalHandover, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RentalHandoverCreate) SaveX(ctx context.Context) *RentalHandover {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RentalHandoverCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RentalHandoverCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RentalHandoverCreate) defaults() {
+	if _, ok := _c.mutation.DistanceKm(); !ok {
+		v := rentalhandover.DefaultDistanceKm
+		_c.mutation.SetDistanceKm(v)
+	}
+	if _, ok := _c.mutation.FuelShortfall(); !ok {
+		v := rentalhandover.DefaultFuelShortfall
+		_c.mutation.SetFuelShortfall(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RentalHandoverCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`entgen: missing required field "RentalHandover.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := rentalhandover.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RentalID(); !ok {
+		return &ValidationError{Name: "rental_id", err: errors.New(`entgen: missing required field "RentalHandover.rental_id"`)}
+	}
+	if v, ok := _c.mutation.RentalID(); ok {
+		if err := rentalhandover.RentalIDValidator(v); err != nil {
+			return &ValidationError{Name: "rental_id", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.rental_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`entgen: missing required field "RentalHandover.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := rentalhandover.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OdometerKm(); !ok {
+		return &ValidationError{Name: "odometer_km", err: errors.New(`entgen: missing required field "RentalHandover.odometer_km"`)}
+	}
+	if v, ok := _c.mutation.OdometerKm(); ok {
+		if err := rentalhandover.OdometerKmValidator(v); err != nil {
+			return &ValidationError{Name: "odometer_km", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.odometer_km": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FuelLevel(); !ok {
+		return &ValidationError{Name: "fuel_level", err: errors.New(`entgen: missing required field "RentalHandover.fuel_level"`)}
+	}
+	if v, ok := _c.mutation.FuelLevel(); ok {
+		if err := rentalhandover.FuelLevelValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_level", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.fuel_level": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DistanceKm(); !ok {
+		return &ValidationError{Name: "distance_km", err: errors.New(`entgen: missing required field "RentalHandover.distance_km"`)}
+	}
+	if v, ok := _c.mutation.DistanceKm(); ok {
+		if err := rentalhandover.DistanceKmValidator(v); err != nil {
+			return &ValidationError{Name: "distance_km", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.distance_km": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FuelShortfall(); !ok {
+		return &ValidationError{Name: "fuel_shortfall", err: errors.New(`entgen: missing required field "RentalHandover.fuel_shortfall"`)}
+	}
+	if v, ok := _c.mutation.FuelShortfall(); ok {
+		if err := rentalhandover.FuelShortfallValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_shortfall", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.fuel_shortfall": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InspectedAt(); !ok {
+		return &ValidationError{Name: "inspected_at", err: errors.New(`entgen: missing required field "RentalHandover.inspected_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := rentalhandover.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`entgen: missing required edge "RentalHandover.tenant"`)}
+	}
+	if len(_c.mutation.RentalIDs()) == 0 {
+		return &ValidationError{Name: "rental", err: errors.New(`entgen: missing required edge "RentalHandover.rental"`)}
+	}
+	return nil
+}
+
+func (_c *RentalHandoverCreate) sqlSave(ctx context.Context) (*RentalHandover, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RentalHandover.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RentalHandoverCreate) createSpec() (*RentalHandover, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RentalHandover{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rentalhandover.Table, sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(rentalhandover.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.OdometerKm(); ok {
+		_spec.SetField(rentalhandover.FieldOdometerKm, field.TypeInt, value)
+		_node.OdometerKm = value
+	}
+	if value, ok := _c.mutation.FuelLevel(); ok {
+		_spec.SetField(rentalhandover.FieldFuelLevel, field.TypeInt, value)
+		_node.FuelLevel = value
+	}
+	if value, ok := _c.mutation.Damages(); ok {
+		_spec.SetField(rentalhandover.FieldDamages, field.TypeJSON, value)
+		_node.Damages = value
+	}
+	if value, ok := _c.mutation.DistanceKm(); ok {
+		_spec.SetField(rentalhandover.FieldDistanceKm, field.TypeInt, value)
+		_node.DistanceKm = value
+	}
+	if value, ok := _c.mutation.FuelShortfall(); ok {
+		_spec.SetField(rentalhandover.FieldFuelShortfall, field.TypeInt, value)
+		_node.FuelShortfall = value
+	}
+	if value, ok := _c.mutation.InspectedAt(); ok {
+		_spec.SetField(rentalhandover.FieldInspectedAt, field.TypeTime, value)
+		_node.InspectedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rentalhandover.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.TenantTable,
+			Columns: []string{rentalhandover.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RentalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.RentalTable,
+			Columns: []string{rentalhandover.RentalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RentalID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RentalHandoverCreateBulk is the builder for creating many RentalHandover entities in bulk.
+type RentalHandoverCreateBulk struct {
+	config
+	err      error
+	builders []*RentalHandoverCreate
+}
+
+// Save creates the RentalHandover entities in the database.
+func (_c *RentalHandoverCreateBulk) Save(ctx context.Context) ([]*RentalHandover, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RentalHandover, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RentalHandoverMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RentalHandoverCreateBulk) SaveX(ctx context.Context) []*RentalHandover {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RentalHandoverCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RentalHandoverCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,888 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+)
+
+// RentalHandoverUpdate is the builder for updating RentalHandover entities.
+type RentalHandoverUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RentalHandoverMutation
+}
+
+// Where appends a list predicates to the RentalHandoverUpdate builder.
+func (_u *RentalHandoverUpdate) Where(ps ...predicate.RentalHandover) *RentalHandoverUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *RentalHandoverUpdate) SetTenantID(v string) *RentalHandoverUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableTenantID(v *string) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetRentalID sets the "rental_id" field.
+func (_u *RentalHandoverUpdate) SetRentalID(v string) *RentalHandoverUpdate {
+	_u.mutation.SetRentalID(v)
+	return _u
+}
+
+// SetNillableRentalID sets the "rental_id" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableRentalID(v *string) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetRentalID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *RentalHandoverUpdate) SetKind(v string) *RentalHandoverUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableKind(v *string) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetOdometerKm sets the "odometer_km" field.
+func (_u *RentalHandoverUpdate) SetOdometerKm(v int) *RentalHandoverUpdate {
+	_u.mutation.ResetOdometerKm()
+	_u.mutation.SetOdometerKm(v)
+	return _u
+}
+
+// SetNillableOdometerKm sets the "odometer_km" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableOdometerKm(v *int) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetOdometerKm(*v)
+	}
+	return _u
+}
+
+// AddOdometerKm adds value to the "odometer_km" field.
+func (_u *RentalHandoverUpdate) AddOdometerKm(v int) *RentalHandoverUpdate {
+	_u.mutation.AddOdometerKm(v)
+	return _u
+}
+
+// SetFuelLevel sets the "fuel_level" field.
+func (_u *RentalHandoverUpdate) SetFuelLevel(v int) *RentalHandoverUpdate {
+	_u.mutation.ResetFuelLevel()
+	_u.mutation.SetFuelLevel(v)
+	return _u
+}
+
+// SetNillableFuelLevel sets the "fuel_level" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableFuelLevel(v *int) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetFuelLevel(*v)
+	}
+	return _u
+}
+
+// AddFuelLevel adds value to the "fuel_level" field.
+func (_u *RentalHandoverUpdate) AddFuelLevel(v int) *RentalHandoverUpdate {
+	_u.mutation.AddFuelLevel(v)
+	return _u
+}
+
+// SetDamages sets the "damages" field.
+func (_u *RentalHandoverUpdate) SetDamages(v []schema.HandoverDamage) *RentalHandoverUpdate {
+	_u.mutation.SetDamages(v)
+	return _u
+}
+
+// AppendDamages appends value to the "damages" field.
+func (_u *RentalHandoverUpdate) AppendDamages(v []schema.HandoverDamage) *RentalHandoverUpdate {
+	_u.mutation.AppendDamages(v)
+	return _u
+}
+
+// ClearDamages clears the value of the "damages" field.
+func (_u *RentalHandoverUpdate) ClearDamages() *RentalHandoverUpdate {
+	_u.mutation.ClearDamages()
+	return _u
+}
+
+// SetDistanceKm sets the "distance_km" field.
+func (_u *RentalHandoverUpdate) SetDistanceKm(v int) *RentalHandoverUpdate {
+	_u.mutation.ResetDistanceKm()
+	_u.mutation.SetDistanceKm(v)
+	return _u
+}
+
+// SetNillableDistanceKm sets the "distance_km" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableDistanceKm(v *int) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetDistanceKm(*v)
+	}
+	return _u
+}
+
+// AddDistanceKm adds value to the "distance_km" field.
+func (_u *RentalHandoverUpdate) AddDistanceKm(v int) *RentalHandoverUpdate {
+	_u.mutation.AddDistanceKm(v)
+	return _u
+}
+
+// SetFuelShortfall sets the "fuel_shortfall" field.
+func (_u *RentalHandoverUpdate) SetFuelShortfall(v int) *RentalHandoverUpdate {
+	_u.mutation.ResetFuelShortfall()
+	_u.mutation.SetFuelShortfall(v)
+	return _u
+}
+
+// SetNillableFuelShortfall sets the "fuel_shortfall" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableFuelShortfall(v *int) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetFuelShortfall(*v)
+	}
+	return _u
+}
+
+// AddFuelShortfall adds value to the "fuel_shortfall" field.
+func (_u *RentalHandoverUpdate) AddFuelShortfall(v int) *RentalHandoverUpdate {
+	_u.mutation.AddFuelShortfall(v)
+	return _u
+}
+
+// SetInspectedAt sets the "inspected_at" field.
+func (_u *RentalHandoverUpdate) SetInspectedAt(v time.Time) *RentalHandoverUpdate {
+	_u.mutation.SetInspectedAt(v)
+	return _u
+}
+
+// SetNillableInspectedAt sets the "inspected_at" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableInspectedAt(v *time.Time) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetInspectedAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RentalHandoverUpdate) SetCreatedAt(v time.Time) *RentalHandoverUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RentalHandoverUpdate) SetNillableCreatedAt(v *time.Time) *RentalHandoverUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *RentalHandoverUpdate) ClearCreatedAt() *RentalHandoverUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *RentalHandoverUpdate) SetTenant(v *Tenant) *RentalHandoverUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetRental sets the "rental" edge to the Rental entity.
+func (_u *RentalHandoverUpdate) SetRental(v *Rental) *RentalHandoverUpdate {
+	return _u.SetRentalID(v.ID)
+}
+
+// Mutation returns the RentalHandoverMutation object of the builder.
+func (_u *RentalHandoverUpdate) Mutation() *RentalHandoverMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *RentalHandoverUpdate) ClearTenant() *RentalHandoverUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearRental clears the "rental" edge to the Rental entity.
+func (_u *RentalHandoverUpdate) ClearRental() *RentalHandoverUpdate {
+	_u.mutation.ClearRental()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RentalHandoverUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RentalHandoverUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RentalHandoverUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RentalHandoverUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RentalHandoverUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := rentalhandover.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RentalID(); ok {
+		if err := rentalhandover.RentalIDValidator(v); err != nil {
+			return &ValidationError{Name: "rental_id", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.rental_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := rentalhandover.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OdometerKm(); ok {
+		if err := rentalhandover.OdometerKmValidator(v); err != nil {
+			return &ValidationError{Name: "odometer_km", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.odometer_km": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FuelLevel(); ok {
+		if err := rentalhandover.FuelLevelValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_level", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.fuel_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DistanceKm(); ok {
+		if err := rentalhandover.DistanceKmValidator(v); err != nil {
+			return &ValidationError{Name: "distance_km", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.distance_km": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FuelShortfall(); ok {
+		if err := rentalhandover.FuelShortfallValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_shortfall", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.fuel_shortfall": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "RentalHandover.tenant"`)
+	}
+	if _u.mutation.RentalCleared() && len(_u.mutation.RentalIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "RentalHandover.rental"`)
+	}
+	return nil
+}
+
+func (_u *RentalHandoverUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rentalhandover.Table, rentalhandover.Columns, sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(rentalhandover.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OdometerKm(); ok {
+		_spec.SetField(rentalhandover.FieldOdometerKm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOdometerKm(); ok {
+		_spec.AddField(rentalhandover.FieldOdometerKm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FuelLevel(); ok {
+		_spec.SetField(rentalhandover.FieldFuelLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFuelLevel(); ok {
+		_spec.AddField(rentalhandover.FieldFuelLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Damages(); ok {
+		_spec.SetField(rentalhandover.FieldDamages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDamages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rentalhandover.FieldDamages, value)
+		})
+	}
+	if _u.mutation.DamagesCleared() {
+		_spec.ClearField(rentalhandover.FieldDamages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DistanceKm(); ok {
+		_spec.SetField(rentalhandover.FieldDistanceKm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDistanceKm(); ok {
+		_spec.AddField(rentalhandover.FieldDistanceKm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FuelShortfall(); ok {
+		_spec.SetField(rentalhandover.FieldFuelShortfall, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFuelShortfall(); ok {
+		_spec.AddField(rentalhandover.FieldFuelShortfall, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InspectedAt(); ok {
+		_spec.SetField(rentalhandover.FieldInspectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(rentalhandover.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(rentalhandover.FieldCreatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.TenantTable,
+			Columns: []string{rentalhandover.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.TenantTable,
+			Columns: []string{rentalhandover.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RentalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.RentalTable,
+			Columns: []string{rentalhandover.RentalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RentalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.RentalTable,
+			Columns: []string{rentalhandover.RentalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rentalhandover.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RentalHandoverUpdateOne is the builder for updating a single RentalHandover entity.
+type RentalHandoverUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RentalHandoverMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *RentalHandoverUpdateOne) SetTenantID(v string) *RentalHandoverUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableTenantID(v *string) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetRentalID sets the "rental_id" field.
+func (_u *RentalHandoverUpdateOne) SetRentalID(v string) *RentalHandoverUpdateOne {
+	_u.mutation.SetRentalID(v)
+	return _u
+}
+
+// SetNillableRentalID sets the "rental_id" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableRentalID(v *string) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetRentalID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *RentalHandoverUpdateOne) SetKind(v string) *RentalHandoverUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableKind(v *string) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetOdometerKm sets the "odometer_km" field.
+func (_u *RentalHandoverUpdateOne) SetOdometerKm(v int) *RentalHandoverUpdateOne {
+	_u.mutation.ResetOdometerKm()
+	_u.mutation.SetOdometerKm(v)
+	return _u
+}
+
+// SetNillableOdometerKm sets the "odometer_km" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableOdometerKm(v *int) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetOdometerKm(*v)
+	}
+	return _u
+}
+
+// AddOdometerKm adds value to the "odometer_km" field.
+func (_u *RentalHandoverUpdateOne) AddOdometerKm(v int) *RentalHandoverUpdateOne {
+	_u.mutation.AddOdometerKm(v)
+	return _u
+}
+
+// SetFuelLevel sets the "fuel_level" field.
+func (_u *RentalHandoverUpdateOne) SetFuelLevel(v int) *RentalHandoverUpdateOne {
+	_u.mutation.ResetFuelLevel()
+	_u.mutation.SetFuelLevel(v)
+	return _u
+}
+
+// SetNillableFuelLevel sets the "fuel_level" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableFuelLevel(v *int) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetFuelLevel(*v)
+	}
+	return _u
+}
+
+// AddFuelLevel adds value to the "fuel_level" field.
+func (_u *RentalHandoverUpdateOne) AddFuelLevel(v int) *RentalHandoverUpdateOne {
+	_u.mutation.AddFuelLevel(v)
+	return _u
+}
+
+// SetDamages sets the "damages" field.
+func (_u *RentalHandoverUpdateOne) SetDamages(v []schema.HandoverDamage) *RentalHandoverUpdateOne {
+	_u.mutation.SetDamages(v)
+	return _u
+}
+
+// AppendDamages appends value to the "damages" field.
+func (_u *RentalHandoverUpdateOne) AppendDamages(v []schema.HandoverDamage) *RentalHandoverUpdateOne {
+	_u.mutation.AppendDamages(v)
+	return _u
+}
+
+// ClearDamages clears the value of the "damages" field.
+func (_u *RentalHandoverUpdateOne) ClearDamages() *RentalHandoverUpdateOne {
+	_u.mutation.ClearDamages()
+	return _u
+}
+
+// SetDistanceKm sets the "distance_km" field.
+func (_u *RentalHandoverUpdateOne) SetDistanceKm(v int) *RentalHandoverUpdateOne {
+	_u.mutation.ResetDistanceKm()
+	_u.mutation.SetDistanceKm(v)
+	return _u
+}
+
+// SetNillableDistanceKm sets the "distance_km" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableDistanceKm(v *int) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetDistanceKm(*v)
+	}
+	return _u
+}
+
+// AddDistanceKm adds value to the "distance_km" field.
+func (_u *RentalHandoverUpdateOne) AddDistanceKm(v int) *RentalHandoverUpdateOne {
+	_u.mutation.AddDistanceKm(v)
+	return _u
+}
+
+// SetFuelShortfall sets the "fuel_shortfall" field.
+func (_u *RentalHandoverUpdateOne) SetFuelShortfall(v int) *RentalHandoverUpdateOne {
+	_u.mutation.ResetFuelShortfall()
+	_u.mutation.SetFuelShortfall(v)
+	return _u
+}
+
+// SetNillableFuelShortfall sets the "fuel_shortfall" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableFuelShortfall(v *int) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetFuelShortfall(*v)
+	}
+	return _u
+}
+
+// AddFuelShortfall adds value to the "fuel_shortfall" field.
+func (_u *RentalHandoverUpdateOne) AddFuelShortfall(v int) *RentalHandoverUpdateOne {
+	_u.mutation.AddFuelShortfall(v)
+	return _u
+}
+
+// SetInspectedAt sets the "inspected_at" field.
+func (_u *RentalHandoverUpdateOne) SetInspectedAt(v time.Time) *RentalHandoverUpdateOne {
+	_u.mutation.SetInspectedAt(v)
+	return _u
+}
+
+// SetNillableInspectedAt sets the "inspected_at" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableInspectedAt(v *time.Time) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetInspectedAt(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *RentalHandoverUpdateOne) SetCreatedAt(v time.Time) *RentalHandoverUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *RentalHandoverUpdateOne) SetNillableCreatedAt(v *time.Time) *RentalHandoverUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *RentalHandoverUpdateOne) ClearCreatedAt() *RentalHandoverUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *RentalHandoverUpdateOne) SetTenant(v *Tenant) *RentalHandoverUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// SetRental sets the "rental" edge to the Rental entity.
+func (_u *RentalHandoverUpdateOne) SetRental(v *Rental) *RentalHandoverUpdateOne {
+	return _u.SetRentalID(v.ID)
+}
+
+// Mutation returns the RentalHandoverMutation object of the builder.
+func (_u *RentalHandoverUpdateOne) Mutation() *RentalHandoverMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *RentalHandoverUpdateOne) ClearTenant() *RentalHandoverUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// ClearRental clears the "rental" edge to the Rental entity.
+func (_u *RentalHandoverUpdateOne) ClearRental() *RentalHandoverUpdateOne {
+	_u.mutation.ClearRental()
+	return _u
+}
+
+// Where appends a list predicates to the RentalHandoverUpdate builder.
+func (_u *RentalHandoverUpdateOne) Where(ps ...predicate.RentalHandover) *RentalHandoverUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RentalHandoverUpdateOne) Select(field string, fields ...string) *RentalHandoverUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RentalHandover entity.
+func (_u *RentalHandoverUpdateOne) Save(ctx context.Context) (*RentalHandover, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RentalHandoverUpdateOne) SaveX(ctx context.Context) *RentalHandover {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RentalHandoverUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RentalHandoverUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RentalHandoverUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := rentalhandover.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RentalID(); ok {
+		if err := rentalhandover.RentalIDValidator(v); err != nil {
+			return &ValidationError{Name: "rental_id", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.rental_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := rentalhandover.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OdometerKm(); ok {
+		if err := rentalhandover.OdometerKmValidator(v); err != nil {
+			return &ValidationError{Name: "odometer_km", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.odometer_km": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FuelLevel(); ok {
+		if err := rentalhandover.FuelLevelValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_level", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.fuel_level": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DistanceKm(); ok {
+		if err := rentalhandover.DistanceKmValidator(v); err != nil {
+			return &ValidationError{Name: "distance_km", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.distance_km": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FuelShortfall(); ok {
+		if err := rentalhandover.FuelShortfallValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_shortfall", err: fmt.Errorf(`entgen: validator failed for field "RentalHandover.fuel_shortfall": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "RentalHandover.tenant"`)
+	}
+	if _u.mutation.RentalCleared() && len(_u.mutation.RentalIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "RentalHandover.rental"`)
+	}
+	return nil
+}
+
+func (_u *RentalHandoverUpdateOne) sqlSave(ctx context.Context) (_node *RentalHandover, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rentalhandover.Table, rentalhandover.Columns, sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "RentalHandover.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rentalhandover.FieldID)
+		for _, f := range fields {
+			if !rentalhandover.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != rentalhandover.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(rentalhandover.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OdometerKm(); ok {
+		_spec.SetField(rentalhandover.FieldOdometerKm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOdometerKm(); ok {
+		_spec.AddField(rentalhandover.FieldOdometerKm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FuelLevel(); ok {
+		_spec.SetField(rentalhandover.FieldFuelLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFuelLevel(); ok {
+		_spec.AddField(rentalhandover.FieldFuelLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Damages(); ok {
+		_spec.SetField(rentalhandover.FieldDamages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDamages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rentalhandover.FieldDamages, value)
+		})
+	}
+	if _u.mutation.DamagesCleared() {
+		_spec.ClearField(rentalhandover.FieldDamages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DistanceKm(); ok {
+		_spec.SetField(rentalhandover.FieldDistanceKm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDistanceKm(); ok {
+		_spec.AddField(rentalhandover.FieldDistanceKm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FuelShortfall(); ok {
+		_spec.SetField(rentalhandover.FieldFuelShortfall, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFuelShortfall(); ok {
+		_spec.AddField(rentalhandover.FieldFuelShortfall, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InspectedAt(); ok {
+		_spec.SetField(rentalhandover.FieldInspectedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(rentalhandover.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(rentalhandover.FieldCreatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.TenantTable,
+			Columns: []string{rentalhandover.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.TenantTable,
+			Columns: []string{rentalhandover.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RentalCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.RentalTable,
+			Columns: []string{rentalhandover.RentalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RentalIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rentalhandover.RentalTable,
+			Columns: []string{rentalhandover.RentalColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rental.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RentalHandover{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rentalhandover.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
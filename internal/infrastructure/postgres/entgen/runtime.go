@@ -4,21 +4,351 @@ package entgen
 
 import (
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/apikey"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/archivejob"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/caroption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/company"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/inbox"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/individual"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/outbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerecord"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerollup"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookdelivery"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookendpoint"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescTenantID is the schema descriptor for tenant_id field.
+	apikeyDescTenantID := apikeyFields[1].Descriptor()
+	// apikey.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	apikey.TenantIDValidator = func() func(string) error {
+		validators := apikeyDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[3].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = func() func(string) error {
+		validators := apikeyDescPrefix.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(prefix string) error {
+			for _, fn := range fns {
+				if err := fn(prefix); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescSecretHash is the schema descriptor for secret_hash field.
+	apikeyDescSecretHash := apikeyFields[4].Descriptor()
+	// apikey.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	apikey.SecretHashValidator = func() func(string) error {
+		validators := apikeyDescSecretHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secret_hash string) error {
+			for _, fn := range fns {
+				if err := fn(secret_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	apikey.IDValidator = func() func(string) error {
+		validators := apikeyDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	archivejobFields := schema.ArchiveJob{}.Fields()
+	_ = archivejobFields
+	// archivejobDescKind is the schema descriptor for kind field.
+	archivejobDescKind := archivejobFields[1].Descriptor()
+	// archivejob.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	archivejob.KindValidator = func() func(string) error {
+		validators := archivejobDescKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(kind string) error {
+			for _, fn := range fns {
+				if err := fn(kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// archivejobDescTenantID is the schema descriptor for tenant_id field.
+	archivejobDescTenantID := archivejobFields[2].Descriptor()
+	// archivejob.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	archivejob.TenantIDValidator = archivejobDescTenantID.Validators[0].(func(string) error)
+	// archivejobDescArchive is the schema descriptor for archive field.
+	archivejobDescArchive := archivejobFields[3].Descriptor()
+	// archivejob.ArchiveValidator is a validator for the "archive" field. It is called by the builders before save.
+	archivejob.ArchiveValidator = func() func(string) error {
+		validators := archivejobDescArchive.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(archive string) error {
+			for _, fn := range fns {
+				if err := fn(archive); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// archivejobDescStatus is the schema descriptor for status field.
+	archivejobDescStatus := archivejobFields[4].Descriptor()
+	// archivejob.DefaultStatus holds the default value on creation for the status field.
+	archivejob.DefaultStatus = archivejobDescStatus.Default.(string)
+	// archivejob.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	archivejob.StatusValidator = archivejobDescStatus.Validators[0].(func(string) error)
+	// archivejobDescError is the schema descriptor for error field.
+	archivejobDescError := archivejobFields[6].Descriptor()
+	// archivejob.ErrorValidator is a validator for the "error" field. It is called by the builders before save.
+	archivejob.ErrorValidator = archivejobDescError.Validators[0].(func(string) error)
+	// archivejobDescID is the schema descriptor for id field.
+	archivejobDescID := archivejobFields[0].Descriptor()
+	// archivejob.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	archivejob.IDValidator = func() func(string) error {
+		validators := archivejobDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	branchFields := schema.Branch{}.Fields()
+	_ = branchFields
+	// branchDescTenantID is the schema descriptor for tenant_id field.
+	branchDescTenantID := branchFields[1].Descriptor()
+	// branch.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	branch.TenantIDValidator = func() func(string) error {
+		validators := branchDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// branchDescName is the schema descriptor for name field.
+	branchDescName := branchFields[2].Descriptor()
+	// branch.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	branch.NameValidator = func() func(string) error {
+		validators := branchDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// branchDescStreet is the schema descriptor for street field.
+	branchDescStreet := branchFields[3].Descriptor()
+	// branch.StreetValidator is a validator for the "street" field. It is called by the builders before save.
+	branch.StreetValidator = func() func(string) error {
+		validators := branchDescStreet.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(street string) error {
+			for _, fn := range fns {
+				if err := fn(street); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// branchDescCity is the schema descriptor for city field.
+	branchDescCity := branchFields[4].Descriptor()
+	// branch.CityValidator is a validator for the "city" field. It is called by the builders before save.
+	branch.CityValidator = func() func(string) error {
+		validators := branchDescCity.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(city string) error {
+			for _, fn := range fns {
+				if err := fn(city); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// branchDescRegion is the schema descriptor for region field.
+	branchDescRegion := branchFields[5].Descriptor()
+	// branch.RegionValidator is a validator for the "region" field. It is called by the builders before save.
+	branch.RegionValidator = branchDescRegion.Validators[0].(func(string) error)
+	// branchDescPostalCode is the schema descriptor for postal_code field.
+	branchDescPostalCode := branchFields[6].Descriptor()
+	// branch.PostalCodeValidator is a validator for the "postal_code" field. It is called by the builders before save.
+	branch.PostalCodeValidator = branchDescPostalCode.Validators[0].(func(string) error)
+	// branchDescCountry is the schema descriptor for country field.
+	branchDescCountry := branchFields[7].Descriptor()
+	// branch.CountryValidator is a validator for the "country" field. It is called by the builders before save.
+	branch.CountryValidator = func() func(string) error {
+		validators := branchDescCountry.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(country string) error {
+			for _, fn := range fns {
+				if err := fn(country); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// branchDescLatitude is the schema descriptor for latitude field.
+	branchDescLatitude := branchFields[8].Descriptor()
+	// branch.LatitudeValidator is a validator for the "latitude" field. It is called by the builders before save.
+	branch.LatitudeValidator = func() func(float64) error {
+		validators := branchDescLatitude.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(latitude float64) error {
+			for _, fn := range fns {
+				if err := fn(latitude); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// branchDescLongitude is the schema descriptor for longitude field.
+	branchDescLongitude := branchFields[9].Descriptor()
+	// branch.LongitudeValidator is a validator for the "longitude" field. It is called by the builders before save.
+	branch.LongitudeValidator = func() func(float64) error {
+		validators := branchDescLongitude.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(longitude float64) error {
+			for _, fn := range fns {
+				if err := fn(longitude); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// branchDescID is the schema descriptor for id field.
+	branchDescID := branchFields[0].Descriptor()
+	// branch.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	branch.IDValidator = func() func(string) error {
+		validators := branchDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	carFields := schema.Car{}.Fields()
 	_ = carFields
 	// carDescTenantID is the schema descriptor for tenant_id field.
@@ -39,29 +369,133 @@ func init() {
 			return nil
 		}
 	}()
-	// carDescModel is the schema descriptor for model field.
-	carDescModel := carFields[2].Descriptor()
-	// car.ModelValidator is a validator for the "model" field. It is called by the builders before save.
-	car.ModelValidator = func() func(string) error {
-		validators := carDescModel.Validators
+	// carDescCarModelID is the schema descriptor for car_model_id field.
+	carDescCarModelID := carFields[2].Descriptor()
+	// car.CarModelIDValidator is a validator for the "car_model_id" field. It is called by the builders before save.
+	car.CarModelIDValidator = carDescCarModelID.Validators[0].(func(string) error)
+	// carDescVin is the schema descriptor for vin field.
+	carDescVin := carFields[3].Descriptor()
+	// car.VinValidator is a validator for the "vin" field. It is called by the builders before save.
+	car.VinValidator = carDescVin.Validators[0].(func(string) error)
+	// carDescLicensePlate is the schema descriptor for license_plate field.
+	carDescLicensePlate := carFields[4].Descriptor()
+	// car.LicensePlateValidator is a validator for the "license_plate" field. It is called by the builders before save.
+	car.LicensePlateValidator = carDescLicensePlate.Validators[0].(func(string) error)
+	// carDescLicensePlateCountry is the schema descriptor for license_plate_country field.
+	carDescLicensePlateCountry := carFields[5].Descriptor()
+	// car.LicensePlateCountryValidator is a validator for the "license_plate_country" field. It is called by the builders before save.
+	car.LicensePlateCountryValidator = carDescLicensePlateCountry.Validators[0].(func(string) error)
+	// carDescHomeBranchID is the schema descriptor for home_branch_id field.
+	carDescHomeBranchID := carFields[6].Descriptor()
+	// car.HomeBranchIDValidator is a validator for the "home_branch_id" field. It is called by the builders before save.
+	car.HomeBranchIDValidator = carDescHomeBranchID.Validators[0].(func(string) error)
+	// carDescCurrentBranchID is the schema descriptor for current_branch_id field.
+	carDescCurrentBranchID := carFields[7].Descriptor()
+	// car.CurrentBranchIDValidator is a validator for the "current_branch_id" field. It is called by the builders before save.
+	car.CurrentBranchIDValidator = carDescCurrentBranchID.Validators[0].(func(string) error)
+	// carDescID is the schema descriptor for id field.
+	carDescID := carFields[0].Descriptor()
+	// car.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	car.IDValidator = func() func(string) error {
+		validators := carDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(model string) error {
+		return func(id string) error {
 			for _, fn := range fns {
-				if err := fn(model); err != nil {
+				if err := fn(id); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// carDescID is the schema descriptor for id field.
-	carDescID := carFields[0].Descriptor()
-	// car.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	car.IDValidator = func() func(string) error {
-		validators := carDescID.Validators
+	carmodelFields := schema.CarModel{}.Fields()
+	_ = carmodelFields
+	// carmodelDescTenantID is the schema descriptor for tenant_id field.
+	carmodelDescTenantID := carmodelFields[1].Descriptor()
+	// carmodel.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	carmodel.TenantIDValidator = func() func(string) error {
+		validators := carmodelDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// carmodelDescMake is the schema descriptor for make field.
+	carmodelDescMake := carmodelFields[2].Descriptor()
+	// carmodel.MakeValidator is a validator for the "make" field. It is called by the builders before save.
+	carmodel.MakeValidator = func() func(string) error {
+		validators := carmodelDescMake.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(make string) error {
+			for _, fn := range fns {
+				if err := fn(make); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// carmodelDescName is the schema descriptor for name field.
+	carmodelDescName := carmodelFields[3].Descriptor()
+	// carmodel.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	carmodel.NameValidator = func() func(string) error {
+		validators := carmodelDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// carmodelDescCategory is the schema descriptor for category field.
+	carmodelDescCategory := carmodelFields[4].Descriptor()
+	// carmodel.DefaultCategory holds the default value on creation for the category field.
+	carmodel.DefaultCategory = carmodelDescCategory.Default.(string)
+	// carmodel.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	carmodel.CategoryValidator = carmodelDescCategory.Validators[0].(func(string) error)
+	// carmodelDescSeats is the schema descriptor for seats field.
+	carmodelDescSeats := carmodelFields[5].Descriptor()
+	// carmodel.DefaultSeats holds the default value on creation for the seats field.
+	carmodel.DefaultSeats = carmodelDescSeats.Default.(int)
+	// carmodel.SeatsValidator is a validator for the "seats" field. It is called by the builders before save.
+	carmodel.SeatsValidator = carmodelDescSeats.Validators[0].(func(int) error)
+	// carmodelDescTransmission is the schema descriptor for transmission field.
+	carmodelDescTransmission := carmodelFields[6].Descriptor()
+	// carmodel.DefaultTransmission holds the default value on creation for the transmission field.
+	carmodel.DefaultTransmission = carmodelDescTransmission.Default.(string)
+	// carmodel.TransmissionValidator is a validator for the "transmission" field. It is called by the builders before save.
+	carmodel.TransmissionValidator = carmodelDescTransmission.Validators[0].(func(string) error)
+	// carmodelDescFuelType is the schema descriptor for fuel_type field.
+	carmodelDescFuelType := carmodelFields[7].Descriptor()
+	// carmodel.DefaultFuelType holds the default value on creation for the fuel_type field.
+	carmodel.DefaultFuelType = carmodelDescFuelType.Default.(string)
+	// carmodel.FuelTypeValidator is a validator for the "fuel_type" field. It is called by the builders before save.
+	carmodel.FuelTypeValidator = carmodelDescFuelType.Validators[0].(func(string) error)
+	// carmodelDescID is the schema descriptor for id field.
+	carmodelDescID := carmodelFields[0].Descriptor()
+	// carmodel.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	carmodel.IDValidator = func() func(string) error {
+		validators := carmodelDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -131,85 +565,927 @@ func init() {
 			return nil
 		}
 	}()
-	companyFields := schema.Company{}.Fields()
-	_ = companyFields
-	// companyDescRenterID is the schema descriptor for renter_id field.
-	companyDescRenterID := companyFields[1].Descriptor()
-	// company.RenterIDValidator is a validator for the "renter_id" field. It is called by the builders before save.
-	company.RenterIDValidator = func() func(string) error {
-		validators := companyDescRenterID.Validators
+	companyFields := schema.Company{}.Fields()
+	_ = companyFields
+	// companyDescRenterID is the schema descriptor for renter_id field.
+	companyDescRenterID := companyFields[1].Descriptor()
+	// company.RenterIDValidator is a validator for the "renter_id" field. It is called by the builders before save.
+	company.RenterIDValidator = func() func(string) error {
+		validators := companyDescRenterID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(renter string) error {
+			for _, fn := range fns {
+				if err := fn(renter); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// companyDescTenantID is the schema descriptor for tenant_id field.
+	companyDescTenantID := companyFields[2].Descriptor()
+	// company.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	company.TenantIDValidator = func() func(string) error {
+		validators := companyDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// companyDescName is the schema descriptor for name field.
+	companyDescName := companyFields[3].Descriptor()
+	// company.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	company.NameValidator = func() func(string) error {
+		validators := companyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// companyDescCompanySize is the schema descriptor for company_size field.
+	companyDescCompanySize := companyFields[4].Descriptor()
+	// company.CompanySizeValidator is a validator for the "company_size" field. It is called by the builders before save.
+	company.CompanySizeValidator = func() func(string) error {
+		validators := companyDescCompanySize.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(company_size string) error {
+			for _, fn := range fns {
+				if err := fn(company_size); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// companyDescID is the schema descriptor for id field.
+	companyDescID := companyFields[0].Descriptor()
+	// company.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	company.IDValidator = func() func(string) error {
+		validators := companyDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	fleetsharingagreementFields := schema.FleetSharingAgreement{}.Fields()
+	_ = fleetsharingagreementFields
+	// fleetsharingagreementDescLenderTenantID is the schema descriptor for lender_tenant_id field.
+	fleetsharingagreementDescLenderTenantID := fleetsharingagreementFields[1].Descriptor()
+	// fleetsharingagreement.LenderTenantIDValidator is a validator for the "lender_tenant_id" field. It is called by the builders before save.
+	fleetsharingagreement.LenderTenantIDValidator = func() func(string) error {
+		validators := fleetsharingagreementDescLenderTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(lender_tenant_id string) error {
+			for _, fn := range fns {
+				if err := fn(lender_tenant_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fleetsharingagreementDescBorrowerTenantID is the schema descriptor for borrower_tenant_id field.
+	fleetsharingagreementDescBorrowerTenantID := fleetsharingagreementFields[2].Descriptor()
+	// fleetsharingagreement.BorrowerTenantIDValidator is a validator for the "borrower_tenant_id" field. It is called by the builders before save.
+	fleetsharingagreement.BorrowerTenantIDValidator = func() func(string) error {
+		validators := fleetsharingagreementDescBorrowerTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(borrower_tenant_id string) error {
+			for _, fn := range fns {
+				if err := fn(borrower_tenant_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// fleetsharingagreementDescMaxRentalDays is the schema descriptor for max_rental_days field.
+	fleetsharingagreementDescMaxRentalDays := fleetsharingagreementFields[3].Descriptor()
+	// fleetsharingagreement.DefaultMaxRentalDays holds the default value on creation for the max_rental_days field.
+	fleetsharingagreement.DefaultMaxRentalDays = fleetsharingagreementDescMaxRentalDays.Default.(int)
+	// fleetsharingagreementDescOwnerSharePercent is the schema descriptor for owner_share_percent field.
+	fleetsharingagreementDescOwnerSharePercent := fleetsharingagreementFields[4].Descriptor()
+	// fleetsharingagreement.DefaultOwnerSharePercent holds the default value on creation for the owner_share_percent field.
+	fleetsharingagreement.DefaultOwnerSharePercent = fleetsharingagreementDescOwnerSharePercent.Default.(int)
+	// fleetsharingagreementDescID is the schema descriptor for id field.
+	fleetsharingagreementDescID := fleetsharingagreementFields[0].Descriptor()
+	// fleetsharingagreement.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	fleetsharingagreement.IDValidator = func() func(string) error {
+		validators := fleetsharingagreementDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	inboxFields := schema.Inbox{}.Fields()
+	_ = inboxFields
+	// inboxDescSource is the schema descriptor for source field.
+	inboxDescSource := inboxFields[1].Descriptor()
+	// inbox.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	inbox.SourceValidator = func() func(string) error {
+		validators := inboxDescSource.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(source string) error {
+			for _, fn := range fns {
+				if err := fn(source); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// inboxDescMessageID is the schema descriptor for message_id field.
+	inboxDescMessageID := inboxFields[2].Descriptor()
+	// inbox.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	inbox.MessageIDValidator = func() func(string) error {
+		validators := inboxDescMessageID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(message_id string) error {
+			for _, fn := range fns {
+				if err := fn(message_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// inboxDescEventType is the schema descriptor for event_type field.
+	inboxDescEventType := inboxFields[3].Descriptor()
+	// inbox.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	inbox.EventTypeValidator = func() func(string) error {
+		validators := inboxDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// inboxDescID is the schema descriptor for id field.
+	inboxDescID := inboxFields[0].Descriptor()
+	// inbox.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	inbox.IDValidator = func() func(string) error {
+		validators := inboxDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	individualFields := schema.Individual{}.Fields()
+	_ = individualFields
+	// individualDescRenterID is the schema descriptor for renter_id field.
+	individualDescRenterID := individualFields[1].Descriptor()
+	// individual.RenterIDValidator is a validator for the "renter_id" field. It is called by the builders before save.
+	individual.RenterIDValidator = func() func(string) error {
+		validators := individualDescRenterID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(renter string) error {
+			for _, fn := range fns {
+				if err := fn(renter); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// individualDescTenantID is the schema descriptor for tenant_id field.
+	individualDescTenantID := individualFields[2].Descriptor()
+	// individual.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	individual.TenantIDValidator = func() func(string) error {
+		validators := individualDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// individualDescEmail is the schema descriptor for email field.
+	individualDescEmail := individualFields[3].Descriptor()
+	// individual.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	individual.EmailValidator = func() func(string) error {
+		validators := individualDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// individualDescFirstName is the schema descriptor for first_name field.
+	individualDescFirstName := individualFields[4].Descriptor()
+	// individual.FirstNameValidator is a validator for the "first_name" field. It is called by the builders before save.
+	individual.FirstNameValidator = individualDescFirstName.Validators[0].(func(string) error)
+	// individualDescLastName is the schema descriptor for last_name field.
+	individualDescLastName := individualFields[5].Descriptor()
+	// individual.LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
+	individual.LastNameValidator = individualDescLastName.Validators[0].(func(string) error)
+	// individualDescID is the schema descriptor for id field.
+	individualDescID := individualFields[0].Descriptor()
+	// individual.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	individual.IDValidator = func() func(string) error {
+		validators := individualDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	maintenancewindowFields := schema.MaintenanceWindow{}.Fields()
+	_ = maintenancewindowFields
+	// maintenancewindowDescTenantID is the schema descriptor for tenant_id field.
+	maintenancewindowDescTenantID := maintenancewindowFields[1].Descriptor()
+	// maintenancewindow.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	maintenancewindow.TenantIDValidator = func() func(string) error {
+		validators := maintenancewindowDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// maintenancewindowDescCarID is the schema descriptor for car_id field.
+	maintenancewindowDescCarID := maintenancewindowFields[2].Descriptor()
+	// maintenancewindow.CarIDValidator is a validator for the "car_id" field. It is called by the builders before save.
+	maintenancewindow.CarIDValidator = func() func(string) error {
+		validators := maintenancewindowDescCarID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(car string) error {
+			for _, fn := range fns {
+				if err := fn(car); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// maintenancewindowDescType is the schema descriptor for type field.
+	maintenancewindowDescType := maintenancewindowFields[3].Descriptor()
+	// maintenancewindow.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	maintenancewindow.TypeValidator = func() func(string) error {
+		validators := maintenancewindowDescType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(_type string) error {
+			for _, fn := range fns {
+				if err := fn(_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// maintenancewindowDescReason is the schema descriptor for reason field.
+	maintenancewindowDescReason := maintenancewindowFields[4].Descriptor()
+	// maintenancewindow.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	maintenancewindow.ReasonValidator = maintenancewindowDescReason.Validators[0].(func(string) error)
+	// maintenancewindowDescStatus is the schema descriptor for status field.
+	maintenancewindowDescStatus := maintenancewindowFields[7].Descriptor()
+	// maintenancewindow.DefaultStatus holds the default value on creation for the status field.
+	maintenancewindow.DefaultStatus = maintenancewindowDescStatus.Default.(string)
+	// maintenancewindow.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	maintenancewindow.StatusValidator = maintenancewindowDescStatus.Validators[0].(func(string) error)
+	// maintenancewindowDescID is the schema descriptor for id field.
+	maintenancewindowDescID := maintenancewindowFields[0].Descriptor()
+	// maintenancewindow.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	maintenancewindow.IDValidator = func() func(string) error {
+		validators := maintenancewindowDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	outboxFields := schema.Outbox{}.Fields()
+	_ = outboxFields
+	// outboxDescTenantID is the schema descriptor for tenant_id field.
+	outboxDescTenantID := outboxFields[1].Descriptor()
+	// outbox.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	outbox.TenantIDValidator = outboxDescTenantID.Validators[0].(func(string) error)
+	// outboxDescAggregateType is the schema descriptor for aggregate_type field.
+	outboxDescAggregateType := outboxFields[2].Descriptor()
+	// outbox.AggregateTypeValidator is a validator for the "aggregate_type" field. It is called by the builders before save.
+	outbox.AggregateTypeValidator = func() func(string) error {
+		validators := outboxDescAggregateType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(aggregate_type string) error {
+			for _, fn := range fns {
+				if err := fn(aggregate_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// outboxDescAggregateID is the schema descriptor for aggregate_id field.
+	outboxDescAggregateID := outboxFields[3].Descriptor()
+	// outbox.AggregateIDValidator is a validator for the "aggregate_id" field. It is called by the builders before save.
+	outbox.AggregateIDValidator = func() func(string) error {
+		validators := outboxDescAggregateID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(aggregate_id string) error {
+			for _, fn := range fns {
+				if err := fn(aggregate_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// outboxDescEventType is the schema descriptor for event_type field.
+	outboxDescEventType := outboxFields[4].Descriptor()
+	// outbox.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	outbox.EventTypeValidator = func() func(string) error {
+		validators := outboxDescEventType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(event_type string) error {
+			for _, fn := range fns {
+				if err := fn(event_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// outboxDescStatus is the schema descriptor for status field.
+	outboxDescStatus := outboxFields[8].Descriptor()
+	// outbox.DefaultStatus holds the default value on creation for the status field.
+	outbox.DefaultStatus = outboxDescStatus.Default.(string)
+	// outbox.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	outbox.StatusValidator = outboxDescStatus.Validators[0].(func(string) error)
+	// outboxDescErrorMessage is the schema descriptor for error_message field.
+	outboxDescErrorMessage := outboxFields[9].Descriptor()
+	// outbox.ErrorMessageValidator is a validator for the "error_message" field. It is called by the builders before save.
+	outbox.ErrorMessageValidator = outboxDescErrorMessage.Validators[0].(func(string) error)
+	// outboxDescAttempts is the schema descriptor for attempts field.
+	outboxDescAttempts := outboxFields[10].Descriptor()
+	// outbox.DefaultAttempts holds the default value on creation for the attempts field.
+	outbox.DefaultAttempts = outboxDescAttempts.Default.(int)
+	// outboxDescVersion is the schema descriptor for version field.
+	outboxDescVersion := outboxFields[12].Descriptor()
+	// outbox.DefaultVersion holds the default value on creation for the version field.
+	outbox.DefaultVersion = outboxDescVersion.Default.(int64)
+	// outboxDescID is the schema descriptor for id field.
+	outboxDescID := outboxFields[0].Descriptor()
+	// outbox.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	outbox.IDValidator = func() func(string) error {
+		validators := outboxDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	planFields := schema.Plan{}.Fields()
+	_ = planFields
+	// planDescCode is the schema descriptor for code field.
+	planDescCode := planFields[1].Descriptor()
+	// plan.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	plan.CodeValidator = func() func(string) error {
+		validators := planDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// planDescName is the schema descriptor for name field.
+	planDescName := planFields[2].Descriptor()
+	// plan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	plan.NameValidator = func() func(string) error {
+		validators := planDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// planDescMaxCars is the schema descriptor for max_cars field.
+	planDescMaxCars := planFields[3].Descriptor()
+	// plan.DefaultMaxCars holds the default value on creation for the max_cars field.
+	plan.DefaultMaxCars = planDescMaxCars.Default.(int)
+	// plan.MaxCarsValidator is a validator for the "max_cars" field. It is called by the builders before save.
+	plan.MaxCarsValidator = planDescMaxCars.Validators[0].(func(int) error)
+	// planDescMaxRenters is the schema descriptor for max_renters field.
+	planDescMaxRenters := planFields[4].Descriptor()
+	// plan.DefaultMaxRenters holds the default value on creation for the max_renters field.
+	plan.DefaultMaxRenters = planDescMaxRenters.Default.(int)
+	// plan.MaxRentersValidator is a validator for the "max_renters" field. It is called by the builders before save.
+	plan.MaxRentersValidator = planDescMaxRenters.Validators[0].(func(int) error)
+	// planDescMaxMonthlyRentals is the schema descriptor for max_monthly_rentals field.
+	planDescMaxMonthlyRentals := planFields[5].Descriptor()
+	// plan.DefaultMaxMonthlyRentals holds the default value on creation for the max_monthly_rentals field.
+	plan.DefaultMaxMonthlyRentals = planDescMaxMonthlyRentals.Default.(int)
+	// plan.MaxMonthlyRentalsValidator is a validator for the "max_monthly_rentals" field. It is called by the builders before save.
+	plan.MaxMonthlyRentalsValidator = planDescMaxMonthlyRentals.Validators[0].(func(int) error)
+	// planDescID is the schema descriptor for id field.
+	planDescID := planFields[0].Descriptor()
+	// plan.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	plan.IDValidator = func() func(string) error {
+		validators := planDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	rentalFields := schema.Rental{}.Fields()
+	_ = rentalFields
+	// rentalDescTenantID is the schema descriptor for tenant_id field.
+	rentalDescTenantID := rentalFields[1].Descriptor()
+	// rental.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	rental.TenantIDValidator = func() func(string) error {
+		validators := rentalDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentalDescCarID is the schema descriptor for car_id field.
+	rentalDescCarID := rentalFields[2].Descriptor()
+	// rental.CarIDValidator is a validator for the "car_id" field. It is called by the builders before save.
+	rental.CarIDValidator = func() func(string) error {
+		validators := rentalDescCarID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(car string) error {
+			for _, fn := range fns {
+				if err := fn(car); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentalDescRenterID is the schema descriptor for renter_id field.
+	rentalDescRenterID := rentalFields[3].Descriptor()
+	// rental.RenterIDValidator is a validator for the "renter_id" field. It is called by the builders before save.
+	rental.RenterIDValidator = func() func(string) error {
+		validators := rentalDescRenterID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(renter string) error {
+			for _, fn := range fns {
+				if err := fn(renter); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentalDescOwnerTenantID is the schema descriptor for owner_tenant_id field.
+	rentalDescOwnerTenantID := rentalFields[4].Descriptor()
+	// rental.OwnerTenantIDValidator is a validator for the "owner_tenant_id" field. It is called by the builders before save.
+	rental.OwnerTenantIDValidator = rentalDescOwnerTenantID.Validators[0].(func(string) error)
+	// rentalDescPickupBranchID is the schema descriptor for pickup_branch_id field.
+	rentalDescPickupBranchID := rentalFields[5].Descriptor()
+	// rental.PickupBranchIDValidator is a validator for the "pickup_branch_id" field. It is called by the builders before save.
+	rental.PickupBranchIDValidator = rentalDescPickupBranchID.Validators[0].(func(string) error)
+	// rentalDescReturnBranchID is the schema descriptor for return_branch_id field.
+	rentalDescReturnBranchID := rentalFields[6].Descriptor()
+	// rental.ReturnBranchIDValidator is a validator for the "return_branch_id" field. It is called by the builders before save.
+	rental.ReturnBranchIDValidator = rentalDescReturnBranchID.Validators[0].(func(string) error)
+	// rentalDescID is the schema descriptor for id field.
+	rentalDescID := rentalFields[0].Descriptor()
+	// rental.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	rental.IDValidator = func() func(string) error {
+		validators := rentalDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	rentalhandoverFields := schema.RentalHandover{}.Fields()
+	_ = rentalhandoverFields
+	// rentalhandoverDescTenantID is the schema descriptor for tenant_id field.
+	rentalhandoverDescTenantID := rentalhandoverFields[1].Descriptor()
+	// rentalhandover.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	rentalhandover.TenantIDValidator = func() func(string) error {
+		validators := rentalhandoverDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentalhandoverDescRentalID is the schema descriptor for rental_id field.
+	rentalhandoverDescRentalID := rentalhandoverFields[2].Descriptor()
+	// rentalhandover.RentalIDValidator is a validator for the "rental_id" field. It is called by the builders before save.
+	rentalhandover.RentalIDValidator = func() func(string) error {
+		validators := rentalhandoverDescRentalID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(rental string) error {
+			for _, fn := range fns {
+				if err := fn(rental); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentalhandoverDescKind is the schema descriptor for kind field.
+	rentalhandoverDescKind := rentalhandoverFields[3].Descriptor()
+	// rentalhandover.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	rentalhandover.KindValidator = func() func(string) error {
+		validators := rentalhandoverDescKind.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(kind string) error {
+			for _, fn := range fns {
+				if err := fn(kind); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentalhandoverDescOdometerKm is the schema descriptor for odometer_km field.
+	rentalhandoverDescOdometerKm := rentalhandoverFields[4].Descriptor()
+	// rentalhandover.OdometerKmValidator is a validator for the "odometer_km" field. It is called by the builders before save.
+	rentalhandover.OdometerKmValidator = rentalhandoverDescOdometerKm.Validators[0].(func(int) error)
+	// rentalhandoverDescFuelLevel is the schema descriptor for fuel_level field.
+	rentalhandoverDescFuelLevel := rentalhandoverFields[5].Descriptor()
+	// rentalhandover.FuelLevelValidator is a validator for the "fuel_level" field. It is called by the builders before save.
+	rentalhandover.FuelLevelValidator = rentalhandoverDescFuelLevel.Validators[0].(func(int) error)
+	// rentalhandoverDescDistanceKm is the schema descriptor for distance_km field.
+	rentalhandoverDescDistanceKm := rentalhandoverFields[7].Descriptor()
+	// rentalhandover.DefaultDistanceKm holds the default value on creation for the distance_km field.
+	rentalhandover.DefaultDistanceKm = rentalhandoverDescDistanceKm.Default.(int)
+	// rentalhandover.DistanceKmValidator is a validator for the "distance_km" field. It is called by the builders before save.
+	rentalhandover.DistanceKmValidator = rentalhandoverDescDistanceKm.Validators[0].(func(int) error)
+	// rentalhandoverDescFuelShortfall is the schema descriptor for fuel_shortfall field.
+	rentalhandoverDescFuelShortfall := rentalhandoverFields[8].Descriptor()
+	// rentalhandover.DefaultFuelShortfall holds the default value on creation for the fuel_shortfall field.
+	rentalhandover.DefaultFuelShortfall = rentalhandoverDescFuelShortfall.Default.(int)
+	// rentalhandover.FuelShortfallValidator is a validator for the "fuel_shortfall" field. It is called by the builders before save.
+	rentalhandover.FuelShortfallValidator = rentalhandoverDescFuelShortfall.Validators[0].(func(int) error)
+	// rentalhandoverDescID is the schema descriptor for id field.
+	rentalhandoverDescID := rentalhandoverFields[0].Descriptor()
+	// rentalhandover.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	rentalhandover.IDValidator = func() func(string) error {
+		validators := rentalhandoverDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	rentaloptionFields := schema.RentalOption{}.Fields()
+	_ = rentaloptionFields
+	// rentaloptionDescTenantID is the schema descriptor for tenant_id field.
+	rentaloptionDescTenantID := rentaloptionFields[1].Descriptor()
+	// rentaloption.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	rentaloption.TenantIDValidator = func() func(string) error {
+		validators := rentaloptionDescTenantID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(tenant string) error {
+			for _, fn := range fns {
+				if err := fn(tenant); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentaloptionDescRentalID is the schema descriptor for rental_id field.
+	rentaloptionDescRentalID := rentaloptionFields[2].Descriptor()
+	// rentaloption.RentalIDValidator is a validator for the "rental_id" field. It is called by the builders before save.
+	rentaloption.RentalIDValidator = func() func(string) error {
+		validators := rentaloptionDescRentalID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(rental string) error {
+			for _, fn := range fns {
+				if err := fn(rental); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentaloptionDescOptionID is the schema descriptor for option_id field.
+	rentaloptionDescOptionID := rentaloptionFields[3].Descriptor()
+	// rentaloption.OptionIDValidator is a validator for the "option_id" field. It is called by the builders before save.
+	rentaloption.OptionIDValidator = func() func(string) error {
+		validators := rentaloptionDescOptionID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(option string) error {
+			for _, fn := range fns {
+				if err := fn(option); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// rentaloptionDescID is the schema descriptor for id field.
+	rentaloptionDescID := rentaloptionFields[0].Descriptor()
+	// rentaloption.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	rentaloption.IDValidator = func() func(string) error {
+		validators := rentaloptionDescID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(id string) error {
+			for _, fn := range fns {
+				if err := fn(id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	renterFields := schema.Renter{}.Fields()
+	_ = renterFields
+	// renterDescTenantID is the schema descriptor for tenant_id field.
+	renterDescTenantID := renterFields[1].Descriptor()
+	// renter.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	renter.TenantIDValidator = func() func(string) error {
+		validators := renterDescTenantID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(renter string) error {
+		return func(tenant string) error {
 			for _, fn := range fns {
-				if err := fn(renter); err != nil {
+				if err := fn(tenant); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// companyDescTenantID is the schema descriptor for tenant_id field.
-	companyDescTenantID := companyFields[2].Descriptor()
-	// company.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
-	company.TenantIDValidator = func() func(string) error {
-		validators := companyDescTenantID.Validators
+	// renterDescType is the schema descriptor for type field.
+	renterDescType := renterFields[2].Descriptor()
+	// renter.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	renter.TypeValidator = func() func(string) error {
+		validators := renterDescType.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(tenant string) error {
+		return func(_type string) error {
 			for _, fn := range fns {
-				if err := fn(tenant); err != nil {
+				if err := fn(_type); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// companyDescName is the schema descriptor for name field.
-	companyDescName := companyFields[3].Descriptor()
-	// company.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	company.NameValidator = func() func(string) error {
-		validators := companyDescName.Validators
+	// renterDescID is the schema descriptor for id field.
+	renterDescID := renterFields[0].Descriptor()
+	// renter.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	renter.IDValidator = func() func(string) error {
+		validators := renterDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(name string) error {
+		return func(id string) error {
 			for _, fn := range fns {
-				if err := fn(name); err != nil {
+				if err := fn(id); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// companyDescCompanySize is the schema descriptor for company_size field.
-	companyDescCompanySize := companyFields[4].Descriptor()
-	// company.CompanySizeValidator is a validator for the "company_size" field. It is called by the builders before save.
-	company.CompanySizeValidator = func() func(string) error {
-		validators := companyDescCompanySize.Validators
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescCode is the schema descriptor for code field.
+	tenantDescCode := tenantFields[1].Descriptor()
+	// tenant.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	tenant.CodeValidator = func() func(string) error {
+		validators := tenantDescCode.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(company_size string) error {
+		return func(code string) error {
 			for _, fn := range fns {
-				if err := fn(company_size); err != nil {
+				if err := fn(code); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// companyDescID is the schema descriptor for id field.
-	companyDescID := companyFields[0].Descriptor()
-	// company.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	company.IDValidator = func() func(string) error {
-		validators := companyDescID.Validators
+	// tenantDescStatus is the schema descriptor for status field.
+	tenantDescStatus := tenantFields[2].Descriptor()
+	// tenant.DefaultStatus holds the default value on creation for the status field.
+	tenant.DefaultStatus = tenantDescStatus.Default.(string)
+	// tenant.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	tenant.StatusValidator = tenantDescStatus.Validators[0].(func(string) error)
+	// tenantDescPlanID is the schema descriptor for plan_id field.
+	tenantDescPlanID := tenantFields[4].Descriptor()
+	// tenant.PlanIDValidator is a validator for the "plan_id" field. It is called by the builders before save.
+	tenant.PlanIDValidator = tenantDescPlanID.Validators[0].(func(string) error)
+	// tenantDescIsolation is the schema descriptor for isolation field.
+	tenantDescIsolation := tenantFields[5].Descriptor()
+	// tenant.DefaultIsolation holds the default value on creation for the isolation field.
+	tenant.DefaultIsolation = tenantDescIsolation.Default.(string)
+	// tenant.IsolationValidator is a validator for the "isolation" field. It is called by the builders before save.
+	tenant.IsolationValidator = tenantDescIsolation.Validators[0].(func(string) error)
+	// tenantDescParentID is the schema descriptor for parent_id field.
+	tenantDescParentID := tenantFields[6].Descriptor()
+	// tenant.ParentIDValidator is a validator for the "parent_id" field. It is called by the builders before save.
+	tenant.ParentIDValidator = tenantDescParentID.Validators[0].(func(string) error)
+	// tenantDescID is the schema descriptor for id field.
+	tenantDescID := tenantFields[0].Descriptor()
+	// tenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenant.IDValidator = func() func(string) error {
+		validators := tenantDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -223,163 +1499,141 @@ func init() {
 			return nil
 		}
 	}()
-	individualFields := schema.Individual{}.Fields()
-	_ = individualFields
-	// individualDescRenterID is the schema descriptor for renter_id field.
-	individualDescRenterID := individualFields[1].Descriptor()
-	// individual.RenterIDValidator is a validator for the "renter_id" field. It is called by the builders before save.
-	individual.RenterIDValidator = func() func(string) error {
-		validators := individualDescRenterID.Validators
+	tenantsettingFields := schema.TenantSetting{}.Fields()
+	_ = tenantsettingFields
+	// tenantsettingDescTenantID is the schema descriptor for tenant_id field.
+	tenantsettingDescTenantID := tenantsettingFields[1].Descriptor()
+	// tenantsetting.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	tenantsetting.TenantIDValidator = func() func(string) error {
+		validators := tenantsettingDescTenantID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(renter string) error {
+		return func(tenant string) error {
 			for _, fn := range fns {
-				if err := fn(renter); err != nil {
+				if err := fn(tenant); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// individualDescTenantID is the schema descriptor for tenant_id field.
-	individualDescTenantID := individualFields[2].Descriptor()
-	// individual.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
-	individual.TenantIDValidator = func() func(string) error {
-		validators := individualDescTenantID.Validators
+	// tenantsettingDescTimezone is the schema descriptor for timezone field.
+	tenantsettingDescTimezone := tenantsettingFields[2].Descriptor()
+	// tenantsetting.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	tenantsetting.TimezoneValidator = func() func(string) error {
+		validators := tenantsettingDescTimezone.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(tenant string) error {
+		return func(timezone string) error {
 			for _, fn := range fns {
-				if err := fn(tenant); err != nil {
+				if err := fn(timezone); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// individualDescEmail is the schema descriptor for email field.
-	individualDescEmail := individualFields[3].Descriptor()
-	// individual.EmailValidator is a validator for the "email" field. It is called by the builders before save.
-	individual.EmailValidator = func() func(string) error {
-		validators := individualDescEmail.Validators
+	// tenantsettingDescCurrency is the schema descriptor for currency field.
+	tenantsettingDescCurrency := tenantsettingFields[3].Descriptor()
+	// tenantsetting.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	tenantsetting.CurrencyValidator = func() func(string) error {
+		validators := tenantsettingDescCurrency.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(email string) error {
+		return func(currency string) error {
 			for _, fn := range fns {
-				if err := fn(email); err != nil {
+				if err := fn(currency); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// individualDescFirstName is the schema descriptor for first_name field.
-	individualDescFirstName := individualFields[4].Descriptor()
-	// individual.FirstNameValidator is a validator for the "first_name" field. It is called by the builders before save.
-	individual.FirstNameValidator = individualDescFirstName.Validators[0].(func(string) error)
-	// individualDescLastName is the schema descriptor for last_name field.
-	individualDescLastName := individualFields[5].Descriptor()
-	// individual.LastNameValidator is a validator for the "last_name" field. It is called by the builders before save.
-	individual.LastNameValidator = individualDescLastName.Validators[0].(func(string) error)
-	// individualDescID is the schema descriptor for id field.
-	individualDescID := individualFields[0].Descriptor()
-	// individual.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	individual.IDValidator = func() func(string) error {
-		validators := individualDescID.Validators
+	// tenantsettingDescLocale is the schema descriptor for locale field.
+	tenantsettingDescLocale := tenantsettingFields[4].Descriptor()
+	// tenantsetting.LocaleValidator is a validator for the "locale" field. It is called by the builders before save.
+	tenantsetting.LocaleValidator = func() func(string) error {
+		validators := tenantsettingDescLocale.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(id string) error {
+		return func(locale string) error {
 			for _, fn := range fns {
-				if err := fn(id); err != nil {
+				if err := fn(locale); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	outboxFields := schema.Outbox{}.Fields()
-	_ = outboxFields
-	// outboxDescAggregateType is the schema descriptor for aggregate_type field.
-	outboxDescAggregateType := outboxFields[1].Descriptor()
-	// outbox.AggregateTypeValidator is a validator for the "aggregate_type" field. It is called by the builders before save.
-	outbox.AggregateTypeValidator = func() func(string) error {
-		validators := outboxDescAggregateType.Validators
+	// tenantsettingDescID is the schema descriptor for id field.
+	tenantsettingDescID := tenantsettingFields[0].Descriptor()
+	// tenantsetting.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantsetting.IDValidator = func() func(string) error {
+		validators := tenantsettingDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(aggregate_type string) error {
+		return func(id string) error {
 			for _, fn := range fns {
-				if err := fn(aggregate_type); err != nil {
+				if err := fn(id); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// outboxDescAggregateID is the schema descriptor for aggregate_id field.
-	outboxDescAggregateID := outboxFields[2].Descriptor()
-	// outbox.AggregateIDValidator is a validator for the "aggregate_id" field. It is called by the builders before save.
-	outbox.AggregateIDValidator = func() func(string) error {
-		validators := outboxDescAggregateID.Validators
+	usagerecordFields := schema.UsageRecord{}.Fields()
+	_ = usagerecordFields
+	// usagerecordDescTenantID is the schema descriptor for tenant_id field.
+	usagerecordDescTenantID := usagerecordFields[1].Descriptor()
+	// usagerecord.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	usagerecord.TenantIDValidator = func() func(string) error {
+		validators := usagerecordDescTenantID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(aggregate_id string) error {
+		return func(tenant_id string) error {
 			for _, fn := range fns {
-				if err := fn(aggregate_id); err != nil {
+				if err := fn(tenant_id); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// outboxDescEventType is the schema descriptor for event_type field.
-	outboxDescEventType := outboxFields[3].Descriptor()
-	// outbox.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
-	outbox.EventTypeValidator = func() func(string) error {
-		validators := outboxDescEventType.Validators
+	// usagerecordDescMeter is the schema descriptor for meter field.
+	usagerecordDescMeter := usagerecordFields[2].Descriptor()
+	// usagerecord.MeterValidator is a validator for the "meter" field. It is called by the builders before save.
+	usagerecord.MeterValidator = func() func(string) error {
+		validators := usagerecordDescMeter.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(event_type string) error {
+		return func(meter string) error {
 			for _, fn := range fns {
-				if err := fn(event_type); err != nil {
+				if err := fn(meter); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// outboxDescStatus is the schema descriptor for status field.
-	outboxDescStatus := outboxFields[7].Descriptor()
-	// outbox.DefaultStatus holds the default value on creation for the status field.
-	outbox.DefaultStatus = outboxDescStatus.Default.(string)
-	// outbox.StatusValidator is a validator for the "status" field. It is called by the builders before save.
-	outbox.StatusValidator = outboxDescStatus.Validators[0].(func(string) error)
-	// outboxDescErrorMessage is the schema descriptor for error_message field.
-	outboxDescErrorMessage := outboxFields[8].Descriptor()
-	// outbox.ErrorMessageValidator is a validator for the "error_message" field. It is called by the builders before save.
-	outbox.ErrorMessageValidator = outboxDescErrorMessage.Validators[0].(func(string) error)
-	// outboxDescVersion is the schema descriptor for version field.
-	outboxDescVersion := outboxFields[9].Descriptor()
-	// outbox.DefaultVersion holds the default value on creation for the version field.
-	outbox.DefaultVersion = outboxDescVersion.Default.(int64)
-	// outboxDescID is the schema descriptor for id field.
-	outboxDescID := outboxFields[0].Descriptor()
-	// outbox.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	outbox.IDValidator = func() func(string) error {
-		validators := outboxDescID.Validators
+	// usagerecordDescID is the schema descriptor for id field.
+	usagerecordDescID := usagerecordFields[0].Descriptor()
+	// usagerecord.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usagerecord.IDValidator = func() func(string) error {
+		validators := usagerecordDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -393,67 +1647,71 @@ func init() {
 			return nil
 		}
 	}()
-	rentalFields := schema.Rental{}.Fields()
-	_ = rentalFields
-	// rentalDescTenantID is the schema descriptor for tenant_id field.
-	rentalDescTenantID := rentalFields[1].Descriptor()
-	// rental.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
-	rental.TenantIDValidator = func() func(string) error {
-		validators := rentalDescTenantID.Validators
+	usagerollupFields := schema.UsageRollup{}.Fields()
+	_ = usagerollupFields
+	// usagerollupDescTenantID is the schema descriptor for tenant_id field.
+	usagerollupDescTenantID := usagerollupFields[1].Descriptor()
+	// usagerollup.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	usagerollup.TenantIDValidator = func() func(string) error {
+		validators := usagerollupDescTenantID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(tenant string) error {
+		return func(tenant_id string) error {
 			for _, fn := range fns {
-				if err := fn(tenant); err != nil {
+				if err := fn(tenant_id); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// rentalDescCarID is the schema descriptor for car_id field.
-	rentalDescCarID := rentalFields[2].Descriptor()
-	// rental.CarIDValidator is a validator for the "car_id" field. It is called by the builders before save.
-	rental.CarIDValidator = func() func(string) error {
-		validators := rentalDescCarID.Validators
+	// usagerollupDescMeter is the schema descriptor for meter field.
+	usagerollupDescMeter := usagerollupFields[2].Descriptor()
+	// usagerollup.MeterValidator is a validator for the "meter" field. It is called by the builders before save.
+	usagerollup.MeterValidator = func() func(string) error {
+		validators := usagerollupDescMeter.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(car string) error {
+		return func(meter string) error {
 			for _, fn := range fns {
-				if err := fn(car); err != nil {
+				if err := fn(meter); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// rentalDescRenterID is the schema descriptor for renter_id field.
-	rentalDescRenterID := rentalFields[3].Descriptor()
-	// rental.RenterIDValidator is a validator for the "renter_id" field. It is called by the builders before save.
-	rental.RenterIDValidator = func() func(string) error {
-		validators := rentalDescRenterID.Validators
+	// usagerollupDescPeriod is the schema descriptor for period field.
+	usagerollupDescPeriod := usagerollupFields[3].Descriptor()
+	// usagerollup.PeriodValidator is a validator for the "period" field. It is called by the builders before save.
+	usagerollup.PeriodValidator = func() func(string) error {
+		validators := usagerollupDescPeriod.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(renter string) error {
+		return func(period string) error {
 			for _, fn := range fns {
-				if err := fn(renter); err != nil {
+				if err := fn(period); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// rentalDescID is the schema descriptor for id field.
-	rentalDescID := rentalFields[0].Descriptor()
-	// rental.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	rental.IDValidator = func() func(string) error {
-		validators := rentalDescID.Validators
+	// usagerollupDescQuantity is the schema descriptor for quantity field.
+	usagerollupDescQuantity := usagerollupFields[5].Descriptor()
+	// usagerollup.DefaultQuantity holds the default value on creation for the quantity field.
+	usagerollup.DefaultQuantity = usagerollupDescQuantity.Default.(int64)
+	// usagerollupDescID is the schema descriptor for id field.
+	usagerollupDescID := usagerollupFields[0].Descriptor()
+	// usagerollup.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usagerollup.IDValidator = func() func(string) error {
+		validators := usagerollupDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -467,13 +1725,13 @@ func init() {
 			return nil
 		}
 	}()
-	rentaloptionFields := schema.RentalOption{}.Fields()
-	_ = rentaloptionFields
-	// rentaloptionDescTenantID is the schema descriptor for tenant_id field.
-	rentaloptionDescTenantID := rentaloptionFields[1].Descriptor()
-	// rentaloption.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
-	rentaloption.TenantIDValidator = func() func(string) error {
-		validators := rentaloptionDescTenantID.Validators
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescTenantID is the schema descriptor for tenant_id field.
+	webhookdeliveryDescTenantID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	webhookdelivery.TenantIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescTenantID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -487,141 +1745,169 @@ func init() {
 			return nil
 		}
 	}()
-	// rentaloptionDescRentalID is the schema descriptor for rental_id field.
-	rentaloptionDescRentalID := rentaloptionFields[2].Descriptor()
-	// rentaloption.RentalIDValidator is a validator for the "rental_id" field. It is called by the builders before save.
-	rentaloption.RentalIDValidator = func() func(string) error {
-		validators := rentaloptionDescRentalID.Validators
+	// webhookdeliveryDescEndpointID is the schema descriptor for endpoint_id field.
+	webhookdeliveryDescEndpointID := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EndpointIDValidator is a validator for the "endpoint_id" field. It is called by the builders before save.
+	webhookdelivery.EndpointIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescEndpointID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(rental string) error {
+		return func(endpoint string) error {
 			for _, fn := range fns {
-				if err := fn(rental); err != nil {
+				if err := fn(endpoint); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// rentaloptionDescOptionID is the schema descriptor for option_id field.
-	rentaloptionDescOptionID := rentaloptionFields[3].Descriptor()
-	// rentaloption.OptionIDValidator is a validator for the "option_id" field. It is called by the builders before save.
-	rentaloption.OptionIDValidator = func() func(string) error {
-		validators := rentaloptionDescOptionID.Validators
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(option string) error {
+		return func(event_id string) error {
 			for _, fn := range fns {
-				if err := fn(option); err != nil {
+				if err := fn(event_id); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// rentaloptionDescID is the schema descriptor for id field.
-	rentaloptionDescID := rentaloptionFields[0].Descriptor()
-	// rentaloption.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	rentaloption.IDValidator = func() func(string) error {
-		validators := rentaloptionDescID.Validators
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = func() func(string) error {
+		validators := webhookdeliveryDescEventType.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(id string) error {
+		return func(event_type string) error {
 			for _, fn := range fns {
-				if err := fn(id); err != nil {
+				if err := fn(event_type); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	renterFields := schema.Renter{}.Fields()
-	_ = renterFields
-	// renterDescTenantID is the schema descriptor for tenant_id field.
-	renterDescTenantID := renterFields[1].Descriptor()
-	// renter.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
-	renter.TenantIDValidator = func() func(string) error {
-		validators := renterDescTenantID.Validators
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdelivery.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	webhookdelivery.StatusValidator = webhookdeliveryDescStatus.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdelivery.LastErrorValidator = webhookdeliveryDescLastError.Validators[0].(func(string) error)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookdelivery.IDValidator = func() func(string) error {
+		validators := webhookdeliveryDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(tenant string) error {
+		return func(id string) error {
 			for _, fn := range fns {
-				if err := fn(tenant); err != nil {
+				if err := fn(id); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// renterDescType is the schema descriptor for type field.
-	renterDescType := renterFields[2].Descriptor()
-	// renter.TypeValidator is a validator for the "type" field. It is called by the builders before save.
-	renter.TypeValidator = func() func(string) error {
-		validators := renterDescType.Validators
+	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
+	_ = webhookendpointFields
+	// webhookendpointDescTenantID is the schema descriptor for tenant_id field.
+	webhookendpointDescTenantID := webhookendpointFields[1].Descriptor()
+	// webhookendpoint.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	webhookendpoint.TenantIDValidator = func() func(string) error {
+		validators := webhookendpointDescTenantID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(_type string) error {
+		return func(tenant string) error {
 			for _, fn := range fns {
-				if err := fn(_type); err != nil {
+				if err := fn(tenant); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// renterDescID is the schema descriptor for id field.
-	renterDescID := renterFields[0].Descriptor()
-	// renter.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	renter.IDValidator = func() func(string) error {
-		validators := renterDescID.Validators
+	// webhookendpointDescURL is the schema descriptor for url field.
+	webhookendpointDescURL := webhookendpointFields[2].Descriptor()
+	// webhookendpoint.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookendpoint.URLValidator = func() func(string) error {
+		validators := webhookendpointDescURL.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(id string) error {
+		return func(url string) error {
 			for _, fn := range fns {
-				if err := fn(id); err != nil {
+				if err := fn(url); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	tenantFields := schema.Tenant{}.Fields()
-	_ = tenantFields
-	// tenantDescCode is the schema descriptor for code field.
-	tenantDescCode := tenantFields[1].Descriptor()
-	// tenant.CodeValidator is a validator for the "code" field. It is called by the builders before save.
-	tenant.CodeValidator = func() func(string) error {
-		validators := tenantDescCode.Validators
+	// webhookendpointDescSecret is the schema descriptor for secret field.
+	webhookendpointDescSecret := webhookendpointFields[3].Descriptor()
+	// webhookendpoint.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhookendpoint.SecretValidator = func() func(string) error {
+		validators := webhookendpointDescSecret.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(code string) error {
+		return func(secret string) error {
 			for _, fn := range fns {
-				if err := fn(code); err != nil {
+				if err := fn(secret); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// tenantDescID is the schema descriptor for id field.
-	tenantDescID := tenantFields[0].Descriptor()
-	// tenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	tenant.IDValidator = func() func(string) error {
-		validators := tenantDescID.Validators
+	// webhookendpointDescDescription is the schema descriptor for description field.
+	webhookendpointDescDescription := webhookendpointFields[5].Descriptor()
+	// webhookendpoint.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	webhookendpoint.DescriptionValidator = webhookendpointDescDescription.Validators[0].(func(string) error)
+	// webhookendpointDescEnabled is the schema descriptor for enabled field.
+	webhookendpointDescEnabled := webhookendpointFields[6].Descriptor()
+	// webhookendpoint.DefaultEnabled holds the default value on creation for the enabled field.
+	webhookendpoint.DefaultEnabled = webhookendpointDescEnabled.Default.(bool)
+	// webhookendpointDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	webhookendpointDescConsecutiveFailures := webhookendpointFields[7].Descriptor()
+	// webhookendpoint.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	webhookendpoint.DefaultConsecutiveFailures = webhookendpointDescConsecutiveFailures.Default.(int)
+	// webhookendpointDescDisabledReason is the schema descriptor for disabled_reason field.
+	webhookendpointDescDisabledReason := webhookendpointFields[9].Descriptor()
+	// webhookendpoint.DisabledReasonValidator is a validator for the "disabled_reason" field. It is called by the builders before save.
+	webhookendpoint.DisabledReasonValidator = webhookendpointDescDisabledReason.Validators[0].(func(string) error)
+	// webhookendpointDescID is the schema descriptor for id field.
+	webhookendpointDescID := webhookendpointFields[0].Descriptor()
+	// webhookendpoint.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	webhookendpoint.IDValidator = func() func(string) error {
+		validators := webhookendpointDescID.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
 )
 
 // Tenant is the model entity for the Tenant schema.
@@ -19,6 +21,18 @@ type Tenant struct {
 	ID string `json:"id,omitempty"`
 	// Code holds the value of the "code" field.
 	Code string `json:"code,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// SuspendedAt holds the value of the "suspended_at" field.
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID *string `json:"plan_id,omitempty"`
+	// Isolation holds the value of the "isolation" field.
+	Isolation string `json:"isolation,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *string `json:"parent_id,omitempty"`
+	// PurgeAt holds the value of the "purge_at" field.
+	PurgeAt *time.Time `json:"purge_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -33,38 +47,118 @@ type Tenant struct {
 
 // TenantEdges holds the relations/edges for other nodes in the graph.
 type TenantEdges struct {
+	// Plan holds the value of the plan edge.
+	Plan *Plan `json:"plan,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Tenant `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Tenant `json:"children,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// Branches holds the value of the branches edge.
+	Branches []*Branch `json:"branches,omitempty"`
 	// Cars holds the value of the cars edge.
 	Cars []*Car `json:"cars,omitempty"`
+	// CarModels holds the value of the car_models edge.
+	CarModels []*CarModel `json:"car_models,omitempty"`
 	// Companies holds the value of the companies edge.
 	Companies []*Company `json:"companies,omitempty"`
 	// Individuals holds the value of the individuals edge.
 	Individuals []*Individual `json:"individuals,omitempty"`
+	// MaintenanceWindows holds the value of the maintenance_windows edge.
+	MaintenanceWindows []*MaintenanceWindow `json:"maintenance_windows,omitempty"`
 	// Options holds the value of the options edge.
 	Options []*CarOption `json:"options,omitempty"`
+	// RentalHandovers holds the value of the rental_handovers edge.
+	RentalHandovers []*RentalHandover `json:"rental_handovers,omitempty"`
 	// RentalOptions holds the value of the rental_options edge.
 	RentalOptions []*RentalOption `json:"rental_options,omitempty"`
 	// Rentals holds the value of the rentals edge.
 	Rentals []*Rental `json:"rentals,omitempty"`
 	// Renters holds the value of the renters edge.
 	Renters []*Renter `json:"renters,omitempty"`
+	// Settings holds the value of the settings edge.
+	Settings *TenantSetting `json:"settings,omitempty"`
+	// WebhookEndpoints holds the value of the webhook_endpoints edge.
+	WebhookEndpoints []*WebhookEndpoint `json:"webhook_endpoints,omitempty"`
+	// WebhookDeliveries holds the value of the webhook_deliveries edge.
+	WebhookDeliveries []*WebhookDelivery `json:"webhook_deliveries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [7]bool
+	loadedTypes [18]bool
+}
+
+// PlanOrErr returns the Plan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantEdges) PlanOrErr() (*Plan, error) {
+	if e.Plan != nil {
+		return e.Plan, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: plan.Label}
+	}
+	return nil, &NotLoadedError{edge: "plan"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantEdges) ParentOrErr() (*Tenant, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) ChildrenOrErr() ([]*Tenant, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[3] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// BranchesOrErr returns the Branches value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) BranchesOrErr() ([]*Branch, error) {
+	if e.loadedTypes[4] {
+		return e.Branches, nil
+	}
+	return nil, &NotLoadedError{edge: "branches"}
 }
 
 // CarsOrErr returns the Cars value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) CarsOrErr() ([]*Car, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[5] {
 		return e.Cars, nil
 	}
 	return nil, &NotLoadedError{edge: "cars"}
 }
 
+// CarModelsOrErr returns the CarModels value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) CarModelsOrErr() ([]*CarModel, error) {
+	if e.loadedTypes[6] {
+		return e.CarModels, nil
+	}
+	return nil, &NotLoadedError{edge: "car_models"}
+}
+
 // CompaniesOrErr returns the Companies value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) CompaniesOrErr() ([]*Company, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[7] {
 		return e.Companies, nil
 	}
 	return nil, &NotLoadedError{edge: "companies"}
@@ -73,25 +167,43 @@ func (e TenantEdges) CompaniesOrErr() ([]*Company, error) {
 // IndividualsOrErr returns the Individuals value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) IndividualsOrErr() ([]*Individual, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[8] {
 		return e.Individuals, nil
 	}
 	return nil, &NotLoadedError{edge: "individuals"}
 }
 
+// MaintenanceWindowsOrErr returns the MaintenanceWindows value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) MaintenanceWindowsOrErr() ([]*MaintenanceWindow, error) {
+	if e.loadedTypes[9] {
+		return e.MaintenanceWindows, nil
+	}
+	return nil, &NotLoadedError{edge: "maintenance_windows"}
+}
+
 // OptionsOrErr returns the Options value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) OptionsOrErr() ([]*CarOption, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[10] {
 		return e.Options, nil
 	}
 	return nil, &NotLoadedError{edge: "options"}
 }
 
+// RentalHandoversOrErr returns the RentalHandovers value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) RentalHandoversOrErr() ([]*RentalHandover, error) {
+	if e.loadedTypes[11] {
+		return e.RentalHandovers, nil
+	}
+	return nil, &NotLoadedError{edge: "rental_handovers"}
+}
+
 // RentalOptionsOrErr returns the RentalOptions value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) RentalOptionsOrErr() ([]*RentalOption, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[12] {
 		return e.RentalOptions, nil
 	}
 	return nil, &NotLoadedError{edge: "rental_options"}
@@ -100,7 +212,7 @@ func (e TenantEdges) RentalOptionsOrErr() ([]*RentalOption, error) {
 // RentalsOrErr returns the Rentals value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) RentalsOrErr() ([]*Rental, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[13] {
 		return e.Rentals, nil
 	}
 	return nil, &NotLoadedError{edge: "rentals"}
@@ -109,20 +221,49 @@ func (e TenantEdges) RentalsOrErr() ([]*Rental, error) {
 // RentersOrErr returns the Renters value or an error if the edge
 // was not loaded in eager-loading.
 func (e TenantEdges) RentersOrErr() ([]*Renter, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[14] {
 		return e.Renters, nil
 	}
 	return nil, &NotLoadedError{edge: "renters"}
 }
 
+// SettingsOrErr returns the Settings value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TenantEdges) SettingsOrErr() (*TenantSetting, error) {
+	if e.Settings != nil {
+		return e.Settings, nil
+	} else if e.loadedTypes[15] {
+		return nil, &NotFoundError{label: tenantsetting.Label}
+	}
+	return nil, &NotLoadedError{edge: "settings"}
+}
+
+// WebhookEndpointsOrErr returns the WebhookEndpoints value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) WebhookEndpointsOrErr() ([]*WebhookEndpoint, error) {
+	if e.loadedTypes[16] {
+		return e.WebhookEndpoints, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_endpoints"}
+}
+
+// WebhookDeliveriesOrErr returns the WebhookDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e TenantEdges) WebhookDeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[17] {
+		return e.WebhookDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_deliveries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tenant.FieldID, tenant.FieldCode:
+		case tenant.FieldID, tenant.FieldCode, tenant.FieldStatus, tenant.FieldPlanID, tenant.FieldIsolation, tenant.FieldParentID:
 			values[i] = new(sql.NullString)
-		case tenant.FieldCreatedAt, tenant.FieldUpdatedAt, tenant.FieldDeletedAt:
+		case tenant.FieldSuspendedAt, tenant.FieldPurgeAt, tenant.FieldCreatedAt, tenant.FieldUpdatedAt, tenant.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -151,6 +292,46 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Code = value.String
 			}
+		case tenant.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case tenant.FieldSuspendedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_at", values[i])
+			} else if value.Valid {
+				_m.SuspendedAt = new(time.Time)
+				*_m.SuspendedAt = value.Time
+			}
+		case tenant.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				_m.PlanID = new(string)
+				*_m.PlanID = value.String
+			}
+		case tenant.FieldIsolation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isolation", values[i])
+			} else if value.Valid {
+				_m.Isolation = value.String
+			}
+		case tenant.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(string)
+				*_m.ParentID = value.String
+			}
+		case tenant.FieldPurgeAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field purge_at", values[i])
+			} else if value.Valid {
+				_m.PurgeAt = new(time.Time)
+				*_m.PurgeAt = value.Time
+			}
 		case tenant.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -183,11 +364,41 @@ func (_m *Tenant) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryPlan queries the "plan" edge of the Tenant entity.
+func (_m *Tenant) QueryPlan() *PlanQuery {
+	return NewTenantClient(_m.config).QueryPlan(_m)
+}
+
+// QueryParent queries the "parent" edge of the Tenant entity.
+func (_m *Tenant) QueryParent() *TenantQuery {
+	return NewTenantClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Tenant entity.
+func (_m *Tenant) QueryChildren() *TenantQuery {
+	return NewTenantClient(_m.config).QueryChildren(_m)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the Tenant entity.
+func (_m *Tenant) QueryAPIKeys() *APIKeyQuery {
+	return NewTenantClient(_m.config).QueryAPIKeys(_m)
+}
+
+// QueryBranches queries the "branches" edge of the Tenant entity.
+func (_m *Tenant) QueryBranches() *BranchQuery {
+	return NewTenantClient(_m.config).QueryBranches(_m)
+}
+
 // QueryCars queries the "cars" edge of the Tenant entity.
 func (_m *Tenant) QueryCars() *CarQuery {
 	return NewTenantClient(_m.config).QueryCars(_m)
 }
 
+// QueryCarModels queries the "car_models" edge of the Tenant entity.
+func (_m *Tenant) QueryCarModels() *CarModelQuery {
+	return NewTenantClient(_m.config).QueryCarModels(_m)
+}
+
 // QueryCompanies queries the "companies" edge of the Tenant entity.
 func (_m *Tenant) QueryCompanies() *CompanyQuery {
 	return NewTenantClient(_m.config).QueryCompanies(_m)
@@ -198,11 +409,21 @@ func (_m *Tenant) QueryIndividuals() *IndividualQuery {
 	return NewTenantClient(_m.config).QueryIndividuals(_m)
 }
 
+// QueryMaintenanceWindows queries the "maintenance_windows" edge of the Tenant entity.
+func (_m *Tenant) QueryMaintenanceWindows() *MaintenanceWindowQuery {
+	return NewTenantClient(_m.config).QueryMaintenanceWindows(_m)
+}
+
 // QueryOptions queries the "options" edge of the Tenant entity.
 func (_m *Tenant) QueryOptions() *CarOptionQuery {
 	return NewTenantClient(_m.config).QueryOptions(_m)
 }
 
+// QueryRentalHandovers queries the "rental_handovers" edge of the Tenant entity.
+func (_m *Tenant) QueryRentalHandovers() *RentalHandoverQuery {
+	return NewTenantClient(_m.config).QueryRentalHandovers(_m)
+}
+
 // QueryRentalOptions queries the "rental_options" edge of the Tenant entity.
 func (_m *Tenant) QueryRentalOptions() *RentalOptionQuery {
 	return NewTenantClient(_m.config).QueryRentalOptions(_m)
@@ -218,6 +439,21 @@ func (_m *Tenant) QueryRenters() *RenterQuery {
 	return NewTenantClient(_m.config).QueryRenters(_m)
 }
 
+// QuerySettings queries the "settings" edge of the Tenant entity.
+func (_m *Tenant) QuerySettings() *TenantSettingQuery {
+	return NewTenantClient(_m.config).QuerySettings(_m)
+}
+
+// QueryWebhookEndpoints queries the "webhook_endpoints" edge of the Tenant entity.
+func (_m *Tenant) QueryWebhookEndpoints() *WebhookEndpointQuery {
+	return NewTenantClient(_m.config).QueryWebhookEndpoints(_m)
+}
+
+// QueryWebhookDeliveries queries the "webhook_deliveries" edge of the Tenant entity.
+func (_m *Tenant) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	return NewTenantClient(_m.config).QueryWebhookDeliveries(_m)
+}
+
 // Update returns a builder for updating this Tenant.
 // Note that you need to call Tenant.Unwrap() before calling this method if this Tenant
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -244,6 +480,32 @@ func (_m *Tenant) String() string {
 	builder.WriteString("code=")
 	builder.WriteString(_m.Code)
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.SuspendedAt; v != nil {
+		builder.WriteString("suspended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PlanID; v != nil {
+		builder.WriteString("plan_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("isolation=")
+	builder.WriteString(_m.Isolation)
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PurgeAt; v != nil {
+		builder.WriteString("purge_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -10,14 +10,23 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/apikey"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/caroption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/company"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/individual"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookdelivery"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookendpoint"
 )
 
 // TenantCreate is the builder for creating a Tenant entity.
@@ -33,6 +42,90 @@ func (_c *TenantCreate) SetCode(v string) *TenantCreate {
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *TenantCreate) SetStatus(v string) *TenantCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableStatus(v *string) *TenantCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_c *TenantCreate) SetSuspendedAt(v time.Time) *TenantCreate {
+	_c.mutation.SetSuspendedAt(v)
+	return _c
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableSuspendedAt(v *time.Time) *TenantCreate {
+	if v != nil {
+		_c.SetSuspendedAt(*v)
+	}
+	return _c
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_c *TenantCreate) SetPlanID(v string) *TenantCreate {
+	_c.mutation.SetPlanID(v)
+	return _c
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_c *TenantCreate) SetNillablePlanID(v *string) *TenantCreate {
+	if v != nil {
+		_c.SetPlanID(*v)
+	}
+	return _c
+}
+
+// SetIsolation sets the "isolation" field.
+func (_c *TenantCreate) SetIsolation(v string) *TenantCreate {
+	_c.mutation.SetIsolation(v)
+	return _c
+}
+
+// SetNillableIsolation sets the "isolation" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableIsolation(v *string) *TenantCreate {
+	if v != nil {
+		_c.SetIsolation(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *TenantCreate) SetParentID(v string) *TenantCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableParentID(v *string) *TenantCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetPurgeAt sets the "purge_at" field.
+func (_c *TenantCreate) SetPurgeAt(v time.Time) *TenantCreate {
+	_c.mutation.SetPurgeAt(v)
+	return _c
+}
+
+// SetNillablePurgeAt sets the "purge_at" field if the given value is not nil.
+func (_c *TenantCreate) SetNillablePurgeAt(v *time.Time) *TenantCreate {
+	if v != nil {
+		_c.SetPurgeAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TenantCreate) SetCreatedAt(v time.Time) *TenantCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -81,6 +174,61 @@ func (_c *TenantCreate) SetID(v string) *TenantCreate {
 	return _c
 }
 
+// SetPlan sets the "plan" edge to the Plan entity.
+func (_c *TenantCreate) SetPlan(v *Plan) *TenantCreate {
+	return _c.SetPlanID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Tenant entity.
+func (_c *TenantCreate) SetParent(v *Tenant) *TenantCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Tenant entity by IDs.
+func (_c *TenantCreate) AddChildIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Tenant entity.
+func (_c *TenantCreate) AddChildren(v ...*Tenant) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (_c *TenantCreate) AddAPIKeyIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddAPIKeyIDs(ids...)
+	return _c
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (_c *TenantCreate) AddAPIKeys(v ...*APIKey) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAPIKeyIDs(ids...)
+}
+
+// AddBranchIDs adds the "branches" edge to the Branch entity by IDs.
+func (_c *TenantCreate) AddBranchIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddBranchIDs(ids...)
+	return _c
+}
+
+// AddBranches adds the "branches" edges to the Branch entity.
+func (_c *TenantCreate) AddBranches(v ...*Branch) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBranchIDs(ids...)
+}
+
 // AddCarIDs adds the "cars" edge to the Car entity by IDs.
 func (_c *TenantCreate) AddCarIDs(ids ...string) *TenantCreate {
 	_c.mutation.AddCarIDs(ids...)
@@ -96,6 +244,21 @@ func (_c *TenantCreate) AddCars(v ...*Car) *TenantCreate {
 	return _c.AddCarIDs(ids...)
 }
 
+// AddCarModelIDs adds the "car_models" edge to the CarModel entity by IDs.
+func (_c *TenantCreate) AddCarModelIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddCarModelIDs(ids...)
+	return _c
+}
+
+// AddCarModels adds the "car_models" edges to the CarModel entity.
+func (_c *TenantCreate) AddCarModels(v ...*CarModel) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCarModelIDs(ids...)
+}
+
 // AddCompanyIDs adds the "companies" edge to the Company entity by IDs.
 func (_c *TenantCreate) AddCompanyIDs(ids ...string) *TenantCreate {
 	_c.mutation.AddCompanyIDs(ids...)
@@ -126,6 +289,21 @@ func (_c *TenantCreate) AddIndividuals(v ...*Individual) *TenantCreate {
 	return _c.AddIndividualIDs(ids...)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (_c *TenantCreate) AddMaintenanceWindowIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddMaintenanceWindowIDs(ids...)
+	return _c
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_c *TenantCreate) AddMaintenanceWindows(v ...*MaintenanceWindow) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMaintenanceWindowIDs(ids...)
+}
+
 // AddOptionIDs adds the "options" edge to the CarOption entity by IDs.
 func (_c *TenantCreate) AddOptionIDs(ids ...string) *TenantCreate {
 	_c.mutation.AddOptionIDs(ids...)
@@ -141,6 +319,21 @@ func (_c *TenantCreate) AddOptions(v ...*CarOption) *TenantCreate {
 	return _c.AddOptionIDs(ids...)
 }
 
+// AddRentalHandoverIDs adds the "rental_handovers" edge to the RentalHandover entity by IDs.
+func (_c *TenantCreate) AddRentalHandoverIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddRentalHandoverIDs(ids...)
+	return _c
+}
+
+// AddRentalHandovers adds the "rental_handovers" edges to the RentalHandover entity.
+func (_c *TenantCreate) AddRentalHandovers(v ...*RentalHandover) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRentalHandoverIDs(ids...)
+}
+
 // AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by IDs.
 func (_c *TenantCreate) AddRentalOptionIDs(ids ...string) *TenantCreate {
 	_c.mutation.AddRentalOptionIDs(ids...)
@@ -186,6 +379,55 @@ func (_c *TenantCreate) AddRenters(v ...*Renter) *TenantCreate {
 	return _c.AddRenterIDs(ids...)
 }
 
+// SetSettingsID sets the "settings" edge to the TenantSetting entity by ID.
+func (_c *TenantCreate) SetSettingsID(id string) *TenantCreate {
+	_c.mutation.SetSettingsID(id)
+	return _c
+}
+
+// SetNillableSettingsID sets the "settings" edge to the TenantSetting entity by ID if the given value is not nil.
+func (_c *TenantCreate) SetNillableSettingsID(id *string) *TenantCreate {
+	if id != nil {
+		_c = _c.SetSettingsID(*id)
+	}
+	return _c
+}
+
+// SetSettings sets the "settings" edge to the TenantSetting entity.
+func (_c *TenantCreate) SetSettings(v *TenantSetting) *TenantCreate {
+	return _c.SetSettingsID(v.ID)
+}
+
+// AddWebhookEndpointIDs adds the "webhook_endpoints" edge to the WebhookEndpoint entity by IDs.
+func (_c *TenantCreate) AddWebhookEndpointIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddWebhookEndpointIDs(ids...)
+	return _c
+}
+
+// AddWebhookEndpoints adds the "webhook_endpoints" edges to the WebhookEndpoint entity.
+func (_c *TenantCreate) AddWebhookEndpoints(v ...*WebhookEndpoint) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookEndpointIDs(ids...)
+}
+
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (_c *TenantCreate) AddWebhookDeliveryIDs(ids ...string) *TenantCreate {
+	_c.mutation.AddWebhookDeliveryIDs(ids...)
+	return _c
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_c *TenantCreate) AddWebhookDeliveries(v ...*WebhookDelivery) *TenantCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookDeliveryIDs(ids...)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_c *TenantCreate) Mutation() *TenantMutation {
 	return _c.mutation
@@ -193,6 +435,7 @@ func (_c *TenantCreate) Mutation() *TenantMutation {
 
 // Save creates the Tenant in the database.
 func (_c *TenantCreate) Save(ctx context.Context) (*Tenant, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -218,6 +461,18 @@ func (_c *TenantCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *TenantCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := tenant.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Isolation(); !ok {
+		v := tenant.DefaultIsolation
+		_c.mutation.SetIsolation(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *TenantCreate) check() error {
 	if _, ok := _c.mutation.Code(); !ok {
@@ -228,6 +483,32 @@ func (_c *TenantCreate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`entgen: validator failed for field "Tenant.code": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`entgen: missing required field "Tenant.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := tenant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "Tenant.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PlanID(); ok {
+		if err := tenant.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`entgen: validator failed for field "Tenant.plan_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Isolation(); !ok {
+		return &ValidationError{Name: "isolation", err: errors.New(`entgen: missing required field "Tenant.isolation"`)}
+	}
+	if v, ok := _c.mutation.Isolation(); ok {
+		if err := tenant.IsolationValidator(v); err != nil {
+			return &ValidationError{Name: "isolation", err: fmt.Errorf(`entgen: validator failed for field "Tenant.isolation": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ParentID(); ok {
+		if err := tenant.ParentIDValidator(v); err != nil {
+			return &ValidationError{Name: "parent_id", err: fmt.Errorf(`entgen: validator failed for field "Tenant.parent_id": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := tenant.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "Tenant.id": %w`, err)}
@@ -272,6 +553,22 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldCode, field.TypeString, value)
 		_node.Code = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(tenant.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.SuspendedAt(); ok {
+		_spec.SetField(tenant.FieldSuspendedAt, field.TypeTime, value)
+		_node.SuspendedAt = &value
+	}
+	if value, ok := _c.mutation.Isolation(); ok {
+		_spec.SetField(tenant.FieldIsolation, field.TypeString, value)
+		_node.Isolation = value
+	}
+	if value, ok := _c.mutation.PurgeAt(); ok {
+		_spec.SetField(tenant.FieldPurgeAt, field.TypeTime, value)
+		_node.PurgeAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -284,6 +581,88 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if nodes := _c.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PlanID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.ParentTable,
+			Columns: []string{tenant.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.APIKeysTable,
+			Columns: []string{tenant.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BranchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BranchesTable,
+			Columns: []string{tenant.BranchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.CarsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -300,6 +679,22 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CarModelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CarModelsTable,
+			Columns: []string{tenant.CarModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.CompaniesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -332,6 +727,22 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceWindowsTable,
+			Columns: []string{tenant.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.OptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -348,6 +759,22 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RentalHandoversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RentalHandoversTable,
+			Columns: []string{tenant.RentalHandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.RentalOptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -396,6 +823,54 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhookEndpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookEndpointsTable,
+			Columns: []string{tenant.WebhookEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookDeliveriesTable,
+			Columns: []string{tenant.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -417,6 +892,7 @@ func (_c *TenantCreateBulk) Save(ctx context.Context) ([]*Tenant, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*TenantMutation)
 				if !ok {
@@ -13,32 +13,52 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/apikey"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/caroption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/company"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/individual"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookdelivery"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookendpoint"
 )
 
 // TenantQuery is the builder for querying Tenant entities.
 type TenantQuery struct {
 	config
-	ctx               *QueryContext
-	order             []tenant.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.Tenant
-	withCars          *CarQuery
-	withCompanies     *CompanyQuery
-	withIndividuals   *IndividualQuery
-	withOptions       *CarOptionQuery
-	withRentalOptions *RentalOptionQuery
-	withRentals       *RentalQuery
-	withRenters       *RenterQuery
-	modifiers         []func(*sql.Selector)
+	ctx                    *QueryContext
+	order                  []tenant.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.Tenant
+	withPlan               *PlanQuery
+	withParent             *TenantQuery
+	withChildren           *TenantQuery
+	withAPIKeys            *APIKeyQuery
+	withBranches           *BranchQuery
+	withCars               *CarQuery
+	withCarModels          *CarModelQuery
+	withCompanies          *CompanyQuery
+	withIndividuals        *IndividualQuery
+	withMaintenanceWindows *MaintenanceWindowQuery
+	withOptions            *CarOptionQuery
+	withRentalHandovers    *RentalHandoverQuery
+	withRentalOptions      *RentalOptionQuery
+	withRentals            *RentalQuery
+	withRenters            *RenterQuery
+	withSettings           *TenantSettingQuery
+	withWebhookEndpoints   *WebhookEndpointQuery
+	withWebhookDeliveries  *WebhookDeliveryQuery
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -75,6 +95,116 @@ func (_q *TenantQuery) Order(o ...tenant.OrderOption) *TenantQuery {
 	return _q
 }
 
+// QueryPlan chains the current query on the "plan" edge.
+func (_q *TenantQuery) QueryPlan() *PlanQuery {
+	query := (&PlanClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenant.PlanTable, tenant.PlanColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (_q *TenantQuery) QueryParent() *TenantQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tenant.ParentTable, tenant.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (_q *TenantQuery) QueryChildren() *TenantQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.ChildrenTable, tenant.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAPIKeys chains the current query on the "api_keys" edge.
+func (_q *TenantQuery) QueryAPIKeys() *APIKeyQuery {
+	query := (&APIKeyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(apikey.Table, apikey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.APIKeysTable, tenant.APIKeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBranches chains the current query on the "branches" edge.
+func (_q *TenantQuery) QueryBranches() *BranchQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(branch.Table, branch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.BranchesTable, tenant.BranchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCars chains the current query on the "cars" edge.
 func (_q *TenantQuery) QueryCars() *CarQuery {
 	query := (&CarClient{config: _q.config}).Query()
@@ -97,6 +227,28 @@ func (_q *TenantQuery) QueryCars() *CarQuery {
 	return query
 }
 
+// QueryCarModels chains the current query on the "car_models" edge.
+func (_q *TenantQuery) QueryCarModels() *CarModelQuery {
+	query := (&CarModelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(carmodel.Table, carmodel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.CarModelsTable, tenant.CarModelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCompanies chains the current query on the "companies" edge.
 func (_q *TenantQuery) QueryCompanies() *CompanyQuery {
 	query := (&CompanyClient{config: _q.config}).Query()
@@ -141,6 +293,28 @@ func (_q *TenantQuery) QueryIndividuals() *IndividualQuery {
 	return query
 }
 
+// QueryMaintenanceWindows chains the current query on the "maintenance_windows" edge.
+func (_q *TenantQuery) QueryMaintenanceWindows() *MaintenanceWindowQuery {
+	query := (&MaintenanceWindowClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(maintenancewindow.Table, maintenancewindow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.MaintenanceWindowsTable, tenant.MaintenanceWindowsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryOptions chains the current query on the "options" edge.
 func (_q *TenantQuery) QueryOptions() *CarOptionQuery {
 	query := (&CarOptionClient{config: _q.config}).Query()
@@ -163,6 +337,28 @@ func (_q *TenantQuery) QueryOptions() *CarOptionQuery {
 	return query
 }
 
+// QueryRentalHandovers chains the current query on the "rental_handovers" edge.
+func (_q *TenantQuery) QueryRentalHandovers() *RentalHandoverQuery {
+	query := (&RentalHandoverClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(rentalhandover.Table, rentalhandover.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.RentalHandoversTable, tenant.RentalHandoversColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryRentalOptions chains the current query on the "rental_options" edge.
 func (_q *TenantQuery) QueryRentalOptions() *RentalOptionQuery {
 	query := (&RentalOptionClient{config: _q.config}).Query()
@@ -229,6 +425,72 @@ func (_q *TenantQuery) QueryRenters() *RenterQuery {
 	return query
 }
 
+// QuerySettings chains the current query on the "settings" edge.
+func (_q *TenantQuery) QuerySettings() *TenantSettingQuery {
+	query := (&TenantSettingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(tenantsetting.Table, tenantsetting.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, tenant.SettingsTable, tenant.SettingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhookEndpoints chains the current query on the "webhook_endpoints" edge.
+func (_q *TenantQuery) QueryWebhookEndpoints() *WebhookEndpointQuery {
+	query := (&WebhookEndpointClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(webhookendpoint.Table, webhookendpoint.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.WebhookEndpointsTable, tenant.WebhookEndpointsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhookDeliveries chains the current query on the "webhook_deliveries" edge.
+func (_q *TenantQuery) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.WebhookDeliveriesTable, tenant.WebhookDeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Tenant entity from the query.
 // Returns a *NotFoundError when no Tenant was found.
 func (_q *TenantQuery) First(ctx context.Context) (*Tenant, error) {
@@ -416,24 +678,90 @@ func (_q *TenantQuery) Clone() *TenantQuery {
 		return nil
 	}
 	return &TenantQuery{
-		config:            _q.config,
-		ctx:               _q.ctx.Clone(),
-		order:             append([]tenant.OrderOption{}, _q.order...),
-		inters:            append([]Interceptor{}, _q.inters...),
-		predicates:        append([]predicate.Tenant{}, _q.predicates...),
-		withCars:          _q.withCars.Clone(),
-		withCompanies:     _q.withCompanies.Clone(),
-		withIndividuals:   _q.withIndividuals.Clone(),
-		withOptions:       _q.withOptions.Clone(),
-		withRentalOptions: _q.withRentalOptions.Clone(),
-		withRentals:       _q.withRentals.Clone(),
-		withRenters:       _q.withRenters.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]tenant.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.Tenant{}, _q.predicates...),
+		withPlan:               _q.withPlan.Clone(),
+		withParent:             _q.withParent.Clone(),
+		withChildren:           _q.withChildren.Clone(),
+		withAPIKeys:            _q.withAPIKeys.Clone(),
+		withBranches:           _q.withBranches.Clone(),
+		withCars:               _q.withCars.Clone(),
+		withCarModels:          _q.withCarModels.Clone(),
+		withCompanies:          _q.withCompanies.Clone(),
+		withIndividuals:        _q.withIndividuals.Clone(),
+		withMaintenanceWindows: _q.withMaintenanceWindows.Clone(),
+		withOptions:            _q.withOptions.Clone(),
+		withRentalHandovers:    _q.withRentalHandovers.Clone(),
+		withRentalOptions:      _q.withRentalOptions.Clone(),
+		withRentals:            _q.withRentals.Clone(),
+		withRenters:            _q.withRenters.Clone(),
+		withSettings:           _q.withSettings.Clone(),
+		withWebhookEndpoints:   _q.withWebhookEndpoints.Clone(),
+		withWebhookDeliveries:  _q.withWebhookDeliveries.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithPlan tells the query-builder to eager-load the nodes that are connected to
+// the "plan" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithPlan(opts ...func(*PlanQuery)) *TenantQuery {
+	query := (&PlanClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPlan = query
+	return _q
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithParent(opts ...func(*TenantQuery)) *TenantQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParent = query
+	return _q
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithChildren(opts ...func(*TenantQuery)) *TenantQuery {
+	query := (&TenantClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChildren = query
+	return _q
+}
+
+// WithAPIKeys tells the query-builder to eager-load the nodes that are connected to
+// the "api_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithAPIKeys(opts ...func(*APIKeyQuery)) *TenantQuery {
+	query := (&APIKeyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAPIKeys = query
+	return _q
+}
+
+// WithBranches tells the query-builder to eager-load the nodes that are connected to
+// the "branches" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithBranches(opts ...func(*BranchQuery)) *TenantQuery {
+	query := (&BranchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBranches = query
+	return _q
+}
+
 // WithCars tells the query-builder to eager-load the nodes that are connected to
 // the "cars" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *TenantQuery) WithCars(opts ...func(*CarQuery)) *TenantQuery {
@@ -445,6 +773,17 @@ func (_q *TenantQuery) WithCars(opts ...func(*CarQuery)) *TenantQuery {
 	return _q
 }
 
+// WithCarModels tells the query-builder to eager-load the nodes that are connected to
+// the "car_models" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithCarModels(opts ...func(*CarModelQuery)) *TenantQuery {
+	query := (&CarModelClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCarModels = query
+	return _q
+}
+
 // WithCompanies tells the query-builder to eager-load the nodes that are connected to
 // the "companies" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *TenantQuery) WithCompanies(opts ...func(*CompanyQuery)) *TenantQuery {
@@ -467,6 +806,17 @@ func (_q *TenantQuery) WithIndividuals(opts ...func(*IndividualQuery)) *TenantQu
 	return _q
 }
 
+// WithMaintenanceWindows tells the query-builder to eager-load the nodes that are connected to
+// the "maintenance_windows" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithMaintenanceWindows(opts ...func(*MaintenanceWindowQuery)) *TenantQuery {
+	query := (&MaintenanceWindowClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMaintenanceWindows = query
+	return _q
+}
+
 // WithOptions tells the query-builder to eager-load the nodes that are connected to
 // the "options" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *TenantQuery) WithOptions(opts ...func(*CarOptionQuery)) *TenantQuery {
@@ -478,6 +828,17 @@ func (_q *TenantQuery) WithOptions(opts ...func(*CarOptionQuery)) *TenantQuery {
 	return _q
 }
 
+// WithRentalHandovers tells the query-builder to eager-load the nodes that are connected to
+// the "rental_handovers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithRentalHandovers(opts ...func(*RentalHandoverQuery)) *TenantQuery {
+	query := (&RentalHandoverClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRentalHandovers = query
+	return _q
+}
+
 // WithRentalOptions tells the query-builder to eager-load the nodes that are connected to
 // the "rental_options" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *TenantQuery) WithRentalOptions(opts ...func(*RentalOptionQuery)) *TenantQuery {
@@ -511,6 +872,39 @@ func (_q *TenantQuery) WithRenters(opts ...func(*RenterQuery)) *TenantQuery {
 	return _q
 }
 
+// WithSettings tells the query-builder to eager-load the nodes that are connected to
+// the "settings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithSettings(opts ...func(*TenantSettingQuery)) *TenantQuery {
+	query := (&TenantSettingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSettings = query
+	return _q
+}
+
+// WithWebhookEndpoints tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_endpoints" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithWebhookEndpoints(opts ...func(*WebhookEndpointQuery)) *TenantQuery {
+	query := (&WebhookEndpointClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhookEndpoints = query
+	return _q
+}
+
+// WithWebhookDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *TenantQuery) WithWebhookDeliveries(opts ...func(*WebhookDeliveryQuery)) *TenantQuery {
+	query := (&WebhookDeliveryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhookDeliveries = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -589,14 +983,25 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 	var (
 		nodes       = []*Tenant{}
 		_spec       = _q.querySpec()
-		loadedTypes = [7]bool{
+		loadedTypes = [18]bool{
+			_q.withPlan != nil,
+			_q.withParent != nil,
+			_q.withChildren != nil,
+			_q.withAPIKeys != nil,
+			_q.withBranches != nil,
 			_q.withCars != nil,
+			_q.withCarModels != nil,
 			_q.withCompanies != nil,
 			_q.withIndividuals != nil,
+			_q.withMaintenanceWindows != nil,
 			_q.withOptions != nil,
+			_q.withRentalHandovers != nil,
 			_q.withRentalOptions != nil,
 			_q.withRentals != nil,
 			_q.withRenters != nil,
+			_q.withSettings != nil,
+			_q.withWebhookEndpoints != nil,
+			_q.withWebhookDeliveries != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -620,13 +1025,53 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := _q.withCars; query != nil {
-		if err := _q.loadCars(ctx, query, nodes,
-			func(n *Tenant) { n.Edges.Cars = []*Car{} },
+	if query := _q.withPlan; query != nil {
+		if err := _q.loadPlan(ctx, query, nodes, nil,
+			func(n *Tenant, e *Plan) { n.Edges.Plan = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withParent; query != nil {
+		if err := _q.loadParent(ctx, query, nodes, nil,
+			func(n *Tenant, e *Tenant) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChildren; query != nil {
+		if err := _q.loadChildren(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Children = []*Tenant{} },
+			func(n *Tenant, e *Tenant) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAPIKeys; query != nil {
+		if err := _q.loadAPIKeys(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.APIKeys = []*APIKey{} },
+			func(n *Tenant, e *APIKey) { n.Edges.APIKeys = append(n.Edges.APIKeys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBranches; query != nil {
+		if err := _q.loadBranches(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Branches = []*Branch{} },
+			func(n *Tenant, e *Branch) { n.Edges.Branches = append(n.Edges.Branches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCars; query != nil {
+		if err := _q.loadCars(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.Cars = []*Car{} },
 			func(n *Tenant, e *Car) { n.Edges.Cars = append(n.Edges.Cars, e) }); err != nil {
 			return nil, err
 		}
 	}
+	if query := _q.withCarModels; query != nil {
+		if err := _q.loadCarModels(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.CarModels = []*CarModel{} },
+			func(n *Tenant, e *CarModel) { n.Edges.CarModels = append(n.Edges.CarModels, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withCompanies; query != nil {
 		if err := _q.loadCompanies(ctx, query, nodes,
 			func(n *Tenant) { n.Edges.Companies = []*Company{} },
@@ -641,6 +1086,15 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			return nil, err
 		}
 	}
+	if query := _q.withMaintenanceWindows; query != nil {
+		if err := _q.loadMaintenanceWindows(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.MaintenanceWindows = []*MaintenanceWindow{} },
+			func(n *Tenant, e *MaintenanceWindow) {
+				n.Edges.MaintenanceWindows = append(n.Edges.MaintenanceWindows, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withOptions; query != nil {
 		if err := _q.loadOptions(ctx, query, nodes,
 			func(n *Tenant) { n.Edges.Options = []*CarOption{} },
@@ -648,6 +1102,13 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			return nil, err
 		}
 	}
+	if query := _q.withRentalHandovers; query != nil {
+		if err := _q.loadRentalHandovers(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.RentalHandovers = []*RentalHandover{} },
+			func(n *Tenant, e *RentalHandover) { n.Edges.RentalHandovers = append(n.Edges.RentalHandovers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withRentalOptions; query != nil {
 		if err := _q.loadRentalOptions(ctx, query, nodes,
 			func(n *Tenant) { n.Edges.RentalOptions = []*RentalOption{} },
@@ -669,9 +1130,186 @@ func (_q *TenantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Tenan
 			return nil, err
 		}
 	}
+	if query := _q.withSettings; query != nil {
+		if err := _q.loadSettings(ctx, query, nodes, nil,
+			func(n *Tenant, e *TenantSetting) { n.Edges.Settings = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhookEndpoints; query != nil {
+		if err := _q.loadWebhookEndpoints(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.WebhookEndpoints = []*WebhookEndpoint{} },
+			func(n *Tenant, e *WebhookEndpoint) { n.Edges.WebhookEndpoints = append(n.Edges.WebhookEndpoints, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhookDeliveries; query != nil {
+		if err := _q.loadWebhookDeliveries(ctx, query, nodes,
+			func(n *Tenant) { n.Edges.WebhookDeliveries = []*WebhookDelivery{} },
+			func(n *Tenant, e *WebhookDelivery) { n.Edges.WebhookDeliveries = append(n.Edges.WebhookDeliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *TenantQuery) loadPlan(ctx context.Context, query *PlanQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Plan)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Tenant)
+	for i := range nodes {
+		if nodes[i].PlanID == nil {
+			continue
+		}
+		fk := *nodes[i].PlanID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(plan.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "plan_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TenantQuery) loadParent(ctx context.Context, query *TenantQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Tenant)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*Tenant)
+	for i := range nodes {
+		if nodes[i].ParentID == nil {
+			continue
+		}
+		fk := *nodes[i].ParentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tenant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *TenantQuery) loadChildren(ctx context.Context, query *TenantQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Tenant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tenant.FieldParentID)
+	}
+	query.Where(predicate.Tenant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.ChildrenColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "parent_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadAPIKeys(ctx context.Context, query *APIKeyQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *APIKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(apikey.FieldTenantID)
+	}
+	query.Where(predicate.APIKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.APIKeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadBranches(ctx context.Context, query *BranchQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Branch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(branch.FieldTenantID)
+	}
+	query.Where(predicate.Branch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.BranchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *TenantQuery) loadCars(ctx context.Context, query *CarQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Car)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Tenant)
@@ -702,6 +1340,36 @@ func (_q *TenantQuery) loadCars(ctx context.Context, query *CarQuery, nodes []*T
 	}
 	return nil
 }
+func (_q *TenantQuery) loadCarModels(ctx context.Context, query *CarModelQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *CarModel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(carmodel.FieldTenantID)
+	}
+	query.Where(predicate.CarModel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.CarModelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *TenantQuery) loadCompanies(ctx context.Context, query *CompanyQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *Company)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Tenant)
@@ -762,6 +1430,36 @@ func (_q *TenantQuery) loadIndividuals(ctx context.Context, query *IndividualQue
 	}
 	return nil
 }
+func (_q *TenantQuery) loadMaintenanceWindows(ctx context.Context, query *MaintenanceWindowQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *MaintenanceWindow)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(maintenancewindow.FieldTenantID)
+	}
+	query.Where(predicate.MaintenanceWindow(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.MaintenanceWindowsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *TenantQuery) loadOptions(ctx context.Context, query *CarOptionQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *CarOption)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Tenant)
@@ -792,6 +1490,36 @@ func (_q *TenantQuery) loadOptions(ctx context.Context, query *CarOptionQuery, n
 	}
 	return nil
 }
+func (_q *TenantQuery) loadRentalHandovers(ctx context.Context, query *RentalHandoverQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *RentalHandover)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rentalhandover.FieldTenantID)
+	}
+	query.Where(predicate.RentalHandover(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.RentalHandoversColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *TenantQuery) loadRentalOptions(ctx context.Context, query *RentalOptionQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *RentalOption)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Tenant)
@@ -883,6 +1611,93 @@ func (_q *TenantQuery) loadRenters(ctx context.Context, query *RenterQuery, node
 	}
 	return nil
 }
+func (_q *TenantQuery) loadSettings(ctx context.Context, query *TenantSettingQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *TenantSetting)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tenantsetting.FieldTenantID)
+	}
+	query.Where(predicate.TenantSetting(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.SettingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadWebhookEndpoints(ctx context.Context, query *WebhookEndpointQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *WebhookEndpoint)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhookendpoint.FieldTenantID)
+	}
+	query.Where(predicate.WebhookEndpoint(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.WebhookEndpointsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *TenantQuery) loadWebhookDeliveries(ctx context.Context, query *WebhookDeliveryQuery, nodes []*Tenant, init func(*Tenant), assign func(*Tenant, *WebhookDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Tenant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhookdelivery.FieldTenantID)
+	}
+	query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tenant.WebhookDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TenantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "tenant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *TenantQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -912,6 +1727,12 @@ func (_q *TenantQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withPlan != nil {
+			_spec.Node.AddColumnOnce(tenant.FieldPlanID)
+		}
+		if _q.withParent != nil {
+			_spec.Node.AddColumnOnce(tenant.FieldParentID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
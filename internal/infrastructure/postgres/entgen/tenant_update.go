@@ -11,15 +11,24 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/apikey"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/caroption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/company"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/individual"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookdelivery"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookendpoint"
 )
 
 // TenantUpdate is the builder for updating Tenant entities.
@@ -49,6 +58,114 @@ func (_u *TenantUpdate) SetNillableCode(v *string) *TenantUpdate {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *TenantUpdate) SetStatus(v string) *TenantUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableStatus(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_u *TenantUpdate) SetSuspendedAt(v time.Time) *TenantUpdate {
+	_u.mutation.SetSuspendedAt(v)
+	return _u
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableSuspendedAt(v *time.Time) *TenantUpdate {
+	if v != nil {
+		_u.SetSuspendedAt(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (_u *TenantUpdate) ClearSuspendedAt() *TenantUpdate {
+	_u.mutation.ClearSuspendedAt()
+	return _u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_u *TenantUpdate) SetPlanID(v string) *TenantUpdate {
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillablePlanID(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (_u *TenantUpdate) ClearPlanID() *TenantUpdate {
+	_u.mutation.ClearPlanID()
+	return _u
+}
+
+// SetIsolation sets the "isolation" field.
+func (_u *TenantUpdate) SetIsolation(v string) *TenantUpdate {
+	_u.mutation.SetIsolation(v)
+	return _u
+}
+
+// SetNillableIsolation sets the "isolation" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableIsolation(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetIsolation(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *TenantUpdate) SetParentID(v string) *TenantUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableParentID(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *TenantUpdate) ClearParentID() *TenantUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetPurgeAt sets the "purge_at" field.
+func (_u *TenantUpdate) SetPurgeAt(v time.Time) *TenantUpdate {
+	_u.mutation.SetPurgeAt(v)
+	return _u
+}
+
+// SetNillablePurgeAt sets the "purge_at" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillablePurgeAt(v *time.Time) *TenantUpdate {
+	if v != nil {
+		_u.SetPurgeAt(*v)
+	}
+	return _u
+}
+
+// ClearPurgeAt clears the value of the "purge_at" field.
+func (_u *TenantUpdate) ClearPurgeAt() *TenantUpdate {
+	_u.mutation.ClearPurgeAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *TenantUpdate) SetCreatedAt(v time.Time) *TenantUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -109,6 +226,61 @@ func (_u *TenantUpdate) ClearDeletedAt() *TenantUpdate {
 	return _u
 }
 
+// SetPlan sets the "plan" edge to the Plan entity.
+func (_u *TenantUpdate) SetPlan(v *Plan) *TenantUpdate {
+	return _u.SetPlanID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Tenant entity.
+func (_u *TenantUpdate) SetParent(v *Tenant) *TenantUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Tenant entity by IDs.
+func (_u *TenantUpdate) AddChildIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Tenant entity.
+func (_u *TenantUpdate) AddChildren(v ...*Tenant) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (_u *TenantUpdate) AddAPIKeyIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddAPIKeyIDs(ids...)
+	return _u
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (_u *TenantUpdate) AddAPIKeys(v ...*APIKey) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIKeyIDs(ids...)
+}
+
+// AddBranchIDs adds the "branches" edge to the Branch entity by IDs.
+func (_u *TenantUpdate) AddBranchIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddBranchIDs(ids...)
+	return _u
+}
+
+// AddBranches adds the "branches" edges to the Branch entity.
+func (_u *TenantUpdate) AddBranches(v ...*Branch) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBranchIDs(ids...)
+}
+
 // AddCarIDs adds the "cars" edge to the Car entity by IDs.
 func (_u *TenantUpdate) AddCarIDs(ids ...string) *TenantUpdate {
 	_u.mutation.AddCarIDs(ids...)
@@ -124,6 +296,21 @@ func (_u *TenantUpdate) AddCars(v ...*Car) *TenantUpdate {
 	return _u.AddCarIDs(ids...)
 }
 
+// AddCarModelIDs adds the "car_models" edge to the CarModel entity by IDs.
+func (_u *TenantUpdate) AddCarModelIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddCarModelIDs(ids...)
+	return _u
+}
+
+// AddCarModels adds the "car_models" edges to the CarModel entity.
+func (_u *TenantUpdate) AddCarModels(v ...*CarModel) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCarModelIDs(ids...)
+}
+
 // AddCompanyIDs adds the "companies" edge to the Company entity by IDs.
 func (_u *TenantUpdate) AddCompanyIDs(ids ...string) *TenantUpdate {
 	_u.mutation.AddCompanyIDs(ids...)
@@ -154,6 +341,21 @@ func (_u *TenantUpdate) AddIndividuals(v ...*Individual) *TenantUpdate {
 	return _u.AddIndividualIDs(ids...)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (_u *TenantUpdate) AddMaintenanceWindowIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddMaintenanceWindowIDs(ids...)
+	return _u
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_u *TenantUpdate) AddMaintenanceWindows(v ...*MaintenanceWindow) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMaintenanceWindowIDs(ids...)
+}
+
 // AddOptionIDs adds the "options" edge to the CarOption entity by IDs.
 func (_u *TenantUpdate) AddOptionIDs(ids ...string) *TenantUpdate {
 	_u.mutation.AddOptionIDs(ids...)
@@ -169,6 +371,21 @@ func (_u *TenantUpdate) AddOptions(v ...*CarOption) *TenantUpdate {
 	return _u.AddOptionIDs(ids...)
 }
 
+// AddRentalHandoverIDs adds the "rental_handovers" edge to the RentalHandover entity by IDs.
+func (_u *TenantUpdate) AddRentalHandoverIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddRentalHandoverIDs(ids...)
+	return _u
+}
+
+// AddRentalHandovers adds the "rental_handovers" edges to the RentalHandover entity.
+func (_u *TenantUpdate) AddRentalHandovers(v ...*RentalHandover) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRentalHandoverIDs(ids...)
+}
+
 // AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by IDs.
 func (_u *TenantUpdate) AddRentalOptionIDs(ids ...string) *TenantUpdate {
 	_u.mutation.AddRentalOptionIDs(ids...)
@@ -214,11 +431,135 @@ func (_u *TenantUpdate) AddRenters(v ...*Renter) *TenantUpdate {
 	return _u.AddRenterIDs(ids...)
 }
 
+// SetSettingsID sets the "settings" edge to the TenantSetting entity by ID.
+func (_u *TenantUpdate) SetSettingsID(id string) *TenantUpdate {
+	_u.mutation.SetSettingsID(id)
+	return _u
+}
+
+// SetNillableSettingsID sets the "settings" edge to the TenantSetting entity by ID if the given value is not nil.
+func (_u *TenantUpdate) SetNillableSettingsID(id *string) *TenantUpdate {
+	if id != nil {
+		_u = _u.SetSettingsID(*id)
+	}
+	return _u
+}
+
+// SetSettings sets the "settings" edge to the TenantSetting entity.
+func (_u *TenantUpdate) SetSettings(v *TenantSetting) *TenantUpdate {
+	return _u.SetSettingsID(v.ID)
+}
+
+// AddWebhookEndpointIDs adds the "webhook_endpoints" edge to the WebhookEndpoint entity by IDs.
+func (_u *TenantUpdate) AddWebhookEndpointIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddWebhookEndpointIDs(ids...)
+	return _u
+}
+
+// AddWebhookEndpoints adds the "webhook_endpoints" edges to the WebhookEndpoint entity.
+func (_u *TenantUpdate) AddWebhookEndpoints(v ...*WebhookEndpoint) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookEndpointIDs(ids...)
+}
+
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (_u *TenantUpdate) AddWebhookDeliveryIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddWebhookDeliveryIDs(ids...)
+	return _u
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_u *TenantUpdate) AddWebhookDeliveries(v ...*WebhookDelivery) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookDeliveryIDs(ids...)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_u *TenantUpdate) Mutation() *TenantMutation {
 	return _u.mutation
 }
 
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (_u *TenantUpdate) ClearPlan() *TenantUpdate {
+	_u.mutation.ClearPlan()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Tenant entity.
+func (_u *TenantUpdate) ClearParent() *TenantUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Tenant entity.
+func (_u *TenantUpdate) ClearChildren() *TenantUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Tenant entities by IDs.
+func (_u *TenantUpdate) RemoveChildIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Tenant entities.
+func (_u *TenantUpdate) RemoveChildren(v ...*Tenant) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (_u *TenantUpdate) ClearAPIKeys() *TenantUpdate {
+	_u.mutation.ClearAPIKeys()
+	return _u
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (_u *TenantUpdate) RemoveAPIKeyIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveAPIKeyIDs(ids...)
+	return _u
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (_u *TenantUpdate) RemoveAPIKeys(v ...*APIKey) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearBranches clears all "branches" edges to the Branch entity.
+func (_u *TenantUpdate) ClearBranches() *TenantUpdate {
+	_u.mutation.ClearBranches()
+	return _u
+}
+
+// RemoveBranchIDs removes the "branches" edge to Branch entities by IDs.
+func (_u *TenantUpdate) RemoveBranchIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveBranchIDs(ids...)
+	return _u
+}
+
+// RemoveBranches removes "branches" edges to Branch entities.
+func (_u *TenantUpdate) RemoveBranches(v ...*Branch) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBranchIDs(ids...)
+}
+
 // ClearCars clears all "cars" edges to the Car entity.
 func (_u *TenantUpdate) ClearCars() *TenantUpdate {
 	_u.mutation.ClearCars()
@@ -240,6 +581,27 @@ func (_u *TenantUpdate) RemoveCars(v ...*Car) *TenantUpdate {
 	return _u.RemoveCarIDs(ids...)
 }
 
+// ClearCarModels clears all "car_models" edges to the CarModel entity.
+func (_u *TenantUpdate) ClearCarModels() *TenantUpdate {
+	_u.mutation.ClearCarModels()
+	return _u
+}
+
+// RemoveCarModelIDs removes the "car_models" edge to CarModel entities by IDs.
+func (_u *TenantUpdate) RemoveCarModelIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveCarModelIDs(ids...)
+	return _u
+}
+
+// RemoveCarModels removes "car_models" edges to CarModel entities.
+func (_u *TenantUpdate) RemoveCarModels(v ...*CarModel) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCarModelIDs(ids...)
+}
+
 // ClearCompanies clears all "companies" edges to the Company entity.
 func (_u *TenantUpdate) ClearCompanies() *TenantUpdate {
 	_u.mutation.ClearCompanies()
@@ -282,6 +644,27 @@ func (_u *TenantUpdate) RemoveIndividuals(v ...*Individual) *TenantUpdate {
 	return _u.RemoveIndividualIDs(ids...)
 }
 
+// ClearMaintenanceWindows clears all "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_u *TenantUpdate) ClearMaintenanceWindows() *TenantUpdate {
+	_u.mutation.ClearMaintenanceWindows()
+	return _u
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to MaintenanceWindow entities by IDs.
+func (_u *TenantUpdate) RemoveMaintenanceWindowIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveMaintenanceWindowIDs(ids...)
+	return _u
+}
+
+// RemoveMaintenanceWindows removes "maintenance_windows" edges to MaintenanceWindow entities.
+func (_u *TenantUpdate) RemoveMaintenanceWindows(v ...*MaintenanceWindow) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMaintenanceWindowIDs(ids...)
+}
+
 // ClearOptions clears all "options" edges to the CarOption entity.
 func (_u *TenantUpdate) ClearOptions() *TenantUpdate {
 	_u.mutation.ClearOptions()
@@ -303,6 +686,27 @@ func (_u *TenantUpdate) RemoveOptions(v ...*CarOption) *TenantUpdate {
 	return _u.RemoveOptionIDs(ids...)
 }
 
+// ClearRentalHandovers clears all "rental_handovers" edges to the RentalHandover entity.
+func (_u *TenantUpdate) ClearRentalHandovers() *TenantUpdate {
+	_u.mutation.ClearRentalHandovers()
+	return _u
+}
+
+// RemoveRentalHandoverIDs removes the "rental_handovers" edge to RentalHandover entities by IDs.
+func (_u *TenantUpdate) RemoveRentalHandoverIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveRentalHandoverIDs(ids...)
+	return _u
+}
+
+// RemoveRentalHandovers removes "rental_handovers" edges to RentalHandover entities.
+func (_u *TenantUpdate) RemoveRentalHandovers(v ...*RentalHandover) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRentalHandoverIDs(ids...)
+}
+
 // ClearRentalOptions clears all "rental_options" edges to the RentalOption entity.
 func (_u *TenantUpdate) ClearRentalOptions() *TenantUpdate {
 	_u.mutation.ClearRentalOptions()
@@ -366,6 +770,54 @@ func (_u *TenantUpdate) RemoveRenters(v ...*Renter) *TenantUpdate {
 	return _u.RemoveRenterIDs(ids...)
 }
 
+// ClearSettings clears the "settings" edge to the TenantSetting entity.
+func (_u *TenantUpdate) ClearSettings() *TenantUpdate {
+	_u.mutation.ClearSettings()
+	return _u
+}
+
+// ClearWebhookEndpoints clears all "webhook_endpoints" edges to the WebhookEndpoint entity.
+func (_u *TenantUpdate) ClearWebhookEndpoints() *TenantUpdate {
+	_u.mutation.ClearWebhookEndpoints()
+	return _u
+}
+
+// RemoveWebhookEndpointIDs removes the "webhook_endpoints" edge to WebhookEndpoint entities by IDs.
+func (_u *TenantUpdate) RemoveWebhookEndpointIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveWebhookEndpointIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookEndpoints removes "webhook_endpoints" edges to WebhookEndpoint entities.
+func (_u *TenantUpdate) RemoveWebhookEndpoints(v ...*WebhookEndpoint) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookEndpointIDs(ids...)
+}
+
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_u *TenantUpdate) ClearWebhookDeliveries() *TenantUpdate {
+	_u.mutation.ClearWebhookDeliveries()
+	return _u
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (_u *TenantUpdate) RemoveWebhookDeliveryIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (_u *TenantUpdate) RemoveWebhookDeliveries(v ...*WebhookDelivery) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookDeliveryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TenantUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -400,12 +852,32 @@ func (_u *TenantUpdate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`entgen: validator failed for field "Tenant.code": %w`, err)}
 		}
 	}
-	return nil
-}
-
-func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+	if v, ok := _u.mutation.Status(); ok {
+		if err := tenant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "Tenant.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlanID(); ok {
+		if err := tenant.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`entgen: validator failed for field "Tenant.plan_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Isolation(); ok {
+		if err := tenant.IsolationValidator(v); err != nil {
+			return &ValidationError{Name: "isolation", err: fmt.Errorf(`entgen: validator failed for field "Tenant.isolation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ParentID(); ok {
+		if err := tenant.ParentIDValidator(v); err != nil {
+			return &ValidationError{Name: "parent_id", err: fmt.Errorf(`entgen: validator failed for field "Tenant.parent_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
@@ -418,6 +890,24 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Code(); ok {
 		_spec.SetField(tenant.FieldCode, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenant.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SuspendedAt(); ok {
+		_spec.SetField(tenant.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedAtCleared() {
+		_spec.ClearField(tenant.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Isolation(); ok {
+		_spec.SetField(tenant.FieldIsolation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PurgeAt(); ok {
+		_spec.SetField(tenant.FieldPurgeAt, field.TypeTime, value)
+	}
+	if _u.mutation.PurgeAtCleared() {
+		_spec.ClearField(tenant.FieldPurgeAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -436,6 +926,199 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DeletedAtCleared() {
 		_spec.ClearField(tenant.FieldDeletedAt, field.TypeTime)
 	}
+	if _u.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.ParentTable,
+			Columns: []string{tenant.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.ParentTable,
+			Columns: []string{tenant.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.APIKeysTable,
+			Columns: []string{tenant.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !_u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.APIKeysTable,
+			Columns: []string{tenant.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.APIKeysTable,
+			Columns: []string{tenant.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BranchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BranchesTable,
+			Columns: []string{tenant.BranchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBranchesIDs(); len(nodes) > 0 && !_u.mutation.BranchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BranchesTable,
+			Columns: []string{tenant.BranchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BranchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BranchesTable,
+			Columns: []string{tenant.BranchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CarsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -481,6 +1164,51 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CarModelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CarModelsTable,
+			Columns: []string{tenant.CarModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCarModelsIDs(); len(nodes) > 0 && !_u.mutation.CarModelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CarModelsTable,
+			Columns: []string{tenant.CarModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CarModelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CarModelsTable,
+			Columns: []string{tenant.CarModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CompaniesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -571,6 +1299,51 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceWindowsTable,
+			Columns: []string{tenant.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMaintenanceWindowsIDs(); len(nodes) > 0 && !_u.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceWindowsTable,
+			Columns: []string{tenant.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceWindowsTable,
+			Columns: []string{tenant.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.OptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -616,6 +1389,51 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RentalHandoversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RentalHandoversTable,
+			Columns: []string{tenant.RentalHandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRentalHandoversIDs(); len(nodes) > 0 && !_u.mutation.RentalHandoversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RentalHandoversTable,
+			Columns: []string{tenant.RentalHandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RentalHandoversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RentalHandoversTable,
+			Columns: []string{tenant.RentalHandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.RentalOptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -751,10 +1569,129 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{tenant.Label}
-		} else if sqlgraph.IsConstraintError(err) {
+	if _u.mutation.SettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookEndpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookEndpointsTable,
+			Columns: []string{tenant.WebhookEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookEndpointsIDs(); len(nodes) > 0 && !_u.mutation.WebhookEndpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookEndpointsTable,
+			Columns: []string{tenant.WebhookEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookEndpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookEndpointsTable,
+			Columns: []string{tenant.WebhookEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookDeliveriesTable,
+			Columns: []string{tenant.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookDeliveriesTable,
+			Columns: []string{tenant.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookDeliveriesTable,
+			Columns: []string{tenant.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
 		return 0, err
@@ -785,6 +1722,114 @@ func (_u *TenantUpdateOne) SetNillableCode(v *string) *TenantUpdateOne {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *TenantUpdateOne) SetStatus(v string) *TenantUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableStatus(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_u *TenantUpdateOne) SetSuspendedAt(v time.Time) *TenantUpdateOne {
+	_u.mutation.SetSuspendedAt(v)
+	return _u
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableSuspendedAt(v *time.Time) *TenantUpdateOne {
+	if v != nil {
+		_u.SetSuspendedAt(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (_u *TenantUpdateOne) ClearSuspendedAt() *TenantUpdateOne {
+	_u.mutation.ClearSuspendedAt()
+	return _u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_u *TenantUpdateOne) SetPlanID(v string) *TenantUpdateOne {
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillablePlanID(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (_u *TenantUpdateOne) ClearPlanID() *TenantUpdateOne {
+	_u.mutation.ClearPlanID()
+	return _u
+}
+
+// SetIsolation sets the "isolation" field.
+func (_u *TenantUpdateOne) SetIsolation(v string) *TenantUpdateOne {
+	_u.mutation.SetIsolation(v)
+	return _u
+}
+
+// SetNillableIsolation sets the "isolation" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableIsolation(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetIsolation(*v)
+	}
+	return _u
+}
+
+// SetParentID sets the "parent_id" field.
+func (_u *TenantUpdateOne) SetParentID(v string) *TenantUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableParentID(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *TenantUpdateOne) ClearParentID() *TenantUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetPurgeAt sets the "purge_at" field.
+func (_u *TenantUpdateOne) SetPurgeAt(v time.Time) *TenantUpdateOne {
+	_u.mutation.SetPurgeAt(v)
+	return _u
+}
+
+// SetNillablePurgeAt sets the "purge_at" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillablePurgeAt(v *time.Time) *TenantUpdateOne {
+	if v != nil {
+		_u.SetPurgeAt(*v)
+	}
+	return _u
+}
+
+// ClearPurgeAt clears the value of the "purge_at" field.
+func (_u *TenantUpdateOne) ClearPurgeAt() *TenantUpdateOne {
+	_u.mutation.ClearPurgeAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *TenantUpdateOne) SetCreatedAt(v time.Time) *TenantUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -845,6 +1890,61 @@ func (_u *TenantUpdateOne) ClearDeletedAt() *TenantUpdateOne {
 	return _u
 }
 
+// SetPlan sets the "plan" edge to the Plan entity.
+func (_u *TenantUpdateOne) SetPlan(v *Plan) *TenantUpdateOne {
+	return _u.SetPlanID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Tenant entity.
+func (_u *TenantUpdateOne) SetParent(v *Tenant) *TenantUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Tenant entity by IDs.
+func (_u *TenantUpdateOne) AddChildIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Tenant entity.
+func (_u *TenantUpdateOne) AddChildren(v ...*Tenant) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (_u *TenantUpdateOne) AddAPIKeyIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddAPIKeyIDs(ids...)
+	return _u
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (_u *TenantUpdateOne) AddAPIKeys(v ...*APIKey) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAPIKeyIDs(ids...)
+}
+
+// AddBranchIDs adds the "branches" edge to the Branch entity by IDs.
+func (_u *TenantUpdateOne) AddBranchIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddBranchIDs(ids...)
+	return _u
+}
+
+// AddBranches adds the "branches" edges to the Branch entity.
+func (_u *TenantUpdateOne) AddBranches(v ...*Branch) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBranchIDs(ids...)
+}
+
 // AddCarIDs adds the "cars" edge to the Car entity by IDs.
 func (_u *TenantUpdateOne) AddCarIDs(ids ...string) *TenantUpdateOne {
 	_u.mutation.AddCarIDs(ids...)
@@ -860,6 +1960,21 @@ func (_u *TenantUpdateOne) AddCars(v ...*Car) *TenantUpdateOne {
 	return _u.AddCarIDs(ids...)
 }
 
+// AddCarModelIDs adds the "car_models" edge to the CarModel entity by IDs.
+func (_u *TenantUpdateOne) AddCarModelIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddCarModelIDs(ids...)
+	return _u
+}
+
+// AddCarModels adds the "car_models" edges to the CarModel entity.
+func (_u *TenantUpdateOne) AddCarModels(v ...*CarModel) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCarModelIDs(ids...)
+}
+
 // AddCompanyIDs adds the "companies" edge to the Company entity by IDs.
 func (_u *TenantUpdateOne) AddCompanyIDs(ids ...string) *TenantUpdateOne {
 	_u.mutation.AddCompanyIDs(ids...)
@@ -890,6 +2005,21 @@ func (_u *TenantUpdateOne) AddIndividuals(v ...*Individual) *TenantUpdateOne {
 	return _u.AddIndividualIDs(ids...)
 }
 
+// AddMaintenanceWindowIDs adds the "maintenance_windows" edge to the MaintenanceWindow entity by IDs.
+func (_u *TenantUpdateOne) AddMaintenanceWindowIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddMaintenanceWindowIDs(ids...)
+	return _u
+}
+
+// AddMaintenanceWindows adds the "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_u *TenantUpdateOne) AddMaintenanceWindows(v ...*MaintenanceWindow) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMaintenanceWindowIDs(ids...)
+}
+
 // AddOptionIDs adds the "options" edge to the CarOption entity by IDs.
 func (_u *TenantUpdateOne) AddOptionIDs(ids ...string) *TenantUpdateOne {
 	_u.mutation.AddOptionIDs(ids...)
@@ -905,6 +2035,21 @@ func (_u *TenantUpdateOne) AddOptions(v ...*CarOption) *TenantUpdateOne {
 	return _u.AddOptionIDs(ids...)
 }
 
+// AddRentalHandoverIDs adds the "rental_handovers" edge to the RentalHandover entity by IDs.
+func (_u *TenantUpdateOne) AddRentalHandoverIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddRentalHandoverIDs(ids...)
+	return _u
+}
+
+// AddRentalHandovers adds the "rental_handovers" edges to the RentalHandover entity.
+func (_u *TenantUpdateOne) AddRentalHandovers(v ...*RentalHandover) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRentalHandoverIDs(ids...)
+}
+
 // AddRentalOptionIDs adds the "rental_options" edge to the RentalOption entity by IDs.
 func (_u *TenantUpdateOne) AddRentalOptionIDs(ids ...string) *TenantUpdateOne {
 	_u.mutation.AddRentalOptionIDs(ids...)
@@ -950,11 +2095,135 @@ func (_u *TenantUpdateOne) AddRenters(v ...*Renter) *TenantUpdateOne {
 	return _u.AddRenterIDs(ids...)
 }
 
+// SetSettingsID sets the "settings" edge to the TenantSetting entity by ID.
+func (_u *TenantUpdateOne) SetSettingsID(id string) *TenantUpdateOne {
+	_u.mutation.SetSettingsID(id)
+	return _u
+}
+
+// SetNillableSettingsID sets the "settings" edge to the TenantSetting entity by ID if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableSettingsID(id *string) *TenantUpdateOne {
+	if id != nil {
+		_u = _u.SetSettingsID(*id)
+	}
+	return _u
+}
+
+// SetSettings sets the "settings" edge to the TenantSetting entity.
+func (_u *TenantUpdateOne) SetSettings(v *TenantSetting) *TenantUpdateOne {
+	return _u.SetSettingsID(v.ID)
+}
+
+// AddWebhookEndpointIDs adds the "webhook_endpoints" edge to the WebhookEndpoint entity by IDs.
+func (_u *TenantUpdateOne) AddWebhookEndpointIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddWebhookEndpointIDs(ids...)
+	return _u
+}
+
+// AddWebhookEndpoints adds the "webhook_endpoints" edges to the WebhookEndpoint entity.
+func (_u *TenantUpdateOne) AddWebhookEndpoints(v ...*WebhookEndpoint) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookEndpointIDs(ids...)
+}
+
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (_u *TenantUpdateOne) AddWebhookDeliveryIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddWebhookDeliveryIDs(ids...)
+	return _u
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_u *TenantUpdateOne) AddWebhookDeliveries(v ...*WebhookDelivery) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookDeliveryIDs(ids...)
+}
+
 // Mutation returns the TenantMutation object of the builder.
 func (_u *TenantUpdateOne) Mutation() *TenantMutation {
 	return _u.mutation
 }
 
+// ClearPlan clears the "plan" edge to the Plan entity.
+func (_u *TenantUpdateOne) ClearPlan() *TenantUpdateOne {
+	_u.mutation.ClearPlan()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Tenant entity.
+func (_u *TenantUpdateOne) ClearParent() *TenantUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Tenant entity.
+func (_u *TenantUpdateOne) ClearChildren() *TenantUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Tenant entities by IDs.
+func (_u *TenantUpdateOne) RemoveChildIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Tenant entities.
+func (_u *TenantUpdateOne) RemoveChildren(v ...*Tenant) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearAPIKeys clears all "api_keys" edges to the APIKey entity.
+func (_u *TenantUpdateOne) ClearAPIKeys() *TenantUpdateOne {
+	_u.mutation.ClearAPIKeys()
+	return _u
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to APIKey entities by IDs.
+func (_u *TenantUpdateOne) RemoveAPIKeyIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveAPIKeyIDs(ids...)
+	return _u
+}
+
+// RemoveAPIKeys removes "api_keys" edges to APIKey entities.
+func (_u *TenantUpdateOne) RemoveAPIKeys(v ...*APIKey) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAPIKeyIDs(ids...)
+}
+
+// ClearBranches clears all "branches" edges to the Branch entity.
+func (_u *TenantUpdateOne) ClearBranches() *TenantUpdateOne {
+	_u.mutation.ClearBranches()
+	return _u
+}
+
+// RemoveBranchIDs removes the "branches" edge to Branch entities by IDs.
+func (_u *TenantUpdateOne) RemoveBranchIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveBranchIDs(ids...)
+	return _u
+}
+
+// RemoveBranches removes "branches" edges to Branch entities.
+func (_u *TenantUpdateOne) RemoveBranches(v ...*Branch) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBranchIDs(ids...)
+}
+
 // ClearCars clears all "cars" edges to the Car entity.
 func (_u *TenantUpdateOne) ClearCars() *TenantUpdateOne {
 	_u.mutation.ClearCars()
@@ -976,6 +2245,27 @@ func (_u *TenantUpdateOne) RemoveCars(v ...*Car) *TenantUpdateOne {
 	return _u.RemoveCarIDs(ids...)
 }
 
+// ClearCarModels clears all "car_models" edges to the CarModel entity.
+func (_u *TenantUpdateOne) ClearCarModels() *TenantUpdateOne {
+	_u.mutation.ClearCarModels()
+	return _u
+}
+
+// RemoveCarModelIDs removes the "car_models" edge to CarModel entities by IDs.
+func (_u *TenantUpdateOne) RemoveCarModelIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveCarModelIDs(ids...)
+	return _u
+}
+
+// RemoveCarModels removes "car_models" edges to CarModel entities.
+func (_u *TenantUpdateOne) RemoveCarModels(v ...*CarModel) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCarModelIDs(ids...)
+}
+
 // ClearCompanies clears all "companies" edges to the Company entity.
 func (_u *TenantUpdateOne) ClearCompanies() *TenantUpdateOne {
 	_u.mutation.ClearCompanies()
@@ -1018,6 +2308,27 @@ func (_u *TenantUpdateOne) RemoveIndividuals(v ...*Individual) *TenantUpdateOne
 	return _u.RemoveIndividualIDs(ids...)
 }
 
+// ClearMaintenanceWindows clears all "maintenance_windows" edges to the MaintenanceWindow entity.
+func (_u *TenantUpdateOne) ClearMaintenanceWindows() *TenantUpdateOne {
+	_u.mutation.ClearMaintenanceWindows()
+	return _u
+}
+
+// RemoveMaintenanceWindowIDs removes the "maintenance_windows" edge to MaintenanceWindow entities by IDs.
+func (_u *TenantUpdateOne) RemoveMaintenanceWindowIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveMaintenanceWindowIDs(ids...)
+	return _u
+}
+
+// RemoveMaintenanceWindows removes "maintenance_windows" edges to MaintenanceWindow entities.
+func (_u *TenantUpdateOne) RemoveMaintenanceWindows(v ...*MaintenanceWindow) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMaintenanceWindowIDs(ids...)
+}
+
 // ClearOptions clears all "options" edges to the CarOption entity.
 func (_u *TenantUpdateOne) ClearOptions() *TenantUpdateOne {
 	_u.mutation.ClearOptions()
@@ -1039,6 +2350,27 @@ func (_u *TenantUpdateOne) RemoveOptions(v ...*CarOption) *TenantUpdateOne {
 	return _u.RemoveOptionIDs(ids...)
 }
 
+// ClearRentalHandovers clears all "rental_handovers" edges to the RentalHandover entity.
+func (_u *TenantUpdateOne) ClearRentalHandovers() *TenantUpdateOne {
+	_u.mutation.ClearRentalHandovers()
+	return _u
+}
+
+// RemoveRentalHandoverIDs removes the "rental_handovers" edge to RentalHandover entities by IDs.
+func (_u *TenantUpdateOne) RemoveRentalHandoverIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveRentalHandoverIDs(ids...)
+	return _u
+}
+
+// RemoveRentalHandovers removes "rental_handovers" edges to RentalHandover entities.
+func (_u *TenantUpdateOne) RemoveRentalHandovers(v ...*RentalHandover) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRentalHandoverIDs(ids...)
+}
+
 // ClearRentalOptions clears all "rental_options" edges to the RentalOption entity.
 func (_u *TenantUpdateOne) ClearRentalOptions() *TenantUpdateOne {
 	_u.mutation.ClearRentalOptions()
@@ -1102,6 +2434,54 @@ func (_u *TenantUpdateOne) RemoveRenters(v ...*Renter) *TenantUpdateOne {
 	return _u.RemoveRenterIDs(ids...)
 }
 
+// ClearSettings clears the "settings" edge to the TenantSetting entity.
+func (_u *TenantUpdateOne) ClearSettings() *TenantUpdateOne {
+	_u.mutation.ClearSettings()
+	return _u
+}
+
+// ClearWebhookEndpoints clears all "webhook_endpoints" edges to the WebhookEndpoint entity.
+func (_u *TenantUpdateOne) ClearWebhookEndpoints() *TenantUpdateOne {
+	_u.mutation.ClearWebhookEndpoints()
+	return _u
+}
+
+// RemoveWebhookEndpointIDs removes the "webhook_endpoints" edge to WebhookEndpoint entities by IDs.
+func (_u *TenantUpdateOne) RemoveWebhookEndpointIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveWebhookEndpointIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookEndpoints removes "webhook_endpoints" edges to WebhookEndpoint entities.
+func (_u *TenantUpdateOne) RemoveWebhookEndpoints(v ...*WebhookEndpoint) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookEndpointIDs(ids...)
+}
+
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_u *TenantUpdateOne) ClearWebhookDeliveries() *TenantUpdateOne {
+	_u.mutation.ClearWebhookDeliveries()
+	return _u
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (_u *TenantUpdateOne) RemoveWebhookDeliveryIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (_u *TenantUpdateOne) RemoveWebhookDeliveries(v ...*WebhookDelivery) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookDeliveryIDs(ids...)
+}
+
 // Where appends a list predicates to the TenantUpdate builder.
 func (_u *TenantUpdateOne) Where(ps ...predicate.Tenant) *TenantUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1149,6 +2529,26 @@ func (_u *TenantUpdateOne) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`entgen: validator failed for field "Tenant.code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := tenant.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`entgen: validator failed for field "Tenant.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PlanID(); ok {
+		if err := tenant.PlanIDValidator(v); err != nil {
+			return &ValidationError{Name: "plan_id", err: fmt.Errorf(`entgen: validator failed for field "Tenant.plan_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Isolation(); ok {
+		if err := tenant.IsolationValidator(v); err != nil {
+			return &ValidationError{Name: "isolation", err: fmt.Errorf(`entgen: validator failed for field "Tenant.isolation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ParentID(); ok {
+		if err := tenant.ParentIDValidator(v); err != nil {
+			return &ValidationError{Name: "parent_id", err: fmt.Errorf(`entgen: validator failed for field "Tenant.parent_id": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1184,23 +2584,234 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 	if value, ok := _u.mutation.Code(); ok {
 		_spec.SetField(tenant.FieldCode, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenant.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SuspendedAt(); ok {
+		_spec.SetField(tenant.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedAtCleared() {
+		_spec.ClearField(tenant.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Isolation(); ok {
+		_spec.SetField(tenant.FieldIsolation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PurgeAt(); ok {
+		_spec.SetField(tenant.FieldPurgeAt, field.TypeTime, value)
+	}
+	if _u.mutation.PurgeAtCleared() {
+		_spec.ClearField(tenant.FieldPurgeAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(tenant.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(tenant.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(tenant.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(tenant.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.PlanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PlanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.PlanTable,
+			Columns: []string{tenant.PlanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(plan.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.ParentTable,
+			Columns: []string{tenant.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tenant.ParentTable,
+			Columns: []string{tenant.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.ChildrenTable,
+			Columns: []string{tenant.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.APIKeysTable,
+			Columns: []string{tenant.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.CreatedAtCleared() {
-		_spec.ClearField(tenant.FieldCreatedAt, field.TypeTime)
+	if nodes := _u.mutation.RemovedAPIKeysIDs(); len(nodes) > 0 && !_u.mutation.APIKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.APIKeysTable,
+			Columns: []string{tenant.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(tenant.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := _u.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.APIKeysTable,
+			Columns: []string{tenant.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.UpdatedAtCleared() {
-		_spec.ClearField(tenant.FieldUpdatedAt, field.TypeTime)
+	if _u.mutation.BranchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BranchesTable,
+			Columns: []string{tenant.BranchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.DeletedAt(); ok {
-		_spec.SetField(tenant.FieldDeletedAt, field.TypeTime, value)
+	if nodes := _u.mutation.RemovedBranchesIDs(); len(nodes) > 0 && !_u.mutation.BranchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BranchesTable,
+			Columns: []string{tenant.BranchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.DeletedAtCleared() {
-		_spec.ClearField(tenant.FieldDeletedAt, field.TypeTime)
+	if nodes := _u.mutation.BranchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.BranchesTable,
+			Columns: []string{tenant.BranchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(branch.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _u.mutation.CarsCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -1247,6 +2858,51 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CarModelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CarModelsTable,
+			Columns: []string{tenant.CarModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCarModelsIDs(); len(nodes) > 0 && !_u.mutation.CarModelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CarModelsTable,
+			Columns: []string{tenant.CarModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CarModelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.CarModelsTable,
+			Columns: []string{tenant.CarModelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carmodel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CompaniesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1337,6 +2993,51 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceWindowsTable,
+			Columns: []string{tenant.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMaintenanceWindowsIDs(); len(nodes) > 0 && !_u.mutation.MaintenanceWindowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceWindowsTable,
+			Columns: []string{tenant.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MaintenanceWindowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.MaintenanceWindowsTable,
+			Columns: []string{tenant.MaintenanceWindowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(maintenancewindow.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.OptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1382,6 +3083,51 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RentalHandoversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RentalHandoversTable,
+			Columns: []string{tenant.RentalHandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRentalHandoversIDs(); len(nodes) > 0 && !_u.mutation.RentalHandoversCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RentalHandoversTable,
+			Columns: []string{tenant.RentalHandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RentalHandoversIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.RentalHandoversTable,
+			Columns: []string{tenant.RentalHandoversColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rentalhandover.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.RentalOptionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1517,6 +3263,125 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SettingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   tenant.SettingsTable,
+			Columns: []string{tenant.SettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookEndpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookEndpointsTable,
+			Columns: []string{tenant.WebhookEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookEndpointsIDs(); len(nodes) > 0 && !_u.mutation.WebhookEndpointsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookEndpointsTable,
+			Columns: []string{tenant.WebhookEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookEndpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookEndpointsTable,
+			Columns: []string{tenant.WebhookEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookDeliveriesTable,
+			Columns: []string{tenant.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookDeliveriesTable,
+			Columns: []string{tenant.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.WebhookDeliveriesTable,
+			Columns: []string{tenant.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Tenant{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
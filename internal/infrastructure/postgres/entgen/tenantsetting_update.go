@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+)
+
+// TenantSettingUpdate is the builder for updating TenantSetting entities.
+type TenantSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantSettingMutation
+}
+
+// Where appends a list predicates to the TenantSettingUpdate builder.
+func (_u *TenantSettingUpdate) Where(ps ...predicate.TenantSetting) *TenantSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TenantSettingUpdate) SetTenantID(v string) *TenantSettingUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableTenantID(v *string) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *TenantSettingUpdate) SetTimezone(v string) *TenantSettingUpdate {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableTimezone(v *string) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TenantSettingUpdate) SetCurrency(v string) *TenantSettingUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableCurrency(v *string) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *TenantSettingUpdate) SetLocale(v string) *TenantSettingUpdate {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableLocale(v *string) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (_u *TenantSettingUpdate) SetBusinessHours(v []schema.OpeningHours) *TenantSettingUpdate {
+	_u.mutation.SetBusinessHours(v)
+	return _u
+}
+
+// AppendBusinessHours appends value to the "business_hours" field.
+func (_u *TenantSettingUpdate) AppendBusinessHours(v []schema.OpeningHours) *TenantSettingUpdate {
+	_u.mutation.AppendBusinessHours(v)
+	return _u
+}
+
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (_u *TenantSettingUpdate) ClearBusinessHours() *TenantSettingUpdate {
+	_u.mutation.ClearBusinessHours()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TenantSettingUpdate) SetCreatedAt(v time.Time) *TenantSettingUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableCreatedAt(v *time.Time) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *TenantSettingUpdate) ClearCreatedAt() *TenantSettingUpdate {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantSettingUpdate) SetUpdatedAt(v time.Time) *TenantSettingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TenantSettingUpdate) SetNillableUpdatedAt(v *time.Time) *TenantSettingUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TenantSettingUpdate) ClearUpdatedAt() *TenantSettingUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *TenantSettingUpdate) SetTenant(v *Tenant) *TenantSettingUpdate {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (_u *TenantSettingUpdate) Mutation() *TenantSettingMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TenantSettingUpdate) ClearTenant() *TenantSettingUpdate {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantSettingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSettingUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := tenantsetting.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "TenantSetting.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := tenantsetting.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`entgen: validator failed for field "TenantSetting.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := tenantsetting.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`entgen: validator failed for field "TenantSetting.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := tenantsetting.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`entgen: validator failed for field "TenantSetting.locale": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "TenantSetting.tenant"`)
+	}
+	return nil
+}
+
+func (_u *TenantSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(tenantsetting.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(tenantsetting.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(tenantsetting.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BusinessHours(); ok {
+		_spec.SetField(tenantsetting.FieldBusinessHours, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBusinessHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldBusinessHours, value)
+		})
+	}
+	if _u.mutation.BusinessHoursCleared() {
+		_spec.ClearField(tenantsetting.FieldBusinessHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantsetting.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(tenantsetting.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantsetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(tenantsetting.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   tenantsetting.TenantTable,
+			Columns: []string{tenantsetting.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   tenantsetting.TenantTable,
+			Columns: []string{tenantsetting.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantSettingUpdateOne is the builder for updating a single TenantSetting entity.
+type TenantSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantSettingMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *TenantSettingUpdateOne) SetTenantID(v string) *TenantSettingUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableTenantID(v *string) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *TenantSettingUpdateOne) SetTimezone(v string) *TenantSettingUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableTimezone(v *string) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TenantSettingUpdateOne) SetCurrency(v string) *TenantSettingUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableCurrency(v *string) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetLocale sets the "locale" field.
+func (_u *TenantSettingUpdateOne) SetLocale(v string) *TenantSettingUpdateOne {
+	_u.mutation.SetLocale(v)
+	return _u
+}
+
+// SetNillableLocale sets the "locale" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableLocale(v *string) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetLocale(*v)
+	}
+	return _u
+}
+
+// SetBusinessHours sets the "business_hours" field.
+func (_u *TenantSettingUpdateOne) SetBusinessHours(v []schema.OpeningHours) *TenantSettingUpdateOne {
+	_u.mutation.SetBusinessHours(v)
+	return _u
+}
+
+// AppendBusinessHours appends value to the "business_hours" field.
+func (_u *TenantSettingUpdateOne) AppendBusinessHours(v []schema.OpeningHours) *TenantSettingUpdateOne {
+	_u.mutation.AppendBusinessHours(v)
+	return _u
+}
+
+// ClearBusinessHours clears the value of the "business_hours" field.
+func (_u *TenantSettingUpdateOne) ClearBusinessHours() *TenantSettingUpdateOne {
+	_u.mutation.ClearBusinessHours()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *TenantSettingUpdateOne) SetCreatedAt(v time.Time) *TenantSettingUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableCreatedAt(v *time.Time) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (_u *TenantSettingUpdateOne) ClearCreatedAt() *TenantSettingUpdateOne {
+	_u.mutation.ClearCreatedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantSettingUpdateOne) SetUpdatedAt(v time.Time) *TenantSettingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TenantSettingUpdateOne) SetNillableUpdatedAt(v *time.Time) *TenantSettingUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TenantSettingUpdateOne) ClearUpdatedAt() *TenantSettingUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_u *TenantSettingUpdateOne) SetTenant(v *Tenant) *TenantSettingUpdateOne {
+	return _u.SetTenantID(v.ID)
+}
+
+// Mutation returns the TenantSettingMutation object of the builder.
+func (_u *TenantSettingUpdateOne) Mutation() *TenantSettingMutation {
+	return _u.mutation
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (_u *TenantSettingUpdateOne) ClearTenant() *TenantSettingUpdateOne {
+	_u.mutation.ClearTenant()
+	return _u
+}
+
+// Where appends a list predicates to the TenantSettingUpdate builder.
+func (_u *TenantSettingUpdateOne) Where(ps ...predicate.TenantSetting) *TenantSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantSettingUpdateOne) Select(field string, fields ...string) *TenantSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantSetting entity.
+func (_u *TenantSettingUpdateOne) Save(ctx context.Context) (*TenantSetting, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantSettingUpdateOne) SaveX(ctx context.Context) *TenantSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantSettingUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := tenantsetting.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "TenantSetting.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Timezone(); ok {
+		if err := tenantsetting.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`entgen: validator failed for field "TenantSetting.timezone": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := tenantsetting.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`entgen: validator failed for field "TenantSetting.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Locale(); ok {
+		if err := tenantsetting.LocaleValidator(v); err != nil {
+			return &ValidationError{Name: "locale", err: fmt.Errorf(`entgen: validator failed for field "TenantSetting.locale": %w`, err)}
+		}
+	}
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`entgen: clearing a required unique edge "TenantSetting.tenant"`)
+	}
+	return nil
+}
+
+func (_u *TenantSettingUpdateOne) sqlSave(ctx context.Context) (_node *TenantSetting, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantsetting.Table, tenantsetting.Columns, sqlgraph.NewFieldSpec(tenantsetting.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "TenantSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantsetting.FieldID)
+		for _, f := range fields {
+			if !tenantsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != tenantsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(tenantsetting.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(tenantsetting.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Locale(); ok {
+		_spec.SetField(tenantsetting.FieldLocale, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BusinessHours(); ok {
+		_spec.SetField(tenantsetting.FieldBusinessHours, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBusinessHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantsetting.FieldBusinessHours, value)
+		})
+	}
+	if _u.mutation.BusinessHoursCleared() {
+		_spec.ClearField(tenantsetting.FieldBusinessHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantsetting.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(tenantsetting.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantsetting.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(tenantsetting.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   tenantsetting.TenantTable,
+			Columns: []string{tenantsetting.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   tenantsetting.TenantTable,
+			Columns: []string{tenantsetting.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TenantSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package entgen
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,24 +14,52 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// ArchiveJob is the client for interacting with the ArchiveJob builders.
+	ArchiveJob *ArchiveJobClient
+	// Branch is the client for interacting with the Branch builders.
+	Branch *BranchClient
 	// Car is the client for interacting with the Car builders.
 	Car *CarClient
+	// CarModel is the client for interacting with the CarModel builders.
+	CarModel *CarModelClient
 	// CarOption is the client for interacting with the CarOption builders.
 	CarOption *CarOptionClient
 	// Company is the client for interacting with the Company builders.
 	Company *CompanyClient
+	// FleetSharingAgreement is the client for interacting with the FleetSharingAgreement builders.
+	FleetSharingAgreement *FleetSharingAgreementClient
+	// Inbox is the client for interacting with the Inbox builders.
+	Inbox *InboxClient
 	// Individual is the client for interacting with the Individual builders.
 	Individual *IndividualClient
+	// MaintenanceWindow is the client for interacting with the MaintenanceWindow builders.
+	MaintenanceWindow *MaintenanceWindowClient
 	// Outbox is the client for interacting with the Outbox builders.
 	Outbox *OutboxClient
+	// Plan is the client for interacting with the Plan builders.
+	Plan *PlanClient
 	// Rental is the client for interacting with the Rental builders.
 	Rental *RentalClient
+	// RentalHandover is the client for interacting with the RentalHandover builders.
+	RentalHandover *RentalHandoverClient
 	// RentalOption is the client for interacting with the RentalOption builders.
 	RentalOption *RentalOptionClient
 	// Renter is the client for interacting with the Renter builders.
 	Renter *RenterClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantSetting is the client for interacting with the TenantSetting builders.
+	TenantSetting *TenantSettingClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
+	// UsageRollup is the client for interacting with the UsageRollup builders.
+	UsageRollup *UsageRollupClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 
 	// lazily loaded.
 	client     *Client
@@ -161,15 +191,29 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.ArchiveJob = NewArchiveJobClient(tx.config)
+	tx.Branch = NewBranchClient(tx.config)
 	tx.Car = NewCarClient(tx.config)
+	tx.CarModel = NewCarModelClient(tx.config)
 	tx.CarOption = NewCarOptionClient(tx.config)
 	tx.Company = NewCompanyClient(tx.config)
+	tx.FleetSharingAgreement = NewFleetSharingAgreementClient(tx.config)
+	tx.Inbox = NewInboxClient(tx.config)
 	tx.Individual = NewIndividualClient(tx.config)
+	tx.MaintenanceWindow = NewMaintenanceWindowClient(tx.config)
 	tx.Outbox = NewOutboxClient(tx.config)
+	tx.Plan = NewPlanClient(tx.config)
 	tx.Rental = NewRentalClient(tx.config)
+	tx.RentalHandover = NewRentalHandoverClient(tx.config)
 	tx.RentalOption = NewRentalOptionClient(tx.config)
 	tx.Renter = NewRenterClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
+	tx.TenantSetting = NewTenantSettingClient(tx.config)
+	tx.UsageRecord = NewUsageRecordClient(tx.config)
+	tx.UsageRollup = NewUsageRollupClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookEndpoint = NewWebhookEndpointClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -179,7 +223,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Car.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -232,3 +276,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
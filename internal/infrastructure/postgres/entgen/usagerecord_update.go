@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerecord"
+)
+
+// UsageRecordUpdate is the builder for updating UsageRecord entities.
+type UsageRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (_u *UsageRecordUpdate) Where(ps ...predicate.UsageRecord) *UsageRecordUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *UsageRecordUpdate) SetTenantID(v string) *UsageRecordUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableTenantID(v *string) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMeter sets the "meter" field.
+func (_u *UsageRecordUpdate) SetMeter(v string) *UsageRecordUpdate {
+	_u.mutation.SetMeter(v)
+	return _u
+}
+
+// SetNillableMeter sets the "meter" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableMeter(v *string) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetMeter(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *UsageRecordUpdate) SetQuantity(v int64) *UsageRecordUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableQuantity(v *int64) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *UsageRecordUpdate) AddQuantity(v int64) *UsageRecordUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *UsageRecordUpdate) SetOccurredAt(v time.Time) *UsageRecordUpdate {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableOccurredAt(v *time.Time) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (_u *UsageRecordUpdate) SetRecordedAt(v time.Time) *UsageRecordUpdate {
+	_u.mutation.SetRecordedAt(v)
+	return _u
+}
+
+// SetNillableRecordedAt sets the "recorded_at" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableRecordedAt(v *time.Time) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetRecordedAt(*v)
+	}
+	return _u
+}
+
+// ClearRecordedAt clears the value of the "recorded_at" field.
+func (_u *UsageRecordUpdate) ClearRecordedAt() *UsageRecordUpdate {
+	_u.mutation.ClearRecordedAt()
+	return _u
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (_u *UsageRecordUpdate) Mutation() *UsageRecordMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageRecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageRecordUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageRecordUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageRecordUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := usagerecord.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "UsageRecord.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Meter(); ok {
+		if err := usagerecord.MeterValidator(v); err != nil {
+			return &ValidationError{Name: "meter", err: fmt.Errorf(`entgen: validator failed for field "UsageRecord.meter": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UsageRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(usagerecord.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Meter(); ok {
+		_spec.SetField(usagerecord.FieldMeter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(usagerecord.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(usagerecord.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(usagerecord.FieldOccurredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RecordedAt(); ok {
+		_spec.SetField(usagerecord.FieldRecordedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RecordedAtCleared() {
+		_spec.ClearField(usagerecord.FieldRecordedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageRecordUpdateOne is the builder for updating a single UsageRecord entity.
+type UsageRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *UsageRecordUpdateOne) SetTenantID(v string) *UsageRecordUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableTenantID(v *string) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMeter sets the "meter" field.
+func (_u *UsageRecordUpdateOne) SetMeter(v string) *UsageRecordUpdateOne {
+	_u.mutation.SetMeter(v)
+	return _u
+}
+
+// SetNillableMeter sets the "meter" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableMeter(v *string) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetMeter(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *UsageRecordUpdateOne) SetQuantity(v int64) *UsageRecordUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableQuantity(v *int64) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *UsageRecordUpdateOne) AddQuantity(v int64) *UsageRecordUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *UsageRecordUpdateOne) SetOccurredAt(v time.Time) *UsageRecordUpdateOne {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableOccurredAt(v *time.Time) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// SetRecordedAt sets the "recorded_at" field.
+func (_u *UsageRecordUpdateOne) SetRecordedAt(v time.Time) *UsageRecordUpdateOne {
+	_u.mutation.SetRecordedAt(v)
+	return _u
+}
+
+// SetNillableRecordedAt sets the "recorded_at" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableRecordedAt(v *time.Time) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetRecordedAt(*v)
+	}
+	return _u
+}
+
+// ClearRecordedAt clears the value of the "recorded_at" field.
+func (_u *UsageRecordUpdateOne) ClearRecordedAt() *UsageRecordUpdateOne {
+	_u.mutation.ClearRecordedAt()
+	return _u
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (_u *UsageRecordUpdateOne) Mutation() *UsageRecordMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (_u *UsageRecordUpdateOne) Where(ps ...predicate.UsageRecord) *UsageRecordUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageRecordUpdateOne) Select(field string, fields ...string) *UsageRecordUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageRecord entity.
+func (_u *UsageRecordUpdateOne) Save(ctx context.Context) (*UsageRecord, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageRecordUpdateOne) SaveX(ctx context.Context) *UsageRecord {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageRecordUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := usagerecord.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "UsageRecord.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Meter(); ok {
+		if err := usagerecord.MeterValidator(v); err != nil {
+			return &ValidationError{Name: "meter", err: fmt.Errorf(`entgen: validator failed for field "UsageRecord.meter": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UsageRecordUpdateOne) sqlSave(ctx context.Context) (_node *UsageRecord, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "UsageRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagerecord.FieldID)
+		for _, f := range fields {
+			if !usagerecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != usagerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(usagerecord.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Meter(); ok {
+		_spec.SetField(usagerecord.FieldMeter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(usagerecord.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(usagerecord.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(usagerecord.FieldOccurredAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RecordedAt(); ok {
+		_spec.SetField(usagerecord.FieldRecordedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RecordedAtCleared() {
+		_spec.ClearField(usagerecord.FieldRecordedAt, field.TypeTime)
+	}
+	_node = &UsageRecord{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usagerollup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Meter applies equality check predicate on the "meter" field. It's identical to MeterEQ.
+func Meter(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldMeter, v))
+}
+
+// Period applies equality check predicate on the "period" field. It's identical to PeriodEQ.
+func Period(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// MeterEQ applies the EQ predicate on the "meter" field.
+func MeterEQ(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldMeter, v))
+}
+
+// MeterNEQ applies the NEQ predicate on the "meter" field.
+func MeterNEQ(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNEQ(FieldMeter, v))
+}
+
+// MeterIn applies the In predicate on the "meter" field.
+func MeterIn(vs ...string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldIn(FieldMeter, vs...))
+}
+
+// MeterNotIn applies the NotIn predicate on the "meter" field.
+func MeterNotIn(vs ...string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNotIn(FieldMeter, vs...))
+}
+
+// MeterGT applies the GT predicate on the "meter" field.
+func MeterGT(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGT(FieldMeter, v))
+}
+
+// MeterGTE applies the GTE predicate on the "meter" field.
+func MeterGTE(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGTE(FieldMeter, v))
+}
+
+// MeterLT applies the LT predicate on the "meter" field.
+func MeterLT(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLT(FieldMeter, v))
+}
+
+// MeterLTE applies the LTE predicate on the "meter" field.
+func MeterLTE(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLTE(FieldMeter, v))
+}
+
+// MeterContains applies the Contains predicate on the "meter" field.
+func MeterContains(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldContains(FieldMeter, v))
+}
+
+// MeterHasPrefix applies the HasPrefix predicate on the "meter" field.
+func MeterHasPrefix(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldHasPrefix(FieldMeter, v))
+}
+
+// MeterHasSuffix applies the HasSuffix predicate on the "meter" field.
+func MeterHasSuffix(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldHasSuffix(FieldMeter, v))
+}
+
+// MeterEqualFold applies the EqualFold predicate on the "meter" field.
+func MeterEqualFold(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEqualFold(FieldMeter, v))
+}
+
+// MeterContainsFold applies the ContainsFold predicate on the "meter" field.
+func MeterContainsFold(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldContainsFold(FieldMeter, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// PeriodGT applies the GT predicate on the "period" field.
+func PeriodGT(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGT(FieldPeriod, v))
+}
+
+// PeriodGTE applies the GTE predicate on the "period" field.
+func PeriodGTE(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGTE(FieldPeriod, v))
+}
+
+// PeriodLT applies the LT predicate on the "period" field.
+func PeriodLT(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLT(FieldPeriod, v))
+}
+
+// PeriodLTE applies the LTE predicate on the "period" field.
+func PeriodLTE(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLTE(FieldPeriod, v))
+}
+
+// PeriodContains applies the Contains predicate on the "period" field.
+func PeriodContains(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldContains(FieldPeriod, v))
+}
+
+// PeriodHasPrefix applies the HasPrefix predicate on the "period" field.
+func PeriodHasPrefix(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldHasPrefix(FieldPeriod, v))
+}
+
+// PeriodHasSuffix applies the HasSuffix predicate on the "period" field.
+func PeriodHasSuffix(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldHasSuffix(FieldPeriod, v))
+}
+
+// PeriodEqualFold applies the EqualFold predicate on the "period" field.
+func PeriodEqualFold(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEqualFold(FieldPeriod, v))
+}
+
+// PeriodContainsFold applies the ContainsFold predicate on the "period" field.
+func PeriodContainsFold(v string) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldContainsFold(FieldPeriod, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int64) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.UsageRollup {
+	return predicate.UsageRollup(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UsageRollup) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UsageRollup) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UsageRollup) predicate.UsageRollup {
+	return predicate.UsageRollup(sql.NotPredicates(p))
+}
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerollup"
+)
+
+// UsageRollupCreate is the builder for creating a UsageRollup entity.
+type UsageRollupCreate struct {
+	config
+	mutation *UsageRollupMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *UsageRollupCreate) SetTenantID(v string) *UsageRollupCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetMeter sets the "meter" field.
+func (_c *UsageRollupCreate) SetMeter(v string) *UsageRollupCreate {
+	_c.mutation.SetMeter(v)
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *UsageRollupCreate) SetPeriod(v string) *UsageRollupCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *UsageRollupCreate) SetPeriodStart(v time.Time) *UsageRollupCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *UsageRollupCreate) SetQuantity(v int64) *UsageRollupCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *UsageRollupCreate) SetNillableQuantity(v *int64) *UsageRollupCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UsageRollupCreate) SetUpdatedAt(v time.Time) *UsageRollupCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UsageRollupCreate) SetNillableUpdatedAt(v *time.Time) *UsageRollupCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UsageRollupCreate) SetID(v string) *UsageRollupCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UsageRollupMutation object of the builder.
+func (_c *UsageRollupCreate) Mutation() *UsageRollupMutation {
+	return _c.mutation
+}
+
+// Save creates the UsageRollup in the database.
+func (_c *UsageRollupCreate) Save(ctx context.Context) (*UsageRollup, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UsageRollupCreate) SaveX(ctx context.Context) *UsageRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageRollupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageRollupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *UsageRollupCreate) defaults() {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := usagerollup.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UsageRollupCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`entgen: missing required field "UsageRollup.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := usagerollup.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Meter(); !ok {
+		return &ValidationError{Name: "meter", err: errors.New(`entgen: missing required field "UsageRollup.meter"`)}
+	}
+	if v, ok := _c.mutation.Meter(); ok {
+		if err := usagerollup.MeterValidator(v); err != nil {
+			return &ValidationError{Name: "meter", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.meter": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`entgen: missing required field "UsageRollup.period"`)}
+	}
+	if v, ok := _c.mutation.Period(); ok {
+		if err := usagerollup.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.period": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`entgen: missing required field "UsageRollup.period_start"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`entgen: missing required field "UsageRollup.quantity"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := usagerollup.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UsageRollupCreate) sqlSave(ctx context.Context) (*UsageRollup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected UsageRollup.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UsageRollupCreate) createSpec() (*UsageRollup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UsageRollup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usagerollup.Table, sqlgraph.NewFieldSpec(usagerollup.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(usagerollup.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Meter(); ok {
+		_spec.SetField(usagerollup.FieldMeter, field.TypeString, value)
+		_node.Meter = value
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(usagerollup.FieldPeriod, field.TypeString, value)
+		_node.Period = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(usagerollup.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(usagerollup.FieldQuantity, field.TypeInt64, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(usagerollup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// UsageRollupCreateBulk is the builder for creating many UsageRollup entities in bulk.
+type UsageRollupCreateBulk struct {
+	config
+	err      error
+	builders []*UsageRollupCreate
+}
+
+// Save creates the UsageRollup entities in the database.
+func (_c *UsageRollupCreateBulk) Save(ctx context.Context) ([]*UsageRollup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UsageRollup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UsageRollupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UsageRollupCreateBulk) SaveX(ctx context.Context) []*UsageRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UsageRollupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UsageRollupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerollup"
+)
+
+// UsageRollupUpdate is the builder for updating UsageRollup entities.
+type UsageRollupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageRollupMutation
+}
+
+// Where appends a list predicates to the UsageRollupUpdate builder.
+func (_u *UsageRollupUpdate) Where(ps ...predicate.UsageRollup) *UsageRollupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *UsageRollupUpdate) SetTenantID(v string) *UsageRollupUpdate {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *UsageRollupUpdate) SetNillableTenantID(v *string) *UsageRollupUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMeter sets the "meter" field.
+func (_u *UsageRollupUpdate) SetMeter(v string) *UsageRollupUpdate {
+	_u.mutation.SetMeter(v)
+	return _u
+}
+
+// SetNillableMeter sets the "meter" field if the given value is not nil.
+func (_u *UsageRollupUpdate) SetNillableMeter(v *string) *UsageRollupUpdate {
+	if v != nil {
+		_u.SetMeter(*v)
+	}
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *UsageRollupUpdate) SetPeriod(v string) *UsageRollupUpdate {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *UsageRollupUpdate) SetNillablePeriod(v *string) *UsageRollupUpdate {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *UsageRollupUpdate) SetPeriodStart(v time.Time) *UsageRollupUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *UsageRollupUpdate) SetNillablePeriodStart(v *time.Time) *UsageRollupUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *UsageRollupUpdate) SetQuantity(v int64) *UsageRollupUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *UsageRollupUpdate) SetNillableQuantity(v *int64) *UsageRollupUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *UsageRollupUpdate) AddQuantity(v int64) *UsageRollupUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UsageRollupUpdate) SetUpdatedAt(v time.Time) *UsageRollupUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UsageRollupUpdate) SetNillableUpdatedAt(v *time.Time) *UsageRollupUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *UsageRollupUpdate) ClearUpdatedAt() *UsageRollupUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// Mutation returns the UsageRollupMutation object of the builder.
+func (_u *UsageRollupUpdate) Mutation() *UsageRollupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageRollupUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageRollupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageRollupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageRollupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageRollupUpdate) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := usagerollup.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Meter(); ok {
+		if err := usagerollup.MeterValidator(v); err != nil {
+			return &ValidationError{Name: "meter", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.meter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Period(); ok {
+		if err := usagerollup.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.period": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UsageRollupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerollup.Table, usagerollup.Columns, sqlgraph.NewFieldSpec(usagerollup.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(usagerollup.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Meter(); ok {
+		_spec.SetField(usagerollup.FieldMeter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(usagerollup.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(usagerollup.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(usagerollup.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(usagerollup.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usagerollup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(usagerollup.FieldUpdatedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageRollupUpdateOne is the builder for updating a single UsageRollup entity.
+type UsageRollupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageRollupMutation
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *UsageRollupUpdateOne) SetTenantID(v string) *UsageRollupUpdateOne {
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *UsageRollupUpdateOne) SetNillableTenantID(v *string) *UsageRollupUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// SetMeter sets the "meter" field.
+func (_u *UsageRollupUpdateOne) SetMeter(v string) *UsageRollupUpdateOne {
+	_u.mutation.SetMeter(v)
+	return _u
+}
+
+// SetNillableMeter sets the "meter" field if the given value is not nil.
+func (_u *UsageRollupUpdateOne) SetNillableMeter(v *string) *UsageRollupUpdateOne {
+	if v != nil {
+		_u.SetMeter(*v)
+	}
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *UsageRollupUpdateOne) SetPeriod(v string) *UsageRollupUpdateOne {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *UsageRollupUpdateOne) SetNillablePeriod(v *string) *UsageRollupUpdateOne {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *UsageRollupUpdateOne) SetPeriodStart(v time.Time) *UsageRollupUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *UsageRollupUpdateOne) SetNillablePeriodStart(v *time.Time) *UsageRollupUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *UsageRollupUpdateOne) SetQuantity(v int64) *UsageRollupUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *UsageRollupUpdateOne) SetNillableQuantity(v *int64) *UsageRollupUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *UsageRollupUpdateOne) AddQuantity(v int64) *UsageRollupUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UsageRollupUpdateOne) SetUpdatedAt(v time.Time) *UsageRollupUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UsageRollupUpdateOne) SetNillableUpdatedAt(v *time.Time) *UsageRollupUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *UsageRollupUpdateOne) ClearUpdatedAt() *UsageRollupUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// Mutation returns the UsageRollupMutation object of the builder.
+func (_u *UsageRollupUpdateOne) Mutation() *UsageRollupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UsageRollupUpdate builder.
+func (_u *UsageRollupUpdateOne) Where(ps ...predicate.UsageRollup) *UsageRollupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageRollupUpdateOne) Select(field string, fields ...string) *UsageRollupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageRollup entity.
+func (_u *UsageRollupUpdateOne) Save(ctx context.Context) (*UsageRollup, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageRollupUpdateOne) SaveX(ctx context.Context) *UsageRollup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageRollupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageRollupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageRollupUpdateOne) check() error {
+	if v, ok := _u.mutation.TenantID(); ok {
+		if err := usagerollup.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.tenant_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Meter(); ok {
+		if err := usagerollup.MeterValidator(v); err != nil {
+			return &ValidationError{Name: "meter", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.meter": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Period(); ok {
+		if err := usagerollup.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`entgen: validator failed for field "UsageRollup.period": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UsageRollupUpdateOne) sqlSave(ctx context.Context) (_node *UsageRollup, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerollup.Table, usagerollup.Columns, sqlgraph.NewFieldSpec(usagerollup.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entgen: missing "UsageRollup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagerollup.FieldID)
+		for _, f := range fields {
+			if !usagerollup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entgen: invalid field %q for query", f)}
+			}
+			if f != usagerollup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(usagerollup.FieldTenantID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Meter(); ok {
+		_spec.SetField(usagerollup.FieldMeter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(usagerollup.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(usagerollup.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(usagerollup.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(usagerollup.FieldQuantity, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usagerollup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(usagerollup.FieldUpdatedAt, field.TypeTime)
+	}
+	_node = &UsageRollup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/migrate"
+)
+
+// TenantNamespace returns the name of the schema or database of a tenant isolated in its
+// own. Tenant codes are lowercase DNS labels, so the name is a valid identifier that never
+// needs quoting and cannot collide with another tenant's.
+func TenantNamespace(code string) string {
+	return "tenant_" + strings.ReplaceAll(code, "-", "_")
+}
+
+// TenantDatabaseTables are the shared tables that the database of a tenant isolated in its
+// own has a copy of. The outbox messages of the tenant's changes, and the inbox records of
+// the messages causing them, are written in the same transaction as the changes, which
+// cannot span databases.
+var TenantDatabaseTables = []string{
+	"outboxes",
+	"inboxes",
+}
+
+// TenantTables returns the tenant-scoped tables as they are created in the schema or
+// database of an isolated tenant. Foreign keys to the other tables are dropped, since
+// those tables stay in the shared schema.
+func TenantTables() []*schema.Table {
+	return tablesOf(TenantScopedTables)
+}
+
+// tablesOf returns the named tables without their foreign keys to the other tables
+func tablesOf(names []string) []*schema.Table {
+	tables := make([]*schema.Table, 0, len(names))
+	for _, t := range migrate.Tables {
+		if !slices.Contains(names, t.Name) {
+			continue
+		}
+		table := *t
+		table.ForeignKeys = slices.DeleteFunc(slices.Clone(t.ForeignKeys), func(fk *schema.ForeignKey) bool {
+			return !slices.Contains(names, fk.RefTable.Name)
+		})
+		tables = append(tables, &table)
+	}
+	return tables
+}
+
+// MigrateTenantSchema creates or upgrades the tenant-scoped tables in the schema of a
+// tenant isolated in its own, and isolates them like the shared ones. It must run as the
+// table owner after the shared tables are migrated, and is safe to run repeatedly.
+func MigrateTenantSchema(ctx context.Context, client *entgen.Client, schemaName, appRole string) error {
+	if _, err := client.ExecContext(ctx, "CREATE SCHEMA IF NOT EXISTS "+pgx.Identifier{schemaName}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to create schema %s: %w", schemaName, err)
+	}
+	if err := migrate.Create(ctx, client.Schema, TenantTables(), schema.WithSchemaName(schemaName)); err != nil {
+		return fmt.Errorf("failed to migrate schema %s: %w", schemaName, err)
+	}
+	if err := MigrateCarCatalog(ctx, client, schemaName); err != nil {
+		return err
+	}
+	return applyTenantPolicies(ctx, client, schemaName, appRole)
+}
+
+// CreateTenantDatabase creates the database of a tenant isolated in its own, unless it
+// already exists. client must be connected to another database of the same server.
+func CreateTenantDatabase(ctx context.Context, client *entgen.Client, name string) error {
+	rows, err := client.QueryContext(ctx, "SELECT 1 FROM pg_database WHERE datname = $1", name)
+	if err != nil {
+		return fmt.Errorf("failed to look up database %s: %w", name, err)
+	}
+	exists := rows.Next()
+	if err := rows.Close(); err != nil {
+		return fmt.Errorf("failed to look up database %s: %w", name, err)
+	}
+	if exists {
+		return nil
+	}
+
+	// CREATE DATABASE cannot run in a transaction
+	if _, err := client.ExecContext(ctx, "CREATE DATABASE "+pgx.Identifier{name}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to create database %s: %w", name, err)
+	}
+	return nil
+}
+
+// MigrateTenantDatabase creates or upgrades the tenant-scoped tables and the
+// TenantDatabaseTables in the database of a tenant isolated in its own, and isolates them
+// like the shared ones. client must be connected to that database as its owner. It is safe
+// to run repeatedly.
+func MigrateTenantDatabase(ctx context.Context, client *entgen.Client, appRole string) error {
+	tables := tablesOf(slices.Concat(TenantScopedTables, TenantDatabaseTables))
+	if err := migrate.Create(ctx, client.Schema, tables); err != nil {
+		return fmt.Errorf("failed to migrate tenant database: %w", err)
+	}
+	if err := MigrateCarCatalog(ctx, client, "public"); err != nil {
+		return err
+	}
+	return applyTenantPolicies(ctx, client, "public", appRole)
+}
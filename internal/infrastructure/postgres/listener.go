@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// OutboxChannel is the LISTEN/NOTIFY channel signalled whenever an outbox message is inserted
+const OutboxChannel = "outbox_events"
+
+// Reconnect backoff settings for the LISTEN connection
+const (
+	defaultListenMinBackoff = 500 * time.Millisecond
+	defaultListenMaxBackoff = 30 * time.Second
+)
+
+// Listener holds a dedicated pgx connection that LISTENs on a channel and turns
+// notifications into wakeup signals.
+//
+// Wakeups are coalesced: any number of notifications that arrive while a previous
+// wakeup is still unconsumed result in a single signal. A wakeup is also emitted
+// every time the connection is (re)established, so that a consumer drains anything
+// that was notified while the listener was disconnected.
+type Listener struct {
+	databaseURL string
+	channel     string
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	wakeups     chan struct{}
+}
+
+// NewListener creates a new Listener for the given channel
+func NewListener(databaseURL, channel string) *Listener {
+	return &Listener{
+		databaseURL: databaseURL,
+		channel:     channel,
+		minBackoff:  defaultListenMinBackoff,
+		maxBackoff:  defaultListenMaxBackoff,
+		wakeups:     make(chan struct{}, 1),
+	}
+}
+
+// Wakeups returns the channel on which wakeup signals are delivered
+func (l *Listener) Wakeups() <-chan struct{} {
+	return l.wakeups
+}
+
+// Run listens for notifications until ctx is cancelled, reconnecting with
+// exponential backoff whenever the connection is lost
+func (l *Listener) Run(ctx context.Context) error {
+	backoff := l.minBackoff
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		log.Printf("Outbox listener on channel %q disconnected: %v; reconnecting in %v", l.channel, err, backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > l.maxBackoff {
+			backoff = l.maxBackoff
+		}
+	}
+}
+
+// listen opens a connection, subscribes to the channel and blocks while
+// forwarding notifications. It only returns on error or cancellation.
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.databaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to listen on channel %q: %w", l.channel, err)
+	}
+	log.Printf("Outbox listener subscribed to channel %q", l.channel)
+
+	// Anything notified while we were not connected has been lost, so ask the
+	// consumer to catch up right away
+	l.wakeup()
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to wait for notification: %w", err)
+		}
+		l.wakeup()
+	}
+}
+
+// wakeup delivers a signal without blocking, coalescing with a pending one
+func (l *Listener) wakeup() {
+	select {
+	case l.wakeups <- struct{}{}:
+	default:
+	}
+}
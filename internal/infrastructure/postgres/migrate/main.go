@@ -2,13 +2,21 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/config"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
 )
 
 func main() {
+	tenantCode := flag.String("tenant", "", "provision only the schema or database of the tenant with this code")
+	flag.Parse()
+
 	// Load configuration using Viper
 	cfg, err := config.LoadConfig()
 	if err != nil {
@@ -22,10 +30,63 @@ func main() {
 	client := postgres.NewClient(databaseUrl)
 	defer client.Close()
 
-	// Run the auto migration tool.
-	if err := client.Schema.Create(context.Background()); err != nil {
-		log.Fatalf("failed creating schema resources: %v", err)
+	ctx := context.Background()
+	if *tenantCode == "" {
+		// Run the auto migration tool.
+		if err := client.Schema.Create(ctx); err != nil {
+			log.Fatalf("failed creating schema resources: %v", err)
+		}
+
+		// Move cars created before the car model catalog onto it
+		if err := postgres.MigrateCarCatalog(ctx, client, "public"); err != nil {
+			log.Fatalf("failed migrating car catalog: %v", err)
+		}
+
+		// Isolate tenants with row-level security and provision the application role
+		if err := postgres.ApplyRowLevelSecurity(ctx, client, cfg.DBAppUser, cfg.DBAppPassword); err != nil {
+			log.Fatalf("failed applying row-level security: %v", err)
+		}
+	}
+
+	// Create or upgrade the schemas and databases of the isolated tenants
+	query := client.Tenant.Query().Where(tenant.IsolationNEQ(entity.TenantIsolationShared.String()))
+	if *tenantCode != "" {
+		query = query.Where(tenant.Code(*tenantCode))
+	}
+	tenants, err := query.All(ctx)
+	if err != nil {
+		log.Fatalf("failed listing isolated tenants: %v", err)
+	}
+	if *tenantCode != "" && len(tenants) == 0 {
+		log.Fatalf("tenant %s does not exist or shares tables", *tenantCode)
+	}
+	for _, t := range tenants {
+		if err := migrateTenant(ctx, cfg, client, t); err != nil {
+			log.Fatalf("failed migrating tenant %s: %v", t.Code, err)
+		}
+		log.Printf("Migrated tenant %s (%s)", t.Code, t.Isolation)
 	}
 
 	log.Println("Migration completed successfully")
 }
+
+// migrateTenant creates or upgrades the schema or database of an isolated tenant
+func migrateTenant(ctx context.Context, cfg *config.Config, client *entgen.Client, t *entgen.Tenant) error {
+	namespace := postgres.TenantNamespace(t.Code)
+
+	switch entity.NewTenantIsolation(t.Isolation) {
+	case entity.TenantIsolationSchema:
+		return postgres.MigrateTenantSchema(ctx, client, namespace, cfg.DBAppUser)
+	case entity.TenantIsolationDatabase:
+		if err := postgres.CreateTenantDatabase(ctx, client, namespace); err != nil {
+			return err
+		}
+		tenantClient, err := postgres.OpenClient(ctx, cfg.DatabaseURLOf(namespace))
+		if err != nil {
+			return fmt.Errorf("failed to connect to database %s: %w", namespace, err)
+		}
+		defer tenantClient.Close()
+		return postgres.MigrateTenantDatabase(ctx, tenantClient, cfg.DBAppUser)
+	}
+	return nil
+}
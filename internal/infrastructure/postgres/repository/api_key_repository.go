@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/apikey"
+)
+
+type apiKeyRepository struct {
+	client *entgen.Client
+}
+
+// NewAPIKeyRepository creates a new API key repository
+func NewAPIKeyRepository(client *entgen.Client) repository.APIKeyRepository {
+	return &apiKeyRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new API key into the database
+func (r *apiKeyRepository) Create(ctx context.Context, apiKey *entity.APIKey) error {
+	_, err := clientFromContext(ctx, r.client).APIKey.
+		Create().
+		SetID(apiKey.ID).
+		SetTenantID(apiKey.TenantID).
+		SetName(apiKey.Name).
+		SetPrefix(apiKey.Prefix).
+		SetSecretHash(apiKey.SecretHash).
+		SetScopes(apiKey.Scopes).
+		SetNillableExpiresAt(apiKey.ExpiresAt.Ptr()).
+		SetNillableLastUsedAt(apiKey.LastUsedAt.Ptr()).
+		SetNillableRevokedAt(apiKey.RevokedAt.Ptr()).
+		SetCreatedAt(apiKey.CreatedAt).
+		SetUpdatedAt(apiKey.UpdatedAt).
+		Save(ctx)
+	return translateError(err)
+}
+
+// GetByID retrieves a tenant's API key by its ID
+func (r *apiKeyRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.APIKey, error) {
+	apiKeyDB, err := clientFromContext(ctx, r.client).APIKey.
+		Query().
+		Where(
+			apikey.ID(id),
+			apikey.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return r.entToDomain(apiKeyDB), nil
+}
+
+// GetByPrefix retrieves an API key by its public prefix
+func (r *apiKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error) {
+	apiKeyDB, err := clientFromContext(ctx, r.client).APIKey.
+		Query().
+		Where(apikey.Prefix(prefix)).
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return r.entToDomain(apiKeyDB), nil
+}
+
+// ListByTenant retrieves a tenant's API keys with pagination, revoked ones included
+func (r *apiKeyRepository) ListByTenant(ctx context.Context, tenantID string, limit int, offset int) ([]*entity.APIKey, error) {
+	apiKeysDB, err := clientFromContext(ctx, r.client).APIKey.
+		Query().
+		Where(apikey.TenantID(tenantID)).
+		Order(entgen.Asc(apikey.FieldCreatedAt), entgen.Asc(apikey.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]*entity.APIKey, len(apiKeysDB))
+	for i, apiKeyDB := range apiKeysDB {
+		apiKeys[i] = r.entToDomain(apiKeyDB)
+	}
+	return apiKeys, nil
+}
+
+// Update updates an existing API key. The last use is left to TouchLastUsed.
+func (r *apiKeyRepository) Update(ctx context.Context, apiKey *entity.APIKey) error {
+	update := clientFromContext(ctx, r.client).APIKey.
+		UpdateOneID(apiKey.ID).
+		Where(apikey.TenantID(apiKey.TenantID)).
+		SetName(apiKey.Name).
+		SetPrefix(apiKey.Prefix).
+		SetSecretHash(apiKey.SecretHash).
+		SetScopes(apiKey.Scopes).
+		SetUpdatedAt(apiKey.UpdatedAt)
+
+	if apiKey.ExpiresAt.Valid {
+		update.SetExpiresAt(apiKey.ExpiresAt.Time)
+	} else {
+		update.ClearExpiresAt()
+	}
+	if apiKey.RevokedAt.Valid {
+		update.SetRevokedAt(apiKey.RevokedAt.Time)
+	} else {
+		update.ClearRevokedAt()
+	}
+
+	_, err := update.Save(ctx)
+	return translateError(err)
+}
+
+// TouchLastUsed records the last use of each key, never moving it backwards
+func (r *apiKeyRepository) TouchLastUsed(ctx context.Context, lastUsed map[string]time.Time) error {
+	client := clientFromContext(ctx, r.client)
+	for id, usedAt := range lastUsed {
+		_, err := client.APIKey.
+			Update().
+			Where(
+				apikey.ID(id),
+				apikey.Or(
+					apikey.LastUsedAtIsNil(),
+					apikey.LastUsedAtLT(usedAt),
+				),
+			).
+			SetLastUsedAt(usedAt).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// entToDomain converts an Ent API key model to a domain API key entity
+func (r *apiKeyRepository) entToDomain(entAPIKey *entgen.APIKey) *entity.APIKey {
+	return &entity.APIKey{
+		ID:         entAPIKey.ID,
+		TenantID:   entAPIKey.TenantID,
+		Name:       entAPIKey.Name,
+		Prefix:     entAPIKey.Prefix,
+		SecretHash: entAPIKey.SecretHash,
+		Scopes:     slices.Clone(entAPIKey.Scopes),
+		ExpiresAt:  null.TimeFromPtr(entAPIKey.ExpiresAt),
+		LastUsedAt: null.TimeFromPtr(entAPIKey.LastUsedAt),
+		RevokedAt:  null.TimeFromPtr(entAPIKey.RevokedAt),
+		CreatedAt:  entAPIKey.CreatedAt,
+		UpdatedAt:  entAPIKey.UpdatedAt,
+	}
+}
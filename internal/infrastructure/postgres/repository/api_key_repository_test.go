@@ -0,0 +1,93 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v9"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	apikeyrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAPIKeyRepository_GetByPrefix tests that a key is found by its prefix without a tenant in the context
+func TestAPIKeyRepository_GetByPrefix(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := apikeyrepo.NewAPIKeyRepository(testutil.DBClient)
+	tenant := testutil.CreateTestTenant(t, "test-tenant-api-key-prefix")
+	ctx := context.Background()
+
+	apiKey, key, err := entity.NewAPIKey(tenant.ID, "CI", []string{"cars:read"}, null.Time{}, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, apiKey))
+
+	prefix, ok := entity.ParseAPIKeyPrefix(key)
+	require.True(t, ok)
+
+	found, err := repo.GetByPrefix(ctx, prefix)
+	require.NoError(t, err)
+	require.Equal(t, apiKey.ID, found.ID)
+	require.Equal(t, []string{"cars:read"}, found.Scopes)
+	require.True(t, found.Matches(key))
+
+	// Another tenant cannot read it by ID
+	_, err = repo.GetByID(ctx, "another-tenant", apiKey.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestAPIKeyRepository_Update tests that rotation and revocation are persisted
+func TestAPIKeyRepository_Update(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := apikeyrepo.NewAPIKeyRepository(testutil.DBClient)
+	tenant := testutil.CreateTestTenant(t, "test-tenant-api-key-update")
+	ctx := context.Background()
+
+	apiKey, oldKey, err := entity.NewAPIKey(tenant.ID, "CI", nil, null.Time{}, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, apiKey))
+	oldPrefix := apiKey.Prefix
+
+	newKey, err := apiKey.Rotate(time.Now())
+	require.NoError(t, err)
+	apiKey.Revoke(time.Now())
+	require.NoError(t, repo.Update(ctx, apiKey))
+
+	// The old prefix no longer finds the key
+	_, err = repo.GetByPrefix(ctx, oldPrefix)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	found, err := repo.GetByID(ctx, tenant.ID, apiKey.ID)
+	require.NoError(t, err)
+	require.False(t, found.Matches(oldKey))
+	require.True(t, found.Matches(newKey))
+	require.True(t, found.RevokedAt.Valid)
+}
+
+// TestAPIKeyRepository_TouchLastUsed tests that the last use only moves forward
+func TestAPIKeyRepository_TouchLastUsed(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := apikeyrepo.NewAPIKeyRepository(testutil.DBClient)
+	tenant := testutil.CreateTestTenant(t, "test-tenant-api-key-touch")
+	ctx := context.Background()
+
+	apiKey, _, err := entity.NewAPIKey(tenant.ID, "CI", nil, null.Time{}, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, apiKey))
+
+	usedAt := time.Now().Truncate(time.Microsecond)
+	require.NoError(t, repo.TouchLastUsed(ctx, map[string]time.Time{apiKey.ID: usedAt}))
+	require.NoError(t, repo.TouchLastUsed(ctx, map[string]time.Time{apiKey.ID: usedAt.Add(-time.Minute)}))
+
+	found, err := repo.GetByID(ctx, tenant.ID, apiKey.ID)
+	require.NoError(t, err)
+	require.True(t, found.LastUsedAt.Valid)
+	require.True(t, usedAt.Equal(found.LastUsedAt.Time))
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/archivejob"
+)
+
+type archiveJobRepository struct {
+	client *entgen.Client
+}
+
+// NewArchiveJobRepository creates a new archive job repository
+func NewArchiveJobRepository(client *entgen.Client) repository.ArchiveJobRepository {
+	return &archiveJobRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new archive job into the database
+func (r *archiveJobRepository) Create(ctx context.Context, job *entity.ArchiveJob) error {
+	_, err := clientFromContext(ctx, r.client).ArchiveJob.
+		Create().
+		SetID(job.ID).
+		SetKind(job.Kind.String()).
+		SetNillableTenantID(job.TenantID.Ptr()).
+		SetArchive(job.Archive).
+		SetStatus(job.Status.String()).
+		SetCounts(job.Counts).
+		SetNillableError(job.Error.Ptr()).
+		SetCreatedAt(job.CreatedAt).
+		SetUpdatedAt(job.UpdatedAt).
+		SetNillableFinishedAt(job.FinishedAt.Ptr()).
+		Save(ctx)
+	return translateError(err)
+}
+
+// GetByID retrieves an archive job by its ID
+func (r *archiveJobRepository) GetByID(ctx context.Context, id string) (*entity.ArchiveJob, error) {
+	jobDB, err := clientFromContext(ctx, r.client).ArchiveJob.
+		Query().
+		Where(archivejob.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toArchiveJobEntity(jobDB), nil
+}
+
+// Update updates the outcome of an archive job
+func (r *archiveJobRepository) Update(ctx context.Context, job *entity.ArchiveJob) error {
+	_, err := clientFromContext(ctx, r.client).ArchiveJob.
+		UpdateOneID(job.ID).
+		SetNillableTenantID(job.TenantID.Ptr()).
+		SetStatus(job.Status.String()).
+		SetCounts(job.Counts).
+		SetNillableError(job.Error.Ptr()).
+		SetUpdatedAt(job.UpdatedAt).
+		SetNillableFinishedAt(job.FinishedAt.Ptr()).
+		Save(ctx)
+	return translateError(err)
+}
+
+// toArchiveJobEntity converts an Ent archive job into a domain entity
+func toArchiveJobEntity(jobDB *entgen.ArchiveJob) *entity.ArchiveJob {
+	return &entity.ArchiveJob{
+		ID:         jobDB.ID,
+		Kind:       entity.NewArchiveJobKind(jobDB.Kind),
+		TenantID:   null.StringFromPtr(jobDB.TenantID),
+		Archive:    jobDB.Archive,
+		Status:     entity.NewArchiveJobStatus(jobDB.Status),
+		Counts:     jobDB.Counts,
+		Error:      null.StringFromPtr(jobDB.Error),
+		CreatedAt:  jobDB.CreatedAt,
+		UpdatedAt:  jobDB.UpdatedAt,
+		FinishedAt: null.TimeFromPtr(jobDB.FinishedAt),
+	}
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
+)
+
+type branchRepository struct {
+	router *Router
+}
+
+// NewBranchRepository creates a new branch repository
+func NewBranchRepository(router *Router) repository.BranchRepository {
+	return &branchRepository{
+		router: router,
+	}
+}
+
+// Create inserts a new branch of a tenant
+func (r *branchRepository) Create(ctx context.Context, b *entity.Branch) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Branch, error) {
+		return client.Branch.
+			Create().
+			SetID(b.ID).
+			SetTenantID(b.TenantID).
+			SetName(b.Spec.Name).
+			SetStreet(b.Spec.Address.Street).
+			SetCity(b.Spec.Address.City).
+			SetRegion(b.Spec.Address.Region).
+			SetPostalCode(b.Spec.Address.PostalCode).
+			SetCountry(b.Spec.Address.Country).
+			SetLatitude(b.Spec.Coordinates.Latitude()).
+			SetLongitude(b.Spec.Coordinates.Longitude()).
+			SetOpeningHours(toOpeningHoursSchema(b.Spec.OpeningHours)).
+			SetCreatedAt(b.CreatedAt).
+			SetUpdatedAt(b.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// GetByID retrieves a branch of a tenant by its ID
+func (r *branchRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.Branch, error) {
+	branchDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Branch, error) {
+		return client.Branch.
+			Query().
+			Where(branch.ID(id), branch.TenantID(tenantID), branch.DeletedAtIsNil()).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toBranchEntity(branchDB), nil
+}
+
+// ListByTenant retrieves the branches of a tenant, ordered by name
+func (r *branchRepository) ListByTenant(ctx context.Context, tenantID string) (entity.Branches, error) {
+	branchesDB, err := withTenant(ctx, r.router, func(client *entgen.Client) ([]*entgen.Branch, error) {
+		return client.Branch.
+			Query().
+			Where(branch.TenantID(tenantID), branch.DeletedAtIsNil()).
+			Order(entgen.Asc(branch.FieldName)).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query branches: %w", err)
+	}
+
+	branches := make(entity.Branches, len(branchesDB))
+	for i, branchDB := range branchesDB {
+		branches[i] = toBranchEntity(branchDB)
+	}
+	return branches, nil
+}
+
+// Update updates an existing branch of the branch's tenant
+func (r *branchRepository) Update(ctx context.Context, b *entity.Branch) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Branch, error) {
+		return client.Branch.
+			UpdateOneID(b.ID).
+			Where(branch.TenantID(b.TenantID)).
+			SetName(b.Spec.Name).
+			SetStreet(b.Spec.Address.Street).
+			SetCity(b.Spec.Address.City).
+			SetRegion(b.Spec.Address.Region).
+			SetPostalCode(b.Spec.Address.PostalCode).
+			SetCountry(b.Spec.Address.Country).
+			SetLatitude(b.Spec.Coordinates.Latitude()).
+			SetLongitude(b.Spec.Coordinates.Longitude()).
+			SetOpeningHours(toOpeningHoursSchema(b.Spec.OpeningHours)).
+			SetUpdatedAt(b.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// toBranchEntity converts an Ent branch into a domain entity. Coordinates are validated
+// before they are stored, and the schema keeps them in range.
+func toBranchEntity(branchDB *entgen.Branch) *entity.Branch {
+	coordinates, _ := value.NewCoordinates(branchDB.Latitude, branchDB.Longitude)
+	return &entity.Branch{
+		ID:       branchDB.ID,
+		TenantID: branchDB.TenantID,
+		Spec: entity.BranchSpec{
+			Name: branchDB.Name,
+			Address: entity.Address{
+				Street:     branchDB.Street,
+				City:       branchDB.City,
+				Region:     branchDB.Region,
+				PostalCode: branchDB.PostalCode,
+				Country:    branchDB.Country,
+			},
+			Coordinates:  coordinates,
+			OpeningHours: toBusinessHours(branchDB.OpeningHours),
+		},
+		CreatedAt: branchDB.CreatedAt,
+		UpdatedAt: branchDB.UpdatedAt,
+	}
+}
@@ -0,0 +1,83 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	branchrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// TestBranchRepository tests that branches, their coordinates and their opening hours round-trip
+func TestBranchRepository(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := branchrepo.NewBranchRepository(testutil.DBRouter)
+	tenant := testutil.CreateTestTenant(t, "test-tenant-branches")
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+
+	tokyo := testutil.CreateTestBranch(t, tenant.ID, 35.6812, 139.7671)
+	osaka := testutil.CreateTestBranch(t, tenant.ID, 34.7025, 135.4959)
+
+	spec := osaka.Spec
+	spec.Name = "Osaka"
+	spec.OpeningHours = entity.BusinessHours{{Weekday: time.Saturday, Opens: 10 * 60, Closes: 16 * 60}}
+	require.NoError(t, osaka.Update(spec, time.Now()))
+	require.NoError(t, repo.Update(ctx, osaka))
+
+	found, err := repo.GetByID(ctx, tenant.ID, osaka.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Osaka", found.Spec.Name)
+	require.Equal(t, osaka.Spec.Address, found.Spec.Address)
+	require.True(t, osaka.Spec.Coordinates.Equals(found.Spec.Coordinates))
+	require.Equal(t, spec.OpeningHours, found.Spec.OpeningHours)
+
+	branches, err := repo.ListByTenant(ctx, tenant.ID)
+	require.NoError(t, err)
+	require.Len(t, branches, 2)
+	require.Equal(t, tokyo.ID, branches[0].ID)
+
+	// Another tenant cannot see them
+	other := testutil.CreateTestTenant(t, "test-tenant-branches-other")
+	otherCtx := tenantctx.WithTenantID(context.Background(), other.ID)
+	_, err = repo.GetByID(otherCtx, tenant.ID, tokyo.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestCarRepository_ListAvailable_Branch tests that available cars are searched at the branch
+// they are currently at
+func TestCarRepository_ListAvailable_Branch(t *testing.T) {
+	repo, ctx, tenant := testSetup(t, "test-tenant-cars-at-branch")
+
+	tokyo := testutil.CreateTestBranch(t, tenant.ID, 35.6812, 139.7671)
+	osaka := testutil.CreateTestBranch(t, tenant.ID, 34.7025, 135.4959)
+	atTokyo := newTestCar(t, tenant.ID)
+	atTokyo.AssignBranches(tokyo.ID, "", time.Now())
+	require.NoError(t, repo.Create(ctx, atTokyo))
+	movedToOsaka := newTestCar(t, tenant.ID)
+	movedToOsaka.AssignBranches(tokyo.ID, osaka.ID, time.Now())
+	require.NoError(t, repo.Create(ctx, movedToOsaka))
+
+	found, err := repo.GetByID(ctx, tenant.ID, movedToOsaka.ID)
+	require.NoError(t, err)
+	require.Equal(t, tokyo.ID, found.HomeBranchID)
+	require.Equal(t, osaka.ID, found.CurrentBranchID)
+
+	startsAt := time.Now().Add(24 * time.Hour)
+	cars, err := repo.ListAvailable(ctx, []string{tenant.ID}, osaka.ID, startsAt, startsAt.Add(48*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, cars, 1)
+	require.Equal(t, movedToOsaka.ID, cars[0].ID)
+
+	cars, err = repo.ListAvailable(ctx, []string{tenant.ID}, "", startsAt, startsAt.Add(48*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, cars, 2)
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
+)
+
+type carModelRepository struct {
+	router *Router
+}
+
+// NewCarModelRepository creates a new car model repository
+func NewCarModelRepository(router *Router) repository.CarModelRepository {
+	return &carModelRepository{
+		router: router,
+	}
+}
+
+// Create inserts a new model into the tenant's catalog
+func (r *carModelRepository) Create(ctx context.Context, model *entity.CarModel) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.CarModel, error) {
+		return client.CarModel.
+			Create().
+			SetID(model.ID).
+			SetTenantID(model.TenantID).
+			SetMake(model.Spec.Make).
+			SetName(model.Spec.Name).
+			SetCategory(model.Spec.Category.String()).
+			SetSeats(model.Spec.Seats).
+			SetTransmission(model.Spec.Transmission.String()).
+			SetFuelType(model.Spec.FuelType.String()).
+			SetCreatedAt(model.CreatedAt).
+			SetUpdatedAt(model.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// GetByID retrieves a model of a tenant's catalog by its ID
+func (r *carModelRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.CarModel, error) {
+	modelDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.CarModel, error) {
+		return client.CarModel.
+			Query().
+			Where(carmodel.ID(id), carmodel.TenantID(tenantID), carmodel.DeletedAtIsNil()).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toCarModelEntity(modelDB), nil
+}
+
+// ListByTenant retrieves the catalog of a tenant, ordered by make and name
+func (r *carModelRepository) ListByTenant(ctx context.Context, tenantID string) (entity.CarModels, error) {
+	modelsDB, err := withTenant(ctx, r.router, func(client *entgen.Client) ([]*entgen.CarModel, error) {
+		return client.CarModel.
+			Query().
+			Where(carmodel.TenantID(tenantID), carmodel.DeletedAtIsNil()).
+			Order(entgen.Asc(carmodel.FieldMake), entgen.Asc(carmodel.FieldName)).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query car models: %w", err)
+	}
+
+	models := make(entity.CarModels, len(modelsDB))
+	for i, modelDB := range modelsDB {
+		models[i] = toCarModelEntity(modelDB)
+	}
+	return models, nil
+}
+
+// Update updates an existing model of the model's tenant
+func (r *carModelRepository) Update(ctx context.Context, model *entity.CarModel) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.CarModel, error) {
+		return client.CarModel.
+			UpdateOneID(model.ID).
+			Where(carmodel.TenantID(model.TenantID)).
+			SetMake(model.Spec.Make).
+			SetName(model.Spec.Name).
+			SetCategory(model.Spec.Category.String()).
+			SetSeats(model.Spec.Seats).
+			SetTransmission(model.Spec.Transmission.String()).
+			SetFuelType(model.Spec.FuelType.String()).
+			SetUpdatedAt(model.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// toCarModelEntity converts an Ent car model into a domain entity
+func toCarModelEntity(modelDB *entgen.CarModel) *entity.CarModel {
+	return &entity.CarModel{
+		ID:       modelDB.ID,
+		TenantID: modelDB.TenantID,
+		Spec: entity.CarModelSpec{
+			Make:         modelDB.Make,
+			Name:         modelDB.Name,
+			Category:     entity.CarCategory(modelDB.Category),
+			Seats:        modelDB.Seats,
+			Transmission: entity.Transmission(modelDB.Transmission),
+			FuelType:     entity.FuelType(modelDB.FuelType),
+		},
+		CreatedAt: modelDB.CreatedAt,
+		UpdatedAt: modelDB.UpdatedAt,
+	}
+}
@@ -6,74 +6,72 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aarondl/null/v9"
+
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
 	car "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
 )
 
 type carRepository struct {
-	client *entgen.Client
+	router *Router
 }
 
 // NewCarRepository creates a new car repository
-func NewCarRepository(client *entgen.Client) repository.CarRepository {
+func NewCarRepository(router *Router) repository.CarRepository {
 	return &carRepository{
-		client: client,
+		router: router,
 	}
 }
 
 // Create inserts a new car into the database
 func (r *carRepository) Create(ctx context.Context, car *entity.Car) error {
-	_, err := r.client.Car.
-		Create().
-		SetID(car.ID).
-		SetTenantID(car.TenantID).
-		SetModel(car.Model).
-		Save(ctx)
-	return err
-}
-
-// CreateInTx inserts a new car into the database within a transaction
-func (r *carRepository) CreateInTx(ctx context.Context, tx *entgen.Tx, car *entity.Car) error {
-	_, err := tx.Car.
-		Create().
-		SetID(car.ID).
-		SetTenantID(car.TenantID).
-		SetModel(car.Model).
-		Save(ctx)
-	return err
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Car, error) {
+		return client.Car.
+			Create().
+			SetID(car.ID).
+			SetTenantID(car.TenantID).
+			SetCarModelID(car.ModelID).
+			SetNillableVin(nillableString(car.VINString())).
+			SetNillableLicensePlate(nillableString(car.LicensePlateString())).
+			SetNillableLicensePlateCountry(nillableString(car.LicensePlateCountry())).
+			SetNillableHomeBranchID(nillableString(car.HomeBranchID)).
+			SetNillableCurrentBranchID(nillableString(car.CurrentBranchID)).
+			Save(ctx)
+	})
+	return translateError(err)
 }
 
-// GetByID retrieves a car by its ID
-func (r *carRepository) GetByID(ctx context.Context, id string) (*entity.Car, error) {
-	carDB, err := r.client.Car.
-		Query().
-		Where(car.ID(id)).
-		Only(ctx)
+// GetByID retrieves a tenant's car by its ID
+func (r *carRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.Car, error) {
+	carDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Car, error) {
+		return client.Car.
+			Query().
+			Where(car.ID(id), car.TenantID(tenantID)).
+			WithCarModel().
+			Only(ctx)
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
-	// Direct conversion from Ent model to domain entity
-	return &entity.Car{
-		ID:        carDB.ID,
-		TenantID:  carDB.TenantID,
-		Model:     carDB.Model,
-		CreatedAt: carDB.CreatedAt,
-		UpdatedAt: carDB.UpdatedAt,
-	}, nil
+	return r.entToDomain(carDB), nil
 }
 
-// GetByIDWithTenant retrieves a car by its ID along with its tenant information
-func (r *carRepository) GetByIDWithTenant(ctx context.Context, id string) (*entity.Car, error) {
-	carDB, err := r.client.Car.
-		Query().
-		Where(car.ID(id)).
-		WithTenant().
-		Only(ctx)
+// GetByIDWithTenant retrieves a tenant's car by its ID along with its tenant information
+func (r *carRepository) GetByIDWithTenant(ctx context.Context, tenantID, id string) (*entity.Car, error) {
+	carDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Car, error) {
+		return client.Car.
+			Query().
+			Where(car.ID(id), car.TenantID(tenantID)).
+			WithTenant().
+			WithCarModel().
+			Only(ctx)
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	// Convert Ent model to domain entity with tenant information
@@ -81,42 +79,38 @@ func (r *carRepository) GetByIDWithTenant(ctx context.Context, id string) (*enti
 	return r.entToDomain(carDB, opts), nil
 }
 
-// Update updates an existing car
-func (r *carRepository) Update(ctx context.Context, car *entity.Car) error {
-	// Update the UpdatedAt field to the current time
-	car.UpdatedAt = time.Now()
-
-	_, err := r.client.Car.
-		UpdateOneID(car.ID).
-		SetTenantID(car.TenantID).
-		SetModel(car.Model).
-		SetUpdatedAt(car.UpdatedAt).
-		Save(ctx)
-	return err
-}
-
-// UpdateInTx updates an existing car within a transaction
-func (r *carRepository) UpdateInTx(ctx context.Context, tx *entgen.Tx, car *entity.Car) error {
+// Update updates an existing car of the car's tenant
+func (r *carRepository) Update(ctx context.Context, c *entity.Car) error {
 	// Update the UpdatedAt field to the current time
-	car.UpdatedAt = time.Now()
-
-	_, err := tx.Car.
-		UpdateOneID(car.ID).
-		SetTenantID(car.TenantID).
-		SetModel(car.Model).
-		SetUpdatedAt(car.UpdatedAt).
-		Save(ctx)
-	return err
+	c.UpdatedAt = time.Now()
+
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Car, error) {
+		return client.Car.
+			UpdateOneID(c.ID).
+			Where(car.TenantID(c.TenantID)).
+			SetCarModelID(c.ModelID).
+			SetNillableVin(nillableString(c.VINString())).
+			SetNillableLicensePlate(nillableString(c.LicensePlateString())).
+			SetNillableLicensePlateCountry(nillableString(c.LicensePlateCountry())).
+			SetNillableHomeBranchID(nillableString(c.HomeBranchID)).
+			SetNillableCurrentBranchID(nillableString(c.CurrentBranchID)).
+			SetUpdatedAt(c.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
 }
 
-// Delete removes a car by its ID
-func (r *carRepository) Delete(ctx context.Context, id string) error {
-	err := r.client.Car.
-		DeleteOneID(id).
-		Exec(ctx)
-		// Make the delete operation idempotent by ignoring "not found" errors
-		// If the record doesn't exist, DeleteOneID.Exec() will return an error
-		// We want Delete to be idempotent, so we ignore "not found" errors
+// Delete removes a tenant's car by its ID
+func (r *carRepository) Delete(ctx context.Context, tenantID, id string) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (struct{}, error) {
+		return struct{}{}, client.Car.
+			DeleteOneID(id).
+			Where(car.TenantID(tenantID)).
+			Exec(ctx)
+	})
+	// Make the delete operation idempotent by ignoring "not found" errors
+	// If the record doesn't exist, DeleteOneID.Exec() will return an error
+	// We want Delete to be idempotent, so we ignore "not found" errors
 	if err != nil {
 		// Check if it's a "not found" error by checking the error message
 		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "no rows in result set") {
@@ -129,35 +123,24 @@ func (r *carRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// DeleteInTx removes a car by its ID within a transaction
-func (r *carRepository) DeleteInTx(ctx context.Context, tx *entgen.Tx, id string) error {
-	return tx.Car.
-		DeleteOneID(id).
-		Exec(ctx)
-}
-
 // ListByTenant retrieves cars by tenant ID with pagination
 func (r *carRepository) ListByTenant(ctx context.Context, tenantID string, limit int, offset int) ([]*entity.Car, string, int32, error) {
-	dbCars, err := r.client.Car.
-		Query().
-		Where(car.TenantID(tenantID)).
-		Limit(limit).
-		Offset(offset).
-		All(ctx)
+	dbCars, err := withTenant(ctx, r.router, func(client *entgen.Client) ([]*entgen.Car, error) {
+		return client.Car.
+			Query().
+			Where(car.TenantID(tenantID)).
+			WithCarModel().
+			Limit(limit).
+			Offset(offset).
+			All(ctx)
+	})
 	if err != nil {
 		return nil, "", 0, fmt.Errorf("failed to query cars: %w", err)
 	}
 
 	cars := make([]*entity.Car, len(dbCars))
 	for i, dbCar := range dbCars {
-		// Direct conversion from Ent model to domain entity
-		cars[i] = &entity.Car{
-			ID:        dbCar.ID,
-			TenantID:  dbCar.TenantID,
-			Model:     dbCar.Model,
-			CreatedAt: dbCar.CreatedAt,
-			UpdatedAt: dbCar.UpdatedAt,
-		}
+		cars[i] = r.entToDomain(dbCar)
 	}
 
 	// For now, we'll use empty nextPageToken and totalCount
@@ -169,25 +152,28 @@ func (r *carRepository) ListByTenant(ctx context.Context, tenantID string, limit
 
 // ListByTenantWithOptions retrieves cars by tenant ID with pagination and load options
 func (r *carRepository) ListByTenantWithOptions(ctx context.Context, tenantID string, limit int, offset int, opts ...repository.CarLoadOptions) ([]*entity.Car, string, int32, error) {
-	query := r.client.Car.
-		Query().
-		Where(car.TenantID(tenantID))
-
-	// Handle eager loading based on options
-	if len(opts) > 0 {
-		opt := opts[0]
-		if opt.WithTenant {
-			query = query.WithTenant()
-		}
-		if opt.WithRentals {
-			query = query.WithRentals()
+	dbCars, err := withTenant(ctx, r.router, func(client *entgen.Client) ([]*entgen.Car, error) {
+		query := client.Car.
+			Query().
+			Where(car.TenantID(tenantID)).
+			WithCarModel()
+
+		// Handle eager loading based on options
+		if len(opts) > 0 {
+			opt := opts[0]
+			if opt.WithTenant {
+				query = query.WithTenant()
+			}
+			if opt.WithRentals {
+				query = query.WithRentals()
+			}
 		}
-	}
 
-	dbCars, err := query.
-		Limit(limit).
-		Offset(offset).
-		All(ctx)
+		return query.
+			Limit(limit).
+			Offset(offset).
+			All(ctx)
+	})
 	if err != nil {
 		return nil, "", 0, fmt.Errorf("failed to query cars: %w", err)
 	}
@@ -204,14 +190,48 @@ func (r *carRepository) ListByTenantWithOptions(ctx context.Context, tenantID st
 	return cars, "", count, nil
 }
 
-// entToDomain converts an Ent car model to a domain car entity
+// ListAvailable retrieves the live cars of the given tenants that are neither rented nor in
+// maintenance over a period, at a branch unless branchID is empty. Row-level security shows
+// the cars of other tenants, the periods of all of their rentals and their maintenance
+// windows only under an active fleet sharing agreement, so cars of tenants not sharing with
+// the tenant of ctx are never returned.
+func (r *carRepository) ListAvailable(ctx context.Context, tenantIDs []string, branchID string, startsAt, endsAt time.Time, limit int) (entity.Cars, error) {
+	dbCars, err := withTenant(ctx, r.router, func(client *entgen.Client) ([]*entgen.Car, error) {
+		query := client.Car.
+			Query().
+			Where(
+				car.TenantIDIn(tenantIDs...),
+				car.DeletedAtIsNil(),
+				notRented(startsAt, endsAt),
+				car.Not(car.HasMaintenanceWindowsWith(blockingMaintenance(startsAt, endsAt)...)),
+			)
+		if branchID != "" {
+			query = query.Where(car.CurrentBranchID(branchID))
+		}
+
+		return query.
+			WithCarModel().
+			Order(car.ByID()).
+			Limit(limit).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query available cars: %w", err)
+	}
+
+	cars := make(entity.Cars, len(dbCars))
+	for i, dbCar := range dbCars {
+		cars[i] = r.entToDomain(dbCar)
+	}
+	return cars, nil
+}
+
+// entToDomain converts an Ent car to a domain car entity, with its catalog model when it
+// was loaded
 func (r *carRepository) entToDomain(entCar *entgen.Car, opts ...repository.CarLoadOptions) *entity.Car {
-	domainCar := &entity.Car{
-		ID:        entCar.ID,
-		TenantID:  entCar.TenantID,
-		Model:     entCar.Model,
-		CreatedAt: entCar.CreatedAt,
-		UpdatedAt: entCar.UpdatedAt,
+	domainCar := toCarEntity(entCar)
+	if entCar.Edges.CarModel != nil {
+		domainCar.Refs = &entity.CarRefs{Model: toCarModelEntity(entCar.Edges.CarModel)}
 	}
 
 	// Handle relationships if options are provided
@@ -227,7 +247,9 @@ func (r *carRepository) entToDomain(entCar *entgen.Car, opts ...repository.CarLo
 		return domainCar
 	}
 
-	domainCar.Refs = &entity.CarRefs{}
+	if domainCar.Refs == nil {
+		domainCar.Refs = &entity.CarRefs{}
+	}
 
 	if hasTenant {
 		domainCar.Refs.Tenant = r.entTenantToDomain(entCar.Edges.Tenant)
@@ -256,19 +278,36 @@ func (r *carRepository) entTenantToDomain(entTenant *entgen.Tenant) *entity.Tena
 
 // entRentalToDomain converts an Ent rental model to a domain rental entity
 func (r *carRepository) entRentalToDomain(entRental *entgen.Rental) *entity.Rental {
-	return &entity.Rental{
-		ID:        entRental.ID,
-		TenantID:  entRental.TenantID,
-		CarID:     entRental.CarID,
-		RenterID:  entRental.RenterID,
-		StartsAt:  entRental.StartsAt,
-		EndsAt:    entRental.EndsAt,
-		CreatedAt: entRental.CreatedAt,
-		UpdatedAt: entRental.UpdatedAt,
+	return toRentalEntity(entRental)
+}
+
+// toCarEntity converts an Ent car into a domain entity. VINs and license plates are
+// validated before they are stored, so they are only dropped if the rules got stricter,
+// like VINs stored before their check digit was checked and plates stored without a country.
+// Updates leave the dropped values in place.
+func toCarEntity(carDB *entgen.Car) *entity.Car {
+	domainCar := &entity.Car{
+		ID:              carDB.ID,
+		TenantID:        carDB.TenantID,
+		ModelID:         carDB.CarModelID,
+		HomeBranchID:    null.StringFromPtr(carDB.HomeBranchID).String,
+		CurrentBranchID: null.StringFromPtr(carDB.CurrentBranchID).String,
+		CreatedAt:       carDB.CreatedAt,
+		UpdatedAt:       carDB.UpdatedAt,
+	}
+	if carDB.Vin != nil {
+		domainCar.VIN, _ = value.NewVIN(*carDB.Vin)
 	}
+	if carDB.LicensePlate != nil && carDB.LicensePlateCountry != nil {
+		domainCar.LicensePlate, _ = value.NewLicensePlate(*carDB.LicensePlateCountry, *carDB.LicensePlate)
+	}
+	return domainCar
 }
 
-// Tx returns the underlying Ent client's transaction capabilities
-func (r *carRepository) Tx() *entgen.Client {
-	return r.client
+// nillableString returns nil for an empty string, for optional columns
+func nillableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
 }
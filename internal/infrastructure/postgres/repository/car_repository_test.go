@@ -10,8 +10,10 @@ import (
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
 	carrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,28 +24,105 @@ func testSetup(t *testing.T, tenantCode string) (repository.CarRepository, conte
 	// Skip this test if not running integration tests
 	testutil.SkipIfShort(t)
 
-	repo := carrepo.NewCarRepository(testutil.DBClient)
-	ctx := context.Background()
+	repo := carrepo.NewCarRepository(testutil.DBRouter)
 	tenant := testutil.CreateTestTenant(t, tenantCode)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
 
 	return repo, ctx, tenant
 }
 
+// newTestCar creates a car of a new model in the tenant's catalog
+func newTestCar(t *testing.T, tenantID string) *entity.Car {
+	t.Helper()
+
+	model := testutil.CreateTestCarModel(t, tenantID)
+	return entity.NewCar(tenantID, model.ID, "", nil, nil, time.Now())
+}
+
 // TestCarRepository_Create tests the Create method of the car repository.
 func TestCarRepository_Create(t *testing.T) {
 	repo, ctx, tenant := testSetup(t, "test-tenant-create")
 
 	// Create a car
-	car := entity.NewCar(tenant.ID, "HARRIER", time.Now())
+	car := newTestCar(t, tenant.ID)
 	err := repo.Create(ctx, car)
 	require.NoError(t, err)
 
 	// Verify the car was created
-	foundCar, err := repo.GetByID(ctx, car.ID)
+	foundCar, err := repo.GetByID(ctx, tenant.ID, car.ID)
 	require.NoError(t, err)
 	require.Equal(t, car.ID, foundCar.ID)
 	require.Equal(t, car.TenantID, foundCar.TenantID)
-	require.Equal(t, car.Model, foundCar.Model)
+	require.Equal(t, car.ModelID, foundCar.ModelID)
+	require.NotNil(t, foundCar.Model())
+	require.Equal(t, car.ModelID, foundCar.Model().ID)
+}
+
+// TestCarRepository_Create_VINAndLicensePlate tests that the VIN and license plate of a car
+// are stored.
+func TestCarRepository_Create_VINAndLicensePlate(t *testing.T) {
+	repo, ctx, tenant := testSetup(t, "test-tenant-create-vin")
+
+	vin, err := value.NewVIN("1HGCM82633A004352")
+	require.NoError(t, err)
+	plate, err := value.NewLicensePlate("US", "ABC-1234")
+	require.NoError(t, err)
+
+	car := newTestCar(t, tenant.ID)
+	car.VIN = vin
+	car.LicensePlate = plate
+	require.NoError(t, repo.Create(ctx, car))
+
+	foundCar, err := repo.GetByID(ctx, tenant.ID, car.ID)
+	require.NoError(t, err)
+	require.Equal(t, "1HGCM82633A004352", foundCar.VINString())
+	require.Equal(t, "ABC 1234", foundCar.LicensePlateString())
+	require.Equal(t, "US", foundCar.LicensePlateCountry())
+}
+
+// TestCarRepository_Create_Duplicate tests that a VIN or license plate is unique among the
+// cars of a tenant, and a license plate only within its country.
+func TestCarRepository_Create_Duplicate(t *testing.T) {
+	repo, ctx, tenant := testSetup(t, "test-tenant-create-duplicate")
+
+	vin, err := value.NewVIN("1HGCM82633A004352")
+	require.NoError(t, err)
+	usPlate, err := value.NewLicensePlate("US", "ABC-1234")
+	require.NoError(t, err)
+	caPlate, err := value.NewLicensePlate("CA", "ABC-1234")
+	require.NoError(t, err)
+
+	car := newTestCar(t, tenant.ID)
+	car.VIN = vin
+	car.LicensePlate = usPlate
+	require.NoError(t, repo.Create(ctx, car))
+
+	sameVIN := newTestCar(t, tenant.ID)
+	sameVIN.VIN = vin
+	require.ErrorIs(t, repo.Create(ctx, sameVIN), repository.ErrAlreadyExists)
+
+	samePlate := newTestCar(t, tenant.ID)
+	samePlate.LicensePlate = usPlate
+	require.ErrorIs(t, repo.Create(ctx, samePlate), repository.ErrAlreadyExists)
+
+	// A hyphen and a space separate the number alike
+	spacedPlate, err := value.NewLicensePlate("US", "ABC 1234")
+	require.NoError(t, err)
+	spaced := newTestCar(t, tenant.ID)
+	spaced.LicensePlate = spacedPlate
+	require.ErrorIs(t, repo.Create(ctx, spaced), repository.ErrAlreadyExists)
+
+	// The same number issued in another country is another plate
+	otherCountry := newTestCar(t, tenant.ID)
+	otherCountry.LicensePlate = caPlate
+	require.NoError(t, repo.Create(ctx, otherCountry))
+
+	// Cars of other tenants can have the same VIN and plate
+	_, otherCtx, otherTenant := testSetup(t, "test-tenant-create-duplicate-other")
+	otherTenantCar := newTestCar(t, otherTenant.ID)
+	otherTenantCar.VIN = vin
+	otherTenantCar.LicensePlate = usPlate
+	require.NoError(t, repo.Create(otherCtx, otherTenantCar))
 }
 
 // TestCarRepository_GetByID tests the GetByID method of the car repository.
@@ -51,16 +130,16 @@ func TestCarRepository_GetByID(t *testing.T) {
 	repo, ctx, tenant := testSetup(t, "test-tenant-get")
 
 	// Create a car
-	car := entity.NewCar(tenant.ID, "RAV4", time.Now())
+	car := newTestCar(t, tenant.ID)
 	err := repo.Create(ctx, car)
 	require.NoError(t, err)
 
 	// Get the car by ID
-	foundCar, err := repo.GetByID(ctx, car.ID)
+	foundCar, err := repo.GetByID(ctx, tenant.ID, car.ID)
 	require.NoError(t, err)
 	require.Equal(t, car.ID, foundCar.ID)
 	require.Equal(t, car.TenantID, foundCar.TenantID)
-	require.Equal(t, car.Model, foundCar.Model)
+	require.Equal(t, car.ModelID, foundCar.ModelID)
 }
 
 // TestCarRepository_GetByIDWithTenant tests the GetByIDWithTenant method of the car repository.
@@ -68,16 +147,16 @@ func TestCarRepository_GetByIDWithTenant(t *testing.T) {
 	repo, ctx, tenant := testSetup(t, "test-tenant-get-with-tenant")
 
 	// Create a car
-	car := entity.NewCar(tenant.ID, "Prius", time.Now())
+	car := newTestCar(t, tenant.ID)
 	err := repo.Create(ctx, car)
 	require.NoError(t, err)
 
 	// Get the car by ID with tenant information
-	foundCar, err := repo.GetByIDWithTenant(ctx, car.ID)
+	foundCar, err := repo.GetByIDWithTenant(ctx, tenant.ID, car.ID)
 	require.NoError(t, err)
 	require.Equal(t, car.ID, foundCar.ID)
 	require.Equal(t, car.TenantID, foundCar.TenantID)
-	require.Equal(t, car.Model, foundCar.Model)
+	require.Equal(t, car.ModelID, foundCar.ModelID)
 	require.NotNil(t, foundCar.Refs)
 	require.NotNil(t, foundCar.Refs.Tenant)
 	require.Equal(t, tenant.ID, foundCar.Refs.Tenant.ID)
@@ -86,10 +165,10 @@ func TestCarRepository_GetByIDWithTenant(t *testing.T) {
 
 // TestCarRepository_GetByID_NotFound tests the GetByID method when a car doesn't exist.
 func TestCarRepository_GetByID_NotFound(t *testing.T) {
-	repo, ctx, _ := testSetup(t, "test-tenant-get-not-found")
+	repo, ctx, tenant := testSetup(t, "test-tenant-get-not-found")
 
 	// Try to get a car that doesn't exist
-	_, err := repo.GetByID(ctx, "non-existent-id")
+	_, err := repo.GetByID(ctx, tenant.ID, "non-existent-id")
 	require.Error(t, err)
 }
 
@@ -98,21 +177,22 @@ func TestCarRepository_Update(t *testing.T) {
 	repo, ctx, tenant := testSetup(t, "test-tenant-update")
 
 	// Create a car
-	car := entity.NewCar(tenant.ID, "HR-V", time.Now())
+	car := newTestCar(t, tenant.ID)
 	err := repo.Create(ctx, car)
 	require.NoError(t, err)
 
 	// Update the car
 	originalUpdatedAt := car.UpdatedAt
-	car.Model = "CR-V"
+	model := testutil.CreateTestCarModel(t, tenant.ID)
+	car.ModelID = model.ID
 	car.UpdatedAt = time.Now()
 	err = repo.Update(ctx, car)
 	require.NoError(t, err)
 
 	// Verify the update
-	updatedCar, err := repo.GetByID(ctx, car.ID)
+	updatedCar, err := repo.GetByID(ctx, tenant.ID, car.ID)
 	require.NoError(t, err)
-	require.Equal(t, "CR-V", updatedCar.Model)
+	require.Equal(t, model.ID, updatedCar.ModelID)
 	require.True(t, updatedCar.UpdatedAt.After(originalUpdatedAt))
 }
 
@@ -121,24 +201,24 @@ func TestCarRepository_Delete(t *testing.T) {
 	repo, ctx, tenant := testSetup(t, "test-tenant-delete")
 
 	// Create a car
-	car := entity.NewCar(tenant.ID, "Tesla Model Y", time.Now())
+	car := newTestCar(t, tenant.ID)
 	err := repo.Create(ctx, car)
 	require.NoError(t, err)
 
 	// Delete the car
-	err = repo.Delete(ctx, car.ID)
+	err = repo.Delete(ctx, tenant.ID, car.ID)
 	require.NoError(t, err)
 
 	// Verify the car is deleted
-	_, err = repo.GetByID(ctx, car.ID)
+	_, err = repo.GetByID(ctx, tenant.ID, car.ID)
 	require.Error(t, err)
 }
 
 // TestCarRepository_Delete_NotFound tests the Delete method when a car doesn't exist.
 func TestCarRepository_Delete_NotFound(t *testing.T) {
-	repo, ctx, _ := testSetup(t, "test-tenant-delete-not-found")
+	repo, ctx, tenant := testSetup(t, "test-tenant-delete-not-found")
 
 	// Try to delete a car that doesn't exist
-	err := repo.Delete(ctx, "non-existent-id")
+	err := repo.Delete(ctx, tenant.ID, "non-existent-id")
 	require.NoError(t, err) // Delete should be idempotent
 }
@@ -12,37 +12,41 @@ import (
 )
 
 type companyRepository struct {
-	client *entgen.Client
+	router *Router
 }
 
 // NewCompanyRepository creates a new company repository
-func NewCompanyRepository(client *entgen.Client) repository.CompanyRepository {
+func NewCompanyRepository(router *Router) repository.CompanyRepository {
 	return &companyRepository{
-		client: client,
+		router: router,
 	}
 }
 
 // Create inserts a new company into the database
 func (r *companyRepository) Create(ctx context.Context, company *entity.Company) error {
-	_, err := r.client.Company.
-		Create().
-		SetID(company.ID).
-		SetRenterID(company.RenterID).
-		SetTenantID(company.TenantID).
-		SetName(company.Name).
-		SetCompanySize(company.CompanySize.String()).
-		Save(ctx)
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Company, error) {
+		return client.Company.
+			Create().
+			SetID(company.ID).
+			SetRenterID(company.RenterID).
+			SetTenantID(company.TenantID).
+			SetName(company.Name).
+			SetCompanySize(company.CompanySize.String()).
+			Save(ctx)
+	})
 	return err
 }
 
 // GetByID retrieves a company by its ID
 func (r *companyRepository) GetByID(ctx context.Context, id string) (*entity.Company, error) {
-	companyDB, err := r.client.Company.
-		Query().
-		Where(company.RenterIDEQ(id)).
-		Only(ctx)
+	companyDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Company, error) {
+		return client.Company.
+			Query().
+			Where(company.RenterIDEQ(id)).
+			Only(ctx)
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	// Direct conversion from Ent model to domain entity
@@ -64,26 +68,30 @@ func (r *companyRepository) Update(ctx context.Context, comp *entity.Company) er
 	// Update the UpdatedAt field to the current time
 	comp.UpdatedAt = time.Now()
 
-	_, err := r.client.Company.
-		Update().
-		Where(company.RenterIDEQ(comp.RenterID)).
-		SetTenantID(comp.TenantID).
-		SetName(comp.Name).
-		SetCompanySize(comp.CompanySize.String()).
-		SetUpdatedAt(comp.UpdatedAt).
-		Save(ctx)
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (int, error) {
+		return client.Company.
+			Update().
+			Where(company.RenterIDEQ(comp.RenterID)).
+			SetTenantID(comp.TenantID).
+			SetName(comp.Name).
+			SetCompanySize(comp.CompanySize.String()).
+			SetUpdatedAt(comp.UpdatedAt).
+			Save(ctx)
+	})
 	return err
 }
 
 // Delete removes a company by its ID
 func (r *companyRepository) Delete(ctx context.Context, id string) error {
-	affected, err := r.client.Company.
-		Delete().
-		Where(company.RenterIDEQ(id)).
-		Exec(ctx)
-		// Make the delete operation idempotent by ignoring "not found" errors
-		// If the record doesn't exist, Delete.Exec() will return an error
-		// We want Delete to be idempotent, so we ignore "not found" errors
+	affected, err := withTenant(ctx, r.router, func(client *entgen.Client) (int, error) {
+		return client.Company.
+			Delete().
+			Where(company.RenterIDEQ(id)).
+			Exec(ctx)
+	})
+	// Make the delete operation idempotent by ignoring "not found" errors
+	// If the record doesn't exist, Delete.Exec() will return an error
+	// We want Delete to be idempotent, so we ignore "not found" errors
 	if err != nil {
 		// Check if it's a "not found" error by checking the error message
 		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "no rows in result set") {
@@ -12,6 +12,7 @@ import (
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
 	companyrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,10 +23,10 @@ func testCompanySetup(t *testing.T, tenantCode string) (repository.CompanyReposi
 	// Skip this test if not running integration tests
 	testutil.SkipIfShort(t)
 
-	repo := companyrepo.NewCompanyRepository(testutil.DBClient)
-	renterRepo := companyrepo.NewRenterRepository(testutil.DBClient)
-	ctx := context.Background()
+	repo := companyrepo.NewCompanyRepository(testutil.DBRouter)
+	renterRepo := companyrepo.NewRenterRepository(testutil.DBRouter)
 	tenant := testutil.CreateTestTenant(t, tenantCode)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
 
 	return repo, renterRepo, ctx, tenant
 }
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+)
+
+// translateError maps Ent errors to the sentinel errors of the domain repository package,
+// so that callers never need to import entgen to inspect them
+func translateError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case entgen.IsNotFound(err):
+		return fmt.Errorf("%w: %v", repository.ErrNotFound, err)
+	case entgen.IsConstraintError(err):
+		return fmt.Errorf("%w: %v", repository.ErrAlreadyExists, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/fleetsharingagreement"
+)
+
+type fleetSharingRepository struct {
+	client *entgen.Client
+}
+
+// NewFleetSharingRepository creates a new fleet sharing agreement repository
+func NewFleetSharingRepository(client *entgen.Client) repository.FleetSharingRepository {
+	return &fleetSharingRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new agreement into the database
+func (r *fleetSharingRepository) Create(ctx context.Context, agreement *entity.FleetSharingAgreement) error {
+	_, err := clientFromContext(ctx, r.client).FleetSharingAgreement.
+		Create().
+		SetID(agreement.ID).
+		SetLenderTenantID(agreement.LenderTenantID).
+		SetBorrowerTenantID(agreement.BorrowerTenantID).
+		SetMaxRentalDays(agreement.Terms.MaxRentalDays).
+		SetOwnerSharePercent(agreement.Terms.OwnerSharePercent).
+		SetValidFrom(agreement.Terms.ValidFrom).
+		SetNillableValidUntil(agreement.Terms.ValidUntil.Ptr()).
+		SetNillableTerminatedAt(agreement.TerminatedAt.Ptr()).
+		SetCreatedAt(agreement.CreatedAt).
+		SetUpdatedAt(agreement.UpdatedAt).
+		Save(ctx)
+	return translateError(err)
+}
+
+// GetByID retrieves an agreement by its ID
+func (r *fleetSharingRepository) GetByID(ctx context.Context, id string) (*entity.FleetSharingAgreement, error) {
+	agreementDB, err := clientFromContext(ctx, r.client).FleetSharingAgreement.
+		Query().
+		Where(fleetsharingagreement.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toFleetSharingEntity(agreementDB), nil
+}
+
+// ListByTenant retrieves the agreements a tenant lends or borrows under, the newest first
+func (r *fleetSharingRepository) ListByTenant(ctx context.Context, tenantID string) (entity.FleetSharingAgreements, error) {
+	agreementsDB, err := clientFromContext(ctx, r.client).FleetSharingAgreement.
+		Query().
+		Where(fleetsharingagreement.Or(
+			fleetsharingagreement.LenderTenantID(tenantID),
+			fleetsharingagreement.BorrowerTenantID(tenantID),
+		)).
+		Order(entgen.Desc(fleetsharingagreement.FieldCreatedAt), entgen.Desc(fleetsharingagreement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toFleetSharingEntities(agreementsDB), nil
+}
+
+// ListActiveByBorrower retrieves the agreements a tenant can book shared cars under
+func (r *fleetSharingRepository) ListActiveByBorrower(ctx context.Context, borrowerTenantID string) (entity.FleetSharingAgreements, error) {
+	agreementsDB, err := clientFromContext(ctx, r.client).FleetSharingAgreement.
+		Query().
+		Where(
+			fleetsharingagreement.BorrowerTenantID(borrowerTenantID),
+			fleetsharingagreement.TerminatedAtIsNil(),
+		).
+		Order(entgen.Asc(fleetsharingagreement.FieldLenderTenantID)).
+		All(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toFleetSharingEntities(agreementsDB), nil
+}
+
+// Update updates an existing agreement
+func (r *fleetSharingRepository) Update(ctx context.Context, agreement *entity.FleetSharingAgreement) error {
+	update := clientFromContext(ctx, r.client).FleetSharingAgreement.
+		UpdateOneID(agreement.ID).
+		SetMaxRentalDays(agreement.Terms.MaxRentalDays).
+		SetOwnerSharePercent(agreement.Terms.OwnerSharePercent).
+		SetValidFrom(agreement.Terms.ValidFrom).
+		SetUpdatedAt(agreement.UpdatedAt)
+
+	if agreement.Terms.ValidUntil.Valid {
+		update.SetValidUntil(agreement.Terms.ValidUntil.Time)
+	} else {
+		update.ClearValidUntil()
+	}
+	if agreement.TerminatedAt.Valid {
+		update.SetTerminatedAt(agreement.TerminatedAt.Time)
+	} else {
+		update.ClearTerminatedAt()
+	}
+
+	_, err := update.Save(ctx)
+	return translateError(err)
+}
+
+// toFleetSharingEntities converts Ent agreements into domain entities
+func toFleetSharingEntities(agreementsDB []*entgen.FleetSharingAgreement) entity.FleetSharingAgreements {
+	agreements := make(entity.FleetSharingAgreements, len(agreementsDB))
+	for i, agreementDB := range agreementsDB {
+		agreements[i] = toFleetSharingEntity(agreementDB)
+	}
+	return agreements
+}
+
+// toFleetSharingEntity converts an Ent agreement into a domain entity
+func toFleetSharingEntity(agreementDB *entgen.FleetSharingAgreement) *entity.FleetSharingAgreement {
+	return &entity.FleetSharingAgreement{
+		ID:               agreementDB.ID,
+		LenderTenantID:   agreementDB.LenderTenantID,
+		BorrowerTenantID: agreementDB.BorrowerTenantID,
+		Terms: entity.FleetSharingTerms{
+			ValidFrom:         agreementDB.ValidFrom,
+			ValidUntil:        null.TimeFromPtr(agreementDB.ValidUntil),
+			MaxRentalDays:     agreementDB.MaxRentalDays,
+			OwnerSharePercent: agreementDB.OwnerSharePercent,
+		},
+		TerminatedAt: null.TimeFromPtr(agreementDB.TerminatedAt),
+		CreatedAt:    agreementDB.CreatedAt,
+		UpdatedAt:    agreementDB.UpdatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/inbox"
+)
+
+type inboxRepository struct {
+	router *Router
+}
+
+// NewInboxRepository creates a new inbox repository. The messages of a tenant isolated in
+// a database are recorded in that database, with the changes their handlers make.
+func NewInboxRepository(router *Router) repository.InboxRepository {
+	return &inboxRepository{
+		router: router,
+	}
+}
+
+// Create records an incoming message, inside RunInTx within the transaction of its handler.
+// It returns repository.ErrAlreadyExists if the message was already recorded; concurrent
+// inserts of the same message block until the first transaction finishes.
+func (r *inboxRepository) Create(ctx context.Context, msg *entity.InboxMessage) error {
+	client, err := tenantDatabaseClient(ctx, r.router)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Inbox.Create().
+		SetID(msg.ID).
+		SetSource(msg.Source).
+		SetMessageID(msg.MessageID).
+		SetEventType(msg.EventType).
+		SetPayload(msg.Payload).
+		SetReceivedAt(msg.ReceivedAt).
+		SetNillableProcessedAt(msg.ProcessedAt.Ptr()).
+		Save(ctx)
+	return translateError(err)
+}
+
+// Exists reports whether a message from the source has already been recorded
+func (r *inboxRepository) Exists(ctx context.Context, source string, messageID string) (bool, error) {
+	client, err := tenantDatabaseClient(ctx, r.router)
+	if err != nil {
+		return false, err
+	}
+
+	return client.Inbox.Query().
+		Where(
+			inbox.Source(source),
+			inbox.MessageID(messageID),
+		).
+		Exist(ctx)
+}
+
+// CleanupProcessedMessages removes processed messages older than the specified duration,
+// from the shared database and the database of every tenant isolated in its own
+func (r *inboxRepository) CleanupProcessedMessages(ctx context.Context, olderThan time.Duration) (int, error) {
+	cutoffTime := time.Now().Add(-olderThan)
+
+	total, err := cleanupInbox(ctx, clientFromContext(ctx, r.router.client), cutoffTime)
+	if err != nil {
+		return 0, err
+	}
+
+	clients, err := r.router.tenantDatabases(ctx)
+	if err != nil {
+		return total, err
+	}
+	for name, client := range clients {
+		affected, err := cleanupInbox(ctx, client, cutoffTime)
+		if err != nil {
+			return total, fmt.Errorf("failed to clean up the inbox of %s: %w", name, err)
+		}
+		total += affected
+	}
+	return total, nil
+}
+
+// cleanupInbox removes the messages of an inbox processed before cutoffTime
+func cleanupInbox(ctx context.Context, client *entgen.Client, cutoffTime time.Time) (int, error) {
+	return client.Inbox.Delete().
+		Where(
+			inbox.ProcessedAtNotNil(),
+			inbox.ProcessedAtLT(cutoffTime),
+		).
+		Exec(ctx)
+}
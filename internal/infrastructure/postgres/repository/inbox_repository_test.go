@@ -0,0 +1,64 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	inboxrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// newInbox creates an inbox record for testing
+func newInbox(source, messageID string, processedAt time.Time) *entity.InboxMessage {
+	return entity.NewInboxMessage(source, messageID, "rental_booked", map[string]interface{}{"rental_id": "rental-1"}, processedAt)
+}
+
+// TestInboxRepository_Create_Duplicate tests that a message can be recorded only once per source
+func TestInboxRepository_Create_Duplicate(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := inboxrepo.NewInboxRepository(testutil.DBRouter)
+	ctx := context.Background()
+
+	// Record the message
+	require.NoError(t, repo.Create(ctx, newInbox("booking", "msg-dup", time.Now())))
+
+	exists, err := repo.Exists(ctx, "booking", "msg-dup")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	// The same message from the same source is rejected
+	err = repo.Create(ctx, newInbox("booking", "msg-dup", time.Now()))
+	require.ErrorIs(t, err, repository.ErrAlreadyExists)
+
+	// The same message ID from another source is a different message
+	require.NoError(t, repo.Create(ctx, newInbox("billing", "msg-dup", time.Now())))
+}
+
+// TestInboxRepository_CleanupProcessedMessages tests that only old records are removed
+func TestInboxRepository_CleanupProcessedMessages(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := inboxrepo.NewInboxRepository(testutil.DBRouter)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Create(ctx, newInbox("cleanup", "msg-old", time.Now().Add(-48*time.Hour))))
+	require.NoError(t, repo.Create(ctx, newInbox("cleanup", "msg-new", time.Now())))
+
+	_, err := repo.CleanupProcessedMessages(ctx, 24*time.Hour)
+	require.NoError(t, err)
+
+	exists, err := repo.Exists(ctx, "cleanup", "msg-old")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = repo.Exists(ctx, "cleanup", "msg-new")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
@@ -0,0 +1,189 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/inbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	entinbox "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/inbox"
+	isolationrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// TestIsolation_Schema tests that the rows of a tenant isolated in a schema are kept there
+func TestIsolation_Schema(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := isolationrepo.NewCarRepository(testutil.DBRouter)
+	tenant := testutil.CreateIsolatedTestTenant(t, entity.TenantIsolationSchema)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+
+	car := newTestCar(t, tenant.ID)
+	require.NoError(t, repo.Create(ctx, car))
+
+	found, err := repo.GetByID(ctx, tenant.ID, car.ID)
+	require.NoError(t, err)
+	require.Equal(t, car.ModelID, found.ModelID)
+
+	// The row is in the tenant's schema and not in the shared table
+	require.Equal(t, 1, countCars(t, testutil.OwnerClient, postgres.TenantNamespace(tenant.Code), car.ID))
+	require.Equal(t, 0, countCars(t, testutil.OwnerClient, "public", car.ID))
+}
+
+// TestIsolation_Database tests that the rows of a tenant isolated in a database are kept
+// there, inside and outside transactions
+func TestIsolation_Database(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := isolationrepo.NewCarRepository(testutil.DBRouter)
+	txManager := isolationrepo.NewTransactionManager(testutil.DBRouter, isolationrepo.TxRetryConfig{})
+	tenant := testutil.CreateIsolatedTestTenant(t, entity.TenantIsolationDatabase)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+
+	car := newTestCar(t, tenant.ID)
+	require.NoError(t, repo.Create(ctx, car))
+
+	inTx := newTestCar(t, tenant.ID)
+	require.NoError(t, txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return repo.Create(ctx, inTx)
+	}))
+
+	cars, _, total, err := repo.ListByTenant(ctx, tenant.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, cars, 2)
+	require.Equal(t, int32(2), total)
+
+	// The rows are in the tenant's database and not in the shared one
+	client, err := postgres.OpenClient(context.Background(), testutil.OwnerDatabaseURL(postgres.TenantNamespace(tenant.Code)))
+	require.NoError(t, err)
+	defer client.Close()
+	for _, id := range []string{car.ID, inTx.ID} {
+		require.Equal(t, 1, countCars(t, client, "public", id))
+		require.Equal(t, 0, countCars(t, testutil.OwnerClient, "public", id))
+	}
+}
+
+// TestIsolation_DatabaseOutbox tests that the outbox messages of a tenant isolated in a
+// database are written there with the tenant's changes, and relayed from there
+func TestIsolation_DatabaseOutbox(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := isolationrepo.NewCarRepository(testutil.DBRouter)
+	outboxRepo := isolationrepo.NewOutboxRepository(testutil.DBClient)
+	txManager := isolationrepo.NewTransactionManager(testutil.DBRouter, isolationrepo.TxRetryConfig{})
+	tenant := testutil.CreateIsolatedTestTenant(t, entity.TenantIsolationDatabase)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+
+	car := newTestCar(t, tenant.ID)
+	msg := entity.NewOutboxMessage(car, "car.created", map[string]interface{}{"id": car.ID}, time.Now())
+	require.NoError(t, txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := repo.Create(ctx, car); err != nil {
+			return err
+		}
+		return outboxRepo.Create(ctx, msg)
+	}))
+
+	// The message is in the outbox of the tenant's database and not in the shared one
+	finder := isolationrepo.NewTenantOutboxFinder(testutil.DBRouter, testutil.AppDatabaseURL)
+	sources, err := finder.Sources(context.Background())
+	require.NoError(t, err)
+	var pending []*entity.OutboxMessage
+	for _, source := range sources {
+		if source.Name == postgres.TenantNamespace(tenant.Code) {
+			pending, err = source.Repo.GetPending(context.Background(), 100)
+			require.NoError(t, err)
+		}
+	}
+	require.Len(t, pending, 1)
+	require.Equal(t, msg.ID, pending[0].ID)
+
+	shared, err := outboxRepo.GetPending(context.Background(), 1000)
+	require.NoError(t, err)
+	for _, m := range shared {
+		require.NotEqual(t, msg.ID, m.ID)
+	}
+}
+
+// TestIsolation_DatabaseInbox tests that the inbox record of a message for a tenant
+// isolated in a database is written there with the handler's changes, so that they commit
+// together and a redelivery is skipped
+func TestIsolation_DatabaseInbox(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := isolationrepo.NewCarRepository(testutil.DBRouter)
+	txManager := isolationrepo.NewTransactionManager(testutil.DBRouter, isolationrepo.TxRetryConfig{})
+	consumer := inbox.NewConsumer(isolationrepo.NewInboxRepository(testutil.DBRouter), txManager)
+	tenant := testutil.CreateIsolatedTestTenant(t, entity.TenantIsolationDatabase)
+
+	car := newTestCar(t, tenant.ID)
+	handled := 0
+	consumer.Register("car_received", inbox.HandlerFunc(func(ctx context.Context, msg *inbox.Message) error {
+		handled++
+		return repo.Create(ctx, car)
+	}))
+
+	msg := &inbox.Message{ID: car.ID, Source: "fleet", TenantID: tenant.ID, EventType: "car_received"}
+	require.NoError(t, consumer.Consume(context.Background(), msg))
+	require.NoError(t, consumer.Consume(context.Background(), msg))
+	require.Equal(t, 1, handled)
+
+	// The record is in the tenant's database with the car, and not in the shared one
+	client, err := postgres.OpenClient(context.Background(), testutil.OwnerDatabaseURL(postgres.TenantNamespace(tenant.Code)))
+	require.NoError(t, err)
+	defer client.Close()
+	require.Equal(t, 1, countCars(t, client, "public", car.ID))
+	n, err := client.Inbox.Query().Where(entinbox.MessageID(car.ID)).Count(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	n, err = testutil.OwnerClient.Inbox.Query().Where(entinbox.MessageID(car.ID)).Count(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+// TestIsolation_Rollback tests that a failed transaction leaves nothing in a tenant database
+func TestIsolation_Rollback(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := isolationrepo.NewCarRepository(testutil.DBRouter)
+	txManager := isolationrepo.NewTransactionManager(testutil.DBRouter, isolationrepo.TxRetryConfig{})
+	tenant := testutil.CreateIsolatedTestTenant(t, entity.TenantIsolationDatabase)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+
+	car := newTestCar(t, tenant.ID)
+	err := txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := repo.Create(ctx, car); err != nil {
+			return err
+		}
+		return context.Canceled
+	})
+	require.ErrorIs(t, err, context.Canceled)
+
+	cars, _, _, err := repo.ListByTenant(ctx, tenant.ID, 10, 0)
+	require.NoError(t, err)
+	require.Empty(t, cars)
+}
+
+// countCars counts the cars with an ID in a schema, as the owner, which bypasses row-level
+// security
+func countCars(t *testing.T, client *entgen.Client, schemaName, id string) int {
+	t.Helper()
+
+	rows, err := client.QueryContext(context.Background(),
+		"SELECT count(*) FROM "+schemaName+".cars WHERE id = $1", id)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var count int
+	require.True(t, rows.Next())
+	require.NoError(t, rows.Scan(&count))
+	return count
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+)
+
+type maintenanceWindowRepository struct {
+	router *Router
+}
+
+// NewMaintenanceWindowRepository creates a new maintenance window repository
+func NewMaintenanceWindowRepository(router *Router) repository.MaintenanceWindowRepository {
+	return &maintenanceWindowRepository{
+		router: router,
+	}
+}
+
+// Create inserts a new maintenance window of a car
+func (r *maintenanceWindowRepository) Create(ctx context.Context, w *entity.MaintenanceWindow) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.MaintenanceWindow, error) {
+		return client.MaintenanceWindow.
+			Create().
+			SetID(w.ID).
+			SetTenantID(w.TenantID).
+			SetCarID(w.CarID).
+			SetType(w.Type.String()).
+			SetReason(w.Reason).
+			SetStartsAt(w.StartsAt).
+			SetEndsAt(w.EndsAt).
+			SetStatus(w.Status.String()).
+			SetCreatedAt(w.CreatedAt).
+			SetUpdatedAt(w.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// GetByID retrieves a maintenance window of a tenant by its ID
+func (r *maintenanceWindowRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.MaintenanceWindow, error) {
+	windowDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.MaintenanceWindow, error) {
+		return client.MaintenanceWindow.
+			Query().
+			Where(maintenancewindow.ID(id), maintenancewindow.TenantID(tenantID)).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toMaintenanceWindowEntity(windowDB), nil
+}
+
+// Update stores the status and the end of an existing maintenance window
+func (r *maintenanceWindowRepository) Update(ctx context.Context, w *entity.MaintenanceWindow) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.MaintenanceWindow, error) {
+		return client.MaintenanceWindow.
+			UpdateOneID(w.ID).
+			Where(maintenancewindow.TenantID(w.TenantID)).
+			SetEndsAt(w.EndsAt).
+			SetStatus(w.Status.String()).
+			SetNillableCompletedAt(w.CompletedAt.Ptr()).
+			SetNillableCanceledAt(w.CanceledAt.Ptr()).
+			SetUpdatedAt(w.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// ListByCar retrieves the maintenance windows of a car of a tenant, ordered by start
+func (r *maintenanceWindowRepository) ListByCar(ctx context.Context, tenantID, carID string) (entity.MaintenanceWindows, error) {
+	windowsDB, err := withTenant(ctx, r.router, func(client *entgen.Client) ([]*entgen.MaintenanceWindow, error) {
+		return client.MaintenanceWindow.
+			Query().
+			Where(maintenancewindow.TenantID(tenantID), maintenancewindow.CarID(carID)).
+			Order(entgen.Asc(maintenancewindow.FieldStartsAt), entgen.Asc(maintenancewindow.FieldID)).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query maintenance windows: %w", err)
+	}
+
+	windows := make(entity.MaintenanceWindows, len(windowsDB))
+	for i, windowDB := range windowsDB {
+		windows[i] = toMaintenanceWindowEntity(windowDB)
+	}
+	return windows, nil
+}
+
+// HasOverlap checks if a window of a car that is not canceled overlaps a period
+func (r *maintenanceWindowRepository) HasOverlap(ctx context.Context, carID string, startsAt, endsAt time.Time) (bool, error) {
+	exists, err := withTenant(ctx, r.router, func(client *entgen.Client) (bool, error) {
+		return client.MaintenanceWindow.
+			Query().
+			Where(maintenancewindow.CarID(carID)).
+			Where(blockingMaintenance(startsAt, endsAt)...).
+			Exist(ctx)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check overlapping maintenance windows: %w", err)
+	}
+	return exists, nil
+}
+
+// blockingMaintenance selects the maintenance windows overlapping [startsAt, endsAt) that
+// keep their car from being booked, as overlapping selects rentals
+func blockingMaintenance(startsAt, endsAt time.Time) []predicate.MaintenanceWindow {
+	return []predicate.MaintenanceWindow{
+		maintenancewindow.StatusNEQ(entity.MaintenanceStatusCanceled.String()),
+		maintenancewindow.StartsAtLT(endsAt),
+		maintenancewindow.EndsAtGT(startsAt),
+	}
+}
+
+// toMaintenanceWindowEntity converts an Ent maintenance window into a domain entity
+func toMaintenanceWindowEntity(windowDB *entgen.MaintenanceWindow) *entity.MaintenanceWindow {
+	return &entity.MaintenanceWindow{
+		ID:          windowDB.ID,
+		TenantID:    windowDB.TenantID,
+		CarID:       windowDB.CarID,
+		Type:        entity.NewMaintenanceType(windowDB.Type),
+		Reason:      windowDB.Reason,
+		StartsAt:    windowDB.StartsAt,
+		EndsAt:      windowDB.EndsAt,
+		Status:      entity.NewMaintenanceStatus(windowDB.Status),
+		CompletedAt: null.TimeFromPtr(windowDB.CompletedAt),
+		CanceledAt:  null.TimeFromPtr(windowDB.CanceledAt),
+		CreatedAt:   windowDB.CreatedAt,
+		UpdatedAt:   windowDB.UpdatedAt,
+	}
+}
@@ -0,0 +1,69 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	maintenancerepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+)
+
+// TestMaintenanceWindowRepository tests that maintenance windows round-trip and block their car
+// until they are canceled
+func TestMaintenanceWindowRepository(t *testing.T) {
+	carRepo, ctx, tenant := testSetup(t, "test-tenant-maintenance")
+	repo := maintenancerepo.NewMaintenanceWindowRepository(testutil.DBRouter)
+
+	inService := newTestCar(t, tenant.ID)
+	require.NoError(t, carRepo.Create(ctx, inService))
+	inShop := newTestCar(t, tenant.ID)
+	require.NoError(t, carRepo.Create(ctx, inShop))
+
+	startsAt := time.Now().Add(24 * time.Hour).Truncate(time.Microsecond)
+	endsAt := startsAt.Add(48 * time.Hour)
+	window, err := entity.NewMaintenanceWindow(tenant.ID, inShop.ID, entity.MaintenanceTypeRepair, "Brake pads", startsAt, endsAt, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, window))
+
+	found, err := repo.GetByID(ctx, tenant.ID, window.ID)
+	require.NoError(t, err)
+	require.Equal(t, entity.MaintenanceTypeRepair, found.Type)
+	require.Equal(t, "Brake pads", found.Reason)
+	require.Equal(t, entity.MaintenanceStatusScheduled, found.Status)
+	require.True(t, startsAt.Equal(found.StartsAt))
+
+	// The car cannot be booked over any part of the window
+	overlap, err := repo.HasOverlap(ctx, inShop.ID, endsAt.Add(-time.Hour), endsAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, overlap)
+	overlap, err = repo.HasOverlap(ctx, inShop.ID, endsAt, endsAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, overlap)
+
+	cars, err := carRepo.ListAvailable(ctx, []string{tenant.ID}, "", startsAt, endsAt, 10)
+	require.NoError(t, err)
+	require.Len(t, cars, 1)
+	require.Equal(t, inService.ID, cars[0].ID)
+
+	// A canceled window frees the car
+	require.NoError(t, window.Cancel(time.Now()))
+	require.NoError(t, repo.Update(ctx, window))
+	overlap, err = repo.HasOverlap(ctx, inShop.ID, startsAt, endsAt)
+	require.NoError(t, err)
+	require.False(t, overlap)
+
+	cars, err = carRepo.ListAvailable(ctx, []string{tenant.ID}, "", startsAt, endsAt, 10)
+	require.NoError(t, err)
+	require.Len(t, cars, 2)
+
+	windows, err := repo.ListByCar(ctx, tenant.ID, inShop.ID)
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+	require.Equal(t, entity.MaintenanceStatusCanceled, windows[0].Status)
+	require.True(t, windows[0].CanceledAt.Valid)
+}
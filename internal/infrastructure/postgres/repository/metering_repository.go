@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerecord"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/usagerollup"
+)
+
+// insertUsageRecord inserts a usage record unless one with its ID exists. A unique
+// violation would abort the surrounding transaction, so conflicts are skipped instead.
+const insertUsageRecord = `INSERT INTO usage_records (id, tenant_id, meter, quantity, occurred_at, recorded_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO NOTHING`
+
+// upsertUsageRollup adds a quantity to a rollup, creating it if needed; %s is the new
+// quantity of an existing rollup
+const upsertUsageRollup = `INSERT INTO usage_rollups (id, tenant_id, meter, period, period_start, quantity, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (tenant_id, period, period_start, meter)
+DO UPDATE SET quantity = %s, updated_at = EXCLUDED.updated_at`
+
+type meteringRepository struct {
+	client *entgen.Client
+}
+
+// NewMeteringRepository creates a new metering repository
+func NewMeteringRepository(client *entgen.Client) repository.MeteringRepository {
+	return &meteringRepository{
+		client: client,
+	}
+}
+
+// Record adds a usage record to its daily and monthly rollups, once per record ID
+func (r *meteringRepository) Record(ctx context.Context, record *entity.UsageRecord) (bool, error) {
+	client := clientFromContext(ctx, r.client)
+	now := time.Now()
+
+	result, err := client.ExecContext(ctx, insertUsageRecord,
+		record.ID, record.TenantID, record.Meter.String(), record.Quantity, record.OccurredAt, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert usage record: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if inserted == 0 {
+		return false, nil
+	}
+
+	// Gauges keep the peak of the period; counters add up
+	quantity := "usage_rollups.quantity + EXCLUDED.quantity"
+	if record.Meter.Gauge() {
+		quantity = "GREATEST(usage_rollups.quantity, EXCLUDED.quantity)"
+	}
+	query := fmt.Sprintf(upsertUsageRollup, quantity)
+	for _, period := range entity.UsagePeriods {
+		_, err := client.ExecContext(ctx, query,
+			ulid.Make().String(), record.TenantID, record.Meter.String(), period.String(),
+			period.Start(record.OccurredAt), record.Quantity, now)
+		if err != nil {
+			return false, fmt.Errorf("failed to update %s usage rollup: %w", period, err)
+		}
+	}
+	return true, nil
+}
+
+// ListRollups retrieves the rollups of a tenant for the periods starting in [from, to)
+func (r *meteringRepository) ListRollups(ctx context.Context, tenantID string, period entity.UsagePeriod, from, to time.Time) (entity.UsageRollups, error) {
+	rollupsDB, err := clientFromContext(ctx, r.client).UsageRollup.
+		Query().
+		Where(
+			usagerollup.TenantID(tenantID),
+			usagerollup.Period(period.String()),
+			usagerollup.PeriodStartGTE(from),
+			usagerollup.PeriodStartLT(to),
+		).
+		Order(usagerollup.ByPeriodStart(), usagerollup.ByMeter()).
+		All(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	rollups := make(entity.UsageRollups, len(rollupsDB))
+	for i, rollupDB := range rollupsDB {
+		rollups[i] = &entity.UsageRollup{
+			TenantID:    rollupDB.TenantID,
+			Meter:       entity.NewMeter(rollupDB.Meter),
+			Period:      entity.NewUsagePeriod(rollupDB.Period),
+			PeriodStart: rollupDB.PeriodStart.UTC(),
+			Quantity:    rollupDB.Quantity,
+			UpdatedAt:   rollupDB.UpdatedAt,
+		}
+	}
+	return rollups, nil
+}
+
+// CleanupRecords removes usage records older than the specified duration
+func (r *meteringRepository) CleanupRecords(ctx context.Context, olderThan time.Duration) (int, error) {
+	cutoffTime := time.Now().Add(-olderThan)
+
+	return clientFromContext(ctx, r.client).UsageRecord.
+		Delete().
+		Where(usagerecord.RecordedAtLT(cutoffTime)).
+		Exec(ctx)
+}
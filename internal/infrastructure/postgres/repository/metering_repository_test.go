@@ -0,0 +1,106 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	meteringrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+)
+
+// newUsageRecord creates a usage record with a new ID for testing
+func newUsageRecord(tenantID string, meter entity.Meter, quantity int64, occurredAt time.Time) *entity.UsageRecord {
+	return &entity.UsageRecord{
+		ID:         ulid.Make().String(),
+		TenantID:   tenantID,
+		Meter:      meter,
+		Quantity:   quantity,
+		OccurredAt: occurredAt,
+	}
+}
+
+// quantities returns the quantity of each meter in rollups
+func quantities(rollups entity.UsageRollups) map[entity.Meter]int64 {
+	q := make(map[entity.Meter]int64)
+	for _, rollup := range rollups {
+		q[rollup.Meter] = rollup.Quantity
+	}
+	return q
+}
+
+// TestMeteringRepository_Record tests that records are rolled up once, counters adding up
+// and gauges keeping their peak
+func TestMeteringRepository_Record(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := meteringrepo.NewMeteringRepository(testutil.DBClient)
+	ctx := context.Background()
+	tenantID := ulid.Make().String()
+	day := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+
+	rental := newUsageRecord(tenantID, entity.MeterRentalsCreated, 1, day)
+	records := []*entity.UsageRecord{
+		rental,
+		newUsageRecord(tenantID, entity.MeterRentalsCreated, 1, day.Add(time.Hour)),
+		newUsageRecord(tenantID, entity.MeterActiveCars, 5, day),
+		newUsageRecord(tenantID, entity.MeterActiveCars, 3, day.Add(time.Hour)),
+		// The next day counts towards the same month
+		newUsageRecord(tenantID, entity.MeterActiveCars, 4, day.AddDate(0, 0, 1)),
+	}
+	for _, record := range records {
+		recorded, err := repo.Record(ctx, record)
+		require.NoError(t, err)
+		require.True(t, recorded)
+	}
+
+	// A record relayed again is skipped
+	recorded, err := repo.Record(ctx, rental)
+	require.NoError(t, err)
+	assert.False(t, recorded)
+
+	monthStart := entity.UsagePeriodMonth.Start(day)
+	days, err := repo.ListRollups(ctx, tenantID, entity.UsagePeriodDay, monthStart, monthStart.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Len(t, days, 3)
+	assert.Equal(t, entity.UsagePeriodDay.Start(day), days[0].PeriodStart)
+	assert.Equal(t, map[entity.Meter]int64{entity.MeterActiveCars: 5, entity.MeterRentalsCreated: 2}, quantities(days[:2]))
+	assert.Equal(t, int64(4), days[2].Quantity)
+
+	months, err := repo.ListRollups(ctx, tenantID, entity.UsagePeriodMonth, monthStart, monthStart.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	assert.Equal(t, map[entity.Meter]int64{entity.MeterActiveCars: 5, entity.MeterRentalsCreated: 2}, quantities(months))
+}
+
+// TestMeteringRepository_CleanupRecords tests that cleaned up records keep their rollups
+func TestMeteringRepository_CleanupRecords(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := meteringrepo.NewMeteringRepository(testutil.DBClient)
+	ctx := context.Background()
+	tenantID := ulid.Make().String()
+	record := newUsageRecord(tenantID, entity.MeterAPICalls, 10, time.Now())
+
+	_, err := repo.Record(ctx, record)
+	require.NoError(t, err)
+
+	_, err = repo.CleanupRecords(ctx, 0)
+	require.NoError(t, err)
+
+	// The record is gone, so relaying it again counts it again
+	recorded, err := repo.Record(ctx, record)
+	require.NoError(t, err)
+	assert.True(t, recorded)
+
+	start := entity.UsagePeriodDay.Start(time.Now())
+	rollups, err := repo.ListRollups(ctx, tenantID, entity.UsagePeriodDay, start, start.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Equal(t, map[entity.Meter]int64{entity.MeterAPICalls: 20}, quantities(rollups))
+}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"time"
 
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/outbox"
 )
@@ -20,49 +24,64 @@ func NewOutboxRepository(client *entgen.Client) repository.OutboxRepository {
 	}
 }
 
-// Create inserts a new outbox message
-func (r *outboxRepository) Create(ctx context.Context, outbox *entgen.Outbox) error {
-	_, err := r.client.Outbox.Create().
-		SetID(outbox.ID).
-		SetAggregateType(outbox.AggregateType).
-		SetAggregateID(outbox.AggregateID).
-		SetEventType(outbox.EventType).
-		SetPayload(outbox.Payload).
-		SetCreatedAt(outbox.CreatedAt).
-		SetStatus(outbox.Status).
-		SetVersion(outbox.Version).
-		Save(ctx)
-	return err
-}
+// notifyQuery wakes up LISTENing relays. NOTIFY is transactional, so when issued
+// inside a transaction the notification is only delivered once it commits.
+const notifyQuery = "SELECT pg_notify($1, $2)"
+
+// Create inserts a new outbox message and notifies listening relays. Inside RunInTx the
+// notification is only delivered once the surrounding transaction commits. For a tenant
+// isolated in its own database, the message goes to that database with the tenant's
+// changes, where the tenant's relay picks it up.
+func (r *outboxRepository) Create(ctx context.Context, msg *entity.OutboxMessage) error {
+	client := clientFromContext(ctx, r.client)
+	if tx := tenantTxFromContext(ctx); tx != nil {
+		client = tx.Client()
+	}
 
-// CreateInTx inserts a new outbox message within a transaction
-func (r *outboxRepository) CreateInTx(ctx context.Context, tx *entgen.Tx, outbox *entgen.Outbox) error {
-	_, err := tx.Outbox.Create().
-		SetID(outbox.ID).
-		SetAggregateType(outbox.AggregateType).
-		SetAggregateID(outbox.AggregateID).
-		SetEventType(outbox.EventType).
-		SetPayload(outbox.Payload).
-		SetCreatedAt(outbox.CreatedAt).
-		SetStatus(outbox.Status).
-		SetVersion(outbox.Version).
+	_, err := client.Outbox.Create().
+		SetID(msg.ID).
+		SetTenantID(msg.TenantID).
+		SetAggregateType(msg.AggregateType).
+		SetAggregateID(msg.AggregateID).
+		SetEventType(msg.EventType).
+		SetPayload(msg.Payload).
+		SetCreatedAt(msg.CreatedAt).
+		SetStatus(msg.Status.String()).
+		SetVersion(msg.Version).
 		Save(ctx)
+	if err != nil {
+		return translateError(err)
+	}
+
+	_, err = client.ExecContext(ctx, notifyQuery, postgres.OutboxChannel, msg.ID)
 	return err
 }
 
 // GetPending retrieves pending outbox messages up to the specified limit
-func (r *outboxRepository) GetPending(ctx context.Context, limit int) ([]*entgen.Outbox, error) {
-	return r.client.Outbox.Query().
-		Where(outbox.Status("pending")).
+func (r *outboxRepository) GetPending(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	messagesDB, err := clientFromContext(ctx, r.client).Outbox.Query().
+		Where(
+			outbox.Status(entity.OutboxStatusPending.String()),
+			outbox.Or(outbox.NextAttemptAtIsNil(), outbox.NextAttemptAtLTE(time.Now())),
+		).
 		Limit(limit).
 		Order(entgen.Asc(outbox.FieldCreatedAt)). // Process in FIFO order
 		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.entsToDomain(messagesDB), nil
 }
 
 // GetPendingWithLock retrieves pending outbox messages with locking
-func (r *outboxRepository) GetPendingWithLock(ctx context.Context, limit int, processorID string) ([]*entgen.Outbox, error) {
-	pendingMessages, err := r.client.Outbox.Query().
-		Where(outbox.Status("pending")).
+func (r *outboxRepository) GetPendingWithLock(ctx context.Context, limit int, processorID string) ([]*entity.OutboxMessage, error) {
+	pendingMessages, err := clientFromContext(ctx, r.client).Outbox.Query().
+		Where(
+			outbox.Status(entity.OutboxStatusPending.String()),
+			outbox.LockedAtIsNil(), // Skip messages already claimed by another processor
+			// Skip messages backing off after a failed publish
+			outbox.Or(outbox.NextAttemptAtIsNil(), outbox.NextAttemptAtLTE(time.Now())),
+		).
 		Limit(limit).
 		Order(entgen.Asc(outbox.FieldCreatedAt)).
 		ForUpdate().
@@ -73,15 +92,16 @@ func (r *outboxRepository) GetPendingWithLock(ctx context.Context, limit int, pr
 
 	// Update the locked messages with processor information
 	now := time.Now()
-	lockedMessages := make([]*entgen.Outbox, 0, len(pendingMessages))
+	lockedMessages := make([]*entity.OutboxMessage, 0, len(pendingMessages))
 
 	for _, msg := range pendingMessages {
-		updatedMsg, err := r.client.Outbox.UpdateOneID(msg.ID).
+		updatedMsg, err := clientFromContext(ctx, r.client).Outbox.UpdateOneID(msg.ID).
+			Where(outbox.LockedAtIsNil()).
 			SetLockedAt(now).
 			SetLockedBy(processorID).
 			Save(ctx)
 		if err == nil {
-			lockedMessages = append(lockedMessages, updatedMsg)
+			lockedMessages = append(lockedMessages, r.entToDomain(updatedMsg))
 		}
 		// If locking fails, another processor got it first - skip it
 	}
@@ -91,19 +111,9 @@ func (r *outboxRepository) GetPendingWithLock(ctx context.Context, limit int, pr
 
 // MarkAsProcessed marks an outbox message as processed
 func (r *outboxRepository) MarkAsProcessed(ctx context.Context, id string, processedAt time.Time) error {
-	return r.client.Outbox.UpdateOneID(id).
+	return clientFromContext(ctx, r.client).Outbox.UpdateOneID(id).
 		SetProcessedAt(processedAt).
-		SetStatus("processed").
-		ClearLockedAt().
-		ClearLockedBy().
-		Exec(ctx)
-}
-
-// MarkAsProcessedInTx marks an outbox message as processed within a transaction
-func (r *outboxRepository) MarkAsProcessedInTx(ctx context.Context, tx *entgen.Tx, id string, processedAt time.Time) error {
-	return tx.Outbox.UpdateOneID(id).
-		SetProcessedAt(processedAt).
-		SetStatus("processed").
+		SetStatus(entity.OutboxStatusProcessed.String()).
 		ClearLockedAt().
 		ClearLockedBy().
 		Exec(ctx)
@@ -111,37 +121,54 @@ func (r *outboxRepository) MarkAsProcessedInTx(ctx context.Context, tx *entgen.T
 
 // MarkAsFailed marks an outbox message as failed
 func (r *outboxRepository) MarkAsFailed(ctx context.Context, id string, errorMessage string) error {
-	return r.client.Outbox.UpdateOneID(id).
-		SetStatus("failed").
+	return clientFromContext(ctx, r.client).Outbox.UpdateOneID(id).
+		SetStatus(entity.OutboxStatusFailed.String()).
 		SetErrorMessage(errorMessage).
 		ClearLockedAt().
 		ClearLockedBy().
 		Exec(ctx)
 }
 
-// MarkAsFailedInTx marks an outbox message as failed within a transaction
-func (r *outboxRepository) MarkAsFailedInTx(ctx context.Context, tx *entgen.Tx, id string, errorMessage string) error {
-	return tx.Outbox.UpdateOneID(id).
-		SetStatus("failed").
-		SetErrorMessage(errorMessage).
+// RecordPublishFailure saves the outcome of a failed publish attempt recorded on msg
+// and releases the lock so that the message is picked up again once it is due
+func (r *outboxRepository) RecordPublishFailure(ctx context.Context, msg *entity.OutboxMessage) error {
+	update := clientFromContext(ctx, r.client).Outbox.UpdateOneID(msg.ID).
+		SetStatus(msg.Status.String()).
+		SetAttempts(msg.Attempts).
 		ClearLockedAt().
-		ClearLockedBy().
-		Exec(ctx)
+		ClearLockedBy()
+
+	if msg.NextAttemptAt.Valid {
+		update.SetNextAttemptAt(msg.NextAttemptAt.Time)
+	} else {
+		update.ClearNextAttemptAt()
+	}
+	if msg.ErrorMessage.Valid {
+		update.SetErrorMessage(truncate(msg.ErrorMessage.String, 1000))
+	} else {
+		update.ClearErrorMessage()
+	}
+
+	return update.Exec(ctx)
 }
 
 // GetFailed retrieves failed outbox messages up to the specified limit
-func (r *outboxRepository) GetFailed(ctx context.Context, limit int) ([]*entgen.Outbox, error) {
-	return r.client.Outbox.Query().
-		Where(outbox.Status("failed")).
+func (r *outboxRepository) GetFailed(ctx context.Context, limit int) ([]*entity.OutboxMessage, error) {
+	messagesDB, err := clientFromContext(ctx, r.client).Outbox.Query().
+		Where(outbox.Status(entity.OutboxStatusFailed.String())).
 		Limit(limit).
 		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.entsToDomain(messagesDB), nil
 }
 
 // UnlockOrphanedMessages unlocks messages that have been locked for too long
 func (r *outboxRepository) UnlockOrphanedMessages(ctx context.Context, olderThan time.Duration) (int, error) {
 	cutoffTime := time.Now().Add(-olderThan)
 
-	affected, err := r.client.Outbox.Update().
+	affected, err := clientFromContext(ctx, r.client).Outbox.Update().
 		Where(
 			outbox.LockedAtNotNil(),
 			outbox.LockedAtLT(cutoffTime),
@@ -157,9 +184,9 @@ func (r *outboxRepository) UnlockOrphanedMessages(ctx context.Context, olderThan
 func (r *outboxRepository) CleanupProcessedMessages(ctx context.Context, olderThan time.Duration) (int, error) {
 	cutoffTime := time.Now().Add(-olderThan)
 
-	affected, err := r.client.Outbox.Delete().
+	affected, err := clientFromContext(ctx, r.client).Outbox.Delete().
 		Where(
-			outbox.Status("processed"),
+			outbox.Status(entity.OutboxStatusProcessed.String()),
 			outbox.ProcessedAtNotNil(),
 			outbox.ProcessedAtLT(cutoffTime),
 		).
@@ -167,3 +194,33 @@ func (r *outboxRepository) CleanupProcessedMessages(ctx context.Context, olderTh
 
 	return affected, err
 }
+
+// entToDomain converts an Ent outbox model to a domain outbox message
+func (r *outboxRepository) entToDomain(entOutbox *entgen.Outbox) *entity.OutboxMessage {
+	return &entity.OutboxMessage{
+		ID:            entOutbox.ID,
+		TenantID:      entOutbox.TenantID,
+		AggregateType: entOutbox.AggregateType,
+		AggregateID:   entOutbox.AggregateID,
+		EventType:     entOutbox.EventType,
+		Payload:       entOutbox.Payload,
+		Status:        entity.NewOutboxStatus(entOutbox.Status),
+		ErrorMessage:  null.StringFromPtr(entOutbox.ErrorMessage),
+		Attempts:      entOutbox.Attempts,
+		NextAttemptAt: null.TimeFromPtr(entOutbox.NextAttemptAt),
+		Version:       entOutbox.Version,
+		CreatedAt:     entOutbox.CreatedAt,
+		ProcessedAt:   null.TimeFromPtr(entOutbox.ProcessedAt),
+		LockedAt:      null.TimeFromPtr(entOutbox.LockedAt),
+		LockedBy:      null.StringFromPtr(entOutbox.LockedBy),
+	}
+}
+
+// entsToDomain converts Ent outbox models to domain outbox messages
+func (r *outboxRepository) entsToDomain(entOutboxes []*entgen.Outbox) []*entity.OutboxMessage {
+	messages := make([]*entity.OutboxMessage, len(entOutboxes))
+	for i, entOutbox := range entOutboxes {
+		messages[i] = r.entToDomain(entOutbox)
+	}
+	return messages
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
+)
+
+type planRepository struct {
+	client *entgen.Client
+}
+
+// NewPlanRepository creates a new plan repository
+func NewPlanRepository(client *entgen.Client) repository.PlanRepository {
+	return &planRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new plan into the catalog
+func (r *planRepository) Create(ctx context.Context, p *entity.Plan) error {
+	_, err := clientFromContext(ctx, r.client).Plan.
+		Create().
+		SetID(p.ID).
+		SetCode(p.Code).
+		SetName(p.Name).
+		SetMaxCars(p.Limits.MaxCars).
+		SetMaxRenters(p.Limits.MaxRenters).
+		SetMaxMonthlyRentals(p.Limits.MaxMonthlyRentals).
+		SetCreatedAt(p.CreatedAt).
+		SetUpdatedAt(p.UpdatedAt).
+		Save(ctx)
+	return translateError(err)
+}
+
+// GetByID retrieves a plan by its ID
+func (r *planRepository) GetByID(ctx context.Context, id string) (*entity.Plan, error) {
+	planDB, err := clientFromContext(ctx, r.client).Plan.
+		Query().
+		Where(plan.ID(id)).
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toPlanEntity(planDB), nil
+}
+
+// GetByCode retrieves a plan by its code
+func (r *planRepository) GetByCode(ctx context.Context, code string) (*entity.Plan, error) {
+	planDB, err := clientFromContext(ctx, r.client).Plan.
+		Query().
+		Where(plan.Code(code)).
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toPlanEntity(planDB), nil
+}
+
+// List retrieves every plan of the catalog
+func (r *planRepository) List(ctx context.Context) (entity.Plans, error) {
+	plansDB, err := clientFromContext(ctx, r.client).Plan.
+		Query().
+		Order(entgen.Asc(plan.FieldCode)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make(entity.Plans, len(plansDB))
+	for i, planDB := range plansDB {
+		plans[i] = toPlanEntity(planDB)
+	}
+	return plans, nil
+}
+
+// toPlanEntity converts an Ent plan into a domain entity
+func toPlanEntity(planDB *entgen.Plan) *entity.Plan {
+	return &entity.Plan{
+		ID:   planDB.ID,
+		Code: planDB.Code,
+		Name: planDB.Name,
+		Limits: entity.PlanLimits{
+			MaxCars:           planDB.MaxCars,
+			MaxRenters:        planDB.MaxRenters,
+			MaxMonthlyRentals: planDB.MaxMonthlyRentals,
+		},
+		CreatedAt: planDB.CreatedAt,
+		UpdatedAt: planDB.UpdatedAt,
+	}
+}
@@ -0,0 +1,111 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	planrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// TestPlanRepository tests that plans are found by ID and code and that codes are unique
+func TestPlanRepository(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := planrepo.NewPlanRepository(testutil.DBClient)
+	ctx := context.Background()
+	code := "plan-" + ulid.Make().String()
+	limits := entity.PlanLimits{MaxCars: 10, MaxRenters: 100, MaxMonthlyRentals: 1000}
+
+	plan := entity.NewPlan(code, "Standard", limits, time.Now())
+	require.NoError(t, repo.Create(ctx, plan))
+
+	found, err := repo.GetByCode(ctx, code)
+	require.NoError(t, err)
+	require.Equal(t, plan.ID, found.ID)
+	require.Equal(t, limits, found.Limits)
+
+	found, err = repo.GetByID(ctx, plan.ID)
+	require.NoError(t, err)
+	require.Equal(t, code, found.Code)
+
+	plans, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Contains(t, planCodes(plans), code)
+
+	err = repo.Create(ctx, entity.NewPlan(code, "Duplicate", limits, time.Now()))
+	require.ErrorIs(t, err, repository.ErrAlreadyExists)
+
+	_, err = repo.GetByCode(ctx, "no-such-plan")
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestTenantRepository_ChangePlan tests that the plan of a tenant round-trips
+func TestTenantRepository_ChangePlan(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	planRepo := planrepo.NewPlanRepository(testutil.DBClient)
+	tenantRepo := planrepo.NewTenantRepository(testutil.DBClient)
+	ctx := context.Background()
+
+	plan := entity.NewPlan("plan-"+ulid.Make().String(), "Standard", entity.PlanLimits{MaxCars: 10}, time.Now())
+	require.NoError(t, planRepo.Create(ctx, plan))
+	created := testutil.CreateRandomTestTenant(t)
+
+	tenant, err := tenantRepo.GetByIDForUpdate(ctx, created.ID)
+	require.NoError(t, err)
+	require.False(t, tenant.PlanID.Valid)
+
+	tenant.ChangePlan(plan.ID, time.Now())
+	require.NoError(t, tenantRepo.Update(ctx, tenant))
+
+	found, err := tenantRepo.GetByID(ctx, tenant.ID)
+	require.NoError(t, err)
+	require.Equal(t, plan.ID, found.PlanID.String)
+}
+
+// TestUsageRepository_Count tests that live cars of the tenant are counted
+func TestUsageRepository_Count(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	usageRepo := planrepo.NewUsageRepository(testutil.DBRouter)
+	carRepo := planrepo.NewCarRepository(testutil.DBRouter)
+	tenant := testutil.CreateRandomTestTenant(t)
+	other := testutil.CreateRandomTestTenant(t)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+	otherCtx := tenantctx.WithTenantID(context.Background(), other.ID)
+
+	for range 2 {
+		require.NoError(t, carRepo.Create(ctx, newTestCar(t, tenant.ID)))
+	}
+	require.NoError(t, carRepo.Create(otherCtx, newTestCar(t, other.ID)))
+
+	monthStart := time.Date(time.Now().Year(), time.Now().Month(), 1, 0, 0, 0, 0, time.UTC)
+	for resource, want := range map[entity.Resource]int{
+		entity.ResourceCars:           2,
+		entity.ResourceRenters:        0,
+		entity.ResourceMonthlyRentals: 0,
+	} {
+		used, err := usageRepo.Count(ctx, tenant.ID, resource, monthStart)
+		require.NoError(t, err)
+		require.Equal(t, want, used, resource)
+	}
+}
+
+// planCodes returns the codes of plans
+func planCodes(plans entity.Plans) []string {
+	codes := make([]string, len(plans))
+	for i, plan := range plans {
+		codes[i] = plan.Code
+	}
+	return codes
+}
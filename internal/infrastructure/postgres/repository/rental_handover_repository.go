@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
+)
+
+type rentalHandoverRepository struct {
+	router *Router
+}
+
+// NewRentalHandoverRepository creates a new rental handover repository
+func NewRentalHandoverRepository(router *Router) repository.RentalHandoverRepository {
+	return &rentalHandoverRepository{
+		router: router,
+	}
+}
+
+// Create inserts a new handover of a rental
+func (r *rentalHandoverRepository) Create(ctx context.Context, h *entity.RentalHandover) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.RentalHandover, error) {
+		return client.RentalHandover.
+			Create().
+			SetID(h.ID).
+			SetTenantID(h.TenantID).
+			SetRentalID(h.RentalID).
+			SetKind(h.Kind.String()).
+			SetOdometerKm(h.Reading.OdometerKm).
+			SetFuelLevel(h.Reading.FuelLevel).
+			SetDamages(toDamagesSchema(h.Reading.Damages)).
+			SetDistanceKm(h.DistanceKm).
+			SetFuelShortfall(h.FuelShortfall).
+			SetInspectedAt(h.InspectedAt).
+			SetCreatedAt(h.CreatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// GetByRental retrieves the handover of a kind of a rental a tenant booked
+func (r *rentalHandoverRepository) GetByRental(ctx context.Context, tenantID, rentalID string, kind entity.HandoverKind) (*entity.RentalHandover, error) {
+	handoverDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.RentalHandover, error) {
+		return client.RentalHandover.
+			Query().
+			Where(
+				rentalhandover.RentalID(rentalID),
+				rentalhandover.TenantID(tenantID),
+				rentalhandover.Kind(kind.String()),
+			).
+			WithRental().
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toRentalHandoverEntity(handoverDB), nil
+}
+
+// ListByRental retrieves the handovers of a rental a tenant booked or owns the car of, in
+// the order they were made
+func (r *rentalHandoverRepository) ListByRental(ctx context.Context, tenantID, rentalID string) (entity.RentalHandovers, error) {
+	handoversDB, err := withTenant(ctx, r.router, func(client *entgen.Client) ([]*entgen.RentalHandover, error) {
+		return client.RentalHandover.
+			Query().
+			Where(
+				rentalhandover.RentalID(rentalID),
+				rentalhandover.HasRentalWith(rental.Or(rental.TenantID(tenantID), rental.OwnerTenantID(tenantID))),
+			).
+			WithRental().
+			Order(entgen.Asc(rentalhandover.FieldInspectedAt), entgen.Asc(rentalhandover.FieldID)).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rental handovers: %w", err)
+	}
+
+	handovers := make(entity.RentalHandovers, len(handoversDB))
+	for i, handoverDB := range handoversDB {
+		handovers[i] = toRentalHandoverEntity(handoverDB)
+	}
+	return handovers, nil
+}
+
+// toDamagesSchema converts the damage checklist of a handover to its stored form
+func toDamagesSchema(damages []entity.Damage) []schema.HandoverDamage {
+	stored := make([]schema.HandoverDamage, len(damages))
+	for i, d := range damages {
+		stored[i] = schema.HandoverDamage{Area: d.Area.String(), Description: d.Description}
+	}
+	return stored
+}
+
+// toRentalHandoverEntity converts an Ent handover, loaded with its rental, into a domain
+// entity
+func toRentalHandoverEntity(handoverDB *entgen.RentalHandover) *entity.RentalHandover {
+	damages := make([]entity.Damage, len(handoverDB.Damages))
+	for i, d := range handoverDB.Damages {
+		damages[i] = entity.Damage{Area: entity.NewDamageArea(d.Area), Description: d.Description}
+	}
+	rentalEntity := toRentalEntity(handoverDB.Edges.Rental)
+	return &entity.RentalHandover{
+		ID:            handoverDB.ID,
+		TenantID:      handoverDB.TenantID,
+		OwnerTenantID: rentalEntity.OwnerTenantID,
+		RentalID:      handoverDB.RentalID,
+		CarID:         rentalEntity.CarID,
+		Kind:          entity.NewHandoverKind(handoverDB.Kind),
+		Reading: entity.HandoverReading{
+			OdometerKm: handoverDB.OdometerKm,
+			FuelLevel:  handoverDB.FuelLevel,
+			Damages:    damages,
+		},
+		DistanceKm:    handoverDB.DistanceKm,
+		FuelShortfall: handoverDB.FuelShortfall,
+		InspectedAt:   handoverDB.InspectedAt,
+		CreatedAt:     handoverDB.CreatedAt,
+	}
+}
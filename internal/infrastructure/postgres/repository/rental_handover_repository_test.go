@@ -0,0 +1,71 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	handoverrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+)
+
+// TestRentalHandoverRepository tests that handovers round-trip with their damage checklist
+// and that a rental is handed over once at pickup and once at return
+func TestRentalHandoverRepository(t *testing.T) {
+	carRepo, ctx, tenant := testSetup(t, "test-tenant-handover")
+	rentalRepo := handoverrepo.NewRentalRepository(testutil.DBRouter)
+	renterRepo := handoverrepo.NewRenterRepository(testutil.DBRouter)
+	repo := handoverrepo.NewRentalHandoverRepository(testutil.DBRouter)
+
+	car := newTestCar(t, tenant.ID)
+	require.NoError(t, carRepo.Create(ctx, car))
+	renter := entity.NewRenter(tenant.ID, entity.IndividualRenter, time.Now())
+	require.NoError(t, renterRepo.Create(ctx, renter))
+	startsAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	rental, err := entity.NewRental(entity.DefaultTenantSettings(tenant.ID, time.Now()), car, renter.ID, entity.RentalBranches{}, startsAt, startsAt.Add(48*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, rentalRepo.Create(ctx, rental))
+
+	// Nothing is handed over yet
+	_, err = repo.GetByRental(ctx, tenant.ID, rental.ID, entity.HandoverKindPickup)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	pickedUpAt := time.Now().Truncate(time.Microsecond)
+	pickup, err := entity.NewPickupHandover(rental, entity.HandoverReading{
+		OdometerKm: 12000,
+		FuelLevel:  90,
+		Damages:    []entity.Damage{{Area: entity.DamageAreaRear, Description: "Scratch on the bumper"}},
+	}, pickedUpAt)
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, pickup))
+
+	found, err := repo.GetByRental(ctx, tenant.ID, rental.ID, entity.HandoverKindPickup)
+	require.NoError(t, err)
+	require.Equal(t, pickup.ID, found.ID)
+	require.Equal(t, car.ID, found.CarID)
+	require.Equal(t, pickup.Reading, found.Reading)
+	require.True(t, pickedUpAt.Equal(found.InspectedAt))
+
+	// A rental is picked up once
+	again, err := entity.NewPickupHandover(rental, entity.HandoverReading{OdometerKm: 12000, FuelLevel: 90}, time.Now())
+	require.NoError(t, err)
+	require.ErrorIs(t, repo.Create(ctx, again), repository.ErrAlreadyExists)
+
+	ret, err := entity.NewReturnHandover(rental, found, entity.HandoverReading{OdometerKm: 12420, FuelLevel: 35}, pickedUpAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, ret))
+
+	handovers, err := repo.ListByRental(ctx, tenant.ID, rental.ID)
+	require.NoError(t, err)
+	require.Len(t, handovers, 2)
+	require.Equal(t, entity.HandoverKindPickup, handovers[0].Kind)
+	require.Equal(t, entity.HandoverKindReturn, handovers[1].Kind)
+	require.Equal(t, 420, handovers[1].DistanceKm)
+	require.Equal(t, 55, handovers[1].FuelShortfall)
+	require.Empty(t, handovers[1].Reading.Damages)
+}
@@ -0,0 +1,254 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	rental "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+)
+
+// lockCarQuery takes a transaction-level advisory lock on a car. Cars shared under a fleet
+// sharing agreement are read-only to the borrower, so their rows cannot be locked with
+// SELECT ... FOR UPDATE.
+const lockCarQuery = "SELECT pg_advisory_xact_lock(hashtextextended($1, 0))"
+
+// hasOverlapQuery checks if a period of a car in the view of rental periods overlaps
+// [$2, $3). Unlike rentals, the view shows a borrower the periods of the rentals of its
+// lenders' cars that other tenants booked.
+const hasOverlapQuery = "SELECT EXISTS (SELECT 1 FROM " + postgres.RentalPeriodsView +
+	" WHERE car_id = $1 AND starts_at < $3 AND ends_at > $2)"
+
+type rentalRepository struct {
+	router *Router
+}
+
+// NewRentalRepository creates a new rental repository
+func NewRentalRepository(router *Router) repository.RentalRepository {
+	return &rentalRepository{
+		router: router,
+	}
+}
+
+// Create inserts a new rental into the database
+func (r *rentalRepository) Create(ctx context.Context, rental *entity.Rental) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Rental, error) {
+		return client.Rental.
+			Create().
+			SetID(rental.ID).
+			SetTenantID(rental.TenantID).
+			SetOwnerTenantID(rental.OwnerTenantID).
+			SetCarID(rental.CarID).
+			SetRenterID(rental.RenterID).
+			SetNillablePickupBranchID(nillableString(rental.PickupBranchID)).
+			SetNillableReturnBranchID(nillableString(rental.ReturnBranchID)).
+			SetStartsAt(rental.StartsAt).
+			SetEndsAt(rental.EndsAt).
+			SetCreatedAt(rental.CreatedAt).
+			SetUpdatedAt(rental.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// GetByID retrieves a live rental the tenant booked by its ID
+func (r *rentalRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.Rental, error) {
+	rentalDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Rental, error) {
+		return client.Rental.
+			Query().
+			Where(rental.ID(id), rental.TenantID(tenantID), rental.DeletedAtIsNil()).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toRentalEntity(rentalDB), nil
+}
+
+// Update stores the return of a rental of the rental's tenant
+func (r *rentalRepository) Update(ctx context.Context, re *entity.Rental) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Rental, error) {
+		return client.Rental.
+			UpdateOneID(re.ID).
+			Where(rental.TenantID(re.TenantID)).
+			SetNillableReturnedAt(re.ReturnedAt.Ptr()).
+			SetUpdatedAt(re.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// LockCar serializes the bookings of a car until the end of the transaction of ctx
+func (r *rentalRepository) LockCar(ctx context.Context, carID string) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (struct{}, error) {
+		_, err := client.ExecContext(ctx, lockCarQuery, carID)
+		return struct{}{}, err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to lock car: %w", err)
+	}
+	return nil
+}
+
+// HasOverlap reports whether a live rental of the car overlaps [startsAt, endsAt), reading
+// the periods of the rentals other tenants booked without their renters
+func (r *rentalRepository) HasOverlap(ctx context.Context, carID string, startsAt, endsAt time.Time) (bool, error) {
+	exists, err := withTenant(ctx, r.router, func(client *entgen.Client) (bool, error) {
+		rows, err := client.QueryContext(ctx, hasOverlapQuery, carID, startsAt, endsAt)
+		if err != nil {
+			return false, err
+		}
+		defer rows.Close()
+
+		var exists bool
+		if rows.Next() {
+			if err := rows.Scan(&exists); err != nil {
+				return false, err
+			}
+		}
+		return exists, rows.Err()
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check overlapping rentals: %w", err)
+	}
+	return exists, nil
+}
+
+// ListOverlapping retrieves the live rentals of a car overlapping a period, ordered by start.
+// Only the owner of the car reads the rentals other tenants booked.
+func (r *rentalRepository) ListOverlapping(ctx context.Context, carID string, startsAt, endsAt time.Time) (entity.Rentals, error) {
+	rentalsDB, err := withTenant(ctx, r.router, func(client *entgen.Client) ([]*entgen.Rental, error) {
+		return client.Rental.
+			Query().
+			Where(rental.CarID(carID)).
+			Where(overlapping(startsAt, endsAt)...).
+			Order(entgen.Asc(rental.FieldStartsAt), entgen.Asc(rental.FieldID)).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query overlapping rentals: %w", err)
+	}
+
+	rentals := make(entity.Rentals, len(rentalsDB))
+	for i, rentalDB := range rentalsDB {
+		rentals[i] = toRentalEntity(rentalDB)
+	}
+	return rentals, nil
+}
+
+// ListByTenant retrieves the rentals a tenant booked or owns the car of, with pagination
+func (r *rentalRepository) ListByTenant(ctx context.Context, tenantID string, filter repository.RentalFilter, limit int, offset int) (entity.Rentals, int, error) {
+	type page struct {
+		rentals []*entgen.Rental
+		total   int
+	}
+
+	result, err := withTenant(ctx, r.router, func(client *entgen.Client) (page, error) {
+		query := client.Rental.
+			Query().
+			Where(
+				rental.Or(rental.TenantID(tenantID), rental.OwnerTenantID(tenantID)),
+				rental.DeletedAtIsNil(),
+			)
+		if filter.RenterID != "" {
+			query = query.Where(rental.RenterID(filter.RenterID))
+		}
+
+		total, err := query.Clone().Count(ctx)
+		if err != nil {
+			return page{}, err
+		}
+		rentals, err := query.
+			Order(entgen.Desc(rental.FieldStartsAt), entgen.Asc(rental.FieldID)).
+			Limit(limit).
+			Offset(offset).
+			All(ctx)
+		if err != nil {
+			return page{}, err
+		}
+		return page{rentals: rentals, total: total}, nil
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query rentals: %w", err)
+	}
+
+	rentals := make(entity.Rentals, len(result.rentals))
+	for i, rentalDB := range result.rentals {
+		rentals[i] = toRentalEntity(rentalDB)
+	}
+	return rentals, result.total, nil
+}
+
+// overlapping selects the live rentals overlapping [startsAt, endsAt). A rental ends when
+// its car is returned, so that a car returned early can be booked again right away.
+func overlapping(startsAt, endsAt time.Time) []predicate.Rental {
+	return []predicate.Rental{
+		rental.DeletedAtIsNil(),
+		rental.StartsAtLT(endsAt),
+		endsAfter(startsAt),
+	}
+}
+
+// notRented selects the cars without a period in the view of rental periods overlapping
+// [startsAt, endsAt), which covers the rentals of shared cars that other tenants booked
+func notRented(startsAt, endsAt time.Time) predicate.Car {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("NOT EXISTS (SELECT 1 FROM ").
+				Ident(postgres.RentalPeriodsView).
+				WriteString(" WHERE car_id = ").
+				Ident(s.C(car.FieldID)).
+				WriteString(" AND starts_at < ").
+				Arg(endsAt).
+				WriteString(" AND ends_at > ").
+				Arg(startsAt).
+				WriteString(")")
+		}))
+	}
+}
+
+// endsAfter selects the rentals whose car is returned, or due back, after t
+func endsAfter(t time.Time) predicate.Rental {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("COALESCE(").
+				Ident(s.C(rental.FieldReturnedAt)).Comma().
+				Ident(s.C(rental.FieldEndsAt)).
+				WriteString(") > ").
+				Arg(t)
+		}))
+	}
+}
+
+// toRentalEntity converts an Ent rental into a domain entity. Rentals booked before fleet
+// sharing have no owner and belong to the booking tenant.
+func toRentalEntity(rentalDB *entgen.Rental) *entity.Rental {
+	ownerTenantID := rentalDB.OwnerTenantID
+	if ownerTenantID == "" {
+		ownerTenantID = rentalDB.TenantID
+	}
+	return &entity.Rental{
+		ID:             rentalDB.ID,
+		TenantID:       rentalDB.TenantID,
+		OwnerTenantID:  ownerTenantID,
+		CarID:          rentalDB.CarID,
+		RenterID:       rentalDB.RenterID,
+		PickupBranchID: null.StringFromPtr(rentalDB.PickupBranchID).String,
+		ReturnBranchID: null.StringFromPtr(rentalDB.ReturnBranchID).String,
+		StartsAt:       rentalDB.StartsAt,
+		EndsAt:         rentalDB.EndsAt,
+		ReturnedAt:     null.TimeFromPtr(rentalDB.ReturnedAt),
+		CreatedAt:      rentalDB.CreatedAt,
+		UpdatedAt:      rentalDB.UpdatedAt,
+	}
+}
@@ -0,0 +1,54 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	rentalrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+)
+
+// TestRentalRepository_HasOverlap_EarlyReturn tests that a car returned before its rental
+// ends can be booked again right away
+func TestRentalRepository_HasOverlap_EarlyReturn(t *testing.T) {
+	carRepo, ctx, tenant := testSetup(t, "test-tenant-early-return")
+	repo := rentalrepo.NewRentalRepository(testutil.DBRouter)
+	renterRepo := rentalrepo.NewRenterRepository(testutil.DBRouter)
+
+	car := newTestCar(t, tenant.ID)
+	require.NoError(t, carRepo.Create(ctx, car))
+	renter := entity.NewRenter(tenant.ID, entity.IndividualRenter, time.Now())
+	require.NoError(t, renterRepo.Create(ctx, renter))
+	startsAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	rental, err := entity.NewRental(entity.DefaultTenantSettings(tenant.ID, time.Now()), car, renter.ID, entity.RentalBranches{}, startsAt, startsAt.Add(72*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, rental))
+
+	// The car is booked until the rental ends
+	nextStartsAt := time.Now().Add(time.Hour)
+	nextEndsAt := nextStartsAt.Add(24 * time.Hour)
+	overlap, err := repo.HasOverlap(ctx, car.ID, nextStartsAt, nextEndsAt)
+	require.NoError(t, err)
+	require.True(t, overlap)
+
+	// Once returned early, it is free from the return on
+	require.NoError(t, rental.Return(time.Now()))
+	require.NoError(t, repo.Update(ctx, rental))
+
+	overlap, err = repo.HasOverlap(ctx, car.ID, nextStartsAt, nextEndsAt)
+	require.NoError(t, err)
+	require.False(t, overlap)
+	overlap, err = repo.HasOverlap(ctx, car.ID, startsAt, nextEndsAt)
+	require.NoError(t, err)
+	require.True(t, overlap)
+
+	cars, err := carRepo.ListAvailable(ctx, []string{tenant.ID}, "", nextStartsAt, nextEndsAt, 10)
+	require.NoError(t, err)
+	require.Len(t, cars, 1)
+	require.Equal(t, car.ID, cars[0].ID)
+}
@@ -12,37 +12,41 @@ import (
 )
 
 type renterRepository struct {
-	client *entgen.Client
+	router *Router
 }
 
 // NewRenterRepository creates a new renter repository
-func NewRenterRepository(client *entgen.Client) repository.RenterRepository {
+func NewRenterRepository(router *Router) repository.RenterRepository {
 	return &renterRepository{
-		client: client,
+		router: router,
 	}
 }
 
 // Create inserts a new renter into the database
 func (r *renterRepository) Create(ctx context.Context, renter *entity.Renter) error {
-	_, err := r.client.Renter.
-		Create().
-		SetID(renter.ID).
-		SetTenantID(renter.TenantID).
-		SetType(string(renter.Type)).
-		SetCreatedAt(renter.CreatedAt).
-		SetUpdatedAt(renter.UpdatedAt).
-		Save(ctx)
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Renter, error) {
+		return client.Renter.
+			Create().
+			SetID(renter.ID).
+			SetTenantID(renter.TenantID).
+			SetType(string(renter.Type)).
+			SetCreatedAt(renter.CreatedAt).
+			SetUpdatedAt(renter.UpdatedAt).
+			Save(ctx)
+	})
 	return err
 }
 
 // GetByID retrieves a renter by its ID
 func (r *renterRepository) GetByID(ctx context.Context, id string) (*entity.Renter, error) {
-	renterDB, err := r.client.Renter.
-		Query().
-		Where(renter.ID(id)).
-		Only(ctx)
+	renterDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Renter, error) {
+		return client.Renter.
+			Query().
+			Where(renter.ID(id)).
+			Only(ctx)
+	})
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	// Direct conversion from Ent model to domain entity
@@ -70,23 +74,27 @@ func (r *renterRepository) Update(ctx context.Context, renter *entity.Renter) er
 	// Update the UpdatedAt field to the current time
 	renter.UpdatedAt = time.Now()
 
-	_, err := r.client.Renter.
-		UpdateOneID(renter.ID).
-		SetTenantID(renter.TenantID).
-		SetType(string(renter.Type)).
-		SetUpdatedAt(renter.UpdatedAt).
-		Save(ctx)
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.Renter, error) {
+		return client.Renter.
+			UpdateOneID(renter.ID).
+			SetTenantID(renter.TenantID).
+			SetType(string(renter.Type)).
+			SetUpdatedAt(renter.UpdatedAt).
+			Save(ctx)
+	})
 	return err
 }
 
 // Delete removes a renter by its ID
 func (r *renterRepository) Delete(ctx context.Context, id string) error {
-	err := r.client.Renter.
-		DeleteOneID(id).
-		Exec(ctx)
-		// Make the delete operation idempotent by ignoring "not found" errors
-		// If the record doesn't exist, DeleteOneID.Exec() will return an error
-		// We want Delete to be idempotent, so we ignore "not found" errors
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (struct{}, error) {
+		return struct{}{}, client.Renter.
+			DeleteOneID(id).
+			Exec(ctx)
+	})
+	// Make the delete operation idempotent by ignoring "not found" errors
+	// If the record doesn't exist, DeleteOneID.Exec() will return an error
+	// We want Delete to be idempotent, so we ignore "not found" errors
 	if err != nil {
 		// Check if it's a "not found" error by checking the error message
 		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "no rows in result set") {
@@ -0,0 +1,238 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+	rlsrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"github.com/oklog/ulid/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// rlsSetup creates two tenants with one car each and returns a context scoped to each tenant
+func rlsSetup(t *testing.T, prefix string) (repository.CarRepository, context.Context, context.Context, *entity.Car, *entity.Car) {
+	t.Helper()
+	testutil.SkipIfShort(t)
+
+	repo := rlsrepo.NewCarRepository(testutil.DBRouter)
+	tenantA := testutil.CreateTestTenant(t, prefix+"-a")
+	tenantB := testutil.CreateTestTenant(t, prefix+"-b")
+	ctxA := tenantctx.WithTenantID(context.Background(), tenantA.ID)
+	ctxB := tenantctx.WithTenantID(context.Background(), tenantB.ID)
+
+	carA := newTestCar(t, tenantA.ID)
+	require.NoError(t, repo.Create(ctxA, carA))
+	carB := newTestCar(t, tenantB.ID)
+	require.NoError(t, repo.Create(ctxB, carB))
+
+	return repo, ctxA, ctxB, carA, carB
+}
+
+// TestRowLevelSecurity_Read tests that a tenant cannot read another tenant's rows
+func TestRowLevelSecurity_Read(t *testing.T) {
+	repo, ctxA, _, carA, carB := rlsSetup(t, "test-tenant-rls-read")
+
+	// Own rows are visible
+	found, err := repo.GetByID(ctxA, carA.TenantID, carA.ID)
+	require.NoError(t, err)
+	require.Equal(t, carA.ID, found.ID)
+
+	// Rows of another tenant are not, even when the query asks for them explicitly
+	_, err = repo.GetByID(ctxA, carB.TenantID, carB.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	// Nor is the other tenant's list
+	cars, _, _, err := repo.ListByTenant(ctxA, carB.TenantID, 10, 0)
+	require.NoError(t, err)
+	require.Empty(t, cars)
+}
+
+// TestRowLevelSecurity_Write tests that a tenant cannot modify another tenant's rows
+func TestRowLevelSecurity_Write(t *testing.T) {
+	repo, ctxA, ctxB, _, carB := rlsSetup(t, "test-tenant-rls-write")
+
+	// Inserting a row for another tenant violates the policy
+	err := repo.Create(ctxA, newTestCar(t, carB.TenantID))
+	require.Error(t, err)
+
+	// Updating another tenant's row finds nothing to update
+	plate, err := value.NewLicensePlate("US", "HIJACKED")
+	require.NoError(t, err)
+	updated := *carB
+	updated.LicensePlate = plate
+	require.Error(t, repo.Update(ctxA, &updated))
+
+	// Deleting another tenant's row leaves it in place
+	require.NoError(t, repo.Delete(ctxA, carB.TenantID, carB.ID))
+
+	found, err := repo.GetByID(ctxB, carB.TenantID, carB.ID)
+	require.NoError(t, err)
+	require.Nil(t, found.LicensePlate)
+}
+
+// TestRowLevelSecurity_NoTenant tests that tenant-scoped rows are hidden without a tenant in the context
+func TestRowLevelSecurity_NoTenant(t *testing.T) {
+	_, _, _, carA, _ := rlsSetup(t, "test-tenant-rls-none")
+	ctx := context.Background()
+
+	count, err := testutil.DBClient.Car.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// The table owner bypasses the policies
+	exists, err := testutil.OwnerClient.Car.Get(ctx, carA.ID)
+	require.NoError(t, err)
+	require.Equal(t, carA.ID, exists.ID)
+}
+
+// TestRowLevelSecurity_RunInTx tests that a transaction is scoped to the tenant of its context
+func TestRowLevelSecurity_RunInTx(t *testing.T) {
+	repo, ctxA, _, carA, carB := rlsSetup(t, "test-tenant-rls-tx")
+	txManager := rlsrepo.NewTransactionManager(testutil.DBRouter, rlsrepo.TxRetryConfig{})
+
+	err := txManager.RunInTx(ctxA, func(ctx context.Context) error {
+		if _, err := repo.GetByID(ctx, carA.TenantID, carA.ID); err != nil {
+			return err
+		}
+		_, err := repo.GetByID(ctx, carB.TenantID, carB.ID)
+		require.ErrorIs(t, err, repository.ErrNotFound)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestRowLevelSecurity_FleetSharing tests that a borrower only reads the cars of a lender
+// while an agreement is active, that the lender reads the rentals of its cars, and that the
+// borrower only reads the periods of the rentals of those cars it did not book
+func TestRowLevelSecurity_FleetSharing(t *testing.T) {
+	repo, ctxA, ctxB, carA, carB := rlsSetup(t, "test-tenant-rls-sharing")
+	sharingRepo := rlsrepo.NewFleetSharingRepository(testutil.DBClient)
+	rentalRepo := rlsrepo.NewRentalRepository(testutil.DBRouter)
+	renterRepo := rlsrepo.NewRenterRepository(testutil.DBRouter)
+	tenantIDs := []string{carA.TenantID, carB.TenantID}
+	startsAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	endsAt := startsAt.Add(48 * time.Hour)
+
+	// Without an agreement, B only finds its own car
+	cars, err := repo.ListAvailable(ctxB, tenantIDs, "", startsAt, endsAt, 10)
+	require.NoError(t, err)
+	require.Len(t, cars, 1)
+	require.Equal(t, carB.ID, cars[0].ID)
+
+	// A lends its cars to B
+	agreement := &entity.FleetSharingAgreement{
+		ID:               ulid.Make().String(),
+		LenderTenantID:   carA.TenantID,
+		BorrowerTenantID: carB.TenantID,
+		Terms:            entity.FleetSharingTerms{ValidFrom: time.Now()},
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+	require.NoError(t, sharingRepo.Create(context.Background(), agreement))
+
+	cars, err = repo.ListAvailable(ctxB, tenantIDs, "", startsAt, endsAt, 10)
+	require.NoError(t, err)
+	require.Len(t, cars, 2)
+	for _, car := range cars {
+		// The model of the shared car is read from the catalog of A
+		require.NotNil(t, car.Model())
+		require.Equal(t, car.TenantID, car.Model().TenantID)
+	}
+
+	// The shared car is read-only to B
+	plate, err := value.NewLicensePlate("US", "HIJACKED")
+	require.NoError(t, err)
+	updated := *carA
+	updated.LicensePlate = plate
+	require.Error(t, repo.Update(ctxB, &updated))
+
+	// B books the car of A for one of its renters
+	renter := entity.NewRenter(carB.TenantID, entity.IndividualRenter, time.Now())
+	require.NoError(t, renterRepo.Create(ctxB, renter))
+	rental, err := entity.NewRental(entity.DefaultTenantSettings(carB.TenantID, time.Now()), carA, renter.ID, entity.RentalBranches{}, startsAt, endsAt)
+	require.NoError(t, err)
+	require.NoError(t, rentalRepo.Create(ctxB, rental))
+
+	// A sees the rental of its car, but not the renter of B
+	rentals, total, err := rentalRepo.ListByTenant(ctxA, carA.TenantID, repository.RentalFilter{}, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, rental.ID, rentals[0].ID)
+	require.Equal(t, carA.TenantID, rentals[0].OwnerTenantID)
+	_, err = renterRepo.GetByID(ctxA, renter.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	// A rents its car out itself afterwards. B does not see the rental, only that the car
+	// is taken.
+	renterA := entity.NewRenter(carA.TenantID, entity.IndividualRenter, time.Now())
+	require.NoError(t, renterRepo.Create(ctxA, renterA))
+	laterStartsAt := endsAt.Add(24 * time.Hour)
+	laterEndsAt := laterStartsAt.Add(48 * time.Hour)
+	rentalA, err := entity.NewRental(entity.DefaultTenantSettings(carA.TenantID, time.Now()), carA, renterA.ID, entity.RentalBranches{}, laterStartsAt, laterEndsAt)
+	require.NoError(t, err)
+	require.NoError(t, rentalRepo.Create(ctxA, rentalA))
+
+	_, err = rentalRepo.GetByID(ctxB, carA.TenantID, rentalA.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+	overlapping, err := rentalRepo.ListOverlapping(ctxB, carA.ID, laterStartsAt, laterEndsAt)
+	require.NoError(t, err)
+	require.Empty(t, overlapping)
+
+	overlap, err := rentalRepo.HasOverlap(ctxB, carA.ID, laterStartsAt, laterEndsAt)
+	require.NoError(t, err)
+	require.True(t, overlap)
+	cars, err = repo.ListAvailable(ctxB, tenantIDs, "", laterStartsAt, laterEndsAt, 10)
+	require.NoError(t, err)
+	require.Len(t, cars, 1)
+	require.Equal(t, carB.ID, cars[0].ID)
+
+	// Once the agreement is terminated, B no longer finds the car of A
+	require.NoError(t, agreement.Terminate(time.Now()))
+	require.NoError(t, sharingRepo.Update(context.Background(), agreement))
+
+	_, err = repo.GetByID(ctxB, carA.TenantID, carA.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
+
+// TestRowLevelSecurity_WebhookEndpoints tests that the webhook endpoints kept in the shared
+// schema, with their signing secrets, are isolated like the tenant-scoped tables
+func TestRowLevelSecurity_WebhookEndpoints(t *testing.T) {
+	_, ctxA, ctxB, carA, _ := rlsSetup(t, "test-tenant-rls-webhook")
+	endpointRepo := rlsrepo.NewWebhookEndpointRepository(testutil.DBClient)
+
+	endpoint, err := entity.NewWebhookEndpoint(carA.TenantID, "https://example.com/hooks", []string{entity.WebhookEventAll}, "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, endpointRepo.Create(ctxA, endpoint))
+
+	// Another tenant can neither read the endpoint nor insert one for its owner
+	_, err = endpointRepo.GetByID(ctxB, carA.TenantID, endpoint.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+	other, err := entity.NewWebhookEndpoint(carA.TenantID, "https://example.com/other", []string{entity.WebhookEventAll}, "", time.Now())
+	require.NoError(t, err)
+	require.Error(t, endpointRepo.Create(ctxB, other))
+
+	// Nothing is visible without a tenant
+	count, err := testutil.DBClient.WebhookEndpoint.Query().Count(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// The owner finds it, also inside a transaction
+	txManager := rlsrepo.NewTransactionManager(testutil.DBRouter, rlsrepo.TxRetryConfig{})
+	err = txManager.RunInTx(ctxA, func(ctx context.Context) error {
+		found, err := endpointRepo.GetByID(ctx, carA.TenantID, endpoint.ID)
+		if err != nil {
+			return err
+		}
+		require.Equal(t, endpoint.Secret, found.Secret)
+		return nil
+	})
+	require.NoError(t, err)
+}
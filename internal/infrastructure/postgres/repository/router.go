@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// DatabaseOpener connects to the database of a tenant isolated in its own database
+type DatabaseOpener func(ctx context.Context, name string) (*entgen.Client, error)
+
+// Router finds the tenant-scoped tables of the tenant carried by a context. Tenants
+// sharing tables use the shared client. Tenants isolated in a schema use the shared client
+// with the schema first on the search path. Tenants isolated in a database use a client
+// connected to that database. The other tables are always in the shared database, except
+// that a tenant isolated in a database keeps its outbox and inbox there.
+type Router struct {
+	client       *entgen.Client
+	openDatabase DatabaseOpener
+
+	mu         sync.Mutex
+	placements map[string]placement
+	databases  map[string]*entgen.Client
+}
+
+// placement is where the tenant-scoped tables of a tenant are
+type placement struct {
+	isolation entity.TenantIsolation
+	// namespace is the schema or database of an isolated tenant
+	namespace string
+}
+
+// searchPath returns the schema to look up tables in first, or "" to keep the default
+func (p placement) searchPath() string {
+	if p.isolation == entity.TenantIsolationSchema {
+		return p.namespace
+	}
+	return ""
+}
+
+// NewRouter creates a router over the shared client. openDatabase is called once per
+// tenant isolated in a database; without it, such tenants cannot be served.
+func NewRouter(client *entgen.Client, openDatabase DatabaseOpener) *Router {
+	return &Router{
+		client:       client,
+		openDatabase: openDatabase,
+		placements:   make(map[string]placement),
+		databases:    make(map[string]*entgen.Client),
+	}
+}
+
+// Close closes the clients of the tenant databases; the shared client belongs to the caller
+func (r *Router) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for name, client := range r.databases {
+		_ = client.Close()
+		delete(r.databases, name)
+	}
+}
+
+// route returns the client holding the tenant-scoped tables of the tenant carried by ctx,
+// and where they are in its database
+func (r *Router) route(ctx context.Context) (*entgen.Client, placement, error) {
+	tenantID, ok := tenantctx.TenantID(ctx)
+	if !ok {
+		return r.client, placement{isolation: entity.TenantIsolationShared}, nil
+	}
+
+	p, err := r.placement(ctx, tenantID)
+	if err != nil {
+		return nil, placement{}, err
+	}
+	if p.isolation != entity.TenantIsolationDatabase {
+		return r.client, p, nil
+	}
+
+	client, err := r.database(ctx, p.namespace)
+	if err != nil {
+		return nil, placement{}, err
+	}
+	return client, p, nil
+}
+
+// placement returns the placement of a tenant. Isolation modes never change, so they are
+// cached for the life of the router.
+func (r *Router) placement(ctx context.Context, tenantID string) (placement, error) {
+	r.mu.Lock()
+	p, ok := r.placements[tenantID]
+	r.mu.Unlock()
+	if ok {
+		return p, nil
+	}
+
+	tenantDB, err := r.client.Tenant.
+		Query().
+		Where(tenant.ID(tenantID)).
+		Select(tenant.FieldCode, tenant.FieldIsolation).
+		Only(ctx)
+	if entgen.IsNotFound(err) {
+		// Unknown tenants own no rows; row-level security hides the shared ones from them
+		return placement{isolation: entity.TenantIsolationShared}, nil
+	}
+	if err != nil {
+		return placement{}, fmt.Errorf("failed to look up tenant isolation: %w", err)
+	}
+
+	p = placement{
+		isolation: entity.NewTenantIsolation(tenantDB.Isolation),
+		namespace: postgres.TenantNamespace(tenantDB.Code),
+	}
+	r.mu.Lock()
+	r.placements[tenantID] = p
+	r.mu.Unlock()
+	return p, nil
+}
+
+// database returns the client of a tenant database, connecting on first use
+func (r *Router) database(ctx context.Context, name string) (*entgen.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if client, ok := r.databases[name]; ok {
+		return client, nil
+	}
+	if r.openDatabase == nil {
+		return nil, fmt.Errorf("tenant database %s cannot be opened: database isolation is not configured", name)
+	}
+
+	client, err := r.openDatabase(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tenant database %s: %w", name, err)
+	}
+	r.databases[name] = client
+	return client, nil
+}
+
+// tenantDatabases returns the clients of the databases of every tenant isolated in its own
+// by database name, connecting on first use. A database that cannot be opened is skipped,
+// so that it does not hold up the others.
+func (r *Router) tenantDatabases(ctx context.Context) (map[string]*entgen.Client, error) {
+	tenantsDB, err := r.client.Tenant.
+		Query().
+		Where(tenant.Isolation(entity.TenantIsolationDatabase.String())).
+		Select(tenant.FieldCode).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenants isolated in a database: %w", err)
+	}
+
+	clients := make(map[string]*entgen.Client, len(tenantsDB))
+	for _, t := range tenantsDB {
+		name := postgres.TenantNamespace(t.Code)
+		client, err := r.database(ctx, name)
+		if err != nil {
+			log.Printf("Skipping tenant database %s: %v", name, err)
+			continue
+		}
+		clients[name] = client
+	}
+	return clients, nil
+}
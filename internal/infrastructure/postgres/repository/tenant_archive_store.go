@@ -0,0 +1,641 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/archive"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/branch"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/carmodel"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/caroption"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/company"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/individual"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/maintenancewindow"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/outbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/plan"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/predicate"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentalhandover"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rentaloption"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenant"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+)
+
+// exportPageSize is the number of rows read per query of an export
+const exportPageSize = 1000
+
+type tenantArchiveStore struct {
+	client *entgen.Client
+	router *Router
+}
+
+// NewTenantArchiveStore creates a new store reading and writing the rows of tenant archives
+func NewTenantArchiveStore(client *entgen.Client, router *Router) archive.Store {
+	return &tenantArchiveStore{
+		client: client,
+		router: router,
+	}
+}
+
+// ExportTenant reads the record of a tenant, with its plan referenced by code
+func (s *tenantArchiveStore) ExportTenant(ctx context.Context, tenantID string) (*archive.Tenant, error) {
+	tenantDB, err := clientFromContext(ctx, s.client).Tenant.
+		Query().
+		Where(tenant.ID(tenantID)).
+		WithPlan().
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	record := &archive.Tenant{
+		ID:          tenantDB.ID,
+		Code:        tenantDB.Code,
+		Status:      tenantDB.Status,
+		SuspendedAt: null.TimeFromPtr(tenantDB.SuspendedAt),
+		PurgeAt:     null.TimeFromPtr(tenantDB.PurgeAt),
+		Isolation:   tenantDB.Isolation,
+		CreatedAt:   tenantDB.CreatedAt,
+		UpdatedAt:   tenantDB.UpdatedAt,
+		DeletedAt:   null.TimeFromPtr(tenantDB.DeletedAt),
+	}
+	if tenantDB.Edges.Plan != nil {
+		record.PlanCode = tenantDB.Edges.Plan.Code
+	}
+	return record, nil
+}
+
+// Export reads the rows of a tenant, soft-deleted ones included, in pages ordered by ID
+func (s *tenantArchiveStore) Export(ctx context.Context, tenantID string, write func(archive.Record) error) error {
+	_, err := withTenant(ctx, s.router, func(client *entgen.Client) (struct{}, error) {
+		return struct{}{}, s.exportTenantScoped(ctx, client, tenantID, write)
+	})
+	if err != nil {
+		return err
+	}
+
+	return exportRows(func(after string) ([]*entgen.Outbox, error) {
+		return clientFromContext(ctx, s.client).Outbox.Query().
+			Where(outbox.TenantID(tenantID), outbox.IDGT(after)).
+			Order(entgen.Asc(outbox.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(m *entgen.Outbox) string { return m.ID }, func(m *entgen.Outbox) archive.Record {
+		return archive.Record{Kind: archive.KindOutboxMessage, Data: &archive.OutboxMessage{
+			ID:            m.ID,
+			TenantID:      m.TenantID,
+			AggregateType: m.AggregateType,
+			AggregateID:   m.AggregateID,
+			EventType:     m.EventType,
+			Payload:       m.Payload,
+			Status:        m.Status,
+			ErrorMessage:  null.StringFromPtr(m.ErrorMessage),
+			Version:       m.Version,
+			CreatedAt:     m.CreatedAt,
+			ProcessedAt:   null.TimeFromPtr(m.ProcessedAt),
+		}}
+	}, write)
+}
+
+// exportTenantScoped reads the rows of the tenant-scoped tables of a tenant
+func (s *tenantArchiveStore) exportTenantScoped(ctx context.Context, client *entgen.Client, tenantID string, write func(archive.Record) error) error {
+	settingsDB, err := client.TenantSetting.Query().Where(tenantsetting.TenantID(tenantID)).Only(ctx)
+	if err != nil && !entgen.IsNotFound(err) {
+		return err
+	}
+	if settingsDB != nil {
+		hours := make([]archive.OpeningHours, len(settingsDB.BusinessHours))
+		for i, h := range settingsDB.BusinessHours {
+			hours[i] = archive.OpeningHours{Weekday: h.Weekday, Opens: h.Opens, Closes: h.Closes}
+		}
+		if err := write(archive.Record{Kind: archive.KindTenantSettings, Data: &archive.TenantSettings{
+			ID:            settingsDB.ID,
+			TenantID:      settingsDB.TenantID,
+			Timezone:      settingsDB.Timezone,
+			Currency:      settingsDB.Currency,
+			Locale:        settingsDB.Locale,
+			BusinessHours: hours,
+			CreatedAt:     settingsDB.CreatedAt,
+			UpdatedAt:     settingsDB.UpdatedAt,
+		}}); err != nil {
+			return err
+		}
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.CarOption, error) {
+		return client.CarOption.Query().
+			Where(caroption.TenantID(tenantID), caroption.IDGT(after)).
+			Order(entgen.Asc(caroption.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(o *entgen.CarOption) string { return o.ID }, func(o *entgen.CarOption) archive.Record {
+		return archive.Record{Kind: archive.KindCarOption, Data: &archive.CarOption{
+			ID:        o.ID,
+			TenantID:  o.TenantID,
+			Name:      o.Name,
+			CreatedAt: o.CreatedAt,
+			UpdatedAt: o.UpdatedAt,
+			DeletedAt: null.TimeFromPtr(o.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.CarModel, error) {
+		return client.CarModel.Query().
+			Where(carmodel.TenantID(tenantID), carmodel.IDGT(after)).
+			Order(entgen.Asc(carmodel.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(m *entgen.CarModel) string { return m.ID }, func(m *entgen.CarModel) archive.Record {
+		return archive.Record{Kind: archive.KindCarModel, Data: &archive.CarModel{
+			ID:           m.ID,
+			TenantID:     m.TenantID,
+			Make:         m.Make,
+			Name:         m.Name,
+			Category:     m.Category,
+			Seats:        m.Seats,
+			Transmission: m.Transmission,
+			FuelType:     m.FuelType,
+			CreatedAt:    m.CreatedAt,
+			UpdatedAt:    m.UpdatedAt,
+			DeletedAt:    null.TimeFromPtr(m.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.Branch, error) {
+		return client.Branch.Query().
+			Where(branch.TenantID(tenantID), branch.IDGT(after)).
+			Order(entgen.Asc(branch.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(b *entgen.Branch) string { return b.ID }, func(b *entgen.Branch) archive.Record {
+		hours := make([]archive.OpeningHours, len(b.OpeningHours))
+		for i, h := range b.OpeningHours {
+			hours[i] = archive.OpeningHours{Weekday: h.Weekday, Opens: h.Opens, Closes: h.Closes}
+		}
+		return archive.Record{Kind: archive.KindBranch, Data: &archive.Branch{
+			ID:           b.ID,
+			TenantID:     b.TenantID,
+			Name:         b.Name,
+			Street:       b.Street,
+			City:         b.City,
+			Region:       b.Region,
+			PostalCode:   b.PostalCode,
+			Country:      b.Country,
+			Latitude:     b.Latitude,
+			Longitude:    b.Longitude,
+			OpeningHours: hours,
+			CreatedAt:    b.CreatedAt,
+			UpdatedAt:    b.UpdatedAt,
+			DeletedAt:    null.TimeFromPtr(b.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.Car, error) {
+		return client.Car.Query().
+			Where(car.TenantID(tenantID), car.IDGT(after)).
+			Order(entgen.Asc(car.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(c *entgen.Car) string { return c.ID }, func(c *entgen.Car) archive.Record {
+		return archive.Record{Kind: archive.KindCar, Data: &archive.Car{
+			ID:                  c.ID,
+			TenantID:            c.TenantID,
+			CarModelID:          c.CarModelID,
+			VIN:                 null.StringFromPtr(c.Vin),
+			LicensePlate:        null.StringFromPtr(c.LicensePlate),
+			LicensePlateCountry: null.StringFromPtr(c.LicensePlateCountry),
+			HomeBranchID:        null.StringFromPtr(c.HomeBranchID),
+			CurrentBranchID:     null.StringFromPtr(c.CurrentBranchID),
+			CreatedAt:           c.CreatedAt,
+			UpdatedAt:           c.UpdatedAt,
+			DeletedAt:           null.TimeFromPtr(c.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.MaintenanceWindow, error) {
+		return client.MaintenanceWindow.Query().
+			Where(maintenancewindow.TenantID(tenantID), maintenancewindow.IDGT(after)).
+			Order(entgen.Asc(maintenancewindow.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(w *entgen.MaintenanceWindow) string { return w.ID }, func(w *entgen.MaintenanceWindow) archive.Record {
+		return archive.Record{Kind: archive.KindMaintenanceWindow, Data: &archive.MaintenanceWindow{
+			ID:          w.ID,
+			TenantID:    w.TenantID,
+			CarID:       w.CarID,
+			Type:        w.Type,
+			Reason:      w.Reason,
+			StartsAt:    w.StartsAt,
+			EndsAt:      w.EndsAt,
+			Status:      w.Status,
+			CompletedAt: null.TimeFromPtr(w.CompletedAt),
+			CanceledAt:  null.TimeFromPtr(w.CanceledAt),
+			CreatedAt:   w.CreatedAt,
+			UpdatedAt:   w.UpdatedAt,
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.Renter, error) {
+		return client.Renter.Query().
+			Where(renter.TenantID(tenantID), renter.IDGT(after)).
+			Order(entgen.Asc(renter.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(r *entgen.Renter) string { return r.ID }, func(r *entgen.Renter) archive.Record {
+		return archive.Record{Kind: archive.KindRenter, Data: &archive.Renter{
+			ID:        r.ID,
+			TenantID:  r.TenantID,
+			Type:      r.Type,
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+			DeletedAt: null.TimeFromPtr(r.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.Company, error) {
+		return client.Company.Query().
+			Where(company.TenantID(tenantID), company.IDGT(after)).
+			Order(entgen.Asc(company.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(c *entgen.Company) string { return c.ID }, func(c *entgen.Company) archive.Record {
+		return archive.Record{Kind: archive.KindCompany, Data: &archive.Company{
+			ID:          c.ID,
+			TenantID:    c.TenantID,
+			RenterID:    c.RenterID,
+			Name:        c.Name,
+			CompanySize: c.CompanySize,
+			CreatedAt:   c.CreatedAt,
+			UpdatedAt:   c.UpdatedAt,
+			DeletedAt:   null.TimeFromPtr(c.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.Individual, error) {
+		return client.Individual.Query().
+			Where(individual.TenantID(tenantID), individual.IDGT(after)).
+			Order(entgen.Asc(individual.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(i *entgen.Individual) string { return i.ID }, func(i *entgen.Individual) archive.Record {
+		return archive.Record{Kind: archive.KindIndividual, Data: &archive.Individual{
+			ID:        i.ID,
+			TenantID:  i.TenantID,
+			RenterID:  i.RenterID,
+			Email:     i.Email,
+			FirstName: i.FirstName,
+			LastName:  i.LastName,
+			CreatedAt: i.CreatedAt,
+			UpdatedAt: i.UpdatedAt,
+			DeletedAt: null.TimeFromPtr(i.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.Rental, error) {
+		return client.Rental.Query().
+			Where(ownRental(tenantID), rental.IDGT(after)).
+			Order(entgen.Asc(rental.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(r *entgen.Rental) string { return r.ID }, func(r *entgen.Rental) archive.Record {
+		return archive.Record{Kind: archive.KindRental, Data: &archive.Rental{
+			ID:             r.ID,
+			TenantID:       r.TenantID,
+			CarID:          r.CarID,
+			RenterID:       r.RenterID,
+			PickupBranchID: null.StringFromPtr(r.PickupBranchID),
+			ReturnBranchID: null.StringFromPtr(r.ReturnBranchID),
+			StartsAt:       r.StartsAt,
+			EndsAt:         r.EndsAt,
+			ReturnedAt:     null.TimeFromPtr(r.ReturnedAt),
+			CreatedAt:      r.CreatedAt,
+			UpdatedAt:      r.UpdatedAt,
+			DeletedAt:      null.TimeFromPtr(r.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	if err := exportRows(func(after string) ([]*entgen.RentalOption, error) {
+		return client.RentalOption.Query().
+			Where(rentaloption.TenantID(tenantID), rentaloption.HasRentalWith(ownRental(tenantID)), rentaloption.IDGT(after)).
+			Order(entgen.Asc(rentaloption.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(o *entgen.RentalOption) string { return o.ID }, func(o *entgen.RentalOption) archive.Record {
+		return archive.Record{Kind: archive.KindRentalOption, Data: &archive.RentalOption{
+			ID:        o.ID,
+			TenantID:  o.TenantID,
+			RentalID:  o.RentalID,
+			OptionID:  o.OptionID,
+			Count:     o.Count,
+			CreatedAt: o.CreatedAt,
+			UpdatedAt: o.UpdatedAt,
+			DeletedAt: null.TimeFromPtr(o.DeletedAt),
+		}}
+	}, write); err != nil {
+		return err
+	}
+
+	return exportRows(func(after string) ([]*entgen.RentalHandover, error) {
+		return client.RentalHandover.Query().
+			Where(rentalhandover.TenantID(tenantID), rentalhandover.HasRentalWith(ownRental(tenantID)), rentalhandover.IDGT(after)).
+			Order(entgen.Asc(rentalhandover.FieldID)).Limit(exportPageSize).All(ctx)
+	}, func(h *entgen.RentalHandover) string { return h.ID }, func(h *entgen.RentalHandover) archive.Record {
+		damages := make([]archive.HandoverDamage, len(h.Damages))
+		for i, d := range h.Damages {
+			damages[i] = archive.HandoverDamage{Area: d.Area, Description: d.Description}
+		}
+		return archive.Record{Kind: archive.KindRentalHandover, Data: &archive.RentalHandover{
+			ID:            h.ID,
+			TenantID:      h.TenantID,
+			RentalID:      h.RentalID,
+			Kind:          h.Kind,
+			OdometerKm:    h.OdometerKm,
+			FuelLevel:     h.FuelLevel,
+			Damages:       damages,
+			DistanceKm:    h.DistanceKm,
+			FuelShortfall: h.FuelShortfall,
+			InspectedAt:   h.InspectedAt,
+			CreatedAt:     h.CreatedAt,
+		}}
+	}, write)
+}
+
+// ownRental selects the rentals a tenant booked of its own cars. Rentals of cars shared
+// under a fleet sharing agreement reference the rows of another tenant, so they are left
+// out of the archive.
+func ownRental(tenantID string) predicate.Rental {
+	return rental.And(
+		rental.TenantID(tenantID),
+		rental.Or(rental.OwnerTenantIDIsNil(), rental.OwnerTenantID(tenantID)),
+	)
+}
+
+// exportRows reads rows in pages of increasing IDs and writes each of them, so that large
+// tenants are never held in memory
+func exportRows[T any](page func(after string) ([]T, error), id func(T) string, toRecord func(T) archive.Record, write func(archive.Record) error) error {
+	after := ""
+	for {
+		rows, err := page(after)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := write(toRecord(row)); err != nil {
+				return err
+			}
+		}
+		if len(rows) < exportPageSize {
+			return nil
+		}
+		after = id(rows[len(rows)-1])
+	}
+}
+
+// Import inserts a record; the tenant's plan is looked up by its code
+func (s *tenantArchiveStore) Import(ctx context.Context, record archive.Record) error {
+	switch data := record.Data.(type) {
+	case *archive.Tenant:
+		return s.importTenant(ctx, data)
+	case *archive.OutboxMessage:
+		_, err := clientFromContext(ctx, s.client).Outbox.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetAggregateType(data.AggregateType).
+			SetAggregateID(data.AggregateID).
+			SetEventType(data.EventType).
+			SetPayload(data.Payload).
+			SetStatus(data.Status).
+			SetNillableErrorMessage(data.ErrorMessage.Ptr()).
+			SetVersion(data.Version).
+			SetCreatedAt(data.CreatedAt).
+			SetNillableProcessedAt(data.ProcessedAt.Ptr()).
+			Save(ctx)
+		return translateError(err)
+	}
+
+	_, err := withTenant(ctx, s.router, func(client *entgen.Client) (struct{}, error) {
+		return struct{}{}, importTenantScoped(ctx, client, record)
+	})
+	return translateError(err)
+}
+
+// importTenant inserts the record of a tenant
+func (s *tenantArchiveStore) importTenant(ctx context.Context, data *archive.Tenant) error {
+	client := clientFromContext(ctx, s.client)
+
+	create := client.Tenant.
+		Create().
+		SetID(data.ID).
+		SetCode(data.Code).
+		SetStatus(data.Status).
+		SetNillableSuspendedAt(data.SuspendedAt.Ptr()).
+		SetNillablePurgeAt(data.PurgeAt.Ptr()).
+		SetIsolation(data.Isolation).
+		SetCreatedAt(data.CreatedAt).
+		SetUpdatedAt(data.UpdatedAt).
+		SetNillableDeletedAt(data.DeletedAt.Ptr())
+	if data.PlanCode != "" {
+		planDB, err := client.Plan.Query().Where(plan.Code(data.PlanCode)).Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to find plan %q: %w", data.PlanCode, translateError(err))
+		}
+		create.SetPlanID(planDB.ID)
+	}
+
+	_, err := create.Save(ctx)
+	if entgen.IsConstraintError(err) {
+		return fmt.Errorf("tenant %s or code %q is taken: %w", data.ID, data.Code, repository.ErrAlreadyExists)
+	}
+	return err
+}
+
+// importTenantScoped inserts a record of a tenant-scoped table
+func importTenantScoped(ctx context.Context, client *entgen.Client, record archive.Record) error {
+	var err error
+	switch data := record.Data.(type) {
+	case *archive.TenantSettings:
+		hours := make([]schema.OpeningHours, len(data.BusinessHours))
+		for i, h := range data.BusinessHours {
+			hours[i] = schema.OpeningHours{Weekday: h.Weekday, Opens: h.Opens, Closes: h.Closes}
+		}
+		_, err = client.TenantSetting.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetTimezone(data.Timezone).
+			SetCurrency(data.Currency).
+			SetLocale(data.Locale).
+			SetBusinessHours(hours).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			Save(ctx)
+	case *archive.CarOption:
+		_, err = client.CarOption.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetName(data.Name).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.CarModel:
+		_, err = client.CarModel.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetMake(data.Make).
+			SetName(data.Name).
+			SetCategory(data.Category).
+			SetSeats(data.Seats).
+			SetTransmission(data.Transmission).
+			SetFuelType(data.FuelType).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.Branch:
+		hours := make([]schema.OpeningHours, len(data.OpeningHours))
+		for i, h := range data.OpeningHours {
+			hours[i] = schema.OpeningHours{Weekday: h.Weekday, Opens: h.Opens, Closes: h.Closes}
+		}
+		_, err = client.Branch.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetName(data.Name).
+			SetStreet(data.Street).
+			SetCity(data.City).
+			SetRegion(data.Region).
+			SetPostalCode(data.PostalCode).
+			SetCountry(data.Country).
+			SetLatitude(data.Latitude).
+			SetLongitude(data.Longitude).
+			SetOpeningHours(hours).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.Car:
+		_, err = client.Car.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetCarModelID(data.CarModelID).
+			SetNillableVin(data.VIN.Ptr()).
+			SetNillableLicensePlate(data.LicensePlate.Ptr()).
+			SetNillableLicensePlateCountry(data.LicensePlateCountry.Ptr()).
+			SetNillableHomeBranchID(data.HomeBranchID.Ptr()).
+			SetNillableCurrentBranchID(data.CurrentBranchID.Ptr()).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.MaintenanceWindow:
+		_, err = client.MaintenanceWindow.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetCarID(data.CarID).
+			SetType(data.Type).
+			SetReason(data.Reason).
+			SetStartsAt(data.StartsAt).
+			SetEndsAt(data.EndsAt).
+			SetStatus(data.Status).
+			SetNillableCompletedAt(data.CompletedAt.Ptr()).
+			SetNillableCanceledAt(data.CanceledAt.Ptr()).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			Save(ctx)
+	case *archive.Renter:
+		_, err = client.Renter.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetType(data.Type).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.Company:
+		_, err = client.Company.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetRenterID(data.RenterID).
+			SetName(data.Name).
+			SetCompanySize(data.CompanySize).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.Individual:
+		_, err = client.Individual.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetRenterID(data.RenterID).
+			SetEmail(data.Email).
+			SetFirstName(data.FirstName).
+			SetLastName(data.LastName).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.Rental:
+		_, err = client.Rental.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetOwnerTenantID(data.TenantID).
+			SetCarID(data.CarID).
+			SetRenterID(data.RenterID).
+			SetNillablePickupBranchID(data.PickupBranchID.Ptr()).
+			SetNillableReturnBranchID(data.ReturnBranchID.Ptr()).
+			SetStartsAt(data.StartsAt).
+			SetEndsAt(data.EndsAt).
+			SetNillableReturnedAt(data.ReturnedAt.Ptr()).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.RentalOption:
+		_, err = client.RentalOption.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetRentalID(data.RentalID).
+			SetOptionID(data.OptionID).
+			SetCount(data.Count).
+			SetCreatedAt(data.CreatedAt).
+			SetUpdatedAt(data.UpdatedAt).
+			SetNillableDeletedAt(data.DeletedAt.Ptr()).
+			Save(ctx)
+	case *archive.RentalHandover:
+		damages := make([]schema.HandoverDamage, len(data.Damages))
+		for i, d := range data.Damages {
+			damages[i] = schema.HandoverDamage{Area: d.Area, Description: d.Description}
+		}
+		_, err = client.RentalHandover.
+			Create().
+			SetID(data.ID).
+			SetTenantID(data.TenantID).
+			SetRentalID(data.RentalID).
+			SetKind(data.Kind).
+			SetOdometerKm(data.OdometerKm).
+			SetFuelLevel(data.FuelLevel).
+			SetDamages(damages).
+			SetDistanceKm(data.DistanceKm).
+			SetFuelShortfall(data.FuelShortfall).
+			SetInspectedAt(data.InspectedAt).
+			SetCreatedAt(data.CreatedAt).
+			Save(ctx)
+	default:
+		return fmt.Errorf("cannot import %s records", record.Kind)
+	}
+	return err
+}
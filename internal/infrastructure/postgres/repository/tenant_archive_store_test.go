@@ -0,0 +1,77 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/archive"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	archiverepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// TestTenantArchiveStore_RoundTrip tests that an exported tenant is imported back as a copy
+// with new IDs and a new code
+func TestTenantArchiveStore_RoundTrip(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	carRepo := archiverepo.NewCarRepository(testutil.DBRouter)
+	renterRepo := archiverepo.NewRenterRepository(testutil.DBRouter)
+	companyRepo := archiverepo.NewCompanyRepository(testutil.DBRouter)
+	txManager := archiverepo.NewTransactionManager(testutil.DBRouter, archiverepo.TxRetryConfig{})
+	store := archiverepo.NewTenantArchiveStore(testutil.DBClient, testutil.DBRouter)
+
+	// Give the tenant a car and a company renter
+	tenant := testutil.CreateRandomTestTenant(t)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+	car := newTestCar(t, tenant.ID)
+	require.NoError(t, carRepo.Create(ctx, car))
+	renter := entity.NewRenter(tenant.ID, entity.CompanyRenter, time.Now())
+	require.NoError(t, renterRepo.Create(ctx, renter))
+	company := entity.NewCompany(renter.ID, tenant.ID, "Acme", entity.CompanySizeSmall, time.Now())
+	require.NoError(t, companyRepo.Create(ctx, company))
+
+	// Export it
+	var buf bytes.Buffer
+	exported, err := archive.NewExporter(txManager, store).Export(context.Background(), tenant.ID, &buf)
+	require.NoError(t, err)
+	require.Equal(t, 1, exported.Counts[archive.KindCar])
+	require.Equal(t, 1, exported.Counts[archive.KindCompany])
+
+	// Importing with the original IDs collides with the exported tenant
+	importer := archive.NewImporter(txManager, store)
+	_, err = importer.Import(context.Background(), bytes.NewReader(buf.Bytes()), archive.ImportOptions{})
+	require.ErrorIs(t, err, repository.ErrAlreadyExists)
+
+	// Import a copy
+	code := tenant.Code + "-copy"
+	imported, err := importer.Import(context.Background(), bytes.NewReader(buf.Bytes()), archive.ImportOptions{
+		RemapIDs: true,
+		Code:     code,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, tenant.ID, imported.TenantID)
+	require.Equal(t, exported.Counts, imported.Counts)
+
+	copyCtx := tenantctx.WithTenantID(context.Background(), imported.TenantID)
+	cars, _, total, err := carRepo.ListByTenant(copyCtx, imported.TenantID, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), total)
+	require.NotEqual(t, car.ID, cars[0].ID)
+	require.NotEqual(t, car.ModelID, cars[0].ModelID)
+	require.NotNil(t, cars[0].Model())
+	require.Equal(t, imported.TenantID, cars[0].Model().TenantID)
+
+	// The copy is left out of the original tenant
+	_, _, total, err = carRepo.ListByTenant(ctx, tenant.ID, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), total)
+}
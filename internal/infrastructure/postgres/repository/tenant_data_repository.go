@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+)
+
+// tenantTable is a table holding rows of tenants
+type tenantTable struct {
+	name string
+	// scoped tables are tenant-scoped and routed per tenant; the others are shared
+	scoped bool
+	// policy marks shared tables that are protected by the tenant isolation policy
+	policy bool
+	// condition selects the rows of the tenant, with the tenant as $1, if they are not the
+	// rows of its tenant_id
+	condition string
+	// filter narrows the rows of a tenant down to the ones to purge
+	filter string
+}
+
+// tenantTables lists the tables holding rows of tenants, each before the tables it
+// references. Pending outbox messages are left to the relay, which never needs the tenant.
+// outboxes is routed like the tenant-scoped tables, since tenants isolated in a database
+// keep their own. The rentals other tenants booked of the tenant's cars go with the cars.
+// usage_records, usage_rollups and archive_jobs have a tenant_id too, but are platform
+// records kept on purpose: usage is billed after the purge, and archive jobs point to
+// archives that outlive the tenant. They are neither purged nor counted, so a purge report
+// can show a tenant as empty while they remain.
+var tenantTables = []tenantTable{
+	{name: "rental_options", scoped: true, condition: "tenant_id = $1 OR rental_id IN (SELECT id FROM rentals WHERE owner_tenant_id = $1)"},
+	{name: "rental_handovers", scoped: true, condition: "tenant_id = $1 OR rental_id IN (SELECT id FROM rentals WHERE owner_tenant_id = $1)"},
+	{name: "rentals", scoped: true, condition: "tenant_id = $1 OR owner_tenant_id = $1"},
+	{name: "companies", scoped: true},
+	{name: "individuals", scoped: true},
+	{name: "renters", scoped: true},
+	{name: "maintenance_windows", scoped: true},
+	{name: "cars", scoped: true},
+	{name: "branches", scoped: true},
+	{name: "car_models", scoped: true},
+	{name: "car_options", scoped: true},
+	{name: "tenant_settings", scoped: true},
+	{name: "fleet_sharing_agreements", condition: "lender_tenant_id = $1 OR borrower_tenant_id = $1"},
+	{name: "webhook_deliveries"},
+	{name: "webhook_endpoints", policy: true},
+	{name: "api_keys"},
+	{name: "outboxes", scoped: true, filter: "status <> 'pending'"},
+}
+
+type tenantDataRepository struct {
+	client *entgen.Client
+	router *Router
+}
+
+// NewTenantDataRepository creates a new tenant data repository
+func NewTenantDataRepository(client *entgen.Client, router *Router) repository.TenantDataRepository {
+	return &tenantDataRepository{
+		client: client,
+		router: router,
+	}
+}
+
+// Count returns how many rows of the tenant each table holds
+func (r *tenantDataRepository) Count(ctx context.Context, tenantID string) (entity.TenantRows, error) {
+	rows := make(entity.TenantRows, len(tenantTables))
+	for i, table := range tenantTables {
+		n, err := r.run(ctx, table, func(client *entgen.Client) (int, error) {
+			return countTenantRows(ctx, client, table, tenantID)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to count rows of %s: %w", table.name, err)
+		}
+		rows[i] = entity.TableRows{Table: table.name, Rows: n}
+	}
+	return rows, nil
+}
+
+// DeleteBatch deletes up to limit rows of the tenant from a table
+func (r *tenantDataRepository) DeleteBatch(ctx context.Context, tenantID, name string, limit int) (int, error) {
+	for _, table := range tenantTables {
+		if table.name != name {
+			continue
+		}
+
+		n, err := r.run(ctx, table, func(client *entgen.Client) (int, error) {
+			return deleteTenantRows(ctx, client, table, tenantID, limit)
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete rows of %s: %w", table.name, err)
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("table %q holds no rows of tenants", name)
+}
+
+// run runs fn with a client that sees the rows of the tenant carried by ctx in table
+func (r *tenantDataRepository) run(ctx context.Context, table tenantTable, fn func(client *entgen.Client) (int, error)) (int, error) {
+	if table.scoped {
+		return withTenant(ctx, r.router, fn)
+	}
+	if table.policy {
+		return withSharedTenant(ctx, r.client, fn)
+	}
+	return fn(clientFromContext(ctx, r.client))
+}
+
+// tenantRowsCondition returns the WHERE condition selecting the rows of a tenant to purge,
+// with the tenant as $1
+func tenantRowsCondition(table tenantTable) string {
+	condition := "tenant_id = $1"
+	if table.condition != "" {
+		condition = "(" + table.condition + ")"
+	}
+	if table.filter != "" {
+		condition += " AND " + table.filter
+	}
+	return condition
+}
+
+// countTenantRows counts the rows of a tenant in a table
+func countTenantRows(ctx context.Context, client *entgen.Client, table tenantTable, tenantID string) (int, error) {
+	query := fmt.Sprintf("SELECT count(*) FROM %s WHERE %s",
+		pgx.Identifier{table.name}.Sanitize(), tenantRowsCondition(table))
+	rows, err := client.QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var n int
+	if rows.Next() {
+		if err := rows.Scan(&n); err != nil {
+			return 0, err
+		}
+	}
+	return n, rows.Err()
+}
+
+// deleteTenantRows deletes up to limit rows of a tenant from a table
+func deleteTenantRows(ctx context.Context, client *entgen.Client, table tenantTable, tenantID string, limit int) (int, error) {
+	name := pgx.Identifier{table.name}.Sanitize()
+	query := fmt.Sprintf("DELETE FROM %[1]s WHERE id IN (SELECT id FROM %[1]s WHERE %[2]s LIMIT $2)",
+		name, tenantRowsCondition(table))
+	result, err := client.ExecContext(ctx, query, tenantID, limit)
+	if err != nil {
+		return 0, err
+	}
+	n, err := result.RowsAffected()
+	return int(n), err
+}
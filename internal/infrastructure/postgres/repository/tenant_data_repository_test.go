@@ -0,0 +1,109 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/offboarding"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	datarepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// rowsOf returns the rows counted for a table
+func rowsOf(rows entity.TenantRows, table string) int {
+	for _, r := range rows {
+		if r.Table == table {
+			return r.Rows
+		}
+	}
+	return -1
+}
+
+// TestTenantDataRepository_CountDeleteBatch tests that rows are counted and deleted per tenant
+func TestTenantDataRepository_CountDeleteBatch(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	carRepo := datarepo.NewCarRepository(testutil.DBRouter)
+	dataRepo := datarepo.NewTenantDataRepository(testutil.DBClient, testutil.DBRouter)
+
+	// Give the tenant three cars and another tenant one
+	tenant := testutil.CreateRandomTestTenant(t)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+	for range 3 {
+		require.NoError(t, carRepo.Create(ctx, newTestCar(t, tenant.ID)))
+	}
+	other := testutil.CreateRandomTestTenant(t)
+	otherCtx := tenantctx.WithTenantID(context.Background(), other.ID)
+	require.NoError(t, carRepo.Create(otherCtx, newTestCar(t, other.ID)))
+
+	// Count
+	rows, err := dataRepo.Count(ctx, tenant.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, rowsOf(rows, "cars"))
+	assert.Equal(t, 0, rowsOf(rows, "rentals"))
+
+	// Delete in batches of two
+	n, err := dataRepo.DeleteBatch(ctx, tenant.ID, "cars", 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	n, err = dataRepo.DeleteBatch(ctx, tenant.ID, "cars", 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	_, err = dataRepo.DeleteBatch(ctx, tenant.ID, "tenants", 2)
+	assert.Error(t, err)
+
+	// The other tenant keeps its car
+	rows, err = dataRepo.Count(otherCtx, other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rowsOf(rows, "cars"))
+}
+
+// TestPurger_Purge_Integration tests that a tenant whose grace period is over is purged
+// with its data
+func TestPurger_Purge_Integration(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	carRepo := datarepo.NewCarRepository(testutil.DBRouter)
+	tenantRepo := datarepo.NewTenantRepository(testutil.DBClient)
+	dataRepo := datarepo.NewTenantDataRepository(testutil.DBClient, testutil.DBRouter)
+	txManager := datarepo.NewTransactionManager(testutil.DBRouter, datarepo.TxRetryConfig{})
+	uowFactory := datarepo.NewUnitOfWorkFactory(txManager, carRepo, tenantRepo, nil, nil, nil, datarepo.NewOutboxRepository(testutil.DBClient))
+	purger := offboarding.NewPurger(tenantRepo, dataRepo, txManager, uowFactory, offboarding.PurgerConfig{BatchSize: 2})
+
+	// Give the tenant three cars and schedule its deletion in the past
+	created := testutil.CreateRandomTestTenant(t)
+	ctx := tenantctx.WithTenantID(context.Background(), created.ID)
+	for range 3 {
+		require.NoError(t, carRepo.Create(ctx, newTestCar(t, created.ID)))
+	}
+	tenant, err := tenantRepo.GetByID(context.Background(), created.ID)
+	require.NoError(t, err)
+	require.NoError(t, tenant.ScheduleDeletion(time.Hour, time.Now().Add(-2*time.Hour)))
+	require.NoError(t, tenantRepo.Update(context.Background(), tenant))
+
+	due, err := tenantRepo.ListDueForPurge(context.Background(), time.Now(), 100)
+	require.NoError(t, err)
+	dueIDs := make([]string, len(due))
+	for i, d := range due {
+		dueIDs[i] = d.ID
+	}
+	assert.Contains(t, dueIDs, tenant.ID)
+
+	// Purge
+	rows, err := purger.Purge(context.Background(), tenant.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, rowsOf(rows, "cars"))
+
+	_, err = tenantRepo.GetByID(context.Background(), tenant.ID)
+	assert.ErrorIs(t, err, repository.ErrNotFound)
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/outbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
+)
+
+type tenantOutboxFinder struct {
+	router        *Router
+	databaseURLOf func(name string) string
+}
+
+// NewTenantOutboxFinder creates a finder of the outboxes in the databases of tenants
+// isolated in their own. databaseURLOf returns the connection string of a tenant database
+// to LISTEN on.
+func NewTenantOutboxFinder(router *Router, databaseURLOf func(name string) string) outbox.SourceFinder {
+	return &tenantOutboxFinder{
+		router:        router,
+		databaseURLOf: databaseURLOf,
+	}
+}
+
+// Sources returns the outbox of every tenant database, connecting to it through the router.
+// A database that cannot be opened is skipped until the next call, so that it does not
+// hold up the others.
+func (f *tenantOutboxFinder) Sources(ctx context.Context) ([]outbox.Source, error) {
+	clients, err := f.router.tenantDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]outbox.Source, 0, len(clients))
+	for name, client := range clients {
+		sources = append(sources, outbox.Source{
+			Name:     name,
+			Repo:     NewOutboxRepository(client),
+			Listener: postgres.NewListener(f.databaseURLOf(name), postgres.OutboxChannel),
+		})
+	}
+	return sources, nil
+}
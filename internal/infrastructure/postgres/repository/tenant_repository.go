@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"time"
+
+	"github.com/aarondl/null/v9"
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
@@ -20,82 +23,81 @@ func NewTenantRepository(client *entgen.Client) repository.TenantRepository {
 
 // Create inserts a new tenant into the database
 func (r *tenantRepository) Create(ctx context.Context, tenant *entity.Tenant) error {
-	_, err := r.client.Tenant.
+	_, err := clientFromContext(ctx, r.client).Tenant.
 		Create().
 		SetID(tenant.ID).
 		SetCode(tenant.Code).
+		SetStatus(tenant.Status.String()).
+		SetNillableSuspendedAt(tenant.SuspendedAt.Ptr()).
+		SetNillablePlanID(tenant.PlanID.Ptr()).
+		SetIsolation(tenant.Isolation.String()).
+		SetNillableParentID(tenant.ParentID.Ptr()).
+		SetNillablePurgeAt(tenant.PurgeAt.Ptr()).
+		SetCreatedAt(tenant.CreatedAt).
+		SetUpdatedAt(tenant.UpdatedAt).
 		Save(ctx)
-	return err
+	return translateError(err)
 }
 
 // GetByID retrieves a tenant by its ID
 func (r *tenantRepository) GetByID(ctx context.Context, id string) (*entity.Tenant, error) {
-	tenantDB, err := r.client.Tenant.
+	tenantDB, err := clientFromContext(ctx, r.client).Tenant.
 		Query().
 		Where(tenant.ID(id)).
 		Only(ctx)
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
-	// Direct conversion from Ent model to domain entity
-	return &entity.Tenant{
-		ID:        tenantDB.ID,
-		Code:      tenantDB.Code,
-		CreatedAt: tenantDB.CreatedAt,
-		UpdatedAt: tenantDB.UpdatedAt,
-	}, nil
+	return toTenantEntity(tenantDB), nil
+}
+
+// GetByIDForUpdate retrieves a tenant with SELECT ... FOR UPDATE, locking it until the end
+// of the transaction of ctx
+func (r *tenantRepository) GetByIDForUpdate(ctx context.Context, id string) (*entity.Tenant, error) {
+	tenantDB, err := clientFromContext(ctx, r.client).Tenant.
+		Query().
+		Where(tenant.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return toTenantEntity(tenantDB), nil
 }
 
 // GetByCode retrieves a tenant by its code
 func (r *tenantRepository) GetByCode(ctx context.Context, code string) (*entity.Tenant, error) {
-	tenantDB, err := r.client.Tenant.
+	tenantDB, err := clientFromContext(ctx, r.client).Tenant.
 		Query().
 		Where(tenant.Code(code)).
 		Only(ctx)
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
-	// Direct conversion from Ent model to domain entity
-	return &entity.Tenant{
-		ID:        tenantDB.ID,
-		Code:      tenantDB.Code,
-		CreatedAt: tenantDB.CreatedAt,
-		UpdatedAt: tenantDB.UpdatedAt,
-	}, nil
+	return toTenantEntity(tenantDB), nil
 }
 
 // GetByIDWithCars retrieves a tenant by its ID along with its associated cars
 func (r *tenantRepository) GetByIDWithCars(ctx context.Context, id string) (*entity.Tenant, error) {
-	tenantDB, err := r.client.Tenant.
+	tenantDB, err := clientFromContext(ctx, r.client).Tenant.
 		Query().
 		Where(tenant.ID(id)).
 		WithCars().
 		Only(ctx)
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
-	// Direct conversion from Ent model to domain entity
-	domainTenant := &entity.Tenant{
-		ID:        tenantDB.ID,
-		Code:      tenantDB.Code,
-		CreatedAt: tenantDB.CreatedAt,
-		UpdatedAt: tenantDB.UpdatedAt,
-	}
+	domainTenant := toTenantEntity(tenantDB)
 
 	// Load the cars information if available
 	if tenantDB.Edges.Cars != nil {
 		cars := make(entity.Cars, len(tenantDB.Edges.Cars))
 		for i, car := range tenantDB.Edges.Cars {
-			cars[i] = &entity.Car{
-				ID:        car.ID,
-				TenantID:  car.TenantID,
-				Model:     car.Model,
-				CreatedAt: car.CreatedAt,
-				UpdatedAt: car.UpdatedAt,
-			}
+			cars[i] = toCarEntity(car)
 		}
 		domainTenant.Refs = &entity.TenantRefs{
 			Cars: cars,
@@ -106,18 +108,98 @@ func (r *tenantRepository) GetByIDWithCars(ctx context.Context, id string) (*ent
 	return domainTenant, nil
 }
 
+// List retrieves a page of tenants ordered by ID
+func (r *tenantRepository) List(ctx context.Context, afterID string, limit int) (entity.Tenants, error) {
+	query := clientFromContext(ctx, r.client).Tenant.Query()
+	if afterID != "" {
+		query = query.Where(tenant.IDGT(afterID))
+	}
+	tenantsDB, err := query.
+		Order(tenant.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	tenants := make(entity.Tenants, len(tenantsDB))
+	for i, tenantDB := range tenantsDB {
+		tenants[i] = toTenantEntity(tenantDB)
+	}
+	return tenants, nil
+}
+
+// ListDueForPurge retrieves tenants pending deletion whose grace period is over
+func (r *tenantRepository) ListDueForPurge(ctx context.Context, now time.Time, limit int) (entity.Tenants, error) {
+	tenantsDB, err := clientFromContext(ctx, r.client).Tenant.
+		Query().
+		Where(
+			tenant.Status(entity.TenantStatusPendingDeletion.String()),
+			tenant.PurgeAtLTE(now),
+		).
+		Order(tenant.ByPurgeAt()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	tenants := make(entity.Tenants, len(tenantsDB))
+	for i, tenantDB := range tenantsDB {
+		tenants[i] = toTenantEntity(tenantDB)
+	}
+	return tenants, nil
+}
+
 // Update updates an existing tenant
 func (r *tenantRepository) Update(ctx context.Context, tenant *entity.Tenant) error {
-	_, err := r.client.Tenant.
+	update := clientFromContext(ctx, r.client).Tenant.
 		UpdateOneID(tenant.ID).
 		SetCode(tenant.Code).
-		Save(ctx)
-	return err
+		SetStatus(tenant.Status.String()).
+		SetUpdatedAt(tenant.UpdatedAt)
+
+	if tenant.SuspendedAt.Valid {
+		update.SetSuspendedAt(tenant.SuspendedAt.Time)
+	} else {
+		update.ClearSuspendedAt()
+	}
+	if tenant.PlanID.Valid {
+		update.SetPlanID(tenant.PlanID.String)
+	} else {
+		update.ClearPlanID()
+	}
+	if tenant.PurgeAt.Valid {
+		update.SetPurgeAt(tenant.PurgeAt.Time)
+	} else {
+		update.ClearPurgeAt()
+	}
+
+	_, err := update.Save(ctx)
+	return translateError(err)
 }
 
-// Delete removes a tenant by its ID
+// Delete removes a tenant by its ID. Its data must have been purged first, or foreign
+// keys reject the delete.
 func (r *tenantRepository) Delete(ctx context.Context, id string) error {
-	return r.client.Tenant.
+	err := clientFromContext(ctx, r.client).Tenant.
 		DeleteOneID(id).
 		Exec(ctx)
+	return translateError(err)
+}
+
+// toTenantEntity converts an Ent tenant into a domain entity
+func toTenantEntity(tenantDB *entgen.Tenant) *entity.Tenant {
+	return &entity.Tenant{
+		ID:          tenantDB.ID,
+		Code:        tenantDB.Code,
+		Status:      entity.NewTenantStatus(tenantDB.Status),
+		SuspendedAt: null.TimeFromPtr(tenantDB.SuspendedAt),
+		PlanID:      null.StringFromPtr(tenantDB.PlanID),
+		Isolation:   entity.NewTenantIsolation(tenantDB.Isolation),
+		ParentID:    null.StringFromPtr(tenantDB.ParentID),
+		PurgeAt:     null.TimeFromPtr(tenantDB.PurgeAt),
+		CreatedAt:   tenantDB.CreatedAt,
+		UpdatedAt:   tenantDB.UpdatedAt,
+	}
 }
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// setTenantQuery is SET LOCAL app.tenant_id with a bind parameter: the setting lasts
+// until the end of the current transaction
+const setTenantQuery = "SELECT set_config($1, $2, true)"
+
+// setTenant scopes the row-level security policies of tx to the tenant carried by ctx.
+// Without a tenant, policies hide every row of the tenant-scoped tables. A non-empty
+// searchPath is the schema of a tenant isolated in its own: its tables are looked up
+// there first, and the shared tables behind it in public.
+func setTenant(ctx context.Context, tx *entgen.Tx, searchPath string) error {
+	tenantID, ok := tenantctx.TenantID(ctx)
+	if !ok {
+		return nil
+	}
+	if _, err := tx.Client().ExecContext(ctx, setTenantQuery, postgres.TenantSetting, tenantID); err != nil {
+		return fmt.Errorf("failed to set tenant: %w", err)
+	}
+	if searchPath != "" {
+		path := pgx.Identifier{searchPath}.Sanitize() + ", public"
+		if _, err := tx.Client().ExecContext(ctx, setTenantQuery, "search_path", path); err != nil {
+			return fmt.Errorf("failed to set search path: %w", err)
+		}
+	}
+	return nil
+}
+
+// tenantTxKey is the context key of the transaction RunInTx starts in the database of a
+// tenant isolated in its own
+type tenantTxKey struct{}
+
+// withTenantTx returns a copy of ctx carrying the transaction of a tenant database
+func withTenantTx(ctx context.Context, tx *entgen.Tx) context.Context {
+	return context.WithValue(ctx, tenantTxKey{}, tx)
+}
+
+// tenantTxFromContext returns the transaction of a tenant database carried by ctx, if any
+func tenantTxFromContext(ctx context.Context) *entgen.Tx {
+	tx, _ := ctx.Value(tenantTxKey{}).(*entgen.Tx)
+	return tx
+}
+
+// withTenant runs fn with a client that sees the tenant-scoped tables through the tenant
+// carried by ctx, wherever its isolation mode keeps them. Inside RunInTx it uses the
+// transaction, whose tenant is already set; otherwise it wraps fn in a short transaction,
+// because the setting only lives as long as one.
+func withTenant[T any](ctx context.Context, router *Router, fn func(client *entgen.Client) (T, error)) (T, error) {
+	var zero T
+
+	if tx := tenantTxFromContext(ctx); tx != nil {
+		return fn(tx.Client())
+	}
+	if tx := entgen.TxFromContext(ctx); tx != nil {
+		return fn(tx.Client())
+	}
+	if _, ok := tenantctx.TenantID(ctx); !ok {
+		return fn(router.client)
+	}
+
+	client, p, err := router.route(ctx)
+	if err != nil {
+		return zero, err
+	}
+	return inTenantTx(ctx, client, p.searchPath(), fn)
+}
+
+// withSharedTenant runs fn with a client that sees the tenant-scoped tables kept in the
+// shared schema for every tenant, such as webhook_endpoints, through the tenant carried
+// by ctx. Inside RunInTx it uses the shared transaction, whose tenant is already set.
+func withSharedTenant[T any](ctx context.Context, client *entgen.Client, fn func(client *entgen.Client) (T, error)) (T, error) {
+	if tx := entgen.TxFromContext(ctx); tx != nil {
+		return fn(tx.Client())
+	}
+	if _, ok := tenantctx.TenantID(ctx); !ok {
+		return fn(client)
+	}
+	return inTenantTx(ctx, client, "", fn)
+}
+
+// tenantDatabaseClient returns the client of the shared tables a tenant isolated in a
+// database keeps a copy of, such as inboxes, for the tenant carried by ctx. Inside RunInTx
+// it is the transaction the tenant's changes are written in; otherwise the tenant's
+// database, or the shared one for the other tenants.
+func tenantDatabaseClient(ctx context.Context, router *Router) (*entgen.Client, error) {
+	if tx := tenantTxFromContext(ctx); tx != nil {
+		return tx.Client(), nil
+	}
+	if tx := entgen.TxFromContext(ctx); tx != nil {
+		return tx.Client(), nil
+	}
+	client, _, err := router.route(ctx)
+	return client, err
+}
+
+// inTenantTx runs fn in a short transaction on client scoped to the tenant carried by ctx
+func inTenantTx[T any](ctx context.Context, client *entgen.Client, searchPath string, fn func(client *entgen.Client) (T, error)) (T, error) {
+	var zero T
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return zero, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err := setTenant(ctx, tx, searchPath); err != nil {
+		_ = tx.Rollback()
+		return zero, err
+	}
+
+	result, err := fn(tx.Client())
+	if err != nil {
+		_ = tx.Rollback()
+		return zero, err
+	}
+	if err := tx.Commit(); err != nil {
+		return zero, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, nil
+}
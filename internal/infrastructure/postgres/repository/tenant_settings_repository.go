@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/ent/schema"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/tenantsetting"
+)
+
+type tenantSettingsRepository struct {
+	router *Router
+}
+
+// NewTenantSettingsRepository creates a new tenant settings repository
+func NewTenantSettingsRepository(router *Router) repository.TenantSettingsRepository {
+	return &tenantSettingsRepository{
+		router: router,
+	}
+}
+
+// Create inserts the settings of a tenant into the database
+func (r *tenantSettingsRepository) Create(ctx context.Context, settings *entity.TenantSettings) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.TenantSetting, error) {
+		return client.TenantSetting.
+			Create().
+			SetID(settings.TenantID).
+			SetTenantID(settings.TenantID).
+			SetTimezone(settings.Timezone).
+			SetCurrency(settings.Currency).
+			SetLocale(settings.Locale).
+			SetBusinessHours(toOpeningHoursSchema(settings.BusinessHours)).
+			SetCreatedAt(settings.CreatedAt).
+			SetUpdatedAt(settings.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// Get retrieves the settings of a tenant
+func (r *tenantSettingsRepository) Get(ctx context.Context, tenantID string) (*entity.TenantSettings, error) {
+	settingsDB, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.TenantSetting, error) {
+		return client.TenantSetting.
+			Query().
+			Where(tenantsetting.TenantID(tenantID)).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &entity.TenantSettings{
+		TenantID:      settingsDB.TenantID,
+		Timezone:      settingsDB.Timezone,
+		Currency:      settingsDB.Currency,
+		Locale:        settingsDB.Locale,
+		BusinessHours: toBusinessHours(settingsDB.BusinessHours),
+		CreatedAt:     settingsDB.CreatedAt,
+		UpdatedAt:     settingsDB.UpdatedAt,
+	}, nil
+}
+
+// Update updates the settings of a tenant
+func (r *tenantSettingsRepository) Update(ctx context.Context, settings *entity.TenantSettings) error {
+	_, err := withTenant(ctx, r.router, func(client *entgen.Client) (*entgen.TenantSetting, error) {
+		return client.TenantSetting.
+			UpdateOneID(settings.TenantID).
+			Where(tenantsetting.TenantID(settings.TenantID)).
+			SetTimezone(settings.Timezone).
+			SetCurrency(settings.Currency).
+			SetLocale(settings.Locale).
+			SetBusinessHours(toOpeningHoursSchema(settings.BusinessHours)).
+			SetUpdatedAt(settings.UpdatedAt).
+			Save(ctx)
+	})
+	return translateError(err)
+}
+
+// toOpeningHoursSchema converts business hours to their stored JSON form
+func toOpeningHoursSchema(hours entity.BusinessHours) []schema.OpeningHours {
+	stored := make([]schema.OpeningHours, len(hours))
+	for i, period := range hours {
+		stored[i] = schema.OpeningHours{
+			Weekday: int(period.Weekday),
+			Opens:   period.Opens.String(),
+			Closes:  period.Closes.String(),
+		}
+	}
+	return stored
+}
+
+// toBusinessHours converts stored business hours back to the domain. They were validated
+// before they were stored, so unparsable times cannot occur.
+func toBusinessHours(stored []schema.OpeningHours) entity.BusinessHours {
+	hours := make(entity.BusinessHours, len(stored))
+	for i, period := range stored {
+		opens, _ := entity.ParseTimeOfDay(period.Opens)
+		closes, _ := entity.ParseTimeOfDay(period.Closes)
+		hours[i] = entity.OpeningHours{
+			Weekday: time.Weekday(period.Weekday),
+			Opens:   opens,
+			Closes:  closes,
+		}
+	}
+	return hours
+}
@@ -0,0 +1,48 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	settingsrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// TestTenantSettingsRepository tests that settings and their business hours round-trip
+func TestTenantSettingsRepository(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := settingsrepo.NewTenantSettingsRepository(testutil.DBRouter)
+	tenant := testutil.CreateTestTenant(t, "test-tenant-settings")
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+
+	_, err := repo.Get(ctx, tenant.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+
+	settings := entity.DefaultTenantSettings(tenant.ID, time.Now())
+	require.NoError(t, repo.Create(ctx, settings))
+
+	hours := entity.BusinessHours{{Weekday: time.Monday, Opens: 9 * 60, Closes: 18 * 60}}
+	require.NoError(t, settings.Update("Asia/Tokyo", "JPY", "ja-JP", hours, time.Now()))
+	require.NoError(t, repo.Update(ctx, settings))
+
+	found, err := repo.Get(ctx, tenant.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Asia/Tokyo", found.Timezone)
+	require.Equal(t, "JPY", found.Currency)
+	require.Equal(t, "ja-JP", found.Locale)
+	require.Equal(t, hours, found.BusinessHours)
+
+	// Another tenant cannot see them
+	otherCtx := tenantctx.WithTenantID(context.Background(), "another-tenant")
+	_, err = repo.Get(otherCtx, tenant.ID)
+	require.ErrorIs(t, err, repository.ErrNotFound)
+}
@@ -4,10 +4,14 @@ package testutil
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity/factory"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
 	"github.com/stretchr/testify/require"
 )
 
@@ -54,3 +58,66 @@ func CreateRandomTestTenant(t *testing.T) *entity.Tenant {
 		Code: tenantDB.Code,
 	}
 }
+
+// CreateIsolatedTestTenant creates a tenant with a random code in the given isolation mode,
+// saves it to the database and provisions its schema or database.
+func CreateIsolatedTestTenant(t *testing.T, isolation entity.TenantIsolation) *entity.Tenant {
+	t.Helper()
+
+	ctx := context.Background()
+	// Tenant codes are lowercase, as the names of their schemas and databases must be
+	tenant := factory.NewTenantWithCode(strings.ToLower(factory.NewTenant().Code))
+	tenant.Isolation = isolation
+
+	_, err := OwnerClient.Tenant.
+		Create().
+		SetID(tenant.ID).
+		SetCode(tenant.Code).
+		SetIsolation(tenant.Isolation.String()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	namespace := postgres.TenantNamespace(tenant.Code)
+	switch isolation {
+	case entity.TenantIsolationSchema:
+		require.NoError(t, postgres.MigrateTenantSchema(ctx, OwnerClient, namespace, appUser))
+	case entity.TenantIsolationDatabase:
+		require.NoError(t, postgres.CreateTenantDatabase(ctx, OwnerClient, namespace))
+		client, err := postgres.OpenClient(ctx, OwnerDatabaseURL(namespace))
+		require.NoError(t, err)
+		defer client.Close()
+		require.NoError(t, postgres.MigrateTenantDatabase(ctx, client, appUser))
+	}
+
+	return &entity.Tenant{
+		ID:        tenant.ID,
+		Code:      tenant.Code,
+		Isolation: tenant.Isolation,
+	}
+}
+
+// CreateTestCarModel creates a model in the catalog of a tenant for testing and saves it to
+// the tenant's schema or database.
+func CreateTestCarModel(t *testing.T, tenantID string) *entity.CarModel {
+	t.Helper()
+
+	model, err := factory.NewCarModel(tenantID)
+	require.NoError(t, err)
+	ctx := tenantctx.WithTenantID(context.Background(), tenantID)
+	require.NoError(t, repository.NewCarModelRepository(DBRouter).Create(ctx, model))
+
+	return model
+}
+
+// CreateTestBranch creates a branch of a tenant at a position for testing and saves it to the
+// tenant's schema or database.
+func CreateTestBranch(t *testing.T, tenantID string, latitude, longitude float64) *entity.Branch {
+	t.Helper()
+
+	branch, err := factory.NewBranch(tenantID, latitude, longitude)
+	require.NoError(t, err)
+	ctx := tenantctx.WithTenantID(context.Background(), tenantID)
+	require.NoError(t, repository.NewBranchRepository(DBRouter).Create(ctx, branch))
+
+	return branch
+}
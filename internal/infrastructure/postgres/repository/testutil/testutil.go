@@ -12,14 +12,23 @@ import (
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
 	"github.com/ory/dockertest/v3"
 	"github.com/ory/dockertest/v3/docker"
 )
 
 var (
-	DBClient *entgen.Client       // shared database client for all repository tests
-	Pool     *dockertest.Pool     // shared Docker test pool
-	Resource *dockertest.Resource // shared Docker resource
+	DBClient    *entgen.Client       // shared database client for all repository tests, subject to row-level security
+	DBRouter    *repository.Router   // router over DBClient, connecting to tenant databases as the application role
+	OwnerClient *entgen.Client       // shared database client of the table owner, which bypasses row-level security
+	Pool        *dockertest.Pool     // shared Docker test pool
+	Resource    *dockertest.Resource // shared Docker resource
+)
+
+// Credentials of the non-owner role the repository tests connect as
+const (
+	appUser     = "app"
+	appPassword = "app_secret"
 )
 
 // SetupTestEnvironment initializes the shared test environment
@@ -85,14 +94,14 @@ func SetupTestEnvironment() error {
 			"dbname",
 			"disable")
 
-		DBClient = postgres.NewClient(databaseUrl)
+		OwnerClient = postgres.NewClient(databaseUrl)
 
 		// Run database migrations using Ent
 		log.Printf("Running database migrations...")
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		if err := DBClient.Schema.Create(ctx); err != nil {
+		if err := OwnerClient.Schema.Create(ctx); err != nil {
 			log.Printf("Failed to run database migrations: %v", err)
 			return err
 		}
@@ -106,18 +115,46 @@ func SetupTestEnvironment() error {
 
 	// Run database migrations using Ent
 	log.Printf("Running database migrations...")
-	if err := DBClient.Schema.Create(context.Background()); err != nil {
+	if err := OwnerClient.Schema.Create(context.Background()); err != nil {
 		log.Printf("Failed to run database migrations: %v", err)
 		return fmt.Errorf("could not migrate: %w", err)
 	}
 
+	// Enable row-level security and connect as the application role, like the application does
+	log.Printf("Applying row-level security...")
+	if err := postgres.ApplyRowLevelSecurity(context.Background(), OwnerClient, appUser, appPassword); err != nil {
+		log.Printf("Failed to apply row-level security: %v", err)
+		return fmt.Errorf("could not apply row-level security: %w", err)
+	}
+	DBClient = postgres.NewClient(AppDatabaseURL("dbname"))
+	DBRouter = repository.NewRouter(DBClient, func(ctx context.Context, name string) (*entgen.Client, error) {
+		return postgres.OpenClient(ctx, AppDatabaseURL(name))
+	})
+
 	log.Printf("Test environment setup completed successfully")
 	return nil
 }
 
+// AppDatabaseURL returns the connection string of the application role to a database of
+// the test server
+func AppDatabaseURL(name string) string {
+	return fmt.Sprintf("postgres://%s:%s@127.0.0.1:%s/%s?sslmode=disable",
+		appUser, appPassword, Resource.GetPort("5432/tcp"), name)
+}
+
+// OwnerDatabaseURL returns the connection string of the table owner to a database of the
+// test server
+func OwnerDatabaseURL(name string) string {
+	return fmt.Sprintf("postgres://%s:%s@127.0.0.1:%s/%s?sslmode=disable",
+		"user", "secret", Resource.GetPort("5432/tcp"), name)
+}
+
 // TeardownTestEnvironment cleans up the shared test environment
 func TeardownTestEnvironment() error {
 	log.Printf("Tearing down test environment...")
+	if DBRouter != nil {
+		DBRouter.Close()
+	}
 	if Pool != nil && Resource != nil {
 		log.Printf("Purging Docker resource...")
 		if err := Pool.Purge(Resource); err != nil {
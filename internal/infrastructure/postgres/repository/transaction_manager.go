@@ -2,33 +2,250 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
 	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
 )
 
+// Transaction retry defaults
+const (
+	DefaultTxMaxAttempts    = 5
+	DefaultTxRetryBaseDelay = 10 * time.Millisecond
+	DefaultTxRetryMaxDelay  = time.Second
+)
+
+// PostgreSQL error codes of transactions that can succeed when retried
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+)
+
+// errTenantCommitted marks failures after the transaction of a tenant database committed.
+// Retrying would apply the tenant's changes a second time, so they are never retried.
+var errTenantCommitted = errors.New("the tenant's transaction was already committed")
+
+// txRetries counts retried transactions by reason, and transactions that still failed
+// after the last attempt under "exhausted". It is published at /debug/vars.
+var txRetries = expvar.NewMap("postgres_tx_retries")
+
+// TxRetryConfig holds the retry policy of a transaction manager
+type TxRetryConfig struct {
+	// MaxAttempts is the maximum number of times a transaction is run, including the first
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry; it doubles with every retry
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts
+	MaxDelay time.Duration
+}
+
 type transactionManager struct {
-	client *entgen.Client
+	router *Router
+	cfg    TxRetryConfig
 }
 
-// NewTransactionManager creates a new transaction manager
-func NewTransactionManager(client *entgen.Client) repository.TransactionManager {
+// NewTransactionManager creates a new transaction manager. Zero values in cfg are replaced with defaults.
+func NewTransactionManager(router *Router, cfg TxRetryConfig) repository.TransactionManager {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultTxMaxAttempts
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = DefaultTxRetryBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = DefaultTxRetryMaxDelay
+	}
+
 	return &transactionManager{
-		client: client,
+		router: router,
+		cfg:    cfg,
 	}
 }
 
-// BeginTx starts a new transaction
-func (tm *transactionManager) BeginTx(ctx context.Context) (*entgen.Tx, error) {
-	return tm.client.Tx(ctx)
+// RunInTx runs fn in a transaction stored in the context, committing on success and
+// rolling back on error or panic. Serialization failures and deadlocks are retried.
+// Row-level security is scoped to the tenant carried by ctx for the whole transaction.
+func (tm *transactionManager) RunInTx(ctx context.Context, fn func(ctx context.Context) error, opts ...repository.TxOptions) error {
+	// Join the transaction already in progress; only the outermost call can retry
+	if inTx(ctx) {
+		return fn(ctx)
+	}
+
+	var txOpts repository.TxOptions
+	if len(opts) > 0 {
+		txOpts = opts[0]
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := tm.runOnce(ctx, fn, txOpts)
+		reason, retryable := retryReason(err)
+		if !retryable {
+			return err
+		}
+
+		if attempt >= tm.cfg.MaxAttempts {
+			txRetries.Add("exhausted", 1)
+			log.Printf("Failed to run transaction after %d attempts: %v", attempt, err)
+			return err
+		}
+
+		delay := tm.backoff(attempt)
+		txRetries.Add(reason, 1)
+		log.Printf("Retrying transaction in %v after %s (attempt %d/%d)", delay, reason, attempt+1, tm.cfg.MaxAttempts)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w; gave up retrying transaction: %v", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
 }
 
-// CommitTx commits a transaction
-func (tm *transactionManager) CommitTx(ctx context.Context, tx *entgen.Tx) error {
-	return tx.Commit()
+// inTx reports whether ctx carries a transaction started by RunInTx
+func inTx(ctx context.Context) bool {
+	return entgen.TxFromContext(ctx) != nil || tenantTxFromContext(ctx) != nil
 }
 
-// RollbackTx rolls back a transaction
-func (tm *transactionManager) RollbackTx(ctx context.Context, tx *entgen.Tx) error {
-	return tx.Rollback()
+// runOnce runs fn in a single transaction. For a tenant isolated in its own database, it
+// runs in a second transaction on that database too, which the tenant-scoped repositories
+// and the outbox use, so that the tenant's changes commit with their events. The tenant's
+// transaction commits first, so a failure in between can only lose the changes of the
+// shared tables, and is reported with errTenantCommitted.
+func (tm *transactionManager) runOnce(ctx context.Context, fn func(ctx context.Context) error, opts repository.TxOptions) error {
+	tenantClient, p, err := tm.router.route(ctx)
+	if err != nil {
+		return err
+	}
+	txOpts := &sql.TxOptions{
+		Isolation: sqlIsolation(opts.Isolation),
+		ReadOnly:  opts.ReadOnly,
+	}
+
+	tx, err := tm.router.client.BeginTx(ctx, txOpts)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err := setTenant(ctx, tx, p.searchPath()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	txs := []*entgen.Tx{tx}
+	txCtx := entgen.NewTxContext(ctx, tx)
+
+	if tenantClient != tm.router.client {
+		tenantTx, err := tenantClient.BeginTx(ctx, txOpts)
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to start tenant transaction: %w", err)
+		}
+		if err := setTenant(ctx, tenantTx, ""); err != nil {
+			_ = tenantTx.Rollback()
+			_ = tx.Rollback()
+			return err
+		}
+		// The tenant's transaction commits first
+		txs = []*entgen.Tx{tenantTx, tx}
+		txCtx = withTenantTx(txCtx, tenantTx)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			_ = rollback(txs)
+			panic(r) // re-panic
+		}
+	}()
+
+	if err := fn(txCtx); err != nil {
+		if rollbackErr := rollback(txs); rollbackErr != nil {
+			return fmt.Errorf("%w; also failed to rollback transaction: %v", err, rollbackErr)
+		}
+		return err
+	}
+
+	for i, tx := range txs {
+		if err := tx.Commit(); err != nil {
+			_ = rollback(txs[i+1:])
+			if i > 0 {
+				return fmt.Errorf("failed to commit transaction: %w: %w", errTenantCommitted, err)
+			}
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+	}
+	return nil
+}
+
+// rollback rolls back every transaction, returning the first error
+func rollback(txs []*entgen.Tx) error {
+	var first error
+	for _, tx := range txs {
+		if err := tx.Rollback(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// backoff returns the delay before the retry following the given attempt. It grows
+// exponentially and is jittered so that conflicting transactions do not collide again.
+func (tm *transactionManager) backoff(attempt int) time.Duration {
+	delay := tm.cfg.BaseDelay
+	for i := 1; i < attempt && delay < tm.cfg.MaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, tm.cfg.MaxDelay)
+	return delay/2 + rand.N(delay/2+1) //nolint:gosec // jitter does not need a cryptographic source
+}
+
+// retryReason reports whether err aborted the transaction in a way that a retry can fix
+func retryReason(err error) (string, bool) {
+	if errors.Is(err, errTenantCommitted) {
+		return "", false
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return "", false
+	}
+	switch pgErr.Code {
+	case sqlStateSerializationFailure:
+		return "serialization_failure", true
+	case sqlStateDeadlockDetected:
+		return "deadlock_detected", true
+	default:
+		return "", false
+	}
+}
+
+// sqlIsolation converts a domain isolation level to its database/sql counterpart
+func sqlIsolation(level repository.IsolationLevel) sql.IsolationLevel {
+	switch level {
+	case repository.IsolationReadCommitted:
+		return sql.LevelReadCommitted
+	case repository.IsolationRepeatableRead:
+		return sql.LevelRepeatableRead
+	case repository.IsolationSerializable:
+		return sql.LevelSerializable
+	default:
+		return sql.LevelDefault
+	}
+}
+
+// clientFromContext returns the client of the transaction started by RunInTx, or
+// client itself when ctx carries no transaction. It is for the shared tables; the
+// tenant-scoped ones are reached through withTenant.
+func clientFromContext(ctx context.Context, client *entgen.Client) *entgen.Client {
+	if tx := entgen.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return client
 }
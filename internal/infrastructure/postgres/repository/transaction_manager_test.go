@@ -0,0 +1,113 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	txrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransactionManager_RunInTx_Commit tests that writes through the context are committed together
+func TestTransactionManager_RunInTx_Commit(t *testing.T) {
+	carRepo, ctx, tenant := testSetup(t, "test-tenant-tx-commit")
+	txManager := txrepo.NewTransactionManager(testutil.DBRouter, txrepo.TxRetryConfig{})
+
+	car := newTestCar(t, tenant.ID)
+	err := txManager.RunInTx(ctx, func(ctx context.Context) error {
+		return carRepo.Create(ctx, car)
+	})
+	require.NoError(t, err)
+
+	_, err = carRepo.GetByID(ctx, tenant.ID, car.ID)
+	require.NoError(t, err)
+}
+
+// TestTransactionManager_RunInTx_Rollback tests that an error rolls back every write made through the context
+func TestTransactionManager_RunInTx_Rollback(t *testing.T) {
+	carRepo, ctx, tenant := testSetup(t, "test-tenant-tx-rollback")
+	txManager := txrepo.NewTransactionManager(testutil.DBRouter, txrepo.TxRetryConfig{})
+
+	car := newTestCar(t, tenant.ID)
+	err := txManager.RunInTx(ctx, func(ctx context.Context) error {
+		require.NoError(t, carRepo.Create(ctx, car))
+
+		// The write is visible inside the transaction
+		_, err := carRepo.GetByID(ctx, tenant.ID, car.ID)
+		require.NoError(t, err)
+
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	_, err = carRepo.GetByID(ctx, tenant.ID, car.ID)
+	require.Error(t, err)
+}
+
+// TestTransactionManager_RunInTx_Nested tests that a nested call joins the outer transaction
+func TestTransactionManager_RunInTx_Nested(t *testing.T) {
+	carRepo, ctx, tenant := testSetup(t, "test-tenant-tx-nested")
+	txManager := txrepo.NewTransactionManager(testutil.DBRouter, txrepo.TxRetryConfig{})
+
+	car := newTestCar(t, tenant.ID)
+	err := txManager.RunInTx(ctx, func(ctx context.Context) error {
+		if err := txManager.RunInTx(ctx, func(ctx context.Context) error {
+			return carRepo.Create(ctx, car)
+		}); err != nil {
+			return err
+		}
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	// The inner write was rolled back with the outer transaction
+	_, err = carRepo.GetByID(ctx, tenant.ID, car.ID)
+	require.Error(t, err)
+}
+
+// TestTransactionManager_RunInTx_RetrySerializationFailure tests that two serializable
+// transactions with a write skew both succeed, one of them after a retry
+func TestTransactionManager_RunInTx_RetrySerializationFailure(t *testing.T) {
+	carRepo, ctx, tenant := testSetup(t, "test-tenant-tx-retry")
+	txManager := txrepo.NewTransactionManager(testutil.DBRouter, txrepo.TxRetryConfig{})
+
+	// Both transactions read the fleet before either inserts, so PostgreSQL must abort one
+	var attempts atomic.Int32
+	var readers sync.WaitGroup
+	readers.Add(2)
+	model := testutil.CreateTestCarModel(t, tenant.ID)
+	book := func() error {
+		var first sync.Once
+		return txManager.RunInTx(ctx, func(ctx context.Context) error {
+			attempts.Add(1)
+			if _, _, _, err := carRepo.ListByTenant(ctx, tenant.ID, 10, 0); err != nil {
+				return err
+			}
+			first.Do(func() {
+				readers.Done()
+				readers.Wait()
+			})
+			return carRepo.Create(ctx, entity.NewCar(tenant.ID, model.ID, "", nil, nil, time.Now()))
+		}, repository.TxOptions{Isolation: repository.IsolationSerializable})
+	}
+
+	errs := make(chan error, 2)
+	go func() { errs <- book() }()
+	go func() { errs <- book() }()
+	require.NoError(t, <-errs)
+	require.NoError(t, <-errs)
+
+	assert.Greater(t, attempts.Load(), int32(2))
+	_, _, total, err := carRepo.ListByTenant(ctx, tenant.ID, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), total)
+}
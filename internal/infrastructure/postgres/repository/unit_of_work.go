@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// changeKind is the kind of change registered for an aggregate
+type changeKind int
+
+const (
+	changeNew changeKind = iota
+	changeDirty
+	changeDeleted
+)
+
+// change is an aggregate registered with a unit of work
+type change struct {
+	kind      changeKind
+	aggregate entity.Aggregate
+}
+
+type unitOfWorkFactory struct {
+	txManager       repository.TransactionManager
+	carRepo         repository.CarRepository
+	tenantRepo      repository.TenantRepository
+	rentalRepo      repository.RentalRepository
+	maintenanceRepo repository.MaintenanceWindowRepository
+	handoverRepo    repository.RentalHandoverRepository
+	outboxRepo      repository.OutboxRepository
+}
+
+// NewUnitOfWorkFactory creates a new unit of work factory
+func NewUnitOfWorkFactory(
+	txManager repository.TransactionManager,
+	carRepo repository.CarRepository,
+	tenantRepo repository.TenantRepository,
+	rentalRepo repository.RentalRepository,
+	maintenanceRepo repository.MaintenanceWindowRepository,
+	handoverRepo repository.RentalHandoverRepository,
+	outboxRepo repository.OutboxRepository,
+) repository.UnitOfWorkFactory {
+	return &unitOfWorkFactory{
+		txManager:       txManager,
+		carRepo:         carRepo,
+		tenantRepo:      tenantRepo,
+		rentalRepo:      rentalRepo,
+		maintenanceRepo: maintenanceRepo,
+		handoverRepo:    handoverRepo,
+		outboxRepo:      outboxRepo,
+	}
+}
+
+// New starts a new unit of work
+func (f *unitOfWorkFactory) New() repository.UnitOfWork {
+	return &unitOfWork{factory: f}
+}
+
+type unitOfWork struct {
+	factory *unitOfWorkFactory
+	changes []change
+}
+
+// RegisterNew registers an aggregate to be inserted
+func (u *unitOfWork) RegisterNew(aggregate entity.Aggregate) {
+	u.changes = append(u.changes, change{kind: changeNew, aggregate: aggregate})
+}
+
+// RegisterDirty registers an aggregate to be updated
+func (u *unitOfWork) RegisterDirty(aggregate entity.Aggregate) {
+	u.changes = append(u.changes, change{kind: changeDirty, aggregate: aggregate})
+}
+
+// RegisterDeleted registers an aggregate to be deleted
+func (u *unitOfWork) RegisterDeleted(aggregate entity.Aggregate) {
+	u.changes = append(u.changes, change{kind: changeDeleted, aggregate: aggregate})
+}
+
+// Commit persists every registered aggregate and writes their recorded events to the
+// outbox in one transaction. Events are cleared from the aggregates only once the
+// transaction has committed. Called inside RunInTx, it joins the surrounding transaction
+// and keeps the events, since a retry of that transaction must write them again.
+func (u *unitOfWork) Commit(ctx context.Context) error {
+	joined := inTx(ctx)
+	err := u.factory.txManager.RunInTx(ctx, func(ctx context.Context) error {
+		// Step 1: Persist the aggregates
+		for _, c := range u.changes {
+			if err := u.persist(ctx, c); err != nil {
+				return err
+			}
+		}
+
+		// Step 2: Write the recorded events to the outbox
+		now := time.Now()
+		for _, c := range u.changes {
+			for _, event := range c.aggregate.Events() {
+				msg, err := toOutbox(c.aggregate, event, now)
+				if err != nil {
+					return err
+				}
+				if err := u.factory.outboxRepo.Create(ctx, msg); err != nil {
+					return fmt.Errorf("failed to create outbox message: %w", err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !joined {
+		for _, c := range u.changes {
+			c.aggregate.ClearEvents()
+		}
+	}
+	u.changes = nil
+	return nil
+}
+
+// persist writes one registered change through the repository of its aggregate
+func (u *unitOfWork) persist(ctx context.Context, c change) error {
+	switch aggregate := c.aggregate.(type) {
+	case *entity.Car:
+		var err error
+		switch c.kind {
+		case changeNew:
+			err = u.factory.carRepo.Create(ctx, aggregate)
+		case changeDirty:
+			err = u.factory.carRepo.Update(ctx, aggregate)
+		case changeDeleted:
+			err = u.factory.carRepo.Delete(ctx, aggregate.TenantID, aggregate.ID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to persist car %s: %w", aggregate.ID, err)
+		}
+		return nil
+	case *entity.Tenant:
+		var err error
+		switch c.kind {
+		case changeNew:
+			err = u.factory.tenantRepo.Create(ctx, aggregate)
+		case changeDirty:
+			err = u.factory.tenantRepo.Update(ctx, aggregate)
+		case changeDeleted:
+			err = u.factory.tenantRepo.Delete(ctx, aggregate.ID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to persist tenant %s: %w", aggregate.ID, err)
+		}
+		return nil
+	case *entity.Rental:
+		// Rentals are booked and returned so far; canceling them is not supported yet
+		var err error
+		switch c.kind {
+		case changeNew:
+			err = u.factory.rentalRepo.Create(ctx, aggregate)
+		case changeDirty:
+			err = u.factory.rentalRepo.Update(ctx, aggregate)
+		case changeDeleted:
+			return errors.New("unit of work does not support deleting rentals")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to persist rental %s: %w", aggregate.ID, err)
+		}
+		return nil
+	case *entity.MaintenanceWindow:
+		// Windows are completed or canceled rather than deleted
+		var err error
+		switch c.kind {
+		case changeNew:
+			err = u.factory.maintenanceRepo.Create(ctx, aggregate)
+		case changeDirty:
+			err = u.factory.maintenanceRepo.Update(ctx, aggregate)
+		case changeDeleted:
+			return errors.New("unit of work does not support deleting maintenance windows")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to persist maintenance window %s: %w", aggregate.ID, err)
+		}
+		return nil
+	case *entity.RentalHandover:
+		// Handovers record what was inspected and never change
+		if c.kind != changeNew {
+			return errors.New("unit of work only supports creating rental handovers")
+		}
+		if err := u.factory.handoverRepo.Create(ctx, aggregate); err != nil {
+			return fmt.Errorf("failed to persist rental handover %s: %w", aggregate.ID, err)
+		}
+		return nil
+	case *entity.UsageReport:
+		// Reports are not stored; only their events are
+		return nil
+	default:
+		return fmt.Errorf("unit of work does not support aggregate %T", c.aggregate)
+	}
+}
+
+// toOutbox converts a domain event into a pending outbox message
+func toOutbox(aggregate entity.Aggregate, event entity.DomainEvent, now time.Time) (*entity.OutboxMessage, error) {
+	payload, err := eventPayload(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s event: %w", event.EventType(), err)
+	}
+
+	return entity.NewOutboxMessage(aggregate, event.EventType(), payload, now), nil
+}
+
+// eventPayload encodes an event into the JSON object stored in the outbox
+func eventPayload(event entity.DomainEvent) (map[string]interface{}, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -0,0 +1,318 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	uowrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// runInTx runs fn as RunInTx would, without a database
+func runInTx(ctx context.Context, fn func(ctx context.Context) error, _ ...repository.TxOptions) error {
+	return fn(ctx)
+}
+
+// TestUnitOfWork_Commit tests that aggregates and their events are written in one transaction
+func TestUnitOfWork_Commit(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockCarRepo := mock_repository.NewMockCarRepository(ctrl)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	factory := uowrepo.NewUnitOfWorkFactory(mockTxManager, mockCarRepo, nil, nil, nil, nil, mockOutboxRepo)
+
+	ctx := context.Background()
+	car := entity.NewCar("tenant-123", "model-1", "", nil, nil, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	// Set up expectations
+	gomock.InOrder(
+		mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx),
+		mockCarRepo.EXPECT().Create(ctx, car).Return(nil),
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, msg *entity.OutboxMessage) error {
+				assert.NotEmpty(t, msg.ID)
+				assert.Equal(t, "tenant-123", msg.TenantID)
+				assert.Equal(t, "car", msg.AggregateType)
+				assert.Equal(t, car.ID, msg.AggregateID)
+				assert.Equal(t, "car_created", msg.EventType)
+				assert.Equal(t, entity.OutboxStatusPending, msg.Status)
+				assert.Equal(t, car.ID, msg.Payload["id"])
+				assert.Equal(t, "model-1", msg.Payload["car_model_id"])
+				assert.Equal(t, "2025-01-02T03:04:05Z", msg.Payload["created_at"])
+				return nil
+			},
+		),
+	)
+
+	// Execute
+	uow := factory.New()
+	uow.RegisterNew(car)
+	err := uow.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, car.Events())
+}
+
+// TestUnitOfWork_Commit_Error tests that a failed write rolls back and keeps the recorded events
+func TestUnitOfWork_Commit_Error(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockCarRepo := mock_repository.NewMockCarRepository(ctrl)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	factory := uowrepo.NewUnitOfWorkFactory(mockTxManager, mockCarRepo, nil, nil, nil, nil, mockOutboxRepo)
+
+	ctx := context.Background()
+	car := entity.NewCar("tenant-123", "model-1", "", nil, nil, time.Now())
+
+	// Set up expectations
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+	mockCarRepo.EXPECT().Create(ctx, car).Return(nil)
+	mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(assert.AnError)
+
+	// Execute
+	uow := factory.New()
+	uow.RegisterNew(car)
+	err := uow.Commit(ctx)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Len(t, car.Events(), 1)
+}
+
+// TestUnitOfWork_Commit_Joined tests that a commit joining an outer transaction keeps the
+// recorded events, so that a retry of the outer transaction writes them again
+func TestUnitOfWork_Commit_Joined(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockCarRepo := mock_repository.NewMockCarRepository(ctrl)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	factory := uowrepo.NewUnitOfWorkFactory(mockTxManager, mockCarRepo, nil, nil, nil, nil, mockOutboxRepo)
+
+	ctx := entgen.NewTxContext(context.Background(), &entgen.Tx{})
+	car := entity.NewCar("tenant-123", "model-1", "", nil, nil, time.Now())
+
+	// Set up expectations
+	mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx)
+	mockCarRepo.EXPECT().Create(ctx, car).Return(nil)
+	mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+	// Execute
+	uow := factory.New()
+	uow.RegisterNew(car)
+	err := uow.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, car.Events(), 1)
+}
+
+// TestUnitOfWork_Commit_Tenant tests that tenant lifecycle changes are written with their events
+func TestUnitOfWork_Commit_Tenant(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	factory := uowrepo.NewUnitOfWorkFactory(mockTxManager, nil, mockTenantRepo, nil, nil, nil, mockOutboxRepo)
+
+	ctx := context.Background()
+	tenant := entity.NewTenant("acme", time.Now())
+	tenant.ClearEvents()
+	assert.NoError(t, tenant.Suspend(time.Now()))
+
+	// Set up expectations
+	gomock.InOrder(
+		mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx),
+		mockTenantRepo.EXPECT().Update(ctx, tenant).Return(nil),
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, msg *entity.OutboxMessage) error {
+				assert.Equal(t, tenant.ID, msg.TenantID)
+				assert.Equal(t, "tenant", msg.AggregateType)
+				assert.Equal(t, tenant.ID, msg.AggregateID)
+				assert.Equal(t, "tenant_suspended", msg.EventType)
+				assert.Equal(t, "acme", msg.Payload["code"])
+				return nil
+			},
+		),
+	)
+
+	// Execute
+	uow := factory.New()
+	uow.RegisterDirty(tenant)
+	err := uow.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, tenant.Events())
+}
+
+func TestUnitOfWork_Commit_UsageReport(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	factory := uowrepo.NewUnitOfWorkFactory(mockTxManager, nil, nil, nil, nil, nil, mockOutboxRepo)
+
+	ctx := context.Background()
+	report := entity.NewAPICallsReport("tenant-1", 42, time.Now().Add(-time.Minute), time.Now())
+
+	// Set up expectations: reports are not stored, only their events
+	gomock.InOrder(
+		mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx),
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, msg *entity.OutboxMessage) error {
+				assert.Equal(t, "tenant-1", msg.TenantID)
+				assert.Equal(t, "usage", msg.AggregateType)
+				assert.Equal(t, "api_calls_counted", msg.EventType)
+				assert.Equal(t, float64(42), msg.Payload["calls"])
+				return nil
+			},
+		),
+	)
+
+	// Execute
+	uow := factory.New()
+	uow.RegisterNew(report)
+	err := uow.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, report.Events())
+}
+
+// TestUnitOfWork_Commit_Rental tests that a rental of a shared car is written with an event
+// naming both tenants
+func TestUnitOfWork_Commit_Rental(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockRentalRepo := mock_repository.NewMockRentalRepository(ctrl)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	factory := uowrepo.NewUnitOfWorkFactory(mockTxManager, nil, nil, mockRentalRepo, nil, nil, mockOutboxRepo)
+
+	ctx := context.Background()
+	settings := entity.DefaultTenantSettings("borrower-1", time.Now())
+	car := entity.NewCar("lender-1", "model-1", "", nil, nil, time.Now())
+	startsAt := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	rental, err := entity.NewRental(settings, car, "renter-1", entity.RentalBranches{}, startsAt, startsAt.Add(48*time.Hour))
+	assert.NoError(t, err)
+
+	// Set up expectations
+	gomock.InOrder(
+		mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx),
+		mockRentalRepo.EXPECT().Create(ctx, rental).Return(nil),
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, msg *entity.OutboxMessage) error {
+				assert.Equal(t, "borrower-1", msg.TenantID)
+				assert.Equal(t, "rental_created", msg.EventType)
+				assert.Equal(t, "lender-1", msg.Payload["owner_tenant_id"])
+				return nil
+			},
+		),
+	)
+
+	// Execute
+	uow := factory.New()
+	uow.RegisterNew(rental)
+	err = uow.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, rental.Events())
+}
+
+// TestUnitOfWork_Commit_MaintenanceWindow tests that a completed maintenance window is
+// updated with an event carrying when the car is back in service
+func TestUnitOfWork_Commit_MaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockMaintenanceRepo := mock_repository.NewMockMaintenanceWindowRepository(ctrl)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	factory := uowrepo.NewUnitOfWorkFactory(mockTxManager, nil, nil, nil, mockMaintenanceRepo, nil, mockOutboxRepo)
+
+	ctx := context.Background()
+	startsAt := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	window, err := entity.NewMaintenanceWindow("tenant-1", "car-1", entity.MaintenanceTypeRepair, "Brakes", startsAt, startsAt.Add(8*time.Hour), startsAt)
+	assert.NoError(t, err)
+	window.ClearEvents()
+	assert.NoError(t, window.Complete(startsAt.Add(2*time.Hour)))
+
+	// Set up expectations
+	gomock.InOrder(
+		mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx),
+		mockMaintenanceRepo.EXPECT().Update(ctx, window).Return(nil),
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, msg *entity.OutboxMessage) error {
+				assert.Equal(t, "maintenance_window", msg.AggregateType)
+				assert.Equal(t, "maintenance_window_completed", msg.EventType)
+				assert.Equal(t, "2026-01-05T11:00:00Z", msg.Payload["ends_at"])
+				return nil
+			},
+		),
+	)
+
+	// Execute
+	uow := factory.New()
+	uow.RegisterDirty(window)
+	err = uow.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, window.Events())
+}
+
+// TestUnitOfWork_Commit_RentalHandover tests that a return handover is written with an event
+// carrying the distance driven and the fuel shortfall since pickup
+func TestUnitOfWork_Commit_RentalHandover(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTxManager := mock_repository.NewMockTransactionManager(ctrl)
+	mockHandoverRepo := mock_repository.NewMockRentalHandoverRepository(ctrl)
+	mockOutboxRepo := mock_repository.NewMockOutboxRepository(ctrl)
+	factory := uowrepo.NewUnitOfWorkFactory(mockTxManager, nil, nil, nil, nil, mockHandoverRepo, mockOutboxRepo)
+
+	ctx := context.Background()
+	startsAt := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	rental := &entity.Rental{ID: "rental-1", TenantID: "borrower-1", OwnerTenantID: "lender-1", CarID: "car-1", StartsAt: startsAt}
+	pickup, err := entity.NewPickupHandover(rental, entity.HandoverReading{OdometerKm: 12000, FuelLevel: 100}, startsAt)
+	assert.NoError(t, err)
+	handover, err := entity.NewReturnHandover(rental, pickup, entity.HandoverReading{OdometerKm: 12350, FuelLevel: 60}, startsAt.Add(48*time.Hour))
+	assert.NoError(t, err)
+
+	// Set up expectations
+	gomock.InOrder(
+		mockTxManager.EXPECT().RunInTx(ctx, gomock.Any()).DoAndReturn(runInTx),
+		mockHandoverRepo.EXPECT().Create(ctx, handover).Return(nil),
+		mockOutboxRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, msg *entity.OutboxMessage) error {
+				assert.Equal(t, "borrower-1", msg.TenantID)
+				assert.Equal(t, "rental_handover", msg.AggregateType)
+				assert.Equal(t, "rental_handover_recorded", msg.EventType)
+				assert.Equal(t, "return", msg.Payload["kind"])
+				assert.EqualValues(t, 350, msg.Payload["distance_km"])
+				assert.EqualValues(t, 40, msg.Payload["fuel_shortfall"])
+				return nil
+			},
+		),
+	)
+
+	// Execute
+	uow := factory.New()
+	uow.RegisterNew(handover)
+	err = uow.Commit(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, handover.Events())
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/car"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/rental"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/renter"
+)
+
+type usageRepository struct {
+	router *Router
+}
+
+// NewUsageRepository creates a new usage repository
+func NewUsageRepository(router *Router) repository.UsageRepository {
+	return &usageRepository{
+		router: router,
+	}
+}
+
+// Count counts the live cars or renters of a tenant, or the rentals it created since since
+func (r *usageRepository) Count(ctx context.Context, tenantID string, resource entity.Resource, since time.Time) (int, error) {
+	return withTenant(ctx, r.router, func(client *entgen.Client) (int, error) {
+		switch resource {
+		case entity.ResourceCars:
+			return client.Car.
+				Query().
+				Where(car.TenantID(tenantID), car.DeletedAtIsNil()).
+				Count(ctx)
+		case entity.ResourceRenters:
+			return client.Renter.
+				Query().
+				Where(renter.TenantID(tenantID), renter.DeletedAtIsNil()).
+				Count(ctx)
+		case entity.ResourceMonthlyRentals:
+			return client.Rental.
+				Query().
+				Where(rental.TenantID(tenantID), rental.CreatedAtGTE(since)).
+				Count(ctx)
+		}
+		return 0, fmt.Errorf("unknown resource %q", resource)
+	})
+}
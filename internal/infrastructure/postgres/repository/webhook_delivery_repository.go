@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookdelivery"
+)
+
+type webhookDeliveryRepository struct {
+	client *entgen.Client
+}
+
+// NewWebhookDeliveryRepository creates a new webhook delivery repository
+func NewWebhookDeliveryRepository(client *entgen.Client) repository.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		client: client,
+	}
+}
+
+// CreateIfNotExists inserts a new webhook delivery unless the endpoint already has one for the event.
+// This keeps scheduling idempotent when the outbox relay delivers the same event twice.
+func (r *webhookDeliveryRepository) CreateIfNotExists(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	_, err := clientFromContext(ctx, r.client).WebhookDelivery.
+		Create().
+		SetID(delivery.ID).
+		SetTenantID(delivery.TenantID).
+		SetEndpointID(delivery.EndpointID).
+		SetEventID(delivery.EventID).
+		SetEventType(delivery.EventType).
+		SetPayload(delivery.Payload).
+		SetStatus(delivery.Status.String()).
+		SetAttempts(delivery.Attempts).
+		SetNillableNextAttemptAt(delivery.NextAttemptAt.Ptr()).
+		SetCreatedAt(delivery.CreatedAt).
+		SetUpdatedAt(delivery.UpdatedAt).
+		Save(ctx)
+	if entgen.IsConstraintError(err) {
+		// The unique index on (endpoint_id, event_id) already holds a delivery for this event
+		return nil
+	}
+	return err
+}
+
+// GetByID retrieves a tenant's webhook delivery by its ID
+func (r *webhookDeliveryRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.WebhookDelivery, error) {
+	deliveryDB, err := clientFromContext(ctx, r.client).WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.ID(id),
+			webhookdelivery.TenantID(tenantID),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return r.entToDomain(deliveryDB), nil
+}
+
+// ListByTenant retrieves a tenant's delivery log, newest first
+func (r *webhookDeliveryRepository) ListByTenant(ctx context.Context, tenantID string, filter repository.WebhookDeliveryFilter, limit int, offset int) ([]*entity.WebhookDelivery, error) {
+	query := clientFromContext(ctx, r.client).WebhookDelivery.
+		Query().
+		Where(webhookdelivery.TenantID(tenantID))
+
+	if filter.EndpointID != "" {
+		query = query.Where(webhookdelivery.EndpointID(filter.EndpointID))
+	}
+	if filter.Status != "" {
+		query = query.Where(webhookdelivery.Status(filter.Status.String()))
+	}
+
+	deliveriesDB, err := query.
+		Order(entgen.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*entity.WebhookDelivery, len(deliveriesDB))
+	for i, deliveryDB := range deliveriesDB {
+		deliveries[i] = r.entToDomain(deliveryDB)
+	}
+	return deliveries, nil
+}
+
+// ClaimDue leases pending deliveries whose next attempt is due
+func (r *webhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error) {
+	dueDB, err := clientFromContext(ctx, r.client).WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.Status(entity.WebhookDeliveryStatusPending.String()),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(entgen.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leasedUntil := now.Add(lease)
+	claimed := make([]*entity.WebhookDelivery, 0, len(dueDB))
+	for _, deliveryDB := range dueDB {
+		// Compare-and-swap on next_attempt_at: only one dispatcher wins each delivery
+		affected, err := clientFromContext(ctx, r.client).WebhookDelivery.
+			Update().
+			Where(
+				webhookdelivery.ID(deliveryDB.ID),
+				webhookdelivery.Status(entity.WebhookDeliveryStatusPending.String()),
+				webhookdelivery.NextAttemptAtEQ(*deliveryDB.NextAttemptAt),
+			).
+			SetNextAttemptAt(leasedUntil).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if affected == 0 {
+			continue
+		}
+
+		delivery := r.entToDomain(deliveryDB)
+		delivery.NextAttemptAt = null.TimeFrom(leasedUntil)
+		claimed = append(claimed, delivery)
+	}
+
+	return claimed, nil
+}
+
+// Update updates an existing webhook delivery
+func (r *webhookDeliveryRepository) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	update := clientFromContext(ctx, r.client).WebhookDelivery.
+		UpdateOneID(delivery.ID).
+		SetStatus(delivery.Status.String()).
+		SetAttempts(delivery.Attempts).
+		SetUpdatedAt(delivery.UpdatedAt)
+
+	if delivery.NextAttemptAt.Valid {
+		update.SetNextAttemptAt(delivery.NextAttemptAt.Time)
+	} else {
+		update.ClearNextAttemptAt()
+	}
+	if delivery.LastAttemptAt.Valid {
+		update.SetLastAttemptAt(delivery.LastAttemptAt.Time)
+	}
+	if delivery.ResponseStatus.Valid {
+		update.SetResponseStatus(delivery.ResponseStatus.Int)
+	} else {
+		update.ClearResponseStatus()
+	}
+	if delivery.LastError.Valid {
+		update.SetLastError(truncate(delivery.LastError.String, 1000))
+	} else {
+		update.ClearLastError()
+	}
+
+	_, err := update.Save(ctx)
+	return err
+}
+
+// entToDomain converts an Ent webhook delivery model to a domain webhook delivery entity
+func (r *webhookDeliveryRepository) entToDomain(entDelivery *entgen.WebhookDelivery) *entity.WebhookDelivery {
+	return &entity.WebhookDelivery{
+		ID:             entDelivery.ID,
+		TenantID:       entDelivery.TenantID,
+		EndpointID:     entDelivery.EndpointID,
+		EventID:        entDelivery.EventID,
+		EventType:      entDelivery.EventType,
+		Payload:        entDelivery.Payload,
+		Status:         entity.NewWebhookDeliveryStatus(entDelivery.Status),
+		Attempts:       entDelivery.Attempts,
+		NextAttemptAt:  null.TimeFromPtr(entDelivery.NextAttemptAt),
+		LastAttemptAt:  null.TimeFromPtr(entDelivery.LastAttemptAt),
+		ResponseStatus: null.IntFromPtr(entDelivery.ResponseStatus),
+		LastError:      null.StringFromPtr(entDelivery.LastError),
+		CreatedAt:      entDelivery.CreatedAt,
+		UpdatedAt:      entDelivery.UpdatedAt,
+	}
+}
+
+// truncate shortens s to at most n bytes so that it fits its column
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/aarondl/null/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen/webhookendpoint"
+)
+
+type webhookEndpointRepository struct {
+	client *entgen.Client
+}
+
+// NewWebhookEndpointRepository creates a new webhook endpoint repository
+func NewWebhookEndpointRepository(client *entgen.Client) repository.WebhookEndpointRepository {
+	return &webhookEndpointRepository{
+		client: client,
+	}
+}
+
+// Create inserts a new webhook endpoint into the database
+func (r *webhookEndpointRepository) Create(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	_, err := withSharedTenant(ctx, r.client, func(client *entgen.Client) (*entgen.WebhookEndpoint, error) {
+		return client.WebhookEndpoint.
+			Create().
+			SetID(endpoint.ID).
+			SetTenantID(endpoint.TenantID).
+			SetURL(endpoint.URL).
+			SetSecret(endpoint.Secret).
+			SetEventTypes(endpoint.EventTypes).
+			SetDescription(endpoint.Description).
+			SetEnabled(endpoint.Enabled).
+			SetConsecutiveFailures(endpoint.ConsecutiveFailures).
+			SetNillableDisabledAt(endpoint.DisabledAt.Ptr()).
+			SetNillableDisabledReason(endpoint.DisabledReason.Ptr()).
+			SetCreatedAt(endpoint.CreatedAt).
+			SetUpdatedAt(endpoint.UpdatedAt).
+			Save(ctx)
+	})
+	return err
+}
+
+// GetByID retrieves a tenant's webhook endpoint by its ID
+func (r *webhookEndpointRepository) GetByID(ctx context.Context, tenantID, id string) (*entity.WebhookEndpoint, error) {
+	endpointDB, err := withSharedTenant(ctx, r.client, func(client *entgen.Client) (*entgen.WebhookEndpoint, error) {
+		return client.WebhookEndpoint.
+			Query().
+			Where(
+				webhookendpoint.ID(id),
+				webhookendpoint.TenantID(tenantID),
+				webhookendpoint.DeletedAtIsNil(),
+			).
+			Only(ctx)
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return r.entToDomain(endpointDB), nil
+}
+
+// ListByTenant retrieves a tenant's webhook endpoints with pagination
+func (r *webhookEndpointRepository) ListByTenant(ctx context.Context, tenantID string, limit int, offset int) ([]*entity.WebhookEndpoint, error) {
+	endpointsDB, err := withSharedTenant(ctx, r.client, func(client *entgen.Client) ([]*entgen.WebhookEndpoint, error) {
+		return client.WebhookEndpoint.
+			Query().
+			Where(
+				webhookendpoint.TenantID(tenantID),
+				webhookendpoint.DeletedAtIsNil(),
+			).
+			Order(entgen.Asc(webhookendpoint.FieldCreatedAt)).
+			Limit(limit).
+			Offset(offset).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]*entity.WebhookEndpoint, len(endpointsDB))
+	for i, endpointDB := range endpointsDB {
+		endpoints[i] = r.entToDomain(endpointDB)
+	}
+	return endpoints, nil
+}
+
+// ListSubscribed retrieves the enabled endpoints of a tenant that subscribe to the event type
+func (r *webhookEndpointRepository) ListSubscribed(ctx context.Context, tenantID, eventType string) ([]*entity.WebhookEndpoint, error) {
+	endpointsDB, err := withSharedTenant(ctx, r.client, func(client *entgen.Client) ([]*entgen.WebhookEndpoint, error) {
+		return client.WebhookEndpoint.
+			Query().
+			Where(
+				webhookendpoint.TenantID(tenantID),
+				webhookendpoint.Enabled(true),
+				webhookendpoint.DeletedAtIsNil(),
+			).
+			All(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Endpoints per tenant are few, so the event filter is applied in memory
+	endpoints := make([]*entity.WebhookEndpoint, 0, len(endpointsDB))
+	for _, endpointDB := range endpointsDB {
+		endpoint := r.entToDomain(endpointDB)
+		if endpoint.Subscribes(eventType) {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints, nil
+}
+
+// Update updates an existing webhook endpoint
+func (r *webhookEndpointRepository) Update(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	_, err := withSharedTenant(ctx, r.client, func(client *entgen.Client) (*entgen.WebhookEndpoint, error) {
+		update := client.WebhookEndpoint.
+			UpdateOneID(endpoint.ID).
+			Where(webhookendpoint.TenantID(endpoint.TenantID)).
+			SetURL(endpoint.URL).
+			SetSecret(endpoint.Secret).
+			SetEventTypes(endpoint.EventTypes).
+			SetDescription(endpoint.Description).
+			SetEnabled(endpoint.Enabled).
+			SetConsecutiveFailures(endpoint.ConsecutiveFailures).
+			SetUpdatedAt(endpoint.UpdatedAt)
+
+		if endpoint.DisabledAt.Valid {
+			update.SetDisabledAt(endpoint.DisabledAt.Time)
+		} else {
+			update.ClearDisabledAt()
+		}
+		if endpoint.DisabledReason.Valid {
+			update.SetDisabledReason(endpoint.DisabledReason.String)
+		} else {
+			update.ClearDisabledReason()
+		}
+
+		return update.Save(ctx)
+	})
+	return err
+}
+
+// recordFailureQuery extends the failure streak of an enabled endpoint and disables it at
+// the threshold ($3). Only enabled endpoints are updated, so disabled by this call means
+// disabled after the update.
+const recordFailureQuery = `UPDATE webhook_endpoints SET
+	consecutive_failures = consecutive_failures + 1,
+	enabled = consecutive_failures + 1 < $3,
+	disabled_at = CASE WHEN consecutive_failures + 1 >= $3 THEN $4::timestamptz END,
+	disabled_reason = CASE WHEN consecutive_failures + 1 >= $3 THEN $5::text END,
+	updated_at = $4
+WHERE id = $1 AND tenant_id = $2 AND enabled AND deleted_at IS NULL
+RETURNING NOT enabled`
+
+// RecordFailure extends the failure streak of an endpoint, disabling it at the threshold.
+// Concurrent deliveries each add their failure, whatever copy of the endpoint they read.
+func (r *webhookEndpointRepository) RecordFailure(ctx context.Context, tenantID, id string, now time.Time) (bool, error) {
+	return withSharedTenant(ctx, r.client, func(client *entgen.Client) (bool, error) {
+		rows, err := client.QueryContext(ctx, recordFailureQuery,
+			id, tenantID, entity.MaxWebhookConsecutiveFailures, now, entity.WebhookFailuresDisabledReason)
+		if err != nil {
+			return false, err
+		}
+		defer rows.Close()
+
+		var disabled bool
+		if rows.Next() {
+			if err := rows.Scan(&disabled); err != nil {
+				return false, err
+			}
+		}
+		return disabled, rows.Err()
+	})
+}
+
+// ResetFailures ends the failure streak of an endpoint, leaving its other fields alone
+func (r *webhookEndpointRepository) ResetFailures(ctx context.Context, tenantID, id string, now time.Time) error {
+	_, err := withSharedTenant(ctx, r.client, func(client *entgen.Client) (int, error) {
+		return client.WebhookEndpoint.
+			Update().
+			Where(
+				webhookendpoint.ID(id),
+				webhookendpoint.TenantID(tenantID),
+				webhookendpoint.ConsecutiveFailuresGT(0),
+			).
+			SetConsecutiveFailures(0).
+			SetUpdatedAt(now).
+			Save(ctx)
+	})
+	return err
+}
+
+// Delete soft-deletes a webhook endpoint so that its delivery log is kept
+func (r *webhookEndpointRepository) Delete(ctx context.Context, tenantID, id string) error {
+	_, err := withSharedTenant(ctx, r.client, func(client *entgen.Client) (int, error) {
+		return client.WebhookEndpoint.
+			Update().
+			Where(
+				webhookendpoint.ID(id),
+				webhookendpoint.TenantID(tenantID),
+				webhookendpoint.DeletedAtIsNil(),
+			).
+			SetEnabled(false).
+			SetDeletedAt(time.Now()).
+			Save(ctx)
+	})
+	return err
+}
+
+// entToDomain converts an Ent webhook endpoint model to a domain webhook endpoint entity
+func (r *webhookEndpointRepository) entToDomain(entEndpoint *entgen.WebhookEndpoint) *entity.WebhookEndpoint {
+	return &entity.WebhookEndpoint{
+		ID:                  entEndpoint.ID,
+		TenantID:            entEndpoint.TenantID,
+		URL:                 entEndpoint.URL,
+		Secret:              entEndpoint.Secret,
+		EventTypes:          slices.Clone(entEndpoint.EventTypes),
+		Description:         entEndpoint.Description,
+		Enabled:             entEndpoint.Enabled,
+		ConsecutiveFailures: entEndpoint.ConsecutiveFailures,
+		DisabledAt:          null.TimeFromPtr(entEndpoint.DisabledAt),
+		DisabledReason:      null.StringFromPtr(entEndpoint.DisabledReason),
+		CreatedAt:           entEndpoint.CreatedAt,
+		UpdatedAt:           entEndpoint.UpdatedAt,
+	}
+}
@@ -0,0 +1,72 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	webhookrepo "github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/repository/testutil"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWebhookEndpointRepository_RecordFailure tests that concurrent failures all count,
+// that the endpoint is disabled once at the threshold, and that the other fields are kept
+func TestWebhookEndpointRepository_RecordFailure(t *testing.T) {
+	testutil.SkipIfShort(t)
+
+	repo := webhookrepo.NewWebhookEndpointRepository(testutil.DBClient)
+	tenant := testutil.CreateRandomTestTenant(t)
+	ctx := tenantctx.WithTenantID(context.Background(), tenant.ID)
+
+	endpoint, err := entity.NewWebhookEndpoint(tenant.ID, "https://example.com/hooks", []string{entity.WebhookEventAll}, "", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(ctx, endpoint))
+
+	// An admin rotates the URL while deliveries read before it are failing
+	rotated := *endpoint
+	rotated.URL = "https://example.com/rotated"
+	require.NoError(t, repo.Update(ctx, &rotated))
+
+	var wg sync.WaitGroup
+	disabled := make(chan bool, entity.MaxWebhookConsecutiveFailures+1)
+	for range entity.MaxWebhookConsecutiveFailures + 1 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d, err := repo.RecordFailure(ctx, tenant.ID, endpoint.ID, time.Now())
+			assert.NoError(t, err)
+			disabled <- d
+		}()
+	}
+	wg.Wait()
+	close(disabled)
+
+	count := 0
+	for d := range disabled {
+		if d {
+			count++
+		}
+	}
+	require.Equal(t, 1, count)
+
+	found, err := repo.GetByID(ctx, tenant.ID, endpoint.ID)
+	require.NoError(t, err)
+	require.False(t, found.Enabled)
+	require.Equal(t, entity.MaxWebhookConsecutiveFailures, found.ConsecutiveFailures)
+	require.Equal(t, entity.WebhookFailuresDisabledReason, found.DisabledReason.String)
+	require.Equal(t, "https://example.com/rotated", found.URL)
+
+	// A success resets the streak without enabling the endpoint again
+	require.NoError(t, repo.ResetFailures(ctx, tenant.ID, endpoint.ID, time.Now()))
+	found, err = repo.GetByID(ctx, tenant.ID, endpoint.ID)
+	require.NoError(t, err)
+	require.Zero(t, found.ConsecutiveFailures)
+	require.False(t, found.Enabled)
+}
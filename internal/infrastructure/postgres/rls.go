@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/postgres/entgen"
+)
+
+// TenantSetting is the run-time parameter that row-level security policies compare
+// tenant_id against. It is set per transaction by the repositories.
+const TenantSetting = "app.tenant_id"
+
+// TenantScopedTables are the tables protected by the tenant isolation policy
+var TenantScopedTables = []string{
+	"branches",
+	"car_models",
+	"cars",
+	"maintenance_windows",
+	"rentals",
+	"renters",
+	"companies",
+	"individuals",
+	"car_options",
+	"rental_options",
+	"rental_handovers",
+	"tenant_settings",
+}
+
+// SharedTenantTables are the tables protected by the tenant isolation policy that stay in
+// the shared schema whatever the isolation mode of their tenant. webhook_endpoints holds
+// signing secrets, but the webhook workers reach it from the shared database only.
+var SharedTenantTables = []string{
+	"webhook_endpoints",
+}
+
+// RentalPeriodsView is the view of the periods the cars a tenant can book are rented for,
+// without who rented them. Availability checks read it instead of rentals, which only shows
+// a borrower the rentals it booked.
+const RentalPeriodsView = "rental_periods"
+
+// tenantPolicy is the name of the row-level security policy of every tenant-scoped table
+const tenantPolicy = "tenant_isolation"
+
+// Names of the policies opening rows to the tenants of fleet sharing agreements
+const (
+	sharingPolicy     = "fleet_sharing"
+	ownerDeletePolicy = "owner_delete"
+)
+
+// ApplyRowLevelSecurity enables the tenant isolation policy on every tenant-scoped table and
+// the fleet sharing policies on top of it, and provisions appRole, the non-owner role the
+// application connects as. Policies do not apply to the table owner, so migrations and
+// seeding keep seeing every row.
+// It must run as the table owner after each migration, and is safe to run repeatedly.
+func ApplyRowLevelSecurity(ctx context.Context, client *entgen.Client, appRole, appPassword string) error {
+	statements := []string{
+		// CREATE ROLE has no IF NOT EXISTS
+		fmt.Sprintf(`DO $$ BEGIN
+	IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = %[1]s) THEN
+		CREATE ROLE %[2]s LOGIN PASSWORD %[3]s NOSUPERUSER NOBYPASSRLS;
+	END IF;
+END $$`, quoteLiteral(appRole), pgx.Identifier{appRole}.Sanitize(), quoteLiteral(appPassword)),
+	}
+	statements = append(statements, policyStatements("public", appRole, slices.Concat(TenantScopedTables, SharedTenantTables))...)
+	statements = append(statements, sharingPolicyStatements()...)
+	statements = append(statements, rentalPeriodsStatements("public", appRole, true)...)
+	return execInTx(ctx, client, statements)
+}
+
+// applyTenantPolicies grants appRole access to the tenant-scoped tables of a schema and
+// enables the tenant isolation policy on them. appRole must already exist; roles belong
+// to the server, so ApplyRowLevelSecurity creates it for every database.
+func applyTenantPolicies(ctx context.Context, client *entgen.Client, schemaName, appRole string) error {
+	statements := policyStatements(schemaName, appRole, TenantScopedTables)
+	statements = append(statements, rentalPeriodsStatements(schemaName, appRole, false)...)
+	return execInTx(ctx, client, statements)
+}
+
+// policyStatements returns the statements granting appRole access to the tables of a
+// schema and enabling the tenant isolation policy on the given tables of it
+func policyStatements(schemaName, appRole string, tables []string) []string {
+	role := pgx.Identifier{appRole}.Sanitize()
+	ns := pgx.Identifier{schemaName}.Sanitize()
+
+	statements := []string{
+		fmt.Sprintf("GRANT USAGE ON SCHEMA %s TO %s", ns, role),
+		fmt.Sprintf("GRANT SELECT, INSERT, UPDATE, DELETE ON ALL TABLES IN SCHEMA %s TO %s", ns, role),
+	}
+
+	condition := fmt.Sprintf("tenant_id = current_setting(%s, true)", quoteLiteral(TenantSetting))
+	for _, table := range tables {
+		t := pgx.Identifier{schemaName, table}.Sanitize()
+		statements = append(statements,
+			fmt.Sprintf("ALTER TABLE %s ENABLE ROW LEVEL SECURITY", t),
+			fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", tenantPolicy, t),
+			fmt.Sprintf("CREATE POLICY %s ON %s USING (%s) WITH CHECK (%s)", tenantPolicy, t, condition, condition),
+		)
+	}
+	return statements
+}
+
+// sharingPolicyStatements returns the statements opening the rows of public to the tenants
+// of active fleet sharing agreements. Permissive policies are combined with OR, so on top of
+// its own rows a borrower reads the cars of its lenders with their models and branches, and
+// their maintenance windows. It does not read the rentals of those cars that other tenants
+// booked, only their periods in RentalPeriodsView, to check their availability. A lender
+// reads the rentals of its cars that borrowers booked, with their options and handovers.
+// Writes stay limited to the tenant's own rows, except that the owner of a car deletes its
+// rentals when it is purged. Agreements only exist between tenants in shared tables, so
+// schemas and databases of isolated tenants need none of this.
+func sharingPolicyStatements() []string {
+	current := currentTenant()
+	lenders := lendersOf(current)
+
+	branches := pgx.Identifier{"public", "branches"}.Sanitize()
+	carModels := pgx.Identifier{"public", "car_models"}.Sanitize()
+	cars := pgx.Identifier{"public", "cars"}.Sanitize()
+	maintenanceWindows := pgx.Identifier{"public", "maintenance_windows"}.Sanitize()
+	rentals := pgx.Identifier{"public", "rentals"}.Sanitize()
+	rentalOptions := pgx.Identifier{"public", "rental_options"}.Sanitize()
+	rentalHandovers := pgx.Identifier{"public", "rental_handovers"}.Sanitize()
+	ownedRental := fmt.Sprintf("rental_id IN (SELECT id FROM %s WHERE owner_tenant_id = %s)", rentals, current)
+	return []string{
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", sharingPolicy, branches),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR SELECT USING (tenant_id IN (%s))", sharingPolicy, branches, lenders),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", sharingPolicy, carModels),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR SELECT USING (tenant_id IN (%s))", sharingPolicy, carModels, lenders),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", sharingPolicy, cars),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR SELECT USING (tenant_id IN (%s))", sharingPolicy, cars, lenders),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", sharingPolicy, maintenanceWindows),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR SELECT USING (tenant_id IN (%s))", sharingPolicy, maintenanceWindows, lenders),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", sharingPolicy, rentals),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR SELECT USING (owner_tenant_id = %s)", sharingPolicy, rentals, current),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", ownerDeletePolicy, rentals),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR DELETE USING (owner_tenant_id = %s)", ownerDeletePolicy, rentals, current),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", sharingPolicy, rentalOptions),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR SELECT USING (%s)", sharingPolicy, rentalOptions, ownedRental),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", ownerDeletePolicy, rentalOptions),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR DELETE USING (%s)", ownerDeletePolicy, rentalOptions, ownedRental),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", sharingPolicy, rentalHandovers),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR SELECT USING (%s)", sharingPolicy, rentalHandovers, ownedRental),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", ownerDeletePolicy, rentalHandovers),
+		fmt.Sprintf("CREATE POLICY %s ON %s FOR DELETE USING (%s)", ownerDeletePolicy, rentalHandovers, ownedRental),
+	}
+}
+
+// rentalPeriodsStatements returns the statements creating RentalPeriodsView in a schema and
+// granting appRole read-only access to it. The view reads rentals as its owner, past their
+// row-level security, so it only returns the live rentals of the cars the tenant owns and,
+// with sharing, of the cars its lenders share with it, without their renters and tenants.
+// A returned rental ends when its car was returned. The view is recreated rather than
+// replaced, so that its columns can change.
+func rentalPeriodsStatements(schemaName, appRole string, sharing bool) []string {
+	role := pgx.Identifier{appRole}.Sanitize()
+	view := pgx.Identifier{schemaName, RentalPeriodsView}.Sanitize()
+	rentals := pgx.Identifier{schemaName, "rentals"}.Sanitize()
+
+	owner := "COALESCE(owner_tenant_id, tenant_id)"
+	visible := fmt.Sprintf("%s = %s", owner, currentTenant())
+	if sharing {
+		visible = fmt.Sprintf("(%s OR %s IN (%s))", visible, owner, lendersOf(currentTenant()))
+	}
+
+	return []string{
+		fmt.Sprintf("DROP VIEW IF EXISTS %s", view),
+		// security_barrier keeps the application's conditions from seeing rows before
+		// the view's own have filtered them
+		fmt.Sprintf("CREATE VIEW %s WITH (security_barrier) AS"+
+			" SELECT car_id, starts_at, COALESCE(returned_at, ends_at) AS ends_at FROM %s"+
+			" WHERE deleted_at IS NULL AND %s", view, rentals, visible),
+		// Simple views are updatable, and writes through them would skip row-level security
+		fmt.Sprintf("REVOKE ALL ON %s FROM %s", view, role),
+		fmt.Sprintf("GRANT SELECT ON %s TO %s", view, role),
+	}
+}
+
+// currentTenant returns the SQL expression of the tenant of the transaction
+func currentTenant() string {
+	return fmt.Sprintf("current_setting(%s, true)", quoteLiteral(TenantSetting))
+}
+
+// lendersOf returns the SQL query of the tenants lending their cars to the tenant of the
+// SQL expression borrower under an active agreement
+func lendersOf(borrower string) string {
+	return fmt.Sprintf("SELECT lender_tenant_id FROM public.fleet_sharing_agreements"+
+		" WHERE borrower_tenant_id = %s AND terminated_at IS NULL", borrower)
+}
+
+// execInTx runs statements in a single transaction
+func execInTx(ctx context.Context, client *entgen.Client, statements []string) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for _, stmt := range statements {
+		if _, err := tx.Client().ExecContext(ctx, stmt); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to apply row-level security: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit row-level security: %w", err)
+	}
+	return nil
+}
+
+// quoteLiteral quotes s as an SQL string literal
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,27 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// NewClient creates a new Redis client from a redis:// URL
+func NewClient(redisURL string) (*goredis.Client, error) {
+	opts, err := goredis.ParseURL(redisURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redis URL: %w", err)
+	}
+
+	client := goredis.NewClient(opts)
+
+	// Ping to verify connection, but keep the client: the relay retries on its own
+	// and go-redis reconnects transparently once Redis becomes reachable
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		log.Printf("Failed to ping Redis at %s: %v", opts.Addr, err)
+	}
+
+	return client, nil
+}
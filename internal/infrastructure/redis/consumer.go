@@ -0,0 +1,241 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Consumer defaults
+const (
+	DefaultConsumerBatchSize = 10
+	DefaultConsumerBlock     = 5 * time.Second
+	DefaultConsumerMinIdle   = time.Minute
+)
+
+// StreamMessage is an outbox message read back from a stream. TenantID is the tenant the
+// event belongs to, which handlers scope their work to, e.g. through the inbox.
+type StreamMessage struct {
+	Stream        string
+	StreamID      string
+	OutboxID      string
+	TenantID      string
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	Payload       json.RawMessage
+	CreatedAt     time.Time
+	Version       int64
+}
+
+// Handler processes a single stream message. Returning an error leaves the
+// message unacknowledged so that it is redelivered once it has been idle for MinIdle.
+type Handler func(ctx context.Context, msg *StreamMessage) error
+
+// ConsumerConfig holds the settings of a Consumer
+type ConsumerConfig struct {
+	// Group is the consumer group name, shared by all instances of a service
+	Group string
+	// Name identifies this consumer within the group (e.g. hostname)
+	Name string
+	// AggregateTypes selects the streams to consume (see StreamName)
+	AggregateTypes []string
+	// BatchSize is the maximum number of entries read per stream and round trip
+	BatchSize int64
+	// Block is how long XREADGROUP waits for new entries; a negative value disables blocking
+	Block time.Duration
+	// MinIdle is how long a delivered but unacknowledged entry waits before it is reclaimed
+	MinIdle time.Duration
+}
+
+// Consumer reads outbox streams as a member of a consumer group and
+// acknowledges each entry once its handler succeeds
+type Consumer struct {
+	client  goredis.Cmdable
+	cfg     ConsumerConfig
+	handler Handler
+	streams []string
+}
+
+// NewConsumer creates a new Consumer. Zero values in cfg are replaced with defaults.
+func NewConsumer(client goredis.Cmdable, cfg ConsumerConfig, handler Handler) *Consumer {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultConsumerBatchSize
+	}
+	if cfg.Block == 0 {
+		cfg.Block = DefaultConsumerBlock
+	}
+	if cfg.MinIdle <= 0 {
+		cfg.MinIdle = DefaultConsumerMinIdle
+	}
+
+	streams := make([]string, len(cfg.AggregateTypes))
+	for i, aggregateType := range cfg.AggregateTypes {
+		streams[i] = StreamName(aggregateType)
+	}
+
+	return &Consumer{
+		client:  client,
+		cfg:     cfg,
+		handler: handler,
+		streams: streams,
+	}
+}
+
+// EnsureGroups creates the consumer group on every stream, creating the streams
+// if needed. Existing groups are left untouched.
+func (c *Consumer) EnsureGroups(ctx context.Context) error {
+	for _, stream := range c.streams {
+		err := c.client.XGroupCreateMkStream(ctx, stream, c.cfg.Group, "0").Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return fmt.Errorf("failed to create consumer group %q on stream %q: %w", c.cfg.Group, stream, err)
+		}
+	}
+	return nil
+}
+
+// Run consumes messages until ctx is cancelled
+func (c *Consumer) Run(ctx context.Context) error {
+	if err := c.EnsureGroups(ctx); err != nil {
+		return err
+	}
+
+	for {
+		if _, err := c.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("Redis consumer %s/%s failed to poll: %v", c.cfg.Group, c.cfg.Name, err)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
+
+// Poll performs one round: it first reclaims entries abandoned by other consumers,
+// then reads new entries. It returns the number of entries acknowledged.
+func (c *Consumer) Poll(ctx context.Context) (int, error) {
+	acked := 0
+
+	for _, stream := range c.streams {
+		claimed, _, err := c.client.XAutoClaim(ctx, &goredis.XAutoClaimArgs{
+			Stream:   stream,
+			Group:    c.cfg.Group,
+			Consumer: c.cfg.Name,
+			MinIdle:  c.cfg.MinIdle,
+			Start:    "0-0",
+			Count:    c.cfg.BatchSize,
+		}).Result()
+		if err != nil {
+			return acked, fmt.Errorf("failed to reclaim pending entries on %q: %w", stream, err)
+		}
+
+		n, err := c.handle(ctx, stream, claimed)
+		acked += n
+		if err != nil {
+			return acked, err
+		}
+	}
+
+	streams := make([]string, 0, len(c.streams)*2)
+	streams = append(streams, c.streams...)
+	for range c.streams {
+		streams = append(streams, ">")
+	}
+
+	results, err := c.client.XReadGroup(ctx, &goredis.XReadGroupArgs{
+		Group:    c.cfg.Group,
+		Consumer: c.cfg.Name,
+		Streams:  streams,
+		Count:    c.cfg.BatchSize,
+		Block:    c.cfg.Block,
+	}).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return acked, nil
+		}
+		return acked, fmt.Errorf("failed to read from streams: %w", err)
+	}
+
+	for _, result := range results {
+		n, err := c.handle(ctx, result.Stream, result.Messages)
+		acked += n
+		if err != nil {
+			return acked, err
+		}
+	}
+
+	return acked, nil
+}
+
+// handle runs the handler for each entry and acknowledges the successful ones
+func (c *Consumer) handle(ctx context.Context, stream string, entries []goredis.XMessage) (int, error) {
+	acked := 0
+	for _, entry := range entries {
+		msg, err := decodeStreamMessage(stream, entry)
+		if err != nil {
+			// A malformed entry will never succeed; acknowledge it so it does not block the group
+			log.Printf("Redis consumer %s/%s dropping malformed entry %s on %q: %v", c.cfg.Group, c.cfg.Name, entry.ID, stream, err)
+		} else if err := c.handler(ctx, msg); err != nil {
+			log.Printf("Redis consumer %s/%s failed to handle entry %s on %q: %v", c.cfg.Group, c.cfg.Name, entry.ID, stream, err)
+			continue
+		}
+
+		if err := c.client.XAck(ctx, stream, c.cfg.Group, entry.ID).Err(); err != nil {
+			return acked, fmt.Errorf("failed to acknowledge entry %s on %q: %w", entry.ID, stream, err)
+		}
+		acked++
+	}
+	return acked, nil
+}
+
+// decodeStreamMessage converts a raw stream entry into a StreamMessage
+func decodeStreamMessage(stream string, entry goredis.XMessage) (*StreamMessage, error) {
+	field := func(name string) string {
+		v, _ := entry.Values[name].(string)
+		return v
+	}
+
+	msg := &StreamMessage{
+		Stream:        stream,
+		StreamID:      entry.ID,
+		OutboxID:      field(FieldOutboxID),
+		TenantID:      field(FieldTenantID),
+		AggregateType: field(FieldAggregateType),
+		AggregateID:   field(FieldAggregateID),
+		EventType:     field(FieldEventType),
+		Payload:       json.RawMessage(field(FieldPayload)),
+	}
+	if msg.OutboxID == "" || msg.EventType == "" {
+		return nil, errors.New("missing outbox_id or event_type")
+	}
+
+	if createdAt := field(FieldCreatedAt); createdAt != "" {
+		t, err := time.Parse(time.RFC3339Nano, createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid created_at: %w", err)
+		}
+		msg.CreatedAt = t
+	}
+
+	if version := field(FieldVersion); version != "" {
+		v, err := strconv.ParseInt(version, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version: %w", err)
+		}
+		msg.Version = v
+	}
+
+	return msg, nil
+}
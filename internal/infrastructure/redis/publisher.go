@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/outbox"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// Stream defaults
+const (
+	// StreamPrefix is prepended to the aggregate type to build the stream key
+	StreamPrefix = "outbox"
+	// DefaultStreamMaxLen caps each stream at roughly this many entries
+	DefaultStreamMaxLen = 10000
+)
+
+// Stream entry field names
+const (
+	FieldOutboxID      = "outbox_id"
+	FieldTenantID      = "tenant_id"
+	FieldAggregateType = "aggregate_type"
+	FieldAggregateID   = "aggregate_id"
+	FieldEventType     = "event_type"
+	FieldPayload       = "payload"
+	FieldCreatedAt     = "created_at"
+	FieldVersion       = "version"
+)
+
+// StreamPublisher publishes outbox messages to Redis Streams, one stream per
+// aggregate type (e.g. "outbox:car").
+//
+// The relay delivers at least once, so the same outbox message may be added
+// twice after a crash; consumers should deduplicate on FieldOutboxID.
+type StreamPublisher struct {
+	client goredis.Cmdable
+	maxLen int64
+}
+
+var _ outbox.Publisher = (*StreamPublisher)(nil)
+
+// NewStreamPublisher creates a new StreamPublisher. A non-positive maxLen falls back to DefaultStreamMaxLen.
+func NewStreamPublisher(client goredis.Cmdable, maxLen int64) *StreamPublisher {
+	if maxLen <= 0 {
+		maxLen = DefaultStreamMaxLen
+	}
+	return &StreamPublisher{
+		client: client,
+		maxLen: maxLen,
+	}
+}
+
+// StreamName returns the stream key used for an aggregate type
+func StreamName(aggregateType string) string {
+	return StreamPrefix + ":" + aggregateType
+}
+
+// Publish appends the message to its aggregate type's stream with XADD, trimming
+// the stream to approximately maxLen entries
+func (p *StreamPublisher) Publish(ctx context.Context, msg *entity.OutboxMessage) error {
+	payload, err := json.Marshal(msg.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox payload: %w", err)
+	}
+
+	err = p.client.XAdd(ctx, &goredis.XAddArgs{
+		Stream: StreamName(msg.AggregateType),
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: []any{
+			FieldOutboxID, msg.ID,
+			FieldTenantID, msg.TenantID,
+			FieldAggregateType, msg.AggregateType,
+			FieldAggregateID, msg.AggregateID,
+			FieldEventType, msg.EventType,
+			FieldPayload, payload,
+			FieldCreatedAt, msg.CreatedAt.UTC().Format(time.RFC3339Nano),
+			FieldVersion, msg.Version,
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to add outbox message %s to stream: %w", msg.ID, err)
+	}
+
+	return nil
+}
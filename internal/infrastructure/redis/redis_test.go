@@ -0,0 +1,162 @@
+package redis_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/redis"
+)
+
+// setupRedis starts an in-process Redis stand-in and returns a client connected to it
+func setupRedis(t *testing.T) (*miniredis.Miniredis, *goredis.Client) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return server, client
+}
+
+// newOutbox creates an outbox message for testing
+func newOutbox(id string) *entity.OutboxMessage {
+	return &entity.OutboxMessage{
+		ID:            id,
+		TenantID:      "tenant-1",
+		AggregateType: "car",
+		AggregateID:   "car-123",
+		EventType:     "car_created",
+		Payload:       map[string]interface{}{"model": "Toyota Prius"},
+		CreatedAt:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Version:       1,
+	}
+}
+
+// TestStreamPublisher_Publish tests that messages are added to the aggregate type's stream
+func TestStreamPublisher_Publish(t *testing.T) {
+	t.Parallel()
+
+	_, client := setupRedis(t)
+	publisher := redis.NewStreamPublisher(client, 0)
+	ctx := context.Background()
+
+	err := publisher.Publish(ctx, newOutbox("msg-1"))
+	require.NoError(t, err)
+
+	entries, err := client.XRange(ctx, "outbox:car", "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "msg-1", entries[0].Values[redis.FieldOutboxID])
+	require.Equal(t, "tenant-1", entries[0].Values[redis.FieldTenantID])
+	require.Equal(t, "car_created", entries[0].Values[redis.FieldEventType])
+	require.JSONEq(t, `{"model":"Toyota Prius"}`, entries[0].Values[redis.FieldPayload].(string))
+}
+
+// TestStreamPublisher_Publish_MaxLen tests that streams are capped
+func TestStreamPublisher_Publish_MaxLen(t *testing.T) {
+	t.Parallel()
+
+	_, client := setupRedis(t)
+	publisher := redis.NewStreamPublisher(client, 3)
+	ctx := context.Background()
+
+	for _, id := range []string{"msg-1", "msg-2", "msg-3", "msg-4", "msg-5"} {
+		require.NoError(t, publisher.Publish(ctx, newOutbox(id)))
+	}
+
+	length, err := client.XLen(ctx, "outbox:car").Result()
+	require.NoError(t, err)
+	require.LessOrEqual(t, length, int64(3))
+}
+
+// TestConsumer_Poll tests that published messages are handled and acknowledged
+func TestConsumer_Poll(t *testing.T) {
+	t.Parallel()
+
+	_, client := setupRedis(t)
+	publisher := redis.NewStreamPublisher(client, 0)
+	ctx := context.Background()
+
+	var handled []*redis.StreamMessage
+	consumer := redis.NewConsumer(client, redis.ConsumerConfig{
+		Group:          "search-indexer",
+		Name:           "consumer-1",
+		AggregateTypes: []string{"car"},
+		Block:          -1,
+	}, func(ctx context.Context, msg *redis.StreamMessage) error {
+		handled = append(handled, msg)
+		return nil
+	})
+	require.NoError(t, consumer.EnsureGroups(ctx))
+	require.NoError(t, consumer.EnsureGroups(ctx)) // idempotent
+
+	require.NoError(t, publisher.Publish(ctx, newOutbox("msg-1")))
+
+	acked, err := consumer.Poll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, acked)
+	require.Len(t, handled, 1)
+	require.Equal(t, "msg-1", handled[0].OutboxID)
+	require.Equal(t, "tenant-1", handled[0].TenantID)
+	require.Equal(t, "car-123", handled[0].AggregateID)
+	require.Equal(t, int64(1), handled[0].Version)
+	require.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), handled[0].CreatedAt)
+
+	var payload map[string]string
+	require.NoError(t, json.Unmarshal(handled[0].Payload, &payload))
+	require.Equal(t, "Toyota Prius", payload["model"])
+
+	pending, err := client.XPending(ctx, "outbox:car", "search-indexer").Result()
+	require.NoError(t, err)
+	require.Zero(t, pending.Count)
+}
+
+// TestConsumer_Poll_Redelivery tests that a failed message stays pending and is reclaimed after MinIdle
+func TestConsumer_Poll_Redelivery(t *testing.T) {
+	t.Parallel()
+
+	_, client := setupRedis(t)
+	publisher := redis.NewStreamPublisher(client, 0)
+	ctx := context.Background()
+
+	attempts := 0
+	consumer := redis.NewConsumer(client, redis.ConsumerConfig{
+		Group:          "search-indexer",
+		Name:           "consumer-1",
+		AggregateTypes: []string{"car"},
+		Block:          -1,
+		MinIdle:        10 * time.Millisecond,
+	}, func(ctx context.Context, msg *redis.StreamMessage) error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+	require.NoError(t, consumer.EnsureGroups(ctx))
+	require.NoError(t, publisher.Publish(ctx, newOutbox("msg-1")))
+
+	// First delivery fails and stays pending
+	acked, err := consumer.Poll(ctx)
+	require.NoError(t, err)
+	require.Zero(t, acked)
+
+	pending, err := client.XPending(ctx, "outbox:car", "search-indexer").Result()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), pending.Count)
+
+	// Once idle long enough, the entry is reclaimed and acknowledged
+	time.Sleep(20 * time.Millisecond)
+	acked, err = consumer.Poll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, acked)
+	require.Equal(t, 2, attempts)
+}
@@ -0,0 +1,199 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// Headers sent with every webhook request
+const (
+	HeaderID        = "Webhook-Id"
+	HeaderTimestamp = "Webhook-Timestamp"
+	HeaderSignature = "Webhook-Signature"
+	HeaderEventType = "Webhook-Event-Type"
+)
+
+// signatureVersion prefixes signatures so that the scheme can evolve
+const signatureVersion = "v1"
+
+// DefaultTimeout is the time an endpoint has to respond to a request
+const DefaultTimeout = 10 * time.Second
+
+// DefaultTolerance is the maximum age of a timestamp accepted by Verify
+const DefaultTolerance = 5 * time.Minute
+
+// Envelope is the JSON body posted to webhook endpoints
+type Envelope struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	CreatedAt time.Time              `json:"created_at"`
+	TenantID  string                 `json:"tenant_id"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// HTTPSender posts signed webhook requests over HTTP
+type HTTPSender struct {
+	client *http.Client
+}
+
+// SenderOption configures an HTTPSender
+type SenderOption func(*senderOptions)
+
+type senderOptions struct {
+	allowed []netip.Prefix
+}
+
+// AllowNetworks lets the sender reach addresses of prefixes it otherwise refuses, such as
+// a receiver on the loopback interface in tests
+func AllowNetworks(prefixes ...netip.Prefix) SenderOption {
+	return func(o *senderOptions) {
+		o.allowed = append(o.allowed, prefixes...)
+	}
+}
+
+// NewHTTPSender creates a new HTTP sender. A zero timeout uses DefaultTimeout.
+// Connections to loopback, private, link-local and unspecified addresses are refused once
+// the host name is resolved, so that a URL validated when it was registered cannot be
+// pointed into the platform's network later by changing what its name resolves to.
+func NewHTTPSender(timeout time.Duration, opts ...SenderOption) *HTTPSender {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	var o senderOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return checkAddress(address, o.allowed)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// A proxy would be dialed instead of the endpoint and hide its address from the check
+	transport.Proxy = nil
+
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			// Redirects are not followed so that a signed payload only goes to the registered URL
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// checkAddress checks the resolved address a connection is about to be made to
+func checkAddress(address string, allowed []netip.Prefix) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse webhook address: %w", err)
+	}
+	addr := addrPort.Addr().Unmap()
+	for _, prefix := range allowed {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+	if err := entity.ValidateWebhookAddr(addr); err != nil {
+		return fmt.Errorf("refused to connect to %s: %w", addr, err)
+	}
+	return nil
+}
+
+// Send posts the delivery's event to the endpoint and returns the response status code
+func (s *HTTPSender) Send(ctx context.Context, endpoint *entity.WebhookEndpoint, delivery *entity.WebhookDelivery) (int, error) {
+	// The event ID stays the same across retries so that receivers can deduplicate
+	body, err := json.Marshal(Envelope{
+		ID:        delivery.EventID,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.CreatedAt,
+		TenantID:  delivery.TenantID,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, delivery.EventID)
+	req.Header.Set(HeaderEventType, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(endpoint.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain a bounded amount of the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header value for a request body sent at timestamp.
+// The signature is the hex-encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with the secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	return signatureVersion + "=" + hex.EncodeToString(mac(secret, timestamp, body))
+}
+
+// Verify checks a signature header against the body and rejects timestamps older than
+// tolerance. It is meant for receivers and tests.
+func Verify(secret, signature, timestamp string, body []byte, now time.Time, tolerance time.Duration) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.New("invalid webhook timestamp")
+	}
+	if age := now.Sub(time.Unix(ts, 0)); age > tolerance || age < -tolerance {
+		return errors.New("webhook timestamp outside of tolerance")
+	}
+
+	encoded, ok := strings.CutPrefix(signature, signatureVersion+"=")
+	if !ok {
+		return errors.New("unsupported webhook signature version")
+	}
+	got, err := hex.DecodeString(encoded)
+	if err != nil {
+		return errors.New("invalid webhook signature")
+	}
+	if !hmac.Equal(got, mac(secret, ts, body)) {
+		return errors.New("webhook signature mismatch")
+	}
+	return nil
+}
+
+func mac(secret string, timestamp int64, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
@@ -0,0 +1,149 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/infrastructure/webhook"
+)
+
+// allowLoopback lets senders reach the test servers, which listen on the loopback interface
+var allowLoopback = webhook.AllowNetworks(netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("::1/128"))
+
+// TestHTTPSender_Send tests that the request carries the envelope and a verifiable signature
+func TestHTTPSender_Send(t *testing.T) {
+	t.Parallel()
+
+	const secret = "whsec_test"
+
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	// The sender itself does not enforce HTTPS; that is validated when the endpoint is created
+	endpoint := &entity.WebhookEndpoint{ID: "endpoint-1", TenantID: "tenant-1", URL: server.URL, Secret: secret}
+	delivery := &entity.WebhookDelivery{
+		ID:        "delivery-1",
+		TenantID:  "tenant-1",
+		EventID:   "event-1",
+		EventType: "car_created",
+		Payload:   map[string]interface{}{"model": "Toyota Prius"},
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	status, err := webhook.NewHTTPSender(0, allowLoopback).Send(context.Background(), endpoint, delivery)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, status)
+
+	require.Equal(t, "event-1", received.Header.Get(webhook.HeaderID))
+	require.Equal(t, "car_created", received.Header.Get(webhook.HeaderEventType))
+	require.NoError(t, webhook.Verify(
+		secret,
+		received.Header.Get(webhook.HeaderSignature),
+		received.Header.Get(webhook.HeaderTimestamp),
+		body,
+		time.Now(),
+		webhook.DefaultTolerance,
+	))
+
+	var envelope webhook.Envelope
+	require.NoError(t, json.Unmarshal(body, &envelope))
+	require.Equal(t, "event-1", envelope.ID)
+	require.Equal(t, "tenant-1", envelope.TenantID)
+	require.Equal(t, "Toyota Prius", envelope.Data["model"])
+}
+
+// TestHTTPSender_Send_ErrorStatus tests that non-2xx statuses are returned to the caller
+func TestHTTPSender_Send_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	endpoint := &entity.WebhookEndpoint{URL: server.URL, Secret: "whsec_test"}
+	delivery := &entity.WebhookDelivery{EventID: "event-1", EventType: "car_created"}
+
+	status, err := webhook.NewHTTPSender(0, allowLoopback).Send(context.Background(), endpoint, delivery)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, status)
+}
+
+// TestHTTPSender_Send_RefusesNonPublicAddresses tests that the sender does not connect to
+// non-public addresses, whatever name they were reached through
+func TestHTTPSender_Send_RefusesNonPublicAddresses(t *testing.T) {
+	t.Parallel()
+
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	// localhost resolves to the loopback interface, as a name rebound to it would
+	port := server.URL[strings.LastIndex(server.URL, ":"):]
+	for _, url := range []string{server.URL, "http://localhost" + port} {
+		endpoint := &entity.WebhookEndpoint{URL: url, Secret: "whsec_test"}
+		delivery := &entity.WebhookDelivery{EventID: "event-1", EventType: "car_created"}
+
+		_, err := webhook.NewHTTPSender(0).Send(context.Background(), endpoint, delivery)
+		require.ErrorIs(t, err, entity.ErrWebhookAddressNotAllowed, url)
+	}
+	require.False(t, called)
+}
+
+// TestVerify tests signature verification failures
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"event-1"}`)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	signature := webhook.Sign("whsec_test", now.Unix(), body)
+
+	tests := map[string]struct {
+		secret    string
+		signature string
+		timestamp string
+		body      []byte
+		wantErr   bool
+	}{
+		"ok":                       {secret: "whsec_test", signature: signature, timestamp: timestamp, body: body},
+		"ng (wrong secret)":        {secret: "whsec_other", signature: signature, timestamp: timestamp, body: body, wantErr: true},
+		"ng (tampered body)":       {secret: "whsec_test", signature: signature, timestamp: timestamp, body: []byte(`{"id":"event-2"}`), wantErr: true},
+		"ng (stale timestamp)":     {secret: "whsec_test", signature: signature, timestamp: strconv.FormatInt(now.Add(-time.Hour).Unix(), 10), body: body, wantErr: true},
+		"ng (unsupported version)": {secret: "whsec_test", signature: "v0=abc", timestamp: timestamp, body: body, wantErr: true},
+		"ng (malformed timestamp)": {secret: "whsec_test", signature: signature, timestamp: "yesterday", body: body, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := webhook.Verify(tt.secret, tt.signature, tt.timestamp, tt.body, now, webhook.DefaultTolerance)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Package authctx carries the authenticated caller of a request through a context.
+package authctx
+
+import (
+	"context"
+	"slices"
+)
+
+// Role is a role granted to a user by the identity provider
+type Role string
+
+// Role values
+const (
+	// RolePlatformAdmin operates the platform itself and belongs to no tenant
+	RolePlatformAdmin Role = "platform_admin"
+	RoleTenantAdmin   Role = "tenant_admin"
+	RoleAgent         Role = "agent"
+	RoleRenter        Role = "renter"
+)
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	// TenantID is the tenant the credentials belong to; empty for platform admins
+	TenantID string
+	// Subject identifies the caller within the tenant, e.g. the ID of an API key
+	Subject string
+	// Scopes are the permissions granted to the credentials
+	Scopes []string
+	// Roles are the roles of a user; API keys have none
+	Roles []Role
+	// RenterID is the renter a user with the renter role acts as
+	RenterID string
+}
+
+// HasRole reports whether p has role
+func (p *Principal) HasRole(role Role) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// HasScope reports whether p was granted scope
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx that carries p
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal carried by ctx, if any
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,17 @@
+// Package tenantctx carries the tenant of a request through a context.
+package tenantctx
+
+import "context"
+
+type tenantIDKey struct{}
+
+// WithTenantID returns a copy of ctx that carries tenantID
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+// TenantID returns the tenant carried by ctx, if any
+func TenantID(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(tenantIDKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
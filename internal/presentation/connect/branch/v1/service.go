@@ -0,0 +1,187 @@
+package branch
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	branchv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/branch/v1"
+	tenantsettingsv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	tenantsettings "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/tenantsettings/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// BranchServiceHandler implements the Connect service for branch operations
+type BranchServiceHandler struct {
+	branchService service.BranchService
+}
+
+// NewBranchServiceHandler creates a new BranchServiceHandler
+func NewBranchServiceHandler(branchService service.BranchService) *BranchServiceHandler {
+	return &BranchServiceHandler{
+		branchService: branchService,
+	}
+}
+
+// CreateBranch adds a branch to the tenant
+func (h *BranchServiceHandler) CreateBranch(ctx context.Context, req *connect.Request[branchv1.CreateBranchRequest]) (*connect.Response[branchv1.CreateBranchResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	spec, err := fromProtoSpec(req.Msg.GetName(), req.Msg.GetAddress(), req.Msg.GetLatitude(), req.Msg.GetLongitude(), req.Msg.GetOpeningHours())
+	if err != nil {
+		return nil, err
+	}
+	input := input.CreateBranch{
+		TenantID: tenantID,
+		Spec:     spec,
+	}
+
+	// Call application service
+	branch, err := h.branchService.Create(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&branchv1.CreateBranchResponse{
+		Branch: toProtoBranch(branch),
+	}), nil
+}
+
+// UpdateBranch replaces the attributes of a branch of the tenant
+func (h *BranchServiceHandler) UpdateBranch(ctx context.Context, req *connect.Request[branchv1.UpdateBranchRequest]) (*connect.Response[branchv1.UpdateBranchResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	spec, err := fromProtoSpec(req.Msg.GetName(), req.Msg.GetAddress(), req.Msg.GetLatitude(), req.Msg.GetLongitude(), req.Msg.GetOpeningHours())
+	if err != nil {
+		return nil, err
+	}
+	input := input.UpdateBranch{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+		Spec:     spec,
+	}
+
+	// Call application service
+	branch, err := h.branchService.Update(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&branchv1.UpdateBranchResponse{
+		Branch: toProtoBranch(branch),
+	}), nil
+}
+
+// ListBranches retrieves the branches of the tenant
+func (h *BranchServiceHandler) ListBranches(ctx context.Context, _ *connect.Request[branchv1.ListBranchesRequest]) (*connect.Response[branchv1.ListBranchesResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ListBranches{
+		TenantID: tenantID,
+	}
+
+	// Call application service
+	branches, err := h.branchService.List(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoBranches := make([]*branchv1.Branch, len(branches))
+	for i, branch := range branches {
+		protoBranches[i] = toProtoBranch(branch)
+	}
+
+	return connect.NewResponse(&branchv1.ListBranchesResponse{
+		Branches: protoBranches,
+	}), nil
+}
+
+// FindNearestBranches retrieves the branches of the tenant closest to a position
+func (h *BranchServiceHandler) FindNearestBranches(ctx context.Context, req *connect.Request[branchv1.FindNearestBranchesRequest]) (*connect.Response[branchv1.FindNearestBranchesResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.FindNearestBranches{
+		TenantID:  tenantID,
+		Latitude:  req.Msg.GetLatitude(),
+		Longitude: req.Msg.GetLongitude(),
+		Limit:     req.Msg.GetLimit(),
+	}
+
+	// Call application service
+	nearest, err := h.branchService.FindNearest(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoBranches := make([]*branchv1.NearestBranch, len(nearest))
+	for i, n := range nearest {
+		protoBranches[i] = &branchv1.NearestBranch{
+			Branch:     toProtoBranch(n.Branch),
+			DistanceKm: n.DistanceKm,
+		}
+	}
+
+	return connect.NewResponse(&branchv1.FindNearestBranchesResponse{
+		Branches: protoBranches,
+	}), nil
+}
+
+// toConnectError converts an application error to a Connect error with a matching code
+func toConnectError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, entity.ErrInvalidBranch), errors.Is(err, value.ErrInvalidCoordinates):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return err
+}
+
+// fromProtoSpec converts the attributes of a branch from their Connect representation
+func fromProtoSpec(name string, address *branchv1.Address, latitude, longitude float64, hours []*tenantsettingsv1.OpeningHours) (input.BranchSpec, error) {
+	openingHours, err := tenantsettings.FromProtoOpeningHours(hours)
+	if err != nil {
+		return input.BranchSpec{}, err
+	}
+
+	return input.BranchSpec{
+		Name:         name,
+		Street:       address.GetStreet(),
+		City:         address.GetCity(),
+		Region:       address.GetRegion(),
+		PostalCode:   address.GetPostalCode(),
+		Country:      address.GetCountry(),
+		Latitude:     latitude,
+		Longitude:    longitude,
+		OpeningHours: openingHours,
+	}, nil
+}
+
+// toProtoBranch converts a branch to its Connect representation
+func toProtoBranch(branch *entity.Branch) *branchv1.Branch {
+	return &branchv1.Branch{
+		Id:       branch.ID,
+		TenantId: branch.TenantID,
+		Name:     branch.Spec.Name,
+		Address: &branchv1.Address{
+			Street:     branch.Spec.Address.Street,
+			City:       branch.Spec.Address.City,
+			Region:     branch.Spec.Address.Region,
+			PostalCode: branch.Spec.Address.PostalCode,
+			Country:    branch.Spec.Address.Country,
+		},
+		Latitude:     branch.Spec.Coordinates.Latitude(),
+		Longitude:    branch.Spec.Coordinates.Longitude(),
+		OpeningHours: tenantsettings.ToProtoOpeningHours(branch.Spec.OpeningHours),
+		CreatedAt:    timestamppb.New(branch.CreatedAt),
+		UpdatedAt:    timestamppb.New(branch.UpdatedAt),
+	}
+}
@@ -2,48 +2,56 @@ package car
 
 import (
 	"context"
+	"errors"
 
 	"connectrpc.com/connect"
 	carv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1"
 	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
 	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/value"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CarServiceHandler implements the Connect service for car operations
 type CarServiceHandler struct {
-	carService service.CarService
+	carService      service.CarService
+	carModelService service.CarModelService
 }
 
 // NewCarServiceHandler creates a new CarServiceHandler
-func NewCarServiceHandler(carService service.CarService) *CarServiceHandler {
+func NewCarServiceHandler(carService service.CarService, carModelService service.CarModelService) *CarServiceHandler {
 	return &CarServiceHandler{
-		carService: carService,
+		carService:      carService,
+		carModelService: carModelService,
 	}
 }
 
 // CreateCar creates a new car
 func (h *CarServiceHandler) CreateCar(ctx context.Context, req *connect.Request[carv1.CreateCarRequest]) (*connect.Response[carv1.CreateCarResponse], error) {
-	// Convert Connect request to application DTO
+	// Convert Connect request to application DTO; the tenant is the one resolved by the
+	// tenant interceptor, which has already rejected a mismatching tenant_id
+	tenantID, _ := tenantctx.TenantID(ctx)
 	input := input.CreateCar{
-		TenantID: req.Msg.GetTenantId(),
-		Model:    req.Msg.GetModel(),
+		TenantID:            tenantID,
+		CarModelID:          req.Msg.GetCarModelId(),
+		VIN:                 req.Msg.GetVin(),
+		LicensePlate:        req.Msg.GetLicensePlate(),
+		LicensePlateCountry: req.Msg.GetLicensePlateCountry(),
+		HomeBranchID:        req.Msg.GetHomeBranchId(),
 	}
 
 	// Call application service
 	carOutput, err := h.carService.Create(ctx, input)
 	if err != nil {
-		return nil, err
+		return nil, toConnectError(err)
 	}
 
 	// Convert DTO to Connect response
 	response := &carv1.CreateCarResponse{
-		Car: &carv1.Car{
-			Id:        carOutput.ID,
-			TenantId:  carOutput.TenantID,
-			Model:     carOutput.Model,
-			CreatedAt: timestamppb.New(carOutput.CreatedAt),
-		},
+		Car: toProtoCar(carOutput),
 	}
 
 	return connect.NewResponse(response), nil
@@ -52,25 +60,21 @@ func (h *CarServiceHandler) CreateCar(ctx context.Context, req *connect.Request[
 // GetCar retrieves a car by ID
 func (h *CarServiceHandler) GetCar(ctx context.Context, req *connect.Request[carv1.GetCarRequest]) (*connect.Response[carv1.GetCarResponse], error) {
 	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
 	input := input.GetCarByID{
-		ID: req.Msg.GetId(),
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
 	}
 
 	// Call application service
 	carOutput, err := h.carService.GetByID(ctx, input)
 	if err != nil {
-		return nil, err
+		return nil, toConnectError(err)
 	}
 
 	// Convert DTO to Connect response
 	response := &carv1.GetCarResponse{
-		Car: &carv1.Car{
-			Id:        carOutput.ID,
-			TenantId:  carOutput.TenantID,
-			Model:     carOutput.Model,
-			CreatedAt: timestamppb.New(carOutput.CreatedAt),
-			UpdatedAt: timestamppb.New(carOutput.UpdatedAt),
-		},
+		Car: toProtoCar(carOutput),
 	}
 
 	return connect.NewResponse(response), nil
@@ -79,8 +83,9 @@ func (h *CarServiceHandler) GetCar(ctx context.Context, req *connect.Request[car
 // ListCars retrieves a list of cars
 func (h *CarServiceHandler) ListCars(ctx context.Context, req *connect.Request[carv1.ListCarsRequest]) (*connect.Response[carv1.ListCarsResponse], error) {
 	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
 	input := input.ListCars{
-		TenantID:  req.Msg.GetTenantId(),
+		TenantID:  tenantID,
 		PageSize:  req.Msg.GetPageSize(),
 		PageToken: req.Msg.GetPageToken(),
 	}
@@ -88,15 +93,23 @@ func (h *CarServiceHandler) ListCars(ctx context.Context, req *connect.Request[c
 	// Call application service
 	listOutput, err := h.carService.List(ctx, input)
 	if err != nil {
-		return nil, err
+		return nil, toConnectError(err)
 	}
 
 	// Convert DTOs to Connect response
 	grpcCars := make([]*carv1.Car, len(listOutput.Cars))
 	for i, carSummary := range listOutput.Cars {
 		grpcCars[i] = &carv1.Car{
-			Id:    carSummary.ID,
-			Model: carSummary.Model,
+			Id:                  carSummary.ID,
+			CarModelId:          carSummary.ModelID,
+			Vin:                 carSummary.VIN,
+			LicensePlate:        carSummary.LicensePlate,
+			LicensePlateCountry: carSummary.LicensePlateCountry,
+			HomeBranchId:        carSummary.HomeBranchID,
+			CurrentBranchId:     carSummary.CurrentBranchID,
+		}
+		if carSummary.Model != nil {
+			grpcCars[i].Model = toProtoCarModel(carSummary.Model)
 		}
 	}
 
@@ -107,3 +120,263 @@ func (h *CarServiceHandler) ListCars(ctx context.Context, req *connect.Request[c
 
 	return connect.NewResponse(response), nil
 }
+
+// AssignCarBranches sets the branch a car belongs to and the one it is at
+func (h *CarServiceHandler) AssignCarBranches(ctx context.Context, req *connect.Request[carv1.AssignCarBranchesRequest]) (*connect.Response[carv1.AssignCarBranchesResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.AssignCarBranches{
+		TenantID:        tenantID,
+		ID:              req.Msg.GetId(),
+		HomeBranchID:    req.Msg.GetHomeBranchId(),
+		CurrentBranchID: req.Msg.GetCurrentBranchId(),
+	}
+
+	// Call application service
+	car, err := h.carService.AssignBranches(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&carv1.AssignCarBranchesResponse{
+		Car: toProtoCar(car),
+	}), nil
+}
+
+// CreateCarModel adds a model to the tenant's catalog
+func (h *CarServiceHandler) CreateCarModel(ctx context.Context, req *connect.Request[carv1.CreateCarModelRequest]) (*connect.Response[carv1.CreateCarModelResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.CreateCarModel{
+		TenantID: tenantID,
+		Spec: input.CarModelSpec{
+			Make:         req.Msg.GetMake(),
+			Name:         req.Msg.GetName(),
+			Category:     fromProtoCategory(req.Msg.GetCategory()),
+			Seats:        int(req.Msg.GetSeats()),
+			Transmission: fromProtoTransmission(req.Msg.GetTransmission()),
+			FuelType:     fromProtoFuelType(req.Msg.GetFuelType()),
+		},
+	}
+
+	// Call application service
+	model, err := h.carModelService.Create(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&carv1.CreateCarModelResponse{
+		Model: toProtoCarModel(model),
+	}), nil
+}
+
+// UpdateCarModel replaces the attributes of a model in the tenant's catalog
+func (h *CarServiceHandler) UpdateCarModel(ctx context.Context, req *connect.Request[carv1.UpdateCarModelRequest]) (*connect.Response[carv1.UpdateCarModelResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.UpdateCarModel{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+		Spec: input.CarModelSpec{
+			Make:         req.Msg.GetMake(),
+			Name:         req.Msg.GetName(),
+			Category:     fromProtoCategory(req.Msg.GetCategory()),
+			Seats:        int(req.Msg.GetSeats()),
+			Transmission: fromProtoTransmission(req.Msg.GetTransmission()),
+			FuelType:     fromProtoFuelType(req.Msg.GetFuelType()),
+		},
+	}
+
+	// Call application service
+	model, err := h.carModelService.Update(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&carv1.UpdateCarModelResponse{
+		Model: toProtoCarModel(model),
+	}), nil
+}
+
+// ListCarModels retrieves the tenant's catalog of car models
+func (h *CarServiceHandler) ListCarModels(ctx context.Context, _ *connect.Request[carv1.ListCarModelsRequest]) (*connect.Response[carv1.ListCarModelsResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ListCarModels{
+		TenantID: tenantID,
+	}
+
+	// Call application service
+	models, err := h.carModelService.List(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoModels := make([]*carv1.CarModel, len(models))
+	for i, model := range models {
+		protoModels[i] = toProtoCarModel(model)
+	}
+
+	return connect.NewResponse(&carv1.ListCarModelsResponse{
+		Models: protoModels,
+	}), nil
+}
+
+// toConnectError converts an application error to a Connect error with a matching code
+func toConnectError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, entity.ErrInvalidCarModel), errors.Is(err, value.ErrInvalidVIN),
+		errors.Is(err, value.ErrInvalidLicensePlate):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return err
+}
+
+// toProtoCar converts a car to its Connect representation
+func toProtoCar(car *entity.Car) *carv1.Car {
+	pb := &carv1.Car{
+		Id:                  car.ID,
+		TenantId:            car.TenantID,
+		CarModelId:          car.ModelID,
+		Vin:                 car.VINString(),
+		LicensePlate:        car.LicensePlateString(),
+		CreatedAt:           timestamppb.New(car.CreatedAt),
+		UpdatedAt:           timestamppb.New(car.UpdatedAt),
+		LicensePlateCountry: car.LicensePlateCountry(),
+		HomeBranchId:        car.HomeBranchID,
+		CurrentBranchId:     car.CurrentBranchID,
+	}
+	if model := car.Model(); model != nil {
+		pb.Model = toProtoCarModel(model)
+	}
+	return pb
+}
+
+// toProtoCarModel converts a car model to its Connect representation
+func toProtoCarModel(model *entity.CarModel) *carv1.CarModel {
+	return &carv1.CarModel{
+		Id:           model.ID,
+		TenantId:     model.TenantID,
+		Make:         model.Spec.Make,
+		Name:         model.Spec.Name,
+		Category:     toProtoCategory(model.Spec.Category),
+		Seats:        int32(model.Spec.Seats), // #nosec G115
+		Transmission: toProtoTransmission(model.Spec.Transmission),
+		FuelType:     toProtoFuelType(model.Spec.FuelType),
+		CreatedAt:    timestamppb.New(model.CreatedAt),
+		UpdatedAt:    timestamppb.New(model.UpdatedAt),
+	}
+}
+
+// toProtoCategory converts a car category to its Connect representation
+func toProtoCategory(category entity.CarCategory) carv1.CarCategory {
+	switch category {
+	case entity.CarCategoryEconomy:
+		return carv1.CarCategory_CAR_CATEGORY_ECONOMY
+	case entity.CarCategoryCompact:
+		return carv1.CarCategory_CAR_CATEGORY_COMPACT
+	case entity.CarCategoryMidsize:
+		return carv1.CarCategory_CAR_CATEGORY_MIDSIZE
+	case entity.CarCategoryFullsize:
+		return carv1.CarCategory_CAR_CATEGORY_FULLSIZE
+	case entity.CarCategorySUV:
+		return carv1.CarCategory_CAR_CATEGORY_SUV
+	case entity.CarCategoryVan:
+		return carv1.CarCategory_CAR_CATEGORY_VAN
+	case entity.CarCategoryLuxury:
+		return carv1.CarCategory_CAR_CATEGORY_LUXURY
+	case entity.CarCategoryUnspecified:
+		return carv1.CarCategory_CAR_CATEGORY_UNSPECIFIED
+	}
+	return carv1.CarCategory_CAR_CATEGORY_UNSPECIFIED
+}
+
+// fromProtoCategory converts a car category from its Connect representation; unspecified is
+// rejected by the domain
+func fromProtoCategory(category carv1.CarCategory) string {
+	switch category {
+	case carv1.CarCategory_CAR_CATEGORY_ECONOMY:
+		return entity.CarCategoryEconomy.String()
+	case carv1.CarCategory_CAR_CATEGORY_COMPACT:
+		return entity.CarCategoryCompact.String()
+	case carv1.CarCategory_CAR_CATEGORY_MIDSIZE:
+		return entity.CarCategoryMidsize.String()
+	case carv1.CarCategory_CAR_CATEGORY_FULLSIZE:
+		return entity.CarCategoryFullsize.String()
+	case carv1.CarCategory_CAR_CATEGORY_SUV:
+		return entity.CarCategorySUV.String()
+	case carv1.CarCategory_CAR_CATEGORY_VAN:
+		return entity.CarCategoryVan.String()
+	case carv1.CarCategory_CAR_CATEGORY_LUXURY:
+		return entity.CarCategoryLuxury.String()
+	case carv1.CarCategory_CAR_CATEGORY_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
+// toProtoTransmission converts a transmission to its Connect representation
+func toProtoTransmission(transmission entity.Transmission) carv1.Transmission {
+	switch transmission {
+	case entity.TransmissionAutomatic:
+		return carv1.Transmission_TRANSMISSION_AUTOMATIC
+	case entity.TransmissionManual:
+		return carv1.Transmission_TRANSMISSION_MANUAL
+	case entity.TransmissionUnspecified:
+		return carv1.Transmission_TRANSMISSION_UNSPECIFIED
+	}
+	return carv1.Transmission_TRANSMISSION_UNSPECIFIED
+}
+
+// fromProtoTransmission converts a transmission from its Connect representation;
+// unspecified is rejected by the domain
+func fromProtoTransmission(transmission carv1.Transmission) string {
+	switch transmission {
+	case carv1.Transmission_TRANSMISSION_AUTOMATIC:
+		return entity.TransmissionAutomatic.String()
+	case carv1.Transmission_TRANSMISSION_MANUAL:
+		return entity.TransmissionManual.String()
+	case carv1.Transmission_TRANSMISSION_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
+// toProtoFuelType converts a fuel type to its Connect representation
+func toProtoFuelType(fuelType entity.FuelType) carv1.FuelType {
+	switch fuelType {
+	case entity.FuelTypeGasoline:
+		return carv1.FuelType_FUEL_TYPE_GASOLINE
+	case entity.FuelTypeDiesel:
+		return carv1.FuelType_FUEL_TYPE_DIESEL
+	case entity.FuelTypeHybrid:
+		return carv1.FuelType_FUEL_TYPE_HYBRID
+	case entity.FuelTypeElectric:
+		return carv1.FuelType_FUEL_TYPE_ELECTRIC
+	case entity.FuelTypeUnspecified:
+		return carv1.FuelType_FUEL_TYPE_UNSPECIFIED
+	}
+	return carv1.FuelType_FUEL_TYPE_UNSPECIFIED
+}
+
+// fromProtoFuelType converts a fuel type from its Connect representation; unspecified is
+// rejected by the domain
+func fromProtoFuelType(fuelType carv1.FuelType) string {
+	switch fuelType {
+	case carv1.FuelType_FUEL_TYPE_GASOLINE:
+		return entity.FuelTypeGasoline.String()
+	case carv1.FuelType_FUEL_TYPE_DIESEL:
+		return entity.FuelTypeDiesel.String()
+	case carv1.FuelType_FUEL_TYPE_HYBRID:
+		return entity.FuelTypeHybrid.String()
+	case carv1.FuelType_FUEL_TYPE_ELECTRIC:
+		return entity.FuelTypeElectric.String()
+	case carv1.FuelType_FUEL_TYPE_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
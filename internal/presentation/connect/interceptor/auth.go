@@ -0,0 +1,81 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/auth"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+)
+
+// Authenticator authenticates one kind of bearer token
+type Authenticator interface {
+	// Authenticate returns the principal of token. ok is false when token is not of the
+	// kind the authenticator handles, so that the next authenticator is tried.
+	Authenticate(ctx context.Context, token string) (principal *authctx.Principal, ok bool, err error)
+}
+
+// NewAuthInterceptor returns an interceptor that authenticates the "Authorization: Bearer"
+// credentials of every request and stores the principal in the context with authctx.
+// Requests without credentials go through unauthenticated; the tenant interceptor decides
+// whether they may proceed.
+func NewAuthInterceptor(authenticators ...Authenticator) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			header := req.Header().Get("Authorization")
+			if header == "" {
+				return next(ctx, req)
+			}
+
+			scheme, token, found := strings.Cut(header, " ")
+			if !found || !strings.EqualFold(scheme, "Bearer") || token == "" {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("authorization must be a bearer token"))
+			}
+
+			principal, err := authenticate(ctx, authenticators, token)
+			if err != nil {
+				return nil, err
+			}
+			return next(authctx.WithPrincipal(ctx, principal), req)
+		}
+	}
+}
+
+// authenticate returns the principal of token from the first authenticator that handles it
+func authenticate(ctx context.Context, authenticators []Authenticator, token string) (*authctx.Principal, error) {
+	for _, authenticator := range authenticators {
+		principal, ok, err := authenticator.Authenticate(ctx, token)
+		if !ok {
+			continue
+		}
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to authenticate: %w", err))
+		}
+		return principal, nil
+	}
+	return nil, connect.NewError(connect.CodeUnauthenticated, auth.ErrInvalidCredentials)
+}
+
+// CredentialsResolver resolves the tenant of the principal authenticated by the auth
+// interceptor, which must run before the tenant interceptor
+type CredentialsResolver struct{}
+
+// ResolveTenant returns the tenant of the authenticated principal, if it belongs to one
+func (CredentialsResolver) ResolveTenant(ctx context.Context, _ TenantRequest) (string, bool, error) {
+	principal, ok := authctx.FromContext(ctx)
+	if !ok || principal.TenantID == "" {
+		return "", false, nil
+	}
+	return principal.TenantID, true, nil
+}
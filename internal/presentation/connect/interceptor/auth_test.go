@@ -0,0 +1,127 @@
+package interceptor_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/auth"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+)
+
+// tokenAuthenticator handles tokens starting with "test_"
+type tokenAuthenticator map[string]error
+
+func (a tokenAuthenticator) Authenticate(_ context.Context, token string) (*authctx.Principal, bool, error) {
+	if !strings.HasPrefix(token, "test_") {
+		return nil, false, nil
+	}
+	if err, ok := a[token]; ok {
+		return nil, true, err
+	}
+	return &authctx.Principal{TenantID: "tenant-" + strings.TrimPrefix(token, "test_"), Subject: token}, true, nil
+}
+
+// newAuthServer returns the car service behind the auth and tenant interceptors
+func newAuthServer(authenticator interceptor.Authenticator, resolvers ...interceptor.TenantResolver) (http.Handler, *carHandler) {
+	handler := &carHandler{}
+	_, h := carv1connect.NewCarServiceHandler(handler, connect.WithInterceptors(
+		interceptor.NewAuthInterceptor(authenticator),
+		interceptor.NewTenantInterceptor(append([]interceptor.TenantResolver{interceptor.CredentialsResolver{}}, resolvers...)...),
+	))
+	return interceptor.WithHost(h), handler
+}
+
+// callWithAuth sends a Connect unary request with an Authorization header
+func callWithAuth(server http.Handler, authorization string) int {
+	req := httptest.NewRequest(http.MethodPost, "http://localhost"+carv1connect.CarServiceGetCarProcedure, strings.NewReader(`{"id":"car-1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+// TestAuthInterceptor tests that bearer credentials authenticate the request and resolve its tenant
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		authorization string
+		wantStatus    int
+		wantTenantID  string
+	}{
+		"valid token": {
+			authorization: "Bearer test_acme",
+			wantStatus:    http.StatusOK,
+			wantTenantID:  "tenant-acme",
+		},
+		"lowercase scheme": {
+			authorization: "bearer test_acme",
+			wantStatus:    http.StatusOK,
+			wantTenantID:  "tenant-acme",
+		},
+		"invalid token": {
+			authorization: "Bearer test_revoked",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		"unrecognised token": {
+			authorization: "Bearer other",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		"basic scheme": {
+			authorization: "Basic dXNlcjpwYXNz",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		"empty token": {
+			authorization: "Bearer ",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		"authenticator error": {
+			authorization: "Bearer test_broken",
+			wantStatus:    http.StatusInternalServerError,
+		},
+		"no credentials": {
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			server, handler := newAuthServer(tokenAuthenticator{
+				"test_revoked": auth.ErrInvalidCredentials,
+				"test_broken":  assert.AnError,
+			})
+
+			// Execute
+			code := callWithAuth(server, tt.authorization)
+			assert.Equal(t, tt.wantStatus, code)
+			assert.Equal(t, tt.wantTenantID, handler.tenantID)
+		})
+	}
+}
+
+// TestAuthInterceptor_Conflict tests that credentials of another tenant than the host are rejected
+func TestAuthInterceptor_Conflict(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	server, handler := newAuthServer(tokenAuthenticator{}, staticResolver("tenant-other"))
+
+	// Execute
+	code := callWithAuth(server, "Bearer test_acme")
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Empty(t, handler.tenantID)
+}
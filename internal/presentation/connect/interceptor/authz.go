@@ -0,0 +1,91 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+)
+
+// Rule lists the callers allowed to call a procedure
+type Rule struct {
+	// Roles are the user roles allowed to call the procedure
+	Roles []authctx.Role
+	// Scope is the API key scope allowed to call the procedure. API keys cannot call
+	// procedures without one.
+	Scope string
+	// RenterOwned restricts renters to requests about themselves: the renter_id field of
+	// the request must be the renter the caller acts as
+	RenterOwned bool
+}
+
+// Policy maps each procedure, e.g. "/car.v1.CarService/CreateCar", to the rule that
+// authorizes it. Procedures without a rule are denied.
+type Policy map[string]Rule
+
+// renterScoped is implemented by request messages with a renter_id field
+type renterScoped interface {
+	GetRenterId() string
+}
+
+// Authorize returns an error unless principal may send msg to procedure
+func (p Policy) Authorize(principal *authctx.Principal, procedure string, msg any) error {
+	rule, ok := p[procedure]
+	if !ok {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("no access rule for %s", procedure))
+	}
+
+	if rule.Scope != "" && principal.HasScope(rule.Scope) {
+		return nil
+	}
+
+	// Any role other than renter grants access to every request of the procedure
+	for _, role := range rule.Roles {
+		if role != authctx.RoleRenter && principal.HasRole(role) {
+			return nil
+		}
+	}
+
+	if slices.Contains(rule.Roles, authctx.RoleRenter) && principal.HasRole(authctx.RoleRenter) {
+		if !rule.RenterOwned {
+			return nil
+		}
+		if msg, ok := msg.(renterScoped); ok && msg.GetRenterId() == principal.RenterID {
+			return nil
+		}
+		return connect.NewError(connect.CodePermissionDenied, errors.New("renters can only access their own rentals"))
+	}
+
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("not allowed to call %s", procedure))
+}
+
+// NewAuthorizationInterceptor returns an interceptor that checks the principal stored by
+// the auth interceptor against policy. Requests without credentials are rejected, unless
+// allowAnonymous is set, in which case they are not checked at all; that is meant for
+// local development only.
+func NewAuthorizationInterceptor(policy Policy, allowAnonymous bool) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			principal, ok := authctx.FromContext(ctx)
+			if !ok {
+				if allowAnonymous {
+					return next(ctx, req)
+				}
+				return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("credentials are required"))
+			}
+
+			if err := policy.Authorize(principal, req.Spec().Procedure, req.Any()); err != nil {
+				return nil, err
+			}
+			return next(ctx, req)
+		}
+	}
+}
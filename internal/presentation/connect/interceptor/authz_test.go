@@ -0,0 +1,122 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+)
+
+const (
+	createRental = "/rental.v1.RentalService/CreateRental"
+	createCar    = "/car.v1.CarService/CreateCar"
+)
+
+// rentalRequest is a request message about a renter
+type rentalRequest struct {
+	renterID string
+}
+
+func (r *rentalRequest) GetRenterId() string { return r.renterID }
+
+// renterAuthenticator authenticates every token as a renter of tenant-acme
+type renterAuthenticator struct{}
+
+func (renterAuthenticator) Authenticate(_ context.Context, token string) (*authctx.Principal, bool, error) {
+	return &authctx.Principal{TenantID: "tenant-acme", Subject: token, Roles: []authctx.Role{authctx.RoleRenter}, RenterID: "renter-1"}, true, nil
+}
+
+// testPolicy is the policy the tests authorize against
+var testPolicy = interceptor.Policy{
+	createCar: {Roles: []authctx.Role{authctx.RoleTenantAdmin, authctx.RoleAgent}, Scope: "cars:write"},
+	createRental: {
+		Roles:       []authctx.Role{authctx.RoleAgent, authctx.RoleRenter},
+		RenterOwned: true,
+	},
+}
+
+// TestPolicy_Authorize tests which principals may call which procedures
+func TestPolicy_Authorize(t *testing.T) {
+	t.Parallel()
+
+	admin := &authctx.Principal{Roles: []authctx.Role{authctx.RoleTenantAdmin}}
+	agent := &authctx.Principal{Roles: []authctx.Role{authctx.RoleAgent}}
+	renter := &authctx.Principal{Roles: []authctx.Role{authctx.RoleRenter}, RenterID: "renter-1"}
+	writeKey := &authctx.Principal{Scopes: []string{"cars:write"}}
+	readKey := &authctx.Principal{Scopes: []string{"cars:read"}}
+
+	tests := map[string]struct {
+		principal *authctx.Principal
+		procedure string
+		msg       any
+		wantOK    bool
+	}{
+		"admin creates car":             {principal: admin, procedure: createCar, wantOK: true},
+		"renter creates car":            {principal: renter, procedure: createCar},
+		"key with scope creates car":    {principal: writeKey, procedure: createCar, wantOK: true},
+		"key without scope creates car": {principal: readKey, procedure: createCar},
+		"agent books for any renter":    {principal: agent, procedure: createRental, msg: &rentalRequest{renterID: "renter-2"}, wantOK: true},
+		"renter books for themselves":   {principal: renter, procedure: createRental, msg: &rentalRequest{renterID: "renter-1"}, wantOK: true},
+		"renter books for another":      {principal: renter, procedure: createRental, msg: &rentalRequest{renterID: "renter-2"}},
+		"renter omits renter":           {principal: renter, procedure: createRental, msg: &rentalRequest{}},
+		"admin not listed":              {principal: admin, procedure: createRental, msg: &rentalRequest{renterID: "renter-1"}},
+		"procedure without rule":        {principal: admin, procedure: "/car.v1.CarService/DeleteCar"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testPolicy.Authorize(tt.principal, tt.procedure, tt.msg)
+			if tt.wantOK {
+				assert.NoError(t, err)
+				return
+			}
+			var connectErr *connect.Error
+			if assert.True(t, errors.As(err, &connectErr)) {
+				assert.Equal(t, connect.CodePermissionDenied, connectErr.Code())
+			}
+		})
+	}
+}
+
+// TestAuthorizationInterceptor tests that requests are authorized after authentication
+func TestAuthorizationInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		authorization  string
+		allowAnonymous bool
+		wantStatus     int
+	}{
+		"allowed":            {authorization: "Bearer test_acme", wantStatus: http.StatusOK},
+		"anonymous rejected": {wantStatus: http.StatusUnauthorized},
+		"anonymous allowed":  {allowAnonymous: true, wantStatus: http.StatusOK},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup: GetCar is open to renters, which test tokens are
+			handler := &carHandler{}
+			policy := interceptor.Policy{carv1connect.CarServiceGetCarProcedure: {Roles: []authctx.Role{authctx.RoleRenter}}}
+			_, h := carv1connect.NewCarServiceHandler(handler, connect.WithInterceptors(
+				interceptor.NewAuthInterceptor(renterAuthenticator{}),
+				interceptor.NewAuthorizationInterceptor(policy, tt.allowAnonymous),
+				interceptor.NewTenantInterceptor(interceptor.CredentialsResolver{}, staticResolver("tenant-acme")),
+			))
+
+			// Execute
+			code := callWithAuth(interceptor.WithHost(h), tt.authorization)
+			assert.Equal(t, tt.wantStatus, code)
+		})
+	}
+}
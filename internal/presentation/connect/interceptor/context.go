@@ -0,0 +1,24 @@
+package interceptor
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+)
+
+// NewContextInterceptor returns an interceptor that prepares the context of every request
+// with decorators, e.g. to give each request its own caches
+func NewContextInterceptor(decorators ...func(context.Context) context.Context) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			for _, decorate := range decorators {
+				ctx = decorate(ctx)
+			}
+			return next(ctx, req)
+		}
+	}
+}
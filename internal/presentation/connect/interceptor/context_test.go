@@ -0,0 +1,32 @@
+package interceptor_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+)
+
+// TestContextInterceptor tests that every request is handled with a decorated context
+func TestContextInterceptor(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	handler := &carHandler{}
+	_, h := carv1connect.NewCarServiceHandler(handler, connect.WithInterceptors(
+		interceptor.NewContextInterceptor(func(ctx context.Context) context.Context {
+			return tenantctx.WithTenantID(ctx, "tenant-a")
+		}),
+	))
+
+	// Execute
+	code := call(h, "localhost", carv1connect.CarServiceGetCarProcedure, `{"id":"car-1"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "tenant-a", handler.tenantID)
+}
@@ -0,0 +1,32 @@
+package interceptor
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// CallCounter counts the API calls of tenants. Count is called on every call, so it must
+// not block.
+type CallCounter interface {
+	Count(tenantID string)
+}
+
+// NewMeteringInterceptor returns an interceptor that counts the calls made on behalf of a
+// tenant, so that they can be billed. Calls without a tenant, such as the platform
+// operator's, are not counted. Calls rejected by the interceptors before it are not
+// counted either, so it should run last.
+func NewMeteringInterceptor(counter CallCounter) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !req.Spec().IsClient {
+				if tenantID, ok := tenantctx.TenantID(ctx); ok {
+					counter.Count(tenantID)
+				}
+			}
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package interceptor_test
+
+import (
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+)
+
+// callCounter records the calls it counts per tenant
+type callCounter map[string]int
+
+func (c callCounter) Count(tenantID string) {
+	c[tenantID]++
+}
+
+// TestMeteringInterceptor tests that calls are counted against their tenant
+func TestMeteringInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		interceptors []connect.Interceptor
+		want         callCounter
+	}{
+		"tenant call": {
+			interceptors: []connect.Interceptor{interceptor.NewTenantInterceptor(staticResolver("tenant-a"))},
+			want:         callCounter{"tenant-a": 2},
+		},
+		"call without tenant": {
+			want: callCounter{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			counter := callCounter{}
+			interceptors := append(tt.interceptors, interceptor.NewMeteringInterceptor(counter))
+			_, h := carv1connect.NewCarServiceHandler(&carHandler{}, connect.WithInterceptors(interceptors...))
+			server := interceptor.WithHost(h)
+
+			// Execute
+			assert.Equal(t, http.StatusOK, call(server, "localhost", carv1connect.CarServiceGetCarProcedure, `{"id":"car-1"}`))
+			assert.Equal(t, http.StatusOK, call(server, "localhost", carv1connect.CarServiceCreateCarProcedure, `{"carModelId":"model-1"}`))
+
+			assert.Equal(t, tt.want, counter)
+		})
+	}
+}
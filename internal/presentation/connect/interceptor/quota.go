@@ -0,0 +1,65 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+)
+
+// QuotaErrorDomain is the domain of the ErrorInfo details of quota errors
+const QuotaErrorDomain = "go-arch-patterns"
+
+// NewQuotaInterceptor returns an interceptor that turns the errors of creates that would take
+// a tenant over the limits of its plan into ResourceExhausted errors. The errors carry a
+// QuotaFailure and an ErrorInfo detail with the resource, its limit and its usage, so that
+// clients can tell the user which limit was reached.
+func NewQuotaInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			res, err := next(ctx, req)
+			if req.Spec().IsClient {
+				return res, err
+			}
+
+			var quotaErr *entity.QuotaExceededError
+			if !errors.As(err, &quotaErr) {
+				return res, err
+			}
+			return nil, toQuotaError(quotaErr)
+		}
+	}
+}
+
+// toQuotaError converts a quota error to a ResourceExhausted error with its details
+func toQuotaError(quotaErr *entity.QuotaExceededError) *connect.Error {
+	connectErr := connect.NewError(connect.CodeResourceExhausted, quotaErr)
+
+	failure := &errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{
+			Subject:     quotaErr.Resource.String(),
+			Description: fmt.Sprintf("the plan allows %d %s", quotaErr.Limit, quotaErr.Resource),
+		}},
+	}
+	info := &errdetails.ErrorInfo{
+		Reason: "QUOTA_EXCEEDED",
+		Domain: QuotaErrorDomain,
+		Metadata: map[string]string{
+			"resource": quotaErr.Resource.String(),
+			"limit":    strconv.Itoa(quotaErr.Limit),
+			"used":     strconv.Itoa(quotaErr.Used),
+		},
+	}
+	for _, msg := range []proto.Message{failure, info} {
+		if detail, err := connect.NewErrorDetail(msg); err == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr
+}
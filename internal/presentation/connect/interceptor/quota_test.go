@@ -0,0 +1,71 @@
+package interceptor_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	carv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+)
+
+// overQuotaCarHandler is a car service whose tenant has used up its cars
+type overQuotaCarHandler struct {
+	carv1connect.UnimplementedCarServiceHandler
+}
+
+func (overQuotaCarHandler) CreateCar(context.Context, *connect.Request[carv1.CreateCarRequest]) (*connect.Response[carv1.CreateCarResponse], error) {
+	quotaErr := &entity.QuotaExceededError{Resource: entity.ResourceCars, Limit: 10, Used: 10}
+	return nil, fmt.Errorf("failed to create car: %w", quotaErr)
+}
+
+// TestQuotaInterceptor tests that quota errors reach clients as ResourceExhausted with their details
+func TestQuotaInterceptor(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	path, h := carv1connect.NewCarServiceHandler(overQuotaCarHandler{}, connect.WithInterceptors(
+		interceptor.NewQuotaInterceptor(),
+	))
+	mux := http.NewServeMux()
+	mux.Handle(path, h)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := carv1connect.NewCarServiceClient(server.Client(), server.URL)
+
+	// Execute
+	_, err := client.CreateCar(context.Background(), connect.NewRequest(&carv1.CreateCarRequest{CarModelId: "model-1"}))
+
+	// Assert
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, connect.CodeResourceExhausted, connectErr.Code())
+
+	var failure *errdetails.QuotaFailure
+	var info *errdetails.ErrorInfo
+	for _, detail := range connectErr.Details() {
+		msg, err := detail.Value()
+		require.NoError(t, err)
+		switch msg := msg.(type) {
+		case *errdetails.QuotaFailure:
+			failure = msg
+		case *errdetails.ErrorInfo:
+			info = msg
+		}
+	}
+	require.NotNil(t, failure)
+	require.Len(t, failure.GetViolations(), 1)
+	assert.Equal(t, "cars", failure.GetViolations()[0].GetSubject())
+	require.NotNil(t, info)
+	assert.Equal(t, "QUOTA_EXCEEDED", info.GetReason())
+	assert.Equal(t, map[string]string{"resource": "cars", "limit": "10", "used": "10"}, info.GetMetadata())
+}
@@ -0,0 +1,24 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// SkipServices returns an interceptor that runs interceptor for every procedure except
+// those of services, e.g. platform services that act for no tenant
+func SkipServices(interceptor connect.UnaryInterceptorFunc, services ...string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		intercepted := interceptor(next)
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			for _, service := range services {
+				if strings.HasPrefix(req.Spec().Procedure, "/"+service+"/") {
+					return next(ctx, req)
+				}
+			}
+			return intercepted(ctx, req)
+		}
+	}
+}
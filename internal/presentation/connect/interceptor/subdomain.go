@@ -0,0 +1,64 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+)
+
+// SubdomainResolver resolves the tenant from the subdomain of the Host, matched against
+// Tenant.Code: acme.example.com belongs to the tenant with code "acme"
+type SubdomainResolver struct {
+	tenantRepo repository.TenantRepository
+	baseDomain string
+}
+
+// NewSubdomainResolver creates a new SubdomainResolver for hosts under baseDomain. An empty
+// baseDomain disables it.
+func NewSubdomainResolver(tenantRepo repository.TenantRepository, baseDomain string) *SubdomainResolver {
+	return &SubdomainResolver{
+		tenantRepo: tenantRepo,
+		baseDomain: strings.ToLower(strings.Trim(baseDomain, ".")),
+	}
+}
+
+// ResolveTenant looks up the tenant whose code is the subdomain of req.Host. Hosts outside
+// the base domain are not recognised, and unknown codes are rejected.
+func (r *SubdomainResolver) ResolveTenant(ctx context.Context, req TenantRequest) (string, bool, error) {
+	code, ok := r.subdomain(req.Host)
+	if !ok {
+		return "", false, nil
+	}
+
+	tenant, err := r.tenantRepo.GetByCode(ctx, code)
+	if errors.Is(err, repository.ErrNotFound) {
+		return "", false, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("unknown tenant %q", code))
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return tenant.ID, true, nil
+}
+
+// subdomain returns the single label in front of the base domain
+func (r *SubdomainResolver) subdomain(host string) (string, bool) {
+	if r.baseDomain == "" {
+		return "", false
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	code, found := strings.CutSuffix(host, "."+r.baseDomain)
+	if !found || code == "" || strings.Contains(code, ".") {
+		return "", false
+	}
+	return code, true
+}
@@ -0,0 +1,39 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// NewSuspensionInterceptor returns an interceptor that rejects every procedure with side
+// effects called for a suspended tenant or a tenant pending deletion. Procedures marked
+// with the NO_SIDE_EFFECTS idempotency level in their proto definition stay available, so
+// that these tenants can still read their data. It must run after the tenant interceptor.
+func NewSuspensionInterceptor(tenantRepo repository.TenantRepository) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient || req.Spec().IdempotencyLevel == connect.IdempotencyNoSideEffects {
+				return next(ctx, req)
+			}
+
+			tenantID, ok := tenantctx.TenantID(ctx)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			tenant, err := tenantRepo.GetByID(ctx, tenantID)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get tenant: %w", err))
+			}
+			if err := tenant.CheckWritable(); err != nil {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+			return next(ctx, req)
+		}
+	}
+}
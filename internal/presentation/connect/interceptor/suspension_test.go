@@ -0,0 +1,102 @@
+package interceptor_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+)
+
+// newSuspensionServer returns the car service of tenant-a, in the given status, behind the
+// suspension interceptor
+func newSuspensionServer(t *testing.T, status entity.TenantStatus) http.Handler {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	tenant := entity.NewTenant("acme", time.Now()).WithID("tenant-a")
+	switch status {
+	case entity.TenantStatusSuspended:
+		require.NoError(t, tenant.Suspend(time.Now()))
+	case entity.TenantStatusPendingDeletion:
+		require.NoError(t, tenant.ScheduleDeletion(time.Hour, time.Now()))
+	}
+	mockTenantRepo.EXPECT().GetByID(gomock.Any(), "tenant-a").Return(tenant, nil).AnyTimes()
+
+	_, h := carv1connect.NewCarServiceHandler(&carHandler{}, connect.WithInterceptors(
+		interceptor.NewTenantInterceptor(staticResolver("tenant-a")),
+		interceptor.NewSuspensionInterceptor(mockTenantRepo),
+	))
+	return interceptor.WithHost(h)
+}
+
+// TestSuspensionInterceptor tests that suspended tenants and tenants pending deletion can
+// read but not change their data
+func TestSuspensionInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status     entity.TenantStatus
+		procedure  string
+		body       string
+		wantStatus int
+	}{
+		"active tenant creates":           {status: entity.TenantStatusActive, procedure: carv1connect.CarServiceCreateCarProcedure, body: `{"carModelId":"model-1"}`, wantStatus: http.StatusOK},
+		"suspended tenant creates":        {status: entity.TenantStatusSuspended, procedure: carv1connect.CarServiceCreateCarProcedure, body: `{"carModelId":"model-1"}`, wantStatus: http.StatusBadRequest},
+		"suspended tenant reads":          {status: entity.TenantStatusSuspended, procedure: carv1connect.CarServiceGetCarProcedure, body: `{"id":"car-1"}`, wantStatus: http.StatusOK},
+		"pending deletion tenant creates": {status: entity.TenantStatusPendingDeletion, procedure: carv1connect.CarServiceCreateCarProcedure, body: `{"carModelId":"model-1"}`, wantStatus: http.StatusBadRequest},
+		"pending deletion tenant reads":   {status: entity.TenantStatusPendingDeletion, procedure: carv1connect.CarServiceGetCarProcedure, body: `{"id":"car-1"}`, wantStatus: http.StatusOK},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			server := newSuspensionServer(t, tt.status)
+
+			// Execute
+			code := call(server, "localhost", tt.procedure, tt.body)
+			assert.Equal(t, tt.wantStatus, code)
+		})
+	}
+}
+
+// TestSkipServices tests that skipped services bypass the wrapped interceptor
+func TestSkipServices(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		services   []string
+		wantStatus int
+	}{
+		"skipped":        {services: []string{carv1connect.CarServiceName}, wantStatus: http.StatusOK},
+		"other service":  {services: []string{"tenant.v1.TenantService"}, wantStatus: http.StatusUnauthorized},
+		"service prefix": {services: []string{"car.v1.Car"}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup: the tenant interceptor rejects every request without resolvers
+			handler := &carHandler{}
+			_, h := carv1connect.NewCarServiceHandler(handler, connect.WithInterceptors(
+				interceptor.SkipServices(interceptor.NewTenantInterceptor(), tt.services...),
+			))
+
+			// Execute
+			code := call(interceptor.WithHost(h), "localhost", carv1connect.CarServiceGetCarProcedure, `{"id":"car-1"}`)
+			assert.Equal(t, tt.wantStatus, code)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Package interceptor provides the Connect interceptors shared by every service.
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+)
+
+// TenantRequest is the part of a request that tenant resolvers look at
+type TenantRequest struct {
+	Procedure string
+	Header    http.Header
+	Host      string
+}
+
+// TenantResolver resolves the tenant a request acts for
+type TenantResolver interface {
+	// ResolveTenant returns the ID of the tenant of req. ok is false when req carries
+	// nothing the resolver recognises, so that the other resolvers decide.
+	ResolveTenant(ctx context.Context, req TenantRequest) (tenantID string, ok bool, err error)
+}
+
+// TenantResolverFunc adapts a function to TenantResolver
+type TenantResolverFunc func(ctx context.Context, req TenantRequest) (string, bool, error)
+
+// ResolveTenant calls f
+func (f TenantResolverFunc) ResolveTenant(ctx context.Context, req TenantRequest) (string, bool, error) {
+	return f(ctx, req)
+}
+
+// tenantScoped is implemented by request messages with a tenant_id field
+type tenantScoped interface {
+	GetTenantId() string
+}
+
+// NewTenantInterceptor returns an interceptor that resolves the tenant of every request
+// and stores it in the context with tenantctx. Requests are rejected when no resolver
+// recognises them, when resolvers disagree, or when their tenant_id field names another
+// tenant.
+func NewTenantInterceptor(resolvers ...TenantResolver) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			tenantID, err := resolveTenant(ctx, resolvers, TenantRequest{
+				Procedure: req.Spec().Procedure,
+				Header:    req.Header(),
+				Host:      hostFromContext(ctx),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if msg, ok := req.Any().(tenantScoped); ok && msg.GetTenantId() != "" && msg.GetTenantId() != tenantID {
+				return nil, connect.NewError(connect.CodePermissionDenied, errors.New("tenant_id does not match the authenticated tenant"))
+			}
+
+			return next(tenantctx.WithTenantID(ctx, tenantID), req)
+		}
+	}
+}
+
+// resolveTenant asks every resolver for the tenant of req and requires those that
+// recognise it to agree
+func resolveTenant(ctx context.Context, resolvers []TenantResolver, req TenantRequest) (string, error) {
+	var tenantID string
+	for _, resolver := range resolvers {
+		resolved, ok, err := resolver.ResolveTenant(ctx, req)
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				return "", err
+			}
+			return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to resolve tenant: %w", err))
+		}
+		if !ok {
+			continue
+		}
+		if tenantID != "" && tenantID != resolved {
+			return "", connect.NewError(connect.CodePermissionDenied, errors.New("credentials and host belong to different tenants"))
+		}
+		tenantID = resolved
+	}
+
+	if tenantID == "" {
+		return "", connect.NewError(connect.CodeUnauthenticated, errors.New("tenant could not be resolved"))
+	}
+	return tenantID, nil
+}
+
+type hostKey struct{}
+
+// WithHost stores the Host of every request in its context. Connect does not expose it
+// to interceptors, and net/http removes it from the request headers.
+func WithHost(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), hostKey{}, r.Host)))
+	})
+}
+
+// hostFromContext returns the Host stored by WithHost
+func hostFromContext(ctx context.Context) string {
+	host, _ := ctx.Value(hostKey{}).(string)
+	return host
+}
@@ -0,0 +1,196 @@
+package interceptor_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	carv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	mock_repository "github.com/jp-ryuji/go-arch-patterns/internal/domain/repository/mock"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+)
+
+// carHandler records the tenant its handlers are called with
+type carHandler struct {
+	carv1connect.UnimplementedCarServiceHandler
+	tenantID string
+}
+
+func (h *carHandler) CreateCar(ctx context.Context, _ *connect.Request[carv1.CreateCarRequest]) (*connect.Response[carv1.CreateCarResponse], error) {
+	h.tenantID, _ = tenantctx.TenantID(ctx)
+	return connect.NewResponse(&carv1.CreateCarResponse{}), nil
+}
+
+func (h *carHandler) GetCar(ctx context.Context, _ *connect.Request[carv1.GetCarRequest]) (*connect.Response[carv1.GetCarResponse], error) {
+	h.tenantID, _ = tenantctx.TenantID(ctx)
+	return connect.NewResponse(&carv1.GetCarResponse{}), nil
+}
+
+// newServer returns the car service behind the tenant interceptor
+func newServer(resolvers ...interceptor.TenantResolver) (http.Handler, *carHandler) {
+	handler := &carHandler{}
+	_, h := carv1connect.NewCarServiceHandler(handler, connect.WithInterceptors(interceptor.NewTenantInterceptor(resolvers...)))
+	return interceptor.WithHost(h), handler
+}
+
+// call sends a Connect unary request with a JSON body to host
+func call(server http.Handler, host, procedure, body string) int {
+	req := httptest.NewRequest(http.MethodPost, "http://"+host+procedure, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+// staticResolver resolves every request to tenantID
+func staticResolver(tenantID string) interceptor.TenantResolver {
+	return interceptor.TenantResolverFunc(func(context.Context, interceptor.TenantRequest) (string, bool, error) {
+		return tenantID, true, nil
+	})
+}
+
+// TestTenantInterceptor_Subdomain tests that the tenant is resolved from the Host subdomain
+func TestTenantInterceptor_Subdomain(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	server, handler := newServer(interceptor.NewSubdomainResolver(mockTenantRepo, "example.com"))
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByCode(gomock.Any(), "acme").Return(&entity.Tenant{ID: "tenant-acme", Code: "acme"}, nil)
+
+	// Execute
+	code := call(server, "ACME.example.com:8081", carv1connect.CarServiceGetCarProcedure, `{"id":"car-1"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "tenant-acme", handler.tenantID)
+}
+
+// TestTenantInterceptor_UnknownSubdomain tests that a subdomain without a tenant is rejected
+func TestTenantInterceptor_UnknownSubdomain(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	server, _ := newServer(interceptor.NewSubdomainResolver(mockTenantRepo, "example.com"))
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByCode(gomock.Any(), "nobody").Return(nil, repository.ErrNotFound)
+
+	// Execute
+	code := call(server, "nobody.example.com", carv1connect.CarServiceGetCarProcedure, `{"id":"car-1"}`)
+	assert.Equal(t, http.StatusUnauthorized, code)
+}
+
+// TestTenantInterceptor_Unresolved tests that requests without a recognisable tenant are rejected
+func TestTenantInterceptor_Unresolved(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"base domain":      "example.com",
+		"nested subdomain": "a.b.example.com",
+		"other domain":     "acme.example.org",
+		"suffix lookalike": "acme.badexample.com",
+		"ip address":       "127.0.0.1:8081",
+		"localhost":        "localhost:8081",
+		"trailing dot":     "example.com.",
+	}
+
+	for name, host := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup; the repository must not be queried
+			ctrl := gomock.NewController(t)
+			mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+			server, _ := newServer(interceptor.NewSubdomainResolver(mockTenantRepo, "example.com"))
+
+			// Execute
+			code := call(server, host, carv1connect.CarServiceGetCarProcedure, `{"id":"car-1"}`)
+			assert.Equal(t, http.StatusUnauthorized, code)
+		})
+	}
+}
+
+// TestTenantInterceptor_BodyTenant tests that a tenant_id in the body must match the resolved tenant
+func TestTenantInterceptor_BodyTenant(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body       string
+		wantStatus int
+	}{
+		"matching tenant": {
+			body:       `{"tenant_id":"tenant-a","model":"Prius"}`,
+			wantStatus: http.StatusOK,
+		},
+		"omitted tenant": {
+			body:       `{"carModelId":"model-1"}`,
+			wantStatus: http.StatusOK,
+		},
+		"other tenant": {
+			body:       `{"tenant_id":"tenant-b","model":"Prius"}`,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			server, handler := newServer(staticResolver("tenant-a"))
+
+			// Execute
+			code := call(server, "localhost", carv1connect.CarServiceCreateCarProcedure, tt.body)
+			assert.Equal(t, tt.wantStatus, code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, "tenant-a", handler.tenantID)
+			} else {
+				assert.Empty(t, handler.tenantID)
+			}
+		})
+	}
+}
+
+// TestTenantInterceptor_Conflict tests that resolvers naming different tenants reject the request
+func TestTenantInterceptor_Conflict(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	server, handler := newServer(staticResolver("tenant-a"), staticResolver("tenant-b"))
+
+	// Execute
+	code := call(server, "localhost", carv1connect.CarServiceGetCarProcedure, `{"id":"car-1"}`)
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Empty(t, handler.tenantID)
+}
+
+// TestTenantInterceptor_ResolverError tests that unexpected resolver errors are reported as internal
+func TestTenantInterceptor_ResolverError(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockTenantRepo := mock_repository.NewMockTenantRepository(ctrl)
+	server, _ := newServer(interceptor.NewSubdomainResolver(mockTenantRepo, "example.com"))
+
+	// Set up expectations
+	mockTenantRepo.EXPECT().GetByCode(gomock.Any(), "acme").Return(nil, assert.AnError)
+
+	// Execute
+	code := call(server, "acme.example.com", carv1connect.CarServiceGetCarProcedure, `{"id":"car-1"}`)
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
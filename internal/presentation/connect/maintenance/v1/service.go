@@ -0,0 +1,232 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	maintenancev1 "github.com/jp-ryuji/go-arch-patterns/api/generated/maintenance/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaintenanceServiceHandler implements the Connect service for maintenance windows
+type MaintenanceServiceHandler struct {
+	maintenanceService service.MaintenanceWindowService
+}
+
+// NewMaintenanceServiceHandler creates a new MaintenanceServiceHandler
+func NewMaintenanceServiceHandler(maintenanceService service.MaintenanceWindowService) *MaintenanceServiceHandler {
+	return &MaintenanceServiceHandler{
+		maintenanceService: maintenanceService,
+	}
+}
+
+// ScheduleMaintenanceWindow takes a car of the tenant out of service over a period
+func (h *MaintenanceServiceHandler) ScheduleMaintenanceWindow(ctx context.Context, req *connect.Request[maintenancev1.ScheduleMaintenanceWindowRequest]) (*connect.Response[maintenancev1.ScheduleMaintenanceWindowResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ScheduleMaintenanceWindow{
+		TenantID: tenantID,
+		CarID:    req.Msg.GetCarId(),
+		Type:     fromProtoType(req.Msg.GetType()),
+		Reason:   req.Msg.GetReason(),
+	}
+	if req.Msg.StartsAt != nil {
+		input.StartsAt = req.Msg.GetStartsAt().AsTime()
+	}
+	if req.Msg.EndsAt != nil {
+		input.EndsAt = req.Msg.GetEndsAt().AsTime()
+	}
+
+	// Call application service
+	window, err := h.maintenanceService.Schedule(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&maintenancev1.ScheduleMaintenanceWindowResponse{
+		MaintenanceWindow: toProtoWindow(window),
+	}), nil
+}
+
+// CompleteMaintenanceWindow records that the work of a maintenance window of the tenant is done
+func (h *MaintenanceServiceHandler) CompleteMaintenanceWindow(ctx context.Context, req *connect.Request[maintenancev1.CompleteMaintenanceWindowRequest]) (*connect.Response[maintenancev1.CompleteMaintenanceWindowResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.CompleteMaintenanceWindow{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+	}
+
+	// Call application service
+	window, err := h.maintenanceService.Complete(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&maintenancev1.CompleteMaintenanceWindowResponse{
+		MaintenanceWindow: toProtoWindow(window),
+	}), nil
+}
+
+// CancelMaintenanceWindow calls off a maintenance window of the tenant
+func (h *MaintenanceServiceHandler) CancelMaintenanceWindow(ctx context.Context, req *connect.Request[maintenancev1.CancelMaintenanceWindowRequest]) (*connect.Response[maintenancev1.CancelMaintenanceWindowResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.CancelMaintenanceWindow{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+	}
+
+	// Call application service
+	window, err := h.maintenanceService.Cancel(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&maintenancev1.CancelMaintenanceWindowResponse{
+		MaintenanceWindow: toProtoWindow(window),
+	}), nil
+}
+
+// ListMaintenanceWindows retrieves the maintenance windows of a car of the tenant
+func (h *MaintenanceServiceHandler) ListMaintenanceWindows(ctx context.Context, req *connect.Request[maintenancev1.ListMaintenanceWindowsRequest]) (*connect.Response[maintenancev1.ListMaintenanceWindowsResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ListMaintenanceWindows{
+		TenantID: tenantID,
+		CarID:    req.Msg.GetCarId(),
+	}
+
+	// Call application service
+	windows, err := h.maintenanceService.List(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoWindows := make([]*maintenancev1.MaintenanceWindow, len(windows))
+	for i, window := range windows {
+		protoWindows[i] = toProtoWindow(window)
+	}
+
+	return connect.NewResponse(&maintenancev1.ListMaintenanceWindowsResponse{
+		MaintenanceWindows: protoWindows,
+	}), nil
+}
+
+// toConnectError converts an application error to a Connect error with a matching code
+func toConnectError(err error) error {
+	var conflict *entity.MaintenanceConflictError
+	switch {
+	case errors.As(err, &conflict):
+		return toConflictError(err, conflict)
+	case errors.Is(err, repository.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, entity.ErrInvalidMaintenanceWindow):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, entity.ErrMaintenanceNotScheduled), errors.Is(err, entity.ErrMaintenanceNotStarted):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return err
+}
+
+// toConflictError converts a maintenance conflict to a FailedPrecondition error with a
+// PreconditionFailure detail listing the rentals in the way, so that agents can move them
+// to another car
+func toConflictError(err error, conflict *entity.MaintenanceConflictError) *connect.Error {
+	connectErr := connect.NewError(connect.CodeFailedPrecondition, err)
+
+	failure := &errdetails.PreconditionFailure{
+		Violations: make([]*errdetails.PreconditionFailure_Violation, len(conflict.RentalIDs)),
+	}
+	for i, rentalID := range conflict.RentalIDs {
+		failure.Violations[i] = &errdetails.PreconditionFailure_Violation{
+			Type:        "RENTAL_OVERLAP",
+			Subject:     rentalID,
+			Description: "the rental overlaps the maintenance window",
+		}
+	}
+	if detail, err := connect.NewErrorDetail(failure); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// toProtoWindow converts a maintenance window to its Connect representation
+func toProtoWindow(window *entity.MaintenanceWindow) *maintenancev1.MaintenanceWindow {
+	pb := &maintenancev1.MaintenanceWindow{
+		Id:        window.ID,
+		TenantId:  window.TenantID,
+		CarId:     window.CarID,
+		Type:      toProtoType(window.Type),
+		Reason:    window.Reason,
+		StartsAt:  timestamppb.New(window.StartsAt),
+		EndsAt:    timestamppb.New(window.EndsAt),
+		Status:    toProtoStatus(window.Status),
+		CreatedAt: timestamppb.New(window.CreatedAt),
+		UpdatedAt: timestamppb.New(window.UpdatedAt),
+	}
+	if window.CompletedAt.Valid {
+		pb.CompletedAt = timestamppb.New(window.CompletedAt.Time)
+	}
+	if window.CanceledAt.Valid {
+		pb.CanceledAt = timestamppb.New(window.CanceledAt.Time)
+	}
+	return pb
+}
+
+// toProtoType converts a maintenance type to its Connect representation
+func toProtoType(typ entity.MaintenanceType) maintenancev1.MaintenanceType {
+	switch typ {
+	case entity.MaintenanceTypeInspection:
+		return maintenancev1.MaintenanceType_MAINTENANCE_TYPE_INSPECTION
+	case entity.MaintenanceTypeService:
+		return maintenancev1.MaintenanceType_MAINTENANCE_TYPE_SERVICE
+	case entity.MaintenanceTypeRepair:
+		return maintenancev1.MaintenanceType_MAINTENANCE_TYPE_REPAIR
+	case entity.MaintenanceTypeCleaning:
+		return maintenancev1.MaintenanceType_MAINTENANCE_TYPE_CLEANING
+	case entity.MaintenanceTypeUnknown:
+		return maintenancev1.MaintenanceType_MAINTENANCE_TYPE_UNSPECIFIED
+	}
+	return maintenancev1.MaintenanceType_MAINTENANCE_TYPE_UNSPECIFIED
+}
+
+// fromProtoType converts a maintenance type from its Connect representation; unspecified is
+// rejected by the input validation
+func fromProtoType(typ maintenancev1.MaintenanceType) string {
+	switch typ {
+	case maintenancev1.MaintenanceType_MAINTENANCE_TYPE_INSPECTION:
+		return entity.MaintenanceTypeInspection.String()
+	case maintenancev1.MaintenanceType_MAINTENANCE_TYPE_SERVICE:
+		return entity.MaintenanceTypeService.String()
+	case maintenancev1.MaintenanceType_MAINTENANCE_TYPE_REPAIR:
+		return entity.MaintenanceTypeRepair.String()
+	case maintenancev1.MaintenanceType_MAINTENANCE_TYPE_CLEANING:
+		return entity.MaintenanceTypeCleaning.String()
+	case maintenancev1.MaintenanceType_MAINTENANCE_TYPE_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
+
+// toProtoStatus converts a maintenance status to its Connect representation
+func toProtoStatus(status entity.MaintenanceStatus) maintenancev1.MaintenanceStatus {
+	switch status {
+	case entity.MaintenanceStatusScheduled:
+		return maintenancev1.MaintenanceStatus_MAINTENANCE_STATUS_SCHEDULED
+	case entity.MaintenanceStatusCompleted:
+		return maintenancev1.MaintenanceStatus_MAINTENANCE_STATUS_COMPLETED
+	case entity.MaintenanceStatusCanceled:
+		return maintenancev1.MaintenanceStatus_MAINTENANCE_STATUS_CANCELED
+	case entity.MaintenanceStatusUnknown:
+		return maintenancev1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED
+	}
+	return maintenancev1.MaintenanceStatus_MAINTENANCE_STATUS_UNSPECIFIED
+}
@@ -0,0 +1,132 @@
+package metering
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	meteringv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/metering/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MeteringServiceHandler implements the Connect service for usage metering
+type MeteringServiceHandler struct {
+	meteringService service.MeteringService
+}
+
+// NewMeteringServiceHandler creates a new MeteringServiceHandler
+func NewMeteringServiceHandler(meteringService service.MeteringService) *MeteringServiceHandler {
+	return &MeteringServiceHandler{
+		meteringService: meteringService,
+	}
+}
+
+// GetUsage retrieves the daily or monthly usage rollups of the tenant
+func (h *MeteringServiceHandler) GetUsage(ctx context.Context, req *connect.Request[meteringv1.GetUsageRequest]) (*connect.Response[meteringv1.GetUsageResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.GetMeteredUsage{
+		TenantID: tenantID,
+	}
+	if req.Msg.GetPeriod() != meteringv1.UsagePeriod_USAGE_PERIOD_UNSPECIFIED {
+		period := toUsagePeriod(req.Msg.GetPeriod())
+		if period == entity.UsagePeriodUnknown {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown usage period %v", req.Msg.GetPeriod()))
+		}
+		input.Period = period.String()
+	}
+	if req.Msg.From != nil {
+		input.From = req.Msg.GetFrom().AsTime()
+	}
+	if req.Msg.To != nil {
+		input.To = req.Msg.GetTo().AsTime()
+	}
+
+	// Call application service
+	rollups, err := h.meteringService.GetUsage(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	protoRollups := make([]*meteringv1.UsageRollup, len(rollups))
+	for i, rollup := range rollups {
+		protoRollups[i] = toProtoRollup(rollup)
+	}
+
+	return connect.NewResponse(&meteringv1.GetUsageResponse{
+		Rollups: protoRollups,
+	}), nil
+}
+
+// ExportUsageStatement exports the daily usage of the tenant over a month as CSV
+func (h *MeteringServiceHandler) ExportUsageStatement(ctx context.Context, req *connect.Request[meteringv1.ExportUsageStatementRequest]) (*connect.Response[meteringv1.ExportUsageStatementResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.GetUsageStatement{
+		TenantID: tenantID,
+	}
+	if req.Msg.GetMonth() != "" {
+		month, err := time.Parse("2006-01", req.Msg.GetMonth())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("month must be YYYY-MM: %w", err))
+		}
+		input.Month = month
+	}
+
+	// Call application service
+	statement, err := h.meteringService.Statement(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := statement.WriteCSV(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write usage statement: %w", err)
+	}
+
+	return connect.NewResponse(&meteringv1.ExportUsageStatementResponse{
+		Filename: statement.Filename(),
+		Csv:      buf.Bytes(),
+	}), nil
+}
+
+// toProtoRollup converts a usage rollup to its Connect representation
+func toProtoRollup(rollup *entity.UsageRollup) *meteringv1.UsageRollup {
+	return &meteringv1.UsageRollup{
+		Meter:       rollup.Meter.String(),
+		Period:      toProtoUsagePeriod(rollup.Period),
+		PeriodStart: timestamppb.New(rollup.PeriodStart),
+		Quantity:    rollup.Quantity,
+		UpdatedAt:   timestamppb.New(rollup.UpdatedAt),
+	}
+}
+
+// toUsagePeriod converts a usage period from its Connect representation
+func toUsagePeriod(period meteringv1.UsagePeriod) entity.UsagePeriod {
+	switch period {
+	case meteringv1.UsagePeriod_USAGE_PERIOD_DAY:
+		return entity.UsagePeriodDay
+	case meteringv1.UsagePeriod_USAGE_PERIOD_MONTH:
+		return entity.UsagePeriodMonth
+	default:
+		return entity.UsagePeriodUnknown
+	}
+}
+
+// toProtoUsagePeriod converts a usage period to its Connect representation
+func toProtoUsagePeriod(period entity.UsagePeriod) meteringv1.UsagePeriod {
+	switch period {
+	case entity.UsagePeriodDay:
+		return meteringv1.UsagePeriod_USAGE_PERIOD_DAY
+	case entity.UsagePeriodMonth:
+		return meteringv1.UsagePeriod_USAGE_PERIOD_MONTH
+	default:
+		return meteringv1.UsagePeriod_USAGE_PERIOD_UNSPECIFIED
+	}
+}
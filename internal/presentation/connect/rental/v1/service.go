@@ -0,0 +1,343 @@
+package rental
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	rentalv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/rental/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RentalServiceHandler implements the Connect service for availability search and booking
+type RentalServiceHandler struct {
+	rentalService service.RentalService
+}
+
+// NewRentalServiceHandler creates a new RentalServiceHandler
+func NewRentalServiceHandler(rentalService service.RentalService) *RentalServiceHandler {
+	return &RentalServiceHandler{
+		rentalService: rentalService,
+	}
+}
+
+// SearchAvailableCars retrieves the cars free over a period, the tenant's own and shared ones
+func (h *RentalServiceHandler) SearchAvailableCars(ctx context.Context, req *connect.Request[rentalv1.SearchAvailableCarsRequest]) (*connect.Response[rentalv1.SearchAvailableCarsResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.SearchAvailableCars{
+		TenantID: tenantID,
+		BranchID: req.Msg.GetBranchId(),
+		PageSize: req.Msg.GetPageSize(),
+	}
+	if req.Msg.StartsAt != nil {
+		input.StartsAt = req.Msg.GetStartsAt().AsTime()
+	}
+	if req.Msg.EndsAt != nil {
+		input.EndsAt = req.Msg.GetEndsAt().AsTime()
+	}
+
+	// Call application service
+	cars, err := h.rentalService.SearchAvailableCars(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoCars := make([]*rentalv1.AvailableCar, len(cars))
+	for i, car := range cars {
+		protoCars[i] = &rentalv1.AvailableCar{
+			CarId:           car.Car.ID,
+			TenantId:        car.Car.TenantID,
+			CarModelId:      car.Car.ModelID,
+			LicensePlate:    car.Car.LicensePlateString(),
+			AgreementId:     car.AgreementID,
+			CurrentBranchId: car.Car.CurrentBranchID,
+		}
+		if model := car.Car.Model(); model != nil {
+			protoCars[i].Model = model.DisplayName()
+		}
+	}
+
+	return connect.NewResponse(&rentalv1.SearchAvailableCarsResponse{
+		Cars: protoCars,
+	}), nil
+}
+
+// BookRental books a car for a renter of the tenant
+func (h *RentalServiceHandler) BookRental(ctx context.Context, req *connect.Request[rentalv1.BookRentalRequest]) (*connect.Response[rentalv1.BookRentalResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.BookRental{
+		TenantID:       tenantID,
+		CarID:          req.Msg.GetCarId(),
+		RenterID:       req.Msg.GetRenterId(),
+		PickupBranchID: req.Msg.GetPickupBranchId(),
+		ReturnBranchID: req.Msg.GetReturnBranchId(),
+	}
+	if req.Msg.StartsAt != nil {
+		input.StartsAt = req.Msg.GetStartsAt().AsTime()
+	}
+	if req.Msg.EndsAt != nil {
+		input.EndsAt = req.Msg.GetEndsAt().AsTime()
+	}
+
+	// Call application service
+	rental, err := h.rentalService.Book(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&rentalv1.BookRentalResponse{
+		Rental: toProtoRental(rental),
+	}), nil
+}
+
+// PickUpRental records that the car of a rental the tenant booked was picked up, with its
+// inspection
+func (h *RentalServiceHandler) PickUpRental(ctx context.Context, req *connect.Request[rentalv1.PickUpRentalRequest]) (*connect.Response[rentalv1.PickUpRentalResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.PickUpRental{
+		TenantID:   tenantID,
+		ID:         req.Msg.GetId(),
+		Inspection: fromProtoInspection(req.Msg.GetInspection()),
+	}
+
+	// Call application service
+	handover, err := h.rentalService.PickUp(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&rentalv1.PickUpRentalResponse{
+		Handover: toProtoHandover(handover),
+	}), nil
+}
+
+// ReturnRental records that the car of a rental the tenant booked was brought back, with
+// its inspection
+func (h *RentalServiceHandler) ReturnRental(ctx context.Context, req *connect.Request[rentalv1.ReturnRentalRequest]) (*connect.Response[rentalv1.ReturnRentalResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ReturnRental{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+	}
+	if req.Msg.Inspection != nil {
+		inspection := fromProtoInspection(req.Msg.GetInspection())
+		input.Inspection = &inspection
+	}
+
+	// Call application service
+	result, err := h.rentalService.Return(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &rentalv1.ReturnRentalResponse{
+		Rental: toProtoRental(result.Rental),
+	}
+	if result.Handover != nil {
+		resp.Handover = toProtoHandover(result.Handover)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// ListRentals retrieves the rentals the tenant booked or owns the car of
+func (h *RentalServiceHandler) ListRentals(ctx context.Context, req *connect.Request[rentalv1.ListRentalsRequest]) (*connect.Response[rentalv1.ListRentalsResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ListRentals{
+		TenantID:  tenantID,
+		RenterID:  req.Msg.GetRenterId(),
+		PageSize:  req.Msg.GetPageSize(),
+		PageToken: req.Msg.GetPageToken(),
+	}
+
+	// Call application service
+	result, err := h.rentalService.List(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoRentals := make([]*rentalv1.Rental, len(result.Rentals))
+	for i, rental := range result.Rentals {
+		protoRentals[i] = toProtoRental(rental)
+	}
+
+	return connect.NewResponse(&rentalv1.ListRentalsResponse{
+		Rentals:       protoRentals,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount), // #nosec G115
+	}), nil
+}
+
+// ListRentalHandovers retrieves the handover inspections of a rental the tenant booked or
+// owns the car of
+func (h *RentalServiceHandler) ListRentalHandovers(ctx context.Context, req *connect.Request[rentalv1.ListRentalHandoversRequest]) (*connect.Response[rentalv1.ListRentalHandoversResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ListRentalHandovers{
+		TenantID: tenantID,
+		RentalID: req.Msg.GetRentalId(),
+	}
+
+	// Call application service
+	handovers, err := h.rentalService.ListHandovers(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoHandovers := make([]*rentalv1.RentalHandover, len(handovers))
+	for i, handover := range handovers {
+		protoHandovers[i] = toProtoHandover(handover)
+	}
+
+	return connect.NewResponse(&rentalv1.ListRentalHandoversResponse{
+		Handovers: protoHandovers,
+	}), nil
+}
+
+// toConnectError converts an application error to a Connect error with a matching code
+func toConnectError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, entity.ErrInvalidRentalPeriod), errors.Is(err, entity.ErrReturnWithoutPickup),
+		errors.Is(err, entity.ErrInvalidHandover), errors.Is(err, entity.ErrOdometerWentBackwards),
+		errors.Is(err, entity.ErrReturnNotInspected):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, entity.ErrCarUnavailable), errors.Is(err, entity.ErrOutsideBusinessHours),
+		errors.Is(err, entity.ErrRentalOutsideSharingTerms), errors.Is(err, entity.ErrSharingAgreementEnded),
+		errors.Is(err, entity.ErrCarNotAtBranch), errors.Is(err, entity.ErrOneWaySharedCar),
+		errors.Is(err, entity.ErrRentalNotStarted), errors.Is(err, entity.ErrRentalAlreadyReturned),
+		errors.Is(err, entity.ErrRentalNotPickedUp), errors.Is(err, entity.ErrRentalAlreadyPickedUp):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return err
+}
+
+// toProtoRental converts a rental to its Connect representation
+func toProtoRental(rental *entity.Rental) *rentalv1.Rental {
+	pb := &rentalv1.Rental{
+		Id:             rental.ID,
+		TenantId:       rental.TenantID,
+		OwnerTenantId:  rental.OwnerTenantID,
+		CarId:          rental.CarID,
+		RenterId:       rental.RenterID,
+		StartsAt:       timestamppb.New(rental.StartsAt),
+		EndsAt:         timestamppb.New(rental.EndsAt),
+		CreatedAt:      timestamppb.New(rental.CreatedAt),
+		UpdatedAt:      timestamppb.New(rental.UpdatedAt),
+		PickupBranchId: rental.PickupBranchID,
+		ReturnBranchId: rental.ReturnBranchID,
+	}
+	if rental.Returned() {
+		pb.ReturnedAt = timestamppb.New(rental.ReturnedAt.Time)
+	}
+	return pb
+}
+
+// toProtoHandover converts a handover inspection to its Connect representation
+func toProtoHandover(handover *entity.RentalHandover) *rentalv1.RentalHandover {
+	damages := make([]*rentalv1.Damage, len(handover.Reading.Damages))
+	for i, d := range handover.Reading.Damages {
+		damages[i] = &rentalv1.Damage{Area: toProtoDamageArea(d.Area), Description: d.Description}
+	}
+	return &rentalv1.RentalHandover{
+		Id:       handover.ID,
+		TenantId: handover.TenantID,
+		RentalId: handover.RentalID,
+		Kind:     toProtoHandoverKind(handover.Kind),
+		Inspection: &rentalv1.HandoverInspection{
+			OdometerKm: int32(handover.Reading.OdometerKm), // #nosec G115
+			FuelLevel:  int32(handover.Reading.FuelLevel),  // #nosec G115
+			Damages:    damages,
+		},
+		DistanceKm:    int32(handover.DistanceKm),    // #nosec G115
+		FuelShortfall: int32(handover.FuelShortfall), // #nosec G115
+		InspectedAt:   timestamppb.New(handover.InspectedAt),
+	}
+}
+
+// fromProtoInspection converts a handover inspection from its Connect representation
+func fromProtoInspection(inspection *rentalv1.HandoverInspection) input.HandoverInspection {
+	damages := make([]input.HandoverDamage, len(inspection.GetDamages()))
+	for i, d := range inspection.GetDamages() {
+		damages[i] = input.HandoverDamage{Area: fromProtoDamageArea(d.GetArea()), Description: d.GetDescription()}
+	}
+	return input.HandoverInspection{
+		OdometerKm: int(inspection.GetOdometerKm()),
+		FuelLevel:  int(inspection.GetFuelLevel()),
+		Damages:    damages,
+	}
+}
+
+// toProtoHandoverKind converts a handover kind to its Connect representation
+func toProtoHandoverKind(kind entity.HandoverKind) rentalv1.HandoverKind {
+	switch kind {
+	case entity.HandoverKindPickup:
+		return rentalv1.HandoverKind_HANDOVER_KIND_PICKUP
+	case entity.HandoverKindReturn:
+		return rentalv1.HandoverKind_HANDOVER_KIND_RETURN
+	case entity.HandoverKindUnknown:
+		return rentalv1.HandoverKind_HANDOVER_KIND_UNSPECIFIED
+	}
+	return rentalv1.HandoverKind_HANDOVER_KIND_UNSPECIFIED
+}
+
+// toProtoDamageArea converts a damage area to its Connect representation
+func toProtoDamageArea(area entity.DamageArea) rentalv1.DamageArea {
+	switch area {
+	case entity.DamageAreaFront:
+		return rentalv1.DamageArea_DAMAGE_AREA_FRONT
+	case entity.DamageAreaRear:
+		return rentalv1.DamageArea_DAMAGE_AREA_REAR
+	case entity.DamageAreaLeftSide:
+		return rentalv1.DamageArea_DAMAGE_AREA_LEFT_SIDE
+	case entity.DamageAreaRightSide:
+		return rentalv1.DamageArea_DAMAGE_AREA_RIGHT_SIDE
+	case entity.DamageAreaRoof:
+		return rentalv1.DamageArea_DAMAGE_AREA_ROOF
+	case entity.DamageAreaGlass:
+		return rentalv1.DamageArea_DAMAGE_AREA_GLASS
+	case entity.DamageAreaWheels:
+		return rentalv1.DamageArea_DAMAGE_AREA_WHEELS
+	case entity.DamageAreaInterior:
+		return rentalv1.DamageArea_DAMAGE_AREA_INTERIOR
+	case entity.DamageAreaUnknown:
+		return rentalv1.DamageArea_DAMAGE_AREA_UNSPECIFIED
+	}
+	return rentalv1.DamageArea_DAMAGE_AREA_UNSPECIFIED
+}
+
+// fromProtoDamageArea converts a damage area from its Connect representation; unspecified
+// is rejected by the inspection validation
+func fromProtoDamageArea(area rentalv1.DamageArea) string {
+	switch area {
+	case rentalv1.DamageArea_DAMAGE_AREA_FRONT:
+		return entity.DamageAreaFront.String()
+	case rentalv1.DamageArea_DAMAGE_AREA_REAR:
+		return entity.DamageAreaRear.String()
+	case rentalv1.DamageArea_DAMAGE_AREA_LEFT_SIDE:
+		return entity.DamageAreaLeftSide.String()
+	case rentalv1.DamageArea_DAMAGE_AREA_RIGHT_SIDE:
+		return entity.DamageAreaRightSide.String()
+	case rentalv1.DamageArea_DAMAGE_AREA_ROOF:
+		return entity.DamageAreaRoof.String()
+	case rentalv1.DamageArea_DAMAGE_AREA_GLASS:
+		return entity.DamageAreaGlass.String()
+	case rentalv1.DamageArea_DAMAGE_AREA_WHEELS:
+		return entity.DamageAreaWheels.String()
+	case rentalv1.DamageArea_DAMAGE_AREA_INTERIOR:
+		return entity.DamageAreaInterior.String()
+	case rentalv1.DamageArea_DAMAGE_AREA_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
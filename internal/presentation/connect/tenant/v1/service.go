@@ -0,0 +1,515 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	tenantv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenant/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/archive"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TenantServiceHandler implements the Connect service for tenant lifecycle operations
+type TenantServiceHandler struct {
+	tenantService  service.TenantService
+	archiveService service.TenantArchiveService
+	sharingService service.FleetSharingService
+}
+
+// NewTenantServiceHandler creates a new TenantServiceHandler
+func NewTenantServiceHandler(
+	tenantService service.TenantService,
+	archiveService service.TenantArchiveService,
+	sharingService service.FleetSharingService,
+) *TenantServiceHandler {
+	return &TenantServiceHandler{
+		tenantService:  tenantService,
+		archiveService: archiveService,
+		sharingService: sharingService,
+	}
+}
+
+// CreateTenant creates a new tenant
+func (h *TenantServiceHandler) CreateTenant(ctx context.Context, req *connect.Request[tenantv1.CreateTenantRequest]) (*connect.Response[tenantv1.CreateTenantResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.CreateTenant{
+		Code:      req.Msg.GetCode(),
+		PlanCode:  req.Msg.GetPlanCode(),
+		Isolation: fromProtoIsolation(req.Msg.GetIsolation()),
+		ParentID:  req.Msg.GetParentId(),
+	}
+
+	// Call application service
+	tenant, err := h.tenantService.Create(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.CreateTenantResponse{
+		Tenant: toProtoTenant(tenant),
+	}), nil
+}
+
+// GetTenant retrieves a tenant by its ID or by its code
+func (h *TenantServiceHandler) GetTenant(ctx context.Context, req *connect.Request[tenantv1.GetTenantRequest]) (*connect.Response[tenantv1.GetTenantResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.GetTenant{
+		ID:   req.Msg.GetId(),
+		Code: req.Msg.GetCode(),
+	}
+
+	// Call application service
+	tenant, err := h.tenantService.Get(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.GetTenantResponse{
+		Tenant: toProtoTenant(tenant),
+	}), nil
+}
+
+// SuspendTenant suspends a tenant
+func (h *TenantServiceHandler) SuspendTenant(ctx context.Context, req *connect.Request[tenantv1.SuspendTenantRequest]) (*connect.Response[tenantv1.SuspendTenantResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.SuspendTenant{
+		ID: req.Msg.GetId(),
+	}
+
+	// Call application service
+	tenant, err := h.tenantService.Suspend(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.SuspendTenantResponse{
+		Tenant: toProtoTenant(tenant),
+	}), nil
+}
+
+// ReactivateTenant lifts the suspension of a tenant
+func (h *TenantServiceHandler) ReactivateTenant(ctx context.Context, req *connect.Request[tenantv1.ReactivateTenantRequest]) (*connect.Response[tenantv1.ReactivateTenantResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.ReactivateTenant{
+		ID: req.Msg.GetId(),
+	}
+
+	// Call application service
+	tenant, err := h.tenantService.Reactivate(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.ReactivateTenantResponse{
+		Tenant: toProtoTenant(tenant),
+	}), nil
+}
+
+// ChangeTenantPlan moves a tenant to another plan
+func (h *TenantServiceHandler) ChangeTenantPlan(ctx context.Context, req *connect.Request[tenantv1.ChangeTenantPlanRequest]) (*connect.Response[tenantv1.ChangeTenantPlanResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.ChangeTenantPlan{
+		ID:       req.Msg.GetId(),
+		PlanCode: req.Msg.GetPlanCode(),
+	}
+
+	// Call application service
+	tenant, err := h.tenantService.ChangePlan(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.ChangeTenantPlanResponse{
+		Tenant: toProtoTenant(tenant),
+	}), nil
+}
+
+// ScheduleTenantDeletion schedules the deletion of a tenant
+func (h *TenantServiceHandler) ScheduleTenantDeletion(ctx context.Context, req *connect.Request[tenantv1.ScheduleTenantDeletionRequest]) (*connect.Response[tenantv1.ScheduleTenantDeletionResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.ScheduleTenantDeletion{
+		ID: req.Msg.GetId(),
+	}
+
+	// Call application service
+	tenant, err := h.tenantService.ScheduleDeletion(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.ScheduleTenantDeletionResponse{
+		Tenant: toProtoTenant(tenant),
+	}), nil
+}
+
+// CancelTenantDeletion cancels the deletion of a tenant
+func (h *TenantServiceHandler) CancelTenantDeletion(ctx context.Context, req *connect.Request[tenantv1.CancelTenantDeletionRequest]) (*connect.Response[tenantv1.CancelTenantDeletionResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.CancelTenantDeletion{
+		ID: req.Msg.GetId(),
+	}
+
+	// Call application service
+	tenant, err := h.tenantService.CancelDeletion(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.CancelTenantDeletionResponse{
+		Tenant: toProtoTenant(tenant),
+	}), nil
+}
+
+// GetTenantPurgeReport counts the rows a purge of a tenant would delete
+func (h *TenantServiceHandler) GetTenantPurgeReport(ctx context.Context, req *connect.Request[tenantv1.GetTenantPurgeReportRequest]) (*connect.Response[tenantv1.GetTenantPurgeReportResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.GetTenantPurgeReport{
+		ID: req.Msg.GetId(),
+	}
+
+	// Call application service
+	rows, err := h.tenantService.PurgeReport(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	tables := make([]*tenantv1.TableRows, len(rows))
+	for i, table := range rows {
+		tables[i] = &tenantv1.TableRows{
+			Table: table.Table,
+			Rows:  int32(table.Rows), //nolint:gosec // row counts of a tenant are far below 2^31
+		}
+	}
+
+	return connect.NewResponse(&tenantv1.GetTenantPurgeReportResponse{
+		Tables:    tables,
+		TotalRows: int32(rows.Total()), //nolint:gosec // row counts of a tenant are far below 2^31
+	}), nil
+}
+
+// ListPlans retrieves the plan catalog
+func (h *TenantServiceHandler) ListPlans(ctx context.Context, _ *connect.Request[tenantv1.ListPlansRequest]) (*connect.Response[tenantv1.ListPlansResponse], error) {
+	// Call application service
+	plans, err := h.tenantService.ListPlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pbPlans := make([]*tenantv1.Plan, len(plans))
+	for i, plan := range plans {
+		pbPlans[i] = toProtoPlan(plan)
+	}
+
+	return connect.NewResponse(&tenantv1.ListPlansResponse{
+		Plans: pbPlans,
+	}), nil
+}
+
+// ExportTenant starts exporting a tenant to an archive
+func (h *TenantServiceHandler) ExportTenant(ctx context.Context, req *connect.Request[tenantv1.ExportTenantRequest]) (*connect.Response[tenantv1.ExportTenantResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.ExportTenant{
+		TenantID: req.Msg.GetId(),
+	}
+
+	// Call application service
+	job, err := h.archiveService.Export(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.ExportTenantResponse{
+		Job: toProtoArchiveJob(job),
+	}), nil
+}
+
+// ImportTenant starts importing a tenant from an archive
+func (h *TenantServiceHandler) ImportTenant(ctx context.Context, req *connect.Request[tenantv1.ImportTenantRequest]) (*connect.Response[tenantv1.ImportTenantResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.ImportTenant{
+		Archive:  req.Msg.GetArchive(),
+		RemapIDs: req.Msg.GetRemapIds(),
+		Code:     req.Msg.GetCode(),
+	}
+
+	// Call application service
+	job, err := h.archiveService.Import(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.ImportTenantResponse{
+		Job: toProtoArchiveJob(job),
+	}), nil
+}
+
+// GetArchiveJob retrieves an export or import job
+func (h *TenantServiceHandler) GetArchiveJob(ctx context.Context, req *connect.Request[tenantv1.GetArchiveJobRequest]) (*connect.Response[tenantv1.GetArchiveJobResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.GetArchiveJob{
+		ID: req.Msg.GetId(),
+	}
+
+	// Call application service
+	job, err := h.archiveService.GetJob(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.GetArchiveJobResponse{
+		Job: toProtoArchiveJob(job),
+	}), nil
+}
+
+// CreateFleetSharingAgreement lets a tenant book the cars of a sibling tenant
+func (h *TenantServiceHandler) CreateFleetSharingAgreement(ctx context.Context, req *connect.Request[tenantv1.CreateFleetSharingAgreementRequest]) (*connect.Response[tenantv1.CreateFleetSharingAgreementResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.CreateFleetSharingAgreement{
+		LenderTenantID:    req.Msg.GetLenderTenantId(),
+		BorrowerTenantID:  req.Msg.GetBorrowerTenantId(),
+		MaxRentalDays:     int(req.Msg.GetMaxRentalDays()),
+		OwnerSharePercent: int(req.Msg.GetOwnerSharePercent()),
+	}
+	if req.Msg.ValidFrom != nil {
+		input.ValidFrom = req.Msg.GetValidFrom().AsTime()
+	}
+	if req.Msg.ValidUntil != nil {
+		input.ValidUntil = req.Msg.GetValidUntil().AsTime()
+	}
+
+	// Call application service
+	agreement, err := h.sharingService.Create(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.CreateFleetSharingAgreementResponse{
+		Agreement: toProtoAgreement(agreement),
+	}), nil
+}
+
+// TerminateFleetSharingAgreement ends a fleet sharing agreement
+func (h *TenantServiceHandler) TerminateFleetSharingAgreement(ctx context.Context, req *connect.Request[tenantv1.TerminateFleetSharingAgreementRequest]) (*connect.Response[tenantv1.TerminateFleetSharingAgreementResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.TerminateFleetSharingAgreement{
+		ID: req.Msg.GetId(),
+	}
+
+	// Call application service
+	agreement, err := h.sharingService.Terminate(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&tenantv1.TerminateFleetSharingAgreementResponse{
+		Agreement: toProtoAgreement(agreement),
+	}), nil
+}
+
+// ListFleetSharingAgreements retrieves the agreements a tenant lends or borrows under
+func (h *TenantServiceHandler) ListFleetSharingAgreements(ctx context.Context, req *connect.Request[tenantv1.ListFleetSharingAgreementsRequest]) (*connect.Response[tenantv1.ListFleetSharingAgreementsResponse], error) {
+	// Convert Connect request to application DTO
+	input := input.ListFleetSharingAgreements{
+		TenantID: req.Msg.GetTenantId(),
+	}
+
+	// Call application service
+	agreements, err := h.sharingService.List(ctx, input)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	pbAgreements := make([]*tenantv1.FleetSharingAgreement, len(agreements))
+	for i, agreement := range agreements {
+		pbAgreements[i] = toProtoAgreement(agreement)
+	}
+
+	return connect.NewResponse(&tenantv1.ListFleetSharingAgreementsResponse{
+		Agreements: pbAgreements,
+	}), nil
+}
+
+// toConnectError gives the errors callers can act on a Connect code
+func toConnectError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, entity.ErrTenantSuspended), errors.Is(err, entity.ErrTenantNotSuspended),
+		errors.Is(err, entity.ErrTenantPendingDeletion), errors.Is(err, entity.ErrTenantNotPendingDeletion),
+		errors.Is(err, entity.ErrTenantGracePeriodOver), errors.Is(err, entity.ErrTenantParentIsChild),
+		errors.Is(err, entity.ErrSharingNotSiblings), errors.Is(err, entity.ErrSharingNeedsSharedTables),
+		errors.Is(err, entity.ErrSharingAgreementEnded):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, entity.ErrInvalidSharingTerms):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, archive.ErrInvalidArchive):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return err
+}
+
+// toProtoTenant converts a tenant to its Connect representation
+func toProtoTenant(tenant *entity.Tenant) *tenantv1.Tenant {
+	pb := &tenantv1.Tenant{
+		Id:        tenant.ID,
+		Code:      tenant.Code,
+		Status:    toProtoStatus(tenant.Status),
+		Isolation: toProtoIsolation(tenant.Isolation),
+		CreatedAt: timestamppb.New(tenant.CreatedAt),
+		UpdatedAt: timestamppb.New(tenant.UpdatedAt),
+	}
+	if tenant.SuspendedAt.Valid {
+		pb.SuspendedAt = timestamppb.New(tenant.SuspendedAt.Time)
+	}
+	if tenant.PlanID.Valid {
+		pb.PlanId = tenant.PlanID.String
+	}
+	if tenant.PurgeAt.Valid {
+		pb.PurgeAt = timestamppb.New(tenant.PurgeAt.Time)
+	}
+	if tenant.ParentID.Valid {
+		pb.ParentId = tenant.ParentID.String
+	}
+	return pb
+}
+
+// toProtoAgreement converts a fleet sharing agreement to its Connect representation
+func toProtoAgreement(agreement *entity.FleetSharingAgreement) *tenantv1.FleetSharingAgreement {
+	pb := &tenantv1.FleetSharingAgreement{
+		Id:                agreement.ID,
+		LenderTenantId:    agreement.LenderTenantID,
+		BorrowerTenantId:  agreement.BorrowerTenantID,
+		ValidFrom:         timestamppb.New(agreement.Terms.ValidFrom),
+		MaxRentalDays:     int32(agreement.Terms.MaxRentalDays),     // #nosec G115
+		OwnerSharePercent: int32(agreement.Terms.OwnerSharePercent), // #nosec G115
+		CreatedAt:         timestamppb.New(agreement.CreatedAt),
+		UpdatedAt:         timestamppb.New(agreement.UpdatedAt),
+	}
+	if agreement.Terms.ValidUntil.Valid {
+		pb.ValidUntil = timestamppb.New(agreement.Terms.ValidUntil.Time)
+	}
+	if agreement.TerminatedAt.Valid {
+		pb.TerminatedAt = timestamppb.New(agreement.TerminatedAt.Time)
+	}
+	return pb
+}
+
+// toProtoPlan converts a plan to its Connect representation
+func toProtoPlan(plan *entity.Plan) *tenantv1.Plan {
+	return &tenantv1.Plan{
+		Id:   plan.ID,
+		Code: plan.Code,
+		Name: plan.Name,
+		Limits: &tenantv1.PlanLimits{
+			MaxCars:           int32(plan.Limits.MaxCars),           //nolint:gosec // limits are far below 2^31
+			MaxRenters:        int32(plan.Limits.MaxRenters),        //nolint:gosec // limits are far below 2^31
+			MaxMonthlyRentals: int32(plan.Limits.MaxMonthlyRentals), //nolint:gosec // limits are far below 2^31
+		},
+	}
+}
+
+// toProtoArchiveJob converts an archive job to its Connect representation
+func toProtoArchiveJob(job *entity.ArchiveJob) *tenantv1.ArchiveJob {
+	pb := &tenantv1.ArchiveJob{
+		Id:        job.ID,
+		Kind:      toProtoArchiveJobKind(job.Kind),
+		Archive:   job.Archive,
+		Status:    toProtoArchiveJobStatus(job.Status),
+		CreatedAt: timestamppb.New(job.CreatedAt),
+	}
+	if job.TenantID.Valid {
+		pb.TenantId = job.TenantID.String
+	}
+	if len(job.Counts) > 0 {
+		pb.Counts = make(map[string]int32, len(job.Counts))
+		for kind, count := range job.Counts {
+			pb.Counts[kind] = int32(count) //nolint:gosec // rows of one tenant are far below 2^31
+		}
+	}
+	if job.Error.Valid {
+		pb.Error = job.Error.String
+	}
+	if job.FinishedAt.Valid {
+		pb.FinishedAt = timestamppb.New(job.FinishedAt.Time)
+	}
+	return pb
+}
+
+// toProtoArchiveJobKind converts an archive job kind to its Connect representation
+func toProtoArchiveJobKind(kind entity.ArchiveJobKind) tenantv1.ArchiveJobKind {
+	switch kind {
+	case entity.ArchiveJobKindExport:
+		return tenantv1.ArchiveJobKind_ARCHIVE_JOB_KIND_EXPORT
+	case entity.ArchiveJobKindImport:
+		return tenantv1.ArchiveJobKind_ARCHIVE_JOB_KIND_IMPORT
+	case entity.ArchiveJobKindUnknown:
+		return tenantv1.ArchiveJobKind_ARCHIVE_JOB_KIND_UNSPECIFIED
+	}
+	return tenantv1.ArchiveJobKind_ARCHIVE_JOB_KIND_UNSPECIFIED
+}
+
+// toProtoArchiveJobStatus converts an archive job status to its Connect representation
+func toProtoArchiveJobStatus(status entity.ArchiveJobStatus) tenantv1.ArchiveJobStatus {
+	switch status {
+	case entity.ArchiveJobStatusRunning:
+		return tenantv1.ArchiveJobStatus_ARCHIVE_JOB_STATUS_RUNNING
+	case entity.ArchiveJobStatusSucceeded:
+		return tenantv1.ArchiveJobStatus_ARCHIVE_JOB_STATUS_SUCCEEDED
+	case entity.ArchiveJobStatusFailed:
+		return tenantv1.ArchiveJobStatus_ARCHIVE_JOB_STATUS_FAILED
+	case entity.ArchiveJobStatusUnknown:
+		return tenantv1.ArchiveJobStatus_ARCHIVE_JOB_STATUS_UNSPECIFIED
+	}
+	return tenantv1.ArchiveJobStatus_ARCHIVE_JOB_STATUS_UNSPECIFIED
+}
+
+// toProtoStatus converts a tenant status to its Connect representation
+func toProtoStatus(status entity.TenantStatus) tenantv1.TenantStatus {
+	switch status {
+	case entity.TenantStatusActive:
+		return tenantv1.TenantStatus_TENANT_STATUS_ACTIVE
+	case entity.TenantStatusSuspended:
+		return tenantv1.TenantStatus_TENANT_STATUS_SUSPENDED
+	case entity.TenantStatusPendingDeletion:
+		return tenantv1.TenantStatus_TENANT_STATUS_PENDING_DELETION
+	case entity.TenantStatusUnknown:
+		return tenantv1.TenantStatus_TENANT_STATUS_UNSPECIFIED
+	}
+	return tenantv1.TenantStatus_TENANT_STATUS_UNSPECIFIED
+}
+
+// toProtoIsolation converts a tenant isolation mode to its Connect representation
+func toProtoIsolation(isolation entity.TenantIsolation) tenantv1.TenantIsolation {
+	switch isolation {
+	case entity.TenantIsolationShared:
+		return tenantv1.TenantIsolation_TENANT_ISOLATION_SHARED
+	case entity.TenantIsolationSchema:
+		return tenantv1.TenantIsolation_TENANT_ISOLATION_SCHEMA
+	case entity.TenantIsolationDatabase:
+		return tenantv1.TenantIsolation_TENANT_ISOLATION_DATABASE
+	}
+	return tenantv1.TenantIsolation_TENANT_ISOLATION_UNSPECIFIED
+}
+
+// fromProtoIsolation converts a tenant isolation mode from its Connect representation;
+// unspecified leaves the choice to the service
+func fromProtoIsolation(isolation tenantv1.TenantIsolation) string {
+	switch isolation {
+	case tenantv1.TenantIsolation_TENANT_ISOLATION_SHARED:
+		return entity.TenantIsolationShared.String()
+	case tenantv1.TenantIsolation_TENANT_ISOLATION_SCHEMA:
+		return entity.TenantIsolationSchema.String()
+	case tenantv1.TenantIsolation_TENANT_ISOLATION_DATABASE:
+		return entity.TenantIsolationDatabase.String()
+	case tenantv1.TenantIsolation_TENANT_ISOLATION_UNSPECIFIED:
+		return ""
+	}
+	return ""
+}
@@ -0,0 +1,177 @@
+package tenantadmin
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	tenantadminv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantadmin/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TenantAdminServiceHandler implements the Connect service for tenant administration
+type TenantAdminServiceHandler struct {
+	tenantAdminService service.TenantAdminService
+	quotaService       service.QuotaService
+}
+
+// NewTenantAdminServiceHandler creates a new TenantAdminServiceHandler
+func NewTenantAdminServiceHandler(tenantAdminService service.TenantAdminService, quotaService service.QuotaService) *TenantAdminServiceHandler {
+	return &TenantAdminServiceHandler{
+		tenantAdminService: tenantAdminService,
+		quotaService:       quotaService,
+	}
+}
+
+// CreateAPIKey creates a new API key
+func (h *TenantAdminServiceHandler) CreateAPIKey(ctx context.Context, req *connect.Request[tenantadminv1.CreateAPIKeyRequest]) (*connect.Response[tenantadminv1.CreateAPIKeyResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.CreateAPIKey{
+		TenantID: tenantID,
+		Name:     req.Msg.GetName(),
+		Scopes:   req.Msg.GetScopes(),
+	}
+	if req.Msg.ExpiresAt != nil {
+		expiresAt := req.Msg.GetExpiresAt().AsTime()
+		input.ExpiresAt = &expiresAt
+	}
+
+	// Call application service
+	apiKey, key, err := h.tenantAdminService.CreateAPIKey(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&tenantadminv1.CreateAPIKeyResponse{
+		ApiKey: toProtoAPIKey(apiKey),
+		Key:    key,
+	}), nil
+}
+
+// ListAPIKeys retrieves a list of API keys
+func (h *TenantAdminServiceHandler) ListAPIKeys(ctx context.Context, req *connect.Request[tenantadminv1.ListAPIKeysRequest]) (*connect.Response[tenantadminv1.ListAPIKeysResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ListAPIKeys{
+		TenantID:  tenantID,
+		PageSize:  req.Msg.GetPageSize(),
+		PageToken: req.Msg.GetPageToken(),
+	}
+
+	// Call application service
+	listOutput, err := h.tenantAdminService.ListAPIKeys(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]*tenantadminv1.APIKey, len(listOutput.APIKeys))
+	for i, apiKey := range listOutput.APIKeys {
+		apiKeys[i] = toProtoAPIKey(apiKey)
+	}
+
+	return connect.NewResponse(&tenantadminv1.ListAPIKeysResponse{
+		ApiKeys:       apiKeys,
+		NextPageToken: listOutput.NextPageToken,
+	}), nil
+}
+
+// RotateAPIKey replaces the key of an API key
+func (h *TenantAdminServiceHandler) RotateAPIKey(ctx context.Context, req *connect.Request[tenantadminv1.RotateAPIKeyRequest]) (*connect.Response[tenantadminv1.RotateAPIKeyResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.RotateAPIKey{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+	}
+
+	// Call application service
+	apiKey, key, err := h.tenantAdminService.RotateAPIKey(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&tenantadminv1.RotateAPIKeyResponse{
+		ApiKey: toProtoAPIKey(apiKey),
+		Key:    key,
+	}), nil
+}
+
+// RevokeAPIKey permanently disables an API key
+func (h *TenantAdminServiceHandler) RevokeAPIKey(ctx context.Context, req *connect.Request[tenantadminv1.RevokeAPIKeyRequest]) (*connect.Response[tenantadminv1.RevokeAPIKeyResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.RevokeAPIKey{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+	}
+
+	// Call application service
+	apiKey, err := h.tenantAdminService.RevokeAPIKey(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&tenantadminv1.RevokeAPIKeyResponse{
+		ApiKey: toProtoAPIKey(apiKey),
+	}), nil
+}
+
+// GetUsage retrieves the plan of the tenant and its usage of the plan's limits
+func (h *TenantAdminServiceHandler) GetUsage(ctx context.Context, req *connect.Request[tenantadminv1.GetUsageRequest]) (*connect.Response[tenantadminv1.GetUsageResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.GetUsage{
+		TenantID: tenantID,
+	}
+
+	// Call application service
+	usage, err := h.quotaService.Usage(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &tenantadminv1.GetUsageResponse{
+		PeriodStart: timestamppb.New(usage.PeriodStart),
+		Resources:   make([]*tenantadminv1.ResourceUsage, len(usage.Resources)),
+	}
+	if usage.Plan != nil {
+		res.PlanCode = usage.Plan.Code
+		res.PlanName = usage.Plan.Name
+	}
+	for i, resource := range usage.Resources {
+		res.Resources[i] = &tenantadminv1.ResourceUsage{
+			Resource: resource.Resource.String(),
+			Used:     int32(resource.Used),  //nolint:gosec // counts are far below 2^31
+			Limit:    int32(resource.Limit), //nolint:gosec // limits are far below 2^31
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// toProtoAPIKey converts an API key to its Connect representation without its hash
+func toProtoAPIKey(apiKey *entity.APIKey) *tenantadminv1.APIKey {
+	pb := &tenantadminv1.APIKey{
+		Id:        apiKey.ID,
+		TenantId:  apiKey.TenantID,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Scopes:    apiKey.Scopes,
+		CreatedAt: timestamppb.New(apiKey.CreatedAt),
+		UpdatedAt: timestamppb.New(apiKey.UpdatedAt),
+	}
+	if apiKey.ExpiresAt.Valid {
+		pb.ExpiresAt = timestamppb.New(apiKey.ExpiresAt.Time)
+	}
+	if apiKey.LastUsedAt.Valid {
+		pb.LastUsedAt = timestamppb.New(apiKey.LastUsedAt.Time)
+	}
+	if apiKey.RevokedAt.Valid {
+		pb.RevokedAt = timestamppb.New(apiKey.RevokedAt.Time)
+	}
+	return pb
+}
@@ -0,0 +1,137 @@
+package tenantsettings
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	tenantsettingsv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TenantSettingsServiceHandler implements the Connect service for tenant settings
+type TenantSettingsServiceHandler struct {
+	settingsService service.TenantSettingsService
+}
+
+// NewTenantSettingsServiceHandler creates a new TenantSettingsServiceHandler
+func NewTenantSettingsServiceHandler(settingsService service.TenantSettingsService) *TenantSettingsServiceHandler {
+	return &TenantSettingsServiceHandler{
+		settingsService: settingsService,
+	}
+}
+
+// GetTenantSettings retrieves the settings of the tenant
+func (h *TenantSettingsServiceHandler) GetTenantSettings(ctx context.Context, req *connect.Request[tenantsettingsv1.GetTenantSettingsRequest]) (*connect.Response[tenantsettingsv1.GetTenantSettingsResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.GetTenantSettings{
+		TenantID: tenantID,
+	}
+
+	// Call application service
+	settings, err := h.settingsService.Get(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&tenantsettingsv1.GetTenantSettingsResponse{
+		Settings: toProtoSettings(settings),
+	}), nil
+}
+
+// UpdateTenantSettings replaces the settings of the tenant
+func (h *TenantSettingsServiceHandler) UpdateTenantSettings(ctx context.Context, req *connect.Request[tenantsettingsv1.UpdateTenantSettingsRequest]) (*connect.Response[tenantsettingsv1.UpdateTenantSettingsResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	hours, err := FromProtoOpeningHours(req.Msg.GetBusinessHours())
+	if err != nil {
+		return nil, err
+	}
+	input := input.UpdateTenantSettings{
+		TenantID:      tenantID,
+		Timezone:      req.Msg.GetTimezone(),
+		Currency:      req.Msg.GetCurrency(),
+		Locale:        req.Msg.GetLocale(),
+		BusinessHours: hours,
+	}
+
+	// Call application service
+	settings, err := h.settingsService.Update(ctx, input)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidTenantSettings) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+
+	return connect.NewResponse(&tenantsettingsv1.UpdateTenantSettingsResponse{
+		Settings: toProtoSettings(settings),
+	}), nil
+}
+
+// toProtoSettings converts tenant settings to their Connect representation
+func toProtoSettings(settings *entity.TenantSettings) *tenantsettingsv1.TenantSettings {
+	return &tenantsettingsv1.TenantSettings{
+		TenantId:      settings.TenantID,
+		Timezone:      settings.Timezone,
+		Currency:      settings.Currency,
+		Locale:        settings.Locale,
+		BusinessHours: ToProtoOpeningHours(settings.BusinessHours),
+		CreatedAt:     timestamppb.New(settings.CreatedAt),
+		UpdatedAt:     timestamppb.New(settings.UpdatedAt),
+	}
+}
+
+// FromProtoOpeningHours converts opening hours from their Connect representation, which
+// the branch service shares. Periods without a day of the week are rejected.
+func FromProtoOpeningHours(periods []*tenantsettingsv1.OpeningHours) ([]input.OpeningHours, error) {
+	hours := make([]input.OpeningHours, len(periods))
+	for i, period := range periods {
+		weekday, ok := toWeekday(period.GetDay())
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("opening hours need a day of the week"))
+		}
+		hours[i] = input.OpeningHours{
+			Weekday: weekday,
+			Opens:   period.GetOpens(),
+			Closes:  period.GetCloses(),
+		}
+	}
+	return hours, nil
+}
+
+// ToProtoOpeningHours converts opening hours to their Connect representation
+func ToProtoOpeningHours(hours entity.BusinessHours) []*tenantsettingsv1.OpeningHours {
+	periods := make([]*tenantsettingsv1.OpeningHours, len(hours))
+	for i, period := range hours {
+		periods[i] = &tenantsettingsv1.OpeningHours{
+			Day:    toProtoDay(period.Weekday),
+			Opens:  period.Opens.String(),
+			Closes: period.Closes.String(),
+		}
+	}
+	return periods
+}
+
+// toWeekday converts a day of the week from its Connect representation, where Monday
+// comes first
+func toWeekday(day tenantsettingsv1.DayOfWeek) (time.Weekday, bool) {
+	if day < tenantsettingsv1.DayOfWeek_DAY_OF_WEEK_MONDAY || day > tenantsettingsv1.DayOfWeek_DAY_OF_WEEK_SUNDAY {
+		return 0, false
+	}
+	return time.Weekday(day % 7), true
+}
+
+// toProtoDay converts a day of the week to its Connect representation
+func toProtoDay(weekday time.Weekday) tenantsettingsv1.DayOfWeek {
+	if weekday == time.Sunday {
+		return tenantsettingsv1.DayOfWeek_DAY_OF_WEEK_SUNDAY
+	}
+	return tenantsettingsv1.DayOfWeek(weekday)
+}
@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	webhookv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/webhook/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/input"
+	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	"github.com/jp-ryuji/go-arch-patterns/internal/domain/entity"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/tenantctx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WebhookServiceHandler implements the Connect service for webhook operations
+type WebhookServiceHandler struct {
+	webhookService service.WebhookService
+}
+
+// NewWebhookServiceHandler creates a new WebhookServiceHandler
+func NewWebhookServiceHandler(webhookService service.WebhookService) *WebhookServiceHandler {
+	return &WebhookServiceHandler{
+		webhookService: webhookService,
+	}
+}
+
+// CreateWebhookEndpoint registers a new webhook endpoint
+func (h *WebhookServiceHandler) CreateWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.CreateWebhookEndpointRequest]) (*connect.Response[webhookv1.CreateWebhookEndpointResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.CreateWebhookEndpoint{
+		TenantID:    tenantID,
+		URL:         req.Msg.GetUrl(),
+		EventTypes:  req.Msg.GetEventTypes(),
+		Description: req.Msg.GetDescription(),
+	}
+
+	// Call application service
+	endpoint, err := h.webhookService.CreateEndpoint(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&webhookv1.CreateWebhookEndpointResponse{
+		Endpoint: toProtoEndpoint(endpoint),
+		Secret:   endpoint.Secret,
+	}), nil
+}
+
+// GetWebhookEndpoint retrieves a webhook endpoint by ID
+func (h *WebhookServiceHandler) GetWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.GetWebhookEndpointRequest]) (*connect.Response[webhookv1.GetWebhookEndpointResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.GetWebhookEndpoint{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+	}
+
+	// Call application service
+	endpoint, err := h.webhookService.GetEndpoint(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&webhookv1.GetWebhookEndpointResponse{
+		Endpoint: toProtoEndpoint(endpoint),
+	}), nil
+}
+
+// ListWebhookEndpoints retrieves a list of webhook endpoints
+func (h *WebhookServiceHandler) ListWebhookEndpoints(ctx context.Context, req *connect.Request[webhookv1.ListWebhookEndpointsRequest]) (*connect.Response[webhookv1.ListWebhookEndpointsResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ListWebhookEndpoints{
+		TenantID:  tenantID,
+		PageSize:  req.Msg.GetPageSize(),
+		PageToken: req.Msg.GetPageToken(),
+	}
+
+	// Call application service
+	listOutput, err := h.webhookService.ListEndpoints(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]*webhookv1.WebhookEndpoint, len(listOutput.Endpoints))
+	for i, endpoint := range listOutput.Endpoints {
+		endpoints[i] = toProtoEndpoint(endpoint)
+	}
+
+	return connect.NewResponse(&webhookv1.ListWebhookEndpointsResponse{
+		Endpoints:     endpoints,
+		NextPageToken: listOutput.NextPageToken,
+	}), nil
+}
+
+// UpdateWebhookEndpoint updates a webhook endpoint
+func (h *WebhookServiceHandler) UpdateWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.UpdateWebhookEndpointRequest]) (*connect.Response[webhookv1.UpdateWebhookEndpointResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.UpdateWebhookEndpoint{
+		TenantID:    tenantID,
+		ID:          req.Msg.GetId(),
+		URL:         req.Msg.Url,
+		EventTypes:  req.Msg.GetEventTypes(),
+		Description: req.Msg.Description,
+		Enabled:     req.Msg.Enabled,
+	}
+
+	// Call application service
+	endpoint, err := h.webhookService.UpdateEndpoint(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&webhookv1.UpdateWebhookEndpointResponse{
+		Endpoint: toProtoEndpoint(endpoint),
+	}), nil
+}
+
+// DeleteWebhookEndpoint deletes a webhook endpoint
+func (h *WebhookServiceHandler) DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[webhookv1.DeleteWebhookEndpointRequest]) (*connect.Response[webhookv1.DeleteWebhookEndpointResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.DeleteWebhookEndpoint{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+	}
+
+	// Call application service
+	if err := h.webhookService.DeleteEndpoint(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&webhookv1.DeleteWebhookEndpointResponse{}), nil
+}
+
+// RotateWebhookEndpointSecret replaces the signing secret of a webhook endpoint
+func (h *WebhookServiceHandler) RotateWebhookEndpointSecret(ctx context.Context, req *connect.Request[webhookv1.RotateWebhookEndpointSecretRequest]) (*connect.Response[webhookv1.RotateWebhookEndpointSecretResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.RotateWebhookEndpointSecret{
+		TenantID: tenantID,
+		ID:       req.Msg.GetId(),
+	}
+
+	// Call application service
+	endpoint, err := h.webhookService.RotateSecret(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&webhookv1.RotateWebhookEndpointSecretResponse{
+		Endpoint: toProtoEndpoint(endpoint),
+		Secret:   endpoint.Secret,
+	}), nil
+}
+
+// ListWebhookDeliveries retrieves the webhook delivery log
+func (h *WebhookServiceHandler) ListWebhookDeliveries(ctx context.Context, req *connect.Request[webhookv1.ListWebhookDeliveriesRequest]) (*connect.Response[webhookv1.ListWebhookDeliveriesResponse], error) {
+	// Convert Connect request to application DTO
+	tenantID, _ := tenantctx.TenantID(ctx)
+	input := input.ListWebhookDeliveries{
+		TenantID:   tenantID,
+		EndpointID: req.Msg.GetEndpointId(),
+		Status:     req.Msg.GetStatus(),
+		PageSize:   req.Msg.GetPageSize(),
+		PageToken:  req.Msg.GetPageToken(),
+	}
+
+	// Call application service
+	listOutput, err := h.webhookService.ListDeliveries(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*webhookv1.WebhookDelivery, len(listOutput.Deliveries))
+	for i, delivery := range listOutput.Deliveries {
+		deliveries[i] = toProtoDelivery(delivery)
+	}
+
+	return connect.NewResponse(&webhookv1.ListWebhookDeliveriesResponse{
+		Deliveries:    deliveries,
+		NextPageToken: listOutput.NextPageToken,
+	}), nil
+}
+
+// toProtoEndpoint converts a webhook endpoint to its Connect representation without the secret
+func toProtoEndpoint(endpoint *entity.WebhookEndpoint) *webhookv1.WebhookEndpoint {
+	pb := &webhookv1.WebhookEndpoint{
+		Id:                  endpoint.ID,
+		TenantId:            endpoint.TenantID,
+		Url:                 endpoint.URL,
+		EventTypes:          endpoint.EventTypes,
+		Description:         endpoint.Description,
+		Enabled:             endpoint.Enabled,
+		ConsecutiveFailures: int32(endpoint.ConsecutiveFailures), //nolint:gosec // bounded by MaxWebhookConsecutiveFailures
+		DisabledReason:      endpoint.DisabledReason.String,
+		CreatedAt:           timestamppb.New(endpoint.CreatedAt),
+		UpdatedAt:           timestamppb.New(endpoint.UpdatedAt),
+	}
+	if endpoint.DisabledAt.Valid {
+		pb.DisabledAt = timestamppb.New(endpoint.DisabledAt.Time)
+	}
+	return pb
+}
+
+// toProtoDelivery converts a webhook delivery to its Connect representation
+func toProtoDelivery(delivery *entity.WebhookDelivery) *webhookv1.WebhookDelivery {
+	pb := &webhookv1.WebhookDelivery{
+		Id:             delivery.ID,
+		EndpointId:     delivery.EndpointID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status.String(),
+		Attempts:       int32(delivery.Attempts),           //nolint:gosec // bounded by MaxWebhookDeliveryAttempts
+		ResponseStatus: int32(delivery.ResponseStatus.Int), //nolint:gosec // HTTP status code
+		LastError:      delivery.LastError.String,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+		UpdatedAt:      timestamppb.New(delivery.UpdatedAt),
+	}
+	if delivery.NextAttemptAt.Valid {
+		pb.NextAttemptAt = timestamppb.New(delivery.NextAttemptAt.Time)
+	}
+	if delivery.LastAttemptAt.Valid {
+		pb.LastAttemptAt = timestamppb.New(delivery.LastAttemptAt.Time)
+	}
+	return pb
+}
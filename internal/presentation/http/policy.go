@@ -0,0 +1,114 @@
+package http
+
+import (
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/branch/v1/branchv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/maintenance/v1/maintenancev1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/metering/v1/meteringv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/rental/v1/rentalv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/tenant/v1/tenantv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/tenantadmin/v1/tenantadminv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1/tenantsettingsv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/webhook/v1/webhookv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+)
+
+// API key scopes
+const (
+	ScopeCarsRead      = "cars:read"
+	ScopeCarsWrite     = "cars:write"
+	ScopeWebhooksRead  = "webhooks:read"
+	ScopeWebhooksWrite = "webhooks:write"
+	ScopeSettingsRead  = "settings:read"
+	ScopeSettingsWrite = "settings:write"
+	ScopeRentalsRead   = "rentals:read"
+	ScopeRentalsWrite  = "rentals:write"
+)
+
+var (
+	staff    = []authctx.Role{authctx.RoleTenantAdmin, authctx.RoleAgent}
+	everyone = []authctx.Role{authctx.RoleTenantAdmin, authctx.RoleAgent, authctx.RoleRenter}
+	admins   = []authctx.Role{authctx.RoleTenantAdmin}
+	platform = []authctx.Role{authctx.RolePlatformAdmin}
+)
+
+// AccessPolicy returns who may call each procedure of the API. A procedure missing here
+// cannot be called by anyone.
+func AccessPolicy() interceptor.Policy {
+	return interceptor.Policy{
+		// Staff manage the fleet; renters browse it
+		carv1connect.CarServiceCreateCarProcedure:         {Roles: staff, Scope: ScopeCarsWrite},
+		carv1connect.CarServiceGetCarProcedure:            {Roles: everyone, Scope: ScopeCarsRead},
+		carv1connect.CarServiceListCarsProcedure:          {Roles: everyone, Scope: ScopeCarsRead},
+		carv1connect.CarServiceCreateCarModelProcedure:    {Roles: staff, Scope: ScopeCarsWrite},
+		carv1connect.CarServiceUpdateCarModelProcedure:    {Roles: staff, Scope: ScopeCarsWrite},
+		carv1connect.CarServiceListCarModelsProcedure:     {Roles: everyone, Scope: ScopeCarsRead},
+		carv1connect.CarServiceAssignCarBranchesProcedure: {Roles: staff, Scope: ScopeCarsWrite},
+
+		// Staff manage the branches; renters look for the closest ones
+		branchv1connect.BranchServiceCreateBranchProcedure:        {Roles: staff, Scope: ScopeCarsWrite},
+		branchv1connect.BranchServiceUpdateBranchProcedure:        {Roles: staff, Scope: ScopeCarsWrite},
+		branchv1connect.BranchServiceListBranchesProcedure:        {Roles: everyone, Scope: ScopeCarsRead},
+		branchv1connect.BranchServiceFindNearestBranchesProcedure: {Roles: everyone, Scope: ScopeCarsRead},
+
+		// Taking cars out of service is up to staff, who see when they are back
+		maintenancev1connect.MaintenanceServiceScheduleMaintenanceWindowProcedure: {Roles: staff, Scope: ScopeCarsWrite},
+		maintenancev1connect.MaintenanceServiceCompleteMaintenanceWindowProcedure: {Roles: staff, Scope: ScopeCarsWrite},
+		maintenancev1connect.MaintenanceServiceCancelMaintenanceWindowProcedure:   {Roles: staff, Scope: ScopeCarsWrite},
+		maintenancev1connect.MaintenanceServiceListMaintenanceWindowsProcedure:    {Roles: staff, Scope: ScopeCarsRead},
+
+		// Webhooks are part of the tenant's integration setup
+		webhookv1connect.WebhookServiceCreateWebhookEndpointProcedure:       {Roles: admins, Scope: ScopeWebhooksWrite},
+		webhookv1connect.WebhookServiceGetWebhookEndpointProcedure:          {Roles: admins, Scope: ScopeWebhooksRead},
+		webhookv1connect.WebhookServiceListWebhookEndpointsProcedure:        {Roles: admins, Scope: ScopeWebhooksRead},
+		webhookv1connect.WebhookServiceUpdateWebhookEndpointProcedure:       {Roles: admins, Scope: ScopeWebhooksWrite},
+		webhookv1connect.WebhookServiceDeleteWebhookEndpointProcedure:       {Roles: admins, Scope: ScopeWebhooksWrite},
+		webhookv1connect.WebhookServiceRotateWebhookEndpointSecretProcedure: {Roles: admins, Scope: ScopeWebhooksWrite},
+		webhookv1connect.WebhookServiceListWebhookDeliveriesProcedure:       {Roles: admins, Scope: ScopeWebhooksRead},
+
+		// API keys cannot manage API keys or see what the tenant pays for
+		tenantadminv1connect.TenantAdminServiceCreateAPIKeyProcedure: {Roles: admins},
+		tenantadminv1connect.TenantAdminServiceListAPIKeysProcedure:  {Roles: admins},
+		tenantadminv1connect.TenantAdminServiceRotateAPIKeyProcedure: {Roles: admins},
+		tenantadminv1connect.TenantAdminServiceRevokeAPIKeyProcedure: {Roles: admins},
+		tenantadminv1connect.TenantAdminServiceGetUsageProcedure:     {Roles: admins},
+
+		// Everyone needs the tenant's timezone and opening hours; admins set them
+		tenantsettingsv1connect.TenantSettingsServiceGetTenantSettingsProcedure:    {Roles: everyone, Scope: ScopeSettingsRead},
+		tenantsettingsv1connect.TenantSettingsServiceUpdateTenantSettingsProcedure: {Roles: admins, Scope: ScopeSettingsWrite},
+
+		// Tenants are managed by the platform operator
+		tenantv1connect.TenantServiceCreateTenantProcedure:           {Roles: platform},
+		tenantv1connect.TenantServiceGetTenantProcedure:              {Roles: platform},
+		tenantv1connect.TenantServiceSuspendTenantProcedure:          {Roles: platform},
+		tenantv1connect.TenantServiceReactivateTenantProcedure:       {Roles: platform},
+		tenantv1connect.TenantServiceChangeTenantPlanProcedure:       {Roles: platform},
+		tenantv1connect.TenantServiceScheduleTenantDeletionProcedure: {Roles: platform},
+		tenantv1connect.TenantServiceCancelTenantDeletionProcedure:   {Roles: platform},
+		tenantv1connect.TenantServiceGetTenantPurgeReportProcedure:   {Roles: platform},
+		tenantv1connect.TenantServiceListPlansProcedure:              {Roles: platform},
+		tenantv1connect.TenantServiceExportTenantProcedure:           {Roles: platform},
+		tenantv1connect.TenantServiceImportTenantProcedure:           {Roles: platform},
+		tenantv1connect.TenantServiceGetArchiveJobProcedure:          {Roles: platform},
+
+		// Fleet sharing opens the cars of one tenant to another, so only the platform operator
+		// sets it up between the children of a franchise
+		tenantv1connect.TenantServiceCreateFleetSharingAgreementProcedure:    {Roles: platform},
+		tenantv1connect.TenantServiceTerminateFleetSharingAgreementProcedure: {Roles: platform},
+		tenantv1connect.TenantServiceListFleetSharingAgreementsProcedure:     {Roles: platform},
+
+		// Renters browse what is available like the fleet, and book and see their own rentals,
+		// including those of cars shared by sibling tenants; staff act for any renter
+		rentalv1connect.RentalServiceSearchAvailableCarsProcedure: {Roles: everyone, Scope: ScopeCarsRead},
+		rentalv1connect.RentalServiceBookRentalProcedure:          {Roles: everyone, Scope: ScopeRentalsWrite, RenterOwned: true},
+		rentalv1connect.RentalServicePickUpRentalProcedure:        {Roles: staff, Scope: ScopeRentalsWrite},
+		rentalv1connect.RentalServiceReturnRentalProcedure:        {Roles: staff, Scope: ScopeRentalsWrite},
+		rentalv1connect.RentalServiceListRentalsProcedure:         {Roles: everyone, Scope: ScopeRentalsRead, RenterOwned: true},
+		rentalv1connect.RentalServiceListRentalHandoversProcedure: {Roles: staff, Scope: ScopeRentalsRead},
+
+		// Metered usage is what the tenant is billed on, so it is for admins only, like the plan
+		meteringv1connect.MeteringServiceGetUsageProcedure:             {Roles: admins},
+		meteringv1connect.MeteringServiceExportUsageStatementProcedure: {Roles: admins},
+	}
+}
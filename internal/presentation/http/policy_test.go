@@ -0,0 +1,129 @@
+package http_test
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	branchv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/branch/v1"
+	carv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1"
+	maintenancev1 "github.com/jp-ryuji/go-arch-patterns/api/generated/maintenance/v1"
+	rentalv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/rental/v1"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/rental/v1/rentalv1connect"
+	tenantv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenant/v1"
+	tenantadminv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantadmin/v1"
+	tenantsettingsv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1"
+	webhookv1 "github.com/jp-ryuji/go-arch-patterns/api/generated/webhook/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/pkg/authctx"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/http"
+)
+
+// TestAccessPolicy tests that every procedure served has an access rule
+func TestAccessPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := http.AccessPolicy()
+	files := []protoreflect.FileDescriptor{
+		carv1.File_api_proto_car_v1_car_service_proto,
+		webhookv1.File_api_proto_webhook_v1_webhook_service_proto,
+		tenantadminv1.File_api_proto_tenantadmin_v1_tenant_admin_service_proto,
+		tenantv1.File_api_proto_tenant_v1_tenant_service_proto,
+		tenantsettingsv1.File_api_proto_tenantsettings_v1_tenant_settings_service_proto,
+		rentalv1.File_api_proto_rental_v1_rental_service_proto,
+		branchv1.File_api_proto_branch_v1_branch_service_proto,
+		maintenancev1.File_api_proto_maintenance_v1_maintenance_service_proto,
+	}
+
+	for _, file := range files {
+		services := file.Services()
+		for i := range services.Len() {
+			methods := services.Get(i).Methods()
+			for j := range methods.Len() {
+				method := methods.Get(j)
+				procedure := "/" + string(method.Parent().FullName()) + "/" + string(method.Name())
+				assert.Contains(t, policy, procedure)
+			}
+		}
+	}
+}
+
+// TestAccessPolicy_RenterOwned tests that renters may only act on their own rentals
+func TestAccessPolicy_RenterOwned(t *testing.T) {
+	t.Parallel()
+
+	policy := http.AccessPolicy()
+	agent := &authctx.Principal{Roles: []authctx.Role{authctx.RoleAgent}}
+	renter := &authctx.Principal{Roles: []authctx.Role{authctx.RoleRenter}, RenterID: "renter-1"}
+
+	tests := map[string]struct {
+		principal *authctx.Principal
+		procedure string
+		msg       any
+		wantOK    bool
+	}{
+		"renter books for themselves": {
+			principal: renter,
+			procedure: rentalv1connect.RentalServiceBookRentalProcedure,
+			msg:       &rentalv1.BookRentalRequest{CarId: "car-1", RenterId: "renter-1"},
+			wantOK:    true,
+		},
+		"renter books for another renter": {
+			principal: renter,
+			procedure: rentalv1connect.RentalServiceBookRentalProcedure,
+			msg:       &rentalv1.BookRentalRequest{CarId: "car-1", RenterId: "renter-2"},
+		},
+		"agent books for any renter": {
+			principal: agent,
+			procedure: rentalv1connect.RentalServiceBookRentalProcedure,
+			msg:       &rentalv1.BookRentalRequest{CarId: "car-1", RenterId: "renter-2"},
+			wantOK:    true,
+		},
+		"renter books a car shared by a sibling tenant": {
+			principal: renter,
+			procedure: rentalv1connect.RentalServiceBookRentalProcedure,
+			msg:       &rentalv1.BookRentalRequest{CarId: "car-of-sibling", RenterId: "renter-1"},
+			wantOK:    true,
+		},
+		"renter lists their own rentals": {
+			principal: renter,
+			procedure: rentalv1connect.RentalServiceListRentalsProcedure,
+			msg:       &rentalv1.ListRentalsRequest{RenterId: "renter-1"},
+			wantOK:    true,
+		},
+		"renter lists the rentals of another renter": {
+			principal: renter,
+			procedure: rentalv1connect.RentalServiceListRentalsProcedure,
+			msg:       &rentalv1.ListRentalsRequest{RenterId: "renter-2"},
+		},
+		"renter lists every rental": {
+			principal: renter,
+			procedure: rentalv1connect.RentalServiceListRentalsProcedure,
+			msg:       &rentalv1.ListRentalsRequest{},
+		},
+		"agent lists every rental": {
+			principal: agent,
+			procedure: rentalv1connect.RentalServiceListRentalsProcedure,
+			msg:       &rentalv1.ListRentalsRequest{},
+			wantOK:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := policy.Authorize(tt.principal, tt.procedure, tt.msg)
+			if tt.wantOK {
+				assert.NoError(t, err)
+				return
+			}
+			var connectErr *connect.Error
+			if assert.True(t, errors.As(err, &connectErr)) {
+				assert.Equal(t, connect.CodePermissionDenied, connectErr.Code())
+			}
+		})
+	}
+}
@@ -2,34 +2,96 @@ package http
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"net"
 	"net/http"
 	"time"
 
+	"connectrpc.com/connect"
 	"connectrpc.com/grpchealth"
 	"connectrpc.com/grpcreflect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/branch/v1/branchv1connect"
 	"github.com/jp-ryuji/go-arch-patterns/api/generated/car/v1/carv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/maintenance/v1/maintenancev1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/metering/v1/meteringv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/rental/v1/rentalv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/tenant/v1/tenantv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/tenantadmin/v1/tenantadminv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/tenantsettings/v1/tenantsettingsv1connect"
+	"github.com/jp-ryuji/go-arch-patterns/api/generated/webhook/v1/webhookv1connect"
 	"github.com/jp-ryuji/go-arch-patterns/internal/application/service"
+	connectbranch "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/branch/v1"
 	connectcar "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/car/v1"
+	"github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/interceptor"
+	connectmaintenance "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/maintenance/v1"
+	connectmetering "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/metering/v1"
+	connectrental "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/rental/v1"
+	connecttenant "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/tenant/v1"
+	connecttenantadmin "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/tenantadmin/v1"
+	connecttenantsettings "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/tenantsettings/v1"
+	connectwebhook "github.com/jp-ryuji/go-arch-patterns/internal/presentation/connect/webhook/v1"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
 
 // Server represents the HTTP server with gRPC Connect
 type Server struct {
-	httpServer *http.Server
-	grpcPort   int
-	httpPort   int
-	carService service.CarService
+	httpServer         *http.Server
+	grpcPort           int
+	httpPort           int
+	carService         service.CarService
+	carModelService    service.CarModelService
+	webhookService     service.WebhookService
+	tenantAdminService service.TenantAdminService
+	tenantService      service.TenantService
+	settingsService    service.TenantSettingsService
+	quotaService       service.QuotaService
+	archiveService     service.TenantArchiveService
+	meteringService    service.MeteringService
+	sharingService     service.FleetSharingService
+	rentalService      service.RentalService
+	branchService      service.BranchService
+	maintenanceService service.MaintenanceWindowService
+	interceptors       []connect.Interceptor
 }
 
-// NewServer creates a new HTTP server with gRPC Connect
-func NewServer(grpcPort, httpPort int, carService service.CarService) *Server {
+// NewServer creates a new HTTP server with gRPC Connect. interceptors run around every
+// service call, the first one outermost.
+func NewServer(
+	grpcPort, httpPort int,
+	carService service.CarService,
+	carModelService service.CarModelService,
+	webhookService service.WebhookService,
+	tenantAdminService service.TenantAdminService,
+	tenantService service.TenantService,
+	settingsService service.TenantSettingsService,
+	quotaService service.QuotaService,
+	archiveService service.TenantArchiveService,
+	meteringService service.MeteringService,
+	sharingService service.FleetSharingService,
+	rentalService service.RentalService,
+	branchService service.BranchService,
+	maintenanceService service.MaintenanceWindowService,
+	interceptors ...connect.Interceptor,
+) *Server {
 	return &Server{
-		grpcPort:   grpcPort,
-		httpPort:   httpPort,
-		carService: carService,
+		grpcPort:           grpcPort,
+		httpPort:           httpPort,
+		carService:         carService,
+		carModelService:    carModelService,
+		webhookService:     webhookService,
+		tenantAdminService: tenantAdminService,
+		tenantService:      tenantService,
+		settingsService:    settingsService,
+		quotaService:       quotaService,
+		archiveService:     archiveService,
+		meteringService:    meteringService,
+		sharingService:     sharingService,
+		rentalService:      rentalService,
+		branchService:      branchService,
+		maintenanceService: maintenanceService,
+		interceptors:       interceptors,
 	}
 }
 
@@ -42,22 +104,70 @@ func (s *Server) Start() error {
 	// Create a mux for Connect handlers
 	mux := http.NewServeMux()
 
-	// Register Connect handlers
-	connectCarServiceHandler := connectcar.NewCarServiceHandler(s.carService)
-	path, handler := carv1connect.NewCarServiceHandler(connectCarServiceHandler)
+	// Register Connect handlers behind the interceptors
+	interceptors := connect.WithInterceptors(s.interceptors...)
+
+	connectCarServiceHandler := connectcar.NewCarServiceHandler(s.carService, s.carModelService)
+	path, handler := carv1connect.NewCarServiceHandler(connectCarServiceHandler, interceptors)
+	mux.Handle(path, handler)
+
+	connectWebhookServiceHandler := connectwebhook.NewWebhookServiceHandler(s.webhookService)
+	path, handler = webhookv1connect.NewWebhookServiceHandler(connectWebhookServiceHandler, interceptors)
+	mux.Handle(path, handler)
+
+	connectTenantAdminServiceHandler := connecttenantadmin.NewTenantAdminServiceHandler(s.tenantAdminService, s.quotaService)
+	path, handler = tenantadminv1connect.NewTenantAdminServiceHandler(connectTenantAdminServiceHandler, interceptors)
+	mux.Handle(path, handler)
+
+	connectTenantServiceHandler := connecttenant.NewTenantServiceHandler(s.tenantService, s.archiveService, s.sharingService)
+	path, handler = tenantv1connect.NewTenantServiceHandler(connectTenantServiceHandler, interceptors)
+	mux.Handle(path, handler)
+
+	connectTenantSettingsServiceHandler := connecttenantsettings.NewTenantSettingsServiceHandler(s.settingsService)
+	path, handler = tenantsettingsv1connect.NewTenantSettingsServiceHandler(connectTenantSettingsServiceHandler, interceptors)
+	mux.Handle(path, handler)
+
+	connectMeteringServiceHandler := connectmetering.NewMeteringServiceHandler(s.meteringService)
+	path, handler = meteringv1connect.NewMeteringServiceHandler(connectMeteringServiceHandler, interceptors)
+	mux.Handle(path, handler)
+
+	connectRentalServiceHandler := connectrental.NewRentalServiceHandler(s.rentalService)
+	path, handler = rentalv1connect.NewRentalServiceHandler(connectRentalServiceHandler, interceptors)
+	mux.Handle(path, handler)
+
+	connectBranchServiceHandler := connectbranch.NewBranchServiceHandler(s.branchService)
+	path, handler = branchv1connect.NewBranchServiceHandler(connectBranchServiceHandler, interceptors)
+	mux.Handle(path, handler)
+
+	connectMaintenanceServiceHandler := connectmaintenance.NewMaintenanceServiceHandler(s.maintenanceService)
+	path, handler = maintenancev1connect.NewMaintenanceServiceHandler(connectMaintenanceServiceHandler, interceptors)
 	mux.Handle(path, handler)
 
 	// Register health and reflection handlers
-	mux.Handle(grpchealth.NewHandler(grpchealth.NewStaticChecker(carv1connect.CarServiceName)))
-	mux.Handle(grpcreflect.NewHandlerV1(grpcreflect.NewStaticReflector(carv1connect.CarServiceName)))
-	mux.Handle(grpcreflect.NewHandlerV1Alpha(grpcreflect.NewStaticReflector(carv1connect.CarServiceName)))
+	serviceNames := []string{
+		carv1connect.CarServiceName,
+		webhookv1connect.WebhookServiceName,
+		tenantadminv1connect.TenantAdminServiceName,
+		tenantv1connect.TenantServiceName,
+		tenantsettingsv1connect.TenantSettingsServiceName,
+		meteringv1connect.MeteringServiceName,
+		rentalv1connect.RentalServiceName,
+		branchv1connect.BranchServiceName,
+		maintenancev1connect.MaintenanceServiceName,
+	}
+	mux.Handle(grpchealth.NewHandler(grpchealth.NewStaticChecker(serviceNames...)))
+	mux.Handle(grpcreflect.NewHandlerV1(grpcreflect.NewStaticReflector(serviceNames...)))
+	mux.Handle(grpcreflect.NewHandlerV1Alpha(grpcreflect.NewStaticReflector(serviceNames...)))
+
+	// Expose runtime metrics such as transaction retries
+	mux.Handle("/debug/vars", expvar.Handler())
 
-	fmt.Printf("Registered car service handler with gRPC Connect\n")
+	fmt.Printf("Registered car, webhook, tenant admin, tenant, tenant settings, metering, rental, branch and maintenance service handlers with gRPC Connect\n")
 
 	// Create HTTP server with timeout configuration
 	s.httpServer = &http.Server{
 		Addr:              fmt.Sprintf(":%d", s.httpPort),
-		Handler:           h2c.NewHandler(mux, &http2.Server{}),
+		Handler:           h2c.NewHandler(interceptor.WithHost(mux), &http2.Server{}),
 		ReadHeaderTimeout: 5 * time.Second, // Add timeout to prevent Slowloris attacks
 	}
 	fmt.Printf("Created HTTP server on port %d\n", s.httpPort)
@@ -1,8 +1,16 @@
 -- Sample data for the car rental platform
 
--- Create a sample tenant if it doesn't already exist
-INSERT INTO tenants (id, code, created_at, updated_at)
-VALUES ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'sample-tenant', NOW(), NOW())
+-- Create the plan catalog if it doesn't already exist; a limit of 0 means unlimited
+INSERT INTO plans (id, code, name, max_cars, max_renters, max_monthly_rentals, created_at, updated_at)
+VALUES
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0ZP01', 'free', 'Free', 5, 50, 20, NOW(), NOW()),
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0ZP02', 'standard', 'Standard', 100, 5000, 2000, NOW(), NOW()),
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0ZP03', 'enterprise', 'Enterprise', 0, 0, 0, NOW(), NOW())
+ON CONFLICT (code) DO NOTHING;
+
+-- Create a sample tenant on the standard plan if it doesn't already exist
+INSERT INTO tenants (id, code, plan_id, created_at, updated_at)
+VALUES ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'sample-tenant', '01GQMF65J0Z0Z0Z0Z0Z0Z0ZP02', NOW(), NOW())
 ON CONFLICT (code) DO NOTHING;
 
 -- Create sample car options if they don't already exist
@@ -13,12 +21,20 @@ VALUES
   ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z3', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'Winter Tires', NOW(), NOW())
 ON CONFLICT DO NOTHING;
 
+-- Create the sample car model catalog if it doesn't already exist
+INSERT INTO car_models (id, tenant_id, make, name, category, seats, transmission, fuel_type, created_at, updated_at)
+VALUES
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0ZM01', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'Toyota', 'Camry', 'midsize', 5, 'automatic', 'hybrid', NOW(), NOW()),
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0ZM02', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'Honda', 'Civic', 'compact', 5, 'manual', 'gasoline', NOW(), NOW()),
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0ZM03', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'Ford', 'Mustang', 'luxury', 4, 'automatic', 'gasoline', NOW(), NOW())
+ON CONFLICT DO NOTHING;
+
 -- Create sample cars if they don't already exist
-INSERT INTO cars (id, tenant_id, model, created_at, updated_at)
+INSERT INTO cars (id, tenant_id, car_model_id, license_plate, license_plate_country, created_at, updated_at)
 VALUES
-  ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z4', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'Toyota Camry', NOW(), NOW()),
-  ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z5', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'Honda Civic', NOW(), NOW()),
-  ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z6', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', 'Ford Mustang', NOW(), NOW())
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z4', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', '01GQMF65J0Z0Z0Z0Z0Z0Z0ZM01', 'ABC-1234', 'US', NOW(), NOW()),
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z5', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', '01GQMF65J0Z0Z0Z0Z0Z0Z0ZM02', 'XYZ-5678', 'US', NOW(), NOW()),
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z6', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0', '01GQMF65J0Z0Z0Z0Z0Z0Z0ZM03', 'MUS-0001', 'US', NOW(), NOW())
 ON CONFLICT DO NOTHING;
 
 -- Create sample renters if they don't already exist
@@ -59,6 +75,6 @@ ON CONFLICT DO NOTHING;
 -- Create sample outbox entries if they don't already exist
 INSERT INTO outboxes (id, aggregate_type, aggregate_id, event_type, payload, created_at, status, version)
 VALUES
-  ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0ZH', 'car', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z4', 'car_created', '{"model": "Toyota Camry", "tenant_id": "01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0"}', NOW(), 'pending', 1),
+  ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0ZH', 'car', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z4', 'car_created', '{"car_model_id": "01GQMF65J0Z0Z0Z0Z0Z0Z0ZM01", "license_plate": "ABC-1234", "license_plate_country": "US", "tenant_id": "01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z0"}', NOW(), 'pending', 1),
   ('01GQMF65J0Z0Z0Z0Z0Z0Z0Z0ZI', 'rental', '01GQMF65J0Z0Z0Z0Z0Z0Z0Z0ZD', 'rental_created', '{"car_id": "01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z4", "renter_id": "01GQMF65J0Z0Z0Z0Z0Z0Z0Z0Z7", "starts_at": "2025-09-23T09:00:00Z"}', NOW(), 'processed', 1)
 ON CONFLICT DO NOTHING;